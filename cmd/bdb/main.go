@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"sync"
+	"os/signal"
+	"syscall"
 
 	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/server"
 	"github.com/spf13/cobra"
 )
@@ -50,7 +52,7 @@ func versionCmd() *cobra.Command {
 			}
 
 			cmd.SilenceUsage = true
-			cmd.Println("bdb 0.1")
+			cmd.Println("bdb " + constants.ServerVersion)
 
 			return nil
 		},
@@ -86,18 +88,18 @@ func startCmd() *cobra.Command {
 				return err
 			}
 
-			var wg sync.WaitGroup
-
-			wg.Add(1)
 			go func() {
 				if err := srv.Start(); err != nil {
-					wg.Done()
 					log.Fatalf("%v", err)
 				}
 			}()
-			wg.Wait()
 
-			return nil
+			// On SIGTERM or SIGINT the server shuts down in order, such that no block is left torn across the stores.
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+			log.Printf("Received signal %s, stopping the blockchain database", <-signals)
+
+			return srv.Stop()
 		},
 	}
 
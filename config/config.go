@@ -15,6 +15,9 @@ import (
 
 const (
 	defaultLocalConfigFile = "config.yml"
+
+	// DefaultMaxTxSizeBytes is the maximal size of a transaction when blockCreation.maxTxSizeBytes is not set.
+	DefaultMaxTxSizeBytes = 4 * 1024 * 1024
 )
 
 // Configurations holds the complete configuration of a database node.
@@ -45,6 +48,13 @@ type ReplicationConf struct {
 	Network NetworkConf
 	// TLS defines TLS settings for server to server communication.
 	TLS TLSConf
+	// StrictBlockFormat refuses the blocks that lack the header fields stamped by the current version, e.g., blocks
+	// replicated from a node of the previous minor version during a rolling upgrade, which are otherwise accepted.
+	StrictBlockFormat bool
+	// StateDeltaCatchUpThreshold is the number of blocks by which a node must lag behind when it catches up, so that it
+	// fetches the net state delta of the range from the leader instead of its blocks, see DatabaseConf.RecordStateDeltas.
+	// The last block of the range is always fetched whole. Zero disables it.
+	StateDeltaCatchUpThreshold uint64
 }
 
 // TLSConf holds TLS configuration settings.
@@ -80,8 +90,28 @@ type ServerConf struct {
 	Provenance    ProvenanceConf
 	// The lengths of various queues that buffer between internal components.
 	QueueLength QueueLengthConf
+	// Shutdown holds the parameters of the orderly shutdown of the node.
+	Shutdown ShutdownConf
+	// Backpressure holds the parameters of the rejection of the transactions the node has no room for.
+	Backpressure BackpressureConf
+	// DeadLetter holds the parameters of the records of the transactions the node accepted but dropped before they
+	// were included in a block.
+	DeadLetter DeadLetterConf
+	// TxLatencySampleRate is the fraction of the submitted transactions, between 0 and 1, for which the time spent
+	// in each stage of the transaction pipeline is recorded. Zero disables the recording.
+	TxLatencySampleRate float64
+	// HeartbeatInterval is the interval at which the node submits a heartbeat transaction, recording its ID,
+	// software version, and last committed height in the heartbeats system database. Zero disables the heartbeats.
+	HeartbeatInterval time.Duration
 	// QueryProcessing holds limits associated with query responses
 	QueryProcessing QueryProcessingConf
+	// QueryConcurrency holds the limits of the number of queries served concurrently, by class of query.
+	QueryConcurrency QueryConcurrencyConf
+	// AccessTokens holds the parameters of the access tokens which the node issues to authenticate the queries of a
+	// user in place of a signature of each query.
+	AccessTokens AccessTokensConf
+	// Performance holds the switches of the performance features of the transaction pipeline.
+	Performance PerformanceConf
 	// Server logging level.
 	LogLevel string
 	// Server TLS configuration, for secure communication with clients.
@@ -113,6 +143,152 @@ type NetworkConf struct {
 type DatabaseConf struct {
 	Name            string
 	LedgerDirectory string
+	// MaxRecoveryBlocks is the maximal number of blocks by which the state database or the state trie may lag
+	// behind the block store, e.g., after a crash, and still be repaired on start by replaying the missing blocks.
+	// If a store lags further behind, the server refuses to start, and the store must be rebuilt.
+	// Zero means the default of 10000 blocks.
+	MaxRecoveryBlocks uint64
+	// StatsSamplingInterval is the interval at which the internal statistics of the state database are sampled
+	// into metrics. Zero disables the sampling.
+	StatsSamplingInterval time.Duration
+	// HandleTTL is the maximal time a snapshot, or an iterator, of the state database may be held before the node
+	// releases it on behalf of its owner. Zero disables the release.
+	HandleTTL time.Duration
+	// CommitCoalescing lets the node group the state database updates of consecutive blocks into a single write,
+	// while it works through a backlog of blocks, e.g., during catch-up.
+	CommitCoalescing CommitCoalescingConf
+	// RecordStateDeltas records the state delta of every committed block, which the node serves to lagging peers
+	// so that they can catch up on a range of blocks by applying its net state delta.
+	RecordStateDeltas bool
+	// RecordLedgerRollups maintains daily rollups of the ledger, e.g., the numbers of transactions and of active users
+	// per day, which are served on /ledger/rollups. The blocks fall on the day of their timestamps. On the first start
+	// with the rollups recorded, they are derived from the blocks already committed.
+	RecordLedgerRollups bool
+	// IsolateFailedDatabases lets the node keep on committing blocks when the commit to a user database fails, e.g.,
+	// as its storage went read-only. The database is then marked unavailable, and the data transactions on it are
+	// invalidated with INVALID_DB_UNAVAILABLE, until an admin resyncs it with the updates it missed, which the node
+	// records. It weakens the atomicity of a block across databases, and the validation of the node may then differ
+	// from its peers', hence it is disabled by default.
+	IsolateFailedDatabases bool
+	// VerifyReplayedValidation verifies, when the blocks are replayed onto a state database that lags behind the
+	// block store, e.g., after a long downtime, that the validation results of each block were signed by this node or
+	// by another node of the cluster, and applies them without validating the block anew. The blocks whose results
+	// are not signed, e.g., as they were committed by an older version of the server, are validated anew. When false,
+	// the validation results of the replayed blocks are applied as is.
+	VerifyReplayedValidation bool
+	// IntegrityCheckedDBs are the state databases in the integrity mode: the SHA256 hash of each value is stored
+	// along with the value when it is committed, and is verified whenever the value is read, so that a value which
+	// rotted, on the storage or in memory, is never returned. The values of a database can be verified in the
+	// background on /admin/scrub. The values committed before a database was put in the mode are read unverified.
+	IntegrityCheckedDBs []string
+	// ReadReplica serves the analytical queries, e.g., large range scans, from a read-only copy of the state
+	// database, so that they do not compete with the commits on the state database.
+	ReadReplica ReadReplicaConf
+	// TrustedCheckpointsFile is the path of a file that pins the header hashes of some blocks, e.g., as emitted by
+	// the /admin/checkpoints endpoint of a trusted node. If set, the server refuses to start on a block store whose
+	// headers do not match the checkpoints.
+	TrustedCheckpointsFile string
+	// VerifyChainOnStart verifies, on start, that each block of the block store is linked to the header of the
+	// previous block, and that the cumulative counters of its header add up the transactions of the blocks up to it.
+	// The server refuses to start on a block store that fails the verification. As every block is read, it slows the
+	// start of a node with a long ledger, hence, it is disabled by default.
+	VerifyChainOnStart bool
+	// IndexRebuildWorkers is the number of workers which rebuild the block index from the block files on start, if
+	// the block index is missing. Zero means one worker per CPU.
+	IndexRebuildWorkers int
+	// MaxBlockFileSize is the size, in bytes, beyond which a file of the block store is not appended to: the blocks
+	// go to a new file, so that the files can be backed up, or archived, one at a time. A block larger than the size
+	// takes a file of its own. Zero means the default of 64 MiB.
+	MaxBlockFileSize int64
+	// BlockCompression is the compression of the blocks appended to the files of the block store: none, or snappy.
+	// The compression is recorded with each block, hence, it can be changed between restarts, and the blocks
+	// appended before the change stay readable. Empty means snappy.
+	BlockCompression string
+	// WarmUp preloads the caches of the state database on start, once the state database is recovered, so that the
+	// first queries after a restart do not hit cold caches.
+	WarmUp WarmUpConf
+}
+
+// WarmUpConf holds the parameters of the warm-up of the caches of the state database. The warm-up runs in the
+// background, and its progress is reported in the cluster status; the node is ready to serve once it ends.
+type WarmUpConf struct {
+	// RecentBlocks is the number of most recent blocks whose read and written keys, and whose submitters, are read
+	// back into the caches. The warm-up is disabled when it is zero and no HotKeyPrefixes are set.
+	RecentBlocks uint64
+	// HotKeyPrefixes are the key prefixes, of the user databases, read into the caches after the recent blocks.
+	HotKeyPrefixes []HotKeyPrefixConf
+	// MaxDuration bounds the time of the warm-up, which is aborted once it is exceeded. Zero means the default of
+	// one minute.
+	MaxDuration time.Duration
+	// MaxBytes bounds the bytes of the values read by the warm-up, which is aborted once they are exceeded. Zero
+	// means the default of 256 MiB.
+	MaxBytes uint64
+}
+
+// HotKeyPrefixConf is a key prefix of a database
+type HotKeyPrefixConf struct {
+	DBName string
+	Prefix string
+}
+
+// ReadReplicaConf holds the parameters of the read replica of the state database. The replica is opened on a
+// hard-linked checkpoint of the state database, which is taken anew every RefreshInterval blocks.
+type ReadReplicaConf struct {
+	// RefreshInterval is the number of blocks between the refreshes of the replica. Zero disables the replica.
+	RefreshInterval uint64
+}
+
+// CommitCoalescingConf holds the parameters of the coalescing of state database commits. The blocks are validated
+// and committed to the block store one by one; only their state database updates are grouped.
+type CommitCoalescingConf struct {
+	// BacklogThreshold is the number of blocks waiting behind a block, as reported by the replication layer, at or
+	// above which the block may be coalesced. Zero disables the coalescing.
+	BacklogThreshold uint64
+	// MaxBlocks is the maximal number of blocks grouped into a single write. Zero means the default of 16 blocks.
+	MaxBlocks uint32
+}
+
+// ShutdownConf holds the parameters of the orderly shutdown of the node, which starts by rejecting new transaction
+// submissions, and ends by closing the stores.
+type ShutdownConf struct {
+	// DrainTimeout is the maximal time to wait for the pending transactions, which were submitted to this node
+	// before the shutdown started, to be committed. Zero skips the wait. The transactions that are still pending when
+	// the pipeline stops are released with an error.
+	DrainTimeout time.Duration
+	// StepTimeout bounds each of the other steps of the shutdown. If a step does not complete in time, the shutdown
+	// is aborted before the stores are closed. Zero means the default of 30 seconds.
+	StepTimeout time.Duration
+}
+
+// DeadLetterConf holds the parameters of the dead-letter records, which the node keeps for every transaction it
+// accepted but dropped before it was included in a block, e.g., as its deadline passed or as the node shut down.
+type DeadLetterConf struct {
+	// Retention is the period for which a record is kept after the drop. Zero means the default of 7 days.
+	Retention time.Duration
+	// JanitorInterval is the interval between two purges of the records older than the retention period. Zero means
+	// the default of 1 hour.
+	JanitorInterval time.Duration
+}
+
+// AccessTokensConf holds the parameters of the access tokens issued by the node.
+type AccessTokensConf struct {
+	// MaxLifetime is the maximal lifetime of a token, which is also the lifetime of a token requested without one.
+	// Zero means the default of 1 hour.
+	MaxLifetime time.Duration
+}
+
+// BackpressureConf holds the parameters of the rejection of the transactions submitted while the transaction queue
+// is full. The client is asked, with a Retry-After header, to wait for the time the node needs to commit its backlog
+// at the commit rate observed over the recent window, clamped to the range [RetryAfterMin, RetryAfterMax].
+type BackpressureConf struct {
+	// CommitRateWindow is the length of the window over which the commit rate is observed. Zero means the default
+	// of 30 seconds.
+	CommitRateWindow time.Duration
+	// RetryAfterMin is the minimal interval a client is asked to wait. Zero means the default of 1 second.
+	RetryAfterMin time.Duration
+	// RetryAfterMax is the maximal interval a client is asked to wait, which is also the interval when no
+	// transaction was committed over the window. Zero means the default of 60 seconds.
+	RetryAfterMax time.Duration
 }
 
 // QueueLengthConf holds the queue length of all queues within the node.
@@ -125,6 +301,92 @@ type QueueLengthConf struct {
 // QueryProcessingConf holds the configuration associated with rich and range query processing.
 type QueryProcessingConf struct {
 	ResponseSizeLimitInBytes uint64
+	// The maximum number of key versions, and of keys in the case of a range query, that a point-in-time query,
+	// i.e., a query of data as of a past block, may examine in the provenance store.
+	HistoricalQueryCostLimit uint64
+	// The maximum number of keys that a count or existence query may examine; beyond it the result is truncated. Zero
+	// stands for 100000.
+	CountQueryCostLimit uint64
+	// The maximum number of keys that a multi-key read may request. Zero stands for 100.
+	MultiGetKeysLimit uint64
+	// The policy which decides whether a user may read a past version of a key: "strict", the default, lets a user
+	// read a version only if the user could read it at its time, while "union" also lets a user who can read the key
+	// now read all its past versions.
+	HistoricalReadPolicy string
+	// CursorSecretFile is the file holding the secret which signs the pagination cursors. The nodes of a cluster that
+	// share the secret accept the cursors issued by each other. Empty means a secret generated by the node on its
+	// first start, and kept in the ledger directory.
+	CursorSecretFile string
+}
+
+// QueryConcurrencyConf holds the limits of the number of queries served concurrently, by class of query, which keep
+// a burst of expensive queries from saturating the state database and stalling the commits of the blocks. A query
+// that finds its class at the limit waits for up to QueueTimeout for another query of the class to complete, and is
+// then rejected with 503 and a Retry-After header. The transactions, the subscriptions, and the admin, cluster and
+// session requests are not limited, nor is the access of the committer to the state database.
+type QueryConcurrencyConf struct {
+	// PointReads limits the reads of a single key, user, database, configuration, block, receipt or proof. Zero
+	// means no limit.
+	PointReads uint32
+	// RangeQueries limits the range, JSON, count, existence and multi-key queries over the keys of a database. Zero
+	// means no limit.
+	RangeQueries uint32
+	// HistoryQueries limits the provenance queries, and the queries of the descriptor history and the digest of a
+	// database. Zero means no limit.
+	HistoryQueries uint32
+	// QueueTimeout is the maximal time a query waits for its class to fall below the limit. Zero means the default
+	// of 100 milliseconds.
+	QueueTimeout time.Duration
+	// RetryAfter is the interval a client whose query was rejected is asked to wait before it retries. Zero means
+	// the default of 1 second.
+	RetryAfter time.Duration
+	// CommitPriority gives the commits of the blocks priority over the queries while the commits are slow.
+	CommitPriority CommitPriorityConf
+}
+
+// CommitPriorityConf holds the parameters of the priority of the commits over the queries. The commits come under
+// pressure when the mean latency of the validation and commit of the blocks committed over the commit rate window of
+// the backpressure reaches PressureLatency. Under pressure, the limit of each class of queries is halved, and the
+// queue timeout of the queries is halved, so that more queries are shed, until the mean latency falls below
+// ReliefLatency. The pressure is evaluated on each commit, and only the classes with a limit are affected.
+type CommitPriorityConf struct {
+	// Enabled turns on the priority of the commits over the queries.
+	Enabled bool
+	// PressureLatency is the mean commit latency at which the query limits are halved. Zero means the default of
+	// 1 second.
+	PressureLatency time.Duration
+	// ReliefLatency is the mean commit latency below which the query limits are restored. It must be lower than
+	// PressureLatency. Zero means half of PressureLatency.
+	ReliefLatency time.Duration
+	// MinCommits is the number of commits the window must hold before the commits come under pressure, so that a
+	// single slow block does not halve the limits. Zero means the default of 3.
+	MinCommits uint32
+}
+
+// PerformanceConf holds the switches of the performance features of the transaction pipeline.
+type PerformanceConf struct {
+	// DebugDeterministic runs the validation and commit of blocks strictly sequentially, on a single goroutine:
+	// the parallel signature validation, the bulk prefetch of read versions, and the coalescing of commits are all
+	// disabled. It is meant for debugging non-deterministic behavior, and is recorded in the producer metadata of
+	// every block committed while it is set.
+	DebugDeterministic bool
+	// ReadHints warms the state database with the keys which the submitted data transactions declare they read,
+	// before their block is validated.
+	ReadHints ReadHintsConf
+}
+
+// ReadHintsConf holds the parameters of the prefetch of the keys which a data transaction, submitted over the REST
+// API, declares it reads in the X-Read-Hints header. The hints are advisory: they affect the time the validation of
+// the transaction takes, never its result.
+type ReadHintsConf struct {
+	// Enabled turns on the prefetch of the hinted keys.
+	Enabled bool
+	// MaxHintsPerTx bounds the number of keys prefetched for a transaction; the hints beyond it are ignored. Zero
+	// stands for 256.
+	MaxHintsPerTx uint32
+	// QueueLength bounds the number of transactions whose hints wait to be prefetched; the hints of a transaction
+	// submitted while the queue is full are dropped. Zero stands for 1024.
+	QueueLength uint32
 }
 
 // BlockCreationConf holds the block creation parameters.
@@ -133,6 +395,36 @@ type BlockCreationConf struct {
 	MaxBlockSize                uint64
 	MaxTransactionCountPerBlock uint32
 	BlockTimeout                time.Duration
+	// MaxTxSizeBytes bounds the size of a transaction submitted to the node, and hence, the size of the body of the
+	// requests that the node reads. Zero stands for DefaultMaxTxSizeBytes.
+	MaxTxSizeBytes uint64
+	// LowLatency cuts the data transactions into blocks of their own, without waiting for the block timeout, while
+	// the node is lightly loaded.
+	LowLatency LowLatencyConf
+	// AdminReservationPercent is the percentage of MaxTransactionCountPerBlock reserved for the pending user
+	// administration transactions while data transactions are pending. The user administration transactions then
+	// ride along with the data transactions, in a mini-batch of their own that is cut right after each batch of data
+	// transactions. Zero keeps each user administration transaction in a block of its own.
+	AdminReservationPercent uint32
+	// InvalidBatchPolicy decides what becomes of a batch of data transactions which are all invalid: "commit", the
+	// default, commits the batch in a block, so that each transaction has a receipt, while "drop" validates the batch
+	// before the block is created, and drops the batch if all its transactions are invalid, so that it consumes no
+	// block. The transactions of a dropped batch have no receipt; their rejection is reported by the receipt query
+	// and by their dead-letter records.
+	InvalidBatchPolicy string
+}
+
+// LowLatencyConf holds the parameters of the low-latency mode of block creation. A data transaction that arrives
+// after the transaction queue has been quiet for QuietPeriod is cut into a block immediately, and so are the
+// transactions that follow it, until more than MaxArrivalRate transactions arrive within a second. The regular
+// batching then resumes until the queue is quiet again for QuietPeriod.
+type LowLatencyConf struct {
+	// QuietPeriod is the time without any arriving transaction that switches to the low-latency mode. Zero disables
+	// the mode.
+	QuietPeriod time.Duration
+	// MaxArrivalRate is the number of transactions per second above which the low-latency mode reverts to the
+	// regular batching.
+	MaxArrivalRate uint32
 }
 
 // ProvenanceConf holds the provenance configuration parameters.
@@ -219,6 +511,8 @@ func readLocalConfig(localConfigFile string) (*LocalConfiguration, error) {
 	v.SetDefault("server.database.name", "leveldb")
 	v.SetDefault("server.database.ledgerDirectory", "./tmp/")
 	v.SetDefault("server.queryProcessing.responseSizeLimitInBytes", 1048576)
+	v.SetDefault("server.queryProcessing.historicalQueryCostLimit", 100000)
+	v.SetDefault("server.queryProcessing.countQueryCostLimit", 100000)
 
 	if err := v.ReadInConfig(); err != nil {
 		return nil, errors.Wrap(err, "error reading local config file")
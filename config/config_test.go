@@ -34,6 +34,10 @@ var expectedLocalConfig = &LocalConfiguration{
 		},
 		QueryProcessing: QueryProcessingConf{
 			ResponseSizeLimitInBytes: 1048576,
+			HistoricalQueryCostLimit: 100000,
+			CountQueryCostLimit:      100000,
+			MultiGetKeysLimit:        100,
+			HistoricalReadPolicy:     "strict",
 		},
 		LogLevel: "info",
 		TLS: TLSConf{
@@ -94,6 +94,23 @@ type LedgerConf struct {
 	// This flag takes effect on deployment (bootstrap) only, from the first (genesis) block.
 	// The value of this flag cannot be changed during run-time.
 	StateMerklePatriciaTrieDisabled bool
+	// MaxKeysPerRangeDelete is the maximal number of keys that a single range delete of a data transaction may
+	// delete. A transaction with a range delete that covers more keys is invalid. Zero means the default of 1000 keys.
+	// The value is recorded in the genesis block, and can be changed later by a config transaction.
+	MaxKeysPerRangeDelete uint64
+	// TxOperationLimits bounds the number of operations of a single data transaction. A transaction that exceeds any
+	// of the limits is invalid. The limits are recorded in the genesis block, and can be changed later by a config
+	// transaction.
+	TxOperationLimits TxOperationLimitsConf
+}
+
+// TxOperationLimitsConf holds the limits on the operations of a single data transaction, summed over the databases it
+// operates on. A patch counts as a write, and a range delete as a single delete. Zero means no limit.
+type TxOperationLimitsConf struct {
+	MaxReads      uint64
+	MaxWrites     uint64
+	MaxDeletes    uint64
+	MaxOperations uint64
 }
 
 // readSharedConfig reads the shared config from the file and returns it.
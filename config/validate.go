@@ -0,0 +1,263 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Violation describes a single configuration parameter that fails validation. The Field is the path of the
+// parameter as it appears in the configuration files, e.g., "server.queueLength.block".
+type Violation struct {
+	Field  string
+	Reason string
+}
+
+func (v *Violation) String() string {
+	return v.Field + ": " + v.Reason
+}
+
+// ValidationError holds all the violations found in a configuration.
+type ValidationError struct {
+	Violations []*Violation
+}
+
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("invalid configuration, %d violation(s):", len(e.Violations)))
+	for _, v := range e.Violations {
+		sb.WriteString("\n\t")
+		sb.WriteString(v.String())
+	}
+	return sb.String()
+}
+
+// defaultMaxRecoveryBlocks mirrors the default of the block processor, which the config package cannot import
+const defaultMaxRecoveryBlocks = 10000
+
+type violations []*Violation
+
+func (vs *violations) add(field, format string, args ...interface{}) {
+	*vs = append(*vs, &Violation{Field: field, Reason: fmt.Sprintf(format, args...)})
+}
+
+func (vs *violations) requireSet(field, value string) {
+	if value == "" {
+		vs.add(field, "must be set")
+	}
+}
+
+func (vs *violations) requireNonNegative(field string, d time.Duration) {
+	if d < 0 {
+		vs.add(field, "must not be negative, found %s", d)
+	}
+}
+
+// Validate checks the configuration as a whole, before any of the stores of the node is opened. It reports all the
+// violations it finds, rather than the first one, as a *ValidationError. The shared configuration is validated only
+// if it is present, i.e., when the node bootstraps from a genesis configuration.
+func (c *Configurations) Validate() error {
+	var vs violations
+
+	if c.LocalConfig == nil {
+		vs.add("local", "the local configuration is missing")
+	} else {
+		c.LocalConfig.validate(&vs)
+	}
+
+	if c.SharedConfig != nil {
+		c.SharedConfig.validate(&vs)
+	}
+
+	if c.LocalConfig != nil && c.SharedConfig != nil {
+		validateCrossConfig(c.LocalConfig, c.SharedConfig, &vs)
+	}
+
+	if len(vs) > 0 {
+		return &ValidationError{Violations: vs}
+	}
+	return nil
+}
+
+func (c *LocalConfiguration) validate(vs *violations) {
+	server := &c.Server
+
+	vs.requireSet("server.identity.id", server.Identity.ID)
+	vs.requireSet("server.identity.certificatePath", server.Identity.CertificatePath)
+	vs.requireSet("server.identity.keyPath", server.Identity.KeyPath)
+
+	if server.Database.Name != "leveldb" {
+		vs.add("server.database.name", "must be leveldb, which is the only supported state database, found %q", server.Database.Name)
+	}
+	vs.requireSet("server.database.ledgerDirectory", server.Database.LedgerDirectory)
+	vs.requireNonNegative("server.database.statsSamplingInterval", server.Database.StatsSamplingInterval)
+	vs.requireNonNegative("server.database.handleTTL", server.Database.HandleTTL)
+	if server.Database.IndexRebuildWorkers < 0 {
+		vs.add("server.database.indexRebuildWorkers", "must not be negative, found %d", server.Database.IndexRebuildWorkers)
+	}
+	if server.Database.MaxBlockFileSize < 0 {
+		vs.add("server.database.maxBlockFileSize", "must not be negative, found %d", server.Database.MaxBlockFileSize)
+	}
+	switch server.Database.BlockCompression {
+	case "", "none", "snappy":
+	case "zstd":
+		vs.add("server.database.blockCompression", "zstd is not available in this build, must be none or snappy")
+	default:
+		vs.add("server.database.blockCompression", "must be none or snappy, found %q", server.Database.BlockCompression)
+	}
+	if coalescing := server.Database.CommitCoalescing; coalescing.BacklogThreshold > 0 {
+		maxRecoveryBlocks := server.Database.MaxRecoveryBlocks
+		if maxRecoveryBlocks == 0 {
+			maxRecoveryBlocks = defaultMaxRecoveryBlocks
+		}
+		if uint64(coalescing.MaxBlocks) > maxRecoveryBlocks {
+			vs.add("server.database.commitCoalescing.maxBlocks",
+				"must not exceed server.database.maxRecoveryBlocks [%d], as a crash may leave the state database behind by a whole group, found %d",
+				maxRecoveryBlocks, coalescing.MaxBlocks)
+		}
+	}
+
+	if server.QueueLength.Transaction == 0 {
+		vs.add("server.queueLength.transaction", "must be greater than 0, e.g., 1000")
+	}
+	if server.QueueLength.ReorderedTransactionBatch == 0 {
+		vs.add("server.queueLength.reorderedTransactionBatch", "must be greater than 0, e.g., 100")
+	}
+	if server.QueueLength.Block == 0 {
+		vs.add("server.queueLength.block", "must be greater than 0, e.g., 100")
+	}
+
+	vs.requireNonNegative("server.shutdown.drainTimeout", server.Shutdown.DrainTimeout)
+	vs.requireNonNegative("server.shutdown.stepTimeout", server.Shutdown.StepTimeout)
+	vs.requireNonNegative("server.heartbeatInterval", server.HeartbeatInterval)
+	vs.requireNonNegative("server.backpressure.commitRateWindow", server.Backpressure.CommitRateWindow)
+	vs.requireNonNegative("server.backpressure.retryAfterMin", server.Backpressure.RetryAfterMin)
+	vs.requireNonNegative("server.backpressure.retryAfterMax", server.Backpressure.RetryAfterMax)
+	if backpressure := server.Backpressure; backpressure.RetryAfterMax > 0 && backpressure.RetryAfterMin > backpressure.RetryAfterMax {
+		vs.add("server.backpressure.retryAfterMin", "must not exceed server.backpressure.retryAfterMax [%s], found %s",
+			backpressure.RetryAfterMax, backpressure.RetryAfterMin)
+	}
+
+	vs.requireNonNegative("server.queryConcurrency.queueTimeout", server.QueryConcurrency.QueueTimeout)
+	vs.requireNonNegative("server.queryConcurrency.retryAfter", server.QueryConcurrency.RetryAfter)
+	vs.requireNonNegative("server.queryConcurrency.commitPriority.pressureLatency", server.QueryConcurrency.CommitPriority.PressureLatency)
+	vs.requireNonNegative("server.queryConcurrency.commitPriority.reliefLatency", server.QueryConcurrency.CommitPriority.ReliefLatency)
+	if priority := server.QueryConcurrency.CommitPriority; priority.PressureLatency > 0 && priority.ReliefLatency >= priority.PressureLatency {
+		vs.add("server.queryConcurrency.commitPriority.reliefLatency", "must be lower than server.queryConcurrency.commitPriority.pressureLatency [%s], found %s",
+			priority.PressureLatency, priority.ReliefLatency)
+	}
+
+	if server.TxLatencySampleRate < 0 || server.TxLatencySampleRate > 1 {
+		vs.add("server.txLatencySampleRate", "must be in the range [0, 1], found %v", server.TxLatencySampleRate)
+	}
+
+	switch server.LogLevel {
+	case "debug", "info", "warn", "err", "panic":
+	default:
+		vs.add("server.logLevel", "must be one of debug, info, warn, err, or panic, found %q", server.LogLevel)
+	}
+
+	validateTLS("server.tls", &server.TLS, false, vs)
+
+	if c.BlockCreation.MaxBlockSize == 0 {
+		vs.add("blockCreation.maxBlockSize", "must be greater than 0, e.g., 2097152")
+	}
+	if c.BlockCreation.MaxTransactionCountPerBlock == 0 {
+		vs.add("blockCreation.maxTransactionCountPerBlock", "must be greater than 0, e.g., 100")
+	} else if server.QueueLength.Transaction > 0 && c.BlockCreation.MaxTransactionCountPerBlock > server.QueueLength.Transaction {
+		vs.add("blockCreation.maxTransactionCountPerBlock",
+			"must not exceed server.queueLength.transaction [%d], as a block could never be filled from the transaction queue, found %d",
+			server.QueueLength.Transaction, c.BlockCreation.MaxTransactionCountPerBlock)
+	}
+	if c.BlockCreation.BlockTimeout <= 0 {
+		vs.add("blockCreation.blockTimeout", "must be greater than 0, e.g., 50ms, found %s", c.BlockCreation.BlockTimeout)
+	}
+	if c.BlockCreation.AdminReservationPercent >= 100 {
+		vs.add("blockCreation.adminReservationPercent", "must be less than 100, e.g., 5, found %d", c.BlockCreation.AdminReservationPercent)
+	}
+	vs.requireNonNegative("blockCreation.lowLatency.quietPeriod", c.BlockCreation.LowLatency.QuietPeriod)
+	if c.BlockCreation.LowLatency.QuietPeriod > 0 && c.BlockCreation.LowLatency.MaxArrivalRate == 0 {
+		vs.add("blockCreation.lowLatency.maxArrivalRate", "must be greater than 0 when blockCreation.lowLatency.quietPeriod is set, e.g., 100")
+	}
+
+	vs.requireSet("replication.walDir", c.Replication.WALDir)
+	vs.requireSet("replication.snapDir", c.Replication.SnapDir)
+	vs.requireSet("replication.auxDir", c.Replication.AuxDir)
+	if c.Replication.WALDir != "" && c.Replication.WALDir == c.Replication.SnapDir {
+		vs.add("replication.snapDir", "must differ from replication.walDir [%s]", c.Replication.WALDir)
+	}
+
+	if server.Network.Port != 0 && server.Network == c.Replication.Network {
+		vs.add("replication.network",
+			"must differ from the client listener server.network [%s:%d], as both are served by separate listeners",
+			server.Network.Address, server.Network.Port)
+	}
+
+	validateTLS("replication.tls", &c.Replication.TLS, true, vs)
+}
+
+// validateTLS checks that an enabled TLS configuration carries both the certificate and the key of the server, and,
+// for server to server communication, of the client too.
+func validateTLS(field string, tlsConf *TLSConf, withClient bool, vs *violations) {
+	if !tlsConf.Enabled {
+		if tlsConf.ClientAuthRequired {
+			vs.add(field+".clientAuthRequired", "requires %s.enabled to be true", field)
+		}
+		return
+	}
+
+	vs.requireSet(field+".serverCertificatePath", tlsConf.ServerCertificatePath)
+	vs.requireSet(field+".serverKeyPath", tlsConf.ServerKeyPath)
+	if withClient {
+		vs.requireSet(field+".clientCertificatePath", tlsConf.ClientCertificatePath)
+		vs.requireSet(field+".clientKeyPath", tlsConf.ClientKeyPath)
+	}
+}
+
+func (c *SharedConfiguration) validate(vs *violations) {
+	if c.Consensus == nil {
+		vs.add("consensus", "must be set")
+		return
+	}
+
+	if c.Consensus.Algorithm != "raft" {
+		vs.add("consensus.algorithm", "must be raft, which is the only supported algorithm, found %q", c.Consensus.Algorithm)
+	}
+
+	raft := c.Consensus.RaftConfig
+	if raft == nil {
+		vs.add("consensus.raftConfig", "must be set")
+		return
+	}
+
+	if d, err := time.ParseDuration(raft.TickInterval); err != nil || d <= 0 {
+		vs.add("consensus.raftConfig.tickInterval", "must be a positive duration, e.g., 100ms, found %q", raft.TickInterval)
+	}
+	if raft.HeartbeatTicks == 0 {
+		vs.add("consensus.raftConfig.heartbeatTicks", "must be greater than 0, e.g., 1")
+	}
+	if raft.ElectionTicks <= raft.HeartbeatTicks {
+		vs.add("consensus.raftConfig.electionTicks",
+			"must be greater than consensus.raftConfig.heartbeatTicks [%d], e.g., 10 times greater, found %d",
+			raft.HeartbeatTicks, raft.ElectionTicks)
+	}
+	if raft.MaxInflightBlocks == 0 {
+		vs.add("consensus.raftConfig.maxInflightBlocks", "must be greater than 0, e.g., 50")
+	}
+}
+
+func validateCrossConfig(local *LocalConfiguration, shared *SharedConfiguration, vs *violations) {
+	if shared.Consensus == nil || shared.Consensus.RaftConfig == nil {
+		return
+	}
+
+	snapshotIntervalSize := shared.Consensus.RaftConfig.SnapshotIntervalSize
+	if maxBlockSize := local.BlockCreation.MaxBlockSize; snapshotIntervalSize < maxBlockSize {
+		vs.add("consensus.raftConfig.snapshotIntervalSize",
+			"must not be smaller than blockCreation.maxBlockSize [%d], otherwise a snapshot is taken on every block, found %d",
+			maxBlockSize, snapshotIntervalSize)
+	}
+}
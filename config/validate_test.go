@@ -0,0 +1,343 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	readConfig := func(t *testing.T, path string) *Configurations {
+		conf, err := Read(path)
+		require.NoError(t, err)
+		return conf
+	}
+
+	t.Run("valid genesis and join configurations", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, readConfig(t, "./testdata").Validate())
+		require.NoError(t, readConfig(t, "./testdata/config-join.yml").Validate())
+	})
+
+	tests := []struct {
+		name               string
+		update             func(c *Configurations)
+		expectedViolations []*Violation
+	}{
+		{
+			name:   "local configuration is missing",
+			update: func(c *Configurations) { c.LocalConfig = nil },
+			expectedViolations: []*Violation{
+				{Field: "local", Reason: "the local configuration is missing"},
+			},
+		},
+		{
+			name: "identity is not set",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.Identity = IdentityConf{}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.identity.id", Reason: "must be set"},
+				{Field: "server.identity.certificatePath", Reason: "must be set"},
+				{Field: "server.identity.keyPath", Reason: "must be set"},
+			},
+		},
+		{
+			name: "unsupported database",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.Database = DatabaseConf{Name: "couchdb", StatsSamplingInterval: -time.Second, HandleTTL: -time.Minute, IndexRebuildWorkers: -1, MaxBlockFileSize: -1, BlockCompression: "lz4"}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.database.name", Reason: "must be leveldb, which is the only supported state database, found \"couchdb\""},
+				{Field: "server.database.ledgerDirectory", Reason: "must be set"},
+				{Field: "server.database.statsSamplingInterval", Reason: "must not be negative, found -1s"},
+				{Field: "server.database.handleTTL", Reason: "must not be negative, found -1m0s"},
+				{Field: "server.database.indexRebuildWorkers", Reason: "must not be negative, found -1"},
+				{Field: "server.database.maxBlockFileSize", Reason: "must not be negative, found -1"},
+				{Field: "server.database.blockCompression", Reason: "must be none or snappy, found \"lz4\""},
+			},
+		},
+		{
+			name: "coalesced group larger than the recoverable gap",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.Database.MaxRecoveryBlocks = 8
+				c.LocalConfig.Server.Database.CommitCoalescing = CommitCoalescingConf{BacklogThreshold: 10, MaxBlocks: 16}
+			},
+			expectedViolations: []*Violation{
+				{
+					Field:  "server.database.commitCoalescing.maxBlocks",
+					Reason: "must not exceed server.database.maxRecoveryBlocks [8], as a crash may leave the state database behind by a whole group, found 16",
+				},
+			},
+		},
+		{
+			name: "queue lengths of 0",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.QueueLength = QueueLengthConf{}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.queueLength.transaction", Reason: "must be greater than 0, e.g., 1000"},
+				{Field: "server.queueLength.reorderedTransactionBatch", Reason: "must be greater than 0, e.g., 100"},
+				{Field: "server.queueLength.block", Reason: "must be greater than 0, e.g., 100"},
+			},
+		},
+		{
+			name: "negative intervals",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.Shutdown = ShutdownConf{DrainTimeout: -time.Second, StepTimeout: -time.Minute}
+				c.LocalConfig.Server.HeartbeatInterval = -time.Millisecond
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.shutdown.drainTimeout", Reason: "must not be negative, found -1s"},
+				{Field: "server.shutdown.stepTimeout", Reason: "must not be negative, found -1m0s"},
+				{Field: "server.heartbeatInterval", Reason: "must not be negative, found -1ms"},
+			},
+		},
+		{
+			name: "retry after range",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.Backpressure = BackpressureConf{CommitRateWindow: -time.Second, RetryAfterMin: 10 * time.Second, RetryAfterMax: 5 * time.Second}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.backpressure.commitRateWindow", Reason: "must not be negative, found -1s"},
+				{Field: "server.backpressure.retryAfterMin", Reason: "must not exceed server.backpressure.retryAfterMax [5s], found 10s"},
+			},
+		},
+		{
+			name: "negative query concurrency intervals",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.QueryConcurrency = QueryConcurrencyConf{HistoryQueries: 2, QueueTimeout: -time.Millisecond, RetryAfter: -time.Second}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.queryConcurrency.queueTimeout", Reason: "must not be negative, found -1ms"},
+				{Field: "server.queryConcurrency.retryAfter", Reason: "must not be negative, found -1s"},
+			},
+		},
+		{
+			name: "commit priority thresholds",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.QueryConcurrency.CommitPriority = CommitPriorityConf{Enabled: true, PressureLatency: time.Second, ReliefLatency: time.Second}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.queryConcurrency.commitPriority.reliefLatency", Reason: "must be lower than server.queryConcurrency.commitPriority.pressureLatency [1s], found 1s"},
+			},
+		},
+		{
+			name: "sample rate out of range",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.TxLatencySampleRate = 1.5
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.txLatencySampleRate", Reason: "must be in the range [0, 1], found 1.5"},
+			},
+		},
+		{
+			name: "invalid log level",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.LogLevel = "error"
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.logLevel", Reason: "must be one of debug, info, warn, err, or panic, found \"error\""},
+			},
+		},
+		{
+			name: "server TLS without a certificate and a key",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.TLS = TLSConf{Enabled: true}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.tls.serverCertificatePath", Reason: "must be set"},
+				{Field: "server.tls.serverKeyPath", Reason: "must be set"},
+			},
+		},
+		{
+			name: "client authentication without TLS",
+			update: func(c *Configurations) {
+				c.LocalConfig.Server.TLS = TLSConf{ClientAuthRequired: true}
+			},
+			expectedViolations: []*Violation{
+				{Field: "server.tls.clientAuthRequired", Reason: "requires server.tls.enabled to be true"},
+			},
+		},
+		{
+			name: "replication TLS without client credentials",
+			update: func(c *Configurations) {
+				c.LocalConfig.Replication.TLS = TLSConf{
+					Enabled:               true,
+					ServerCertificatePath: "server.cert",
+					ServerKeyPath:         "server.key",
+					ClientCertificatePath: "client.cert",
+				}
+			},
+			expectedViolations: []*Violation{
+				{Field: "replication.tls.clientKeyPath", Reason: "must be set"},
+			},
+		},
+		{
+			name: "zero block creation parameters",
+			update: func(c *Configurations) {
+				c.LocalConfig.BlockCreation = BlockCreationConf{}
+				c.SharedConfig.Consensus.RaftConfig.SnapshotIntervalSize = 0
+			},
+			expectedViolations: []*Violation{
+				{Field: "blockCreation.maxBlockSize", Reason: "must be greater than 0, e.g., 2097152"},
+				{Field: "blockCreation.maxTransactionCountPerBlock", Reason: "must be greater than 0, e.g., 100"},
+				{Field: "blockCreation.blockTimeout", Reason: "must be greater than 0, e.g., 50ms, found 0s"},
+			},
+		},
+		{
+			name: "block larger than the transaction queue",
+			update: func(c *Configurations) {
+				c.LocalConfig.BlockCreation.MaxTransactionCountPerBlock = 1001
+			},
+			expectedViolations: []*Violation{
+				{
+					Field:  "blockCreation.maxTransactionCountPerBlock",
+					Reason: "must not exceed server.queueLength.transaction [1000], as a block could never be filled from the transaction queue, found 1001",
+				},
+			},
+		},
+		{
+			name: "low-latency mode without an arrival rate",
+			update: func(c *Configurations) {
+				c.LocalConfig.BlockCreation.LowLatency = LowLatencyConf{QuietPeriod: 100 * time.Millisecond}
+			},
+			expectedViolations: []*Violation{
+				{
+					Field:  "blockCreation.lowLatency.maxArrivalRate",
+					Reason: "must be greater than 0 when blockCreation.lowLatency.quietPeriod is set, e.g., 100",
+				},
+			},
+		},
+		{
+			name: "whole block reserved for admin transactions",
+			update: func(c *Configurations) {
+				c.LocalConfig.BlockCreation.AdminReservationPercent = 100
+			},
+			expectedViolations: []*Violation{
+				{
+					Field:  "blockCreation.adminReservationPercent",
+					Reason: "must be less than 100, e.g., 5, found 100",
+				},
+			},
+		},
+		{
+			name: "replication directories",
+			update: func(c *Configurations) {
+				c.LocalConfig.Replication.SnapDir = c.LocalConfig.Replication.WALDir
+				c.LocalConfig.Replication.AuxDir = ""
+			},
+			expectedViolations: []*Violation{
+				{Field: "replication.auxDir", Reason: "must be set"},
+				{Field: "replication.snapDir", Reason: "must differ from replication.walDir [./tmp/etcdraft/wal]"},
+			},
+		},
+		{
+			name: "identical client and replication listeners",
+			update: func(c *Configurations) {
+				c.LocalConfig.Replication.Network = c.LocalConfig.Server.Network
+			},
+			expectedViolations: []*Violation{
+				{
+					Field:  "replication.network",
+					Reason: "must differ from the client listener server.network [127.0.0.1:6001], as both are served by separate listeners",
+				},
+			},
+		},
+		{
+			name:   "consensus is missing",
+			update: func(c *Configurations) { c.SharedConfig.Consensus = nil },
+			expectedViolations: []*Violation{
+				{Field: "consensus", Reason: "must be set"},
+			},
+		},
+		{
+			name: "raft configuration is missing",
+			update: func(c *Configurations) {
+				c.SharedConfig.Consensus.Algorithm = "solo"
+				c.SharedConfig.Consensus.RaftConfig = nil
+			},
+			expectedViolations: []*Violation{
+				{Field: "consensus.algorithm", Reason: "must be raft, which is the only supported algorithm, found \"solo\""},
+				{Field: "consensus.raftConfig", Reason: "must be set"},
+			},
+		},
+		{
+			name: "invalid raft parameters",
+			update: func(c *Configurations) {
+				c.SharedConfig.Consensus.RaftConfig = &RaftConf{
+					TickInterval:         "-1s",
+					ElectionTicks:        0,
+					HeartbeatTicks:       0,
+					SnapshotIntervalSize: 10,
+				}
+			},
+			expectedViolations: []*Violation{
+				{Field: "consensus.raftConfig.tickInterval", Reason: "must be a positive duration, e.g., 100ms, found \"-1s\""},
+				{Field: "consensus.raftConfig.heartbeatTicks", Reason: "must be greater than 0, e.g., 1"},
+				{Field: "consensus.raftConfig.electionTicks", Reason: "must be greater than consensus.raftConfig.heartbeatTicks [0], e.g., 10 times greater, found 0"},
+				{Field: "consensus.raftConfig.maxInflightBlocks", Reason: "must be greater than 0, e.g., 50"},
+			},
+		},
+		{
+			name: "election ticks not greater than heartbeat ticks",
+			update: func(c *Configurations) {
+				c.SharedConfig.Consensus.RaftConfig.ElectionTicks = 2
+				c.SharedConfig.Consensus.RaftConfig.HeartbeatTicks = 2
+			},
+			expectedViolations: []*Violation{
+				{Field: "consensus.raftConfig.electionTicks", Reason: "must be greater than consensus.raftConfig.heartbeatTicks [2], e.g., 10 times greater, found 2"},
+			},
+		},
+		{
+			name: "snapshot interval smaller than a block",
+			update: func(c *Configurations) {
+				c.LocalConfig.BlockCreation.MaxBlockSize = 2048
+				c.SharedConfig.Consensus.RaftConfig.SnapshotIntervalSize = 1024
+			},
+			expectedViolations: []*Violation{
+				{
+					Field:  "consensus.raftConfig.snapshotIntervalSize",
+					Reason: "must not be smaller than blockCreation.maxBlockSize [2048], otherwise a snapshot is taken on every block, found 1024",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conf := readConfig(t, "./testdata")
+			tt.update(conf)
+
+			err := conf.Validate()
+			require.Error(t, err)
+			validationErr, ok := err.(*ValidationError)
+			require.True(t, ok)
+			require.Equal(t, tt.expectedViolations, validationErr.Violations)
+		})
+	}
+
+	t.Run("all violations are reported", func(t *testing.T) {
+		t.Parallel()
+
+		conf := readConfig(t, "./testdata")
+		conf.LocalConfig.Server.QueueLength.Block = 0
+		conf.LocalConfig.Replication.Network = conf.LocalConfig.Server.Network
+		conf.SharedConfig.Consensus.RaftConfig.MaxInflightBlocks = 0
+
+		err := conf.Validate()
+		require.EqualError(t, err, "invalid configuration, 3 violation(s):"+
+			"\n\tserver.queueLength.block: must be greater than 0, e.g., 100"+
+			"\n\treplication.network: must differ from the client listener server.network [127.0.0.1:6001], as both are served by separate listeners"+
+			"\n\tconsensus.raftConfig.maxInflightBlocks: must be greater than 0, e.g., 50")
+	})
+}
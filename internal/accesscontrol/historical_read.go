@@ -0,0 +1,62 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package accesscontrol
+
+import (
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// HistoricalReadPolicy decides whether a user may read a past version of a key. The history of the ACL of a key is
+// the history of its versions, as every version carries the ACL which was in place while the version was live, hence,
+// whether a user could read a version at its time is decided by the ACL of the version itself.
+type HistoricalReadPolicy string
+
+const (
+	// HistoricalReadStrict lets a user read a past version only if the user could read it at its time
+	HistoricalReadStrict HistoricalReadPolicy = "strict"
+	// HistoricalReadUnion lets a user read a past version if the user could read it at its time, or if the user can
+	// read the key now
+	HistoricalReadUnion HistoricalReadPolicy = "union"
+)
+
+// ParseHistoricalReadPolicy parses the policy configured at the server. The strict policy is the default.
+func ParseHistoricalReadPolicy(policy string) (HistoricalReadPolicy, error) {
+	switch HistoricalReadPolicy(policy) {
+	case "", HistoricalReadStrict:
+		return HistoricalReadStrict, nil
+	case HistoricalReadUnion:
+		return HistoricalReadUnion, nil
+	default:
+		return "", errors.Errorf("unsupported historical read policy [%s], expected either [%s] or [%s]", policy, HistoricalReadStrict, HistoricalReadUnion)
+	}
+}
+
+// Allows returns true if the policy lets a user read a past version, given whether the user could read the version
+// at its time. Whether the user can read the key now is evaluated only if the policy depends on it.
+func (p HistoricalReadPolicy) Allows(readableThen bool, readableNow func() (bool, error)) (bool, error) {
+	if readableThen {
+		return true, nil
+	}
+	if p != HistoricalReadUnion {
+		return false, nil
+	}
+
+	return readableNow()
+}
+
+// FallsBackToCurrentAccess returns true if a user who could not read a version at its time may still read it
+func (p HistoricalReadPolicy) FallsBackToCurrentAccess() bool {
+	return p == HistoricalReadUnion
+}
+
+// CanRead returns true if the ACL of a key permits the user to read it. A key without an ACL is readable by every
+// user who can read from its database.
+func CanRead(userID string, acl *types.AccessControl) bool {
+	if acl == nil {
+		return true
+	}
+
+	return acl.ReadUsers[userID] || acl.ReadWriteUsers[userID]
+}
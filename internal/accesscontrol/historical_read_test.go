@@ -0,0 +1,64 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package accesscontrol
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHistoricalReadPolicy(t *testing.T) {
+	for policy, expected := range map[string]HistoricalReadPolicy{
+		"":       HistoricalReadStrict,
+		"strict": HistoricalReadStrict,
+		"union":  HistoricalReadUnion,
+	} {
+		p, err := ParseHistoricalReadPolicy(policy)
+		require.NoError(t, err)
+		require.Equal(t, expected, p)
+	}
+
+	_, err := ParseHistoricalReadPolicy("lenient")
+	require.EqualError(t, err, "unsupported historical read policy [lenient], expected either [strict] or [union]")
+}
+
+func TestHistoricalReadPolicyAllows(t *testing.T) {
+	readable := func(r bool, err error) func() (bool, error) {
+		return func() (bool, error) { return r, err }
+	}
+	unexpected := func() (bool, error) {
+		t.Fatal("the current access is evaluated")
+		return false, nil
+	}
+
+	for _, p := range []HistoricalReadPolicy{HistoricalReadStrict, HistoricalReadUnion} {
+		ok, err := p.Allows(true, unexpected)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	ok, err := HistoricalReadStrict.Allows(false, unexpected)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = HistoricalReadUnion.Allows(false, readable(true, nil))
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = HistoricalReadUnion.Allows(false, readable(false, nil))
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = HistoricalReadUnion.Allows(false, readable(false, errors.New("error")))
+	require.EqualError(t, err, "error")
+}
+
+func TestCanRead(t *testing.T) {
+	require.True(t, CanRead("alice", nil))
+	require.True(t, CanRead("alice", &types.AccessControl{ReadUsers: map[string]bool{"alice": true}}))
+	require.True(t, CanRead("alice", &types.AccessControl{ReadWriteUsers: map[string]bool{"alice": true}}))
+	require.False(t, CanRead("alice", &types.AccessControl{ReadUsers: map[string]bool{"bob": true}}))
+	require.False(t, CanRead("alice", &types.AccessControl{}))
+}
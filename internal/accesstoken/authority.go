@@ -0,0 +1,366 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package accesstoken issues and verifies the access tokens of the node. An access token authenticates the queries of
+// a user in place of a signature of each query, e.g., for a web frontend which cannot hold the private key of the
+// user. A token is short-lived, limited to some query endpoints and databases, bound to the user it was issued to, and
+// signed by the node, which is the only node that accepts it. A token never authenticates a transaction, as the ledger
+// records the signatures of the submitters.
+//
+// A token is revoked before it expires by adding its identifier to the denylist of the node, which is held in memory
+// and persisted, until the token expires. The key schema of the store of the denylist is:
+//
+//	revoked/<id>    the expiry of the revoked token whose identifier is id, as a big-endian int64 of nanoseconds
+package accesstoken
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// DefaultMaxLifetime is the maximal lifetime of a token when none is configured
+const DefaultMaxLifetime = time.Hour
+
+const (
+	revokedKeyPrefix = "revoked/"
+	tokenIDSize      = 16
+)
+
+// Endpoints are the route templates of the query endpoints a token may be issued for. The transactions, the
+// administrative endpoints, and the endpoints of the tokens themselves are never authenticated by a token, nor are the
+// provenance queries of the data a user read, wrote or deleted, which span all the databases and, hence, escape the
+// databases of a token.
+var Endpoints = map[string]bool{
+	constants.GetData:                 true,
+	constants.GetDataRange:            true,
+	constants.GetDataCount:            true,
+	constants.GetDataExists:           true,
+	constants.PostDataQuery:           true,
+	constants.PostDataMultiGet:        true,
+	constants.PostSubscribeKeys:       true,
+	constants.GetDBStatus:             true,
+	constants.GetDBIndex:              true,
+	constants.GetDBDescriptor:         true,
+	constants.GetDBDescriptorHistory:  true,
+	constants.GetDBDigest:             true,
+	constants.GetUser:                 true,
+	constants.GetConfig:               true,
+	constants.GetNodeConfig:           true,
+	constants.GetLastConfigBlock:      true,
+	constants.GetClusterStatus:        true,
+	constants.GetConfigLimits:         true,
+	constants.GetBlockHeader:          true,
+	constants.GetBlockComposition:     true,
+	constants.GetLastBlockHeader:      true,
+	constants.GetPath:                 true,
+	constants.GetTxProof:              true,
+	constants.GetDataProof:            true,
+	constants.GetTxReceipt:            true,
+	constants.GetTxWriteSetDigest:     true,
+	constants.GetDroppedTx:            true,
+	constants.GetLedgerRollups:        true,
+	constants.GetLedgerUsage:          true,
+	constants.GetKeyBlocks:            true,
+	constants.GetHistoricalData:       true,
+	constants.GetDataByVersion:        true,
+	constants.GetDataReaders:          true,
+	constants.GetDataWriters:          true,
+	constants.GetTxIDsSubmittedBy:     true,
+	constants.GetMostRecentUserOrNode: true,
+	constants.GetClusterHeartbeats:    true,
+	constants.GetSessionBootstrap:     true,
+	constants.GetPrivilegeChanges:     true,
+}
+
+// Authority issues, verifies and revokes the access tokens of the node
+type Authority struct {
+	db          *leveldb.DB
+	nodeID      string
+	signer      crypto.Signer
+	verifier    *crypto.Verifier
+	maxLifetime time.Duration
+	now         func() time.Time
+	// mu guards the denylist, which maps the identifiers of the revoked tokens to their expiry
+	mu      sync.RWMutex
+	revoked map[string]int64
+	logger  *logger.SugarLogger
+}
+
+// Config holds the configuration of the access token authority
+type Config struct {
+	// StoreDir is the directory of the store of the denylist
+	StoreDir string
+	NodeID   string
+	// Signer signs the tokens on behalf of the node, and NodeCertificate, the raw certificate of the node, verifies
+	// them
+	Signer          crypto.Signer
+	NodeCertificate []byte
+	// MaxLifetime bounds the lifetime of the tokens. Zero means DefaultMaxLifetime.
+	MaxLifetime time.Duration
+	Logger      *logger.SugarLogger
+}
+
+// Open opens, or creates, the store of the denylist, and loads the revoked tokens which are yet to expire
+func Open(c *Config) (*Authority, error) {
+	verifier, err := crypto.NewVerifier(c.NodeCertificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while parsing the certificate of the node")
+	}
+
+	db, err := leveldb.OpenFile(c.StoreDir, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while opening the access token store at %s", c.StoreDir)
+	}
+
+	a := &Authority{
+		db:          db,
+		nodeID:      c.NodeID,
+		signer:      c.Signer,
+		verifier:    verifier,
+		maxLifetime: c.MaxLifetime,
+		now:         time.Now,
+		revoked:     make(map[string]int64),
+		logger:      c.Logger,
+	}
+	if a.maxLifetime == 0 {
+		a.maxLifetime = DefaultMaxLifetime
+	}
+
+	if err := a.loadDenylist(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return a, nil
+}
+
+// loadDenylist loads the revoked tokens from the store, and deletes the ones which expired meanwhile
+func (a *Authority) loadDenylist() error {
+	now := a.now().UnixNano()
+	expired := &leveldb.Batch{}
+
+	it := a.db.NewIterator(util.BytesPrefix([]byte(revokedKeyPrefix)), nil)
+	defer it.Release()
+	for it.Next() {
+		if len(it.Value()) != 8 {
+			return errors.Errorf("the expiry of the revoked token [%s] is malformed", it.Key()[len(revokedKeyPrefix):])
+		}
+		expiresAt := int64(binary.BigEndian.Uint64(it.Value()))
+		if expiresAt <= now {
+			expired.Delete(append([]byte(nil), it.Key()...))
+			continue
+		}
+		a.revoked[string(it.Key()[len(revokedKeyPrefix):])] = expiresAt
+	}
+	if err := it.Error(); err != nil {
+		return errors.Wrap(err, "error while loading the revoked access tokens")
+	}
+
+	if expired.Len() > 0 {
+		if err := a.db.Write(expired, nil); err != nil {
+			return errors.Wrap(err, "error while deleting the expired access tokens from the denylist")
+		}
+	}
+	return nil
+}
+
+// Issue signs a token for the user, limited to the given databases and endpoints, which expires after the given
+// lifetime, or after the maximal lifetime if it is zero. It returns the encoded token along with its claims.
+func (a *Authority) Issue(userID string, dbNames, endpoints []string, lifetime time.Duration) (string, *types.AccessToken, error) {
+	if len(endpoints) == 0 {
+		return "", nil, &ierrors.BadRequestError{ErrMsg: "an access token must be issued for at least one endpoint"}
+	}
+	for _, endpoint := range endpoints {
+		if !Endpoints[endpoint] {
+			return "", nil, &ierrors.BadRequestError{ErrMsg: "an access token cannot be issued for the endpoint [" + endpoint + "]"}
+		}
+	}
+	for _, dbName := range dbNames {
+		if dbName == "" {
+			return "", nil, &ierrors.BadRequestError{ErrMsg: "the database names of an access token cannot be empty"}
+		}
+	}
+	if lifetime > a.maxLifetime {
+		return "", nil, &ierrors.BadRequestError{ErrMsg: fmt.Sprintf("the lifetime of an access token cannot exceed %s", a.maxLifetime)}
+	}
+	if lifetime == 0 {
+		lifetime = a.maxLifetime
+	}
+
+	id := make([]byte, tokenIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, errors.Wrap(err, "error while generating the identifier of the access token")
+	}
+
+	issuedAt := a.now()
+	claims := &types.AccessToken{
+		TokenId:   hex.EncodeToString(id),
+		UserId:    userID,
+		NodeId:    a.nodeID,
+		DbNames:   sortedCopy(dbNames),
+		Endpoints: sortedCopy(endpoints),
+		IssuedAt:  issuedAt.UnixNano(),
+		ExpiresAt: issuedAt.Add(lifetime).UnixNano(),
+	}
+
+	signedBytes, err := marshal.DeterministicMarshal(claims)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error while marshaling the access token")
+	}
+	if claims.NodeSignature, err = a.signer.Sign(signedBytes); err != nil {
+		return "", nil, errors.Wrap(err, "error while signing the access token")
+	}
+
+	tokenBytes, err := marshal.DeterministicMarshal(claims)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "error while marshaling the access token")
+	}
+	return base64.RawURLEncoding.EncodeToString(tokenBytes), claims, nil
+}
+
+// Verify returns the claims of the token if the token was issued by the node, has not expired, and was not revoked.
+// It returns an *InvalidTokenError otherwise.
+func (a *Authority) Verify(token string) (*types.AccessToken, error) {
+	claims, err := a.Decode(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.now().UnixNano() >= claims.GetExpiresAt() {
+		return nil, &InvalidTokenError{Reason: "the access token expired"}
+	}
+
+	a.mu.RLock()
+	_, revoked := a.revoked[claims.GetTokenId()]
+	a.mu.RUnlock()
+	if revoked {
+		return nil, &InvalidTokenError{Reason: "the access token was revoked"}
+	}
+
+	return claims, nil
+}
+
+// Decode returns the claims of the token if the token was issued by the node, whether or not it expired or was
+// revoked. It returns an *InvalidTokenError otherwise.
+func (a *Authority) Decode(token string) (*types.AccessToken, error) {
+	tokenBytes, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, &InvalidTokenError{Reason: "the access token is not encoded correctly"}
+	}
+	claims := &types.AccessToken{}
+	if err := proto.Unmarshal(tokenBytes, claims); err != nil {
+		return nil, &InvalidTokenError{Reason: "the access token is malformed"}
+	}
+	if claims.GetNodeId() != a.nodeID {
+		return nil, &InvalidTokenError{Reason: "the access token was issued by another node [" + claims.GetNodeId() + "]"}
+	}
+
+	unsigned := proto.Clone(claims).(*types.AccessToken)
+	unsigned.NodeSignature = nil
+	signedBytes, err := marshal.DeterministicMarshal(unsigned)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the access token")
+	}
+	if err := a.verifier.Verify(signedBytes, claims.GetNodeSignature()); err != nil {
+		return nil, &InvalidTokenError{Reason: "the signature of the access token does not verify"}
+	}
+
+	return claims, nil
+}
+
+// Revoke adds the token to the denylist until it expires. Revoking a token twice, or a token which expired, is a
+// no-op.
+func (a *Authority) Revoke(claims *types.AccessToken) error {
+	if a.now().UnixNano() >= claims.GetExpiresAt() {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.revoked[claims.GetTokenId()]; ok {
+		return nil
+	}
+
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(claims.GetExpiresAt()))
+	if err := a.db.Put(revokedKey(claims.GetTokenId()), value, &opt.WriteOptions{Sync: true}); err != nil {
+		return errors.Wrap(err, "error while adding the access token to the denylist")
+	}
+	a.revoked[claims.GetTokenId()] = claims.GetExpiresAt()
+
+	if err := a.pruneLocked(); err != nil {
+		return err
+	}
+
+	a.logger.Infof("revoked the access token [%s] of the user [%s]", claims.GetTokenId(), claims.GetUserId())
+	return nil
+}
+
+// pruneLocked drops the expired tokens from the denylist
+func (a *Authority) pruneLocked() error {
+	now := a.now().UnixNano()
+	expired := &leveldb.Batch{}
+	for id, expiresAt := range a.revoked {
+		if expiresAt <= now {
+			expired.Delete(revokedKey(id))
+		}
+	}
+	if expired.Len() == 0 {
+		return nil
+	}
+
+	if err := a.db.Write(expired, nil); err != nil {
+		return errors.Wrap(err, "error while deleting the expired access tokens from the denylist")
+	}
+	for id, expiresAt := range a.revoked {
+		if expiresAt <= now {
+			delete(a.revoked, id)
+		}
+	}
+	return nil
+}
+
+// Close closes the store of the denylist
+func (a *Authority) Close() error {
+	return errors.Wrap(a.db.Close(), "error while closing the access token store")
+}
+
+// InvalidTokenError is returned by Verify and Decode on a token which does not authenticate a query
+type InvalidTokenError struct {
+	Reason string
+}
+
+func (e *InvalidTokenError) Error() string {
+	return e.Reason
+}
+
+func revokedKey(tokenID string) []byte {
+	return append([]byte(revokedKeyPrefix), tokenID...)
+}
+
+func sortedCopy(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
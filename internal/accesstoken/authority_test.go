@@ -0,0 +1,195 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package accesstoken
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthority(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "accesstoken")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"node1", "node2"})
+	nodeCert, nodeSigner := testutils.LoadTestCrypto(t, cryptoDir, "node1")
+	otherCert, otherSigner := testutils.LoadTestCrypto(t, cryptoDir, "node2")
+
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	c := &Config{
+		StoreDir:        filepath.Join(testDir, "accesstokens"),
+		NodeID:          "node1",
+		Signer:          nodeSigner,
+		NodeCertificate: nodeCert.Raw,
+		MaxLifetime:     10 * time.Minute,
+		Logger:          lg,
+	}
+	a, err := Open(c)
+	require.NoError(t, err)
+
+	// the expired tokens are pruned from the denylist on open against the wall clock
+	clock := time.Now()
+	a.now = func() time.Time { return clock }
+
+	readScope := []string{constants.GetDataRange, constants.GetData}
+
+	t.Run("issue and verify", func(t *testing.T) {
+		token, claims, err := a.Issue("alice", []string{"db2", "db1"}, readScope, 5*time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claims.TokenId, 2*tokenIDSize)
+		require.Equal(t, "alice", claims.UserId)
+		require.Equal(t, "node1", claims.NodeId)
+		require.Equal(t, []string{"db1", "db2"}, claims.DbNames)
+		require.Equal(t, []string{constants.GetDataRange, constants.GetData}, claims.Endpoints)
+		require.Equal(t, clock.UnixNano(), claims.IssuedAt)
+		require.Equal(t, clock.Add(5*time.Minute).UnixNano(), claims.ExpiresAt)
+		require.NotEmpty(t, claims.NodeSignature)
+
+		verified, err := a.Verify(token)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(claims, verified))
+
+		// no lifetime means the maximal lifetime
+		_, claims, err = a.Issue("alice", nil, readScope, 0)
+		require.NoError(t, err)
+		require.Equal(t, clock.Add(10*time.Minute).UnixNano(), claims.ExpiresAt)
+		require.Nil(t, claims.DbNames)
+	})
+
+	t.Run("invalid issue requests", func(t *testing.T) {
+		for _, tc := range []struct {
+			dbNames   []string
+			endpoints []string
+			lifetime  time.Duration
+			expected  string
+		}{
+			{endpoints: nil, expected: "an access token must be issued for at least one endpoint"},
+			{endpoints: []string{constants.PostDataTx}, expected: "an access token cannot be issued for the endpoint [" + constants.PostDataTx + "]"},
+			{endpoints: []string{constants.GetData, constants.PostIssueAccessToken}, expected: "an access token cannot be issued for the endpoint [" + constants.PostIssueAccessToken + "]"},
+			{dbNames: []string{"db1", ""}, endpoints: readScope, expected: "the database names of an access token cannot be empty"},
+			{endpoints: readScope, lifetime: time.Hour, expected: "the lifetime of an access token cannot exceed 10m0s"},
+		} {
+			_, _, err := a.Issue("alice", tc.dbNames, tc.endpoints, tc.lifetime)
+			require.EqualError(t, err, tc.expected)
+			require.IsType(t, &interrors.BadRequestError{}, err)
+		}
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token, _, err := a.Issue("alice", nil, readScope, time.Minute)
+		require.NoError(t, err)
+
+		a.now = func() time.Time { return clock.Add(time.Minute) }
+		defer func() { a.now = func() time.Time { return clock } }()
+
+		_, err = a.Verify(token)
+		require.EqualError(t, err, "the access token expired")
+		require.IsType(t, &InvalidTokenError{}, err)
+	})
+
+	t.Run("tampered and foreign tokens", func(t *testing.T) {
+		_, err := a.Verify("not a token!")
+		require.EqualError(t, err, "the access token is not encoded correctly")
+		_, err = a.Verify(base64.RawURLEncoding.EncodeToString([]byte{0xff, 0xff}))
+		require.EqualError(t, err, "the access token is malformed")
+
+		_, claims, err := a.Issue("alice", []string{"db1"}, readScope, time.Minute)
+		require.NoError(t, err)
+		tampered := proto.Clone(claims).(*types.AccessToken)
+		tampered.DbNames = append(tampered.DbNames, "db2")
+		tamperedBytes, err := marshal.DeterministicMarshal(tampered)
+		require.NoError(t, err)
+		_, err = a.Verify(base64.RawURLEncoding.EncodeToString(tamperedBytes))
+		require.EqualError(t, err, "the signature of the access token does not verify")
+
+		other, err := Open(&Config{
+			StoreDir:        filepath.Join(testDir, "other"),
+			NodeID:          "node2",
+			Signer:          otherSigner,
+			NodeCertificate: otherCert.Raw,
+			Logger:          lg,
+		})
+		require.NoError(t, err)
+		defer other.Close()
+		foreign, _, err := other.Issue("alice", nil, readScope, time.Minute)
+		require.NoError(t, err)
+		_, err = a.Verify(foreign)
+		require.EqualError(t, err, "the access token was issued by another node [node2]")
+	})
+
+	t.Run("revoked tokens are denied across restarts until they expire", func(t *testing.T) {
+		token1, claims1, err := a.Issue("alice", nil, readScope, 2*time.Minute)
+		require.NoError(t, err)
+		token2, claims2, err := a.Issue("bob", nil, readScope, 8*time.Minute)
+		require.NoError(t, err)
+		token3, _, err := a.Issue("bob", nil, readScope, 8*time.Minute)
+		require.NoError(t, err)
+
+		require.NoError(t, a.Revoke(claims1))
+		require.NoError(t, a.Revoke(claims1))
+		require.NoError(t, a.Revoke(claims2))
+		_, err = a.Verify(token1)
+		require.EqualError(t, err, "the access token was revoked")
+		_, err = a.Verify(token2)
+		require.EqualError(t, err, "the access token was revoked")
+		_, err = a.Verify(token3)
+		require.NoError(t, err)
+
+		// the token is still decoded, e.g., to revoke it again
+		decoded, err := a.Decode(token1)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(claims1, decoded))
+
+		require.NoError(t, a.Close())
+		a, err = Open(c)
+		require.NoError(t, err)
+		a.now = func() time.Time { return clock }
+		_, err = a.Verify(token2)
+		require.EqualError(t, err, "the access token was revoked")
+		_, err = a.Verify(token3)
+		require.NoError(t, err)
+		require.Len(t, a.revoked, 2)
+
+		// the tokens are dropped from the denylist once they expire
+		later := clock.Add(5 * time.Minute)
+		a.now = func() time.Time { return later }
+		token4, claims4, err := a.Issue("bob", nil, readScope, 2*time.Minute)
+		require.NoError(t, err)
+		require.NoError(t, a.Revoke(claims4))
+		_, err = a.Verify(token4)
+		require.EqualError(t, err, "the access token was revoked")
+		require.NoError(t, a.Close())
+
+		a, err = Open(c)
+		require.NoError(t, err)
+		a.now = func() time.Time { return later }
+		require.Len(t, a.revoked, 2)
+		_, ok := a.revoked[claims4.TokenId]
+		require.True(t, ok)
+		_, ok = a.revoked[claims2.TokenId]
+		require.True(t, ok)
+		require.NoError(t, a.Close())
+	})
+}
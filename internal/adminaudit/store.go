@@ -0,0 +1,244 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package adminaudit keeps the audit records of the administrative actions performed on a node via its HTTP
+// endpoints. The records form a hash-linked chain: each record holds the hash of the previous one, and is signed by
+// the node, so that an admin holding the hash of a record, i.e., its receipt, can verify the record and the records
+// before it. The records are local to the node, and are never purged.
+//
+// The key schema of the store is:
+//
+//	seq/<n>     the record numbered n in the chain, a marshaled types.AdminAuditRecord, where <n> is a big-endian uint64
+//	hash/<h>    the number of the record whose hash is h, as a big-endian uint64
+//
+// The two entries of a record are written by a single batch.
+package adminaudit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	seqKeyPrefix  = "seq/"
+	hashKeyPrefix = "hash/"
+)
+
+// Store holds the chain of the admin audit records of a node
+type Store struct {
+	db     *leveldb.DB
+	nodeID string
+	signer crypto.Signer
+	now    func() time.Time
+	// mu serializes the appends, as a record links to the last one
+	mu       sync.Mutex
+	lastSeq  uint64
+	lastHash []byte
+	logger   *logger.SugarLogger
+}
+
+// Config holds the configuration of the admin audit store
+type Config struct {
+	StoreDir string
+	NodeID   string
+	// Signer signs the records on behalf of the node
+	Signer crypto.Signer
+	Logger *logger.SugarLogger
+}
+
+// Open opens, or creates, the admin audit store, and loads the last record of the chain
+func Open(c *Config) (*Store, error) {
+	db, err := leveldb.OpenFile(c.StoreDir, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while opening the admin audit store at %s", c.StoreDir)
+	}
+
+	s := &Store{
+		db:     db,
+		nodeID: c.NodeID,
+		signer: c.Signer,
+		now:    time.Now,
+		logger: c.Logger,
+	}
+
+	it := db.NewIterator(util.BytesPrefix([]byte(seqKeyPrefix)), nil)
+	defer it.Release()
+	if it.Last() {
+		last := &types.AdminAuditRecord{}
+		if err := proto.Unmarshal(it.Value(), last); err != nil {
+			db.Close()
+			return nil, errors.Wrap(err, "error while unmarshaling the last admin audit record")
+		}
+		if s.lastHash, err = RecordHash(last); err != nil {
+			db.Close()
+			return nil, err
+		}
+		s.lastSeq = last.GetSequence()
+	}
+	if err := it.Error(); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "error while finding the last admin audit record")
+	}
+
+	return s, nil
+}
+
+// Append links the record of an action to the chain, signs it on behalf of the node, and returns its hash. The
+// sequence number, the previous hash, the timestamp, the node, and the signature of the record are set by the store.
+func (s *Store) Append(record *types.AdminAuditRecord) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record.Sequence = s.lastSeq + 1
+	record.PreviousHash = s.lastHash
+	record.Timestamp = s.now().UnixNano()
+	record.NodeId = s.nodeID
+	record.NodeSignature = nil
+
+	signedBytes, err := marshal.DeterministicMarshal(record)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the admin audit record")
+	}
+	if record.NodeSignature, err = s.signer.Sign(signedBytes); err != nil {
+		return nil, errors.Wrap(err, "error while signing the admin audit record")
+	}
+
+	value, err := marshal.DeterministicMarshal(record)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the admin audit record")
+	}
+	hash, err := crypto.ComputeSHA256Hash(value)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &leveldb.Batch{}
+	batch.Put(seqKey(record.Sequence), value)
+	batch.Put(hashKey(hash), encodeSeq(record.Sequence))
+	if err := s.db.Write(batch, nil); err != nil {
+		return nil, errors.Wrap(err, "error while writing the admin audit record")
+	}
+
+	s.lastSeq = record.Sequence
+	s.lastHash = hash
+	return hash, nil
+}
+
+// Get returns the record whose hash is given. A NotFoundErr is returned if the chain holds no such record.
+func (s *Store) Get(hash []byte) (*types.AdminAuditRecord, error) {
+	seq, err := s.db.Get(hashKey(hash), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, &interrors.NotFoundErr{Message: "no admin audit record with hash " + hex.EncodeToString(hash) + " on this node"}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while retrieving the admin audit record with hash %x", hash)
+	}
+
+	value, err := s.db.Get(append([]byte(seqKeyPrefix), seq...), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while retrieving the admin audit record with hash %x", hash)
+	}
+
+	record := &types.AdminAuditRecord{}
+	if err := proto.Unmarshal(value, record); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the admin audit record with hash %x", hash)
+	}
+	return record, nil
+}
+
+// List returns, in the order of the chain, the records numbered since and after. At most limit records are returned,
+// along with true if more records follow them. A zero limit returns all the records.
+func (s *Store) List(since, limit uint64) ([]*types.AdminAuditRecord, bool, error) {
+	it := s.db.NewIterator(&util.Range{Start: seqKey(since), Limit: util.BytesPrefix([]byte(seqKeyPrefix)).Limit}, nil)
+	defer it.Release()
+
+	var records []*types.AdminAuditRecord
+	for it.Next() {
+		if limit > 0 && uint64(len(records)) == limit {
+			return records, true, nil
+		}
+
+		record := &types.AdminAuditRecord{}
+		if err := proto.Unmarshal(it.Value(), record); err != nil {
+			return nil, false, errors.Wrap(err, "error while unmarshaling an admin audit record")
+		}
+		records = append(records, record)
+	}
+	if err := it.Error(); err != nil {
+		return nil, false, errors.Wrap(err, "error while iterating over the admin audit records")
+	}
+
+	return records, false, nil
+}
+
+// Close closes the store
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// RecordHash returns the hash of a record, which links the next record to it, and which is the receipt of the action
+func RecordHash(record *types.AdminAuditRecord) ([]byte, error) {
+	value, err := marshal.DeterministicMarshal(record)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the admin audit record")
+	}
+	return crypto.ComputeSHA256Hash(value)
+}
+
+// VerifyChain verifies that the records, given in the order of the chain, are linked to each other and are signed by
+// the node whose certificate is given
+func VerifyChain(records []*types.AdminAuditRecord, nodeCert []byte) error {
+	verifier, err := crypto.NewVerifier(nodeCert)
+	if err != nil {
+		return errors.Wrap(err, "error while parsing the certificate of the node")
+	}
+
+	var prevHash []byte
+	for i, record := range records {
+		if i > 0 && !bytes.Equal(record.GetPreviousHash(), prevHash) {
+			return errors.Errorf("the admin audit record %d is not linked to the record %d", record.GetSequence(), records[i-1].GetSequence())
+		}
+
+		unsigned := proto.Clone(record).(*types.AdminAuditRecord)
+		unsigned.NodeSignature = nil
+		signedBytes, err := marshal.DeterministicMarshal(unsigned)
+		if err != nil {
+			return errors.Wrap(err, "error while marshaling the admin audit record")
+		}
+		if err := verifier.Verify(signedBytes, record.GetNodeSignature()); err != nil {
+			return errors.Wrapf(err, "the signature of the admin audit record %d does not verify", record.GetSequence())
+		}
+
+		if prevHash, err = RecordHash(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seqKey(seq uint64) []byte {
+	return append([]byte(seqKeyPrefix), encodeSeq(seq)...)
+}
+
+func hashKey(hash []byte) []byte {
+	return append([]byte(hashKeyPrefix), hash...)
+}
+
+func encodeSeq(seq uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, seq)
+	return b
+}
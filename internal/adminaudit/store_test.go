@@ -0,0 +1,131 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package adminaudit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "adminaudit")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"node1", "node2"})
+	nodeCert, nodeSigner := testutils.LoadTestCrypto(t, cryptoDir, "node1")
+	otherCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "node2")
+
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	c := &Config{
+		StoreDir: filepath.Join(testDir, "adminaudit"),
+		NodeID:   "node1",
+		Signer:   nodeSigner,
+		Logger:   lg,
+	}
+	s, err := Open(c)
+	require.NoError(t, err)
+
+	clock := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return clock }
+
+	records, more, err := s.List(1, 0)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	require.False(t, more)
+
+	hash1, err := s.Append(&types.AdminAuditRecord{
+		UserId:     "admin",
+		Method:     "PUT",
+		Endpoint:   "/admin/logging",
+		Body:       []byte(`{"level":"debug"}`),
+		StatusCode: 200,
+	})
+	require.NoError(t, err)
+	hash2, err := s.Append(&types.AdminAuditRecord{
+		UserId:     "admin",
+		Method:     "POST",
+		Endpoint:   "/admin/resync",
+		StatusCode: 500,
+	})
+	require.NoError(t, err)
+
+	record1, err := s.Get(hash1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), record1.Sequence)
+	require.Nil(t, record1.PreviousHash)
+	require.Equal(t, "node1", record1.NodeId)
+	require.Equal(t, clock.UnixNano(), record1.Timestamp)
+	require.Equal(t, "/admin/logging", record1.Endpoint)
+	require.NotEmpty(t, record1.NodeSignature)
+	h, err := RecordHash(record1)
+	require.NoError(t, err)
+	require.Equal(t, hash1, h)
+
+	record2, err := s.Get(hash2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), record2.Sequence)
+	require.Equal(t, hash1, record2.PreviousHash)
+
+	_, err = s.Get([]byte("no-such-record"))
+	require.IsType(t, &interrors.NotFoundErr{}, err)
+
+	// the chain continues after a restart
+	require.NoError(t, s.Close())
+	s, err = Open(c)
+	require.NoError(t, err)
+	defer s.Close()
+
+	hash3, err := s.Append(&types.AdminAuditRecord{UserId: "admin", Method: "DELETE", Endpoint: "/admin/queries/1"})
+	require.NoError(t, err)
+	record3, err := s.Get(hash3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), record3.Sequence)
+	require.Equal(t, hash2, record3.PreviousHash)
+
+	records, more, err = s.List(1, 2)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Len(t, records, 2)
+	require.Equal(t, uint64(1), records[0].Sequence)
+	require.Equal(t, uint64(2), records[1].Sequence)
+
+	records, more, err = s.List(2, 0)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Len(t, records, 2)
+
+	all, _, err := s.List(1, 0)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.NoError(t, VerifyChain(all, nodeCert.Raw))
+
+	err = VerifyChain(all, otherCert.Raw)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the signature of the admin audit record 1 does not verify")
+
+	err = VerifyChain([]*types.AdminAuditRecord{all[0], all[2]}, nodeCert.Raw)
+	require.EqualError(t, err, "the admin audit record 3 is not linked to the record 1")
+
+	all[1].StatusCode = 200
+	err = VerifyChain(all, nodeCert.Raw)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the signature of the admin audit record 2 does not verify")
+}
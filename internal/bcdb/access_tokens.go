@@ -0,0 +1,119 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/accesstoken"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func openAccessTokenAuthority(localConf *config.LocalConfiguration, signer crypto.Signer, logger *logger.SugarLogger) (*accesstoken.Authority, error) {
+	certPath := localConf.Server.Identity.CertificatePath
+	certBytes, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while reading the certificate of the node: %s", certPath)
+	}
+	certBlock, _ := pem.Decode(certBytes)
+	if certBlock == nil {
+		return nil, errors.Errorf("the certificate of the node is not PEM encoded: %s", certPath)
+	}
+
+	return accesstoken.Open(
+		&accesstoken.Config{
+			StoreDir:        constructAccessTokenPath(localConf.Server.Database.LedgerDirectory),
+			NodeID:          localConf.Server.Identity.ID,
+			Signer:          signer,
+			NodeCertificate: certBlock.Bytes,
+			MaxLifetime:     localConf.Server.AccessTokens.MaxLifetime,
+			Logger:          logger,
+		},
+	)
+}
+
+// IssueAccessToken issues an access token to the querier, limited to the databases, the endpoints and the lifetime of
+// the query
+func (d *db) IssueAccessToken(query *types.IssueAccessTokenQuery) (*types.IssueAccessTokenResponseEnvelope, error) {
+	lifetime := time.Duration(query.GetLifetimeSeconds()) * time.Second
+	if lifetime/time.Second != time.Duration(query.GetLifetimeSeconds()) {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the lifetime of the access token is out of range"}
+	}
+
+	token, claims, err := d.accessTokens.Issue(query.GetUserId(), query.GetDbNames(), query.GetEndpoints(), lifetime)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.IssueAccessTokenResponse{
+		Header: d.responseHeader(),
+		Token:  token,
+		Claims: claims,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.IssueAccessTokenResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// VerifyAccessToken returns the claims of the access token if it was issued by the node, has not expired, and was not
+// revoked, and an *accesstoken.InvalidTokenError otherwise
+func (d *db) VerifyAccessToken(token string) (*types.AccessToken, error) {
+	return d.accessTokens.Verify(token)
+}
+
+// RevokeAccessToken revokes the access token, which must have been issued to the querier unless the querier is an
+// admin
+func (d *db) RevokeAccessToken(querierUserID, token string) (*types.RevokeAccessTokenResponseEnvelope, error) {
+	claims, err := d.accessTokens.Decode(token)
+	if err != nil {
+		if _, ok := err.(*accesstoken.InvalidTokenError); ok {
+			return nil, &ierrors.BadRequestError{ErrMsg: err.Error()}
+		}
+		return nil, err
+	}
+
+	if claims.GetUserId() != querierUserID {
+		isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, &ierrors.PermissionErr{
+				ErrMsg: "the user [" + querierUserID + "] has no permission to revoke an access token of the user [" + claims.GetUserId() + "]",
+			}
+		}
+	}
+
+	if err := d.accessTokens.Revoke(claims); err != nil {
+		return nil, err
+	}
+
+	response := &types.RevokeAccessTokenResponse{
+		Header:  d.responseHeader(),
+		TokenId: claims.GetTokenId(),
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RevokeAccessTokenResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"encoding/binary"
+
+	"github.com/hyperledger-labs/orion-server/internal/adminaudit"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// defaultAdminAuditRecordsLimit bounds the number of admin audit records listed by a query that sets no limit
+const defaultAdminAuditRecordsLimit = 1000
+
+func openAdminAuditStore(ledgerDir, nodeID string, signer crypto.Signer, logger *logger.SugarLogger) (*adminaudit.Store, error) {
+	return adminaudit.Open(
+		&adminaudit.Config{
+			StoreDir: constructAdminAuditPath(ledgerDir),
+			NodeID:   nodeID,
+			Signer:   signer,
+			Logger:   logger,
+		},
+	)
+}
+
+// RecordAdminAction appends the record of an administrative action to the admin audit chain of the node, and returns
+// the hash of the record
+func (d *db) RecordAdminAction(record *types.AdminAuditRecord) ([]byte, error) {
+	return d.adminAuditStore.Append(record)
+}
+
+// GetAdminAuditRecords returns the admin audit record with the given hash, if set, and otherwise lists the admin audit
+// records of the node in the order of the chain
+func (d *db) GetAdminAuditRecords(querierUserID string, hash []byte, since, limit uint64, pageCursor string) (*types.GetAdminAuditRecordsResponseEnvelope, error) {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to read the admin audit records",
+		}
+	}
+
+	var records []*types.AdminAuditRecord
+	var more bool
+	var nextCursor string
+	if len(hash) > 0 {
+		record, err := d.adminAuditStore.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		records = []*types.AdminAuditRecord{record}
+	} else {
+		if limit == 0 {
+			limit = defaultAdminAuditRecordsLimit
+		}
+
+		listing := adminAuditListing(querierUserID, since)
+		from := since
+		if pageCursor != "" {
+			position, err := d.cursors.Resume(listing, pageCursor)
+			if err != nil {
+				return nil, err
+			}
+			if len(position) != 8 {
+				return nil, &ierrors.BadRequestError{ErrMsg: "the cursor holds a malformed sequence number"}
+			}
+			from = binary.BigEndian.Uint64(position)
+		}
+
+		if records, more, err = d.adminAuditStore.List(from, limit); err != nil {
+			return nil, err
+		}
+		if more {
+			// the records of the chain are numbered consecutively
+			position := make([]byte, 8)
+			binary.BigEndian.PutUint64(position, records[len(records)-1].GetSequence()+1)
+			if nextCursor, err = d.cursors.Issue(listing, position, 0); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	response := &types.GetAdminAuditRecordsResponse{
+		Header:     d.responseHeader(),
+		Records:    records,
+		More:       more,
+		NextCursor: nextCursor,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetAdminAuditRecordsResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/txreorderer"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// maxPendingBatchCompositions bounds the compositions of the batches that wait for their block to commit. The batches
+// of a node that loses the leadership are never committed, and their compositions are evicted, oldest first.
+const maxPendingBatchCompositions = 1024
+
+// batchCompositionRecorder stores the composition of the batches cut by the tx reorderer next to the blocks they
+// became. The reorderer emits the composition of a batch on the stats channel before it enqueues the batch, hence,
+// the recorder drains the channel when a block commits, and finds the composition of the batch of the block by the
+// ID of its first transaction. It is called only by the block processor, in commit order.
+type batchCompositionRecorder struct {
+	stats      chan *txreorderer.BatchStats
+	pending    map[string]*types.BatchComposition
+	order      []string
+	blockStore *blockstore.Store
+	logger     *logger.SugarLogger
+}
+
+func newBatchCompositionRecorder(blockStore *blockstore.Store, logger *logger.SugarLogger) *batchCompositionRecorder {
+	return &batchCompositionRecorder{
+		stats:      make(chan *txreorderer.BatchStats, maxPendingBatchCompositions),
+		pending:    make(map[string]*types.BatchComposition),
+		blockStore: blockStore,
+		logger:     logger,
+	}
+}
+
+// onBlockCommit stores the composition of the batch of the given block, if the batch was cut by the local node. A
+// failure to store it is logged, as the composition serves the auditing of the reorderer only.
+func (r *batchCompositionRecorder) onBlockCommit(block *types.Block) {
+	r.drain()
+
+	envs := block.GetDataTxEnvelopes().GetEnvelopes()
+	if len(envs) == 0 {
+		return
+	}
+
+	firstTxID := envs[0].GetPayload().GetTxId()
+	composition, ok := r.pending[firstTxID]
+	if !ok {
+		return
+	}
+	delete(r.pending, firstTxID)
+
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+	composition.BlockNumber = blockNum
+	if err := r.blockStore.CommitBatchComposition(blockNum, composition); err != nil {
+		r.logger.Warnf("failed to store the batch composition of block %d: %s", blockNum, err)
+	}
+}
+
+func (r *batchCompositionRecorder) drain() {
+	for {
+		select {
+		case s := <-r.stats:
+			r.pending[s.FirstTxID] = s.Composition
+			r.order = append(r.order, s.FirstTxID)
+		default:
+			r.evict()
+			return
+		}
+	}
+}
+
+func (r *batchCompositionRecorder) evict() {
+	for len(r.pending) > maxPendingBatchCompositions && len(r.order) > 0 {
+		delete(r.pending, r.order[0])
+		r.order = r.order[1:]
+	}
+	// the IDs of the compositions that were already stored are dropped from the order as well
+	if len(r.order) > 2*maxPendingBatchCompositions {
+		var order []string
+		for _, txID := range r.order {
+			if _, ok := r.pending[txID]; ok {
+				order = append(order, txID)
+			}
+		}
+		r.order = order
+	}
+}
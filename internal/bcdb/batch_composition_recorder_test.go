@@ -0,0 +1,199 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/txreorderer"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchComposition(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	cryptoDir, conf := testConfiguration(t)
+	defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+	conf.LocalConfig.BlockCreation.MaxTransactionCountPerBlock = 4
+	conf.LocalConfig.BlockCreation.BlockTimeout = 500 * time.Millisecond
+
+	_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	certs := make(map[string][]byte)
+	signers := make(map[string]crypto.Signer)
+	for _, name := range []string{"alice", "bob"} {
+		certs[name], signers[name] = issueTestUserCrypto(t, cryptoDir, name)
+	}
+
+	e, err := NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	defer e.Close()
+
+	var userWrites []*types.UserWrite
+	for _, name := range []string{"alice", "bob"} {
+		userWrites = append(userWrites, &types.UserWrite{
+			User: &types.User{
+				Id:          name,
+				Certificate: certs[name],
+				Privilege:   &types.Privilege{DbPermission: map[string]types.Privilege_Access{worldstate.DefaultDBName: types.Privilege_ReadWrite}},
+			},
+		})
+	}
+	userTx := func(txID string) *types.UserAdministrationTxEnvelope {
+		return testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+			UserId:     "admin",
+			TxId:       txID,
+			UserWrites: userWrites,
+		})
+	}
+	resp, err := e.Submit(userTx("user-tx1"), 5*time.Second)
+	require.NoError(t, err)
+	userBlockNum := resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber()
+
+	txCount := 0
+	dataTx := func(userID string) *types.DataTxEnvelope {
+		txCount++
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signers[userID]}, &types.DataTx{
+			MustSignUserIds: []string{userID},
+			TxId:            fmt.Sprintf("%s-tx%d", userID, txCount),
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: fmt.Sprintf("key%d", txCount), Value: []byte("value")}},
+				},
+			},
+		})
+	}
+
+	// skewed traffic: alice submits most of the transactions. The first batch is cut when it is full, the second one
+	// on the block timeout, and the third one ahead of an administration transaction.
+	txs := []*types.DataTxEnvelope{
+		dataTx("alice"), dataTx("alice"), dataTx("alice"), dataTx("bob"),
+		dataTx("alice"), dataTx("alice"), dataTx("alice"),
+	}
+	for _, tx := range txs[:len(txs)-1] {
+		_, err = e.Submit(tx, 0)
+		require.NoError(t, err)
+	}
+	_, err = e.Submit(txs[len(txs)-1], 5*time.Second)
+	require.NoError(t, err)
+
+	_, err = e.Submit(dataTx("alice"), 0)
+	require.NoError(t, err)
+	_, err = e.Submit(dataTx("bob"), 0)
+	require.NoError(t, err)
+	_, err = e.Submit(userTx("user-tx2"), 5*time.Second)
+	require.NoError(t, err)
+
+	blockNumOf := func(txID string) uint64 {
+		resp, err := e.GetReceipt("admin", txID)
+		require.NoError(t, err)
+		return resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber()
+	}
+
+	p := newLedgerQueryProcessor(&ledgerQueryProcessorConfig{
+		db:              e.stores.levelDB,
+		blockStore:      e.stores.blockStore,
+		identityQuerier: identity.NewQuerier(e.stores.levelDB),
+		logger:          lg,
+	})
+
+	expected := []struct {
+		firstTxID      string
+		cutReason      types.BatchComposition_CutReason
+		txCountPerUser map[string]uint32
+	}{
+		{firstTxID: "alice-tx1", cutReason: types.BatchComposition_TX_COUNT, txCountPerUser: map[string]uint32{"alice": 3, "bob": 1}},
+		{firstTxID: "alice-tx5", cutReason: types.BatchComposition_TIMEOUT, txCountPerUser: map[string]uint32{"alice": 3}},
+		{firstTxID: "alice-tx8", cutReason: types.BatchComposition_ADMIN_FAST_PATH, txCountPerUser: map[string]uint32{"alice": 1, "bob": 1}},
+	}
+	for _, exp := range expected {
+		blockNum := blockNumOf(exp.firstTxID)
+		resp, err := p.getBlockComposition("admin", blockNum)
+		require.NoError(t, err)
+
+		composition := resp.GetComposition()
+		require.Equal(t, blockNum, composition.GetBlockNumber())
+		require.Equal(t, exp.cutReason, composition.GetCutReason(), "block %d", blockNum)
+		require.Equal(t, exp.txCountPerUser, composition.GetTxCountPerUser(), "block %d", blockNum)
+
+		var count uint32
+		for _, c := range exp.txCountPerUser {
+			count += c
+		}
+		require.Equal(t, count, composition.GetTxCount())
+		require.GreaterOrEqual(t, composition.GetQueueWaitP95Micros(), composition.GetQueueWaitP50Micros())
+	}
+	// the transactions of the timed out batch waited for the block timeout
+	resp, err = e.GetReceipt("admin", "alice-tx5")
+	require.NoError(t, err)
+	composition, err := p.getBlockComposition("admin", resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, composition.GetComposition().GetQueueWaitP50Micros(), uint64(400*time.Millisecond/time.Microsecond))
+
+	t.Run("administration block", func(t *testing.T) {
+		resp, err := p.getBlockComposition("admin", userBlockNum)
+		require.EqualError(t, err, fmt.Sprintf("the batch composition of block %d is not recorded on this node", userBlockNum))
+		require.IsType(t, &ierrors.NotFoundErr{}, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("block not found", func(t *testing.T) {
+		height, err := e.stores.blockStore.Height()
+		require.NoError(t, err)
+
+		resp, err := p.getBlockComposition("admin", height+1)
+		require.EqualError(t, err, fmt.Sprintf("block not found: %d", height+1))
+		require.IsType(t, &ierrors.NotFoundErr{}, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("not an admin", func(t *testing.T) {
+		resp, err := p.getBlockComposition("alice", blockNumOf("alice-tx1"))
+		require.EqualError(t, err, "user alice has no permission to access the batch composition of a block")
+		require.IsType(t, &ierrors.PermissionErr{}, err)
+		require.Nil(t, resp)
+	})
+}
+
+func TestBatchCompositionRecorderEvictsUncommittedBatches(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	r := newBatchCompositionRecorder(nil, lg)
+	for i := 0; i < maxPendingBatchCompositions; i++ {
+		r.stats <- &txreorderer.BatchStats{FirstTxID: fmt.Sprintf("tx%d", i), Composition: &types.BatchComposition{}}
+	}
+	// a block without a batch cut by the local node stores nothing
+	r.onBlockCommit(&types.Block{Payload: &types.Block_UserAdministrationTxEnvelope{}})
+	require.Len(t, r.pending, maxPendingBatchCompositions)
+
+	for i := maxPendingBatchCompositions; i < maxPendingBatchCompositions+10; i++ {
+		r.stats <- &txreorderer.BatchStats{FirstTxID: fmt.Sprintf("tx%d", i), Composition: &types.BatchComposition{}}
+	}
+	r.drain()
+	require.Len(t, r.pending, maxPendingBatchCompositions)
+	require.NotContains(t, r.pending, "tx9")
+	require.Contains(t, r.pending, "tx10")
+	require.Contains(t, r.pending, fmt.Sprintf("tx%d", maxPendingBatchCompositions+9))
+}
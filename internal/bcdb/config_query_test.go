@@ -11,6 +11,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/internal/mptrie/store"
@@ -398,6 +399,7 @@ func TestGetClusterStatus(t *testing.T) {
 		worldstateQueryProcessor: env.stateQP,
 		ledgerQueryProcessor:     env.ledgerQP,
 		db:                       env.db,
+		shutdown:                 &shutdownProgress{},
 		logger:                   env.logger,
 	}
 
@@ -408,6 +410,8 @@ func TestGetClusterStatus(t *testing.T) {
 		bcdb.signer = signerMock
 
 		txProcMock.On("ClusterStatus").Return("node1", []string{"node1", "node2"})
+		txProcMock.On("StateDivergence").Return(nil)
+		txProcMock.On("RecoveryStatus").Return(nil)
 		signerMock.On("Sign", mock.Anything).Return([]byte("bogus-sig"), nil)
 
 		status, err := bcdb.GetClusterStatus(false)
@@ -433,6 +437,8 @@ func TestGetClusterStatus(t *testing.T) {
 		bcdb.signer = signerMock
 
 		txProcMock.On("ClusterStatus").Return("", []string{"node1"})
+		txProcMock.On("StateDivergence").Return(nil)
+		txProcMock.On("RecoveryStatus").Return(nil)
 		signerMock.On("Sign", mock.Anything).Return([]byte("bogus-sig"), nil)
 		status, err := bcdb.GetClusterStatus(false)
 		require.NoError(t, err)
@@ -457,6 +463,8 @@ func TestGetClusterStatus(t *testing.T) {
 		bcdb.signer = signerMock
 
 		txProcMock.On("ClusterStatus").Return("node1", []string{"node1", "node2"})
+		txProcMock.On("StateDivergence").Return(nil)
+		txProcMock.On("RecoveryStatus").Return(nil)
 		signerMock.On("Sign", mock.Anything).Return([]byte("bogus-sig"), nil)
 		status, err := bcdb.GetClusterStatus(true)
 		require.NoError(t, err)
@@ -481,6 +489,8 @@ func TestGetClusterStatus(t *testing.T) {
 		bcdb.signer = signerMock
 
 		txProcMock.On("ClusterStatus").Return("bogus-node", []string{"node1", "node2", "bogus-node"})
+		txProcMock.On("StateDivergence").Return(nil)
+		txProcMock.On("RecoveryStatus").Return(nil)
 		signerMock.On("Sign", mock.Anything).Return([]byte("bogus-sig"), nil)
 
 		status, err := bcdb.GetClusterStatus(false)
@@ -499,6 +509,62 @@ func TestGetClusterStatus(t *testing.T) {
 		require.Equal(t, []string{"node1", "node2"}, status.Response.Active)
 	})
 
+	t.Run("valid: halted on a divergence", func(t *testing.T) {
+		txProcMock := &mocks.TxProcessor{}
+		signerMock := &crypto_mocks.Signer{}
+		bcdb.txProcessor = txProcMock
+		bcdb.signer = signerMock
+
+		divergence := &types.StateDivergence{
+			BlockNumber: 11,
+			PeerId:      "node2",
+			Fields: []*types.HeaderFieldDivergence{
+				{Field: "state_merkel_tree_root_hash", LocalValue: "AQ==", PeerValue: "Ag=="},
+			},
+		}
+		txProcMock.On("ClusterStatus").Return("node1", []string{"node1", "node2"})
+		txProcMock.On("StateDivergence").Return(divergence)
+		txProcMock.On("RecoveryStatus").Return(nil)
+		signerMock.On("Sign", mock.Anything).Return([]byte("bogus-sig"), nil)
+
+		status, err := bcdb.GetClusterStatus(true)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(divergence, status.Response.StateDivergence))
+	})
+
+	t.Run("valid: recovered on start", func(t *testing.T) {
+		txProcMock := &mocks.TxProcessor{}
+		signerMock := &crypto_mocks.Signer{}
+		bcdb.txProcessor = txProcMock
+		bcdb.signer = signerMock
+
+		txProcMock.On("ClusterStatus").Return("node1", []string{"node1", "node2"})
+		txProcMock.On("StateDivergence").Return(nil)
+		txProcMock.On("RecoveryStatus").Return([]*blockprocessor.RecoveryStatus{
+			{
+				Store:             blockprocessor.RecoveryStateDB,
+				StartHeight:       7,
+				TargetHeight:      10,
+				RecoveredHeight:   10,
+				Done:              true,
+				RevalidatedBlocks: 1,
+			},
+		})
+		signerMock.On("Sign", mock.Anything).Return([]byte("bogus-sig"), nil)
+
+		status, err := bcdb.GetClusterStatus(true)
+		require.NoError(t, err)
+		require.Len(t, status.Response.Recoveries, 1)
+		require.True(t, proto.Equal(&types.RecoveryStatus{
+			Store:             blockprocessor.RecoveryStateDB,
+			StartHeight:       7,
+			TargetHeight:      10,
+			RecoveredHeight:   10,
+			Done:              true,
+			RevalidatedBlocks: 1,
+		}, status.Response.Recoveries[0]))
+	})
+
 	t.Run("wrong: cannot sign", func(t *testing.T) {
 		txProcMock := &mocks.TxProcessor{}
 		signerMock := &crypto_mocks.Signer{}
@@ -506,9 +572,46 @@ func TestGetClusterStatus(t *testing.T) {
 		bcdb.signer = signerMock
 
 		txProcMock.On("ClusterStatus").Return("node1", []string{"node1", "node2"})
+		txProcMock.On("StateDivergence").Return(nil)
+		txProcMock.On("RecoveryStatus").Return(nil)
 		signerMock.On("Sign", mock.Anything).Return(nil, fmt.Errorf("oops"))
 		status, err := bcdb.GetClusterStatus(false)
 		require.EqualError(t, err, "oops")
 		require.Nil(t, status)
 	})
+
+	t.Run("valid: during shutdown", func(t *testing.T) {
+		txProcMock := &mocks.TxProcessor{}
+		signerMock := &crypto_mocks.Signer{}
+		bcdb.txProcessor = txProcMock
+		bcdb.signer = signerMock
+		bcdb.shutdown = &shutdownProgress{}
+		defer func() { bcdb.shutdown = &shutdownProgress{} }()
+
+		txProcMock.On("ClusterStatus").Return("node1", []string{"node1", "node2"})
+		txProcMock.On("StateDivergence").Return(nil)
+		txProcMock.On("RecoveryStatus").Return(nil)
+		signerMock.On("Sign", mock.Anything).Return([]byte("bogus-sig"), nil)
+
+		clusterStatus, err := bcdb.clusterStatus()
+		require.NoError(t, err)
+		bcdb.shutdown.begin(clusterStatus)
+		bcdb.shutdown.setStage(ShutdownCommittingInFlightBlock)
+
+		// the status is served from the snapshot, without querying the stopped replication
+		txProcMock.ExpectedCalls = nil
+		status, err := bcdb.GetClusterStatus(true)
+		require.NoError(t, err)
+		require.Equal(t, ShutdownCommittingInFlightBlock, status.Response.ShutdownStage)
+		require.Equal(t, "node1", status.Response.Leader)
+		require.Equal(t, []string{"node1", "node2"}, status.Response.Active)
+		require.Len(t, status.Response.Nodes, 2)
+		require.Nil(t, status.Response.Nodes[0].Certificate)
+
+		bcdb.shutdown.setStage(ShutdownClosingStores)
+		status, err = bcdb.GetClusterStatus(false)
+		require.NoError(t, err)
+		require.Equal(t, ShutdownClosingStores, status.Response.ShutdownStage)
+		require.NotNil(t, status.Response.Nodes[0].Certificate)
+	})
 }
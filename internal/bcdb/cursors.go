@@ -0,0 +1,199 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"sort"
+	"strconv"
+
+	"github.com/hyperledger-labs/orion-server/internal/cursor"
+	"github.com/hyperledger-labs/orion-server/internal/deadletter"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// The formats of the positions of the listings whose positions do not depend on the keys of a database
+const (
+	historyCursorFormat    = "versions-v1"
+	adminAuditCursorFormat = "sequence-v1"
+	droppedTxsCursorFormat = "drops-v1"
+)
+
+// newCursorCodec returns the codec of the pagination cursors, which signs them with the secret of the configured file,
+// if set, or otherwise with the secret generated by the node in its ledger directory
+func newCursorCodec(ledgerDir, secretFile string) (*cursor.Codec, error) {
+	if secretFile == "" {
+		secret, err := cursor.LoadOrGenerateSecret(constructCursorSecretPath(ledgerDir))
+		if err != nil {
+			return nil, err
+		}
+		return cursor.NewCodec(secret), nil
+	}
+
+	secret, err := ioutil.ReadFile(secretFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while reading the cursor secret file %s", secretFile)
+	}
+	if len(secret) == 0 {
+		return nil, errors.Errorf("the cursor secret file %s is empty", secretFile)
+	}
+	return cursor.NewCodec(secret), nil
+}
+
+// keyListing returns the listing of a range or an index query over the keys of the database, whose positions are keys
+// in the format of the keys of the database
+func (d *db) keyListing(name, dbName string, shape ...string) (*cursor.Listing, error) {
+	collation, err := worldstate.GetKeyCollation(d.db, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cursor.Listing{
+		Name:   name,
+		Shape:  append([]string{dbName}, shape...),
+		Format: worldstate.KeyFormat(collation),
+	}, nil
+}
+
+// resumeRange returns the listing of a range query, and the key its page starts at, i.e., the start key of the query,
+// or the position of its cursor
+func (d *db) resumeRange(dbName, querierUserID, startKey, endKey string, asOf uint64, analytical bool, pageCursor string) (*cursor.Listing, string, error) {
+	listing, err := d.keyListing(cursor.Range, dbName, querierUserID, startKey, endKey, strconv.FormatUint(asOf, 10), strconv.FormatBool(analytical))
+	if err != nil {
+		return nil, "", err
+	}
+	if pageCursor == "" {
+		return listing, startKey, nil
+	}
+
+	position, err := d.cursors.Resume(listing, pageCursor)
+	if err != nil {
+		return nil, "", err
+	}
+	return listing, string(position), nil
+}
+
+// setRangeCursor sets the cursor of the next page of a range query whose result is pending
+func (d *db) setRangeCursor(listing *cursor.Listing, resp *types.GetDataRangeResponse) error {
+	if !resp.GetPendingResult() {
+		return nil
+	}
+
+	next, err := d.cursors.Issue(listing, []byte(resp.GetNextStartKey()), 0)
+	if err != nil {
+		return err
+	}
+	resp.NextCursor = next
+	return nil
+}
+
+// pageHistoricalValues cuts the values of the history of a key to the page which starts at the version held by the
+// cursor, if any, and holds at most limit values, ordered by their version. The values are returned as they are when
+// neither a limit nor a cursor is given.
+func (d *db) pageHistoricalValues(resp *types.GetHistoricalDataResponse, listing *cursor.Listing, limit uint64, pageCursor string) error {
+	if limit == 0 && pageCursor == "" {
+		return nil
+	}
+
+	values := resp.GetValues()
+	sort.SliceStable(values, func(i, j int) bool {
+		return versionLess(values[i].GetMetadata().GetVersion(), values[j].GetMetadata().GetVersion())
+	})
+
+	if pageCursor != "" {
+		position, err := d.cursors.Resume(listing, pageCursor)
+		if err != nil {
+			return err
+		}
+		if len(position) != 16 {
+			return &ierrors.BadRequestError{ErrMsg: "the cursor holds a malformed version"}
+		}
+		from := &types.Version{
+			BlockNum: binary.BigEndian.Uint64(position[:8]),
+			TxNum:    binary.BigEndian.Uint64(position[8:]),
+		}
+		skip := sort.Search(len(values), func(i int) bool {
+			return !versionLess(values[i].GetMetadata().GetVersion(), from)
+		})
+		values = values[skip:]
+	}
+
+	resp.Values = values
+	if limit == 0 || uint64(len(values)) <= limit {
+		return nil
+	}
+
+	resp.Values = values[:limit]
+	next := values[limit].GetMetadata().GetVersion()
+	position := make([]byte, 16)
+	binary.BigEndian.PutUint64(position[:8], next.GetBlockNum())
+	binary.BigEndian.PutUint64(position[8:], next.GetTxNum())
+	nextCursor, err := d.cursors.Issue(listing, position, 0)
+	if err != nil {
+		return err
+	}
+	resp.NextCursor = nextCursor
+	return nil
+}
+
+func versionLess(a, b *types.Version) bool {
+	if a.GetBlockNum() != b.GetBlockNum() {
+		return a.GetBlockNum() < b.GetBlockNum()
+	}
+	return a.GetTxNum() < b.GetTxNum()
+}
+
+// historyListing returns the listing of the values, or of the deleted values, of a key
+func historyListing(userID, dbName, key string, onlyDeletes bool) *cursor.Listing {
+	return &cursor.Listing{
+		Name:   cursor.History,
+		Shape:  []string{userID, dbName, key, strconv.FormatBool(onlyDeletes)},
+		Format: historyCursorFormat,
+	}
+}
+
+// adminAuditListing returns the listing of the admin audit records, whose positions are sequence numbers
+func adminAuditListing(querierUserID string, since uint64) *cursor.Listing {
+	return &cursor.Listing{
+		Name:   cursor.AdminAudit,
+		Shape:  []string{querierUserID, strconv.FormatUint(since, 10)},
+		Format: adminAuditCursorFormat,
+	}
+}
+
+// droppedTxsListing returns the listing of the dead-letter records, whose positions are drops, and whose retention
+// boundary is the time before which the records were purged
+func (d *db) droppedTxsListing(querierUserID string, since int64) (*cursor.Listing, error) {
+	purgedBefore, err := d.deadLetterStore.PurgedBefore()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cursor.Listing{
+		Name:     cursor.DroppedTxs,
+		Shape:    []string{querierUserID, strconv.FormatInt(since, 10)},
+		Format:   droppedTxsCursorFormat,
+		Boundary: uint64(purgedBefore),
+	}, nil
+}
+
+func encodeDropPosition(p *deadletter.Position) []byte {
+	position := make([]byte, 8+len(p.TxID))
+	binary.BigEndian.PutUint64(position, uint64(p.DroppedAt))
+	copy(position[8:], p.TxID)
+	return position
+}
+
+func decodeDropPosition(position []byte) (*deadletter.Position, error) {
+	if len(position) < 8 {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the cursor holds a malformed position"}
+	}
+	return &deadletter.Position{
+		DroppedAt: int64(binary.BigEndian.Uint64(position)),
+		TxID:      string(position[8:]),
+	}, nil
+}
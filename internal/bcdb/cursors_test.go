@@ -0,0 +1,133 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/cursor"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	crypto_mocks "github.com/hyperledger-labs/orion-server/pkg/crypto/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDataRangeCursor(t *testing.T) {
+	env := newWorldstateQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+	env.q.queryProcessingConf.ResponseSizeLimitInBytes = 1024
+
+	alice, err := proto.Marshal(&types.User{
+		Id: "alice",
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{worldstate.DefaultDBName: types.Privilege_Read},
+		},
+	})
+	require.NoError(t, err)
+
+	updates := map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:      string(identity.UserNamespace) + "alice",
+					Value:    alice,
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2, TxNum: 1}},
+				},
+			},
+		},
+		worldstate.DefaultDBName: {},
+	}
+	for _, k := range []string{"key1", "key2", "key3", "key4", "key5"} {
+		updates[worldstate.DefaultDBName].Writes = append(updates[worldstate.DefaultDBName].Writes, &worldstate.KVWithMetadata{
+			Key:      k,
+			Value:    []byte("value-" + k),
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2, TxNum: 2}},
+		})
+	}
+	require.NoError(t, env.db.Commit(updates, 2))
+
+	signer := &crypto_mocks.Signer{}
+	signer.On("Sign", mock.Anything).Return([]byte("signature"), nil)
+	d := &db{
+		nodeID:                   "node1",
+		db:                       env.db,
+		worldstateQueryProcessor: env.q,
+		cursors:                  cursor.NewCodec([]byte("secret")),
+		signer:                   signer,
+	}
+
+	var keys []string
+	var pageCursor string
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3)
+		resp, err := d.GetDataRange(worldstate.DefaultDBName, "alice", "key1", "key9", 2, pageCursor)
+		require.NoError(t, err)
+		for _, kv := range resp.GetResponse().GetKVs() {
+			keys = append(keys, kv.GetKey())
+		}
+
+		pageCursor = resp.GetResponse().GetNextCursor()
+		require.Equal(t, resp.GetResponse().GetPendingResult(), pageCursor != "")
+		if pageCursor == "" {
+			break
+		}
+	}
+	require.Equal(t, []string{"key1", "key2", "key3", "key4", "key5"}, keys)
+
+	resp, err := d.GetDataRange(worldstate.DefaultDBName, "alice", "key1", "key9", 2, "")
+	require.NoError(t, err)
+	pageCursor = resp.GetResponse().GetNextCursor()
+
+	_, err = d.GetDataRange(worldstate.DefaultDBName, "alice", "key1", "key8", 2, pageCursor)
+	require.EqualError(t, err, "the cursor was issued for another query")
+	require.IsType(t, &ierrors.BadRequestError{}, err)
+
+	d.cursors = cursor.NewCodec([]byte("rotated"))
+	_, err = d.GetDataRange(worldstate.DefaultDBName, "alice", "key1", "key9", 2, pageCursor)
+	require.EqualError(t, err, "the cursor was not issued by this server, or was altered")
+}
+
+func TestPageHistoricalValues(t *testing.T) {
+	d := &db{cursors: cursor.NewCodec([]byte("secret"))}
+	listing := historyListing("alice", "db1", "key1", false)
+
+	history := func() *types.GetHistoricalDataResponse {
+		resp := &types.GetHistoricalDataResponse{}
+		for _, v := range []*types.Version{{BlockNum: 4, TxNum: 0}, {BlockNum: 2, TxNum: 1}, {BlockNum: 2, TxNum: 0}, {BlockNum: 3, TxNum: 5}} {
+			resp.Values = append(resp.Values, &types.ValueWithMetadata{Metadata: &types.Metadata{Version: v}})
+		}
+		return resp
+	}
+	versions := func(resp *types.GetHistoricalDataResponse) []uint64 {
+		var vs []uint64
+		for _, v := range resp.GetValues() {
+			vs = append(vs, v.GetMetadata().GetVersion().GetBlockNum()*10+v.GetMetadata().GetVersion().GetTxNum())
+		}
+		return vs
+	}
+
+	// without a limit and a cursor, the values are left as they are
+	resp := history()
+	require.NoError(t, d.pageHistoricalValues(resp, listing, 0, ""))
+	require.Equal(t, []uint64{40, 21, 20, 35}, versions(resp))
+	require.Empty(t, resp.GetNextCursor())
+
+	resp = history()
+	require.NoError(t, d.pageHistoricalValues(resp, listing, 3, ""))
+	require.Equal(t, []uint64{20, 21, 35}, versions(resp))
+	require.NotEmpty(t, resp.GetNextCursor())
+
+	pageCursor := resp.GetNextCursor()
+	resp = history()
+	require.NoError(t, d.pageHistoricalValues(resp, listing, 3, pageCursor))
+	require.Equal(t, []uint64{40}, versions(resp))
+	require.Empty(t, resp.GetNextCursor())
+
+	err := d.pageHistoricalValues(history(), historyListing("alice", "db1", "key1", true), 3, pageCursor)
+	require.EqualError(t, err, "the cursor was issued for another query")
+}
@@ -10,12 +10,20 @@ import (
 	"time"
 
 	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/accesscontrol"
+	"github.com/hyperledger-labs/orion-server/internal/accesstoken"
+	"github.com/hyperledger-labs/orion-server/internal/adminaudit"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/cursor"
+	"github.com/hyperledger-labs/orion-server/internal/deadletter"
 	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/fileops"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	mptrieStore "github.com/hyperledger-labs/orion-server/internal/mptrie/store"
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
 	"github.com/hyperledger-labs/orion-server/pkg/certificateauthority"
@@ -24,6 +32,7 @@ import (
 	"github.com/hyperledger-labs/orion-server/pkg/marshal"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -40,6 +49,87 @@ type DB interface {
 	// IsLeader returns whether this server is the leader
 	IsLeader() *ierrors.NotLeaderError
 
+	// TxLatencyHistograms returns the time spent by the sampled transactions in each stage of the transaction
+	// pipeline, per validation outcome, or nil if latency sampling is disabled.
+	TxLatencyHistograms() []*queue.LatencyHistogram
+
+	// OnCommitPressure sets the function called when the mean latency of the commits of the blocks reaches the
+	// pressure latency of the commit priority, with true, and when it falls below the relief latency, with false. It
+	// is never called unless the commit priority is enabled, and must not block.
+	OnCommitPressure(listener func(underPressure bool))
+
+	// GetStorageStats returns the raw internal statistics of each database partition of the state database.
+	// Only admin users can get the storage statistics.
+	GetStorageStats(querierUserID string) (map[string]*goleveldb.DBStats, error)
+
+	// GetStorageMetrics returns the last periodic sample of the metrics of the state database, or a
+	// ServerRestrictionError if the sampling is disabled. Only admin users can get the storage metrics.
+	GetStorageMetrics(querierUserID string) ([]*leveldb.StorageMetric, error)
+
+	// GetStorageHandles returns the snapshots and iterators of the state database which are not yet released, in the
+	// order of their creation. Only admin users can get the handles.
+	GetStorageHandles(querierUserID string) ([]*leveldb.HandleInfo, error)
+
+	// GetBlockStoreStats returns the number of blocks in the block store, and the space they take on disk and before
+	// compression. Only admin users can get the block store statistics.
+	GetBlockStoreStats(querierUserID string) (*blockstore.Stats, error)
+
+	// TraceValidation re-runs the validation of a committed block against the state as of the previous block, and
+	// returns the checks performed on each transaction. Only admin users can trace the validation.
+	TraceValidation(querierUserID string, blockNum uint64) (*txvalidation.TraceReport, error)
+
+	// AcceptPeerHeader resolves the divergence on which the node halted its commits at the given block, by committing
+	// the version of the block computed by the peer it was pulled from. Only admin users can accept it.
+	AcceptPeerHeader(querierUserID string, blockNum uint64) (*types.AcceptPeerHeaderResponseEnvelope, error)
+
+	// ResyncDB commits to a database which is unavailable, as a commit to it failed, the updates it missed, and makes
+	// it available again. Only admin users can resync a database.
+	ResyncDB(querierUserID, dbName string) (*types.ResyncDBResponseEnvelope, error)
+
+	// GetTrustedCheckpoints returns the checkpoints of the ledger, one every interval blocks along with one of the
+	// last block, to be distributed as a trusted checkpoints file. Only admin users can get the checkpoints.
+	GetTrustedCheckpoints(querierUserID string, interval uint64) (*types.GetTrustedCheckpointsResponseEnvelope, error)
+
+	// GetStateMigrationStatus returns the status of the last migration of the records of the state database to the
+	// current encoding started on the node. Only admin users can get it.
+	GetStateMigrationStatus(querierUserID string) (*types.StateMigrationResponseEnvelope, error)
+
+	// MigrateState starts, or resumes, the migration of the records of the state database to the current encoding,
+	// which runs in the background, or aborts the running migration, and returns its status. Only admin users can
+	// migrate the state.
+	MigrateState(querierUserID string, abort bool) (*types.StateMigrationResponseEnvelope, error)
+
+	// GetStateScrubStatus returns the status of the last scrub of a state database started on the node. Only admin
+	// users can get it.
+	GetStateScrubStatus(querierUserID string) (*types.StateScrubResponseEnvelope, error)
+
+	// ScrubState starts the scrub of a state database which is in the integrity mode, i.e., the verification of the
+	// stored hash of each of its values, which runs in the background, and returns its status. Only admin users can
+	// scrub the state.
+	ScrubState(querierUserID, dbName string) (*types.StateScrubResponseEnvelope, error)
+
+	// GetDroppedTxs lists, in the order of the drops, the dead-letter records of the transactions that the node
+	// accepted but dropped before they were included in a block, starting with those dropped at or after since, in
+	// nanoseconds since the Unix epoch, or with the position of the pagination cursor, if given. Only admin users can
+	// list them.
+	GetDroppedTxs(querierUserID string, since int64, limit uint64, pageCursor string) (*types.GetDroppedTxsResponseEnvelope, error)
+
+	// RecordAdminAction appends the record of an administrative action performed via the HTTP endpoints to the admin
+	// audit chain of the node, signed by the node, and returns the hash of the record, i.e., the receipt of the action
+	RecordAdminAction(record *types.AdminAuditRecord) ([]byte, error)
+
+	// GetAdminAuditRecords returns the admin audit record with the given hash, if set, and otherwise lists, in the
+	// order of the chain, the admin audit records numbered since and after, or from the position of the pagination
+	// cursor, if given. Only admin users can read the records.
+	GetAdminAuditRecords(querierUserID string, hash []byte, since, limit uint64, pageCursor string) (*types.GetAdminAuditRecordsResponseEnvelope, error)
+
+	// GetLogLevels returns the logging level of every module of the server. Only admin users can get the levels.
+	GetLogLevels(querierUserID string) (*types.GetLogLevelsResponseEnvelope, error)
+
+	// SetLogLevels sets the logging levels of the given modules of the server, either all of them or none, and returns
+	// the level of every module. Only admin users can set the levels.
+	SetLogLevels(querierUserID string, levels map[string]string) (*types.GetLogLevelsResponseEnvelope, error)
+
 	// DoesUserExist checks whenever user with given userID exists
 	DoesUserExist(userID string) (bool, error)
 
@@ -59,12 +149,41 @@ type DB interface {
 	// If blockNumber==0, the last config block is returned.
 	GetConfigBlock(querierUserID string, blockNumber uint64) (*types.GetConfigBlockResponseEnvelope, error)
 
+	// GetConfigLimits returns the limits on data transactions, as set in the committed cluster configuration.
+	// Any user can get the limits.
+	GetConfigLimits() (*types.GetConfigLimitsResponseEnvelope, error)
+
 	// GetClusterStatus returns the cluster status:
 	// - the nodes, as defined in the ClusterConfig, without certificates if `noCert`=true;
 	// - the ID of the leader, if it exists;
 	// - the IDs of all active nodes, including the leader.
 	GetClusterStatus(noCerts bool) (*types.GetClusterStatusResponseEnvelope, error)
 
+	// GetClusterHeartbeats returns the last heartbeat of each node in the ClusterConfig, along with its staleness in
+	// blocks.
+	GetClusterHeartbeats() (*types.GetClusterHeartbeatsResponseEnvelope, error)
+
+	// GetSessionBootstrap returns the record of the querier, the user databases it has at least read access to, and
+	// the limits enforced by the node, which a client fetches when it starts a session.
+	GetSessionBootstrap(querierUserID string) (*types.GetSessionBootstrapResponseEnvelope, error)
+
+	// SubscribePrivilegeChanges subscribes a session of the querier to the changes of its privileges. It returns the
+	// channel on which the notifications are delivered, which is closed if the session is dropped, and the function
+	// that closes the session once the client disconnects.
+	SubscribePrivilegeChanges(querierUserID string) (<-chan *types.PrivilegeChangesResponseEnvelope, func(), error)
+
+	// IssueAccessToken issues to the querier an access token, signed by the node, which authenticates the queries of
+	// the querier to the endpoints and databases of the query in place of a signature of each query, until it expires
+	IssueAccessToken(query *types.IssueAccessTokenQuery) (*types.IssueAccessTokenResponseEnvelope, error)
+
+	// VerifyAccessToken returns the claims of the access token if it was issued by the node, has not expired, and was
+	// not revoked
+	VerifyAccessToken(token string) (*types.AccessToken, error)
+
+	// RevokeAccessToken revokes the access token before it expires. Only the user the token was issued to, or an
+	// admin, can revoke it.
+	RevokeAccessToken(querierUserID, token string) (*types.RevokeAccessTokenResponseEnvelope, error)
+
 	// GetNodeConfig returns single node subsection of database configuration
 	GetNodeConfig(nodeID string) (*types.GetNodeConfigResponseEnvelope, error)
 
@@ -75,11 +194,38 @@ type DB interface {
 	// response envelope is a JSON string.
 	GetDBIndex(dbName, querierUserID string) (*types.GetDBIndexResponseEnvelope, error)
 
+	// GetDBDescriptor returns the descriptor of a given database as of a block, or the committed descriptor
+	// when asOf is 0
+	GetDBDescriptor(dbName, querierUserID string, asOf uint64) (*types.GetDBDescriptorResponseEnvelope, error)
+
+	// GetDBDescriptorHistory returns the versions of the descriptor of a given database along with the admin
+	// who set each of them, from the provenance store
+	GetDBDescriptorHistory(dbName, querierUserID string) (*types.GetDBDescriptorHistoryResponseEnvelope, error)
+
+	// GetDBDigest returns the state digest of a given database as of a block, or as of the last committed block
+	// when blockNum is 0
+	GetDBDigest(dbName, querierUserID string, blockNum uint64) (*types.GetDBDigestResponseEnvelope, error)
+
 	// GetData retrieves values for given key
 	GetData(dbName, querierUserID, key string) (*types.GetDataResponseEnvelope, error)
 
-	// GetDataRange retrieves a range of values
-	GetDataRange(dbName, querierUserID, startKey, endKey string, limit uint64) (*types.GetDataRangeResponseEnvelope, error)
+	// GetDataMulti retrieves the values of the given keys from a single snapshot of the state, along with the height
+	// of the snapshot. A key which does not exist, or which the querier cannot read, is marked as such rather than
+	// failing the query
+	GetDataMulti(dbName, querierUserID string, keys []string) (*types.GetDataMultiResponseEnvelope, error)
+
+	// GetDataAsOf retrieves the value of the given key as of a past block, from the provenance store
+	GetDataAsOf(dbName, querierUserID, key string, asOf uint64) (*types.GetDataResponseEnvelope, error)
+
+	// GetDataRange retrieves a range of values. A range whose result is pending carries the pagination cursor of
+	// the next page, which is passed back, along with the same query, to resume the range.
+	GetDataRange(dbName, querierUserID, startKey, endKey string, limit uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error)
+
+	// GetDataRangeAsOf retrieves a range of values as of a past block, from the provenance store
+	GetDataRangeAsOf(dbName, querierUserID, startKey, endKey string, limit, asOf uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error)
+
+	// GetDataRangeAnalytical retrieves a range of values from the read replica of the state database
+	GetDataRangeAnalytical(dbName, querierUserID, startKey, endKey string, limit uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error)
 
 	// DataQuery executes a given JSON query and return key-value pairs which are matching
 	// the criteria provided in the query. The query is a json marshled bytes which needs
@@ -102,7 +248,27 @@ type DB interface {
 	// 		}
 	//   }
 	// }
-	DataQuery(ctx context.Context, dbName, querierUserID string, query []byte) (*types.DataQueryResponseEnvelope, error)
+	//
+	// The attributes of an "$and" query, unlike the ones of an "$or" query, need not all be
+	// indexed as long as one of them is. The conditions on the attributes which are not indexed,
+	// and on the indexed attributes whose index would be too costly to scan, are verified
+	// against the values, and these attributes are listed in the post_filtered_attributes of
+	// the response.
+	//
+	// A non-zero limit bounds the number of key-value pairs returned, and a response cut short by the limit carries
+	// the pagination cursor of the next page.
+	DataQuery(ctx context.Context, dbName, querierUserID string, query []byte, limit uint64, pageCursor string) (*types.DataQueryResponseEnvelope, error)
+
+	// CountData counts the keys of the database which the querier can read, selected by the JSON query if it is not
+	// empty, otherwise by the range [startKey, endKey). The keys are counted from the index or the range iterator, and
+	// no value is returned. When existsOnly is set, it only checks whether any such key exists. The response is
+	// truncated when the query reaches the count query cost limit.
+	CountData(dbName, querierUserID, startKey, endKey, jsonQuery string, existsOnly bool) (*types.GetDataCountResponseEnvelope, error)
+
+	// SubscribeKeys subscribes the user to the changes of the given keys, and of the keys with the given prefixes, of
+	// the database. It returns the channel on which the notifications are delivered, which is closed if the
+	// subscription is dropped, and the function that cancels the subscription once the subscriber disconnects.
+	SubscribeKeys(querierUserID, dbName string, keys, prefixes []string) (<-chan *types.KeyChangesResponseEnvelope, func(), error)
 
 	// GetBlockHeader returns ledger block header
 	GetBlockHeader(userID string, blockNum uint64) (*types.GetBlockResponseEnvelope, error)
@@ -113,7 +279,8 @@ type DB interface {
 	// GetTxProof returns intermediate hashes to recalculate merkle tree root from tx hash
 	GetTxProof(userID string, blockNum uint64, txIdx uint64) (*types.GetTxProofResponseEnvelope, error)
 
-	// GetDataProof returns hashes path from value to root in merkle-patricia trie
+	// GetDataProof returns hashes path from value to root in merkle-patricia trie. If the value of a key is queried
+	// but the key is absent, it returns the proof of the absence of the key instead
 	GetDataProof(userID string, blockNum uint64, dbname string, key string, deleted bool) (*types.GetDataProofResponseEnvelope, error)
 
 	// GetLedgerPath returns list of blocks that forms the shortest path in the skip list chain of the ledger.
@@ -121,15 +288,20 @@ type DB interface {
 	// 'start'<='end'. The returned path is the shortest path from the 'end' block to the 'start' block.
 	GetLedgerPath(userID string, start, end uint64) (*types.GetLedgerPathResponseEnvelope, error)
 
-	// GetValues returns all values associated with a given key
-	GetValues(userID, dbName, key string) (*types.GetHistoricalDataResponseEnvelope, error)
+	// GetValues returns all values associated with a given key. A non-zero limit, or a pagination cursor, pages
+	// through the values ordered by their version.
+	GetValues(userID, dbName, key string, limit uint64, pageCursor string) (*types.GetHistoricalDataResponseEnvelope, error)
 
-	// GetDeletedValues returns all deleted values associated with a given key
-	GetDeletedValues(userID, dbname, key string) (*types.GetHistoricalDataResponseEnvelope, error)
+	// GetDeletedValues returns all deleted values associated with a given key, paged as GetValues
+	GetDeletedValues(userID, dbname, key string, limit uint64, pageCursor string) (*types.GetHistoricalDataResponseEnvelope, error)
 
 	// GetValueAt returns the value of a given key at a particular version
 	GetValueAt(userID, dbName, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error)
 
+	// GetDataByVersion returns the value of a given key at a particular version along with the transaction
+	// which wrote it and whether it is still live
+	GetDataByVersion(userID, dbName, key string, version *types.Version) (*types.GetDataByVersionResponseEnvelope, error)
+
 	// GetMostRecentValueAtOrBelow returns the most recent value of a given key at or below the given version
 	GetMostRecentValueAtOrBelow(userID, dbName, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error)
 
@@ -159,48 +331,146 @@ type DB interface {
 	// GetTxIDsSubmittedByUser returns all ids of all transactions submitted by a targetUserID
 	GetTxIDsSubmittedByUser(querierUserID, targetUserID string) (*types.GetTxIDsSubmittedByResponseEnvelope, error)
 
+	// GetDroppedTx returns the dead-letter record of a transaction that the node accepted but dropped before it was
+	// included in a block. Only the submitter of the transaction and admin users can get it.
+	GetDroppedTx(querierUserID, txID string) (*types.GetDroppedTxResponseEnvelope, error)
+
+	// GetLedgerRollups returns the daily rollups of the ledger from the start date to the end date, both included,
+	// each given as YYYY-MM-DD, in UTC. The blocks fall on the day of their timestamps.
+	GetLedgerRollups(querierUserID, startDate, endDate string) (*types.GetLedgerRollupsResponseEnvelope, error)
+
+	// GetLedgerUsage sums the sizes of the valid transactions submitted by the target user in the blocks from the
+	// start block to the end block, both included
+	GetLedgerUsage(querierUserID, targetUserID string, startBlockNum, endBlockNum uint64) (*types.GetLedgerUsageResponseEnvelope, error)
+
+	// GetKeyBlocks returns the candidate blocks which may have modified the key of the database, from the start block
+	// to the end block, both included, as found by the key filters of the blocks. A candidate may be a false positive.
+	GetKeyBlocks(querierUserID, dbName, key string, startBlockNum, endBlockNum uint64) (*types.GetKeyBlocksResponseEnvelope, error)
+
 	// GetTxReceipt returns transaction receipt - block header of ledger block that contains the transaction
 	// and transaction index inside the block
 	GetTxReceipt(userId string, txID string) (*types.TxReceiptResponseEnvelope, error)
 
+	// VerifyTxWriteSetDigest recomputes the write-set digest of a given transaction from the
+	// provenance store and compares it with the digest recorded at commit time
+	VerifyTxWriteSetDigest(userId string, txID string) (*types.GetTxWriteSetDigestResponseEnvelope, error)
+
+	// GetBlockComposition returns the composition of the batch of a given block, as recorded by the tx reorderer of
+	// this node when it cut the batch. Only admin users can query it.
+	GetBlockComposition(userId string, blockNum uint64) (*types.GetBlockCompositionResponseEnvelope, error)
+
 	// SubmitTransaction submits transaction to the database with a timeout. If the timeout is
 	// set to 0, the submission would be treated as async while a non-zero timeout would be
 	// treated as a sync submission. When a timeout occurs with the sync submission, a
 	// timeout error will be returned
 	SubmitTransaction(tx interface{}, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error)
 
+	// ImportUsers creates the users of a signed user import request in bulk, split into user administration
+	// transactions that each carry the signed request, and returns the IDs of the transactions along with the users
+	// that failed. The timeout applies to each transaction as in SubmitTransaction.
+	ImportUsers(request *types.UserImportRequestEnvelope, timeout time.Duration) (*types.UserImportResponseEnvelope, error)
+
+	// ExportDB exports a database, from a snapshot of the state, as a stream of records passed to emit: the manifest,
+	// a record per key, with its historical values if provenance is set, and the trailer, which holds the checksum of
+	// the records before it. Only an admin can export a database.
+	ExportDB(querierUserID, dbName string, provenance bool, emit func(*types.DBExportRecord) error) error
+
+	// ImportDB creates a database from the export in a signed database import request, in transactions that each
+	// carry the signed request, and returns the IDs of the transactions. The timeout, which is required, applies to
+	// each transaction as in SubmitTransaction.
+	ImportDB(request *types.DBImportRequestEnvelope, timeout time.Duration) (*types.DBImportResponseEnvelope, error)
+
+	// PrefetchReads warms the state database, asynchronously, with the keys which a submitted data transaction
+	// declares it reads. The hints are advisory: they never fail the submission nor affect the validation of the
+	// transaction.
+	PrefetchReads(hints []*types.ReadHint)
+
 	// IsDBExists returns true if database with given name is exists otherwise false
 	IsDBExists(name string) bool
 
-	// Close frees and closes resources allocated by database instance
+	// Close shuts the node down in order: it stops the transaction pipeline at a block boundary, and then closes
+	// the stores. The progress is reported by GetClusterStatus until Close returns.
 	Close() error
 }
 
 //go:generate mockery --dir . --name TxProcessor --case underscore --output mocks/
 type TxProcessor interface {
 	Close() error
+	Shutdown(report func(stage string)) error
 	ClusterStatus() (leader string, active []string)
 	IsLeader() *ierrors.NotLeaderError
+	TxLatencyHistograms() []*queue.LatencyHistogram
+	OnCommitPressure(listener func(underPressure bool))
 	SubmitTransaction(tx interface{}, timeout time.Duration) (*types.TxReceiptResponse, error)
+	PrefetchReads(hints []*types.ReadHint)
+	StateDivergence() *types.StateDivergence
+	RecoveryStatus() []*blockprocessor.RecoveryStatus
+	AcceptPeerHeader(blockNum uint64) (*types.StateDivergence, error)
+	ResyncDB(dbName string) ([]uint64, error)
+	IsTxExpired(txID string) bool
+	TxRejection(txID string) *types.ValidationInfo
 }
 
 type db struct {
-	nodeID                   string
-	worldstateQueryProcessor *worldstateQueryProcessor
-	ledgerQueryProcessor     *ledgerQueryProcessor
-	provenanceQueryProcessor *provenanceQueryProcessor
-	txProcessor              TxProcessor
-	db                       worldstate.DB
-	blockStore               *blockstore.Store
-	provenanceStore          *provenance.Store
-	stateTrieStore           *mptrieStore.Store
-	signer                   crypto.Signer
-	logger                   *logger.SugarLogger
+	nodeID                     string
+	worldstateQueryProcessor   *worldstateQueryProcessor
+	ledgerQueryProcessor       *ledgerQueryProcessor
+	provenanceQueryProcessor   *provenanceQueryProcessor
+	pointInTimeQueryProcessor  *pointInTimeQueryProcessor
+	storageStatsQueryProcessor *storageStatsQueryProcessor
+	validationTraceProcessor   *validationTraceProcessor
+	readReplica                *readReplica
+	keySubscriptions           *keySubscriptions
+	privilegeChanges           *privilegeChanges
+	ledgerRollups              *ledgerRollups
+	cacheWarmer                *cacheWarmer
+	txProcessor                TxProcessor
+	db                         worldstate.DB
+	levelDB                    *leveldb.LevelDB
+	blockStore                 *blockstore.Store
+	provenanceStore            *provenance.Store
+	stateTrieStore             *mptrieStore.Store
+	deadLetterStore            *deadletter.Store
+	adminAuditStore            *adminaudit.Store
+	accessTokens               *accesstoken.Authority
+	cursors                    *cursor.Codec
+	shutdownConf               config.ShutdownConf
+	shutdown                   *shutdownProgress
+	signer                     crypto.Signer
+	logger                     *logger.SugarLogger
 }
 
-// NewDB creates a new database bcdb which handles both the queries and transactions.
-func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error) {
-	localConf := conf.LocalConfig
+// ledgerStores are the stores that hold the ledger and the state of a node
+type ledgerStores struct {
+	levelDB         *leveldb.LevelDB
+	blockStore      *blockstore.Store
+	provenanceStore *provenance.Store
+	stateTrieStore  *mptrieStore.Store
+	deadLetterStore *deadletter.Store
+}
+
+// close closes the stores in dependency order: the stores derived from the block store first, the block store last
+func (s *ledgerStores) close() error {
+	stores := []struct {
+		name  string
+		close func() error
+	}{
+		{name: "dead-letter store", close: s.deadLetterStore.Close},
+		{name: "state trie store", close: s.stateTrieStore.Close},
+		{name: "provenance store", close: s.provenanceStore.Close},
+		{name: "worldstate database", close: s.levelDB.Close},
+		{name: "block store", close: s.blockStore.Close},
+	}
+	for _, store := range stores {
+		if err := store.close(); err != nil {
+			return errors.WithMessagef(err, "error while closing the %s", store.name)
+		}
+	}
+	return nil
+}
+
+// openStores opens, or creates, the stores in the ledger directory of the local configuration
+func openStores(localConf *config.LocalConfiguration, logger *logger.SugarLogger) (*ledgerStores, error) {
 	if localConf.Server.Database.Name != "leveldb" {
 		return nil, errors.New("only leveldb is supported as the state database")
 	}
@@ -212,8 +482,11 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 
 	levelDB, err := leveldb.Open(
 		&leveldb.Config{
-			DBRootDir: constructWorldStatePath(ledgerDir),
-			Logger:    logger,
+			DBRootDir:             constructWorldStatePath(ledgerDir),
+			StatsSamplingInterval: localConf.Server.Database.StatsSamplingInterval,
+			HandleTTL:             localConf.Server.Database.HandleTTL,
+			IntegrityCheckedDBs:   localConf.Server.Database.IntegrityCheckedDBs,
+			Logger:                logger,
 		},
 	)
 	if err != nil {
@@ -222,8 +495,13 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 
 	blockStore, err := blockstore.Open(
 		&blockstore.Config{
-			StoreDir: constructBlockStorePath(ledgerDir),
-			Logger:   logger,
+			StoreDir:               constructBlockStorePath(ledgerDir),
+			TrustedCheckpointsFile: localConf.Server.Database.TrustedCheckpointsFile,
+			VerifyChain:            localConf.Server.Database.VerifyChainOnStart,
+			IndexRebuildWorkers:    localConf.Server.Database.IndexRebuildWorkers,
+			MaxFileSize:            localConf.Server.Database.MaxBlockFileSize,
+			Compression:            blockstore.Compression(localConf.Server.Database.BlockCompression),
+			Logger:                 logger,
 		},
 	)
 	if err != nil {
@@ -233,7 +511,7 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 	provenanceStore, err := provenance.Open(
 		&provenance.Config{
 			StoreDir: constructProvenanceStorePath(ledgerDir),
-			Disabled: conf.LocalConfig.Server.Provenance.Disabled,
+			Disabled: localConf.Server.Provenance.Disabled,
 			Logger:   logger,
 		},
 	)
@@ -251,6 +529,39 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 		return nil, errors.WithMessage(err, "error while creating the state trie store")
 	}
 
+	deadLetterStore, err := openDeadLetterStore(ledgerDir, localConf.Server.DeadLetter, logger)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while creating the dead-letter store")
+	}
+
+	return &ledgerStores{
+		levelDB:         levelDB,
+		blockStore:      blockStore,
+		provenanceStore: provenanceStore,
+		stateTrieStore:  stateTrieStore,
+		deadLetterStore: deadLetterStore,
+	}, nil
+}
+
+// NewDB creates a new database bcdb which handles both the queries and transactions.
+func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error) {
+	localConf := conf.LocalConfig
+
+	historicalReadPolicy, err := accesscontrol.ParseHistoricalReadPolicy(localConf.Server.QueryProcessing.HistoricalReadPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	stores, err := openStores(localConf, logger)
+	if err != nil {
+		return nil, err
+	}
+	levelDB := stores.levelDB
+	blockStore := stores.blockStore
+	provenanceStore := stores.provenanceStore
+	stateTrieStore := stores.stateTrieStore
+	deadLetterStore := stores.deadLetterStore
+
 	querier := identity.NewQuerier(levelDB)
 
 	signer, err := crypto.NewSigner(&crypto.SignerOptions{KeyFilePath: localConf.Server.Identity.KeyPath})
@@ -258,11 +569,27 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 		return nil, errors.Wrap(err, "can't load private key")
 	}
 
+	adminAuditStore, err := openAdminAuditStore(localConf.Server.Database.LedgerDirectory, localConf.Server.Identity.ID, signer, logger)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while creating the admin audit store")
+	}
+
+	accessTokens, err := openAccessTokenAuthority(localConf, signer, logger)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while creating the access token authority")
+	}
+
+	cursors, err := newCursorCodec(localConf.Server.Database.LedgerDirectory, localConf.Server.QueryProcessing.CursorSecretFile)
+	if err != nil {
+		return nil, err
+	}
+
 	worldstateQueryProcessor := newWorldstateQueryProcessor(
 		&worldstateQueryProcessorConfig{
 			nodeID:              localConf.Server.Identity.ID,
 			db:                  levelDB,
 			queryProcessingConf: &localConf.Server.QueryProcessing,
+			blockCreationConf:   &localConf.BlockCreation,
 			blockStore:          blockStore,
 			identityQuerier:     querier,
 			logger:              logger,
@@ -273,6 +600,7 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 		db:              levelDB,
 		blockStore:      blockStore,
 		trieStore:       stateTrieStore,
+		provenanceStore: provenanceStore,
 		identityQuerier: querier,
 		logger:          logger,
 	}
@@ -280,12 +608,61 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 
 	provenanceQueryProcessor := newProvenanceQueryProcessor(
 		&provenanceQueryProcessorConfig{
-			provenanceStore: provenanceStore,
+			db:                   levelDB,
+			provenanceStore:      provenanceStore,
+			identityQuerier:      querier,
+			historicalReadPolicy: historicalReadPolicy,
+			logger:               logger,
+		},
+	)
+
+	pointInTimeQueryProcessor := newPointInTimeQueryProcessor(
+		&pointInTimeQueryProcessorConfig{
+			db:                       levelDB,
+			provenanceStore:          provenanceStore,
+			worldstateQueryProcessor: worldstateQueryProcessor,
+			queryProcessingConf:      &localConf.Server.QueryProcessing,
+			historicalReadPolicy:     historicalReadPolicy,
+			logger:                   logger,
+		},
+	)
+
+	storageStatsQueryProcessor := newStorageStatsQueryProcessor(
+		&storageStatsQueryProcessorConfig{
+			db:              levelDB,
+			blockStore:      blockStore,
+			identityQuerier: querier,
+			logger:          logger,
+		},
+	)
+
+	validationTraceProcessor := newValidationTraceProcessor(
+		&validationTraceProcessorConfig{
+			blockStore:      blockStore,
 			identityQuerier: querier,
+			scratchDir:      constructValidationTracePath(localConf.Server.Database.LedgerDirectory),
 			logger:          logger,
 		},
 	)
 
+	var replica *readReplica
+	if interval := localConf.Server.Database.ReadReplica.RefreshInterval; interval > 0 {
+		// the replica is opened before the block processor starts to commit blocks, which then refreshes it
+		// between two commits
+		replica, err = newReadReplica(
+			&readReplicaConfig{
+				db:                       levelDB,
+				dir:                      constructReadReplicaPath(localConf.Server.Database.LedgerDirectory),
+				refreshInterval:          interval,
+				worldstateQueryProcessor: worldstateQueryProcessor,
+				logger:                   logger,
+			},
+		)
+		if err != nil {
+			return nil, errors.WithMessage(err, "can't open the read replica")
+		}
+	}
+
 	txProcessor, err := newTransactionProcessor(
 		&txProcessorConfig{
 			config:          conf,
@@ -293,25 +670,103 @@ func NewDB(conf *config.Configurations, logger *logger.SugarLogger) (DB, error)
 			blockStore:      blockStore,
 			provenanceStore: provenanceStore,
 			stateTrieStore:  stateTrieStore,
+			deadLetterStore: deadLetterStore,
+			signer:          signer,
 			logger:          logger,
 		},
 	)
 	if err != nil {
 		return nil, errors.WithMessage(err, "can't initiate tx processor")
 	}
+	if replica != nil {
+		if err := txProcessor.blockProcessor.RegisterBlockCommitListener(readReplicaListenerName, replica); err != nil {
+			return nil, err
+		}
+	}
+
+	var rollups *ledgerRollups
+	if localConf.Server.Database.RecordLedgerRollups {
+		rollups, err = newLedgerRollups(
+			&ledgerRollupsConfig{
+				db:         levelDB,
+				blockStore: blockStore,
+				logger:     logger,
+			},
+		)
+		if err != nil {
+			return nil, errors.WithMessage(err, "can't derive the ledger rollups")
+		}
+		if err := txProcessor.blockProcessor.RegisterDurableBlockCommitListener(ledgerRollupsListenerName, rollups); err != nil {
+			return nil, err
+		}
+	}
+
+	subscriptions := newKeySubscriptions(
+		&keySubscriptionsConfig{
+			nodeID:          localConf.Server.Identity.ID,
+			identityQuerier: querier,
+			signer:          signer,
+			logger:          logger,
+		},
+	)
+	if err := txProcessor.blockProcessor.RegisterBlockCommitListener(keySubscriptionsListenerName, subscriptions); err != nil {
+		return nil, err
+	}
+
+	privileges := newPrivilegeChanges(
+		&privilegeChangesConfig{
+			nodeID:          localConf.Server.Identity.ID,
+			identityQuerier: querier,
+			signer:          signer,
+			logger:          logger,
+		},
+	)
+	if err := txProcessor.blockProcessor.RegisterBlockCommitListener(privilegeChangesListenerName, privileges); err != nil {
+		return nil, err
+	}
+
+	// the state database was recovered when the transaction processor started, hence, the caches are warmed up with
+	// the state as of the last block
+	warmer := newCacheWarmer(
+		&cacheWarmerConfig{
+			db:              levelDB,
+			blockStore:      blockStore,
+			identityQuerier: querier,
+			conf:            &localConf.Server.Database.WarmUp,
+			logger:          logger,
+		},
+	)
+	if warmer != nil {
+		warmer.start()
+	}
 
 	return &db{
-		nodeID:                   localConf.Server.Identity.ID,
-		worldstateQueryProcessor: worldstateQueryProcessor,
-		ledgerQueryProcessor:     ledgerQueryProcessor,
-		provenanceQueryProcessor: provenanceQueryProcessor,
-		txProcessor:              txProcessor,
-		db:                       levelDB,
-		blockStore:               blockStore,
-		provenanceStore:          provenanceStore,
-		stateTrieStore:           stateTrieStore,
-		logger:                   logger,
-		signer:                   signer,
+		nodeID:                     localConf.Server.Identity.ID,
+		worldstateQueryProcessor:   worldstateQueryProcessor,
+		ledgerQueryProcessor:       ledgerQueryProcessor,
+		provenanceQueryProcessor:   provenanceQueryProcessor,
+		pointInTimeQueryProcessor:  pointInTimeQueryProcessor,
+		storageStatsQueryProcessor: storageStatsQueryProcessor,
+		validationTraceProcessor:   validationTraceProcessor,
+		readReplica:                replica,
+		keySubscriptions:           subscriptions,
+		privilegeChanges:           privileges,
+		ledgerRollups:              rollups,
+		cacheWarmer:                warmer,
+		txProcessor:                txProcessor,
+		db:                         levelDB,
+		levelDB:                    levelDB,
+		blockStore:                 blockStore,
+		provenanceStore:            provenanceStore,
+		stateTrieStore:             stateTrieStore,
+		deadLetterStore:            deadLetterStore,
+		adminAuditStore:            adminAuditStore,
+		accessTokens:               accessTokens,
+		cursors:                    cursors,
+		shutdownConf:               localConf.Server.Shutdown,
+		shutdown:                   &shutdownProgress{},
+		logger:                     logger,
+		signer:                     signer,
 	}, nil
 }
 
@@ -330,6 +785,323 @@ func (d *db) IsLeader() *ierrors.NotLeaderError {
 	return d.txProcessor.IsLeader()
 }
 
+// TxLatencyHistograms returns the per-stage latency histograms of the sampled transactions
+func (d *db) TxLatencyHistograms() []*queue.LatencyHistogram {
+	return d.txProcessor.TxLatencyHistograms()
+}
+
+// OnCommitPressure sets the function called on each transition of the pressure on the commits of the blocks
+func (d *db) OnCommitPressure(listener func(underPressure bool)) {
+	d.txProcessor.OnCommitPressure(listener)
+}
+
+// GetStorageStats returns the raw internal statistics of each database partition of the state database
+func (d *db) GetStorageStats(querierUserID string) (map[string]*goleveldb.DBStats, error) {
+	return d.storageStatsQueryProcessor.getStorageStats(querierUserID)
+}
+
+// GetStorageMetrics returns the last periodic sample of the metrics of the state database
+func (d *db) GetStorageMetrics(querierUserID string) ([]*leveldb.StorageMetric, error) {
+	return d.storageStatsQueryProcessor.getStorageMetrics(querierUserID)
+}
+
+// GetStorageHandles returns the snapshots and iterators of the state database which are not yet released
+func (d *db) GetStorageHandles(querierUserID string) ([]*leveldb.HandleInfo, error) {
+	return d.storageStatsQueryProcessor.getStorageHandles(querierUserID)
+}
+
+// GetBlockStoreStats returns the space taken by the blocks of the block store
+func (d *db) GetBlockStoreStats(querierUserID string) (*blockstore.Stats, error) {
+	return d.storageStatsQueryProcessor.getBlockStoreStats(querierUserID)
+}
+
+// TraceValidation returns the decision trace of a committed block
+func (d *db) TraceValidation(querierUserID string, blockNum uint64) (*txvalidation.TraceReport, error) {
+	return d.validationTraceProcessor.traceValidation(querierUserID, blockNum)
+}
+
+// AcceptPeerHeader resumes the commits halted on a divergence at the given block
+func (d *db) AcceptPeerHeader(querierUserID string, blockNum uint64) (*types.AcceptPeerHeaderResponseEnvelope, error) {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to accept the peer's version of a block",
+		}
+	}
+
+	divergence, err := d.txProcessor.AcceptPeerHeader(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.AcceptPeerHeaderResponse{
+		Header:     d.responseHeader(),
+		Divergence: divergence,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AcceptPeerHeaderResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// ResyncDB commits the updates an unavailable database missed, and makes it available again
+func (d *db) ResyncDB(querierUserID, dbName string) (*types.ResyncDBResponseEnvelope, error) {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to resync a database",
+		}
+	}
+
+	resynced, err := d.txProcessor.ResyncDB(dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.ResyncDBResponse{
+		Header:         d.responseHeader(),
+		DbName:         dbName,
+		ResyncedBlocks: resynced,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ResyncDBResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetTrustedCheckpoints returns the checkpoints of the ledger
+func (d *db) GetTrustedCheckpoints(querierUserID string, interval uint64) (*types.GetTrustedCheckpointsResponseEnvelope, error) {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to get the trusted checkpoints",
+		}
+	}
+
+	checkpoints, err := d.blockStore.TrustedCheckpoints(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.GetTrustedCheckpointsResponse{
+		Header:      d.responseHeader(),
+		Checkpoints: checkpoints,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTrustedCheckpointsResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetStateMigrationStatus returns the status of the last migration of the state database
+func (d *db) GetStateMigrationStatus(querierUserID string) (*types.StateMigrationResponseEnvelope, error) {
+	if err := d.checkStateMigrationPermission(querierUserID, "get the status of"); err != nil {
+		return nil, err
+	}
+
+	return d.stateMigrationResponse()
+}
+
+// MigrateState starts, resumes, or aborts the migration of the state database
+func (d *db) MigrateState(querierUserID string, abort bool) (*types.StateMigrationResponseEnvelope, error) {
+	if abort {
+		if err := d.checkStateMigrationPermission(querierUserID, "abort"); err != nil {
+			return nil, err
+		}
+
+		d.levelDB.AbortMigration()
+		d.logger.Infof("The user [%s] aborted the migration of the state database", querierUserID)
+		return d.stateMigrationResponse()
+	}
+
+	if err := d.checkStateMigrationPermission(querierUserID, "start"); err != nil {
+		return nil, err
+	}
+
+	if err := d.levelDB.StartMigration(nil); err != nil {
+		return nil, err
+	}
+	d.logger.Infof("The user [%s] started the migration of the state database", querierUserID)
+	return d.stateMigrationResponse()
+}
+
+func (d *db) checkStateMigrationPermission(querierUserID, action string) error {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to " + action + " the migration of the state database",
+		}
+	}
+	return nil
+}
+
+func (d *db) stateMigrationResponse() (*types.StateMigrationResponseEnvelope, error) {
+	status, err := d.levelDB.MigrationStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.StateMigrationResponse{
+		Header: d.responseHeader(),
+		Status: status,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StateMigrationResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetStateScrubStatus returns the status of the last scrub of a state database
+func (d *db) GetStateScrubStatus(querierUserID string) (*types.StateScrubResponseEnvelope, error) {
+	if err := d.checkStateScrubPermission(querierUserID); err != nil {
+		return nil, err
+	}
+
+	return d.stateScrubResponse()
+}
+
+// ScrubState starts the scrub of a state database in the integrity mode
+func (d *db) ScrubState(querierUserID, dbName string) (*types.StateScrubResponseEnvelope, error) {
+	if err := d.checkStateScrubPermission(querierUserID); err != nil {
+		return nil, err
+	}
+
+	if !d.levelDB.Exist(dbName) {
+		return nil, &ierrors.BadRequestError{ErrMsg: "database [" + dbName + "] does not exist"}
+	}
+	if !d.levelDB.IsIntegrityChecked(dbName) {
+		return nil, &ierrors.BadRequestError{ErrMsg: "database [" + dbName + "] is not in the integrity mode"}
+	}
+	if status := d.levelDB.ScrubStatus(); status.State == types.StateScrubStatus_RUNNING && status.DbName != dbName {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the scrub of database [" + status.DbName + "] is running"}
+	}
+
+	if err := d.levelDB.StartScrub(dbName, nil); err != nil {
+		return nil, err
+	}
+	d.logger.Infof("The user [%s] started the scrub of database [%s]", querierUserID, dbName)
+	return d.stateScrubResponse()
+}
+
+func (d *db) checkStateScrubPermission(querierUserID string) error {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to scrub the state database",
+		}
+	}
+	return nil
+}
+
+func (d *db) stateScrubResponse() (*types.StateScrubResponseEnvelope, error) {
+	response := &types.StateScrubResponse{
+		Header: d.responseHeader(),
+		Status: d.levelDB.ScrubStatus(),
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StateScrubResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetLogLevels returns the logging levels of the modules of the server
+func (d *db) GetLogLevels(querierUserID string) (*types.GetLogLevelsResponseEnvelope, error) {
+	if err := d.checkLogLevelsPermission(querierUserID, "get"); err != nil {
+		return nil, err
+	}
+
+	return d.logLevelsResponse()
+}
+
+// SetLogLevels sets the logging levels of some modules of the server
+func (d *db) SetLogLevels(querierUserID string, levels map[string]string) (*types.GetLogLevelsResponseEnvelope, error) {
+	if err := d.checkLogLevelsPermission(querierUserID, "set"); err != nil {
+		return nil, err
+	}
+
+	if err := d.logger.SetModuleLevels(levels); err != nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: err.Error()}
+	}
+	d.logger.Infof("The user [%s] set the logging levels %v", querierUserID, levels)
+
+	return d.logLevelsResponse()
+}
+
+func (d *db) checkLogLevelsPermission(querierUserID, action string) error {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to " + action + " the logging levels",
+		}
+	}
+	return nil
+}
+
+func (d *db) logLevelsResponse() (*types.GetLogLevelsResponseEnvelope, error) {
+	response := &types.GetLogLevelsResponse{
+		Header: d.responseHeader(),
+		Levels: d.logger.ModuleLevels(),
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetLogLevelsResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
 // DoesUserExist checks whenever userID exists
 func (d *db) DoesUserExist(userID string) (bool, error) {
 	return d.worldstateQueryProcessor.identityQuerier.DoesUserExist(userID)
@@ -347,14 +1119,15 @@ func (d *db) GetUser(querierUserID, targetUserID string) (*types.GetUserResponse
 	}
 
 	userResponse.Header = d.responseHeader()
-	sign, err := d.signature(userResponse)
+	responseBytes, sign, err := d.signature(userResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetUserResponseEnvelope{
-		Response:  userResponse,
-		Signature: sign,
+		Response:      userResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -366,14 +1139,15 @@ func (d *db) GetNodeConfig(nodeID string) (*types.GetNodeConfigResponseEnvelope,
 	}
 
 	nodeConfigResponse.Header = d.responseHeader()
-	sign, err := d.signature(nodeConfigResponse)
+	responseBytes, sign, err := d.signature(nodeConfigResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetNodeConfigResponseEnvelope{
-		Response:  nodeConfigResponse,
-		Signature: sign,
+		Response:      nodeConfigResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -386,48 +1160,163 @@ func (d *db) GetConfig(querierUserID string) (*types.GetConfigResponseEnvelope,
 		return nil, err
 	}
 
-	configResponse.Header = d.responseHeader()
-	sign, err := d.signature(configResponse)
+	configResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(configResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetConfigResponseEnvelope{
+		Response:      configResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetConfigBlock retrieves a numbered config block. If blockNumber==0 the latest valid block is returned. If the
+// blockNumber does not identify a config-block, and error is returned.
+func (d *db) GetConfigBlock(querierUserID string, blockNumber uint64) (*types.GetConfigBlockResponseEnvelope, error) {
+	configBlockResponse, err := d.worldstateQueryProcessor.getConfigBlock(querierUserID, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	configBlockResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(configBlockResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetConfigBlockResponseEnvelope{
+		Response:      configBlockResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetConfigLimits returns the limits on data transactions
+func (d *db) GetConfigLimits() (*types.GetConfigLimitsResponseEnvelope, error) {
+	limitsResponse, err := d.worldstateQueryProcessor.getConfigLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	limitsResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(limitsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetConfigLimitsResponseEnvelope{
+		Response:      limitsResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetClusterStatus returns the cluster status
+func (d *db) GetClusterStatus(noCerts bool) (*types.GetClusterStatusResponseEnvelope, error) {
+	// While the node shuts down, the status is served from the snapshot taken when the shutdown started, as the
+	// stores and the replication are being closed.
+	clusterStatusResponse := d.shutdown.status()
+	if clusterStatusResponse == nil {
+		var err error
+		if clusterStatusResponse, err = d.clusterStatus(); err != nil {
+			return nil, err
+		}
+	}
+
+	if noCerts {
+		for i := 0; i < len(clusterStatusResponse.Nodes); i++ {
+			clusterStatusResponse.Nodes[i].Certificate = nil
+		}
+	}
+
+	clusterStatusResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(clusterStatusResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetClusterStatusResponseEnvelope{
+		Response:      clusterStatusResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetClusterHeartbeats returns the last heartbeat of each node
+func (d *db) GetClusterHeartbeats() (*types.GetClusterHeartbeatsResponseEnvelope, error) {
+	heartbeatsResponse, err := d.worldstateQueryProcessor.getClusterHeartbeats()
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatsResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(heartbeatsResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.GetConfigResponseEnvelope{
-		Response:  configResponse,
-		Signature: sign,
+	return &types.GetClusterHeartbeatsResponseEnvelope{
+		Response:      heartbeatsResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
-// GetConfigBlock retrieves a numbered config block. If blockNumber==0 the latest valid block is returned. If the
-// blockNumber does not identify a config-block, and error is returned.
-func (d *db) GetConfigBlock(querierUserID string, blockNumber uint64) (*types.GetConfigBlockResponseEnvelope, error) {
-	configBlockResponse, err := d.worldstateQueryProcessor.getConfigBlock(querierUserID, blockNumber)
+// GetSessionBootstrap returns the user record, visible databases, and limits of the querier
+func (d *db) GetSessionBootstrap(querierUserID string) (*types.GetSessionBootstrapResponseEnvelope, error) {
+	bootstrapResponse, err := d.worldstateQueryProcessor.getSessionBootstrap(querierUserID)
 	if err != nil {
 		return nil, err
 	}
 
-	configBlockResponse.Header = d.responseHeader()
-	sign, err := d.signature(configBlockResponse)
+	bootstrapResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(bootstrapResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.GetConfigBlockResponseEnvelope{
-		Response:  configBlockResponse,
-		Signature: sign,
+	return &types.GetSessionBootstrapResponseEnvelope{
+		Response:      bootstrapResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
-// GetClusterStatus returns the cluster status
-func (d *db) GetClusterStatus(noCerts bool) (*types.GetClusterStatusResponseEnvelope, error) {
+// SubscribePrivilegeChanges subscribes a session of the querier to the changes of its privileges
+func (d *db) SubscribePrivilegeChanges(querierUserID string) (<-chan *types.PrivilegeChangesResponseEnvelope, func(), error) {
+	s, err := d.privilegeChanges.subscribe(querierUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.notifications, s.close, nil
+}
+
+func (d *db) clusterStatus() (*types.GetClusterStatusResponse, error) {
 	nodes, metadata, err := d.worldstateQueryProcessor.getNodeConfigAndMetadata()
 	if err != nil {
 		return nil, err
 	}
 
 	clusterStatusResponse := &types.GetClusterStatusResponse{
-		Nodes:   nodes,
-		Version: metadata.GetVersion(),
+		Nodes:           nodes,
+		Version:         metadata.GetVersion(),
+		StateDivergence: d.txProcessor.StateDivergence(),
+	}
+	if d.cacheWarmer != nil {
+		clusterStatusResponse.WarmUp = d.cacheWarmer.getStatus()
+	}
+	for _, r := range d.txProcessor.RecoveryStatus() {
+		clusterStatusResponse.Recoveries = append(clusterStatusResponse.Recoveries, &types.RecoveryStatus{
+			Store:             r.Store,
+			StartHeight:       r.StartHeight,
+			TargetHeight:      r.TargetHeight,
+			RecoveredHeight:   r.RecoveredHeight,
+			Done:              r.Done,
+			RevalidatedBlocks: r.RevalidatedBlocks,
+		})
 	}
 
 	leader, active := d.txProcessor.ClusterStatus()
@@ -450,22 +1339,7 @@ func (d *db) GetClusterStatus(noCerts bool) (*types.GetClusterStatusResponseEnve
 		}
 	}
 
-	if noCerts {
-		for i := 0; i < len(clusterStatusResponse.Nodes); i++ {
-			clusterStatusResponse.Nodes[i].Certificate = nil
-		}
-	}
-
-	clusterStatusResponse.Header = d.responseHeader()
-	sign, err := d.signature(clusterStatusResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &types.GetClusterStatusResponseEnvelope{
-		Response:  clusterStatusResponse,
-		Signature: sign,
-	}, nil
+	return clusterStatusResponse, nil
 }
 
 // GetDBStatus returns database status
@@ -476,14 +1350,15 @@ func (d *db) GetDBStatus(dbName string) (*types.GetDBStatusResponseEnvelope, err
 	}
 
 	dbStatusResponse.Header = d.responseHeader()
-	sign, err := d.signature(dbStatusResponse)
+	responseBytes, sign, err := d.signature(dbStatusResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDBStatusResponseEnvelope{
-		Response:  dbStatusResponse,
-		Signature: sign,
+		Response:      dbStatusResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -495,14 +1370,75 @@ func (d *db) GetDBIndex(dbName, querierUserID string) (*types.GetDBIndexResponse
 	}
 
 	dbIndexResponse.Header = d.responseHeader()
-	sign, err := d.signature(dbIndexResponse)
+	responseBytes, sign, err := d.signature(dbIndexResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDBIndexResponseEnvelope{
-		Response:  dbIndexResponse,
-		Signature: sign,
+		Response:      dbIndexResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDBDescriptor returns the descriptor of a given database at the end of the given block
+func (d *db) GetDBDescriptor(dbName, querierUserID string, asOf uint64) (*types.GetDBDescriptorResponseEnvelope, error) {
+	descriptorResponse, err := d.pointInTimeQueryProcessor.getDBDescriptor(dbName, querierUserID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptorResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(descriptorResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDBDescriptorResponseEnvelope{
+		Response:      descriptorResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDBDescriptorHistory returns the change history of the descriptor of a given database
+func (d *db) GetDBDescriptorHistory(dbName, querierUserID string) (*types.GetDBDescriptorHistoryResponseEnvelope, error) {
+	historyResponse, err := d.pointInTimeQueryProcessor.getDBDescriptorHistory(dbName, querierUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	historyResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(historyResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDBDescriptorHistoryResponseEnvelope{
+		Response:      historyResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDBDigest returns the state digest of a given database as of the given block
+func (d *db) GetDBDigest(dbName, querierUserID string, blockNum uint64) (*types.GetDBDigestResponseEnvelope, error) {
+	digestResponse, err := d.worldstateQueryProcessor.getDBDigest(dbName, querierUserID, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	digestResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(digestResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDBDigestResponseEnvelope{
+		Response:      digestResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -517,17 +1453,23 @@ func (d *db) SubmitTransaction(tx interface{}, timeout time.Duration) (*types.Tx
 	}
 
 	receipt.Header = d.responseHeader()
-	sign, err := d.signature(receipt)
+	responseBytes, sign, err := d.signature(receipt)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.TxReceiptResponseEnvelope{
-		Response:  receipt,
-		Signature: sign,
+		Response:      receipt,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
+// PrefetchReads warms the state database with the keys which a submitted data transaction declares it reads
+func (d *db) PrefetchReads(hints []*types.ReadHint) {
+	d.txProcessor.PrefetchReads(hints)
+}
+
 // GetData returns value for provided key
 func (d *db) GetData(dbName, querierUserID, key string) (*types.GetDataResponseEnvelope, error) {
 	dataResponse, err := d.worldstateQueryProcessor.getData(dbName, querierUserID, key)
@@ -536,40 +1478,185 @@ func (d *db) GetData(dbName, querierUserID, key string) (*types.GetDataResponseE
 	}
 
 	dataResponse.Header = d.responseHeader()
-	sign, err := d.signature(dataResponse)
+	responseBytes, sign, err := d.signature(dataResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDataResponseEnvelope{
+		Response:      dataResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDataMulti returns the values of the provided keys, all read from a single snapshot of the state
+func (d *db) GetDataMulti(dbName, querierUserID string, keys []string) (*types.GetDataMultiResponseEnvelope, error) {
+	dataResponse, err := d.worldstateQueryProcessor.getDataMulti(dbName, querierUserID, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	dataResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(dataResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDataMultiResponseEnvelope{
+		Response:      dataResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDataAsOf returns the value of the provided key at the end of the given block
+func (d *db) GetDataAsOf(dbName, querierUserID, key string, asOf uint64) (*types.GetDataResponseEnvelope, error) {
+	dataResponse, err := d.pointInTimeQueryProcessor.getData(dbName, querierUserID, key, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	dataResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(dataResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataResponseEnvelope{
-		Response:  dataResponse,
-		Signature: sign,
+		Response:      dataResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
 // GetDataRange returns a range of values starting from the start key and till before the end key
-func (d *db) GetDataRange(dbName, querierUserID, startKey, endKey string, limit uint64) (*types.GetDataRangeResponseEnvelope, error) {
-	dataResponse, err := d.worldstateQueryProcessor.getDataRange(dbName, querierUserID, startKey, endKey, limit)
+func (d *db) GetDataRange(dbName, querierUserID, startKey, endKey string, limit uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error) {
+	listing, fromKey, err := d.resumeRange(dbName, querierUserID, startKey, endKey, 0, false, pageCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dataResponse, err := d.worldstateQueryProcessor.getDataRange(dbName, querierUserID, fromKey, endKey, limit)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.setRangeCursor(listing, dataResponse); err != nil {
+		return nil, err
+	}
+
+	dataResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(dataResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDataRangeResponseEnvelope{
+		Response:      dataResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDataRangeAsOf returns a range of values starting from the start key and till before the end key, as they
+// were at the end of the given block
+func (d *db) GetDataRangeAsOf(dbName, querierUserID, startKey, endKey string, limit, asOf uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error) {
+	listing, fromKey, err := d.resumeRange(dbName, querierUserID, startKey, endKey, asOf, false, pageCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dataResponse, err := d.pointInTimeQueryProcessor.getDataRange(dbName, querierUserID, fromKey, endKey, limit, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.setRangeCursor(listing, dataResponse); err != nil {
+		return nil, err
+	}
+
+	dataResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(dataResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDataRangeResponseEnvelope{
+		Response:      dataResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDataRangeAnalytical returns a range of values starting from the start key and till before the end key, from
+// the read replica of the state database
+func (d *db) GetDataRangeAnalytical(dbName, querierUserID, startKey, endKey string, limit uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error) {
+	if d.readReplica == nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the read replica is disabled on this node"}
+	}
+
+	listing, fromKey, err := d.resumeRange(dbName, querierUserID, startKey, endKey, 0, true, pageCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dataResponse, err := d.readReplica.getDataRange(dbName, querierUserID, fromKey, endKey, limit)
 	if err != nil {
 		return nil, err
 	}
+	if err := d.setRangeCursor(listing, dataResponse); err != nil {
+		return nil, err
+	}
 
 	dataResponse.Header = d.responseHeader()
-	sign, err := d.signature(dataResponse)
+	responseBytes, sign, err := d.signature(dataResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataRangeResponseEnvelope{
-		Response:  dataResponse,
-		Signature: sign,
+		Response:      dataResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// CountData counts the keys of the database which the querier can read, or checks whether any exists
+func (d *db) CountData(dbName, querierUserID, startKey, endKey, jsonQuery string, existsOnly bool) (*types.GetDataCountResponseEnvelope, error) {
+	countResponse, err := d.worldstateQueryProcessor.countData(dbName, querierUserID, startKey, endKey, jsonQuery, existsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	countResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(countResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDataCountResponseEnvelope{
+		Response:      countResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
 // DataQuery executes a given JSON query and return key-value pairs which are matching
 // the criteria provided in the query
-func (d *db) DataQuery(ctx context.Context, dbName, querierUserID string, query []byte) (*types.DataQueryResponseEnvelope, error) {
-	queryResponse, err := d.worldstateQueryProcessor.executeJSONQuery(ctx, dbName, querierUserID, query)
+func (d *db) DataQuery(ctx context.Context, dbName, querierUserID string, query []byte, limit uint64, pageCursor string) (*types.DataQueryResponseEnvelope, error) {
+	listing, err := d.keyListing(cursor.Index, dbName, querierUserID, string(query))
+	if err != nil {
+		return nil, err
+	}
+	var fromKey string
+	if pageCursor != "" {
+		position, err := d.cursors.Resume(listing, pageCursor)
+		if err != nil {
+			return nil, err
+		}
+		fromKey = string(position)
+	}
+
+	queryResponse, nextKey, err := d.worldstateQueryProcessor.executeJSONQuery(ctx, dbName, querierUserID, query, fromKey, limit)
 
 	select {
 	case <-ctx.Done():
@@ -578,20 +1665,35 @@ func (d *db) DataQuery(ctx context.Context, dbName, querierUserID string, query
 		if err != nil {
 			return nil, err
 		}
+		if nextKey != "" {
+			if queryResponse.NextCursor, err = d.cursors.Issue(listing, []byte(nextKey), 0); err != nil {
+				return nil, err
+			}
+		}
 		queryResponse.Header = d.responseHeader()
-		sign, err := d.signature(queryResponse)
+		responseBytes, sign, err := d.signature(queryResponse)
 		if err != nil {
 			return nil, err
 		}
 
 		return &types.DataQueryResponseEnvelope{
-			Response:  queryResponse,
-			Signature: sign,
+			Response:      queryResponse,
+			Signature:     sign,
+			ResponseBytes: responseBytes,
 		}, nil
 	}
 
 }
 
+// SubscribeKeys subscribes the user to the changes of keys of the database
+func (d *db) SubscribeKeys(querierUserID, dbName string, keys, prefixes []string) (<-chan *types.KeyChangesResponseEnvelope, func(), error) {
+	s, err := d.keySubscriptions.subscribe(querierUserID, dbName, keys, prefixes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.notifications, s.close, nil
+}
+
 func (d *db) IsDBExists(name string) bool {
 	return d.worldstateQueryProcessor.isDBExists(name)
 }
@@ -603,14 +1705,15 @@ func (d *db) GetBlockHeader(userID string, blockNum uint64) (*types.GetBlockResp
 	}
 
 	blockHeader.Header = d.responseHeader()
-	sign, err := d.signature(blockHeader)
+	responseBytes, sign, err := d.signature(blockHeader)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetBlockResponseEnvelope{
-		Response:  blockHeader,
-		Signature: sign,
+		Response:      blockHeader,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -621,14 +1724,15 @@ func (d *db) GetAugmentedBlockHeader(userID string, blockNum uint64) (*types.Get
 	}
 
 	blockHeader.Header = d.responseHeader()
-	sign, err := d.signature(blockHeader)
+	responseBytes, sign, err := d.signature(blockHeader)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetAugmentedBlockHeaderResponseEnvelope{
-		Response:  blockHeader,
-		Signature: sign,
+		Response:      blockHeader,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -639,14 +1743,15 @@ func (d *db) GetTxProof(userID string, blockNum uint64, txIdx uint64) (*types.Ge
 	}
 
 	proofResponse.Header = d.responseHeader()
-	sign, err := d.signature(proofResponse)
+	responseBytes, sign, err := d.signature(proofResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetTxProofResponseEnvelope{
-		Response:  proofResponse,
-		Signature: sign,
+		Response:      proofResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -657,14 +1762,15 @@ func (d *db) GetDataProof(userID string, blockNum uint64, dbname string, key str
 	}
 
 	proofResponse.Header = d.responseHeader()
-	sign, err := d.signature(proofResponse)
+	responseBytes, sign, err := d.signature(proofResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataProofResponseEnvelope{
-		Response:  proofResponse,
-		Signature: sign,
+		Response:      proofResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -675,70 +1781,168 @@ func (d *db) GetLedgerPath(userID string, start, end uint64) (*types.GetLedgerPa
 	}
 
 	pathResponse.Header = d.responseHeader()
-	sign, err := d.signature(pathResponse)
+	responseBytes, sign, err := d.signature(pathResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetLedgerPathResponseEnvelope{
-		Response:  pathResponse,
-		Signature: sign,
+		Response:      pathResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
 func (d *db) GetTxReceipt(userId string, txID string) (*types.TxReceiptResponseEnvelope, error) {
 	receiptResponse, err := d.ledgerQueryProcessor.getTxReceipt(userId, txID)
 	if err != nil {
-		return nil, err
+		if _, ok := err.(*ierrors.NotFoundErr); !ok || d.txProcessor == nil {
+			return nil, err
+		}
+		switch {
+		case d.txProcessor.IsTxExpired(txID):
+			// the transaction was dropped as its deadline passed before it was included in a block
+			receiptResponse = &types.TxReceiptResponse{Expired: true}
+		case d.txProcessor.TxRejection(txID) != nil:
+			// the transaction was dropped as it was found invalid along with the rest of its batch
+			receiptResponse = &types.TxReceiptResponse{Rejection: d.txProcessor.TxRejection(txID)}
+		default:
+			return nil, err
+		}
 	}
 
 	receiptResponse.Header = d.responseHeader()
-	sign, err := d.signature(receiptResponse)
+	responseBytes, sign, err := d.signature(receiptResponse)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.TxReceiptResponseEnvelope{
-		Response:  receiptResponse,
-		Signature: sign,
+		Response:      receiptResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+func (d *db) GetLedgerUsage(querierUserID, targetUserID string, startBlockNum, endBlockNum uint64) (*types.GetLedgerUsageResponseEnvelope, error) {
+	usage, err := d.ledgerQueryProcessor.getLedgerUsage(querierUserID, targetUserID, startBlockNum, endBlockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(usage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetLedgerUsageResponseEnvelope{
+		Response:      usage,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+func (d *db) GetKeyBlocks(querierUserID, dbName, key string, startBlockNum, endBlockNum uint64) (*types.GetKeyBlocksResponseEnvelope, error) {
+	keyBlocks, err := d.ledgerQueryProcessor.getKeyBlocks(querierUserID, dbName, key, startBlockNum, endBlockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	keyBlocks.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(keyBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetKeyBlocksResponseEnvelope{
+		Response:      keyBlocks,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+func (d *db) VerifyTxWriteSetDigest(userId string, txID string) (*types.GetTxWriteSetDigestResponseEnvelope, error) {
+	digestResponse, err := d.ledgerQueryProcessor.verifyTxWriteSetDigest(userId, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	digestResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(digestResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTxWriteSetDigestResponseEnvelope{
+		Response:      digestResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+func (d *db) GetBlockComposition(userId string, blockNum uint64) (*types.GetBlockCompositionResponseEnvelope, error) {
+	compositionResponse, err := d.ledgerQueryProcessor.getBlockComposition(userId, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	compositionResponse.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(compositionResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetBlockCompositionResponseEnvelope{
+		Response:      compositionResponse,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
 // GetValues returns all values associated with a given key
-func (d *db) GetValues(userID, dbName, key string) (*types.GetHistoricalDataResponseEnvelope, error) {
+func (d *db) GetValues(userID, dbName, key string, limit uint64, pageCursor string) (*types.GetHistoricalDataResponseEnvelope, error) {
 	values, err := d.provenanceQueryProcessor.GetValues(userID, dbName, key)
 	if err != nil {
 		return nil, err
 	}
+	if err := d.pageHistoricalValues(values, historyListing(userID, dbName, key, false), limit, pageCursor); err != nil {
+		return nil, err
+	}
 
 	values.Header = d.responseHeader()
-	sign, err := d.signature(values)
+	responseBytes, sign, err := d.signature(values)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetHistoricalDataResponseEnvelope{
-		Response:  values,
-		Signature: sign,
+		Response:      values,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
 // GetDeletedValues returns all deleted values associated with a given key
-func (d *db) GetDeletedValues(userID, dbName, key string) (*types.GetHistoricalDataResponseEnvelope, error) {
+func (d *db) GetDeletedValues(userID, dbName, key string, limit uint64, pageCursor string) (*types.GetHistoricalDataResponseEnvelope, error) {
 	deletedValues, err := d.provenanceQueryProcessor.GetDeletedValues(userID, dbName, key)
 	if err != nil {
 		return nil, err
 	}
+	if err := d.pageHistoricalValues(deletedValues, historyListing(userID, dbName, key, true), limit, pageCursor); err != nil {
+		return nil, err
+	}
 
 	deletedValues.Header = d.responseHeader()
-	sign, err := d.signature(deletedValues)
+	responseBytes, sign, err := d.signature(deletedValues)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetHistoricalDataResponseEnvelope{
-		Response:  deletedValues,
-		Signature: sign,
+		Response:      deletedValues,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -750,14 +1954,36 @@ func (d *db) GetValueAt(userID, dbName, key string, version *types.Version) (*ty
 	}
 
 	valueAt.Header = d.responseHeader()
-	sign, err := d.signature(valueAt)
+	responseBytes, sign, err := d.signature(valueAt)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetHistoricalDataResponseEnvelope{
-		Response:  valueAt,
-		Signature: sign,
+		Response:      valueAt,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDataByVersion returns the value of a given key at a particular version along with the transaction
+// which wrote it and whether it is still live
+func (d *db) GetDataByVersion(userID, dbName, key string, version *types.Version) (*types.GetDataByVersionResponseEnvelope, error) {
+	dataByVersion, err := d.provenanceQueryProcessor.GetDataByVersion(userID, dbName, key, version)
+	if err != nil {
+		return nil, err
+	}
+
+	dataByVersion.Header = d.responseHeader()
+	responseBytes, sign, err := d.signature(dataByVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDataByVersionResponseEnvelope{
+		Response:      dataByVersion,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -769,14 +1995,15 @@ func (d *db) GetMostRecentValueAtOrBelow(userID, dbName, key string, version *ty
 	}
 
 	valueAt.Header = d.responseHeader()
-	sign, err := d.signature(valueAt)
+	responseBytes, sign, err := d.signature(valueAt)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetHistoricalDataResponseEnvelope{
-		Response:  valueAt,
-		Signature: sign,
+		Response:      valueAt,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -789,14 +2016,15 @@ func (d *db) GetPreviousValues(userID, dbName, key string, version *types.Versio
 	}
 
 	previousValues.Header = d.responseHeader()
-	sign, err := d.signature(previousValues)
+	responseBytes, sign, err := d.signature(previousValues)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetHistoricalDataResponseEnvelope{
-		Response:  previousValues,
-		Signature: sign,
+		Response:      previousValues,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -809,14 +2037,15 @@ func (d *db) GetNextValues(userID, dbName, key string, version *types.Version) (
 	}
 
 	nextValues.Header = d.responseHeader()
-	sign, err := d.signature(nextValues)
+	responseBytes, sign, err := d.signature(nextValues)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetHistoricalDataResponseEnvelope{
-		Response:  nextValues,
-		Signature: sign,
+		Response:      nextValues,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -828,14 +2057,15 @@ func (d *db) GetValuesReadByUser(querierUserID, targetUserID string) (*types.Get
 	}
 
 	readByUser.Header = d.responseHeader()
-	sign, err := d.signature(readByUser)
+	responseBytes, sign, err := d.signature(readByUser)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataProvenanceResponseEnvelope{
-		Response:  readByUser,
-		Signature: sign,
+		Response:      readByUser,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -847,14 +2077,15 @@ func (d *db) GetValuesWrittenByUser(querierUserID, targetUserID string) (*types.
 	}
 
 	writtenByUser.Header = d.responseHeader()
-	sign, err := d.signature(writtenByUser)
+	responseBytes, sign, err := d.signature(writtenByUser)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataProvenanceResponseEnvelope{
-		Response:  writtenByUser,
-		Signature: sign,
+		Response:      writtenByUser,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -866,14 +2097,15 @@ func (d *db) GetValuesDeletedByUser(querierUserID, targetUserID string) (*types.
 	}
 
 	deletedByUser.Header = d.responseHeader()
-	sign, err := d.signature(deletedByUser)
+	responseBytes, sign, err := d.signature(deletedByUser)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataProvenanceResponseEnvelope{
-		Response:  deletedByUser,
-		Signature: sign,
+		Response:      deletedByUser,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -885,14 +2117,15 @@ func (d *db) GetReaders(userID, dbName, key string) (*types.GetDataReadersRespon
 	}
 
 	readers.Header = d.responseHeader()
-	sign, err := d.signature(readers)
+	responseBytes, sign, err := d.signature(readers)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataReadersResponseEnvelope{
-		Response:  readers,
-		Signature: sign,
+		Response:      readers,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -904,14 +2137,15 @@ func (d *db) GetWriters(userID, dbName, key string) (*types.GetDataWritersRespon
 	}
 
 	writers.Header = d.responseHeader()
-	sign, err := d.signature(writers)
+	responseBytes, sign, err := d.signature(writers)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetDataWritersResponseEnvelope{
-		Response:  writers,
-		Signature: sign,
+		Response:      writers,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
@@ -923,56 +2157,130 @@ func (d *db) GetTxIDsSubmittedByUser(querierUserID, targetUserID string) (*types
 	}
 
 	submittedByUser.Header = d.responseHeader()
-	sign, err := d.signature(submittedByUser)
+	responseBytes, sign, err := d.signature(submittedByUser)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.GetTxIDsSubmittedByResponseEnvelope{
-		Response:  submittedByUser,
-		Signature: sign,
+		Response:      submittedByUser,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
 	}, nil
 }
 
+func (d *db) closeReadReplica() error {
+	if d.readReplica == nil {
+		return nil
+	}
+	return d.readReplica.close()
+}
+
 // Close closes and release resources used by db
 func (d *db) Close() error {
-	if err := d.txProcessor.Close(); err != nil {
-		return errors.WithMessage(err, "error while closing the transaction processor")
+	clusterStatus, err := d.clusterStatus()
+	if err != nil {
+		d.logger.Warnf("Failed to take the cluster status before the shutdown: %s", err)
+		clusterStatus = &types.GetClusterStatusResponse{}
 	}
+	d.shutdown.begin(clusterStatus)
+	report := d.shutdown.setStage
 
-	if err := d.db.Close(); err != nil {
-		return errors.WithMessage(err, "error while closing the worldstate database")
+	// a warm-up still running is aborted, as it reads from the stores
+	if d.cacheWarmer != nil {
+		d.cacheWarmer.stop()
 	}
 
-	if err := d.provenanceStore.Close(); err != nil {
-		return errors.WithMessage(err, "error while closing the provenance store")
+	// The transaction pipeline stops at a block boundary before any store is closed. If it does not, the stores are
+	// left open, and the stores that lag behind the block store are recovered on the next start.
+	if err := d.txProcessor.Shutdown(report); err != nil {
+		return errors.WithMessage(err, "error while shutting down the transaction processor")
 	}
+	// no block is committed anymore, hence, the subscribers are disconnected
+	d.keySubscriptions.closeAll()
+	d.privilegeChanges.closeAll()
 
-	if err := d.blockStore.Close(); err != nil {
-		return errors.WithMessage(err, "error while closing the block store")
+	stepTimeout := shutdownStepTimeout(d.shutdownConf.StepTimeout)
+	if err := runShutdownStep(d.logger, report, ShutdownVerifyingStores, stepTimeout, d.verifyStoresHeight); err != nil {
+		return err
 	}
 
-	if err := d.stateTrieStore.Close(); err != nil {
-		return errors.WithMessage(err, "error while closing the block store")
+	// The stores are closed in dependency order: the stores derived from the block store first, the block store last.
+	report(ShutdownClosingStores)
+	stores := []struct {
+		name  string
+		close func() error
+	}{
+		{name: "admin audit store", close: d.adminAuditStore.Close},
+		{name: "access token store", close: d.accessTokens.Close},
+		{name: "dead-letter store", close: d.deadLetterStore.Close},
+		{name: "state trie store", close: d.stateTrieStore.Close},
+		{name: "provenance store", close: d.provenanceStore.Close},
+		{name: "read replica", close: d.closeReadReplica},
+		{name: "worldstate database", close: d.db.Close},
+		{name: "block store", close: d.blockStore.Close},
+	}
+	for _, store := range stores {
+		if err := runShutdownStep(d.logger, func(string) {}, "closing the "+store.name, stepTimeout, store.close); err != nil {
+			return errors.WithMessagef(err, "error while closing the %s", store.name)
+		}
 	}
 
+	report(ShutdownClosed)
 	d.logger.Info("Closed internal DB")
 	return nil
 }
 
+// verifyStoresHeight checks that the state database and the state trie reached the height of the block store, such
+// that the next start needs no recovery. A lagging store is only reported, as it is recovered on the next start.
+func (d *db) verifyStoresHeight() error {
+	blockStoreHeight, err := d.blockStore.Height()
+	if err != nil {
+		return err
+	}
+
+	stateDBHeight, err := d.db.Height()
+	if err != nil {
+		return err
+	}
+	if stateDBHeight != blockStoreHeight {
+		d.logger.Warnf("The height of the state database [%d] differs from the height of the block store [%d]", stateDBHeight, blockStoreHeight)
+	}
+
+	if d.stateTrieStore.IsDisabled() {
+		return nil
+	}
+	stateTrieHeight, err := d.stateTrieStore.Height()
+	if err != nil {
+		return err
+	}
+	if stateTrieHeight != blockStoreHeight {
+		d.logger.Warnf("The height of the state trie [%d] differs from the height of the block store [%d]", stateTrieHeight, blockStoreHeight)
+	}
+
+	return nil
+}
+
 func (d *db) responseHeader() *types.ResponseHeader {
 	return &types.ResponseHeader{
 		NodeId: d.nodeID,
 	}
 }
 
-func (d *db) signature(response interface{}) ([]byte, error) {
-	responseBytes, err := marshal.DefaultMarshaler().Marshal(response.(proto.Message))
+// signature returns the deterministic protobuf encoding of the response and the signature of the node over it. The
+// encoding is sent along the response, so that clients verify the signature over the signed bytes rather than over a
+// re-encoding of the JSON rendering of the response.
+func (d *db) signature(response interface{}) ([]byte, []byte, error) {
+	responseBytes, err := marshal.DeterministicMarshal(response.(proto.Message))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return d.signer.Sign(responseBytes)
+	sign, err := d.signer.Sign(responseBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return responseBytes, sign, nil
 }
 
 type certsInGenesisConfig struct {
@@ -0,0 +1,393 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// dbImportHeadroom is kept free in each transaction of a database import, below the size limit, to cover the
+// encoding of the envelope of the transaction.
+const dbImportHeadroom = 1024
+
+// ExportDB exports the database `dbName`, from a snapshot of the state, as a stream of records passed to emit one by
+// one: the manifest, a record per key, and the trailer, which holds the checksum of the records before it. With
+// provenance, the record of a key holds the values the key held before the snapshot, as found in the provenance
+// store. Only an admin can export a database. An error returned by emit stops the export.
+func (d *db) ExportDB(querierUserID, dbName string, provenance bool, emit func(*types.DBExportRecord) error) error {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no privilege to export a database",
+		}
+	}
+	if worldstate.IsSystemDB(dbName) {
+		return &ierrors.PermissionErr{
+			ErrMsg: "the system database [" + dbName + "] cannot be exported",
+		}
+	}
+	if !d.db.Exist(dbName) {
+		return &ierrors.BadRequestError{ErrMsg: "the database [" + dbName + "] does not exist"}
+	}
+	if provenance && d.provenanceStore == nil {
+		return &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
+	}
+
+	snapshot, height, err := d.db.GetDBsSnapshotWithHeight([]string{dbName, worldstate.DatabasesDBName, worldstate.DBDescriptorsDBName})
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+
+	manifest, err := d.exportManifest(snapshot, height, dbName, provenance)
+	if err != nil {
+		return err
+	}
+
+	checksum := types.NewDBExportChecksum()
+	emitRecord := func(record *types.DBExportRecord) error {
+		if err := checksum.Add(record); err != nil {
+			return err
+		}
+		return emit(record)
+	}
+
+	if err := emitRecord(&types.DBExportRecord{Record: &types.DBExportRecord_Manifest{Manifest: manifest}}); err != nil {
+		return err
+	}
+
+	itr, err := snapshot.GetIterator(dbName, "", "")
+	if err != nil {
+		return err
+	}
+	defer itr.Release()
+
+	for itr.Next() {
+		key := string(itr.Key())
+		if strings.HasPrefix(key, worldstate.ReservedKeyPrefix) {
+			continue
+		}
+
+		v := &types.ValueWithMetadata{}
+		if err := proto.Unmarshal(itr.Value(), v); err != nil {
+			return errors.Wrapf(err, "error while unmarshaling the value of the key [%s] in database [%s]", key, dbName)
+		}
+		kv := &types.DBExportKV{
+			Key:      key,
+			Value:    v.Value,
+			Metadata: v.Metadata,
+		}
+		if provenance {
+			if kv.History, err = d.exportHistory(dbName, key, v.GetMetadata().GetVersion()); err != nil {
+				return err
+			}
+		}
+
+		if err := emitRecord(&types.DBExportRecord{Record: &types.DBExportRecord_Kv{Kv: kv}}); err != nil {
+			return err
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+
+	return emit(&types.DBExportRecord{Record: &types.DBExportRecord_Trailer{Trailer: checksum.Trailer()}})
+}
+
+// exportManifest describes the database of an export, with the index and the descriptor of the database as of the
+// snapshot
+func (d *db) exportManifest(snapshot worldstate.DBsSnapshot, height uint64, dbName string, provenance bool) (*types.DBExportManifest, error) {
+	ledgerID, err := d.blockStore.GetHash(1)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while fetching the hash of the genesis block")
+	}
+
+	manifest := &types.DBExportManifest{
+		LedgerId:   ledgerID,
+		NodeId:     d.nodeID,
+		Height:     height,
+		DbName:     dbName,
+		Provenance: provenance,
+	}
+
+	indexDef, _, err := snapshot.GetIndexDefinition(dbName)
+	if err != nil {
+		return nil, err
+	}
+	if len(indexDef) > 0 {
+		manifest.Index = &types.DBIndex{}
+		if err := json.Unmarshal(indexDef, &manifest.Index.AttributeAndType); err != nil {
+			return nil, errors.Wrap(err, "error while unmarshaling the index of database ["+dbName+"]")
+		}
+	}
+
+	value, metadata, err := snapshot.Get(worldstate.DBDescriptorsDBName, dbName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while fetching the descriptor of database [%s]", dbName)
+	}
+	if metadata != nil {
+		manifest.DbDescriptor = &types.DBDescriptor{}
+		if err := proto.Unmarshal(value, manifest.DbDescriptor); err != nil {
+			return nil, errors.Wrap(err, "error while unmarshaling the descriptor of database ["+dbName+"]")
+		}
+	}
+
+	return manifest, nil
+}
+
+// exportHistory returns the values the key held before the given version, oldest first
+func (d *db) exportHistory(dbName, key string, version *types.Version) ([]*types.ValueWithMetadata, error) {
+	values, err := d.provenanceStore.GetValues(dbName, key)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while fetching the historical values of the key [%s] in database [%s]", key, dbName)
+	}
+
+	var history []*types.ValueWithMetadata
+	for _, v := range values {
+		if versionLess(v.GetMetadata().GetVersion(), version) {
+			history = append(history, v)
+		}
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return versionLess(history[i].GetMetadata().GetVersion(), history[j].GetMetadata().GetVersion())
+	})
+	return history, nil
+}
+
+// ImportDB creates a database from the export in the request, and writes the keys of the export to it, with their
+// access control. The database is created, with the index and the settings of the exported one, by a database
+// administration transaction, and the keys are written by data transactions. Each transaction carries the signed
+// request as its proof, and is recorded in the ledger as submitted by the user of the request. The keys are written
+// anew, hence, they get new versions on the target. With provenance, the historical values of the keys are written in
+// rounds before their last values, so that the target holds the history of each key in the same order.
+//
+// The signature of the request must have been verified by the caller. The transactions are submitted one after the
+// other, each waiting for the previous one to commit, and the import stops at the first transaction that fails.
+func (d *db) ImportDB(requestEnv *types.DBImportRequestEnvelope, timeout time.Duration) (*types.DBImportResponseEnvelope, error) {
+	request := requestEnv.GetPayload()
+	if err := constants.SafeURLSegmentNZ(request.GetImportId()); err != nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: errors.WithMessage(err, "bad ImportId").Error()}
+	}
+	if timeout == 0 {
+		return nil, &ierrors.BadRequestError{ErrMsg: "a database import waits for its transactions to commit, and requires a timeout"}
+	}
+	switch {
+	case !d.db.ValidDBName(request.DbName):
+		return nil, &ierrors.BadRequestError{ErrMsg: "the database name [" + request.DbName + "] is not valid"}
+	case worldstate.IsSystemDB(request.DbName):
+		return nil, &ierrors.BadRequestError{ErrMsg: "the database [" + request.DbName + "] is a system database"}
+	case d.db.Exist(request.DbName):
+		return nil, &ierrors.BadRequestError{ErrMsg: "the database [" + request.DbName + "] already exists"}
+	}
+
+	manifest, kvs, err := types.VerifyDBExport(requestEnv.Records)
+	if err != nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: err.Error()}
+	}
+	manifestHash, err := types.DBExportManifestHash(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(manifestHash, request.ManifestHash) {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the manifest of the export does not match the manifest hash of the signed import request"}
+	}
+
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + request.UserId + "] has no privilege to perform database administrative operations",
+		}
+	}
+	if err := d.txProcessor.IsLeader(); err != nil {
+		return nil, err
+	}
+
+	proof := &types.DBImportProof{
+		Request:   request,
+		Signature: requestEnv.Signature,
+	}
+	clusterConfig, _, err := d.db.GetConfig()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while fetching the cluster configuration")
+	}
+	chunks, err := chunkImportedKVs(request, proof, kvs, d.worldstateQueryProcessor.blockCreationConf,
+		clusterConfig.GetLedgerConfig().GetTxOperationLimits().GetMaxWrites())
+	if err != nil {
+		return nil, err
+	}
+
+	txEnvs := []interface{}{importedDBCreation(request, proof, manifest)}
+	for n, chunk := range chunks {
+		txEnvs = append(txEnvs, &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds: []string{request.UserId},
+				TxId:            fmt.Sprintf("%s-%d", request.ImportId, n+1),
+				DbOperations: []*types.DBOperation{
+					{
+						DbName:     request.DbName,
+						DataWrites: chunk,
+					},
+				},
+			},
+			ImportProof: proof,
+		})
+	}
+
+	response := &types.DBImportResponse{
+		Header:  d.responseHeader(),
+		DbName:  request.DbName,
+		KvCount: uint64(len(kvs)),
+	}
+	for _, txEnv := range txEnvs {
+		txID := fmt.Sprintf("%s-%d", request.ImportId, len(response.TxIds))
+
+		receipt, err := d.txProcessor.SubmitTransaction(txEnv, timeout)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "the import of database [%s] stopped as the transaction [%s] was not committed, "+
+				"after %d committed transactions", request.DbName, txID, len(response.TxIds))
+		}
+		valInfo := receipt.GetReceipt().GetHeader().GetValidationInfo()[receipt.GetReceipt().GetTxIndex()]
+		if valInfo.GetFlag() != types.Flag_VALID {
+			return nil, errors.Errorf("the import of database [%s] stopped as the transaction [%s] is invalid: %s, "+
+				"after %d committed transactions", request.DbName, txID, valInfo.GetReasonIfInvalid(), len(response.TxIds))
+		}
+		response.TxIds = append(response.TxIds, txID)
+	}
+
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DBImportResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// importedDBCreation returns the transaction that creates the database of the import, with the index and the
+// settings of the exported database
+func importedDBCreation(request *types.DBImportRequest, proof *types.DBImportProof, manifest *types.DBExportManifest) *types.DBAdministrationTxEnvelope {
+	dbName := request.DbName
+	tx := &types.DBAdministrationTx{
+		UserId:    request.UserId,
+		TxId:      request.ImportId + "-0",
+		CreateDbs: []string{dbName},
+	}
+
+	if len(manifest.GetIndex().GetAttributeAndType()) > 0 {
+		tx.DbsIndex = map[string]*types.DBIndex{dbName: manifest.Index}
+	}
+	descriptor := manifest.GetDbDescriptor()
+	if descriptor.GetJsonSchema() != "" {
+		tx.DbsSchema = map[string]*types.DBSchema{dbName: {JsonSchema: descriptor.JsonSchema}}
+	}
+	if descriptor.GetDefaultAcl() != nil {
+		tx.DbsDefaultAcl = map[string]*types.DBDefaultACL{dbName: {Acl: descriptor.DefaultAcl}}
+	}
+	if descriptor.GetMaxValueSizeBytes() > 0 {
+		tx.DbsMaxValueSize = map[string]*types.DBMaxValueSize{dbName: {MaxValueSizeBytes: descriptor.MaxValueSizeBytes}}
+	}
+	if !worldstate.IsBinaryCollation(descriptor.GetKeyCollation()) {
+		tx.DbsKeyCollation = map[string]*types.DBKeyCollation{dbName: descriptor.KeyCollation}
+	}
+	if descriptor.GetImmutable() {
+		tx.ImmutableDbs = []string{dbName}
+	}
+
+	return &types.DBAdministrationTxEnvelope{
+		Payload:     tx,
+		ImportProof: proof,
+	}
+}
+
+// chunkImportedKVs splits the writes of the imported keys into chunks whose transactions, each carrying the proof,
+// fit in both the block size and the transaction size limits, and hold at most maxWrites writes, unless maxWrites is
+// zero. The writes are grouped in rounds, the n-th round writing the n-th value of the keys that have one, from the
+// oldest historical value to the value as of the export, and a chunk holds the writes of a single round, so that no
+// transaction writes a key twice.
+func chunkImportedKVs(request *types.DBImportRequest, proof *types.DBImportProof, kvs []*types.DBExportKV, conf *config.BlockCreationConf, maxWrites uint64) ([][]*types.DataWrite, error) {
+	limit := conf.MaxTxSizeBytes
+	if limit == 0 {
+		limit = config.DefaultMaxTxSizeBytes
+	}
+	if conf.MaxBlockSize > 0 && conf.MaxBlockSize < limit {
+		limit = conf.MaxBlockSize
+	}
+
+	base := uint64(proto.Size(&types.DataTxEnvelope{
+		Payload: &types.DataTx{
+			MustSignUserIds: []string{request.UserId},
+			TxId:            fmt.Sprintf("%s-%d", request.ImportId, len(kvs)),
+			DbOperations:    []*types.DBOperation{{DbName: request.DbName}},
+		},
+		ImportProof: proof,
+	})) + dbImportHeadroom
+	if base >= limit {
+		return nil, &ierrors.BadRequestError{
+			ErrMsg: fmt.Sprintf("the signed import request does not fit in a transaction of at most %d bytes", limit),
+		}
+	}
+
+	var chunks [][]*types.DataWrite
+	for round := 0; ; round++ {
+		var chunk []*types.DataWrite
+		size := base
+		written := false
+		for _, kv := range kvs {
+			var w *types.DataWrite
+			switch {
+			case round < len(kv.History):
+				w = &types.DataWrite{Key: kv.Key, Value: kv.History[round].GetValue(), Acl: kv.History[round].GetMetadata().GetAccessControl()}
+			case round == len(kv.History):
+				w = &types.DataWrite{Key: kv.Key, Value: kv.Value, Acl: kv.GetMetadata().GetAccessControl()}
+			default:
+				continue
+			}
+			written = true
+
+			// the size of a write within the operation, including its field tag and length
+			writeSize := uint64(proto.Size(&types.DBOperation{DataWrites: []*types.DataWrite{w}}))
+			if base+writeSize > limit {
+				return nil, &ierrors.BadRequestError{
+					ErrMsg: fmt.Sprintf("the key [%s] does not fit in a transaction of at most %d bytes", kv.Key, limit),
+				}
+			}
+
+			if size+writeSize > limit || (maxWrites > 0 && uint64(len(chunk)) == maxWrites) {
+				chunks = append(chunks, chunk)
+				chunk = nil
+				size = base
+			}
+			chunk = append(chunk, w)
+			size += writeSize
+		}
+		if len(chunk) > 0 {
+			chunks = append(chunks, chunk)
+		}
+		if !written {
+			return chunks, nil
+		}
+	}
+}
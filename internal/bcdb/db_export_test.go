@@ -0,0 +1,96 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChunkImportedKVs(t *testing.T) {
+	var kvs []*types.DBExportKV
+	for i := 0; i < 50; i++ {
+		kv := &types.DBExportKV{
+			Key:   fmt.Sprintf("key%02d", i),
+			Value: bytes.Repeat([]byte{1}, 500),
+		}
+		// every other key has an older value
+		if i%2 == 0 {
+			kv.History = []*types.ValueWithMetadata{{Value: bytes.Repeat([]byte{2}, 500)}}
+		}
+		kvs = append(kvs, kv)
+	}
+	request, err := types.NewDBImportRequest("admin", "import1", "db1", &types.DBExportManifest{DbName: "db1"})
+	require.NoError(t, err)
+	proof := &types.DBImportProof{Request: request, Signature: []byte("signature")}
+
+	t.Run("split by the smaller limit, the history first", func(t *testing.T) {
+		conf := &config.BlockCreationConf{MaxBlockSize: 10 * 1024, MaxTxSizeBytes: 1024 * 1024}
+		chunks, err := chunkImportedKVs(request, proof, kvs, conf, 0)
+		require.NoError(t, err)
+		require.Greater(t, len(chunks), 2)
+
+		// the chunk of each write of a key
+		writtenIn := make(map[string][]int)
+		for n, chunk := range chunks {
+			txEnv := &types.DataTxEnvelope{
+				Payload: &types.DataTx{
+					MustSignUserIds: []string{"admin"},
+					TxId:            fmt.Sprintf("import1-%d", n+1),
+					DbOperations:    []*types.DBOperation{{DbName: "db1", DataWrites: chunk}},
+				},
+				ImportProof: proof,
+			}
+			require.LessOrEqual(t, proto.Size(txEnv), int(conf.MaxBlockSize))
+
+			for _, w := range chunk {
+				writtenIn[w.Key] = append(writtenIn[w.Key], n)
+			}
+		}
+		require.Len(t, writtenIn, len(kvs))
+		for i, kv := range kvs {
+			require.Len(t, writtenIn[kv.Key], len(kv.History)+1)
+			if len(kv.History) > 0 {
+				// the historical value is committed before the current one
+				require.Less(t, writtenIn[kv.Key][0], writtenIn[kv.Key][1], "key %d", i)
+			}
+		}
+	})
+
+	t.Run("split by the write limit", func(t *testing.T) {
+		conf := &config.BlockCreationConf{MaxBlockSize: 1024 * 1024}
+		chunks, err := chunkImportedKVs(request, proof, kvs, conf, 10)
+		require.NoError(t, err)
+		// 25 historical values and 50 current values
+		require.Len(t, chunks, 8)
+		for _, chunk := range chunks {
+			require.LessOrEqual(t, len(chunk), 10)
+		}
+	})
+
+	t.Run("request does not fit", func(t *testing.T) {
+		conf := &config.BlockCreationConf{MaxBlockSize: 1024}
+		chunks, err := chunkImportedKVs(request, proof, kvs, conf, 0)
+		require.EqualError(t, err, "the signed import request does not fit in a transaction of at most 1024 bytes")
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+		require.Nil(t, chunks)
+	})
+
+	t.Run("key does not fit", func(t *testing.T) {
+		conf := &config.BlockCreationConf{MaxBlockSize: 5 * 1024}
+		chunks, err := chunkImportedKVs(request, proof, append(kvs[:49:49], &types.DBExportKV{
+			Key:   "large",
+			Value: bytes.Repeat([]byte{1}, 5*1024),
+		}), conf, 0)
+		require.EqualError(t, err, "the key [large] does not fit in a transaction of at most 5120 bytes")
+		require.Nil(t, chunks)
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/deadletter"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+const (
+	defaultDeadLetterRetention = 7 * 24 * time.Hour
+	// defaultDroppedTxsLimit bounds the number of dead-letter records listed by a query that sets no limit
+	defaultDroppedTxsLimit = 1000
+)
+
+func openDeadLetterStore(ledgerDir string, conf config.DeadLetterConf, logger *logger.SugarLogger) (*deadletter.Store, error) {
+	retention := conf.Retention
+	if retention == 0 {
+		retention = defaultDeadLetterRetention
+	}
+
+	return deadletter.Open(
+		&deadletter.Config{
+			StoreDir:        constructDeadLetterPath(ledgerDir),
+			Retention:       retention,
+			JanitorInterval: conf.JanitorInterval,
+			Logger:          logger,
+		},
+	)
+}
+
+// deadLetterRecorder returns the function that persists the dead-letter records of the transactions dropped by the
+// pipeline. A record that cannot be persisted is only logged, as the submitter was already released with the reason
+// of the drop.
+func deadLetterRecorder(store *deadletter.Store, logger *logger.SugarLogger) func(drops []*types.DroppedTx) {
+	return func(drops []*types.DroppedTx) {
+		for _, d := range drops {
+			logger.Infof("transaction [%s] of [%s] was dropped at stage [%s] because: %s", d.GetTxId(), d.GetSubmitter(), d.GetStage(), d.GetReason())
+		}
+		if err := store.Put(drops); err != nil {
+			logger.Errorf("failed to record [%d] dropped transactions in the dead-letter store: %s", len(drops), err)
+		}
+	}
+}
+
+// GetDroppedTx returns the dead-letter record of a transaction that the node accepted but dropped before it was
+// included in a block
+func (d *db) GetDroppedTx(querierUserID, txID string) (*types.GetDroppedTxResponseEnvelope, error) {
+	record, err := d.deadLetterStore.Get(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.GetSubmitter() != querierUserID {
+		isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, &ierrors.PermissionErr{
+				ErrMsg: "the user [" + querierUserID + "] is neither the submitter of the transaction [" + txID + "] nor an admin",
+			}
+		}
+	}
+
+	response := &types.GetDroppedTxResponse{
+		Header:    d.responseHeader(),
+		DroppedTx: record,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDroppedTxResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// GetDroppedTxs lists the dead-letter records of the node in the order of the drops
+func (d *db) GetDroppedTxs(querierUserID string, since int64, limit uint64, pageCursor string) (*types.GetDroppedTxsResponseEnvelope, error) {
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to list the dropped transactions",
+		}
+	}
+
+	if limit == 0 {
+		limit = defaultDroppedTxsLimit
+	}
+
+	listing, err := d.droppedTxsListing(querierUserID, since)
+	if err != nil {
+		return nil, err
+	}
+	from := &deadletter.Position{DroppedAt: since}
+	if pageCursor != "" {
+		position, err := d.cursors.Resume(listing, pageCursor)
+		if err != nil {
+			return nil, err
+		}
+		if from, err = decodeDropPosition(position); err != nil {
+			return nil, err
+		}
+	}
+
+	records, next, err := d.deadLetterStore.ListFrom(from, limit)
+	if err != nil {
+		return nil, err
+	}
+	var nextCursor string
+	if next != nil {
+		if nextCursor, err = d.cursors.Issue(listing, encodeDropPosition(next), uint64(next.DroppedAt)); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &types.GetDroppedTxsResponse{
+		Header:     d.responseHeader(),
+		DroppedTxs: records,
+		More:       next != nil,
+		NextCursor: nextCursor,
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDroppedTxsResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/cursor"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	crypto_mocks "github.com/hyperledger-labs/orion-server/pkg/crypto/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDroppedTxs(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	cryptoDir, conf := testConfiguration(t)
+	defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+	_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+	e, err := NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	defer e.Close()
+
+	signer := &crypto_mocks.Signer{}
+	signer.On("Sign", mock.Anything).Return([]byte("signature"), nil)
+	d := &db{
+		nodeID:                   "node1",
+		worldstateQueryProcessor: e.worldstateQueryProcessor,
+		deadLetterStore:          e.stores.deadLetterStore,
+		cursors:                  cursor.NewCodec([]byte("secret")),
+		signer:                   signer,
+		logger:                   lg,
+	}
+
+	resp, err := e.Submit(testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+		UserId: "admin",
+		TxId:   "user-tx",
+		UserWrites: []*types.UserWrite{
+			{
+				User: &types.User{
+					Id:          "testUser",
+					Certificate: userCert.Raw,
+					Privilege: &types.Privilege{
+						DbPermission: map[string]types.Privilege_Access{worldstate.DefaultDBName: types.Privilege_ReadWrite},
+					},
+				},
+			},
+		},
+	}), 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	// the transaction expired before it was submitted, hence it is dropped by the reorderer
+	before := time.Now().UnixNano()
+	_, err = e.Submit(testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+		MustSignUserIds:   []string{"testUser"},
+		TxId:              "expired-tx",
+		NotCommittedAfter: &types.TxDeadline{BlockNumber: 1},
+		DbOperations: []*types.DBOperation{
+			{
+				DbName:     worldstate.DefaultDBName,
+				DataWrites: []*types.DataWrite{{Key: "key1", Value: []byte("value")}},
+			},
+		},
+	}), 5*time.Second)
+	require.IsType(t, &ierrors.TxExpiredError{}, err)
+	expiredErr := err
+
+	// the submitter and the admins get the record
+	for _, querier := range []string{"testUser", "admin"} {
+		dropped, err := d.GetDroppedTx(querier, "expired-tx")
+		require.NoError(t, err)
+		require.Equal(t, "node1", dropped.GetResponse().GetHeader().GetNodeId())
+		record := dropped.GetResponse().GetDroppedTx()
+		require.Equal(t, "expired-tx", record.GetTxId())
+		require.Equal(t, "testUser", record.GetSubmitter())
+		require.Equal(t, "queued", record.GetStage())
+		require.Equal(t, expiredErr.Error(), record.GetReason())
+		require.GreaterOrEqual(t, record.GetDroppedAt(), before)
+	}
+
+	_, err = d.GetDroppedTx("testUser", "user-tx")
+	require.EqualError(t, err, "no dead-letter record of transaction user-tx on this node")
+	require.IsType(t, &ierrors.NotFoundErr{}, err)
+
+	// another user does not get the record
+	require.NoError(t, e.stores.deadLetterStore.Put([]*types.DroppedTx{
+		{TxId: "heartbeat-tx", Submitter: "node1", Stage: "queued", Reason: "superseded", DroppedAt: time.Now().UnixNano()},
+	}))
+	_, err = d.GetDroppedTx("testUser", "heartbeat-tx")
+	require.EqualError(t, err, "the user [testUser] is neither the submitter of the transaction [heartbeat-tx] nor an admin")
+	require.IsType(t, &ierrors.PermissionErr{}, err)
+
+	// only the admins list the records
+	listed, err := d.GetDroppedTxs("admin", 0, 0, "")
+	require.NoError(t, err)
+	require.False(t, listed.GetResponse().GetMore())
+	require.Len(t, listed.GetResponse().GetDroppedTxs(), 2)
+	require.Equal(t, "expired-tx", listed.GetResponse().GetDroppedTxs()[0].GetTxId())
+	require.Equal(t, "heartbeat-tx", listed.GetResponse().GetDroppedTxs()[1].GetTxId())
+
+	listed, err = d.GetDroppedTxs("admin", 0, 1, "")
+	require.NoError(t, err)
+	require.True(t, listed.GetResponse().GetMore())
+	require.Len(t, listed.GetResponse().GetDroppedTxs(), 1)
+	require.NotEmpty(t, listed.GetResponse().GetNextCursor())
+
+	listed, err = d.GetDroppedTxs("admin", 0, 1, listed.GetResponse().GetNextCursor())
+	require.NoError(t, err)
+	require.False(t, listed.GetResponse().GetMore())
+	require.Empty(t, listed.GetResponse().GetNextCursor())
+	require.Len(t, listed.GetResponse().GetDroppedTxs(), 1)
+	require.Equal(t, "heartbeat-tx", listed.GetResponse().GetDroppedTxs()[0].GetTxId())
+
+	_, err = d.GetDroppedTxs("testUser", 0, 0, "")
+	require.EqualError(t, err, "the user [testUser] has no permission to list the dropped transactions")
+}
+
+func TestDroppedTxsCursorAcrossPurge(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	_, conf := testConfiguration(t)
+	defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+
+	e, err := NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	defer e.Close()
+
+	signer := &crypto_mocks.Signer{}
+	signer.On("Sign", mock.Anything).Return([]byte("signature"), nil)
+	d := &db{
+		nodeID:                   "node1",
+		worldstateQueryProcessor: e.worldstateQueryProcessor,
+		deadLetterStore:          e.stores.deadLetterStore,
+		cursors:                  cursor.NewCodec([]byte("secret")),
+		signer:                   signer,
+		logger:                   lg,
+	}
+
+	var records []*types.DroppedTx
+	for i, txID := range []string{"tx1", "tx2", "tx3", "tx4", "tx5", "tx6"} {
+		records = append(records, &types.DroppedTx{
+			TxId: txID, Submitter: "node1", Stage: "queued", Reason: "superseded", DroppedAt: int64(1000 * (i + 1)),
+		})
+	}
+	require.NoError(t, e.stores.deadLetterStore.Put(records))
+
+	listTxIDs := func(resp *types.GetDroppedTxsResponseEnvelope) []string {
+		var txIDs []string
+		for _, r := range resp.GetResponse().GetDroppedTxs() {
+			txIDs = append(txIDs, r.GetTxId())
+		}
+		return txIDs
+	}
+
+	page, err := d.GetDroppedTxs("admin", 0, 2, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx1", "tx2"}, listTxIDs(page))
+
+	// the served records are purged: the listing resumes where it stopped, neither skipping nor repeating records
+	purged, err := e.stores.deadLetterStore.Purge(time.Unix(0, 2500))
+	require.NoError(t, err)
+	require.Equal(t, 2, purged)
+
+	page, err = d.GetDroppedTxs("admin", 0, 2, page.GetResponse().GetNextCursor())
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx3", "tx4"}, listTxIDs(page))
+	require.True(t, page.GetResponse().GetMore())
+
+	// the cursor of another query is rejected
+	_, err = d.GetDroppedTxs("admin", 500, 2, page.GetResponse().GetNextCursor())
+	require.EqualError(t, err, "the cursor was issued for another query")
+	require.IsType(t, &ierrors.BadRequestError{}, err)
+
+	// the records at the position of the cursor are purged: the cursor expires rather than skipping records
+	_, err = e.stores.deadLetterStore.Purge(time.Unix(0, 5500))
+	require.NoError(t, err)
+
+	_, err = d.GetDroppedTxs("admin", 0, 2, page.GetResponse().GetNextCursor())
+	require.EqualError(t, err, "cursor expired: the entries at the position of the cursor were pruned, restart the listing")
+	require.IsType(t, &ierrors.CursorExpiredError{}, err)
+
+	page, err = d.GetDroppedTxs("admin", 0, 2, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx6"}, listTxIDs(page))
+	require.False(t, page.GetResponse().GetMore())
+}
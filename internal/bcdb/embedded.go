@@ -0,0 +1,268 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Embedded is a single node database that runs in-process, as a library. It runs only the transaction pipeline - the
+// tx reorderer, the block creator, and the block processor - and numbers and commits the blocks by itself, without
+// consensus, peer transport, heartbeats, or any listener. Transactions are submitted, and data and receipts are
+// queried, by Go calls, and the responses are not signed.
+type Embedded struct {
+	nodeID                   string
+	pipeline                 *txPipeline
+	replicator               *localReplicator
+	worldstateQueryProcessor *worldstateQueryProcessor
+	ledgerQueryProcessor     *ledgerQueryProcessor
+	stores                   *ledgerStores
+	closeOnce                sync.Once
+	closeErr                 error
+	logger                   *logger.SugarLogger
+}
+
+// NewEmbedded opens, or creates, the ledger in the ledger directory of the configuration and starts the transaction
+// pipeline. An empty ledger is bootstrapped from the shared configuration, which must have a single consensus member,
+// the local node. Joining a cluster is not supported.
+func NewEmbedded(conf *config.Configurations, logger *logger.SugarLogger) (*Embedded, error) {
+	if conf.JoinBlock != nil {
+		return nil, errors.New("an embedded node cannot join a cluster")
+	}
+	if conf.SharedConfig != nil && len(conf.SharedConfig.Consensus.Members) != 1 {
+		return nil, errors.Errorf("an embedded node must be the only consensus member, but the shared configuration has %d members",
+			len(conf.SharedConfig.Consensus.Members))
+	}
+
+	localConf := conf.LocalConfig
+	stores, err := openStores(localConf, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, _, err := newTxPipeline(
+		&txProcessorConfig{
+			config:          conf,
+			db:              stores.levelDB,
+			blockStore:      stores.blockStore,
+			provenanceStore: stores.provenanceStore,
+			stateTrieStore:  stores.stateTrieStore,
+			deadLetterStore: stores.deadLetterStore,
+			logger:          logger,
+		},
+	)
+	if err != nil {
+		return nil, errors.WithMessage(err, "can't initiate the transaction pipeline")
+	}
+
+	clusterConfig, _, err := stores.levelDB.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	if n := len(clusterConfig.GetConsensusConfig().GetMembers()); n != 1 {
+		return nil, errors.Errorf("an embedded node must be the only consensus member, but the cluster has %d members", n)
+	}
+	if clusterConfig.LedgerConfig != nil && clusterConfig.LedgerConfig.StateMerkelPatriciaTrieDisabled {
+		stores.stateTrieStore.SetDisabled(true)
+	}
+
+	querier := identity.NewQuerier(stores.levelDB)
+	e := &Embedded{
+		nodeID:   localConf.Server.Identity.ID,
+		pipeline: pipeline,
+		replicator: &localReplicator{
+			nodeID:               localConf.Server.Identity.ID,
+			blockStore:           stores.blockStore,
+			blockOneQueueBarrier: pipeline.blockOneQueueBarrier,
+			pendingTxs:           pipeline.pendingTxs,
+			logger:               logger,
+		},
+		worldstateQueryProcessor: newWorldstateQueryProcessor(
+			&worldstateQueryProcessorConfig{
+				nodeID:              localConf.Server.Identity.ID,
+				db:                  stores.levelDB,
+				queryProcessingConf: &localConf.Server.QueryProcessing,
+				blockCreationConf:   &localConf.BlockCreation,
+				blockStore:          stores.blockStore,
+				identityQuerier:     querier,
+				logger:              logger,
+			},
+		),
+		ledgerQueryProcessor: newLedgerQueryProcessor(
+			&ledgerQueryProcessorConfig{
+				db:              stores.levelDB,
+				blockStore:      stores.blockStore,
+				trieStore:       stores.stateTrieStore,
+				provenanceStore: stores.provenanceStore,
+				identityQuerier: querier,
+				logger:          logger,
+			},
+		),
+		stores: stores,
+		logger: logger,
+	}
+
+	if err = pipeline.setReplicator(e.replicator); err != nil {
+		return nil, err
+	}
+	pipeline.startPreOrdering()
+	pipeline.startBlockProcessor()
+
+	return e, nil
+}
+
+// Submit submits a transaction envelope: a *types.DataTxEnvelope, *types.UserAdministrationTxEnvelope,
+// *types.DBAdministrationTxEnvelope, or *types.ConfigTxEnvelope. If the timeout is 0 the submission is asynchronous and
+// the receipt is nil; otherwise Submit waits for the transaction to commit, and returns a timeout error if it does not
+// commit in time.
+func (e *Embedded) Submit(tx interface{}, timeout time.Duration) (*types.TxReceiptResponse, error) {
+	return e.pipeline.SubmitTransaction(tx, timeout)
+}
+
+// Query returns the value of the key in the database, as seen by the querier
+func (e *Embedded) Query(dbName, querierUserID, key string) (*types.GetDataResponse, error) {
+	dataResponse, err := e.worldstateQueryProcessor.getData(dbName, querierUserID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	dataResponse.Header = &types.ResponseHeader{NodeId: e.nodeID}
+	return dataResponse, nil
+}
+
+// GetReceipt returns the receipt of a committed transaction, or a response that reports the expiry of a transaction
+// dropped as its deadline passed before it was included in a block, or the rejection of a transaction dropped as it
+// was found invalid along with the rest of its batch
+func (e *Embedded) GetReceipt(querierUserID, txID string) (*types.TxReceiptResponse, error) {
+	receiptResponse, err := e.ledgerQueryProcessor.getTxReceipt(querierUserID, txID)
+	if err != nil {
+		if _, ok := err.(*ierrors.NotFoundErr); !ok {
+			return nil, err
+		}
+		switch {
+		case e.pipeline.IsTxExpired(txID):
+			receiptResponse = &types.TxReceiptResponse{Expired: true}
+		case e.pipeline.TxRejection(txID) != nil:
+			receiptResponse = &types.TxReceiptResponse{Rejection: e.pipeline.TxRejection(txID)}
+		default:
+			return nil, err
+		}
+	}
+
+	receiptResponse.Header = &types.ResponseHeader{NodeId: e.nodeID}
+	return receiptResponse, nil
+}
+
+// RegisterCommitListener registers a listener that is called after each block is committed, in commit order
+func (e *Embedded) RegisterCommitListener(name string, listener blockprocessor.BlockCommitListener) error {
+	return e.pipeline.blockProcessor.RegisterBlockCommitListener(name, listener)
+}
+
+// RegisterDurableCommitListener registers a listener that sees every committed block, in commit order, without ever
+// holding up the commits. The blocks above the height recorded by the listener are delivered again after it fails,
+// or after a restart, see blockprocessor.DurableBlockCommitListener.
+func (e *Embedded) RegisterDurableCommitListener(name string, listener blockprocessor.DurableBlockCommitListener) error {
+	return e.pipeline.blockProcessor.RegisterDurableBlockCommitListener(name, listener)
+}
+
+// Close stops the transaction pipeline at a block boundary, and then closes the stores. Only the first call has an
+// effect; subsequent calls return the result of the first.
+func (e *Embedded) Close() error {
+	e.closeOnce.Do(func() {
+		e.closeErr = e.close()
+	})
+
+	return e.closeErr
+}
+
+func (e *Embedded) close() error {
+	if err := e.pipeline.shutdown(func(string) {}, e.replicator.close); err != nil {
+		return errors.WithMessage(err, "error while shutting down the transaction pipeline")
+	}
+
+	if err := e.stores.close(); err != nil {
+		return err
+	}
+
+	e.logger.Info("Closed embedded DB")
+	return nil
+}
+
+// localReplicator takes the place of the block replicator in an embedded node. As the node is the only member of the
+// cluster, it is always the leader, and it numbers each block created by the block creator and commits it through the
+// block processor, one block at a time.
+type localReplicator struct {
+	nodeID               string
+	blockStore           *blockstore.Store
+	blockOneQueueBarrier *queue.OneQueueBarrier
+	pendingTxs           *queue.PendingTxs
+	closed               bool
+	logger               *logger.SugarLogger
+	sync.Mutex
+}
+
+// Submit numbers the block after the last committed block, and waits for the block processor to commit it
+func (r *localReplicator) Submit(block *types.Block) error {
+	r.Lock()
+	closed := r.closed
+	r.Unlock()
+	if closed {
+		return &ierrors.ClosedError{ErrMsg: "the local replicator is closed"}
+	}
+
+	height, err := r.blockStore.Height()
+	if err != nil {
+		return err
+	}
+	baseHeader := &types.BlockHeaderBase{
+		Number:          height + 1,
+		Timestamp:       time.Now().UnixNano(),
+		ProducerVersion: constants.ServerVersion,
+		RulesVersion:    constants.RulesVersion,
+	}
+	if height > 0 {
+		if baseHeader.PreviousBaseHeaderHash, err = r.blockStore.GetBaseHeaderHash(height); err != nil {
+			return err
+		}
+		if baseHeader.LastCommittedBlockHash, err = r.blockStore.GetHash(height); err != nil {
+			return err
+		}
+		baseHeader.LastCommittedBlockNum = height
+	}
+	block.Header = &types.BlockHeader{BaseHeader: baseHeader}
+
+	if txIDs, err := utils.BlockPayloadToTxIDs(block.GetPayload()); err == nil {
+		r.pendingTxs.UpdateStage(txIDs, queue.TxStageValidating, baseHeader.Number)
+	} else {
+		r.logger.Errorf("Failed to extract TxIDs from block: %v; error: %s", block.GetHeader(), err)
+	}
+
+	r.logger.Debugf("Enqueue for commit block [%d]", baseHeader.Number)
+	_, err = r.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, r.nodeID))
+	return err
+}
+
+// IsLeader always returns nil, as the embedded node is the only member of the cluster
+func (r *localReplicator) IsLeader() *ierrors.NotLeaderError {
+	return nil
+}
+
+func (r *localReplicator) close() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.closed = true
+}
@@ -0,0 +1,504 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	internalerror "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingCommitListener struct {
+	blockNums []uint64
+	sync.Mutex
+}
+
+func (l *recordingCommitListener) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	l.Lock()
+	defer l.Unlock()
+
+	l.blockNums = append(l.blockNums, event.Block.GetHeader().GetBaseHeader().GetNumber())
+	return nil
+}
+
+func (l *recordingCommitListener) committed() []uint64 {
+	l.Lock()
+	defer l.Unlock()
+
+	return append([]uint64(nil), l.blockNums...)
+}
+
+// throttlingCommitListener slows down the commits of the blocks, as the block processor calls the listeners before
+// it processes the next block
+type throttlingCommitListener struct {
+	delay int64
+}
+
+func (l *throttlingCommitListener) PostBlockCommitProcessing(_ *blockprocessor.CommitEvent) error {
+	time.Sleep(time.Duration(atomic.LoadInt64(&l.delay)))
+	return nil
+}
+
+func TestEmbedded(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	t.Run("commit cycle in-process", func(t *testing.T) {
+		cryptoDir, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+		userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+		e, err := NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		defer e.Close()
+
+		listener := &recordingCommitListener{}
+		require.NoError(t, e.RegisterCommitListener("recorder", listener))
+
+		userTx := testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+			UserId: "admin",
+			TxId:   "user-tx",
+			UserWrites: []*types.UserWrite{
+				{
+					User: &types.User{
+						Id:          "testUser",
+						Certificate: userCert.Raw,
+						Privilege: &types.Privilege{
+							DbPermission: map[string]types.Privilege_Access{
+								worldstate.DefaultDBName: types.Privilege_ReadWrite,
+							},
+						},
+					},
+				},
+			},
+		})
+		resp, err := e.Submit(userTx, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		require.Equal(t, uint64(2), resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+
+		dataTx := testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+			MustSignUserIds: []string{"testUser"},
+			TxId:            "data-tx",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName: worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{
+						{
+							Key:   "key1",
+							Value: []byte("value1"),
+						},
+					},
+				},
+			},
+		})
+		resp, err = e.Submit(dataTx, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		require.Equal(t, uint64(3), resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+
+		data, err := e.Query(worldstate.DefaultDBName, "testUser", "key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), data.GetValue())
+		require.Equal(t, uint64(3), data.GetMetadata().GetVersion().GetBlockNum())
+		require.Equal(t, "bdb-node-1", data.GetHeader().GetNodeId())
+
+		receipt, err := e.GetReceipt("testUser", "data-tx")
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), receipt.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+		require.Equal(t, uint64(0), receipt.GetReceipt().GetTxIndex())
+
+		// the blocks are chained to the blocks that were committed before them
+		block2Hash, err := e.stores.blockStore.GetHash(2)
+		require.NoError(t, err)
+		block2BaseHash, err := e.stores.blockStore.GetBaseHeaderHash(2)
+		require.NoError(t, err)
+		block3, err := e.stores.blockStore.Get(3)
+		require.NoError(t, err)
+		require.Equal(t, block2Hash, block3.GetHeader().GetBaseHeader().GetLastCommittedBlockHash())
+		require.Equal(t, block2BaseHash, block3.GetHeader().GetBaseHeader().GetPreviousBaseHeaderHash())
+		require.Equal(t, uint64(2), block3.GetHeader().GetBaseHeader().GetLastCommittedBlockNum())
+
+		require.Equal(t, []uint64{2, 3}, listener.committed())
+
+		_, err = e.Submit(dataTx, 5*time.Second)
+		require.EqualError(t, err, "the transaction has a duplicate txID [data-tx]")
+
+		require.NoError(t, e.Close())
+		require.NoError(t, e.Close())
+
+		_, err = e.Submit(testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+			MustSignUserIds: []string{"testUser"},
+			TxId:            "after-close",
+		}), 0)
+		require.EqualError(t, err, "the server is shutting down and does not accept transactions")
+	})
+
+	t.Run("void a transaction ID", func(t *testing.T) {
+		cryptoDir, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+		userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+		e, err := NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		defer e.Close()
+
+		userTx := testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+			UserId: "admin",
+			TxId:   "user-tx",
+			UserWrites: []*types.UserWrite{
+				{
+					User: &types.User{
+						Id:          "testUser",
+						Certificate: userCert.Raw,
+						Privilege: &types.Privilege{
+							DbPermission: map[string]types.Privilege_Access{
+								worldstate.DefaultDBName: types.Privilege_ReadWrite,
+							},
+						},
+					},
+				},
+			},
+		})
+		_, err = e.Submit(userTx, 5*time.Second)
+		require.NoError(t, err)
+
+		voidTx := testutils.SignedVoidTxEnvelope(t, userSigner, &types.VoidTx{
+			UserId:   "testUser",
+			TxId:     "voided-tx",
+			Sequence: 1,
+		})
+		resp, err := e.Submit(voidTx, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VOIDED, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		require.Equal(t, uint64(3), resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+
+		receipt, err := e.GetReceipt("admin", "voided-tx")
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), receipt.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+		require.Equal(t, types.Flag_VOIDED, receipt.GetReceipt().GetHeader().GetValidationInfo()[receipt.GetReceipt().GetTxIndex()].GetFlag())
+
+		dataTx := func(txID string, sequence uint64) *types.DataTxEnvelope {
+			return testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+				MustSignUserIds: []string{"testUser"},
+				TxId:            txID,
+				Sequence:        sequence,
+				DbOperations: []*types.DBOperation{
+					{
+						DbName: worldstate.DefaultDBName,
+						DataWrites: []*types.DataWrite{
+							{
+								Key:   "key1",
+								Value: []byte("value1"),
+							},
+						},
+					},
+				},
+			})
+		}
+
+		// the voided ID cannot be reused
+		_, err = e.Submit(dataTx("voided-tx", 2), 5*time.Second)
+		require.EqualError(t, err, "the transaction has a duplicate txID [voided-tx]")
+
+		// the void consumed the sequence number 1, hence, the chain of the user continues from it
+		resp, err = e.Submit(dataTx("data-tx-1", 1), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_INVALID_OUT_OF_ORDER, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+		resp, err = e.Submit(dataTx("data-tx-2", 2), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+		data, err := e.Query(worldstate.DefaultDBName, "testUser", "key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), data.GetValue())
+	})
+
+	t.Run("reopen an existing ledger", func(t *testing.T) {
+		cryptoDir, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+
+		e, err := NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		dbTx := testutils.SignedDBAdministrationTxEnvelope(t, adminSigner, &types.DBAdministrationTx{
+			UserId:    "admin",
+			TxId:      "db-tx",
+			CreateDbs: []string{"db1"},
+		})
+		_, err = e.Submit(dbTx, 5*time.Second)
+		require.NoError(t, err)
+		require.NoError(t, e.Close())
+
+		e, err = NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		defer e.Close()
+
+		dbTx = testutils.SignedDBAdministrationTxEnvelope(t, adminSigner, &types.DBAdministrationTx{
+			UserId:    "admin",
+			TxId:      "db-tx-2",
+			CreateDbs: []string{"db2"},
+		})
+		resp, err := e.Submit(dbTx, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	})
+
+	t.Run("recover a partial batch after a restart", func(t *testing.T) {
+		cryptoDir, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+		userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+		// the block timeout never fires before the restart, hence, the data transactions stay in the partial batch
+		conf.LocalConfig.BlockCreation.MaxTransactionCountPerBlock = 10
+		conf.LocalConfig.BlockCreation.BlockTimeout = time.Hour
+		e, err := NewEmbedded(conf, lg)
+		require.NoError(t, err)
+
+		_, err = e.Submit(testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+			UserId: "admin",
+			TxId:   "user-tx",
+			UserWrites: []*types.UserWrite{
+				{
+					User: &types.User{
+						Id:          "testUser",
+						Certificate: userCert.Raw,
+						Privilege: &types.Privilege{
+							DbPermission: map[string]types.Privilege_Access{
+								worldstate.DefaultDBName: types.Privilege_ReadWrite,
+							},
+						},
+					},
+				},
+			},
+		}), 5*time.Second)
+		require.NoError(t, err)
+
+		dataTx := func(txID string) *types.DataTxEnvelope {
+			return testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+				MustSignUserIds: []string{"testUser"},
+				TxId:            txID,
+				DbOperations: []*types.DBOperation{
+					{
+						DbName:     worldstate.DefaultDBName,
+						DataWrites: []*types.DataWrite{{Key: txID, Value: []byte(txID)}},
+					},
+				},
+			})
+		}
+		for _, txID := range []string{"tx1", "tx2", "tx3"} {
+			_, err = e.Submit(dataTx(txID), 0)
+			require.NoError(t, err)
+		}
+		partialBatchPath := constructPartialBatchPath(conf.LocalConfig.Server.Database.LedgerDirectory)
+		require.Eventually(t, func() bool {
+			content, err := ioutil.ReadFile(partialBatchPath)
+			if err != nil {
+				return false
+			}
+			block := &types.Block{}
+			require.NoError(t, proto.Unmarshal(content, block))
+			return len(block.GetDataTxEnvelopes().GetEnvelopes()) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+		require.NoError(t, e.Close())
+
+		conf.LocalConfig.BlockCreation.BlockTimeout = 50 * time.Millisecond
+		e, err = NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		defer e.Close()
+
+		// the recovered transactions are pending, hence, a resubmission is a duplicate
+		_, err = e.Submit(dataTx("tx2"), 0)
+		require.EqualError(t, err, "the transaction has a duplicate txID [tx2]")
+
+		resp, err := e.Submit(dataTx("tx4"), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+
+		// every transaction accepted before the restart is committed exactly once, ahead of tx4
+		block, err := e.stores.blockStore.Get(3)
+		require.NoError(t, err)
+		var txIDs []string
+		for _, env := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			txIDs = append(txIDs, env.GetPayload().GetTxId())
+		}
+		require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, txIDs)
+		for _, txID := range txIDs {
+			data, err := e.Query(worldstate.DefaultDBName, "testUser", txID)
+			require.NoError(t, err)
+			require.Equal(t, []byte(txID), data.GetValue())
+		}
+		_, err = os.Stat(partialBatchPath)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("retry after scales with the backlog", func(t *testing.T) {
+		// fillQueue fills the transaction queue of a node whose committer commits a transaction every 20ms, and
+		// returns the interval the node asks to wait for once the queue is full
+		fillQueue := func(queueLength uint32) time.Duration {
+			cryptoDir, conf := testConfiguration(t)
+			defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+			conf.LocalConfig.Server.QueueLength.Transaction = queueLength
+			conf.LocalConfig.Server.QueueLength.ReorderedTransactionBatch = 1
+			conf.LocalConfig.Server.Backpressure = config.BackpressureConf{
+				CommitRateWindow: time.Minute,
+				RetryAfterMin:    time.Millisecond,
+				RetryAfterMax:    time.Hour,
+			}
+			_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+
+			e, err := NewEmbedded(conf, lg)
+			require.NoError(t, err)
+			defer e.Close()
+			throttle := &throttlingCommitListener{delay: int64(20 * time.Millisecond)}
+			require.NoError(t, e.RegisterCommitListener("throttle", throttle))
+			// the pending transactions are committed quickly on close
+			defer atomic.StoreInt64(&throttle.delay, 0)
+
+			dataTx := func(txID string) *types.DataTxEnvelope {
+				return testutils.SignedDataTxEnvelope(t, []crypto.Signer{adminSigner}, &types.DataTx{
+					MustSignUserIds: []string{"admin"},
+					TxId:            txID,
+				})
+			}
+
+			// the commit rate is observed on the transactions committed one by one
+			for i := 0; i < 10; i++ {
+				_, err := e.Submit(dataTx(fmt.Sprintf("warm-up-%d", i)), 5*time.Second)
+				require.NoError(t, err)
+			}
+
+			for i := 0; ; i++ {
+				_, err := e.Submit(dataTx(fmt.Sprintf("tx-%d", i)), 0)
+				if err == nil {
+					continue
+				}
+				overloaded, ok := err.(*internalerror.OverloadedError)
+				require.True(t, ok, "unexpected error: %v", err)
+				require.GreaterOrEqual(t, i, int(queueLength))
+				return overloaded.RetryAfter
+			}
+		}
+
+		shortBacklog := fillQueue(10)
+		longBacklog := fillQueue(80)
+		require.Greater(t, int64(longBacklog), 2*int64(shortBacklog), "short backlog: %s, long backlog: %s", shortBacklog, longBacklog)
+		require.Less(t, longBacklog, time.Hour)
+	})
+
+	t.Run("expired transaction is dropped", func(t *testing.T) {
+		cryptoDir, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		// one transaction per block and one batch in the queue, so that the transactions submitted while the commits
+		// are paused wait in the transaction queue
+		conf.LocalConfig.Server.QueueLength.ReorderedTransactionBatch = 1
+		conf.LocalConfig.BlockCreation.MaxTransactionCountPerBlock = 1
+		_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+
+		e, err := NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		defer e.Close()
+		pause := &pausingCommitListener{blockNumber: 2, resume: make(chan struct{})}
+		require.NoError(t, e.RegisterCommitListener("pause", pause))
+		var resumeOnce sync.Once
+		resume := func() { resumeOnce.Do(func() { close(pause.resume) }) }
+		defer resume()
+
+		dataTx := func(txID string, deadline *types.TxDeadline) *types.DataTxEnvelope {
+			return testutils.SignedDataTxEnvelope(t, []crypto.Signer{adminSigner}, &types.DataTx{
+				MustSignUserIds:   []string{"admin"},
+				TxId:              txID,
+				NotCommittedAfter: deadline,
+				DbOperations: []*types.DBOperation{
+					{
+						DbName:     worldstate.DefaultDBName,
+						DataWrites: []*types.DataWrite{{Key: txID, Value: []byte("value")}},
+					},
+				},
+			})
+		}
+
+		_, err = e.Submit(dataTx("tx-block-2", nil), 0)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			height, err := e.stores.blockStore.Height()
+			return err == nil && height == 2
+		}, 10*time.Second, 10*time.Millisecond)
+
+		// block 3 waits for the paused commits, the next batch fills the batch queue, and the reorderer waits to
+		// enqueue the third batch, hence, the last transaction is batched only once block 3 commits, too late to
+		// make it into block 3
+		for _, txID := range []string{"tx-block-3", "tx-block-4", "tx-block-5"} {
+			_, err = e.Submit(dataTx(txID, nil), 0)
+			require.NoError(t, err)
+		}
+		_, err = e.Submit(dataTx("tx-expiring", &types.TxDeadline{BlockNumber: 3}), 0)
+		require.NoError(t, err)
+
+		_, err = e.GetReceipt("admin", "tx-expiring")
+		require.IsType(t, &internalerror.NotFoundErr{}, err)
+
+		resume()
+		require.Eventually(t, func() bool {
+			receipt, err := e.GetReceipt("admin", "tx-expiring")
+			return err == nil && receipt.GetExpired()
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Eventually(t, func() bool {
+			height, err := e.stores.blockStore.Height()
+			return err == nil && height == 5
+		}, 10*time.Second, 10*time.Millisecond)
+
+		exists, err := e.stores.blockStore.DoesTxIDExist("tx-expiring")
+		require.NoError(t, err)
+		require.False(t, exists)
+		data, err := e.Query(worldstate.DefaultDBName, "admin", "tx-expiring")
+		require.NoError(t, err)
+		require.Nil(t, data.GetValue())
+		data, err = e.Query(worldstate.DefaultDBName, "admin", "tx-block-5")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), data.GetValue())
+
+		// a resubmission without the deadline commits
+		resp, err := e.Submit(dataTx("tx-expiring", nil), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		receipt, err := e.GetReceipt("admin", "tx-expiring")
+		require.NoError(t, err)
+		require.False(t, receipt.GetExpired())
+	})
+
+	t.Run("more than one consensus member", func(t *testing.T) {
+		_, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		conf.SharedConfig.Consensus.Members = append(conf.SharedConfig.Consensus.Members, conf.SharedConfig.Consensus.Members[0])
+
+		e, err := NewEmbedded(conf, lg)
+		require.EqualError(t, err, "an embedded node must be the only consensus member, but the shared configuration has 2 members")
+		require.Nil(t, e)
+	})
+}
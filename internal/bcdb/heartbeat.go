@@ -0,0 +1,121 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// heartbeatSender periodically creates a heartbeat of the local node, which records the node ID, the software
+// version, and the last committed height, signs it with the node's key, and submits it. The heartbeat is submitted
+// locally when the node is the leader, and is forwarded to the leader otherwise.
+type heartbeatSender struct {
+	nodeID   string
+	interval time.Duration
+	signer   crypto.Signer
+	height   func() (uint64, error)
+	submit   func(ctx context.Context, env *types.HeartbeatTxEnvelope) error
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+	logger   *logger.SugarLogger
+}
+
+type heartbeatSenderConfig struct {
+	nodeID   string
+	interval time.Duration
+	signer   crypto.Signer
+	height   func() (uint64, error)
+	submit   func(ctx context.Context, env *types.HeartbeatTxEnvelope) error
+	logger   *logger.SugarLogger
+}
+
+func newHeartbeatSender(conf *heartbeatSenderConfig) *heartbeatSender {
+	return &heartbeatSender{
+		nodeID:   conf.nodeID,
+		interval: conf.interval,
+		signer:   conf.signer,
+		height:   conf.height,
+		submit:   conf.submit,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+		logger:   conf.logger,
+	}
+}
+
+func (h *heartbeatSender) start() {
+	h.logger.Infof("starting the heartbeat sender, interval: %s", h.interval)
+	go h.run()
+}
+
+func (h *heartbeatSender) run() {
+	defer close(h.doneCh)
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stopCh:
+			h.logger.Info("stopping the heartbeat sender")
+			return
+
+		case <-ticker.C:
+			if err := h.send(); err != nil {
+				// a heartbeat is best effort, the next one will be sent in the next interval
+				h.logger.Warnf("failed to submit heartbeat: %s", err)
+			}
+		}
+	}
+}
+
+func (h *heartbeatSender) send() error {
+	env, err := h.newHeartbeat()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.interval)
+	defer cancel()
+
+	h.logger.Debugf("submitting heartbeat [%s], last committed height: %d", env.Payload.TxId, env.Payload.LastCommittedHeight)
+	return h.submit(ctx, env)
+}
+
+func (h *heartbeatSender) newHeartbeat() (*types.HeartbeatTxEnvelope, error) {
+	height, err := h.height()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while fetching the ledger height")
+	}
+
+	tx := &types.HeartbeatTx{
+		NodeId:              h.nodeID,
+		TxId:                uuid.New().String(),
+		Version:             constants.ServerVersion,
+		LastCommittedHeight: height,
+	}
+
+	sig, err := cryptoservice.SignTx(h.signer, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign heartbeat")
+	}
+
+	return &types.HeartbeatTxEnvelope{
+		Payload:   tx,
+		Signature: sig,
+	}, nil
+}
+
+func (h *heartbeatSender) stop() {
+	close(h.stopCh)
+	<-h.doneCh
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeartbeatsWithReplication(t *testing.T) {
+	nodeIDs := []string{"bdb-node-1", "bdb-node-2"}
+	cryptoDir := testutils.GenerateTestCrypto(t, append([]string{"testUser", "admin"}, nodeIDs...))
+	confs := twoNodesHeartbeatConfiguration(t, cryptoDir, nodeIDs, 100*time.Millisecond)
+
+	var envs []*txProcessorTestEnv
+	for _, conf := range confs {
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		env := openTxProcessorTestEnv(t, cryptoDir, conf)
+		defer env.cleanup()
+		envs = append(envs, env)
+	}
+
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	for i, env := range envs {
+		queryProcessor := newWorldstateQueryProcessor(
+			&worldstateQueryProcessorConfig{
+				nodeID:          nodeIDs[i],
+				db:              env.db,
+				blockStore:      env.blockStore,
+				identityQuerier: identity.NewQuerier(env.db),
+				logger:          lg,
+			},
+		)
+
+		// each node sees the heartbeats of both nodes, committed through the replication
+		require.Eventually(t, func() bool {
+			res, err := queryProcessor.getClusterHeartbeats()
+			if err != nil || len(res.GetHeartbeats()) != len(nodeIDs) {
+				return false
+			}
+			for j, hb := range res.GetHeartbeats() {
+				if hb.GetNodeId() != nodeIDs[j] || hb.GetBlockNumber() == 0 {
+					return false
+				}
+			}
+			return true
+		}, 30*time.Second, 100*time.Millisecond)
+
+		res, err := queryProcessor.getClusterHeartbeats()
+		require.NoError(t, err)
+		for _, hb := range res.GetHeartbeats() {
+			require.Equal(t, constants.ServerVersion, hb.GetVersion())
+			require.Less(t, hb.GetLastCommittedHeight(), hb.GetBlockNumber())
+			require.Equal(t, res.GetHeight()-hb.GetBlockNumber(), hb.GetStaleness())
+		}
+	}
+
+	// the heartbeats of a node are coalesced by the leader, hence blocks are not flooded by heartbeats
+	for _, env := range envs {
+		height, err := env.blockStore.Height()
+		require.NoError(t, err)
+		for n := uint64(2); n <= height; n++ {
+			block, err := env.blockStore.Get(n)
+			require.NoError(t, err)
+			heartbeats := block.GetHeartbeatTxEnvelopes().GetEnvelopes()
+			seen := make(map[string]bool)
+			for i, hb := range heartbeats {
+				require.False(t, seen[hb.GetPayload().GetNodeId()], "block %d", n)
+				seen[hb.GetPayload().GetNodeId()] = true
+				require.Equal(t, types.Flag_VALID, block.GetHeader().GetValidationInfo()[i].GetFlag())
+			}
+		}
+	}
+}
+
+func twoNodesHeartbeatConfiguration(t *testing.T, cryptoDir string, nodeIDs []string, interval time.Duration) []*config.Configurations {
+	sharedConfig := &config.SharedConfiguration{
+		Consensus: &config.ConsensusConf{
+			Algorithm: "raft",
+			RaftConfig: &config.RaftConf{
+				TickInterval:         "20ms",
+				ElectionTicks:        10,
+				HeartbeatTicks:       1,
+				MaxInflightBlocks:    10,
+				SnapshotIntervalSize: math.MaxUint64,
+			},
+		},
+		CAConfig: config.CAConfiguration{
+			RootCACertsPath: []string{path.Join(cryptoDir, testutils.RootCAFileName+".pem")},
+		},
+		Admin: config.AdminConf{
+			ID:              "admin",
+			CertificatePath: path.Join(cryptoDir, "admin.pem"),
+		},
+	}
+
+	peerPorts := make([]uint32, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		peerPorts[i] = freePort(t)
+		sharedConfig.Nodes = append(sharedConfig.Nodes, &config.NodeConf{
+			NodeID:          nodeID,
+			Host:            "127.0.0.1",
+			Port:            freePort(t),
+			CertificatePath: path.Join(cryptoDir, nodeID+".pem"),
+		})
+		sharedConfig.Consensus.Members = append(sharedConfig.Consensus.Members, &config.PeerConf{
+			NodeId:   nodeID,
+			RaftId:   uint64(i + 1),
+			PeerHost: "127.0.0.1",
+			PeerPort: peerPorts[i],
+		})
+	}
+
+	var confs []*config.Configurations
+	for i, nodeID := range nodeIDs {
+		ledgerDir, err := ioutil.TempDir("/tmp", fmt.Sprintf("server-%s", nodeID))
+		require.NoError(t, err)
+
+		confs = append(confs, &config.Configurations{
+			LocalConfig: &config.LocalConfiguration{
+				Server: config.ServerConf{
+					Identity: config.IdentityConf{
+						ID:              nodeID,
+						CertificatePath: path.Join(cryptoDir, nodeID+".pem"),
+						KeyPath:         path.Join(cryptoDir, nodeID+".key"),
+					},
+					Network: config.NetworkConf{
+						Address: "127.0.0.1",
+						Port:    0,
+					},
+					Database: config.DatabaseConf{
+						Name:            "leveldb",
+						LedgerDirectory: ledgerDir,
+					},
+					QueueLength: config.QueueLengthConf{
+						Transaction:               1000,
+						ReorderedTransactionBatch: 100,
+						Block:                     100,
+					},
+					HeartbeatInterval: interval,
+					LogLevel:          "info",
+				},
+				BlockCreation: config.BlockCreationConf{
+					MaxBlockSize:                2,
+					MaxTransactionCountPerBlock: 1,
+					BlockTimeout:                50 * time.Millisecond,
+				},
+				Replication: config.ReplicationConf{
+					WALDir:  path.Join(ledgerDir, "raft", "wal"),
+					SnapDir: path.Join(ledgerDir, "raft", "snap"),
+					Network: config.NetworkConf{
+						Address: "127.0.0.1",
+						Port:    peerPorts[i],
+					},
+				},
+			},
+			SharedConfig: sharedConfig,
+		})
+	}
+
+	return confs
+}
+
+func freePort(t *testing.T) uint32 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	return uint32(l.Addr().(*net.TCPAddr).Port)
+}
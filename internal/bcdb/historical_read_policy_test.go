@@ -0,0 +1,245 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/accesscontrol"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/provenance"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHistoricalReadPolicy builds an ACL timeline of a key which grants, revokes, and grants again the read access of
+// bob, and checks which versions bob can read under both policies, through the history queries of the provenance
+// store and through the point-in-time queries.
+func TestHistoricalReadPolicy(t *testing.T) {
+	env := newPointInTimeQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+
+	prov := newProvenanceQueryProcessor(&provenanceQueryProcessorConfig{
+		db:              env.db,
+		provenanceStore: env.provenanceStore,
+		identityQuerier: identity.NewQuerier(env.db),
+		logger:          env.p.logger,
+	})
+	setPolicy := func(policy accesscontrol.HistoricalReadPolicy) {
+		prov.historicalReadPolicy = policy
+		env.p.historicalReadPolicy = policy
+	}
+
+	var blockNum uint64
+	lastVersions := make(map[string]*types.Version)
+	// commit commits a block which writes the key with a value readable by the given users, and then the users
+	commit := func(users []*types.User, key string, value string, readers ...string) {
+		blockNum++
+		var provenanceData []*provenance.TxDataForProvenance
+		dbsUpdates := map[string]*worldstate.DBUpdates{
+			worldstate.UsersDBName: {},
+			"db1":                  {},
+		}
+
+		write := func(dbName, provenanceKey, stateKey string, value []byte, metadata *types.Metadata) {
+			metadata.Version = &types.Version{BlockNum: blockNum, TxNum: uint64(len(provenanceData))}
+			txData := &provenance.TxDataForProvenance{
+				IsValid:            true,
+				DBName:             dbName,
+				UserID:             "admin",
+				TxID:               fmt.Sprintf("tx-%d-%d", blockNum, len(provenanceData)),
+				Writes:             []*types.KVWithMetadata{{Key: provenanceKey, Value: value, Metadata: metadata}},
+				OldVersionOfWrites: map[string]*types.Version{},
+			}
+			if v, ok := lastVersions[dbName+provenanceKey]; ok {
+				txData.OldVersionOfWrites[provenanceKey] = v
+			}
+			lastVersions[dbName+provenanceKey] = metadata.Version
+			provenanceData = append(provenanceData, txData)
+			dbsUpdates[dbName].Writes = append(dbsUpdates[dbName].Writes, &worldstate.KVWithMetadata{
+				Key:      stateKey,
+				Value:    value,
+				Metadata: metadata,
+			})
+		}
+
+		acl := &types.AccessControl{ReadUsers: make(map[string]bool)}
+		for _, reader := range readers {
+			acl.ReadUsers[reader] = true
+		}
+		write("db1", key, key, []byte(value), &types.Metadata{AccessControl: acl})
+		for _, user := range users {
+			u, err := proto.Marshal(user)
+			require.NoError(t, err)
+			write(worldstate.UsersDBName, user.Id, string(identity.UserNamespace)+user.Id, u, &types.Metadata{})
+		}
+
+		require.NoError(t, env.provenanceStore.Commit(blockNum, provenanceData))
+		require.NoError(t, env.db.Commit(dbsUpdates, blockNum))
+	}
+	user := func(userID string, access ...types.Privilege_Access) *types.User {
+		u := &types.User{Id: userID, Privilege: &types.Privilege{DbPermission: map[string]types.Privilege_Access{}}}
+		for _, a := range access {
+			u.Privilege.DbPermission["db1"] = a
+		}
+		return u
+	}
+
+	history := func(userID string) ([]string, error) {
+		resp, err := prov.GetValues(userID, "db1", "key")
+		if err != nil {
+			return nil, err
+		}
+		var values []string
+		for _, v := range resp.GetValues() {
+			values = append(values, string(v.GetValue()))
+		}
+		return values, nil
+	}
+	valueAt := func(userID string, blockNum uint64) (string, error) {
+		resp, err := prov.GetValueAt(userID, "db1", "key", &types.Version{BlockNum: blockNum, TxNum: 0})
+		if err != nil {
+			return "", err
+		}
+		if len(resp.GetValues()) != 1 {
+			return "", fmt.Errorf("expected a single value, got %d", len(resp.GetValues()))
+		}
+		return string(resp.GetValues()[0].GetValue()), nil
+	}
+	dataByVersion := func(userID string, blockNum uint64) (string, error) {
+		resp, err := prov.GetDataByVersion(userID, "db1", "key", &types.Version{BlockNum: blockNum, TxNum: 0})
+		if err != nil {
+			return "", err
+		}
+		return string(resp.GetValue().GetValue()), nil
+	}
+	asOf := func(userID string, blockNum uint64) (string, error) {
+		resp, err := env.p.getData("db1", userID, "key", blockNum)
+		if err != nil {
+			return "", err
+		}
+		return string(resp.GetValue()), nil
+	}
+	noKeyPermErr := func(blockNum uint64) error {
+		return &ierrors.PermissionErr{
+			ErrMsg: fmt.Sprintf("the user [bob] has no permission to read key [key] from database [db1] at version (%d, 0)", blockNum),
+		}
+	}
+	noKeyAsOfPermErr := func(blockNum uint64) error {
+		return &ierrors.PermissionErr{
+			ErrMsg: fmt.Sprintf("the user [bob] has no permission to read key [key] from database [db1] as of block [%d]", blockNum),
+		}
+	}
+
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}}},
+	}, 1))
+	// bob is granted the read access on the key at block 2 and revoked at block 3; the block 4 does not touch the key
+	commit([]*types.User{user("alice", types.Privilege_Read), user("bob", types.Privilege_Read)}, "key", "v1", "alice")
+	commit(nil, "key", "v2", "alice", "bob")
+	commit(nil, "key", "v3", "alice")
+	commit(nil, "other", "x", "alice")
+
+	for _, policy := range []accesscontrol.HistoricalReadPolicy{accesscontrol.HistoricalReadStrict, accesscontrol.HistoricalReadUnion} {
+		t.Run(fmt.Sprintf("revoked now under the %s policy", policy), func(t *testing.T) {
+			setPolicy(policy)
+
+			// bob cannot read the key now, hence, both policies let bob read only the version readable at its time
+			values, err := history("bob")
+			require.NoError(t, err)
+			require.Equal(t, []string{"v2"}, values)
+
+			_, err = valueAt("bob", 1)
+			require.Equal(t, noKeyPermErr(1), err)
+			v, err := valueAt("bob", 2)
+			require.NoError(t, err)
+			require.Equal(t, "v2", v)
+			_, err = dataByVersion("bob", 3)
+			require.Equal(t, noKeyPermErr(3), err)
+
+			_, err = asOf("bob", 1)
+			require.Equal(t, noKeyAsOfPermErr(1), err)
+			v, err = asOf("bob", 2)
+			require.NoError(t, err)
+			require.Equal(t, "v2", v)
+			_, err = asOf("bob", 3)
+			require.Equal(t, noKeyAsOfPermErr(3), err)
+
+			values, err = history("alice")
+			require.NoError(t, err)
+			require.Equal(t, []string{"v1", "v2", "v3"}, values)
+		})
+	}
+
+	// bob is granted the read access on the key again at block 5
+	commit(nil, "key", "v5", "alice", "bob")
+	commit(nil, "other", "y", "alice")
+
+	t.Run("granted now under the strict policy", func(t *testing.T) {
+		setPolicy(accesscontrol.HistoricalReadStrict)
+
+		values, err := history("bob")
+		require.NoError(t, err)
+		require.Equal(t, []string{"v2", "v5"}, values)
+
+		_, err = valueAt("bob", 3)
+		require.Equal(t, noKeyPermErr(3), err)
+		_, err = dataByVersion("bob", 1)
+		require.Equal(t, noKeyPermErr(1), err)
+
+		_, err = asOf("bob", 1)
+		require.Equal(t, noKeyAsOfPermErr(1), err)
+		_, err = asOf("bob", 4)
+		require.Equal(t, noKeyAsOfPermErr(4), err)
+		v, err := asOf("bob", 5)
+		require.NoError(t, err)
+		require.Equal(t, "v5", v)
+	})
+
+	t.Run("granted now under the union policy", func(t *testing.T) {
+		setPolicy(accesscontrol.HistoricalReadUnion)
+
+		values, err := history("bob")
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1", "v2", "v3", "v5"}, values)
+
+		v, err := valueAt("bob", 3)
+		require.NoError(t, err)
+		require.Equal(t, "v3", v)
+		v, err = dataByVersion("bob", 1)
+		require.NoError(t, err)
+		require.Equal(t, "v1", v)
+
+		for blockNum, expected := range map[uint64]string{1: "v1", 2: "v2", 4: "v3", 5: "v5"} {
+			v, err = asOf("bob", blockNum)
+			require.NoError(t, err)
+			require.Equal(t, expected, v, "as of block %d", blockNum)
+		}
+	})
+
+	// bob loses the read access on the database at block 7, while the ACL of the key still holds bob
+	commit([]*types.User{user("bob")}, "other", "z", "alice")
+	commit(nil, "other", "w", "alice")
+
+	for _, policy := range []accesscontrol.HistoricalReadPolicy{accesscontrol.HistoricalReadStrict, accesscontrol.HistoricalReadUnion} {
+		t.Run(fmt.Sprintf("database access revoked now under the %s policy", policy), func(t *testing.T) {
+			setPolicy(policy)
+
+			_, err := history("bob")
+			require.Equal(t, &ierrors.PermissionErr{ErrMsg: "the user [bob] has no permission to read from database [db1]"}, err)
+
+			// the point-in-time queries consult the privileges of bob at the queried block
+			v, err := asOf("bob", 2)
+			require.NoError(t, err)
+			require.Equal(t, "v2", v)
+			_, err = asOf("bob", 3)
+			require.Equal(t, noKeyAsOfPermErr(3), err)
+			_, err = asOf("bob", 7)
+			require.Equal(t, &ierrors.PermissionErr{ErrMsg: "the user [bob] has no permission to read from database [db1] as of block [7]"}, err)
+		})
+	}
+}
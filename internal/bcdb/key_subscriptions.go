@@ -0,0 +1,358 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"sync"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+const (
+	keySubscriptionsListenerName = "keySubscriptions"
+
+	// keySubscriptionBuffer is the number of notifications that a subscription holds for its subscriber. A
+	// subscriber that falls further behind is dropped, as the commits never wait for a subscriber.
+	keySubscriptionBuffer = 256
+)
+
+// keySubscriptions holds the subscriptions to the changes of keys of the data databases. As a block commit listener,
+// it matches the keys written and deleted by every committed block against the subscribed keys and prefixes, and
+// notifies each matching subscription of its keys. The subscribed keys are indexed in a map, and the subscribed
+// prefixes in a trie, per database, hence, matching a key takes a lookup and a walk along the key, regardless of the
+// number of subscriptions.
+type keySubscriptions struct {
+	nodeID          string
+	identityQuerier *identity.Querier
+	signer          crypto.Signer
+	logger          *logger.SugarLogger
+
+	mu  sync.Mutex
+	dbs map[string]*keySubscriptionIndex
+}
+
+type keySubscriptionsConfig struct {
+	nodeID          string
+	identityQuerier *identity.Querier
+	signer          crypto.Signer
+	logger          *logger.SugarLogger
+}
+
+func newKeySubscriptions(conf *keySubscriptionsConfig) *keySubscriptions {
+	return &keySubscriptions{
+		nodeID:          conf.nodeID,
+		identityQuerier: conf.identityQuerier,
+		signer:          conf.signer,
+		logger:          conf.logger,
+		dbs:             make(map[string]*keySubscriptionIndex),
+	}
+}
+
+// keySubscription is a subscription to the changes of keys of a database. The subscriber receives a notification
+// for every committed block that writes or deletes any of the subscribed keys, until it closes the subscription, or
+// until the subscription is dropped, in which case the notifications channel is closed.
+type keySubscription struct {
+	userID        string
+	dbName        string
+	keys          []string
+	prefixes      []string
+	notifications chan *types.KeyChangesResponseEnvelope
+	registry      *keySubscriptions
+	closed        bool
+}
+
+// close removes the subscription. It is called once the subscriber disconnects.
+func (s *keySubscription) close() {
+	s.registry.mu.Lock()
+	defer s.registry.mu.Unlock()
+
+	s.registry.removeLocked(s)
+}
+
+// subscribe registers a subscription of the user to the given keys and key prefixes of the database
+func (k *keySubscriptions) subscribe(userID, dbName string, keys, prefixes []string) (*keySubscription, error) {
+	if worldstate.IsSystemDB(dbName) {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "no user can subscribe to the changes of a system database [" + dbName + "]",
+		}
+	}
+	if len(keys) == 0 && len(prefixes) == 0 {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the subscription holds neither keys nor prefixes"}
+	}
+
+	hasPerm, err := k.identityQuerier.HasReadAccessOnDataDB(userID, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + userID + "] has no permission to read from database [" + dbName + "]",
+		}
+	}
+
+	s := &keySubscription{
+		userID:        userID,
+		dbName:        dbName,
+		keys:          keys,
+		prefixes:      prefixes,
+		notifications: make(chan *types.KeyChangesResponseEnvelope, keySubscriptionBuffer),
+		registry:      k,
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	index, ok := k.dbs[dbName]
+	if !ok {
+		index = newKeySubscriptionIndex()
+		k.dbs[dbName] = index
+	}
+	index.add(s)
+
+	k.logger.Debugf("user [%s] subscribed to %d keys and %d prefixes of database [%s]", userID, len(keys), len(prefixes), dbName)
+	return s, nil
+}
+
+// PostBlockCommitProcessing notifies the subscriptions of the subscribed keys that the block changed. The replayed
+// blocks are ignored, as they were committed before any subscription was made.
+func (k *keySubscriptions) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	if event.IsReplay || event.StateDelta == nil {
+		return nil
+	}
+	blockNum := event.Block.GetHeader().GetBaseHeader().GetNumber()
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if len(k.dbs) == 0 {
+		return nil
+	}
+
+	// the keys of the delta are ordered by database name, hence, the changes of each subscription are in key order
+	changes := make(map[*keySubscription][]*types.KeyStateDelta)
+	var matched []*keySubscription
+	for _, kd := range event.StateDelta.GetKeys() {
+		index, ok := k.dbs[kd.DbName]
+		if !ok {
+			continue
+		}
+		for _, s := range index.match(kd.Key) {
+			if _, ok := changes[s]; !ok {
+				matched = append(matched, s)
+			}
+			changes[s] = append(changes[s], kd)
+		}
+	}
+
+	for _, s := range matched {
+		// the read permission on the database may have been revoked since the subscription was made
+		hasPerm, err := k.identityQuerier.HasReadAccessOnDataDB(s.userID, s.dbName)
+		if err != nil {
+			return err
+		}
+		if !hasPerm {
+			k.logger.Infof("dropping the subscription of user [%s] to database [%s], as the user has no permission to read from it anymore", s.userID, s.dbName)
+			k.removeLocked(s)
+			continue
+		}
+
+		envelope, err := k.notification(s, blockNum, changes[s])
+		if err != nil {
+			return err
+		}
+
+		select {
+		case s.notifications <- envelope:
+		default:
+			k.logger.Warnf("dropping the subscription of user [%s] to database [%s], as the subscriber lags behind by more than %d notifications", s.userID, s.dbName, keySubscriptionBuffer)
+			k.removeLocked(s)
+		}
+	}
+
+	return nil
+}
+
+func (k *keySubscriptions) notification(s *keySubscription, blockNum uint64, deltas []*types.KeyStateDelta) (*types.KeyChangesResponseEnvelope, error) {
+	resp := &types.KeyChangesResponse{
+		Header:      &types.ResponseHeader{NodeId: k.nodeID},
+		DbName:      s.dbName,
+		BlockNumber: blockNum,
+	}
+	for _, kd := range deltas {
+		change := &types.KeyChange{
+			Key:     kd.Key,
+			Deleted: kd.Deleted,
+		}
+		if !kd.Deleted {
+			change.Version = kd.GetMetadata().GetVersion()
+			acl := kd.GetMetadata().GetAccessControl()
+			if acl == nil || acl.ReadUsers[s.userID] || acl.ReadWriteUsers[s.userID] {
+				change.Value = kd.Value
+			} else {
+				change.ValueWithheld = true
+			}
+		}
+		resp.Changes = append(resp.Changes, change)
+	}
+
+	respBytes, err := marshal.DeterministicMarshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := k.signer.Sign(respBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.KeyChangesResponseEnvelope{
+		Response:      resp,
+		Signature:     sig,
+		ResponseBytes: respBytes,
+	}, nil
+}
+
+// closeAll drops all the subscriptions, e.g., once the node stops committing blocks
+func (k *keySubscriptions) closeAll() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for _, index := range k.dbs {
+		for s := range index.all {
+			k.removeLocked(s)
+		}
+	}
+}
+
+func (k *keySubscriptions) removeLocked(s *keySubscription) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.notifications)
+
+	index := k.dbs[s.dbName]
+	index.remove(s)
+	if index.empty() {
+		delete(k.dbs, s.dbName)
+	}
+}
+
+// keySubscriptionIndex indexes the subscriptions to the keys of a single database
+type keySubscriptionIndex struct {
+	keys     map[string]map[*keySubscription]struct{}
+	prefixes *prefixNode
+	all      map[*keySubscription]struct{}
+}
+
+// prefixNode is a node of a trie of the subscribed prefixes. The subscriptions of a node are those to the prefix that
+// leads from the root to the node.
+type prefixNode struct {
+	children      map[byte]*prefixNode
+	subscriptions map[*keySubscription]struct{}
+}
+
+func newKeySubscriptionIndex() *keySubscriptionIndex {
+	return &keySubscriptionIndex{
+		keys:     make(map[string]map[*keySubscription]struct{}),
+		prefixes: &prefixNode{},
+		all:      make(map[*keySubscription]struct{}),
+	}
+}
+
+func (i *keySubscriptionIndex) add(s *keySubscription) {
+	for _, key := range s.keys {
+		subs, ok := i.keys[key]
+		if !ok {
+			subs = make(map[*keySubscription]struct{})
+			i.keys[key] = subs
+		}
+		subs[s] = struct{}{}
+	}
+
+	for _, prefix := range s.prefixes {
+		node := i.prefixes
+		for j := 0; j < len(prefix); j++ {
+			if node.children == nil {
+				node.children = make(map[byte]*prefixNode)
+			}
+			child, ok := node.children[prefix[j]]
+			if !ok {
+				child = &prefixNode{}
+				node.children[prefix[j]] = child
+			}
+			node = child
+		}
+		if node.subscriptions == nil {
+			node.subscriptions = make(map[*keySubscription]struct{})
+		}
+		node.subscriptions[s] = struct{}{}
+	}
+
+	i.all[s] = struct{}{}
+}
+
+func (i *keySubscriptionIndex) remove(s *keySubscription) {
+	for _, key := range s.keys {
+		delete(i.keys[key], s)
+		if len(i.keys[key]) == 0 {
+			delete(i.keys, key)
+		}
+	}
+
+	for _, prefix := range s.prefixes {
+		i.prefixes.remove(prefix, s)
+	}
+
+	delete(i.all, s)
+}
+
+// remove removes the subscription from the node of the prefix, and prunes the nodes left without subscriptions and
+// children. It returns whether the node itself can be pruned.
+func (n *prefixNode) remove(prefix string, s *keySubscription) bool {
+	if len(prefix) == 0 {
+		delete(n.subscriptions, s)
+	} else if child, ok := n.children[prefix[0]]; ok && child.remove(prefix[1:], s) {
+		delete(n.children, prefix[0])
+	}
+
+	return len(n.subscriptions) == 0 && len(n.children) == 0
+}
+
+// match returns the subscriptions to the key, either by the key itself or by any of its prefixes. A subscription is
+// returned once, even if it matches the key more than once.
+func (i *keySubscriptionIndex) match(key string) []*keySubscription {
+	var matched []*keySubscription
+	seen := make(map[*keySubscription]struct{})
+	collect := func(subs map[*keySubscription]struct{}) {
+		for s := range subs {
+			if _, ok := seen[s]; !ok {
+				seen[s] = struct{}{}
+				matched = append(matched, s)
+			}
+		}
+	}
+
+	collect(i.keys[key])
+	node := i.prefixes
+	collect(node.subscriptions)
+	for j := 0; j < len(key) && node != nil; j++ {
+		node = node.children[key[j]]
+		if node != nil {
+			collect(node.subscriptions)
+		}
+	}
+
+	return matched
+}
+
+func (i *keySubscriptionIndex) empty() bool {
+	return len(i.all) == 0
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	crypto_mocks "github.com/hyperledger-labs/orion-server/pkg/crypto/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySubscriptions(t *testing.T) {
+	env := newWorldstateQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+
+	setPrivilege := func(blockNum uint64, access map[string]types.Privilege_Access) {
+		u, err := proto.Marshal(&types.User{Id: "alice", Privilege: &types.Privilege{DbPermission: access}})
+		require.NoError(t, err)
+		require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.UsersDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{Key: string(identity.UserNamespace) + "alice", Value: u},
+				},
+			},
+		}, blockNum))
+	}
+	setPrivilege(1, map[string]types.Privilege_Access{"db1": types.Privilege_Read, "db2": types.Privilege_Read})
+
+	signer := &crypto_mocks.Signer{}
+	signer.On("Sign", mock.Anything).Return([]byte("signature"), nil)
+	k := newKeySubscriptions(&keySubscriptionsConfig{
+		nodeID:          "node1",
+		identityQuerier: identity.NewQuerier(env.db),
+		signer:          signer,
+		logger:          env.q.logger,
+	})
+
+	commit := func(blockNum uint64, keys ...*types.KeyStateDelta) {
+		require.NoError(t, k.PostBlockCommitProcessing(&blockprocessor.CommitEvent{
+			Block:      &types.Block{Header: &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: blockNum}}},
+			StateDelta: &types.StateDelta{StartBlockNum: blockNum, EndBlockNum: blockNum, Keys: keys},
+		}))
+	}
+	write := func(dbName, key string, blockNum uint64) *types.KeyStateDelta {
+		return &types.KeyStateDelta{
+			DbName:   dbName,
+			Key:      key,
+			Value:    []byte(key),
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum}},
+		}
+	}
+	requireNotified := func(s *keySubscription, blockNum uint64, keys ...string) {
+		select {
+		case n := <-s.notifications:
+			require.Equal(t, blockNum, n.GetResponse().GetBlockNumber())
+			var notified []string
+			for _, c := range n.GetResponse().GetChanges() {
+				notified = append(notified, c.GetKey())
+			}
+			require.Equal(t, keys, notified)
+		default:
+			t.Fatalf("no notification of block %d", blockNum)
+		}
+	}
+	requireNoNotification := func(s *keySubscription) {
+		select {
+		case n, ok := <-s.notifications:
+			require.True(t, ok, "the subscription was dropped")
+			t.Fatalf("unexpected notification: %v", n)
+		default:
+		}
+	}
+
+	s1, err := k.subscribe("alice", "db1", nil, []string{"a/", "a/b/"})
+	require.NoError(t, err)
+	s2, err := k.subscribe("alice", "db1", []string{"a/b/c", "z"}, []string{""})
+	require.NoError(t, err)
+	s3, err := k.subscribe("alice", "db2", []string{"a/b/c"}, nil)
+	require.NoError(t, err)
+
+	// a key under two prefixes of a subscription is notified once; the empty prefix matches every key
+	commit(2, write("db1", "a/b/c", 2), write("db1", "a", 2), write("db1", "b/c", 2), write("db2", "x", 2))
+	requireNotified(s1, 2, "a/b/c")
+	requireNotified(s2, 2, "a/b/c", "a", "b/c")
+	requireNoNotification(s3)
+
+	// replayed blocks are not notified
+	require.NoError(t, k.PostBlockCommitProcessing(&blockprocessor.CommitEvent{
+		Block:      &types.Block{Header: &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: 2}}},
+		StateDelta: &types.StateDelta{Keys: []*types.KeyStateDelta{write("db1", "a/b/c", 2)}},
+		IsReplay:   true,
+	}))
+	requireNoNotification(s1)
+	requireNoNotification(s2)
+
+	// a closed subscription is removed from the index, and the trie nodes of its prefixes are pruned
+	s2.close()
+	_, ok := <-s2.notifications
+	require.False(t, ok)
+	require.NotContains(t, k.dbs["db1"].keys, "z")
+	require.Empty(t, k.dbs["db1"].prefixes.subscriptions)
+	s1.close()
+	require.NotContains(t, k.dbs, "db1")
+	s1.close()
+
+	// a subscriber that lags behind is dropped
+	for blockNum := uint64(3); blockNum < 3+keySubscriptionBuffer; blockNum++ {
+		commit(blockNum, write("db2", "a/b/c", blockNum))
+	}
+	require.Len(t, s3.notifications, keySubscriptionBuffer)
+	require.Contains(t, k.dbs, "db2")
+	commit(3+keySubscriptionBuffer, write("db2", "a/b/c", 3+keySubscriptionBuffer))
+	require.Empty(t, k.dbs)
+	for range s3.notifications {
+	}
+
+	// a subscriber whose read permission is revoked is dropped on its next notification
+	s4, err := k.subscribe("alice", "db2", []string{"key"}, nil)
+	require.NoError(t, err)
+	setPrivilege(4+keySubscriptionBuffer, map[string]types.Privilege_Access{"db1": types.Privilege_Read})
+	commit(5+keySubscriptionBuffer, write("db2", "key", 5+keySubscriptionBuffer))
+	_, ok = <-s4.notifications
+	require.False(t, ok)
+	require.Empty(t, k.dbs)
+
+	_, err = k.subscribe("alice", "db2", []string{"key"}, nil)
+	require.EqualError(t, err, "the user [alice] has no permission to read from database [db2]")
+
+	// closing all the subscriptions ends their streams
+	s5, err := k.subscribe("alice", "db1", []string{"key"}, []string{"k"})
+	require.NoError(t, err)
+	k.closeAll()
+	_, ok = <-s5.notifications
+	require.False(t, ok)
+	require.Empty(t, k.dbs)
+}
@@ -4,6 +4,7 @@
 package bcdb
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
@@ -11,6 +12,7 @@ import (
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/internal/mptrie"
 	"github.com/hyperledger-labs/orion-server/internal/mtree"
+	"github.com/hyperledger-labs/orion-server/internal/provenance"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/state"
@@ -22,6 +24,7 @@ type ledgerQueryProcessor struct {
 	db              worldstate.DB
 	blockStore      *blockstore.Store
 	trieStore       mptrie.Store
+	provenanceStore *provenance.Store
 	identityQuerier *identity.Querier
 	logger          *logger.SugarLogger
 }
@@ -30,6 +33,7 @@ type ledgerQueryProcessorConfig struct {
 	db              worldstate.DB
 	blockStore      *blockstore.Store
 	trieStore       mptrie.Store
+	provenanceStore *provenance.Store
 	identityQuerier *identity.Querier
 	logger          *logger.SugarLogger
 }
@@ -39,6 +43,7 @@ func newLedgerQueryProcessor(conf *ledgerQueryProcessorConfig) *ledgerQueryProce
 		db:              conf.db,
 		blockStore:      conf.blockStore,
 		trieStore:       conf.trieStore,
+		provenanceStore: conf.provenanceStore,
 		identityQuerier: conf.identityQuerier,
 		logger:          conf.logger,
 	}
@@ -138,8 +143,14 @@ func (p *ledgerQueryProcessor) getTxProof(userId string, blockNum uint64, txIdx
 	if err != nil {
 		return nil, err
 	}
+	var conflictingReads []*types.ConflictingRead
+	if validationInfo := block.GetHeader().GetValidationInfo(); txIdx < uint64(len(validationInfo)) {
+		conflictingReads = validationInfo[txIdx].GetConflictingReads()
+	}
+
 	return &types.GetTxProofResponse{
-		Hashes: path,
+		Hashes:           path,
+		ConflictingReads: conflictingReads,
 	}, nil
 }
 
@@ -176,15 +187,26 @@ func (p *ledgerQueryProcessor) getDataProof(userId string, blockNum uint64, dbna
 		return nil, err
 	}
 
-	if proof == nil {
-		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("no proof for block %d, db %s, key %s, isDeleted %t found", blockNum, dbname, key, isDeleted)}
+	if proof != nil {
+		return &types.GetDataProofResponse{
+			Path: proof.GetPath(),
+		}, nil
 	}
 
-	resp := &types.GetDataProofResponse{
-		Path: proof.GetPath(),
+	// the absence of a key whose value is queried is proven rather than reported as not found
+	if !isDeleted {
+		nonInclusionProof, err := trie.GetNonInclusionProof(trieKey)
+		if err != nil {
+			return nil, err
+		}
+		if nonInclusionProof != nil {
+			return &types.GetDataProofResponse{
+				NonInclusionPath: nonInclusionProof.GetPath(),
+			}, nil
+		}
 	}
 
-	return resp, nil
+	return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("no proof for block %d, db %s, key %s, isDeleted %t found", blockNum, dbname, key, isDeleted)}
 }
 
 func (p *ledgerQueryProcessor) getTxReceipt(userId string, txId string) (*types.TxReceiptResponse, error) {
@@ -209,12 +231,212 @@ func (p *ledgerQueryProcessor) getTxReceipt(userId string, txId string) (*types.
 
 	return &types.TxReceiptResponse{
 		Receipt: &types.TxReceipt{
-			Header:  blockHeader,
-			TxIndex: txInfo.GetTxIndex(),
+			Header:           blockHeader,
+			TxIndex:          txInfo.GetTxIndex(),
+			WriteSetDigest:   txInfo.GetValidation().GetWriteSetDigest(),
+			ConflictingReads: txInfo.GetValidation().GetConflictingReads(),
+			Sizes:            txInfo.GetSizes(),
 		},
 	}, nil
 }
 
+func (p *ledgerQueryProcessor) verifyTxWriteSetDigest(userId string, txId string) (*types.GetTxWriteSetDigestResponse, error) {
+	if p.provenanceStore == nil {
+		return nil, &interrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
+	}
+
+	hasAccess, err := p.identityQuerier.HasLedgerAccess(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasAccess {
+		return nil, &interrors.PermissionErr{ErrMsg: fmt.Sprintf("user %s has no permission to access the ledger", userId)}
+	}
+
+	txInfo, err := p.blockStore.GetTxInfo(txId)
+	if err != nil {
+		return nil, err
+	}
+
+	if txInfo.GetValidation().GetFlag() != types.Flag_VALID {
+		return nil, &interrors.BadRequestError{ErrMsg: fmt.Sprintf("transaction %s is invalid and has no write-set digest", txId)}
+	}
+
+	committedDigest := txInfo.GetValidation().GetWriteSetDigest()
+	if len(committedDigest) == 0 {
+		return nil, &interrors.BadRequestError{ErrMsg: fmt.Sprintf("transaction %s has no write-set digest", txId)}
+	}
+
+	written, err := p.provenanceStore.GetValuesWrittenByTx(txId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := p.provenanceStore.GetValuesDeletedByTx(txId)
+	if err != nil {
+		return nil, err
+	}
+
+	dbsWrites := make(map[string][]*types.KVWithMetadata)
+	for dbName, kvs := range written {
+		dbsWrites[dbName] = kvs.GetKVs()
+	}
+	dbsDeletes := make(map[string][]*types.KVWithMetadata)
+	for dbName, kvs := range deleted {
+		dbsDeletes[dbName] = kvs.GetKVs()
+	}
+
+	recomputedDigest, err := state.CalculateWriteSetDigest(dbsWrites, dbsDeletes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTxWriteSetDigestResponse{
+		CommittedDigest:  committedDigest,
+		RecomputedDigest: recomputedDigest,
+		Verified:         bytes.Equal(committedDigest, recomputedDigest),
+	}, nil
+}
+
+// getLedgerUsage sums the sizes of the valid transactions submitted by the target user in the blocks from startBlockNum
+// to endBlockNum, both included. The transactions of the user are found in the provenance store, and their sizes in
+// the index of the transactions of the block store, which holds their receipts.
+func (p *ledgerQueryProcessor) getLedgerUsage(querierUserID, targetUserID string, startBlockNum, endBlockNum uint64) (*types.GetLedgerUsageResponse, error) {
+	if p.provenanceStore == nil {
+		return nil, &interrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
+	}
+
+	if startBlockNum < 1 {
+		return nil, &interrors.BadRequestError{ErrMsg: "start block number must be >=1"}
+	}
+	if endBlockNum < startBlockNum {
+		return nil, &interrors.BadRequestError{ErrMsg: fmt.Sprintf("the end block %d is before the start block %d", endBlockNum, startBlockNum)}
+	}
+
+	isAdmin, err := p.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && querierUserID != targetUserID {
+		return nil, &interrors.PermissionErr{
+			ErrMsg: fmt.Sprintf("user %s is neither an admin nor querying its own usage of the ledger, only an admin can query the usage of user %s", querierUserID, targetUserID),
+		}
+	}
+
+	txIDs, err := p.provenanceStore.GetTxIDsSubmittedByUser(targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &types.GetLedgerUsageResponse{
+		TargetUserId:     targetUserID,
+		StartBlockNumber: startBlockNum,
+		EndBlockNumber:   endBlockNum,
+	}
+	for _, txID := range txIDs {
+		txInfo, err := p.blockStore.GetTxInfo(txID)
+		if err != nil {
+			return nil, err
+		}
+		if txInfo.GetBlockNumber() < startBlockNum || txInfo.GetBlockNumber() > endBlockNum ||
+			txInfo.GetValidation().GetFlag() != types.Flag_VALID {
+			continue
+		}
+
+		usage.Transactions++
+		sizes := txInfo.GetSizes()
+		if sizes == nil {
+			usage.UnsizedTransactions++
+			continue
+		}
+		usage.EnvelopeBytes += sizes.GetEnvelopeBytes()
+		usage.WriteBytes += sizes.GetWriteBytes()
+	}
+
+	return usage, nil
+}
+
+// getKeyBlocks finds the blocks which may have modified the key of the database, from startBlockNum to endBlockNum,
+// both included, by scanning the key filters of the blocks alone. As the candidates reveal when the key was modified,
+// the querier must be able to read the database.
+func (p *ledgerQueryProcessor) getKeyBlocks(userId, dbName, key string, startBlockNum, endBlockNum uint64) (*types.GetKeyBlocksResponse, error) {
+	if startBlockNum < 1 {
+		return nil, &interrors.BadRequestError{ErrMsg: "start block number must be >=1"}
+	}
+	if endBlockNum < startBlockNum {
+		return nil, &interrors.BadRequestError{ErrMsg: fmt.Sprintf("the end block %d is before the start block %d", endBlockNum, startBlockNum)}
+	}
+
+	hasAccess, err := p.identityQuerier.HasLedgerAccess(userId)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAccess {
+		return nil, &interrors.PermissionErr{ErrMsg: fmt.Sprintf("user %s has no permission to access the ledger", userId)}
+	}
+
+	isAdmin, err := p.identityQuerier.HasAdministrationPrivilege(userId)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		if worldstate.IsSystemDB(dbName) {
+			return nil, &interrors.PermissionErr{
+				ErrMsg: fmt.Sprintf("user %s is not an admin, only an admin can find the blocks which modified a key of system database %s", userId, dbName),
+			}
+		}
+		canRead, err := p.identityQuerier.HasReadAccessOnDataDB(userId, dbName)
+		if err != nil {
+			return nil, err
+		}
+		if !canRead {
+			return nil, &interrors.PermissionErr{ErrMsg: fmt.Sprintf("user %s has no permission to read from database %s", userId, dbName)}
+		}
+	}
+
+	candidates, err := p.blockStore.GetKeyBlockCandidates(dbName, key, startBlockNum, endBlockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetKeyBlocksResponse{
+		DbName:           dbName,
+		Key:              key,
+		StartBlockNumber: startBlockNum,
+		EndBlockNumber:   endBlockNum,
+		Candidates:       candidates,
+	}, nil
+}
+
+func (p *ledgerQueryProcessor) getBlockComposition(userId string, blockNum uint64) (*types.GetBlockCompositionResponse, error) {
+	isAdmin, err := p.identityQuerier.HasAdministrationPrivilege(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		return nil, &interrors.PermissionErr{ErrMsg: fmt.Sprintf("user %s has no permission to access the batch composition of a block", userId)}
+	}
+
+	height, err := p.blockStore.Height()
+	if err != nil {
+		return nil, err
+	}
+	if blockNum == 0 || blockNum > height {
+		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("block not found: %d", blockNum)}
+	}
+
+	composition, err := p.blockStore.GetBatchComposition(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetBlockCompositionResponse{
+		Composition: composition,
+	}, nil
+}
+
 func (p *ledgerQueryProcessor) calculateProof(block *types.Block, txIdx uint64) ([][]byte, error) {
 	root, err := mtree.BuildTreeForBlockTx(block)
 	if err != nil {
@@ -23,8 +23,10 @@ import (
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/stretchr/testify/require"
@@ -123,6 +125,7 @@ func newLedgerProcessorTestEnv(t *testing.T) *ledgerProcessorTestEnv {
 		db:              db,
 		blockStore:      blockStore,
 		trieStore:       trieStore,
+		provenanceStore: provenanceStore,
 		identityQuerier: identity.NewQuerier(db),
 		logger:          logger,
 	}
@@ -224,6 +227,13 @@ func setup(t *testing.T, env *ledgerProcessorTestEnv, blocksNum int) {
 			value = append(value, []byte(fmt.Sprintf("value_%d_%d", j, i)))
 		}
 		block := createSampleBlock(i, key, value)
+		for txNum, tx := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			block.Header.ValidationInfo[txNum].WriteSetDigest, err = blockprocessor.CalculateWriteSetDigestForDataTx(nil,
+				tx.GetPayload(),
+				&types.Version{BlockNum: i, TxNum: uint64(txNum)},
+			)
+			require.NoError(t, err)
+		}
 		require.NoError(t, env.p.blockStore.AddSkipListLinks(block))
 		root, err := mtree.BuildTreeForBlockTx(block)
 		require.NoError(t, err)
@@ -233,6 +243,7 @@ func setup(t *testing.T, env *ledgerProcessorTestEnv, blocksNum int) {
 		block.Header.StateMerkelTreeRootHash, err = trie.Hash()
 		require.NoError(t, err)
 		require.NoError(t, env.p.blockStore.Commit(block))
+		require.NoError(t, env.p.provenanceStore.Commit(i, createProvenanceDataFromBlock(block)))
 
 		err = trie.Commit(block.GetHeader().GetBaseHeader().GetNumber())
 		require.NoError(t, err)
@@ -309,6 +320,7 @@ func constructProvenanceEntriesForDataTx(tx *types.DataTx, version *types.Versio
 
 	for i, ops := range tx.DbOperations {
 		txpData[i] = &provenance.TxDataForProvenance{
+			IsValid:            true,
 			DBName:             ops.DbName,
 			UserID:             tx.MustSignUserIds[0],
 			TxID:               tx.TxId,
@@ -752,14 +764,12 @@ func TestGetDataProof(t *testing.T) {
 			expectedErr: nil,
 		},
 		{
-			name:        "get for non exist key",
+			name:        "get for non exist key with isDeleted true",
 			blockNumber: 95,
 			key:         "keyyyy13",
-			value:       []byte(fmt.Sprintf("value_%d_%d", 13, 5)),
-			isDeleted:   false,
-			isValid:     false,
+			isDeleted:   true,
 			user:        "testUser",
-			expectedErr: &interrors.NotFoundErr{Message: "no proof for block 95, db bdb, key keyyyy13, isDeleted false found"},
+			expectedErr: &interrors.NotFoundErr{Message: "no proof for block 95, db bdb, key keyyyy13, isDeleted true found"},
 		},
 		{
 			name:        "get proof from block 515 - not exist",
@@ -799,6 +809,62 @@ func TestGetDataProof(t *testing.T) {
 	}
 }
 
+func TestGetDataProofForAbsentKey(t *testing.T) {
+	env := newLedgerProcessorTestEnv(t)
+	defer env.cleanup(t)
+	setup(t, env, 100)
+
+	testCases := []struct {
+		name        string
+		blockNumber uint64
+		key         string
+	}{
+		{
+			name:        "non exist key",
+			blockNumber: 95,
+			key:         "keyyyy13",
+		},
+		{
+			name:        "key written after the block",
+			blockNumber: 5,
+			key:         "key13",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			proof, err := env.p.getDataProof("testUser", testCase.blockNumber, worldstate.DefaultDBName, testCase.key, false)
+			require.NoError(t, err)
+			require.Empty(t, proof.GetPath())
+			require.NotEmpty(t, proof.GetNonInclusionPath())
+
+			trieKey, err := state.ConstructCompositeKey(worldstate.DefaultDBName, testCase.key)
+			require.NoError(t, err)
+			isValid, err := state.NewNonInclusionProof(proof.GetNonInclusionPath()).Verify(trieKey, env.blocks[testCase.blockNumber-1].StateMerkelTreeRootHash)
+			require.NoError(t, err)
+			require.True(t, isValid)
+
+			// the proof does not hold against the state of another block
+			isValid, err = state.NewNonInclusionProof(proof.GetNonInclusionPath()).Verify(trieKey, env.blocks[97].StateMerkelTreeRootHash)
+			require.NoError(t, err)
+			require.False(t, isValid)
+		})
+	}
+
+	t.Run("present key", func(t *testing.T) {
+		proof, err := env.p.getDataProof("testUser", 95, worldstate.DefaultDBName, "key13", false)
+		require.NoError(t, err)
+		require.NotEmpty(t, proof.GetPath())
+		require.Empty(t, proof.GetNonInclusionPath())
+
+		trieKey, err := state.ConstructCompositeKey(worldstate.DefaultDBName, "key13")
+		require.NoError(t, err)
+		isValid, err := state.NewNonInclusionProof(proof.GetPath()).Verify(trieKey, env.blocks[94].StateMerkelTreeRootHash)
+		require.NoError(t, err)
+		require.False(t, isValid)
+	})
+}
+
 func TestGetTxReceipt(t *testing.T) {
 	env := newLedgerProcessorTestEnv(t)
 	defer env.cleanup(t)
@@ -864,6 +930,8 @@ func TestGetTxReceipt(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tt.txIndex, receipt.GetReceipt().GetTxIndex())
 				require.True(t, proto.Equal(env.blocks[tt.blockNumber-1], receipt.GetReceipt().GetHeader()))
+				require.NotEmpty(t, receipt.GetReceipt().GetWriteSetDigest())
+				require.Equal(t, env.blocks[tt.blockNumber-1].ValidationInfo[tt.txIndex].WriteSetDigest, receipt.GetReceipt().GetWriteSetDigest())
 			} else {
 				require.Error(t, err)
 				require.EqualError(t, err, tt.expectedErr.Error())
@@ -873,6 +941,405 @@ func TestGetTxReceipt(t *testing.T) {
 	}
 }
 
+func TestGetTxReceiptAndProofWithConflictingReads(t *testing.T) {
+	env := newLedgerProcessorTestEnv(t)
+	defer env.cleanup(t)
+	setup(t, env, 5)
+
+	conflictingReads := []*types.ConflictingRead{
+		{
+			DbName:          worldstate.DefaultDBName,
+			Key:             "key0",
+			ExpectedVersion: &types.Version{BlockNum: 3, TxNum: 0},
+			ActualVersion:   &types.Version{BlockNum: 5, TxNum: 0},
+		},
+		{
+			DbName:          worldstate.DefaultDBName,
+			Key:             "key1",
+			ExpectedVersion: &types.Version{BlockNum: 2, TxNum: 1},
+			ActualVersion:   &types.Version{BlockNum: 4, TxNum: 1},
+		},
+		{
+			DbName:          worldstate.DefaultDBName,
+			Key:             "key7",
+			ExpectedVersion: &types.Version{BlockNum: 4, TxNum: 7},
+		},
+	}
+
+	block := createSampleBlock(5, []string{"key0", "key1"}, [][]byte{[]byte("value_0_5"), []byte("value_1_5")})
+	block.Header.ValidationInfo[1] = &types.ValidationInfo{
+		Flag:             types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+		ReasonIfInvalid:  "mvcc conflict has occurred within the block for the key [key0] in database [" + worldstate.DefaultDBName + "]",
+		ConflictingReads: conflictingReads,
+	}
+	require.NoError(t, env.p.blockStore.AddSkipListLinks(block))
+	root, err := mtree.BuildTreeForBlockTx(block)
+	require.NoError(t, err)
+	block.Header.TxMerkelTreeRootHash = root.Hash()
+	require.NoError(t, env.p.blockStore.Commit(block))
+
+	receipt, err := env.p.getTxReceipt("testUser", "Tx5key1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), receipt.GetReceipt().GetTxIndex())
+	require.Len(t, receipt.GetReceipt().GetConflictingReads(), len(conflictingReads))
+	for i, r := range conflictingReads {
+		require.True(t, proto.Equal(r, receipt.GetReceipt().GetConflictingReads()[i]))
+	}
+
+	receipt, err = env.p.getTxReceipt("testUser", "Tx5key0")
+	require.NoError(t, err)
+	require.Empty(t, receipt.GetReceipt().GetConflictingReads())
+
+	txProof, err := env.p.getTxProof("testUser", 5, 1)
+	require.NoError(t, err)
+	require.Len(t, txProof.GetConflictingReads(), len(conflictingReads))
+	for i, r := range conflictingReads {
+		require.True(t, proto.Equal(r, txProof.GetConflictingReads()[i]))
+	}
+
+	txProof, err = env.p.getTxProof("testUser", 5, 0)
+	require.NoError(t, err)
+	require.Empty(t, txProof.GetConflictingReads())
+}
+
+func TestGetTxReceiptAndProofOfAttestation(t *testing.T) {
+	env := newLedgerProcessorTestEnv(t)
+	defer env.cleanup(t)
+	setup(t, env, 5)
+
+	block := createSampleBlock(5, []string{"key0"}, [][]byte{[]byte("value_0_5")})
+	block.Header.BaseHeader.RulesVersion = constants.AttestationRulesVersion
+	attestation := &types.DataTxEnvelope{
+		Payload: &types.DataTx{
+			MustSignUserIds:    []string{"testUser"},
+			TxId:               "attestation1",
+			DbOperations:       []*types.DBOperation{{DbName: worldstate.DefaultDBName}},
+			AttestationPayload: []byte("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"),
+		},
+	}
+	block.GetDataTxEnvelopes().Envelopes = append(block.GetDataTxEnvelopes().Envelopes, attestation)
+	block.Header.ValidationInfo = append(block.Header.ValidationInfo, &types.ValidationInfo{Flag: types.Flag_VALID})
+	require.NoError(t, env.p.blockStore.AddSkipListLinks(block))
+	root, err := mtree.BuildTreeForBlockTx(block)
+	require.NoError(t, err)
+	block.Header.TxMerkelTreeRootHash = root.Hash()
+	require.NoError(t, env.p.blockStore.Commit(block))
+
+	receipt, err := env.p.getTxReceipt("testUser", "attestation1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), receipt.GetReceipt().GetTxIndex())
+	require.Equal(t, uint64(5), receipt.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+	require.Equal(t, block.Header.TxMerkelTreeRootHash, receipt.GetReceipt().GetHeader().GetTxMerkelTreeRootHash())
+
+	txHash := func(txEnv *types.DataTxEnvelope) []byte {
+		txBytes, err := json.Marshal(txEnv)
+		require.NoError(t, err)
+		valInfoBytes, err := json.Marshal(block.Header.ValidationInfo[1])
+		require.NoError(t, err)
+		hash, err := crypto.ComputeSHA256Hash(append(txBytes, valInfoBytes...))
+		require.NoError(t, err)
+		return hash
+	}
+
+	txProof, err := env.p.getTxProof("testUser", 5, 1)
+	require.NoError(t, err)
+	require.Equal(t, txHash(attestation), txProof.GetHashes()[0])
+	currRoot := txProof.GetHashes()[0]
+	for _, h := range txProof.GetHashes()[1:] {
+		currRoot, err = crypto.ConcatenateHashes(currRoot, h)
+		require.NoError(t, err)
+	}
+	require.Equal(t, receipt.GetReceipt().GetHeader().GetTxMerkelTreeRootHash(), currRoot)
+
+	// the proof binds the attested payload, hence, it does not prove another payload
+	tampered := proto.Clone(attestation).(*types.DataTxEnvelope)
+	tampered.Payload.AttestationPayload = []byte("sha256:0000000000000000000000000000000000000000000000000000000000000000")
+	require.NotEqual(t, txHash(tampered), txProof.GetHashes()[0])
+}
+
+func TestVerifyTxWriteSetDigest(t *testing.T) {
+	t.Run("digest matches the provenance store", func(t *testing.T) {
+		env := newLedgerProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env, 10)
+
+		receipt, err := env.p.getTxReceipt("testUser", "Tx5key3")
+		require.NoError(t, err)
+		require.NotEmpty(t, receipt.GetReceipt().GetWriteSetDigest())
+
+		resp, err := env.p.verifyTxWriteSetDigest("testUser", "Tx5key3")
+		require.NoError(t, err)
+		require.True(t, resp.GetVerified())
+		require.Equal(t, receipt.GetReceipt().GetWriteSetDigest(), resp.GetCommittedDigest())
+		require.Equal(t, receipt.GetReceipt().GetWriteSetDigest(), resp.GetRecomputedDigest())
+	})
+
+	t.Run("tampered provenance store fails verification", func(t *testing.T) {
+		env := newLedgerProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env, 10)
+
+		receipt, err := env.p.getTxReceipt("testUser", "Tx5key3")
+		require.NoError(t, err)
+		digest := receipt.GetReceipt().GetWriteSetDigest()
+
+		tamperedWrite := &provenance.TxDataForProvenance{
+			IsValid: true,
+			DBName:  worldstate.DefaultDBName,
+			UserID:  "testUser",
+			TxID:    "Tx5key3",
+			Writes: []*types.KVWithMetadata{
+				{
+					Key:   "key3",
+					Value: []byte("tampered-value"),
+					Metadata: &types.Metadata{
+						Version: &types.Version{
+							BlockNum: 5,
+							TxNum:    3,
+						},
+					},
+				},
+			},
+			OldVersionOfWrites: make(map[string]*types.Version),
+		}
+		require.NoError(t, env.p.provenanceStore.Commit(5, []*provenance.TxDataForProvenance{tamperedWrite}))
+
+		resp, err := env.p.verifyTxWriteSetDigest("testUser", "Tx5key3")
+		require.NoError(t, err)
+		require.False(t, resp.GetVerified())
+		require.Equal(t, digest, resp.GetCommittedDigest())
+		require.NotEqual(t, digest, resp.GetRecomputedDigest())
+	})
+
+	t.Run("delete missing from the digest fails verification", func(t *testing.T) {
+		env := newLedgerProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env, 10)
+
+		receipt, err := env.p.getTxReceipt("testUser", "Tx5key3")
+		require.NoError(t, err)
+		digest := receipt.GetReceipt().GetWriteSetDigest()
+
+		tamperedDelete := &provenance.TxDataForProvenance{
+			IsValid: true,
+			DBName:  worldstate.DefaultDBName,
+			UserID:  "testUser",
+			TxID:    "Tx5key3",
+			Deletes: map[string]*types.Version{
+				"key1": {
+					BlockNum: 4,
+					TxNum:    1,
+				},
+			},
+			OldVersionOfWrites: make(map[string]*types.Version),
+		}
+		require.NoError(t, env.p.provenanceStore.Commit(5, []*provenance.TxDataForProvenance{tamperedDelete}))
+
+		resp, err := env.p.verifyTxWriteSetDigest("testUser", "Tx5key3")
+		require.NoError(t, err)
+		require.False(t, resp.GetVerified())
+		require.Equal(t, digest, resp.GetCommittedDigest())
+		require.NotEqual(t, digest, resp.GetRecomputedDigest())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		env := newLedgerProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env, 10)
+
+		resp, err := env.p.verifyTxWriteSetDigest("nonExistUser", "Tx5key3")
+		require.EqualError(t, err, "user nonExistUser has no permission to access the ledger")
+		require.IsType(t, &interrors.PermissionErr{}, err)
+		require.Nil(t, resp)
+
+		resp, err = env.p.verifyTxWriteSetDigest("testUser", "Tx15key20")
+		require.EqualError(t, err, "txID not found: Tx15key20")
+		require.IsType(t, &interrors.NotFoundErr{}, err)
+		require.Nil(t, resp)
+
+		env.p.provenanceStore = nil
+		resp, err = env.p.verifyTxWriteSetDigest("testUser", "Tx5key3")
+		require.EqualError(t, err, "provenance store is disabled on this server")
+		require.IsType(t, &interrors.ServerRestrictionError{}, err)
+		require.Nil(t, resp)
+	})
+}
+
+func TestGetLedgerUsage(t *testing.T) {
+	env := newLedgerProcessorTestEnv(t)
+	defer env.cleanup(t)
+	// blocks 2 to 4 hold the transactions of testUser, which were committed before the sizes were recorded
+	setup(t, env, 5)
+
+	var users []*worldstate.KVWithMetadata
+	for _, user := range []*types.User{
+		{Id: "admin1", Privilege: &types.Privilege{Admin: true}},
+		{Id: "alice"},
+		{Id: "bob"},
+	} {
+		u, err := proto.Marshal(user)
+		require.NoError(t, err)
+		users = append(users, &worldstate.KVWithMetadata{
+			Key:      string(identity.UserNamespace) + user.Id,
+			Value:    u,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: 4}},
+		})
+	}
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{worldstate.UsersDBName: {Writes: users}}, 4))
+
+	// blocks 5 to 9 hold the transactions of alice and bob, every third of which is invalid
+	for blockNum := uint64(5); blockNum < 10; blockNum++ {
+		var keys []string
+		var values [][]byte
+		for i := uint64(0); i < blockNum-2; i++ {
+			keys = append(keys, fmt.Sprintf("key%d", i))
+			values = append(values, []byte(fmt.Sprintf("value_%d_%d", i, blockNum*i)))
+		}
+		block := createSampleBlock(blockNum, keys, values)
+
+		var txSizes []*types.TxSizes
+		var provenanceData []*provenance.TxDataForProvenance
+		for txNum, txEnv := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			tx := txEnv.GetPayload()
+			tx.MustSignUserIds = []string{"alice", "bob"}[txNum%2 : txNum%2+1]
+			valid := txNum%3 != 2
+			if !valid {
+				block.Header.ValidationInfo[txNum].Flag = types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE
+			}
+
+			envBytes, err := marshal.DeterministicMarshal(txEnv)
+			require.NoError(t, err)
+			sizes := &types.TxSizes{EnvelopeBytes: uint64(len(envBytes))}
+			if valid {
+				write := tx.GetDbOperations()[0].GetDataWrites()[0]
+				sizes.WriteBytes = uint64(len(write.Key) + len(write.Value))
+			}
+			txSizes = append(txSizes, sizes)
+			provenanceData = append(provenanceData, &provenance.TxDataForProvenance{
+				IsValid: valid,
+				DBName:  worldstate.DefaultDBName,
+				UserID:  tx.MustSignUserIds[0],
+				TxID:    tx.TxId,
+			})
+		}
+
+		require.NoError(t, env.p.blockStore.AddSkipListLinks(block))
+		root, err := mtree.BuildTreeForBlockTx(block)
+		require.NoError(t, err)
+		block.Header.TxMerkelTreeRootHash = root.Hash()
+		prepared, err := blockstore.PrepareBlock(block)
+		require.NoError(t, err)
+		prepared.SetTxSizes(txSizes)
+		require.NoError(t, env.p.blockStore.CommitPrepared(prepared, nil))
+		require.NoError(t, env.p.provenanceStore.Commit(blockNum, provenanceData))
+	}
+
+	// the sizes of the transactions of a block sum to the sizes of the block
+	for blockNum := uint64(5); blockNum < 10; blockNum++ {
+		block, err := env.p.blockStore.Get(blockNum)
+		require.NoError(t, err)
+		rawBlock, err := env.p.blockStore.GetRaw(blockNum)
+		require.NoError(t, err)
+
+		var envelopeBytes, writeBytes uint64
+		envelopes := block.GetDataTxEnvelopes().GetEnvelopes()
+		for _, txEnv := range envelopes {
+			receipt, err := env.p.getTxReceipt("admin1", txEnv.GetPayload().GetTxId())
+			require.NoError(t, err)
+			sizes := receipt.GetReceipt().GetSizes()
+			require.NotNil(t, sizes)
+			require.Equal(t, uint64(len(envelopes)), sizes.GetBlockTxCount())
+			require.Equal(t, uint64(len(rawBlock)), sizes.GetBlockBytes())
+			envelopeBytes += sizes.GetEnvelopeBytes()
+			writeBytes += sizes.GetWriteBytes()
+		}
+		for _, txEnv := range envelopes {
+			txInfo, err := env.p.blockStore.GetTxInfo(txEnv.GetPayload().GetTxId())
+			require.NoError(t, err)
+			require.Equal(t, envelopeBytes, txInfo.GetSizes().GetBlockEnvelopeBytes())
+			require.Equal(t, writeBytes, txInfo.GetSizes().GetBlockWriteBytes())
+		}
+	}
+
+	// bruteForceUsage sums the sizes of the valid transactions of the user by walking the blocks
+	bruteForceUsage := func(userID string, startBlockNum, endBlockNum uint64) *types.GetLedgerUsageResponse {
+		usage := &types.GetLedgerUsageResponse{
+			TargetUserId:     userID,
+			StartBlockNumber: startBlockNum,
+			EndBlockNumber:   endBlockNum,
+		}
+		for blockNum := startBlockNum; blockNum <= endBlockNum && blockNum < 10; blockNum++ {
+			block, err := env.p.blockStore.Get(blockNum)
+			require.NoError(t, err)
+			for txNum, txEnv := range block.GetDataTxEnvelopes().GetEnvelopes() {
+				tx := txEnv.GetPayload()
+				if tx.GetMustSignUserIds()[0] != userID || block.GetHeader().GetValidationInfo()[txNum].GetFlag() != types.Flag_VALID {
+					continue
+				}
+				usage.Transactions++
+				if blockNum < 5 {
+					usage.UnsizedTransactions++
+					continue
+				}
+				envBytes, err := marshal.DeterministicMarshal(txEnv)
+				require.NoError(t, err)
+				usage.EnvelopeBytes += uint64(len(envBytes))
+				for _, ops := range tx.GetDbOperations() {
+					for _, write := range ops.GetDataWrites() {
+						usage.WriteBytes += uint64(len(write.Key) + len(write.Value))
+					}
+				}
+			}
+		}
+		return usage
+	}
+
+	testCases := []struct {
+		querier    string
+		target     string
+		start, end uint64
+	}{
+		{querier: "alice", target: "alice", start: 1, end: 9},
+		{querier: "bob", target: "bob", start: 1, end: 100},
+		{querier: "admin1", target: "alice", start: 6, end: 8},
+		{querier: "admin1", target: "bob", start: 9, end: 9},
+		{querier: "testUser", target: "testUser", start: 1, end: 9},
+		{querier: "testUser", target: "testUser", start: 3, end: 6},
+		{querier: "admin1", target: "admin1", start: 1, end: 9},
+	}
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%s queries %s from %d to %d", tt.querier, tt.target, tt.start, tt.end), func(t *testing.T) {
+			usage, err := env.p.getLedgerUsage(tt.querier, tt.target, tt.start, tt.end)
+			require.NoError(t, err)
+			expected := bruteForceUsage(tt.target, tt.start, tt.end)
+			require.True(t, proto.Equal(expected, usage), "expected: %v, actual: %v", expected, usage)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		usage, err := env.p.getLedgerUsage("alice", "bob", 1, 9)
+		require.EqualError(t, err, "user alice is neither an admin nor querying its own usage of the ledger, only an admin can query the usage of user bob")
+		require.IsType(t, &interrors.PermissionErr{}, err)
+		require.Nil(t, usage)
+
+		usage, err = env.p.getLedgerUsage("alice", "alice", 0, 9)
+		require.EqualError(t, err, "start block number must be >=1")
+		require.IsType(t, &interrors.BadRequestError{}, err)
+		require.Nil(t, usage)
+
+		usage, err = env.p.getLedgerUsage("alice", "alice", 6, 5)
+		require.EqualError(t, err, "the end block 5 is before the start block 6")
+		require.IsType(t, &interrors.BadRequestError{}, err)
+		require.Nil(t, usage)
+
+		env.p.provenanceStore = nil
+		usage, err = env.p.getLedgerUsage("alice", "alice", 1, 9)
+		require.EqualError(t, err, "provenance store is disabled on this server")
+		require.IsType(t, &interrors.ServerRestrictionError{}, err)
+		require.Nil(t, usage)
+	})
+}
+
 func generateCrypto(t *testing.T) ([]byte, []byte) {
 	rootCAPemCert, caPrivKey, err := testutils.GenerateRootCA("BCDB RootCA", "127.0.0.1")
 	require.NoError(t, err)
@@ -890,3 +1357,103 @@ func generateCrypto(t *testing.T) ([]byte, []byte) {
 	require.NoError(t, err)
 	return instCertPem, adminCertPem
 }
+
+func TestGetKeyBlocks(t *testing.T) {
+	env := newLedgerProcessorTestEnv(t)
+	defer env.cleanup(t)
+	// block i, from 2 to 5, writes key0 to key{i-1} of the default database
+	setup(t, env, 6)
+
+	var users []*worldstate.KVWithMetadata
+	for _, user := range []*types.User{
+		{Id: "admin1", Privilege: &types.Privilege{Admin: true}},
+		{Id: "bob"},
+	} {
+		u, err := proto.Marshal(user)
+		require.NoError(t, err)
+		users = append(users, &worldstate.KVWithMetadata{
+			Key:      string(identity.UserNamespace) + user.Id,
+			Value:    u,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: 5}},
+		})
+	}
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{worldstate.UsersDBName: {Writes: users}}, 5))
+
+	// the blocks from 3 on are filtered
+	for blockNum := uint64(3); blockNum <= 5; blockNum++ {
+		delta := &types.StateDelta{}
+		for j := uint64(0); j < blockNum; j++ {
+			delta.Keys = append(delta.Keys, &types.KeyStateDelta{DbName: worldstate.DefaultDBName, Key: fmt.Sprintf("key%d", j)})
+		}
+		require.NoError(t, env.p.blockStore.CommitKeyFilter(blockNum, blockstore.NewKeyFilter(delta)))
+	}
+
+	blockNumbers := func(response *types.GetKeyBlocksResponse) (filtered, unfiltered []uint64) {
+		for _, c := range response.GetCandidates() {
+			if c.GetUnfiltered() {
+				require.Equal(t, float64(1), c.GetFalsePositiveProbability())
+				unfiltered = append(unfiltered, c.GetBlockNumber())
+				continue
+			}
+			require.Less(t, c.GetFalsePositiveProbability(), 0.02)
+			filtered = append(filtered, c.GetBlockNumber())
+		}
+		return filtered, unfiltered
+	}
+
+	testCases := []struct {
+		querier            string
+		dbName             string
+		key                string
+		start, end         uint64
+		expectedFiltered   []uint64
+		expectedUnfiltered []uint64
+	}{
+		{querier: "testUser", dbName: worldstate.DefaultDBName, key: "key0", start: 1, end: 5, expectedFiltered: []uint64{3, 4, 5}, expectedUnfiltered: []uint64{1, 2}},
+		{querier: "testUser", dbName: worldstate.DefaultDBName, key: "key3", start: 2, end: 5, expectedFiltered: []uint64{4, 5}, expectedUnfiltered: []uint64{2}},
+		{querier: "testUser", dbName: worldstate.DefaultDBName, key: "key4", start: 3, end: 4},
+		{querier: "admin1", dbName: worldstate.DefaultDBName, key: "key4", start: 3, end: 5, expectedFiltered: []uint64{5}},
+		{querier: "admin1", dbName: worldstate.UsersDBName, key: "key0", start: 3, end: 5},
+	}
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%s queries %s in %s from %d to %d", tt.querier, tt.key, tt.dbName, tt.start, tt.end), func(t *testing.T) {
+			response, err := env.p.getKeyBlocks(tt.querier, tt.dbName, tt.key, tt.start, tt.end)
+			require.NoError(t, err)
+			require.Equal(t, tt.dbName, response.GetDbName())
+			require.Equal(t, tt.key, response.GetKey())
+			require.Equal(t, tt.start, response.GetStartBlockNumber())
+			require.Equal(t, tt.end, response.GetEndBlockNumber())
+
+			filtered, unfiltered := blockNumbers(response)
+			require.Equal(t, tt.expectedFiltered, filtered)
+			require.Equal(t, tt.expectedUnfiltered, unfiltered)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		response, err := env.p.getKeyBlocks("bob", worldstate.DefaultDBName, "key0", 1, 5)
+		require.EqualError(t, err, "user bob has no permission to read from database "+worldstate.DefaultDBName)
+		require.IsType(t, &interrors.PermissionErr{}, err)
+		require.Nil(t, response)
+
+		response, err = env.p.getKeyBlocks("testUser", worldstate.UsersDBName, "key0", 1, 5)
+		require.EqualError(t, err, "user testUser is not an admin, only an admin can find the blocks which modified a key of system database "+worldstate.UsersDBName)
+		require.IsType(t, &interrors.PermissionErr{}, err)
+		require.Nil(t, response)
+
+		response, err = env.p.getKeyBlocks("testUser", worldstate.DefaultDBName, "key0", 0, 5)
+		require.EqualError(t, err, "start block number must be >=1")
+		require.IsType(t, &interrors.BadRequestError{}, err)
+		require.Nil(t, response)
+
+		response, err = env.p.getKeyBlocks("testUser", worldstate.DefaultDBName, "key0", 4, 3)
+		require.EqualError(t, err, "the end block 3 is before the start block 4")
+		require.IsType(t, &interrors.BadRequestError{}, err)
+		require.Nil(t, response)
+
+		response, err = env.p.getKeyBlocks("testUser", worldstate.DefaultDBName, "key0", 4, 6)
+		require.EqualError(t, err, "block not found: 6")
+		require.IsType(t, &interrors.NotFoundErr{}, err)
+		require.Nil(t, response)
+	})
+}
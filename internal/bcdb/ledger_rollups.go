@@ -0,0 +1,277 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	ledgerRollupsListenerName = "ledgerRollups"
+
+	rollupDateLayout = "2006-01-02"
+	rollupDay        = 24 * time.Hour
+)
+
+// ledgerRollups maintains the daily rollups of the ledger in the system database. As a durable block commit listener,
+// it adds each committed block, in order, to the rollup of the day its timestamp falls on, hence, the rollups are the
+// same on every node. The rollup height is the height the listener records: the blocks above it, i.e., all the blocks
+// committed before the rollups were first recorded, those committed while the node did not record them, and those
+// the listener failed to add, are read back from the block store.
+type ledgerRollups struct {
+	db         *leveldb.LevelDB
+	blockStore *blockstore.Store
+	logger     *logger.SugarLogger
+
+	mu     sync.Mutex
+	height uint64
+}
+
+type ledgerRollupsConfig struct {
+	db         *leveldb.LevelDB
+	blockStore *blockstore.Store
+	logger     *logger.SugarLogger
+}
+
+// newLedgerRollups derives the rollups of the blocks that are committed but not yet aggregated. It must be called
+// before the listener is registered with the block processor.
+func newLedgerRollups(conf *ledgerRollupsConfig) (*ledgerRollups, error) {
+	r := &ledgerRollups{
+		db:         conf.db,
+		blockStore: conf.blockStore,
+		logger:     conf.logger,
+	}
+
+	height, recorded, err := r.db.GetRollupHeight()
+	if err != nil {
+		return nil, err
+	}
+	r.height = height
+
+	ledgerHeight, err := r.blockStore.Height()
+	if err != nil {
+		return nil, err
+	}
+	if !recorded {
+		r.logger.Infof("deriving the daily rollups of the ledger from the %d blocks already committed", ledgerHeight)
+	}
+	if err := r.catchUp(ledgerHeight); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// PostBlockCommitProcessing adds the block to the rollups. A block at or below the rollup height was already added,
+// and is ignored, as a durable listener gets the blocks at least once. On a failure, the block is delivered again.
+func (r *ledgerRollups) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	blockNum := event.Block.GetHeader().GetBaseHeader().GetNumber()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if blockNum <= r.height {
+		return nil
+	}
+	if blockNum != r.height+1 {
+		return errors.Errorf("block [%d] is delivered to the daily rollups at the rollup height %d", blockNum, r.height)
+	}
+
+	if err := r.add(event.Block); err != nil {
+		return errors.WithMessagef(err, "failed to add block [%d] to the daily rollups", blockNum)
+	}
+	return nil
+}
+
+// ProcessedHeight returns the rollup height, which is recorded along with the rollups
+func (r *ledgerRollups) ProcessedHeight() (uint64, error) {
+	return r.rollupHeight(), nil
+}
+
+// catchUp adds the blocks above the rollup height, up to the given block, from the block store
+func (r *ledgerRollups) catchUp(blockNum uint64) error {
+	for n := r.height + 1; n <= blockNum; n++ {
+		block, err := r.blockStore.GetForReplay(n)
+		if err != nil {
+			return err
+		}
+		if err := r.add(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ledgerRollups) add(block *types.Block) error {
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+	summary, users := summarizeBlock(block)
+	if err := r.db.CommitBlockRollup(blockNum, summary, users); err != nil {
+		return err
+	}
+
+	r.height = blockNum
+	return nil
+}
+
+func (r *ledgerRollups) rollupHeight() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.height
+}
+
+// summarizeBlock returns the counts of the block, on the day of its timestamp, and the users who signed its
+// transactions. A block without a timestamp, which was produced before the field was introduced, falls on no day, and
+// its summary is nil.
+func summarizeBlock(block *types.Block) (*sysstate.DailyRollup, []string) {
+	timestamp := block.GetHeader().GetBaseHeader().GetTimestamp()
+	if timestamp <= 0 {
+		return nil, nil
+	}
+
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+	summary := &sysstate.DailyRollup{
+		Day:        uint64(timestamp / int64(rollupDay)),
+		Blocks:     1,
+		FirstBlock: blockNum,
+		LastBlock:  blockNum,
+	}
+	validationInfo := block.GetHeader().GetValidationInfo()
+	isValid := func(txIndex int) bool {
+		return txIndex < len(validationInfo) && validationInfo[txIndex].GetFlag() == types.Flag_VALID
+	}
+
+	var users []string
+	addSingleTx := func(userID string) {
+		users = append(users, userID)
+		summary.Transactions = 1
+		if isValid(0) {
+			summary.ValidTransactions = 1
+		}
+	}
+	switch block.Payload.(type) {
+	case *types.Block_DataTxEnvelopes:
+		for i, env := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			signers := make([]string, 0, len(env.GetSignatures()))
+			for userID := range env.GetSignatures() {
+				signers = append(signers, userID)
+			}
+			sort.Strings(signers)
+			users = append(users, signers...)
+
+			if !isValid(i) {
+				continue
+			}
+			summary.ValidTransactions++
+			for _, op := range env.GetPayload().GetDbOperations() {
+				for _, w := range op.GetDataWrites() {
+					summary.BytesWritten += uint64(len(w.GetKey()) + len(w.GetValue()))
+				}
+			}
+		}
+		summary.Transactions = uint64(len(block.GetDataTxEnvelopes().GetEnvelopes()))
+
+	case *types.Block_VoidTxEnvelopes:
+		for i, env := range block.GetVoidTxEnvelopes().GetEnvelopes() {
+			users = append(users, env.GetPayload().GetUserId())
+			if isValid(i) {
+				summary.ValidTransactions++
+			}
+		}
+		summary.Transactions = uint64(len(block.GetVoidTxEnvelopes().GetEnvelopes()))
+
+	case *types.Block_UserAdministrationTxEnvelope:
+		addSingleTx(block.GetUserAdministrationTxEnvelope().GetPayload().GetUserId())
+
+	case *types.Block_UserAdministrationTxEnvelopes:
+		for i, env := range block.GetUserAdministrationTxEnvelopes().GetEnvelopes() {
+			users = append(users, env.GetPayload().GetUserId())
+			if isValid(i) {
+				summary.ValidTransactions++
+			}
+		}
+		summary.Transactions = uint64(len(block.GetUserAdministrationTxEnvelopes().GetEnvelopes()))
+
+	case *types.Block_DbAdministrationTxEnvelope:
+		addSingleTx(block.GetDbAdministrationTxEnvelope().GetPayload().GetUserId())
+
+	case *types.Block_ConfigTxEnvelope:
+		addSingleTx(block.GetConfigTxEnvelope().GetPayload().GetUserId())
+	}
+
+	return summary, users
+}
+
+// GetLedgerRollups returns the daily rollups of the ledger from the start date to the end date, both included
+func (d *db) GetLedgerRollups(querierUserID, startDate, endDate string) (*types.GetLedgerRollupsResponseEnvelope, error) {
+	if d.ledgerRollups == nil {
+		return nil, &ierrors.ServerRestrictionError{ErrMsg: "the node does not record the ledger rollups"}
+	}
+
+	startDay, err := parseRollupDate(startDate)
+	if err != nil {
+		return nil, err
+	}
+	endDay, err := parseRollupDate(endDate)
+	if err != nil {
+		return nil, err
+	}
+	if endDay < startDay {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the end date [" + endDate + "] is before the start date [" + startDate + "]"}
+	}
+
+	// the height is read first, as the rollups may only be ahead of it
+	height := d.ledgerRollups.rollupHeight()
+	rollups, err := d.levelDB.GetDailyRollups(startDay, endDay)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.GetLedgerRollupsResponse{
+		Header:       d.responseHeader(),
+		RollupHeight: height,
+	}
+	for _, rollup := range rollups {
+		response.Rollups = append(response.Rollups, &types.LedgerDailyRollup{
+			Date:              time.Unix(0, int64(rollup.Day)*int64(rollupDay)).UTC().Format(rollupDateLayout),
+			Blocks:            rollup.Blocks,
+			Transactions:      rollup.Transactions,
+			ValidTransactions: rollup.ValidTransactions,
+			ActiveUsers:       rollup.ActiveUsers,
+			BytesWritten:      rollup.BytesWritten,
+			FirstBlock:        rollup.FirstBlock,
+			LastBlock:         rollup.LastBlock,
+		})
+	}
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetLedgerRollupsResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// parseRollupDate returns the number of days since the Unix epoch of a date given as YYYY-MM-DD
+func parseRollupDate(date string) (uint64, error) {
+	t, err := time.Parse(rollupDateLayout, date)
+	if err != nil || t.Unix() < 0 {
+		return 0, &ierrors.BadRequestError{ErrMsg: "the date [" + date + "] is not a date since 1970-01-01 given as YYYY-MM-DD"}
+	}
+	return uint64(t.Unix() / int64(rollupDay/time.Second)), nil
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	crypto_mocks "github.com/hyperledger-labs/orion-server/pkg/crypto/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerRollups(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "ledgerRollups")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	levelDB, err := leveldb.Open(&leveldb.Config{DBRootDir: filepath.Join(dir, "statedb"), Logger: lg})
+	require.NoError(t, err)
+	defer levelDB.Close()
+	blockStore, err := blockstore.Open(&blockstore.Config{StoreDir: filepath.Join(dir, "blockstore"), Logger: lg})
+	require.NoError(t, err)
+	defer blockStore.Close()
+
+	// the blocks are committed around the midnight, UTC, that ends 2026-10-15
+	midnight := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	newBlock := func(number uint64, timestamp time.Time, flags ...types.Flag) *types.Block {
+		block := &types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{Number: number},
+			},
+		}
+		if !timestamp.IsZero() {
+			block.Header.BaseHeader.Timestamp = timestamp.UnixNano()
+		}
+		for _, flag := range flags {
+			block.Header.ValidationInfo = append(block.Header.ValidationInfo, &types.ValidationInfo{Flag: flag})
+		}
+		return block
+	}
+	dataTxEnvelope := func(key string, value string, signers ...string) *types.DataTxEnvelope {
+		env := &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				TxId:            "tx-" + key,
+				MustSignUserIds: signers,
+				DbOperations: []*types.DBOperation{
+					{DbName: "db1", DataWrites: []*types.DataWrite{{Key: key, Value: []byte(value)}}},
+				},
+			},
+			Signatures: make(map[string][]byte),
+		}
+		for _, signer := range signers {
+			env.Signatures[signer] = []byte("signature")
+		}
+		return env
+	}
+
+	block1 := newBlock(1, midnight.Add(-2*time.Hour), types.Flag_VALID)
+	block1.Payload = &types.Block_ConfigTxEnvelope{
+		ConfigTxEnvelope: &types.ConfigTxEnvelope{Payload: &types.ConfigTx{UserId: "admin", TxId: "config-tx"}},
+	}
+	block2 := newBlock(2, midnight.Add(-time.Second), types.Flag_VALID, types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE)
+	block2.Payload = &types.Block_DataTxEnvelopes{
+		DataTxEnvelopes: &types.DataTxEnvelopes{
+			Envelopes: []*types.DataTxEnvelope{
+				dataTxEnvelope("key1", "value1", "alice"),
+				dataTxEnvelope("key2", "value2", "alice", "bob"),
+			},
+		},
+	}
+	block3 := newBlock(3, midnight, types.Flag_VALID)
+	block3.Payload = &types.Block_DataTxEnvelopes{
+		DataTxEnvelopes: &types.DataTxEnvelopes{
+			Envelopes: []*types.DataTxEnvelope{dataTxEnvelope("key3", "value-3", "alice")},
+		},
+	}
+	for _, block := range []*types.Block{block1, block2, block3} {
+		require.NoError(t, blockStore.Commit(block))
+	}
+
+	signer := &crypto_mocks.Signer{}
+	signer.On("Sign", mock.Anything).Return([]byte("signature"), nil)
+	d := &db{
+		nodeID:  "node1",
+		levelDB: levelDB,
+		signer:  signer,
+		logger:  lg,
+	}
+
+	_, err = d.GetLedgerRollups("alice", "2026-10-15", "2026-10-16")
+	require.EqualError(t, err, "the node does not record the ledger rollups")
+	require.IsType(t, &ierrors.ServerRestrictionError{}, err)
+
+	// the rollups of the committed blocks are derived on the first start
+	d.ledgerRollups, err = newLedgerRollups(&ledgerRollupsConfig{db: levelDB, blockStore: blockStore, logger: lg})
+	require.NoError(t, err)
+
+	day15 := &types.LedgerDailyRollup{
+		Date:              "2026-10-15",
+		Blocks:            2,
+		Transactions:      3,
+		ValidTransactions: 2,
+		ActiveUsers:       3,
+		BytesWritten:      uint64(len("key1") + len("value1")),
+		FirstBlock:        1,
+		LastBlock:         2,
+	}
+	requireRollups := func(startDate, endDate string, expectedHeight uint64, expected ...*types.LedgerDailyRollup) {
+		resp, err := d.GetLedgerRollups("alice", startDate, endDate)
+		require.NoError(t, err)
+		require.Equal(t, "node1", resp.GetResponse().GetHeader().GetNodeId())
+		require.Equal(t, expectedHeight, resp.GetResponse().GetRollupHeight())
+		require.Len(t, resp.GetResponse().GetRollups(), len(expected))
+		for i := range expected {
+			require.Equal(t, expected[i].String(), resp.GetResponse().GetRollups()[i].String())
+		}
+	}
+	requireRollups("2026-10-15", "2026-10-15", 3, day15)
+	requireRollups("2026-10-16", "2026-10-16", 3, &types.LedgerDailyRollup{
+		Date:              "2026-10-16",
+		Blocks:            1,
+		Transactions:      1,
+		ValidTransactions: 1,
+		ActiveUsers:       1,
+		BytesWritten:      uint64(len("key3") + len("value-3")),
+		FirstBlock:        3,
+		LastBlock:         3,
+	})
+
+	// the listener adds the committed blocks in order; a block without a timestamp falls on no day
+	block4 := newBlock(4, time.Time{}, types.Flag_VALID)
+	block4.Payload = &types.Block_DataTxEnvelopes{
+		DataTxEnvelopes: &types.DataTxEnvelopes{
+			Envelopes: []*types.DataTxEnvelope{dataTxEnvelope("key4", "value4", "dave")},
+		},
+	}
+	block5 := newBlock(5, midnight.Add(time.Hour), types.Flag_VALID, types.Flag_VALID)
+	block5.Payload = &types.Block_VoidTxEnvelopes{
+		VoidTxEnvelopes: &types.VoidTxEnvelopes{
+			Envelopes: []*types.VoidTxEnvelope{
+				{Payload: &types.VoidTx{UserId: "carol", TxId: "void1"}},
+				{Payload: &types.VoidTx{UserId: "alice", TxId: "void2"}},
+			},
+		},
+	}
+	block6 := newBlock(6, midnight.Add(24*time.Hour), types.Flag_VALID)
+	block6.Payload = &types.Block_HeartbeatTxEnvelopes{
+		HeartbeatTxEnvelopes: &types.HeartbeatTxEnvelopes{
+			Envelopes: []*types.HeartbeatTxEnvelope{{Payload: &types.HeartbeatTx{NodeId: "node1", TxId: "heartbeat1"}}},
+		},
+	}
+	for _, block := range []*types.Block{block4, block5, block6} {
+		require.NoError(t, blockStore.Commit(block))
+	}
+	err = d.ledgerRollups.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: block5})
+	require.EqualError(t, err, "block [5] is delivered to the daily rollups at the rollup height 3")
+	height, err := d.ledgerRollups.ProcessedHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+
+	require.NoError(t, d.ledgerRollups.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: block4}))
+	require.NoError(t, d.ledgerRollups.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: block5}))
+	require.NoError(t, d.ledgerRollups.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: block6}))
+	// a block delivered again was already added
+	require.NoError(t, d.ledgerRollups.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: block2, IsReplay: true}))
+	require.NoError(t, d.ledgerRollups.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: block6}))
+
+	day16 := &types.LedgerDailyRollup{
+		Date:              "2026-10-16",
+		Blocks:            2,
+		Transactions:      3,
+		ValidTransactions: 3,
+		ActiveUsers:       2,
+		BytesWritten:      uint64(len("key3") + len("value-3")),
+		FirstBlock:        3,
+		LastBlock:         5,
+	}
+	day17 := &types.LedgerDailyRollup{
+		Date:       "2026-10-17",
+		Blocks:     1,
+		FirstBlock: 6,
+		LastBlock:  6,
+	}
+	requireRollups("2026-10-01", "2026-10-31", 6, day15, day16, day17)
+	requireRollups("2026-10-16", "2026-10-16", 6, day16)
+	requireRollups("2026-10-18", "2026-12-31", 6)
+
+	// the rollups are not derived again on a restart
+	d.ledgerRollups, err = newLedgerRollups(&ledgerRollupsConfig{db: levelDB, blockStore: blockStore, logger: lg})
+	require.NoError(t, err)
+	requireRollups("2026-10-01", "2026-10-31", 6, day15, day16, day17)
+
+	for _, tt := range []struct {
+		startDate, endDate string
+		expectedErr        string
+	}{
+		{"2026-10-32", "2026-11-01", "the date [2026-10-32] is not a date since 1970-01-01 given as YYYY-MM-DD"},
+		{"1969-12-31", "2026-11-01", "the date [1969-12-31] is not a date since 1970-01-01 given as YYYY-MM-DD"},
+		{"2026-10-15", "20261016", "the date [20261016] is not a date since 1970-01-01 given as YYYY-MM-DD"},
+		{"2026-10-16", "2026-10-15", "the end date [2026-10-15] is before the start date [2026-10-16]"},
+	} {
+		_, err := d.GetLedgerRollups("alice", tt.startDate, tt.endDate)
+		require.EqualError(t, err, tt.expectedErr)
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	crypto_mocks "github.com/hyperledger-labs/orion-server/pkg/crypto/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLogLevels(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "node.log")
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{logFile},
+		ErrOutputPath: []string{logFile},
+		Encoding:      "console",
+		Name:          "node1",
+	})
+	require.NoError(t, err)
+
+	cryptoDir, conf := testConfiguration(t)
+	defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+	_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+	e, err := NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	defer e.Close()
+
+	signer := &crypto_mocks.Signer{}
+	signer.On("Sign", mock.Anything).Return([]byte("signature"), nil)
+	d := &db{
+		nodeID:                   "node1",
+		worldstateQueryProcessor: e.worldstateQueryProcessor,
+		signer:                   signer,
+		logger:                   lg,
+	}
+
+	resp, err := e.Submit(testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+		UserId: "admin",
+		TxId:   "user-tx",
+		UserWrites: []*types.UserWrite{
+			{
+				User: &types.User{
+					Id:          "testUser",
+					Certificate: userCert.Raw,
+					Privilege: &types.Privilege{
+						DbPermission: map[string]types.Privilege_Access{worldstate.DefaultDBName: types.Privilege_ReadWrite},
+					},
+				},
+			},
+		},
+	}), 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	submitDataTx := func(txID string) {
+		resp, err := e.Submit(testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+			MustSignUserIds: []string{"testUser"},
+			TxId:            txID,
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: txID, Value: []byte("value")}},
+				},
+			},
+		}), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	}
+	// debugLines returns the debug lines written since the last call, by the name of the logger that wrote them
+	debugLines := func() map[string]int {
+		require.NoError(t, lg.Sync())
+		content, err := ioutil.ReadFile(logFile)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(logFile, 0))
+
+		lines := make(map[string]int)
+		for _, line := range strings.Split(string(content), "\n") {
+			fields := strings.Split(line, "\t")
+			if len(fields) > 2 && fields[1] == "DEBUG" {
+				lines[fields[2]]++
+			}
+		}
+		return lines
+	}
+
+	levels, err := d.GetLogLevels("admin")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		logger.ModuleReorderer: "info",
+		logger.ModuleCreator:   "info",
+		logger.ModuleValidator: "info",
+		logger.ModuleCommitter: "info",
+	}, levels.GetResponse().GetLevels())
+
+	submitDataTx("tx1")
+	require.Empty(t, debugLines())
+
+	// the committer is flipped to debug mid-run, while the other modules stay quiet
+	levels, err = d.SetLogLevels("admin", map[string]string{logger.ModuleCommitter: "debug"})
+	require.NoError(t, err)
+	require.Equal(t, "debug", levels.GetResponse().GetLevels()[logger.ModuleCommitter])
+	require.Equal(t, "info", levels.GetResponse().GetLevels()[logger.ModuleReorderer])
+
+	submitDataTx("tx2")
+	lines := debugLines()
+	require.NotZero(t, lines["node1."+logger.ModuleCommitter], "no debug lines of the committer")
+	delete(lines, "node1."+logger.ModuleCommitter)
+	require.Empty(t, lines)
+
+	// a change with an unknown module sets none of the levels
+	_, err = d.SetLogLevels("admin", map[string]string{logger.ModuleCommitter: "info", "unknown": "debug"})
+	require.EqualError(t, err, "unknown logging module [unknown]")
+	levels, err = d.GetLogLevels("admin")
+	require.NoError(t, err)
+	require.Equal(t, "debug", levels.GetResponse().GetLevels()[logger.ModuleCommitter])
+
+	// only admins get and set the levels
+	_, err = d.SetLogLevels("testUser", map[string]string{logger.ModuleCommitter: "info"})
+	require.EqualError(t, err, "the user [testUser] has no permission to set the logging levels")
+	_, err = d.GetLogLevels("testUser")
+	require.EqualError(t, err, "the user [testUser] has no permission to get the logging levels")
+
+	levels, err = d.SetLogLevels("admin", map[string]string{logger.ModuleCommitter: "info"})
+	require.NoError(t, err)
+	require.Equal(t, "info", levels.GetResponse().GetLevels()[logger.ModuleCommitter])
+	debugLines()
+	submitDataTx("tx3")
+	require.Empty(t, debugLines(), "debug lines after the committer is set back to info")
+}
@@ -3,13 +3,22 @@
 package mocks
 
 import (
+	blockstore "github.com/hyperledger-labs/orion-server/internal/blockstore"
+
 	context "context"
 
 	errors "github.com/hyperledger-labs/orion-server/internal/errors"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+
+	leveldb "github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+
+	queue "github.com/hyperledger-labs/orion-server/internal/queue"
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
 
+	txvalidation "github.com/hyperledger-labs/orion-server/internal/txvalidation"
+
 	types "github.com/hyperledger-labs/orion-server/pkg/types"
 
 	x509 "crypto/x509"
@@ -20,6 +29,29 @@ type DB struct {
 	mock.Mock
 }
 
+// AcceptPeerHeader provides a mock function with given fields: querierUserID, blockNum
+func (_m *DB) AcceptPeerHeader(querierUserID string, blockNum uint64) (*types.AcceptPeerHeaderResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, blockNum)
+
+	var r0 *types.AcceptPeerHeaderResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, uint64) *types.AcceptPeerHeaderResponseEnvelope); ok {
+		r0 = rf(querierUserID, blockNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.AcceptPeerHeaderResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
+		r1 = rf(querierUserID, blockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *DB) Close() error {
 	ret := _m.Called()
@@ -34,13 +66,36 @@ func (_m *DB) Close() error {
 	return r0
 }
 
-// DataQuery provides a mock function with given fields: ctx, dbName, querierUserID, query
-func (_m *DB) DataQuery(ctx context.Context, dbName string, querierUserID string, query []byte) (*types.DataQueryResponseEnvelope, error) {
-	ret := _m.Called(ctx, dbName, querierUserID, query)
+// CountData provides a mock function with given fields: dbName, querierUserID, startKey, endKey, jsonQuery, existsOnly
+func (_m *DB) CountData(dbName string, querierUserID string, startKey string, endKey string, jsonQuery string, existsOnly bool) (*types.GetDataCountResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, startKey, endKey, jsonQuery, existsOnly)
+
+	var r0 *types.GetDataCountResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, bool) *types.GetDataCountResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, startKey, endKey, jsonQuery, existsOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetDataCountResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string, string, bool) error); ok {
+		r1 = rf(dbName, querierUserID, startKey, endKey, jsonQuery, existsOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataQuery provides a mock function with given fields: ctx, dbName, querierUserID, query, limit, pageCursor
+func (_m *DB) DataQuery(ctx context.Context, dbName string, querierUserID string, query []byte, limit uint64, pageCursor string) (*types.DataQueryResponseEnvelope, error) {
+	ret := _m.Called(ctx, dbName, querierUserID, query, limit, pageCursor)
 
 	var r0 *types.DataQueryResponseEnvelope
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) *types.DataQueryResponseEnvelope); ok {
-		r0 = rf(ctx, dbName, querierUserID, query)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte, uint64, string) *types.DataQueryResponseEnvelope); ok {
+		r0 = rf(ctx, dbName, querierUserID, query, limit, pageCursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.DataQueryResponseEnvelope)
@@ -48,8 +103,8 @@ func (_m *DB) DataQuery(ctx context.Context, dbName string, querierUserID string
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte) error); ok {
-		r1 = rf(ctx, dbName, querierUserID, query)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte, uint64, string) error); ok {
+		r1 = rf(ctx, dbName, querierUserID, query, limit, pageCursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,6 +133,43 @@ func (_m *DB) DoesUserExist(userID string) (bool, error) {
 	return r0, r1
 }
 
+// ExportDB provides a mock function with given fields: querierUserID, dbName, provenance, emit
+func (_m *DB) ExportDB(querierUserID string, dbName string, provenance bool, emit func(*types.DBExportRecord) error) error {
+	ret := _m.Called(querierUserID, dbName, provenance, emit)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool, func(*types.DBExportRecord) error) error); ok {
+		r0 = rf(querierUserID, dbName, provenance, emit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAdminAuditRecords provides a mock function with given fields: querierUserID, hash, since, limit, pageCursor
+func (_m *DB) GetAdminAuditRecords(querierUserID string, hash []byte, since uint64, limit uint64, pageCursor string) (*types.GetAdminAuditRecordsResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, hash, since, limit, pageCursor)
+
+	var r0 *types.GetAdminAuditRecordsResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, []byte, uint64, uint64, string) *types.GetAdminAuditRecordsResponseEnvelope); ok {
+		r0 = rf(querierUserID, hash, since, limit, pageCursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetAdminAuditRecordsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []byte, uint64, uint64, string) error); ok {
+		r1 = rf(querierUserID, hash, since, limit, pageCursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAugmentedBlockHeader provides a mock function with given fields: userID, blockNum
 func (_m *DB) GetAugmentedBlockHeader(userID string, blockNum uint64) (*types.GetAugmentedBlockHeaderResponseEnvelope, error) {
 	ret := _m.Called(userID, blockNum)
@@ -101,6 +193,29 @@ func (_m *DB) GetAugmentedBlockHeader(userID string, blockNum uint64) (*types.Ge
 	return r0, r1
 }
 
+// GetBlockComposition provides a mock function with given fields: userId, blockNum
+func (_m *DB) GetBlockComposition(userId string, blockNum uint64) (*types.GetBlockCompositionResponseEnvelope, error) {
+	ret := _m.Called(userId, blockNum)
+
+	var r0 *types.GetBlockCompositionResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, uint64) *types.GetBlockCompositionResponseEnvelope); ok {
+		r0 = rf(userId, blockNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetBlockCompositionResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
+		r1 = rf(userId, blockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockHeader provides a mock function with given fields: userID, blockNum
 func (_m *DB) GetBlockHeader(userID string, blockNum uint64) (*types.GetBlockResponseEnvelope, error) {
 	ret := _m.Called(userID, blockNum)
@@ -124,6 +239,29 @@ func (_m *DB) GetBlockHeader(userID string, blockNum uint64) (*types.GetBlockRes
 	return r0, r1
 }
 
+// GetBlockStoreStats provides a mock function with given fields: querierUserID
+func (_m *DB) GetBlockStoreStats(querierUserID string) (*blockstore.Stats, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 *blockstore.Stats
+	if rf, ok := ret.Get(0).(func(string) *blockstore.Stats); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*blockstore.Stats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCertificate provides a mock function with given fields: userID
 func (_m *DB) GetCertificate(userID string) (*x509.Certificate, error) {
 	ret := _m.Called(userID)
@@ -147,6 +285,29 @@ func (_m *DB) GetCertificate(userID string) (*x509.Certificate, error) {
 	return r0, r1
 }
 
+// GetClusterHeartbeats provides a mock function with given fields:
+func (_m *DB) GetClusterHeartbeats() (*types.GetClusterHeartbeatsResponseEnvelope, error) {
+	ret := _m.Called()
+
+	var r0 *types.GetClusterHeartbeatsResponseEnvelope
+	if rf, ok := ret.Get(0).(func() *types.GetClusterHeartbeatsResponseEnvelope); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetClusterHeartbeatsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetClusterStatus provides a mock function with given fields: noCerts
 func (_m *DB) GetClusterStatus(noCerts bool) (*types.GetClusterStatusResponseEnvelope, error) {
 	ret := _m.Called(noCerts)
@@ -216,6 +377,98 @@ func (_m *DB) GetConfigBlock(querierUserID string, blockNumber uint64) (*types.G
 	return r0, r1
 }
 
+// GetConfigLimits provides a mock function with given fields:
+func (_m *DB) GetConfigLimits() (*types.GetConfigLimitsResponseEnvelope, error) {
+	ret := _m.Called()
+
+	var r0 *types.GetConfigLimitsResponseEnvelope
+	if rf, ok := ret.Get(0).(func() *types.GetConfigLimitsResponseEnvelope); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetConfigLimitsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDBDescriptor provides a mock function with given fields: dbName, querierUserID, asOf
+func (_m *DB) GetDBDescriptor(dbName string, querierUserID string, asOf uint64) (*types.GetDBDescriptorResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, asOf)
+
+	var r0 *types.GetDBDescriptorResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, uint64) *types.GetDBDescriptorResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetDBDescriptorResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, uint64) error); ok {
+		r1 = rf(dbName, querierUserID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDBDescriptorHistory provides a mock function with given fields: dbName, querierUserID
+func (_m *DB) GetDBDescriptorHistory(dbName string, querierUserID string) (*types.GetDBDescriptorHistoryResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID)
+
+	var r0 *types.GetDBDescriptorHistoryResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string) *types.GetDBDescriptorHistoryResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetDBDescriptorHistoryResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(dbName, querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDBDigest provides a mock function with given fields: dbName, querierUserID, blockNum
+func (_m *DB) GetDBDigest(dbName string, querierUserID string, blockNum uint64) (*types.GetDBDigestResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, blockNum)
+
+	var r0 *types.GetDBDigestResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, uint64) *types.GetDBDigestResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, blockNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetDBDigestResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, uint64) error); ok {
+		r1 = rf(dbName, querierUserID, blockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDBIndex provides a mock function with given fields: dbName, querierUserID
 func (_m *DB) GetDBIndex(dbName string, querierUserID string) (*types.GetDBIndexResponseEnvelope, error) {
 	ret := _m.Called(dbName, querierUserID)
@@ -285,22 +538,22 @@ func (_m *DB) GetData(dbName string, querierUserID string, key string) (*types.G
 	return r0, r1
 }
 
-// GetDataProof provides a mock function with given fields: userID, blockNum, dbname, key, deleted
-func (_m *DB) GetDataProof(userID string, blockNum uint64, dbname string, key string, deleted bool) (*types.GetDataProofResponseEnvelope, error) {
-	ret := _m.Called(userID, blockNum, dbname, key, deleted)
+// GetDataAsOf provides a mock function with given fields: dbName, querierUserID, key, asOf
+func (_m *DB) GetDataAsOf(dbName string, querierUserID string, key string, asOf uint64) (*types.GetDataResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, key, asOf)
 
-	var r0 *types.GetDataProofResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, uint64, string, string, bool) *types.GetDataProofResponseEnvelope); ok {
-		r0 = rf(userID, blockNum, dbname, key, deleted)
+	var r0 *types.GetDataResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64) *types.GetDataResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, key, asOf)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetDataProofResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDataResponseEnvelope)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, uint64, string, string, bool) error); ok {
-		r1 = rf(userID, blockNum, dbname, key, deleted)
+	if rf, ok := ret.Get(1).(func(string, string, string, uint64) error); ok {
+		r1 = rf(dbName, querierUserID, key, asOf)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -308,22 +561,22 @@ func (_m *DB) GetDataProof(userID string, blockNum uint64, dbname string, key st
 	return r0, r1
 }
 
-// GetDataRange provides a mock function with given fields: dbName, querierUserID, startKey, endKey, limit
-func (_m *DB) GetDataRange(dbName string, querierUserID string, startKey string, endKey string, limit uint64) (*types.GetDataRangeResponseEnvelope, error) {
-	ret := _m.Called(dbName, querierUserID, startKey, endKey, limit)
+// GetDataByVersion provides a mock function with given fields: userID, dbName, key, version
+func (_m *DB) GetDataByVersion(userID string, dbName string, key string, version *types.Version) (*types.GetDataByVersionResponseEnvelope, error) {
+	ret := _m.Called(userID, dbName, key, version)
 
-	var r0 *types.GetDataRangeResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, string, string, string, uint64) *types.GetDataRangeResponseEnvelope); ok {
-		r0 = rf(dbName, querierUserID, startKey, endKey, limit)
+	var r0 *types.GetDataByVersionResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, *types.Version) *types.GetDataByVersionResponseEnvelope); ok {
+		r0 = rf(userID, dbName, key, version)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetDataRangeResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDataByVersionResponseEnvelope)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, string, uint64) error); ok {
-		r1 = rf(dbName, querierUserID, startKey, endKey, limit)
+	if rf, ok := ret.Get(1).(func(string, string, string, *types.Version) error); ok {
+		r1 = rf(userID, dbName, key, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -331,22 +584,22 @@ func (_m *DB) GetDataRange(dbName string, querierUserID string, startKey string,
 	return r0, r1
 }
 
-// GetDeletedValues provides a mock function with given fields: userID, dbname, key
-func (_m *DB) GetDeletedValues(userID string, dbname string, key string) (*types.GetHistoricalDataResponseEnvelope, error) {
-	ret := _m.Called(userID, dbname, key)
+// GetDataMulti provides a mock function with given fields: dbName, querierUserID, keys
+func (_m *DB) GetDataMulti(dbName string, querierUserID string, keys []string) (*types.GetDataMultiResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, keys)
 
-	var r0 *types.GetHistoricalDataResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, string, string) *types.GetHistoricalDataResponseEnvelope); ok {
-		r0 = rf(userID, dbname, key)
+	var r0 *types.GetDataMultiResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, []string) *types.GetDataMultiResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, keys)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDataMultiResponseEnvelope)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(userID, dbname, key)
+	if rf, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = rf(dbName, querierUserID, keys)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -354,22 +607,22 @@ func (_m *DB) GetDeletedValues(userID string, dbname string, key string) (*types
 	return r0, r1
 }
 
-// GetLedgerPath provides a mock function with given fields: userID, start, end
-func (_m *DB) GetLedgerPath(userID string, start uint64, end uint64) (*types.GetLedgerPathResponseEnvelope, error) {
-	ret := _m.Called(userID, start, end)
+// GetDataProof provides a mock function with given fields: userID, blockNum, dbname, key, deleted
+func (_m *DB) GetDataProof(userID string, blockNum uint64, dbname string, key string, deleted bool) (*types.GetDataProofResponseEnvelope, error) {
+	ret := _m.Called(userID, blockNum, dbname, key, deleted)
 
-	var r0 *types.GetLedgerPathResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, uint64, uint64) *types.GetLedgerPathResponseEnvelope); ok {
-		r0 = rf(userID, start, end)
+	var r0 *types.GetDataProofResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, uint64, string, string, bool) *types.GetDataProofResponseEnvelope); ok {
+		r0 = rf(userID, blockNum, dbname, key, deleted)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetLedgerPathResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDataProofResponseEnvelope)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, uint64, uint64) error); ok {
-		r1 = rf(userID, start, end)
+	if rf, ok := ret.Get(1).(func(string, uint64, string, string, bool) error); ok {
+		r1 = rf(userID, blockNum, dbname, key, deleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -377,22 +630,22 @@ func (_m *DB) GetLedgerPath(userID string, start uint64, end uint64) (*types.Get
 	return r0, r1
 }
 
-// GetMostRecentValueAtOrBelow provides a mock function with given fields: userID, dbName, key, version
-func (_m *DB) GetMostRecentValueAtOrBelow(userID string, dbName string, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error) {
-	ret := _m.Called(userID, dbName, key, version)
+// GetDataRange provides a mock function with given fields: dbName, querierUserID, startKey, endKey, limit, pageCursor
+func (_m *DB) GetDataRange(dbName string, querierUserID string, startKey string, endKey string, limit uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, startKey, endKey, limit, pageCursor)
 
-	var r0 *types.GetHistoricalDataResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, string, string, *types.Version) *types.GetHistoricalDataResponseEnvelope); ok {
-		r0 = rf(userID, dbName, key, version)
+	var r0 *types.GetDataRangeResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, string, uint64, string) *types.GetDataRangeResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, startKey, endKey, limit, pageCursor)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDataRangeResponseEnvelope)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, *types.Version) error); ok {
-		r1 = rf(userID, dbName, key, version)
+	if rf, ok := ret.Get(1).(func(string, string, string, string, uint64, string) error); ok {
+		r1 = rf(dbName, querierUserID, startKey, endKey, limit, pageCursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -400,22 +653,22 @@ func (_m *DB) GetMostRecentValueAtOrBelow(userID string, dbName string, key stri
 	return r0, r1
 }
 
-// GetNextValues provides a mock function with given fields: userID, dbname, key, version
-func (_m *DB) GetNextValues(userID string, dbname string, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error) {
-	ret := _m.Called(userID, dbname, key, version)
+// GetDataRangeAsOf provides a mock function with given fields: dbName, querierUserID, startKey, endKey, limit, asOf, pageCursor
+func (_m *DB) GetDataRangeAsOf(dbName string, querierUserID string, startKey string, endKey string, limit uint64, asOf uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, startKey, endKey, limit, asOf, pageCursor)
 
-	var r0 *types.GetHistoricalDataResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, string, string, *types.Version) *types.GetHistoricalDataResponseEnvelope); ok {
-		r0 = rf(userID, dbname, key, version)
+	var r0 *types.GetDataRangeResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, string, uint64, uint64, string) *types.GetDataRangeResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, startKey, endKey, limit, asOf, pageCursor)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDataRangeResponseEnvelope)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, *types.Version) error); ok {
-		r1 = rf(userID, dbname, key, version)
+	if rf, ok := ret.Get(1).(func(string, string, string, string, uint64, uint64, string) error); ok {
+		r1 = rf(dbName, querierUserID, startKey, endKey, limit, asOf, pageCursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -423,22 +676,22 @@ func (_m *DB) GetNextValues(userID string, dbname string, key string, version *t
 	return r0, r1
 }
 
-// GetNodeConfig provides a mock function with given fields: nodeID
-func (_m *DB) GetNodeConfig(nodeID string) (*types.GetNodeConfigResponseEnvelope, error) {
-	ret := _m.Called(nodeID)
+// GetDataRangeAnalytical provides a mock function with given fields: dbName, querierUserID, startKey, endKey, limit, pageCursor
+func (_m *DB) GetDataRangeAnalytical(dbName string, querierUserID string, startKey string, endKey string, limit uint64, pageCursor string) (*types.GetDataRangeResponseEnvelope, error) {
+	ret := _m.Called(dbName, querierUserID, startKey, endKey, limit, pageCursor)
 
-	var r0 *types.GetNodeConfigResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string) *types.GetNodeConfigResponseEnvelope); ok {
-		r0 = rf(nodeID)
+	var r0 *types.GetDataRangeResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, string, uint64, string) *types.GetDataRangeResponseEnvelope); ok {
+		r0 = rf(dbName, querierUserID, startKey, endKey, limit, pageCursor)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetNodeConfigResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDataRangeResponseEnvelope)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(nodeID)
+	if rf, ok := ret.Get(1).(func(string, string, string, string, uint64, string) error); ok {
+		r1 = rf(dbName, querierUserID, startKey, endKey, limit, pageCursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -446,13 +699,13 @@ func (_m *DB) GetNodeConfig(nodeID string) (*types.GetNodeConfigResponseEnvelope
 	return r0, r1
 }
 
-// GetPreviousValues provides a mock function with given fields: userID, dbname, key, version
-func (_m *DB) GetPreviousValues(userID string, dbname string, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error) {
-	ret := _m.Called(userID, dbname, key, version)
+// GetDeletedValues provides a mock function with given fields: userID, dbname, key, limit, pageCursor
+func (_m *DB) GetDeletedValues(userID string, dbname string, key string, limit uint64, pageCursor string) (*types.GetHistoricalDataResponseEnvelope, error) {
+	ret := _m.Called(userID, dbname, key, limit, pageCursor)
 
 	var r0 *types.GetHistoricalDataResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, string, string, *types.Version) *types.GetHistoricalDataResponseEnvelope); ok {
-		r0 = rf(userID, dbname, key, version)
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64, string) *types.GetHistoricalDataResponseEnvelope); ok {
+		r0 = rf(userID, dbname, key, limit, pageCursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
@@ -460,8 +713,8 @@ func (_m *DB) GetPreviousValues(userID string, dbname string, key string, versio
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, *types.Version) error); ok {
-		r1 = rf(userID, dbname, key, version)
+	if rf, ok := ret.Get(1).(func(string, string, string, uint64, string) error); ok {
+		r1 = rf(userID, dbname, key, limit, pageCursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -469,16 +722,269 @@ func (_m *DB) GetPreviousValues(userID string, dbname string, key string, versio
 	return r0, r1
 }
 
-// GetReaders provides a mock function with given fields: userID, dbName, key
-func (_m *DB) GetReaders(userID string, dbName string, key string) (*types.GetDataReadersResponseEnvelope, error) {
-	ret := _m.Called(userID, dbName, key)
+// GetDroppedTx provides a mock function with given fields: querierUserID, txID
+func (_m *DB) GetDroppedTx(querierUserID string, txID string) (*types.GetDroppedTxResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, txID)
 
-	var r0 *types.GetDataReadersResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, string, string) *types.GetDataReadersResponseEnvelope); ok {
-		r0 = rf(userID, dbName, key)
+	var r0 *types.GetDroppedTxResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string) *types.GetDroppedTxResponseEnvelope); ok {
+		r0 = rf(querierUserID, txID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.GetDataReadersResponseEnvelope)
+			r0 = ret.Get(0).(*types.GetDroppedTxResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(querierUserID, txID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDroppedTxs provides a mock function with given fields: querierUserID, since, limit, pageCursor
+func (_m *DB) GetDroppedTxs(querierUserID string, since int64, limit uint64, pageCursor string) (*types.GetDroppedTxsResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, since, limit, pageCursor)
+
+	var r0 *types.GetDroppedTxsResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, int64, uint64, string) *types.GetDroppedTxsResponseEnvelope); ok {
+		r0 = rf(querierUserID, since, limit, pageCursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetDroppedTxsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, uint64, string) error); ok {
+		r1 = rf(querierUserID, since, limit, pageCursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLedgerPath provides a mock function with given fields: userID, start, end
+func (_m *DB) GetLedgerPath(userID string, start uint64, end uint64) (*types.GetLedgerPathResponseEnvelope, error) {
+	ret := _m.Called(userID, start, end)
+
+	var r0 *types.GetLedgerPathResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, uint64, uint64) *types.GetLedgerPathResponseEnvelope); ok {
+		r0 = rf(userID, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetLedgerPathResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint64, uint64) error); ok {
+		r1 = rf(userID, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLedgerRollups provides a mock function with given fields: querierUserID, startDate, endDate
+func (_m *DB) GetLedgerRollups(querierUserID string, startDate string, endDate string) (*types.GetLedgerRollupsResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, startDate, endDate)
+
+	var r0 *types.GetLedgerRollupsResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string) *types.GetLedgerRollupsResponseEnvelope); ok {
+		r0 = rf(querierUserID, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetLedgerRollupsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(querierUserID, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLedgerUsage provides a mock function with given fields: querierUserID, targetUserID, startBlockNum, endBlockNum
+func (_m *DB) GetLedgerUsage(querierUserID string, targetUserID string, startBlockNum uint64, endBlockNum uint64) (*types.GetLedgerUsageResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, targetUserID, startBlockNum, endBlockNum)
+
+	var r0 *types.GetLedgerUsageResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, uint64, uint64) *types.GetLedgerUsageResponseEnvelope); ok {
+		r0 = rf(querierUserID, targetUserID, startBlockNum, endBlockNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetLedgerUsageResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, uint64, uint64) error); ok {
+		r1 = rf(querierUserID, targetUserID, startBlockNum, endBlockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyBlocks provides a mock function with given fields: querierUserID, dbName, key, startBlockNum, endBlockNum
+func (_m *DB) GetKeyBlocks(querierUserID string, dbName string, key string, startBlockNum uint64, endBlockNum uint64) (*types.GetKeyBlocksResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, dbName, key, startBlockNum, endBlockNum)
+
+	var r0 *types.GetKeyBlocksResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64, uint64) *types.GetKeyBlocksResponseEnvelope); ok {
+		r0 = rf(querierUserID, dbName, key, startBlockNum, endBlockNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetKeyBlocksResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, uint64, uint64) error); ok {
+		r1 = rf(querierUserID, dbName, key, startBlockNum, endBlockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLogLevels provides a mock function with given fields: querierUserID
+func (_m *DB) GetLogLevels(querierUserID string) (*types.GetLogLevelsResponseEnvelope, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 *types.GetLogLevelsResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string) *types.GetLogLevelsResponseEnvelope); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetLogLevelsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMostRecentValueAtOrBelow provides a mock function with given fields: userID, dbName, key, version
+func (_m *DB) GetMostRecentValueAtOrBelow(userID string, dbName string, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error) {
+	ret := _m.Called(userID, dbName, key, version)
+
+	var r0 *types.GetHistoricalDataResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, *types.Version) *types.GetHistoricalDataResponseEnvelope); ok {
+		r0 = rf(userID, dbName, key, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, *types.Version) error); ok {
+		r1 = rf(userID, dbName, key, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNextValues provides a mock function with given fields: userID, dbname, key, version
+func (_m *DB) GetNextValues(userID string, dbname string, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error) {
+	ret := _m.Called(userID, dbname, key, version)
+
+	var r0 *types.GetHistoricalDataResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, *types.Version) *types.GetHistoricalDataResponseEnvelope); ok {
+		r0 = rf(userID, dbname, key, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, *types.Version) error); ok {
+		r1 = rf(userID, dbname, key, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNodeConfig provides a mock function with given fields: nodeID
+func (_m *DB) GetNodeConfig(nodeID string) (*types.GetNodeConfigResponseEnvelope, error) {
+	ret := _m.Called(nodeID)
+
+	var r0 *types.GetNodeConfigResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string) *types.GetNodeConfigResponseEnvelope); ok {
+		r0 = rf(nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetNodeConfigResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPreviousValues provides a mock function with given fields: userID, dbname, key, version
+func (_m *DB) GetPreviousValues(userID string, dbname string, key string, version *types.Version) (*types.GetHistoricalDataResponseEnvelope, error) {
+	ret := _m.Called(userID, dbname, key, version)
+
+	var r0 *types.GetHistoricalDataResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string, *types.Version) *types.GetHistoricalDataResponseEnvelope); ok {
+		r0 = rf(userID, dbname, key, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, *types.Version) error); ok {
+		r1 = rf(userID, dbname, key, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReaders provides a mock function with given fields: userID, dbName, key
+func (_m *DB) GetReaders(userID string, dbName string, key string) (*types.GetDataReadersResponseEnvelope, error) {
+	ret := _m.Called(userID, dbName, key)
+
+	var r0 *types.GetDataReadersResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, string) *types.GetDataReadersResponseEnvelope); ok {
+		r0 = rf(userID, dbName, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetDataReadersResponseEnvelope)
 		}
 	}
 
@@ -492,6 +998,144 @@ func (_m *DB) GetReaders(userID string, dbName string, key string) (*types.GetDa
 	return r0, r1
 }
 
+// GetSessionBootstrap provides a mock function with given fields: querierUserID
+func (_m *DB) GetSessionBootstrap(querierUserID string) (*types.GetSessionBootstrapResponseEnvelope, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 *types.GetSessionBootstrapResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string) *types.GetSessionBootstrapResponseEnvelope); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetSessionBootstrapResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStateMigrationStatus provides a mock function with given fields: querierUserID
+func (_m *DB) GetStateMigrationStatus(querierUserID string) (*types.StateMigrationResponseEnvelope, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 *types.StateMigrationResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string) *types.StateMigrationResponseEnvelope); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateMigrationResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStateScrubStatus provides a mock function with given fields: querierUserID
+func (_m *DB) GetStateScrubStatus(querierUserID string) (*types.StateScrubResponseEnvelope, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 *types.StateScrubResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string) *types.StateScrubResponseEnvelope); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateScrubResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageHandles provides a mock function with given fields: querierUserID
+func (_m *DB) GetStorageHandles(querierUserID string) ([]*leveldb.HandleInfo, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 []*leveldb.HandleInfo
+	if rf, ok := ret.Get(0).(func(string) []*leveldb.HandleInfo); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*leveldb.HandleInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageMetrics provides a mock function with given fields: querierUserID
+func (_m *DB) GetStorageMetrics(querierUserID string) ([]*leveldb.StorageMetric, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 []*leveldb.StorageMetric
+	if rf, ok := ret.Get(0).(func(string) []*leveldb.StorageMetric); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*leveldb.StorageMetric)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageStats provides a mock function with given fields: querierUserID
+func (_m *DB) GetStorageStats(querierUserID string) (map[string]*goleveldb.DBStats, error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 map[string]*goleveldb.DBStats
+	if rf, ok := ret.Get(0).(func(string) map[string]*goleveldb.DBStats); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*goleveldb.DBStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(querierUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTxIDsSubmittedByUser provides a mock function with given fields: querierUserID, targetUserID
 func (_m *DB) GetTxIDsSubmittedByUser(querierUserID string, targetUserID string) (*types.GetTxIDsSubmittedByResponseEnvelope, error) {
 	ret := _m.Called(querierUserID, targetUserID)
@@ -561,6 +1205,29 @@ func (_m *DB) GetTxReceipt(userId string, txID string) (*types.TxReceiptResponse
 	return r0, r1
 }
 
+// GetTrustedCheckpoints provides a mock function with given fields: querierUserID, interval
+func (_m *DB) GetTrustedCheckpoints(querierUserID string, interval uint64) (*types.GetTrustedCheckpointsResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, interval)
+
+	var r0 *types.GetTrustedCheckpointsResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, uint64) *types.GetTrustedCheckpointsResponseEnvelope); ok {
+		r0 = rf(querierUserID, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetTrustedCheckpointsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
+		r1 = rf(querierUserID, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUser provides a mock function with given fields: querierUserID, targetUserID
 func (_m *DB) GetUser(querierUserID string, targetUserID string) (*types.GetUserResponseEnvelope, error) {
 	ret := _m.Called(querierUserID, targetUserID)
@@ -607,13 +1274,13 @@ func (_m *DB) GetValueAt(userID string, dbName string, key string, version *type
 	return r0, r1
 }
 
-// GetValues provides a mock function with given fields: userID, dbName, key
-func (_m *DB) GetValues(userID string, dbName string, key string) (*types.GetHistoricalDataResponseEnvelope, error) {
-	ret := _m.Called(userID, dbName, key)
+// GetValues provides a mock function with given fields: userID, dbName, key, limit, pageCursor
+func (_m *DB) GetValues(userID string, dbName string, key string, limit uint64, pageCursor string) (*types.GetHistoricalDataResponseEnvelope, error) {
+	ret := _m.Called(userID, dbName, key, limit, pageCursor)
 
 	var r0 *types.GetHistoricalDataResponseEnvelope
-	if rf, ok := ret.Get(0).(func(string, string, string) *types.GetHistoricalDataResponseEnvelope); ok {
-		r0 = rf(userID, dbName, key)
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64, string) *types.GetHistoricalDataResponseEnvelope); ok {
+		r0 = rf(userID, dbName, key, limit, pageCursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.GetHistoricalDataResponseEnvelope)
@@ -621,8 +1288,8 @@ func (_m *DB) GetValues(userID string, dbName string, key string) (*types.GetHis
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(userID, dbName, key)
+	if rf, ok := ret.Get(1).(func(string, string, string, uint64, string) error); ok {
+		r1 = rf(userID, dbName, key, limit, pageCursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -757,6 +1424,52 @@ func (_m *DB) IsDBExists(name string) bool {
 	return r0
 }
 
+// ImportDB provides a mock function with given fields: request, timeout
+func (_m *DB) ImportDB(request *types.DBImportRequestEnvelope, timeout time.Duration) (*types.DBImportResponseEnvelope, error) {
+	ret := _m.Called(request, timeout)
+
+	var r0 *types.DBImportResponseEnvelope
+	if rf, ok := ret.Get(0).(func(*types.DBImportRequestEnvelope, time.Duration) *types.DBImportResponseEnvelope); ok {
+		r0 = rf(request, timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.DBImportResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.DBImportRequestEnvelope, time.Duration) error); ok {
+		r1 = rf(request, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportUsers provides a mock function with given fields: request, timeout
+func (_m *DB) ImportUsers(request *types.UserImportRequestEnvelope, timeout time.Duration) (*types.UserImportResponseEnvelope, error) {
+	ret := _m.Called(request, timeout)
+
+	var r0 *types.UserImportResponseEnvelope
+	if rf, ok := ret.Get(0).(func(*types.UserImportRequestEnvelope, time.Duration) *types.UserImportResponseEnvelope); ok {
+		r0 = rf(request, timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.UserImportResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.UserImportRequestEnvelope, time.Duration) error); ok {
+		r1 = rf(request, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsLeader provides a mock function with given fields:
 func (_m *DB) IsLeader() *errors.NotLeaderError {
 	ret := _m.Called()
@@ -773,6 +1486,29 @@ func (_m *DB) IsLeader() *errors.NotLeaderError {
 	return r0
 }
 
+// IssueAccessToken provides a mock function with given fields: query
+func (_m *DB) IssueAccessToken(query *types.IssueAccessTokenQuery) (*types.IssueAccessTokenResponseEnvelope, error) {
+	ret := _m.Called(query)
+
+	var r0 *types.IssueAccessTokenResponseEnvelope
+	if rf, ok := ret.Get(0).(func(*types.IssueAccessTokenQuery) *types.IssueAccessTokenResponseEnvelope); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.IssueAccessTokenResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.IssueAccessTokenQuery) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LedgerHeight provides a mock function with given fields:
 func (_m *DB) LedgerHeight() (uint64, error) {
 	ret := _m.Called()
@@ -794,6 +1530,218 @@ func (_m *DB) LedgerHeight() (uint64, error) {
 	return r0, r1
 }
 
+// MigrateState provides a mock function with given fields: querierUserID, abort
+func (_m *DB) MigrateState(querierUserID string, abort bool) (*types.StateMigrationResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, abort)
+
+	var r0 *types.StateMigrationResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, bool) *types.StateMigrationResponseEnvelope); ok {
+		r0 = rf(querierUserID, abort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateMigrationResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(querierUserID, abort)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OnCommitPressure provides a mock function with given fields: listener
+func (_m *DB) OnCommitPressure(listener func(bool)) {
+	_m.Called(listener)
+}
+
+// PrefetchReads provides a mock function with given fields: hints
+func (_m *DB) PrefetchReads(hints []*types.ReadHint) {
+	_m.Called(hints)
+}
+
+// RecordAdminAction provides a mock function with given fields: record
+func (_m *DB) RecordAdminAction(record *types.AdminAuditRecord) ([]byte, error) {
+	ret := _m.Called(record)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(*types.AdminAuditRecord) []byte); ok {
+		r0 = rf(record)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.AdminAuditRecord) error); ok {
+		r1 = rf(record)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResyncDB provides a mock function with given fields: querierUserID, dbName
+func (_m *DB) ResyncDB(querierUserID string, dbName string) (*types.ResyncDBResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, dbName)
+
+	var r0 *types.ResyncDBResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string) *types.ResyncDBResponseEnvelope); ok {
+		r0 = rf(querierUserID, dbName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ResyncDBResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(querierUserID, dbName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeAccessToken provides a mock function with given fields: querierUserID, token
+func (_m *DB) RevokeAccessToken(querierUserID string, token string) (*types.RevokeAccessTokenResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, token)
+
+	var r0 *types.RevokeAccessTokenResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string) *types.RevokeAccessTokenResponseEnvelope); ok {
+		r0 = rf(querierUserID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RevokeAccessTokenResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(querierUserID, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrubState provides a mock function with given fields: querierUserID, dbName
+func (_m *DB) ScrubState(querierUserID string, dbName string) (*types.StateScrubResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, dbName)
+
+	var r0 *types.StateScrubResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string) *types.StateScrubResponseEnvelope); ok {
+		r0 = rf(querierUserID, dbName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateScrubResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(querierUserID, dbName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLogLevels provides a mock function with given fields: querierUserID, levels
+func (_m *DB) SetLogLevels(querierUserID string, levels map[string]string) (*types.GetLogLevelsResponseEnvelope, error) {
+	ret := _m.Called(querierUserID, levels)
+
+	var r0 *types.GetLogLevelsResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, map[string]string) *types.GetLogLevelsResponseEnvelope); ok {
+		r0 = rf(querierUserID, levels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetLogLevelsResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]string) error); ok {
+		r1 = rf(querierUserID, levels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeKeys provides a mock function with given fields: querierUserID, dbName, keys, prefixes
+func (_m *DB) SubscribeKeys(querierUserID string, dbName string, keys []string, prefixes []string) (<-chan *types.KeyChangesResponseEnvelope, func(), error) {
+	ret := _m.Called(querierUserID, dbName, keys, prefixes)
+
+	var r0 <-chan *types.KeyChangesResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string, []string, []string) <-chan *types.KeyChangesResponseEnvelope); ok {
+		r0 = rf(querierUserID, dbName, keys, prefixes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *types.KeyChangesResponseEnvelope)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(string, string, []string, []string) func()); ok {
+		r1 = rf(querierUserID, dbName, keys, prefixes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, []string, []string) error); ok {
+		r2 = rf(querierUserID, dbName, keys, prefixes)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SubscribePrivilegeChanges provides a mock function with given fields: querierUserID
+func (_m *DB) SubscribePrivilegeChanges(querierUserID string) (<-chan *types.PrivilegeChangesResponseEnvelope, func(), error) {
+	ret := _m.Called(querierUserID)
+
+	var r0 <-chan *types.PrivilegeChangesResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string) <-chan *types.PrivilegeChangesResponseEnvelope); ok {
+		r0 = rf(querierUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *types.PrivilegeChangesResponseEnvelope)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(string) func()); ok {
+		r1 = rf(querierUserID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(querierUserID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SubmitTransaction provides a mock function with given fields: tx, timeout
 func (_m *DB) SubmitTransaction(tx interface{}, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
 	ret := _m.Called(tx, timeout)
@@ -816,3 +1764,88 @@ func (_m *DB) SubmitTransaction(tx interface{}, timeout time.Duration) (*types.T
 
 	return r0, r1
 }
+
+// TraceValidation provides a mock function with given fields: querierUserID, blockNum
+func (_m *DB) TraceValidation(querierUserID string, blockNum uint64) (*txvalidation.TraceReport, error) {
+	ret := _m.Called(querierUserID, blockNum)
+
+	var r0 *txvalidation.TraceReport
+	if rf, ok := ret.Get(0).(func(string, uint64) *txvalidation.TraceReport); ok {
+		r0 = rf(querierUserID, blockNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txvalidation.TraceReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
+		r1 = rf(querierUserID, blockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxLatencyHistograms provides a mock function with given fields:
+func (_m *DB) TxLatencyHistograms() []*queue.LatencyHistogram {
+	ret := _m.Called()
+
+	var r0 []*queue.LatencyHistogram
+	if rf, ok := ret.Get(0).(func() []*queue.LatencyHistogram); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*queue.LatencyHistogram)
+		}
+	}
+
+	return r0
+}
+
+// VerifyAccessToken provides a mock function with given fields: token
+func (_m *DB) VerifyAccessToken(token string) (*types.AccessToken, error) {
+	ret := _m.Called(token)
+
+	var r0 *types.AccessToken
+	if rf, ok := ret.Get(0).(func(string) *types.AccessToken); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.AccessToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyTxWriteSetDigest provides a mock function with given fields: userId, txID
+func (_m *DB) VerifyTxWriteSetDigest(userId string, txID string) (*types.GetTxWriteSetDigestResponseEnvelope, error) {
+	ret := _m.Called(userId, txID)
+
+	var r0 *types.GetTxWriteSetDigestResponseEnvelope
+	if rf, ok := ret.Get(0).(func(string, string) *types.GetTxWriteSetDigestResponseEnvelope); ok {
+		r0 = rf(userId, txID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.GetTxWriteSetDigestResponseEnvelope)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userId, txID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -3,7 +3,9 @@
 package mocks
 
 import (
+	blockprocessor "github.com/hyperledger-labs/orion-server/internal/blockprocessor"
 	errors "github.com/hyperledger-labs/orion-server/internal/errors"
+	queue "github.com/hyperledger-labs/orion-server/internal/queue"
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -16,6 +18,29 @@ type TxProcessor struct {
 	mock.Mock
 }
 
+// AcceptPeerHeader provides a mock function with given fields: blockNum
+func (_m *TxProcessor) AcceptPeerHeader(blockNum uint64) (*types.StateDivergence, error) {
+	ret := _m.Called(blockNum)
+
+	var r0 *types.StateDivergence
+	if rf, ok := ret.Get(0).(func(uint64) *types.StateDivergence); ok {
+		r0 = rf(blockNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateDivergence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(blockNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxProcessor) Close() error {
 	ret := _m.Called()
@@ -69,6 +94,99 @@ func (_m *TxProcessor) IsLeader() *errors.NotLeaderError {
 	return r0
 }
 
+// IsTxExpired provides a mock function with given fields: txID
+func (_m *TxProcessor) IsTxExpired(txID string) bool {
+	ret := _m.Called(txID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(txID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// OnCommitPressure provides a mock function with given fields: listener
+func (_m *TxProcessor) OnCommitPressure(listener func(bool)) {
+	_m.Called(listener)
+}
+
+// PrefetchReads provides a mock function with given fields: hints
+func (_m *TxProcessor) PrefetchReads(hints []*types.ReadHint) {
+	_m.Called(hints)
+}
+
+// RecoveryStatus provides a mock function with given fields:
+func (_m *TxProcessor) RecoveryStatus() []*blockprocessor.RecoveryStatus {
+	ret := _m.Called()
+
+	var r0 []*blockprocessor.RecoveryStatus
+	if rf, ok := ret.Get(0).(func() []*blockprocessor.RecoveryStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*blockprocessor.RecoveryStatus)
+		}
+	}
+
+	return r0
+}
+
+// ResyncDB provides a mock function with given fields: dbName
+func (_m *TxProcessor) ResyncDB(dbName string) ([]uint64, error) {
+	ret := _m.Called(dbName)
+
+	var r0 []uint64
+	if rf, ok := ret.Get(0).(func(string) []uint64); ok {
+		r0 = rf(dbName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(dbName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Shutdown provides a mock function with given fields: report
+func (_m *TxProcessor) Shutdown(report func(string)) error {
+	ret := _m.Called(report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(string)) error); ok {
+		r0 = rf(report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateDivergence provides a mock function with given fields: 
+func (_m *TxProcessor) StateDivergence() *types.StateDivergence {
+	ret := _m.Called()
+
+	var r0 *types.StateDivergence
+	if rf, ok := ret.Get(0).(func() *types.StateDivergence); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateDivergence)
+		}
+	}
+
+	return r0
+}
+
 // SubmitTransaction provides a mock function with given fields: tx, timeout
 func (_m *TxProcessor) SubmitTransaction(tx interface{}, timeout time.Duration) (*types.TxReceiptResponse, error) {
 	ret := _m.Called(tx, timeout)
@@ -91,3 +209,35 @@ func (_m *TxProcessor) SubmitTransaction(tx interface{}, timeout time.Duration)
 
 	return r0, r1
 }
+
+// TxLatencyHistograms provides a mock function with given fields:
+func (_m *TxProcessor) TxLatencyHistograms() []*queue.LatencyHistogram {
+	ret := _m.Called()
+
+	var r0 []*queue.LatencyHistogram
+	if rf, ok := ret.Get(0).(func() []*queue.LatencyHistogram); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*queue.LatencyHistogram)
+		}
+	}
+
+	return r0
+}
+
+// TxRejection provides a mock function with given fields: txID
+func (_m *TxProcessor) TxRejection(txID string) *types.ValidationInfo {
+	ret := _m.Called(txID)
+
+	var r0 *types.ValidationInfo
+	if rf, ok := ret.Get(0).(func(string) *types.ValidationInfo); ok {
+		r0 = rf(txID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ValidationInfo)
+		}
+	}
+
+	return r0
+}
@@ -19,3 +19,41 @@ func constructProvenanceStorePath(dir string) string {
 func constructStateTrieStorePath(dir string) string {
 	return filepath.Join(dir, "statetriestore")
 }
+
+// constructValidationTracePath returns the directory of the scratch state database on which the state is rebuilt
+// to trace the validation of a block
+func constructValidationTracePath(dir string) string {
+	return filepath.Join(dir, "validationtrace")
+}
+
+// constructReadReplicaPath returns the directory of the checkpoints of the state database on which the read replica
+// is opened
+func constructReadReplicaPath(dir string) string {
+	return filepath.Join(dir, "readreplica")
+}
+
+// constructPartialBatchPath returns the file on which the tx reorderer persists its partial batch across restarts
+func constructPartialBatchPath(dir string) string {
+	return filepath.Join(dir, "partialbatch")
+}
+
+// constructDeadLetterPath returns the directory of the store of the dead-letter records of the transactions dropped
+// before they were included in a block
+func constructDeadLetterPath(dir string) string {
+	return filepath.Join(dir, "deadletter")
+}
+
+// constructAdminAuditPath returns the directory of the store of the audit records of the administrative actions
+func constructAdminAuditPath(dir string) string {
+	return filepath.Join(dir, "adminaudit")
+}
+
+// constructAccessTokenPath returns the directory of the store of the denylist of the revoked access tokens
+func constructAccessTokenPath(dir string) string {
+	return filepath.Join(dir, "accesstokens")
+}
+
+// constructCursorSecretPath returns the file of the secret generated by the node to sign the pagination cursors
+func constructCursorSecretPath(dir string) string {
+	return filepath.Join(dir, "cursorsecret")
+}
@@ -35,4 +35,28 @@ func TestPath(t *testing.T) {
 			fmt.Sprintf("%s/blockstore", dir),
 		)
 	})
+
+	t.Run("validation trace path", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "validationtrace")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		require.Equal(
+			t,
+			constructValidationTracePath(dir),
+			fmt.Sprintf("%s/validationtrace", dir),
+		)
+	})
+
+	t.Run("partial batch path", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "partialbatch")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		require.Equal(
+			t,
+			constructPartialBatchPath(dir),
+			fmt.Sprintf("%s/partialbatch", dir),
+		)
+	})
 }
@@ -0,0 +1,450 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/accesscontrol"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/provenance"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const defaultHistoricalQueryCostLimit = 100000
+
+// pointInTimeQueryProcessor serves the data queries as of a past block. The values are read from the version
+// index of the provenance store instead of the worldstate, and the permissions are evaluated against the user
+// privileges and the ACLs which were in place at that block. Under the union historical read policy, a user who
+// could not read a value at that block may still read it if the user can read the key now. Queries as of the current
+// block are served by the worldstate query processor.
+type pointInTimeQueryProcessor struct {
+	db                       worldstate.DB
+	provenanceStore          *provenance.Store
+	worldstateQueryProcessor *worldstateQueryProcessor
+	queryProcessingConf      *config.QueryProcessingConf
+	historicalReadPolicy     accesscontrol.HistoricalReadPolicy
+	logger                   *logger.SugarLogger
+}
+
+type pointInTimeQueryProcessorConfig struct {
+	db                       worldstate.DB
+	provenanceStore          *provenance.Store
+	worldstateQueryProcessor *worldstateQueryProcessor
+	queryProcessingConf      *config.QueryProcessingConf
+	historicalReadPolicy     accesscontrol.HistoricalReadPolicy
+	logger                   *logger.SugarLogger
+}
+
+func newPointInTimeQueryProcessor(conf *pointInTimeQueryProcessorConfig) *pointInTimeQueryProcessor {
+	return &pointInTimeQueryProcessor{
+		db:                       conf.db,
+		provenanceStore:          conf.provenanceStore,
+		worldstateQueryProcessor: conf.worldstateQueryProcessor,
+		queryProcessingConf:      conf.queryProcessingConf,
+		historicalReadPolicy:     conf.historicalReadPolicy,
+		logger:                   conf.logger,
+	}
+}
+
+// getData returns the value held by the given key at the end of the block `asOf`
+func (p *pointInTimeQueryProcessor) getData(dbName, querierUserID, key string, asOf uint64) (*types.GetDataResponse, error) {
+	isCurrent, err := p.isCurrentHeight(asOf)
+	if err != nil {
+		return nil, err
+	}
+	if isCurrent {
+		return p.worldstateQueryProcessor.getData(dbName, querierUserID, key)
+	}
+
+	costLimit := p.costLimit()
+	dbReadable, cost, err := p.hasReadAccessOnDataDB(querierUserID, dbName, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if !dbReadable && !p.historicalReadPolicy.FallsBackToCurrentAccess() {
+		return nil, noReadAccessOnDataDBAsOfErr(querierUserID, dbName, asOf)
+	}
+
+	value, c, err := p.provenanceStore.GetValueAsOf(dbName, key, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if cost += c; cost > costLimit {
+		return nil, &ierrors.ServerRestrictionError{
+			ErrMsg: fmt.Sprintf("the cost of the query as of block [%d] exceeds the configured limit of %d key versions. Increase the historical query cost limit at the server", asOf, costLimit),
+		}
+	}
+
+	readableThen := dbReadable && (value == nil || accesscontrol.CanRead(querierUserID, value.GetMetadata().GetAccessControl()))
+	readable, err := p.historicalReadPolicy.Allows(readableThen, p.currentReadAccess(querierUserID, dbName, key))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !readable && !dbReadable:
+		return nil, noReadAccessOnDataDBAsOfErr(querierUserID, dbName, asOf)
+	case !readable:
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: fmt.Sprintf("the user [%s] has no permission to read key [%s] from database [%s] as of block [%d]", querierUserID, key, dbName, asOf),
+		}
+	case value == nil:
+		return &types.GetDataResponse{}, nil
+	}
+
+	return &types.GetDataResponse{
+		Value:    value.GetValue(),
+		Metadata: value.GetMetadata(),
+	}, nil
+}
+
+// getDataRange returns the values held by the keys in the range [startKey, endKey) at the end of the block `asOf`.
+// When either the limit on the number of records, the response size limit, or the cost limit is reached, a partial
+// result is returned along with the key to continue from.
+func (p *pointInTimeQueryProcessor) getDataRange(dbName, querierUserID, startKey, endKey string, limit, asOf uint64) (*types.GetDataRangeResponse, error) {
+	isCurrent, err := p.isCurrentHeight(asOf)
+	if err != nil {
+		return nil, err
+	}
+	if isCurrent {
+		return p.worldstateQueryProcessor.getDataRange(dbName, querierUserID, startKey, endKey, limit)
+	}
+
+	costLimit := p.costLimit()
+	dbReadable, cost, err := p.hasReadAccessOnDataDB(querierUserID, dbName, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if !dbReadable && !p.historicalReadPolicy.FallsBackToCurrentAccess() {
+		return nil, noReadAccessOnDataDBAsOfErr(querierUserID, dbName, asOf)
+	}
+
+	// the keys are found by examining all the keys of the database, each of which costs one unit of the limit
+	var remainingCost uint64
+	if cost < costLimit {
+		remainingCost = costLimit - cost
+	}
+	keys, c, err := p.getKeysInRange(dbName, startKey, endKey, remainingCost)
+	if err != nil {
+		return nil, err
+	}
+	if cost += c; cost > costLimit {
+		return nil, &ierrors.ServerRestrictionError{
+			ErrMsg: fmt.Sprintf("the number of keys of database [%s] exceeds the configured historical query cost limit of %d. Increase the historical query cost limit at the server", dbName, costLimit),
+		}
+	}
+
+	var kvs []*types.KVWithMetadata
+	var resultCount uint64
+	var size uint64
+	var pendingResult bool
+	var nextStartKey string
+
+	for i, k := range keys {
+		v, c, err := p.provenanceStore.GetValueAsOf(dbName, k, asOf)
+		if err != nil {
+			return nil, err
+		}
+
+		cost += c
+		if cost > costLimit {
+			pendingResult = true
+			nextStartKey = k
+			if i != 0 {
+				break
+			}
+
+			return nil, &ierrors.ServerRestrictionError{
+				ErrMsg: fmt.Sprintf("the cost of the query as of block [%d] exceeds the configured limit of %d key versions. Increase the historical query cost limit at the server", asOf, costLimit),
+			}
+		}
+
+		if v == nil {
+			continue
+		}
+
+		readableThen := dbReadable && accesscontrol.CanRead(querierUserID, v.GetMetadata().GetAccessControl())
+		readable, err := p.historicalReadPolicy.Allows(readableThen, p.currentReadAccess(querierUserID, dbName, k))
+		if err != nil {
+			return nil, err
+		}
+		if !readable {
+			continue
+		}
+
+		if limit > 0 {
+			resultCount++
+			if resultCount > limit {
+				pendingResult = true
+				nextStartKey = k
+				break
+			}
+		}
+
+		size += uint64(len(k) + proto.Size(v))
+		if size > p.queryProcessingConf.ResponseSizeLimitInBytes {
+			pendingResult = true
+			nextStartKey = k
+			if len(kvs) != 0 {
+				break
+			}
+
+			return nil, &ierrors.ServerRestrictionError{
+				ErrMsg: fmt.Sprintf("response size limit for queries is configured as %d bytes but a single record size itself is %d bytes. Increase the query response size limit at the server", p.queryProcessingConf.ResponseSizeLimitInBytes, size),
+			}
+		}
+
+		kvs = append(kvs, &types.KVWithMetadata{
+			Key:      k,
+			Value:    v.GetValue(),
+			Metadata: v.GetMetadata(),
+		})
+	}
+
+	return &types.GetDataRangeResponse{
+		KVs:           kvs,
+		PendingResult: pendingResult,
+		NextStartKey:  nextStartKey,
+	}, nil
+}
+
+// getKeysInRange returns the keys ever written to the database in the range [startKey, endKey), in the order of the
+// key collation of the database, along with the cost of the lookup. As the provenance store orders the keys byte by
+// byte, the keys of a database with a collation other than BINARY are all fetched, and then selected and ordered by
+// the collation. No keys are returned once the cost exceeds costLimit.
+func (p *pointInTimeQueryProcessor) getKeysInRange(dbName, startKey, endKey string, costLimit uint64) ([]string, uint64, error) {
+	collation, err := worldstate.GetKeyCollation(p.db, dbName)
+	if err != nil {
+		return nil, 0, err
+	}
+	if worldstate.IsBinaryCollation(collation) {
+		return p.provenanceStore.GetKeys(dbName, startKey, endKey, costLimit)
+	}
+
+	for _, bound := range []string{startKey, endKey} {
+		if bound == "" {
+			continue
+		}
+		if _, err := worldstate.CollateRangeBound(collation, bound); err != nil {
+			return nil, 0, rangeBoundErr(err)
+		}
+	}
+
+	allKeys, cost, err := p.provenanceStore.GetKeys(dbName, "", "", costLimit)
+	if err != nil || cost > costLimit {
+		return nil, cost, err
+	}
+
+	var keys []string
+	for _, k := range allKeys {
+		if worldstate.InCollatedRange(collation, k, startKey, endKey) {
+			keys = append(keys, k)
+		}
+	}
+	worldstate.SortKeys(collation, keys)
+	return keys, cost, nil
+}
+
+// getDBDescriptor returns the descriptor of the database at the end of the block `asOf`, or the committed descriptor
+// when `asOf` is 0. The descriptor in place at the end of a block is the one the validation of the next block used.
+func (p *pointInTimeQueryProcessor) getDBDescriptor(dbName, querierUserID string, asOf uint64) (*types.GetDBDescriptorResponse, error) {
+	if worldstate.IsSystemDB(dbName) {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "no descriptor for the system database [" + dbName + "]",
+		}
+	}
+
+	isCurrent := asOf == 0
+	if !isCurrent {
+		var err error
+		if isCurrent, err = p.isCurrentHeight(asOf); err != nil {
+			return nil, err
+		}
+	}
+
+	var value []byte
+	var metadata *types.Metadata
+	if isCurrent {
+		if err := p.checkCurrentReadAccessOnDataDB(querierUserID, dbName); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if value, metadata, err = p.db.Get(worldstate.DBDescriptorsDBName, dbName); err != nil {
+			return nil, err
+		}
+	} else {
+		costLimit := p.costLimit()
+		dbReadable, cost, err := p.hasReadAccessOnDataDB(querierUserID, dbName, asOf)
+		if err != nil {
+			return nil, err
+		}
+		if !dbReadable {
+			return nil, noReadAccessOnDataDBAsOfErr(querierUserID, dbName, asOf)
+		}
+
+		v, c, err := p.provenanceStore.GetValueAsOf(worldstate.DBDescriptorsDBName, dbName, asOf)
+		if err != nil {
+			return nil, err
+		}
+		if cost += c; cost > costLimit {
+			return nil, &ierrors.ServerRestrictionError{
+				ErrMsg: fmt.Sprintf("the cost of the query as of block [%d] exceeds the configured limit of %d key versions. Increase the historical query cost limit at the server", asOf, costLimit),
+			}
+		}
+		value, metadata = v.GetValue(), v.GetMetadata()
+	}
+
+	if metadata == nil {
+		return &types.GetDBDescriptorResponse{}, nil
+	}
+
+	descriptor := &types.DBDescriptor{}
+	if err := proto.Unmarshal(value, descriptor); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the descriptor of the database [%s]", dbName)
+	}
+	return &types.GetDBDescriptorResponse{
+		DbDescriptor: descriptor,
+		Version:      metadata.GetVersion(),
+	}, nil
+}
+
+// getDBDescriptorHistory returns every version of the descriptor of the database, in order, along with the admin who
+// set it. The versions of a database that was deleted and created again are included.
+func (p *pointInTimeQueryProcessor) getDBDescriptorHistory(dbName, querierUserID string) (*types.GetDBDescriptorHistoryResponse, error) {
+	if worldstate.IsSystemDB(dbName) {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "no descriptor for the system database [" + dbName + "]",
+		}
+	}
+	if err := p.checkCurrentReadAccessOnDataDB(querierUserID, dbName); err != nil {
+		return nil, err
+	}
+	if p.provenanceStore == nil {
+		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
+	}
+
+	values, err := p.provenanceStore.GetWrittenValues(worldstate.DBDescriptorsDBName, dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*types.DBDescriptorChange
+	for _, v := range values {
+		descriptor := &types.DBDescriptor{}
+		if err := proto.Unmarshal(v.Value.GetValue(), descriptor); err != nil {
+			return nil, errors.Wrapf(err, "error while unmarshaling the descriptor of the database [%s]", dbName)
+		}
+		changes = append(changes, &types.DBDescriptorChange{
+			DbDescriptor: descriptor,
+			Version:      v.Value.GetMetadata().GetVersion(),
+			TxId:         v.TxID,
+			UserId:       v.UserID,
+		})
+	}
+
+	return &types.GetDBDescriptorHistoryResponse{
+		Changes: changes,
+	}, nil
+}
+
+// isCurrentHeight returns true if the block `asOf` is the last committed block, and false if it is a past block.
+// Blocks which are yet to be committed cannot be queried.
+func (p *pointInTimeQueryProcessor) isCurrentHeight(asOf uint64) (bool, error) {
+	height, err := p.db.Height()
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case asOf == 0:
+		return false, &ierrors.BadRequestError{ErrMsg: "the block number of a query as of a past block must be greater than 0"}
+	case asOf > height:
+		return false, &ierrors.BadRequestError{ErrMsg: fmt.Sprintf("block [%d] is greater than the current height [%d]", asOf, height)}
+	case asOf == height:
+		return true, nil
+	}
+
+	if p.provenanceStore == nil {
+		return false, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
+	}
+	return false, nil
+}
+
+// hasReadAccessOnDataDB returns whether the user had read access on the database at the end of the block `asOf`,
+// along with the cost of reading the user as of that block. A system database is never readable.
+func (p *pointInTimeQueryProcessor) hasReadAccessOnDataDB(querierUserID, dbName string, asOf uint64) (bool, uint64, error) {
+	if worldstate.IsSystemDB(dbName) {
+		return false, 0, &ierrors.PermissionErr{
+			ErrMsg: "no user can directly read from a system database [" + dbName + "]. " +
+				"To read from a system database, use /config, /user, /db rest endpoints instead of /data",
+		}
+	}
+
+	value, cost, err := p.provenanceStore.GetValueAsOf(worldstate.UsersDBName, querierUserID, asOf)
+	if err != nil || value == nil {
+		return false, cost, err
+	}
+
+	user := &types.User{}
+	if err := proto.Unmarshal(value.GetValue(), user); err != nil {
+		return false, cost, err
+	}
+
+	if user.GetPrivilege().GetAdmin() {
+		return true, cost, nil
+	}
+	perm, ok := user.GetPrivilege().GetDbPermission()[dbName]
+	return ok && perm >= types.Privilege_Read, cost, nil
+}
+
+func noReadAccessOnDataDBAsOfErr(querierUserID, dbName string, asOf uint64) error {
+	return &ierrors.PermissionErr{
+		ErrMsg: fmt.Sprintf("the user [%s] has no permission to read from database [%s] as of block [%d]", querierUserID, dbName, asOf),
+	}
+}
+
+// currentReadAccess returns a function which decides whether the user can read the key in the committed state, i.e.,
+// whether the user can read from the database now, and the committed value of the key, if any, permits the user to
+// read it
+func (p *pointInTimeQueryProcessor) currentReadAccess(querierUserID, dbName, key string) func() (bool, error) {
+	return func() (bool, error) {
+		hasPerm, err := p.worldstateQueryProcessor.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
+		if err != nil || !hasPerm {
+			return false, err
+		}
+
+		_, metadata, err := p.db.Get(dbName, key)
+		if err != nil || metadata == nil {
+			return false, err
+		}
+		return accesscontrol.CanRead(querierUserID, metadata.GetAccessControl()), nil
+	}
+}
+
+// checkCurrentReadAccessOnDataDB checks whether the user has read access on the database in the committed state
+func (p *pointInTimeQueryProcessor) checkCurrentReadAccessOnDataDB(querierUserID, dbName string) error {
+	hasPerm, err := p.worldstateQueryProcessor.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
+	if err != nil {
+		return err
+	}
+	if !hasPerm {
+		return &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to read from database [" + dbName + "]",
+		}
+	}
+	return nil
+}
+
+func (p *pointInTimeQueryProcessor) costLimit() uint64 {
+	if p.queryProcessingConf.HistoricalQueryCostLimit == 0 {
+		return defaultHistoricalQueryCostLimit
+	}
+	return p.queryProcessingConf.HistoricalQueryCostLimit
+}
@@ -0,0 +1,465 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/config"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/provenance"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type pointInTimeQueryProcessorTestEnv struct {
+	db              *leveldb.LevelDB
+	provenanceStore *provenance.Store
+	conf            *config.QueryProcessingConf
+	p               *pointInTimeQueryProcessor
+	cleanup         func(t *testing.T)
+}
+
+func newPointInTimeQueryProcessorTestEnv(t *testing.T) *pointInTimeQueryProcessorTestEnv {
+	c := &logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	}
+	logger, err := logger.New(c)
+	require.NoError(t, err)
+
+	dbPath, err := ioutil.TempDir("/tmp", "pointInTimeQueryProcessor")
+	require.NoError(t, err)
+	db, err := leveldb.Open(
+		&leveldb.Config{
+			DBRootDir: dbPath,
+			Logger:    logger,
+		},
+	)
+	if err != nil {
+		if err := os.RemoveAll(dbPath); err != nil {
+			t.Errorf("failed to remove %s due to %v", dbPath, err)
+		}
+
+		t.Fatalf("failed to create a new leveldb instance, %v", err)
+	}
+
+	provenancePath, err := ioutil.TempDir("/tmp", "pointInTimeProvenance")
+	require.NoError(t, err)
+	provenanceStore, err := provenance.Open(
+		&provenance.Config{
+			StoreDir: provenancePath,
+			Logger:   logger,
+		},
+	)
+	if err != nil {
+		if err := os.RemoveAll(provenancePath); err != nil {
+			t.Errorf("failed to remove %s due to %v", provenancePath, err)
+		}
+
+		t.Fatalf("failed to create a new provenance store, %v", err)
+	}
+
+	cleanup := func(t *testing.T) {
+		if err := provenanceStore.Close(); err != nil {
+			t.Errorf("failed to close the provenance store: %v", err)
+		}
+		if err := db.Close(); err != nil {
+			t.Errorf("failed to close leveldb: %v", err)
+		}
+		if err := os.RemoveAll(provenancePath); err != nil {
+			t.Fatalf("failed to remove %s due to %v", provenancePath, err)
+		}
+		if err := os.RemoveAll(dbPath); err != nil {
+			t.Fatalf("failed to remove %s due to %v", dbPath, err)
+		}
+	}
+
+	conf := &config.QueryProcessingConf{
+		ResponseSizeLimitInBytes: 1048576,
+	}
+	worldstateQueryProcessor := newWorldstateQueryProcessor(
+		&worldstateQueryProcessorConfig{
+			db:                  db,
+			queryProcessingConf: conf,
+			identityQuerier:     identity.NewQuerier(db),
+			logger:              logger,
+		},
+	)
+
+	return &pointInTimeQueryProcessorTestEnv{
+		db:              db,
+		provenanceStore: provenanceStore,
+		conf:            conf,
+		p: newPointInTimeQueryProcessor(
+			&pointInTimeQueryProcessorConfig{
+				db:                       db,
+				provenanceStore:          provenanceStore,
+				worldstateQueryProcessor: worldstateQueryProcessor,
+				queryProcessingConf:      conf,
+				logger:                   logger,
+			},
+		),
+		cleanup: cleanup,
+	}
+}
+
+// historyOp is a write, or a delete when the value is nil, of a key of db1 in a block
+type historyOp struct {
+	blockNum uint64
+	key      string
+	value    *types.ValueWithMetadata
+}
+
+// pointInTimeHistory builds a history of blocks with the same transactions committed to both the worldstate and
+// the provenance store, and keeps a log of the data operations to reconstruct the state at any block by brute force.
+type pointInTimeHistory struct {
+	env      *pointInTimeQueryProcessorTestEnv
+	ops      []*historyOp
+	state    map[string]*types.ValueWithMetadata
+	users    map[string]*types.Version
+	blockNum uint64
+}
+
+func (h *pointInTimeHistory) commitBlock(t *testing.T, users []*types.User, rnd *rand.Rand, keys []string) {
+	h.blockNum++
+	version := func(txNum int) *types.Version {
+		return &types.Version{BlockNum: h.blockNum, TxNum: uint64(txNum)}
+	}
+
+	var provenanceData []*provenance.TxDataForProvenance
+	dbsUpdates := map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {},
+		"db1":                  {},
+	}
+
+	for _, user := range users {
+		txNum := len(provenanceData)
+		userSerialized, err := proto.Marshal(user)
+		require.NoError(t, err)
+
+		txData := &provenance.TxDataForProvenance{
+			IsValid: true,
+			DBName:  worldstate.UsersDBName,
+			UserID:  "admin",
+			TxID:    fmt.Sprintf("tx-%d-%d", h.blockNum, txNum),
+			Writes: []*types.KVWithMetadata{
+				{Key: user.Id, Value: userSerialized, Metadata: &types.Metadata{Version: version(txNum)}},
+			},
+			OldVersionOfWrites: map[string]*types.Version{},
+		}
+		if v, ok := h.users[user.Id]; ok {
+			txData.OldVersionOfWrites[user.Id] = v
+		}
+		h.users[user.Id] = version(txNum)
+		provenanceData = append(provenanceData, txData)
+
+		dbsUpdates[worldstate.UsersDBName].Writes = append(dbsUpdates[worldstate.UsersDBName].Writes, &worldstate.KVWithMetadata{
+			Key:      string(identity.UserNamespace) + user.Id,
+			Value:    userSerialized,
+			Metadata: &types.Metadata{Version: version(txNum)},
+		})
+	}
+
+	for _, key := range keys {
+		txNum := len(provenanceData)
+		txData := &provenance.TxDataForProvenance{
+			IsValid:            true,
+			DBName:             "db1",
+			UserID:             "alice",
+			TxID:               fmt.Sprintf("tx-%d-%d", h.blockNum, txNum),
+			Deletes:            map[string]*types.Version{},
+			OldVersionOfWrites: map[string]*types.Version{},
+		}
+
+		if old, ok := h.state[key]; ok && rnd.Intn(3) == 0 {
+			txData.Deletes[key] = old.Metadata.Version
+			dbsUpdates["db1"].Deletes = append(dbsUpdates["db1"].Deletes, key)
+			h.ops = append(h.ops, &historyOp{blockNum: h.blockNum, key: key})
+			delete(h.state, key)
+			provenanceData = append(provenanceData, txData)
+			continue
+		}
+
+		var acl *types.AccessControl
+		switch rnd.Intn(3) {
+		case 1:
+			acl = &types.AccessControl{ReadUsers: map[string]bool{"alice": true}}
+		case 2:
+			acl = &types.AccessControl{ReadWriteUsers: map[string]bool{"bob": true, "carol": true}}
+		}
+		value := &types.ValueWithMetadata{
+			Value:    []byte(fmt.Sprintf("%s-value-%d", key, h.blockNum)),
+			Metadata: &types.Metadata{Version: version(txNum), AccessControl: acl},
+		}
+
+		if old, ok := h.state[key]; ok {
+			txData.OldVersionOfWrites[key] = old.Metadata.Version
+		}
+		txData.Writes = []*types.KVWithMetadata{{Key: key, Value: value.Value, Metadata: value.Metadata}}
+		dbsUpdates["db1"].Writes = append(dbsUpdates["db1"].Writes, &worldstate.KVWithMetadata{
+			Key:      key,
+			Value:    value.Value,
+			Metadata: value.Metadata,
+		})
+		h.ops = append(h.ops, &historyOp{blockNum: h.blockNum, key: key, value: value})
+		h.state[key] = value
+		provenanceData = append(provenanceData, txData)
+	}
+
+	require.NoError(t, h.env.provenanceStore.Commit(h.blockNum, provenanceData))
+	require.NoError(t, h.env.db.Commit(dbsUpdates, h.blockNum))
+}
+
+// reconstruct replays the data operations up to the given block and returns the values readable by the user, in
+// the key order
+func (h *pointInTimeHistory) reconstruct(asOf uint64, userID string) []*types.KVWithMetadata {
+	state := make(map[string]*types.ValueWithMetadata)
+	for _, op := range h.ops {
+		if op.blockNum > asOf {
+			break
+		}
+		if op.value == nil {
+			delete(state, op.key)
+			continue
+		}
+		state[op.key] = op.value
+	}
+
+	var kvs []*types.KVWithMetadata
+	for k, v := range state {
+		acl := v.Metadata.AccessControl
+		if acl != nil && !acl.ReadUsers[userID] && !acl.ReadWriteUsers[userID] {
+			continue
+		}
+		kvs = append(kvs, &types.KVWithMetadata{Key: k, Value: v.Value, Metadata: v.Metadata})
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return kvs[i].Key < kvs[j].Key
+	})
+
+	return kvs
+}
+
+func TestPointInTimeQueries(t *testing.T) {
+	env := newPointInTimeQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+
+	h := &pointInTimeHistory{
+		env:   env,
+		state: make(map[string]*types.ValueWithMetadata),
+		users: make(map[string]*types.Version),
+	}
+
+	reader := func(id string) *types.User {
+		return &types.User{
+			Id: id,
+			Privilege: &types.Privilege{
+				DbPermission: map[string]types.Privilege_Access{"db1": types.Privilege_Read},
+			},
+		}
+	}
+
+	var allKeys []string
+	for i := 0; i < 10; i++ {
+		allKeys = append(allKeys, fmt.Sprintf("key%d", i))
+	}
+
+	// block 1 creates db1 and the users alice and bob, carol is added in block 4, and bob loses the access to
+	// db1 in block 6
+	rnd := rand.New(rand.NewSource(7))
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}}},
+	}, 1))
+	h.commitBlock(t, []*types.User{reader("alice"), reader("bob")}, rnd, nil)
+	for h.blockNum < 12 {
+		var users []*types.User
+		switch h.blockNum + 1 {
+		case 4:
+			users = append(users, reader("carol"))
+		case 6:
+			users = append(users, &types.User{Id: "bob", Privilege: &types.Privilege{}})
+		}
+
+		perm := rnd.Perm(len(allKeys))
+		var keys []string
+		for _, i := range perm[:4] {
+			keys = append(keys, allKeys[i])
+		}
+		h.commitBlock(t, users, rnd, keys)
+	}
+
+	hasAccess := func(userID string, asOf uint64) bool {
+		switch userID {
+		case "bob":
+			return asOf < 6
+		case "carol":
+			return asOf >= 4
+		}
+		return true
+	}
+
+	// the last block is the current height, and is served from the worldstate
+	for asOf := uint64(1); asOf <= h.blockNum; asOf++ {
+		for _, userID := range []string{"alice", "bob", "carol"} {
+			t.Run(fmt.Sprintf("data of user %s as of block %d", userID, asOf), func(t *testing.T) {
+				expected := h.reconstruct(asOf, userID)
+
+				for _, key := range allKeys {
+					res, err := env.p.getData("db1", userID, key, asOf)
+					if !hasAccess(userID, asOf) {
+						require.IsType(t, &ierrors.PermissionErr{}, err)
+						continue
+					}
+
+					var expectedValue *types.KVWithMetadata
+					for _, kv := range expected {
+						if kv.Key == key {
+							expectedValue = kv
+						}
+					}
+					if expectedValue != nil {
+						require.NoError(t, err)
+						require.Equal(t, expectedValue.Value, res.Value)
+						require.True(t, proto.Equal(expectedValue.Metadata, res.Metadata))
+						continue
+					}
+
+					// the key either did not exist or was not readable by the user
+					if err != nil {
+						require.IsType(t, &ierrors.PermissionErr{}, err)
+						continue
+					}
+					require.Nil(t, res.Value)
+					require.Nil(t, res.Metadata)
+				}
+
+				for _, limit := range []uint64{0, 3} {
+					var actual []*types.KVWithMetadata
+					startKey := ""
+					for {
+						res, err := env.p.getDataRange("db1", userID, startKey, "", limit, asOf)
+						if !hasAccess(userID, asOf) {
+							require.IsType(t, &ierrors.PermissionErr{}, err)
+							break
+						}
+						require.NoError(t, err)
+						actual = append(actual, res.KVs...)
+						if !res.PendingResult {
+							break
+						}
+						require.Len(t, res.KVs, int(limit))
+						startKey = res.NextStartKey
+					}
+
+					if !hasAccess(userID, asOf) {
+						continue
+					}
+					require.Len(t, actual, len(expected))
+					for i := range expected {
+						require.Equal(t, expected[i].Key, actual[i].Key)
+						require.Equal(t, expected[i].Value, actual[i].Value)
+						require.True(t, proto.Equal(expected[i].Metadata, actual[i].Metadata))
+					}
+				}
+			})
+		}
+	}
+
+	t.Run("range of keys", func(t *testing.T) {
+		expected := h.reconstruct(8, "alice")
+		res, err := env.p.getDataRange("db1", "alice", "key3", "key7", 0, 8)
+		require.NoError(t, err)
+
+		var expectedKeys, actualKeys []string
+		for _, kv := range expected {
+			if kv.Key >= "key3" && kv.Key < "key7" {
+				expectedKeys = append(expectedKeys, kv.Key)
+			}
+		}
+		for _, kv := range res.KVs {
+			actualKeys = append(actualKeys, kv.Key)
+		}
+		require.Equal(t, expectedKeys, actualKeys)
+	})
+
+	t.Run("query cost limit", func(t *testing.T) {
+		defer func() {
+			env.conf.HistoricalQueryCostLimit = 0
+		}()
+
+		// every page examines all the keys of db1, each key has at most 11 versions, and alice a single version, so
+		// every page makes progress
+		_, numKeys, err := env.p.provenanceStore.GetKeys("db1", "", "", 100)
+		require.NoError(t, err)
+		env.conf.HistoricalQueryCostLimit = numKeys + 12
+		expected := h.reconstruct(10, "alice")
+		var actual []*types.KVWithMetadata
+		startKey := ""
+		pages := 0
+		for {
+			res, err := env.p.getDataRange("db1", "alice", startKey, "", 0, 10)
+			require.NoError(t, err)
+			actual = append(actual, res.KVs...)
+			pages++
+			if !res.PendingResult {
+				break
+			}
+			startKey = res.NextStartKey
+		}
+		require.Greater(t, pages, 1)
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			require.Equal(t, expected[i].Key, actual[i].Key)
+		}
+
+		env.conf.HistoricalQueryCostLimit = numKeys + 1
+		res, err := env.p.getDataRange("db1", "alice", "", "", 0, 10)
+		require.EqualError(t, err, fmt.Sprintf("the cost of the query as of block [10] exceeds the configured limit of %d key versions. Increase the historical query cost limit at the server", numKeys+1))
+		require.IsType(t, &ierrors.ServerRestrictionError{}, err)
+		require.Nil(t, res)
+
+		// the keys are examined even for a range that holds none of them
+		env.conf.HistoricalQueryCostLimit = numKeys
+		res, err = env.p.getDataRange("db1", "alice", "key99", "", 0, 10)
+		require.EqualError(t, err, fmt.Sprintf("the number of keys of database [db1] exceeds the configured historical query cost limit of %d. Increase the historical query cost limit at the server", numKeys))
+		require.IsType(t, &ierrors.ServerRestrictionError{}, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("block above the current height", func(t *testing.T) {
+		res, err := env.p.getData("db1", "alice", "key1", 13)
+		require.EqualError(t, err, "block [13] is greater than the current height [12]")
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("system database", func(t *testing.T) {
+		res, err := env.p.getDataRange(worldstate.UsersDBName, "alice", "", "", 0, 5)
+		require.IsType(t, &ierrors.PermissionErr{}, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("provenance store disabled", func(t *testing.T) {
+		p := newPointInTimeQueryProcessor(&pointInTimeQueryProcessorConfig{
+			db:                  env.db,
+			queryProcessingConf: env.conf,
+			logger:              env.p.logger,
+		})
+		res, err := p.getData("db1", "alice", "key1", 5)
+		require.EqualError(t, err, "provenance store is disabled on this server")
+		require.Nil(t, res)
+	})
+}
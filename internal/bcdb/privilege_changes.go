@@ -0,0 +1,301 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+const (
+	privilegeChangesListenerName = "privilegeChanges"
+
+	// privilegeChangesBuffer is the number of notifications that a session holds for its client. A client that falls
+	// further behind is dropped, as the commits never wait for a client.
+	privilegeChangesBuffer = 64
+)
+
+// privilegeChanges holds the sessions of the users that are notified of the changes of their privileges. As a block
+// commit listener, it derives the users whose privileges a committed block may change from the keys the block writes
+// and deletes: the record of a user that is rewritten or deleted, and a database whose default ACL is changed or which
+// is deleted, which affects the users that have a privilege on it. The sessions are indexed by user, and the users by
+// the databases they have a privilege on, hence, a block is matched by a lookup per key, regardless of the number of
+// sessions.
+type privilegeChanges struct {
+	nodeID          string
+	identityQuerier *identity.Querier
+	signer          crypto.Signer
+	logger          *logger.SugarLogger
+
+	mu     sync.Mutex
+	users  map[string]*privilegeSessions
+	dbs    map[string]map[string]struct{}
+	admins map[string]struct{}
+}
+
+type privilegeChangesConfig struct {
+	nodeID          string
+	identityQuerier *identity.Querier
+	signer          crypto.Signer
+	logger          *logger.SugarLogger
+}
+
+func newPrivilegeChanges(conf *privilegeChangesConfig) *privilegeChanges {
+	return &privilegeChanges{
+		nodeID:          conf.nodeID,
+		identityQuerier: conf.identityQuerier,
+		signer:          conf.signer,
+		logger:          conf.logger,
+		users:           make(map[string]*privilegeSessions),
+		dbs:             make(map[string]map[string]struct{}),
+		admins:          make(map[string]struct{}),
+	}
+}
+
+// privilegeSessions holds the sessions of a single user, along with the privileges of the user by which the user is
+// indexed
+type privilegeSessions struct {
+	sessions map[*privilegeSession]struct{}
+	dbNames  []string
+	admin    bool
+}
+
+// privilegeSession is a session of a user that is notified of the changes of the privileges of the user, until it
+// closes the session, or until the session is dropped, in which case the notifications channel is closed.
+type privilegeSession struct {
+	userID        string
+	notifications chan *types.PrivilegeChangesResponseEnvelope
+	registry      *privilegeChanges
+	closed        bool
+}
+
+// close removes the session. It is called once the client disconnects.
+func (s *privilegeSession) close() {
+	s.registry.mu.Lock()
+	defer s.registry.mu.Unlock()
+
+	s.registry.removeLocked(s)
+}
+
+// subscribe registers a session of the user
+func (p *privilegeChanges) subscribe(userID string) (*privilegeSession, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sessions, ok := p.users[userID]
+	if !ok {
+		user, _, err := p.identityQuerier.GetUser(userID)
+		if err != nil {
+			return nil, err
+		}
+		sessions = &privilegeSessions{sessions: make(map[*privilegeSession]struct{})}
+		p.users[userID] = sessions
+		p.indexLocked(userID, sessions, user)
+	}
+
+	s := &privilegeSession{
+		userID:        userID,
+		notifications: make(chan *types.PrivilegeChangesResponseEnvelope, privilegeChangesBuffer),
+		registry:      p,
+	}
+	sessions.sessions[s] = struct{}{}
+
+	p.logger.Debugf("user [%s] subscribed to the changes of its privileges", userID)
+	return s, nil
+}
+
+// PostBlockCommitProcessing notifies the sessions of the users whose privileges the block may change. The replayed
+// blocks are ignored, as they were committed before any session was opened.
+func (p *privilegeChanges) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	if event.IsReplay || event.StateDelta == nil {
+		return nil
+	}
+	blockNum := event.Block.GetHeader().GetBaseHeader().GetNumber()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.users) == 0 {
+		return nil
+	}
+
+	changes := make(map[string][]*types.PrivilegeChange)
+	var affected []string
+	add := func(userID string, change *types.PrivilegeChange) {
+		if _, ok := changes[userID]; !ok {
+			affected = append(affected, userID)
+		}
+		changes[userID] = append(changes[userID], change)
+	}
+	addDB := func(dbName string, kind types.PrivilegeChange_Kind) {
+		change := &types.PrivilegeChange{Kind: kind, DbName: dbName}
+		for userID := range p.dbs[dbName] {
+			add(userID, change)
+		}
+		for userID := range p.admins {
+			add(userID, change)
+		}
+	}
+
+	// a database administration transaction is the only transaction of its block, and the descriptor of a database
+	// is only written by a valid one
+	defaultACLs := event.Block.GetDbAdministrationTxEnvelope().GetPayload().GetDbsDefaultAcl()
+	for _, kd := range event.StateDelta.GetKeys() {
+		switch kd.DbName {
+		case worldstate.UsersDBName:
+			if !strings.HasPrefix(kd.Key, string(identity.UserNamespace)) {
+				continue
+			}
+			userID := strings.TrimPrefix(kd.Key, string(identity.UserNamespace))
+			if _, ok := p.users[userID]; !ok {
+				continue
+			}
+			kind := types.PrivilegeChange_USER_UPDATED
+			if kd.Deleted {
+				kind = types.PrivilegeChange_USER_DELETED
+			}
+			add(userID, &types.PrivilegeChange{Kind: kind})
+
+		case worldstate.DatabasesDBName:
+			if kd.Deleted && !stateindex.IsIndexDB(kd.Key) {
+				addDB(kd.Key, types.PrivilegeChange_DB_DELETED)
+			}
+
+		case worldstate.DBDescriptorsDBName:
+			if _, ok := defaultACLs[kd.Key]; ok && !kd.Deleted {
+				addDB(kd.Key, types.PrivilegeChange_DB_DEFAULT_ACL_CHANGED)
+			}
+		}
+	}
+
+	for _, userID := range affected {
+		sessions := p.users[userID]
+		deleted := false
+		for _, change := range changes[userID] {
+			switch change.Kind {
+			case types.PrivilegeChange_USER_UPDATED:
+				user, _, err := p.identityQuerier.GetUser(userID)
+				if err != nil {
+					return err
+				}
+				p.unindexLocked(userID, sessions)
+				p.indexLocked(userID, sessions, user)
+			case types.PrivilegeChange_USER_DELETED:
+				deleted = true
+			}
+		}
+
+		envelope, err := p.notification(userID, blockNum, changes[userID])
+		if err != nil {
+			return err
+		}
+
+		for s := range sessions.sessions {
+			select {
+			case s.notifications <- envelope:
+				if deleted {
+					p.removeLocked(s)
+				}
+			default:
+				p.logger.Warnf("dropping a session of user [%s], as the client lags behind by more than %d notifications of the changes of its privileges", userID, privilegeChangesBuffer)
+				p.removeLocked(s)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *privilegeChanges) notification(userID string, blockNum uint64, changes []*types.PrivilegeChange) (*types.PrivilegeChangesResponseEnvelope, error) {
+	resp := &types.PrivilegeChangesResponse{
+		Header:      &types.ResponseHeader{NodeId: p.nodeID},
+		UserId:      userID,
+		BlockNumber: blockNum,
+		Changes:     changes,
+	}
+
+	respBytes, err := marshal.DeterministicMarshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := p.signer.Sign(respBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PrivilegeChangesResponseEnvelope{
+		Response:      resp,
+		Signature:     sig,
+		ResponseBytes: respBytes,
+	}, nil
+}
+
+// closeAll drops all the sessions, e.g., once the node stops committing blocks
+func (p *privilegeChanges) closeAll() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, sessions := range p.users {
+		for s := range sessions.sessions {
+			p.removeLocked(s)
+		}
+	}
+}
+
+func (p *privilegeChanges) removeLocked(s *privilegeSession) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.notifications)
+
+	sessions := p.users[s.userID]
+	delete(sessions.sessions, s)
+	if len(sessions.sessions) == 0 {
+		p.unindexLocked(s.userID, sessions)
+		delete(p.users, s.userID)
+	}
+}
+
+// indexLocked indexes the user by the databases it has a privilege on, or as an admin, who has a privilege on every
+// database
+func (p *privilegeChanges) indexLocked(userID string, sessions *privilegeSessions, user *types.User) {
+	sessions.admin = user.GetPrivilege().GetAdmin()
+	sessions.dbNames = nil
+	if sessions.admin {
+		p.admins[userID] = struct{}{}
+		return
+	}
+
+	for dbName := range user.GetPrivilege().GetDbPermission() {
+		sessions.dbNames = append(sessions.dbNames, dbName)
+		users, ok := p.dbs[dbName]
+		if !ok {
+			users = make(map[string]struct{})
+			p.dbs[dbName] = users
+		}
+		users[userID] = struct{}{}
+	}
+}
+
+func (p *privilegeChanges) unindexLocked(userID string, sessions *privilegeSessions) {
+	if sessions.admin {
+		delete(p.admins, userID)
+	}
+	for _, dbName := range sessions.dbNames {
+		delete(p.dbs[dbName], userID)
+		if len(p.dbs[dbName]) == 0 {
+			delete(p.dbs, dbName)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	crypto_mocks "github.com/hyperledger-labs/orion-server/pkg/crypto/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivilegeChanges(t *testing.T) {
+	env := newWorldstateQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+
+	setUser := func(blockNum uint64, user *types.User) {
+		u, err := proto.Marshal(user)
+		require.NoError(t, err)
+		require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.UsersDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{Key: string(identity.UserNamespace) + user.Id, Value: u},
+				},
+			},
+		}, blockNum))
+	}
+	setUser(1, &types.User{Id: "alice", Privilege: &types.Privilege{DbPermission: map[string]types.Privilege_Access{"db1": types.Privilege_Read, "db2": types.Privilege_ReadWrite}}})
+	setUser(1, &types.User{Id: "bob", Privilege: &types.Privilege{DbPermission: map[string]types.Privilege_Access{"db2": types.Privilege_Read}}})
+	setUser(1, &types.User{Id: "carol", Privilege: &types.Privilege{Admin: true}})
+
+	signer := &crypto_mocks.Signer{}
+	signer.On("Sign", mock.Anything).Return([]byte("signature"), nil)
+	p := newPrivilegeChanges(&privilegeChangesConfig{
+		nodeID:          "node1",
+		identityQuerier: identity.NewQuerier(env.db),
+		signer:          signer,
+		logger:          env.q.logger,
+	})
+
+	commit := func(block *types.Block, blockNum uint64, keys ...*types.KeyStateDelta) {
+		block.Header = &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: blockNum}}
+		require.NoError(t, p.PostBlockCommitProcessing(&blockprocessor.CommitEvent{
+			Block:      block,
+			StateDelta: &types.StateDelta{StartBlockNum: blockNum, EndBlockNum: blockNum, Keys: keys},
+		}))
+	}
+	userKey := func(userID string, deleted bool) *types.KeyStateDelta {
+		return &types.KeyStateDelta{DbName: worldstate.UsersDBName, Key: string(identity.UserNamespace) + userID, Deleted: deleted}
+	}
+	requireNotified := func(s *privilegeSession, blockNum uint64, changes ...*types.PrivilegeChange) {
+		select {
+		case n := <-s.notifications:
+			require.Equal(t, blockNum, n.GetResponse().GetBlockNumber())
+			require.Equal(t, s.userID, n.GetResponse().GetUserId())
+			require.Equal(t, []byte("signature"), n.GetSignature())
+			require.Len(t, n.GetResponse().GetChanges(), len(changes))
+			for i, c := range changes {
+				require.True(t, proto.Equal(c, n.GetResponse().GetChanges()[i]), "expected: %v, actual: %v", c, n.GetResponse().GetChanges()[i])
+			}
+		default:
+			t.Fatalf("no notification of block %d", blockNum)
+		}
+	}
+	requireNoNotification := func(sessions ...*privilegeSession) {
+		for _, s := range sessions {
+			select {
+			case n, ok := <-s.notifications:
+				require.True(t, ok, "the session was dropped")
+				t.Fatalf("unexpected notification: %v", n)
+			default:
+			}
+		}
+	}
+
+	_, err := p.subscribe("dave")
+	require.EqualError(t, err, "the user [dave] does not exist")
+
+	alice1, err := p.subscribe("alice")
+	require.NoError(t, err)
+	alice2, err := p.subscribe("alice")
+	require.NoError(t, err)
+	bob, err := p.subscribe("bob")
+	require.NoError(t, err)
+	carol, err := p.subscribe("carol")
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]struct{}{
+		"db1": {"alice": {}},
+		"db2": {"alice": {}, "bob": {}},
+	}, p.dbs)
+
+	// the rewrite of the record of a user is notified to every session of the user, and to no other user; the users
+	// without a session are ignored
+	setUser(2, &types.User{Id: "alice", Privilege: &types.Privilege{DbPermission: map[string]types.Privilege_Access{"db1": types.Privilege_Read}}})
+	commit(&types.Block{}, 2, userKey("alice", false), userKey("dave", false))
+	requireNotified(alice1, 2, &types.PrivilegeChange{Kind: types.PrivilegeChange_USER_UPDATED})
+	requireNotified(alice2, 2, &types.PrivilegeChange{Kind: types.PrivilegeChange_USER_UPDATED})
+	requireNoNotification(bob, carol)
+	require.Equal(t, map[string]map[string]struct{}{
+		"db1": {"alice": {}},
+		"db2": {"bob": {}},
+	}, p.dbs)
+
+	// the change of the default ACL of a database is notified to the users that have a privilege on it and to the
+	// admins, while the other changes of the descriptor are not notified
+	commit(
+		&types.Block{
+			Payload: &types.Block_DbAdministrationTxEnvelope{
+				DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
+					Payload: &types.DBAdministrationTx{
+						DbsDefaultAcl: map[string]*types.DBDefaultACL{"db2": {Acl: &types.AccessControl{ReadUsers: map[string]bool{"bob": true}}}},
+						DbsSchema:     map[string]*types.DBSchema{"db1": {JsonSchema: "{}"}},
+					},
+				},
+			},
+		},
+		3,
+		&types.KeyStateDelta{DbName: worldstate.DBDescriptorsDBName, Key: "db1"},
+		&types.KeyStateDelta{DbName: worldstate.DBDescriptorsDBName, Key: "db2"},
+	)
+	requireNotified(bob, 3, &types.PrivilegeChange{Kind: types.PrivilegeChange_DB_DEFAULT_ACL_CHANGED, DbName: "db2"})
+	requireNotified(carol, 3, &types.PrivilegeChange{Kind: types.PrivilegeChange_DB_DEFAULT_ACL_CHANGED, DbName: "db2"})
+	requireNoNotification(alice1, alice2)
+
+	// the deletion of a database is notified likewise, while the deletion of its index database is not
+	commit(
+		&types.Block{},
+		4,
+		&types.KeyStateDelta{DbName: worldstate.DatabasesDBName, Key: "db1", Deleted: true},
+		&types.KeyStateDelta{DbName: worldstate.DatabasesDBName, Key: stateindex.IndexDB("db1"), Deleted: true},
+		&types.KeyStateDelta{DbName: worldstate.DatabasesDBName, Key: "db3"},
+	)
+	requireNotified(alice1, 4, &types.PrivilegeChange{Kind: types.PrivilegeChange_DB_DELETED, DbName: "db1"})
+	requireNotified(alice2, 4, &types.PrivilegeChange{Kind: types.PrivilegeChange_DB_DELETED, DbName: "db1"})
+	requireNotified(carol, 4, &types.PrivilegeChange{Kind: types.PrivilegeChange_DB_DELETED, DbName: "db1"})
+	requireNoNotification(bob)
+
+	// replayed blocks are not notified
+	require.NoError(t, p.PostBlockCommitProcessing(&blockprocessor.CommitEvent{
+		Block:      &types.Block{Header: &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: 2}}},
+		StateDelta: &types.StateDelta{Keys: []*types.KeyStateDelta{userKey("alice", false)}},
+		IsReplay:   true,
+	}))
+	requireNoNotification(alice1, alice2)
+
+	// the session of a deleted user ends after the notification of the deletion
+	commit(&types.Block{}, 5, userKey("bob", true))
+	requireNotified(bob, 5, &types.PrivilegeChange{Kind: types.PrivilegeChange_USER_DELETED})
+	_, ok := <-bob.notifications
+	require.False(t, ok)
+	require.NotContains(t, p.users, "bob")
+	require.NotContains(t, p.dbs, "db2")
+
+	// a user stays indexed until its last session is closed
+	alice1.close()
+	_, ok = <-alice1.notifications
+	require.False(t, ok)
+	require.Contains(t, p.users, "alice")
+	alice2.close()
+	require.NotContains(t, p.users, "alice")
+	require.Empty(t, p.dbs)
+	alice2.close()
+
+	// a client that lags behind is dropped
+	for blockNum := uint64(6); blockNum < 6+privilegeChangesBuffer; blockNum++ {
+		commit(&types.Block{}, blockNum, &types.KeyStateDelta{DbName: worldstate.DatabasesDBName, Key: "db2", Deleted: true})
+	}
+	require.Len(t, carol.notifications, privilegeChangesBuffer)
+	commit(&types.Block{}, 6+privilegeChangesBuffer, &types.KeyStateDelta{DbName: worldstate.DatabasesDBName, Key: "db2", Deleted: true})
+	require.Empty(t, p.users)
+	require.Empty(t, p.admins)
+	for range carol.notifications {
+	}
+
+	// closing all the sessions ends their streams
+	carol, err = p.subscribe("carol")
+	require.NoError(t, err)
+	p.closeAll()
+	_, ok = <-carol.notifications
+	require.False(t, ok)
+	require.Empty(t, p.users)
+}
@@ -4,30 +4,43 @@
 package bcdb
 
 import (
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/internal/accesscontrol"
 	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 )
 
+// provenanceQueryProcessor serves the queries of the provenance store. Besides an admin, a user who can read from a
+// data database can query the past versions of its keys, and sees only the versions which the historical read policy
+// lets the user read, see versionFilter.
 type provenanceQueryProcessor struct {
-	provenanceStore *provenance.Store
-	identityQuerier *identity.Querier
-	logger          *logger.SugarLogger
+	db                   worldstate.DB
+	provenanceStore      *provenance.Store
+	identityQuerier      *identity.Querier
+	historicalReadPolicy accesscontrol.HistoricalReadPolicy
+	logger               *logger.SugarLogger
 }
 
 type provenanceQueryProcessorConfig struct {
-	provenanceStore *provenance.Store
-	identityQuerier *identity.Querier
-	logger          *logger.SugarLogger
+	db                   worldstate.DB
+	provenanceStore      *provenance.Store
+	identityQuerier      *identity.Querier
+	historicalReadPolicy accesscontrol.HistoricalReadPolicy
+	logger               *logger.SugarLogger
 }
 
 func newProvenanceQueryProcessor(conf *provenanceQueryProcessorConfig) *provenanceQueryProcessor {
 	return &provenanceQueryProcessor{
-		provenanceStore: conf.provenanceStore,
-		identityQuerier: conf.identityQuerier,
-		logger:          conf.logger,
+		db:                   conf.db,
+		provenanceStore:      conf.provenanceStore,
+		identityQuerier:      conf.identityQuerier,
+		historicalReadPolicy: conf.historicalReadPolicy,
+		logger:               conf.logger,
 	}
 }
 
@@ -37,7 +50,8 @@ func (p *provenanceQueryProcessor) GetValues(userID, dbName, key string) (*types
 		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
 	}
 
-	if err := p.aclCheckForData(userID); err != nil {
+	isAdmin, err := p.aclCheckForKeyHistory(userID, dbName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -46,6 +60,12 @@ func (p *provenanceQueryProcessor) GetValues(userID, dbName, key string) (*types
 		return nil, err
 	}
 
+	if !isAdmin {
+		if values, err = p.filterVersions(userID, dbName, key, values); err != nil {
+			return nil, err
+		}
+	}
+
 	return p.composeHistoricalDataResponse(values)
 }
 
@@ -55,7 +75,8 @@ func (p *provenanceQueryProcessor) GetValueAt(userID, dbName, key string, versio
 		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
 	}
 
-	if err := p.aclCheckForData(userID); err != nil {
+	isAdmin, err := p.aclCheckForKeyHistory(userID, dbName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -68,16 +89,80 @@ func (p *provenanceQueryProcessor) GetValueAt(userID, dbName, key string, versio
 		return p.composeHistoricalDataResponse(nil)
 	}
 
+	if !isAdmin {
+		if err := p.checkVersionReadable(userID, dbName, key, value); err != nil {
+			return nil, err
+		}
+	}
+
 	return p.composeHistoricalDataResponse([]*types.ValueWithMetadata{value})
 }
 
+// GetDataByVersion returns the value of a given key at a particular version along with the transaction which
+// wrote it and whether it is still live. Besides an admin, a user who can read from the database can fetch the value
+// if the historical read policy lets the user read that version. When the key did not hold a value at the version, a
+// VersionNotFoundErr is returned with the nearest earlier version of the key which is readable by the user, if any.
+func (p *provenanceQueryProcessor) GetDataByVersion(userID, dbName, key string, version *types.Version) (*types.GetDataByVersionResponse, error) {
+	if p.provenanceStore == nil {
+		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
+	}
+
+	isAdmin, err := p.aclCheckForKeyHistory(userID, dbName)
+	if err != nil {
+		return nil, err
+	}
+	canRead := p.versionFilter(userID, dbName, key)
+
+	versioned, err := p.provenanceStore.GetVersionedValue(dbName, key, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if versioned == nil {
+		notFoundErr := &ierrors.VersionNotFoundErr{
+			ErrMsg: fmt.Sprintf("the key [%s] in database [%s] did not hold a value at version (%d, %d)", key, dbName, version.BlockNum, version.TxNum),
+		}
+
+		nearest, err := p.provenanceStore.GetMostRecentValueAtOrBelow(dbName, key, version)
+		if err != nil {
+			return nil, err
+		}
+		if nearest != nil {
+			readable := isAdmin
+			if !readable {
+				if readable, err = canRead(nearest); err != nil {
+					return nil, err
+				}
+			}
+			if readable {
+				notFoundErr.NearestVersion = nearest.GetMetadata().GetVersion()
+			}
+		}
+
+		return nil, notFoundErr
+	}
+
+	if !isAdmin {
+		if err := p.checkVersionReadable(userID, dbName, key, versioned.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.GetDataByVersionResponse{
+		Value:  versioned.Value,
+		TxId:   versioned.TxID,
+		IsLive: versioned.IsLive,
+	}, nil
+}
+
 // GetMostRecentValueAtOrBelow returns the most recent value of a given key at or below the given version
 func (p *provenanceQueryProcessor) GetMostRecentValueAtOrBelow(userID, dbName, key string, version *types.Version) (*types.GetHistoricalDataResponse, error) {
 	if p.provenanceStore == nil {
 		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
 	}
 
-	if err := p.aclCheckForData(userID); err != nil {
+	isAdmin, err := p.aclCheckForKeyHistory(userID, dbName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -90,6 +175,12 @@ func (p *provenanceQueryProcessor) GetMostRecentValueAtOrBelow(userID, dbName, k
 		return p.composeHistoricalDataResponse(nil)
 	}
 
+	if !isAdmin {
+		if err := p.checkVersionReadable(userID, dbName, key, value); err != nil {
+			return nil, err
+		}
+	}
+
 	return p.composeHistoricalDataResponse([]*types.ValueWithMetadata{value})
 }
 
@@ -100,7 +191,8 @@ func (p *provenanceQueryProcessor) GetPreviousValues(userID, dbName, key string,
 		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
 	}
 
-	if err := p.aclCheckForData(userID); err != nil {
+	isAdmin, err := p.aclCheckForKeyHistory(userID, dbName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -109,6 +201,12 @@ func (p *provenanceQueryProcessor) GetPreviousValues(userID, dbName, key string,
 		return nil, err
 	}
 
+	if !isAdmin {
+		if values, err = p.filterVersions(userID, dbName, key, values); err != nil {
+			return nil, err
+		}
+	}
+
 	return p.composeHistoricalDataResponse(values)
 }
 
@@ -119,7 +217,8 @@ func (p *provenanceQueryProcessor) GetNextValues(userID, dbName, key string, ver
 		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
 	}
 
-	if err := p.aclCheckForData(userID); err != nil {
+	isAdmin, err := p.aclCheckForKeyHistory(userID, dbName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -128,6 +227,12 @@ func (p *provenanceQueryProcessor) GetNextValues(userID, dbName, key string, ver
 		return nil, err
 	}
 
+	if !isAdmin {
+		if values, err = p.filterVersions(userID, dbName, key, values); err != nil {
+			return nil, err
+		}
+	}
+
 	return p.composeHistoricalDataResponse(values)
 }
 
@@ -136,7 +241,8 @@ func (p *provenanceQueryProcessor) GetDeletedValues(userID, dbName, key string)
 		return nil, &ierrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"}
 	}
 
-	if err := p.aclCheckForData(userID); err != nil {
+	isAdmin, err := p.aclCheckForKeyHistory(userID, dbName)
+	if err != nil {
 		return nil, err
 	}
 
@@ -145,6 +251,12 @@ func (p *provenanceQueryProcessor) GetDeletedValues(userID, dbName, key string)
 		return nil, err
 	}
 
+	if !isAdmin {
+		if values, err = p.filterVersions(userID, dbName, key, values); err != nil {
+			return nil, err
+		}
+	}
+
 	return p.composeHistoricalDataResponse(values)
 }
 
@@ -305,6 +417,94 @@ func (p *provenanceQueryProcessor) aclCheckForData(querierUserID string) error {
 	return nil
 }
 
+// aclCheckForKeyHistory checks whether the user can query the past versions of a key of the database, and returns
+// whether the user is an admin, who can read every version. Any other user must be able to read from the database,
+// which must be a data database.
+func (p *provenanceQueryProcessor) aclCheckForKeyHistory(querierUserID, dbName string) (bool, error) {
+	isAdmin, err := p.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return false, err
+	}
+	if isAdmin {
+		return true, nil
+	}
+
+	if worldstate.IsSystemDB(dbName) {
+		return false, &ierrors.PermissionErr{
+			ErrMsg: "The querier [" + querierUserID + "] is not an admin. Only an admin can query historical data of a system database [" + dbName + "]",
+		}
+	}
+
+	hasPerm, err := p.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
+	if err != nil {
+		return false, err
+	}
+	if !hasPerm {
+		return false, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to read from database [" + dbName + "]",
+		}
+	}
+
+	return false, nil
+}
+
+// versionFilter returns a function which decides whether a non-admin user can read a past version of the key. The
+// user could read the version at its time if the ACL of the version permitted it. Whether the user can read the key
+// now is decided by the ACL of the committed value of the key, which is read once, and only if the historical read
+// policy depends on it.
+func (p *provenanceQueryProcessor) versionFilter(userID, dbName, key string) func(*types.ValueWithMetadata) (bool, error) {
+	var readableNow *bool
+	isReadableNow := func() (bool, error) {
+		if readableNow == nil {
+			_, metadata, err := p.db.Get(dbName, key)
+			if err != nil {
+				return false, err
+			}
+			readable := metadata != nil && accesscontrol.CanRead(userID, metadata.GetAccessControl())
+			readableNow = &readable
+		}
+		return *readableNow, nil
+	}
+
+	return func(value *types.ValueWithMetadata) (bool, error) {
+		return p.historicalReadPolicy.Allows(accesscontrol.CanRead(userID, value.GetMetadata().GetAccessControl()), isReadableNow)
+	}
+}
+
+// filterVersions returns the past versions of the key which the non-admin user can read
+func (p *provenanceQueryProcessor) filterVersions(userID, dbName, key string, values []*types.ValueWithMetadata) ([]*types.ValueWithMetadata, error) {
+	canRead := p.versionFilter(userID, dbName, key)
+
+	var readable []*types.ValueWithMetadata
+	for _, v := range values {
+		ok, err := canRead(v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			readable = append(readable, v)
+		}
+	}
+
+	return readable, nil
+}
+
+// checkVersionReadable returns a permission error if the non-admin user cannot read the past version of the key
+func (p *provenanceQueryProcessor) checkVersionReadable(userID, dbName, key string, value *types.ValueWithMetadata) error {
+	readable, err := p.versionFilter(userID, dbName, key)(value)
+	if err != nil {
+		return err
+	}
+	if !readable {
+		version := value.GetMetadata().GetVersion()
+		return &ierrors.PermissionErr{
+			ErrMsg: fmt.Sprintf("the user [%s] has no permission to read key [%s] from database [%s] at version (%d, %d)", userID, key, dbName, version.GetBlockNum(), version.GetTxNum()),
+		}
+	}
+
+	return nil
+}
+
 func (p *provenanceQueryProcessor) composeHistoricalDataResponse(values []*types.ValueWithMetadata) (*types.GetHistoricalDataResponse, error) {
 	return &types.GetHistoricalDataResponse{
 		Values: values,
@@ -406,12 +406,12 @@ func TestGetValues(t *testing.T) {
 			},
 		},
 		{
-			name:            "fetching all values of key1 with non-admin user will result in an error",
+			name:            "fetching all values of key1 by a non-admin user without read access on the database will result in an error",
 			dbName:          "db1",
 			key:             "key1",
 			user:            "user1",
 			expectedPayload: nil,
-			expectedError:   &ierrors.PermissionErr{ErrMsg: "The querier [user1] is not an admin. Only an admin can query historical data"},
+			expectedError:   &ierrors.PermissionErr{ErrMsg: "the user [user1] has no permission to read from database [db1]"},
 		},
 	}
 
@@ -484,12 +484,12 @@ func TestGetDeletedValues(t *testing.T) {
 			},
 		},
 		{
-			name:             "fetching all deleted values key1 with non-admin user will result in an error",
+			name:             "fetching all deleted values key1 by a non-admin user without read access on the database will result in an error",
 			dbName:           "db1",
 			key:              "key1",
 			user:             "user1",
 			expectedEnvelope: nil,
-			expectedError:    &ierrors.PermissionErr{ErrMsg: "The querier [user1] is not an admin. Only an admin can query historical data"},
+			expectedError:    &ierrors.PermissionErr{ErrMsg: "the user [user1] has no permission to read from database [db1]"},
 		},
 	}
 
@@ -569,7 +569,7 @@ func TestGetPreviousValues(t *testing.T) {
 			expectedPayload: &types.GetHistoricalDataResponse{},
 		},
 		{
-			name:   "fetching the previous value of key1 at version{Blk 3, txNum 0} by an non-admin user will result in an error",
+			name:   "fetching the previous value of key1 at version{Blk 3, txNum 0} by a non-admin user without read access on the database will result in an error",
 			dbName: "db1",
 			key:    "key1",
 			user:   "user1",
@@ -578,7 +578,7 @@ func TestGetPreviousValues(t *testing.T) {
 				TxNum:    0,
 			},
 			expectedPayload: nil,
-			expectedError:   &ierrors.PermissionErr{ErrMsg: "The querier [user1] is not an admin. Only an admin can query historical data"},
+			expectedError:   &ierrors.PermissionErr{ErrMsg: "the user [user1] has no permission to read from database [db1]"},
 		},
 	}
 
@@ -660,7 +660,7 @@ func TestGetNextValues(t *testing.T) {
 			},
 		},
 		{
-			name:   "fetching next value of key1 at version {Blk 2, txNum 0} by an non-admin user will result in an error",
+			name:   "fetching next value of key1 at version {Blk 2, txNum 0} by a non-admin user without read access on the database will result in an error",
 			dbName: "db1",
 			key:    "key1",
 			user:   "user1",
@@ -669,7 +669,7 @@ func TestGetNextValues(t *testing.T) {
 				TxNum:    0,
 			},
 			expectedPayload: nil,
-			expectedError:   &ierrors.PermissionErr{ErrMsg: "The querier [user1] is not an admin. Only an admin can query historical data"},
+			expectedError:   &ierrors.PermissionErr{ErrMsg: "the user [user1] has no permission to read from database [db1]"},
 		},
 	}
 
@@ -742,7 +742,7 @@ func TestGetValueAt(t *testing.T) {
 			},
 		},
 		{
-			name:   "fetching value of key1 at a particular version by an non-admin user will result in an error",
+			name:   "fetching value of key1 at a particular version by a non-admin user without read access on the database will result in an error",
 			dbName: "db1",
 			key:    "key1",
 			user:   "user1",
@@ -751,7 +751,7 @@ func TestGetValueAt(t *testing.T) {
 				TxNum:    0,
 			},
 			expectedPayload: nil,
-			expectedError:   &ierrors.PermissionErr{ErrMsg: "The querier [user1] is not an admin. Only an admin can query historical data"},
+			expectedError:   &ierrors.PermissionErr{ErrMsg: "the user [user1] has no permission to read from database [db1]"},
 		},
 	}
 
@@ -769,6 +769,210 @@ func TestGetValueAt(t *testing.T) {
 	}
 }
 
+func TestGetDataByVersion(t *testing.T) {
+	env := newProvenanceQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+
+	setupProvenanceStore(t, env.p.provenanceStore)
+
+	setupUserForTest(t, "admin1", true, env.db)
+	setupUserForTest(t, "user4", false, env.db)
+	for _, userID := range []string{"user1", "user3"} {
+		u, err := proto.Marshal(&types.User{
+			Id: userID,
+			Privilege: &types.Privilege{
+				DbPermission: map[string]types.Privilege_Access{"db1": types.Privilege_Read},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.UsersDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:   string(identity.UserNamespace) + userID,
+						Value: u,
+						Metadata: &types.Metadata{
+							Version: &types.Version{BlockNum: 2, TxNum: 1},
+						},
+					},
+				},
+			},
+		}, 2))
+	}
+
+	key2ACL := &types.AccessControl{
+		ReadWriteUsers: map[string]bool{
+			"user1": true,
+			"user2": true,
+		},
+	}
+
+	tests := []struct {
+		name            string
+		dbName          string
+		key             string
+		user            string
+		version         *types.Version
+		expectedPayload *types.GetDataByVersionResponse
+		expectedError   error
+	}{
+		{
+			name:    "live value fetched by an admin",
+			dbName:  "db1",
+			key:     "key2",
+			user:    "admin1",
+			version: &types.Version{BlockNum: 3, TxNum: 0},
+			expectedPayload: &types.GetDataByVersionResponse{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value2"),
+					Metadata: &types.Metadata{
+						AccessControl: key2ACL,
+						Version:       &types.Version{BlockNum: 3, TxNum: 0},
+					},
+				},
+				TxId:   "tx5",
+				IsLive: true,
+			},
+		},
+		{
+			name:    "live value fetched by a user in the ACL of the version",
+			dbName:  "db1",
+			key:     "key2",
+			user:    "user1",
+			version: &types.Version{BlockNum: 3, TxNum: 0},
+			expectedPayload: &types.GetDataByVersionResponse{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value2"),
+					Metadata: &types.Metadata{
+						AccessControl: key2ACL,
+						Version:       &types.Version{BlockNum: 3, TxNum: 0},
+					},
+				},
+				TxId:   "tx5",
+				IsLive: true,
+			},
+		},
+		{
+			name:    "superseded value",
+			dbName:  "db1",
+			key:     "key1",
+			user:    "user1",
+			version: &types.Version{BlockNum: 1, TxNum: 0},
+			expectedPayload: &types.GetDataByVersionResponse{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value1"),
+					Metadata: &types.Metadata{
+						Version: &types.Version{BlockNum: 1, TxNum: 0},
+					},
+				},
+				TxId: "tx1",
+			},
+		},
+		{
+			name:    "deleted value",
+			dbName:  "db1",
+			key:     "key1",
+			user:    "user3",
+			version: &types.Version{BlockNum: 5, TxNum: 0},
+			expectedPayload: &types.GetDataByVersionResponse{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value5"),
+					Metadata: &types.Metadata{
+						Version: &types.Version{BlockNum: 5, TxNum: 0},
+					},
+				},
+				TxId: "tx6",
+			},
+		},
+		{
+			name:    "missing version with the nearest earlier version as a hint",
+			dbName:  "db1",
+			key:     "key1",
+			user:    "user1",
+			version: &types.Version{BlockNum: 4, TxNum: 0},
+			expectedError: &ierrors.VersionNotFoundErr{
+				ErrMsg:         "the key [key1] in database [db1] did not hold a value at version (4, 0)",
+				NearestVersion: &types.Version{BlockNum: 3, TxNum: 0},
+			},
+		},
+		{
+			name:    "missing version without a hint as the nearest earlier version is not readable by the user",
+			dbName:  "db1",
+			key:     "key2",
+			user:    "user3",
+			version: &types.Version{BlockNum: 2, TxNum: 0},
+			expectedError: &ierrors.VersionNotFoundErr{
+				ErrMsg: "the key [key2] in database [db1] did not hold a value at version (2, 0)",
+			},
+		},
+		{
+			name:    "missing version with a hint for an admin",
+			dbName:  "db1",
+			key:     "key2",
+			user:    "admin1",
+			version: &types.Version{BlockNum: 2, TxNum: 0},
+			expectedError: &ierrors.VersionNotFoundErr{
+				ErrMsg:         "the key [key2] in database [db1] did not hold a value at version (2, 0)",
+				NearestVersion: &types.Version{BlockNum: 1, TxNum: 1},
+			},
+		},
+		{
+			name:    "missing version without an earlier version",
+			dbName:  "db1",
+			key:     "key1",
+			user:    "user1",
+			version: &types.Version{BlockNum: 0, TxNum: 5},
+			expectedError: &ierrors.VersionNotFoundErr{
+				ErrMsg: "the key [key1] in database [db1] did not hold a value at version (0, 5)",
+			},
+		},
+		{
+			name:    "user not in the ACL of the version",
+			dbName:  "db1",
+			key:     "key2",
+			user:    "user3",
+			version: &types.Version{BlockNum: 3, TxNum: 0},
+			expectedError: &ierrors.PermissionErr{
+				ErrMsg: "the user [user3] has no permission to read key [key2] from database [db1] at version (3, 0)",
+			},
+		},
+		{
+			name:    "user without read access on the database",
+			dbName:  "db1",
+			key:     "key1",
+			user:    "user4",
+			version: &types.Version{BlockNum: 1, TxNum: 0},
+			expectedError: &ierrors.PermissionErr{
+				ErrMsg: "the user [user4] has no permission to read from database [db1]",
+			},
+		},
+		{
+			name:    "non-admin user on a system database",
+			dbName:  worldstate.UsersDBName,
+			key:     "user1",
+			user:    "user1",
+			version: &types.Version{BlockNum: 2, TxNum: 1},
+			expectedError: &ierrors.PermissionErr{
+				ErrMsg: "The querier [user1] is not an admin. Only an admin can query historical data of a system database [_users]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := env.p.GetDataByVersion(tt.user, tt.dbName, tt.key, tt.version)
+			if tt.expectedError != nil {
+				require.Equal(t, tt.expectedError, err)
+				require.Nil(t, payload)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedPayload, payload), "expected %v, actual %v", tt.expectedPayload, payload)
+		})
+	}
+}
+
 func TestGetMostRecentValueAtOrBelow(t *testing.T) {
 	env := newProvenanceQueryProcessorTestEnv(t)
 	defer env.cleanup(t)
@@ -824,7 +1028,7 @@ func TestGetMostRecentValueAtOrBelow(t *testing.T) {
 			},
 		},
 		{
-			name:   "fetching most recent value of key1 at or below a particular version by an non-admin user will result in an error",
+			name:   "fetching most recent value of key1 at or below a particular version by a non-admin user without read access on the database will result in an error",
 			dbName: "db1",
 			key:    "key1",
 			user:   "user1",
@@ -833,7 +1037,7 @@ func TestGetMostRecentValueAtOrBelow(t *testing.T) {
 				TxNum:    5,
 			},
 			expectedPayload: nil,
-			expectedError:   &ierrors.PermissionErr{ErrMsg: "The querier [user1] is not an admin. Only an admin can query historical data"},
+			expectedError:   &ierrors.PermissionErr{ErrMsg: "the user [user1] has no permission to read from database [db1]"},
 		},
 	}
 
@@ -1401,6 +1605,8 @@ func TestDisabledStore(t *testing.T) {
 	require.EqualError(t, err, "provenance store is disabled on this server")
 	_, err = env.p.GetValueAt("user", "db", "key", &types.Version{BlockNum: 1, TxNum: 1})
 	require.EqualError(t, err, "provenance store is disabled on this server")
+	_, err = env.p.GetDataByVersion("user", "db", "key", &types.Version{BlockNum: 1, TxNum: 1})
+	require.EqualError(t, err, "provenance store is disabled on this server")
 
 	_, err = env.p.GetValuesDeletedByUser("user", "user")
 	require.EqualError(t, err, "provenance store is disabled on this server")
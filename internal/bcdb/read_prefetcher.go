@@ -0,0 +1,122 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"sync/atomic"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+const (
+	defaultMaxReadHintsPerTx    = 256
+	defaultReadHintsQueueLength = 1024
+)
+
+// readPrefetcher reads the keys which the submitted data transactions declare they read, while the transactions wait
+// in the queues of the pipeline, so that the validation of their block finds the keys in the cache of the state
+// database rather than on the disk. The hints are advisory, as the validation fetches the versions of the reads
+// anyway: the hints on a database which does not exist are ignored, a failure to read a key is only logged, the hints
+// of a transaction beyond maxHintsPerTx are ignored, and the hints which do not fit in the queue are dropped.
+type readPrefetcher struct {
+	db            worldstate.DB
+	maxHintsPerTx int
+	hintsCh       chan []*types.ReadHint
+	stopCh        chan struct{}
+	doneCh        chan struct{}
+	// prefetched counts the keys read, and dropped counts the hints ignored for the cap or for a full queue
+	prefetched uint64
+	dropped    uint64
+	logger     *logger.SugarLogger
+}
+
+type readPrefetcherConfig struct {
+	db            worldstate.DB
+	maxHintsPerTx uint32
+	queueLength   uint32
+	logger        *logger.SugarLogger
+}
+
+func newReadPrefetcher(conf *readPrefetcherConfig) *readPrefetcher {
+	maxHintsPerTx := int(conf.maxHintsPerTx)
+	if maxHintsPerTx == 0 {
+		maxHintsPerTx = defaultMaxReadHintsPerTx
+	}
+	queueLength := int(conf.queueLength)
+	if queueLength == 0 {
+		queueLength = defaultReadHintsQueueLength
+	}
+
+	return &readPrefetcher{
+		db:            conf.db,
+		maxHintsPerTx: maxHintsPerTx,
+		hintsCh:       make(chan []*types.ReadHint, queueLength),
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+		logger:        conf.logger,
+	}
+}
+
+func (r *readPrefetcher) start() {
+	r.logger.Infof("starting the read prefetcher, max hints per transaction: %d", r.maxHintsPerTx)
+	go r.run()
+}
+
+// submit queues the hints of a transaction without blocking the submission
+func (r *readPrefetcher) submit(hints []*types.ReadHint) {
+	if len(hints) > r.maxHintsPerTx {
+		atomic.AddUint64(&r.dropped, uint64(len(hints)-r.maxHintsPerTx))
+		hints = hints[:r.maxHintsPerTx]
+	}
+	if len(hints) == 0 {
+		return
+	}
+
+	select {
+	case r.hintsCh <- hints:
+	default:
+		atomic.AddUint64(&r.dropped, uint64(len(hints)))
+		r.logger.Debugf("the queue of the read prefetcher is full, dropping %d hints", len(hints))
+	}
+}
+
+func (r *readPrefetcher) run() {
+	defer close(r.doneCh)
+
+	for {
+		select {
+		case <-r.stopCh:
+			r.logger.Info("stopping the read prefetcher")
+			return
+
+		case hints := <-r.hintsCh:
+			r.prefetch(hints)
+		}
+	}
+}
+
+func (r *readPrefetcher) prefetch(hints []*types.ReadHint) {
+	for _, h := range hints {
+		if !r.db.Exist(h.DBName) {
+			continue
+		}
+		if _, _, err := r.db.Get(h.DBName, h.Key); err != nil {
+			r.logger.Debugf("failed to prefetch the key [%s] of the database [%s]: %s", h.Key, h.DBName, err)
+			continue
+		}
+		atomic.AddUint64(&r.prefetched, 1)
+	}
+}
+
+// stats returns the number of keys prefetched, and the number of hints dropped
+func (r *readPrefetcher) stats() (prefetched, dropped uint64) {
+	return atomic.LoadUint64(&r.prefetched), atomic.LoadUint64(&r.dropped)
+}
+
+func (r *readPrefetcher) stop() {
+	close(r.stopCh)
+	<-r.doneCh
+}
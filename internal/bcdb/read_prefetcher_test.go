@@ -0,0 +1,265 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/testfault"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type readPrefetcherTestEnv struct {
+	path    string
+	db      *leveldb.LevelDB
+	logger  *logger.SugarLogger
+	cleanup func()
+}
+
+func newReadPrefetcherTestEnv(t testing.TB) *readPrefetcherTestEnv {
+	path, err := ioutil.TempDir("/tmp", "readPrefetcher")
+	require.NoError(t, err)
+
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	db, err := leveldb.Open(
+		&leveldb.Config{
+			DBRootDir: path,
+			Logger:    lg,
+		},
+	)
+	if err != nil {
+		if err := os.RemoveAll(path); err != nil {
+			t.Errorf("failed to remove %s due to %v", path, err)
+		}
+		t.Fatalf("failed to create a new leveldb instance, %v", err)
+	}
+
+	env := &readPrefetcherTestEnv{
+		path:   path,
+		db:     db,
+		logger: lg,
+	}
+	env.cleanup = func() {
+		if err := env.db.Close(); err != nil {
+			t.Errorf("failed to close leveldb: %v", err)
+		}
+		if err := os.RemoveAll(path); err != nil {
+			t.Errorf("failed to remove %s due to %v", path, err)
+		}
+	}
+	return env
+}
+
+// reopen closes and opens the state database again, which empties its cache
+func (env *readPrefetcherTestEnv) reopen(t testing.TB) {
+	require.NoError(t, env.db.Close())
+
+	var err error
+	env.db, err = leveldb.Open(
+		&leveldb.Config{
+			DBRootDir: env.path,
+			Logger:    env.logger,
+		},
+	)
+	require.NoError(t, err)
+}
+
+func writeKeys(t testing.TB, db worldstate.DB, numKeys int, value []byte) {
+	var writes []*worldstate.KVWithMetadata
+	for i := 0; i < numKeys; i++ {
+		writes = append(writes, &worldstate.KVWithMetadata{
+			Key:      fmt.Sprintf("key%06d", i),
+			Value:    value,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2, TxNum: uint64(i)}},
+		})
+	}
+	require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DefaultDBName: {Writes: writes},
+	}, 2))
+}
+
+func TestReadPrefetcher(t *testing.T) {
+	hintsOf := func(keys ...string) []*types.ReadHint {
+		var hints []*types.ReadHint
+		for _, key := range keys {
+			hints = append(hints, &types.ReadHint{DBName: worldstate.DefaultDBName, Key: key})
+		}
+		return hints
+	}
+
+	t.Run("hints beyond the cap are ignored", func(t *testing.T) {
+		env := newReadPrefetcherTestEnv(t)
+		defer env.cleanup()
+		writeKeys(t, env.db, 100, []byte("value"))
+
+		db := testfault.NewDB(env.db)
+		p := newReadPrefetcher(&readPrefetcherConfig{db: db, maxHintsPerTx: 10, logger: env.logger})
+		p.start()
+		defer p.stop()
+
+		var keys []string
+		for i := 0; i < 100; i++ {
+			keys = append(keys, fmt.Sprintf("key%06d", i))
+		}
+		p.submit(hintsOf(keys...))
+
+		require.Eventually(t, func() bool {
+			prefetched, _ := p.stats()
+			return prefetched == 10
+		}, 10*time.Second, 10*time.Millisecond)
+		_, dropped := p.stats()
+		require.Equal(t, uint64(90), dropped)
+		require.Equal(t, 10, db.Calls("Get"))
+	})
+
+	t.Run("bogus hints cause no error", func(t *testing.T) {
+		env := newReadPrefetcherTestEnv(t)
+		defer env.cleanup()
+		writeKeys(t, env.db, 1, []byte("value"))
+
+		db := testfault.NewDB(env.db)
+		p := newReadPrefetcher(&readPrefetcherConfig{db: db, logger: env.logger})
+		p.start()
+		defer p.stop()
+
+		db.FailNthCall("Get", 2, errors.New("read failure"))
+		p.submit([]*types.ReadHint{
+			{DBName: "", Key: "key000000"},
+			{DBName: "no-such-db", Key: "key000000"},
+			{DBName: "db/name", Key: "key000000"},
+			{DBName: worldstate.DefaultDBName, Key: "no-such-key"},
+			{DBName: worldstate.DefaultDBName, Key: "key000000"},
+			{DBName: worldstate.DefaultDBName, Key: ""},
+			{DBName: worldstate.UsersDBName, Key: "no-such-user"},
+		})
+
+		// the databases which do not exist are skipped, and the failed read is only logged
+		require.Eventually(t, func() bool {
+			return db.Calls("Get") == 4
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Eventually(t, func() bool {
+			prefetched, _ := p.stats()
+			return prefetched == 3
+		}, 10*time.Second, 10*time.Millisecond)
+
+		// the prefetcher goes on with the hints that follow
+		p.submit(hintsOf("key000000"))
+		require.Eventually(t, func() bool {
+			prefetched, _ := p.stats()
+			return prefetched == 4
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("hints are dropped while the queue is full", func(t *testing.T) {
+		env := newReadPrefetcherTestEnv(t)
+		defer env.cleanup()
+		writeKeys(t, env.db, 1, []byte("value"))
+
+		db := testfault.NewDB(env.db)
+		db.InjectLatency("Get", 200*time.Millisecond)
+		p := newReadPrefetcher(&readPrefetcherConfig{db: db, queueLength: 1, logger: env.logger})
+		p.start()
+		defer p.stop()
+
+		// the first hints are taken by the prefetcher, the second ones wait in the queue, and the rest are dropped
+		p.submit(hintsOf("key000000"))
+		require.Eventually(t, func() bool {
+			return db.Calls("Get") == 1
+		}, 10*time.Second, 10*time.Millisecond)
+		for i := 0; i < 5; i++ {
+			p.submit(hintsOf("key000000", "key000001"))
+		}
+
+		_, dropped := p.stats()
+		require.Equal(t, uint64(8), dropped)
+		require.Eventually(t, func() bool {
+			prefetched, _ := p.stats()
+			return prefetched == 3
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+}
+
+// BenchmarkValidationReadsWithReadHints compares the reads of the MVCC validation of a block with a large read set
+// on a state database with a cold cache, with and without the hinted keys prefetched while the block is being built.
+func BenchmarkValidationReadsWithReadHints(b *testing.B) {
+	env := newReadPrefetcherTestEnv(b)
+	defer env.cleanup()
+
+	// the keys read are spread over the database, and the blocks that hold them fit in the cache of the database
+	const numKeys, numReads = 100000, 1500
+	writeKeys(b, env.db, numKeys, make([]byte, 512))
+
+	var hints []*types.ReadHint
+	for i := 0; i < numReads; i++ {
+		hints = append(hints, &types.ReadHint{
+			DBName: worldstate.DefaultDBName,
+			Key:    fmt.Sprintf("key%06d", i*numKeys/numReads),
+		})
+	}
+
+	validationReads := func(b *testing.B) {
+		snap, err := env.db.GetDBsSnapshot([]string{worldstate.DefaultDBName})
+		require.NoError(b, err)
+		defer snap.Release()
+
+		for _, h := range hints {
+			_, metadata, err := snap.Get(h.DBName, h.Key)
+			require.NoError(b, err)
+			require.NotNil(b, metadata.GetVersion())
+		}
+	}
+
+	b.Run("cold cache", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			env.reopen(b)
+			b.StartTimer()
+
+			validationReads(b)
+		}
+	})
+
+	b.Run("prefetched hints", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			env.reopen(b)
+			// the hints are prefetched while the transactions wait in the queues of the pipeline
+			p := newReadPrefetcher(&readPrefetcherConfig{db: env.db, logger: env.logger})
+			p.start()
+			for start := 0; start < len(hints); start += defaultMaxReadHintsPerTx {
+				end := start + defaultMaxReadHintsPerTx
+				if end > len(hints) {
+					end = len(hints)
+				}
+				p.submit(hints[start:end])
+			}
+			for {
+				if prefetched, _ := p.stats(); prefetched == numReads {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			p.stop()
+			b.StartTimer()
+
+			validationReads(b)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const readReplicaListenerName = "readReplica"
+
+// readReplica serves the analytical queries from a read-only state database, which is opened on a checkpoint of the
+// state database. As a block commit listener, it takes a new checkpoint every refreshInterval blocks, between two
+// commits, and swaps the replica opened on it for the current one. The previous replica is closed, and its checkpoint
+// removed, once the queries that hold it complete, hence, a long query neither blocks a refresh nor sees the replica
+// change under it.
+type readReplica struct {
+	db                       *leveldb.LevelDB
+	dir                      string
+	refreshInterval          uint64
+	worldstateQueryProcessor *worldstateQueryProcessor
+	logger                   *logger.SugarLogger
+
+	mu      sync.RWMutex
+	current *replicaInstance
+	seq     uint64
+	retired sync.WaitGroup
+}
+
+// replicaInstance is a read-only state database opened on a checkpoint
+type replicaInstance struct {
+	db      *leveldb.LevelDB
+	dir     string
+	height  uint64
+	readers sync.WaitGroup
+}
+
+type readReplicaConfig struct {
+	db                       *leveldb.LevelDB
+	dir                      string
+	refreshInterval          uint64
+	worldstateQueryProcessor *worldstateQueryProcessor
+	logger                   *logger.SugarLogger
+}
+
+// newReadReplica opens the replica on a checkpoint of the current state. It must be called before the block
+// processor starts to commit blocks, or from a block commit listener.
+func newReadReplica(conf *readReplicaConfig) (*readReplica, error) {
+	r := &readReplica{
+		db:                       conf.db,
+		dir:                      conf.dir,
+		refreshInterval:          conf.refreshInterval,
+		worldstateQueryProcessor: conf.worldstateQueryProcessor,
+		logger:                   conf.logger,
+	}
+
+	// the checkpoints left by a previous run are stale
+	if err := fileops.RemoveAll(r.dir); err != nil {
+		return nil, errors.WithMessagef(err, "error while removing the stale checkpoints in [%s]", r.dir)
+	}
+	if err := fileops.CreateDir(r.dir); err != nil {
+		return nil, errors.WithMessagef(err, "failed to create directory %s", r.dir)
+	}
+	if err := r.refresh(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// PostBlockCommitProcessing refreshes the replica every refreshInterval blocks. The block processor invokes it
+// between two commits, as the checkpoint requires. A failed refresh leaves the current replica in place. The replayed
+// blocks refresh the replica as well, as they bring the state database up to date.
+func (r *readReplica) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	if event.Block.GetHeader().GetBaseHeader().GetNumber()%r.refreshInterval != 0 {
+		return nil
+	}
+
+	if err := r.refresh(); err != nil {
+		r.logger.Errorf("failed to refresh the read replica: %s", err)
+	}
+	return nil
+}
+
+func (r *readReplica) refresh() error {
+	start := time.Now()
+
+	r.seq++
+	dir := filepath.Join(r.dir, strconv.FormatUint(r.seq, 10))
+	if err := r.db.Checkpoint(dir); err != nil {
+		return errors.WithMessage(err, "error while checkpointing the state database")
+	}
+	checkpointed := time.Since(start)
+
+	db, err := leveldb.OpenReadOnly(&leveldb.Config{
+		DBRootDir:           dir,
+		IntegrityCheckedDBs: r.db.IntegrityCheckedDBs(),
+		Logger:              r.logger,
+	})
+	if err != nil {
+		return errors.WithMessage(err, "error while opening the read replica")
+	}
+	height, err := db.Height()
+	if err != nil {
+		db.Close()
+		return errors.WithMessage(err, "error while reading the height of the read replica")
+	}
+
+	replica := &replicaInstance{
+		db:     db,
+		dir:    dir,
+		height: height,
+	}
+
+	r.mu.Lock()
+	previous := r.current
+	r.current = replica
+	r.mu.Unlock()
+
+	if previous != nil {
+		r.retire(previous)
+	}
+
+	r.logger.Debugf("refreshed the read replica to height %d in %s, of which the checkpoint took %s",
+		height, time.Since(start), checkpointed)
+	return nil
+}
+
+// retire closes the replica, and removes its checkpoint, once the queries that hold it complete
+func (r *readReplica) retire(replica *replicaInstance) {
+	r.retired.Add(1)
+	go func() {
+		defer r.retired.Done()
+
+		replica.readers.Wait()
+		if err := replica.db.Close(); err != nil {
+			r.logger.Warnf("failed to close the read replica at height %d: %s", replica.height, err)
+		}
+		if err := fileops.RemoveAll(replica.dir); err != nil {
+			r.logger.Warnf("failed to remove the checkpoint [%s]: %s", replica.dir, err)
+		}
+	}()
+}
+
+// acquire returns the current replica, which stays open until it is released
+func (r *readReplica) acquire() (*replicaInstance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.current == nil {
+		return nil, &ierrors.ServerRestrictionError{ErrMsg: "the read replica is closed"}
+	}
+
+	r.current.readers.Add(1)
+	return r.current, nil
+}
+
+func (r *readReplica) release(replica *replicaInstance) {
+	replica.readers.Done()
+}
+
+// getDataRange serves a range query from the current replica. The permissions of the querier are checked against the
+// state database, while the data is read from the replica, whose height labels the response.
+func (r *readReplica) getDataRange(dbName, querierUserID, startKey, endKey string, limit uint64) (*types.GetDataRangeResponse, error) {
+	replica, err := r.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer r.release(replica)
+
+	q := *r.worldstateQueryProcessor
+	q.db = replica.db
+	response, err := q.getDataRange(dbName, querierUserID, startKey, endKey, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response.ReplicaHeight = replica.height
+	return response, nil
+}
+
+// close closes the current replica once the queries that hold it complete, and waits for every replica to close
+func (r *readReplica) close() error {
+	r.mu.Lock()
+	current := r.current
+	r.current = nil
+	r.mu.Unlock()
+
+	if current != nil {
+		r.retire(current)
+	}
+	r.retired.Wait()
+
+	return nil
+}
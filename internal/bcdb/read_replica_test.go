@@ -0,0 +1,146 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadReplica(t *testing.T) {
+	env := newWorldstateQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+	env.q.queryProcessingConf.ResponseSizeLimitInBytes = 1024
+
+	user := &types.User{
+		Id: "alice",
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				"test-db": types.Privilege_Read,
+			},
+		},
+	}
+	u, err := proto.Marshal(user)
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: string(identity.UserNamespace) + "alice", Value: u},
+			},
+		},
+		worldstate.DatabasesDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: "test-db"},
+			},
+		},
+	}, 1))
+
+	commitValue := func(blockNum uint64, value string) {
+		require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+			"test-db": {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      "key1",
+						Value:    []byte(value),
+						Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum}},
+					},
+				},
+			},
+		}, blockNum))
+	}
+	commitValue(2, "value-2")
+
+	// the checkpoints must reside on the file system of the state database, which is in /tmp
+	path, err := ioutil.TempDir("/tmp", "readReplica")
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+	dir := filepath.Join(path, "readreplica")
+
+	r, err := newReadReplica(&readReplicaConfig{
+		db:                       env.db,
+		dir:                      dir,
+		refreshInterval:          2,
+		worldstateQueryProcessor: env.q,
+		logger:                   env.q.logger,
+	})
+	require.NoError(t, err)
+
+	requireRange := func(expectedHeight uint64, expectedValue string) {
+		resp, err := r.getDataRange("test-db", "alice", "", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, expectedHeight, resp.ReplicaHeight)
+		require.Len(t, resp.KVs, 1)
+		require.Equal(t, expectedValue, string(resp.KVs[0].Value))
+	}
+	requireRange(2, "value-2")
+
+	// the replica is refreshed every second block only
+	commitValue(3, "value-3")
+	require.NoError(t, r.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: blockWithNumber(3)}))
+	requireRange(2, "value-2")
+
+	// a long query holds the replica at height 2, which neither blocks the refresh nor changes under the query
+	held, err := r.acquire()
+	require.NoError(t, err)
+
+	commitValue(4, "value-4")
+	start := time.Now()
+	require.NoError(t, r.PostBlockCommitProcessing(&blockprocessor.CommitEvent{Block: blockWithNumber(4)}))
+	require.Less(t, int64(time.Since(start)), int64(5*time.Second))
+	requireRange(4, "value-4")
+
+	val, _, err := held.db.Get("test-db", "key1")
+	require.NoError(t, err)
+	require.Equal(t, "value-2", string(val))
+	_, err = os.Stat(held.dir)
+	require.NoError(t, err)
+
+	// the retired replica is removed once the query releases it
+	r.release(held)
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(held.dir)
+		return os.IsNotExist(err)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the permissions are checked against the state database, which revokes the permission of alice
+	user.Privilege.DbPermission = nil
+	u, err = proto.Marshal(user)
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: string(identity.UserNamespace) + "alice", Value: u},
+			},
+		},
+	}, 5))
+	resp, err := r.getDataRange("test-db", "alice", "", "", 0)
+	require.EqualError(t, err, "the user [alice] has no permission to read from database [test-db]")
+	require.IsType(t, &ierrors.PermissionErr{}, err)
+	require.Nil(t, resp)
+
+	require.NoError(t, r.close())
+	resp, err = r.getDataRange("test-db", "alice", "", "", 0)
+	require.EqualError(t, err, "the read replica is closed")
+	require.IsType(t, &ierrors.ServerRestrictionError{}, err)
+	require.Nil(t, resp)
+}
+
+func blockWithNumber(blockNum uint64) *types.Block {
+	return &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{Number: blockNum},
+		},
+	}
+}
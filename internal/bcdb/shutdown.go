@@ -0,0 +1,108 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// The stages of the orderly shutdown of the node, in the order they are carried out. The current stage is reported
+// in the cluster status while the shutdown is in progress.
+const (
+	ShutdownRejectingSubmissions    = "rejecting-submissions"
+	ShutdownDrainingPendingTxs      = "draining-pending-txs"
+	ShutdownStoppingPipeline        = "stopping-pipeline"
+	ShutdownCommittingInFlightBlock = "committing-in-flight-block"
+	ShutdownReleasingPendingTxs     = "releasing-pending-txs"
+	ShutdownVerifyingStores         = "verifying-stores"
+	ShutdownClosingStores           = "closing-stores"
+	ShutdownClosed                  = "closed"
+
+	defaultShutdownStepTimeout = 30 * time.Second
+)
+
+// shutdownProgress tracks the stage of the orderly shutdown, and holds the cluster status as it was when the
+// shutdown started, as the stores it is read from are closed during the shutdown.
+type shutdownProgress struct {
+	mu            sync.RWMutex
+	stage         string
+	clusterStatus *types.GetClusterStatusResponse
+}
+
+func (p *shutdownProgress) begin(clusterStatus *types.GetClusterStatusResponse) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.clusterStatus = clusterStatus
+}
+
+func (p *shutdownProgress) setStage(stage string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stage = stage
+}
+
+// status returns a copy of the cluster status, as it was when the shutdown started, with the current stage. It
+// returns nil if no shutdown is in progress.
+func (p *shutdownProgress) status() *types.GetClusterStatusResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.clusterStatus == nil {
+		return nil
+	}
+
+	var nodes []*types.NodeConfig
+	for _, node := range p.clusterStatus.GetNodes() {
+		nodes = append(nodes, proto.Clone(node).(*types.NodeConfig))
+	}
+
+	return &types.GetClusterStatusResponse{
+		Nodes:         nodes,
+		Version:       p.clusterStatus.GetVersion(),
+		Leader:        p.clusterStatus.GetLeader(),
+		Active:        p.clusterStatus.GetActive(),
+		ShutdownStage: p.stage,
+	}
+}
+
+// runShutdownStep reports the stage and runs the step, waiting for it at most `timeout`. A step that does not
+// complete in time is left running in the background.
+func runShutdownStep(lg *logger.SugarLogger, report func(stage string), stage string, timeout time.Duration, step func() error) error {
+	report(stage)
+	lg.Infof("Shutdown stage [%s] started", stage)
+	start := time.Now()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- step()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			lg.Errorf("Shutdown stage [%s] failed after %s: %s", stage, time.Since(start), err)
+			return errors.WithMessagef(err, "shutdown stage [%s] failed", stage)
+		}
+		lg.Infof("Shutdown stage [%s] completed in %s", stage, time.Since(start))
+		return nil
+	case <-time.After(timeout):
+		lg.Errorf("Shutdown stage [%s] did not complete within %s", stage, timeout)
+		return errors.Errorf("shutdown stage [%s] did not complete within %s", stage, timeout)
+	}
+}
+
+func shutdownStepTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return defaultShutdownStepTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,977 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/txreorderer"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultSimulationMaxSteps bounds the steps of a simulation whose configuration does not set a bound
+	DefaultSimulationMaxSteps = 10000
+	// simulationStepTimeout bounds the wall-clock time the go-routines of the pipeline take to reach their next queue
+	// operation after a step, beyond which the simulation fails, as a go-routine is blocked outside of the queues
+	simulationStepTimeout = 30 * time.Second
+
+	simClient     = "client"
+	simReorderer  = "reorderer"
+	simCreator    = "creator"
+	simReplicator = "replicator"
+)
+
+// simActors are the go-routines whose queue operations are scheduled by the simulation, in the order their operations
+// are offered to the schedule
+var simActors = []string{simClient, simReorderer, simCreator, simReplicator}
+
+// Schedule chooses the interleaving of a simulation: at each step, the operation that proceeds among the operations
+// that can.
+type Schedule interface {
+	// Choose returns the index of the operation that proceeds, out of n > 1 operations that can
+	Choose(n int) int
+}
+
+type randomSchedule struct {
+	seed int64
+	rand *rand.Rand
+}
+
+// RandomSchedule returns a schedule that chooses the operations at random. The same seed yields the same interleaving
+// of the same workload.
+func RandomSchedule(seed int64) Schedule {
+	return &randomSchedule{seed: seed, rand: rand.New(rand.NewSource(seed))}
+}
+
+func (s *randomSchedule) Choose(n int) int {
+	return s.rand.Intn(n)
+}
+
+func (s *randomSchedule) String() string {
+	return fmt.Sprintf("random schedule of seed %d", s.seed)
+}
+
+// ScheduleExplorer enumerates the schedules of a small simulation exhaustively, depth first. A schedule is the sequence
+// of its choices: each schedule replays the choices of the previous one up to the last step that has an operation left
+// to choose, chooses the next operation at that step, and the first operation at every later step.
+type ScheduleExplorer struct {
+	choices  []int
+	branches []int
+	started  bool
+}
+
+// NewScheduleExplorer creates an explorer whose first schedule always chooses the first operation
+func NewScheduleExplorer() *ScheduleExplorer {
+	return &ScheduleExplorer{}
+}
+
+// Next returns the schedule of the next simulation, or false once every schedule was returned. The simulation of a
+// schedule must end before Next is called again.
+func (e *ScheduleExplorer) Next() (Schedule, bool) {
+	if e.started {
+		i := len(e.choices) - 1
+		for ; i >= 0 && e.choices[i]+1 >= e.branches[i]; i-- {
+		}
+		if i < 0 {
+			return nil, false
+		}
+		e.choices = e.choices[:i+1]
+		e.branches = e.branches[:i+1]
+		e.choices[i]++
+	}
+	e.started = true
+
+	return &exploredSchedule{explorer: e}, true
+}
+
+// ReplaySchedule returns the schedule that makes the given choices, as reported by a failed simulation of an explored
+// schedule, and then chooses the first operation at every later step
+func ReplaySchedule(choices []int) Schedule {
+	return &exploredSchedule{
+		explorer: &ScheduleExplorer{
+			choices:  append([]int(nil), choices...),
+			branches: make([]int, len(choices)),
+		},
+	}
+}
+
+type exploredSchedule struct {
+	explorer *ScheduleExplorer
+	step     int
+}
+
+func (s *exploredSchedule) Choose(n int) int {
+	e := s.explorer
+	defer func() { s.step++ }()
+
+	if s.step < len(e.choices) {
+		e.branches[s.step] = n
+		if e.choices[s.step] >= n {
+			// the workload, or the pipeline, is not deterministic
+			e.choices[s.step] = n - 1
+		}
+		return e.choices[s.step]
+	}
+
+	e.choices = append(e.choices, 0)
+	e.branches = append(e.branches, n)
+	return 0
+}
+
+func (s *exploredSchedule) String() string {
+	return fmt.Sprintf("explored schedule of choices %v", s.explorer.choices[:s.step])
+}
+
+// SimulationConfig holds the configuration of a simulation
+type SimulationConfig struct {
+	// Config is the configuration of the simulated node, whose ledger directory must not hold a ledger
+	Config *config.Configurations
+	// Workload holds the transaction envelopes the client submits, in order, each asynchronously
+	Workload []interface{}
+	// Schedule chooses the interleaving
+	Schedule Schedule
+	// MaxSteps bounds the steps of the simulation, and is DefaultSimulationMaxSteps if zero. A simulation which does not
+	// settle within the steps fails, as the pipeline livelocks.
+	MaxSteps int
+	Logger   *logger.SugarLogger
+}
+
+// SimulationResult summarizes a simulation that settled without violating an invariant
+type SimulationResult struct {
+	Steps int
+	// Height is the height of the ledger once settled
+	Height uint64
+	// Committed holds the validation flag of each committed transaction
+	Committed map[string]types.Flag
+	// Trace is the interleaving of the simulation, one line per step
+	Trace []string
+}
+
+// SimulationFailure is returned by a simulation that violated an invariant. It holds the schedule, e.g., the seed,
+// and the interleaving that led to the violation, so that the failure can be reproduced.
+type SimulationFailure struct {
+	Schedule  string
+	Step      int
+	Violation string
+	Trace     []string
+}
+
+func (f *SimulationFailure) Error() string {
+	return fmt.Sprintf("simulation of the %s failed at step %d: %s\ninterleaving:\n  %s",
+		f.Schedule, f.Step, f.Violation, strings.Join(f.Trace, "\n  "))
+}
+
+// RunSimulation runs the transaction pipeline of a single node over the workload, with the interleaving of its
+// go-routines - the client, the tx reorderer, the block creator, and the replicator that delivers the blocks to the
+// block processor - chosen by the schedule. The go-routines hand entries over to one another through mediated queues
+// only, so that a single operation proceeds at each step, and the block timeouts of the reorderer occur as scheduled
+// too. After each step, the ledger is checked against the blocks committed so far:
+//   - the block store, the state database, and the state trie have the same height, and the blocks are consecutive;
+//   - no transaction is committed twice, nor without being accepted on submission;
+//   - the receipt of each transaction matches the block and the position it was committed at;
+//   - the state matches the writes of the valid transactions, in commit order, and each valid transaction read the
+//     versions committed before it.
+//
+// The simulation settles once the workload was submitted and every accepted transaction was committed or released. It
+// returns a *SimulationFailure if an invariant is violated, or if the pipeline deadlocks or livelocks.
+func RunSimulation(conf *SimulationConfig) (*SimulationResult, error) {
+	s, err := newSimulation(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.run()
+	if closeErr := s.close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return result, err
+}
+
+type simulation struct {
+	conf       *SimulationConfig
+	maxSteps   int
+	stores     *ledgerStores
+	pipeline   *txPipeline
+	replicator *simulatedReplicator
+	ticker     *simulatedTicker
+	trieHeight bool
+	queueNames map[*queue.Queue]string
+	// inQueue holds the description of the entries in each queue, in order
+	inQueue      map[*queue.Queue][]string
+	commitDone   chan struct{}
+	wake         chan struct{}
+	clientExited chan struct{}
+
+	mu           sync.Mutex
+	parked       map[string]*parkedOp
+	running      int
+	closed       bool
+	clientDone   bool
+	step         int
+	trace        []string
+	violation    string
+	sinceTimeout bool // whether the reorderer dequeued a transaction since the last block timeout
+	accepted     map[string]bool
+	committed    map[string]*simCommittedTx
+	unverified   []string
+	lastBlock    uint64
+	model        map[string]*simModelValue
+	unknownDBs   map[string]bool
+}
+
+type parkedOp struct {
+	actor   string
+	op      *queue.Op
+	release chan bool
+}
+
+type simCommittedTx struct {
+	blockNum uint64
+	txIndex  uint64
+	flag     types.Flag
+}
+
+// simModelValue is the value and the version of a key, as written by the valid transactions committed so far
+type simModelValue struct {
+	value   []byte
+	version *types.Version
+	deleted bool
+	// unknown is set once the key is written in a way the model does not follow, e.g., by a patch
+	unknown bool
+}
+
+func newSimulation(conf *SimulationConfig) (*simulation, error) {
+	if conf.Schedule == nil {
+		return nil, errors.New("a simulation requires a schedule")
+	}
+
+	// the validation of the transactions is sequential, and hence, deterministic
+	localConf := *conf.Config.LocalConfig
+	localConf.Server.Performance.DebugDeterministic = true
+	configs := &config.Configurations{LocalConfig: &localConf, SharedConfig: conf.Config.SharedConfig}
+
+	s := &simulation{
+		conf:         conf,
+		maxSteps:     conf.MaxSteps,
+		ticker:       &simulatedTicker{c: make(chan time.Time, 1)},
+		inQueue:      make(map[*queue.Queue][]string),
+		commitDone:   make(chan struct{}, 1),
+		wake:         make(chan struct{}, 1),
+		clientExited: make(chan struct{}),
+		parked:       make(map[string]*parkedOp),
+		accepted:     make(map[string]bool),
+		committed:    make(map[string]*simCommittedTx),
+		model:        make(map[string]*simModelValue),
+		unknownDBs:   make(map[string]bool),
+	}
+	if s.maxSteps == 0 {
+		s.maxSteps = DefaultSimulationMaxSteps
+	}
+
+	stores, err := openStores(&localConf, conf.Logger)
+	if err != nil {
+		return nil, err
+	}
+	s.stores = stores
+	if height, err := stores.blockStore.Height(); err != nil || height > 0 {
+		_ = stores.close()
+		return nil, errors.Errorf("the ledger directory of a simulation must not hold a ledger, height: %d, error: %v", height, err)
+	}
+
+	s.pipeline, s.lastBlock, err = newTxPipeline(
+		&txProcessorConfig{
+			config:          configs,
+			db:              stores.levelDB,
+			blockStore:      stores.blockStore,
+			provenanceStore: stores.provenanceStore,
+			stateTrieStore:  stores.stateTrieStore,
+			deadLetterStore: stores.deadLetterStore,
+			logger:          conf.Logger,
+			queueMediator:   s,
+			newBatchTicker:  func(time.Duration) txreorderer.Ticker { return s.ticker },
+		},
+	)
+	if err != nil {
+		_ = stores.close()
+		return nil, errors.WithMessage(err, "can't initiate the transaction pipeline")
+	}
+
+	clusterConfig, _, err := stores.levelDB.GetConfig()
+	if err != nil {
+		_ = stores.close()
+		return nil, err
+	}
+	if clusterConfig.GetLedgerConfig().GetStateMerkelPatriciaTrieDisabled() {
+		stores.stateTrieStore.SetDisabled(true)
+	} else {
+		s.trieHeight = true
+	}
+
+	s.replicator = &simulatedReplicator{
+		sim:                  s,
+		nodeID:               localConf.Server.Identity.ID,
+		blockStore:           stores.blockStore,
+		blockOneQueueBarrier: s.pipeline.blockOneQueueBarrier,
+		pendingTxs:           s.pipeline.pendingTxs,
+		orderedBlocks:        queue.NewMediated(localConf.Server.QueueLength.Block, s),
+		logger:               conf.Logger,
+	}
+	if s.replicator.lastBlock, err = stores.blockStore.GetForReplay(s.lastBlock); err != nil {
+		_ = stores.close()
+		return nil, err
+	}
+
+	// the simulation takes the place of the pipeline as the commit listener, so that each commit is checked once the
+	// pipeline completed the transactions of the block
+	s.pipeline.replicator = s.replicator
+	s.pipeline.blockCreator.RegisterReplicator(s.replicator)
+	if err = s.pipeline.blockProcessor.RegisterBlockCommitListener(commitListenerName, s); err != nil {
+		_ = stores.close()
+		return nil, err
+	}
+
+	s.queueNames = map[*queue.Queue]string{
+		s.pipeline.txQueue:         "tx queue",
+		s.pipeline.txBatchQueue:    "batch queue",
+		s.replicator.orderedBlocks: "ordered block queue",
+	}
+	return s, nil
+}
+
+func (s *simulation) run() (*SimulationResult, error) {
+	s.running = len(simActors)
+	s.pipeline.startBlockProcessor()
+	go s.runClient()
+	s.pipeline.startPreOrdering()
+	go s.replicator.deliver()
+
+	for {
+		if err := s.awaitParked(); err != nil {
+			return nil, s.failure(err.Error())
+		}
+		if violation := s.checkInvariants(); violation != "" {
+			return nil, s.failure(violation)
+		}
+		if s.settled() {
+			break
+		}
+
+		actions := s.enabledActions()
+		if len(actions) == 0 {
+			return nil, s.failure("deadlock: no operation can proceed while transactions are pending")
+		}
+		if s.step >= s.maxSteps {
+			return nil, s.failure(fmt.Sprintf("livelock: the pipeline did not settle within %d steps", s.maxSteps))
+		}
+
+		choice := 0
+		if len(actions) > 1 {
+			choice = s.conf.Schedule.Choose(len(actions))
+		}
+		s.proceed(actions[choice])
+	}
+
+	if violation := s.checkCompletion(); violation != "" {
+		return nil, s.failure(violation)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := &SimulationResult{
+		Steps:     s.step,
+		Height:    s.lastBlock,
+		Committed: make(map[string]types.Flag),
+		Trace:     append([]string(nil), s.trace...),
+	}
+	for txID, tx := range s.committed {
+		result.Committed[txID] = tx.flag
+	}
+	return result, nil
+}
+
+// close lets the queue operations proceed as if the queues were not mediated, shuts the pipeline down, and closes the
+// stores
+func (s *simulation) close() error {
+	s.mu.Lock()
+	s.closed = true
+	parked := s.parked
+	s.parked = make(map[string]*parkedOp)
+	s.mu.Unlock()
+
+	for _, p := range parked {
+		p.release <- false
+	}
+	<-s.clientExited
+
+	if err := s.pipeline.shutdown(func(string) {}, s.replicator.close); err != nil {
+		return errors.WithMessage(err, "error while shutting down the transaction pipeline")
+	}
+	return s.stores.close()
+}
+
+func (s *simulation) runClient() {
+	defer close(s.clientExited)
+
+	for _, tx := range s.conf.Workload {
+		s.mu.Lock()
+		closed := s.closed
+		s.mu.Unlock()
+		if closed {
+			break
+		}
+
+		txID := envelopeTxID(tx)
+		_, err := s.pipeline.SubmitTransaction(tx, 0)
+
+		s.mu.Lock()
+		if err != nil {
+			s.trace = append(s.trace, fmt.Sprintf("%d: %s: the submission of [%s] is rejected: %s", s.step, simClient, txID, err))
+		} else {
+			s.accepted[txID] = true
+		}
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	s.clientDone = true
+	s.running--
+	s.mu.Unlock()
+	s.signal()
+}
+
+// Await parks the queue operation of a go-routine until the scheduler lets it proceed
+func (s *simulation) Await(op *queue.Op) bool {
+	actor := s.actorOf(op)
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return false
+	}
+	p := &parkedOp{actor: actor, op: op, release: make(chan bool, 1)}
+	s.parked[actor] = p
+	s.running--
+	s.mu.Unlock()
+	s.signal()
+
+	return <-p.release
+}
+
+func (s *simulation) actorOf(op *queue.Op) string {
+	switch {
+	case op.Queue == s.pipeline.txQueue && op.Kind == queue.OpEnqueue:
+		return simClient
+	case op.Queue == s.pipeline.txQueue, op.Queue == s.pipeline.txBatchQueue && op.Kind == queue.OpEnqueue:
+		return simReorderer
+	case op.Queue == s.pipeline.txBatchQueue, op.Kind == queue.OpEnqueue:
+		return simCreator
+	default:
+		return simReplicator
+	}
+}
+
+func (s *simulation) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// awaitParked waits for every running go-routine to park on a queue operation, or to exit
+func (s *simulation) awaitParked() error {
+	deadline := time.NewTimer(simulationStepTimeout)
+	defer deadline.Stop()
+
+	for {
+		s.mu.Lock()
+		running := s.running
+		s.mu.Unlock()
+		if running == 0 {
+			return nil
+		}
+
+		select {
+		case <-s.wake:
+		case <-deadline.C:
+			return errors.Errorf("%d go-routines of the pipeline did not reach a queue operation within %s", running, simulationStepTimeout)
+		}
+	}
+}
+
+type simAction struct {
+	op      *parkedOp
+	timeout bool
+}
+
+// enabledActions lists the operations that can proceed without blocking, in the order of the actors: an enqueue on a
+// queue that has room, or that fails otherwise, a dequeue from a queue that holds an entry, and the block timeout of
+// the reorderer. The timeout is offered only once the reorderer dequeued a transaction since the last timeout, as
+// timeouts in a row make no progress and would not bound the simulation.
+func (s *simulation) enabledActions() []*simAction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var actions []*simAction
+	for _, actor := range simActors {
+		p, ok := s.parked[actor]
+		if !ok {
+			continue
+		}
+
+		q := p.op.Queue
+		switch p.op.Kind {
+		case queue.OpEnqueue:
+			if p.op.NonBlocking || !q.IsFull() {
+				actions = append(actions, &simAction{op: p})
+			}
+		case queue.OpDequeue:
+			if !q.IsEmpty() {
+				actions = append(actions, &simAction{op: p})
+			}
+			if p.op.WaitLimit > 0 && s.sinceTimeout {
+				actions = append(actions, &simAction{op: p, timeout: true})
+			}
+		}
+	}
+	return actions
+}
+
+func (s *simulation) proceed(a *simAction) {
+	s.mu.Lock()
+	s.step++
+	delete(s.parked, a.op.actor)
+	s.running++
+
+	q := a.op.op.Queue
+	name := s.queueNames[q]
+	var line string
+	switch {
+	case a.timeout:
+		s.sinceTimeout = false
+		line = fmt.Sprintf("the block timeout occurs while the %s waits on the %s", a.op.actor, name)
+	case a.op.op.Kind == queue.OpEnqueue && a.op.op.NonBlocking && q.IsFull():
+		line = fmt.Sprintf("the %s fails to enqueue %s into the full %s", a.op.actor, describeEntry(a.op.op.Entry), name)
+	case a.op.op.Kind == queue.OpEnqueue:
+		entry := describeEntry(a.op.op.Entry)
+		s.inQueue[q] = append(s.inQueue[q], entry)
+		line = fmt.Sprintf("the %s enqueues %s into the %s", a.op.actor, entry, name)
+	default:
+		if q == s.pipeline.txQueue {
+			s.sinceTimeout = true
+		}
+		var entry string
+		if len(s.inQueue[q]) > 0 {
+			entry, s.inQueue[q] = s.inQueue[q][0], s.inQueue[q][1:]
+		}
+		line = fmt.Sprintf("the %s dequeues %s from the %s", a.op.actor, entry, name)
+	}
+	s.trace = append(s.trace, fmt.Sprintf("%d: %s", s.step, line))
+	s.mu.Unlock()
+
+	if a.timeout {
+		s.ticker.fire()
+	}
+	a.op.release <- a.timeout
+}
+
+// settled is true once the workload was submitted, and the accepted transactions were committed or released
+func (s *simulation) settled() bool {
+	s.mu.Lock()
+	clientDone := s.clientDone
+	s.mu.Unlock()
+
+	return clientDone && s.pipeline.pendingTxs.Empty() &&
+		s.pipeline.txQueue.IsEmpty() && s.pipeline.txBatchQueue.IsEmpty() && s.replicator.orderedBlocks.IsEmpty()
+}
+
+func (s *simulation) failure(violation string) *SimulationFailure {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule := "schedule"
+	if stringer, ok := s.conf.Schedule.(fmt.Stringer); ok {
+		schedule = stringer.String()
+	}
+	return &SimulationFailure{
+		Schedule:  schedule,
+		Step:      s.step,
+		Violation: violation,
+		Trace:     append([]string(nil), s.trace...),
+	}
+}
+
+// PostBlockCommitProcessing completes the transactions of the committed block through the pipeline, records the block
+// for the invariant checks, and lets the replicator deliver the next block
+func (s *simulation) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	err := s.pipeline.PostBlockCommitProcessing(event)
+	if event.IsReplay {
+		return err
+	}
+
+	s.recordCommit(event.Block)
+	s.commitDone <- struct{}{}
+	return err
+}
+
+func (s *simulation) recordCommit(block *types.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+	if blockNum != s.lastBlock+1 {
+		s.violate("block %d is committed after block %d", blockNum, s.lastBlock)
+	}
+	s.lastBlock = blockNum
+
+	txIDs, err := utils.BlockPayloadToTxIDs(block.GetPayload())
+	if err != nil {
+		s.violate("the transactions of block %d are unknown: %s", blockNum, err)
+		return
+	}
+	valInfo := block.GetHeader().GetValidationInfo()
+	if len(valInfo) != len(txIDs) {
+		s.violate("block %d holds %d transactions, but %d validation results", blockNum, len(txIDs), len(valInfo))
+		return
+	}
+
+	var flags []string
+	for i, txID := range txIDs {
+		flag := valInfo[i].GetFlag()
+		flags = append(flags, fmt.Sprintf("%s %s", txID, flag))
+
+		if prev, ok := s.committed[txID]; ok {
+			s.violate("transaction [%s] is committed in block %d, and again in block %d", txID, prev.blockNum, blockNum)
+		}
+		if !s.accepted[txID] {
+			s.violate("transaction [%s] is committed in block %d, yet was never accepted on submission", txID, blockNum)
+		}
+		s.committed[txID] = &simCommittedTx{blockNum: blockNum, txIndex: uint64(i), flag: flag}
+		s.unverified = append(s.unverified, txID)
+	}
+	s.trace = append(s.trace, fmt.Sprintf("%d: block %d is committed: %s", s.step, blockNum, strings.Join(flags, ", ")))
+
+	for i, env := range block.GetDataTxEnvelopes().GetEnvelopes() {
+		s.checkDataTx(env.GetPayload(), valInfo[i].GetFlag(), &types.Version{BlockNum: blockNum, TxNum: uint64(i)})
+	}
+}
+
+// checkDataTx checks the reads of a committed data transaction against the model, and applies its writes to the model
+// if it is valid
+func (s *simulation) checkDataTx(tx *types.DataTx, flag types.Flag, version *types.Version) {
+	stale := false
+	for _, ops := range tx.GetDbOperations() {
+		for _, read := range ops.GetDataReads() {
+			v, known := s.modelValue(ops.GetDbName(), read.GetKey())
+			if !known {
+				// the version the transaction should have read is unknown
+				stale = true
+				continue
+			}
+			var current *types.Version
+			if v != nil && !v.deleted {
+				current = v.version
+			}
+			if !proto.Equal(read.GetVersion(), current) {
+				stale = true
+				if flag == types.Flag_VALID {
+					s.violate("transaction [%s] is valid, yet it read version %v of key [%s] in [%s], whose committed version is %v",
+						tx.GetTxId(), read.GetVersion(), read.GetKey(), ops.GetDbName(), current)
+				}
+			}
+		}
+	}
+	// a conflict within the block is also raised by a second write of a key in the block, whatever the reads
+	if !stale && flag == types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE {
+		s.violate("transaction [%s] is invalidated by an MVCC conflict with the committed state, yet it read the committed versions", tx.GetTxId())
+	}
+
+	if flag != types.Flag_VALID {
+		return
+	}
+	for _, ops := range tx.GetDbOperations() {
+		dbName := ops.GetDbName()
+		if len(ops.GetDataDeleteRanges()) > 0 {
+			s.unknownDBs[dbName] = true
+		}
+		for _, w := range ops.GetDataWrites() {
+			s.model[modelKey(dbName, w.GetKey())] = &simModelValue{value: w.GetValue(), version: version}
+		}
+		for _, p := range ops.GetDataPatches() {
+			s.model[modelKey(dbName, p.GetKey())] = &simModelValue{unknown: true}
+		}
+		for _, d := range ops.GetDataDeletes() {
+			s.model[modelKey(dbName, d.GetKey())] = &simModelValue{deleted: true}
+		}
+	}
+}
+
+// modelValue returns the value of the key in the model, nil if the key was never written, and false if the model does
+// not follow the key
+func (s *simulation) modelValue(dbName, key string) (*simModelValue, bool) {
+	if s.unknownDBs[dbName] {
+		return nil, false
+	}
+	v := s.model[modelKey(dbName, key)]
+	if v != nil && v.unknown {
+		return nil, false
+	}
+	return v, true
+}
+
+func modelKey(dbName, key string) string {
+	return dbName + "\x00" + key
+}
+
+// violate records the first violation, which is reported by the scheduler once the step completes
+func (s *simulation) violate(format string, args ...interface{}) {
+	if s.violation == "" {
+		s.violation = fmt.Sprintf(format, args...)
+	}
+}
+
+// checkInvariants checks the ledger against the blocks committed so far, and returns the first violation, or an empty
+// string
+func (s *simulation) checkInvariants() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.violation != "" {
+		return s.violation
+	}
+
+	blockStoreHeight, err := s.stores.blockStore.Height()
+	if err != nil {
+		return fmt.Sprintf("the height of the block store is unknown: %s", err)
+	}
+	if blockStoreHeight != s.lastBlock {
+		return fmt.Sprintf("the height of the block store is %d, but the last committed block is %d", blockStoreHeight, s.lastBlock)
+	}
+	stateHeight, err := s.stores.levelDB.Height()
+	if err != nil {
+		return fmt.Sprintf("the height of the state database is unknown: %s", err)
+	}
+	if stateHeight != blockStoreHeight {
+		return fmt.Sprintf("the height of the state database is %d, but the height of the block store is %d", stateHeight, blockStoreHeight)
+	}
+	if s.trieHeight {
+		trieHeight, err := s.stores.stateTrieStore.Height()
+		if err != nil {
+			return fmt.Sprintf("the height of the state trie is unknown: %s", err)
+		}
+		if trieHeight != blockStoreHeight {
+			return fmt.Sprintf("the height of the state trie is %d, but the height of the block store is %d", trieHeight, blockStoreHeight)
+		}
+	}
+
+	for _, txID := range s.unverified {
+		tx := s.committed[txID]
+		txInfo, err := s.stores.blockStore.GetTxInfo(txID)
+		if err != nil {
+			return fmt.Sprintf("the receipt of transaction [%s] is unknown: %s", txID, err)
+		}
+		if txInfo.GetBlockNumber() != tx.blockNum || txInfo.GetTxIndex() != tx.txIndex || txInfo.GetValidation().GetFlag() != tx.flag {
+			return fmt.Sprintf("the receipt of transaction [%s] is at index %d of block %d, flag %s, but it was committed at index %d of block %d, flag %s",
+				txID, txInfo.GetTxIndex(), txInfo.GetBlockNumber(), txInfo.GetValidation().GetFlag(), tx.txIndex, tx.blockNum, tx.flag)
+		}
+	}
+	s.unverified = nil
+
+	for k, v := range s.model {
+		keyParts := strings.SplitN(k, "\x00", 2)
+		dbName, key := keyParts[0], keyParts[1]
+		if v.unknown || s.unknownDBs[dbName] {
+			continue
+		}
+
+		value, metadata, err := s.stores.levelDB.Get(dbName, key)
+		if err != nil {
+			return fmt.Sprintf("key [%s] in [%s] is unknown: %s", key, dbName, err)
+		}
+		switch {
+		case v.deleted && value != nil:
+			return fmt.Sprintf("key [%s] in [%s] holds version %v, but it was deleted", key, dbName, metadata.GetVersion())
+		case !v.deleted && (!bytes.Equal(value, v.value) || !proto.Equal(metadata.GetVersion(), v.version)):
+			return fmt.Sprintf("key [%s] in [%s] holds version %v, but the committed transactions wrote version %v",
+				key, dbName, metadata.GetVersion(), v.version)
+		}
+	}
+
+	return ""
+}
+
+// checkCompletion checks that each accepted transaction which is not committed was released with a reason
+func (s *simulation) checkCompletion() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for txID := range s.accepted {
+		if _, ok := s.committed[txID]; ok {
+			continue
+		}
+		if !s.pipeline.IsTxExpired(txID) && s.pipeline.TxRejection(txID) == nil {
+			return fmt.Sprintf("transaction [%s] was accepted, yet it is neither committed, nor expired, nor rejected", txID)
+		}
+	}
+	return ""
+}
+
+// simulatedReplicator takes the place of the block replicator in a simulation. It numbers the blocks created by the
+// block creator in the order they are submitted, as the leader does, and enqueues them into a mediated queue of ordered
+// blocks, out of which it delivers them to the block processor, one block at a time.
+type simulatedReplicator struct {
+	sim                  *simulation
+	nodeID               string
+	blockStore           *blockstore.Store
+	blockOneQueueBarrier *queue.OneQueueBarrier
+	pendingTxs           *queue.PendingTxs
+	orderedBlocks        *queue.Queue
+	lastBlock            *types.Block
+	closed               bool
+	logger               *logger.SugarLogger
+	sync.Mutex
+}
+
+// Submit numbers the block after the last block submitted, and enqueues it for delivery
+func (r *simulatedReplicator) Submit(block *types.Block) error {
+	r.Lock()
+	closed := r.closed
+	r.Unlock()
+	if closed {
+		return &ierrors.ClosedError{ErrMsg: "the simulated replicator is closed"}
+	}
+
+	previousHash, err := blockstore.ComputeBlockBaseHash(r.lastBlock)
+	if err != nil {
+		return err
+	}
+	height, err := r.blockStore.Height()
+	if err != nil {
+		return err
+	}
+	lastCommittedHash, err := r.blockStore.GetHash(height)
+	if err != nil {
+		return err
+	}
+
+	baseHeader := &types.BlockHeaderBase{
+		Number:                 r.lastBlock.GetHeader().GetBaseHeader().GetNumber() + 1,
+		PreviousBaseHeaderHash: previousHash,
+		LastCommittedBlockHash: lastCommittedHash,
+		LastCommittedBlockNum:  height,
+		Timestamp:              time.Now().UnixNano(),
+		ProducerVersion:        constants.ServerVersion,
+		RulesVersion:           constants.RulesVersion,
+	}
+	block.Header = &types.BlockHeader{BaseHeader: baseHeader}
+	r.lastBlock = block
+
+	if txIDs, err := utils.BlockPayloadToTxIDs(block.GetPayload()); err == nil {
+		r.pendingTxs.UpdateStage(txIDs, queue.TxStageValidating, baseHeader.Number)
+	} else {
+		r.logger.Errorf("Failed to extract TxIDs from block: %v; error: %s", block.GetHeader(), err)
+	}
+
+	r.orderedBlocks.Enqueue(block)
+	return nil
+}
+
+// deliver delivers the ordered blocks to the block processor, and waits for the post-commit processing of each, so
+// that a block is committed within the step that delivers it
+func (r *simulatedReplicator) deliver() {
+	for {
+		entry := r.orderedBlocks.Dequeue()
+		if entry == nil {
+			return
+		}
+
+		block := entry.(*types.Block)
+		if _, err := r.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, r.nodeID)); err != nil {
+			r.logger.Debugf("OneQueueBarrier error: %s", err)
+			return
+		}
+		<-r.sim.commitDone
+	}
+}
+
+// IsLeader always returns nil, as the simulated node is the only member of the cluster
+func (r *simulatedReplicator) IsLeader() *ierrors.NotLeaderError {
+	return nil
+}
+
+func (r *simulatedReplicator) close() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.closed = true
+	r.orderedBlocks.Close()
+}
+
+// simulatedTicker is the block timeout ticker of the reorderer in a simulation, which ticks when the simulation
+// schedules a timeout
+type simulatedTicker struct {
+	c chan time.Time
+}
+
+func (t *simulatedTicker) Chan() <-chan time.Time {
+	return t.c
+}
+
+func (t *simulatedTicker) fire() {
+	select {
+	case t.c <- time.Now():
+	default:
+	}
+}
+
+func (t *simulatedTicker) Reset(time.Duration) {}
+
+func (t *simulatedTicker) Stop() {}
+
+func envelopeTxID(tx interface{}) string {
+	switch env := tx.(type) {
+	case *types.DataTxEnvelope:
+		return env.GetPayload().GetTxId()
+	case *types.UserAdministrationTxEnvelope:
+		return env.GetPayload().GetTxId()
+	case *types.DBAdministrationTxEnvelope:
+		return env.GetPayload().GetTxId()
+	case *types.ConfigTxEnvelope:
+		return env.GetPayload().GetTxId()
+	}
+	return ""
+}
+
+// describeEntry describes an entry of the queues of the pipeline by the IDs of its transactions
+func describeEntry(entry interface{}) string {
+	switch e := entry.(type) {
+	case *types.Block:
+		txIDs, _ := utils.BlockPayloadToTxIDs(e.GetPayload())
+		return fmt.Sprintf("block %d %v", e.GetHeader().GetBaseHeader().GetNumber(), txIDs)
+	case *types.Block_DataTxEnvelopes, *types.Block_UserAdministrationTxEnvelope, *types.Block_UserAdministrationTxEnvelopes,
+		*types.Block_DbAdministrationTxEnvelope, *types.Block_ConfigTxEnvelope, *types.Block_VoidTxEnvelopes,
+		*types.Block_HeartbeatTxEnvelopes:
+		txIDs, _ := utils.BlockPayloadToTxIDs(e)
+		return fmt.Sprintf("batch %v", txIDs)
+	}
+	if txID := envelopeTxID(entry); txID != "" {
+		return fmt.Sprintf("[%s]", txID)
+	}
+	return fmt.Sprintf("%T", entry)
+}
@@ -0,0 +1,18 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build go1.18
+// +build go1.18
+
+package bcdb
+
+import "testing"
+
+// FuzzSimulation runs the pipeline over generated workloads and interleavings
+func FuzzSimulation(f *testing.F) {
+	for _, seed := range simulationFuzzSeeds {
+		f.Add(seed.seed, seed.txCount, seed.maxTxPerBlock, seed.queueLength)
+	}
+
+	f.Fuzz(runSimulationFuzzInput)
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// simulationSetup configures a node for a simulation, with the given batch size and queue length, and generates the
+// workload of the seed: the creation of the user, followed by data transactions that read and write a few keys, at
+// versions that are stale or current depending on the interleaving, some of which reuse a transaction ID.
+func simulationSetup(t *testing.T, seed int64, txCount int, maxTxPerBlock, queueLength uint32) *SimulationConfig {
+	cryptoDir, conf := testConfiguration(t)
+	t.Cleanup(func() { os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory) })
+	conf.LocalConfig.BlockCreation.MaxTransactionCountPerBlock = maxTxPerBlock
+	conf.LocalConfig.Server.QueueLength = config.QueueLengthConf{
+		Transaction:               queueLength,
+		ReorderedTransactionBatch: queueLength,
+		Block:                     queueLength,
+	}
+
+	lg, err := logger.New(&logger.Config{
+		Level:         "err",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+	workload := []interface{}{
+		testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+			UserId: "admin",
+			TxId:   "user-tx",
+			UserWrites: []*types.UserWrite{
+				{
+					User: &types.User{
+						Id:          "testUser",
+						Certificate: userCert.Raw,
+						Privilege: &types.Privilege{
+							DbPermission: map[string]types.Privilege_Access{
+								worldstate.DefaultDBName: types.Privilege_ReadWrite,
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	randomKey := func() string {
+		return fmt.Sprintf("key%d", r.Intn(3))
+	}
+	for i := 0; i < txCount; i++ {
+		txID := fmt.Sprintf("tx%d", i)
+		if i > 0 && r.Intn(6) == 0 {
+			txID = fmt.Sprintf("tx%d", r.Intn(i))
+		}
+
+		ops := &types.DBOperation{DbName: worldstate.DefaultDBName}
+		for j := r.Intn(3); j > 0; j-- {
+			read := &types.DataRead{Key: randomKey()}
+			if r.Intn(2) == 0 {
+				read.Version = &types.Version{BlockNum: uint64(3 + r.Intn(txCount+1)), TxNum: uint64(r.Intn(int(maxTxPerBlock)))}
+			}
+			ops.DataReads = append(ops.DataReads, read)
+		}
+		switch r.Intn(5) {
+		case 0:
+			ops.DataDeletes = append(ops.DataDeletes, &types.DataDelete{Key: randomKey()})
+		default:
+			ops.DataWrites = append(ops.DataWrites, &types.DataWrite{Key: randomKey(), Value: []byte(fmt.Sprintf("value-%d", i))})
+		}
+
+		workload = append(workload, testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+			MustSignUserIds: []string{"testUser"},
+			TxId:            txID,
+			DbOperations:    []*types.DBOperation{ops},
+		}))
+	}
+
+	return &SimulationConfig{
+		Config:   conf,
+		Workload: workload,
+		Schedule: RandomSchedule(seed),
+		Logger:   lg,
+	}
+}
+
+func TestSimulation(t *testing.T) {
+	t.Run("random schedules", func(t *testing.T) {
+		for seed := int64(1); seed <= 10; seed++ {
+			conf := simulationSetup(t, seed, 8, uint32(1+seed%3), 4)
+			result, err := RunSimulation(conf)
+			require.NoError(t, err)
+			require.Equal(t, types.Flag_VALID, result.Committed["user-tx"])
+			require.True(t, result.Height > 2)
+		}
+	})
+
+	t.Run("the same seed replays the same interleaving", func(t *testing.T) {
+		result1, err := RunSimulation(simulationSetup(t, 7, 6, 2, 100))
+		require.NoError(t, err)
+		result2, err := RunSimulation(simulationSetup(t, 7, 6, 2, 100))
+		require.NoError(t, err)
+		require.Equal(t, result1.Trace, result2.Trace)
+		require.Equal(t, result1.Committed, result2.Committed)
+	})
+
+	t.Run("exhaustive schedules of a small workload", func(t *testing.T) {
+		explorer := NewScheduleExplorer()
+		traces := make(map[string]bool)
+		for i := 0; i < 30; i++ {
+			schedule, ok := explorer.Next()
+			if !ok {
+				break
+			}
+			conf := simulationSetup(t, 3, 2, 2, 2)
+			conf.Schedule = schedule
+			result, err := RunSimulation(conf)
+			require.NoError(t, err)
+			traces[fmt.Sprint(result.Trace)] = true
+		}
+		require.True(t, len(traces) > 1)
+	})
+
+	t.Run("a failed simulation reports the seed and the interleaving", func(t *testing.T) {
+		conf := simulationSetup(t, 5, 4, 1, 4)
+		conf.MaxSteps = 6
+		_, err := RunSimulation(conf)
+		require.Error(t, err)
+		failure, ok := err.(*SimulationFailure)
+		require.True(t, ok)
+		require.Equal(t, "random schedule of seed 5", failure.Schedule)
+		require.Contains(t, failure.Violation, "livelock")
+		require.Contains(t, err.Error(), "random schedule of seed 5")
+		require.Contains(t, err.Error(), failure.Trace[len(failure.Trace)-1])
+	})
+
+	t.Run("seeds of the fuzz target", func(t *testing.T) {
+		for _, seed := range simulationFuzzSeeds {
+			runSimulationFuzzInput(t, seed.seed, seed.txCount, seed.maxTxPerBlock, seed.queueLength)
+		}
+	})
+
+	t.Run("the ledger must be empty", func(t *testing.T) {
+		conf := simulationSetup(t, 1, 1, 1, 4)
+		_, err := RunSimulation(conf)
+		require.NoError(t, err)
+
+		conf.Schedule = RandomSchedule(1)
+		_, err = RunSimulation(conf)
+		require.EqualError(t, err, "the ledger directory of a simulation must not hold a ledger, height: 3, error: <nil>")
+	})
+}
+
+// simulationFuzzSeeds are the seed inputs of FuzzSimulation, which are also run by TestSimulation, as the fuzz target
+// only builds with Go 1.18 on
+var simulationFuzzSeeds = []struct {
+	seed                                int64
+	txCount, maxTxPerBlock, queueLength uint8
+}{
+	{seed: 1, txCount: 4, maxTxPerBlock: 1, queueLength: 2},
+	{seed: 2, txCount: 8, maxTxPerBlock: 2, queueLength: 4},
+	{seed: 3, txCount: 12, maxTxPerBlock: 3, queueLength: 100},
+}
+
+// runSimulationFuzzInput runs the pipeline over the workload and the interleaving generated from a fuzz input. A
+// counterexample fails with the seed of the workload and of the schedule, and the interleaving that led to the
+// violation.
+func runSimulationFuzzInput(t *testing.T, seed int64, txCount, maxTxPerBlock, queueLength uint8) {
+	conf := simulationSetup(t, seed, int(txCount%16), uint32(1+maxTxPerBlock%4), uint32(1+queueLength%8))
+	if _, err := RunSimulation(conf); err != nil {
+		t.Fatalf("%s", err)
+	}
+}
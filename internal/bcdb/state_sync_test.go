@@ -0,0 +1,304 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/mptrie"
+	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/statesync"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/state"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStateSync syncs a standby, whose ledger is a copy of the ledger of a source node, across a gap of more than a
+// thousand blocks by the net state delta of the gap.
+func TestStateSync(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	cryptoDir, conf := testConfiguration(t)
+	ledgerDir := conf.LocalConfig.Server.Database.LedgerDirectory
+	defer os.RemoveAll(ledgerDir)
+	conf.LocalConfig.Server.Database.RecordStateDeltas = true
+	_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+	dataTx := func(txID, dbName string, writes []*types.DataWrite, deletes []*types.DataDelete) *types.DataTxEnvelope {
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+			MustSignUserIds: []string{"testUser"},
+			TxId:            txID,
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:      dbName,
+					DataWrites:  writes,
+					DataDeletes: deletes,
+				},
+			},
+		})
+	}
+
+	userTx := func(txID string, dbNames ...string) *types.UserAdministrationTxEnvelope {
+		privilege := &types.Privilege{DbPermission: map[string]types.Privilege_Access{}}
+		for _, dbName := range dbNames {
+			privilege.DbPermission[dbName] = types.Privilege_ReadWrite
+		}
+		return testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+			UserId: "admin",
+			TxId:   txID,
+			UserWrites: []*types.UserWrite{
+				{
+					User: &types.User{
+						Id:          "testUser",
+						Certificate: userCert.Raw,
+						Privilege:   privilege,
+					},
+				},
+			},
+		})
+	}
+
+	// the ledger that both nodes share
+	source, err := NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	_, err = source.Submit(userTx("user-tx", worldstate.DefaultDBName), 5*time.Second)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		resp, err := source.Submit(dataTx(fmt.Sprintf("shared-%d", i), worldstate.DefaultDBName,
+			[]*types.DataWrite{{Key: fmt.Sprintf("key-%d", i), Value: []byte(fmt.Sprintf("shared-value-%d", i))}}, nil), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	}
+	require.NoError(t, source.Close())
+
+	standbyDir := ledgerDir + "-standby"
+	defer os.RemoveAll(standbyDir)
+	copyDir(t, ledgerDir, standbyDir)
+
+	// the gap: a database with an index is created, and keys are overwritten, deleted, or written and then deleted
+	source, err = NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	defer source.Close()
+	_, err = source.Submit(testutils.SignedDBAdministrationTxEnvelope(t, adminSigner, &types.DBAdministrationTx{
+		UserId:    "admin",
+		TxId:      "db-tx",
+		CreateDbs: []string{"db1"},
+		DbsIndex: map[string]*types.DBIndex{
+			"db1": {AttributeAndType: map[string]types.IndexAttributeType{"color": types.IndexAttributeType_STRING}},
+		},
+	}), 5*time.Second)
+	require.NoError(t, err)
+	_, err = source.Submit(userTx("user-tx-2", worldstate.DefaultDBName, "db1"), 5*time.Second)
+	require.NoError(t, err)
+
+	var tx *types.DataTxEnvelope
+	for i := 0; i < 1000; i++ {
+		switch {
+		case i < 5:
+			tx = dataTx(fmt.Sprintf("gap-%d", i), worldstate.DefaultDBName, nil, []*types.DataDelete{{Key: fmt.Sprintf("key-%d", i)}})
+		case i%10 == 0:
+			tx = dataTx(fmt.Sprintf("gap-%d", i), "db1",
+				[]*types.DataWrite{{Key: fmt.Sprintf("doc-%d", i), Value: []byte(fmt.Sprintf(`{"color":"color-%d"}`, i))}}, nil)
+		default:
+			tx = dataTx(fmt.Sprintf("gap-%d", i), worldstate.DefaultDBName,
+				[]*types.DataWrite{{Key: fmt.Sprintf("key-%d", i%50), Value: []byte(fmt.Sprintf("gap-value-%d", i))}}, nil)
+		}
+		_, err = source.Submit(tx, 0)
+		require.NoError(t, err)
+	}
+	_, err = source.Submit(dataTx("delete-gap-key", worldstate.DefaultDBName, nil, []*types.DataDelete{{Key: "key-49"}}), 0)
+	require.NoError(t, err)
+	_, err = source.Submit(dataTx("delete-doc", "db1", nil, []*types.DataDelete{{Key: "doc-990"}}), 0)
+	require.NoError(t, err)
+	// each transaction of the gap is a block of its own, hence, the last one is awaited on the block store rather
+	// than by a synchronous submission, whose timeout would depend on the speed of the machine
+	require.Eventually(t, func() bool {
+		_, err := source.stores.blockStore.GetTxInfo("delete-doc")
+		return err == nil
+	}, 2*time.Minute, 100*time.Millisecond)
+	txInfo, err := source.stores.blockStore.GetTxInfo("delete-doc")
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, txInfo.GetValidation().GetFlag())
+
+	sourceHeight, err := source.stores.blockStore.Height()
+	require.NoError(t, err)
+
+	standby, err := openStores(standbyLocalConf(conf, standbyDir).LocalConfig, lg)
+	require.NoError(t, err)
+	standbyHeight, err := standby.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), standbyHeight)
+	require.Greater(t, sourceHeight-standbyHeight, uint64(1000))
+
+	src := statesync.NewSource(source.stores.blockStore)
+	headers, err := src.GetHeaders(standbyHeight+1, sourceHeight)
+	require.NoError(t, err)
+	delta, err := src.NetStateDelta(standbyHeight+1, sourceHeight)
+	require.NoError(t, err)
+
+	applier := statesync.NewApplier(&statesync.Config{
+		DB:              standby.levelDB,
+		BlockStore:      standby.blockStore,
+		StateTrieStore:  standby.stateTrieStore,
+		ProvenanceStore: standby.provenanceStore,
+		Logger:          lg,
+	})
+
+	t.Run("mismatched state root", func(t *testing.T) {
+		tampered := proto.Clone(delta).(*types.StateDelta)
+		for _, k := range tampered.Keys {
+			if k.DbName == worldstate.DefaultDBName && k.Key == "key-21" {
+				k.Value = []byte("tampered")
+			}
+		}
+
+		err := applier.Apply(headers, tampered)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("does not match the state root of block %d", sourceHeight))
+
+		for _, height := range []func() (uint64, error){standby.blockStore.Height, standby.levelDB.Height, standby.stateTrieStore.Height} {
+			h, err := height()
+			require.NoError(t, err)
+			require.Equal(t, standbyHeight, h)
+		}
+		require.False(t, standby.levelDB.Exist("db1"))
+	})
+
+	t.Run("headers not chained", func(t *testing.T) {
+		err := applier.Apply(headers[1:], &types.StateDelta{StartBlockNum: standbyHeight + 2, EndBlockNum: sourceHeight})
+		require.EqualError(t, err, fmt.Sprintf("the state delta of blocks [%d, %d] does not follow the last committed block [%d]",
+			standbyHeight+2, sourceHeight, standbyHeight))
+	})
+
+	t.Run("sync across the gap", func(t *testing.T) {
+		require.NoError(t, applier.Apply(headers, delta))
+
+		for _, height := range []func() (uint64, error){standby.blockStore.Height, standby.levelDB.Height, standby.stateTrieStore.Height} {
+			h, err := height()
+			require.NoError(t, err)
+			require.Equal(t, sourceHeight, h)
+		}
+
+		sourceHash, err := source.stores.blockStore.GetHash(sourceHeight)
+		require.NoError(t, err)
+		standbyHash, err := standby.blockStore.GetHash(sourceHeight)
+		require.NoError(t, err)
+		require.Equal(t, sourceHash, standbyHash)
+
+		// the synced blocks are held by their headers alone, hence the standby does not serve them
+		_, err = standby.blockStore.GetRaw(sourceHeight)
+		require.EqualError(t, err, fmt.Sprintf("the payload of block [%d] is not available, as the block was synced by the state delta of its range", sourceHeight))
+
+		sourceHeader, err := source.stores.blockStore.GetHeader(sourceHeight)
+		require.NoError(t, err)
+		trie, err := mptrie.NewTrie(sourceHeader.GetStateMerkelTreeRootHash(), standby.stateTrieStore)
+		require.NoError(t, err)
+		root, err := trie.Hash()
+		require.NoError(t, err)
+		require.Equal(t, sourceHeader.GetStateMerkelTreeRootHash(), root)
+		trieKey, err := state.ConstructCompositeKey("db1", "doc-500")
+		require.NoError(t, err)
+		trieValue, err := trie.Get(trieKey)
+		require.NoError(t, err)
+		require.Equal(t, []byte(`{"color":"color-500"}`), trieValue)
+
+		for dbName, keys := range map[string][]string{
+			worldstate.DefaultDBName: {"key-0", "key-4", "key-5", "key-9", "key-20", "key-49"},
+			"db1":                    {"doc-10", "doc-500", "doc-990"},
+		} {
+			for _, key := range keys {
+				sourceValue, sourceMetadata, err := source.stores.levelDB.Get(dbName, key)
+				require.NoError(t, err)
+				standbyValue, standbyMetadata, err := standby.levelDB.Get(dbName, key)
+				require.NoError(t, err)
+				require.Equal(t, sourceValue, standbyValue, "%s/%s", dbName, key)
+				require.True(t, proto.Equal(sourceMetadata, standbyMetadata), "%s/%s", dbName, key)
+			}
+		}
+
+		// the history of a key links the value written by the range to the value before it
+		version, err := standby.levelDB.GetVersion(worldstate.DefaultDBName, "key-5")
+		require.NoError(t, err)
+		previous, err := standby.provenanceStore.GetPreviousValues(worldstate.DefaultDBName, "key-5", version, 1)
+		require.NoError(t, err)
+		require.Len(t, previous, 1)
+		require.Equal(t, []byte("shared-value-5"), previous[0].GetValue())
+
+		require.True(t, standby.levelDB.Exist(stateindex.IndexDB("db1")))
+		indexDef, _, err := standby.levelDB.GetIndexDefinition("db1")
+		require.NoError(t, err)
+		require.NotNil(t, indexDef)
+	})
+
+	// the standby continues the ledger after the sync
+	require.NoError(t, standby.stateTrieStore.Close())
+	require.NoError(t, standby.provenanceStore.Close())
+	require.NoError(t, standby.levelDB.Close())
+	require.NoError(t, standby.blockStore.Close())
+	require.NoError(t, standby.deadLetterStore.Close())
+	conf.LocalConfig = standbyLocalConf(conf, standbyDir).LocalConfig
+	standbyDB, err := NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	defer standbyDB.Close()
+
+	resp, err := standbyDB.Submit(dataTx("after-sync", "db1", []*types.DataWrite{{Key: "doc-10", Value: []byte(`{"color":"blue"}`)}}, nil), 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	require.Equal(t, sourceHeight+1, resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+}
+
+func standbyLocalConf(conf *config.Configurations, ledgerDir string) *config.Configurations {
+	localConf := *conf.LocalConfig
+	localConf.Server.Database.LedgerDirectory = ledgerDir
+	standbyConf := *conf
+	standbyConf.LocalConfig = &localConf
+	return &standbyConf
+}
+
+func copyDir(t *testing.T, src, dst string) {
+	err := filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode())
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, in)
+		return err
+	})
+	require.NoError(t, err)
+}
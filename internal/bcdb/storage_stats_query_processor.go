@@ -0,0 +1,90 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+)
+
+type storageStatsQueryProcessor struct {
+	db              *leveldb.LevelDB
+	blockStore      *blockstore.Store
+	identityQuerier *identity.Querier
+	logger          *logger.SugarLogger
+}
+
+type storageStatsQueryProcessorConfig struct {
+	db              *leveldb.LevelDB
+	blockStore      *blockstore.Store
+	identityQuerier *identity.Querier
+	logger          *logger.SugarLogger
+}
+
+func newStorageStatsQueryProcessor(conf *storageStatsQueryProcessorConfig) *storageStatsQueryProcessor {
+	return &storageStatsQueryProcessor{
+		db:              conf.db,
+		blockStore:      conf.blockStore,
+		identityQuerier: conf.identityQuerier,
+		logger:          conf.logger,
+	}
+}
+
+// getStorageStats returns the raw internal statistics of each database partition of the state database
+func (s *storageStatsQueryProcessor) getStorageStats(querierUserID string) (map[string]*goleveldb.DBStats, error) {
+	if err := s.checkAdmin(querierUserID); err != nil {
+		return nil, err
+	}
+
+	return s.db.RawStats()
+}
+
+// getStorageMetrics returns the last periodic sample of the metrics of the state database
+func (s *storageStatsQueryProcessor) getStorageMetrics(querierUserID string) ([]*leveldb.StorageMetric, error) {
+	if err := s.checkAdmin(querierUserID); err != nil {
+		return nil, err
+	}
+
+	if !s.db.StorageMetricsEnabled() {
+		return nil, &ierrors.ServerRestrictionError{ErrMsg: "storage metrics are disabled on this server"}
+	}
+
+	return s.db.StorageMetrics(), nil
+}
+
+// getStorageHandles returns the snapshots and iterators of the state database which are not yet released
+func (s *storageStatsQueryProcessor) getStorageHandles(querierUserID string) ([]*leveldb.HandleInfo, error) {
+	if err := s.checkAdmin(querierUserID); err != nil {
+		return nil, err
+	}
+
+	return s.db.LiveHandles(), nil
+}
+
+// getBlockStoreStats returns the space taken by the blocks of the block store, before and after compression
+func (s *storageStatsQueryProcessor) getBlockStoreStats(querierUserID string) (*blockstore.Stats, error) {
+	if err := s.checkAdmin(querierUserID); err != nil {
+		return nil, err
+	}
+
+	return s.blockStore.Stats()
+}
+
+func (s *storageStatsQueryProcessor) checkAdmin(querierUserID string) error {
+	isAdmin, err := s.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to read the storage statistics",
+		}
+	}
+
+	return nil
+}
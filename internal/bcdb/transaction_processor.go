@@ -3,49 +3,37 @@
 package bcdb
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hyperledger-labs/orion-server/config"
-	"github.com/hyperledger-labs/orion-server/internal/blockcreator"
-	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
 	"github.com/hyperledger-labs/orion-server/internal/comm"
-	internalerror "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/deadletter"
 	"github.com/hyperledger-labs/orion-server/internal/mptrie"
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
 	"github.com/hyperledger-labs/orion-server/internal/queue"
 	"github.com/hyperledger-labs/orion-server/internal/replication"
+	"github.com/hyperledger-labs/orion-server/internal/statesync"
 	"github.com/hyperledger-labs/orion-server/internal/txreorderer"
-	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
-	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
 )
 
-const (
-	commitListenerName = "transactionProcessor"
-)
-
+// transactionProcessor is the transaction pipeline of a networked node, which orders the blocks by running consensus
+// with the other nodes of the cluster over the peer transport.
 type transactionProcessor struct {
-	nodeID               string
-	txQueue              *queue.Queue
-	txBatchQueue         *queue.Queue
-	blockOneQueueBarrier *queue.OneQueueBarrier
-	txReorderer          *txreorderer.TxReorderer
-	blockCreator         *blockcreator.BlockCreator
-	blockReplicator      *replication.BlockReplicator
-	peerTransport        *comm.HTTPTransport
-	blockProcessor       *blockprocessor.BlockProcessor
-	blockStore           *blockstore.Store
-	pendingTxs           *queue.PendingTxs
-	logger               *logger.SugarLogger
-	sync.Mutex
+	*txPipeline
+	blockReplicator *replication.BlockReplicator
+	peerTransport   *comm.HTTPTransport
+	heartbeats      *heartbeatSender
+	shutdownOnce    sync.Once
+	shutdownErr     error
 }
 
 type txProcessorConfig struct {
@@ -54,102 +42,39 @@ type txProcessorConfig struct {
 	blockStore      *blockstore.Store
 	provenanceStore *provenance.Store
 	stateTrieStore  mptrie.Store
+	deadLetterStore *deadletter.Store // records the dropped transactions, optional
+	signer          crypto.Signer     // used to sign the block headers, and the heartbeats, which require it when enabled
 	logger          *logger.SugarLogger
+	// queueMediator and newBatchTicker are set by a simulation only, to schedule the operations on the queues of the
+	// pipeline and the block timeouts of the reorderer
+	queueMediator  queue.Mediator
+	newBatchTicker func(d time.Duration) txreorderer.Ticker
 }
 
 func newTransactionProcessor(conf *txProcessorConfig) (*transactionProcessor, error) {
-	p := &transactionProcessor{}
-
-	localConfig := conf.config.LocalConfig
-
-	p.nodeID = localConfig.Server.Identity.ID
-	p.logger = conf.logger
-	p.txQueue = queue.New(localConfig.Server.QueueLength.Transaction)
-	p.txBatchQueue = queue.New(localConfig.Server.QueueLength.ReorderedTransactionBatch)
-	p.blockOneQueueBarrier = queue.NewOneQueueBarrier(conf.logger)
-	p.pendingTxs = queue.NewPendingTxs(conf.logger)
-
-	p.txReorderer = txreorderer.New(
-		&txreorderer.Config{
-			TxQueue:            p.txQueue,
-			TxBatchQueue:       p.txBatchQueue,
-			MaxTxCountPerBatch: localConfig.BlockCreation.MaxTransactionCountPerBlock,
-			BatchTimeout:       localConfig.BlockCreation.BlockTimeout,
-			Logger:             conf.logger,
-		},
-	)
-
-	var err error
-
-	// The txValidator is used by the block processor (commit-phase) as well as by some pre-order components that need
-	// it (or one of its sub-components), e.g. the config-validator is used by the block-replicator.
-	txValidator := txvalidation.NewValidator(
-		&txvalidation.Config{
-			DB:     conf.db,
-			Logger: conf.logger,
-		},
-	)
-
-	p.blockProcessor = blockprocessor.New(
-		&blockprocessor.Config{
-			BlockOneQueueBarrier: p.blockOneQueueBarrier,
-			BlockStore:           conf.blockStore,
-			ProvenanceStore:      conf.provenanceStore,
-			StateTrieStore:       conf.stateTrieStore,
-			DB:                   conf.db,
-			TxValidator:          txValidator,
-			Logger:               conf.logger,
-		},
-	)
-
-	ledgerHeight, err := conf.blockStore.Height()
+	pipeline, ledgerHeight, err := newTxPipeline(conf)
 	if err != nil {
 		return nil, err
 	}
-	if ledgerHeight == 0 {
-		p.logger.Info("Ledger is empty")
-		if conf.config.SharedConfig != nil {
-			p.logger.Info("Bootstrapping the ledger and database from SharedConfiguration")
-			tx, err := PrepareBootstrapConfigTx(conf.config)
-			if err != nil {
-				return nil, err
-			}
-			bootBlock, err := blockcreator.BootstrapBlock(tx)
-			if err != nil {
-				return nil, err
-			}
-			if err = p.blockProcessor.Bootstrap(bootBlock, conf.config.SharedConfig.Ledger); err != nil {
-				return nil, err
-			}
-			ledgerHeight = 1 // genesis block generated
-		} else if conf.config.JoinBlock != nil {
-			p.logger.Infof("Bootstrapping the ledger and database from the cluster using a join block, number: %d",
-				conf.config.JoinBlock.GetHeader().GetBaseHeader().GetNumber())
-		} else {
-			return nil, errors.New("missing bootstrap, no SharedConfig or JoinBlock")
-		}
-	}
+	p := &transactionProcessor{txPipeline: pipeline}
 
-	p.blockCreator, err = blockcreator.New(
-		&blockcreator.Config{
-			TxBatchQueue: p.txBatchQueue,
-			Logger:       conf.logger,
-			BlockStore:   conf.blockStore,
-			PendingTxs:   p.pendingTxs,
-		},
-	)
-	if err != nil {
-		return nil, err
-	}
+	localConfig := conf.config.LocalConfig
 
-	p.peerTransport, err = comm.NewHTTPTransport(&comm.Config{
+	transportConf := &comm.Config{
 		LocalConf:    localConfig,
 		Logger:       conf.logger,
 		LedgerReader: conf.blockStore,
-	})
+		DigestSource: conf.db,
+	}
+	if localConfig.Server.Database.RecordStateDeltas {
+		transportConf.StateDeltaSource = statesync.NewSource(conf.blockStore)
+	}
+	p.peerTransport, err = comm.NewHTTPTransport(transportConf)
 	if err != nil {
 		return nil, err
 	}
+	// the blocks pulled from a peer are first compared with the peer by the state digests
+	p.blockProcessor.SetPeerDigestSource(p.peerTransport)
 
 	var clusterConfig *types.ClusterConfig
 	// A 'normal start' is when the server has the most current config known to it in the DB (and ledger), and has no
@@ -197,7 +122,7 @@ func newTransactionProcessor(conf *txProcessorConfig) (*transactionProcessor, er
 		Transport:            p.peerTransport,
 		BlockOneQueueBarrier: p.blockOneQueueBarrier,
 		PendingTxs:           p.pendingTxs,
-		ConfigValidator:      txValidator.ConfigValidator(),
+		ConfigValidator:      p.txValidator.ConfigValidator(),
 		Logger:               conf.logger,
 	}
 	if joinStart {
@@ -212,17 +137,14 @@ func newTransactionProcessor(conf *txProcessorConfig) (*transactionProcessor, er
 	if err = p.peerTransport.SetConsensusListener(p.blockReplicator); err != nil {
 		return nil, err
 	}
-	p.blockCreator.RegisterReplicator(p.blockReplicator)
-
-	if err = p.blockProcessor.RegisterBlockCommitListener(commitListenerName, p); err != nil {
+	if err = p.peerTransport.SetHeartbeatListener(p); err != nil {
+		return nil, err
+	}
+	if err = p.setReplicator(p.blockReplicator); err != nil {
 		return nil, err
 	}
 
-	go p.txReorderer.Start()
-	p.txReorderer.WaitTillStart()
-
-	go p.blockCreator.Start()
-	p.blockCreator.WaitTillStart()
+	p.startPreOrdering()
 
 	err = p.peerTransport.Start() // Starts internal goroutine
 	if err != nil {
@@ -231,146 +153,79 @@ func newTransactionProcessor(conf *txProcessorConfig) (*transactionProcessor, er
 
 	p.blockReplicator.Start() // Starts internal goroutine
 
-	go p.blockProcessor.Start()
-	p.blockProcessor.WaitTillStart()
+	p.startBlockProcessor()
 
-	p.blockStore = conf.blockStore
+	if interval := localConfig.Server.HeartbeatInterval; interval > 0 {
+		if conf.signer == nil {
+			return nil, errors.New("heartbeats are enabled but no signer is provided")
+		}
+		p.heartbeats = newHeartbeatSender(
+			&heartbeatSenderConfig{
+				nodeID:   p.nodeID,
+				interval: interval,
+				signer:   conf.signer,
+				height:   conf.blockStore.Height,
+				submit:   p.submitOwnHeartbeat,
+				logger:   conf.logger,
+			},
+		)
+		p.heartbeats.start()
+	}
 
 	return p, nil
 }
 
-// SubmitTransaction enqueue the transaction to the transaction queue
-// If the timeout is set to 0, the submission would be treated as async while
-// a non-zero timeout would be treated as a sync submission. When a timeout
-// occurs with the sync submission, a timeout error will be returned
-func (t *transactionProcessor) SubmitTransaction(tx interface{}, timeout time.Duration) (*types.TxReceiptResponse, error) {
-	var txID string
-	switch tx.(type) {
-	case *types.DataTxEnvelope:
-		txID = tx.(*types.DataTxEnvelope).Payload.TxId
-	case *types.UserAdministrationTxEnvelope:
-		txID = tx.(*types.UserAdministrationTxEnvelope).Payload.TxId
-	case *types.DBAdministrationTxEnvelope:
-		txID = tx.(*types.DBAdministrationTxEnvelope).Payload.TxId
-	case *types.ConfigTxEnvelope:
-		txID = tx.(*types.ConfigTxEnvelope).Payload.TxId
-	default:
-		return nil, errors.Errorf("unexpected transaction type")
-	}
-
-	if err := constants.SafeURLSegmentNZ(txID); err != nil {
-		return nil, &internalerror.BadRequestError{ErrMsg: errors.WithMessage(err, "bad TxId").Error()}
-	}
-
-	if err := t.IsLeader(); err != nil {
-		return nil, err
+// SubmitHeartbeat submits a heartbeat which another node forwarded to this node, asynchronously. It fails if this
+// node is not the leader.
+func (t *transactionProcessor) SubmitHeartbeat(env *types.HeartbeatTxEnvelope) error {
+	if env.GetPayload() == nil {
+		return errors.New("heartbeat has no payload")
 	}
 
-	t.Lock()
-	duplicate, err := t.isTxIDDuplicate(txID)
-	if err != nil {
-		t.Unlock()
-		return nil, err
-	}
-	if duplicate {
-		t.Unlock()
-		return nil, &internalerror.DuplicateTxIDError{TxID: txID}
-	}
-
-	if t.txQueue.IsFull() {
-		t.Unlock()
-		return nil, fmt.Errorf("transaction queue is full. It means the server load is high. Try after sometime")
-	}
-
-	jsonBytes, err := json.MarshalIndent(tx, "", "\t")
-	if err != nil {
-		t.Unlock()
-		return nil, fmt.Errorf("failed to marshal transaction: %v", err)
-	}
-	t.logger.Debugf("enqueuing transaction %s\n", string(jsonBytes))
-
-	t.txQueue.Enqueue(tx)
-	t.logger.Debug("transaction is enqueued for re-ordering")
-
-	promise := queue.NewCompletionPromise(timeout)
-	// TODO: add limit on the number of pending sync tx
-	t.pendingTxs.Add(txID, promise)
-	t.Unlock()
-
-	receipt, err := promise.Wait()
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &types.TxReceiptResponse{
-		Receipt: receipt,
-	}, nil
+	_, err := t.SubmitTransaction(env, 0)
+	return err
 }
 
-func (t *transactionProcessor) PostBlockCommitProcessing(block *types.Block) error {
-	t.logger.Debugf("received commit event for block[%d]", block.GetHeader().GetBaseHeader().GetNumber())
-
-	var txIDs []string
-
-	switch block.Payload.(type) {
-	case *types.Block_DataTxEnvelopes:
-		dataTxEnvs := block.GetDataTxEnvelopes().Envelopes
-		for _, tx := range dataTxEnvs {
-			txIDs = append(txIDs, tx.Payload.TxId)
-		}
-
-	case *types.Block_UserAdministrationTxEnvelope:
-		userTxEnv := block.GetUserAdministrationTxEnvelope()
-		txIDs = append(txIDs, userTxEnv.Payload.TxId)
-
-	case *types.Block_DbAdministrationTxEnvelope:
-		dbTxEnv := block.GetDbAdministrationTxEnvelope()
-		txIDs = append(txIDs, dbTxEnv.Payload.TxId)
-
-	case *types.Block_ConfigTxEnvelope:
-		configTxEnv := block.GetConfigTxEnvelope()
-		txIDs = append(txIDs, configTxEnv.Payload.TxId)
-
+// submitOwnHeartbeat submits a heartbeat of this node, locally if this node is the leader, or by forwarding it to the
+// leader otherwise.
+func (t *transactionProcessor) submitOwnHeartbeat(ctx context.Context, env *types.HeartbeatTxEnvelope) error {
+	leaderID := t.blockReplicator.GetLeaderID()
+	switch leaderID {
+	case 0:
+		return errors.New("there is no leader in the cluster")
+	case t.blockReplicator.RaftID():
+		return t.SubmitHeartbeat(env)
 	default:
-		return errors.Errorf("unexpected transaction envelope in the block")
-	}
-
-	t.pendingTxs.DoneWithReceipt(txIDs, block.Header)
-
-	return nil
-}
-
-func (t *transactionProcessor) isTxIDDuplicate(txID string) (bool, error) {
-	if t.pendingTxs.Has(txID) {
-		return true, nil
-	}
-
-	isTxIDAlreadyCommitted, err := t.blockStore.DoesTxIDExist(txID)
-	if err != nil {
-		return false, err
+		return t.peerTransport.SendHeartbeat(ctx, leaderID, env)
 	}
-	return isTxIDAlreadyCommitted, nil
 }
 
 func (t *transactionProcessor) Close() error {
-	t.Lock()
-	defer t.Unlock()
-
-	t.txReorderer.Stop()
-	t.blockCreator.Stop()
-	t.blockReplicator.Close()
-	t.peerTransport.Close()
-	t.blockProcessor.Stop()
-
-	return nil
+	return t.Shutdown(func(string) {})
 }
 
-func (t *transactionProcessor) IsLeader() *internalerror.NotLeaderError {
-	t.Lock()
-	defer t.Unlock()
+// Shutdown stops the transaction pipeline at a block boundary, reporting each stage it enters:
+//   - new submissions are rejected;
+//   - the pending transactions are given the configured time to be committed;
+//   - the pre-order components and the replication are stopped;
+//   - the block in flight, if any, is committed, and its post-commit processing completes;
+//   - the transactions that are still pending are released with an error.
+//
+// Each stage is bounded by the step timeout. If a stage does not complete in time, the shutdown is aborted with an
+// error, and the caller must not close the stores. Only the first call has an effect; subsequent calls return the
+// result of the first.
+func (t *transactionProcessor) Shutdown(report func(stage string)) error {
+	t.shutdownOnce.Do(func() {
+		t.shutdownErr = t.shutdown(report, func() {
+			if t.heartbeats != nil {
+				t.heartbeats.stop()
+			}
+			t.blockReplicator.Close()
+			t.peerTransport.Close()
+		})
+	})
 
-	return t.blockReplicator.IsLeader()
+	return t.shutdownErr
 }
 
 // ClusterStatus returns the leader NodeID, and the active nodes NodeIDs.
@@ -450,6 +305,13 @@ func PrepareBootstrapConfigTx(conf *config.Configurations) (*types.ConfigTxEnvel
 		},
 		LedgerConfig: &types.LedgerConfig{
 			StateMerkelPatriciaTrieDisabled: conf.SharedConfig.Ledger.StateMerklePatriciaTrieDisabled,
+			MaxKeysPerRangeDelete:           conf.SharedConfig.Ledger.MaxKeysPerRangeDelete,
+			TxOperationLimits: &types.TxOperationLimits{
+				MaxReads:      conf.SharedConfig.Ledger.TxOperationLimits.MaxReads,
+				MaxWrites:     conf.SharedConfig.Ledger.TxOperationLimits.MaxWrites,
+				MaxDeletes:    conf.SharedConfig.Ledger.TxOperationLimits.MaxDeletes,
+				MaxOperations: conf.SharedConfig.Ledger.TxOperationLimits.MaxOperations,
+			},
 		},
 	}
 
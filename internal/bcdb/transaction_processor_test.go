@@ -5,6 +5,7 @@ package bcdb
 import (
 	"bytes"
 	"crypto/x509"
+	"fmt"
 	"io/ioutil"
 	"math"
 	"os"
@@ -16,14 +17,17 @@ import (
 	"github.com/hyperledger-labs/orion-server/config"
 	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/deadletter"
 	internalerror "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/internal/mptrie"
 	mptrieStore "github.com/hyperledger-labs/orion-server/internal/mptrie/store"
 	"github.com/hyperledger-labs/orion-server/internal/mtree"
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
@@ -37,15 +41,32 @@ type txProcessorTestEnv struct {
 	db             *leveldb.LevelDB
 	blockStore     *blockstore.Store
 	stateTrieStore mptrie.Store
+	deadLetter     *deadletter.Store
 	blockStorePath string
 	txProcessor    *transactionProcessor
 	userID         string
 	userCert       *x509.Certificate
 	userSigner     crypto.Signer
+	closeStores    func()
 	cleanup        func()
 }
 
 func newTxProcessorTestEnv(t *testing.T, cryptoDir string, conf *config.Configurations) *txProcessorTestEnv {
+	env := openTxProcessorTestEnv(t, cryptoDir, conf)
+
+	if conf.JoinBlock == nil {
+		require.Eventually(t, func() bool { return env.txProcessor.IsLeader() == nil }, 30*time.Second, 100*time.Millisecond)
+		require.Eventually(t, func() bool {
+			leader, active := env.txProcessor.ClusterStatus()
+			return len(leader) > 0 && len(active) > 0
+		}, 30*time.Second, 100*time.Millisecond)
+	}
+
+	return env
+}
+
+// openTxProcessorTestEnv opens the stores and starts the transaction processor, without waiting for a leader
+func openTxProcessorTestEnv(t *testing.T, cryptoDir string, conf *config.Configurations) *txProcessorTestEnv {
 	dir := conf.LocalConfig.Server.Database.LedgerDirectory
 
 	c := &logger.Config{
@@ -114,7 +135,12 @@ func newTxProcessorTestEnv(t *testing.T, cryptoDir string, conf *config.Configur
 		t.Fatalf("error while creating state trie store, %v", err)
 	}
 
+	deadLetterStore, err := openDeadLetterStore(dir, conf.LocalConfig.Server.DeadLetter, lg)
+	require.NoError(t, err)
+
 	userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+	nodeSigner, err := crypto.NewSigner(&crypto.SignerOptions{KeyFilePath: conf.LocalConfig.Server.Identity.KeyPath})
+	require.NoError(t, err)
 
 	txProcConf := &txProcessorConfig{
 		config:          conf,
@@ -122,16 +148,22 @@ func newTxProcessorTestEnv(t *testing.T, cryptoDir string, conf *config.Configur
 		blockStore:      blockStore,
 		provenanceStore: provenanceStore,
 		stateTrieStore:  stateTrieStore,
+		deadLetterStore: deadLetterStore,
+		signer:          nodeSigner,
 		logger:          lg,
 	}
 	txProcessor, err := newTransactionProcessor(txProcConf)
 	require.NoError(t, err)
 
-	cleanup := func() {
+	closeStores := func() {
 		if err := txProcessor.Close(); err != nil {
 			t.Errorf("error while closing the transaction processor")
 		}
 
+		if err := deadLetterStore.Close(); err != nil {
+			t.Errorf("error while closing the dead-letter store, %v", err)
+		}
+
 		if err := provenanceStore.Close(); err != nil {
 			t.Errorf("error while closing the provenance store")
 		}
@@ -147,30 +179,28 @@ func newTxProcessorTestEnv(t *testing.T, cryptoDir string, conf *config.Configur
 		if err := blockStore.Close(); err != nil {
 			t.Errorf("error while closing blockstore, %v", err)
 		}
+	}
+
+	cleanup := func() {
+		closeStores()
 
 		if err := os.RemoveAll(dir); err != nil {
 			t.Fatalf("error while removing directory %s, %v", dir, err)
 		}
 	}
 
-	if conf.JoinBlock == nil {
-		require.Eventually(t, func() bool { return txProcessor.IsLeader() == nil }, 30*time.Second, 100*time.Millisecond)
-		require.Eventually(t, func() bool {
-			leader, active := txProcessor.ClusterStatus()
-			return len(leader) > 0 && len(active) > 0
-		}, 30*time.Second, 100*time.Millisecond)
-	}
-
 	return &txProcessorTestEnv{
 		dbPath:         dbPath,
 		db:             db,
 		blockStore:     blockStore,
 		stateTrieStore: stateTrieStore,
+		deadLetter:     deadLetterStore,
 		blockStorePath: blockStorePath,
 		txProcessor:    txProcessor,
 		userID:         "testUser",
 		userCert:       userCert,
 		userSigner:     userSigner,
+		closeStores:    closeStores,
 		cleanup:        cleanup,
 	}
 }
@@ -307,6 +337,12 @@ func TestTransactionProcessor(t *testing.T) {
 			},
 		}
 
+		expectedBlock.Header.ValidationInfo[0].WriteSetDigest, err = blockprocessor.CalculateWriteSetDigestForDataTx(nil,
+			tx.Payload,
+			&types.Version{BlockNum: 2, TxNum: 0},
+		)
+		require.NoError(t, err)
+
 		root, err := mtree.BuildTreeForBlockTx(expectedBlock)
 		require.NoError(t, err)
 		expectedBlock.Header.TxMerkelTreeRootHash = root.Hash()
@@ -314,12 +350,20 @@ func TestTransactionProcessor(t *testing.T) {
 		stateTrie, err := mptrie.NewTrie(genesisHeader.StateMerkelTreeRootHash, env.stateTrieStore)
 		require.NoError(t, err)
 		expectedBlock.Header.StateMerkelTreeRootHash = applyTxsOnTrie(t, env, expectedBlock.Payload.(*types.Block_DataTxEnvelopes).DataTxEnvelopes, stateTrie)
+		envelopeBytes, err := blockstore.BlockEnvelopeBytes(expectedBlock)
+		require.NoError(t, err)
+		expectedBlock.Header.Cumulative = blockstore.NextCumulativeCounters(genesisHeader.GetCumulative(), expectedBlock.Header, envelopeBytes)
 
 		block, err := env.blockStore.Get(2)
 		require.NoError(t, err)
 		require.True(t, block.GetConsensusMetadata().GetRaftTerm() > 0)
 		require.True(t, block.GetConsensusMetadata().GetRaftIndex() > 0)
 		block.ConsensusMetadata = nil
+		// the timestamp is set by the leader when it numbers the block
+		require.True(t, block.GetHeader().GetBaseHeader().GetTimestamp() > 0)
+		expectedBlock.Header.BaseHeader.Timestamp = block.GetHeader().GetBaseHeader().GetTimestamp()
+		expectedBlock.Header.BaseHeader.ProducerVersion = constants.ServerVersion
+		expectedBlock.Header.BaseHeader.RulesVersion = constants.RulesVersion
 		require.True(t, proto.Equal(expectedBlock, block), "expected: %+v, actual: %+v", expectedBlock, block)
 
 		noPendingTxs := func() bool {
@@ -402,21 +446,37 @@ func TestTransactionProcessor(t *testing.T) {
 		stateTrie, err := mptrie.NewTrie(genesisHeader.StateMerkelTreeRootHash, env.stateTrieStore)
 		require.NoError(t, err)
 		expectedBlock.Header.StateMerkelTreeRootHash = applyTxsOnTrie(t, env, expectedBlock.Payload.(*types.Block_DataTxEnvelopes).DataTxEnvelopes, stateTrie)
+		envelopeBytes, err := blockstore.BlockEnvelopeBytes(expectedBlock)
+		require.NoError(t, err)
+		expectedBlock.Header.Cumulative = blockstore.NextCumulativeCounters(genesisHeader.GetCumulative(), expectedBlock.Header, envelopeBytes)
+
+		expectedBlock.Header.ValidationInfo[0].WriteSetDigest, err = blockprocessor.CalculateWriteSetDigestForDataTx(nil,
+			tx.Payload,
+			&types.Version{BlockNum: 2, TxNum: 0},
+		)
+		require.NoError(t, err)
 
 		root, err := mtree.BuildTreeForBlockTx(expectedBlock)
 		require.NoError(t, err)
 		expectedBlock.Header.TxMerkelTreeRootHash = root.Hash()
+
 		block, err := env.blockStore.Get(2)
 		require.NoError(t, err)
 		require.True(t, block.GetConsensusMetadata().GetRaftTerm() > 0)
 		require.True(t, block.GetConsensusMetadata().GetRaftIndex() > 0)
 		block.ConsensusMetadata = nil
+		// the timestamp is set by the leader when it numbers the block
+		require.True(t, block.GetHeader().GetBaseHeader().GetTimestamp() > 0)
+		expectedBlock.Header.BaseHeader.Timestamp = block.GetHeader().GetBaseHeader().GetTimestamp()
+		expectedBlock.Header.BaseHeader.ProducerVersion = constants.ServerVersion
+		expectedBlock.Header.BaseHeader.RulesVersion = constants.RulesVersion
 		require.True(t, proto.Equal(expectedBlock, block))
 
 		expectedRespPayload := &types.TxReceiptResponse{
 			Receipt: &types.TxReceipt{
-				Header:  expectedBlockHeader,
-				TxIndex: 0,
+				Header:         expectedBlockHeader,
+				TxIndex:        0,
+				WriteSetDigest: expectedBlockHeader.ValidationInfo[0].WriteSetDigest,
 			},
 		}
 		require.True(t, proto.Equal(expectedRespPayload, resp))
@@ -557,6 +617,84 @@ func TestTransactionProcessor(t *testing.T) {
 		require.Nil(t, resp)
 	})
 
+	t.Run("timeout of a synchronous transaction reports its progress", func(t *testing.T) {
+		cryptoDir, conf := testConfiguration(t)
+		require.NotEqual(t, "", cryptoDir)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		env := newTxProcessorTestEnv(t, cryptoDir, conf)
+		defer env.cleanup()
+
+		setupTxProcessor(t, env, worldstate.DefaultDBName)
+
+		// pause the block processor after it commits block 2, so that it does not process block 3
+		pause := &pausingCommitListener{
+			blockNumber: 2,
+			resume:      make(chan struct{}),
+		}
+		require.NoError(t, env.txProcessor.blockProcessor.RegisterBlockCommitListener("pause", pause))
+
+		dataTx := func(txID, key string) *types.DataTxEnvelope {
+			return testutils.SignedDataTxEnvelope(t, []crypto.Signer{env.userSigner}, &types.DataTx{
+				MustSignUserIds: []string{"testUser"},
+				TxId:            txID,
+				DbOperations: []*types.DBOperation{
+					{
+						DbName: worldstate.DefaultDBName,
+						DataWrites: []*types.DataWrite{
+							{
+								Key:   key,
+								Value: []byte("value"),
+							},
+						},
+					},
+				},
+			})
+		}
+
+		_, err := env.txProcessor.SubmitTransaction(dataTx("tx1", "key1"), 0)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			height, err := env.blockStore.Height()
+			return err == nil && height == 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		resp, err := env.txProcessor.SubmitTransaction(dataTx("tx2", "key2"), time.Second)
+		require.Nil(t, resp)
+		require.EqualError(t, err, "timeout has occurred while waiting for the transaction receipt; last observed stage: validating, block number: 3")
+		timeoutErr, ok := err.(*internalerror.TimeoutErr)
+		require.True(t, ok)
+		require.Equal(t, "validating", timeoutErr.Stage)
+		require.Equal(t, uint64(3), timeoutErr.BlockNumber)
+
+		// the transaction remains pending, hence it cannot be resubmitted
+		require.True(t, env.txProcessor.pendingTxs.Has("tx2"))
+		_, err = env.txProcessor.SubmitTransaction(dataTx("tx2", "key2"), 0)
+		require.EqualError(t, err, "the transaction has a duplicate txID [tx2]")
+
+		close(pause.resume)
+		require.Eventually(t, func() bool {
+			return env.txProcessor.pendingTxs.Empty()
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// the client polls the receipt endpoint rather than resubmitting
+		ledgerQP := newLedgerQueryProcessor(&ledgerQueryProcessorConfig{
+			db:              env.db,
+			blockStore:      env.blockStore,
+			identityQuerier: identity.NewQuerier(env.db),
+			logger:          env.txProcessor.logger,
+		})
+		receiptResp, err := ledgerQP.getTxReceipt("testUser", "tx2")
+		require.NoError(t, err)
+		receipt := receiptResp.GetReceipt()
+		require.Equal(t, uint64(3), receipt.GetHeader().GetBaseHeader().GetNumber())
+		require.Equal(t, uint64(0), receipt.GetTxIndex())
+		require.Equal(t, types.Flag_VALID, receipt.GetHeader().GetValidationInfo()[receipt.GetTxIndex()].GetFlag())
+		require.NotEmpty(t, receipt.GetWriteSetDigest())
+		header, err := env.blockStore.GetHeader(3)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(header, receipt.GetHeader()))
+	})
+
 	t.Run("create with a join block", func(t *testing.T) {
 		cryptoDir, conf := testJoinConfiguration(t)
 		require.NotEqual(t, "", cryptoDir)
@@ -585,6 +723,196 @@ func TestTransactionProcessor(t *testing.T) {
 	})
 }
 
+func TestTransactionProcessorTxLatency(t *testing.T) {
+	cryptoDir, conf := testConfiguration(t)
+	require.NotEqual(t, "", cryptoDir)
+	defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+	conf.LocalConfig.Server.TxLatencySampleRate = 1.0
+	env := newTxProcessorTestEnv(t, cryptoDir, conf)
+	defer env.cleanup()
+
+	setupTxProcessor(t, env, worldstate.DefaultDBName)
+
+	var measured time.Duration
+	for i := 1; i <= 3; i++ {
+		tx := testutils.SignedDataTxEnvelope(t, []crypto.Signer{env.userSigner}, &types.DataTx{
+			MustSignUserIds: []string{"testUser"},
+			TxId:            fmt.Sprintf("tx%d", i),
+			DbOperations: []*types.DBOperation{
+				{
+					DbName: worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{
+						{
+							Key:   fmt.Sprintf("test-key%d", i),
+							Value: []byte("test-value"),
+						},
+					},
+				},
+			},
+		})
+
+		start := time.Now()
+		resp, err := env.txProcessor.SubmitTransaction(tx, 5*time.Second)
+		measured += time.Since(start)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	}
+
+	histograms := env.txProcessor.TxLatencyHistograms()
+	require.Len(t, histograms, 6)
+
+	var stagesSum time.Duration
+	for i, h := range histograms {
+		require.Equal(t, types.Flag_VALID.String(), h.Outcome)
+		require.Equal(t, uint64(3), h.Count)
+		require.GreaterOrEqual(t, h.Min, time.Duration(0))
+		if i < 5 {
+			require.Equal(t, queue.TxStage(i).String(), h.Stage)
+			stagesSum += h.Sum
+		}
+	}
+
+	endToEnd := histograms[5]
+	require.Equal(t, queue.TxLatencyEndToEnd, endToEnd.Stage)
+	require.Greater(t, endToEnd.Sum, time.Duration(0))
+	require.InDelta(t, float64(endToEnd.Sum), float64(stagesSum), float64(time.Millisecond))
+	require.LessOrEqual(t, endToEnd.Sum, measured)
+}
+
+func TestTransactionProcessorShutdownUnderLoad(t *testing.T) {
+	testCases := []struct {
+		name           string
+		drainTimeout   time.Duration
+		expectedStages []string
+	}{
+		{
+			name:         "drain pending transactions",
+			drainTimeout: 10 * time.Second,
+			expectedStages: []string{
+				ShutdownRejectingSubmissions,
+				ShutdownDrainingPendingTxs,
+				ShutdownStoppingPipeline,
+				ShutdownCommittingInFlightBlock,
+				ShutdownReleasingPendingTxs,
+			},
+		},
+		{
+			name:         "release pending transactions",
+			drainTimeout: 0,
+			expectedStages: []string{
+				ShutdownRejectingSubmissions,
+				ShutdownStoppingPipeline,
+				ShutdownCommittingInFlightBlock,
+				ShutdownReleasingPendingTxs,
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			cryptoDir, conf := testConfiguration(t)
+			require.NotEqual(t, "", cryptoDir)
+			defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+			conf.LocalConfig.Server.Shutdown.DrainTimeout = tt.drainTimeout
+			env := newTxProcessorTestEnv(t, cryptoDir, conf)
+
+			setupTxProcessor(t, env, worldstate.DefaultDBName)
+
+			type result struct {
+				committed []string
+				failedTx  string
+				err       error
+			}
+			results := make(chan *result, 8)
+			for w := 0; w < 8; w++ {
+				go func(w int) {
+					res := &result{}
+					for i := 0; ; i++ {
+						key := fmt.Sprintf("key-%d-%d", w, i)
+						tx := testutils.SignedDataTxEnvelope(t, []crypto.Signer{env.userSigner}, &types.DataTx{
+							MustSignUserIds: []string{"testUser"},
+							TxId:            fmt.Sprintf("tx-%d-%d", w, i),
+							DbOperations: []*types.DBOperation{
+								{
+									DbName:     worldstate.DefaultDBName,
+									DataWrites: []*types.DataWrite{{Key: key, Value: []byte("value")}},
+								},
+							},
+						})
+
+						if _, err := env.txProcessor.SubmitTransaction(tx, 30*time.Second); err != nil {
+							res.failedTx = tx.Payload.TxId
+							res.err = err
+							results <- res
+							return
+						}
+						res.committed = append(res.committed, key)
+					}
+				}(w)
+			}
+
+			require.Eventually(t, func() bool {
+				height, err := env.blockStore.Height()
+				return err == nil && height > 20
+			}, 30*time.Second, 10*time.Millisecond)
+
+			var stages []string
+			require.NoError(t, env.txProcessor.Shutdown(func(stage string) { stages = append(stages, stage) }))
+			require.Equal(t, tt.expectedStages, stages)
+			require.True(t, env.txProcessor.pendingTxs.Empty())
+
+			var committed []string
+			for w := 0; w < 8; w++ {
+				res := <-results
+				if tt.drainTimeout > 0 {
+					require.EqualError(t, res.err, "the server is shutting down and does not accept transactions")
+				} else {
+					// a transaction in flight is released either by the replication, as it stops, or by the shutdown
+					switch res.err.(type) {
+					case *internalerror.ServerRestrictionError, *internalerror.NotLeaderError:
+					default:
+						t.Fatalf("unexpected error: %v", res.err)
+					}
+				}
+
+				// a transaction rejected on submission was never accepted, while a released one is dead-lettered
+				dropped, err := env.deadLetter.Get(res.failedTx)
+				if res.err.Error() == "the server is shutting down and does not accept transactions" {
+					require.IsType(t, &internalerror.NotFoundErr{}, err)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, "testUser", dropped.GetSubmitter())
+					require.Equal(t, res.err.Error(), dropped.GetReason())
+				}
+				committed = append(committed, res.committed...)
+			}
+			require.NotEmpty(t, committed)
+
+			env.closeStores()
+
+			// the reopened node finds all the stores at the same height, hence it replays no block onto them
+			env = newTxProcessorTestEnv(t, cryptoDir, conf)
+			defer env.cleanup()
+			require.Empty(t, env.txProcessor.blockProcessor.RecoveryStatus())
+
+			blockStoreHeight, err := env.blockStore.Height()
+			require.NoError(t, err)
+			stateDBHeight, err := env.db.Height()
+			require.NoError(t, err)
+			require.Equal(t, blockStoreHeight, stateDBHeight)
+			stateTrieHeight, err := env.stateTrieStore.Height()
+			require.NoError(t, err)
+			require.Equal(t, blockStoreHeight, stateTrieHeight)
+
+			for _, key := range committed {
+				value, _, err := env.db.Get(worldstate.DefaultDBName, key)
+				require.NoError(t, err)
+				require.Equal(t, []byte("value"), value)
+			}
+		})
+	}
+}
+
 func testConfiguration(t *testing.T) (string, *config.Configurations) {
 	ledgerDir, err := ioutil.TempDir("/tmp", "server")
 	require.NoError(t, err)
@@ -843,3 +1171,15 @@ func applyTxsOnTrie(t *testing.T, env *txProcessorTestEnv, payload interface{},
 	require.NoError(t, env.stateTrieStore.RollbackChanges())
 	return stateTrieRoot
 }
+
+type pausingCommitListener struct {
+	blockNumber uint64
+	resume      chan struct{}
+}
+
+func (l *pausingCommitListener) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	if event.Block.GetHeader().GetBaseHeader().GetNumber() == l.blockNumber {
+		<-l.resume
+	}
+	return nil
+}
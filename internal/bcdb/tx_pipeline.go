@@ -0,0 +1,636 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/blockcreator"
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	internalerror "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/txreorderer"
+	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	commitListenerName = "transactionProcessor"
+	// maxLoggedTxBytes bounds the rendering of a transaction in the debug log
+	maxLoggedTxBytes = 4 * 1024
+
+	defaultCommitRateWindow = 30 * time.Second
+	defaultRetryAfterMin    = time.Second
+	defaultRetryAfterMax    = 60 * time.Second
+
+	defaultCommitPressureLatency = time.Second
+	defaultMinPressureCommits    = 3
+)
+
+// pipelineReplicator orders the blocks created by the pipeline and hands them over to the block processor. The
+// replicator of a networked node runs consensus among the nodes of the cluster, while the replicator of an embedded
+// node numbers and commits the blocks by itself.
+type pipelineReplicator interface {
+	blockcreator.Replicator
+	IsLeader() *internalerror.NotLeaderError
+}
+
+// txPipeline is the transaction pipeline of a node: the tx reorderer, the block creator, and the block processor,
+// along with the queues that connect them and the transactions pending in them. It is shared by the transaction
+// processor of a networked node and by the embedded node, which differ only by the replicator that connects the
+// block creator to the block processor.
+type txPipeline struct {
+	nodeID               string
+	txQueue              *queue.Queue
+	txBatchQueue         *queue.Queue
+	blockOneQueueBarrier *queue.OneQueueBarrier
+	txReorderer          *txreorderer.TxReorderer
+	txValidator          *txvalidation.Validator
+	blockCreator         *blockcreator.BlockCreator
+	blockProcessor       *blockprocessor.BlockProcessor
+	blockStore           *blockstore.Store
+	pendingTxs           *queue.PendingTxs
+	txLatency            *queue.TxLatencyTracker
+	commitStats          *queue.CommitStats
+	batchCompositions    *batchCompositionRecorder
+	readPrefetcher       *readPrefetcher
+	replicator           pipelineReplicator
+	shutdownConf         config.ShutdownConf
+	shuttingDown         bool
+	logger               *logger.SugarLogger
+	sync.Mutex
+}
+
+// newTxPipeline creates the components of the pipeline, and commits the genesis block if the ledger is empty and
+// the configuration holds a shared configuration. It returns the ledger height after the bootstrap. The pipeline is
+// started by start, once a replicator is set.
+func newTxPipeline(conf *txProcessorConfig) (*txPipeline, uint64, error) {
+	p := &txPipeline{}
+
+	localConfig := conf.config.LocalConfig
+
+	p.nodeID = localConfig.Server.Identity.ID
+	p.logger = conf.logger
+	p.blockStore = conf.blockStore
+	if conf.queueMediator != nil {
+		p.txQueue = queue.NewMediated(localConfig.Server.QueueLength.Transaction, conf.queueMediator)
+		p.txBatchQueue = queue.NewMediated(localConfig.Server.QueueLength.ReorderedTransactionBatch, conf.queueMediator)
+	} else {
+		p.txQueue = queue.New(localConfig.Server.QueueLength.Transaction)
+		p.txBatchQueue = queue.New(localConfig.Server.QueueLength.ReorderedTransactionBatch)
+	}
+	p.blockOneQueueBarrier = queue.NewOneQueueBarrier(conf.logger)
+	p.pendingTxs = queue.NewPendingTxs(conf.logger)
+	if conf.deadLetterStore != nil {
+		p.pendingTxs.SetDropRecorder(deadLetterRecorder(conf.deadLetterStore, conf.logger))
+	}
+	p.shutdownConf = localConfig.Server.Shutdown
+	if sampleRate := localConfig.Server.TxLatencySampleRate; sampleRate > 0 {
+		p.txLatency = queue.NewTxLatencyTracker(sampleRate)
+		p.pendingTxs.SetLatencyTracker(p.txLatency)
+	}
+	p.commitStats = newCommitStats(localConfig.Server.Backpressure, localConfig.Server.QueryConcurrency.CommitPriority)
+	p.batchCompositions = newBatchCompositionRecorder(conf.blockStore, conf.logger)
+	if readHints := localConfig.Server.Performance.ReadHints; readHints.Enabled {
+		p.readPrefetcher = newReadPrefetcher(
+			&readPrefetcherConfig{
+				db:            conf.db,
+				maxHintsPerTx: readHints.MaxHintsPerTx,
+				queueLength:   readHints.QueueLength,
+				logger:        conf.logger,
+			},
+		)
+	}
+
+	p.txReorderer = txreorderer.New(
+		&txreorderer.Config{
+			TxQueue:            p.txQueue,
+			TxBatchQueue:       p.txBatchQueue,
+			PendingTxs:         p.pendingTxs,
+			MaxTxCountPerBatch: localConfig.BlockCreation.MaxTransactionCountPerBlock,
+			BatchTimeout:       localConfig.BlockCreation.BlockTimeout,
+			Stats:              p.batchCompositions.stats,
+
+			LowLatencyQuietPeriod:    localConfig.BlockCreation.LowLatency.QuietPeriod,
+			LowLatencyMaxArrivalRate: localConfig.BlockCreation.LowLatency.MaxArrivalRate,
+			AdminReservationPercent:  localConfig.BlockCreation.AdminReservationPercent,
+			PartialBatchFile:         constructPartialBatchPath(localConfig.Server.Database.LedgerDirectory),
+			IsTxCommitted:            conf.blockStore.DoesTxIDExist,
+			LedgerHeight:             conf.blockStore.Height,
+			NewTicker:                conf.newBatchTicker,
+			Logger:                   conf.logger.Module(logger.ModuleReorderer),
+		},
+	)
+
+	// The txValidator is used by the block processor (commit-phase) as well as by some pre-order components that need
+	// it (or one of its sub-components), e.g. the config-validator is used by the block-replicator.
+	p.txValidator = txvalidation.NewValidator(
+		&txvalidation.Config{
+			DB:         conf.db,
+			BlockStore: conf.blockStore,
+			ExecutionMode: txvalidation.ExecutionMode{
+				Deterministic: localConfig.Server.Performance.DebugDeterministic,
+			},
+			Logger: conf.logger.Module(logger.ModuleValidator),
+		},
+	)
+
+	p.blockProcessor = blockprocessor.New(
+		&blockprocessor.Config{
+			BlockOneQueueBarrier: p.blockOneQueueBarrier,
+			BlockStore:           conf.blockStore,
+			ProvenanceStore:      conf.provenanceStore,
+			StateTrieStore:       conf.stateTrieStore,
+			DB:                   conf.db,
+			TxValidator:          p.txValidator,
+			MaxRecoveryBlocks:    localConfig.Server.Database.MaxRecoveryBlocks,
+			PendingTxs:           p.pendingTxs,
+			Logger:               conf.logger.Module(logger.ModuleCommitter),
+
+			CoalesceBacklogThreshold: localConfig.Server.Database.CommitCoalescing.BacklogThreshold,
+			MaxCoalescedBlocks:       int(localConfig.Server.Database.CommitCoalescing.MaxBlocks),
+			RecordStateDeltas:        localConfig.Server.Database.RecordStateDeltas,
+			IsolateFailedDBs:         localConfig.Server.Database.IsolateFailedDatabases,
+			Signer:                   conf.signer,
+			NodeID:                   p.nodeID,
+			VerifyReplayedValidation: localConfig.Server.Database.VerifyReplayedValidation,
+		},
+	)
+
+	ledgerHeight, err := conf.blockStore.Height()
+	if err != nil {
+		return nil, 0, err
+	}
+	if ledgerHeight == 0 {
+		p.logger.Info("Ledger is empty")
+		if conf.config.SharedConfig != nil {
+			p.logger.Info("Bootstrapping the ledger and database from SharedConfiguration")
+			tx, err := PrepareBootstrapConfigTx(conf.config)
+			if err != nil {
+				return nil, 0, err
+			}
+			bootBlock, err := blockcreator.BootstrapBlock(tx)
+			if err != nil {
+				return nil, 0, err
+			}
+			if err = p.blockProcessor.Bootstrap(bootBlock, conf.config.SharedConfig.Ledger); err != nil {
+				return nil, 0, err
+			}
+			ledgerHeight = 1 // genesis block generated
+		} else if conf.config.JoinBlock != nil {
+			p.logger.Infof("Bootstrapping the ledger and database from the cluster using a join block, number: %d",
+				conf.config.JoinBlock.GetHeader().GetBaseHeader().GetNumber())
+		} else {
+			return nil, 0, errors.New("missing bootstrap, no SharedConfig or JoinBlock")
+		}
+	}
+
+	// the partial batch is recovered once the ledger is bootstrapped, and before any transaction is submitted
+	if err = p.txReorderer.RecoverPartialBatch(); err != nil {
+		return nil, 0, err
+	}
+
+	invalidBatchPolicy, err := blockcreator.ParseInvalidBatchPolicy(localConfig.BlockCreation.InvalidBatchPolicy)
+	if err != nil {
+		return nil, 0, err
+	}
+	p.blockCreator, err = blockcreator.New(
+		&blockcreator.Config{
+			TxBatchQueue:       p.txBatchQueue,
+			Logger:             conf.logger.Module(logger.ModuleCreator),
+			BlockStore:         conf.blockStore,
+			PendingTxs:         p.pendingTxs,
+			InvalidBatchPolicy: invalidBatchPolicy,
+			Validator:          p.txValidator,
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return p, ledgerHeight, nil
+}
+
+// setReplicator connects the block creator to the block processor through the replicator, and registers the
+// pipeline for the commit events that complete the pending transactions.
+func (p *txPipeline) setReplicator(replicator pipelineReplicator) error {
+	p.replicator = replicator
+	p.blockCreator.RegisterReplicator(replicator)
+
+	return p.blockProcessor.RegisterBlockCommitListener(commitListenerName, p)
+}
+
+// startPreOrdering starts the components that precede the replicator: the read prefetcher, if enabled, the tx
+// reorderer, and the block creator
+func (p *txPipeline) startPreOrdering() {
+	if p.readPrefetcher != nil {
+		p.readPrefetcher.start()
+	}
+
+	go p.txReorderer.Start()
+	p.txReorderer.WaitTillStart()
+
+	go p.blockCreator.Start()
+	p.blockCreator.WaitTillStart()
+}
+
+// startBlockProcessor starts the block processor, which validates and commits the blocks delivered by the replicator
+func (p *txPipeline) startBlockProcessor() {
+	go p.blockProcessor.Start()
+	p.blockProcessor.WaitTillStart()
+}
+
+// SubmitTransaction enqueue the transaction to the transaction queue
+// If the timeout is set to 0, the submission would be treated as async while
+// a non-zero timeout would be treated as a sync submission. When a timeout
+// occurs with the sync submission, a timeout error will be returned
+func (p *txPipeline) SubmitTransaction(tx interface{}, timeout time.Duration) (*types.TxReceiptResponse, error) {
+	var txID string
+	switch tx.(type) {
+	case *types.DataTxEnvelope:
+		txID = tx.(*types.DataTxEnvelope).Payload.TxId
+	case *types.UserAdministrationTxEnvelope:
+		txID = tx.(*types.UserAdministrationTxEnvelope).Payload.TxId
+	case *types.DBAdministrationTxEnvelope:
+		txID = tx.(*types.DBAdministrationTxEnvelope).Payload.TxId
+	case *types.ConfigTxEnvelope:
+		txID = tx.(*types.ConfigTxEnvelope).Payload.TxId
+	case *types.HeartbeatTxEnvelope:
+		txID = tx.(*types.HeartbeatTxEnvelope).Payload.TxId
+	case *types.VoidTxEnvelope:
+		txID = tx.(*types.VoidTxEnvelope).Payload.TxId
+	default:
+		return nil, errors.Errorf("unexpected transaction type")
+	}
+
+	if err := constants.SafeURLSegmentNZ(txID); err != nil {
+		return nil, &internalerror.BadRequestError{ErrMsg: errors.WithMessage(err, "bad TxId").Error()}
+	}
+
+	// Checked before leadership, which is lost once the replication stops during the shutdown, and again below,
+	// atomically with adding the transaction to the pending ones.
+	p.Lock()
+	shuttingDown := p.shuttingDown
+	p.Unlock()
+	if shuttingDown {
+		return nil, &internalerror.ServerRestrictionError{ErrMsg: "the server is shutting down and does not accept transactions"}
+	}
+
+	if err := p.IsLeader(); err != nil {
+		return nil, err
+	}
+
+	// a data transaction that exceeds the operation limits would be invalidated by the validator, hence, it is
+	// rejected before it takes up room in the queues and in a block
+	if dataTxEnv, ok := tx.(*types.DataTxEnvelope); ok {
+		valRes, err := p.txValidator.ValidateOperationLimits(dataTxEnv.Payload)
+		if err != nil {
+			return nil, err
+		}
+		if valRes.Flag != types.Flag_VALID {
+			return nil, &internalerror.BadRequestError{ErrMsg: valRes.ReasonIfInvalid}
+		}
+	}
+
+	p.Lock()
+	if p.shuttingDown {
+		p.Unlock()
+		return nil, &internalerror.ServerRestrictionError{ErrMsg: "the server is shutting down and does not accept transactions"}
+	}
+
+	duplicate, err := p.isTxIDDuplicate(txID)
+	if err != nil {
+		p.Unlock()
+		return nil, err
+	}
+	if duplicate {
+		p.Unlock()
+		return nil, &internalerror.DuplicateTxIDError{TxID: txID}
+	}
+
+	p.logger.Debugf("enqueuing transaction %s", loggedTx{tx: tx})
+
+	// the transaction is added to the pending ones before it is enqueued, so that the reorderer observes the time
+	// it was submitted at
+	promise := queue.NewCompletionPromise(timeout)
+	// TODO: add limit on the number of pending sync tx
+	p.pendingTxs.Add(txID, utils.TxSubmitter(tx), promise)
+
+	// the queue is consumed by the reorderer concurrently, hence, the room in the queue is checked atomically with the
+	// enqueue, rather than beforehand
+	if err := p.txQueue.TryEnqueue(tx); err != nil {
+		p.pendingTxs.Withdraw(txID, err)
+		backlog := p.pendingTxs.Len()
+		p.Unlock()
+		return nil, &internalerror.OverloadedError{
+			ErrMsg:     fmt.Sprintf("transaction queue is full, with %d transactions pending. It means the server load is high. Try after sometime", backlog),
+			RetryAfter: p.commitStats.RetryAfter(backlog),
+		}
+	}
+	p.logger.Debug("transaction is enqueued for re-ordering")
+	p.Unlock()
+
+	receipt, err := promise.Wait()
+	if timeoutErr, ok := err.(*internalerror.TimeoutErr); ok {
+		receipt, err = p.onWaitTimeout(txID, promise, timeoutErr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TxReceiptResponse{
+		Receipt: receipt,
+	}, nil
+}
+
+// PrefetchReads hands the read hints of a submitted data transaction over to the read prefetcher. The hints are
+// ignored if the prefetch of reads is disabled.
+func (p *txPipeline) PrefetchReads(hints []*types.ReadHint) {
+	if p.readPrefetcher == nil {
+		return
+	}
+	p.readPrefetcher.submit(hints)
+}
+
+// onWaitTimeout stops waiting for the transaction, which stays pending until its block commits, and enriches the
+// timeout error with the progress the transaction made, so that the client can poll for the receipt instead of
+// resubmitting it.
+func (p *txPipeline) onWaitTimeout(txID string, promise *queue.CompletionPromise, timeoutErr *internalerror.TimeoutErr) (*types.TxReceipt, error) {
+	stage, blockNumber, pending := p.pendingTxs.DetachOnTimeout(txID)
+	if !pending {
+		// the transaction completed concurrently with the timeout, hence the result is already in the promise
+		return promise.Wait()
+	}
+
+	timeoutErr.Stage = stage.String()
+	timeoutErr.BlockNumber = blockNumber
+	if blockNumber > 0 {
+		timeoutErr.ErrMsg = fmt.Sprintf("%s; last observed stage: %s, block number: %d", timeoutErr.ErrMsg, stage, blockNumber)
+	} else {
+		timeoutErr.ErrMsg = fmt.Sprintf("%s; last observed stage: %s", timeoutErr.ErrMsg, stage)
+	}
+	p.logger.Debugf("timeout while waiting for transaction [%s]: %s", txID, timeoutErr)
+
+	return nil, timeoutErr
+}
+
+// PostBlockCommitProcessing releases the waiters of the transactions of a freshly committed block. A replayed block
+// holds no transaction that is waited on, as the replay runs on start, and is ignored.
+func (p *txPipeline) PostBlockCommitProcessing(event *blockprocessor.CommitEvent) error {
+	block := event.Block
+	if event.IsReplay {
+		p.logger.Debugf("ignoring the replay of block[%d] onto the %s", block.GetHeader().GetBaseHeader().GetNumber(), event.Source)
+		return nil
+	}
+	p.logger.Debugf("received commit event for block[%d]", block.GetHeader().GetBaseHeader().GetNumber())
+
+	var txIDs []string
+
+	switch block.Payload.(type) {
+	case *types.Block_DataTxEnvelopes:
+		dataTxEnvs := block.GetDataTxEnvelopes().Envelopes
+		for _, tx := range dataTxEnvs {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+
+	case *types.Block_UserAdministrationTxEnvelope:
+		userTxEnv := block.GetUserAdministrationTxEnvelope()
+		txIDs = append(txIDs, userTxEnv.Payload.TxId)
+
+	case *types.Block_UserAdministrationTxEnvelopes:
+		for _, tx := range block.GetUserAdministrationTxEnvelopes().Envelopes {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+
+	case *types.Block_DbAdministrationTxEnvelope:
+		dbTxEnv := block.GetDbAdministrationTxEnvelope()
+		txIDs = append(txIDs, dbTxEnv.Payload.TxId)
+
+	case *types.Block_ConfigTxEnvelope:
+		configTxEnv := block.GetConfigTxEnvelope()
+		txIDs = append(txIDs, configTxEnv.Payload.TxId)
+
+	case *types.Block_HeartbeatTxEnvelopes:
+		for _, tx := range block.GetHeartbeatTxEnvelopes().Envelopes {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+
+	case *types.Block_VoidTxEnvelopes:
+		for _, tx := range block.GetVoidTxEnvelopes().Envelopes {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+
+	default:
+		return errors.Errorf("unexpected transaction envelope in the block")
+	}
+
+	p.commitStats.RecordCommit(len(txIDs), event.CommitLatency)
+
+	// the composition is stored before the receipts are delivered, so that it can be queried once a receipt is
+	p.batchCompositions.onBlockCommit(block)
+	p.pendingTxs.DoneWithReceipt(txIDs, block.Header)
+
+	return nil
+}
+
+// newCommitStats creates the aggregator of the commits of the pipeline, which derives the Retry-After of the
+// transactions rejected while the transaction queue is full, and, if the commit priority is enabled, detects the
+// pressure on the commits
+func newCommitStats(conf config.BackpressureConf, priority config.CommitPriorityConf) *queue.CommitStats {
+	window := conf.CommitRateWindow
+	if window == 0 {
+		window = defaultCommitRateWindow
+	}
+	retryAfterMin := conf.RetryAfterMin
+	if retryAfterMin == 0 {
+		retryAfterMin = defaultRetryAfterMin
+	}
+	retryAfterMax := conf.RetryAfterMax
+	if retryAfterMax == 0 {
+		retryAfterMax = defaultRetryAfterMax
+	}
+	if retryAfterMin > retryAfterMax {
+		retryAfterMin = retryAfterMax
+	}
+
+	stats := queue.NewCommitStats(window, retryAfterMin, retryAfterMax)
+	if !priority.Enabled {
+		return stats
+	}
+
+	pressureLatency := priority.PressureLatency
+	if pressureLatency == 0 {
+		pressureLatency = defaultCommitPressureLatency
+	}
+	reliefLatency := priority.ReliefLatency
+	if reliefLatency == 0 || reliefLatency >= pressureLatency {
+		reliefLatency = pressureLatency / 2
+	}
+	minCommits := int(priority.MinCommits)
+	if minCommits == 0 {
+		minCommits = defaultMinPressureCommits
+	}
+	stats.SetPressureThresholds(pressureLatency, reliefLatency, minCommits)
+
+	return stats
+}
+
+func (p *txPipeline) isTxIDDuplicate(txID string) (bool, error) {
+	if p.pendingTxs.Has(txID) {
+		return true, nil
+	}
+
+	isTxIDAlreadyCommitted, err := p.blockStore.DoesTxIDExist(txID)
+	if err != nil {
+		return false, err
+	}
+	return isTxIDAlreadyCommitted, nil
+}
+
+// shutdown stops the pipeline at a block boundary, reporting each stage it enters:
+//   - new submissions are rejected;
+//   - the pending transactions are given the configured time to be committed;
+//   - the pre-order components and the replication, which stopReplication stops, are stopped;
+//   - the block in flight, if any, is committed, and its post-commit processing completes;
+//   - the transactions that are still pending are released with an error.
+//
+// Each stage is bounded by the step timeout. If a stage does not complete in time, the shutdown is aborted with an
+// error, and the caller must not close the stores.
+func (p *txPipeline) shutdown(report func(stage string), stopReplication func()) error {
+	stepTimeout := shutdownStepTimeout(p.shutdownConf.StepTimeout)
+
+	if err := runShutdownStep(p.logger, report, ShutdownRejectingSubmissions, stepTimeout, func() error {
+		p.Lock()
+		defer p.Unlock()
+
+		p.shuttingDown = true
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if drainTimeout := p.shutdownConf.DrainTimeout; drainTimeout > 0 {
+		report(ShutdownDrainingPendingTxs)
+		start := time.Now()
+		for !p.pendingTxs.Empty() && time.Since(start) < drainTimeout {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if p.pendingTxs.Empty() {
+			p.logger.Infof("Shutdown stage [%s] completed in %s", ShutdownDrainingPendingTxs, time.Since(start))
+		} else {
+			p.logger.Warnf("Shutdown stage [%s]: transactions are still pending after %s", ShutdownDrainingPendingTxs, drainTimeout)
+		}
+	}
+
+	if err := runShutdownStep(p.logger, report, ShutdownStoppingPipeline, stepTimeout, func() error {
+		p.txReorderer.Stop()
+		p.blockCreator.Stop()
+		if p.readPrefetcher != nil {
+			p.readPrefetcher.stop()
+		}
+		stopReplication()
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := runShutdownStep(p.logger, report, ShutdownCommittingInFlightBlock, stepTimeout, func() error {
+		p.blockProcessor.Stop()
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return runShutdownStep(p.logger, report, ShutdownReleasingPendingTxs, stepTimeout, func() error {
+		txIDs := p.pendingTxs.TxIDs()
+		if len(txIDs) > 0 {
+			p.logger.Warnf("Releasing %d transactions that were not committed before the shutdown", len(txIDs))
+		}
+		p.pendingTxs.ReleaseWithError(txIDs, &internalerror.ServerRestrictionError{
+			ErrMsg: "the server shut down before the transaction was committed; the transaction may still be committed by the cluster",
+		})
+		return nil
+	})
+}
+
+func (p *txPipeline) IsLeader() *internalerror.NotLeaderError {
+	p.Lock()
+	defer p.Unlock()
+
+	return p.replicator.IsLeader()
+}
+
+// TxLatencyHistograms returns the per-stage latency histograms of the sampled transactions, or nil if latency
+// sampling is disabled.
+func (p *txPipeline) TxLatencyHistograms() []*queue.LatencyHistogram {
+	if p.txLatency == nil {
+		return nil
+	}
+	return p.txLatency.Histograms()
+}
+
+// OnCommitPressure sets the function called when the commits of the blocks come under pressure, with true, and when
+// they are relieved, with false. It is never called unless the commit priority is enabled.
+func (p *txPipeline) OnCommitPressure(listener func(underPressure bool)) {
+	p.commitStats.SetPressureListener(listener)
+}
+
+// StateDivergence returns the divergence on which the block processor halted its commits, or nil.
+func (p *txPipeline) StateDivergence() *types.StateDivergence {
+	return p.blockProcessor.StateDivergence()
+}
+
+// RecoveryStatus returns the replays of the committed blocks onto the stores that lagged behind the block store when
+// the block processor started.
+func (p *txPipeline) RecoveryStatus() []*blockprocessor.RecoveryStatus {
+	return p.blockProcessor.RecoveryStatus()
+}
+
+// AcceptPeerHeader resumes the commits of the block processor halted on a divergence at the given block.
+func (p *txPipeline) AcceptPeerHeader(blockNum uint64) (*types.StateDivergence, error) {
+	return p.blockProcessor.AcceptPeerHeader(blockNum)
+}
+
+// ResyncDB commits to an unavailable database the updates of the blocks it missed, and returns their numbers.
+func (p *txPipeline) ResyncDB(dbName string) ([]uint64, error) {
+	return p.blockProcessor.ResyncDB(dbName)
+}
+
+// IsTxExpired returns true if the transaction was recently dropped, as its deadline passed before it was included in
+// a block.
+func (p *txPipeline) IsTxExpired(txID string) bool {
+	return p.pendingTxs.IsExpired(txID)
+}
+
+// TxRejection returns the validation result of a transaction that was recently dropped, as it was found invalid along
+// with the rest of its batch before it was included in a block, or nil.
+func (p *txPipeline) TxRejection(txID string) *types.ValidationInfo {
+	return p.pendingTxs.Rejection(txID)
+}
+
+// loggedTx renders a transaction for the debug log. The rendering is lazy, i.e., it takes place only when the debug
+// level is enabled, and is truncated to maxLoggedTxBytes, so that a large transaction does not flood the log.
+type loggedTx struct {
+	tx interface{}
+}
+
+func (l loggedTx) String() string {
+	jsonBytes, err := json.Marshal(l.tx)
+	if err != nil {
+		return fmt.Sprintf("<failed to marshal transaction: %v>", err)
+	}
+	if len(jsonBytes) <= maxLoggedTxBytes {
+		return string(jsonBytes)
+	}
+	return fmt.Sprintf("%s... (truncated, %d bytes in total)", jsonBytes[:maxLoggedTxBytes], len(jsonBytes))
+}
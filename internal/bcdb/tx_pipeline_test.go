@@ -0,0 +1,220 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockcreator"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggedTx(t *testing.T) {
+	small := &types.DataTxEnvelope{Payload: &types.DataTx{TxId: "tx1"}}
+	require.Equal(t, `{"payload":{"tx_id":"tx1"}}`, fmt.Sprintf("%s", loggedTx{tx: small}))
+
+	large := &types.DataTxEnvelope{
+		Payload: &types.DataTx{
+			TxId: "tx2",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     "bdb",
+					DataWrites: []*types.DataWrite{{Key: "key", Value: make([]byte, 1024*1024)}},
+				},
+			},
+		},
+	}
+	rendered := loggedTx{tx: large}.String()
+	require.True(t, strings.HasPrefix(rendered, `{"payload":{"tx_id":"tx2"`))
+	require.Regexp(t, `\.\.\. \(truncated, \d+ bytes in total\)$`, rendered)
+	require.Less(t, len(rendered), maxLoggedTxBytes+64)
+}
+
+func TestInvalidBatchPolicy(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	// runBatches submits a batch of two invalid data transactions, followed by a batch of a valid and an invalid one,
+	// and returns the embedded node, along with the ledger height before the batches were submitted
+	runBatches := func(t *testing.T, policy blockcreator.InvalidBatchPolicy) (*Embedded, uint64) {
+		cryptoDir, conf := testConfiguration(t)
+		t.Cleanup(func() { os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory) })
+		conf.LocalConfig.BlockCreation.MaxTransactionCountPerBlock = 2
+		conf.LocalConfig.BlockCreation.BlockTimeout = time.Second
+		conf.LocalConfig.BlockCreation.InvalidBatchPolicy = string(policy)
+		_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+		userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+		e, err := NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		t.Cleanup(func() { e.Close() })
+
+		resp, err := e.Submit(testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+			UserId: "admin",
+			TxId:   "user-tx",
+			UserWrites: []*types.UserWrite{
+				{
+					User: &types.User{
+						Id:          "testUser",
+						Certificate: userCert.Raw,
+						Privilege: &types.Privilege{
+							DbPermission: map[string]types.Privilege_Access{worldstate.DefaultDBName: types.Privilege_ReadWrite},
+						},
+					},
+				},
+			},
+		}), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		height, err := e.stores.blockStore.Height()
+		require.NoError(t, err)
+
+		submit := func(txID, dbName string) {
+			_, err := e.Submit(testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+				MustSignUserIds: []string{"testUser"},
+				TxId:            txID,
+				DbOperations: []*types.DBOperation{
+					{
+						DbName:     dbName,
+						DataWrites: []*types.DataWrite{{Key: "key-of-" + txID, Value: []byte("value")}},
+					},
+				},
+			}), 0)
+			require.NoError(t, err)
+		}
+		awaitReceipts := func(txIDs ...string) {
+			require.Eventually(t, func() bool {
+				for _, txID := range txIDs {
+					if _, err := e.GetReceipt("testUser", txID); err != nil {
+						return false
+					}
+				}
+				return true
+			}, 10*time.Second, 10*time.Millisecond)
+		}
+
+		submit("invalid-tx1", "no-such-db")
+		submit("invalid-tx2", "no-such-db")
+		awaitReceipts("invalid-tx1", "invalid-tx2")
+
+		submit("valid-tx", worldstate.DefaultDBName)
+		submit("invalid-tx3", "no-such-db")
+		awaitReceipts("valid-tx", "invalid-tx3")
+
+		return e, height
+	}
+
+	// requireMixedBatchCommitted requires the batch of the valid and the invalid transaction to be committed in a block
+	requireMixedBatchCommitted := func(t *testing.T, e *Embedded, blockNum uint64) {
+		for txIndex, txID := range []string{"valid-tx", "invalid-tx3"} {
+			resp, err := e.GetReceipt("testUser", txID)
+			require.NoError(t, err)
+			require.Nil(t, resp.GetRejection())
+			require.Equal(t, blockNum, resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+			require.Equal(t, uint64(txIndex), resp.GetReceipt().GetTxIndex())
+		}
+		resp, err := e.GetReceipt("testUser", "invalid-tx3")
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_INVALID_DATABASE_DOES_NOT_EXIST, resp.GetReceipt().GetHeader().GetValidationInfo()[1].GetFlag())
+	}
+
+	t.Run("commit", func(t *testing.T) {
+		e, height := runBatches(t, blockcreator.InvalidBatchCommit)
+
+		// the invalid batch consumes a block, in which each transaction has a receipt
+		newHeight, err := e.stores.blockStore.Height()
+		require.NoError(t, err)
+		require.Equal(t, height+2, newHeight)
+
+		for txIndex, txID := range []string{"invalid-tx1", "invalid-tx2"} {
+			resp, err := e.GetReceipt("testUser", txID)
+			require.NoError(t, err)
+			require.Nil(t, resp.GetRejection())
+			receipt := resp.GetReceipt()
+			require.Equal(t, height+1, receipt.GetHeader().GetBaseHeader().GetNumber())
+			require.Equal(t, uint64(txIndex), receipt.GetTxIndex())
+			require.Equal(t, types.Flag_INVALID_DATABASE_DOES_NOT_EXIST, receipt.GetHeader().GetValidationInfo()[txIndex].GetFlag())
+
+			_, err = e.stores.deadLetterStore.Get(txID)
+			require.IsType(t, &ierrors.NotFoundErr{}, err)
+		}
+		requireMixedBatchCommitted(t, e, height+2)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		e, height := runBatches(t, blockcreator.InvalidBatchDrop)
+
+		// the invalid batch consumes no block, and the rejection of each of its transactions is reported by the receipt
+		// query and by its dead-letter record
+		newHeight, err := e.stores.blockStore.Height()
+		require.NoError(t, err)
+		require.Equal(t, height+1, newHeight)
+
+		for _, txID := range []string{"invalid-tx1", "invalid-tx2"} {
+			resp, err := e.GetReceipt("testUser", txID)
+			require.NoError(t, err)
+			require.Nil(t, resp.GetReceipt())
+			require.Equal(t, types.Flag_INVALID_DATABASE_DOES_NOT_EXIST, resp.GetRejection().GetFlag())
+			require.NotEmpty(t, resp.GetRejection().GetReasonIfInvalid())
+
+			exist, err := e.stores.blockStore.DoesTxIDExist(txID)
+			require.NoError(t, err)
+			require.False(t, exist)
+
+			record, err := e.stores.deadLetterStore.Get(txID)
+			require.NoError(t, err)
+			require.Equal(t, "testUser", record.GetSubmitter())
+			require.Equal(t, "batched", record.GetStage())
+			require.Contains(t, record.GetReason(), "flag: INVALID_DATABASE_DOES_NOT_EXIST")
+		}
+		requireMixedBatchCommitted(t, e, height+1)
+	})
+
+	t.Run("a rejected transaction is reported to its synchronous submitter", func(t *testing.T) {
+		cryptoDir, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		conf.LocalConfig.BlockCreation.InvalidBatchPolicy = string(blockcreator.InvalidBatchDrop)
+		_, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+		e, err := NewEmbedded(conf, lg)
+		require.NoError(t, err)
+		defer e.Close()
+
+		// the user is not registered, hence its transaction is invalid
+		_, err = e.Submit(testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+			MustSignUserIds: []string{"testUser"},
+			TxId:            "unknown-user-tx",
+			DbOperations:    []*types.DBOperation{{DbName: worldstate.DefaultDBName}},
+		}), 5*time.Second)
+		require.IsType(t, &ierrors.TxRejectedError{}, err)
+		require.Contains(t, err.Error(), "the transaction was rejected before it was included in a block, as it is invalid, flag: INVALID_UNAUTHORISED")
+
+		height, err := e.stores.blockStore.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), height)
+	})
+
+	t.Run("unsupported policy", func(t *testing.T) {
+		_, conf := testConfiguration(t)
+		defer os.RemoveAll(conf.LocalConfig.Server.Database.LedgerDirectory)
+		conf.LocalConfig.BlockCreation.InvalidBatchPolicy = "discard"
+
+		_, err := NewEmbedded(conf, lg)
+		require.EqualError(t, err, "can't initiate the transaction pipeline: unsupported invalid batch policy [discard], expected either [commit] or [drop]")
+	})
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/certificateauthority"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// userImportHeadroom is kept free in each transaction of a user import, below the size limit, to cover the encoding
+// of the envelope of the transaction.
+const userImportHeadroom = 1024
+
+// ImportUsers checks the users of a user import one by one, and submits the users that pass the checks in user
+// administration transactions which carry the signed import request as their proof. The users are split into as many
+// transactions as needed to keep each transaction within the block size and the transaction size limits. The
+// signature of the request must have been verified by the caller. The users that fail a check, as well as the users
+// of a transaction whose submission fails or which is invalidated, are reported in the failures of the response.
+func (d *db) ImportUsers(requestEnv *types.UserImportRequestEnvelope, timeout time.Duration) (*types.UserImportResponseEnvelope, error) {
+	request := requestEnv.GetPayload()
+	if err := constants.SafeURLSegmentNZ(request.GetImportId()); err != nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: errors.WithMessage(err, "bad ImportId").Error()}
+	}
+	if len(requestEnv.Users) != len(request.UserDigests) {
+		return nil, &ierrors.BadRequestError{
+			ErrMsg: fmt.Sprintf("the import request holds %d users but %d digests", len(requestEnv.Users), len(request.UserDigests)),
+		}
+	}
+
+	isAdmin, err := d.worldstateQueryProcessor.identityQuerier.HasAdministrationPrivilege(request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + request.UserId + "] has no privilege to perform user administrative operations",
+		}
+	}
+	if err := d.txProcessor.IsLeader(); err != nil {
+		return nil, err
+	}
+
+	response := &types.UserImportResponse{
+		Header: d.responseHeader(),
+	}
+	fail := func(index int, reason string) {
+		response.Failures = append(response.Failures, &types.UserImportFailure{
+			UserId: requestEnv.Users[index].GetId(),
+			Index:  uint32(index),
+			Reason: reason,
+		})
+	}
+
+	checked, err := d.checkImportedUsers(requestEnv, fail)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &types.UserImportProof{
+		Request:   request,
+		Signature: requestEnv.Signature,
+	}
+	chunks, err := chunkImportedUsers(request, proof, requestEnv.Users, checked, d.worldstateQueryProcessor.blockCreationConf)
+	if err != nil {
+		return nil, err
+	}
+
+	for n, chunk := range chunks {
+		txEnv := &types.UserAdministrationTxEnvelope{
+			Payload: &types.UserAdministrationTx{
+				UserId: request.UserId,
+				TxId:   fmt.Sprintf("%s-%d", request.ImportId, n),
+			},
+			ImportProof: proof,
+		}
+		for _, index := range chunk {
+			txEnv.Payload.UserWrites = append(txEnv.Payload.UserWrites, &types.UserWrite{User: requestEnv.Users[index]})
+		}
+
+		receipt, err := d.txProcessor.SubmitTransaction(txEnv, timeout)
+		if err != nil {
+			if _, ok := err.(*ierrors.TimeoutErr); !ok {
+				for _, index := range chunk {
+					fail(index, "the transaction ["+txEnv.Payload.TxId+"] was not submitted: "+err.Error())
+				}
+				continue
+			}
+		}
+		response.TxIds = append(response.TxIds, txEnv.Payload.TxId)
+
+		// on a synchronous submission, the users of an invalidated transaction are reported too
+		if receipt.GetReceipt() != nil {
+			valInfo := receipt.GetReceipt().GetHeader().GetValidationInfo()[receipt.GetReceipt().GetTxIndex()]
+			if valInfo.GetFlag() != types.Flag_VALID {
+				for _, index := range chunk {
+					fail(index, "the transaction ["+txEnv.Payload.TxId+"] is invalid: "+valInfo.GetReasonIfInvalid())
+				}
+			}
+		}
+	}
+
+	responseBytes, sign, err := d.signature(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UserImportResponseEnvelope{
+		Response:      response,
+		Signature:     sign,
+		ResponseBytes: responseBytes,
+	}, nil
+}
+
+// checkImportedUsers applies to each user of the import the checks of the validation of a user write, and returns the
+// indexes of the users that pass them. A user that fails a check is reported through fail.
+func (d *db) checkImportedUsers(requestEnv *types.UserImportRequestEnvelope, fail func(index int, reason string)) ([]int, error) {
+	clusterConfig, _, err := d.db.GetConfig()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while fetching the cluster configuration")
+	}
+	caCertCollection, err := certificateauthority.NewCACertCollection(clusterConfig.GetCertAuthConfig().GetRoots(), clusterConfig.GetCertAuthConfig().GetIntermediates())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build CA certificate collection")
+	}
+
+	var checked []int
+	seen := make(map[string]bool)
+	for index, user := range requestEnv.Users {
+		digest, err := types.UserImportDigest(user)
+		if err != nil {
+			fail(index, err.Error())
+			continue
+		}
+
+		switch {
+		case !bytes.Equal(digest, requestEnv.Payload.UserDigests[index]):
+			fail(index, "the user does not match its digest in the signed import request")
+			continue
+		case user.Id == "":
+			fail(index, "the user has an empty ID")
+			continue
+		case seen[user.Id]:
+			fail(index, "the user appears more than once in the import request")
+			continue
+		case user.GetPrivilege().GetAdmin():
+			fail(index, "an admin user can only be added via a cluster configuration transaction")
+			continue
+		}
+		seen[user.Id] = true
+
+		missingDB := ""
+		for dbName := range user.GetPrivilege().GetDbPermission() {
+			if !d.db.Exist(dbName) {
+				missingDB = dbName
+				break
+			}
+		}
+		if missingDB != "" {
+			fail(index, "the database ["+missingDB+"] in the db permission list does not exist")
+			continue
+		}
+
+		if err := caCertCollection.VerifyLeafCert(user.Certificate); err != nil {
+			fail(index, "the certificate of the user is invalid: "+err.Error())
+			continue
+		}
+
+		exists, err := d.worldstateQueryProcessor.identityQuerier.DoesUserExist(user.Id)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			fail(index, "the user already exists")
+			continue
+		}
+
+		checked = append(checked, index)
+	}
+
+	return checked, nil
+}
+
+// chunkImportedUsers splits the given users, by their indexes, into chunks whose transactions, each carrying the
+// proof, fit in both the block size and the transaction size limits.
+func chunkImportedUsers(request *types.UserImportRequest, proof *types.UserImportProof, users []*types.User, indexes []int, conf *config.BlockCreationConf) ([][]int, error) {
+	limit := conf.MaxTxSizeBytes
+	if limit == 0 {
+		limit = config.DefaultMaxTxSizeBytes
+	}
+	if conf.MaxBlockSize > 0 && conf.MaxBlockSize < limit {
+		limit = conf.MaxBlockSize
+	}
+
+	base := uint64(proto.Size(&types.UserAdministrationTxEnvelope{
+		Payload: &types.UserAdministrationTx{
+			UserId: request.UserId,
+			TxId:   fmt.Sprintf("%s-%d", request.ImportId, len(indexes)),
+		},
+		ImportProof: proof,
+	})) + userImportHeadroom
+	if base >= limit {
+		return nil, &ierrors.BadRequestError{
+			ErrMsg: fmt.Sprintf("the signed import request of %d users does not fit in a transaction of at most %d bytes, "+
+				"the users must be split into smaller imports", len(users), limit),
+		}
+	}
+
+	var chunks [][]int
+	var chunk []int
+	size := base
+	for _, index := range indexes {
+		// the size of a write within the transaction, including its field tag and length
+		writeSize := uint64(proto.Size(&types.UserAdministrationTx{
+			UserWrites: []*types.UserWrite{{User: users[index]}},
+		}))
+		if base+writeSize > limit {
+			return nil, &ierrors.BadRequestError{
+				ErrMsg: fmt.Sprintf("the user [%s] does not fit in a transaction of at most %d bytes", users[index].GetId(), limit),
+			}
+		}
+
+		if size+writeSize > limit {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			size = base
+		}
+		chunk = append(chunk, index)
+		size += writeSize
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
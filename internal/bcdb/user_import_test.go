@@ -0,0 +1,71 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChunkImportedUsers(t *testing.T) {
+	var users []*types.User
+	var indexes []int
+	for i := 0; i < 100; i++ {
+		users = append(users, &types.User{
+			Id:          fmt.Sprintf("user%03d", i),
+			Certificate: bytes.Repeat([]byte{1}, 500),
+		})
+		indexes = append(indexes, i)
+	}
+	request, err := types.NewUserImportRequest("admin", "import1", users)
+	require.NoError(t, err)
+	proof := &types.UserImportProof{Request: request, Signature: []byte("signature")}
+
+	t.Run("split by the smaller limit", func(t *testing.T) {
+		conf := &config.BlockCreationConf{MaxBlockSize: 20 * 1024, MaxTxSizeBytes: 1024 * 1024}
+		chunks, err := chunkImportedUsers(request, proof, users, indexes[1:], conf)
+		require.NoError(t, err)
+		require.Greater(t, len(chunks), 1)
+
+		var chunked []int
+		for n, chunk := range chunks {
+			chunked = append(chunked, chunk...)
+
+			txEnv := &types.UserAdministrationTxEnvelope{
+				Payload:     &types.UserAdministrationTx{UserId: "admin", TxId: fmt.Sprintf("import1-%d", n)},
+				ImportProof: proof,
+			}
+			for _, index := range chunk {
+				txEnv.Payload.UserWrites = append(txEnv.Payload.UserWrites, &types.UserWrite{User: users[index]})
+			}
+			require.LessOrEqual(t, proto.Size(txEnv), int(conf.MaxBlockSize))
+		}
+		require.Equal(t, indexes[1:], chunked)
+	})
+
+	t.Run("request does not fit", func(t *testing.T) {
+		conf := &config.BlockCreationConf{MaxBlockSize: 2 * 1024}
+		chunks, err := chunkImportedUsers(request, proof, users, indexes, conf)
+		require.EqualError(t, err, "the signed import request of 100 users does not fit in a transaction of at most 2048 bytes, the users must be split into smaller imports")
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+		require.Nil(t, chunks)
+	})
+
+	t.Run("user does not fit", func(t *testing.T) {
+		conf := &config.BlockCreationConf{MaxBlockSize: 5 * 1024}
+		chunks, err := chunkImportedUsers(request, proof, append(users[:99:99], &types.User{
+			Id:          "large",
+			Certificate: bytes.Repeat([]byte{1}, 5*1024),
+		}), indexes, conf)
+		require.EqualError(t, err, "the user [large] does not fit in a transaction of at most 5120 bytes")
+		require.Nil(t, chunks)
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockprocessor"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/pkg/errors"
+)
+
+// validationTraceProcessor re-runs the validation of a committed block with tracing enabled, to explain the flag of
+// each of its transactions. The state as of the previous block, i.e., the users, their certificates and privileges,
+// the ACLs, the versions, and the cluster configuration that the block was validated against, is rebuilt by replaying
+// the ledger onto a scratch state database. The live state is never touched. As the replay reads every block up to
+// the traced one, its cost grows with the height of the block, and only one trace runs at a time.
+type validationTraceProcessor struct {
+	blockStore      *blockstore.Store
+	identityQuerier *identity.Querier
+	scratchDir      string
+	running         int32
+	logger          *logger.SugarLogger
+}
+
+type validationTraceProcessorConfig struct {
+	blockStore      *blockstore.Store
+	identityQuerier *identity.Querier
+	scratchDir      string
+	logger          *logger.SugarLogger
+}
+
+func newValidationTraceProcessor(conf *validationTraceProcessorConfig) *validationTraceProcessor {
+	return &validationTraceProcessor{
+		blockStore:      conf.blockStore,
+		identityQuerier: conf.identityQuerier,
+		scratchDir:      conf.scratchDir,
+		logger:          conf.logger,
+	}
+}
+
+// traceValidation returns the decision trace of the given block. Only admin users can trace the validation.
+func (p *validationTraceProcessor) traceValidation(querierUserID string, blockNum uint64) (*txvalidation.TraceReport, error) {
+	isAdmin, err := p.identityQuerier.HasAdministrationPrivilege(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, &ierrors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to trace the validation of a block",
+		}
+	}
+
+	height, err := p.blockStore.Height()
+	if err != nil {
+		return nil, err
+	}
+	if blockNum == 0 || blockNum > height {
+		return nil, &ierrors.BadRequestError{
+			ErrMsg: fmt.Sprintf("block [%d] is not committed, the height of the ledger is [%d]", blockNum, height),
+		}
+	}
+
+	if !atomic.CompareAndSwapInt32(&p.running, 0, 1) {
+		return nil, &ierrors.ServerRestrictionError{ErrMsg: "the validation of another block is being traced, try again later"}
+	}
+	defer atomic.StoreInt32(&p.running, 0)
+
+	// a scratch database left by a crash during a previous trace is discarded
+	if err := fileops.RemoveAll(p.scratchDir); err != nil {
+		return nil, errors.Wrap(err, "error while removing the scratch state database")
+	}
+	scratchDB, err := leveldb.Open(&leveldb.Config{
+		DBRootDir: p.scratchDir,
+		Logger:    p.logger,
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while creating the scratch state database")
+	}
+	defer func() {
+		if err := scratchDB.Close(); err != nil {
+			p.logger.Warnf("error while closing the scratch state database: %s", err)
+		}
+		if err := fileops.RemoveAll(p.scratchDir); err != nil {
+			p.logger.Warnf("error while removing the scratch state database: %s", err)
+		}
+	}()
+
+	p.logger.Infof("tracing the validation of block %d, replaying %d blocks", blockNum, blockNum-1)
+	if err := blockprocessor.ReplayState(scratchDB, p.blockStore, blockNum-1, p.logger); err != nil {
+		return nil, errors.WithMessagef(err, "error while rebuilding the state as of block %d", blockNum-1)
+	}
+
+	block, err := p.blockStore.Get(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := txvalidation.NewTraceRecorder()
+	validator := txvalidation.NewValidator(&txvalidation.Config{
+		DB:            scratchDB,
+		BlockStore:    p.blockStore,
+		ExecutionMode: txvalidation.ExecutionMode{Deterministic: true},
+		TraceSink:     recorder,
+		Logger:        p.logger,
+	})
+	valInfo, err := validator.ValidateBlock(block)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while validating block %d", blockNum)
+	}
+
+	return recorder.Report(block, valInfo), nil
+}
@@ -0,0 +1,343 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package bcdb
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceValidation(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	cryptoDir, conf := testConfiguration(t)
+	ledgerDir := conf.LocalConfig.Server.Database.LedgerDirectory
+	defer os.RemoveAll(ledgerDir)
+	// the transactions of the traced block are submitted together and cut into a single block
+	conf.LocalConfig.BlockCreation.MaxTransactionCountPerBlock = 10
+	conf.LocalConfig.BlockCreation.BlockTimeout = time.Second
+
+	_, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	certs := make(map[string][]byte)
+	signers := make(map[string]crypto.Signer)
+	for _, name := range []string{"alice", "bob", "carol"} {
+		certs[name], signers[name] = issueTestUserCrypto(t, cryptoDir, name)
+	}
+
+	e, err := NewEmbedded(conf, lg)
+	require.NoError(t, err)
+	defer e.Close()
+
+	// block 2: alice and bob can write to the default database, while carol can only read it
+	var userWrites []*types.UserWrite
+	for name, access := range map[string]types.Privilege_Access{"alice": types.Privilege_ReadWrite, "bob": types.Privilege_ReadWrite, "carol": types.Privilege_Read} {
+		userWrites = append(userWrites, &types.UserWrite{
+			User: &types.User{
+				Id:          name,
+				Certificate: certs[name],
+				Privilege:   &types.Privilege{DbPermission: map[string]types.Privilege_Access{worldstate.DefaultDBName: access}},
+			},
+		})
+	}
+	resp, err := e.Submit(testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+		UserId:     "admin",
+		TxId:       "user-tx",
+		UserWrites: userWrites,
+	}), 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	dataTx := func(signer string, tx *types.DataTx) *types.DataTxEnvelope {
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signers[signer]}, tx)
+	}
+
+	// block 3: alice writes a counter and a key which only she can write
+	resp, err = e.Submit(dataTx("alice", &types.DataTx{
+		MustSignUserIds: []string{"alice"},
+		TxId:            "setup-tx",
+		DbOperations: []*types.DBOperation{
+			{
+				DbName: worldstate.DefaultDBName,
+				DataWrites: []*types.DataWrite{
+					{Key: "counter", Value: []byte("1")},
+					{Key: "alice-only", Value: []byte("mine"), Acl: &types.AccessControl{
+						ReadUsers:      map[string]bool{"bob": true},
+						ReadWriteUsers: map[string]bool{"alice": true},
+					}},
+				},
+			},
+		},
+	}), 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, resp.GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	setupVersion := &types.Version{BlockNum: resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber(), TxNum: 0}
+
+	// the traced block, with a transaction per failure mode
+	txs := []*types.DataTxEnvelope{
+		dataTx("alice", &types.DataTx{
+			MustSignUserIds: []string{"alice"},
+			TxId:            "valid",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataReads:  []*types.DataRead{{Key: "counter", Version: setupVersion}},
+					DataWrites: []*types.DataWrite{{Key: "counter", Value: []byte("2")}},
+				},
+			},
+		}),
+		dataTx("bob", &types.DataTx{
+			MustSignUserIds: []string{"bob"},
+			TxId:            "conflict-within-block",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataReads:  []*types.DataRead{{Key: "counter", Version: setupVersion}},
+					DataWrites: []*types.DataWrite{{Key: "bob-key", Value: []byte("2")}},
+				},
+			},
+		}),
+		dataTx("alice", &types.DataTx{
+			MustSignUserIds: []string{"alice"},
+			TxId:            "stale-read",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataReads:  []*types.DataRead{{Key: "alice-only", Version: &types.Version{BlockNum: 2, TxNum: 0}}},
+					DataWrites: []*types.DataWrite{{Key: "alice-key", Value: []byte("2")}},
+				},
+			},
+		}),
+		dataTx("carol", &types.DataTx{
+			MustSignUserIds: []string{"carol"},
+			TxId:            "no-db-permission",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: "carol-key", Value: []byte("2")}},
+				},
+			},
+		}),
+		dataTx("bob", &types.DataTx{
+			MustSignUserIds: []string{"bob"},
+			TxId:            "no-acl-permission",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: "alice-only", Value: []byte("bob's")}},
+				},
+			},
+		}),
+		// signed by bob on behalf of alice
+		dataTx("bob", &types.DataTx{
+			MustSignUserIds: []string{"alice"},
+			TxId:            "bad-signature",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: "forged-key", Value: []byte("2")}},
+				},
+			},
+		}),
+	}
+	txs[5].Signatures = map[string][]byte{"alice": txs[5].Signatures["bob"]}
+
+	for _, tx := range txs[:len(txs)-1] {
+		_, err = e.Submit(tx, 0)
+		require.NoError(t, err)
+	}
+	resp, err = e.Submit(txs[len(txs)-1], 5*time.Second)
+	require.NoError(t, err)
+	blockNum := resp.GetReceipt().GetHeader().GetBaseHeader().GetNumber()
+	require.Len(t, resp.GetReceipt().GetHeader().GetValidationInfo(), len(txs))
+
+	height, err := e.stores.levelDB.Height()
+	require.NoError(t, err)
+
+	scratchDir := constructValidationTracePath(ledgerDir)
+	p := newValidationTraceProcessor(&validationTraceProcessorConfig{
+		blockStore:      e.stores.blockStore,
+		identityQuerier: identity.NewQuerier(e.stores.levelDB),
+		scratchDir:      scratchDir,
+		logger:          lg,
+	})
+
+	t.Run("block with several failure modes", func(t *testing.T) {
+		report, err := p.traceValidation("admin", blockNum)
+		require.NoError(t, err)
+		require.Equal(t, blockNum, report.BlockNumber)
+		require.True(t, report.Matches)
+		require.Len(t, report.Transactions, len(txs))
+
+		traces := make(map[string]*txvalidation.TxTrace)
+		for txNum, txTrace := range report.Transactions {
+			require.Equal(t, txNum, txTrace.TxNum)
+			require.Equal(t, txTrace.RecordedFlag, txTrace.Flag)
+			traces[txTrace.TxID] = txTrace
+		}
+
+		valid := traces["valid"]
+		require.Equal(t, types.Flag_VALID.String(), valid.Flag)
+		require.Equal(t, &txvalidation.TraceCheck{
+			Name:    "signature",
+			Inputs:  map[string]string{"user": "alice", "certificate": fingerprint(certs["alice"])},
+			Outcome: types.Flag_VALID.String(),
+		}, valid.Checks[0])
+		require.Equal(t, []string{"signature", "database", "database permission", "key format", "write entries",
+			"delete entries", "unique keys", "read acl", "write acl", "mvcc"}, checkNames(valid))
+		require.Equal(t, map[string]string{
+			"db":            worldstate.DefaultDBName,
+			"key":           "counter",
+			"readVersion":   "{blockNum: 3, txNum: 0}",
+			"actualVersion": "{blockNum: 3, txNum: 0}",
+		}, findCheck(t, valid, "mvcc").Inputs)
+
+		withinBlock := traces["conflict-within-block"]
+		require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK.String(), withinBlock.Flag)
+		mvcc := findCheck(t, withinBlock, "mvcc")
+		require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK.String(), mvcc.Outcome)
+		require.Equal(t, traceVersionOf(blockNum, 0), mvcc.Inputs["actualVersion"])
+
+		stale := traces["stale-read"]
+		require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE.String(), stale.Flag)
+		readACL := findCheck(t, stale, "read acl")
+		require.Equal(t, types.Flag_VALID.String(), readACL.Outcome)
+		require.Equal(t, "{readUsers: [bob], readWriteUsers: [alice], signPolicyForWrite: ANY}", readACL.Inputs["acl"])
+		mvcc = findCheck(t, stale, "mvcc")
+		require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE.String(), mvcc.Outcome)
+		require.Equal(t, "{blockNum: 2, txNum: 0}", mvcc.Inputs["readVersion"])
+		require.Equal(t, "{blockNum: 3, txNum: 0}", mvcc.Inputs["actualVersion"])
+
+		noDBPermission := traces["no-db-permission"]
+		require.Equal(t, types.Flag_INVALID_NO_PERMISSION.String(), noDBPermission.Flag)
+		require.Equal(t, []string{"signature", "database", "database permission"}, checkNames(noDBPermission))
+		require.Equal(t, &txvalidation.TraceCheck{
+			Name: "database permission",
+			Inputs: map[string]string{
+				"db":                 worldstate.DefaultDBName,
+				"signers":            "[carol]",
+				"usersWithReadWrite": "[]",
+			},
+			Outcome: types.Flag_INVALID_NO_PERMISSION.String(),
+			Reason:  "none of the user in [carol] has read-write permission on the database [bdb]",
+		}, noDBPermission.Checks[2])
+
+		noACLPermission := traces["no-acl-permission"]
+		require.Equal(t, types.Flag_INVALID_NO_PERMISSION.String(), noACLPermission.Flag)
+		writeACL := findCheck(t, noACLPermission, "write acl")
+		require.Equal(t, types.Flag_INVALID_NO_PERMISSION.String(), writeACL.Outcome)
+		require.Equal(t, map[string]string{
+			"db":    worldstate.DefaultDBName,
+			"key":   "alice-only",
+			"acl":   "{readUsers: [bob], readWriteUsers: [alice], signPolicyForWrite: ANY}",
+			"users": "[bob]",
+		}, writeACL.Inputs)
+
+		badSignature := traces["bad-signature"]
+		require.Equal(t, types.Flag_INVALID_UNAUTHORISED.String(), badSignature.Flag)
+		require.Len(t, badSignature.Checks, 1)
+		require.Equal(t, "signature", badSignature.Checks[0].Name)
+		require.Equal(t, fingerprint(certs["alice"]), badSignature.Checks[0].Inputs["certificate"])
+		require.Equal(t, types.Flag_INVALID_UNAUTHORISED.String(), badSignature.Checks[0].Outcome)
+
+		// the live state is untouched and the scratch database is removed
+		h, err := e.stores.levelDB.Height()
+		require.NoError(t, err)
+		require.Equal(t, height, h)
+		exist, err := fileops.Exists(scratchDir)
+		require.NoError(t, err)
+		require.False(t, exist)
+	})
+
+	t.Run("administration blocks", func(t *testing.T) {
+		report, err := p.traceValidation("admin", 1)
+		require.NoError(t, err)
+		require.True(t, report.Matches)
+		require.Equal(t, []string{"genesis configuration"}, checkNames(report.Transactions[0]))
+
+		report, err = p.traceValidation("admin", 2)
+		require.NoError(t, err)
+		require.True(t, report.Matches)
+		require.Equal(t, "user-tx", report.Transactions[0].TxID)
+		require.Equal(t, []string{"signature", "privilege", "user administration"}, checkNames(report.Transactions[0]))
+		require.Equal(t, map[string]string{"user": "admin", "privilege": "admin", "granted": "true"}, report.Transactions[0].Checks[1].Inputs)
+	})
+
+	t.Run("not an admin", func(t *testing.T) {
+		report, err := p.traceValidation("alice", blockNum)
+		require.EqualError(t, err, "the user [alice] has no permission to trace the validation of a block")
+		require.IsType(t, &ierrors.PermissionErr{}, err)
+		require.Nil(t, report)
+	})
+
+	t.Run("block not committed", func(t *testing.T) {
+		report, err := p.traceValidation("admin", blockNum+1)
+		require.EqualError(t, err, fmt.Sprintf("block [%d] is not committed, the height of the ledger is [%d]", blockNum+1, blockNum))
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+		require.Nil(t, report)
+	})
+}
+
+func issueTestUserCrypto(t *testing.T, cryptoDir, name string) ([]byte, crypto.Signer) {
+	caCert, caKey := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
+	caKeyPair, err := tls.X509KeyPair(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}), caKey)
+	require.NoError(t, err)
+
+	pemCert, privKey, err := testutils.IssueCertificate("Orion "+name, "127.0.0.1", caKeyPair)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path.Join(cryptoDir, name+".pem"), pemCert, 0666))
+	require.NoError(t, os.WriteFile(path.Join(cryptoDir, name+".key"), privKey, 0666))
+
+	cert, signer := testutils.LoadTestCrypto(t, cryptoDir, name)
+	return cert.Raw, signer
+}
+
+func fingerprint(cert []byte) string {
+	sum := sha256.Sum256(cert)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func traceVersionOf(blockNum, txNum uint64) string {
+	return fmt.Sprintf("{blockNum: %d, txNum: %d}", blockNum, txNum)
+}
+
+func checkNames(txTrace *txvalidation.TxTrace) []string {
+	var names []string
+	for _, c := range txTrace.Checks {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func findCheck(t *testing.T, txTrace *txvalidation.TxTrace, name string) *txvalidation.TraceCheck {
+	for _, c := range txTrace.Checks {
+		if c.Name == name {
+			return c
+		}
+	}
+	require.FailNow(t, "check not found", "the trace of [%s] has no check [%s]", txTrace.TxID, name)
+	return nil
+}
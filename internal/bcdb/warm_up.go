@@ -0,0 +1,344 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultWarmUpMaxDuration = time.Minute
+	defaultWarmUpMaxBytes    = 256 * 1024 * 1024
+)
+
+var errWarmUpStopped = errors.New("the node is shutting down")
+
+// cacheWarmer preloads the caches of the state database after a restart, once the state database is recovered, so
+// that the first queries find the hot keys and identities in the caches rather than on the disk. The keys read and
+// written by the most recent blocks, and the identities of their submitters, are read back, from the most recent
+// block down, followed by the configured hot key prefixes. The warm-up is bounded by time and by the bytes of the
+// values read; once either budget is exceeded, the warm-up is aborted and the caches are left partially warmed.
+type cacheWarmer struct {
+	db              worldstate.DB
+	blockStore      *blockstore.Store
+	identityQuerier *identity.Querier
+	recentBlocks    uint64
+	hotKeyPrefixes  []config.HotKeyPrefixConf
+	maxDuration     time.Duration
+	maxBytes        uint64
+	stopCh          chan struct{}
+	doneCh          chan struct{}
+	logger          *logger.SugarLogger
+
+	mu     sync.RWMutex
+	status *types.WarmUpStatus
+	// loadedKeys and loadedUsers are the keys, by database, and the users already read, which are not read again
+	loadedKeys  map[string]map[string]bool
+	loadedUsers map[string]bool
+}
+
+type cacheWarmerConfig struct {
+	db              worldstate.DB
+	blockStore      *blockstore.Store
+	identityQuerier *identity.Querier
+	conf            *config.WarmUpConf
+	logger          *logger.SugarLogger
+}
+
+// newCacheWarmer returns the cache warmer, or nil if the warm-up is disabled
+func newCacheWarmer(conf *cacheWarmerConfig) *cacheWarmer {
+	if conf.conf.RecentBlocks == 0 && len(conf.conf.HotKeyPrefixes) == 0 {
+		return nil
+	}
+
+	maxDuration := conf.conf.MaxDuration
+	if maxDuration == 0 {
+		maxDuration = defaultWarmUpMaxDuration
+	}
+	maxBytes := conf.conf.MaxBytes
+	if maxBytes == 0 {
+		maxBytes = defaultWarmUpMaxBytes
+	}
+
+	return &cacheWarmer{
+		db:              conf.db,
+		blockStore:      conf.blockStore,
+		identityQuerier: conf.identityQuerier,
+		recentBlocks:    conf.conf.RecentBlocks,
+		hotKeyPrefixes:  conf.conf.HotKeyPrefixes,
+		maxDuration:     maxDuration,
+		maxBytes:        maxBytes,
+		stopCh:          make(chan struct{}),
+		doneCh:          make(chan struct{}),
+		logger:          conf.logger,
+		status: &types.WarmUpStatus{
+			State: types.WarmUpStatus_RUNNING,
+		},
+		loadedKeys:  make(map[string]map[string]bool),
+		loadedUsers: make(map[string]bool),
+	}
+}
+
+func (w *cacheWarmer) start() {
+	w.logger.Infof("starting the warm-up of the caches, recent blocks: %d, hot key prefixes: %d, max duration: %s, max bytes: %d",
+		w.recentBlocks, len(w.hotKeyPrefixes), w.maxDuration, w.maxBytes)
+	go w.run()
+}
+
+// stop aborts the warm-up, if it is running, and waits for it to end
+func (w *cacheWarmer) stop() {
+	select {
+	case <-w.stopCh:
+	default:
+		close(w.stopCh)
+	}
+	<-w.doneCh
+}
+
+// getStatus returns a copy of the status of the warm-up
+func (w *cacheWarmer) getStatus() *types.WarmUpStatus {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return proto.Clone(w.status).(*types.WarmUpStatus)
+}
+
+func (w *cacheWarmer) run() {
+	defer close(w.doneCh)
+
+	start := time.Now()
+	err := w.warmUp(start)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.status.ElapsedMillis = uint64(time.Since(start).Milliseconds())
+	if err != nil {
+		w.status.State = types.WarmUpStatus_ABORTED
+		w.status.Reason = err.Error()
+		w.logger.Warnf("the warm-up of the caches was aborted after %s: %s", time.Since(start), err)
+		return
+	}
+
+	w.status.State = types.WarmUpStatus_COMPLETED
+	w.logger.Infof("the warm-up of the caches completed in %s, %d keys, %d identities, and %d bytes were loaded",
+		time.Since(start), w.status.LoadedKeys, w.status.LoadedIdentities, w.status.LoadedBytes)
+}
+
+func (w *cacheWarmer) warmUp(start time.Time) error {
+	height, err := w.blockStore.Height()
+	if err != nil {
+		return errors.WithMessage(err, "error while fetching the height of the block store")
+	}
+
+	blocks := w.recentBlocks
+	if blocks > height {
+		blocks = height
+	}
+	w.mu.Lock()
+	w.status.TotalBlocks = blocks
+	w.mu.Unlock()
+
+	for blockNum := height; blockNum > height-blocks; blockNum-- {
+		block, err := w.blockStore.GetForReplay(blockNum)
+		if err != nil {
+			return errors.WithMessagef(err, "error while fetching block [%d]", blockNum)
+		}
+		if err := w.replayBlock(block, start); err != nil {
+			return err
+		}
+
+		w.mu.Lock()
+		w.status.ReplayedBlocks++
+		w.mu.Unlock()
+	}
+
+	for _, hot := range w.hotKeyPrefixes {
+		if err := w.loadPrefix(hot.DBName, hot.Prefix, start); err != nil {
+			return err
+		}
+
+		w.mu.Lock()
+		w.status.LoadedPrefixes++
+		w.mu.Unlock()
+	}
+
+	return nil
+}
+
+// replayBlock reads back the keys read and written by the transactions of the block, and the identities of their
+// submitters, whether the transactions were valid or not
+func (w *cacheWarmer) replayBlock(block *types.Block, start time.Time) error {
+	var userIDs []string
+	switch payload := block.GetPayload().(type) {
+	case *types.Block_DataTxEnvelopes:
+		for _, env := range payload.DataTxEnvelopes.GetEnvelopes() {
+			tx := env.GetPayload()
+			userIDs = append(userIDs, tx.GetMustSignUserIds()...)
+
+			for _, ops := range tx.GetDbOperations() {
+				for _, key := range operationKeys(ops) {
+					if err := w.loadKey(ops.GetDbName(), key, start); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	case *types.Block_UserAdministrationTxEnvelope:
+		userIDs = append(userIDs, payload.UserAdministrationTxEnvelope.GetPayload().GetUserId())
+	case *types.Block_UserAdministrationTxEnvelopes:
+		for _, env := range payload.UserAdministrationTxEnvelopes.GetEnvelopes() {
+			userIDs = append(userIDs, env.GetPayload().GetUserId())
+		}
+	case *types.Block_DbAdministrationTxEnvelope:
+		userIDs = append(userIDs, payload.DbAdministrationTxEnvelope.GetPayload().GetUserId())
+	case *types.Block_ConfigTxEnvelope:
+		userIDs = append(userIDs, payload.ConfigTxEnvelope.GetPayload().GetUserId())
+	}
+
+	for _, userID := range userIDs {
+		if err := w.loadIdentity(userID, start); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func operationKeys(ops *types.DBOperation) []string {
+	var keys []string
+	for _, r := range ops.GetDataReads() {
+		keys = append(keys, r.GetKey())
+	}
+	for _, wr := range ops.GetDataWrites() {
+		keys = append(keys, wr.GetKey())
+	}
+	for _, d := range ops.GetDataDeletes() {
+		keys = append(keys, d.GetKey())
+	}
+	for _, p := range ops.GetDataPatches() {
+		keys = append(keys, p.GetKey())
+	}
+	return keys
+}
+
+// loadKey reads the key into the caches, unless it was already read. A key of a database which was deleted since is
+// skipped.
+func (w *cacheWarmer) loadKey(dbName, key string, start time.Time) error {
+	if err := w.checkBudget(start); err != nil {
+		return err
+	}
+	if w.loadedKeys[dbName][key] || !w.db.Exist(dbName) {
+		return nil
+	}
+
+	value, _, err := w.db.Get(dbName, key)
+	if err != nil {
+		return errors.WithMessagef(err, "error while reading the key [%s] of database [%s]", key, dbName)
+	}
+	if w.loadedKeys[dbName] == nil {
+		w.loadedKeys[dbName] = make(map[string]bool)
+	}
+	w.loadedKeys[dbName][key] = true
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.status.LoadedKeys++
+	w.status.LoadedBytes += uint64(len(value))
+	return nil
+}
+
+// loadIdentity reads the user into the caches, unless it was already read. A user which was deleted since is skipped.
+func (w *cacheWarmer) loadIdentity(userID string, start time.Time) error {
+	if err := w.checkBudget(start); err != nil {
+		return err
+	}
+	if userID == "" || w.loadedUsers[userID] {
+		return nil
+	}
+	w.loadedUsers[userID] = true
+
+	if _, _, err := w.identityQuerier.GetUser(userID); err != nil {
+		if _, ok := err.(*identity.NotFoundErr); ok {
+			return nil
+		}
+		return errors.WithMessagef(err, "error while reading the user [%s]", userID)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.status.LoadedIdentities++
+	return nil
+}
+
+// loadPrefix reads the keys of the database which begin with the prefix into the caches. A prefix of a database which
+// does not exist, or whose keys are ordered by a key collation, is skipped.
+func (w *cacheWarmer) loadPrefix(dbName, prefix string, start time.Time) error {
+	if !w.db.Exist(dbName) {
+		w.logger.Warnf("skipping the hot key prefix [%s] of database [%s], as the database does not exist", prefix, dbName)
+		return nil
+	}
+
+	startKey, endKey := worldstate.PrefixRange(prefix)
+	itr, err := w.db.GetIterator(dbName, startKey, endKey)
+	if err != nil {
+		if _, ok := err.(*worldstate.ErrKeyNotCollatable); ok {
+			w.logger.Warnf("skipping the hot key prefix [%s] of database [%s]: %s", prefix, dbName, err)
+			return nil
+		}
+		return errors.WithMessagef(err, "error while iterating over the hot key prefix [%s] of database [%s]", prefix, dbName)
+	}
+	defer itr.Release()
+
+	for itr.Next() {
+		if err := w.checkBudget(start); err != nil {
+			return err
+		}
+
+		v := &types.ValueWithMetadata{}
+		if err := proto.Unmarshal(itr.Value(), v); err != nil {
+			return errors.Wrapf(err, "error while unmarshaling the value of key [%s] of database [%s]", itr.Key(), dbName)
+		}
+
+		w.mu.Lock()
+		w.status.LoadedKeys++
+		w.status.LoadedBytes += uint64(len(v.GetValue()))
+		w.mu.Unlock()
+	}
+	if err := itr.Error(); err != nil {
+		return errors.Wrapf(err, "error while iterating over the hot key prefix [%s] of database [%s]", prefix, dbName)
+	}
+
+	return nil
+}
+
+// checkBudget returns an error when the warm-up is to be aborted, as it exceeded its budget or the node is shutting
+// down
+func (w *cacheWarmer) checkBudget(start time.Time) error {
+	select {
+	case <-w.stopCh:
+		return errWarmUpStopped
+	default:
+	}
+
+	if time.Since(start) > w.maxDuration {
+		return errors.Errorf("the warm-up exceeded its time budget of %s", w.maxDuration)
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.status.LoadedBytes > w.maxBytes {
+		return errors.Errorf("the warm-up exceeded its budget of %d bytes", w.maxBytes)
+	}
+	return nil
+}
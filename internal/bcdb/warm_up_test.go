@@ -0,0 +1,233 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package bcdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type cacheWarmerTestEnv struct {
+	dir        string
+	levelDB    *leveldb.LevelDB
+	blockStore *blockstore.Store
+	logger     *logger.SugarLogger
+}
+
+// newCacheWarmerTestEnv populates the state database and the block store, and restarts the state database, so that
+// its caches are cold
+func newCacheWarmerTestEnv(t *testing.T) *cacheWarmerTestEnv {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "cacheWarmer")
+	require.NoError(t, err)
+	env := &cacheWarmerTestEnv{dir: dir, logger: lg}
+	t.Cleanup(func() {
+		if env.levelDB != nil {
+			env.levelDB.Close()
+		}
+		if env.blockStore != nil {
+			env.blockStore.Close()
+		}
+		os.RemoveAll(dir)
+	})
+
+	env.levelDB, err = leveldb.Open(&leveldb.Config{DBRootDir: filepath.Join(dir, "statedb"), Logger: lg})
+	require.NoError(t, err)
+	env.blockStore, err = blockstore.Open(&blockstore.Config{StoreDir: filepath.Join(dir, "blockstore"), Logger: lg})
+	require.NoError(t, err)
+
+	var users []*worldstate.KVWithMetadata
+	for _, userID := range []string{"admin", "alice"} {
+		user, err := proto.Marshal(&types.User{Id: userID})
+		require.NoError(t, err)
+		users = append(users, &worldstate.KVWithMetadata{
+			Key:      string(identity.UserNamespace) + userID,
+			Value:    user,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}},
+		})
+	}
+	require.NoError(t, env.levelDB.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}}},
+		worldstate.UsersDBName:     {Writes: users},
+	}, 1))
+
+	// the values span many blocks of the tables, so that a key far from the warmed ones is not in the cache
+	value := make([]byte, 1024)
+	var writes []*worldstate.KVWithMetadata
+	for i := 0; i < 2000; i++ {
+		writes = append(writes, &worldstate.KVWithMetadata{
+			Key:      fmt.Sprintf("key%06d", i),
+			Value:    value,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2, TxNum: uint64(i)}},
+		})
+	}
+	for i := 0; i < 10; i++ {
+		writes = append(writes, &worldstate.KVWithMetadata{
+			Key:      fmt.Sprintf("hot%02d", i),
+			Value:    value,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2}},
+		})
+	}
+	require.NoError(t, env.levelDB.Commit(map[string]*worldstate.DBUpdates{"db1": {Writes: writes}}, 2))
+
+	block1 := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader:     &types.BlockHeaderBase{Number: 1},
+			ValidationInfo: []*types.ValidationInfo{{Flag: types.Flag_VALID}},
+		},
+		Payload: &types.Block_ConfigTxEnvelope{
+			ConfigTxEnvelope: &types.ConfigTxEnvelope{Payload: &types.ConfigTx{UserId: "admin", TxId: "config-tx"}},
+		},
+	}
+	block2 := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader:     &types.BlockHeaderBase{Number: 2},
+			ValidationInfo: []*types.ValidationInfo{{Flag: types.Flag_VALID}},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{
+					{
+						Payload: &types.DataTx{
+							TxId:            "data-tx",
+							MustSignUserIds: []string{"alice"},
+							DbOperations: []*types.DBOperation{
+								{
+									DbName:     "db1",
+									DataReads:  []*types.DataRead{{Key: "key000010"}},
+									DataWrites: []*types.DataWrite{{Key: "key001000"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, block := range []*types.Block{block1, block2} {
+		require.NoError(t, env.blockStore.Commit(block))
+	}
+
+	require.NoError(t, env.levelDB.Close())
+	env.levelDB, err = leveldb.Open(&leveldb.Config{DBRootDir: filepath.Join(dir, "statedb"), Logger: lg})
+	require.NoError(t, err)
+
+	return env
+}
+
+func (env *cacheWarmerTestEnv) newCacheWarmer(conf *config.WarmUpConf) *cacheWarmer {
+	return newCacheWarmer(&cacheWarmerConfig{
+		db:              env.levelDB,
+		blockStore:      env.blockStore,
+		identityQuerier: identity.NewQuerier(env.levelDB),
+		conf:            conf,
+		logger:          env.logger,
+	})
+}
+
+func TestCacheWarmer(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		require.Nil(t, newCacheWarmer(&cacheWarmerConfig{conf: &config.WarmUpConf{MaxDuration: time.Second}}))
+	})
+
+	t.Run("the first queries hit the warmed caches", func(t *testing.T) {
+		env := newCacheWarmerTestEnv(t)
+
+		w := env.newCacheWarmer(&config.WarmUpConf{
+			RecentBlocks:   10,
+			HotKeyPrefixes: []config.HotKeyPrefixConf{{DBName: "db1", Prefix: "hot"}, {DBName: "no-such-db", Prefix: "hot"}},
+		})
+		w.start()
+		defer w.stop()
+
+		require.Eventually(t, func() bool {
+			return w.getStatus().State == types.WarmUpStatus_COMPLETED
+		}, 10*time.Second, 10*time.Millisecond)
+		require.True(t, proto.Equal(&types.WarmUpStatus{
+			State:            types.WarmUpStatus_COMPLETED,
+			ReplayedBlocks:   2,
+			TotalBlocks:      2,
+			LoadedKeys:       12,
+			LoadedIdentities: 2,
+			LoadedBytes:      12 * 1024,
+			LoadedPrefixes:   2,
+			ElapsedMillis:    w.getStatus().ElapsedMillis,
+		}, w.getStatus()), "%v", w.getStatus())
+
+		// the keys and the identities of the recent blocks, and the hot keys, are read from the block cache
+		read := func(dbName, key string) *leveldb.BlockCacheStats {
+			before := env.levelDB.BlockCacheStats()[dbName]
+			value, _, err := env.levelDB.Get(dbName, key)
+			require.NoError(t, err)
+			require.NotNil(t, value)
+			after := env.levelDB.BlockCacheStats()[dbName]
+			return &leveldb.BlockCacheStats{Hits: after.Hits - before.Hits, Misses: after.Misses - before.Misses}
+		}
+		for _, key := range []string{"key000010", "key001000", "hot00", "hot09"} {
+			stats := read("db1", key)
+			require.Zero(t, stats.Misses, "key %s", key)
+			require.NotZero(t, stats.Hits, "key %s", key)
+		}
+		stats := read(worldstate.UsersDBName, string(identity.UserNamespace)+"alice")
+		require.Zero(t, stats.Misses)
+		require.NotZero(t, stats.Hits)
+
+		// a key far from the warmed ones is read from the disk
+		stats = read("db1", "key001900")
+		require.NotZero(t, stats.Misses)
+	})
+
+	t.Run("the warm-up is aborted once it exceeds its budget", func(t *testing.T) {
+		env := newCacheWarmerTestEnv(t)
+
+		w := env.newCacheWarmer(&config.WarmUpConf{
+			RecentBlocks: 10,
+			MaxBytes:     1024,
+		})
+		w.start()
+		defer w.stop()
+
+		require.Eventually(t, func() bool {
+			return w.getStatus().State == types.WarmUpStatus_ABORTED
+		}, 10*time.Second, 10*time.Millisecond)
+		status := w.getStatus()
+		require.Equal(t, "the warm-up exceeded its budget of 1024 bytes", status.Reason)
+		require.Equal(t, uint64(2), status.LoadedKeys)
+		require.Equal(t, uint64(0), status.ReplayedBlocks)
+	})
+
+	t.Run("the warm-up is aborted on stop", func(t *testing.T) {
+		env := newCacheWarmerTestEnv(t)
+
+		w := env.newCacheWarmer(&config.WarmUpConf{RecentBlocks: 10})
+		close(w.stopCh)
+		w.start()
+		w.stop()
+
+		status := w.getStatus()
+		require.Equal(t, types.WarmUpStatus_ABORTED, status.State)
+		require.Equal(t, "the node is shutting down", status.Reason)
+	})
+}
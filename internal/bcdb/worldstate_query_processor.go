@@ -6,6 +6,9 @@ package bcdb
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/config"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
@@ -14,15 +17,22 @@ import (
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/internal/queryexecutor"
 	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 )
 
+const (
+	defaultCountQueryCostLimit = 100000
+	defaultMultiGetKeysLimit   = 100
+)
+
 type worldstateQueryProcessor struct {
 	nodeID              string
 	db                  worldstate.DB
 	queryProcessingConf *config.QueryProcessingConf
+	blockCreationConf   *config.BlockCreationConf
 	blockStore          *blockstore.Store
 	identityQuerier     *identity.Querier
 	logger              *logger.SugarLogger
@@ -32,6 +42,7 @@ type worldstateQueryProcessorConfig struct {
 	nodeID              string
 	db                  worldstate.DB
 	queryProcessingConf *config.QueryProcessingConf
+	blockCreationConf   *config.BlockCreationConf
 	blockStore          *blockstore.Store
 	identityQuerier     *identity.Querier
 	logger              *logger.SugarLogger
@@ -42,6 +53,7 @@ func newWorldstateQueryProcessor(conf *worldstateQueryProcessorConfig) *worldsta
 		nodeID:              conf.nodeID,
 		db:                  conf.db,
 		queryProcessingConf: conf.queryProcessingConf,
+		blockCreationConf:   conf.blockCreationConf,
 		blockStore:          conf.blockStore,
 		identityQuerier:     conf.identityQuerier,
 		logger:              conf.logger,
@@ -52,13 +64,31 @@ func (q *worldstateQueryProcessor) isDBExists(name string) bool {
 	return q.db.Exist(name)
 }
 
-// getDBStatus returns the status about a database, i.e., whether a database exist or not
+// getDBStatus returns the status about a database, i.e., whether a database exist or not, and the cap on the size of
+// the values written to it
 func (q *worldstateQueryProcessor) getDBStatus(dbName string) (*types.GetDBStatusResponse, error) {
 	// ACL is meaningless here as this call is to check whether a DB exist. Even with ACL,
 	// the user can infer the information.
-	return &types.GetDBStatusResponse{
+	status := &types.GetDBStatusResponse{
 		Exist: q.isDBExists(dbName),
-	}, nil
+	}
+	if !status.Exist || worldstate.IsSystemDB(dbName) {
+		return status, nil
+	}
+
+	value, metadata, err := q.db.Get(worldstate.DBDescriptorsDBName, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if metadata != nil {
+		descriptor := &types.DBDescriptor{}
+		if err := proto.Unmarshal(value, descriptor); err != nil {
+			return nil, err
+		}
+		status.MaxValueSizeBytes = descriptor.MaxValueSizeBytes
+	}
+
+	return status, nil
 }
 
 // getDBIndex returns the index definition associated with the given database.
@@ -90,6 +120,54 @@ func (q *worldstateQueryProcessor) getDBIndex(dbName, querierUserID string) (*ty
 	}, nil
 }
 
+// getDBDigest returns the state digest of the database as of the block `blockNum`, or as of the last committed block
+// when `blockNum` is 0. The digest of a system database is exposed to the admins only.
+func (q *worldstateQueryProcessor) getDBDigest(dbName, querierUserID string, blockNum uint64) (*types.GetDBDigestResponse, error) {
+	if worldstate.IsSystemDB(dbName) {
+		isAdmin, err := q.identityQuerier.HasAdministrationPrivilege(querierUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, &errors.PermissionErr{
+				ErrMsg: "only an admin can read the state digest of the system database [" + dbName + "]",
+			}
+		}
+	} else {
+		hasPerm, err := q.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
+		if err != nil {
+			return nil, err
+		}
+		if !hasPerm {
+			return nil, &errors.PermissionErr{
+				ErrMsg: "the user [" + querierUserID + "] has no permission to read from database [" + dbName + "]",
+			}
+		}
+	}
+
+	height, err := q.db.Height()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case blockNum == 0:
+		blockNum = height
+	case blockNum > height:
+		return nil, &errors.BadRequestError{ErrMsg: fmt.Sprintf("block [%d] is greater than the current height [%d]", blockNum, height)}
+	}
+
+	digest, updatedAt, err := q.db.GetDigest(dbName, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetDBDigestResponse{
+		BlockNumber: blockNum,
+		Digest:      digest,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
+
 // getState return the state associated with a given key
 func (q *worldstateQueryProcessor) getData(dbName, querierUserID, key string) (*types.GetDataResponse, error) {
 	if worldstate.IsSystemDB(dbName) {
@@ -129,6 +207,85 @@ func (q *worldstateQueryProcessor) getData(dbName, querierUserID, key string) (*
 	}, nil
 }
 
+// getDataMulti returns the values of the given keys, in the order of the keys, all read from a single snapshot of the
+// state, along with the height of the snapshot. A key which does not exist is marked as not found, and a key whose ACL
+// excludes the querier is marked as forbidden, without its value or metadata.
+func (q *worldstateQueryProcessor) getDataMulti(dbName, querierUserID string, keys []string) (*types.GetDataMultiResponse, error) {
+	if worldstate.IsSystemDB(dbName) {
+		return nil, &errors.PermissionErr{
+			ErrMsg: "no user can directly read from a system database [" + dbName + "]. " +
+				"To read from a system database, use /config, /user, /db rest endpoints instead of /data",
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, &errors.BadRequestError{ErrMsg: "no key is given"}
+	}
+	if limit := q.multiGetKeysLimit(); uint64(len(keys)) > limit {
+		return nil, &errors.BadRequestError{
+			ErrMsg: fmt.Sprintf("the number of keys [%d] exceeds the limit of a multi-key read [%d]", len(keys), limit),
+		}
+	}
+
+	hasPerm, err := q.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return nil, &errors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to read from database [" + dbName + "]",
+		}
+	}
+
+	snapshots, height, err := q.db.GetDBsSnapshotWithHeight([]string{dbName})
+	if err != nil {
+		return nil, err
+	}
+	defer snapshots.Release()
+
+	var size uint64
+	entries := make([]*types.MultiGetEntry, 0, len(keys))
+	for _, key := range keys {
+		value, metadata, err := snapshots.Get(dbName, key)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := &types.MultiGetEntry{Key: key}
+		acl := metadata.GetAccessControl()
+		switch {
+		case metadata == nil && value == nil:
+			entry.Status = types.MultiGetEntry_NOT_FOUND
+		case acl != nil && !acl.ReadUsers[querierUserID] && !acl.ReadWriteUsers[querierUserID]:
+			entry.Status = types.MultiGetEntry_FORBIDDEN
+		default:
+			entry.Value = value
+			entry.Metadata = metadata
+		}
+
+		size += uint64(len(key) + len(entry.Value))
+		if size > q.queryProcessingConf.ResponseSizeLimitInBytes {
+			return nil, &errors.ServerRestrictionError{
+				ErrMsg: fmt.Sprintf("response size limit for queries is configured as %d bytes but the values of the keys exceed it. Read fewer keys at a time or increase the query response size limit at the server", q.queryProcessingConf.ResponseSizeLimitInBytes),
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &types.GetDataMultiResponse{
+		Height:  height,
+		Entries: entries,
+	}, nil
+}
+
+func (q *worldstateQueryProcessor) multiGetKeysLimit() uint64 {
+	if q.queryProcessingConf.MultiGetKeysLimit == 0 {
+		return defaultMultiGetKeysLimit
+	}
+	return q.queryProcessingConf.MultiGetKeysLimit
+}
+
 // getDataRange return the state associated with a given key
 func (q *worldstateQueryProcessor) getDataRange(dbName, querierUserID, startKey, endKey string, limit uint64) (*types.GetDataRangeResponse, error) {
 	if worldstate.IsSystemDB(dbName) {
@@ -155,10 +312,10 @@ func (q *worldstateQueryProcessor) getDataRange(dbName, querierUserID, startKey,
 	var nextStartKey string
 
 	itr, err := q.db.GetIterator(dbName, startKey, endKey)
-	defer itr.Release()
 	if err != nil {
-		return nil, err
+		return nil, rangeBoundErr(err)
 	}
+	defer itr.Release()
 
 	for itr.Next() {
 		k := string(itr.Key())
@@ -210,6 +367,132 @@ func (q *worldstateQueryProcessor) getDataRange(dbName, querierUserID, startKey,
 	}, nil
 }
 
+// countData counts the keys of the database which the querier can read. The keys are selected either by the JSON
+// query, and served from the index of the database, or by the range [startKey, endKey), and served from the range
+// iterator. A key whose ACL excludes the querier is not counted, hence, the metadata of each selected key is read,
+// while no value is returned. Each key examined costs one unit of the count query cost limit; once the limit is
+// reached, the count found so far is returned as truncated. When existsOnly is set, the query stops at the first
+// readable key.
+func (q *worldstateQueryProcessor) countData(dbName, querierUserID, startKey, endKey, jsonQuery string, existsOnly bool) (*types.GetDataCountResponse, error) {
+	if worldstate.IsSystemDB(dbName) {
+		return nil, &errors.PermissionErr{
+			ErrMsg: "no user can directly read from a system database [" + dbName + "]. " +
+				"To read from a system database, use /config, /user, /db rest endpoints instead of /data",
+		}
+	}
+
+	hasPerm, err := q.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPerm {
+		return nil, &errors.PermissionErr{
+			ErrMsg: "the user [" + querierUserID + "] has no permission to read from database [" + dbName + "]",
+		}
+	}
+
+	snapshots, err := q.db.GetDBsSnapshot(
+		[]string{
+			worldstate.DatabasesDBName,
+			dbName,
+			stateindex.IndexDB(dbName),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer snapshots.Release()
+
+	costLimit := q.countQueryCostLimit()
+	res := &types.GetDataCountResponse{}
+	// examine returns false once the query is done, either because the cost limit is reached, or because a readable
+	// key is found and only its existence is asked
+	examine := func(metadata *types.Metadata, cost uint64) bool {
+		if cost > costLimit {
+			res.Truncated = true
+			return false
+		}
+		if acl := metadata.GetAccessControl(); acl != nil && !acl.ReadUsers[querierUserID] && !acl.ReadWriteUsers[querierUserID] {
+			return true
+		}
+		res.Count++
+		res.Exists = true
+		return !existsOnly
+	}
+
+	if jsonQuery != "" {
+		jsonQueryExecutor := queryexecutor.NewWorldStateJSONQueryExecutor(snapshots, q.logger)
+		keys, err := jsonQueryExecutor.ExecuteQuery(context.Background(), dbName, []byte(jsonQuery))
+		if err != nil {
+			return nil, err
+		}
+
+		// the keys are examined in order, so that a truncated count is the same on every node
+		collation, err := worldstate.GetKeyCollation(q.db, dbName)
+		if err != nil {
+			return nil, err
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		worldstate.SortKeys(collation, sortedKeys)
+
+		for i, k := range sortedKeys {
+			_, metadata, err := snapshots.Get(dbName, k)
+			if err != nil {
+				return nil, err
+			}
+			if !examine(metadata, uint64(i+1)) {
+				break
+			}
+		}
+		return res, nil
+	}
+
+	itr, err := snapshots.GetIterator(dbName, startKey, endKey)
+	if err != nil {
+		return nil, rangeBoundErr(err)
+	}
+	defer itr.Release()
+
+	var cost uint64
+	for itr.Next() {
+		if strings.HasPrefix(string(itr.Key()), worldstate.ReservedKeyPrefix) {
+			continue
+		}
+
+		v := &types.ValueWithMetadata{}
+		if err := proto.Unmarshal(itr.Value(), v); err != nil {
+			return nil, err
+		}
+		cost++
+		if !examine(v.GetMetadata(), cost) {
+			break
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// rangeBoundErr turns a range bound which does not conform to the key collation of the database into a bad request
+func rangeBoundErr(err error) error {
+	if _, ok := err.(*worldstate.ErrKeyNotCollatable); ok {
+		return &errors.BadRequestError{ErrMsg: err.Error()}
+	}
+	return err
+}
+
+func (q *worldstateQueryProcessor) countQueryCostLimit() uint64 {
+	if q.queryProcessingConf.CountQueryCostLimit == 0 {
+		return defaultCountQueryCostLimit
+	}
+	return q.queryProcessingConf.CountQueryCostLimit
+}
+
 func (q *worldstateQueryProcessor) getUser(querierUserID, targetUserID string) (*types.GetUserResponse, error) {
 	user, metadata, err := q.identityQuerier.GetUser(targetUserID)
 	if err != nil {
@@ -243,6 +526,61 @@ func (q *worldstateQueryProcessor) getUser(querierUserID, targetUserID string) (
 	}, nil
 }
 
+// getSessionBootstrap returns what a client needs to start a session: the record of the querier, the user databases
+// the querier can read along with its access to each, and the limits enforced by the node.
+func (q *worldstateQueryProcessor) getSessionBootstrap(querierUserID string) (*types.GetSessionBootstrapResponse, error) {
+	user, metadata, err := q.identityQuerier.GetUser(querierUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbNames := []string{worldstate.DefaultDBName}
+	for _, dbName := range q.db.ListDBs() {
+		if worldstate.IsDefaultWorldStateDB(dbName) || stateindex.IsIndexDB(dbName) {
+			continue
+		}
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	var databases []*types.DatabaseAccess
+	for _, dbName := range dbNames {
+		canRead, err := q.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
+		if err != nil {
+			return nil, err
+		}
+		if !canRead {
+			continue
+		}
+
+		canWrite, err := q.identityQuerier.HasReadWriteAccess(querierUserID, dbName)
+		if err != nil {
+			return nil, err
+		}
+		access := types.Privilege_Read
+		if canWrite {
+			access = types.Privilege_ReadWrite
+		}
+
+		databases = append(databases, &types.DatabaseAccess{
+			Name:   dbName,
+			Access: access,
+		})
+	}
+
+	return &types.GetSessionBootstrapResponse{
+		User:         user,
+		UserMetadata: metadata,
+		Databases:    databases,
+		Limits: &types.SessionLimits{
+			ResponseSizeLimitInBytes:    q.queryProcessingConf.ResponseSizeLimitInBytes,
+			HistoricalQueryCostLimit:    q.queryProcessingConf.HistoricalQueryCostLimit,
+			MaxBlockSize:                q.blockCreationConf.MaxBlockSize,
+			MaxTransactionCountPerBlock: q.blockCreationConf.MaxTransactionCountPerBlock,
+		},
+	}, nil
+}
+
 func (q *worldstateQueryProcessor) getConfig(querierUserID string) (*types.GetConfigResponse, error) {
 	// Limited access to admins only. Regular users can use the `GetNodeConfig` or `GetClusterStatus` APIs to discover
 	// and fetch the details of nodes that are needed for external cluster access.
@@ -267,6 +605,24 @@ func (q *worldstateQueryProcessor) getConfig(querierUserID string) (*types.GetCo
 	}, nil
 }
 
+// getConfigLimits returns the limits on data transactions, as set in the committed cluster configuration
+func (q *worldstateQueryProcessor) getConfigLimits() (*types.GetConfigLimitsResponse, error) {
+	config, _, err := q.db.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	maxKeys := config.GetLedgerConfig().GetMaxKeysPerRangeDelete()
+	if maxKeys == 0 {
+		maxKeys = txvalidation.DefaultMaxKeysPerRangeDelete
+	}
+
+	return &types.GetConfigLimitsResponse{
+		TxOperationLimits:     config.GetLedgerConfig().GetTxOperationLimits(),
+		MaxKeysPerRangeDelete: maxKeys,
+	}, nil
+}
+
 func (q *worldstateQueryProcessor) getNodeConfigAndMetadata() ([]*types.NodeConfig, *types.Metadata, error) {
 	config, metadata, err := q.db.GetConfig()
 	if err != nil {
@@ -291,6 +647,50 @@ func (q *worldstateQueryProcessor) getNodeConfig(nodeID string) (*types.GetNodeC
 	return c, nil
 }
 
+// getClusterHeartbeats returns the last heartbeat of each node in the cluster configuration, along with its staleness,
+// i.e., the number of blocks committed since the heartbeat was committed.
+func (q *worldstateQueryProcessor) getClusterHeartbeats() (*types.GetClusterHeartbeatsResponse, error) {
+	height, err := q.db.Height()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, _, err := q.getNodeConfigAndMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.GetClusterHeartbeatsResponse{
+		Height: height,
+	}
+	for _, node := range nodes {
+		heartbeat := &types.NodeHeartbeat{
+			NodeId:    node.Id,
+			Staleness: height,
+		}
+
+		value, metadata, err := q.db.Get(worldstate.HeartbeatsDBName, node.Id)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			tx := &types.HeartbeatTx{}
+			if err := proto.Unmarshal(value, tx); err != nil {
+				return nil, err
+			}
+
+			heartbeat.Version = tx.Version
+			heartbeat.LastCommittedHeight = tx.LastCommittedHeight
+			heartbeat.BlockNumber = metadata.GetVersion().GetBlockNum()
+			heartbeat.Staleness = height - heartbeat.BlockNumber
+		}
+
+		res.Heartbeats = append(res.Heartbeats, heartbeat)
+	}
+
+	return res, nil
+}
+
 func (q *worldstateQueryProcessor) getConfigBlock(querierUserID string, blockNumber uint64) (*types.GetConfigBlockResponse, error) {
 	isAdmin, err := q.identityQuerier.HasAdministrationPrivilege(querierUserID)
 	if err != nil {
@@ -326,9 +726,12 @@ func (q *worldstateQueryProcessor) getConfigBlock(querierUserID string, blockNum
 	}, nil
 }
 
-func (q *worldstateQueryProcessor) executeJSONQuery(ctx context.Context, dbName, querierUserID string, query []byte) (*types.DataQueryResponse, error) {
+// executeJSONQuery returns the readable key-value pairs selected by the JSON query, in the order of the key collation
+// of the database, starting with the key fromKey, if set. A non-zero limit bounds the number of pairs returned, and
+// the key the next page starts at is returned if the limit cut the pairs short.
+func (q *worldstateQueryProcessor) executeJSONQuery(ctx context.Context, dbName, querierUserID string, query []byte, fromKey string, limit uint64) (*types.DataQueryResponse, string, error) {
 	if worldstate.IsSystemDB(dbName) {
-		return nil, &errors.PermissionErr{
+		return nil, "", &errors.PermissionErr{
 			ErrMsg: "no user can directly read from a system database [" + dbName + "]. " +
 				"To read from a system database, use /config, /user, /db rest endpoints instead of /data",
 		}
@@ -336,10 +739,10 @@ func (q *worldstateQueryProcessor) executeJSONQuery(ctx context.Context, dbName,
 
 	hasPerm, err := q.identityQuerier.HasReadAccessOnDataDB(querierUserID, dbName)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if !hasPerm {
-		return nil, &errors.PermissionErr{
+		return nil, "", &errors.PermissionErr{
 			ErrMsg: "the user [" + querierUserID + "] has no permission to read from database [" + dbName + "]",
 		}
 	}
@@ -352,33 +755,52 @@ func (q *worldstateQueryProcessor) executeJSONQuery(ctx context.Context, dbName,
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer func() {
 		snapshots.Release()
 	}()
 
 	jsonQueryExecutor := queryexecutor.NewWorldStateJSONQueryExecutor(snapshots, q.logger)
-	keys, err := jsonQueryExecutor.ExecuteQuery(ctx, dbName, query)
+	queryResult, err := jsonQueryExecutor.Execute(ctx, dbName, query)
 	select {
 	case <-ctx.Done():
-		return nil, nil
+		return nil, "", nil
 	default:
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
+	// the keys selected from the index are returned in the order of the key collation of the database
+	collation, err := worldstate.GetKeyCollation(q.db, dbName)
+	if err != nil {
+		return nil, "", err
+	}
+	sortedKeys := make([]string, 0, len(queryResult.Keys))
+	for k := range queryResult.Keys {
+		sortedKeys = append(sortedKeys, k)
+	}
+	worldstate.SortKeys(collation, sortedKeys)
+
+	if fromKey != "" {
+		sortedKeys = sortedKeys[sort.Search(len(sortedKeys), func(i int) bool {
+			return worldstate.CompareKeys(collation, sortedKeys[i], fromKey) >= 0
+		}):]
+	}
+
 	var results []*types.KVWithMetadata
+	var nextKey string
 
-	for k := range keys {
+keys:
+	for _, k := range sortedKeys {
 		select {
 		case <-ctx.Done():
-			return nil, nil
+			return nil, "", nil
 		default:
 			value, metadata, err := snapshots.Get(dbName, k)
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
 
 			// TODO: we can store the ACL as value in the indexEntry. With that, we can avoid reading the whole value
@@ -390,6 +812,11 @@ func (q *worldstateQueryProcessor) executeJSONQuery(ctx context.Context, dbName,
 				}
 			}
 
+			if limit > 0 && uint64(len(results)) == limit {
+				nextKey = k
+				break keys
+			}
+
 			results = append(
 				results,
 				&types.KVWithMetadata{
@@ -402,6 +829,7 @@ func (q *worldstateQueryProcessor) executeJSONQuery(ctx context.Context, dbName,
 	}
 
 	return &types.DataQueryResponse{
-		KVs: results,
-	}, nil
+		KVs:                    results,
+		PostFilteredAttributes: queryResult.PostFilteredAttributes,
+	}, nextKey, nil
 }
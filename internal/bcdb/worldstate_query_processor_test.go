@@ -5,12 +5,16 @@ package bcdb
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/internal/stateindex"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
@@ -68,6 +72,7 @@ func newWorldstateQueryProcessorTestEnv(t *testing.T) *worldstateQueryProcessorT
 		nodeID:              nodeID,
 		db:                  db,
 		queryProcessingConf: &config.QueryProcessingConf{},
+		blockCreationConf:   &config.BlockCreationConf{},
 		blockStore:          nil,
 		identityQuerier:     identity.NewQuerier(db),
 		logger:              logger,
@@ -117,8 +122,41 @@ func TestGetDBStatus(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, status)
 			require.Equal(t, testCase.isExist, status.Exist)
+			require.Zero(t, status.MaxValueSizeBytes)
 		}
 	})
+
+	t.Run("getDBStatus-Returns-MaxValueSize", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+
+		descriptor, err := proto.Marshal(&types.DBDescriptor{MaxValueSizeBytes: 1024})
+		require.NoError(t, err)
+		require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.DatabasesDBName: {
+				Writes: []*worldstate.KVWithMetadata{{Key: "capped-db"}, {Key: "uncapped-db"}},
+			},
+			worldstate.DBDescriptorsDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      "capped-db",
+						Value:    descriptor,
+						Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}},
+					},
+				},
+			},
+		}, 1))
+
+		status, err := env.q.getDBStatus("capped-db")
+		require.NoError(t, err)
+		require.True(t, status.Exist)
+		require.Equal(t, uint64(1024), status.MaxValueSizeBytes)
+
+		status, err = env.q.getDBStatus("uncapped-db")
+		require.NoError(t, err)
+		require.True(t, status.Exist)
+		require.Zero(t, status.MaxValueSizeBytes)
+	})
 }
 
 func TestGetDBIndex(t *testing.T) {
@@ -235,6 +273,73 @@ func TestGetDBIndex(t *testing.T) {
 	})
 }
 
+func TestGetDBDigest(t *testing.T) {
+	env := newWorldstateQueryProcessorTestEnv(t)
+	defer env.cleanup(t)
+
+	commit := func(dbsUpdates map[string]*worldstate.DBUpdates, blockNum uint64) {
+		digests, err := worldstate.ComputeDigests(env.db, blockNum, dbsUpdates)
+		require.NoError(t, err)
+		require.NoError(t, env.db.CommitDigests(digests, blockNum))
+		require.NoError(t, env.db.Commit(dbsUpdates, blockNum))
+	}
+	user := func(userID string, admin bool) *worldstate.KVWithMetadata {
+		u, err := proto.Marshal(&types.User{
+			Id: userID,
+			Privilege: &types.Privilege{
+				DbPermission: map[string]types.Privilege_Access{"test-db": types.Privilege_Read},
+				Admin:        admin,
+			},
+		})
+		require.NoError(t, err)
+		return &worldstate.KVWithMetadata{Key: string(identity.UserNamespace) + userID, Value: u}
+	}
+
+	commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "test-db"}, {Key: "other-db"}}},
+		worldstate.UsersDBName:     {Writes: []*worldstate.KVWithMetadata{user("alice", false), user("admin", true)}},
+	}, 1)
+	commit(map[string]*worldstate.DBUpdates{
+		"test-db": {Writes: []*worldstate.KVWithMetadata{{Key: "key1", Value: []byte("value1")}}},
+	}, 2)
+	commit(map[string]*worldstate.DBUpdates{
+		"other-db": {Writes: []*worldstate.KVWithMetadata{{Key: "key1", Value: []byte("value1")}}},
+	}, 3)
+
+	t.Run("digest at the current height", func(t *testing.T) {
+		digest, err := env.q.getDBDigest("test-db", "alice", 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), digest.GetBlockNumber())
+		require.Equal(t, uint64(2), digest.GetUpdatedAt())
+		require.NotEqual(t, worldstate.EmptyDigest(), digest.GetDigest())
+	})
+
+	t.Run("digest as of a block", func(t *testing.T) {
+		digest, err := env.q.getDBDigest("test-db", "alice", 1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), digest.GetBlockNumber())
+		require.Equal(t, uint64(0), digest.GetUpdatedAt())
+		require.Equal(t, worldstate.EmptyDigest(), digest.GetDigest())
+	})
+
+	t.Run("digest of a system database", func(t *testing.T) {
+		digest, err := env.q.getDBDigest(worldstate.UsersDBName, "admin", 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), digest.GetUpdatedAt())
+
+		_, err = env.q.getDBDigest(worldstate.UsersDBName, "alice", 0)
+		require.EqualError(t, err, "only an admin can read the state digest of the system database [_users]")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := env.q.getDBDigest("other-db", "alice", 0)
+		require.EqualError(t, err, "the user [alice] has no permission to read from database [other-db]")
+
+		_, err = env.q.getDBDigest("test-db", "alice", 4)
+		require.EqualError(t, err, "block [4] is greater than the current height [3]")
+	})
+}
+
 func TestGetDataRange(t *testing.T) {
 	aliceKVs := []*worldstate.KVWithMetadata{
 		{
@@ -1098,14 +1203,47 @@ func TestExecuteJSONQuery(t *testing.T) {
 	}
 
 	tests := []struct {
-		name                string
-		dbName              string
-		userID              string
-		query               []byte
-		useCancelledContext bool
-		expectedKVs         map[string]*types.KVWithMetadata
-		expectedErr         string
+		name                 string
+		dbName               string
+		userID               string
+		query                []byte
+		useCancelledContext  bool
+		expectedKVs          map[string]*types.KVWithMetadata
+		expectedPostFiltered []string
+		expectedErr          string
 	}{
+		{
+			name:   "fetch records based on an indexed and an unindexed attribute",
+			dbName: "db1",
+			userID: "user1",
+			query: []byte(
+				`{
+					"selector": {
+						"$and": {
+							"attr2": {
+								"$eq": true
+							},
+							"attr4": {
+								"$lt": -100
+							}
+						}
+					}
+				}`,
+			),
+			expectedKVs: map[string]*types.KVWithMetadata{
+				"key5": {
+					Key:      "key5",
+					Value:    []byte(`{"attr1":"g","attr2":true,"attr3":"n","attr4":-101}`),
+					Metadata: m,
+				},
+				"key6": {
+					Key:      "key6",
+					Value:    []byte(`{"attr1":"h","attr2":true,"attr3":"o","attr4":-102}`),
+					Metadata: m,
+				},
+			},
+			expectedPostFiltered: []string{"attr4"},
+		},
 		{
 			name:   "fetch records based on boolean matching",
 			dbName: "db1",
@@ -1280,7 +1418,7 @@ func TestExecuteJSONQuery(t *testing.T) {
 			if tt.useCancelledContext {
 				cancel()
 			}
-			result, err := env.q.executeJSONQuery(ctx, tt.dbName, tt.userID, tt.query)
+			result, _, err := env.q.executeJSONQuery(ctx, tt.dbName, tt.userID, tt.query, "", 0)
 			if tt.expectedErr == "" {
 				require.NoError(t, err)
 				if tt.useCancelledContext {
@@ -1292,6 +1430,7 @@ func TestExecuteJSONQuery(t *testing.T) {
 				for _, kv := range result.KVs {
 					require.True(t, proto.Equal(kv, tt.expectedKVs[kv.Key]))
 				}
+				require.Equal(t, tt.expectedPostFiltered, result.PostFilteredAttributes)
 			} else {
 				require.Nil(t, result)
 				require.NotNil(t, err)
@@ -1301,6 +1440,425 @@ func TestExecuteJSONQuery(t *testing.T) {
 	}
 }
 
+func TestCountData(t *testing.T) {
+	db1 := "db1"
+
+	setup := func(t *testing.T, db worldstate.DB) []string {
+		for _, userID := range []string{"user1", "user2"} {
+			u, err := proto.Marshal(&types.User{
+				Id: userID,
+				Privilege: &types.Privilege{
+					DbPermission: map[string]types.Privilege_Access{db1: types.Privilege_Read},
+				},
+			})
+			require.NoError(t, err)
+			require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.UsersDBName: {
+					Writes: []*worldstate.KVWithMetadata{
+						{Key: string(identity.UserNamespace) + userID, Value: u},
+					},
+				},
+			}, 2))
+		}
+
+		indexDef, err := json.Marshal(map[string]types.IndexAttributeType{"status": types.IndexAttributeType_STRING})
+		require.NoError(t, err)
+		require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.DatabasesDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{Key: db1, Value: indexDef},
+					{Key: stateindex.IndexDB(db1)},
+					{Key: "db2"},
+				},
+			},
+		}, 2))
+
+		// every third key is readable by user2 only, every fifth key by user1 explicitly, and the rest by everyone
+		var keys []string
+		updates := &worldstate.DBUpdates{}
+		for i := 0; i < 60; i++ {
+			key := fmt.Sprintf("order-%02d", i)
+			if i >= 50 {
+				key = fmt.Sprintf("item-%02d", i)
+			}
+			status := "closed"
+			if i%2 == 0 {
+				status = "open"
+			}
+
+			metadata := &types.Metadata{Version: &types.Version{BlockNum: 3, TxNum: uint64(i)}}
+			switch {
+			case i%3 == 0:
+				metadata.AccessControl = &types.AccessControl{ReadUsers: map[string]bool{"user2": true}}
+			case i%5 == 0:
+				metadata.AccessControl = &types.AccessControl{ReadWriteUsers: map[string]bool{"user1": true}}
+			}
+
+			keys = append(keys, key)
+			updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+				Key:      key,
+				Value:    []byte(`{"status":"` + status + `"}`),
+				Metadata: metadata,
+			})
+		}
+
+		dbsUpdates := map[string]*worldstate.DBUpdates{db1: updates}
+		indexUpdates, err := stateindex.ConstructIndexEntries(dbsUpdates, db)
+		require.NoError(t, err)
+		for indexDB, u := range indexUpdates {
+			dbsUpdates[indexDB] = u
+		}
+		require.NoError(t, db.Commit(dbsUpdates, 3))
+
+		sort.Strings(keys)
+		return keys
+	}
+
+	// bruteForceCount reads every selected key the way a data query does, and counts the ones the user can read
+	bruteForceCount := func(t *testing.T, env *worldstateQueryProcessorTestEnv, keys []string, userID string, selected func(key string, value []byte) bool) uint64 {
+		var count uint64
+		for _, key := range keys {
+			value, _, err := env.db.Get(db1, key)
+			require.NoError(t, err)
+			if !selected(key, value) {
+				continue
+			}
+
+			_, err = env.q.getData(db1, userID, key)
+			if err == nil {
+				count++
+				continue
+			}
+			require.IsType(t, &errors.PermissionErr{}, err)
+		}
+		return count
+	}
+
+	inRange := func(startKey, endKey string) func(string, []byte) bool {
+		return func(key string, _ []byte) bool {
+			return worldstate.InRange(key, startKey, endKey)
+		}
+	}
+	hasStatus := func(status string) func(string, []byte) bool {
+		return func(_ string, value []byte) bool {
+			return strings.Contains(string(value), `"`+status+`"`)
+		}
+	}
+	openQuery := `{"selector":{"status":{"$eq":"open"}}}`
+
+	t.Run("counts match a brute-force enumeration", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		keys := setup(t, env.db)
+
+		prefixStart, prefixEnd := worldstate.PrefixRange("order-")
+		tests := []struct {
+			name               string
+			startKey, endKey   string
+			jsonQuery          string
+			selected           func(string, []byte) bool
+			expectedUser1Count uint64
+		}{
+			{name: "whole database", selected: inRange("", ""), expectedUser1Count: 40},
+			{name: "range", startKey: "order-10", endKey: "order-40", selected: inRange("order-10", "order-40"), expectedUser1Count: 20},
+			{name: "prefix", startKey: prefixStart, endKey: prefixEnd, selected: inRange(prefixStart, prefixEnd), expectedUser1Count: 33},
+			{name: "empty range", startKey: "p", endKey: "q", selected: inRange("p", "q")},
+			{name: "indexed predicate", jsonQuery: openQuery, selected: hasStatus("open"), expectedUser1Count: 20},
+		}
+
+		for _, tt := range tests {
+			for _, userID := range []string{"user1", "user2"} {
+				expected := bruteForceCount(t, env, keys, userID, tt.selected)
+				if userID == "user1" {
+					require.Equal(t, tt.expectedUser1Count, expected, tt.name)
+				}
+
+				res, err := env.q.countData(db1, userID, tt.startKey, tt.endKey, tt.jsonQuery, false)
+				require.NoError(t, err)
+				require.Equal(t, expected, res.Count, "%s, %s", tt.name, userID)
+				require.Equal(t, expected > 0, res.Exists, "%s, %s", tt.name, userID)
+				require.False(t, res.Truncated)
+
+				res, err = env.q.countData(db1, userID, tt.startKey, tt.endKey, tt.jsonQuery, true)
+				require.NoError(t, err)
+				require.Equal(t, expected > 0, res.Exists, "%s, %s", tt.name, userID)
+				require.LessOrEqual(t, res.Count, uint64(1))
+				require.False(t, res.Truncated)
+			}
+		}
+	})
+
+	t.Run("existence ignores the keys excluded by the ACL", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env.db)
+
+		// order-00 is readable by user2 only
+		res, err := env.q.countData(db1, "user1", "order-00", "order-01", "", true)
+		require.NoError(t, err)
+		require.False(t, res.Exists)
+		require.Equal(t, uint64(0), res.Count)
+
+		res, err = env.q.countData(db1, "user2", "order-00", "order-01", "", true)
+		require.NoError(t, err)
+		require.True(t, res.Exists)
+	})
+
+	t.Run("count is truncated by the cost limit", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		keys := setup(t, env.db)
+		env.q.queryProcessingConf.CountQueryCostLimit = 10
+
+		// the first ten keys are examined, in order
+		expected := bruteForceCount(t, env, keys[:10], "user1", inRange("", ""))
+		res, err := env.q.countData(db1, "user1", "", "", "", false)
+		require.NoError(t, err)
+		require.True(t, res.Truncated)
+		require.Equal(t, expected, res.Count)
+
+		var openKeys []string
+		for _, key := range keys {
+			value, _, err := env.db.Get(db1, key)
+			require.NoError(t, err)
+			if hasStatus("open")(key, value) {
+				openKeys = append(openKeys, key)
+			}
+		}
+		expected = bruteForceCount(t, env, openKeys[:10], "user1", hasStatus("open"))
+		res, err = env.q.countData(db1, "user1", "", "", openQuery, false)
+		require.NoError(t, err)
+		require.True(t, res.Truncated)
+		require.Equal(t, expected, res.Count)
+
+		// a range within the limit is exact
+		res, err = env.q.countData(db1, "user1", "order-00", "order-10", "", false)
+		require.NoError(t, err)
+		require.False(t, res.Truncated)
+		require.Equal(t, bruteForceCount(t, env, keys, "user1", inRange("order-00", "order-10")), res.Count)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env.db)
+
+		res, err := env.q.countData("db2", "user1", "", "", "", false)
+		require.EqualError(t, err, "the user [user1] has no permission to read from database [db2]")
+		require.Nil(t, res)
+
+		res, err = env.q.countData(worldstate.UsersDBName, "user1", "", "", "", false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no user can directly read from a system database")
+		require.Nil(t, res)
+
+		res, err = env.q.countData(db1, "user1", "", "", `{"status":{"$eq":"open"}}`, false)
+		require.EqualError(t, err, "selector field is missing in the query")
+		require.Nil(t, res)
+	})
+}
+
+func TestGetDataMulti(t *testing.T) {
+	db1 := "db1"
+
+	setup := func(t *testing.T, env *worldstateQueryProcessorTestEnv) {
+		db := env.db
+		env.q.queryProcessingConf.ResponseSizeLimitInBytes = 1024
+		for _, userID := range []string{"user1", "user2"} {
+			u, err := proto.Marshal(&types.User{
+				Id: userID,
+				Privilege: &types.Privilege{
+					DbPermission: map[string]types.Privilege_Access{db1: types.Privilege_Read},
+				},
+			})
+			require.NoError(t, err)
+			require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.UsersDBName: {
+					Writes: []*worldstate.KVWithMetadata{
+						{Key: string(identity.UserNamespace) + userID, Value: u},
+					},
+				},
+			}, 1))
+		}
+
+		require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.DatabasesDBName: {
+				Writes: []*worldstate.KVWithMetadata{{Key: db1}, {Key: "db2"}},
+			},
+		}, 2))
+	}
+
+	t.Run("the keys are read with their status", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env)
+
+		require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+			db1: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      "key1",
+						Value:    []byte("value1"),
+						Metadata: &types.Metadata{Version: &types.Version{BlockNum: 3, TxNum: 0}},
+					},
+					{
+						Key:   "key2",
+						Value: []byte("value2"),
+						Metadata: &types.Metadata{
+							Version:       &types.Version{BlockNum: 3, TxNum: 1},
+							AccessControl: &types.AccessControl{ReadUsers: map[string]bool{"user2": true}},
+						},
+					},
+					{
+						Key:   "key3",
+						Value: []byte("value3"),
+						Metadata: &types.Metadata{
+							Version:       &types.Version{BlockNum: 3, TxNum: 2},
+							AccessControl: &types.AccessControl{ReadWriteUsers: map[string]bool{"user1": true}},
+						},
+					},
+				},
+			},
+		}, 3))
+
+		res, err := env.q.getDataMulti(db1, "user1", []string{"key3", "key2", "key4", "key1"})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&types.GetDataMultiResponse{
+			Height: 3,
+			Entries: []*types.MultiGetEntry{
+				{
+					Key:   "key3",
+					Value: []byte("value3"),
+					Metadata: &types.Metadata{
+						Version:       &types.Version{BlockNum: 3, TxNum: 2},
+						AccessControl: &types.AccessControl{ReadWriteUsers: map[string]bool{"user1": true}},
+					},
+				},
+				{Key: "key2", Status: types.MultiGetEntry_FORBIDDEN},
+				{Key: "key4", Status: types.MultiGetEntry_NOT_FOUND},
+				{
+					Key:      "key1",
+					Value:    []byte("value1"),
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 3, TxNum: 0}},
+				},
+			},
+		}, res), "%v", res)
+
+		res, err = env.q.getDataMulti(db1, "user2", []string{"key2", "key3"})
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2"), res.Entries[0].Value)
+		require.Equal(t, types.MultiGetEntry_FOUND, res.Entries[0].Status)
+		require.Equal(t, types.MultiGetEntry_FORBIDDEN, res.Entries[1].Status)
+		require.Nil(t, res.Entries[1].Value)
+		require.Nil(t, res.Entries[1].Metadata)
+	})
+
+	t.Run("the returned versions are consistent with one height while blocks are committed", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env)
+
+		// every block writes all the keys of db1, and a key of db2, which is committed after db1
+		keys := []string{"key1", "key2", "key3", "key4", "key5"}
+		commitBlock := func(blockNum uint64) error {
+			updates := &worldstate.DBUpdates{}
+			for i, key := range keys {
+				updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+					Key:      key,
+					Value:    []byte(fmt.Sprintf("value-%d", blockNum)),
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum, TxNum: uint64(i)}},
+				})
+			}
+			return env.db.Commit(map[string]*worldstate.DBUpdates{
+				db1: updates,
+				"db2": {
+					Writes: []*worldstate.KVWithMetadata{
+						{Key: "key", Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum}}},
+					},
+				},
+			}, blockNum)
+		}
+		require.NoError(t, commitBlock(3))
+
+		const lastBlock = 200
+		committed := make(chan error, 1)
+		go func() {
+			for blockNum := uint64(4); blockNum <= lastBlock; blockNum++ {
+				if err := commitBlock(blockNum); err != nil {
+					committed <- err
+					return
+				}
+			}
+			committed <- nil
+		}()
+
+		var heights []uint64
+		for done := false; !done; {
+			select {
+			case err := <-committed:
+				require.NoError(t, err)
+				done = true
+			default:
+			}
+
+			res, err := env.q.getDataMulti(db1, "user1", keys)
+			require.NoError(t, err)
+			for _, entry := range res.Entries {
+				require.Equal(t, types.MultiGetEntry_FOUND, entry.Status)
+				require.Equal(t, res.Height, entry.Metadata.Version.BlockNum, "key %s", entry.Key)
+				require.Equal(t, []byte(fmt.Sprintf("value-%d", res.Height)), entry.Value)
+			}
+			if len(heights) == 0 || heights[len(heights)-1] != res.Height {
+				heights = append(heights, res.Height)
+			}
+		}
+		require.True(t, sort.SliceIsSorted(heights, func(i, j int) bool { return heights[i] < heights[j] }))
+		require.Equal(t, uint64(lastBlock), heights[len(heights)-1])
+	})
+
+	t.Run("the query is rejected", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env)
+		env.q.queryProcessingConf.MultiGetKeysLimit = 2
+
+		_, err := env.q.getDataMulti(worldstate.UsersDBName, "user1", []string{"key1"})
+		require.IsType(t, &errors.PermissionErr{}, err)
+
+		_, err = env.q.getDataMulti(db1, "user1", nil)
+		require.EqualError(t, err, "no key is given")
+		require.IsType(t, &errors.BadRequestError{}, err)
+
+		_, err = env.q.getDataMulti(db1, "user1", []string{"key1", "key2", "key3"})
+		require.EqualError(t, err, "the number of keys [3] exceeds the limit of a multi-key read [2]")
+		require.IsType(t, &errors.BadRequestError{}, err)
+
+		_, err = env.q.getDataMulti("db2", "user1", []string{"key1"})
+		require.EqualError(t, err, "the user [user1] has no permission to read from database [db2]")
+		require.IsType(t, &errors.PermissionErr{}, err)
+
+		require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+			db1: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      "key1",
+						Value:    make([]byte, 1000),
+						Metadata: &types.Metadata{Version: &types.Version{BlockNum: 3}},
+					},
+					{
+						Key:      "key2",
+						Value:    make([]byte, 1000),
+						Metadata: &types.Metadata{Version: &types.Version{BlockNum: 3}},
+					},
+				},
+			},
+		}, 3))
+		_, err = env.q.getDataMulti(db1, "user1", []string{"key1", "key2"})
+		require.EqualError(t, err, "response size limit for queries is configured as 1024 bytes but the values of the keys exceed it. Read fewer keys at a time or increase the query response size limit at the server")
+		require.IsType(t, &errors.ServerRestrictionError{}, err)
+	})
+}
+
 func TestGetUser(t *testing.T) {
 	querierUser := &types.User{
 		Id: "querierUser",
@@ -1567,6 +2125,142 @@ func TestGetUser(t *testing.T) {
 	})
 }
 
+func TestGetSessionBootstrap(t *testing.T) {
+	user := &types.User{
+		Id: "user1",
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_Read,
+				"db1":                    types.Privilege_Read,
+				"db2":                    types.Privilege_ReadWrite,
+				// a permission on a database which does not exist is not listed
+				"db5": types.Privilege_ReadWrite,
+			},
+		},
+	}
+	userSerialized, err := proto.Marshal(user)
+	require.NoError(t, err)
+
+	admin := &types.User{
+		Id: "admin1",
+		Privilege: &types.Privilege{
+			Admin: true,
+		},
+	}
+	adminSerialized, err := proto.Marshal(admin)
+	require.NoError(t, err)
+
+	userMetadata := &types.Metadata{
+		Version: &types.Version{
+			BlockNum: 1,
+			TxNum:    0,
+		},
+	}
+
+	setup := func(t *testing.T, env *worldstateQueryProcessorTestEnv) {
+		createDBsAndUsers := map[string]*worldstate.DBUpdates{
+			worldstate.DatabasesDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{Key: "db1"},
+					{Key: "db2"},
+					{Key: stateindex.IndexDB("db2")},
+					{Key: "db3"},
+					{Key: "db4"},
+				},
+			},
+			worldstate.UsersDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      string(identity.UserNamespace) + "user1",
+						Value:    userSerialized,
+						Metadata: userMetadata,
+					},
+					{
+						Key:      string(identity.UserNamespace) + "admin1",
+						Value:    adminSerialized,
+						Metadata: userMetadata,
+					},
+				},
+			},
+		}
+		require.NoError(t, env.db.Commit(createDBsAndUsers, 1))
+
+		env.q.queryProcessingConf = &config.QueryProcessingConf{
+			ResponseSizeLimitInBytes: 4096,
+			HistoricalQueryCostLimit: 1000,
+		}
+		env.q.blockCreationConf = &config.BlockCreationConf{
+			MaxBlockSize:                2 * 1024 * 1024,
+			MaxTransactionCountPerBlock: 10,
+		}
+	}
+
+	expectedLimits := &types.SessionLimits{
+		ResponseSizeLimitInBytes:    4096,
+		HistoricalQueryCostLimit:    1000,
+		MaxBlockSize:                2 * 1024 * 1024,
+		MaxTransactionCountPerBlock: 10,
+	}
+
+	tests := []struct {
+		name             string
+		querierUserID    string
+		expectedUser     *types.User
+		expectedDatabase []*types.DatabaseAccess
+	}{
+		{
+			name:          "user with mixed privileges sees only the databases it can read",
+			querierUserID: "user1",
+			expectedUser:  user,
+			expectedDatabase: []*types.DatabaseAccess{
+				{Name: worldstate.DefaultDBName, Access: types.Privilege_Read},
+				{Name: "db1", Access: types.Privilege_Read},
+				{Name: "db2", Access: types.Privilege_ReadWrite},
+			},
+		},
+		{
+			name:          "admin sees all user databases",
+			querierUserID: "admin1",
+			expectedUser:  admin,
+			expectedDatabase: []*types.DatabaseAccess{
+				{Name: worldstate.DefaultDBName, Access: types.Privilege_ReadWrite},
+				{Name: "db1", Access: types.Privilege_ReadWrite},
+				{Name: "db2", Access: types.Privilege_ReadWrite},
+				{Name: "db3", Access: types.Privilege_ReadWrite},
+				{Name: "db4", Access: types.Privilege_ReadWrite},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newWorldstateQueryProcessorTestEnv(t)
+			defer env.cleanup(t)
+			setup(t, env)
+
+			bootstrap, err := env.q.getSessionBootstrap(tt.querierUserID)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedUser, bootstrap.GetUser()))
+			require.True(t, proto.Equal(userMetadata, bootstrap.GetUserMetadata()))
+			require.Len(t, bootstrap.GetDatabases(), len(tt.expectedDatabase))
+			for i, expected := range tt.expectedDatabase {
+				require.True(t, proto.Equal(expected, bootstrap.GetDatabases()[i]), "expected %v, actual %v", expected, bootstrap.GetDatabases()[i])
+			}
+			require.True(t, proto.Equal(expectedLimits, bootstrap.GetLimits()))
+		})
+	}
+
+	t.Run("unknown querier", func(t *testing.T) {
+		env := newWorldstateQueryProcessorTestEnv(t)
+		defer env.cleanup(t)
+		setup(t, env)
+
+		bootstrap, err := env.q.getSessionBootstrap("user2")
+		require.EqualError(t, err, "the user [user2] does not exist")
+		require.Nil(t, bootstrap)
+	})
+}
+
 func TestGetConfig(t *testing.T) {
 	clusterConfig := &types.ClusterConfig{
 		Nodes: []*types.NodeConfig{
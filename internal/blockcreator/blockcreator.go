@@ -3,12 +3,16 @@
 package blockcreator
 
 import (
+	"time"
+
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
 	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/queue"
 	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
 )
 
 //go:generate counterfeiter -o mocks/replicator.go --fake-name Replicator . Replicator
@@ -18,6 +22,37 @@ type Replicator interface {
 	Submit(block *types.Block) error
 }
 
+// BatchValidator validates the transactions of a block against the committed state
+type BatchValidator interface {
+	ValidateBlock(block *types.Block) ([]*types.ValidationInfo, error)
+}
+
+// InvalidBatchPolicy decides what becomes of a batch of data transactions which are all invalid
+type InvalidBatchPolicy string
+
+const (
+	// InvalidBatchCommit commits the batch in a block, as any other batch, hence, each invalid transaction has a
+	// receipt, whose block header holds the reason the transaction is invalid
+	InvalidBatchCommit InvalidBatchPolicy = "commit"
+	// InvalidBatchDrop validates the batch before a block is created out of it, and drops the batch if all its
+	// transactions are invalid, hence, the batch consumes no block number. An invalid transaction of a dropped batch
+	// has no receipt: its rejection is reported by the receipt query, as long as the node keeps it in memory, and by
+	// the dead-letter record of the transaction.
+	InvalidBatchDrop InvalidBatchPolicy = "drop"
+)
+
+// ParseInvalidBatchPolicy parses the policy configured at the server. The commit policy is the default.
+func ParseInvalidBatchPolicy(policy string) (InvalidBatchPolicy, error) {
+	switch InvalidBatchPolicy(policy) {
+	case "", InvalidBatchCommit:
+		return InvalidBatchCommit, nil
+	case InvalidBatchDrop:
+		return InvalidBatchDrop, nil
+	default:
+		return "", errors.Errorf("unsupported invalid batch policy [%s], expected either [%s] or [%s]", policy, InvalidBatchCommit, InvalidBatchDrop)
+	}
+}
+
 // BlockCreator uses transactions batch queue to construct a block proposal and submits the proposed block to the
 // block-replicator. The block-replicator is in charge of numbering the blocks and setting the previous
 // BlockHeaderBase hash.
@@ -27,6 +62,10 @@ type BlockCreator struct {
 	pendingTxs         *queue.PendingTxs
 	nextProposalNumber uint64 // this numbers the local blocks proposed throughout the life cycle of the node
 	blockStore         *blockstore.Store
+	invalidBatchPolicy InvalidBatchPolicy
+	validator          BatchValidator
+	// lastBlockTxIDs holds the IDs of the transactions of the last block submitted to the block-replicator
+	lastBlockTxIDs []string
 
 	started chan struct{}
 	stop    chan struct{}
@@ -41,17 +80,29 @@ type Config struct {
 	TxBatchQueue *queue.Queue
 	BlockStore   *blockstore.Store
 	PendingTxs   *queue.PendingTxs
-	Logger       *logger.SugarLogger
+	// InvalidBatchPolicy decides whether a batch of data transactions which are all invalid is committed in a block
+	// or dropped. The empty policy stands for InvalidBatchCommit.
+	InvalidBatchPolicy InvalidBatchPolicy
+	// Validator validates the batches of data transactions before the blocks are created out of them, and is
+	// required by the InvalidBatchDrop policy only
+	Validator BatchValidator
+	Logger    *logger.SugarLogger
 }
 
 // New creates a new block assembler
 func New(conf *Config) (*BlockCreator, error) {
+	if conf.InvalidBatchPolicy == InvalidBatchDrop && conf.Validator == nil {
+		return nil, errors.Errorf("the invalid batch policy [%s] requires a validator", InvalidBatchDrop)
+	}
+
 	return &BlockCreator{
 		txBatchQueue:       conf.TxBatchQueue,
 		nextProposalNumber: 1,
 		logger:             conf.Logger,
 		blockStore:         conf.BlockStore,
 		pendingTxs:         conf.PendingTxs,
+		invalidBatchPolicy: conf.InvalidBatchPolicy,
+		validator:          conf.Validator,
 		started:            make(chan struct{}),
 		stop:               make(chan struct{}),
 		stopped:            make(chan struct{}),
@@ -108,6 +159,9 @@ func (b *BlockCreator) Start() {
 
 			switch batch := txBatch.(type) {
 			case *types.Block_DataTxEnvelopes:
+				if b.dropIfAllInvalid(batch) {
+					continue
+				}
 				block.Payload = batch
 				b.logger.Debugf("created block %d with %d data transactions\n",
 					blkNum,
@@ -118,6 +172,13 @@ func (b *BlockCreator) Start() {
 				block.Payload = batch
 				b.logger.Debugf("created block %d with an user administrative transaction", blkNum)
 
+			case *types.Block_UserAdministrationTxEnvelopes:
+				block.Payload = batch
+				b.logger.Debugf("created block %d with %d user administrative transactions\n",
+					blkNum,
+					len(batch.UserAdministrationTxEnvelopes.Envelopes),
+				)
+
 			case *types.Block_ConfigTxEnvelope:
 				block.Payload = batch
 				b.logger.Debugf("created block %d with a cluster config administrative transaction", blkNum)
@@ -125,6 +186,20 @@ func (b *BlockCreator) Start() {
 			case *types.Block_DbAdministrationTxEnvelope:
 				block.Payload = batch
 				b.logger.Debugf("created block %d with a DB administrative transaction", blkNum)
+
+			case *types.Block_HeartbeatTxEnvelopes:
+				block.Payload = batch
+				b.logger.Debugf("created block %d with %d heartbeat transactions\n",
+					blkNum,
+					len(batch.HeartbeatTxEnvelopes.Envelopes),
+				)
+
+			case *types.Block_VoidTxEnvelopes:
+				block.Payload = batch
+				b.logger.Debugf("created block %d with %d void transactions\n",
+					blkNum,
+					len(batch.VoidTxEnvelopes.Envelopes),
+				)
 			}
 
 			err := b.blockReplicator.Submit(block)
@@ -152,11 +227,74 @@ func (b *BlockCreator) Start() {
 				b.logger.Panicf("block submission to block-replicator failed: %v", err)
 			}
 
+			if b.invalidBatchPolicy == InvalidBatchDrop {
+				b.lastBlockTxIDs, _ = utils.BlockPayloadToTxIDs(block.Payload)
+			}
 			b.nextProposalNumber++
 		}
 	}
 }
 
+// dropIfAllInvalid validates the batch against the committed state under the InvalidBatchDrop policy, and returns
+// true if all its transactions are invalid, in which case they are released with their rejection and no block is
+// created. The batch is validated only while no block submitted by the block creator is in flight, i.e., once the
+// transactions of the last submitted block are no longer pending, as a transaction invalid against the committed
+// state may be valid against the state left by a block in flight. Otherwise, and whenever the validation fails, the
+// block is created, and the transactions are validated once the block is ordered, as under the commit policy.
+func (b *BlockCreator) dropIfAllInvalid(batch *types.Block_DataTxEnvelopes) bool {
+	if b.invalidBatchPolicy != InvalidBatchDrop || b.blockInFlight() {
+		return false
+	}
+
+	height, err := b.blockStore.Height()
+	if err != nil {
+		b.logger.Errorf("error while fetching the ledger height, the batch is not validated before the block is created: %s", err)
+		return false
+	}
+	// the header holds the fields the validation depends on, which are set the same way once the block is ordered
+	candidate := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number:       height + 1,
+				Timestamp:    time.Now().UnixNano(),
+				RulesVersion: constants.RulesVersion,
+			},
+		},
+		Payload: batch,
+	}
+	valInfo, err := b.validator.ValidateBlock(candidate)
+	if err != nil {
+		b.logger.Errorf("error while validating the batch, the batch is not validated before the block is created: %s", err)
+		return false
+	}
+	for _, v := range valInfo {
+		if v.GetFlag() == types.Flag_VALID {
+			return false
+		}
+	}
+
+	txIDs, err := utils.BlockPayloadToTxIDs(batch)
+	if err != nil {
+		b.logger.Errorf("failed to extract TXIDs from the batch: %s", err)
+		return false
+	}
+	b.logger.Infof("dropping a batch of [%d] data transactions, as all of them are invalid: %v", len(txIDs), txIDs)
+	b.pendingTxs.ReleaseRejected(txIDs, valInfo)
+
+	return true
+}
+
+// blockInFlight returns true if a transaction of the last block submitted to the block-replicator is still pending,
+// i.e., the block is neither committed nor dropped
+func (b *BlockCreator) blockInFlight() bool {
+	for _, txID := range b.lastBlockTxIDs {
+		if b.pendingTxs.Has(txID) {
+			return true
+		}
+	}
+	return false
+}
+
 // WaitTillStart waits till the block creator is started
 func (b *BlockCreator) WaitTillStart() {
 	<-b.started
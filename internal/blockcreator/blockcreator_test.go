@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -34,6 +35,10 @@ type testEnv struct {
 }
 
 func newTestEnv(t *testing.T) *testEnv {
+	return newTestEnvWithPolicy(t, "", nil)
+}
+
+func newTestEnvWithPolicy(t *testing.T, policy blockcreator.InvalidBatchPolicy, validator blockcreator.BatchValidator) *testEnv {
 	c := &logger.Config{
 		Level:         "debug",
 		OutputPath:    []string{"stdout"},
@@ -77,10 +82,12 @@ func newTestEnv(t *testing.T) *testEnv {
 	txBatchQ := queue.New(10)
 	pendingTxs := queue.NewPendingTxs(logger)
 	b, err := blockcreator.New(&blockcreator.Config{
-		TxBatchQueue: txBatchQ,
-		PendingTxs:   pendingTxs,
-		Logger:       logger,
-		BlockStore:   blockStore,
+		TxBatchQueue:       txBatchQ,
+		PendingTxs:         pendingTxs,
+		Logger:             logger,
+		BlockStore:         blockStore,
+		InvalidBatchPolicy: policy,
+		Validator:          validator,
 	})
 	require.NoError(t, err)
 
@@ -344,8 +351,18 @@ func TestBlockCreator_ReleaseAsync(t *testing.T) {
 		LeaderHostPort: "10.10.10.10:1111",
 	})
 
+	var lock sync.Mutex
+	dropped := make(map[string]*types.DroppedTx)
+	testEnv.pendingTxs.SetDropRecorder(func(drops []*types.DroppedTx) {
+		lock.Lock()
+		defer lock.Unlock()
+		for _, d := range drops {
+			dropped[d.GetTxId()] = d
+		}
+	})
+
 	for i := 1; i < 6; i++ {
-		testEnv.pendingTxs.Add(fmt.Sprintf("txid:%d", i), nil)
+		testEnv.pendingTxs.Add(fmt.Sprintf("txid:%d", i), "alice", nil)
 	}
 
 	for _, txBatch := range txBatches {
@@ -356,6 +373,17 @@ func TestBlockCreator_ReleaseAsync(t *testing.T) {
 		return testEnv.pendingTxs.Empty()
 	}
 	require.Eventually(t, allReleased, 2*time.Second, 10*time.Millisecond)
+
+	// the async transactions, which have no one to redirect, are dead-lettered
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(t, dropped, 5)
+	for i := 1; i < 6; i++ {
+		d := dropped[fmt.Sprintf("txid:%d", i)]
+		require.NotNil(t, d)
+		require.Equal(t, "alice", d.GetSubmitter())
+		require.Equal(t, "not a leader, leader is RaftID: 1, with HostPort: 10.10.10.10:1111", d.GetReason())
+	}
 }
 
 func TestBlockCreator_ReleaseSync(t *testing.T) {
@@ -371,7 +399,7 @@ func TestBlockCreator_ReleaseSync(t *testing.T) {
 	wg.Add(5)
 	for i := 1; i < 6; i++ {
 		promise := queue.NewCompletionPromise(5 * time.Second)
-		testEnv.pendingTxs.Add(fmt.Sprintf("txid:%d", i), promise)
+		testEnv.pendingTxs.Add(fmt.Sprintf("txid:%d", i), "", promise)
 		go func() {
 			receipt, err := promise.Wait()
 			require.Nil(t, receipt)
@@ -390,3 +418,122 @@ func TestBlockCreator_ReleaseSync(t *testing.T) {
 	require.Eventually(t, allReleased, 2*time.Second, 10*time.Millisecond)
 	wg.Wait()
 }
+
+// prefixValidator finds valid the transactions whose ID begins with "valid", and counts the blocks it validates
+type prefixValidator struct {
+	mu     sync.Mutex
+	blocks []*types.Block
+}
+
+func (v *prefixValidator) ValidateBlock(block *types.Block) ([]*types.ValidationInfo, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.blocks = append(v.blocks, block)
+
+	var valInfo []*types.ValidationInfo
+	for _, env := range block.GetDataTxEnvelopes().GetEnvelopes() {
+		if strings.HasPrefix(env.GetPayload().GetTxId(), "valid") {
+			valInfo = append(valInfo, &types.ValidationInfo{Flag: types.Flag_VALID})
+		} else {
+			valInfo = append(valInfo, &types.ValidationInfo{Flag: types.Flag_INVALID_NO_PERMISSION, ReasonIfInvalid: "no permission"})
+		}
+	}
+	return valInfo, nil
+}
+
+func (v *prefixValidator) validated() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return len(v.blocks)
+}
+
+func TestBlockCreator_InvalidBatchPolicy(t *testing.T) {
+	dataTxBatch := func(txIDs ...string) *types.Block_DataTxEnvelopes {
+		batch := &types.Block_DataTxEnvelopes{DataTxEnvelopes: &types.DataTxEnvelopes{}}
+		for _, txID := range txIDs {
+			batch.DataTxEnvelopes.Envelopes = append(batch.DataTxEnvelopes.Envelopes, &types.DataTxEnvelope{
+				Payload: &types.DataTx{TxId: txID},
+			})
+		}
+		return batch
+	}
+
+	t.Run("commit", func(t *testing.T) {
+		validator := &prefixValidator{}
+		testEnv := newTestEnvWithPolicy(t, blockcreator.InvalidBatchCommit, validator)
+		defer testEnv.cleanup()
+
+		testEnv.pendingTxs.Add("invalid1", "alice", nil)
+		testEnv.txBatchQueue.Enqueue(dataTxBatch("invalid1"))
+		require.Eventually(t, func() bool { return testEnv.mockReplicator.SubmitCallCount() == 1 }, 2*time.Second, 10*time.Millisecond)
+		require.Zero(t, validator.validated())
+		require.True(t, testEnv.pendingTxs.Has("invalid1"))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		validator := &prefixValidator{}
+		testEnv := newTestEnvWithPolicy(t, blockcreator.InvalidBatchDrop, validator)
+		defer testEnv.cleanup()
+
+		// a batch whose transactions are all invalid is dropped, and its transactions are released with their rejection
+		promise := queue.NewCompletionPromise(5 * time.Second)
+		testEnv.pendingTxs.Add("invalid1", "alice", promise)
+		testEnv.pendingTxs.Add("invalid2", "bob", nil)
+		testEnv.txBatchQueue.Enqueue(dataTxBatch("invalid1", "invalid2"))
+
+		receipt, err := promise.Wait()
+		require.Nil(t, receipt)
+		require.EqualError(t, err, "the transaction was rejected before it was included in a block, as it is invalid, flag: INVALID_NO_PERMISSION, reason: no permission")
+		require.IsType(t, &ierrors.TxRejectedError{}, err)
+		require.Eventually(t, testEnv.pendingTxs.Empty, 2*time.Second, 10*time.Millisecond)
+		require.Equal(t, types.Flag_INVALID_NO_PERMISSION, testEnv.pendingTxs.Rejection("invalid2").GetFlag())
+		require.Equal(t, 1, validator.validated())
+		require.Equal(t, uint64(1), validator.blocks[0].GetHeader().GetBaseHeader().GetNumber())
+		require.Zero(t, testEnv.mockReplicator.SubmitCallCount())
+
+		// a batch that holds a valid transaction is submitted
+		testEnv.pendingTxs.Add("valid1", "alice", nil)
+		testEnv.pendingTxs.Add("invalid3", "alice", nil)
+		testEnv.txBatchQueue.Enqueue(dataTxBatch("valid1", "invalid3"))
+		require.Eventually(t, func() bool { return testEnv.mockReplicator.SubmitCallCount() == 1 }, 2*time.Second, 10*time.Millisecond)
+		require.Equal(t, 2, validator.validated())
+		require.Nil(t, testEnv.pendingTxs.Rejection("invalid3"))
+
+		// while the block is in flight, an invalid batch is submitted without being validated, as it may be valid
+		// against the state the block leaves
+		testEnv.pendingTxs.Add("invalid4", "alice", nil)
+		testEnv.txBatchQueue.Enqueue(dataTxBatch("invalid4"))
+		require.Eventually(t, func() bool { return testEnv.mockReplicator.SubmitCallCount() == 2 }, 2*time.Second, 10*time.Millisecond)
+		require.Equal(t, 2, validator.validated())
+		require.True(t, testEnv.pendingTxs.Has("invalid4"))
+
+		// once the blocks in flight are committed, an invalid batch is dropped again
+		testEnv.pendingTxs.DoneWithReceipt([]string{"valid1", "invalid3"}, &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: 1}})
+		testEnv.pendingTxs.DoneWithReceipt([]string{"invalid4"}, &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: 2}})
+		testEnv.pendingTxs.Add("invalid5", "alice", nil)
+		testEnv.txBatchQueue.Enqueue(dataTxBatch("invalid5"))
+		require.Eventually(t, func() bool { return testEnv.pendingTxs.Rejection("invalid5") != nil }, 2*time.Second, 10*time.Millisecond)
+		require.Equal(t, 3, validator.validated())
+		require.Equal(t, 2, testEnv.mockReplicator.SubmitCallCount())
+	})
+
+	t.Run("drop requires a validator", func(t *testing.T) {
+		_, err := blockcreator.New(&blockcreator.Config{InvalidBatchPolicy: blockcreator.InvalidBatchDrop})
+		require.EqualError(t, err, "the invalid batch policy [drop] requires a validator")
+	})
+}
+
+func TestParseInvalidBatchPolicy(t *testing.T) {
+	for policy, expected := range map[string]blockcreator.InvalidBatchPolicy{
+		"":       blockcreator.InvalidBatchCommit,
+		"commit": blockcreator.InvalidBatchCommit,
+		"drop":   blockcreator.InvalidBatchDrop,
+	} {
+		p, err := blockcreator.ParseInvalidBatchPolicy(policy)
+		require.NoError(t, err)
+		require.Equal(t, expected, p)
+	}
+
+	_, err := blockcreator.ParseInvalidBatchPolicy("discard")
+	require.EqualError(t, err, "unsupported invalid batch policy [discard], expected either [commit] or [drop]")
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// DefaultMaxCoalescedBlocks is the maximal number of blocks whose state database updates are grouped into a single
+// write, when no other limit is configured.
+const DefaultMaxCoalescedBlocks = 16
+
+// coalescedUpdates holds the state database updates of consecutive blocks, which were committed to the block store,
+// the provenance store, and the state trie, but not yet to the state database. The blocks never write or delete the
+// same key, hence, the updates of the blocks are merged by concatenation, and each write keeps the version of its own
+// block. The whole group is written by a single commit at the number of its last block, such that the height of the
+// state database moves from the block before the group to the last block of the group at once. After a crash, the
+// recovery replays the blocks above the height of the state database, as it does for a single block.
+type coalescedUpdates struct {
+	firstBlockNum uint64
+	lastBlockNum  uint64
+	blocks        int
+	dbsUpdates    map[string]*worldstate.DBUpdates
+	// modifiedKeys holds the composite keys that are written, deleted, or patched by the blocks in the group
+	modifiedKeys map[string]struct{}
+}
+
+func newCoalescedUpdates() *coalescedUpdates {
+	return &coalescedUpdates{
+		dbsUpdates:   make(map[string]*worldstate.DBUpdates),
+		modifiedKeys: make(map[string]struct{}),
+	}
+}
+
+func (c *coalescedUpdates) add(block *types.Block, dbsUpdates map[string]*worldstate.DBUpdates) {
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+	if c.blocks == 0 {
+		c.firstBlockNum = blockNum
+	}
+	c.lastBlockNum = blockNum
+	c.blocks++
+
+	for dbName, updates := range dbsUpdates {
+		merged, ok := c.dbsUpdates[dbName]
+		if !ok {
+			merged = &worldstate.DBUpdates{}
+			c.dbsUpdates[dbName] = merged
+		}
+		merged.Writes = append(merged.Writes, updates.Writes...)
+		merged.Deletes = append(merged.Deletes, updates.Deletes...)
+	}
+
+	// the keys of the invalid transactions are recorded too, which is conservative but keeps the check cheap
+	for _, txEnv := range block.GetDataTxEnvelopes().GetEnvelopes() {
+		if key, ok := sequenceKey(txEnv.GetPayload()); ok {
+			c.modifiedKeys[key] = struct{}{}
+		}
+		for _, ops := range txEnv.GetPayload().GetDbOperations() {
+			for _, w := range ops.GetDataWrites() {
+				c.modifiedKeys[constructCompositeKey(ops.GetDbName(), w.GetKey())] = struct{}{}
+			}
+			for _, d := range ops.GetDataDeletes() {
+				c.modifiedKeys[constructCompositeKey(ops.GetDbName(), d.GetKey())] = struct{}{}
+			}
+			for _, p := range ops.GetDataPatches() {
+				c.modifiedKeys[constructCompositeKey(ops.GetDbName(), p.GetKey())] = struct{}{}
+			}
+		}
+	}
+}
+
+// independentOf returns true if the given data block neither reads, writes, deletes, nor patches a key that is modified by
+// the blocks in the group, nor carries an ordered transaction of a user whose sequence number is advanced by the
+// group. The validation and the construction of the updates of such a block do not depend on the updates held by the
+// group, and can run against the state database as is.
+func (c *coalescedUpdates) independentOf(block *types.Block) bool {
+	touches := func(dbName, key string) bool {
+		_, ok := c.modifiedKeys[constructCompositeKey(dbName, key)]
+		return ok
+	}
+
+	for _, txEnv := range block.GetDataTxEnvelopes().GetEnvelopes() {
+		// the sequence number of an ordered transaction is validated against the last one of its user
+		if key, ok := sequenceKey(txEnv.GetPayload()); ok {
+			if _, modified := c.modifiedKeys[key]; modified {
+				return false
+			}
+		}
+		for _, ops := range txEnv.GetPayload().GetDbOperations() {
+			// a range delete reads an unbounded set of keys
+			if len(ops.GetDataDeleteRanges()) > 0 {
+				return false
+			}
+			for _, r := range ops.GetDataReads() {
+				if touches(ops.GetDbName(), r.GetKey()) {
+					return false
+				}
+			}
+			for _, w := range ops.GetDataWrites() {
+				if touches(ops.GetDbName(), w.GetKey()) {
+					return false
+				}
+			}
+			for _, d := range ops.GetDataDeletes() {
+				if touches(ops.GetDbName(), d.GetKey()) {
+					return false
+				}
+			}
+			// a patch is applied to the committed value of its key
+			for _, p := range ops.GetDataPatches() {
+				if touches(ops.GetDbName(), p.GetKey()) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func constructCompositeKey(dbName, key string) string {
+	return dbName + "~" + key
+}
+
+// sequenceKey returns the composite key of the last sequence number of the user of an ordered transaction
+func sequenceKey(tx *types.DataTx) (string, bool) {
+	if tx.GetSequence() == 0 || len(tx.GetMustSignUserIds()) == 0 {
+		return "", false
+	}
+	return constructCompositeKey(worldstate.UsersDBName, string(identity.SequenceNamespace)+tx.GetMustSignUserIds()[0]), true
+}
+
+// canCoalesce returns true if the state database updates of the block can be added to the coalesced updates of the
+// committer. Coalescing applies only while the replication layer holds a backlog of blocks, and only to data blocks
+// that are independent of the blocks already in the group. A locally created block, or a block that carries a
+// transaction submitted to this node, is never coalesced, as the receipt of its transactions must be followed by
+// reads of the state they produced.
+func (b *BlockProcessor) canCoalesce(blockWithOrigin *queue.BlockWithOrigin) bool {
+	if b.coalesceBacklogThreshold == 0 ||
+		blockWithOrigin.Origin == queue.BlockOriginLocal ||
+		blockWithOrigin.Backlog < b.coalesceBacklogThreshold {
+		return false
+	}
+
+	block := blockWithOrigin.Block
+	if block.GetDataTxEnvelopes() == nil {
+		return false
+	}
+
+	if b.pendingTxs != nil {
+		for _, txEnv := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			if b.pendingTxs.Has(txEnv.GetPayload().GetTxId()) {
+				return false
+			}
+		}
+	}
+
+	coalesced := b.committer.coalesced
+	return coalesced == nil || (coalesced.blocks < b.maxCoalescedBlocks && coalesced.independentOf(block))
+}
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockprocessor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalescedUpdates(t *testing.T) {
+	t.Parallel()
+
+	block := func(blockNumber uint64, ops ...*types.DBOperation) *types.Block {
+		b := createSampleBlock(blockNumber, nil)
+		b.GetDataTxEnvelopes().Envelopes = []*types.DataTxEnvelope{{Payload: &types.DataTx{DbOperations: ops}}}
+		return b
+	}
+
+	c := newCoalescedUpdates()
+	c.add(
+		block(5, &types.DBOperation{
+			DbName:      "db1",
+			DataWrites:  []*types.DataWrite{{Key: "key1"}},
+			DataDeletes: []*types.DataDelete{{Key: "key2"}},
+		}),
+		map[string]*worldstate.DBUpdates{
+			"db1": {
+				Writes:  []*worldstate.KVWithMetadata{{Key: "key1"}},
+				Deletes: []string{"key2"},
+			},
+		},
+	)
+	c.add(
+		block(6, &types.DBOperation{
+			DbName:      "db2",
+			DataWrites:  []*types.DataWrite{{Key: "key1"}},
+			DataPatches: []*types.DataPatch{{Key: "key4"}},
+		}),
+		map[string]*worldstate.DBUpdates{
+			"db1": {Writes: []*worldstate.KVWithMetadata{{Key: "key3"}}},
+			"db2": {Writes: []*worldstate.KVWithMetadata{{Key: "key1"}, {Key: "key4"}}},
+		},
+	)
+
+	require.Equal(t, uint64(5), c.firstBlockNum)
+	require.Equal(t, uint64(6), c.lastBlockNum)
+	require.Equal(t, 2, c.blocks)
+	require.Equal(t,
+		map[string]*worldstate.DBUpdates{
+			"db1": {
+				Writes:  []*worldstate.KVWithMetadata{{Key: "key1"}, {Key: "key3"}},
+				Deletes: []string{"key2"},
+			},
+			"db2": {Writes: []*worldstate.KVWithMetadata{{Key: "key1"}, {Key: "key4"}}},
+		},
+		c.dbsUpdates,
+	)
+
+	tests := []struct {
+		name        string
+		op          *types.DBOperation
+		independent bool
+	}{
+		{
+			name:        "untouched key",
+			op:          &types.DBOperation{DbName: "db1", DataReads: []*types.DataRead{{Key: "key3"}}},
+			independent: true,
+		},
+		{
+			name:        "same key in another database",
+			op:          &types.DBOperation{DbName: "db3", DataWrites: []*types.DataWrite{{Key: "key1"}}},
+			independent: true,
+		},
+		{
+			name: "read of a written key",
+			op:   &types.DBOperation{DbName: "db1", DataReads: []*types.DataRead{{Key: "key1"}}},
+		},
+		{
+			name: "write of a deleted key",
+			op:   &types.DBOperation{DbName: "db1", DataWrites: []*types.DataWrite{{Key: "key2"}}},
+		},
+		{
+			name: "delete of a written key",
+			op:   &types.DBOperation{DbName: "db2", DataDeletes: []*types.DataDelete{{Key: "key1"}}},
+		},
+		{
+			name: "read of a patched key",
+			op:   &types.DBOperation{DbName: "db2", DataReads: []*types.DataRead{{Key: "key4"}}},
+		},
+		{
+			name: "patch of a written key",
+			op:   &types.DBOperation{DbName: "db1", DataPatches: []*types.DataPatch{{Key: "key1"}}},
+		},
+		{
+			name: "range delete",
+			op: &types.DBOperation{
+				DbName:           "db3",
+				DataDeleteRanges: []*types.DataDeleteRange{{StartKey: "a", EndKey: "b"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.independent, c.independentOf(block(7, tt.op)), tt.name)
+	}
+
+	// an ordered transaction depends on the last sequence number of its user, which the group may advance
+	ordered := func(blockNumber uint64, userID string) *types.Block {
+		b := createSampleBlock(blockNumber, nil)
+		b.GetDataTxEnvelopes().Envelopes = []*types.DataTxEnvelope{
+			{Payload: &types.DataTx{MustSignUserIds: []string{userID}, Sequence: blockNumber}},
+		}
+		return b
+	}
+	require.True(t, c.independentOf(ordered(7, "user1")))
+	c.add(ordered(7, "user1"), nil)
+	require.False(t, c.independentOf(ordered(8, "user1")))
+	require.True(t, c.independentOf(ordered(8, "user2")))
+}
+
+func TestBlockProcessor_CoalescedCommits(t *testing.T) {
+	t.Parallel()
+
+	const (
+		blockCount         = 500
+		maxCoalescedBlocks = 8
+	)
+
+	type committedState struct {
+		values      map[string][]byte
+		metadata    map[string]*types.Metadata
+		validation  [][]*types.ValidationInfo
+		stateCommit int
+	}
+
+	// replay commits the same sequence of catch-up blocks, each of which reports the blocks still waiting behind it,
+	// and returns the resulting state. Every 7th block writes a hot key that is also written by its neighbours, so that
+	// the group is flushed early on a conflict too.
+	replay := func(t *testing.T, coalesce bool) *committedState {
+		env := newTestEnvWithConfig(t, func(c *Config) {
+			if coalesce {
+				c.CoalesceBacklogThreshold = 1
+				c.MaxCoalescedBlocks = maxCoalescedBlocks
+			}
+		})
+		defer env.cleanup(true)
+
+		setup(t, env)
+		commitsBefore := env.db.Calls("Commit")
+
+		keys := make(map[string]struct{})
+		for i := 0; i < blockCount; i++ {
+			blockNumber := uint64(i + 2)
+			key := fmt.Sprintf("key%d", i%50)
+			if i%7 == 0 || i%7 == 1 {
+				key = "hot-key"
+			}
+			keys[key] = struct{}{}
+
+			txID := fmt.Sprintf("dataTx%d", blockNumber)
+			block := createSampleBlock(blockNumber, createSampleTx(t, txID, []string{key}, [][]byte{[]byte(txID)}, env.userSigner))
+			blockWithOrigin := queue.NewBlockWithOrigin(block, queue.BlockOriginCatchUp, "node2")
+			blockWithOrigin.Backlog = uint64(blockCount - i - 1)
+
+			reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(blockWithOrigin)
+			require.NoError(t, err)
+			require.Nil(t, reply)
+
+			// the block store is committed per block, while the state database trails by less than a group
+			blockStoreHeight, err := env.blockStore.Height()
+			require.NoError(t, err)
+			require.Equal(t, blockNumber, blockStoreHeight)
+
+			stateHeight, err := env.db.Height()
+			require.NoError(t, err)
+			require.LessOrEqual(t, stateHeight, blockNumber)
+			require.Less(t, blockNumber-stateHeight, uint64(maxCoalescedBlocks))
+			if !coalesce {
+				require.Equal(t, blockNumber, stateHeight)
+			}
+		}
+
+		// the last block carries no backlog and hence, the state database has caught up with the block store
+		stateHeight, err := env.db.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(blockCount+1), stateHeight)
+
+		state := &committedState{
+			values:      make(map[string][]byte),
+			metadata:    make(map[string]*types.Metadata),
+			stateCommit: env.db.Calls("Commit") - commitsBefore,
+		}
+		for key := range keys {
+			value, metadata, err := env.db.Get(worldstate.DefaultDBName, key)
+			require.NoError(t, err)
+			state.values[key] = value
+			state.metadata[key] = metadata
+		}
+		for blockNumber := uint64(1); blockNumber <= blockCount+1; blockNumber++ {
+			block, err := env.blockStore.Get(blockNumber)
+			require.NoError(t, err)
+			state.validation = append(state.validation, block.GetHeader().GetValidationInfo())
+		}
+
+		return state
+	}
+
+	expected := replay(t, false)
+	actual := replay(t, true)
+
+	require.Equal(t, expected.values, actual.values)
+	require.Len(t, actual.metadata, len(expected.metadata))
+	for key, metadata := range expected.metadata {
+		require.True(t, proto.Equal(metadata, actual.metadata[key]), key)
+	}
+	require.Len(t, actual.validation, len(expected.validation))
+	for i, validationInfo := range expected.validation {
+		require.Len(t, actual.validation[i], len(validationInfo))
+		for j := range validationInfo {
+			require.True(t, proto.Equal(validationInfo[j], actual.validation[i][j]))
+		}
+	}
+
+	require.Equal(t, blockCount, expected.stateCommit)
+	require.Less(t, actual.stateCommit, expected.stateCommit/2)
+}
@@ -4,16 +4,23 @@
 package blockprocessor
 
 import (
+	"bytes"
 	"encoding/json"
+	"sort"
+	"sync"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/jsonpatch"
 	"github.com/hyperledger-labs/orion-server/internal/mptrie"
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
 	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
 	"github.com/hyperledger-labs/orion-server/pkg/state"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
@@ -31,7 +38,22 @@ type committer struct {
 	provenanceStore *provenance.Store
 	stateTrieStore  mptrie.Store
 	stateTrie       *mptrie.MPTrie // may be nil when MPTrie disabled
-	logger          *logger.SugarLogger
+	// coalesced holds the state database updates of the blocks whose commit to the state database is deferred, if any
+	coalesced *coalescedUpdates
+	// producerMetadata is recorded along with every block committed to the block store
+	producerMetadata *blockstore.ProducerMetadata
+	// signer, if not nil, signs the header of every block committed to the block store on behalf of the node nodeID,
+	// and the signature is recorded in the producer metadata of the block
+	signer crypto.Signer
+	nodeID string
+	// recordStateDeltas records the state delta of every block committed to the block store
+	recordStateDeltas bool
+	// isolateFailedDBs commits the updates of each user database on its own, so that a failed commit to one of them
+	// is skipped and recorded instead of failing the commit of the block
+	isolateFailedDBs bool
+	// stateDBMu serializes the commits to the state database with the resyncs of the unavailable databases
+	stateDBMu sync.Mutex
+	logger    *logger.SugarLogger
 }
 
 func newCommitter(conf *Config) *committer {
@@ -40,15 +62,77 @@ func newCommitter(conf *Config) *committer {
 		blockStore:      conf.BlockStore,
 		provenanceStore: conf.ProvenanceStore,
 		stateTrieStore:  conf.StateTrieStore,
-		logger:          conf.Logger,
+		producerMetadata: &blockstore.ProducerMetadata{
+			DebugDeterministic: executionMode(conf).Deterministic,
+		},
+		signer:            conf.Signer,
+		nodeID:            conf.NodeID,
+		recordStateDeltas: conf.RecordStateDeltas,
+		isolateFailedDBs:  conf.IsolateFailedDBs,
+		logger:            conf.Logger,
 	}
 }
 
 func (c *committer) commitBlock(block *types.Block) error {
+	_, err := c.commit(block, false, nil, nil, nil)
+	return err
+}
+
+// commitBlockCoalesced commits the block as commitBlock does, except that its state database updates are added to
+// the coalesced updates, which are written by flushCoalesced. The block must be independent of the blocks already
+// coalesced.
+func (c *committer) commitBlockCoalesced(block *types.Block) error {
+	_, err := c.commit(block, true, nil, nil, nil)
+	return err
+}
+
+// flushCoalesced writes the coalesced updates, if any, to the state database with a single commit.
+func (c *committer) flushCoalesced() error {
+	if c.coalesced == nil {
+		return nil
+	}
+
+	coalesced := c.coalesced
+	c.coalesced = nil
+	c.logger.Debugf("committing the coalesced updates of blocks [%d, %d] to the state database", coalesced.firstBlockNum, coalesced.lastBlockNum)
+	if err := c.commitRangeToStateDB(coalesced.firstBlockNum, coalesced.lastBlockNum, coalesced.dbsUpdates); err != nil {
+		return errors.WithMessagef(err, "error while committing the coalesced updates of blocks [%d, %d]", coalesced.firstBlockNum, coalesced.lastBlockNum)
+	}
+
+	return nil
+}
+
+// commit commits the block to the stores, and returns the state delta of the block. If prepared is not nil, it holds
+// the block serialized but for its header, which is then the only part left to serialize. If verifyDigests is not nil, it is
+// called with the state digests of the databases updated by the block, before the state trie is updated. If
+// verifyHeader is not nil, it is called once the header of the block is complete. Both are called before anything is
+// committed, and an error either returns aborts the commit. The state trie then holds the updates of the aborted
+// block, if any, and must be reloaded.
+func (c *committer) commit(block *types.Block, coalesce bool, prepared *blockstore.PreparedBlock, verifyDigests func(map[string][]byte) error, verifyHeader func(*types.BlockHeader) error) (*types.StateDelta, error) {
+	// a block that is not coalesced is committed on top of the complete state
+	if !coalesce {
+		if err := c.flushCoalesced(); err != nil {
+			return nil, err
+		}
+	}
+
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+
 	// Calculate expected changes to world state db and provenance db
 	dbsUpdates, provenanceData, err := c.constructDBAndProvenanceEntries(block)
 	if err != nil {
-		return errors.WithMessagef(err, "error while constructing database and provenance entries for block %d", block.GetHeader().GetBaseHeader().GetNumber())
+		return nil, errors.WithMessagef(err, "error while constructing database and provenance entries for block %d", blockNum)
+	}
+
+	// The state digests are cheap to compute, hence, they are compared before any work on the state trie
+	digests, err := c.computeDigests(blockNum, dbsUpdates)
+	if err != nil {
+		return nil, err
+	}
+	if verifyDigests != nil {
+		if err := verifyDigests(digests); err != nil {
+			return nil, err
+		}
 	}
 
 	// Update state trie with expected world state db changes
@@ -65,38 +149,130 @@ func (c *committer) commitBlock(block *types.Block) error {
 	// Update block with state trie root
 	block.Header.StateMerkelTreeRootHash = stateTrieRootHash
 
+	txSizes, err := computeTxSizes(c.db, block)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while computing the sizes of the transactions of block %d", blockNum)
+	}
+	if block.Header.Cumulative, err = c.cumulativeCounters(block, txSizes); err != nil {
+		return nil, errors.WithMessagef(err, "error while computing the cumulative counters of block %d", blockNum)
+	}
+
+	if verifyHeader != nil {
+		if err := verifyHeader(block.GetHeader()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Commit block to block store
-	if err := c.commitToBlockStore(block); err != nil {
-		return errors.WithMessagef(
+	if prepared == nil {
+		if prepared, err = blockstore.PrepareBlock(block); err != nil {
+			return nil, err
+		}
+	}
+	prepared.SetTxSizes(txSizes)
+	if err := c.commitPreparedToBlockStore(prepared); err != nil {
+		return nil, errors.WithMessagef(
 			err,
 			"error while committing block %d to the block store",
-			block.GetHeader().GetBaseHeader().GetNumber(),
+			blockNum,
 		)
 	}
 
+	// The state digests are recorded before the updates of the block, so that the recovery of an interrupted commit
+	// does not derive them from a state which already holds some of the updates. The updates of a coalesced block
+	// modify keys that no other block of the group does, hence, its digests are derived from the state as is.
+	if err := c.commitDigests(blockNum, digests); err != nil {
+		return nil, err
+	}
+
+	// The state delta is constructed before the index updates are added to the database updates, as the indexes are
+	// derived from the state by every node that applies the delta.
+	delta := constructStateDelta(blockNum, dbsUpdates)
+	if c.recordStateDeltas {
+		if err := c.blockStore.CommitStateDelta(blockNum, delta); err != nil {
+			return nil, errors.WithMessagef(err, "error while recording the state delta of block %d", blockNum)
+		}
+	}
+	if err := c.commitKeyFilter(blockNum, delta); err != nil {
+		return nil, err
+	}
+
 	// Commit block to world state db and provenance db
-	if err = c.commitToDBs(dbsUpdates, provenanceData, block); err != nil {
-		return err
+	if coalesce {
+		if err := c.commitToProvenanceStore(blockNum, provenanceData); err != nil {
+			return nil, err
+		}
+		if c.coalesced == nil {
+			c.coalesced = newCoalescedUpdates()
+		}
+		c.coalesced.add(block, dbsUpdates)
+	} else if err = c.commitToDBs(dbsUpdates, provenanceData, block); err != nil {
+		return nil, err
 	}
 
 	// Commit state trie changes to trie store
 	if !c.stateTrieStore.IsDisabled() {
-		if err = c.commitTrie(block.GetHeader().GetBaseHeader().GetNumber()); err != nil {
-			return err
+		if err = c.commitTrie(blockNum); err != nil {
+			return nil, err
 		}
 	}
 
+	return delta, nil
+}
+
+// commitKeyFilter records the key filter of the block, which is built from the keys of its state delta
+func (c *committer) commitKeyFilter(blockNum uint64, delta *types.StateDelta) error {
+	if err := c.blockStore.CommitKeyFilter(blockNum, blockstore.NewKeyFilter(delta)); err != nil {
+		return errors.WithMessagef(err, "error while recording the key filter of block %d", blockNum)
+	}
 	return nil
 }
 
 func (c *committer) commitToBlockStore(block *types.Block) error {
-	if err := c.blockStore.Commit(block); err != nil {
+	prepared, err := blockstore.PrepareBlock(block)
+	if err != nil {
+		return err
+	}
+	return c.commitPreparedToBlockStore(prepared)
+}
+
+func (c *committer) commitPreparedToBlockStore(prepared *blockstore.PreparedBlock) error {
+	block := prepared.Block()
+	metadata, err := c.signedProducerMetadata(block.GetHeader())
+	if err != nil {
+		return err
+	}
+
+	if err := c.blockStore.CommitPrepared(prepared, metadata); err != nil {
 		return errors.WithMessagef(err, "failed to commit block %d to block store", block.Header.BaseHeader.Number)
 	}
 
 	return nil
 }
 
+// signedProducerMetadata returns the producer metadata of a block along with the signature of the node over the header
+// of the block, if the committer has a signer.
+func (c *committer) signedProducerMetadata(header *types.BlockHeader) (*blockstore.ProducerMetadata, error) {
+	if c.signer == nil {
+		return c.producerMetadata, nil
+	}
+
+	headerBytes, err := marshal.DeterministicMarshal(header)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while marshaling the header of block %d", header.GetBaseHeader().GetNumber())
+	}
+	signature, err := c.signer.Sign(headerBytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while signing the header of block %d", header.GetBaseHeader().GetNumber())
+	}
+
+	return &blockstore.ProducerMetadata{
+		DebugDeterministic: c.producerMetadata.GetDebugDeterministic(),
+		ValidatorNodeId:    c.nodeID,
+		HeaderSignature:    signature,
+	}, nil
+}
+
 func (c *committer) commitToDBs(dbsUpdates map[string]*worldstate.DBUpdates, provenanceData []*provenance.TxDataForProvenance, block *types.Block) error {
 	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
 
@@ -119,7 +295,35 @@ func (c *committer) commitToProvenanceStore(blockNum uint64, provenanceData []*p
 	return nil
 }
 
+// computeDigests returns the state digests of the databases updated by the block, which exclude the indexes, as they
+// are derived from the state.
+func (c *committer) computeDigests(blockNum uint64, dbsUpdates map[string]*worldstate.DBUpdates) (map[string][]byte, error) {
+	digests, err := worldstate.ComputeDigests(c.db, blockNum, dbsUpdates)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while computing the state digests of block %d", blockNum)
+	}
+
+	return digests, nil
+}
+
+func (c *committer) commitDigests(blockNum uint64, digests map[string][]byte) error {
+	if err := c.db.CommitDigests(digests, blockNum); err != nil {
+		return errors.WithMessagef(err, "failed to record the state digests of block %d", blockNum)
+	}
+
+	return nil
+}
+
 func (c *committer) commitToStateDB(blockNum uint64, dbsUpdates map[string]*worldstate.DBUpdates) error {
+	return c.commitRangeToStateDB(blockNum, blockNum, dbsUpdates)
+}
+
+// commitRangeToStateDB commits the updates of the blocks in the range [firstBlockNum, lastBlockNum] to the state
+// database, which moves its height to the last block.
+func (c *committer) commitRangeToStateDB(firstBlockNum, lastBlockNum uint64, dbsUpdates map[string]*worldstate.DBUpdates) error {
+	c.stateDBMu.Lock()
+	defer c.stateDBMu.Unlock()
+
 	indexUpdates, err := stateindex.ConstructIndexEntries(dbsUpdates, c.db)
 	if err != nil {
 		return errors.WithMessage(err, "failed to create index updates")
@@ -130,13 +334,79 @@ func (c *committer) commitToStateDB(blockNum uint64, dbsUpdates map[string]*worl
 		dbsUpdates[indexDB] = updates
 	}
 
-	if err := c.db.Commit(dbsUpdates, blockNum); err != nil {
-		return errors.WithMessagef(err, "failed to commit block %d to state database", blockNum)
+	if c.isolateFailedDBs {
+		if dbsUpdates, err = c.commitUserDBs(firstBlockNum, lastBlockNum, dbsUpdates); err != nil {
+			return err
+		}
+	}
+
+	if err := c.db.Commit(dbsUpdates, lastBlockNum); err != nil {
+		return errors.WithMessagef(err, "failed to commit block %d to state database", lastBlockNum)
 	}
 
 	return nil
 }
 
+// commitUserDBs commits the updates of each user database, along with its index, on its own, without moving the
+// height of the state database, and returns the updates of the system databases, which are left to the commit of the
+// height. The updates to a database which is unavailable or whose commit fails are skipped, and recorded as such, so
+// that the database is resynced later on. Hence, the commit of the blocks goes on for the other databases.
+func (c *committer) commitUserDBs(firstBlockNum, lastBlockNum uint64, dbsUpdates map[string]*worldstate.DBUpdates) (map[string]*worldstate.DBUpdates, error) {
+	height, err := c.db.Height()
+	if err != nil {
+		return nil, err
+	}
+
+	var userDBs []string
+	for dbName := range dbsUpdates {
+		if !worldstate.IsSystemDB(dbName) && !stateindex.IsIndexDB(dbName) {
+			userDBs = append(userDBs, dbName)
+		}
+	}
+	sort.Strings(userDBs)
+
+	remaining := make(map[string]*worldstate.DBUpdates, len(dbsUpdates))
+	for dbName, updates := range dbsUpdates {
+		remaining[dbName] = updates
+	}
+
+	var failed []string
+	for _, dbName := range userDBs {
+		updates := map[string]*worldstate.DBUpdates{dbName: remaining[dbName]}
+		delete(remaining, dbName)
+		if indexUpdates, ok := remaining[stateindex.IndexDB(dbName)]; ok {
+			updates[stateindex.IndexDB(dbName)] = indexUpdates
+			delete(remaining, stateindex.IndexDB(dbName))
+		}
+
+		if c.db.IsUnavailable(dbName) {
+			failed = append(failed, dbName)
+			continue
+		}
+		if err := c.db.Commit(updates, height); err != nil {
+			c.logger.Errorf("failed to commit the updates of blocks [%d, %d] to database [%s], which is unavailable until it is resynced: %s",
+				firstBlockNum, lastBlockNum, dbName, err)
+			failed = append(failed, dbName)
+		}
+	}
+
+	if len(failed) > 0 {
+		var blockNums []uint64
+		for blockNum := firstBlockNum; blockNum <= lastBlockNum; blockNum++ {
+			blockNums = append(blockNums, blockNum)
+		}
+		if err := c.db.RecordSkippedCommits(failed, blockNums); err != nil {
+			return nil, errors.WithMessagef(err, "failed to record the skipped commits of blocks [%d, %d]", firstBlockNum, lastBlockNum)
+		}
+	}
+
+	return remaining, nil
+}
+
+// constructDBAndProvenanceEntries constructs the state updates and the provenance entries of a block. The updates
+// of each database are ordered by key, whatever the order in which the transactions of the block produced them, so
+// that the updates of a block are the same on every node and for every run. Of the writes of a key, the last one is
+// kept last. The databases are applied in the order of their names, see worldstate.SortedDBNames.
 func (c *committer) constructDBAndProvenanceEntries(block *types.Block) (map[string]*worldstate.DBUpdates, []*provenance.TxDataForProvenance, error) {
 	dbsUpdates := make(map[string]*worldstate.DBUpdates)
 	var provenanceData []*provenance.TxDataForProvenance
@@ -166,7 +436,10 @@ func (c *committer) constructDBAndProvenanceEntries(block *types.Block) (map[str
 				TxNum:    uint64(txNum),
 			}
 
-			tx := txsEnvelopes[txNum].Payload
+			tx, err := dropRepeatedImmutableWrites(c.db, txsEnvelopes[txNum].Payload)
+			if err != nil {
+				return nil, nil, err
+			}
 
 			if c.provenanceStore != nil {
 				pData, err := constructProvenanceEntriesForDataTx(c.db, tx, version)
@@ -176,7 +449,21 @@ func (c *committer) constructDBAndProvenanceEntries(block *types.Block) (map[str
 				provenanceData = append(provenanceData, pData...)
 			}
 
+			// an attestation leaves the state untouched, including the digest of the database it names
+			if utils.IsAttestation(tx, block.GetHeader().GetBaseHeader()) {
+				continue
+			}
+
 			AddDBEntriesForDataTx(tx, version, dbsUpdates)
+			if err := addDBEntriesForDataDeleteRanges(c.db, tx, dbsUpdates); err != nil {
+				return nil, nil, err
+			}
+			if err := addDBEntriesForDataPatches(c.db, tx, version, dbsUpdates); err != nil {
+				return nil, nil, err
+			}
+			if utils.IsOrdered(tx, block.GetHeader().GetBaseHeader()) {
+				addDBEntryForSequence(tx, version, dbsUpdates)
+			}
 		}
 		c.logger.Debugf("constructed %d, updates for data transactions, block number %d",
 			len(blockValidationInfo),
@@ -220,6 +507,50 @@ func (c *committer) constructDBAndProvenanceEntries(block *types.Block) (map[str
 		c.logger.Debugf("constructed user admin update, block number %d",
 			block.GetHeader().GetBaseHeader().GetNumber())
 
+	case *types.Block_UserAdministrationTxEnvelopes:
+		// the validator invalidates a transaction that touches a user modified by a previous transaction of the
+		// block, hence, the entries of the valid transactions never overlap
+		usersUpdates := &worldstate.DBUpdates{}
+		for txNum, txEnv := range block.GetUserAdministrationTxEnvelopes().GetEnvelopes() {
+			tx := txEnv.GetPayload()
+			if blockValidationInfo[txNum].Flag != types.Flag_VALID {
+				if c.provenanceStore != nil {
+					provenanceData = append(provenanceData, &provenance.TxDataForProvenance{
+						IsValid: false,
+						TxID:    tx.GetTxId(),
+					})
+				}
+				continue
+			}
+
+			version := &types.Version{
+				BlockNum: block.GetHeader().GetBaseHeader().GetNumber(),
+				TxNum:    uint64(txNum),
+			}
+
+			entries, err := identity.ConstructDBEntriesForUserAdminTx(tx, version)
+			if err != nil {
+				return nil, nil, errors.WithMessage(err, "error while creating entries for the user admin transaction")
+			}
+			usersUpdates.Writes = append(usersUpdates.Writes, entries.Writes...)
+			usersUpdates.Deletes = append(usersUpdates.Deletes, entries.Deletes...)
+
+			if c.provenanceStore != nil {
+				pData, err := identity.ConstructProvenanceEntriesForUserAdminTx(tx, version, c.db)
+				if err != nil {
+					return nil, nil, errors.WithMessage(err, "error while creating provenance entries for the user admin transaction")
+				}
+				provenanceData = append(provenanceData, pData)
+			}
+		}
+		if len(usersUpdates.Writes) > 0 || len(usersUpdates.Deletes) > 0 {
+			dbsUpdates[worldstate.UsersDBName] = usersUpdates
+		}
+
+		c.logger.Debugf("constructed user admin updates of %d transactions, block number %d",
+			len(block.GetUserAdministrationTxEnvelopes().GetEnvelopes()),
+			block.GetHeader().GetBaseHeader().GetNumber())
+
 	case *types.Block_DbAdministrationTxEnvelope:
 		if blockValidationInfo[dbAdminTxIndex].Flag != types.Flag_VALID {
 			return nil, nil, nil
@@ -236,6 +567,21 @@ func (c *committer) constructDBAndProvenanceEntries(block *types.Block) (map[str
 		if err != nil {
 			return nil, nil, errors.WithMessage(err, "error while creating entries for db admin transaction")
 		}
+		descriptorUpdates, err := constructDescriptorEntriesForDBAdminTx(tx, version, c.db)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, "error while creating descriptor entries for db admin transaction")
+		}
+		if descriptorUpdates != nil {
+			dbsUpdates[worldstate.DBDescriptorsDBName] = descriptorUpdates
+
+			if c.provenanceStore != nil {
+				pData, err := constructProvenanceEntriesForDBDescriptors(tx, descriptorUpdates, c.db, c.provenanceStore)
+				if err != nil {
+					return nil, nil, errors.WithMessage(err, "error while creating provenance entries for the db admin transaction")
+				}
+				provenanceData = append(provenanceData, pData)
+			}
+		}
 		c.logger.Debugf("constructed db admin update, block number %d",
 			block.GetHeader().GetBaseHeader().GetNumber())
 
@@ -283,8 +629,27 @@ func (c *committer) constructDBAndProvenanceEntries(block *types.Block) (map[str
 
 		c.logger.Debugf("constructed configuration update, block number %d",
 			block.GetHeader().GetBaseHeader().GetNumber())
+
+	case *types.Block_HeartbeatTxEnvelopes:
+		entries, err := constructDBEntriesForHeartbeatTxs(block)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, "error while creating entries for the heartbeat transactions")
+		}
+		if entries != nil {
+			dbsUpdates[worldstate.HeartbeatsDBName] = entries
+		}
+		c.logger.Debugf("constructed heartbeat updates, block number %d",
+			block.GetHeader().GetBaseHeader().GetNumber())
+
+	case *types.Block_VoidTxEnvelopes:
+		if entries := constructDBEntriesForVoidTxs(block); entries != nil {
+			dbsUpdates[worldstate.UsersDBName] = entries
+		}
+		c.logger.Debugf("constructed void updates, block number %d",
+			block.GetHeader().GetBaseHeader().GetNumber())
 	}
 
+	worldstate.SortDBsUpdates(dbsUpdates)
 	return dbsUpdates, provenanceData, nil
 }
 
@@ -299,7 +664,8 @@ func (c *committer) commitTrie(height uint64) error {
 }
 
 func ApplyBlockOnStateTrie(trie *mptrie.MPTrie, worldStateUpdates map[string]*worldstate.DBUpdates) error {
-	for dbName, dbUpdate := range worldStateUpdates {
+	for _, dbName := range worldstate.SortedDBNames(worldStateUpdates) {
+		dbUpdate := worldStateUpdates[dbName]
 		for _, dbWrite := range dbUpdate.Writes {
 			key, err := state.ConstructCompositeKey(dbName, dbWrite.Key)
 			if err != nil {
@@ -326,6 +692,224 @@ func ApplyBlockOnStateTrie(trie *mptrie.MPTrie, worldStateUpdates map[string]*wo
 	return nil
 }
 
+// addWriteSetDigests sets the write-set digest in the validation info of each valid
+// data transaction. Invalid transactions and non-data transactions are left without
+// a digest, and so are the transactions of a block of rules that predate the digests, as they were by the node that
+// produced the block. The values of the patched keys are derived from the committed state, and hence, the digests must
+// be computed before the block is committed to the state database.
+func addWriteSetDigests(db worldstate.DB, block *types.Block) error {
+	txsEnvelopes := block.GetDataTxEnvelopes().GetEnvelopes()
+	if txsEnvelopes == nil || !utils.HasWriteSetDigests(block.GetHeader().GetBaseHeader()) {
+		return nil
+	}
+
+	for txNum, txValidationInfo := range block.GetHeader().GetValidationInfo() {
+		if txValidationInfo.Flag != types.Flag_VALID {
+			continue
+		}
+
+		version := &types.Version{
+			BlockNum: block.GetHeader().GetBaseHeader().GetNumber(),
+			TxNum:    uint64(txNum),
+		}
+
+		digest, err := CalculateWriteSetDigestForDataTx(db, txsEnvelopes[txNum].Payload, version)
+		if err != nil {
+			return err
+		}
+		txValidationInfo.WriteSetDigest = digest
+	}
+
+	return nil
+}
+
+// CalculateWriteSetDigestForDataTx computes the digest over the writes and the deletes applied by
+// a valid data transaction committed at the given version, including the values
+// resulting from its patches and the keys of its range deletes. A deleted key is hashed along with the version of the
+// deleted value, as the provenance store records it. The no-op writes of the keys of immutable databases are left out,
+// as they are from the state and the provenance store. The db is used only to derive the patched values, the versions
+// of the deleted keys and the no-op writes, hence, it may be nil for a transaction that neither patches nor deletes
+// keys, nor writes to an immutable database.
+func CalculateWriteSetDigestForDataTx(db worldstate.DB, tx *types.DataTx, version *types.Version) ([]byte, error) {
+	if db != nil {
+		var err error
+		if tx, err = dropRepeatedImmutableWrites(db, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	dbsWrites := make(map[string][]*types.KVWithMetadata)
+	dbsDeletes := make(map[string][]*types.KVWithMetadata)
+	for _, ops := range tx.DbOperations {
+		for _, write := range ops.DataWrites {
+			dbsWrites[ops.DbName] = append(dbsWrites[ops.DbName], &types.KVWithMetadata{
+				Key:   write.Key,
+				Value: write.Value,
+				Metadata: &types.Metadata{
+					Version:       version,
+					AccessControl: write.Acl,
+				},
+			})
+		}
+
+		for _, p := range ops.DataPatches {
+			kv, err := patchedValue(db, ops.DbName, p, version)
+			if err != nil {
+				return nil, err
+			}
+			dbsWrites[ops.DbName] = append(dbsWrites[ops.DbName], kv)
+		}
+
+		deletedKeys := make([]string, 0, len(ops.DataDeletes))
+		for _, d := range ops.DataDeletes {
+			deletedKeys = append(deletedKeys, d.Key)
+		}
+		for _, r := range ops.DataDeleteRanges {
+			keys, err := worldstate.DeleteRangeKeys(db, ops.DbName, r, 0)
+			if err != nil {
+				return nil, err
+			}
+			deletedKeys = append(deletedKeys, keys...)
+		}
+
+		for _, key := range deletedKeys {
+			v, err := db.GetVersion(ops.DbName, key)
+			if err != nil {
+				return nil, err
+			}
+			dbsDeletes[ops.DbName] = append(dbsDeletes[ops.DbName], &types.KVWithMetadata{
+				Key:      key,
+				Metadata: &types.Metadata{Version: v},
+			})
+		}
+	}
+
+	return state.CalculateWriteSetDigest(dbsWrites, dbsDeletes)
+}
+
+// computeTxSizes returns the envelope bytes and the write bytes of each transaction of the block, in the order of the
+// transactions, which the block store records in the index of the transactions along with the sizes of the block. The
+// values of the patched keys are derived from the committed state, as for the write-set digests, hence, the sizes must
+// be computed before the block is committed to the state database.
+func computeTxSizes(db worldstate.DB, block *types.Block) ([]*types.TxSizes, error) {
+	envelopes := utils.BlockEnvelopes(block)
+	if envelopes == nil {
+		return nil, nil
+	}
+
+	txSizes := make([]*types.TxSizes, 0, len(envelopes))
+	for txNum, env := range envelopes {
+		envBytes, err := marshal.DeterministicMarshal(env)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while marshaling transaction %d", txNum)
+		}
+		sizes := &types.TxSizes{EnvelopeBytes: uint64(len(envBytes))}
+
+		if dataEnv, ok := env.(*types.DataTxEnvelope); ok && block.GetHeader().GetValidationInfo()[txNum].GetFlag() == types.Flag_VALID &&
+			!utils.IsAttestation(dataEnv.GetPayload(), block.GetHeader().GetBaseHeader()) {
+			version := &types.Version{
+				BlockNum: block.GetHeader().GetBaseHeader().GetNumber(),
+				TxNum:    uint64(txNum),
+			}
+			if sizes.WriteBytes, err = writeBytesOfDataTx(db, dataEnv.GetPayload(), version); err != nil {
+				return nil, err
+			}
+		}
+		txSizes = append(txSizes, sizes)
+	}
+
+	return txSizes, nil
+}
+
+// cumulativeCounters returns the cumulative counters of the block, derived from the counters of the previous block and
+// from the validation info and the sizes of the transactions of the block
+func (c *committer) cumulativeCounters(block *types.Block, txSizes []*types.TxSizes) (*types.CumulativeCounters, error) {
+	var prev *types.CumulativeCounters
+	if blockNum := block.GetHeader().GetBaseHeader().GetNumber(); blockNum > 1 {
+		var err error
+		if prev, err = c.blockStore.CumulativeCounters(blockNum - 1); err != nil {
+			return nil, err
+		}
+	}
+
+	var envelopeBytes uint64
+	for _, sizes := range txSizes {
+		envelopeBytes += sizes.GetEnvelopeBytes()
+	}
+	return blockstore.NextCumulativeCounters(prev, block.GetHeader(), envelopeBytes), nil
+}
+
+// writeBytesOfDataTx sums the sizes of the keys and the values written by a valid data transaction committed at the
+// given version, including the values resulting from its patches, but for the no-op writes of immutable databases
+func writeBytesOfDataTx(db worldstate.DB, tx *types.DataTx, version *types.Version) (uint64, error) {
+	tx, err := dropRepeatedImmutableWrites(db, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	var writeBytes uint64
+	for _, ops := range tx.DbOperations {
+		for _, write := range ops.DataWrites {
+			writeBytes += uint64(len(write.Key) + len(write.Value))
+		}
+
+		for _, p := range ops.DataPatches {
+			kv, err := patchedValue(db, ops.DbName, p, version)
+			if err != nil {
+				return 0, err
+			}
+			writeBytes += uint64(len(kv.Key) + len(kv.Value))
+		}
+	}
+
+	return writeBytes, nil
+}
+
+// dropRepeatedImmutableWrites returns the transaction without its writes of the keys of immutable databases which
+// exist with the same value. Such a write is a no-op: the key keeps the version of its creation, and neither the state
+// nor the provenance store records the write. Any other write of an existing key of an immutable database is
+// invalidated by the validator. The transaction is returned as it is when it has no such write.
+func dropRepeatedImmutableWrites(db worldstate.DB, tx *types.DataTx) (*types.DataTx, error) {
+	var filtered *types.DataTx
+	for i, ops := range tx.DbOperations {
+		if len(ops.DataWrites) == 0 {
+			continue
+		}
+		immutable, err := worldstate.IsImmutableDB(db, ops.DbName)
+		if err != nil {
+			return nil, err
+		}
+		if !immutable {
+			continue
+		}
+
+		var writes []*types.DataWrite
+		for _, write := range ops.DataWrites {
+			value, metadata, err := db.Get(ops.DbName, write.Key)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "error while fetching the key [%s] of the immutable database [%s]", write.Key, ops.DbName)
+			}
+			if metadata != nil && bytes.Equal(value, write.Value) {
+				continue
+			}
+			writes = append(writes, write)
+		}
+		if len(writes) == len(ops.DataWrites) {
+			continue
+		}
+
+		if filtered == nil {
+			filtered = proto.Clone(tx).(*types.DataTx)
+		}
+		filtered.DbOperations[i].DataWrites = writes
+	}
+
+	if filtered == nil {
+		return tx, nil
+	}
+	return filtered, nil
+}
+
 func AddDBEntriesForDataTx(tx *types.DataTx, version *types.Version, dbsUpdates map[string]*worldstate.DBUpdates) {
 	for _, ops := range tx.DbOperations {
 		updates, ok := dbsUpdates[ops.DbName]
@@ -352,25 +936,328 @@ func AddDBEntriesForDataTx(tx *types.DataTx, version *types.Version, dbsUpdates
 	}
 }
 
+// addDBEntriesForDataDeleteRanges expands the range deletes of a valid data transaction into deletes of the keys in
+// each range. The keys are fetched from the committed state, as is done by the validator, and hence, the expansion
+// yields the keys that were validated.
+func addDBEntriesForDataDeleteRanges(db worldstate.DB, tx *types.DataTx, dbsUpdates map[string]*worldstate.DBUpdates) error {
+	for _, ops := range tx.DbOperations {
+		for _, r := range ops.DataDeleteRanges {
+			keys, err := worldstate.DeleteRangeKeys(db, ops.DbName, r, 0)
+			if err != nil {
+				return errors.WithMessagef(err, "error while expanding a range delete of the transaction [%s]", tx.TxId)
+			}
+
+			updates, ok := dbsUpdates[ops.DbName]
+			if !ok {
+				updates = &worldstate.DBUpdates{}
+				dbsUpdates[ops.DbName] = updates
+			}
+			updates.Deletes = append(updates.Deletes, keys...)
+		}
+	}
+
+	return nil
+}
+
+// addDBEntriesForDataPatches records the values resulting from the patches of a valid data transaction as writes. The
+// validation guarantees that the committed value of a patched key is the one read by the client, and that no other
+// transaction in the block modifies it, hence, the patch is applied to the committed value.
+func addDBEntriesForDataPatches(db worldstate.DB, tx *types.DataTx, version *types.Version, dbsUpdates map[string]*worldstate.DBUpdates) error {
+	for _, ops := range tx.DbOperations {
+		if len(ops.DataPatches) == 0 {
+			continue
+		}
+
+		updates, ok := dbsUpdates[ops.DbName]
+		if !ok {
+			updates = &worldstate.DBUpdates{}
+			dbsUpdates[ops.DbName] = updates
+		}
+
+		for _, p := range ops.DataPatches {
+			kv, err := patchedValue(db, ops.DbName, p, version)
+			if err != nil {
+				return errors.WithMessagef(err, "error while applying a patch of the transaction [%s]", tx.TxId)
+			}
+			updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+				Key:      kv.Key,
+				Value:    kv.Value,
+				Metadata: kv.Metadata,
+			})
+		}
+	}
+
+	return nil
+}
+
+// patchedValue applies the patch to the committed value of its key, and returns the resulting value with the given
+// version. The patched key keeps its access control.
+func patchedValue(db worldstate.DB, dbName string, p *types.DataPatch, version *types.Version) (*types.KVWithMetadata, error) {
+	value, metadata, err := db.Get(dbName, p.Key)
+	if err != nil {
+		return nil, err
+	}
+	if metadata == nil {
+		return nil, errors.Errorf("the patched key [%s] does not exist in the database [%s]", p.Key, dbName)
+	}
+
+	patched, err := jsonpatch.ApplyDataPatch(value, p)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while patching the key [%s] in the database [%s]", p.Key, dbName)
+	}
+
+	return &types.KVWithMetadata{
+		Key:   p.Key,
+		Value: patched,
+		Metadata: &types.Metadata{
+			Version:       version,
+			AccessControl: metadata.AccessControl,
+		},
+	}, nil
+}
+
+// addDBEntryForSequence records the sequence number of a valid ordered data transaction as the last sequence number
+// of its first must-sign user. The validation guarantees that a later transaction of the same user in the block
+// carries a greater sequence number, and hence, its entry supersedes this one.
+func addDBEntryForSequence(tx *types.DataTx, version *types.Version, dbsUpdates map[string]*worldstate.DBUpdates) {
+	updates, ok := dbsUpdates[worldstate.UsersDBName]
+	if !ok {
+		updates = &worldstate.DBUpdates{}
+		dbsUpdates[worldstate.UsersDBName] = updates
+	}
+	updates.Writes = append(updates.Writes, identity.ConstructDBEntryForUserSequence(tx.MustSignUserIds[0], tx.Sequence, version))
+}
+
+// constructDBEntriesForHeartbeatTxs records the valid heartbeats of the block under the ID of the node which sent
+// them, so that the heartbeats database holds the last heartbeat of each node. Heartbeats are not recorded in the
+// provenance store.
+func constructDBEntriesForHeartbeatTxs(block *types.Block) (*worldstate.DBUpdates, error) {
+	var writes []*worldstate.KVWithMetadata
+	for txNum, txEnv := range block.GetHeartbeatTxEnvelopes().GetEnvelopes() {
+		if block.GetHeader().GetValidationInfo()[txNum].GetFlag() != types.Flag_VALID {
+			continue
+		}
+
+		tx := txEnv.GetPayload()
+		value, err := worldstate.MarshalValue(tx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while marshaling the heartbeat of node [%s]", tx.GetNodeId())
+		}
+
+		writes = append(writes, &worldstate.KVWithMetadata{
+			Key:   tx.GetNodeId(),
+			Value: value,
+			Metadata: &types.Metadata{
+				Version: &types.Version{
+					BlockNum: block.GetHeader().GetBaseHeader().GetNumber(),
+					TxNum:    uint64(txNum),
+				},
+			},
+		})
+	}
+
+	if len(writes) == 0 {
+		return nil, nil
+	}
+	return &worldstate.DBUpdates{Writes: writes}, nil
+}
+
+// constructDBEntriesForVoidTxs records the sequence number of each voided transaction as the last sequence number of
+// its user. A void writes nothing else, and is not recorded in the provenance store.
+func constructDBEntriesForVoidTxs(block *types.Block) *worldstate.DBUpdates {
+	var writes []*worldstate.KVWithMetadata
+	for txNum, txEnv := range block.GetVoidTxEnvelopes().GetEnvelopes() {
+		tx := txEnv.GetPayload()
+		if block.GetHeader().GetValidationInfo()[txNum].GetFlag() != types.Flag_VOIDED || tx.GetSequence() == 0 {
+			continue
+		}
+
+		version := &types.Version{
+			BlockNum: block.GetHeader().GetBaseHeader().GetNumber(),
+			TxNum:    uint64(txNum),
+		}
+		writes = append(writes, identity.ConstructDBEntryForUserSequence(tx.GetUserId(), tx.GetSequence(), version))
+	}
+
+	if len(writes) == 0 {
+		return nil
+	}
+	return &worldstate.DBUpdates{Writes: writes}
+}
+
 func constructDBEntriesForDBAdminTx(tx *types.DBAdministrationTx, version *types.Version, db worldstate.DB) (*worldstate.DBUpdates, error) {
 	var indexForExistingDBs []*worldstate.KVWithMetadata
 
-	toCreateDBs, err := createEntriesForNewDBs(tx.CreateDbs, tx.DbsIndex, version)
+	// the indexes of the new databases are consumed from a copy, so that the transaction of the block is left as is
+	dbsIndex := make(map[string]*types.DBIndex, len(tx.DbsIndex))
+	for dbName, dbIndex := range tx.DbsIndex {
+		dbsIndex[dbName] = dbIndex
+	}
+
+	toCreateDBs, err := createEntriesForNewDBs(tx.CreateDbs, dbsIndex, version)
 	if err != nil {
 		return nil, err
 	}
 
-	indexForExistingDBs, toDeleteIndexDBs, err := createEntriesForIndexUpdates(tx.DbsIndex, db, version)
+	indexForExistingDBs, toDeleteIndexDBs, err := createEntriesForIndexUpdates(dbsIndex, db, version)
 	if err != nil {
 		return nil, err
 	}
 
 	return &worldstate.DBUpdates{
 		Writes:  append(toCreateDBs, indexForExistingDBs...),
-		Deletes: append(tx.DeleteDbs, toDeleteIndexDBs...),
+		Deletes: append(append([]string(nil), tx.DeleteDbs...), toDeleteIndexDBs...),
 	}, nil
 }
 
+// constructDescriptorEntriesForDBAdminTx updates the descriptors of the databases whose schema, default ACL, or value
+// size cap is set or removed by the transaction, records the key collation and the immutability of the created
+// databases, and removes the descriptors of the deleted databases. A descriptor whose settings are all removed is kept
+// as an empty descriptor, so that the removal is recorded as a version of the descriptor. It returns nil when no
+// descriptor changes.
+//
+// As a database administration transaction is the only transaction of its block, and such a block is never
+// coalesced with others, the descriptor changes are visible to the validation of the next block on.
+func constructDescriptorEntriesForDBAdminTx(tx *types.DBAdministrationTx, version *types.Version, db worldstate.DB) (*worldstate.DBUpdates, error) {
+	updates := &worldstate.DBUpdates{}
+
+	toUpdate := make(map[string]bool)
+	for dbName := range tx.DbsSchema {
+		toUpdate[dbName] = true
+	}
+	for dbName := range tx.DbsDefaultAcl {
+		toUpdate[dbName] = true
+	}
+	for dbName := range tx.DbsMaxValueSize {
+		toUpdate[dbName] = true
+	}
+	for dbName := range tx.DbsKeyCollation {
+		toUpdate[dbName] = true
+	}
+	immutable := make(map[string]bool)
+	for _, dbName := range tx.ImmutableDbs {
+		toUpdate[dbName] = true
+		immutable[dbName] = true
+	}
+	var dbNames []string
+	for dbName := range toUpdate {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	for _, dbName := range dbNames {
+		value, metadata, err := db.Get(worldstate.DBDescriptorsDBName, dbName)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while fetching the descriptor of database [%s]", dbName)
+		}
+
+		descriptor := &types.DBDescriptor{}
+		if metadata != nil {
+			if err := proto.Unmarshal(value, descriptor); err != nil {
+				return nil, errors.Wrap(err, "error while unmarshaling the descriptor of database ["+dbName+"]")
+			}
+		}
+		if schema, ok := tx.DbsSchema[dbName]; ok {
+			descriptor.JsonSchema = schema.GetJsonSchema()
+		}
+		if defaultACL, ok := tx.DbsDefaultAcl[dbName]; ok {
+			descriptor.DefaultAcl = defaultACL.GetAcl()
+		}
+		if maxValueSize, ok := tx.DbsMaxValueSize[dbName]; ok {
+			descriptor.MaxValueSizeBytes = maxValueSize.GetMaxValueSizeBytes()
+		}
+		if keyCollation, ok := tx.DbsKeyCollation[dbName]; ok && !worldstate.IsBinaryCollation(keyCollation) {
+			descriptor.KeyCollation = keyCollation
+		}
+		if immutable[dbName] {
+			descriptor.Immutable = true
+		}
+
+		if metadata == nil && proto.Equal(descriptor, &types.DBDescriptor{}) {
+			continue
+		}
+
+		value, err = worldstate.MarshalValue(descriptor)
+		if err != nil {
+			return nil, errors.Wrap(err, "error while marshaling the descriptor of database ["+dbName+"]")
+		}
+		updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+			Key:   dbName,
+			Value: value,
+			Metadata: &types.Metadata{
+				Version: version,
+			},
+		})
+	}
+
+	for _, dbName := range tx.DeleteDbs {
+		exist, err := db.Has(worldstate.DBDescriptorsDBName, dbName)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while checking the descriptor of database [%s]", dbName)
+		}
+		if exist {
+			updates.Deletes = append(updates.Deletes, dbName)
+		}
+	}
+
+	if len(updates.Writes) == 0 && len(updates.Deletes) == 0 {
+		return nil, nil
+	}
+	return updates, nil
+}
+
+// constructProvenanceEntriesForDBDescriptors records the descriptor updates in the provenance store like the updates
+// of any key, so that the settings of a database can be queried as of any block, along with the admin who set them. A
+// descriptor committed before the descriptors were recorded in the provenance store starts a new history.
+func constructProvenanceEntriesForDBDescriptors(
+	tx *types.DBAdministrationTx,
+	updates *worldstate.DBUpdates,
+	db worldstate.DB,
+	provenanceStore *provenance.Store,
+) (*provenance.TxDataForProvenance, error) {
+	pData := &provenance.TxDataForProvenance{
+		IsValid:            true,
+		DBName:             worldstate.DBDescriptorsDBName,
+		UserID:             tx.UserId,
+		TxID:               tx.TxId,
+		Deletes:            make(map[string]*types.Version),
+		OldVersionOfWrites: make(map[string]*types.Version),
+	}
+
+	for _, w := range updates.Writes {
+		pData.Writes = append(pData.Writes, &types.KVWithMetadata{
+			Key:      w.Key,
+			Value:    w.Value,
+			Metadata: w.Metadata,
+		})
+
+		oldVersion, err := db.GetVersion(worldstate.DBDescriptorsDBName, w.Key)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while fetching the version of the descriptor of database [%s]", w.Key)
+		}
+		if oldVersion == nil {
+			continue
+		}
+		oldValue, err := provenanceStore.GetValueAt(worldstate.DBDescriptorsDBName, w.Key, oldVersion)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while fetching the previous descriptor of database [%s]", w.Key)
+		}
+		if oldValue != nil {
+			pData.OldVersionOfWrites[w.Key] = oldVersion
+		}
+	}
+
+	for _, dbName := range updates.Deletes {
+		oldVersion, err := db.GetVersion(worldstate.DBDescriptorsDBName, dbName)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while fetching the version of the descriptor of database [%s]", dbName)
+		}
+		pData.Deletes[dbName] = oldVersion
+	}
+
+	return pData, nil
+}
+
 func createEntriesForNewDBs(newDBs []string, dbsIndex map[string]*types.DBIndex, version *types.Version) ([]*worldstate.KVWithMetadata, error) {
 	var toCreateDBs []*worldstate.KVWithMetadata
 	var err error
@@ -419,7 +1306,14 @@ func createEntriesForIndexUpdates(
 	var toDeleteDBs []string
 	var err error
 
-	for dbName, dbIndex := range dbsIndex {
+	dbNames := make([]string, 0, len(dbsIndex))
+	for dbName := range dbsIndex {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	for _, dbName := range dbNames {
+		dbIndex := dbsIndex[dbName]
 		indexExist := db.Exist(stateindex.IndexDB(dbName))
 		deleteExistingIndex := dbIndex == nil || dbIndex.GetAttributeAndType() == nil
 
@@ -489,7 +1383,7 @@ func constructDBEntriesForConfigTx(tx *types.ConfigTx, oldConfig *types.ClusterC
 	}
 	newConfigClone.ConsensusConfig.RaftConfig.MaxRaftId = maxID
 
-	newConfigSerialized, err := proto.Marshal(newConfigClone)
+	newConfigSerialized, err := worldstate.MarshalValue(newConfigClone)
 	if err != nil {
 		return nil, errors.Wrap(err, "error while marshaling new configuration")
 	}
@@ -562,6 +1456,21 @@ func constructProvenanceEntriesForDataTx(db worldstate.DB, tx *types.DataTx, ver
 			pData.OldVersionOfWrites[write.Key] = v
 		}
 
+		// a patch is recorded as a read of the version on which it was
+		// applied and a write of the resulting value, not of the patch
+		for _, p := range ops.DataPatches {
+			kv, err := patchedValue(db, ops.DbName, p, version)
+			if err != nil {
+				return nil, err
+			}
+			pData.Reads = append(pData.Reads, &provenance.KeyWithVersion{
+				Key:     p.Key,
+				Version: p.Version,
+			})
+			pData.Writes = append(pData.Writes, kv)
+			pData.OldVersionOfWrites[p.Key] = p.Version
+		}
+
 		// we assume a block to delete a key only once. If more than
 		// one transaction in a block deletes the same key, only the
 		// first valid transaction gets committed while others get
@@ -577,6 +1486,22 @@ func constructProvenanceEntriesForDataTx(db worldstate.DB, tx *types.DataTx, ver
 			pData.Deletes[d.Key] = v
 		}
 
+		// each key in a range delete is recorded as deleted
+		for _, r := range ops.DataDeleteRanges {
+			keys, err := worldstate.DeleteRangeKeys(db, ops.DbName, r, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, key := range keys {
+				v, err := db.GetVersion(ops.DbName, key)
+				if err != nil {
+					return nil, err
+				}
+				pData.Deletes[key] = v
+			}
+		}
+
 		txpData[i] = pData
 	}
 
@@ -589,7 +1514,7 @@ func constructProvenanceEntriesForConfigTx(
 	updates *dbEntriesForConfigTx,
 	db worldstate.DB,
 ) ([]*provenance.TxDataForProvenance, error) {
-	configSerialized, err := proto.Marshal(tx.NewConfig)
+	configSerialized, err := worldstate.MarshalValue(tx.NewConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "error while marshaling new cluster configuration")
 	}
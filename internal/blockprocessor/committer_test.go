@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -18,7 +19,10 @@ import (
 	"github.com/hyperledger-labs/orion-server/internal/stateindex"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/state"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/stretchr/testify/require"
 )
@@ -243,6 +247,274 @@ func TestCommitter(t *testing.T) {
 		stateTrieHash, err := env.committer.stateTrie.Hash()
 		require.NoError(t, err)
 		require.Equal(t, block.GetHeader().GetStateMerkelTreeRootHash(), stateTrieHash)
+
+		// the key filter of the block holds the keys it wrote
+		filter, err := env.blockStore.GetKeyFilter(1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), filter.GetKeys())
+		for _, db := range []string{"db1", "db2", "db3"} {
+			require.True(t, filter.MayContain(db, db+"-key1"))
+		}
+		candidates, err := env.blockStore.GetKeyBlockCandidates("db1", "db2-key1", 1, 1)
+		require.NoError(t, err)
+		require.Empty(t, candidates)
+	})
+
+	t.Run("commit block with a range delete", func(t *testing.T) {
+		t.Parallel()
+
+		env := newCommitterTestEnv(t)
+		defer env.cleanup()
+
+		createDB := map[string]*worldstate.DBUpdates{
+			worldstate.DatabasesDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key: "db1",
+					},
+				},
+			},
+		}
+		require.NoError(t, env.db.Commit(createDB, 1))
+
+		dataBlock := func(number uint64, ops *types.DBOperation) *types.Block {
+			ops.DbName = "db1"
+			return &types.Block{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number:       number,
+						RulesVersion: constants.RulesVersion,
+					},
+					ValidationInfo: []*types.ValidationInfo{
+						{
+							Flag: types.Flag_VALID,
+						},
+					},
+				},
+				Payload: &types.Block_DataTxEnvelopes{
+					DataTxEnvelopes: &types.DataTxEnvelopes{
+						Envelopes: []*types.DataTxEnvelope{
+							{
+								Payload: &types.DataTx{
+									MustSignUserIds: []string{"testUser"},
+									TxId:            fmt.Sprintf("dataTx%d", number),
+									DbOperations:    []*types.DBOperation{ops},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		var writes []*types.DataWrite
+		for _, key := range []string{"p/1", "p/2", "q/1", "q/2"} {
+			writes = append(writes, &types.DataWrite{Key: key, Value: []byte("value-" + key)})
+		}
+		require.NoError(t, env.committer.commitBlock(dataBlock(1, &types.DBOperation{DataWrites: writes})))
+
+		block2 := dataBlock(2, &types.DBOperation{
+			DataDeletes: []*types.DataDelete{
+				{
+					Key: "q/2",
+				},
+			},
+			DataDeleteRanges: []*types.DataDeleteRange{
+				{
+					Prefix: "p/",
+				},
+				{
+					StartKey: "r",
+				},
+			},
+		})
+		require.NoError(t, addWriteSetDigests(env.db, block2))
+		require.NoError(t, env.committer.commitBlock(block2))
+
+		// the write-set digest covers the deleted keys along with the versions of the deleted values, as recorded by
+		// the provenance store
+		deleted, err := env.committer.provenanceStore.GetValuesDeletedByTx("dataTx2")
+		require.NoError(t, err)
+		require.Len(t, deleted["db1"].GetKVs(), 3)
+		recomputedDigest, err := state.CalculateWriteSetDigest(nil, map[string][]*types.KVWithMetadata{"db1": deleted["db1"].GetKVs()})
+		require.NoError(t, err)
+		require.Equal(t, block2.Header.ValidationInfo[0].WriteSetDigest, recomputedDigest)
+		emptyDigest, err := state.CalculateWriteSetDigest(nil, nil)
+		require.NoError(t, err)
+		require.NotEqual(t, emptyDigest, recomputedDigest)
+
+		for _, key := range []string{"p/1", "p/2", "q/2"} {
+			val, metadata, err := env.db.Get("db1", key)
+			require.NoError(t, err)
+			require.Nil(t, val)
+			require.Nil(t, metadata)
+
+			deleted, err := env.committer.provenanceStore.GetDeletedValues("db1", key)
+			require.NoError(t, err)
+			require.Len(t, deleted, 1)
+			require.Equal(t, []byte("value-"+key), deleted[0].Value)
+		}
+
+		val, _, err := env.db.Get("db1", "q/1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-q/1"), val)
+
+		block, err := env.blockStore.Get(2)
+		require.NoError(t, err)
+		stateTrieHash, err := env.committer.stateTrie.Hash()
+		require.NoError(t, err)
+		require.Equal(t, block.GetHeader().GetStateMerkelTreeRootHash(), stateTrieHash)
+	})
+
+	t.Run("commit block with patches", func(t *testing.T) {
+		t.Parallel()
+
+		env := newCommitterTestEnv(t)
+		defer env.cleanup()
+
+		createDB := map[string]*worldstate.DBUpdates{
+			worldstate.DatabasesDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key: "db1",
+					},
+				},
+			},
+		}
+		require.NoError(t, env.db.Commit(createDB, 1))
+
+		dataBlock := func(number uint64, ops *types.DBOperation) *types.Block {
+			ops.DbName = "db1"
+			return &types.Block{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number:       number,
+						RulesVersion: constants.RulesVersion,
+					},
+					ValidationInfo: []*types.ValidationInfo{
+						{
+							Flag: types.Flag_VALID,
+						},
+					},
+				},
+				Payload: &types.Block_DataTxEnvelopes{
+					DataTxEnvelopes: &types.DataTxEnvelopes{
+						Envelopes: []*types.DataTxEnvelope{
+							{
+								Payload: &types.DataTx{
+									MustSignUserIds: []string{"testUser"},
+									TxId:            fmt.Sprintf("dataTx%d", number),
+									DbOperations:    []*types.DBOperation{ops},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		acl := &types.AccessControl{
+			ReadWriteUsers: map[string]bool{
+				"testUser": true,
+			},
+		}
+		require.NoError(t, env.committer.commitBlock(dataBlock(1, &types.DBOperation{
+			DataWrites: []*types.DataWrite{
+				{
+					Key:   "profile",
+					Value: []byte(`{"name":"alice","address":{"city":"haifa","zip":"3200003"}}`),
+					Acl:   acl,
+				},
+				{
+					Key:   "list",
+					Value: []byte(`{"items":[1,2,3]}`),
+				},
+			},
+		})))
+
+		readVersion := &types.Version{BlockNum: 1, TxNum: 0}
+		patchVersion := &types.Version{BlockNum: 2, TxNum: 0}
+		block2 := dataBlock(2, &types.DBOperation{
+			DataPatches: []*types.DataPatch{
+				{
+					Key:        "profile",
+					Version:    readVersion,
+					MergePatch: []byte(`{"address":{"city":"tel aviv","zip":null}}`),
+				},
+				{
+					Key:     "list",
+					Version: readVersion,
+					Operations: []*types.JSONPatchOperation{
+						{Op: "remove", Path: "/items/0"},
+						{Op: "add", Path: "/items/-", Value: []byte(`4`)},
+					},
+				},
+			},
+		})
+		require.NoError(t, addWriteSetDigests(env.db, block2))
+		require.NoError(t, env.committer.commitBlock(block2))
+
+		expected := map[string][]byte{
+			"profile": []byte(`{"address":{"city":"tel aviv"},"name":"alice"}`),
+			"list":    []byte(`{"items":[2,3,4]}`),
+		}
+		for key, expectedValue := range expected {
+			val, metadata, err := env.db.Get("db1", key)
+			require.NoError(t, err)
+			require.Equal(t, expectedValue, val)
+			require.True(t, proto.Equal(patchVersion, metadata.GetVersion()))
+
+			// the provenance store holds the resulting value rather than the patch
+			values, err := env.committer.provenanceStore.GetValues("db1", key)
+			require.NoError(t, err)
+			require.Len(t, values, 2)
+			v, err := env.committer.provenanceStore.GetValueAt("db1", key, patchVersion)
+			require.NoError(t, err)
+			require.Equal(t, expectedValue, v.GetValue())
+
+			readers, err := env.committer.provenanceStore.GetReaders("db1", key)
+			require.NoError(t, err)
+			require.Equal(t, map[string]uint32{"testUser": 1}, readers)
+		}
+
+		// the patched key keeps its access control
+		_, metadata, err := env.db.Get("db1", "profile")
+		require.NoError(t, err)
+		require.True(t, proto.Equal(acl, metadata.GetAccessControl()))
+
+		// the write-set digest covers the resulting values, as recorded by the provenance store
+		written, err := env.committer.provenanceStore.GetValuesWrittenByTx("dataTx2")
+		require.NoError(t, err)
+		dbsWrites := make(map[string][]*types.KVWithMetadata)
+		for dbName, kvs := range written {
+			dbsWrites[dbName] = kvs.GetKVs()
+		}
+		recomputedDigest, err := state.CalculateWriteSetDigest(dbsWrites, nil)
+		require.NoError(t, err)
+		require.Equal(t, block2.Header.ValidationInfo[0].WriteSetDigest, recomputedDigest)
+
+		block, err := env.blockStore.Get(2)
+		require.NoError(t, err)
+		stateTrieHash, err := env.committer.stateTrie.Hash()
+		require.NoError(t, err)
+		require.Equal(t, block.GetHeader().GetStateMerkelTreeRootHash(), stateTrieHash)
+
+		// the write bytes of the transaction count the resulting values rather than the patches
+		txInfo, err := env.blockStore.GetTxInfo("dataTx2")
+		require.NoError(t, err)
+		envBytes, err := marshal.DeterministicMarshal(block2.GetDataTxEnvelopes().GetEnvelopes()[0])
+		require.NoError(t, err)
+		rawBlock, err := env.blockStore.GetRaw(2)
+		require.NoError(t, err)
+		writeBytes := uint64(len("profile") + len(expected["profile"]) + len("list") + len(expected["list"]))
+		require.True(t, proto.Equal(&types.TxSizes{
+			EnvelopeBytes:      uint64(len(envBytes)),
+			WriteBytes:         writeBytes,
+			BlockBytes:         uint64(len(rawBlock)),
+			BlockTxCount:       1,
+			BlockEnvelopeBytes: uint64(len(envBytes)),
+			BlockWriteBytes:    writeBytes,
+		}, txInfo.GetSizes()), "%v", txInfo.GetSizes())
 	})
 }
 
@@ -572,6 +844,10 @@ func TestStateDBCommitterForDataBlockWithIndex(t *testing.T) {
 		require.NoError(t, err)
 		for itr.Next() {
 			k := string(itr.Key())
+			// the counts of the index entries are covered by the tests of the stateindex
+			if stateindex.IsCardinalityKey(k) {
+				continue
+			}
 			v := &types.ValueWithMetadata{}
 			require.NoError(t, proto.Unmarshal(itr.Value(), v))
 			require.NoError(t, err)
@@ -829,6 +1105,90 @@ func TestStateDBCommitterForUserBlock(t *testing.T) {
 	}
 }
 
+func TestStateDBCommitterForUserMiniBatch(t *testing.T) {
+	t.Parallel()
+
+	env := newCommitterTestEnv(t)
+	defer env.cleanup()
+
+	sampleVersion := &types.Version{
+		BlockNum: 1,
+		TxNum:    1,
+	}
+	users := map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				constructUserForTest(t, "user1", sampleVersion),
+				constructUserForTest(t, "user2", sampleVersion),
+			},
+		},
+	}
+	require.NoError(t, env.db.Commit(users, 1))
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: 2,
+			},
+			ValidationInfo: []*types.ValidationInfo{
+				{Flag: types.Flag_VALID},
+				{Flag: types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK},
+				{Flag: types.Flag_VALID},
+			},
+		},
+		Payload: &types.Block_UserAdministrationTxEnvelopes{
+			UserAdministrationTxEnvelopes: &types.UserAdministrationTxEnvelopes{
+				Envelopes: []*types.UserAdministrationTxEnvelope{
+					{
+						Payload: &types.UserAdministrationTx{
+							UserId: "user0",
+							TxId:   "tx1",
+							UserWrites: []*types.UserWrite{
+								{User: &types.User{Id: "user3", Certificate: []byte("certificate~user3")}},
+							},
+						},
+					},
+					{
+						Payload: &types.UserAdministrationTx{
+							UserId:      "user0",
+							TxId:        "tx2",
+							UserDeletes: []*types.UserDelete{{UserId: "user1"}},
+						},
+					},
+					{
+						Payload: &types.UserAdministrationTx{
+							UserId:      "user0",
+							TxId:        "tx3",
+							UserDeletes: []*types.UserDelete{{UserId: "user2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dbsUpdates, provenanceData, err := env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.Len(t, provenanceData, 3)
+	require.True(t, provenanceData[0].IsValid)
+	require.Equal(t, &provenance.TxDataForProvenance{IsValid: false, TxID: "tx2"}, provenanceData[1])
+	require.True(t, provenanceData[2].IsValid)
+	require.Equal(t, "tx3", provenanceData[2].TxID)
+	require.NoError(t, env.committer.commitToDBs(dbsUpdates, provenanceData, block))
+
+	exist, err := env.identityQuerier.DoesUserExist("user1")
+	require.NoError(t, err)
+	require.True(t, exist)
+	exist, err = env.identityQuerier.DoesUserExist("user2")
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	user3, metadata, err := env.identityQuerier.GetUser("user3")
+	require.NoError(t, err)
+	require.Equal(t, []byte("certificate~user3"), user3.Certificate)
+	require.Equal(t, &types.Version{BlockNum: 2, TxNum: 0}, metadata.Version)
+}
+
 func TestStateDBCommitterForDBBlock(t *testing.T) {
 	t.Parallel()
 
@@ -1037,96 +1397,514 @@ func TestStateDBCommitterForDBBlock(t *testing.T) {
 					},
 				}
 
-				require.NoError(t, db.Commit(createDBs, 1))
-			},
-			expectedDBsBefore: []string{"db1", "db2"},
-			expectedIndexBefore: map[string]*types.DBIndex{
-				"db1": nil,
-				"db2": nil,
-			},
-			dbAdminTx: &types.DBAdministrationTx{
-				DeleteDbs: []string{"db1", "db2"},
+				require.NoError(t, db.Commit(createDBs, 1))
+			},
+			expectedDBsBefore: []string{"db1", "db2"},
+			expectedIndexBefore: map[string]*types.DBIndex{
+				"db1": nil,
+				"db2": nil,
+			},
+			dbAdminTx: &types.DBAdministrationTx{
+				DeleteDbs: []string{"db1", "db2"},
+			},
+			expectedDBsAfter: []string{"db1", "db2"},
+			expectedIndexAfter: map[string]*types.DBIndex{
+				"db1": nil,
+				"db2": nil,
+			},
+			valInfo: []*types.ValidationInfo{
+				{
+					Flag: types.Flag_INVALID_NO_PERMISSION,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newCommitterTestEnv(t)
+			defer env.cleanup()
+
+			tt.setup(env.db)
+
+			for _, dbName := range tt.expectedDBsBefore {
+				require.True(t, env.db.Exist(dbName))
+				index, _, err := env.db.GetIndexDefinition(dbName)
+				require.NoError(t, err)
+
+				var expectedIndex []byte
+				if tt.expectedIndexBefore[dbName] != nil {
+					expectedIndex, err = json.Marshal(tt.expectedIndexBefore[dbName].GetAttributeAndType())
+				}
+				require.NoError(t, err)
+				require.Equal(t, expectedIndex, index)
+			}
+
+			for _, dbName := range tt.expectedIndexDBsBefore {
+				require.True(t, env.db.Exist(stateindex.IndexDB(dbName)))
+			}
+
+			block := &types.Block{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number: 2,
+					},
+					ValidationInfo: tt.valInfo,
+				},
+				Payload: &types.Block_DbAdministrationTxEnvelope{
+					DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
+						Payload: tt.dbAdminTx,
+					},
+				},
+			}
+
+			dbsUpdates, provenanceData, err := env.committer.constructDBAndProvenanceEntries(block)
+			require.NoError(t, err)
+			require.NoError(t, env.committer.commitToDBs(dbsUpdates, provenanceData, block))
+
+			for _, dbName := range tt.expectedDBsAfter {
+				require.True(t, env.db.Exist(dbName))
+				index, _, err := env.db.GetIndexDefinition(dbName)
+				require.NoError(t, err)
+
+				var expectedIndex []byte
+				if tt.expectedIndexAfter[dbName] != nil {
+					expectedIndex, err = json.Marshal(tt.expectedIndexAfter[dbName].GetAttributeAndType())
+				}
+				require.NoError(t, err)
+				require.Equal(t, expectedIndex, index)
+			}
+
+			for _, dbName := range tt.expectedIndexDBsAfter {
+				require.True(t, env.db.Exist(stateindex.IndexDB(dbName)))
+			}
+
+			for _, dbName := range tt.expectedNoIndexDBsAfter {
+				require.False(t, env.db.Exist(stateindex.IndexDB(dbName)))
+			}
+		})
+	}
+}
+
+func TestStateDBCommitterForDBBlockWithSchemas(t *testing.T) {
+	t.Parallel()
+
+	env := newCommitterTestEnv(t)
+	defer env.cleanup()
+
+	descriptor := func(schema string) []byte {
+		d, err := proto.Marshal(&types.DBDescriptor{JsonSchema: schema})
+		require.NoError(t, err)
+		return d
+	}
+
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {
+			Writes: []*worldstate.KVWithMetadata{{Key: "db1"}, {Key: "db2"}},
+		},
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: "db1", Value: descriptor(`{"type":"object"}`), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}}},
+				{Key: "db2", Value: descriptor(`{"type":"array"}`), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}}},
+			},
+		},
+	}, 1))
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: 2,
+			},
+			ValidationInfo: []*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+			},
+		},
+		Payload: &types.Block_DbAdministrationTxEnvelope{
+			DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
+				Payload: &types.DBAdministrationTx{
+					CreateDbs: []string{"db3"},
+					DeleteDbs: []string{"db2"},
+					DbsSchema: map[string]*types.DBSchema{
+						"db1":                    {JsonSchema: `{"type":"object","required":["name"]}`},
+						"db3":                    {JsonSchema: `{"type":"string"}`},
+						worldstate.DefaultDBName: {},
+					},
+				},
+			},
+		},
+	}
+
+	dbsUpdates, provenanceData, err := env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.NoError(t, env.committer.commitToDBs(dbsUpdates, provenanceData, block))
+
+	expectedDescriptors := map[string]string{
+		"db1": `{"type":"object","required":["name"]}`,
+		"db3": `{"type":"string"}`,
+	}
+	for dbName, schema := range expectedDescriptors {
+		value, metadata, err := env.db.Get(worldstate.DBDescriptorsDBName, dbName)
+		require.NoError(t, err)
+		require.Equal(t, descriptor(schema), value)
+		require.True(t, proto.Equal(&types.Version{BlockNum: 2}, metadata.GetVersion()))
+	}
+
+	// the descriptor of a deleted database is removed along with it
+	for _, dbName := range []string{"db2", worldstate.DefaultDBName} {
+		exist, err := env.db.Has(worldstate.DBDescriptorsDBName, dbName)
+		require.NoError(t, err)
+		require.False(t, exist)
+	}
+
+	// a block that changes no schema leaves the descriptors untouched
+	block.Header.BaseHeader.Number = 3
+	block.Payload = &types.Block_DbAdministrationTxEnvelope{
+		DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
+			Payload: &types.DBAdministrationTx{
+				CreateDbs: []string{"db4"},
+			},
+		},
+	}
+	dbsUpdates, _, err = env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.NotContains(t, dbsUpdates, worldstate.DBDescriptorsDBName)
+}
+
+func TestStateDBCommitterForDBBlockWithDefaultACLs(t *testing.T) {
+	t.Parallel()
+
+	env := newCommitterTestEnv(t)
+	defer env.cleanup()
+
+	aliceACL := &types.AccessControl{ReadWriteUsers: map[string]bool{"alice": true}}
+	dbAdminBlock := func(number uint64, tx *types.DBAdministrationTx) *types.Block {
+		tx.UserId = "admin"
+		tx.TxId = fmt.Sprintf("dbAdminTx%d", number)
+		return &types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number: number,
+				},
+				ValidationInfo: []*types.ValidationInfo{
+					{
+						Flag: types.Flag_VALID,
+					},
+				},
+			},
+			Payload: &types.Block_DbAdministrationTxEnvelope{
+				DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
+					Payload: tx,
+				},
+			},
+		}
+	}
+	commit := func(block *types.Block) {
+		dbsUpdates, provenanceData, err := env.committer.constructDBAndProvenanceEntries(block)
+		require.NoError(t, err)
+		require.NoError(t, env.committer.commitToDBs(dbsUpdates, provenanceData, block))
+	}
+	requireDescriptor := func(dbName string, expected *types.DBDescriptor, blockNum uint64) {
+		value, metadata, err := env.db.Get(worldstate.DBDescriptorsDBName, dbName)
+		require.NoError(t, err)
+		descriptor := &types.DBDescriptor{}
+		require.NoError(t, proto.Unmarshal(value, descriptor))
+		require.True(t, proto.Equal(expected, descriptor), "%v", descriptor)
+		require.True(t, proto.Equal(&types.Version{BlockNum: blockNum}, metadata.GetVersion()))
+	}
+
+	commit(dbAdminBlock(2, &types.DBAdministrationTx{
+		CreateDbs: []string{"db1", "db2"},
+		DbsSchema: map[string]*types.DBSchema{
+			"db1": {JsonSchema: `{"type":"object"}`},
+		},
+		DbsDefaultAcl: map[string]*types.DBDefaultACL{
+			"db2": {Acl: aliceACL},
+		},
+	}))
+	requireDescriptor("db1", &types.DBDescriptor{JsonSchema: `{"type":"object"}`}, 2)
+	requireDescriptor("db2", &types.DBDescriptor{DefaultAcl: aliceACL}, 2)
+
+	// a default ACL set on a database with a schema keeps the schema, and the removal of every setting keeps an
+	// empty descriptor
+	commit(dbAdminBlock(3, &types.DBAdministrationTx{
+		DbsDefaultAcl: map[string]*types.DBDefaultACL{
+			"db1": {Acl: aliceACL},
+			"db2": {},
+		},
+	}))
+	requireDescriptor("db1", &types.DBDescriptor{JsonSchema: `{"type":"object"}`, DefaultAcl: aliceACL}, 3)
+	requireDescriptor("db2", &types.DBDescriptor{}, 3)
+
+	// a value size cap set alone keeps the other settings
+	commit(dbAdminBlock(4, &types.DBAdministrationTx{
+		DeleteDbs: []string{"db2"},
+		DbsMaxValueSize: map[string]*types.DBMaxValueSize{
+			"db1": {MaxValueSizeBytes: 1024},
+		},
+	}))
+	requireDescriptor("db1", &types.DBDescriptor{JsonSchema: `{"type":"object"}`, DefaultAcl: aliceACL, MaxValueSizeBytes: 1024}, 4)
+	exist, err := env.db.Has(worldstate.DBDescriptorsDBName, "db2")
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	// every version of a descriptor is recorded in the provenance store along with the admin who set it
+	values, err := env.committer.provenanceStore.GetWrittenValues(worldstate.DBDescriptorsDBName, "db1")
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+	for i, blockNum := range []uint64{2, 3, 4} {
+		require.Equal(t, blockNum, values[i].Value.GetMetadata().GetVersion().GetBlockNum())
+		require.Equal(t, fmt.Sprintf("dbAdminTx%d", blockNum), values[i].TxID)
+		require.Equal(t, "admin", values[i].UserID)
+	}
+
+	value, _, err := env.committer.provenanceStore.GetValueAsOf(worldstate.DBDescriptorsDBName, "db2", 3)
+	require.NoError(t, err)
+	require.NotNil(t, value)
+	value, _, err = env.committer.provenanceStore.GetValueAsOf(worldstate.DBDescriptorsDBName, "db2", 4)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestStateDBCommitterForImmutableDB(t *testing.T) {
+	t.Parallel()
+
+	env := newCommitterTestEnv(t)
+	defer env.cleanup()
+
+	require.NoError(t, env.committer.commitBlock(&types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: 1,
+			},
+			ValidationInfo: []*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+			},
+		},
+		Payload: &types.Block_DbAdministrationTxEnvelope{
+			DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
+				Payload: &types.DBAdministrationTx{
+					UserId:       "admin",
+					TxId:         "dbAdminTx1",
+					CreateDbs:    []string{"db1"},
+					ImmutableDbs: []string{"db1"},
+				},
+			},
+		},
+	}))
+
+	value, _, err := env.db.Get(worldstate.DBDescriptorsDBName, "db1")
+	require.NoError(t, err)
+	descriptor := &types.DBDescriptor{}
+	require.NoError(t, proto.Unmarshal(value, descriptor))
+	require.True(t, proto.Equal(&types.DBDescriptor{Immutable: true}, descriptor), "%v", descriptor)
+
+	dataBlock := func(number uint64, keys ...string) *types.Block {
+		ops := &types.DBOperation{DbName: "db1"}
+		for _, key := range keys {
+			ops.DataWrites = append(ops.DataWrites, &types.DataWrite{Key: key, Value: []byte("content-" + key)})
+		}
+		block := &types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number:       number,
+					RulesVersion: constants.RulesVersion,
+				},
+				ValidationInfo: []*types.ValidationInfo{
+					{
+						Flag: types.Flag_VALID,
+					},
+				},
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{
+					Envelopes: []*types.DataTxEnvelope{
+						{
+							Payload: &types.DataTx{
+								MustSignUserIds: []string{"testUser"},
+								TxId:            fmt.Sprintf("dataTx%d", number),
+								DbOperations:    []*types.DBOperation{ops},
+							},
+						},
+					},
+				},
+			},
+		}
+		require.NoError(t, addWriteSetDigests(env.db, block))
+		return block
+	}
+
+	createVersion := &types.Version{BlockNum: 2, TxNum: 0}
+	require.NoError(t, env.committer.commitBlock(dataBlock(2, "digest1")))
+
+	// the rewrites of the key with its value leave its version and its provenance as they are, while the new keys
+	// written along with them are committed
+	for blockNum := uint64(3); blockNum <= 10; blockNum++ {
+		block := dataBlock(blockNum, "digest1", fmt.Sprintf("digest%d", blockNum))
+		require.NoError(t, env.committer.commitBlock(block))
+
+		value, metadata, err := env.db.Get("db1", "digest1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("content-digest1"), value)
+		require.True(t, proto.Equal(createVersion, metadata.GetVersion()), "%v", metadata.GetVersion())
+
+		values, err := env.committer.provenanceStore.GetValues("db1", "digest1")
+		require.NoError(t, err)
+		require.Len(t, values, 1)
+		require.True(t, proto.Equal(createVersion, values[0].GetMetadata().GetVersion()))
+
+		newKey := fmt.Sprintf("digest%d", blockNum)
+		_, metadata, err = env.db.Get("db1", newKey)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&types.Version{BlockNum: blockNum}, metadata.GetVersion()))
+
+		// the write set of the transaction holds the new key only
+		written, err := env.committer.provenanceStore.GetValuesWrittenByTx(fmt.Sprintf("dataTx%d", blockNum))
+		require.NoError(t, err)
+		require.Len(t, written["db1"].GetKVs(), 1)
+		require.Equal(t, newKey, written["db1"].GetKVs()[0].GetKey())
+		recomputedDigest, err := state.CalculateWriteSetDigest(map[string][]*types.KVWithMetadata{"db1": written["db1"].GetKVs()}, nil)
+		require.NoError(t, err)
+		require.Equal(t, block.Header.ValidationInfo[0].WriteSetDigest, recomputedDigest)
+	}
+
+	// a block made of rewrites only leaves the state trie as it is
+	hashBefore, err := env.committer.stateTrie.Hash()
+	require.NoError(t, err)
+	require.NoError(t, env.committer.commitBlock(dataBlock(11, "digest1", "digest3")))
+	hashAfter, err := env.committer.stateTrie.Hash()
+	require.NoError(t, err)
+	require.Equal(t, hashBefore, hashAfter)
+}
+
+func TestStateDBCommitterForVoidBlock(t *testing.T) {
+	t.Parallel()
+
+	env := newCommitterTestEnv(t)
+	defer env.cleanup()
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: 2,
 			},
-			expectedDBsAfter: []string{"db1", "db2"},
-			expectedIndexAfter: map[string]*types.DBIndex{
-				"db1": nil,
-				"db2": nil,
+			ValidationInfo: []*types.ValidationInfo{
+				{Flag: types.Flag_VOIDED},
+				{Flag: types.Flag_VOIDED},
+				{Flag: types.Flag_INVALID_OUT_OF_ORDER},
+				{Flag: types.Flag_VOIDED},
 			},
-			valInfo: []*types.ValidationInfo{
-				{
-					Flag: types.Flag_INVALID_NO_PERMISSION,
+		},
+		Payload: &types.Block_VoidTxEnvelopes{
+			VoidTxEnvelopes: &types.VoidTxEnvelopes{
+				Envelopes: []*types.VoidTxEnvelope{
+					{Payload: &types.VoidTx{UserId: "user1", TxId: "tx1", Sequence: 1}},
+					{Payload: &types.VoidTx{UserId: "user1", TxId: "tx2", Sequence: 2}},
+					{Payload: &types.VoidTx{UserId: "user2", TxId: "tx3", Sequence: 2}},
+					{Payload: &types.VoidTx{UserId: "user3", TxId: "tx4"}},
 				},
 			},
 		},
 	}
 
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			env := newCommitterTestEnv(t)
-			defer env.cleanup()
-
-			tt.setup(env.db)
+	dbsUpdates, provenanceData, err := env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.Empty(t, provenanceData)
+	// a void writes nothing but the sequence number of its user
+	require.Len(t, dbsUpdates, 1)
+	require.NoError(t, env.committer.commitToDBs(dbsUpdates, provenanceData, block))
+
+	idQuerier := identity.NewQuerier(env.db)
+	for userID, expectedSeq := range map[string]uint64{"user1": 2, "user2": 0, "user3": 0} {
+		seq, err := idQuerier.GetLastSequence(userID)
+		require.NoError(t, err)
+		require.Equal(t, expectedSeq, seq, userID)
+	}
 
-			for _, dbName := range tt.expectedDBsBefore {
-				require.True(t, env.db.Exist(dbName))
-				index, _, err := env.db.GetIndexDefinition(dbName)
-				require.NoError(t, err)
+	// a block without a voided sequence number updates nothing
+	block.Header.BaseHeader.Number = 3
+	block.Header.ValidationInfo = block.Header.ValidationInfo[2:]
+	block.GetVoidTxEnvelopes().Envelopes = block.GetVoidTxEnvelopes().Envelopes[2:]
+	dbsUpdates, _, err = env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.Empty(t, dbsUpdates)
+}
 
-				var expectedIndex []byte
-				if tt.expectedIndexBefore[dbName] != nil {
-					expectedIndex, err = json.Marshal(tt.expectedIndexBefore[dbName].GetAttributeAndType())
-				}
-				require.NoError(t, err)
-				require.Equal(t, expectedIndex, index)
-			}
+func TestStateDBCommitterForAttestations(t *testing.T) {
+	t.Parallel()
 
-			for _, dbName := range tt.expectedIndexDBsBefore {
-				require.True(t, env.db.Exist(stateindex.IndexDB(dbName)))
-			}
+	env := newCommitterTestEnv(t)
+	defer env.cleanup()
 
-			block := &types.Block{
-				Header: &types.BlockHeader{
-					BaseHeader: &types.BlockHeaderBase{
-						Number: 2,
-					},
-					ValidationInfo: tt.valInfo,
+	attestation := &types.DataTxEnvelope{
+		Payload: &types.DataTx{
+			MustSignUserIds:    []string{"user1"},
+			TxId:               "attestation1",
+			DbOperations:       []*types.DBOperation{{DbName: worldstate.DefaultDBName}},
+			AttestationPayload: []byte("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"),
+		},
+	}
+	write := &types.DataTxEnvelope{
+		Payload: &types.DataTx{
+			MustSignUserIds: []string{"user1"},
+			TxId:            "tx1",
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: "key1", Value: []byte("value1")}},
 				},
-				Payload: &types.Block_DbAdministrationTxEnvelope{
-					DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
-						Payload: tt.dbAdminTx,
-					},
+			},
+		},
+	}
+	newBlock := func(number uint64, rulesVersion uint32, envelopes ...*types.DataTxEnvelope) *types.Block {
+		block := &types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number:       number,
+					RulesVersion: rulesVersion,
 				},
-			}
-
-			dbsUpdates, provenanceData, err := env.committer.constructDBAndProvenanceEntries(block)
-			require.NoError(t, err)
-			require.NoError(t, env.committer.commitToDBs(dbsUpdates, provenanceData, block))
-
-			for _, dbName := range tt.expectedDBsAfter {
-				require.True(t, env.db.Exist(dbName))
-				index, _, err := env.db.GetIndexDefinition(dbName)
-				require.NoError(t, err)
-
-				var expectedIndex []byte
-				if tt.expectedIndexAfter[dbName] != nil {
-					expectedIndex, err = json.Marshal(tt.expectedIndexAfter[dbName].GetAttributeAndType())
-				}
-				require.NoError(t, err)
-				require.Equal(t, expectedIndex, index)
-			}
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{Envelopes: envelopes},
+			},
+		}
+		for range envelopes {
+			block.Header.ValidationInfo = append(block.Header.ValidationInfo, &types.ValidationInfo{Flag: types.Flag_VALID})
+		}
+		return block
+	}
 
-			for _, dbName := range tt.expectedIndexDBsAfter {
-				require.True(t, env.db.Exist(stateindex.IndexDB(dbName)))
-			}
+	// the attestation is recorded in the provenance store, but leaves the state to the write
+	block := newBlock(2, constants.AttestationRulesVersion, attestation, write)
+	dbsUpdates, provenanceData, err := env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.Len(t, dbsUpdates, 1)
+	require.Len(t, dbsUpdates[worldstate.DefaultDBName].Writes, 1)
+	require.Equal(t, "key1", dbsUpdates[worldstate.DefaultDBName].Writes[0].Key)
+	require.Len(t, provenanceData, 2)
+	require.Equal(t, "attestation1", provenanceData[0].TxID)
+	require.True(t, provenanceData[0].IsValid)
+	require.Empty(t, provenanceData[0].Writes)
+
+	// a block of attestations only updates no database, not even the digest of the attested one
+	block = newBlock(2, constants.AttestationRulesVersion, attestation)
+	dbsUpdates, provenanceData, err = env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.Empty(t, dbsUpdates)
+	require.Len(t, provenanceData, 1)
 
-			for _, dbName := range tt.expectedNoIndexDBsAfter {
-				require.False(t, env.db.Exist(stateindex.IndexDB(dbName)))
-			}
-		})
-	}
+	// the attestation payload of a block of older rules is ignored, as it was by the nodes that produced the block
+	block = newBlock(2, constants.TxDeadlinesRulesVersion, attestation)
+	dbsUpdates, _, err = env.committer.constructDBAndProvenanceEntries(block)
+	require.NoError(t, err)
+	require.Contains(t, dbsUpdates, worldstate.DefaultDBName)
+	require.Empty(t, dbsUpdates[worldstate.DefaultDBName].Writes)
 }
 
 func TestStateDBCommitterForConfigBlock(t *testing.T) {
@@ -3028,6 +3806,408 @@ func TestConstructProvenanceEntriesForConfigTx(t *testing.T) {
 	}
 }
 
+func TestAddWriteSetDigests(t *testing.T) {
+	t.Parallel()
+
+	dataTx := func(txID string, writes ...*types.DataWrite) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds: []string{"testUser"},
+				TxId:            txID,
+				DbOperations: []*types.DBOperation{
+					{
+						DbName:     worldstate.DefaultDBName,
+						DataWrites: writes,
+					},
+				},
+			},
+		}
+	}
+
+	write1 := &types.DataWrite{
+		Key:   "key1",
+		Value: []byte("value1"),
+		Acl: &types.AccessControl{
+			ReadUsers: map[string]bool{
+				"user1": true,
+				"user2": true,
+			},
+		},
+	}
+	write2 := &types.DataWrite{
+		Key:   "key2",
+		Value: []byte("value2"),
+	}
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number:       2,
+				RulesVersion: constants.RulesVersion,
+			},
+			ValidationInfo: []*types.ValidationInfo{
+				{Flag: types.Flag_VALID},
+				{Flag: types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE},
+				{Flag: types.Flag_VALID},
+			},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{
+					dataTx("tx1", write1, write2),
+					dataTx("tx2", write1),
+					dataTx("tx3", write2, write1),
+				},
+			},
+		},
+	}
+
+	require.NoError(t, addWriteSetDigests(nil, block))
+	valInfo := block.Header.ValidationInfo
+	require.NotEmpty(t, valInfo[0].WriteSetDigest)
+	require.Empty(t, valInfo[1].WriteSetDigest)
+	require.NotEmpty(t, valInfo[2].WriteSetDigest)
+	// the same writes at a different version must result in a different digest
+	require.NotEqual(t, valInfo[0].WriteSetDigest, valInfo[2].WriteSetDigest)
+
+	// the digest must not depend on the order of writes
+	expectedDigest, err := CalculateWriteSetDigestForDataTx(nil,
+		dataTx("tx3", write1, write2).Payload,
+		&types.Version{BlockNum: 2, TxNum: 2},
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedDigest, valInfo[2].WriteSetDigest)
+
+	// a change in the ACL must result in a different digest
+	write1WithoutACL := proto.Clone(write1).(*types.DataWrite)
+	write1WithoutACL.Acl = nil
+	digest, err := CalculateWriteSetDigestForDataTx(nil,
+		dataTx("tx1", write1WithoutACL, write2).Payload,
+		&types.Version{BlockNum: 2, TxNum: 0},
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, valInfo[0].WriteSetDigest, digest)
+
+	// a block of rules that predate the digests is left without them, as it was by the node that produced it
+	for _, rulesVersion := range []uint32{0, constants.WriteSetDigestRulesVersion - 1} {
+		legacyBlock := proto.Clone(block).(*types.Block)
+		legacyBlock.Header.BaseHeader.RulesVersion = rulesVersion
+		for _, info := range legacyBlock.Header.ValidationInfo {
+			info.WriteSetDigest = nil
+		}
+		require.NoError(t, addWriteSetDigests(nil, legacyBlock))
+		for _, info := range legacyBlock.Header.ValidationInfo {
+			require.Empty(t, info.WriteSetDigest)
+		}
+	}
+
+	userAdminBlock := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: 3,
+			},
+			ValidationInfo: []*types.ValidationInfo{
+				{Flag: types.Flag_VALID},
+			},
+		},
+		Payload: &types.Block_UserAdministrationTxEnvelope{
+			UserAdministrationTxEnvelope: &types.UserAdministrationTxEnvelope{
+				Payload: &types.UserAdministrationTx{
+					UserId: "admin",
+					TxId:   "tx4",
+				},
+			},
+		},
+	}
+	require.NoError(t, addWriteSetDigests(nil, userAdminBlock))
+	require.Empty(t, userAdminBlock.Header.ValidationInfo[0].WriteSetDigest)
+}
+
+func TestConstructDBUpdatesIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	env := newCommitterTestEnv(t)
+	defer env.cleanup()
+
+	committedConfig, err := worldstate.MarshalValue(&types.ClusterConfig{
+		Admins: []*types.Admin{{Id: "admin0", Certificate: []byte("certificate~admin0")}},
+	})
+	require.NoError(t, err)
+	setup := map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: "db1"},
+				{Key: "db2"},
+				{Key: "db3"},
+			},
+		},
+		worldstate.ConfigDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: worldstate.ConfigKey, Value: committedConfig},
+			},
+		},
+	}
+	setup[worldstate.UsersDBName] = &worldstate.DBUpdates{
+		Writes: []*worldstate.KVWithMetadata{constructUserForTest(t, "admin0", &types.Version{BlockNum: 1})},
+	}
+	for i := 1; i <= 20; i++ {
+		setup[worldstate.UsersDBName].Writes = append(setup[worldstate.UsersDBName].Writes,
+			constructUserForTest(t, fmt.Sprintf("deleted%d", i), &types.Version{BlockNum: 1}))
+	}
+	require.NoError(t, env.db.Commit(setup, 1))
+
+	acl := func(i int) *types.AccessControl {
+		return &types.AccessControl{
+			ReadUsers:      map[string]bool{"alice": true, "bob": true, "charlie": true, fmt.Sprintf("user%d", i): true},
+			ReadWriteUsers: map[string]bool{"alice": true, "dave": true, "eve": true},
+		}
+	}
+
+	var dataTxs []*types.DataTxEnvelope
+	var dataValInfo []*types.ValidationInfo
+	for i := 0; i < 20; i++ {
+		tx := &types.DataTx{
+			MustSignUserIds: []string{fmt.Sprintf("user%d", i%3)},
+			TxId:            fmt.Sprintf("tx%d", i),
+			Sequence:        uint64(i % 3),
+		}
+		for _, dbName := range []string{"db3", "db1", "db2"} {
+			ops := &types.DBOperation{DbName: dbName}
+			for k := 9; k >= 0; k-- {
+				ops.DataWrites = append(ops.DataWrites, &types.DataWrite{
+					Key:   fmt.Sprintf("key%d-%d", k, i),
+					Value: []byte(fmt.Sprintf("value%d-%d", k, i)),
+					Acl:   acl(i),
+				})
+			}
+			ops.DataDeletes = []*types.DataDelete{{Key: fmt.Sprintf("deleted%d", 20-i)}}
+			tx.DbOperations = append(tx.DbOperations, ops)
+		}
+		dataTxs = append(dataTxs, &types.DataTxEnvelope{Payload: tx})
+
+		flag := types.Flag_VALID
+		if i%7 == 6 {
+			flag = types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE
+		}
+		dataValInfo = append(dataValInfo, &types.ValidationInfo{Flag: flag})
+	}
+
+	var userWrites []*types.UserWrite
+	var userDeletes []*types.UserDelete
+	for i := 20; i > 0; i-- {
+		userWrites = append(userWrites, &types.UserWrite{
+			User: &types.User{
+				Id:          fmt.Sprintf("user%d", i),
+				Certificate: []byte(fmt.Sprintf("certificate~user%d", i)),
+				Privilege: &types.Privilege{
+					DbPermission: map[string]types.Privilege_Access{
+						"db1": types.Privilege_Read,
+						"db2": types.Privilege_ReadWrite,
+						"db3": types.Privilege_Read,
+					},
+				},
+			},
+			Acl: acl(i),
+		})
+		userDeletes = append(userDeletes, &types.UserDelete{UserId: fmt.Sprintf("deleted%d", i)})
+	}
+
+	var admins []*types.Admin
+	var nodes []*types.NodeConfig
+	var peers []*types.PeerConfig
+	for i := uint64(10); i > 0; i-- {
+		admins = append(admins, &types.Admin{Id: fmt.Sprintf("admin%d", i), Certificate: []byte(fmt.Sprintf("certificate~admin%d", i))})
+		nodes = append(nodes, constructNodeEntryForTest(i))
+		peers = append(peers, constructPeerEntryForTest(i))
+	}
+
+	var heartbeats []*types.HeartbeatTxEnvelope
+	var voids []*types.VoidTxEnvelope
+	var valInfo []*types.ValidationInfo
+	for i := 10; i > 0; i-- {
+		heartbeats = append(heartbeats, &types.HeartbeatTxEnvelope{
+			Payload: &types.HeartbeatTx{NodeId: fmt.Sprintf("bdb-node-%d", i), TxId: fmt.Sprintf("hb%d", i)},
+		})
+		voids = append(voids, &types.VoidTxEnvelope{
+			Payload: &types.VoidTx{UserId: fmt.Sprintf("user%d", i), TxId: fmt.Sprintf("void%d", i), Sequence: uint64(i)},
+		})
+		valInfo = append(valInfo, &types.ValidationInfo{Flag: types.Flag_VALID})
+	}
+	var userAdminTxs []*types.UserAdministrationTxEnvelope
+	var userAdminValInfo []*types.ValidationInfo
+	for i := range userWrites {
+		userAdminTxs = append(userAdminTxs, &types.UserAdministrationTxEnvelope{
+			Payload: &types.UserAdministrationTx{
+				UserId:      "admin0",
+				TxId:        fmt.Sprintf("user-tx%d", i),
+				UserWrites:  userWrites[i : i+1],
+				UserDeletes: userDeletes[i : i+1],
+			},
+		})
+		userAdminValInfo = append(userAdminValInfo, &types.ValidationInfo{Flag: types.Flag_VALID})
+	}
+
+	voidValInfo := make([]*types.ValidationInfo, len(voids))
+	for i := range voidValInfo {
+		voidValInfo[i] = &types.ValidationInfo{Flag: types.Flag_VOIDED}
+	}
+
+	index := &types.DBIndex{
+		AttributeAndType: map[string]types.IndexAttributeType{
+			"a": types.IndexAttributeType_NUMBER,
+			"b": types.IndexAttributeType_STRING,
+			"c": types.IndexAttributeType_BOOLEAN,
+		},
+	}
+
+	header := func(valInfo []*types.ValidationInfo) *types.BlockHeader {
+		return &types.BlockHeader{
+			BaseHeader:     &types.BlockHeaderBase{Number: 2},
+			ValidationInfo: valInfo,
+		}
+	}
+	valid := []*types.ValidationInfo{{Flag: types.Flag_VALID}}
+
+	// each payload type of a block must be covered here, so that the updates of a new type of transaction are
+	// checked to be deterministic too
+	blocks := map[string]*types.Block{
+		"data_tx_envelopes": {
+			Header: header(dataValInfo),
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{Envelopes: dataTxs},
+			},
+		},
+		"user_administration_tx_envelope": {
+			Header: header(valid),
+			Payload: &types.Block_UserAdministrationTxEnvelope{
+				UserAdministrationTxEnvelope: &types.UserAdministrationTxEnvelope{
+					Payload: &types.UserAdministrationTx{UserId: "admin0", TxId: "user-tx", UserWrites: userWrites, UserDeletes: userDeletes},
+				},
+			},
+		},
+		"user_administration_tx_envelopes": {
+			Header: header(userAdminValInfo),
+			Payload: &types.Block_UserAdministrationTxEnvelopes{
+				UserAdministrationTxEnvelopes: &types.UserAdministrationTxEnvelopes{Envelopes: userAdminTxs},
+			},
+		},
+		"db_administration_tx_envelope": {
+			Header: header(valid),
+			Payload: &types.Block_DbAdministrationTxEnvelope{
+				DbAdministrationTxEnvelope: &types.DBAdministrationTxEnvelope{
+					Payload: &types.DBAdministrationTx{
+						UserId:    "admin0",
+						TxId:      "db-tx",
+						CreateDbs: []string{"db9", "db7", "db8", "db4"},
+						DeleteDbs: []string{"db3"},
+						DbsIndex: map[string]*types.DBIndex{
+							"db1": index,
+							"db2": index,
+							"db4": index,
+							"db7": index,
+							"db9": index,
+						},
+						DbsDefaultAcl: map[string]*types.DBDefaultACL{
+							"db1": {Acl: acl(1)},
+							"db2": {Acl: acl(2)},
+							"db8": {Acl: acl(8)},
+						},
+					},
+				},
+			},
+		},
+		"config_tx_envelope": {
+			Header: header(valid),
+			Payload: &types.Block_ConfigTxEnvelope{
+				ConfigTxEnvelope: &types.ConfigTxEnvelope{
+					Payload: &types.ConfigTx{
+						UserId: "admin0",
+						TxId:   "config-tx",
+						NewConfig: &types.ClusterConfig{
+							Nodes:  nodes,
+							Admins: admins,
+							ConsensusConfig: &types.ConsensusConfig{
+								Algorithm:  "raft",
+								Members:    peers,
+								RaftConfig: &types.RaftConfig{TickInterval: "100ms"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"heartbeat_tx_envelopes": {
+			Header: header(valInfo),
+			Payload: &types.Block_HeartbeatTxEnvelopes{
+				HeartbeatTxEnvelopes: &types.HeartbeatTxEnvelopes{Envelopes: heartbeats},
+			},
+		},
+		"void_tx_envelopes": {
+			Header: header(voidValInfo),
+			Payload: &types.Block_VoidTxEnvelopes{
+				VoidTxEnvelopes: &types.VoidTxEnvelopes{Envelopes: voids},
+			},
+		},
+	}
+
+	payloads := (&types.Block{}).ProtoReflect().Descriptor().Oneofs().ByName("Payload").Fields()
+	for i := 0; i < payloads.Len(); i++ {
+		name := string(payloads.Get(i).Name())
+		block, ok := blocks[name]
+		require.True(t, ok, "no block covers the payload type [%s]", name)
+
+		t.Run(name, func(t *testing.T) {
+			requireDeterministicDBUpdates(t, env.committer, block, 100)
+		})
+	}
+}
+
+// requireDeterministicDBUpdates constructs the updates of the block the given number of times, and checks that every
+// run yields the same updates, that the updates of each database are ordered by key, and that the block is left as
+// is. The updates of any new type of transaction are expected to pass it.
+func requireDeterministicDBUpdates(t *testing.T, c *committer, block *types.Block, runs int) {
+	original := proto.Clone(block)
+
+	var expected []byte
+	for run := 0; run < runs; run++ {
+		dbsUpdates, _, err := c.constructDBAndProvenanceEntries(block)
+		require.NoError(t, err)
+		require.NotEmpty(t, dbsUpdates)
+
+		for dbName, updates := range dbsUpdates {
+			require.True(t, sort.SliceIsSorted(updates.Writes, func(i, j int) bool {
+				return updates.Writes[i].Key < updates.Writes[j].Key
+			}), "the writes to database [%s] are not ordered by key", dbName)
+			require.True(t, sort.StringsAreSorted(updates.Deletes), "the deletes of database [%s] are not ordered by key", dbName)
+		}
+
+		serialized := serializeDBUpdatesForTest(t, dbsUpdates)
+		if run == 0 {
+			expected = serialized
+			continue
+		}
+		require.Equal(t, expected, serialized, "run %d constructed different updates", run)
+	}
+
+	require.True(t, proto.Equal(original, block), "the construction of the updates modified the block")
+}
+
+func serializeDBUpdatesForTest(t *testing.T, dbsUpdates map[string]*worldstate.DBUpdates) []byte {
+	var serialized []byte
+	for _, dbName := range worldstate.SortedDBNames(dbsUpdates) {
+		serialized = append(serialized, fmt.Sprintf("db %q\n", dbName)...)
+		for _, kv := range dbsUpdates[dbName].Writes {
+			metadata, err := worldstate.MarshalValue(kv.Metadata)
+			require.NoError(t, err)
+			serialized = append(serialized, fmt.Sprintf("write %q %x %x\n", kv.Key, kv.Value, metadata)...)
+		}
+		for _, key := range dbsUpdates[dbName].Deletes {
+			serialized = append(serialized, fmt.Sprintf("delete %q\n", key)...)
+		}
+	}
+	return serialized
+}
+
 func constructDataEntryForTest(key string, value []byte, metadata *types.Metadata) *worldstate.KVWithMetadata {
 	return &worldstate.KVWithMetadata{
 		Key:      key,
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// peerDigestsTimeout bounds the retrieval of the state digests of a block from a peer
+const peerDigestsTimeout = 5 * time.Second
+
+// PeerDigestSource retrieves the state digests that the peers of the node recorded for their committed blocks.
+type PeerDigestSource interface {
+	// PullDigests returns the state digests of the given databases as of the given block, as recorded by the peer
+	// with the given node ID.
+	PullDigests(ctx context.Context, peerID string, blockNum uint64, dbNames []string) (map[string][]byte, error)
+}
+
+// peerHeader holds the fields of a block header that a peer computed when it committed the block. A block pulled from
+// a peer carries them, while a block proposed by consensus carries the base header only.
+type peerHeader struct {
+	peerID                  string
+	validationInfo          []*types.ValidationInfo
+	txMerkelTreeRootHash    []byte
+	stateMerkelTreeRootHash []byte
+	// cumulative is nil if the peer predates the cumulative counters
+	cumulative *types.CumulativeCounters
+}
+
+// newPeerHeader returns the fields of the header computed by the peer, or nil if the block does not carry them. It
+// must be called before the block is validated, which overwrites them.
+func newPeerHeader(block *types.Block, peerID string) *peerHeader {
+	header := block.GetHeader()
+	if len(header.GetTxMerkelTreeRootHash()) == 0 {
+		return nil
+	}
+
+	return &peerHeader{
+		peerID:                  peerID,
+		validationInfo:          append([]*types.ValidationInfo(nil), header.GetValidationInfo()...),
+		txMerkelTreeRootHash:    header.GetTxMerkelTreeRootHash(),
+		stateMerkelTreeRootHash: header.GetStateMerkelTreeRootHash(),
+		cumulative:              header.GetCumulative(),
+	}
+}
+
+// compare returns the fields of the given header that differ from the ones computed by the peer. The validation info
+// is compared by the fields that the rules of the block define, hence, a block of older rules that a peer of a newer
+// version computed, e.g., along with fields its producer did not compute, is not reported as diverging.
+func (p *peerHeader) compare(header *types.BlockHeader) []*types.HeaderFieldDivergence {
+	var fields []*types.HeaderFieldDivergence
+
+	baseHeader := header.GetBaseHeader()
+	localInfo := header.GetValidationInfo()
+	for i := 0; i < len(localInfo) || i < len(p.validationInfo); i++ {
+		var local, peer *types.ValidationInfo
+		if i < len(localInfo) {
+			local = localInfo[i]
+		}
+		if i < len(p.validationInfo) {
+			peer = p.validationInfo[i]
+		}
+		if local != nil && peer != nil && proto.Equal(utils.RulesValidationInfo(local, baseHeader), utils.RulesValidationInfo(peer, baseHeader)) {
+			continue
+		}
+
+		fields = append(fields, &types.HeaderFieldDivergence{
+			Field:      fmt.Sprintf("validation_info[%d]", i),
+			LocalValue: validationInfoString(local),
+			PeerValue:  validationInfoString(peer),
+		})
+	}
+
+	if !bytes.Equal(header.GetTxMerkelTreeRootHash(), p.txMerkelTreeRootHash) {
+		fields = append(fields, &types.HeaderFieldDivergence{
+			Field:      "tx_merkel_tree_root_hash",
+			LocalValue: base64.StdEncoding.EncodeToString(header.GetTxMerkelTreeRootHash()),
+			PeerValue:  base64.StdEncoding.EncodeToString(p.txMerkelTreeRootHash),
+		})
+	}
+
+	if !bytes.Equal(header.GetStateMerkelTreeRootHash(), p.stateMerkelTreeRootHash) {
+		fields = append(fields, &types.HeaderFieldDivergence{
+			Field:      "state_merkel_tree_root_hash",
+			LocalValue: base64.StdEncoding.EncodeToString(header.GetStateMerkelTreeRootHash()),
+			PeerValue:  base64.StdEncoding.EncodeToString(p.stateMerkelTreeRootHash),
+		})
+	}
+
+	if p.cumulative != nil && !proto.Equal(header.GetCumulative(), p.cumulative) {
+		fields = append(fields, &types.HeaderFieldDivergence{
+			Field:      "cumulative",
+			LocalValue: protoString(header.GetCumulative()),
+			PeerValue:  protoString(p.cumulative),
+		})
+	}
+
+	return fields
+}
+
+// compareDigests returns the databases whose state digest, as computed by this node, differs from the one recorded by
+// the peer, in the order of their names.
+func compareDigests(local, peer map[string][]byte) []*types.HeaderFieldDivergence {
+	var dbNames []string
+	for dbName := range local {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	var fields []*types.HeaderFieldDivergence
+	for _, dbName := range dbNames {
+		if bytes.Equal(local[dbName], peer[dbName]) {
+			continue
+		}
+
+		fields = append(fields, &types.HeaderFieldDivergence{
+			Field:      fmt.Sprintf("state_digest[%s]", dbName),
+			LocalValue: base64.StdEncoding.EncodeToString(local[dbName]),
+			PeerValue:  base64.StdEncoding.EncodeToString(peer[dbName]),
+		})
+	}
+
+	return fields
+}
+
+func validationInfoString(info *types.ValidationInfo) string {
+	if info == nil {
+		return ""
+	}
+	return protoString(info)
+}
+
+func protoString(m proto.Message) string {
+	value, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(proto.MessageV2(m))
+	if err != nil {
+		return proto.CompactTextString(m)
+	}
+	return string(value)
+}
+
+// divergenceError is returned by the commit of a block whose header differs from the header computed by the peer the
+// block was pulled from. The block is not committed.
+type divergenceError struct {
+	report *types.StateDivergence
+}
+
+func (e *divergenceError) Error() string {
+	return fmt.Sprintf("the header of block %d computed by this node differs from the header computed by the peer [%s] in %d field(s)",
+		e.report.GetBlockNumber(), e.report.GetPeerId(), len(e.report.GetFields()))
+}
+
+// divergenceMonitor holds the divergence on which the block processor halted, and signals the block processor once an
+// admin forces the acceptance of the peer's version of the diverging block.
+type divergenceMonitor struct {
+	mu       sync.Mutex
+	report   *types.StateDivergence
+	accepted chan struct{}
+}
+
+// halt records the divergence and returns a channel that is closed once the peer's version of the block is accepted.
+func (m *divergenceMonitor) halt(report *types.StateDivergence) <-chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.report = report
+	m.accepted = make(chan struct{})
+	return m.accepted
+}
+
+// resume clears the divergence once the peer's version of the block is committed.
+func (m *divergenceMonitor) resume() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.report = nil
+	m.accepted = nil
+}
+
+func (m *divergenceMonitor) current() *types.StateDivergence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.report == nil {
+		return nil
+	}
+	return proto.Clone(m.report).(*types.StateDivergence)
+}
+
+func (m *divergenceMonitor) accept(blockNum uint64) (*types.StateDivergence, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.report == nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the node has not halted on a divergence"}
+	}
+	if m.report.GetBlockNumber() != blockNum {
+		return nil, &ierrors.BadRequestError{
+			ErrMsg: fmt.Sprintf("the node halted on a divergence at block [%d], not at block [%d]", m.report.GetBlockNumber(), blockNum),
+		}
+	}
+
+	select {
+	case <-m.accepted:
+	default:
+		close(m.accepted)
+	}
+	return proto.Clone(m.report).(*types.StateDivergence), nil
+}
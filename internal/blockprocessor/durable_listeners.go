@@ -0,0 +1,205 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ReplayBlockStore is the source of the events of the blocks that are read back from the block store for a durable
+	// listener, as the listener failed, lagged behind, or recorded a lower height before a restart of the node.
+	ReplayBlockStore = "block store"
+
+	// durableListenerBacklog is the number of commit events queued for a durable listener. The events of the blocks
+	// committed while the backlog is full are not queued, and the blocks are read back from the block store instead.
+	durableListenerBacklog = 64
+
+	durableListenerRetryIntervalMin = 100 * time.Millisecond
+	durableListenerRetryIntervalMax = 10 * time.Second
+)
+
+// DurableBlockCommitListener is a commit listener which must see every committed block, in the order of the commits.
+// The listener records, durably, the height of the last block it processed. The blocks are delivered by a go-routine
+// of the listener, hence, the commits never wait for the listener, which lags behind instead. When the listener fails
+// to process a block, or when the node restarts, the blocks above the height it recorded are delivered again, read
+// back from the block store. A block may hence be delivered more than once, and the listener must ignore the blocks
+// at or below its height.
+type DurableBlockCommitListener interface {
+	BlockCommitListener
+	// ProcessedHeight returns the height of the last block processed by the listener
+	ProcessedHeight() (uint64, error)
+}
+
+// RegisterDurableBlockCommitListener registers a durable commit listener with the block processor. The blocks above
+// the height recorded by the listener are delivered once the block processor is started.
+func (b *BlockProcessor) RegisterDurableBlockCommitListener(name string, listener DurableBlockCommitListener) error {
+	return b.listeners.addDurable(name, listener)
+}
+
+// durableListener delivers the committed blocks to a durable listener, strictly in order, from the queued commit
+// events or, for the blocks whose event is not queued, from the block store
+type durableListener struct {
+	name       string
+	listener   DurableBlockCommitListener
+	blockStore *blockstore.Store
+	logger     *logger.SugarLogger
+
+	mu sync.Mutex
+	// committed is the height of the last committed block, and pending holds the queued events, in commit order
+	committed uint64
+	pending   []*CommitEvent
+
+	notify chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func newDurableListener(name string, listener DurableBlockCommitListener, blockStore *blockstore.Store, logger *logger.SugarLogger) *durableListener {
+	return &durableListener{
+		name:       name,
+		listener:   listener,
+		blockStore: blockStore,
+		logger:     logger,
+		notify:     make(chan struct{}, 1),
+	}
+}
+
+// start starts the delivery of the blocks committed up to the height of the block store. The delivery is started
+// again when the block processor is restarted.
+func (d *durableListener) start() error {
+	height, err := d.blockStore.Height()
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	if height > d.committed {
+		d.committed = height
+	}
+	d.mu.Unlock()
+
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+	go d.run(d.stop, d.done)
+	d.signal()
+	return nil
+}
+
+// enqueue queues the event of a committed block for delivery. It never blocks: the event is not queued when the
+// backlog is full, and the block is read back from the block store when its turn comes.
+func (d *durableListener) enqueue(event *CommitEvent) {
+	blockNum := event.Block.GetHeader().GetBaseHeader().GetNumber()
+
+	d.mu.Lock()
+	if blockNum > d.committed {
+		d.committed = blockNum
+	}
+	if len(d.pending) < durableListenerBacklog {
+		d.pending = append(d.pending, event)
+	} else {
+		d.logger.Debugf("the backlog of the durable listener [%s] is full, block [%d] is to be read from the block store", d.name, blockNum)
+	}
+	d.mu.Unlock()
+
+	d.signal()
+}
+
+func (d *durableListener) signal() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+// close stops the delivery at a block boundary
+func (d *durableListener) close() {
+	close(d.stop)
+	<-d.done
+}
+
+func (d *durableListener) run(stop, done chan struct{}) {
+	defer close(done)
+
+	retryInterval := durableListenerRetryIntervalMin
+	for {
+		select {
+		case <-stop:
+			return
+		case <-d.notify:
+		}
+
+		err := d.deliver(stop)
+		if err == nil {
+			retryInterval = durableListenerRetryIntervalMin
+			continue
+		}
+
+		d.logger.Errorf("the delivery of the committed blocks to the durable listener [%s] failed, retrying in %s: %s", d.name, retryInterval, err)
+		select {
+		case <-stop:
+			return
+		case <-time.After(retryInterval):
+		}
+		retryInterval *= 2
+		if retryInterval > durableListenerRetryIntervalMax {
+			retryInterval = durableListenerRetryIntervalMax
+		}
+		d.signal()
+	}
+}
+
+// deliver delivers the blocks above the height recorded by the listener, up to the last committed block. On a failure,
+// the delivery is resumed from the height recorded by the listener, hence, a block is delivered at least once.
+func (d *durableListener) deliver(stop chan struct{}) error {
+	height, err := d.listener.ProcessedHeight()
+	if err != nil {
+		return errors.WithMessage(err, "error while reading the height of the listener")
+	}
+
+	for {
+		d.mu.Lock()
+		committed := d.committed
+		events := d.pending
+		d.pending = nil
+		d.mu.Unlock()
+
+		if height >= committed {
+			return nil
+		}
+
+		for blockNum := height + 1; blockNum <= committed; blockNum++ {
+			select {
+			case <-stop:
+				return nil
+			default:
+			}
+
+			for len(events) > 0 && events[0].Block.GetHeader().GetBaseHeader().GetNumber() < blockNum {
+				events = events[1:]
+			}
+			var event *CommitEvent
+			if len(events) > 0 && events[0].Block.GetHeader().GetBaseHeader().GetNumber() == blockNum {
+				event, events = events[0], events[1:]
+			} else {
+				block, err := d.blockStore.GetForReplay(blockNum)
+				if err != nil {
+					return errors.WithMessagef(err, "error while reading block [%d] from the block store", blockNum)
+				}
+				event = &CommitEvent{Block: block, IsReplay: true, Source: ReplayBlockStore}
+			}
+
+			d.logger.Debugf("delivering block [%d] to the durable listener [%s], replay: %t", blockNum, d.name, event.IsReplay)
+			if err := d.listener.PostBlockCommitProcessing(event); err != nil {
+				return errors.WithMessagef(err, "error while delivering block [%d]", blockNum)
+			}
+			height = blockNum
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// durableRecordingListener records the blocks it processed, and the height of the last one, as a durable listener
+// records it in its store. It fails the blocks of failures as many times as given, and processes a block only once
+// the gate, if any, is open.
+type durableRecordingListener struct {
+	mu        sync.Mutex
+	height    uint64
+	processed []*CommitEvent
+	attempts  []uint64
+	failures  map[uint64]int
+	outOfSeq  []uint64
+	gate      chan struct{}
+}
+
+func (l *durableRecordingListener) PostBlockCommitProcessing(event *CommitEvent) error {
+	if l.gate != nil {
+		<-l.gate
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	blockNum := event.Block.GetHeader().GetBaseHeader().GetNumber()
+	l.attempts = append(l.attempts, blockNum)
+	if blockNum <= l.height {
+		return nil
+	}
+	if blockNum != l.height+1 {
+		l.outOfSeq = append(l.outOfSeq, blockNum)
+		return errors.Errorf("block [%d] delivered at height %d", blockNum, l.height)
+	}
+	if l.failures[blockNum] > 0 {
+		l.failures[blockNum]--
+		return errors.Errorf("crash while processing block [%d]", blockNum)
+	}
+
+	l.processed = append(l.processed, event)
+	l.height = blockNum
+	return nil
+}
+
+func (l *durableRecordingListener) ProcessedHeight() (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.height, nil
+}
+
+func (l *durableRecordingListener) processedHeight() uint64 {
+	height, _ := l.ProcessedHeight()
+	return height
+}
+
+func (l *durableRecordingListener) processedBlocks() []uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var blocks []uint64
+	for _, e := range l.processed {
+		blocks = append(blocks, e.Block.GetHeader().GetBaseHeader().GetNumber())
+	}
+	return blocks
+}
+
+func (l *durableRecordingListener) event(blockNum uint64) *CommitEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.processed[blockNum-1]
+}
+
+func (l *durableRecordingListener) clearFailures() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.failures = nil
+}
+
+func commitSampleBlocks(t *testing.T, env *testEnv, from, to uint64) {
+	for blockNum := from; blockNum <= to; blockNum++ {
+		key := fmt.Sprintf("key%d", blockNum)
+		block := createSampleBlock(blockNum, createSampleTx(t, "dataTx"+key, []string{key}, [][]byte{[]byte("value")}, env.userSigner))
+		_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, ""))
+		require.NoError(t, err)
+	}
+}
+
+func TestDurableBlockCommitListener(t *testing.T) {
+	t.Run("a listener that fails gets the blocks again, in order and without gaps", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		listener := &durableRecordingListener{failures: map[uint64]int{3: 2}}
+		require.NoError(t, env.blockProcessor.RegisterDurableBlockCommitListener("durable", listener))
+		require.EqualError(t, env.blockProcessor.RegisterBlockCommitListener("durable", &recordingCommitListener{}),
+			"the listener [durable] is already registered")
+
+		setup(t, env)
+		commitSampleBlocks(t, env, 2, 6)
+
+		require.Eventually(t, func() bool { return listener.processedHeight() == 6 }, 5*time.Second, 50*time.Millisecond)
+		require.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, listener.processedBlocks())
+		require.Empty(t, listener.outOfSeq)
+
+		// the block the listener failed on, and the blocks after it, are read back from the block store
+		require.False(t, listener.event(2).IsReplay)
+		require.Equal(t, "local", listener.event(2).Source)
+		require.NotNil(t, listener.event(2).StateDelta)
+		require.True(t, listener.event(3).IsReplay)
+		require.Equal(t, ReplayBlockStore, listener.event(3).Source)
+
+		attempts := 0
+		for _, blockNum := range listener.attempts {
+			if blockNum == 3 {
+				attempts++
+			}
+		}
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("the commits do not wait for a slow listener", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		gate := make(chan struct{})
+		listener := &durableRecordingListener{gate: gate}
+		require.NoError(t, env.blockProcessor.RegisterDurableBlockCommitListener("durable", listener))
+
+		setup(t, env)
+		commitSampleBlocks(t, env, 2, 5)
+
+		height, err := env.blockStore.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), height)
+		require.Equal(t, uint64(0), listener.processedHeight())
+
+		close(gate)
+		require.Eventually(t, func() bool { return listener.processedHeight() == 5 }, 5*time.Second, 50*time.Millisecond)
+		require.Equal(t, []uint64{1, 2, 3, 4, 5}, listener.processedBlocks())
+		require.Empty(t, listener.outOfSeq)
+	})
+
+	t.Run("the delivery resumes from the recorded height after a restart", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		listener := &durableRecordingListener{failures: map[uint64]int{4: 1000}}
+		require.NoError(t, env.blockProcessor.RegisterDurableBlockCommitListener("durable", listener))
+
+		setup(t, env)
+		commitSampleBlocks(t, env, 2, 5)
+		require.Eventually(t, func() bool { return listener.processedHeight() == 3 }, 5*time.Second, 50*time.Millisecond)
+
+		// mimic a node restart by starting the block processor goroutine again
+		env.blockProcessor.Stop()
+		listener.clearFailures()
+		require.Equal(t, uint64(3), listener.processedHeight())
+
+		env.blockProcessor.started = make(chan struct{})
+		env.blockProcessor.stop = make(chan struct{})
+		env.blockProcessor.stopped = make(chan struct{})
+		env.blockProcessor.blockOneQueueBarrier = queue.NewOneQueueBarrier(env.blockProcessor.logger)
+		defer env.blockProcessor.Stop()
+		go env.blockProcessor.Start()
+		env.blockProcessor.WaitTillStart()
+
+		require.Eventually(t, func() bool { return listener.processedHeight() == 5 }, 5*time.Second, 50*time.Millisecond)
+		commitSampleBlocks(t, env, 6, 6)
+		require.Eventually(t, func() bool { return listener.processedHeight() == 6 }, 5*time.Second, 50*time.Millisecond)
+
+		require.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, listener.processedBlocks())
+		require.Empty(t, listener.outOfSeq)
+		require.True(t, listener.event(4).IsReplay)
+		require.Equal(t, ReplayBlockStore, listener.event(4).Source)
+		require.False(t, listener.event(6).IsReplay)
+	})
+}
@@ -4,16 +4,22 @@
 package blockprocessor
 
 import (
-	"github.com/hyperledger-labs/orion-server/config"
+	"bytes"
+	"context"
 	"sync"
+	"time"
 
+	"github.com/hyperledger-labs/orion-server/config"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
 	"github.com/hyperledger-labs/orion-server/internal/mptrie"
 	"github.com/hyperledger-labs/orion-server/internal/mtree"
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
 	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/statesync"
 	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
@@ -27,10 +33,23 @@ type BlockProcessor struct {
 	validator            *txvalidation.Validator
 	committer            *committer
 	listeners            *blockCommitListeners
-	started              chan struct{}
-	stop                 chan struct{}
-	stopped              chan struct{}
-	logger               *logger.SugarLogger
+	originCounters       *blockOriginCounters
+	usersDBMaintainer    *usersDBMaintainer
+	stateDeltaApplier    *statesync.Applier
+	pendingTxs           *queue.PendingTxs
+	maxRecoveryBlocks    uint64
+	// verifyReplayedValidation verifies the validation info of the blocks replayed onto the state database
+	verifyReplayedValidation bool
+	// coalesceBacklogThreshold and maxCoalescedBlocks control the coalescing of state database commits
+	coalesceBacklogThreshold uint64
+	maxCoalescedBlocks       int
+	recoveries               *recoveryStatuses
+	divergence               *divergenceMonitor
+	peerDigests              PeerDigestSource
+	started                  chan struct{}
+	stop                     chan struct{}
+	stopped                  chan struct{}
+	logger                   *logger.SugarLogger
 }
 
 // Config holds the configuration information needed to bootstrap the
@@ -41,23 +60,99 @@ type Config struct {
 	DB                   worldstate.DB
 	ProvenanceStore      *provenance.Store
 	StateTrieStore       mptrie.Store
-	TxValidator          *txvalidation.Validator
-	Logger               *logger.SugarLogger
+	// TxValidator validates the blocks. Its execution mode governs the block processor as well: in the deterministic
+	// mode, the commits are never coalesced, and every committed block records the mode in its producer metadata.
+	TxValidator *txvalidation.Validator
+	Logger      *logger.SugarLogger
+	// UsersDBCompactionThreshold is the number of user writes and deletes in a user administration transaction
+	// above which the users database is compacted in the background. Zero means DefaultUsersDBCompactionThreshold,
+	// and a negative value disables the compaction.
+	UsersDBCompactionThreshold int
+	// UsersDBMaintenanceHooks, if not nil, are invoked by the background maintenance of the users database.
+	UsersDBMaintenanceHooks *UsersDBMaintenanceHooks
+	// MaxRecoveryBlocks is the maximal number of blocks by which the state database or the state trie may lag
+	// behind the block store and still be recovered on start. Zero means DefaultMaxRecoveryBlocks.
+	MaxRecoveryBlocks uint64
+	// PendingTxs, if not nil, is updated with the transactions of a block once the block is validated.
+	PendingTxs *queue.PendingTxs
+	// CoalesceBacklogThreshold is the backlog of blocks, as reported by the replication layer, at or above which the
+	// state database updates of consecutive data blocks are grouped into a single write. Zero disables the coalescing.
+	CoalesceBacklogThreshold uint64
+	// MaxCoalescedBlocks is the maximal number of blocks grouped into a single write. Zero means
+	// DefaultMaxCoalescedBlocks. It must not exceed MaxRecoveryBlocks, as a crash may leave the state database behind
+	// by a whole group.
+	MaxCoalescedBlocks int
+	// RecordStateDeltas records the state delta of every committed block in the block store, so that a lagging node
+	// can catch up by applying the net state delta of a range of blocks instead of the blocks.
+	RecordStateDeltas bool
+	// IsolateFailedDBs lets the commits of the blocks go on when the commit to a user database fails. The database is
+	// marked unavailable, and the updates it missed are recorded, until it is resynced with ResyncDB.
+	IsolateFailedDBs bool
+	// Signer, if not nil, signs the header of every committed block on behalf of the node NodeID. The signature is
+	// recorded in the producer metadata of the block, and attests its validation info when the block is replayed.
+	Signer crypto.Signer
+	NodeID string
+	// VerifyReplayedValidation verifies, on the replay of the committed blocks onto a lagging state database, that
+	// the validation info of each block is attested by the signature of a node of the cluster over its header. A
+	// block whose header is not signed, or is signed by a node that is not in the cluster, is validated anew. When
+	// false, the validation info of the replayed blocks is applied as is.
+	VerifyReplayedValidation bool
 }
 
 // New creates a ValidatorAndCommitter
 func New(conf *Config) *BlockProcessor {
+	maxRecoveryBlocks := conf.MaxRecoveryBlocks
+	if maxRecoveryBlocks == 0 {
+		maxRecoveryBlocks = DefaultMaxRecoveryBlocks
+	}
+
+	maxCoalescedBlocks := conf.MaxCoalescedBlocks
+	if maxCoalescedBlocks == 0 {
+		maxCoalescedBlocks = DefaultMaxCoalescedBlocks
+	}
+
+	coalesceBacklogThreshold := conf.CoalesceBacklogThreshold
+	if executionMode(conf).Deterministic {
+		conf.Logger.Warn("Deterministic execution mode is enabled: blocks are validated and committed sequentially, " +
+			"without parallel signature validation, bulk read prefetch, or commit coalescing")
+		coalesceBacklogThreshold = 0
+	}
+
 	return &BlockProcessor{
 		blockOneQueueBarrier: conf.BlockOneQueueBarrier,
 		blockStore:           conf.BlockStore,
 		validator:            conf.TxValidator,
 		committer:            newCommitter(conf),
-		listeners:            newBlockCommitListeners(conf.Logger),
-		started:              make(chan struct{}),
-		stop:                 make(chan struct{}),
-		stopped:              make(chan struct{}),
-		logger:               conf.Logger,
+		listeners:            newBlockCommitListeners(conf.BlockStore, conf.Logger),
+		originCounters:       newBlockOriginCounters(),
+		usersDBMaintainer:    newUsersDBMaintainer(conf),
+		stateDeltaApplier: statesync.NewApplier(&statesync.Config{
+			DB:              conf.DB,
+			BlockStore:      conf.BlockStore,
+			StateTrieStore:  conf.StateTrieStore,
+			ProvenanceStore: conf.ProvenanceStore,
+			Logger:          conf.Logger,
+		}),
+		pendingTxs:               conf.PendingTxs,
+		maxRecoveryBlocks:        maxRecoveryBlocks,
+		verifyReplayedValidation: conf.VerifyReplayedValidation,
+		coalesceBacklogThreshold: coalesceBacklogThreshold,
+		maxCoalescedBlocks:       maxCoalescedBlocks,
+		recoveries:               &recoveryStatuses{},
+		divergence:               &divergenceMonitor{},
+		started:                  make(chan struct{}),
+		stop:                     make(chan struct{}),
+		stopped:                  make(chan struct{}),
+		logger:                   conf.Logger,
+	}
+}
+
+// executionMode returns the execution mode of the transaction validator, if one is configured.
+func executionMode(conf *Config) txvalidation.ExecutionMode {
+	if conf.TxValidator == nil {
+		return txvalidation.ExecutionMode{}
 	}
+	return conf.TxValidator.ExecutionMode()
 }
 
 // Bootstrap initializes the ledger and database with the first block, which contains a config transaction.
@@ -72,7 +167,8 @@ func (b *BlockProcessor) Bootstrap(configBlock *types.Block, ledgerConfig config
 		b.committer.stateTrieStore.SetDisabled(true)
 	}
 
-	return b.validateAndCommit(configBlock)
+	_, err := b.validateAndCommit(configBlock, false, newPeerHeader(configBlock, ""), false)
+	return err
 }
 
 // Start starts the Validator and committer
@@ -90,12 +186,23 @@ func (b *BlockProcessor) Start() {
 		}
 	}
 
+	b.usersDBMaintainer.start()
+	defer b.usersDBMaintainer.close()
+
+	if err := b.listeners.startDurables(); err != nil {
+		panic(errors.WithMessage(err, "error while starting the delivery to the durable listeners"))
+	}
+	defer b.listeners.closeDurables()
+
 	b.logger.Debug("block processor has been started successfully")
 	close(b.started)
 	for {
 		select {
 		case <-b.stop:
 			b.logger.Info("stopping block processing")
+			if err := b.committer.flushCoalesced(); err != nil {
+				panic(err)
+			}
 			return
 
 		default:
@@ -107,11 +214,52 @@ func (b *BlockProcessor) Start() {
 				b.logger.Debugf("OneQueueBarrier error: %s", err)
 				continue
 			}
-			block := blockData.(*types.Block)
+			if stateDelta, ok := blockData.(*queue.StateDeltaWithOrigin); ok {
+				b.logger.Infof("dequeued the state delta of blocks [%d, %d], peer: %s, waited in queue: %s",
+					stateDelta.Delta.GetStartBlockNum(), stateDelta.Delta.GetEndBlockNum(), stateDelta.PeerID,
+					time.Since(stateDelta.ReceivedAt))
+				if err = b.blockOneQueueBarrier.Reply(b.applyStateDelta(stateDelta)); err != nil {
+					b.logger.Debugf("OneQueueBarrier error: %s", err)
+				}
+				continue
+			}
 
-			if err = b.validateAndCommit(block); err != nil {
+			blockWithOrigin := blockData.(*queue.BlockWithOrigin)
+			block := blockWithOrigin.Block
+			b.logger.Debugf("dequeued block %d, origin: %s, peer: %s, waited in queue: %s",
+				block.GetHeader().GetBaseHeader().GetNumber(), blockWithOrigin.Origin, blockWithOrigin.PeerID,
+				time.Since(blockWithOrigin.ReceivedAt))
+
+			startedAt := time.Now()
+			// A block that is not coalesced is validated against the complete state, hence, the coalesced updates are
+			// written first. So are the updates of a full group.
+			if b.committer.coalesced != nil && b.committer.coalesced.blocks >= b.maxCoalescedBlocks {
+				if err = b.committer.flushCoalesced(); err != nil {
+					panic(err)
+				}
+			}
+			coalesce := b.canCoalesce(blockWithOrigin)
+			if !coalesce {
+				if err = b.committer.flushCoalesced(); err != nil {
+					panic(err)
+				}
+			}
+
+			peer := newPeerHeader(block, blockWithOrigin.PeerID)
+			delta, err := b.validateAndCommit(block, coalesce, peer, false)
+			if divergence, ok := err.(*divergenceError); ok {
+				if !b.haltOnDivergence(divergence) {
+					b.logger.Info("stopping block processing while halted on a divergence")
+					return
+				}
+				delta, err = b.validateAndCommit(block, false, peer, true)
+				b.divergence.resume()
+			}
+			if err != nil {
 				panic(err)
 			}
+			commitLatency := time.Since(startedAt)
+			b.originCounters.increment(blockWithOrigin.Origin)
 
 			// Detect config changes that affect the replication component and return an appropriate non-nil object
 			// to instruct it to reconfigure itself. Only valid config transactions are passed on.
@@ -128,31 +276,60 @@ func (b *BlockProcessor) Start() {
 			// the next block.
 			err = b.blockOneQueueBarrier.Reply(reConfig)
 			if err != nil {
-				// when the queue is closed during the teardown/cleanup
+				// When the queue is closed during the teardown/cleanup. The block is already committed, hence the
+				// post-commit processing still runs, so that the indices stop at a block boundary.
 				b.logger.Debugf("OneQueueBarrier error: %s", err)
-				continue
 			}
 
-			if err = b.listeners.invoke(block); err != nil {
+			b.usersDBMaintainer.blockCommitted(block)
+
+			if err = b.listeners.invoke(&CommitEvent{Block: block, StateDelta: delta, Source: blockWithOrigin.Origin.String(), CommitLatency: commitLatency}); err != nil {
 				panic(err)
 			}
 		}
 	}
 }
 
-func (b *BlockProcessor) validateAndCommit(block *types.Block) error {
+// validateAndCommit validates and commits the block. When the block carries the header computed by the peer it was
+// pulled from, the header computed by this node is compared with it before the block is committed, and a
+// *divergenceError is returned on a mismatch. With acceptPeer, the validation info computed by the peer is committed
+// instead of being computed again. It returns the state delta of the committed block.
+func (b *BlockProcessor) validateAndCommit(block *types.Block, coalesce bool, peer *peerHeader, acceptPeer bool) (*types.StateDelta, error) {
 	b.logger.Debugf("validating and committing block %d", block.GetHeader().GetBaseHeader().GetNumber())
-	validationInfo, err := b.validator.ValidateBlock(block)
-	if err != nil {
-		if block.GetHeader().GetBaseHeader().GetNumber() > 1 {
-			panic(err)
+	var prepared *blockstore.PreparedBlock
+	if acceptPeer {
+		block.Header.ValidationInfo = peer.validationInfo
+		b.updatePendingTxsStage(block, queue.TxStageCommitting)
+	} else {
+		// the transaction envelopes do not depend on the validation, hence, they are serialized while the block is
+		// validated, and the write-set digest of each transaction is computed as soon as its validation is final
+		preparation := prepareBlockInBackground(block)
+		digester := newWriteSetDigester(b.committer.db, block)
+		validationInfo, err := b.validator.ValidateBlockStreaming(block, digester.add)
+		digestErr := digester.wait()
+		preparationResult := <-preparation
+		if err != nil {
+			if block.GetHeader().GetBaseHeader().GetNumber() > 1 {
+				panic(err)
+			}
+			return nil, err
 		}
-		return err
-	}
 
-	block.Header.ValidationInfo = validationInfo
+		block.Header.ValidationInfo = validationInfo
+		b.updatePendingTxsStage(block, queue.TxStageCommitting)
 
-	if err = b.blockStore.AddSkipListLinks(block); err != nil {
+		// the write-set digests are part of the validation info and hence, they must
+		// be computed before building the tx merkle tree
+		if digestErr != nil {
+			panic(digestErr)
+		}
+		if preparationResult.err != nil {
+			panic(preparationResult.err)
+		}
+		prepared = preparationResult.prepared
+	}
+
+	if err := b.blockStore.AddSkipListLinks(block); err != nil {
 		panic(err)
 	}
 
@@ -162,12 +339,134 @@ func (b *BlockProcessor) validateAndCommit(block *types.Block) error {
 	}
 	block.Header.TxMerkelTreeRootHash = root.Hash()
 
-	if err = b.committer.commitBlock(block); err != nil {
+	var verifyDigests func(map[string][]byte) error
+	if peer != nil && !acceptPeer && peer.peerID != "" && b.peerDigests != nil {
+		verifyDigests = func(digests map[string][]byte) error {
+			return b.verifyPeerDigests(peer, block.GetHeader().GetBaseHeader().GetNumber(), digests)
+		}
+	}
+
+	var verifyHeader func(*types.BlockHeader) error
+	if peer != nil && !acceptPeer {
+		verifyHeader = func(header *types.BlockHeader) error {
+			fields := peer.compare(header)
+			if len(fields) == 0 {
+				return nil
+			}
+			return &divergenceError{
+				report: &types.StateDivergence{
+					BlockNumber: header.GetBaseHeader().GetNumber(),
+					PeerId:      peer.peerID,
+					Fields:      fields,
+				},
+			}
+		}
+	}
+
+	delta, err := b.committer.commit(block, coalesce, prepared, verifyDigests, verifyHeader)
+	if err != nil {
+		if _, ok := err.(*divergenceError); ok {
+			return nil, err
+		}
 		panic(err)
 	}
 
+	if acceptPeer && !bytes.Equal(block.GetHeader().GetStateMerkelTreeRootHash(), peer.stateMerkelTreeRootHash) {
+		b.logger.Warnf("block %d was committed with the validation info of the peer [%s], yet the state root differs "+
+			"from the one computed by the peer, hence the state diverged before the block", block.GetHeader().GetBaseHeader().GetNumber(), peer.peerID)
+	}
+
 	b.logger.Debugf("validated and committed block %d\n", block.GetHeader().GetBaseHeader().GetNumber())
-	return err
+	return delta, nil
+}
+
+// verifyPeerDigests compares the state digests of the databases updated by the block with the ones recorded by the peer
+// the block was pulled from, which detects a divergence of the state without any work on the state trie. When the peer
+// cannot provide its digests, e.g., as it has not yet committed the block to its state database, the divergence is
+// detected by the comparison of the headers alone.
+func (b *BlockProcessor) verifyPeerDigests(peer *peerHeader, blockNum uint64, digests map[string][]byte) error {
+	var dbNames []string
+	for dbName := range digests {
+		dbNames = append(dbNames, dbName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), peerDigestsTimeout)
+	defer cancel()
+	peerDigests, err := b.peerDigests.PullDigests(ctx, peer.peerID, blockNum, dbNames)
+	if err != nil {
+		b.logger.Warnf("the state digests of block %d could not be retrieved from the peer [%s], the block is compared "+
+			"by its header only: %s", blockNum, peer.peerID, err)
+		return nil
+	}
+
+	fields := compareDigests(digests, peerDigests)
+	if len(fields) == 0 {
+		return nil
+	}
+	return &divergenceError{
+		report: &types.StateDivergence{
+			BlockNumber: blockNum,
+			PeerId:      peer.peerID,
+			Fields:      fields,
+		},
+	}
+}
+
+// SetPeerDigestSource sets the source of the state digests recorded by the peers, which the blocks pulled from a peer
+// are first compared with. It must be called before Start.
+func (b *BlockProcessor) SetPeerDigestSource(source PeerDigestSource) {
+	b.peerDigests = source
+}
+
+// haltOnDivergence records the divergence and halts the commits until an admin accepts the peer's version of the
+// block, in which case it returns true, or until the block processor is stopped, in which case it returns false. The
+// state trie is reloaded, as it holds the updates of the diverging block.
+func (b *BlockProcessor) haltOnDivergence(divergence *divergenceError) bool {
+	b.logger.Errorf("halting the commits: %s; divergence report: %s", divergence, divergence.report)
+
+	if err := b.committer.flushCoalesced(); err != nil {
+		panic(err)
+	}
+	if !b.committer.stateTrieStore.IsDisabled() {
+		if err := b.initAndRecoverStateTrieIfNeeded(); err != nil {
+			panic(errors.WithMessage(err, "error while reloading the state trie"))
+		}
+	}
+
+	accepted := b.divergence.halt(divergence.report)
+	select {
+	case <-b.stop:
+		return false
+	case <-accepted:
+		b.logger.Warnf("the peer's version of block %d was accepted by an admin, resuming the commits", divergence.report.GetBlockNumber())
+		return true
+	}
+}
+
+// StateDivergence returns the divergence on which the block processor halted its commits, or nil if it did not halt.
+func (b *BlockProcessor) StateDivergence() *types.StateDivergence {
+	return b.divergence.current()
+}
+
+// AcceptPeerHeader resumes the commits of a block processor halted on a divergence at the given block, by committing
+// the peer's version of the block, i.e., with the validation info computed by the peer. It returns the divergence
+// being resolved.
+func (b *BlockProcessor) AcceptPeerHeader(blockNum uint64) (*types.StateDivergence, error) {
+	return b.divergence.accept(blockNum)
+}
+
+func (b *BlockProcessor) updatePendingTxsStage(block *types.Block, stage queue.TxStage) {
+	if b.pendingTxs == nil {
+		return
+	}
+
+	txIDs, err := utils.BlockPayloadToTxIDs(block.GetPayload())
+	if err != nil {
+		b.logger.Errorf("Failed to extract TxIDs from block: %v; error: %s", block.GetHeader(), err)
+		return
+	}
+
+	b.pendingTxs.UpdateStage(txIDs, stage, block.GetHeader().GetBaseHeader().GetNumber())
 }
 
 // WaitTillStart waits till the block processor is started
@@ -175,6 +474,12 @@ func (b *BlockProcessor) WaitTillStart() {
 	<-b.started
 }
 
+// ProcessedBlocks returns the number of blocks dequeued and committed by the block processor that arrived from the
+// given origin. Blocks committed via Bootstrap are not counted.
+func (b *BlockProcessor) ProcessedBlocks(origin queue.BlockOrigin) uint64 {
+	return b.originCounters.get(origin)
+}
+
 // Stop stops the block processor
 func (b *BlockProcessor) Stop() {
 	if err := b.blockOneQueueBarrier.Close(); err != nil {
@@ -204,64 +509,66 @@ func (b *BlockProcessor) recoverWorldStateDBIfNeeded() error {
 			stateDBHeight,
 			blockStoreHeight,
 		)
-	case blockStoreHeight-stateDBHeight > 1:
-		// Note: when we support rollback, the different in height can be more than 1.
-		// For now, a failure can occur before committing the block to the block store or after.
-		// As a result, the height of block store would be at most 1 higher than the state database
-		// height.
-		return errors.Errorf(
-			"the difference between the height of the block store [%d] and the state database [%d] cannot be greater than 1 block. The node cannot be recovered",
-			blockStoreHeight,
-			stateDBHeight,
-		)
-	case blockStoreHeight-stateDBHeight == 1:
-		block, err := b.blockStore.Get(blockStoreHeight)
-		if err != nil {
-			return err
-		}
-		dbsUpdates, provenanceData, err := b.committer.constructDBAndProvenanceEntries(block)
-		if err != nil {
-			return err
-		}
-		return b.committer.commitToDBs(dbsUpdates, provenanceData, block)
-	}
-
-	return nil
-}
+	default:
+		// A failure between the commit to the block store and the commit to the state database leaves the latter
+		// one block behind. A larger gap can be left, e.g., by restoring the state database from a backup.
+		return b.replayBlocks(RecoveryStateDB, stateDBHeight, blockStoreHeight, func(block *types.Block, status *RecoveryStatus) error {
+			if b.verifyReplayedValidation {
+				revalidated, err := b.verifyReplayedValidationInfo(block)
+				if err != nil {
+					return err
+				}
+				if revalidated {
+					b.recoveries.addRevalidated(status)
+				}
+			}
 
-func (b *BlockProcessor) initAndRecoverStateTrieIfNeeded() error {
-	trieStoreHeight, blockStoreHeight, stateTrie, err := loadStateTrie(b.committer.stateTrieStore, b.blockStore)
-	if err != nil {
-		return err
-	}
-	b.committer.stateTrie = stateTrie
-	if blockStoreHeight != trieStoreHeight {
-		switch {
-		case trieStoreHeight+1 == blockStoreHeight:
-			b.logger.Warnf("state trie store not updated, last block in block store is %d, last block in state trie is %d, updating trie", blockStoreHeight, trieStoreHeight)
-			block, err := b.blockStore.Get(blockStoreHeight)
+			dbsUpdates, provenanceData, err := b.committer.constructDBAndProvenanceEntries(block)
 			if err != nil {
 				return err
 			}
-			dbsUpdates, _, err := b.committer.constructDBAndProvenanceEntries(block)
+			blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+			digests, err := b.committer.computeDigests(blockNum, dbsUpdates)
 			if err != nil {
 				return err
 			}
-			if err = b.committer.applyBlockOnStateTrie(dbsUpdates); err != nil {
+			if err = b.committer.commitDigests(blockNum, digests); err != nil {
 				return err
 			}
-			if err = b.committer.commitTrie(blockStoreHeight); err != nil {
+			// the state delta is constructed before the commit adds the index updates to the database updates
+			delta := constructStateDelta(blockNum, dbsUpdates)
+			// the key filter of a block whose commit was interrupted may be missing
+			if err = b.committer.commitKeyFilter(blockNum, delta); err != nil {
 				return err
 			}
-		default:
-			return errors.Errorf(
-				"the difference between the height of the block store [%d] and the state trie store [%d] cannot be greater than 1 block. The node cannot be recovered",
-				blockStoreHeight,
-				trieStoreHeight,
-			)
-		}
+			if err = b.committer.commitToDBs(dbsUpdates, provenanceData, block); err != nil {
+				return err
+			}
+			return b.listeners.invoke(&CommitEvent{Block: block, StateDelta: delta, IsReplay: true, Source: RecoveryStateDB})
+		})
 	}
-	return nil
+}
+
+func (b *BlockProcessor) initAndRecoverStateTrieIfNeeded() error {
+	trieStoreHeight, blockStoreHeight, stateTrie, err := loadStateTrie(b.committer.stateTrieStore, b.blockStore)
+	if err != nil {
+		return err
+	}
+	b.committer.stateTrie = stateTrie
+	if blockStoreHeight == trieStoreHeight {
+		return nil
+	}
+
+	return b.replayBlocks(RecoveryStateTrie, trieStoreHeight, blockStoreHeight, func(block *types.Block, _ *RecoveryStatus) error {
+		dbsUpdates, _, err := b.committer.constructDBAndProvenanceEntries(block)
+		if err != nil {
+			return err
+		}
+		if err = b.committer.applyBlockOnStateTrie(dbsUpdates); err != nil {
+			return err
+		}
+		return b.committer.commitTrie(block.GetHeader().GetBaseHeader().GetNumber())
+	})
 }
 
 // RegisterBlockCommitListener registers a commit listener with the block processor
@@ -270,24 +577,47 @@ func (b *BlockProcessor) RegisterBlockCommitListener(name string, listener Block
 }
 
 type blockCommitListeners struct {
-	listens map[string]BlockCommitListener
+	listens    map[string]BlockCommitListener
+	durables   map[string]*durableListener
+	blockStore *blockstore.Store
+	// started is set once the delivery to the durable listeners is started
+	started bool
 	logger  *logger.SugarLogger
 	sync.RWMutex
 }
 
-func newBlockCommitListeners(logger *logger.SugarLogger) *blockCommitListeners {
+func newBlockCommitListeners(blockStore *blockstore.Store, logger *logger.SugarLogger) *blockCommitListeners {
 	return &blockCommitListeners{
-		listens: make(map[string]BlockCommitListener),
-		logger:  logger,
+		listens:    make(map[string]BlockCommitListener),
+		durables:   make(map[string]*durableListener),
+		blockStore: blockStore,
+		logger:     logger,
 	}
 }
 
-//go:generate mockery --dir . --name BlockCommitListener --case underscore --output mocks/
-
 // BlockCommitListener is a listener who listens to the
 // commit events
 type BlockCommitListener interface {
-	PostBlockCommitProcessing(block *types.Block) error
+	PostBlockCommitProcessing(event *CommitEvent) error
+}
+
+// CommitEvent is delivered to the commit listeners after a block is committed
+type CommitEvent struct {
+	Block *types.Block
+	// StateDelta holds the keys written and deleted by the block in the state database, ordered by database name and
+	// key
+	StateDelta *types.StateDelta
+	// IsReplay is set when the block was committed before, and is delivered again because it is replayed onto a
+	// store that lags behind the block store, e.g., on the recovery of the state database, or because it is read back
+	// from the block store for a durable listener. The listeners that notify clients or count the commits should
+	// ignore such events.
+	IsReplay bool
+	// Source is the origin of a freshly committed block, e.g., "replication", the store onto which the block is
+	// replayed, e.g., RecoveryStateDB, or ReplayBlockStore.
+	Source string
+	// CommitLatency is the time a freshly committed block took to be validated and committed, including the write of
+	// the updates coalesced before it. It is zero for a replayed block.
+	CommitLatency time.Duration
 }
 
 func (l *blockCommitListeners) add(name string, listener BlockCommitListener) error {
@@ -295,7 +625,7 @@ func (l *blockCommitListeners) add(name string, listener BlockCommitListener) er
 	defer l.Unlock()
 
 	l.logger.Info("Registering listener [" + name + "]")
-	if _, ok := l.listens[name]; ok {
+	if l.isRegistered(name) {
 		return errors.Errorf("the listener [" + name + "] is already registered")
 	}
 
@@ -303,16 +633,69 @@ func (l *blockCommitListeners) add(name string, listener BlockCommitListener) er
 	return nil
 }
 
-func (l *blockCommitListeners) invoke(block *types.Block) error {
+func (l *blockCommitListeners) addDurable(name string, listener DurableBlockCommitListener) error {
+	l.Lock()
+	defer l.Unlock()
+
+	l.logger.Info("Registering durable listener [" + name + "]")
+	if l.isRegistered(name) {
+		return errors.Errorf("the listener [" + name + "] is already registered")
+	}
+
+	d := newDurableListener(name, listener, l.blockStore, l.logger)
+	if l.started {
+		if err := d.start(); err != nil {
+			return err
+		}
+	}
+	l.durables[name] = d
+	return nil
+}
+
+func (l *blockCommitListeners) isRegistered(name string) bool {
+	_, ok := l.listens[name]
+	_, durable := l.durables[name]
+	return ok || durable
+}
+
+// startDurables starts the delivery to the durable listeners, from the heights they recorded
+func (l *blockCommitListeners) startDurables() error {
+	l.Lock()
+	defer l.Unlock()
+
+	for _, d := range l.durables {
+		if err := d.start(); err != nil {
+			return err
+		}
+	}
+	l.started = true
+	return nil
+}
+
+// closeDurables stops the delivery to the durable listeners
+func (l *blockCommitListeners) closeDurables() {
+	l.Lock()
+	defer l.Unlock()
+
+	for _, d := range l.durables {
+		d.close()
+	}
+	l.started = false
+}
+
+func (l *blockCommitListeners) invoke(event *CommitEvent) error {
 	l.RLock()
 	defer l.RUnlock()
 
 	for name, listener := range l.listens {
-		l.logger.Debugf("Invoking listener [%s] for block [%d]", name, block.Header.BaseHeader.Number)
-		if err := listener.PostBlockCommitProcessing(block); err != nil {
+		l.logger.Debugf("Invoking listener [%s] for block [%d], replay: %t", name, event.Block.Header.BaseHeader.Number, event.IsReplay)
+		if err := listener.PostBlockCommitProcessing(event); err != nil {
 			return errors.WithMessage(err, "error while invoking listener ["+name+"]")
 		}
 	}
+	for _, d := range l.durables {
+		d.enqueue(event)
+	}
 
 	return nil
 }
@@ -351,3 +734,28 @@ func loadStateTrie(mpTrieStore mptrie.Store, blockStore *blockstore.Store) (uint
 	trie, err := mptrie.NewTrie(lastTrieBlockHeader.GetStateMerkelTreeRootHash(), mpTrieStore)
 	return height, blockStoreHeight, trie, err
 }
+
+type blockOriginCounters struct {
+	mu     sync.RWMutex
+	counts map[queue.BlockOrigin]uint64
+}
+
+func newBlockOriginCounters() *blockOriginCounters {
+	return &blockOriginCounters{
+		counts: make(map[queue.BlockOrigin]uint64),
+	}
+}
+
+func (c *blockOriginCounters) increment(origin queue.BlockOrigin) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts[origin]++
+}
+
+func (c *blockOriginCounters) get(origin queue.BlockOrigin) uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.counts[origin]
+}
@@ -4,40 +4,46 @@ package blockprocessor
 
 import (
 	"bytes"
+	"context"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/hyperledger-labs/orion-server/internal/blockprocessor/mocks"
 	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/internal/mptrie"
 	mptrieStore "github.com/hyperledger-labs/orion-server/internal/mptrie/store"
 	"github.com/hyperledger-labs/orion-server/internal/mtree"
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
 	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/statesync"
+	"github.com/hyperledger-labs/orion-server/internal/testfault"
 	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
 	"github.com/hyperledger-labs/orion-server/pkg/state"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
-	"github.com/stretchr/testify/mock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
 type testEnv struct {
 	blockProcessor      *BlockProcessor
 	stopBlockProcessing chan struct{}
-	db                  worldstate.DB
+	db                  *testfault.DB
 	dbPath              string
 	blockStore          *blockstore.Store
 	blockStorePath      string
@@ -50,6 +56,19 @@ type testEnv struct {
 }
 
 func newTestEnv(t *testing.T) *testEnv {
+	return newTestEnvWithConfig(t, nil)
+}
+
+// newTestEnvWithConfig creates a test environment whose block processor configuration is adjusted by the
+// given function, if any, before the block processor is created
+func newTestEnvWithConfig(t *testing.T, adjustConfig func(c *Config)) *testEnv {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	return newTestEnvWithCrypto(t, cryptoDir, adjustConfig)
+}
+
+// newTestEnvWithCrypto creates a test environment as newTestEnvWithConfig does, with the identities found in the given
+// crypto directory, such that several environments can share the same genesis block and users
+func newTestEnvWithCrypto(t *testing.T, cryptoDir string, adjustConfig func(c *Config)) *testEnv {
 	c := &logger.Config{
 		Level:         "debug",
 		OutputPath:    []string{"stdout"},
@@ -63,7 +82,7 @@ func newTestEnv(t *testing.T) *testEnv {
 	require.NoError(t, err)
 
 	dbPath := filepath.Join(dir, "leveldb")
-	db, err := leveldb.Open(
+	levelDB, err := leveldb.Open(
 		&leveldb.Config{
 			DBRootDir: dbPath,
 			Logger:    logger,
@@ -75,6 +94,8 @@ func newTestEnv(t *testing.T) *testEnv {
 		}
 		t.Fatalf("error while creating the leveldb instance, %v", err)
 	}
+	// the faults are injected by the tests of the failure and recovery
+	db := testfault.NewDB(levelDB)
 
 	blockStorePath := filepath.Join(dir, "blockstore")
 	blockStore, err := blockstore.Open(
@@ -126,13 +147,12 @@ func newTestEnv(t *testing.T) *testEnv {
 		},
 	)
 
-	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
 	userCert, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
 	nodeCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "node1")
 	adminCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "admin1")
 	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
 
-	b := New(&Config{
+	conf := &Config{
 		BlockOneQueueBarrier: queue.NewOneQueueBarrier(logger),
 		BlockStore:           blockStore,
 		StateTrieStore:       mptrieStore,
@@ -140,7 +160,11 @@ func newTestEnv(t *testing.T) *testEnv {
 		DB:                   db,
 		TxValidator:          txValidator,
 		Logger:               logger,
-	})
+	}
+	if adjustConfig != nil {
+		adjustConfig(conf)
+	}
+	b := New(conf)
 
 	genesisConfig := &types.ClusterConfig{
 		Nodes: []*types.NodeConfig{
@@ -236,7 +260,7 @@ func newTestEnv(t *testing.T) *testEnv {
 }
 
 func setup(t *testing.T, env *testEnv) {
-	reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(env.genesisBlock)
+	reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(env.genesisBlock, queue.BlockOriginLocal, ""))
 	require.NoError(t, err)
 	require.NotNil(t, reply)
 	require.Equal(t, env.genesisConfig, reply)
@@ -339,7 +363,7 @@ func TestValidatorAndCommitter(t *testing.T) {
 		}
 
 		for _, tt := range testCases {
-			reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(tt.block)
+			reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(tt.block, queue.BlockOriginLocal, ""))
 			require.NoError(t, err)
 			require.Nil(t, reply) // May not be nil when we implement dynamic config
 
@@ -408,10 +432,14 @@ func TestValidatorAndCommitter(t *testing.T) {
 		for _, tt := range testCases {
 			stateTrieRootOrg, err := env.blockProcessor.committer.stateTrie.Hash()
 			require.NoError(t, err)
+			genesisHeader, err := env.blockStore.GetHeader(1)
+			require.NoError(t, err)
+			counters := genesisHeader.GetCumulative()
 			for _, block := range tt.expectedBlocks {
 				// Because we update SkipchainHashes, TxMerkelTreeRootHash and StateMerkelTreeRootHash during process, we want to precalculate them
 				// for the expected blocks
 				block.Header.SkipchainHashes = calculateBlockHashes(t, genesisHash, tt.expectedBlocks, block.Header.BaseHeader.Number)
+				require.NoError(t, addWriteSetDigests(nil, block))
 				root, err := mtree.BuildTreeForBlockTx(block)
 				require.NoError(t, err)
 				block.Header.TxMerkelTreeRootHash = root.Hash()
@@ -421,13 +449,15 @@ func TestValidatorAndCommitter(t *testing.T) {
 				require.NoError(t, env.blockProcessor.committer.applyBlockOnStateTrie(dbsUpdates))
 				block.Header.StateMerkelTreeRootHash, err = env.blockProcessor.committer.stateTrie.Hash()
 				require.NoError(t, err)
+				counters = nextCumulativeCounters(t, counters, block)
+				block.Header.Cumulative = counters
 			}
 			env.blockProcessor.committer.stateTrie, err = mptrie.NewTrie(stateTrieRootOrg, env.blockProcessor.committer.stateTrieStore)
 		}
 
 		for _, tt := range testCases {
 			for _, block := range tt.blocks {
-				reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(block)
+				reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, ""))
 				require.NoError(t, err)
 				require.Nil(t, reply) // May not be nil when we implement dynamic config
 			}
@@ -495,7 +525,12 @@ func TestFailureAndRecovery(t *testing.T) {
 				Flag: types.Flag_VALID,
 			},
 		}
-		require.NoError(t, env.blockProcessor.committer.blockStore.Commit(block2))
+
+		// mimic node crash between the commit to the block store and the commit to the stateDB
+		commits := env.db.Calls("Commit")
+		env.db.FailMethod("Commit", errors.New("crash"))
+		require.EqualError(t, env.blockProcessor.committer.commitBlock(block2), "failed to commit block 2 to state database: crash")
+		env.db.Clear()
 
 		blockStoreHeight, err := env.blockStore.Height()
 		require.NoError(t, err)
@@ -505,8 +540,6 @@ func TestFailureAndRecovery(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, uint64(1), stateDBHeight)
 
-		// before committing the block to the stateDB, mimic node crash
-		// by stopping the block processor goroutine
 		env.blockProcessor.Stop()
 
 		// mimic node restart by starting the block processor goroutine
@@ -527,6 +560,105 @@ func TestFailureAndRecovery(t *testing.T) {
 			return true
 		}
 		require.Eventually(t, assertStateDBHeight, 2*time.Second, 100*time.Millisecond)
+		// the failed commit and the commit of the recovery
+		require.Equal(t, commits+2, env.db.Calls("Commit"))
+	})
+
+	t.Run("stateDB recovery delivers the replayed blocks to the listeners as replays", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		setup(t, env)
+
+		listener := &recordingCommitListener{}
+		require.NoError(t, env.blockProcessor.RegisterBlockCommitListener("listener", listener))
+
+		block2 := createSampleBlock(2, createSampleTx(t, "dataTx1", []string{"key1"}, [][]byte{[]byte("value-1")}, env.userSigner))
+		block2.Header.ValidationInfo = []*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+		}
+
+		env.db.FailMethod("Commit", errors.New("crash"))
+		require.EqualError(t, env.blockProcessor.committer.commitBlock(block2), "failed to commit block 2 to state database: crash")
+		env.db.Clear()
+		require.Empty(t, listener.received())
+
+		env.blockProcessor.Stop()
+
+		env.blockProcessor.started = make(chan struct{})
+		env.blockProcessor.stop = make(chan struct{})
+		env.blockProcessor.stopped = make(chan struct{})
+		env.blockProcessor.blockOneQueueBarrier = queue.NewOneQueueBarrier(env.blockProcessor.logger)
+		defer env.blockProcessor.Stop()
+		go env.blockProcessor.Start()
+		env.blockProcessor.WaitTillStart()
+
+		events := listener.received()
+		require.Len(t, events, 1)
+		require.Equal(t, uint64(2), events[0].Block.GetHeader().GetBaseHeader().GetNumber())
+		require.True(t, events[0].IsReplay)
+		require.Equal(t, RecoveryStateDB, events[0].Source)
+
+		// the blocks committed after the recovery are not replays
+		block3 := createSampleBlock(3, createSampleTx(t, "dataTx2", []string{"key2"}, [][]byte{[]byte("value-2")}, env.userSigner))
+		_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block3, queue.BlockOriginReplication, "node2"))
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return len(listener.received()) == 2 }, 2*time.Second, 100*time.Millisecond)
+		events = listener.received()
+		require.Equal(t, uint64(3), events[1].Block.GetHeader().GetBaseHeader().GetNumber())
+		require.False(t, events[1].IsReplay)
+		require.Equal(t, "replication", events[1].Source)
+	})
+
+	t.Run("stateDB is torn by a partial write -- will recover successfully", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		setup(t, env)
+
+		block2 := createSampleBlock(2, createSampleTx(t, "dataTx1", []string{"key1"}, [][]byte{[]byte("value-1")}, env.userSigner))
+		block2.Header.ValidationInfo = []*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+		}
+
+		// the updates are written to the stateDB but the node crashes before the height is updated
+		env.db.Inject(&testfault.Fault{
+			Method:       "Commit",
+			Err:          errors.New("crash"),
+			PartialWrite: 1,
+		})
+		require.EqualError(t, env.blockProcessor.committer.commitBlock(block2), "failed to commit block 2 to state database: crash")
+		env.db.Clear()
+
+		val, _, err := env.db.Get(worldstate.DefaultDBName, "key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-1"), val)
+		stateDBHeight, err := env.db.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), stateDBHeight)
+
+		env.blockProcessor.Stop()
+
+		env.blockProcessor.started = make(chan struct{})
+		env.blockProcessor.stop = make(chan struct{})
+		env.blockProcessor.stopped = make(chan struct{})
+		env.blockProcessor.blockOneQueueBarrier = queue.NewOneQueueBarrier(env.blockProcessor.logger)
+		defer env.blockProcessor.Stop()
+		go env.blockProcessor.Start()
+		env.blockProcessor.WaitTillStart()
+
+		stateDBHeight, err = env.db.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), stateDBHeight)
+
+		val, metadata, err := env.db.Get(worldstate.DefaultDBName, "key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-1"), val)
+		require.True(t, proto.Equal(&types.Version{BlockNum: 2, TxNum: 0}, metadata.GetVersion()))
 	})
 
 	t.Run("blockstore is behind stateDB by 1 block -- will result in panic", func(t *testing.T) {
@@ -565,32 +697,42 @@ func TestFailureAndRecovery(t *testing.T) {
 		require.PanicsWithError(t, "error while recovering node: the height of state database [2] is higher than the height of block store [1]. The node cannot be recovered", assertPanic)
 	})
 
-	t.Run("blockstore is ahead of stateDB by 2 blocks -- will result in panic", func(t *testing.T) {
+	// mimics a node that keeps on committing blocks while every commit to the stateDB fails
+	commitBlocksToBlockStoreOnly := func(t *testing.T, env *testEnv, numBlocks int) {
+		keys := make([]string, numBlocks)
+		values := make([][]byte, numBlocks)
+		for i := range keys {
+			keys[i] = "key1"
+			values[i] = []byte(fmt.Sprintf("value-%d", i+1))
+		}
+		tx := createSampleTx(t, "dataTx1", keys, values, env.userSigner)
+
+		env.db.FailMethod("Commit", errors.New("disk failure"))
+		defer env.db.Clear()
+
+		for i := 0; i < numBlocks; i++ {
+			block := createSampleBlock(uint64(i+2), tx[i:i+1])
+			block.Header.ValidationInfo = []*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+			}
+			require.EqualError(t, env.blockProcessor.committer.commitBlock(block),
+				fmt.Sprintf("failed to commit block %d to state database: disk failure", i+2))
+		}
+	}
+
+	t.Run("blockstore is ahead of stateDB and state trie by 5 blocks -- will recover successfully", func(t *testing.T) {
 		env := newTestEnv(t)
 		defer env.cleanup(false)
 
 		setup(t, env)
 
-		tx := createSampleTx(t, "dataTx1", []string{"key1", "key1"}, [][]byte{[]byte("value-1"), []byte("value-2")}, env.userSigner)
-		block2 := createSampleBlock(2, tx[:1])
-		block2.Header.ValidationInfo = []*types.ValidationInfo{
-			{
-				Flag: types.Flag_VALID,
-			},
-		}
-		require.NoError(t, env.blockProcessor.committer.commitToBlockStore(block2))
-
-		block3 := createSampleBlock(3, tx[1:])
-		block3.Header.ValidationInfo = []*types.ValidationInfo{
-			{
-				Flag: types.Flag_VALID,
-			},
-		}
-		require.NoError(t, env.blockProcessor.committer.commitToBlockStore(block3))
+		commitBlocksToBlockStoreOnly(t, env, 5)
 
 		blockStoreHeight, err := env.blockStore.Height()
 		require.NoError(t, err)
-		require.Equal(t, uint64(3), blockStoreHeight)
+		require.Equal(t, uint64(6), blockStoreHeight)
 
 		stateDBHeight, err := env.db.Height()
 		require.NoError(t, err)
@@ -598,15 +740,188 @@ func TestFailureAndRecovery(t *testing.T) {
 
 		env.blockProcessor.Stop()
 
+		env.blockProcessor.started = make(chan struct{})
+		env.blockProcessor.stop = make(chan struct{})
+		env.blockProcessor.stopped = make(chan struct{})
+		env.blockProcessor.blockOneQueueBarrier = queue.NewOneQueueBarrier(env.blockProcessor.logger)
+		defer env.blockProcessor.Stop()
+		go env.blockProcessor.Start()
+		env.blockProcessor.WaitTillStart()
+
+		// the recovery completes before the block processor is marked as started
+		stateDBHeight, err = env.db.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(6), stateDBHeight)
+
+		trieStoreHeight, err := env.blockProcessor.committer.stateTrieStore.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(6), trieStoreHeight)
+
+		val, metadata, err := env.db.Get(worldstate.DefaultDBName, "key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-5"), val)
+		require.True(t, proto.Equal(&types.Version{BlockNum: 6, TxNum: 0}, metadata.GetVersion()))
+
+		require.Equal(t, []*RecoveryStatus{
+			{
+				Store:           RecoveryStateDB,
+				StartHeight:     1,
+				TargetHeight:    6,
+				RecoveredHeight: 6,
+				Done:            true,
+			},
+			{
+				Store:           RecoveryStateTrie,
+				StartHeight:     1,
+				TargetHeight:    6,
+				RecoveredHeight: 6,
+				Done:            true,
+			},
+		}, env.blockProcessor.RecoveryStatus())
+	})
+
+	t.Run("blockstore is ahead of stateDB by more blocks than can be recovered -- will result in panic", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		setup(t, env)
+
+		commitBlocksToBlockStoreOnly(t, env, 3)
+
+		env.blockProcessor.Stop()
+
 		env.blockProcessor.stop = make(chan struct{})
 		env.blockProcessor.stopped = make(chan struct{})
+		env.blockProcessor.maxRecoveryBlocks = 2
 
 		env.stopBlockProcessing = make(chan struct{})
 		assertPanic := func() {
 			env.blockProcessor.Start()
 		}
-		require.PanicsWithError(t, "error while recovering node: the difference between the height of the block store [3] and the state database [1] cannot be greater than 1 block. The node cannot be recovered", assertPanic)
+		require.PanicsWithError(t, "error while recovering node: the height of the state database [1] is behind the height of the block store [4] by 3 blocks, which is more than the 2 blocks that can be recovered on start. The state database must be rebuilt", assertPanic)
+		require.Empty(t, env.blockProcessor.RecoveryStatus())
+
+		stateDBHeight, err := env.db.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), stateDBHeight)
+	})
+}
+
+func TestRecoveryReplayVerification(t *testing.T) {
+	numBlocks := 1000
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	_, nodeSigner := testutils.LoadTestCrypto(t, cryptoDir, "node1")
+
+	// commitBlocks checkpoints the state database once the setup is done, commits the blocks through the block
+	// processor, and returns the checkpoint, onto which the blocks are replayed
+	commitBlocks := func(t *testing.T, env *testEnv) string {
+		setup(t, env)
+		checkpointDir := filepath.Join(env.dbPath, "checkpoint")
+		require.NoError(t, env.db.Unwrap().(*leveldb.LevelDB).Checkpoint(checkpointDir))
+
+		for i := 0; i < numBlocks; i++ {
+			blockNum := uint64(i + 2)
+			keys := []string{fmt.Sprintf("key-%d", i%100), fmt.Sprintf("key-%d", (i+50)%100)}
+			values := [][]byte{[]byte(fmt.Sprintf("value-%d", i)), []byte(fmt.Sprintf("value-%d", i))}
+			txs := createSampleTx(t, fmt.Sprintf("tx-%d", blockNum), keys, values, env.userSigner)
+			if i%10 == 0 {
+				// a transaction on a missing database is invalid, and must be invalid on the replay as well
+				txs = append(txs, testutils.SignedDataTxEnvelope(t, []crypto.Signer{env.userSigner}, &types.DataTx{
+					MustSignUserIds: []string{env.userID},
+					TxId:            fmt.Sprintf("tx-%d-invalid", blockNum),
+					DbOperations: []*types.DBOperation{
+						{
+							DbName:     "no-such-db",
+							DataWrites: []*types.DataWrite{{Key: "key", Value: []byte("value")}},
+						},
+					},
+				}))
+			}
+			block := createSampleBlock(blockNum, txs)
+			block.Header.ValidationInfo = nil
+			_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, ""))
+			require.NoError(t, err)
+		}
+		env.blockProcessor.Stop()
+
+		height, err := env.db.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(numBlocks+1), height)
+		return checkpointDir
+	}
+
+	// replay replays the blocks of the block store onto the checkpoint with the verification of the validation info
+	replay := func(t *testing.T, env *testEnv, checkpointDir string) (*leveldb.LevelDB, *RecoveryStatus, time.Duration) {
+		db, err := leveldb.Open(&leveldb.Config{DBRootDir: checkpointDir, Logger: env.blockProcessor.logger})
+		require.NoError(t, err)
+
+		b := New(&Config{
+			BlockOneQueueBarrier: queue.NewOneQueueBarrier(env.blockProcessor.logger),
+			BlockStore:           env.blockStore,
+			StateTrieStore:       env.blockProcessor.committer.stateTrieStore,
+			DB:                   db,
+			TxValidator: txvalidation.NewValidator(&txvalidation.Config{
+				DB:         db,
+				BlockStore: env.blockStore,
+				Logger:     env.blockProcessor.logger,
+			}),
+			MaxRecoveryBlocks:        uint64(numBlocks),
+			VerifyReplayedValidation: true,
+			Logger:                   env.blockProcessor.logger,
+		})
+
+		start := time.Now()
+		require.NoError(t, b.recoverWorldStateDBIfNeeded())
+		elapsed := time.Since(start)
+
+		statuses := b.RecoveryStatus()
+		require.Len(t, statuses, 1)
+		require.True(t, statuses[0].Done)
+		return db, statuses[0], elapsed
+	}
+
+	requireSameState := func(t *testing.T, expected, actual worldstate.DB) {
+		expectedHeight, err := expected.Height()
+		require.NoError(t, err)
+		actualHeight, err := actual.Height()
+		require.NoError(t, err)
+		require.Equal(t, expectedHeight, actualHeight)
+
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("key-%d", i)
+			expectedValue, expectedMetadata, err := expected.Get(worldstate.DefaultDBName, key)
+			require.NoError(t, err)
+			actualValue, actualMetadata, err := actual.Get(worldstate.DefaultDBName, key)
+			require.NoError(t, err)
+			require.NotNil(t, actualValue)
+			require.Equal(t, expectedValue, actualValue)
+			require.True(t, proto.Equal(expectedMetadata, actualMetadata))
+		}
+	}
+
+	// the headers committed with a signer are attested, while the others are validated anew
+	signedEnv := newTestEnvWithCrypto(t, cryptoDir, func(c *Config) {
+		c.Signer = nodeSigner
+		c.NodeID = "node1"
 	})
+	defer signedEnv.cleanup(false)
+	unsignedEnv := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer unsignedEnv.cleanup(false)
+
+	signedDB, signedStatus, attestedElapsed := replay(t, signedEnv, commitBlocks(t, signedEnv))
+	defer signedDB.Close()
+	require.Equal(t, uint64(0), signedStatus.RevalidatedBlocks)
+
+	unsignedDB, unsignedStatus, revalidatedElapsed := replay(t, unsignedEnv, commitBlocks(t, unsignedEnv))
+	defer unsignedDB.Close()
+	require.Equal(t, uint64(numBlocks), unsignedStatus.RevalidatedBlocks)
+
+	t.Logf("replayed %d blocks in %s with the attested validation info, and in %s with the validation anew",
+		numBlocks, attestedElapsed, revalidatedElapsed)
+
+	requireSameState(t, signedEnv.db, signedDB)
+	requireSameState(t, unsignedEnv.db, unsignedDB)
+	requireSameState(t, signedDB, unsignedDB)
 }
 
 func TestBlockCommitListener(t *testing.T) {
@@ -623,7 +938,8 @@ func TestBlockCommitListener(t *testing.T) {
 	expectedBlock := proto.Clone(block2).(*types.Block)
 	genesisHash, err := env.blockStore.GetHash(uint64(1))
 	expectedBlock.Header.SkipchainHashes = calculateBlockHashes(t, genesisHash, []*types.Block{block2}, 2)
-	root, err := mtree.BuildTreeForBlockTx(block2)
+	require.NoError(t, addWriteSetDigests(nil, expectedBlock))
+	root, err := mtree.BuildTreeForBlockTx(expectedBlock)
 	require.NoError(t, err)
 	expectedBlock.Header.TxMerkelTreeRootHash = root.Hash()
 	stateTrieRootOrg, err := env.blockProcessor.committer.stateTrie.Hash()
@@ -634,22 +950,17 @@ func TestBlockCommitListener(t *testing.T) {
 	expectedBlock.Header.StateMerkelTreeRootHash, err = env.blockProcessor.committer.stateTrie.Hash()
 	require.NoError(t, err)
 	env.blockProcessor.committer.stateTrie, err = mptrie.NewTrie(stateTrieRootOrg, env.blockProcessor.committer.stateTrieStore)
+	genesisHeader, err := env.blockStore.GetHeader(1)
+	require.NoError(t, err)
+	expectedBlock.Header.Cumulative = nextCumulativeCounters(t, genesisHeader.GetCumulative(), expectedBlock)
 
-	listener1 := &mocks.BlockCommitListener{}
-	listener1.On("PostBlockCommitProcessing", mock.Anything).Return(func(arg mock.Arguments) {
-		receivedBlock := arg.Get(0).(*types.Block)
-		require.True(t, proto.Equal(expectedBlock, receivedBlock))
-	}).Return(nil)
-	listener2 := &mocks.BlockCommitListener{}
-	listener2.On("PostBlockCommitProcessing", mock.Anything).Return(func(arg mock.Arguments) {
-		receivedBlock := arg.Get(0).(*types.Block)
-		require.True(t, proto.Equal(expectedBlock, receivedBlock))
-	}).Return(nil)
+	listener1 := &recordingCommitListener{}
+	listener2 := &recordingCommitListener{}
 
 	env.blockProcessor.RegisterBlockCommitListener("listener1", listener1)
 	env.blockProcessor.RegisterBlockCommitListener("listener2", listener2)
 
-	reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(block2)
+	reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block2, queue.BlockOriginLocal, ""))
 	require.NoError(t, err)
 	require.Nil(t, reply) // May not be nil when we implement dynamic config
 
@@ -670,6 +981,495 @@ func TestBlockCommitListener(t *testing.T) {
 	}
 
 	require.Eventually(t, assertCommittedBlock, 2*time.Second, 100*time.Millisecond)
+	for _, listener := range []*recordingCommitListener{listener1, listener2} {
+		require.Eventually(t, func() bool { return len(listener.received()) == 1 }, 2*time.Second, 100*time.Millisecond)
+		event := listener.received()[0]
+		require.True(t, proto.Equal(expectedBlock, event.Block))
+		require.False(t, event.IsReplay)
+		require.Equal(t, "local", event.Source)
+	}
+}
+
+type recordingCommitListener struct {
+	events []*CommitEvent
+	sync.Mutex
+}
+
+func (l *recordingCommitListener) PostBlockCommitProcessing(event *CommitEvent) error {
+	l.Lock()
+	defer l.Unlock()
+
+	l.events = append(l.events, event)
+	return nil
+}
+
+func (l *recordingCommitListener) received() []*CommitEvent {
+	l.Lock()
+	defer l.Unlock()
+
+	return append([]*CommitEvent(nil), l.events...)
+}
+
+func TestBlockProcessor_ProcessedBlocksByOrigin(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(true)
+
+	// the genesis block is enqueued with a local origin
+	setup(t, env)
+
+	origins := []struct {
+		origin queue.BlockOrigin
+		peerID string
+	}{
+		{origin: queue.BlockOriginReplication, peerID: "node2"},
+		{origin: queue.BlockOriginCatchUp, peerID: "node3"},
+		{origin: queue.BlockOriginLocal},
+		{origin: queue.BlockOriginReplication, peerID: "node3"},
+		{origin: queue.BlockOriginCatchUp, peerID: "node2"},
+		{origin: queue.BlockOriginReplication, peerID: "node2"},
+	}
+
+	for i, o := range origins {
+		blockNumber := uint64(i + 2)
+		txID := fmt.Sprintf("dataTx%d", blockNumber)
+		block := createSampleBlock(blockNumber, createSampleTx(t, txID, []string{"key1"}, [][]byte{[]byte(txID)}, env.userSigner))
+
+		reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, o.origin, o.peerID))
+		require.NoError(t, err)
+		require.Nil(t, reply)
+	}
+
+	height, err := env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(origins)+1), height)
+
+	require.Equal(t, uint64(2), env.blockProcessor.ProcessedBlocks(queue.BlockOriginLocal))
+	require.Equal(t, uint64(3), env.blockProcessor.ProcessedBlocks(queue.BlockOriginReplication))
+	require.Equal(t, uint64(2), env.blockProcessor.ProcessedBlocks(queue.BlockOriginCatchUp))
+}
+
+func TestBlockProcessor_HaltsOnDivergentHeader(t *testing.T) {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	env := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer env.cleanup(true)
+	peer := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer peer.cleanup(true)
+
+	setup(t, env)
+	setup(t, peer)
+
+	// a block pulled from a peer whose header matches the locally computed one is committed
+	block2 := createSampleBlock(2, createSampleTx(t, "dataTx2", []string{"key1"}, [][]byte{[]byte("value2")}, env.userSigner))
+	reply, err := peer.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block2, queue.BlockOriginLocal, ""))
+	require.NoError(t, err)
+	require.Nil(t, reply)
+	peerBlock2, err := peer.blockStore.Get(2)
+	require.NoError(t, err)
+	require.NotEmpty(t, peerBlock2.GetHeader().GetStateMerkelTreeRootHash())
+
+	reply, err = env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(peerBlock2, queue.BlockOriginCatchUp, "node2"))
+	require.NoError(t, err)
+	require.Nil(t, reply)
+	require.Nil(t, env.blockProcessor.StateDivergence())
+	header, err := env.blockStore.GetHeader(2)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(peerBlock2.GetHeader(), header))
+
+	// the peer claims the transaction of block 3 is invalid and that it computed different roots
+	peerValidationInfo := []*types.ValidationInfo{
+		{
+			Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+			ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the key [key1] in database [bdb] changed",
+		},
+	}
+	block3 := createSampleBlock(3, createSampleTx(t, "dataTx3", []string{"key1"}, [][]byte{[]byte("value3")}, env.userSigner))
+	block3.Header.ValidationInfo = peerValidationInfo
+	block3.Header.TxMerkelTreeRootHash = []byte{1, 2, 3}
+	block3.Header.StateMerkelTreeRootHash = []byte{4, 5, 6}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block3, queue.BlockOriginCatchUp, "node2"))
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool { return env.blockProcessor.StateDivergence() != nil }, 10*time.Second, 10*time.Millisecond)
+	divergence := env.blockProcessor.StateDivergence()
+	require.Equal(t, uint64(3), divergence.GetBlockNumber())
+	require.Equal(t, "node2", divergence.GetPeerId())
+	var fields []string
+	for _, f := range divergence.GetFields() {
+		fields = append(fields, f.GetField())
+		require.NotEqual(t, f.GetLocalValue(), f.GetPeerValue())
+	}
+	require.Equal(t, []string{"validation_info[0]", "tx_merkel_tree_root_hash", "state_merkel_tree_root_hash"}, fields)
+	require.Equal(t, "BAUG", divergence.GetFields()[2].GetPeerValue())
+
+	// the diverging block is neither committed to the block store nor to the state database
+	height, err := env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), height)
+	val, _, err := env.db.Get(worldstate.DefaultDBName, "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), val)
+
+	_, err = env.blockProcessor.AcceptPeerHeader(4)
+	require.EqualError(t, err, "the node halted on a divergence at block [3], not at block [4]")
+
+	accepted, err := env.blockProcessor.AcceptPeerHeader(3)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(divergence, accepted))
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the commits did not resume")
+	}
+	require.Nil(t, env.blockProcessor.StateDivergence())
+
+	_, err = env.blockProcessor.AcceptPeerHeader(3)
+	require.EqualError(t, err, "the node has not halted on a divergence")
+
+	// the block is committed with the validation info of the peer, hence the write is not applied
+	height, err = env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+	header, err = env.blockStore.GetHeader(3)
+	require.NoError(t, err)
+	require.Len(t, header.GetValidationInfo(), 1)
+	require.True(t, proto.Equal(peerValidationInfo[0], header.GetValidationInfo()[0]))
+	val, _, err = env.db.Get(worldstate.DefaultDBName, "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), val)
+}
+
+func TestBlockProcessor_HaltsOnDivergentCumulativeCounters(t *testing.T) {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	env := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer env.cleanup(true)
+	peer := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer peer.cleanup(true)
+
+	setup(t, env)
+	setup(t, peer)
+
+	block2 := createSampleBlock(2, createSampleTx(t, "dataTx2", []string{"key1"}, [][]byte{[]byte("value2")}, env.userSigner))
+	reply, err := peer.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block2, queue.BlockOriginLocal, ""))
+	require.NoError(t, err)
+	require.Nil(t, reply)
+	peerBlock2, err := peer.blockStore.Get(2)
+	require.NoError(t, err)
+	expected := peerBlock2.GetHeader().GetCumulative()
+	require.Equal(t, uint64(2), expected.GetTxCount())
+	require.Equal(t, uint64(2), expected.GetValidTxCount())
+
+	// the peer claims one more transaction than the blocks hold
+	peerBlock2.Header.Cumulative = &types.CumulativeCounters{
+		TxCount:       expected.GetTxCount() + 1,
+		ValidTxCount:  expected.GetValidTxCount(),
+		EnvelopeBytes: expected.GetEnvelopeBytes(),
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(peerBlock2, queue.BlockOriginCatchUp, "node2"))
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool { return env.blockProcessor.StateDivergence() != nil }, 10*time.Second, 10*time.Millisecond)
+	divergence := env.blockProcessor.StateDivergence()
+	require.Equal(t, uint64(2), divergence.GetBlockNumber())
+	require.Len(t, divergence.GetFields(), 1)
+	require.Equal(t, "cumulative", divergence.GetFields()[0].GetField())
+
+	_, err = env.blockProcessor.AcceptPeerHeader(2)
+	require.NoError(t, err)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the commits did not resume")
+	}
+
+	// the counters are derived locally, from the validation info of the peer
+	header, err := env.blockStore.GetHeader(2)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(expected, header.GetCumulative()))
+}
+
+func TestBlockProcessor_CommitsPulledLegacyBlock(t *testing.T) {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	env := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer env.cleanup(true)
+	peer := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer peer.cleanup(true)
+
+	setup(t, env)
+	setup(t, peer)
+
+	// a block in the old format holds a valid transaction and one invalidated due to an mvcc conflict, for which the
+	// current rules compute a write-set digest and the conflicting reads
+	conflictingTx := testutils.SignedDataTxEnvelope(t, []crypto.Signer{env.userSigner}, &types.DataTx{
+		MustSignUserIds: []string{env.userID},
+		TxId:            "dataTx2_1",
+		DbOperations: []*types.DBOperation{
+			{
+				DbName:     worldstate.DefaultDBName,
+				DataReads:  []*types.DataRead{{Key: "key2", Version: &types.Version{BlockNum: 1, TxNum: 5}}},
+				DataWrites: []*types.DataWrite{{Key: "key3", Value: []byte("value3")}},
+			},
+		},
+	})
+	newBlock := func(rulesVersion uint32) *types.Block {
+		block := createSampleBlock(2, append(createSampleTx(t, "dataTx2", []string{"key1"}, [][]byte{[]byte("value2")}, env.userSigner), conflictingTx))
+		block.Header.BaseHeader.RulesVersion = rulesVersion
+		return block
+	}
+
+	current := newBlock(constants.RulesVersion)
+	validationInfo, err := env.blockProcessor.validator.ValidateBlock(current)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE, validationInfo[1].GetFlag())
+	require.NotEmpty(t, validationInfo[1].GetConflictingReads())
+
+	// the peer, like the older node that produced the block, computes neither the digest nor the conflicting reads of
+	// a block of older rules
+	reply, err := peer.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(newBlock(0), queue.BlockOriginLocal, ""))
+	require.NoError(t, err)
+	require.Nil(t, reply)
+	peerBlock2, err := peer.blockStore.Get(2)
+	require.NoError(t, err)
+	require.NotEmpty(t, peerBlock2.GetHeader().GetTxMerkelTreeRootHash())
+	peerInfo := peerBlock2.GetHeader().GetValidationInfo()
+	require.Len(t, peerInfo, 2)
+	require.Equal(t, types.Flag_VALID, peerInfo[0].GetFlag())
+	require.Empty(t, peerInfo[0].GetWriteSetDigest())
+	require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE, peerInfo[1].GetFlag())
+	require.Empty(t, peerInfo[1].GetConflictingReads())
+
+	// the block pulled from the peer, along with its header, is committed without halting on a divergence
+	done := make(chan error, 1)
+	go func() {
+		_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(peerBlock2, queue.BlockOriginCatchUp, "node2"))
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatalf("the block was not committed, divergence: %v", env.blockProcessor.StateDivergence())
+	}
+	require.Nil(t, env.blockProcessor.StateDivergence())
+
+	header, err := env.blockStore.GetHeader(2)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(peerBlock2.GetHeader(), header))
+	val, _, err := env.db.Get(worldstate.DefaultDBName, "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), val)
+}
+
+func TestBlockProcessor_SequencedDataTxs(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(true)
+
+	setup(t, env)
+
+	tx := func(key string, sequence uint64) *types.DataTxEnvelope {
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{env.userSigner}, &types.DataTx{
+			MustSignUserIds: []string{env.userID},
+			TxId:            fmt.Sprintf("tx-%s-%d", key, sequence),
+			Sequence:        sequence,
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: key, Value: []byte(key)}},
+				},
+			},
+		})
+	}
+
+	blocks := []*types.Block{
+		createSampleBlock(2, []*types.DataTxEnvelope{tx("key1", 1), tx("key2", 0), tx("key3", 2)}),
+		createSampleBlock(3, []*types.DataTxEnvelope{tx("key4", 0)}),
+		// the sequence number 4 leaves a gap, while the sequence number 2 was already used
+		createSampleBlock(4, []*types.DataTxEnvelope{tx("key5", 4), tx("key6", 2), tx("key7", 3)}),
+	}
+	for _, block := range blocks {
+		block.Header.BaseHeader.RulesVersion = constants.RulesVersion
+		reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, ""))
+		require.NoError(t, err)
+		require.Nil(t, reply)
+	}
+
+	expectedFlags := [][]types.Flag{
+		{types.Flag_VALID, types.Flag_VALID, types.Flag_VALID},
+		{types.Flag_VALID},
+		{types.Flag_INVALID_OUT_OF_ORDER, types.Flag_INVALID_OUT_OF_ORDER, types.Flag_VALID},
+	}
+	for i, flags := range expectedFlags {
+		block, err := env.blockStore.Get(uint64(i + 2))
+		require.NoError(t, err)
+		require.Len(t, block.GetHeader().GetValidationInfo(), len(flags))
+		for j, flag := range flags {
+			require.Equal(t, flag, block.GetHeader().GetValidationInfo()[j].GetFlag(), "block %d, tx %d", i+2, j)
+		}
+	}
+
+	last, err := identity.NewQuerier(env.db).GetLastSequence(env.userID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), last)
+
+	_, metadata, err := env.db.Get(worldstate.UsersDBName, string(identity.SequenceNamespace)+env.userID)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&types.Version{BlockNum: 4, TxNum: 2}, metadata.GetVersion()))
+
+	// a block of older rules ignores the sequence numbers, which neither invalidate a transaction nor are recorded
+	legacyBlock := createSampleBlock(5, []*types.DataTxEnvelope{tx("key8", 9)})
+	legacyBlock.Header.BaseHeader.RulesVersion = constants.KeyFormatRulesVersion
+	reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(legacyBlock, queue.BlockOriginLocal, ""))
+	require.NoError(t, err)
+	require.Nil(t, reply)
+	block, err := env.blockStore.Get(5)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, block.GetHeader().GetValidationInfo()[0].GetFlag())
+
+	last, err = identity.NewQuerier(env.db).GetLastSequence(env.userID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), last)
+}
+
+func TestBlockProcessor_DeterministicExecutionMode(t *testing.T) {
+	t.Parallel()
+
+	const (
+		blockCount  = 40
+		txsPerBlock = 12
+	)
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	_, userSigner := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+	// the corpus is signed once, as signatures are randomized, and replayed in both modes. Each block mixes blind
+	// writes with reads that are valid, stale, or conflicting with an earlier write in the same block, along with
+	// transactions that must be signed by an unknown user.
+	var corpus []*types.Block
+	for i := 0; i < blockCount; i++ {
+		blockNumber := uint64(i + 2)
+		var envs []*types.DataTxEnvelope
+		for j := 0; j < txsPerBlock; j++ {
+			key := fmt.Sprintf("key%d", (i*txsPerBlock+j)%30)
+			tx := &types.DataTx{
+				MustSignUserIds: []string{"testUser"},
+				TxId:            fmt.Sprintf("dataTx%d_%d", blockNumber, j),
+				DbOperations: []*types.DBOperation{
+					{
+						DbName: worldstate.DefaultDBName,
+						DataWrites: []*types.DataWrite{
+							{Key: key, Value: []byte(fmt.Sprintf("value-%d-%d", blockNumber, j))},
+						},
+					},
+				},
+			}
+
+			switch j % 4 {
+			case 1:
+				tx.DbOperations[0].DataReads = []*types.DataRead{{Key: "absent-key"}}
+			case 2:
+				tx.DbOperations[0].DataReads = []*types.DataRead{{Key: fmt.Sprintf("key%d", (i*txsPerBlock+j-1)%30)}}
+			case 3:
+				tx.DbOperations[0].DataReads = []*types.DataRead{
+					{Key: "key0", Version: &types.Version{BlockNum: 1, TxNum: 0}},
+				}
+			}
+			if j == txsPerBlock-1 {
+				tx.MustSignUserIds = []string{"unknownUser"}
+			}
+
+			envs = append(envs, testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, tx))
+		}
+		corpus = append(corpus, createSampleBlock(blockNumber, envs))
+	}
+
+	type committedLedger struct {
+		validation  [][]*types.ValidationInfo
+		stateHashes [][]byte
+		rawBlocks   [][]byte
+		stateCommit int
+	}
+
+	replay := func(t *testing.T, deterministic bool) *committedLedger {
+		env := newTestEnvWithCrypto(t, cryptoDir, func(c *Config) {
+			c.TxValidator = txvalidation.NewValidator(&txvalidation.Config{
+				DB:            c.DB,
+				ExecutionMode: txvalidation.ExecutionMode{Deterministic: deterministic},
+				Logger:        c.Logger,
+			})
+			c.CoalesceBacklogThreshold = 1
+		})
+		defer env.cleanup(true)
+
+		setup(t, env)
+		commitsBefore := env.db.Calls("Commit")
+
+		for i, block := range corpus {
+			blockWithOrigin := queue.NewBlockWithOrigin(proto.Clone(block).(*types.Block), queue.BlockOriginCatchUp, "node2")
+			blockWithOrigin.Backlog = uint64(len(corpus) - i - 1)
+
+			reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(blockWithOrigin)
+			require.NoError(t, err)
+			require.Nil(t, reply)
+		}
+
+		ledger := &committedLedger{
+			stateCommit: env.db.Calls("Commit") - commitsBefore,
+		}
+		for blockNumber := uint64(1); blockNumber <= blockCount+1; blockNumber++ {
+			header, err := env.blockStore.GetHeader(blockNumber)
+			require.NoError(t, err)
+			ledger.validation = append(ledger.validation, header.GetValidationInfo())
+			ledger.stateHashes = append(ledger.stateHashes, header.GetStateMerkelTreeRootHash())
+
+			rawBlock, err := env.blockStore.GetRaw(blockNumber)
+			require.NoError(t, err)
+			ledger.rawBlocks = append(ledger.rawBlocks, rawBlock)
+
+			metadata, err := env.blockStore.GetProducerMetadata(blockNumber)
+			require.NoError(t, err)
+			require.Equal(t, deterministic, metadata.GetDebugDeterministic())
+		}
+
+		return ledger
+	}
+
+	expected := replay(t, false)
+	actual := replay(t, true)
+
+	var valid, invalid int
+	require.Len(t, actual.validation, len(expected.validation))
+	for i, validationInfo := range expected.validation {
+		require.Len(t, actual.validation[i], len(validationInfo))
+		for j := range validationInfo {
+			require.True(t, proto.Equal(validationInfo[j], actual.validation[i][j]), "block %d, tx %d", i+1, j)
+			if validationInfo[j].Flag == types.Flag_VALID {
+				valid++
+			} else {
+				invalid++
+			}
+		}
+	}
+	// the corpus exercises both outcomes
+	require.Greater(t, valid, blockCount)
+	require.Greater(t, invalid, blockCount)
+
+	require.NotEmpty(t, expected.stateHashes[blockCount])
+	require.Equal(t, expected.stateHashes, actual.stateHashes)
+	require.Equal(t, expected.rawBlocks, actual.rawBlocks)
+
+	// the backlog coalesces the commits, unless the execution mode is deterministic
+	require.Less(t, expected.stateCommit, blockCount)
+	require.Equal(t, blockCount, actual.stateCommit)
 }
 
 func createSampleBlock(blockNumber uint64, env []*types.DataTxEnvelope) *types.Block {
@@ -715,6 +1515,14 @@ func createSampleTx(t *testing.T, txId string, key []string, value [][]byte, sig
 	return envelopes
 }
 
+// nextCumulativeCounters returns the cumulative counters of the block, whose validation info is complete, given the
+// counters of the previous block
+func nextCumulativeCounters(t *testing.T, prev *types.CumulativeCounters, block *types.Block) *types.CumulativeCounters {
+	envelopeBytes, err := blockstore.BlockEnvelopeBytes(block)
+	require.NoError(t, err)
+	return blockstore.NextCumulativeCounters(prev, block.GetHeader(), envelopeBytes)
+}
+
 func calculateBlockHashes(t *testing.T, genesisHash []byte, blocks []*types.Block, blockNum uint64) [][]byte {
 	res := make([][]byte, 0)
 	distance := uint64(1)
@@ -736,3 +1544,313 @@ func calculateBlockHashes(t *testing.T, genesisHash []byte, blocks []*types.Bloc
 	}
 	return res
 }
+
+// stateDBDigestSource serves the state digests recorded by the state database of a peer
+type stateDBDigestSource struct {
+	db worldstate.DB
+}
+
+func (s *stateDBDigestSource) PullDigests(_ context.Context, _ string, blockNum uint64, dbNames []string) (map[string][]byte, error) {
+	height, err := s.db.Height()
+	if err != nil {
+		return nil, err
+	}
+	if blockNum > height {
+		return nil, errors.Errorf("requested block [%d] is above the height of the state database [%d]", blockNum, height)
+	}
+
+	digests := make(map[string][]byte)
+	for _, dbName := range dbNames {
+		if digests[dbName], _, err = s.db.GetDigest(dbName, blockNum); err != nil {
+			return nil, err
+		}
+	}
+	return digests, nil
+}
+
+func TestBlockProcessor_HaltsOnDivergentDigest(t *testing.T) {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	env := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer env.cleanup(true)
+	peer := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer peer.cleanup(true)
+
+	setup(t, env)
+	setup(t, peer)
+	env.blockProcessor.SetPeerDigestSource(&stateDBDigestSource{db: peer.db})
+
+	// both states are built independently from the same blocks, hence they hold the same digests
+	for _, e := range []*testEnv{env, peer} {
+		block2 := createSampleBlock(2, createSampleTx(t, "dataTx2", []string{"key1"}, [][]byte{[]byte("value2")}, env.userSigner))
+		reply, err := e.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block2, queue.BlockOriginLocal, ""))
+		require.NoError(t, err)
+		require.Nil(t, reply)
+	}
+	digest, updatedAt, err := env.db.GetDigest(worldstate.DefaultDBName, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), updatedAt)
+	require.NotEqual(t, worldstate.EmptyDigest(), digest)
+	peerDigest, _, err := peer.db.GetDigest(worldstate.DefaultDBName, 2)
+	require.NoError(t, err)
+	require.Equal(t, digest, peerDigest)
+
+	// the peer commits block 3 with a value that differs from the one of the block pulled from it
+	peerBlock3 := createSampleBlock(3, createSampleTx(t, "dataTx3", []string{"key1"}, [][]byte{[]byte("value3")}, env.userSigner))
+	reply, err := peer.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(peerBlock3, queue.BlockOriginLocal, ""))
+	require.NoError(t, err)
+	require.Nil(t, reply)
+	peerDigest, _, err = peer.db.GetDigest(worldstate.DefaultDBName, 3)
+	require.NoError(t, err)
+	require.NotEqual(t, digest, peerDigest)
+
+	committedPeerBlock3, err := peer.blockStore.Get(3)
+	require.NoError(t, err)
+	block3 := createSampleBlock(3, createSampleTx(t, "dataTx3", []string{"key1"}, [][]byte{[]byte("value4")}, env.userSigner))
+	block3.Header.ValidationInfo = committedPeerBlock3.GetHeader().GetValidationInfo()
+	block3.Header.TxMerkelTreeRootHash = committedPeerBlock3.GetHeader().GetTxMerkelTreeRootHash()
+	block3.Header.StateMerkelTreeRootHash = committedPeerBlock3.GetHeader().GetStateMerkelTreeRootHash()
+	done := make(chan error, 1)
+	go func() {
+		_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block3, queue.BlockOriginCatchUp, "node2"))
+		done <- err
+	}()
+
+	// the digests are compared before the headers, hence the divergence is reported by the digest alone
+	require.Eventually(t, func() bool { return env.blockProcessor.StateDivergence() != nil }, 10*time.Second, 10*time.Millisecond)
+	divergence := env.blockProcessor.StateDivergence()
+	require.Equal(t, uint64(3), divergence.GetBlockNumber())
+	require.Equal(t, "node2", divergence.GetPeerId())
+	require.Len(t, divergence.GetFields(), 1)
+	require.Equal(t, "state_digest[bdb]", divergence.GetFields()[0].GetField())
+	require.Equal(t, base64.StdEncoding.EncodeToString(peerDigest), divergence.GetFields()[0].GetPeerValue())
+	require.NotEqual(t, divergence.GetFields()[0].GetLocalValue(), divergence.GetFields()[0].GetPeerValue())
+
+	// neither the block nor its digests are committed
+	height, err := env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), height)
+	_, updatedAt, err = env.db.GetDigest(worldstate.DefaultDBName, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), updatedAt)
+
+	_, err = env.blockProcessor.AcceptPeerHeader(3)
+	require.NoError(t, err)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the commits did not resume")
+	}
+	height, err = env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+}
+
+func TestBlockProcessor_AppliesStateDelta(t *testing.T) {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser", "node1", "admin1"})
+	env := newTestEnvWithCrypto(t, cryptoDir, nil)
+	defer env.cleanup(true)
+	peer := newTestEnvWithCrypto(t, cryptoDir, func(c *Config) {
+		c.RecordStateDeltas = true
+	})
+	defer peer.cleanup(true)
+
+	setup(t, env)
+	setup(t, peer)
+
+	for blockNum := uint64(2); blockNum <= 5; blockNum++ {
+		block := createSampleBlock(blockNum, createSampleTx(t, fmt.Sprintf("dataTx%d", blockNum), []string{"key1", fmt.Sprintf("key%d", blockNum)},
+			[][]byte{[]byte(fmt.Sprintf("value%d", blockNum)), []byte("value")}, env.userSigner))
+		reply, err := peer.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, ""))
+		require.NoError(t, err)
+		require.Nil(t, reply)
+	}
+
+	source := statesync.NewSource(peer.blockStore)
+	headers, err := source.GetHeaders(2, 4)
+	require.NoError(t, err)
+	delta, err := source.NetStateDelta(2, 4)
+	require.NoError(t, err)
+
+	// a delta that does not match the headers is refused, and nothing is committed
+	tampered := proto.Clone(delta).(*types.StateDelta)
+	tampered.Keys[0].Value = []byte("tampered")
+	reply, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewStateDeltaWithOrigin(headers, tampered, "node2"))
+	require.NoError(t, err)
+	require.Error(t, reply.(*queue.StateDeltaResult).Err)
+	height, err := env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), height)
+
+	reply, err = env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewStateDeltaWithOrigin(headers, delta, "node2"))
+	require.NoError(t, err)
+	require.Equal(t, &queue.StateDeltaResult{}, reply)
+	height, err = env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), height)
+	value, _, err := env.db.Get(worldstate.DefaultDBName, "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value4"), value)
+
+	// the blocks of the range are held by their headers alone
+	_, err = env.blockStore.Get(3)
+	require.IsType(t, &ierrors.NotFoundErr{}, err)
+	header, err := env.blockStore.GetHeader(3)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(headers[1], header))
+
+	// the next block is committed on top of the synced state, and matches the one of the peer
+	block5, err := peer.blockStore.Get(5)
+	require.NoError(t, err)
+	block5.Header = &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: 5}, ValidationInfo: block5.GetHeader().GetValidationInfo()}
+	reply, err = env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block5, queue.BlockOriginLocal, ""))
+	require.NoError(t, err)
+	require.Nil(t, reply)
+	hash, err := env.blockStore.GetHash(5)
+	require.NoError(t, err)
+	peerHash, err := peer.blockStore.GetHash(5)
+	require.NoError(t, err)
+	require.Equal(t, peerHash, hash)
+}
+
+func TestBlockProcessor_IsolatesFailedDB(t *testing.T) {
+	env := newTestEnvWithConfig(t, func(c *Config) {
+		c.IsolateFailedDBs = true
+	})
+	defer env.cleanup(true)
+
+	setup(t, env)
+
+	user := &types.User{
+		Id:          env.userID,
+		Certificate: env.userCert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+				"db1":                    types.Privilege_ReadWrite,
+			},
+		},
+	}
+	u, err := proto.Marshal(user)
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {
+			Writes: []*worldstate.KVWithMetadata{{Key: "db1"}},
+		},
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:   string(identity.UserNamespace) + env.userID,
+					Value: u,
+					Metadata: &types.Metadata{
+						Version: &types.Version{
+							BlockNum: 1,
+							TxNum:    2,
+						},
+					},
+				},
+			},
+		},
+	}, 1))
+
+	dataTx := func(txID, dbName, key, value string) *types.DataTxEnvelope {
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{env.userSigner}, &types.DataTx{
+			MustSignUserIds: []string{env.userID},
+			TxId:            txID,
+			DbOperations: []*types.DBOperation{
+				{
+					DbName: dbName,
+					DataWrites: []*types.DataWrite{
+						{
+							Key:   key,
+							Value: []byte(value),
+						},
+					},
+				},
+			},
+		})
+	}
+	commit := func(block *types.Block) *types.Block {
+		_, err := env.blockProcessor.blockOneQueueBarrier.EnqueueWait(queue.NewBlockWithOrigin(block, queue.BlockOriginLocal, ""))
+		require.NoError(t, err)
+		committed, err := env.blockStore.Get(block.GetHeader().GetBaseHeader().GetNumber())
+		require.NoError(t, err)
+		return committed
+	}
+
+	// the commits to db1 fail, as if its storage went read-only
+	env.db.Inject(&testfault.Fault{
+		Method: "Commit",
+		DBName: "db1",
+		Err:    errors.New("read-only storage"),
+	})
+	block2 := createSampleBlock(2, []*types.DataTxEnvelope{
+		dataTx("dataTx1", worldstate.DefaultDBName, "key1", "value1"),
+		dataTx("dataTx2", "db1", "key1", "value2"),
+	})
+	committed := commit(block2)
+	require.Equal(t, types.Flag_VALID, committed.GetHeader().GetValidationInfo()[0].GetFlag())
+	require.Equal(t, types.Flag_VALID, committed.GetHeader().GetValidationInfo()[1].GetFlag())
+
+	height, err := env.db.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), height)
+	val, _, err := env.db.Get(worldstate.DefaultDBName, "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), val)
+	val, _, err = env.db.Get("db1", "key1")
+	require.NoError(t, err)
+	require.Nil(t, val)
+	require.True(t, env.db.IsUnavailable("db1"))
+	skipped, err := env.db.GetSkippedCommits()
+	require.NoError(t, err)
+	require.Equal(t, map[string][]uint64{"db1": {2}}, skipped)
+
+	// the ledger keeps committing to the other databases, while the transactions on db1 are invalidated
+	block3 := createSampleBlock(3, []*types.DataTxEnvelope{
+		dataTx("dataTx3", worldstate.DefaultDBName, "key2", "value3"),
+		dataTx("dataTx4", "db1", "key2", "value4"),
+	})
+	committed = commit(block3)
+	require.Equal(t, types.Flag_VALID, committed.GetHeader().GetValidationInfo()[0].GetFlag())
+	require.Equal(t, types.Flag_INVALID_DB_UNAVAILABLE, committed.GetHeader().GetValidationInfo()[1].GetFlag())
+
+	height, err = env.db.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+	val, _, err = env.db.Get(worldstate.DefaultDBName, "key2")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value3"), val)
+
+	// a resync fails while the storage of db1 is still faulty, and the skipped commits are kept
+	_, err = env.blockProcessor.ResyncDB("db1")
+	require.EqualError(t, err, "failed to resync block 2 to database [db1]: read-only storage")
+	require.True(t, env.db.IsUnavailable("db1"))
+
+	env.db.Clear()
+	resynced, err := env.blockProcessor.ResyncDB("db1")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, resynced)
+	require.False(t, env.db.IsUnavailable("db1"))
+	val, metadata, err := env.db.Get("db1", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), val)
+	require.True(t, proto.Equal(&types.Version{BlockNum: 2, TxNum: 1}, metadata.GetVersion()))
+	height, err = env.db.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+
+	_, err = env.blockProcessor.ResyncDB("db1")
+	require.EqualError(t, err, "the database [db1] has no skipped commit to resync")
+	require.IsType(t, &ierrors.BadRequestError{}, err)
+
+	// db1 takes transactions again
+	block4 := createSampleBlock(4, []*types.DataTxEnvelope{
+		dataTx("dataTx5", "db1", "key2", "value5"),
+	})
+	committed = commit(block4)
+	require.Equal(t, types.Flag_VALID, committed.GetHeader().GetValidationInfo()[0].GetFlag())
+	val, _, err = env.db.Get("db1", "key2")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value5"), val)
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// A block is committed progressively: the work that does not depend on the validation of the block, or only on the
+// validation of some of its transactions, overlaps the validation of the rest of the block. The transaction envelopes
+// are serialized as soon as the block is received, and the write-set digest of a transaction is computed as soon as
+// its validation result is final. The header, which holds the validation info, is serialized last, hence, the header
+// hash covers the final validation info, and nothing is persisted before the validation of the block completes.
+
+type preparationResult struct {
+	prepared *blockstore.PreparedBlock
+	err      error
+}
+
+// prepareBlockInBackground serializes everything but the header of the block in the background
+func prepareBlockInBackground(block *types.Block) <-chan *preparationResult {
+	result := make(chan *preparationResult, 1)
+	go func() {
+		prepared, err := blockstore.PrepareBlock(block)
+		result <- &preparationResult{prepared: prepared, err: err}
+	}()
+	return result
+}
+
+type txValidationResult struct {
+	txNum   int
+	valInfo *types.ValidationInfo
+}
+
+// writeSetDigester computes the write-set digests of the valid data transactions of a block, in the background, as the
+// validation results of the transactions are handed out by the validator. The digests are derived from the committed
+// state, which the validation of the block does not modify.
+type writeSetDigester struct {
+	db      worldstate.DB
+	block   *types.Block
+	results chan *txValidationResult
+	done    chan struct{}
+	err     error
+}
+
+func newWriteSetDigester(db worldstate.DB, block *types.Block) *writeSetDigester {
+	envelopes := block.GetDataTxEnvelopes().GetEnvelopes()
+	d := &writeSetDigester{
+		db:    db,
+		block: block,
+		done:  make(chan struct{}),
+	}
+	if envelopes == nil || !utils.HasWriteSetDigests(block.GetHeader().GetBaseHeader()) {
+		close(d.done)
+		return d
+	}
+
+	// the channel holds the results of all the transactions, so that the validation never waits for the digests
+	d.results = make(chan *txValidationResult, len(envelopes))
+	go d.run(envelopes)
+	return d
+}
+
+// add hands out the validation result of a transaction, see txvalidation.TxResultHandler
+func (d *writeSetDigester) add(txNum int, valInfo *types.ValidationInfo) {
+	if d.results == nil {
+		return
+	}
+	d.results <- &txValidationResult{txNum: txNum, valInfo: valInfo}
+}
+
+// wait waits for the digests of the results handed out so far, and returns the first error met while computing them
+func (d *writeSetDigester) wait() error {
+	if d.results != nil {
+		close(d.results)
+	}
+	<-d.done
+	return d.err
+}
+
+func (d *writeSetDigester) run(envelopes []*types.DataTxEnvelope) {
+	defer close(d.done)
+
+	for res := range d.results {
+		if d.err != nil || res.valInfo.Flag != types.Flag_VALID {
+			continue
+		}
+
+		version := &types.Version{
+			BlockNum: d.block.GetHeader().GetBaseHeader().GetNumber(),
+			TxNum:    uint64(res.txNum),
+		}
+		digest, err := CalculateWriteSetDigestForDataTx(d.db, envelopes[res.txNum].Payload, version)
+		if err != nil {
+			d.err = err
+			continue
+		}
+		res.valInfo.WriteSetDigest = digest
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockprocessor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSetDigester(t *testing.T) {
+	t.Parallel()
+
+	newBlock := func() *types.Block {
+		var envelopes []*types.DataTxEnvelope
+		for i := 0; i < 10; i++ {
+			envelopes = append(envelopes, &types.DataTxEnvelope{
+				Payload: &types.DataTx{
+					TxId: fmt.Sprintf("tx%d", i),
+					DbOperations: []*types.DBOperation{
+						{
+							DbName:     "db1",
+							DataWrites: []*types.DataWrite{{Key: fmt.Sprintf("key%d", i), Value: []byte("value")}},
+						},
+					},
+				},
+			})
+		}
+		return &types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{Number: 2, RulesVersion: constants.RulesVersion},
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{Envelopes: envelopes},
+			},
+		}
+	}
+	newValidationInfo := func() []*types.ValidationInfo {
+		var valInfo []*types.ValidationInfo
+		for i := 0; i < 10; i++ {
+			flag := types.Flag_VALID
+			if i%3 == 1 {
+				flag = types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE
+			}
+			valInfo = append(valInfo, &types.ValidationInfo{Flag: flag})
+		}
+		return valInfo
+	}
+
+	t.Run("the digests are the ones of the whole block", func(t *testing.T) {
+		t.Parallel()
+
+		expected := newBlock()
+		expected.Header.ValidationInfo = newValidationInfo()
+		require.NoError(t, addWriteSetDigests(nil, expected))
+
+		block := newBlock()
+		valInfo := newValidationInfo()
+		d := newWriteSetDigester(nil, block)
+		for txNum := range valInfo {
+			d.add(txNum, valInfo[txNum])
+		}
+		require.NoError(t, d.wait())
+
+		for txNum := range valInfo {
+			require.True(t, proto.Equal(expected.Header.ValidationInfo[txNum], valInfo[txNum]), "tx %d", txNum)
+		}
+	})
+
+	t.Run("an aborted validation", func(t *testing.T) {
+		t.Parallel()
+
+		block := newBlock()
+		valInfo := newValidationInfo()
+		d := newWriteSetDigester(nil, block)
+		d.add(0, valInfo[0])
+		require.NoError(t, d.wait())
+
+		require.NotEmpty(t, valInfo[0].WriteSetDigest)
+		for _, info := range valInfo[1:] {
+			require.Empty(t, info.WriteSetDigest)
+		}
+	})
+
+	t.Run("a block of rules that predate the digests", func(t *testing.T) {
+		t.Parallel()
+
+		block := newBlock()
+		block.Header.BaseHeader.RulesVersion = constants.WriteSetDigestRulesVersion - 1
+		valInfo := newValidationInfo()
+		d := newWriteSetDigester(nil, block)
+		for txNum := range valInfo {
+			d.add(txNum, valInfo[txNum])
+		}
+		require.NoError(t, d.wait())
+
+		for _, info := range valInfo {
+			require.Empty(t, info.WriteSetDigest)
+		}
+	})
+
+	t.Run("not a data block", func(t *testing.T) {
+		t.Parallel()
+
+		block := createSampleBlock(2, nil)
+		block.Payload = &types.Block_UserAdministrationTxEnvelope{
+			UserAdministrationTxEnvelope: &types.UserAdministrationTxEnvelope{Payload: &types.UserAdministrationTx{TxId: "tx1"}},
+		}
+		d := newWriteSetDigester(nil, block)
+		valInfo := &types.ValidationInfo{Flag: types.Flag_VALID}
+		d.add(0, valInfo)
+		require.NoError(t, d.wait())
+		require.Empty(t, valInfo.WriteSetDigest)
+	})
+}
+
+func TestPrepareBlockInBackground(t *testing.T) {
+	t.Parallel()
+
+	block := createSampleBlock(2, nil)
+	preparation := prepareBlockInBackground(block)
+
+	// the header is completed while the block is prepared
+	block.Header.ValidationInfo = []*types.ValidationInfo{{Flag: types.Flag_VALID}}
+	result := <-preparation
+	require.NoError(t, result.err)
+	require.Same(t, block, result.prepared.Block())
+
+	expected, err := blockstore.PrepareBlock(block)
+	require.NoError(t, err)
+	require.Equal(t, expected, result.prepared)
+}
@@ -0,0 +1,249 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxRecoveryBlocks is the maximal number of blocks by which a store may lag behind the block store and
+	// still be recovered on start, when no other limit is configured.
+	DefaultMaxRecoveryBlocks = uint64(10000)
+
+	// RecoveryStateDB and RecoveryStateTrie name the stores that are recovered on start.
+	RecoveryStateDB   = "state database"
+	RecoveryStateTrie = "state trie"
+
+	recoveryProgressLogInterval = 1000
+)
+
+// RecoveryStatus describes the replay of committed blocks onto a store that lags behind the block store. The replay
+// runs when the block processor starts, and the block processor is marked as started only after it completes.
+type RecoveryStatus struct {
+	// Store is the name of the recovered store, e.g., RecoveryStateDB.
+	Store string
+	// StartHeight is the height of the store before the recovery.
+	StartHeight uint64
+	// TargetHeight is the height of the block store.
+	TargetHeight uint64
+	// RecoveredHeight is the last block replayed onto the store.
+	RecoveredHeight uint64
+	// Done is set once the store reached the target height.
+	Done bool
+	// RevalidatedBlocks is the number of replayed blocks that were validated anew, as their validation info was not
+	// attested by the signature of a node, see Config.VerifyReplayedValidation.
+	RevalidatedBlocks uint64
+}
+
+type recoveryStatuses struct {
+	mu       sync.RWMutex
+	statuses []*RecoveryStatus
+}
+
+func (r *recoveryStatuses) add(status *RecoveryStatus) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.statuses = append(r.statuses, status)
+}
+
+func (r *recoveryStatuses) update(status *RecoveryStatus, recoveredHeight uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status.RecoveredHeight = recoveredHeight
+	status.Done = recoveredHeight == status.TargetHeight
+}
+
+func (r *recoveryStatuses) addRevalidated(status *RecoveryStatus) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status.RevalidatedBlocks++
+}
+
+func (r *recoveryStatuses) get() []*RecoveryStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	statuses := make([]*RecoveryStatus, len(r.statuses))
+	for i, s := range r.statuses {
+		status := *s
+		statuses[i] = &status
+	}
+	return statuses
+}
+
+// RecoveryStatus returns the status of the stores recovered since the block processor was started, if any.
+func (b *BlockProcessor) RecoveryStatus() []*RecoveryStatus {
+	return b.recoveries.get()
+}
+
+// replayBlocks applies the committed blocks in the range (storeHeight, blockStoreHeight] onto a store that lags
+// behind the block store, provided that the gap does not exceed the maximal number of blocks to recover.
+func (b *BlockProcessor) replayBlocks(store string, storeHeight, blockStoreHeight uint64, apply func(block *types.Block, status *RecoveryStatus) error) error {
+	if blockStoreHeight-storeHeight > b.maxRecoveryBlocks {
+		return errors.Errorf(
+			"the height of the %s [%d] is behind the height of the block store [%d] by %d blocks, which is more than the %d blocks that can be recovered on start. The %s must be rebuilt",
+			store, storeHeight, blockStoreHeight, blockStoreHeight-storeHeight, b.maxRecoveryBlocks, store,
+		)
+	}
+
+	status := &RecoveryStatus{
+		Store:           store,
+		StartHeight:     storeHeight,
+		TargetHeight:    blockStoreHeight,
+		RecoveredHeight: storeHeight,
+	}
+	b.recoveries.add(status)
+
+	b.logger.Warnf("the %s is at height %d while the block store is at height %d, replaying %d blocks",
+		store, storeHeight, blockStoreHeight, blockStoreHeight-storeHeight)
+
+	start := time.Now()
+	for blockNum := storeHeight + 1; blockNum <= blockStoreHeight; blockNum++ {
+		block, err := b.blockStore.Get(blockNum)
+		if err != nil {
+			return err
+		}
+		if err = apply(block, status); err != nil {
+			return errors.WithMessagef(err, "error while replaying block %d onto the %s", blockNum, store)
+		}
+		b.recoveries.update(status, blockNum)
+
+		if replayed := blockNum - storeHeight; replayed%recoveryProgressLogInterval == 0 {
+			b.logger.Infof("recovery of the %s: replayed %d of %d blocks", store, replayed, blockStoreHeight-storeHeight)
+		}
+	}
+
+	b.logger.Infof("recovered the %s to height %d in %s", store, blockStoreHeight, time.Since(start))
+	if status.RevalidatedBlocks > 0 {
+		b.logger.Infof("%d of the blocks replayed onto the %s were validated anew, as their validation info was not attested",
+			status.RevalidatedBlocks, store)
+	}
+	return nil
+}
+
+// verifyReplayedValidationInfo verifies that the validation info of a block replayed onto the state database is
+// attested by the signature of a node of the cluster over the header of the block. Otherwise, the block is validated
+// anew against the state database, which holds the state as of the previous block, and the resulting validation info
+// must match the one in the header, which is part of the chain of block hashes, by the fields the rules of the block
+// define. It returns whether the block was validated anew.
+func (b *BlockProcessor) verifyReplayedValidationInfo(block *types.Block) (bool, error) {
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+	reason, err := b.unattestedReason(block.GetHeader())
+	if err != nil {
+		return false, err
+	}
+	if reason == "" {
+		return false, nil
+	}
+	b.logger.Debugf("validating block %d anew, as %s", blockNum, reason)
+
+	revalidated := proto.Clone(block).(*types.Block)
+	validationInfo, err := b.validator.ValidateBlock(revalidated)
+	if err != nil {
+		return false, errors.WithMessagef(err, "error while validating block %d anew", blockNum)
+	}
+	revalidated.Header.ValidationInfo = validationInfo
+	if err = addWriteSetDigests(b.committer.db, revalidated); err != nil {
+		return false, err
+	}
+
+	expected := block.GetHeader().GetValidationInfo()
+	if len(validationInfo) != len(expected) {
+		return false, errors.Errorf("the validation of block %d anew yields %d validation info entries, while its header holds %d",
+			blockNum, len(validationInfo), len(expected))
+	}
+	baseHeader := block.GetHeader().GetBaseHeader()
+	for txNum := range expected {
+		if !proto.Equal(utils.RulesValidationInfo(expected[txNum], baseHeader), utils.RulesValidationInfo(validationInfo[txNum], baseHeader)) {
+			return false, errors.Errorf("the validation of block %d anew yields validation info [%s] for transaction %d, while its header holds [%s]",
+				blockNum, validationInfo[txNum], txNum, expected[txNum])
+		}
+	}
+
+	return true, nil
+}
+
+// unattestedReason returns why the validation info in the header of a block is not attested, or an empty string if
+// the header is signed by a node in the cluster configuration of the state database.
+func (b *BlockProcessor) unattestedReason(header *types.BlockHeader) (string, error) {
+	blockNum := header.GetBaseHeader().GetNumber()
+	metadata, err := b.blockStore.GetProducerMetadata(blockNum)
+	if err != nil {
+		return "", err
+	}
+	if len(metadata.GetHeaderSignature()) == 0 {
+		return "its header is not signed", nil
+	}
+
+	nodeID := metadata.GetValidatorNodeId()
+	node, _, err := identity.NewQuerier(b.committer.db).GetNode(nodeID)
+	if err != nil {
+		if _, ok := err.(*identity.NotFoundErr); ok {
+			return "its header is signed by node [" + nodeID + "], which is not in the cluster configuration", nil
+		}
+		return "", errors.WithMessagef(err, "error while fetching the configuration of node [%s]", nodeID)
+	}
+
+	headerBytes, err := marshal.DeterministicMarshal(header)
+	if err != nil {
+		return "", errors.Wrapf(err, "error while marshaling the header of block %d", blockNum)
+	}
+	if err := cryptoservice.VerifyNodeSignature(node, blockNum, metadata.GetHeaderSignature(), headerBytes); err != nil {
+		return "the signature of node [" + nodeID + "] over its header does not verify: " + err.Error(), nil
+	}
+
+	return "", nil
+}
+
+// ReplayState commits the state changes of the blocks in the range (height of db, untilBlockNum] of the block store
+// onto the given state database. Nothing but the given state database is updated, and hence, it rebuilds the state as
+// of a past block on a scratch database, e.g., to re-validate the block that follows it.
+func ReplayState(db worldstate.DB, blockStore *blockstore.Store, untilBlockNum uint64, logger *logger.SugarLogger) error {
+	c := &committer{
+		db:         db,
+		blockStore: blockStore,
+		logger:     logger,
+	}
+
+	height, err := db.Height()
+	if err != nil {
+		return err
+	}
+	if height > untilBlockNum {
+		return errors.Errorf("the height of the state database [%d] is already higher than block [%d]", height, untilBlockNum)
+	}
+
+	for blockNum := height + 1; blockNum <= untilBlockNum; blockNum++ {
+		block, err := blockStore.Get(blockNum)
+		if err != nil {
+			return err
+		}
+
+		dbsUpdates, _, err := c.constructDBAndProvenanceEntries(block)
+		if err != nil {
+			return errors.WithMessagef(err, "error while replaying block %d", blockNum)
+		}
+		if err := c.commitToStateDB(blockNum, dbsUpdates); err != nil {
+			return errors.WithMessagef(err, "error while replaying block %d", blockNum)
+		}
+	}
+
+	return nil
+}
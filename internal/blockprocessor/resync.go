@@ -0,0 +1,120 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"github.com/golang/protobuf/proto"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// ResyncDB commits to an unavailable database the updates of the blocks whose commit to it was skipped, and makes it
+// available again. The updates are reconstructed from the blocks in the block store. As the data transactions on an
+// unavailable database are invalidated, no later block updated the database, and the updates are committed on top of
+// its state as is. It returns the numbers of the resynced blocks.
+func (b *BlockProcessor) ResyncDB(dbName string) ([]uint64, error) {
+	return b.committer.resyncDB(dbName)
+}
+
+func (c *committer) resyncDB(dbName string) ([]uint64, error) {
+	c.stateDBMu.Lock()
+	defer c.stateDBMu.Unlock()
+
+	skipped, err := c.db.GetSkippedCommits()
+	if err != nil {
+		return nil, err
+	}
+	blockNums, ok := skipped[dbName]
+	if !ok {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the database [" + dbName + "] has no skipped commit to resync"}
+	}
+
+	height, err := c.db.Height()
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Infof("resyncing database [%s] with the updates of blocks %v", dbName, blockNums)
+	for _, blockNum := range blockNums {
+		block, err := c.blockStore.Get(blockNum)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while fetching block %d to resync database [%s]", blockNum, dbName)
+		}
+
+		dbsUpdates, err := c.constructDBEntriesOfBlock(block, dbName)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while constructing the updates of block %d to database [%s]", blockNum, dbName)
+		}
+		if len(dbsUpdates) == 0 {
+			continue
+		}
+
+		indexUpdates, err := stateindex.ConstructIndexEntries(dbsUpdates, c.db)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to create index updates")
+		}
+		for indexDB, updates := range indexUpdates {
+			dbsUpdates[indexDB] = updates
+		}
+
+		// the height is left as is, as the block was committed to the other databases
+		if err := c.db.Commit(dbsUpdates, height); err != nil {
+			return nil, errors.WithMessagef(err, "failed to resync block %d to database [%s]", blockNum, dbName)
+		}
+	}
+
+	if err := c.db.ClearSkippedCommits(dbName); err != nil {
+		return nil, err
+	}
+	c.logger.Infof("resynced database [%s], which is available again", dbName)
+
+	return blockNums, nil
+}
+
+// constructDBEntriesOfBlock returns the updates of the valid data transactions of the block to the given user
+// database. The operations of the transactions on the other databases are ignored, as the state of those databases
+// has moved on since the block was committed.
+func (c *committer) constructDBEntriesOfBlock(block *types.Block, dbName string) (map[string]*worldstate.DBUpdates, error) {
+	dbsUpdates := make(map[string]*worldstate.DBUpdates)
+
+	txsEnvelopes := block.GetDataTxEnvelopes().GetEnvelopes()
+	for txNum, txValidationInfo := range block.GetHeader().GetValidationInfo() {
+		if txValidationInfo.Flag != types.Flag_VALID || txNum >= len(txsEnvelopes) {
+			continue
+		}
+
+		tx, err := dropRepeatedImmutableWrites(c.db, txsEnvelopes[txNum].Payload)
+		if err != nil {
+			return nil, err
+		}
+		tx = proto.Clone(tx).(*types.DataTx)
+		var ops []*types.DBOperation
+		for _, op := range tx.DbOperations {
+			if op.DbName == dbName {
+				ops = append(ops, op)
+			}
+		}
+		if len(ops) == 0 {
+			continue
+		}
+		tx.DbOperations = ops
+
+		version := &types.Version{
+			BlockNum: block.GetHeader().GetBaseHeader().GetNumber(),
+			TxNum:    uint64(txNum),
+		}
+		AddDBEntriesForDataTx(tx, version, dbsUpdates)
+		if err := addDBEntriesForDataDeleteRanges(c.db, tx, dbsUpdates); err != nil {
+			return nil, err
+		}
+		if err := addDBEntriesForDataPatches(c.db, tx, version, dbsUpdates); err != nil {
+			return nil, err
+		}
+	}
+
+	return dbsUpdates, nil
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"sort"
+
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// constructStateDelta returns the state delta of a block from the database updates of the block, ordered by
+// database name and key
+func constructStateDelta(blockNum uint64, dbsUpdates map[string]*worldstate.DBUpdates) *types.StateDelta {
+	delta := &types.StateDelta{
+		StartBlockNum: blockNum,
+		EndBlockNum:   blockNum,
+	}
+
+	for dbName, updates := range dbsUpdates {
+		for _, w := range updates.Writes {
+			delta.Keys = append(delta.Keys, &types.KeyStateDelta{
+				DbName:   dbName,
+				Key:      w.Key,
+				Value:    w.Value,
+				Metadata: w.Metadata,
+			})
+		}
+		for _, key := range updates.Deletes {
+			delta.Keys = append(delta.Keys, &types.KeyStateDelta{
+				DbName:  dbName,
+				Key:     key,
+				Deleted: true,
+			})
+		}
+	}
+
+	sort.SliceStable(delta.Keys, func(i, j int) bool {
+		if delta.Keys[i].DbName != delta.Keys[j].DbName {
+			return delta.Keys[i].DbName < delta.Keys[j].DbName
+		}
+		return delta.Keys[i].Key < delta.Keys[j].Key
+	})
+
+	return delta
+}
+
+// applyStateDelta fast-forwards the ledger over the range of blocks of a state delta pulled by the replication layer,
+// see statesync.Applier.Apply. The coalesced updates are written first, as the delta applies on top of the complete
+// state, and the state trie of the committer is reloaded once the delta is applied. The cluster config is returned
+// if the delta changes it, so that the replication layer reconfigures itself as it would for a config block.
+func (b *BlockProcessor) applyStateDelta(entry *queue.StateDeltaWithOrigin) *queue.StateDeltaResult {
+	if err := b.committer.flushCoalesced(); err != nil {
+		panic(err)
+	}
+
+	if err := b.stateDeltaApplier.Apply(entry.Headers, entry.Delta); err != nil {
+		return &queue.StateDeltaResult{Err: err}
+	}
+
+	_, _, stateTrie, err := loadStateTrie(b.committer.stateTrieStore, b.blockStore)
+	if err != nil {
+		panic(errors.WithMessage(err, "error while reloading the state trie after applying a state delta"))
+	}
+	b.committer.stateTrie = stateTrie
+
+	result := &queue.StateDeltaResult{}
+	for _, k := range entry.Delta.GetKeys() {
+		if k.DbName == worldstate.ConfigDBName && k.Key == worldstate.ConfigKey && !k.Deleted {
+			config, _, err := b.committer.db.GetConfig()
+			if err != nil {
+				panic(errors.WithMessage(err, "error while reading the cluster config after applying a state delta"))
+			}
+			result.ClusterConfig = config
+		}
+	}
+
+	return result
+}
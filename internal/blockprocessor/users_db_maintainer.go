@@ -0,0 +1,188 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// DefaultUsersDBCompactionThreshold is the number of user writes and deletes in a single user administration
+// transaction above which the users database is compacted, when no other threshold is configured.
+const DefaultUsersDBCompactionThreshold = 1000
+
+// UsersDBMaintenanceHooks are invoked by the background maintenance of the users database, once per completed
+// action. They are meant for instrumentation and may be nil.
+type UsersDBMaintenanceHooks struct {
+	// OnCompaction is called after the identity key range of the users database was compacted.
+	OnCompaction func(elapsed time.Duration, err error)
+	// OnWarmUp is called after the entries of the given users were read back from the users database.
+	OnWarmUp func(userIDs []string, elapsed time.Duration)
+}
+
+// usersDBMaintainer compacts the identity key range of the users database, and warms up the entries of the
+// written users, after a block that carries a large user administration transaction. Importing many users
+// fragments the identity namespace, which slows down GetUser, and thus every signature verification.
+//
+// The maintenance is best-effort: it runs in its own go-routine, failures are only logged, and requests that
+// arrive while a previous one is pending are merged into it, so that block processing is never delayed.
+type usersDBMaintainer struct {
+	db        worldstate.DB
+	querier   *identity.Querier
+	threshold int
+	hooks     *UsersDBMaintenanceHooks
+
+	mu             sync.Mutex
+	pendingUserIDs []string
+	signal         chan struct{}
+
+	stop    chan struct{}
+	stopped chan struct{}
+	logger  *logger.SugarLogger
+}
+
+func newUsersDBMaintainer(conf *Config) *usersDBMaintainer {
+	threshold := conf.UsersDBCompactionThreshold
+	if threshold == 0 {
+		threshold = DefaultUsersDBCompactionThreshold
+	}
+
+	hooks := conf.UsersDBMaintenanceHooks
+	if hooks == nil {
+		hooks = &UsersDBMaintenanceHooks{}
+	}
+
+	return &usersDBMaintainer{
+		db:        conf.DB,
+		querier:   identity.NewQuerier(conf.DB),
+		threshold: threshold,
+		hooks:     hooks,
+		signal:    make(chan struct{}, 1),
+		logger:    conf.Logger,
+	}
+}
+
+// start launches the go-routine that serves maintenance requests, until close is called.
+func (m *usersDBMaintainer) start() {
+	m.stop = make(chan struct{})
+	m.stopped = make(chan struct{})
+	go m.run()
+}
+
+func (m *usersDBMaintainer) run() {
+	defer close(m.stopped)
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-m.signal:
+			m.mu.Lock()
+			userIDs := m.pendingUserIDs
+			m.pendingUserIDs = nil
+			m.mu.Unlock()
+
+			m.compact()
+			m.warmUp(userIDs)
+		}
+	}
+}
+
+func (m *usersDBMaintainer) close() {
+	close(m.stop)
+	<-m.stopped
+}
+
+// blockCommitted schedules maintenance if the valid user administration transactions of the committed block carry
+// writes and deletes exceeding the threshold. It never blocks.
+func (m *usersDBMaintainer) blockCommitted(block *types.Block) {
+	if m.threshold < 0 {
+		return
+	}
+
+	var txs []*types.UserAdministrationTx
+	validationInfo := block.GetHeader().GetValidationInfo()
+	if tx := block.GetUserAdministrationTxEnvelope().GetPayload(); tx != nil {
+		if len(validationInfo) > 0 && validationInfo[userAdminTxIndex].Flag == types.Flag_VALID {
+			txs = append(txs, tx)
+		}
+	}
+	for txNum, txEnv := range block.GetUserAdministrationTxEnvelopes().GetEnvelopes() {
+		if txNum < len(validationInfo) && validationInfo[txNum].Flag == types.Flag_VALID {
+			txs = append(txs, txEnv.GetPayload())
+		}
+	}
+
+	var writes, deletes int
+	for _, tx := range txs {
+		writes += len(tx.UserWrites)
+		deletes += len(tx.UserDeletes)
+	}
+	if writes+deletes <= m.threshold {
+		return
+	}
+
+	m.logger.Infof("block %d carries %d user writes and %d user deletes, scheduling users database maintenance",
+		block.GetHeader().GetBaseHeader().GetNumber(), writes, deletes)
+
+	m.mu.Lock()
+	for _, tx := range txs {
+		for _, w := range tx.UserWrites {
+			m.pendingUserIDs = append(m.pendingUserIDs, w.GetUser().GetId())
+		}
+	}
+	m.mu.Unlock()
+
+	select {
+	case m.signal <- struct{}{}:
+	default:
+		// a request is already pending, and it will pick up the user IDs added above
+	}
+}
+
+func (m *usersDBMaintainer) compact() {
+	start := time.Now()
+	userNamespaceEnd := string([]byte{identity.UserNamespace[0] + 1})
+	err := m.db.CompactRange(worldstate.UsersDBName, string(identity.UserNamespace), userNamespaceEnd)
+	if err != nil {
+		m.logger.Warnf("failed to compact the identity key range of the users database: %s", err)
+	} else {
+		m.logger.Infof("compacted the identity key range of the users database in %s", time.Since(start))
+	}
+
+	if m.hooks.OnCompaction != nil {
+		m.hooks.OnCompaction(time.Since(start), err)
+	}
+}
+
+// warmUp reads back the given users so that their entries are loaded into the database caches.
+func (m *usersDBMaintainer) warmUp(userIDs []string) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	start := time.Now()
+	for _, userID := range userIDs {
+		select {
+		case <-m.stop:
+			return
+		default:
+		}
+
+		if _, err := m.querier.GetCertificate(userID); err != nil {
+			// the user may have been deleted by a later block
+			m.logger.Debugf("skipping warm-up of user [%s]: %s", userID, err)
+		}
+	}
+	m.logger.Infof("warmed up %d users in %s", len(userIDs), time.Since(start))
+
+	if m.hooks.OnWarmUp != nil {
+		m.hooks.OnWarmUp(userIDs, time.Since(start))
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockprocessor
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type usersDBMaintainerTestEnv struct {
+	db      *leveldb.LevelDB
+	logger  *logger.SugarLogger
+	cleanup func()
+}
+
+func newUsersDBMaintainerTestEnv(tb testing.TB) *usersDBMaintainerTestEnv {
+	c := &logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	}
+	logger, err := logger.New(c)
+	require.NoError(tb, err)
+
+	dir, err := ioutil.TempDir("/tmp", "usersDBMaintainer")
+	require.NoError(tb, err)
+
+	db, err := leveldb.Open(
+		&leveldb.Config{
+			DBRootDir: filepath.Join(dir, "leveldb"),
+			Logger:    logger,
+		},
+	)
+	if err != nil {
+		if rmErr := os.RemoveAll(dir); rmErr != nil {
+			tb.Errorf("error while removing directory %s, %v", dir, rmErr)
+		}
+		tb.Fatalf("error while creating the leveldb instance, %v", err)
+	}
+
+	return &usersDBMaintainerTestEnv{
+		db:     db,
+		logger: logger,
+		cleanup: func() {
+			if err := db.Close(); err != nil {
+				tb.Errorf("error while closing the db instance, %v", err)
+			}
+			if err := os.RemoveAll(dir); err != nil {
+				tb.Errorf("error while removing directory %s, %v", dir, err)
+			}
+		},
+	}
+}
+
+// importUsers commits a user administration transaction that writes the given number of users, all sharing the
+// same certificate, and returns the block that carries it.
+func importUsers(tb testing.TB, db worldstate.DB, blockNumber uint64, numUsers int, certificate []byte) *types.Block {
+	tx := &types.UserAdministrationTx{
+		UserId: "admin1",
+		TxId:   fmt.Sprintf("import-%d", blockNumber),
+	}
+	for i := 0; i < numUsers; i++ {
+		tx.UserWrites = append(tx.UserWrites, &types.UserWrite{
+			User: &types.User{
+				Id:          fmt.Sprintf("user-%d-%05d", blockNumber, i),
+				Certificate: certificate,
+				Privilege: &types.Privilege{
+					DbPermission: map[string]types.Privilege_Access{
+						worldstate.DefaultDBName: types.Privilege_ReadWrite,
+					},
+				},
+			},
+		})
+	}
+
+	entries, err := identity.ConstructDBEntriesForUserAdminTx(tx, &types.Version{BlockNum: blockNumber})
+	require.NoError(tb, err)
+	require.NoError(tb, db.Commit(map[string]*worldstate.DBUpdates{worldstate.UsersDBName: entries}, blockNumber))
+
+	return &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: blockNumber,
+			},
+			ValidationInfo: []*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+			},
+		},
+		Payload: &types.Block_UserAdministrationTxEnvelope{
+			UserAdministrationTxEnvelope: &types.UserAdministrationTxEnvelope{
+				Payload: tx,
+			},
+		},
+	}
+}
+
+func TestUsersDBMaintainer(t *testing.T) {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"testUser"})
+	userCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "testUser")
+
+	t.Run("import of 10k users triggers compaction and warm-up", func(t *testing.T) {
+		env := newUsersDBMaintainerTestEnv(t)
+		defer env.cleanup()
+
+		compactionErrs := make(chan error, 1)
+		warmedUp := make(chan []string, 1)
+		m := newUsersDBMaintainer(&Config{
+			DB:     env.db,
+			Logger: env.logger,
+			UsersDBMaintenanceHooks: &UsersDBMaintenanceHooks{
+				OnCompaction: func(_ time.Duration, err error) {
+					compactionErrs <- err
+				},
+				OnWarmUp: func(userIDs []string, _ time.Duration) {
+					warmedUp <- userIDs
+				},
+			},
+		})
+		m.start()
+		defer m.close()
+
+		block := importUsers(t, env.db, 2, 10000, userCert.Raw)
+		m.blockCommitted(block)
+
+		select {
+		case err := <-compactionErrs:
+			require.NoError(t, err)
+		case <-time.After(30 * time.Second):
+			t.Fatal("compaction did not run")
+		}
+
+		select {
+		case userIDs := <-warmedUp:
+			require.Len(t, userIDs, 10000)
+			require.Equal(t, "user-2-00000", userIDs[0])
+			require.Equal(t, "user-2-09999", userIDs[9999])
+		case <-time.After(30 * time.Second):
+			t.Fatal("warm-up did not run")
+		}
+
+		cert, err := identity.NewQuerier(env.db).GetCertificate("user-2-05000")
+		require.NoError(t, err)
+		require.Equal(t, userCert.Raw, cert.Raw)
+	})
+
+	t.Run("maintenance is not scheduled", func(t *testing.T) {
+		env := newUsersDBMaintainerTestEnv(t)
+		defer env.cleanup()
+
+		// the maintainer is not started, hence a scheduled request remains in the signal channel
+		m := newUsersDBMaintainer(&Config{
+			DB:                         env.db,
+			Logger:                     env.logger,
+			UsersDBCompactionThreshold: 10,
+		})
+
+		smallBlock := importUsers(t, env.db, 2, 10, userCert.Raw)
+		m.blockCommitted(smallBlock)
+		require.Len(t, m.signal, 0)
+
+		invalidBlock := importUsers(t, env.db, 3, 11, userCert.Raw)
+		invalidBlock.Header.ValidationInfo[0].Flag = types.Flag_INVALID_NO_PERMISSION
+		m.blockCommitted(invalidBlock)
+		require.Len(t, m.signal, 0)
+
+		m.blockCommitted(&types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader:     &types.BlockHeaderBase{Number: 4},
+				ValidationInfo: []*types.ValidationInfo{{Flag: types.Flag_VALID}},
+			},
+			Payload: &types.Block_DataTxEnvelopes{},
+		})
+		require.Len(t, m.signal, 0)
+
+		disabled := newUsersDBMaintainer(&Config{
+			DB:                         env.db,
+			Logger:                     env.logger,
+			UsersDBCompactionThreshold: -1,
+		})
+		disabled.blockCommitted(importUsers(t, env.db, 5, 20, userCert.Raw))
+		require.Len(t, disabled.signal, 0)
+	})
+
+	t.Run("requests arriving while one is pending are merged", func(t *testing.T) {
+		env := newUsersDBMaintainerTestEnv(t)
+		defer env.cleanup()
+
+		m := newUsersDBMaintainer(&Config{
+			DB:                         env.db,
+			Logger:                     env.logger,
+			UsersDBCompactionThreshold: 10,
+		})
+
+		m.blockCommitted(importUsers(t, env.db, 2, 11, userCert.Raw))
+		m.blockCommitted(importUsers(t, env.db, 3, 12, userCert.Raw))
+		require.Len(t, m.signal, 1)
+		require.Len(t, m.pendingUserIDs, 23)
+	})
+}
+
+func BenchmarkGetUserAfterUsersDBCompaction(b *testing.B) {
+	env := newUsersDBMaintainerTestEnv(b)
+	defer env.cleanup()
+
+	certificate := make([]byte, 800)
+	_, err := rand.Read(certificate)
+	require.NoError(b, err)
+
+	// Import the users in several rounds, deleting and re-importing them, so that the identity namespace is
+	// spread over many fragmented tables.
+	const numUsers = 10000
+	var block *types.Block
+	for round := uint64(2); round < 6; round++ {
+		block = importUsers(b, env.db, round, numUsers, certificate)
+		if round < 5 {
+			var deletes []string
+			for _, w := range block.GetUserAdministrationTxEnvelope().GetPayload().GetUserWrites() {
+				deletes = append(deletes, string(identity.UserNamespace)+w.GetUser().GetId())
+			}
+			require.NoError(b, env.db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.UsersDBName: {Deletes: deletes},
+			}, round))
+		}
+	}
+
+	querier := identity.NewQuerier(env.db)
+	userWrites := block.GetUserAdministrationTxEnvelope().GetPayload().GetUserWrites()
+	getUsers := func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, _, err := querier.GetUser(userWrites[i%len(userWrites)].GetUser().GetId()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("fragmented", getUsers)
+
+	m := newUsersDBMaintainer(&Config{
+		DB:     env.db,
+		Logger: env.logger,
+	})
+	m.compact()
+
+	b.Run("compacted", getUsers)
+}
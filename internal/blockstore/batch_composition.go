@@ -0,0 +1,56 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// CommitBatchComposition stores the composition of the batch of a committed block, as recorded by the tx reorderer
+// of the local node. Like the producer metadata, it is kept next to the block and is not part of the block hash.
+func (s *Store) CommitBatchComposition(blockNumber uint64, composition *types.BatchComposition) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if blockNumber == 0 || blockNumber > s.lastCommittedBlockNum {
+		return errors.Errorf("block [%d] is not committed, the last committed block is [%d]", blockNumber, s.lastCommittedBlockNum)
+	}
+
+	compositionBytes, err := proto.Marshal(composition)
+	if err != nil {
+		return errors.Wrapf(err, "can't marshal the batch composition of block %d", blockNumber)
+	}
+
+	return s.blockHeaderDB.Put(constructBatchCompositionKey(blockNumber), compositionBytes, nil)
+}
+
+// GetBatchComposition returns the composition of the batch of a block. A NotFoundErr is returned if the composition
+// was not recorded, e.g., because the batch was cut by another node.
+func (s *Store) GetBatchComposition(blockNumber uint64) (*types.BatchComposition, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	val, err := s.blockHeaderDB.Get(constructBatchCompositionKey(blockNumber), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("the batch composition of block %d is not recorded on this node", blockNumber)}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't access the batch composition of block %d", blockNumber)
+	}
+
+	composition := &types.BatchComposition{}
+	if err := proto.Unmarshal(val, composition); err != nil {
+		return nil, errors.Wrap(err, "error while unmarshalling the batch composition")
+	}
+	return composition, nil
+}
+
+func constructBatchCompositionKey(blockNum uint64) []byte {
+	return append(batchCompositionNs, encodeOrderPreservingVarUint64(blockNum)...)
+}
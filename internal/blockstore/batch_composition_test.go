@@ -0,0 +1,51 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchComposition(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(false)
+
+	composition := &types.BatchComposition{
+		BlockNumber:        1,
+		CutReason:          types.BatchComposition_TX_COUNT,
+		TxCount:            2,
+		TxCountPerUser:     map[string]uint32{"alice": 1, "bob": 1},
+		QueueWaitP50Micros: 1500,
+		QueueWaitP95Micros: 4000,
+	}
+	require.EqualError(t, env.s.CommitBatchComposition(1, composition), "block [1] is not committed, the last committed block is [0]")
+
+	b := createSampleDataTxBlock(1, nil, nil, 2)
+	require.NoError(t, env.s.AddSkipListLinks(b))
+	require.NoError(t, env.s.Commit(b))
+
+	actual, err := env.s.GetBatchComposition(1)
+	require.EqualError(t, err, "the batch composition of block 1 is not recorded on this node")
+	require.IsType(t, &errors.NotFoundErr{}, err)
+	require.Nil(t, actual)
+
+	require.NoError(t, env.s.CommitBatchComposition(1, composition))
+	env.closeAndReOpenStore(t)
+
+	actual, err = env.s.GetBatchComposition(1)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(composition, actual), "expected %v, actual %v", composition, actual)
+
+	// the composition is not part of the block hash
+	hash, err := env.s.GetHash(1)
+	require.NoError(t, err)
+	expectedHash, err := ComputeBlockHash(b)
+	require.NoError(t, err)
+	require.Equal(t, expectedHash, hash)
+	require.NoError(t, env.s.Close())
+}
@@ -5,14 +5,15 @@ package blockstore
 import (
 	"bufio"
 	"encoding/binary"
+	"hash"
 	"io"
+	"io/ioutil"
 	"os"
 
 	"github.com/hyperledger-labs/orion-server/internal/fileops"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/golang/protobuf/proto"
-	"github.com/golang/snappy"
 	"github.com/pkg/errors"
 )
 
@@ -40,6 +41,8 @@ type blockAndLocation struct {
 	fileChunkNum     uint64
 	blockStartOffset int64
 	blockEndOffset   int64
+	// logicalLength is the size of the serialized block, before compression
+	logicalLength int64
 }
 
 func newBlockfileStream(logger *logger.SugarLogger, rootDir string, startLocation *BlockLocation) (*blockfileStream, error) {
@@ -92,6 +95,14 @@ func (s *blockfileStream) nextBlockWithLocation() (*blockAndLocation, error) {
 		return nil, err
 	}
 
+	// a flagged record starts with a zero length, which is followed by the length of the rest of the record
+	flagged := blockSize == 0
+	if flagged {
+		if blockSize, err = s.readNextBlockSize(); err != nil {
+			return nil, err
+		}
+	}
+
 	if blockSize > s.remainingBytes {
 		return nil, ErrUnexpectedEndOfBlockfile
 	}
@@ -104,9 +115,14 @@ func (s *blockfileStream) nextBlockWithLocation() (*blockAndLocation, error) {
 	s.currentOffset += blockSize
 	s.remainingBytes -= blockSize
 
-	marshaledBlock, err := snappy.Decode(nil, blockBytes)
+	var marshaledBlock []byte
+	if flagged {
+		marshaledBlock, err = decodeFlaggedRecord(blockBytes)
+	} else {
+		marshaledBlock, err = decodeSnappyRecord(blockBytes)
+	}
 	if err != nil {
-		return nil, errors.Wrap(err, "error while decoding the block using snappy compression")
+		return nil, err
 	}
 
 	block := &types.Block{}
@@ -119,9 +135,39 @@ func (s *blockfileStream) nextBlockWithLocation() (*blockAndLocation, error) {
 		fileChunkNum:     s.fileChunkNum,
 		blockStartOffset: startOffsetOfNextBlock,
 		blockEndOffset:   s.currentOffset,
+		logicalLength:    int64(len(marshaledBlock)),
 	}, nil
 }
 
+// nextBlockInChunk returns the next block of the current file chunk, or nil at the end of the file chunk, as it does
+// not move to the next file chunk
+func (s *blockfileStream) nextBlockInChunk() (*blockAndLocation, error) {
+	if s.remainingBytes == 0 {
+		return nil, nil
+	}
+
+	return s.nextBlockWithLocation()
+}
+
+// hashContent feeds the bytes read from the current file chunk into the given hash. It must be called before the
+// first read.
+func (s *blockfileStream) hashContent(h hash.Hash) {
+	s.reader = bufio.NewReader(io.TeeReader(s.file, h))
+}
+
+// drain reads the rest of the current file chunk, e.g., a partially written block, so that the hash of the content
+// covers the whole file chunk
+func (s *blockfileStream) drain() error {
+	n, err := io.Copy(ioutil.Discard, s.reader)
+	if err != nil {
+		return errors.Wrap(err, "error while reading block file "+s.file.Name())
+	}
+
+	s.currentOffset += n
+	s.remainingBytes -= n
+	return nil
+}
+
 func (s *blockfileStream) moveToNextFileChunkIfExist() (bool, error) {
 	nextFileChunkPath := constructBlockFileChunkPath(s.filePathDir, s.fileChunkNum+1)
 	exist, err := fileops.Exists(nextFileChunkPath)
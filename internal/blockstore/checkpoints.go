@@ -0,0 +1,133 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockstore
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DefaultCheckpointInterval is the number of blocks between two trusted checkpoints, when no other interval is asked
+const DefaultCheckpointInterval = uint64(1000)
+
+// ReadTrustedCheckpoints reads a trusted checkpoints file, and returns its checkpoints sorted by block number
+func ReadTrustedCheckpoints(filePath string) ([]*types.TrustedCheckpoint, error) {
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while reading the trusted checkpoints file [%s]", filePath)
+	}
+
+	checkpoints := &types.TrustedCheckpoints{}
+	if err := protojson.Unmarshal(content, checkpoints); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the trusted checkpoints file [%s]", filePath)
+	}
+
+	sorted := checkpoints.GetCheckpoints()
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].BlockNumber < sorted[j].BlockNumber
+	})
+	for i, c := range sorted {
+		if c.BlockNumber == 0 {
+			return nil, errors.Errorf("the trusted checkpoints file [%s] holds a checkpoint of block 0", filePath)
+		}
+		if i > 0 && sorted[i-1].BlockNumber == c.BlockNumber && !bytes.Equal(sorted[i-1].HeaderHash, c.HeaderHash) {
+			return nil, errors.Errorf("the trusted checkpoints file [%s] holds conflicting checkpoints of block %d", filePath, c.BlockNumber)
+		}
+	}
+
+	return sorted, nil
+}
+
+// VerifyTrustedCheckpoints verifies the stored headers against the trusted checkpoints, which must be sorted by block
+// number, and returns an error that names the first diverging block on a mismatch. The hash of each stored header is
+// computed anew, so that a header tampered with after its commit does not match either. The checkpoints beyond the
+// height of the store are not verified.
+func (s *Store) VerifyTrustedCheckpoints(checkpoints []*types.TrustedCheckpoint) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var lastMatched uint64
+	for _, c := range checkpoints {
+		if c.BlockNumber > s.lastCommittedBlockNum {
+			s.logger.Infof("the trusted checkpoint of block %d is beyond the height of the block store, %d, and is not verified",
+				c.BlockNumber, s.lastCommittedBlockNum)
+			break
+		}
+
+		headerBytes, err := s.blockHeaderDB.Get(constructHeaderBytesKey(c.BlockNumber), nil)
+		if err != nil {
+			if err == leveldb.ErrNotFound {
+				return &ForkedLedgerError{BlockNumber: c.BlockNumber, LastMatched: lastMatched, Reason: "the header is missing"}
+			}
+			return errors.Wrapf(err, "can't access block's %d header", c.BlockNumber)
+		}
+		hash, err := crypto.ComputeSHA256Hash(headerBytes)
+		if err != nil {
+			return errors.Wrapf(err, "can't calculate block hash of block %d", c.BlockNumber)
+		}
+		if !bytes.Equal(hash, c.HeaderHash) {
+			return &ForkedLedgerError{
+				BlockNumber: c.BlockNumber,
+				LastMatched: lastMatched,
+				Reason:      fmt.Sprintf("the stored header hash [%x] differs from the trusted one [%x]", hash, c.HeaderHash),
+			}
+		}
+		lastMatched = c.BlockNumber
+	}
+
+	return nil
+}
+
+// TrustedCheckpoints returns the checkpoints of the stored blocks at every interval blocks, along with the checkpoint
+// of the last block, to be distributed as a trusted checkpoints file.
+func (s *Store) TrustedCheckpoints(interval uint64) (*types.TrustedCheckpoints, error) {
+	if interval == 0 {
+		interval = DefaultCheckpointInterval
+	}
+
+	height, err := s.Height()
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoints := &types.TrustedCheckpoints{}
+	for blockNum := interval; blockNum <= height; blockNum += interval {
+		hash, err := s.GetHeaderHash(blockNum)
+		if err != nil {
+			return nil, err
+		}
+		checkpoints.Checkpoints = append(checkpoints.Checkpoints, &types.TrustedCheckpoint{BlockNumber: blockNum, HeaderHash: hash})
+	}
+	if height > 0 && height%interval != 0 {
+		hash, err := s.GetHeaderHash(height)
+		if err != nil {
+			return nil, err
+		}
+		checkpoints.Checkpoints = append(checkpoints.Checkpoints, &types.TrustedCheckpoint{BlockNumber: height, HeaderHash: hash})
+	}
+
+	return checkpoints, nil
+}
+
+// ForkedLedgerError is returned when the block store diverges from a trusted checkpoint
+type ForkedLedgerError struct {
+	// BlockNumber is the first block whose checkpoint does not match
+	BlockNumber uint64
+	// LastMatched is the last block whose checkpoint matches, or zero if none does
+	LastMatched uint64
+	Reason      string
+}
+
+func (e *ForkedLedgerError) Error() string {
+	return fmt.Sprintf("the block store diverges from the trusted checkpoints at block %d, after matching up to block %d: %s",
+		e.BlockNumber, e.LastMatched, e.Reason)
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestTrustedCheckpoints(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(false)
+
+	for blockNum := uint64(1); blockNum <= 25; blockNum++ {
+		b := createSampleDataTxBlock(blockNum, nil, nil, 1)
+		require.NoError(t, env.s.AddSkipListLinks(b))
+		require.NoError(t, env.s.Commit(b))
+	}
+
+	// a checkpoint every 10 blocks, and one of the last block
+	checkpoints, err := env.s.TrustedCheckpoints(10)
+	require.NoError(t, err)
+	require.Len(t, checkpoints.Checkpoints, 3)
+	for i, blockNum := range []uint64{10, 20, 25} {
+		hash, err := env.s.GetHash(blockNum)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&types.TrustedCheckpoint{BlockNumber: blockNum, HeaderHash: hash}, checkpoints.Checkpoints[i]))
+	}
+
+	writeFile := func(checkpoints *types.TrustedCheckpoints) string {
+		content, err := protojson.Marshal(checkpoints)
+		require.NoError(t, err)
+		filePath := filepath.Join(t.TempDir(), "checkpoints.json")
+		require.NoError(t, ioutil.WriteFile(filePath, content, 0644))
+		return filePath
+	}
+	reopen := func(filePath string) error {
+		logger := env.s.logger
+		require.NoError(t, env.s.Close())
+
+		s, err := Open(&Config{
+			StoreDir:               env.storeDir,
+			TrustedCheckpointsFile: filePath,
+			Logger:                 logger,
+		})
+		if err != nil {
+			// the store is reopened without the checkpoints, to be closed by the next reopen
+			s, openErr := Open(&Config{StoreDir: env.storeDir, Logger: logger})
+			require.NoError(t, openErr)
+			env.s = s
+			return err
+		}
+		env.s = s
+		return nil
+	}
+
+	every, err := env.s.TrustedCheckpoints(1)
+	require.NoError(t, err)
+	require.Len(t, every.Checkpoints, 25)
+	require.NoError(t, reopen(writeFile(every)))
+
+	// the checkpoints beyond the height of the store are not verified
+	beyond := proto.Clone(checkpoints).(*types.TrustedCheckpoints)
+	beyond.Checkpoints = append(beyond.Checkpoints, &types.TrustedCheckpoint{BlockNumber: 30, HeaderHash: []byte("future")})
+	require.NoError(t, reopen(writeFile(beyond)))
+
+	// a header tampered with in the middle of the ledger is detected at its height
+	headerBytes, err := env.s.blockHeaderDB.Get(constructHeaderBytesKey(13), nil)
+	require.NoError(t, err)
+	header := &types.BlockHeader{}
+	require.NoError(t, proto.Unmarshal(headerBytes, header))
+	header.TxMerkelTreeRootHash = []byte("tampered")
+	tamperedBytes, err := proto.Marshal(header)
+	require.NoError(t, err)
+	require.NoError(t, env.s.blockHeaderDB.Put(constructHeaderBytesKey(13), tamperedBytes, nil))
+
+	require.NoError(t, reopen(writeFile(checkpoints)), "the tampered header is not at a checkpoint")
+
+	err = reopen(writeFile(every))
+	require.IsType(t, &ForkedLedgerError{}, err)
+	require.Equal(t, uint64(13), err.(*ForkedLedgerError).BlockNumber)
+	require.Equal(t, uint64(12), err.(*ForkedLedgerError).LastMatched)
+	require.Contains(t, err.Error(), "the block store diverges from the trusted checkpoints at block 13, after matching up to block 12")
+
+	// a checkpoint of another history is detected at the first diverging checkpoint
+	forked := proto.Clone(checkpoints).(*types.TrustedCheckpoints)
+	forked.Checkpoints[1].HeaderHash = []byte("forked")
+	err = reopen(writeFile(forked))
+	require.IsType(t, &ForkedLedgerError{}, err)
+	require.Equal(t, uint64(20), err.(*ForkedLedgerError).BlockNumber)
+	require.Equal(t, uint64(10), err.(*ForkedLedgerError).LastMatched)
+
+	conflicting := proto.Clone(checkpoints).(*types.TrustedCheckpoints)
+	conflicting.Checkpoints = append(conflicting.Checkpoints, &types.TrustedCheckpoint{BlockNumber: 10, HeaderHash: []byte("other")})
+	filePath := writeFile(conflicting)
+	err = reopen(filePath)
+	require.EqualError(t, err, "the trusted checkpoints file ["+filePath+"] holds conflicting checkpoints of block 10")
+
+	require.NoError(t, env.s.Close())
+}
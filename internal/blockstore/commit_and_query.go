@@ -11,7 +11,6 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/golang/snappy"
 	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/fileops"
 	"github.com/hyperledger-labs/orion-server/internal/utils"
@@ -20,19 +19,127 @@ import (
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 const (
-	SkipListBase   = uint64(2)
-	nonDataTxIndex = 0
+	SkipListBase = uint64(2)
 )
 
 // Commit commits the block to the block store
 func (s *Store) Commit(block *types.Block) error {
+	return s.CommitWithProducerMetadata(block, nil)
+}
+
+// CommitWithProducerMetadata commits the block to the block store along with the metadata describing how the
+// local node produced it. The metadata is stored next to the block headers and does not affect the block bytes
+// or the block hash. A nil metadata stores nothing.
+func (s *Store) CommitWithProducerMetadata(block *types.Block, metadata *ProducerMetadata) error {
 	if block == nil {
 		return errors.New("block cannot be nil")
 	}
 
+	prepared, err := PrepareBlock(block)
+	if err != nil {
+		return err
+	}
+	return s.CommitPrepared(prepared, metadata)
+}
+
+// PreparedBlock holds a block along with the serialization of everything but its header, i.e., of the transaction
+// envelopes and the consensus metadata, which do not change once the block is cut. A block is prepared while it is
+// validated, so that only its header, which holds the validation info, is left to serialize when it is committed.
+type PreparedBlock struct {
+	block     *types.Block
+	bodyBytes []byte
+	txSizes   []*types.TxSizes
+}
+
+// PrepareBlock serializes everything but the header of the block. It neither reads nor modifies the header, which may
+// hence be completed concurrently, and the block store is not involved until the prepared block is committed.
+func PrepareBlock(block *types.Block) (*PreparedBlock, error) {
+	if block == nil {
+		return nil, errors.New("block cannot be nil")
+	}
+
+	body := &types.Block{
+		Payload:           block.Payload,
+		ConsensusMetadata: block.ConsensusMetadata,
+	}
+	body.ProtoReflect().SetUnknown(block.ProtoReflect().GetUnknown())
+
+	// the header is the first field of a block, and the fields are marshaled in the order of their numbers, hence, the
+	// serialization of the header followed by the one of the body is the serialization of the whole block
+	bodyBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the body of the block")
+	}
+
+	return &PreparedBlock{
+		block:     block,
+		bodyBytes: bodyBytes,
+	}, nil
+}
+
+// Block returns the prepared block
+func (p *PreparedBlock) Block() *types.Block {
+	return p.block
+}
+
+// SetTxSizes sets the sizes of the transactions of the block, in the order of the transactions, which are recorded in
+// the index of the transactions once the block is committed. Only the envelope bytes and the write bytes of each
+// transaction are taken, as the sizes of the block are filled in by the block store. A block committed without the
+// sizes of its transactions records none.
+func (p *PreparedBlock) SetTxSizes(txSizes []*types.TxSizes) {
+	p.txSizes = txSizes
+}
+
+// blockTxSizes returns the sizes of the transactions of the block, completed with the sizes of the block, whose
+// deterministic encoding is of the given size, or nil if the sizes of the transactions were not set
+func (p *PreparedBlock) blockTxSizes(blockBytes int) ([]*types.TxSizes, error) {
+	if p.txSizes == nil {
+		return nil, nil
+	}
+
+	txIDs, err := blockTxIDs(p.block)
+	if err != nil {
+		return nil, err
+	}
+	txCount := len(txIDs)
+	if len(p.txSizes) != txCount {
+		return nil, errors.Errorf("the sizes of %d transactions were set, while block %d holds %d transactions",
+			len(p.txSizes), p.block.GetHeader().GetBaseHeader().GetNumber(), txCount)
+	}
+
+	var envelopeBytes, writeBytes uint64
+	for _, sizes := range p.txSizes {
+		envelopeBytes += sizes.GetEnvelopeBytes()
+		writeBytes += sizes.GetWriteBytes()
+	}
+
+	txSizes := make([]*types.TxSizes, 0, txCount)
+	for _, sizes := range p.txSizes {
+		txSizes = append(txSizes, &types.TxSizes{
+			EnvelopeBytes:      sizes.GetEnvelopeBytes(),
+			WriteBytes:         sizes.GetWriteBytes(),
+			BlockBytes:         uint64(blockBytes),
+			BlockTxCount:       uint64(txCount),
+			BlockEnvelopeBytes: envelopeBytes,
+			BlockWriteBytes:    writeBytes,
+		})
+	}
+	return txSizes, nil
+}
+
+// CommitPrepared commits the prepared block, whose header must be complete, to the block store along with the metadata
+// describing how the local node produced it. The block is written to the file chunk at once, as the block committed
+// by CommitWithProducerMetadata, hence, a block that is not committed leaves nothing behind.
+func (s *Store) CommitPrepared(prepared *PreparedBlock, metadata *ProducerMetadata) error {
+	if prepared == nil {
+		return errors.New("prepared block cannot be nil")
+	}
+	block := prepared.block
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -45,14 +152,20 @@ func (s *Store) Commit(block *types.Block) error {
 		)
 	}
 
-	b, err := proto.Marshal(block)
+	// the block is marshaled deterministically, so that the stored bytes are canonical: GetRaw serves
+	// them as is, and a peer that stores a block received from this node writes the very same bytes.
+	b, err := protov2.MarshalOptions{Deterministic: true}.Marshal(&types.Block{Header: block.Header})
 	if err != nil {
-		return errors.Wrapf(err, "error while marshaling block, %v", block)
+		return errors.Wrapf(err, "error while marshaling the header of block %d", blockNumber)
 	}
+	b = append(b, prepared.bodyBytes...)
 
-	encodedBlock := snappy.Encode(nil, b)
-	n := binary.PutUvarint(s.reusableBuffer, uint64(len(encodedBlock)))
-	content := append(s.reusableBuffer[:n], encodedBlock...)
+	txSizes, err := prepared.blockTxSizes(len(b))
+	if err != nil {
+		return err
+	}
+
+	content := encodeBlockRecord(s.compressionFlag, b)
 
 	if !s.canCurrentFileChunkHold(len(content)) {
 		if err := s.moveToNextFileChunk(); err != nil {
@@ -64,12 +177,17 @@ func (s *Store) Commit(block *types.Block) error {
 	if err != nil {
 		return err
 	}
+	blockLocation.LogicalLength = int64(len(b))
+	// only a block synced by the state delta of its range is committed without a payload, see CommitHeader
+	blockLocation.HeaderOnly = block.GetPayload() == nil
 
-	return s.storeMetadataInDB(block, blockLocation)
+	return s.storeMetadataInDB(block, blockLocation, metadata, txSizes)
 }
 
+// canCurrentFileChunkHold returns true if the bytes fit in the current file chunk. An empty chunk holds any block, so
+// that a block larger than the size limit does not leave an empty chunk behind.
 func (s *Store) canCurrentFileChunkHold(toBeAddedBytesLength int) bool {
-	return s.currentOffset+int64(toBeAddedBytesLength) < chunkSizeLimit
+	return s.currentOffset == 0 || s.currentOffset+int64(toBeAddedBytesLength) < s.chunkSizeLimit
 }
 
 func (s *Store) moveToNextFileChunk() error {
@@ -115,7 +233,7 @@ func (s *Store) appendBlock(number uint64, content []byte) (*BlockLocation, erro
 	)
 }
 
-func (s *Store) storeMetadataInDB(block *types.Block, location *BlockLocation) error {
+func (s *Store) storeMetadataInDB(block *types.Block, location *BlockLocation, metadata *ProducerMetadata, txSizes []*types.TxSizes) error {
 	// we can commit to metadata DBs in any order. If the node fails, partial update to
 	// metadata DBs is recovered by the recovery logic implemented in recover() when the
 	// the node is restarted.
@@ -125,21 +243,21 @@ func (s *Store) storeMetadataInDB(block *types.Block, location *BlockLocation) e
 
 	go func() {
 		defer wg.Done()
-		if err := s.storeIndexForBlock(block.Header.BaseHeader.Number, location); err != nil {
+		if err := s.storeIndexForBlock(block, location); err != nil {
 			errC <- err
 		}
 	}()
 
 	go func() {
 		defer wg.Done()
-		if err := s.storeBlockValidationInfo(block); err != nil {
+		if err := s.storeBlockValidationInfo(block, txSizes); err != nil {
 			errC <- err
 		}
 	}()
 
 	go func() {
 		defer wg.Done()
-		if err := s.storeBlockHeaders(block); err != nil {
+		if err := s.storeBlockHeaders(block, metadata); err != nil {
 			errC <- err
 		}
 	}()
@@ -154,27 +272,39 @@ func (s *Store) storeMetadataInDB(block *types.Block, location *BlockLocation) e
 	}
 }
 
-func (s *Store) storeIndexForBlock(number uint64, location *BlockLocation) error {
+// storeIndexForBlock stores the location of the block along with the locations of its transactions, in a single batch,
+// hence, the transactions are located once the block is indexed, and never before
+func (s *Store) storeIndexForBlock(block *types.Block, location *BlockLocation) error {
 	value, err := proto.Marshal(location)
 	if err != nil {
 		return errors.Wrap(err, "error while marshaling BlockLocation")
 	}
 
-	return s.blockIndexDB.Put(
-		encodeOrderPreservingVarUint64(number),
-		value,
+	batch := &leveldb.Batch{}
+	if err := addTxLocations(batch, block); err != nil {
+		return err
+	}
+	batch.Put(encodeOrderPreservingVarUint64(block.GetHeader().GetBaseHeader().GetNumber()), value)
+
+	return s.blockIndexDB.Write(
+		batch,
 		&opt.WriteOptions{
 			Sync: true,
 		},
 	)
 }
 
-// AddSkipListLinks calculated and add skip list block number to the block
+// AddSkipListLinks calculated and add skip list block number to the block.
+// The hashes of the linked blocks are taken from the header hash index, which is populated when a block is committed,
+// hence this requires O(log n) index lookups and never re-reads or re-marshals the linked headers.
 func (s *Store) AddSkipListLinks(block *types.Block) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	skipListHashes := make([][]byte, 0)
 
 	for _, linkedBlockNum := range CalculateSkipListLinks(block.Header.GetBaseHeader().GetNumber()) {
-		hash, err := s.GetHash(linkedBlockNum)
+		hash, err := s.getHeaderHash(linkedBlockNum)
 		if err != nil {
 			return err
 		}
@@ -204,63 +334,80 @@ func CalculateSkipListLinks(blockNum uint64) []uint64 {
 	return links
 }
 
-func (s *Store) storeBlockValidationInfo(block *types.Block) error {
+// storeBlockValidationInfo indexes the transactions of the block by their IDs, along with their sizes, if any
+func (s *Store) storeBlockValidationInfo(block *types.Block, txSizes []*types.TxSizes) error {
 	blockNum := block.Header.BaseHeader.Number
-	var txID string
-
-	switch block.Payload.(type) {
-	case *types.Block_DataTxEnvelopes:
-		dataTxs := block.GetDataTxEnvelopes().Envelopes
-		updateBatch := &leveldb.Batch{}
-
-		for txNum, tx := range dataTxs {
-			key := []byte(tx.Payload.TxId)
-			txInfo := &TxInfo{
-				BlockNumber: blockNum,
-				TxIndex:     uint64(txNum),
-				Validation:  block.Header.ValidationInfo[txNum],
-			}
-			value, err := proto.Marshal(txInfo)
-			if err != nil {
-				return errors.Wrapf(err, "error while marshaling TxInfo info of transaction %d in block %d", txNum, blockNum)
-			}
+	txIDs, err := blockTxIDs(block)
+	if err != nil {
+		return err
+	}
+	if len(txIDs) == 0 {
+		return nil
+	}
 
-			updateBatch.Put(key, value)
+	updateBatch := &leveldb.Batch{}
+	for txNum, id := range txIDs {
+		txInfo := &TxInfo{
+			BlockNumber: blockNum,
+			TxIndex:     uint64(txNum),
+			Validation:  block.Header.ValidationInfo[txNum],
 		}
+		if txSizes != nil {
+			txInfo.Sizes = txSizes[txNum]
+		}
+		value, err := proto.Marshal(txInfo)
+		if err != nil {
+			return errors.Wrapf(err, "error while marshaling TxInfo info of transaction %d in block %d", txNum, blockNum)
+		}
+
+		updateBatch.Put([]byte(id), value)
+	}
 
-		return s.txValidationInfoDB.Write(updateBatch, &opt.WriteOptions{Sync: true})
+	return s.txValidationInfoDB.Write(updateBatch, &opt.WriteOptions{Sync: true})
+}
+
+// blockTxIDs returns the IDs of the transactions of the block, in the order of the transactions
+func blockTxIDs(block *types.Block) ([]string, error) {
+	switch block.Payload.(type) {
+	case *types.Block_DataTxEnvelopes, *types.Block_HeartbeatTxEnvelopes, *types.Block_VoidTxEnvelopes, *types.Block_UserAdministrationTxEnvelopes:
+		var txIDs []string
+		for _, tx := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+		for _, tx := range block.GetHeartbeatTxEnvelopes().GetEnvelopes() {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+		for _, tx := range block.GetVoidTxEnvelopes().GetEnvelopes() {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+		for _, tx := range block.GetUserAdministrationTxEnvelopes().GetEnvelopes() {
+			txIDs = append(txIDs, tx.Payload.TxId)
+		}
+		return txIDs, nil
 
 	case *types.Block_ConfigTxEnvelope:
-		txID = block.GetConfigTxEnvelope().Payload.TxId
+		return []string{block.GetConfigTxEnvelope().Payload.TxId}, nil
 
 	case *types.Block_DbAdministrationTxEnvelope:
-		txID = block.GetDbAdministrationTxEnvelope().Payload.TxId
+		return []string{block.GetDbAdministrationTxEnvelope().Payload.TxId}, nil
 
 	case *types.Block_UserAdministrationTxEnvelope:
-		txID = block.GetUserAdministrationTxEnvelope().Payload.TxId
+		return []string{block.GetUserAdministrationTxEnvelope().Payload.TxId}, nil
 
-	default:
-		return errors.Errorf("unknown block payload")
-	}
+	case nil:
+		// the payload of a block that a state sync fast-forwarded over is not available, hence there are no
+		// transactions to index
+		return nil, nil
 
-	key := []byte(txID)
-	txInfo := &TxInfo{
-		BlockNumber: blockNum,
-		TxIndex:     uint64(nonDataTxIndex),
-		Validation:  block.Header.ValidationInfo[nonDataTxIndex],
-	}
-	value, err := proto.Marshal(txInfo)
-	if err != nil {
-		return errors.Wrapf(err, "error while marshaling validation info of non-data transaction in block %d", blockNum)
+	default:
+		return nil, errors.Errorf("unknown block payload")
 	}
-
-	return s.txValidationInfoDB.Put(key, value, &opt.WriteOptions{Sync: true})
 }
 
-func (s *Store) storeBlockHeaders(block *types.Block) error {
+func (s *Store) storeBlockHeaders(block *types.Block, metadata *ProducerMetadata) error {
 	header := block.GetHeader()
 	number := header.GetBaseHeader().GetNumber()
-	blockHeaderBaseBytes, err := proto.Marshal(header.GetBaseHeader())
+	blockHeaderBaseBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(header.GetBaseHeader())
 	if err != nil {
 		return errors.Wrapf(err, "can't marshal block base header {%d, %v}", number, header)
 	}
@@ -270,7 +417,7 @@ func (s *Store) storeBlockHeaders(block *types.Block) error {
 		return errors.Wrapf(err, "can't calculate block base header hash {%d, %v}", number, header.GetBaseHeader())
 	}
 
-	blockHeaderBytes, err := proto.Marshal(header)
+	blockHeaderBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(header)
 	if err != nil {
 		return errors.Wrapf(err, "can't marshal block header {%d, %v}", number, header)
 	}
@@ -280,11 +427,13 @@ func (s *Store) storeBlockHeaders(block *types.Block) error {
 		return errors.Wrapf(err, "can't calculate block hash {%d, %v}", number, header)
 	}
 
-	txsID, err := utils.BlockPayloadToTxIDs(block.GetPayload())
-	blockTxsID := &BlockTxIDs{TxIds: txsID}
-	if err != nil {
-		return errors.Wrapf(err, "can't access block tx ids {%d, %v}", number, block)
+	var txsID []string
+	if block.GetPayload() != nil {
+		if txsID, err = utils.BlockPayloadToTxIDs(block.GetPayload()); err != nil {
+			return errors.Wrapf(err, "can't access block tx ids {%d, %v}", number, block)
+		}
 	}
+	blockTxsID := &BlockTxIDs{TxIds: txsID}
 	txsIdBytes, err := proto.Marshal(blockTxsID)
 	if err != nil {
 		return errors.Wrapf(err, "can't marshal block txs ids {%d, %v}", number, blockTxsID)
@@ -296,6 +445,13 @@ func (s *Store) storeBlockHeaders(block *types.Block) error {
 	batch.Put(constructHeaderBytesKey(number), blockHeaderBytes)
 	batch.Put(constructHeaderHashIndexKey(blockHash), encodeOrderPreservingVarUint64(number))
 	batch.Put(constructBlockTxsIDKey(number), txsIdBytes)
+	if metadata != nil {
+		metadataBytes, err := proto.Marshal(metadata)
+		if err != nil {
+			return errors.Wrapf(err, "can't marshal block producer metadata {%d, %v}", number, metadata)
+		}
+		batch.Put(constructProducerMetadataKey(number), metadataBytes)
+	}
 
 	return s.blockHeaderDB.Write(batch, &opt.WriteOptions{Sync: true})
 }
@@ -310,6 +466,58 @@ func (s *Store) Height() (uint64, error) {
 
 // Get returns the requested block
 func (s *Store) Get(blockNumber uint64) (*types.Block, error) {
+	marshaledBlock, err := s.GetRaw(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &types.Block{}
+	if err := proto.Unmarshal(marshaledBlock, block); err != nil {
+		return nil, errors.Wrap(err, "error while unmarshalling the block")
+	}
+
+	return block, nil
+}
+
+// CommitHeader commits a block by its header alone, for a node that synced the range of the block by its state delta
+// and does not hold its payload. The header continues the chain as the header of a block committed in full does, but
+// the block is marked as header-only: Get and GetRaw refuse it, and it indexes no transaction.
+func (s *Store) CommitHeader(header *types.BlockHeader) error {
+	if header == nil {
+		return errors.New("header cannot be nil")
+	}
+
+	prepared, err := PrepareBlock(&types.Block{Header: header})
+	if err != nil {
+		return err
+	}
+	return s.CommitPrepared(prepared, nil)
+}
+
+// GetForReplay returns the requested block as Get does, except that a header-only block, see CommitHeader, is returned
+// as a block that holds its header alone. It is meant for the consumers that go through the ledger block by block,
+// which skip the payloads they cannot find.
+func (s *Store) GetForReplay(blockNumber uint64) (*types.Block, error) {
+	marshaledBlock, err := s.getRaw(blockNumber, true)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &types.Block{}
+	if err := proto.Unmarshal(marshaledBlock, block); err != nil {
+		return nil, errors.Wrap(err, "error while unmarshalling the block")
+	}
+
+	return block, nil
+}
+
+// GetRaw returns the requested block as the exact bytes that were marshaled by Commit, without deserializing it.
+// It is meant for serving blocks to peers; local consumers should use Get.
+func (s *Store) GetRaw(blockNumber uint64) ([]byte, error) {
+	return s.getRaw(blockNumber, false)
+}
+
+func (s *Store) getRaw(blockNumber uint64, allowHeaderOnly bool) ([]byte, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -329,8 +537,19 @@ func (s *Store) Get(blockNumber uint64) (*types.Block, error) {
 	if err != nil {
 		return nil, err
 	}
+	if location.HeaderOnly && !allowHeaderOnly {
+		return nil, &interrors.NotFoundErr{
+			Message: fmt.Sprintf("the payload of block [%d] is not available, as the block was synced by the state delta of its range", blockNumber),
+		}
+	}
+
+	return s.readBlockBytes(location)
+}
 
+// readBlockBytes returns the serialized block stored at the location
+func (s *Store) readBlockBytes(location *BlockLocation) ([]byte, error) {
 	var f *os.File
+	var err error
 
 	switch {
 	case s.currentChunkNum == location.FileChunkNum:
@@ -351,7 +570,7 @@ func (s *Store) Get(blockNumber uint64) (*types.Block, error) {
 		}()
 	}
 
-	return readBlockFromFile(f, location.Offset)
+	return readBlockBytesFromFile(f, location.Offset)
 }
 
 // GetHeader returns block header by block number, operation should be faster that regular Get,
@@ -376,6 +595,32 @@ func (s *Store) GetHeader(blockNumber uint64) (*types.BlockHeader, error) {
 	return blockHeader, nil
 }
 
+// GetProducerMetadata returns the metadata recorded by the local node when it committed the given block. It
+// returns nil when the block was committed without metadata, e.g., by an older version
+// of the server, or when its metadata was replayed from the block file during recovery.
+func (s *Store) GetProducerMetadata(blockNumber uint64) (*ProducerMetadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if blockNumber == 0 || blockNumber > s.lastCommittedBlockNum {
+		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("block not found: %d", blockNumber)}
+	}
+
+	val, err := s.blockHeaderDB.Get(constructProducerMetadataKey(blockNumber), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't access block's %d producer metadata", blockNumber)
+	}
+
+	metadata := &ProducerMetadata{}
+	if err := proto.Unmarshal(val, metadata); err != nil {
+		return nil, errors.Wrap(err, "error while unmarshalling block producer metadata")
+	}
+	return metadata, nil
+}
+
 // GetAugmentedHeader returns block header with slice of block tx ids
 func (s *Store) GetAugmentedHeader(blockNumber uint64) (*types.AugmentedBlockHeader, error) {
 	s.mu.RLock()
@@ -410,10 +655,22 @@ func (s *Store) GetAugmentedHeader(blockNumber uint64) (*types.AugmentedBlockHea
 	return augmentedBlockHeader, nil
 }
 
-// GetHash returns block hash by block number
+// GetHash returns block hash by block number, which is the hash of the block header; see GetHeaderHash
 func (s *Store) GetHash(blockNumber uint64) ([]byte, error) {
+	return s.GetHeaderHash(blockNumber)
+}
+
+// GetHeaderHash returns the hash of the block header by block number. The hash is computed once, when the block is
+// committed, and is kept in the header hash index, so that skip list and ledger path construction only require index
+// lookups.
+func (s *Store) GetHeaderHash(blockNumber uint64) ([]byte, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+
+	return s.getHeaderHash(blockNumber)
+}
+
+func (s *Store) getHeaderHash(blockNumber uint64) ([]byte, error) {
 	val, err := s.blockHeaderDB.Get(constructHeaderHashKey(blockNumber), nil)
 	if err == leveldb.ErrNotFound {
 		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("block hash not found: %d", blockNumber)}
@@ -529,7 +786,7 @@ func (s *Store) getLocation(blockNumber uint64) (*BlockLocation, error) {
 	return blockLocation, nil
 }
 
-func readBlockFromFile(f *os.File, offset int64) (*types.Block, error) {
+func readBlockBytesFromFile(f *os.File, offset int64) ([]byte, error) {
 	if _, err := f.Seek(offset, 0); err != nil {
 		return nil, errors.Wrap(err, "error while seeking")
 	}
@@ -540,28 +797,28 @@ func readBlockFromFile(f *os.File, offset int64) (*types.Block, error) {
 		return nil, errors.Wrap(err, "error while reading the length of the stored block")
 	}
 
+	flagged := blockSize == 0
+	if flagged {
+		if blockSize, err = binary.ReadUvarint(bufReader); err != nil {
+			return nil, errors.Wrap(err, "error while reading the length of the stored block")
+		}
+	}
+
 	buf := make([]byte, blockSize)
 	if _, err := io.ReadFull(bufReader, buf); err != nil {
 		return nil, errors.Wrap(err, "error while reading block from the file")
 	}
 
-	marshaledBlock, err := snappy.Decode(nil, buf)
-	if err != nil {
-		return nil, errors.Wrap(err, "error while decoding the block using snappy compression")
+	if flagged {
+		return decodeFlaggedRecord(buf)
 	}
-
-	block := &types.Block{}
-	if err := proto.Unmarshal(marshaledBlock, block); err != nil {
-		return nil, errors.Wrap(err, "error while unmarshalling the block")
-	}
-
-	return block, nil
+	return decodeSnappyRecord(buf)
 }
 
 // ComputeBlockHash returns block hash. Currently block header hash is considered block hash, because it contains
 // all crypto related information, like Merkle tree root(s) and Merkle list and skip list hashes.
 func ComputeBlockHash(block *types.Block) ([]byte, error) {
-	headerBytes, err := proto.Marshal(block.GetHeader())
+	headerBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(block.GetHeader())
 	if err != nil {
 		return nil, err
 	}
@@ -572,7 +829,7 @@ func ComputeBlockHash(block *types.Block) ([]byte, error) {
 // is considered block hash, because it contains  all crypto related information, like Tx Merkle tree root
 // and hash of previous block before validation as well
 func ComputeBlockBaseHash(block *types.Block) ([]byte, error) {
-	headerBytes, err := proto.Marshal(block.GetHeader().GetBaseHeader())
+	headerBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(block.GetHeader().GetBaseHeader())
 	if err != nil {
 		return nil, err
 	}
@@ -598,3 +855,7 @@ func constructHeaderHashKey(blockNum uint64) []byte {
 func constructBlockTxsIDKey(blockNum uint64) []byte {
 	return append(blockTxsIDNs, encodeOrderPreservingVarUint64(blockNum)...)
 }
+
+func constructProducerMetadataKey(blockNum uint64) []byte {
+	return append(producerMetadataNs, encodeOrderPreservingVarUint64(blockNum)...)
+}
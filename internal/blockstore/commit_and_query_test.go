@@ -3,17 +3,23 @@
 package blockstore
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
 	"github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"google.golang.org/protobuf/encoding/protowire"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 type testEnv struct {
@@ -22,7 +28,7 @@ type testEnv struct {
 	cleanup  func(bool)
 }
 
-func newTestEnv(t *testing.T) *testEnv {
+func newTestEnv(t testing.TB) *testEnv {
 	storeDir, err := ioutil.TempDir("", "blockstore")
 	require.NoError(t, err)
 
@@ -258,6 +264,257 @@ func TestCommitAndQuery(t *testing.T) {
 	})
 }
 
+func TestGetRaw(t *testing.T) {
+	t.Run("byte-identical round trip between two stores", func(t *testing.T) {
+		source := newTestEnv(t)
+		defer source.cleanup(true)
+		replica := newTestEnv(t)
+		defer replica.cleanup(true)
+
+		var prevBlockBaseHash, prevBlockHash []byte
+		for blockNumber := uint64(1); blockNumber <= 20; blockNumber++ {
+			b := createSampleDataTxBlock(blockNumber, prevBlockBaseHash, prevBlockHash, 3)
+			// access control maps are marshaled in a random order unless the marshaling is deterministic
+			for _, env := range b.GetDataTxEnvelopes().GetEnvelopes() {
+				acl := &types.AccessControl{ReadUsers: map[string]bool{}, ReadWriteUsers: map[string]bool{}}
+				for i := 0; i < 10; i++ {
+					acl.ReadUsers[fmt.Sprintf("reader-%d", i)] = true
+					acl.ReadWriteUsers[fmt.Sprintf("writer-%d", i)] = true
+				}
+				env.Payload.DbOperations = []*types.DBOperation{
+					{
+						DbName: "db1",
+						DataWrites: []*types.DataWrite{
+							{Key: "key", Value: []byte("value"), Acl: acl},
+						},
+					},
+				}
+			}
+			require.NoError(t, source.s.AddSkipListLinks(b))
+			require.NoError(t, source.s.Commit(b))
+
+			// the replica receives the raw bytes, as a peer does during catch-up, and commits the decoded block
+			rawBlock, err := source.s.GetRaw(blockNumber)
+			require.NoError(t, err)
+			receivedBlock := &types.Block{}
+			require.NoError(t, proto.Unmarshal(rawBlock, receivedBlock))
+			require.True(t, proto.Equal(b, receivedBlock))
+			require.NoError(t, replica.s.Commit(receivedBlock))
+
+			replicaRawBlock, err := replica.s.GetRaw(blockNumber)
+			require.NoError(t, err)
+			require.Equal(t, rawBlock, replicaRawBlock)
+
+			sourceHash, err := source.s.GetHeaderHash(blockNumber)
+			require.NoError(t, err)
+			replicaHash, err := replica.s.GetHeaderHash(blockNumber)
+			require.NoError(t, err)
+			require.Equal(t, sourceHash, replicaHash)
+
+			prevBlockHash = sourceHash
+			prevBlockBaseHash, err = ComputeBlockBaseHash(b)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		rawBlock, err := env.s.GetRaw(1)
+		require.EqualError(t, err, "block store is empty")
+		require.IsType(t, &errors.NotFoundErr{}, err)
+		require.Nil(t, rawBlock)
+
+		require.NoError(t, env.s.Commit(createSampleUserTxBlock(1, nil, nil)))
+		rawBlock, err = env.s.GetRaw(2)
+		require.EqualError(t, err, "requested block number [2] cannot be greater than the last committed block number [1]")
+		require.Nil(t, rawBlock)
+	})
+}
+
+func TestPreparedBlock(t *testing.T) {
+	withConsensusMetadata := createSampleDataTxBlock(1, nil, nil, 3)
+	withConsensusMetadata.ConsensusMetadata = &types.ConsensusMetadata{RaftTerm: 4, RaftIndex: 12}
+	// the consensus metadata is numbered between the payloads of a block
+	voidBlock := &types.Block{
+		Header: createSampleUserTxBlock(1, nil, nil).Header,
+		Payload: &types.Block_VoidTxEnvelopes{
+			VoidTxEnvelopes: &types.VoidTxEnvelopes{
+				Envelopes: []*types.VoidTxEnvelope{{Payload: &types.VoidTx{TxId: "tx1", UserId: "alice"}}},
+			},
+		},
+		ConsensusMetadata: &types.ConsensusMetadata{RaftTerm: 4, RaftIndex: 12},
+	}
+	withUnknownFields := createSampleUserTxBlock(1, nil, nil)
+	unknown := protowire.AppendTag(nil, 100, protowire.VarintType)
+	withUnknownFields.ProtoReflect().SetUnknown(protowire.AppendVarint(unknown, 7))
+
+	t.Run("the serialization is the one of the whole block", func(t *testing.T) {
+		for name, block := range map[string]*types.Block{
+			"user administration tx": createSampleUserTxBlock(1, nil, nil),
+			"data txs":               createSampleDataTxBlock(1, nil, nil, 10),
+			"consensus metadata":     withConsensusMetadata,
+			"void txs":               voidBlock,
+			"unknown fields":         withUnknownFields,
+			"no header":              {Payload: createSampleUserTxBlock(1, nil, nil).Payload},
+		} {
+			prepared, err := PrepareBlock(block)
+			require.NoError(t, err, name)
+			require.Same(t, block, prepared.Block(), name)
+
+			expected, err := protov2.MarshalOptions{Deterministic: true}.Marshal(block)
+			require.NoError(t, err, name)
+			headerBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(&types.Block{Header: block.Header})
+			require.NoError(t, err, name)
+			require.Equal(t, expected, append(headerBytes, prepared.bodyBytes...), name)
+		}
+	})
+
+	t.Run("the header is completed after the preparation", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		var prevBlockBaseHash, prevBlockHash []byte
+		for blockNumber := uint64(1); blockNumber <= 5; blockNumber++ {
+			block := createSampleDataTxBlock(blockNumber, prevBlockBaseHash, prevBlockHash, 3)
+			validationInfo := block.Header.ValidationInfo
+			block.Header.ValidationInfo = nil
+			prepared, err := PrepareBlock(block)
+			require.NoError(t, err)
+
+			validationInfo[1] = &types.ValidationInfo{Flag: types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE}
+			block.Header.ValidationInfo = validationInfo
+			require.NoError(t, env.s.AddSkipListLinks(block))
+			require.NoError(t, env.s.CommitPrepared(prepared, nil))
+
+			expected, err := protov2.MarshalOptions{Deterministic: true}.Marshal(block)
+			require.NoError(t, err)
+			rawBlock, err := env.s.GetRaw(blockNumber)
+			require.NoError(t, err)
+			require.Equal(t, expected, rawBlock)
+
+			valInfo, err := env.s.GetValidationInfo(fmt.Sprintf("tx-%d-1", blockNumber))
+			require.NoError(t, err)
+			require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE, valInfo.Flag)
+
+			expectedHash, err := ComputeBlockHash(block)
+			require.NoError(t, err)
+			prevBlockHash, err = env.s.GetHash(blockNumber)
+			require.NoError(t, err)
+			require.Equal(t, expectedHash, prevBlockHash)
+			prevBlockBaseHash, err = ComputeBlockBaseHash(block)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("a block that is not committed leaves nothing behind", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		block1 := createSampleUserTxBlock(1, nil, nil)
+		require.NoError(t, env.s.AddSkipListLinks(block1))
+		require.NoError(t, env.s.Commit(block1))
+		block1Offset := env.s.currentOffset
+
+		block3 := createSampleUserTxBlock(3, nil, nil)
+		prepared, err := PrepareBlock(block3)
+		require.NoError(t, err)
+		require.EqualError(t, env.s.CommitPrepared(prepared, nil), "expected block number [2] but received [3]")
+		require.Equal(t, block1Offset, env.s.currentOffset)
+
+		require.EqualError(t, env.s.CommitPrepared(nil, nil), "prepared block cannot be nil")
+		_, err = PrepareBlock(nil)
+		require.EqualError(t, err, "block cannot be nil")
+
+		// a crash while the prepared block is written leaves a partially written block, which is discarded on recovery
+		block1BaseHeaderHash, err := env.s.GetBaseHeaderHash(1)
+		require.NoError(t, err)
+		block1HeaderHash, err := env.s.GetHash(1)
+		require.NoError(t, err)
+		block2 := createSampleUserTxBlock(2, block1BaseHeaderHash, block1HeaderHash)
+		require.NoError(t, env.s.AddSkipListLinks(block2))
+		prepared, err = PrepareBlock(block2)
+		require.NoError(t, err)
+		headerBytes, err := protov2.MarshalOptions{Deterministic: true}.Marshal(&types.Block{Header: block2.Header})
+		require.NoError(t, err)
+		encodedBlock := snappy.Encode(nil, append(headerBytes, prepared.bodyBytes...))
+		buf := make([]byte, binary.MaxVarintLen64)
+		n := binary.PutUvarint(buf, uint64(len(encodedBlock)))
+		content := append(buf[:n], encodedBlock...)
+		_, err = env.s.appendBlock(2, content[:len(content)/2])
+		require.NoError(t, err)
+
+		env.closeAndReOpenStore(t)
+		defer env.cleanup(true)
+
+		height, err := env.s.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), height)
+		require.Equal(t, block1Offset, env.s.currentOffset)
+		assertBlockMetadataDoesNotExist(t, env.s, 2, block2.GetUserAdministrationTxEnvelope().Payload.TxId)
+
+		require.NoError(t, env.s.CommitPrepared(prepared, nil))
+		stored, err := env.s.Get(2)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(block2, stored))
+	})
+}
+
+func TestProducerMetadata(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(false)
+
+	metadata, err := env.s.GetProducerMetadata(1)
+	require.EqualError(t, err, "block not found: 1")
+	require.IsType(t, &errors.NotFoundErr{}, err)
+	require.Nil(t, metadata)
+
+	expected := map[uint64]*ProducerMetadata{
+		1: {DebugDeterministic: true},
+		2: {DebugDeterministic: false},
+		3: nil,
+	}
+
+	var prevBlockBaseHash, prevBlockHash []byte
+	for blockNumber := uint64(1); blockNumber <= 3; blockNumber++ {
+		b := createSampleDataTxBlock(blockNumber, prevBlockBaseHash, prevBlockHash, 2)
+		require.NoError(t, env.s.AddSkipListLinks(b))
+		require.NoError(t, env.s.CommitWithProducerMetadata(b, expected[blockNumber]))
+
+		// the metadata is not part of the block
+		committedBlock, err := env.s.Get(blockNumber)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(b, committedBlock))
+
+		prevBlockHash, err = env.s.GetHeaderHash(blockNumber)
+		require.NoError(t, err)
+		prevBlockBaseHash, err = ComputeBlockBaseHash(b)
+		require.NoError(t, err)
+	}
+
+	assertMetadata := func() {
+		for blockNumber, md := range expected {
+			actual, err := env.s.GetProducerMetadata(blockNumber)
+			require.NoError(t, err)
+			if md == nil {
+				require.Nil(t, actual)
+				continue
+			}
+			require.True(t, proto.Equal(md, actual), "block %d: expected %v, actual %v", blockNumber, md, actual)
+		}
+	}
+
+	assertMetadata()
+	env.closeAndReOpenStore(t)
+	assertMetadata()
+
+	metadata, err = env.s.GetProducerMetadata(4)
+	require.EqualError(t, err, "block not found: 4")
+	require.Nil(t, metadata)
+	require.NoError(t, env.s.Close())
+}
+
 func TestTxValidationInfo(t *testing.T) {
 	t.Parallel()
 
@@ -543,3 +800,184 @@ func createSampleDataTxBlock(blockNumber uint64, prevBlockBaseHash []byte, prevB
 
 	return block
 }
+
+// putSyntheticHeaderHashes adds entries only to the header hash index, without committing the blocks, to simulate a
+// store at a large height.
+func putSyntheticHeaderHashes(t testing.TB, s *Store, blockNumbers []uint64) {
+	batch := &leveldb.Batch{}
+	for _, n := range blockNumbers {
+		batch.Put(constructHeaderHashKey(n), syntheticHeaderHash(n))
+	}
+	require.NoError(t, s.blockHeaderDB.Write(batch, nil))
+}
+
+func syntheticHeaderHash(blockNumber uint64) []byte {
+	return []byte(fmt.Sprintf("hash-of-header-%d", blockNumber))
+}
+
+func TestAddSkipListLinksFromHeaderHashIndex(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(true)
+
+	// block 1,048,577 links back to 1,048,576 = 2^20, and to all the blocks at distance 2^i for i in [0, 20]
+	blockNumber := uint64(1<<20 + 1)
+	links := CalculateSkipListLinks(blockNumber)
+	require.Len(t, links, 21)
+	putSyntheticHeaderHashes(t, env.s, links)
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: blockNumber,
+			},
+		},
+	}
+	// only the hash index holds the linked blocks, their headers do not exist in the store
+	require.NoError(t, env.s.AddSkipListLinks(block))
+	require.Len(t, block.Header.SkipchainHashes, len(links))
+	for i, n := range links {
+		require.Equal(t, syntheticHeaderHash(n), block.Header.SkipchainHashes[i])
+
+		hash, err := env.s.GetHeaderHash(n)
+		require.NoError(t, err)
+		require.Equal(t, syntheticHeaderHash(n), hash)
+	}
+
+	_, err := env.s.GetHeader(links[0])
+	require.EqualError(t, err, fmt.Sprintf("block not found: %d", links[0]))
+
+	block.Header.BaseHeader.Number = blockNumber + 1
+	err = env.s.AddSkipListLinks(block)
+	require.EqualError(t, err, fmt.Sprintf("block hash not found: %d", blockNumber))
+}
+
+func BenchmarkAddSkipListLinks(b *testing.B) {
+	env := newTestEnv(b)
+	defer env.cleanup(true)
+
+	// simulate a store at height 10^6, holding the hash index entries needed by the benchmarked blocks
+	const startBlock = uint64(1000000)
+	const numBlocks = uint64(1 << 12)
+	needed := make(map[uint64]struct{})
+	for n := startBlock; n < startBlock+numBlocks; n++ {
+		for _, link := range CalculateSkipListLinks(n) {
+			needed[link] = struct{}{}
+		}
+	}
+	var blockNumbers []uint64
+	for n := range needed {
+		blockNumbers = append(blockNumbers, n)
+	}
+	putSyntheticHeaderHashes(b, env.s, blockNumbers)
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{},
+		},
+	}
+
+	var lookups int
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block.Header.BaseHeader.Number = startBlock + uint64(i)%numBlocks
+		if err := env.s.AddSkipListLinks(block); err != nil {
+			b.Fatal(err)
+		}
+		lookups += len(block.Header.SkipchainHashes)
+	}
+	b.ReportMetric(float64(lookups)/float64(b.N), "lookups/op")
+}
+
+// BenchmarkCommit10MBBlock measures the latency of the commit of a 10MB block to the block store once the block is
+// validated, when the block is serialized at once and when its body is prepared while it is validated
+func BenchmarkCommit10MBBlock(b *testing.B) {
+	newBlock := func(blockNumber uint64) *types.Block {
+		block := createSampleDataTxBlock(blockNumber, nil, nil, 1000)
+		for _, env := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			value := make([]byte, 10*1024)
+			rand.Read(value)
+			env.Payload.DbOperations = []*types.DBOperation{
+				{DbName: "db1", DataWrites: []*types.DataWrite{{Key: env.Payload.TxId, Value: value}}},
+			}
+		}
+		return block
+	}
+
+	b.Run("whole block", func(b *testing.B) {
+		env := newTestEnv(b)
+		defer env.cleanup(true)
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			block := newBlock(uint64(i + 1))
+			b.StartTimer()
+
+			if err := env.s.Commit(block); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("prepared block", func(b *testing.B) {
+		env := newTestEnv(b)
+		defer env.cleanup(true)
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			prepared, err := PrepareBlock(newBlock(uint64(i + 1)))
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.StartTimer()
+
+			if err := env.s.CommitPrepared(prepared, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkGet1MBBlock compares the retrieval of a 1MB block, which reads and unmarshals the whole block from its file
+// chunk, with the retrieval of its header and of its hash, which are read from the header records written at commit
+func BenchmarkGet1MBBlock(b *testing.B) {
+	const numBlocks = 16
+
+	env := newTestEnv(b)
+	defer env.cleanup(true)
+
+	for blockNumber := uint64(1); blockNumber <= numBlocks; blockNumber++ {
+		block := createSampleDataTxBlock(blockNumber, nil, nil, 100)
+		for _, env := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			value := make([]byte, 10*1024)
+			rand.Read(value)
+			env.Payload.DbOperations = []*types.DBOperation{
+				{DbName: "db1", DataWrites: []*types.DataWrite{{Key: env.Payload.TxId, Value: value}}},
+			}
+		}
+		require.NoError(b, env.s.Commit(block))
+	}
+
+	b.Run("block", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := env.s.Get(uint64(i%numBlocks) + 1); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("header", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := env.s.GetHeader(uint64(i%numBlocks) + 1); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("hash", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := env.s.GetHash(uint64(i%numBlocks) + 1); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockstore
+
+import (
+	"encoding/binary"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Compression is the compression of the blocks appended to the file chunks. The compression of each block is recorded
+// in the framing of its record, hence, the compression can be changed between restarts, and the blocks appended
+// before the change stay readable.
+type Compression string
+
+const (
+	// CompressionNone appends the blocks as they are serialized
+	CompressionNone Compression = "none"
+	// CompressionSnappy compresses the blocks with snappy, which is the default
+	CompressionSnappy Compression = "snappy"
+	// CompressionZstd compresses the blocks with zstd. The flag of zstd records is reserved, but no zstd codec is
+	// available to this build, hence, a store configured with it fails to open.
+	CompressionZstd Compression = "zstd"
+)
+
+// The flags of the compression of the records of the blocks. A record is framed as the uvarint zero, which no record
+// written before the flags starts with, the length of the rest of the record as a uvarint, the flag, and the block.
+// A record which does not start with zero is the snappy encoding of the block prefixed with its length as a uvarint.
+const (
+	recordFlagNone   byte = 0
+	recordFlagSnappy byte = 1
+	recordFlagZstd   byte = 2
+)
+
+func (c Compression) recordFlag() (byte, error) {
+	switch c {
+	case "", CompressionSnappy:
+		return recordFlagSnappy, nil
+	case CompressionNone:
+		return recordFlagNone, nil
+	case CompressionZstd:
+		return 0, errors.New("the zstd compression of blocks is not available in this build, use none or snappy")
+	default:
+		return 0, errors.Errorf("unknown compression of blocks [%s], use none or snappy", c)
+	}
+}
+
+// encodeBlockRecord returns the record of the serialized block, compressed as the flag prescribes
+func encodeBlockRecord(flag byte, blockBytes []byte) []byte {
+	payload := blockBytes
+	if flag == recordFlagSnappy {
+		payload = snappy.Encode(nil, blockBytes)
+	}
+
+	// the uvarint zero is a single zero byte
+	record := make([]byte, 1+binary.MaxVarintLen64, 2+binary.MaxVarintLen64+len(payload))
+	n := binary.PutUvarint(record[1:], uint64(len(payload)+1))
+	record = append(record[:1+n], flag)
+	return append(record, payload...)
+}
+
+// decodeFlaggedRecord returns the serialized block of a flagged record, given the record past its length, i.e., the
+// flag followed by the block
+func decodeFlaggedRecord(content []byte) ([]byte, error) {
+	if len(content) == 0 {
+		return nil, errors.New("the stored block misses the flag of its compression")
+	}
+
+	switch flag, payload := content[0], content[1:]; flag {
+	case recordFlagNone:
+		return payload, nil
+	case recordFlagSnappy:
+		return decodeSnappyRecord(payload)
+	case recordFlagZstd:
+		return nil, errors.New("the block is compressed with zstd, which is not available in this build")
+	default:
+		return nil, errors.Errorf("unknown compression flag [%d] of the stored block", flag)
+	}
+}
+
+func decodeSnappyRecord(payload []byte) ([]byte, error) {
+	blockBytes, err := snappy.Decode(nil, payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while decoding the block using snappy compression")
+	}
+	return blockBytes, nil
+}
+
+// Stats describes the space taken by the blocks of a store
+type Stats struct {
+	// Blocks is the number of stored blocks
+	Blocks uint64 `json:"blocks"`
+	// StoredBytes is the size of the records of the blocks in the file chunks, i.e., the space the blocks take on disk
+	StoredBytes uint64 `json:"stored_bytes"`
+	// LogicalBytes is the size of the serialized blocks, before compression
+	LogicalBytes uint64 `json:"logical_bytes"`
+}
+
+// Stats returns the space taken by the blocks of the store on disk, along with the size of the blocks before
+// compression. The size of a block indexed before the size was recorded in the block index is measured by reading the
+// block, once the scan of the block index is done, so that the commits are not held back by the reads.
+func (s *Store) Stats() (*Stats, error) {
+	stats, unsized, err := s.indexedStats()
+	if err != nil {
+		return nil, err
+	}
+
+	// the records of the blocks are never rewritten, hence, they are read from their own file handles while the
+	// store appends to the current file chunk
+	chunks := make(map[uint64]*os.File)
+	defer func() {
+		for _, f := range chunks {
+			if err := f.Close(); err != nil {
+				s.logger.Warnf("error while closing the file [%s]", f.Name())
+			}
+		}
+	}()
+	for _, location := range unsized {
+		f, ok := chunks[location.FileChunkNum]
+		if !ok {
+			if f, err = openFileChunk(s.fileChunksDirPath, location.FileChunkNum); err != nil {
+				return nil, err
+			}
+			chunks[location.FileChunkNum] = f
+		}
+
+		blockBytes, err := readBlockBytesFromFile(f, location.Offset)
+		if err != nil {
+			return nil, err
+		}
+		stats.LogicalBytes += uint64(len(blockBytes))
+	}
+
+	return stats, nil
+}
+
+// indexedStats returns the stats of the blocks as recorded in the block index, along with the locations of the blocks
+// whose size before compression is not recorded. The lock is held only while a snapshot of the block index is taken,
+// and the snapshot is scanned with the lock released, so that the commits are not held back by the scan.
+func (s *Store) indexedStats() (*Stats, []*BlockLocation, error) {
+	s.mu.RLock()
+	snap, err := s.blockIndexDB.GetSnapshot()
+	s.mu.RUnlock()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error while taking a snapshot of the block index")
+	}
+	defer snap.Release()
+
+	itr := snap.NewIterator(&util.Range{Limit: blockLocationKeysLimit}, nil)
+	defer itr.Release()
+
+	stats := &Stats{}
+	var unsized []*BlockLocation
+	for itr.Next() {
+		location := &BlockLocation{}
+		if err := proto.Unmarshal(itr.Value(), location); err != nil {
+			return nil, nil, errors.Wrap(err, "error while unmarshalling block location")
+		}
+
+		stats.Blocks++
+		stats.StoredBytes += uint64(location.Length)
+		if location.LogicalLength == 0 {
+			unsized = append(unsized, location)
+			continue
+		}
+		stats.LogicalBytes += uint64(location.LogicalLength)
+	}
+	if err := itr.Error(); err != nil {
+		return nil, nil, errors.Wrap(err, "error while scanning the block index")
+	}
+
+	return stats, unsized, nil
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockstore
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockCompression(t *testing.T) {
+	reopen := func(t *testing.T, env *testEnv, compression Compression) {
+		logger := env.s.logger
+		require.NoError(t, env.s.Close())
+
+		s, err := Open(&Config{
+			StoreDir:    env.storeDir,
+			Compression: compression,
+			Logger:      logger,
+		})
+		require.NoError(t, err)
+		env.s = s
+	}
+
+	commitBlocks := func(t *testing.T, env *testEnv, from, to uint64) {
+		for blockNumber := from; blockNumber <= to; blockNumber++ {
+			require.NoError(t, env.s.Commit(createSampleDataTxBlock(blockNumber, nil, nil, 20)))
+		}
+	}
+
+	assertBlocks := func(t *testing.T, s *Store, to uint64) uint64 {
+		var logicalBytes uint64
+		for blockNumber := uint64(1); blockNumber <= to; blockNumber++ {
+			block, err := s.Get(blockNumber)
+			require.NoError(t, err)
+			require.Equal(t, blockNumber, block.GetHeader().GetBaseHeader().GetNumber())
+
+			b, err := s.GetRaw(blockNumber)
+			require.NoError(t, err)
+			expected, err := proto.Marshal(block)
+			require.NoError(t, err)
+			require.Equal(t, expected, b)
+			logicalBytes += uint64(len(b))
+		}
+		return logicalBytes
+	}
+
+	for _, tt := range []struct {
+		compression Compression
+		compressed  bool
+	}{
+		{compression: "", compressed: true},
+		{compression: CompressionSnappy, compressed: true},
+		{compression: CompressionNone, compressed: false},
+	} {
+		t.Run("compression ["+string(tt.compression)+"]", func(t *testing.T) {
+			env := newTestEnv(t)
+			defer func() {
+				require.NoError(t, env.s.Close())
+				env.cleanup(false)
+			}()
+
+			reopen(t, env, tt.compression)
+			commitBlocks(t, env, 1, 10)
+			reopen(t, env, tt.compression)
+
+			logicalBytes := assertBlocks(t, env.s, 10)
+			stats, err := env.s.Stats()
+			require.NoError(t, err)
+			require.Equal(t, uint64(10), stats.Blocks)
+			require.Equal(t, logicalBytes, stats.LogicalBytes)
+			if tt.compressed {
+				require.Less(t, stats.StoredBytes, stats.LogicalBytes)
+			} else {
+				// the framing of each record takes a few bytes
+				require.Greater(t, stats.StoredBytes, stats.LogicalBytes)
+			}
+		})
+	}
+
+	t.Run("blocks stay readable after the compression changes", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer func() {
+			require.NoError(t, env.s.Close())
+			env.cleanup(false)
+		}()
+
+		// blocks appended before the records were flagged with their compression
+		for blockNumber := uint64(1); blockNumber <= 3; blockNumber++ {
+			block := createSampleDataTxBlock(blockNumber, nil, nil, 20)
+			require.NoError(t, env.s.AddSkipListLinks(block))
+			b, err := proto.Marshal(block)
+			require.NoError(t, err)
+			encodedBlock := snappy.Encode(nil, b)
+
+			buf := make([]byte, binary.MaxVarintLen64)
+			n := binary.PutUvarint(buf, uint64(len(encodedBlock)))
+			location, err := env.s.appendBlock(blockNumber, append(buf[:n], encodedBlock...))
+			require.NoError(t, err)
+			require.NoError(t, env.s.storeMetadataInDB(block, location, nil, nil))
+		}
+
+		reopen(t, env, CompressionNone)
+		commitBlocks(t, env, 4, 6)
+		reopen(t, env, CompressionSnappy)
+		commitBlocks(t, env, 7, 9)
+
+		logicalBytes := assertBlocks(t, env.s, 9)
+		stats, err := env.s.Stats()
+		require.NoError(t, err)
+		require.Equal(t, uint64(9), stats.Blocks)
+		require.Equal(t, logicalBytes, stats.LogicalBytes)
+
+		// the index rebuilt from the file chunks records the size of every block
+		logger := env.s.logger
+		require.NoError(t, env.s.Close())
+		require.NoError(t, os.RemoveAll(filepath.Join(env.storeDir, blockIndexDBName)))
+		env.s, err = Open(&Config{
+			StoreDir: env.storeDir,
+			Logger:   logger,
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, logicalBytes, assertBlocks(t, env.s, 9))
+		rebuiltStats, err := env.s.Stats()
+		require.NoError(t, err)
+		require.Equal(t, stats, rebuiltStats)
+	})
+
+	t.Run("unavailable compression", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+		logger := env.s.logger
+		require.NoError(t, env.s.Close())
+
+		s, err := Open(&Config{
+			StoreDir:    env.storeDir,
+			Compression: CompressionZstd,
+			Logger:      logger,
+		})
+		require.EqualError(t, err, "the zstd compression of blocks is not available in this build, use none or snappy")
+		require.Nil(t, s)
+
+		s, err = Open(&Config{
+			StoreDir:    env.storeDir,
+			Compression: "lz4",
+			Logger:      logger,
+		})
+		require.EqualError(t, err, "unknown compression of blocks [lz4], use none or snappy")
+		require.Nil(t, s)
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockstore
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// NextCumulativeCounters returns the cumulative counters of a block, given the counters of the previous block, nil
+// for the genesis block, the header of the block, whose validation info must be complete, and the sum of the sizes of
+// its transaction envelopes, see BlockEnvelopeBytes.
+func NextCumulativeCounters(prev *types.CumulativeCounters, header *types.BlockHeader, envelopeBytes uint64) *types.CumulativeCounters {
+	counters := &types.CumulativeCounters{
+		TxCount:       prev.GetTxCount() + uint64(len(header.GetValidationInfo())),
+		ValidTxCount:  prev.GetValidTxCount(),
+		EnvelopeBytes: prev.GetEnvelopeBytes() + envelopeBytes,
+	}
+	for _, info := range header.GetValidationInfo() {
+		if info.GetFlag() == types.Flag_VALID {
+			counters.ValidTxCount++
+		}
+	}
+	return counters
+}
+
+// BlockEnvelopeBytes returns the sum of the sizes of the deterministic encodings of the transaction envelopes of the
+// block
+func BlockEnvelopeBytes(block *types.Block) (uint64, error) {
+	var envelopeBytes uint64
+	for txNum, env := range utils.BlockEnvelopes(block) {
+		envBytes, err := marshal.DeterministicMarshal(env)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error while marshaling transaction %d of block %d", txNum, block.GetHeader().GetBaseHeader().GetNumber())
+		}
+		envelopeBytes += uint64(len(envBytes))
+	}
+	return envelopeBytes, nil
+}
+
+// VerifyHeaderCumulativeCounters checks the cumulative counters of a header against the counters of the previous
+// header when the transactions of the block are not available, e.g., on a state sync: the transaction counts must add
+// up, as the validation info is part of the header, while the envelope bytes must not decrease. A header that lacks
+// the counters, or whose previous header lacks them, was committed by a node that predates them, and is not checked.
+func VerifyHeaderCumulativeCounters(prev, header *types.BlockHeader) error {
+	counters := header.GetCumulative()
+	if counters == nil || prev.GetCumulative() == nil {
+		return nil
+	}
+
+	expected := NextCumulativeCounters(prev.GetCumulative(), header, 0)
+	if counters.GetTxCount() != expected.GetTxCount() || counters.GetValidTxCount() != expected.GetValidTxCount() ||
+		counters.GetEnvelopeBytes() < expected.GetEnvelopeBytes() {
+		return errors.Errorf("the cumulative counters of block %d, {%s}, do not add up from the counters of block %d, {%s}",
+			header.GetBaseHeader().GetNumber(), counters, prev.GetBaseHeader().GetNumber(), prev.GetCumulative())
+	}
+	return nil
+}
+
+// CumulativeCounters returns the cumulative counters of the given block. The counters of a block committed by a node
+// that predates them are derived from the blocks since the last block that records them, or since the genesis block.
+func (s *Store) CumulativeCounters(blockNumber uint64) (*types.CumulativeCounters, error) {
+	header, err := s.GetHeader(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if counters := header.GetCumulative(); counters != nil {
+		return counters, nil
+	}
+
+	first := blockNumber
+	var counters *types.CumulativeCounters
+	for ; first > 1; first-- {
+		prevHeader, err := s.GetHeader(first - 1)
+		if err != nil {
+			return nil, err
+		}
+		if counters = prevHeader.GetCumulative(); counters != nil {
+			break
+		}
+	}
+	s.logger.Infof("deriving the cumulative counters of block %d from blocks [%d, %d], which predate them", blockNumber, first, blockNumber)
+
+	it, err := s.GetRange(first, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for n := first; n <= blockNumber; n++ {
+		block, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		envelopeBytes, err := BlockEnvelopeBytes(block)
+		if err != nil {
+			return nil, err
+		}
+		counters = NextCumulativeCounters(counters, block.GetHeader(), envelopeBytes)
+	}
+	return counters, nil
+}
+
+// VerifyChain verifies the chain of the stored blocks, from the genesis block to the last one: each block must be
+// linked to the hash of the header of the previous block, and the cumulative counters of its header must add up the
+// transactions of the blocks up to it. The counters are summed from the blocks themselves, hence, the counters of a
+// block committed after blocks that predate them are verified too. A block held by its header alone, see CommitHeader,
+// has no envelopes to sum up: its counters are verified against the totals of the previous block, as on a state sync,
+// and the totals go on from them. It returns a *ChainVerificationError that names the first block that fails the
+// verification.
+func (s *Store) VerifyChain() error {
+	height, err := s.Height()
+	if err != nil || height == 0 {
+		return err
+	}
+	it, err := s.GetRange(1, height)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var counters *types.CumulativeCounters
+	// the totals are unknown after a header-only block that lacks the counters, until a header records them again
+	countersKnown := true
+	var prevHeader *types.BlockHeader
+	var prevHash []byte
+	for blockNum := uint64(1); blockNum <= height; blockNum++ {
+		block, err := it.Next()
+		if err != nil {
+			return err
+		}
+		header := block.GetHeader()
+
+		if blockNum > 1 {
+			if links := header.GetSkipchainHashes(); len(links) == 0 || !bytes.Equal(links[0], prevHash) {
+				return &ChainVerificationError{BlockNumber: blockNum, Reason: "the header is not linked to the header of the previous block"}
+			}
+		}
+
+		switch {
+		case block.GetPayload() == nil:
+			if countersKnown {
+				prev := &types.BlockHeader{BaseHeader: prevHeader.GetBaseHeader(), Cumulative: counters}
+				if err = VerifyHeaderCumulativeCounters(prev, header); err != nil {
+					return &ChainVerificationError{BlockNumber: blockNum, Reason: err.Error()}
+				}
+			}
+			counters = header.GetCumulative()
+			countersKnown = counters != nil
+
+		case !countersKnown:
+			counters = header.GetCumulative()
+			countersKnown = counters != nil
+
+		default:
+			envelopeBytes, err := BlockEnvelopeBytes(block)
+			if err != nil {
+				return err
+			}
+			counters = NextCumulativeCounters(counters, header, envelopeBytes)
+			if recorded := header.GetCumulative(); recorded != nil && !proto.Equal(recorded, counters) {
+				return &ChainVerificationError{
+					BlockNumber: blockNum,
+					Reason:      fmt.Sprintf("the cumulative counters of the header, {%s}, differ from the totals of the blocks, {%s}", recorded, counters),
+				}
+			}
+		}
+
+		if prevHash, err = ComputeBlockHash(block); err != nil {
+			return err
+		}
+		prevHeader = header
+	}
+
+	s.logger.Infof("verified the chain of %d blocks", height)
+	return nil
+}
+
+// ChainVerificationError is returned when a stored block fails the verification of the chain
+type ChainVerificationError struct {
+	BlockNumber uint64
+	Reason      string
+}
+
+func (e *ChainVerificationError) Error() string {
+	return fmt.Sprintf("the chain of the block store fails the verification at block %d: %s", e.BlockNumber, e.Reason)
+}
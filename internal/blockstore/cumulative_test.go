@@ -0,0 +1,180 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// commitCorpus commits blocks [first, last] of a corpus of data and user transaction blocks, some of whose
+// transactions are invalid, along with their cumulative counters if withCounters is set, and returns the totals of the
+// committed blocks
+func commitCorpus(t *testing.T, s *Store, first, last uint64, withCounters bool) *types.CumulativeCounters {
+	totals := &types.CumulativeCounters{}
+	for blockNum := first; blockNum <= last; blockNum++ {
+		var b *types.Block
+		if blockNum%4 == 0 {
+			b = createSampleUserTxBlock(blockNum, nil, nil)
+		} else {
+			b = createSampleDataTxBlock(blockNum, nil, nil, int(blockNum%3)+1)
+			b.Header.ValidationInfo[0].Flag = types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE
+		}
+		require.NoError(t, s.AddSkipListLinks(b))
+
+		envelopeBytes, err := BlockEnvelopeBytes(b)
+		require.NoError(t, err)
+		require.NotZero(t, envelopeBytes)
+		totals.TxCount += uint64(len(b.Header.ValidationInfo))
+		for _, info := range b.Header.ValidationInfo {
+			if info.Flag == types.Flag_VALID {
+				totals.ValidTxCount++
+			}
+		}
+		totals.EnvelopeBytes += envelopeBytes
+
+		if withCounters {
+			var prev *types.CumulativeCounters
+			if blockNum > 1 {
+				prev, err = s.CumulativeCounters(blockNum - 1)
+				require.NoError(t, err)
+			}
+			b.Header.Cumulative = NextCumulativeCounters(prev, b.Header, envelopeBytes)
+		}
+		require.NoError(t, s.Commit(b))
+	}
+	return totals
+}
+
+func TestCumulativeCounters(t *testing.T) {
+	t.Run("the counters add up the blocks since genesis", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		totals := commitCorpus(t, env.s, 1, 20, true)
+
+		header, err := env.s.GetHeader(20)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(totals, header.GetCumulative()), "expected: %s, actual: %s", totals, header.GetCumulative())
+		require.True(t, totals.ValidTxCount < totals.TxCount)
+		require.NoError(t, env.s.VerifyChain())
+
+		// the counters of each header add up from the previous header
+		for blockNum := uint64(2); blockNum <= 20; blockNum++ {
+			prev, err := env.s.GetHeader(blockNum - 1)
+			require.NoError(t, err)
+			header, err := env.s.GetHeader(blockNum)
+			require.NoError(t, err)
+			require.NoError(t, VerifyHeaderCumulativeCounters(prev, header))
+		}
+	})
+
+	t.Run("the counters of blocks that predate them are derived", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		legacyTotals := commitCorpus(t, env.s, 1, 7, false)
+		header, err := env.s.GetHeader(7)
+		require.NoError(t, err)
+		require.Nil(t, header.GetCumulative())
+		counters, err := env.s.CumulativeCounters(7)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(legacyTotals, counters))
+
+		totals := commitCorpus(t, env.s, 8, 12, true)
+		header, err = env.s.GetHeader(12)
+		require.NoError(t, err)
+		require.Equal(t, legacyTotals.TxCount+totals.TxCount, header.GetCumulative().GetTxCount())
+		require.Equal(t, legacyTotals.ValidTxCount+totals.ValidTxCount, header.GetCumulative().GetValidTxCount())
+		require.Equal(t, legacyTotals.EnvelopeBytes+totals.EnvelopeBytes, header.GetCumulative().GetEnvelopeBytes())
+		require.NoError(t, env.s.VerifyChain())
+	})
+
+	t.Run("a tampered counter fails the verification", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(false)
+
+		commitCorpus(t, env.s, 1, 6, true)
+		b := createSampleDataTxBlock(7, nil, nil, 2)
+		require.NoError(t, env.s.AddSkipListLinks(b))
+		prev, err := env.s.CumulativeCounters(6)
+		require.NoError(t, err)
+		envelopeBytes, err := BlockEnvelopeBytes(b)
+		require.NoError(t, err)
+		b.Header.Cumulative = NextCumulativeCounters(prev, b.Header, envelopeBytes)
+		b.Header.Cumulative.TxCount++
+		require.NoError(t, env.s.Commit(b))
+		commitCorpus(t, env.s, 8, 10, true)
+
+		err = env.s.VerifyChain()
+		require.Error(t, err)
+		verificationErr, ok := err.(*ChainVerificationError)
+		require.True(t, ok)
+		require.Equal(t, uint64(7), verificationErr.BlockNumber)
+		require.Contains(t, err.Error(), "the cumulative counters of the header")
+
+		prevHeader, err := env.s.GetHeader(6)
+		require.NoError(t, err)
+		header, err := env.s.GetHeader(7)
+		require.NoError(t, err)
+		require.EqualError(t, VerifyHeaderCumulativeCounters(prevHeader, header),
+			"the cumulative counters of block 7, {"+header.GetCumulative().String()+"}, do not add up from the counters of block 6, {"+prevHeader.GetCumulative().String()+"}")
+
+		// the store is not opened when the chain is verified on open
+		logger := env.s.logger
+		require.NoError(t, env.s.Close())
+		_, err = Open(&Config{StoreDir: env.storeDir, VerifyChain: true, Logger: logger})
+		require.EqualError(t, err, verificationErr.Error())
+
+		env.s, err = Open(&Config{StoreDir: env.storeDir, Logger: logger})
+		require.NoError(t, err)
+	})
+
+	t.Run("a block held by its header alone is verified by its counters", func(t *testing.T) {
+		for _, tampered := range []bool{false, true} {
+			env := newTestEnv(t)
+
+			commitCorpus(t, env.s, 1, 5, true)
+			b := createSampleDataTxBlock(6, nil, nil, 2)
+			require.NoError(t, env.s.AddSkipListLinks(b))
+			prev, err := env.s.CumulativeCounters(5)
+			require.NoError(t, err)
+			envelopeBytes, err := BlockEnvelopeBytes(b)
+			require.NoError(t, err)
+			b.Header.Cumulative = NextCumulativeCounters(prev, b.Header, envelopeBytes)
+			if tampered {
+				b.Header.Cumulative.ValidTxCount++
+			}
+			require.NoError(t, env.s.CommitHeader(b.Header))
+			commitCorpus(t, env.s, 7, 9, true)
+
+			err = env.s.VerifyChain()
+			if tampered {
+				verificationErr, ok := err.(*ChainVerificationError)
+				require.True(t, ok)
+				require.Equal(t, uint64(6), verificationErr.BlockNumber)
+				require.Contains(t, err.Error(), "do not add up from the counters of block 5")
+			} else {
+				require.NoError(t, err)
+			}
+			env.cleanup(true)
+		}
+	})
+
+	t.Run("a header is not verified against a header that lacks the counters", func(t *testing.T) {
+		prev := createSampleDataTxBlock(1, nil, nil, 1).Header
+		header := createSampleDataTxBlock(2, nil, nil, 1).Header
+		header.Cumulative = &types.CumulativeCounters{TxCount: 5}
+		require.NoError(t, VerifyHeaderCumulativeCounters(prev, header))
+
+		prev.Cumulative = &types.CumulativeCounters{TxCount: 1, ValidTxCount: 1, EnvelopeBytes: 10}
+		require.Error(t, VerifyHeaderCumulativeCounters(prev, header))
+		header.Cumulative = &types.CumulativeCounters{TxCount: 2, ValidTxCount: 2, EnvelopeBytes: 20}
+		require.NoError(t, VerifyHeaderCumulativeCounters(prev, header))
+		header.Cumulative.EnvelopeBytes = 9
+		require.Error(t, VerifyHeaderCumulativeCounters(prev, header))
+	})
+}
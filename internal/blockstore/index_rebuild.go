@@ -0,0 +1,281 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// segmentIndex is the partial block index of a single file chunk
+type segmentIndex struct {
+	segment *IndexedSegment
+	// batch holds the locations of the blocks of the file chunk, and is nil if the file chunk was indexed before the
+	// rebuild was interrupted
+	batch *leveldb.Batch
+	err   error
+}
+
+// rebuildBlockIndex rebuilds the block index, i.e., the location of each block and of each transaction, from the file
+// chunks. The file chunks are spread over the given number of workers, zero meaning one per CPU, each of which reads
+// the blocks of a file chunk into a partial index. The partial index of a file chunk is merged into the block index
+// database as soon as the file chunk is complete, and the file chunk is recorded in the checkpoint, hence an
+// interrupted rebuild resumes with the file chunks which are not indexed yet. As the partial indexes hold disjoint
+// block numbers, which the keys of the locations of the transactions hold as well, the resulting index does not depend
+// on the order of the merges, and is the one a sequential rebuild yields. It returns the number of file chunks indexed
+// anew.
+func (s *Store) rebuildBlockIndex(checkpointPath string, workers int) (int, error) {
+	checkpoint, err := readIndexRebuildCheckpoint(checkpointPath)
+	if err != nil {
+		return 0, err
+	}
+	indexedBefore := make(map[uint64]*IndexedSegment)
+	for _, segment := range checkpoint.GetSegments() {
+		indexedBefore[segment.FileChunkNum] = segment
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	numChunks := s.currentChunkNum + 1
+	start := time.Now()
+	s.logger.Infof("rebuilding the block index from %d file chunks with %d workers, of which %d file chunks were indexed before the rebuild was interrupted",
+		numChunks, workers, len(indexedBefore))
+
+	chunks := make(chan uint64)
+	stop := make(chan struct{})
+	go func() {
+		defer close(chunks)
+		for chunkNum := uint64(0); chunkNum < numChunks; chunkNum++ {
+			select {
+			case chunks <- chunkNum:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	results := make(chan *segmentIndex)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for chunkNum := range chunks {
+				results <- s.indexSegment(chunkNum, indexedBefore[chunkNum])
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// the partial indexes of the file chunks completed before a failure are merged nonetheless, so that the next
+	// attempt resumes after them
+	var rebuildErr error
+	fail := func(err error) {
+		if rebuildErr == nil {
+			rebuildErr = err
+			close(stop)
+		}
+	}
+	indexed := 0
+	for r := range results {
+		if r.err != nil {
+			fail(r.err)
+			continue
+		}
+		if r.batch == nil {
+			continue
+		}
+
+		if err := s.blockIndexDB.Write(r.batch, &opt.WriteOptions{Sync: true}); err != nil {
+			fail(errors.Wrapf(err, "error while merging the index of file chunk [%d] into the block index", r.segment.FileChunkNum))
+			continue
+		}
+		checkpoint.Segments = append(checkpoint.Segments, r.segment)
+		if err := writeIndexRebuildCheckpoint(checkpointPath, checkpoint); err != nil {
+			fail(err)
+			continue
+		}
+
+		indexed++
+		s.logger.Infof("indexed file chunk [%d] holding blocks [%d, %d], %d of %d file chunks are indexed",
+			r.segment.FileChunkNum, r.segment.FirstBlockNumber, r.segment.LastBlockNumber, len(checkpoint.Segments), numChunks)
+	}
+	if rebuildErr != nil {
+		return indexed, errors.WithMessage(rebuildErr, "error while rebuilding the block index, which resumes on the next open")
+	}
+
+	if err := s.verifySegments(checkpoint.GetSegments()); err != nil {
+		return indexed, err
+	}
+	if err := fileops.Remove(checkpointPath); err != nil {
+		return indexed, errors.Wrapf(err, "error while removing the checkpoint [%s] of the block index rebuild", checkpointPath)
+	}
+
+	s.logger.Infof("rebuilt the block index from %d file chunks in %s, of which %d file chunks were indexed before the rebuild was interrupted",
+		numChunks, time.Since(start), numChunks-uint64(indexed))
+	return indexed, nil
+}
+
+// indexSegment reads the blocks of a file chunk into a partial index, along with the checksum of the file chunk. If
+// the file chunk was indexed before the rebuild was interrupted, only its checksum is verified. The last file chunk
+// may end with a partially written block, which the recovery of the store truncates.
+func (s *Store) indexSegment(chunkNum uint64, indexedBefore *IndexedSegment) *segmentIndex {
+	stream, err := newBlockfileStream(s.logger, s.fileChunksDirPath, &BlockLocation{FileChunkNum: chunkNum})
+	if err != nil {
+		return &segmentIndex{err: err}
+	}
+	defer func() {
+		if err := stream.close(); err != nil {
+			s.logger.Warn(err.Error())
+		}
+	}()
+
+	hash := sha256.New()
+	stream.hashContent(hash)
+
+	if indexedBefore != nil {
+		if err := stream.drain(); err != nil {
+			return &segmentIndex{err: err}
+		}
+		if !bytes.Equal(hash.Sum(nil), indexedBefore.Checksum) {
+			return &segmentIndex{
+				err: errors.Errorf("file chunk [%d] changed since it was indexed by the interrupted rebuild of the block index, "+
+					"remove the block index to rebuild it from scratch", chunkNum),
+			}
+		}
+		return &segmentIndex{segment: indexedBefore}
+	}
+
+	segment := &IndexedSegment{FileChunkNum: chunkNum}
+	batch := &leveldb.Batch{}
+	for {
+		next, err := stream.nextBlockInChunk()
+		if err == ErrUnexpectedEndOfBlockfile && chunkNum == s.currentChunkNum {
+			break
+		}
+		if err != nil {
+			return &segmentIndex{err: errors.WithMessagef(err, "error while reading the blocks of file chunk [%d]", chunkNum)}
+		}
+		if next == nil {
+			break
+		}
+
+		number := next.block.GetHeader().GetBaseHeader().GetNumber()
+		if segment.LastBlockNumber != 0 && number != segment.LastBlockNumber+1 {
+			return &segmentIndex{
+				err: errors.Errorf("file chunk [%d] holds block [%d] after block [%d]", chunkNum, number, segment.LastBlockNumber),
+			}
+		}
+		if segment.FirstBlockNumber == 0 {
+			segment.FirstBlockNumber = number
+		}
+		segment.LastBlockNumber = number
+
+		value, err := proto.Marshal(&BlockLocation{
+			FileChunkNum:  chunkNum,
+			Offset:        next.blockStartOffset,
+			Length:        next.blockEndOffset - next.blockStartOffset,
+			LogicalLength: next.logicalLength,
+			HeaderOnly:    next.block.GetPayload() == nil,
+		})
+		if err != nil {
+			return &segmentIndex{err: errors.Wrap(err, "error while marshaling BlockLocation")}
+		}
+		batch.Put(encodeOrderPreservingVarUint64(number), value)
+		if err := addTxLocations(batch, next.block); err != nil {
+			return &segmentIndex{err: errors.WithMessagef(err, "error while indexing the transactions of block [%d]", number)}
+		}
+	}
+
+	if err := stream.drain(); err != nil {
+		return &segmentIndex{err: err}
+	}
+	segment.Checksum = hash.Sum(nil)
+
+	return &segmentIndex{segment: segment, batch: batch}
+}
+
+// verifySegments verifies that the file chunks hold consecutive blocks, starting from the first block. Only the last
+// file chunk may hold no block, e.g., if the node failed right after creating it.
+func (s *Store) verifySegments(segments []*IndexedSegment) error {
+	sorted := make([]*IndexedSegment, len(segments))
+	copy(sorted, segments)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].FileChunkNum < sorted[j].FileChunkNum
+	})
+
+	nextBlockNumber := uint64(1)
+	for _, segment := range sorted {
+		if segment.LastBlockNumber == 0 {
+			if segment.FileChunkNum != s.currentChunkNum {
+				return errors.Errorf("file chunk [%d] holds no block, while it is not the last file chunk", segment.FileChunkNum)
+			}
+			continue
+		}
+		if segment.FirstBlockNumber != nextBlockNumber {
+			return errors.Errorf("file chunk [%d] starts with block [%d], while block [%d] is expected",
+				segment.FileChunkNum, segment.FirstBlockNumber, nextBlockNumber)
+		}
+		nextBlockNumber = segment.LastBlockNumber + 1
+	}
+
+	return nil
+}
+
+func readIndexRebuildCheckpoint(path string) (*IndexRebuildCheckpoint, error) {
+	checkpoint := &IndexRebuildCheckpoint{}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return checkpoint, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while reading the checkpoint [%s] of the block index rebuild", path)
+	}
+
+	if err := proto.Unmarshal(content, checkpoint); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the checkpoint [%s] of the block index rebuild", path)
+	}
+	return checkpoint, nil
+}
+
+func writeIndexRebuildCheckpoint(path string, checkpoint *IndexRebuildCheckpoint) error {
+	content, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "error while marshaling the checkpoint of the block index rebuild")
+	}
+
+	tmpPath := path + ".tmp"
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		return errors.Wrapf(err, "error while creating the file [%s]", tmpPath)
+	}
+	if _, err := fileops.Write(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "error while closing the file [%s]", tmpPath)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrapf(err, "error while renaming the file [%s] to [%s]", tmpPath, path)
+	}
+
+	return fileops.SyncDir(filepath.Dir(path))
+}
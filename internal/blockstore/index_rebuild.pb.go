@@ -0,0 +1,253 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.15.8
+// source: index_rebuild.proto
+
+package blockstore
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// IndexRebuildCheckpoint records the progress of a rebuild of the block index, so that an interrupted rebuild resumes
+// from the file chunks it has not indexed yet
+type IndexRebuildCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// segments are the file chunks whose blocks are already in the block index
+	Segments []*IndexedSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *IndexRebuildCheckpoint) Reset() {
+	*x = IndexRebuildCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_index_rebuild_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexRebuildCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexRebuildCheckpoint) ProtoMessage() {}
+
+func (x *IndexRebuildCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_index_rebuild_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexRebuildCheckpoint.ProtoReflect.Descriptor instead.
+func (*IndexRebuildCheckpoint) Descriptor() ([]byte, []int) {
+	return file_index_rebuild_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IndexRebuildCheckpoint) GetSegments() []*IndexedSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// IndexedSegment describes a file chunk whose blocks are in the block index
+type IndexedSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileChunkNum uint64 `protobuf:"varint,1,opt,name=file_chunk_num,json=fileChunkNum,proto3" json:"file_chunk_num,omitempty"`
+	// checksum is the SHA-256 hash of the file chunk, which must be unchanged for the rebuild to resume
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// first_block_number and last_block_number are zero if the file chunk holds no complete block
+	FirstBlockNumber uint64 `protobuf:"varint,3,opt,name=first_block_number,json=firstBlockNumber,proto3" json:"first_block_number,omitempty"`
+	LastBlockNumber  uint64 `protobuf:"varint,4,opt,name=last_block_number,json=lastBlockNumber,proto3" json:"last_block_number,omitempty"`
+}
+
+func (x *IndexedSegment) Reset() {
+	*x = IndexedSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_index_rebuild_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexedSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexedSegment) ProtoMessage() {}
+
+func (x *IndexedSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_index_rebuild_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexedSegment.ProtoReflect.Descriptor instead.
+func (*IndexedSegment) Descriptor() ([]byte, []int) {
+	return file_index_rebuild_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IndexedSegment) GetFileChunkNum() uint64 {
+	if x != nil {
+		return x.FileChunkNum
+	}
+	return 0
+}
+
+func (x *IndexedSegment) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (x *IndexedSegment) GetFirstBlockNumber() uint64 {
+	if x != nil {
+		return x.FirstBlockNumber
+	}
+	return 0
+}
+
+func (x *IndexedSegment) GetLastBlockNumber() uint64 {
+	if x != nil {
+		return x.LastBlockNumber
+	}
+	return 0
+}
+
+var File_index_rebuild_proto protoreflect.FileDescriptor
+
+var file_index_rebuild_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x22, 0x50, 0x0a, 0x16, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_index_rebuild_proto_rawDescOnce sync.Once
+	file_index_rebuild_proto_rawDescData = file_index_rebuild_proto_rawDesc
+)
+
+func file_index_rebuild_proto_rawDescGZIP() []byte {
+	file_index_rebuild_proto_rawDescOnce.Do(func() {
+		file_index_rebuild_proto_rawDescData = protoimpl.X.CompressGZIP(file_index_rebuild_proto_rawDescData)
+	})
+	return file_index_rebuild_proto_rawDescData
+}
+
+var file_index_rebuild_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_index_rebuild_proto_goTypes = []interface{}{
+	(*IndexRebuildCheckpoint)(nil), // 0: blockstore.IndexRebuildCheckpoint
+	(*IndexedSegment)(nil),         // 1: blockstore.IndexedSegment
+}
+var file_index_rebuild_proto_depIdxs = []int32{
+	1, // 0: blockstore.IndexRebuildCheckpoint.segments:type_name -> blockstore.IndexedSegment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_index_rebuild_proto_init() }
+func file_index_rebuild_proto_init() {
+	if File_index_rebuild_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_index_rebuild_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexRebuildCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_index_rebuild_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexedSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_index_rebuild_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_index_rebuild_proto_goTypes,
+		DependencyIndexes: file_index_rebuild_proto_depIdxs,
+		MessageInfos:      file_index_rebuild_proto_msgTypes,
+	}.Build()
+	File_index_rebuild_proto = out.File
+	file_index_rebuild_proto_rawDesc = nil
+	file_index_rebuild_proto_goTypes = nil
+	file_index_rebuild_proto_depIdxs = nil
+}
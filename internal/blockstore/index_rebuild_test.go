@@ -0,0 +1,154 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+func TestRebuildBlockIndex(t *testing.T) {
+	totalBlocks := uint64(300)
+
+	setup := func(t *testing.T) *testEnv {
+		env := newTestEnv(t)
+		for blockNumber := uint64(1); blockNumber <= totalBlocks; blockNumber++ {
+			require.NoError(t, env.s.Commit(createSampleUserTxBlock(blockNumber, nil, nil)))
+		}
+		// the blocks must span several file chunks for the workers to share them
+		require.Greater(t, env.s.currentChunkNum, uint64(4))
+		return env
+	}
+
+	dumpIndex := func(t *testing.T, s *Store) [][2][]byte {
+		itr := s.blockIndexDB.NewIterator(nil, nil)
+		defer itr.Release()
+
+		var kvs [][2][]byte
+		for itr.Next() {
+			kvs = append(kvs, [2][]byte{append([]byte{}, itr.Key()...), append([]byte{}, itr.Value()...)})
+		}
+		require.NoError(t, itr.Error())
+		return kvs
+	}
+
+	reopenWithoutIndex := func(t *testing.T, env *testEnv, workers int) *Store {
+		logger := env.s.logger
+		require.NoError(t, env.s.Close())
+		require.NoError(t, os.RemoveAll(filepath.Join(env.storeDir, blockIndexDBName)))
+
+		s, err := Open(&Config{
+			StoreDir:            env.storeDir,
+			IndexRebuildWorkers: workers,
+			Logger:              logger,
+		})
+		require.NoError(t, err)
+		env.s = s
+		return s
+	}
+
+	t.Run("parallel rebuild matches sequential rebuild", func(t *testing.T) {
+		env := setup(t)
+		defer func() {
+			require.NoError(t, env.s.Close())
+			env.cleanup(false)
+		}()
+
+		// the location of each block and of its transaction
+		committed := dumpIndex(t, env.s)
+		require.Len(t, committed, 2*int(totalBlocks))
+
+		sequential := dumpIndex(t, reopenWithoutIndex(t, env, 1))
+		parallel := dumpIndex(t, reopenWithoutIndex(t, env, 4))
+		require.Equal(t, sequential, parallel)
+		require.Equal(t, committed, parallel)
+
+		height, err := env.s.Height()
+		require.NoError(t, err)
+		require.Equal(t, totalBlocks, height)
+		for _, blockNumber := range []uint64{1, totalBlocks / 2, totalBlocks} {
+			block, err := env.s.Get(blockNumber)
+			require.NoError(t, err)
+			require.Equal(t, blockNumber, block.GetHeader().GetBaseHeader().GetNumber())
+		}
+		require.NoFileExists(t, filepath.Join(env.storeDir, indexRebuildCheckpointName))
+
+		require.NoError(t, env.s.Commit(createSampleUserTxBlock(totalBlocks+1, nil, nil)))
+	})
+
+	t.Run("interrupted rebuild resumes", func(t *testing.T) {
+		env := setup(t)
+		defer env.cleanup(true)
+
+		committed := dumpIndex(t, env.s)
+		batch := &leveldb.Batch{}
+		for _, kv := range committed {
+			batch.Delete(kv[0])
+		}
+		require.NoError(t, env.s.blockIndexDB.Write(batch, nil))
+
+		// an unreadable block length in the last file chunk fails its worker, while the other file chunks are indexed
+		numChunks := int(env.s.currentChunkNum) + 1
+		checkpointPath := filepath.Join(env.storeDir, indexRebuildCheckpointName)
+		lastChunkPath := constructBlockFileChunkPath(env.s.fileChunksDirPath, env.s.currentChunkNum)
+		original := corruptFileChunk(t, lastChunkPath, 0, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+		indexed, err := env.s.rebuildBlockIndex(checkpointPath, 4)
+		require.Contains(t, err.Error(), "error while rebuilding the block index, which resumes on the next open")
+		require.Equal(t, numChunks-1, indexed)
+		checkpoint, err := readIndexRebuildCheckpoint(checkpointPath)
+		require.NoError(t, err)
+		require.Len(t, checkpoint.GetSegments(), numChunks-1)
+
+		// a file chunk indexed before the interruption must not change
+		firstChunkPath := constructBlockFileChunkPath(env.s.fileChunksDirPath, 0)
+		originalFirst := corruptFileChunk(t, firstChunkPath, 0, []byte{0x01})
+		_, err = env.s.rebuildBlockIndex(checkpointPath, 4)
+		require.EqualError(t, err, "error while rebuilding the block index, which resumes on the next open: "+
+			"file chunk [0] changed since it was indexed by the interrupted rebuild of the block index, remove the block index to rebuild it from scratch")
+
+		corruptFileChunk(t, firstChunkPath, 0, originalFirst)
+		corruptFileChunk(t, lastChunkPath, 0, original)
+		indexed, err = env.s.rebuildBlockIndex(checkpointPath, 4)
+		require.NoError(t, err)
+		require.Equal(t, 1, indexed)
+		require.Equal(t, committed, dumpIndex(t, env.s))
+		require.NoFileExists(t, checkpointPath)
+	})
+
+	t.Run("blocks missing from a file chunk", func(t *testing.T) {
+		env := setup(t)
+		defer env.cleanup(false)
+
+		logger := env.s.logger
+		require.NoError(t, env.s.Close())
+		require.NoError(t, os.RemoveAll(filepath.Join(env.storeDir, blockIndexDBName)))
+		require.NoError(t, os.Truncate(constructBlockFileChunkPath(filepath.Join(env.storeDir, fileChunksDirName), 1), 0))
+
+		s, err := Open(&Config{
+			StoreDir:            env.storeDir,
+			IndexRebuildWorkers: 4,
+			Logger:              logger,
+		})
+		require.EqualError(t, err, "file chunk [1] holds no block, while it is not the last file chunk")
+		require.Nil(t, s)
+	})
+}
+
+// corruptFileChunk overwrites the file chunk at the given offset, and returns the bytes it overwrote
+func corruptFileChunk(t *testing.T, path string, offset int64, content []byte) []byte {
+	f, err := os.OpenFile(path, os.O_RDWR, 0644)
+	require.NoError(t, err)
+	defer f.Close()
+
+	original := make([]byte, len(content))
+	_, err = f.ReadAt(original, offset)
+	require.NoError(t, err)
+	_, err = f.WriteAt(content, offset)
+	require.NoError(t, err)
+	return original
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// keyFilterBitsPerKey and keyFilterHashCount give the key filters a false positive probability of about 1%
+	keyFilterBitsPerKey = 10
+	keyFilterHashCount  = 7
+	// keyFilterMinBits is the size of the filter of a block which modified few keys, or none
+	keyFilterMinBits = 64
+)
+
+// NewKeyFilter builds the key filter of a block from its state delta. The filter is sized by the number of distinct
+// keys the block modified, and depends on the set of these keys alone, hence, every node builds the same filter for
+// the same block.
+func NewKeyFilter(delta *types.StateDelta) *KeyFilter {
+	keys := make(map[string]struct{}, len(delta.GetKeys()))
+	for _, k := range delta.GetKeys() {
+		keys[string(keyFilterEntry(k.GetDbName(), k.GetKey()))] = struct{}{}
+	}
+
+	numBits := len(keys) * keyFilterBitsPerKey
+	if numBits < keyFilterMinBits {
+		numBits = keyFilterMinBits
+	}
+	f := &KeyFilter{
+		Bits:      make([]byte, (numBits+7)/8),
+		HashCount: keyFilterHashCount,
+		Keys:      uint64(len(keys)),
+	}
+
+	for entry := range keys {
+		f.forEachBit([]byte(entry), func(byteIndex int, mask byte) bool {
+			f.Bits[byteIndex] |= mask
+			return true
+		})
+	}
+	return f
+}
+
+// MayContain returns false if the block of the filter did not modify the key of the database, and true if it may have
+// modified it.
+func (f *KeyFilter) MayContain(dbName, key string) bool {
+	if len(f.GetBits()) == 0 {
+		// a filter without bits excludes no key
+		return true
+	}
+
+	contains := true
+	f.forEachBit(keyFilterEntry(dbName, key), func(byteIndex int, mask byte) bool {
+		contains = f.Bits[byteIndex]&mask != 0
+		return contains
+	})
+	return contains
+}
+
+// FalsePositiveProbability estimates the probability that the filter matches a key which was not added to it
+func (f *KeyFilter) FalsePositiveProbability() float64 {
+	numBits := float64(len(f.GetBits()) * 8)
+	if numBits == 0 {
+		return 1
+	}
+	k := float64(f.GetHashCount())
+	return math.Pow(1-math.Exp(-k*float64(f.GetKeys())/numBits), k)
+}
+
+// forEachBit calls set with the byte index and the mask of each bit of the entry, until set returns false. The bits
+// are derived from the SHA-256 digest of the entry by double hashing.
+func (f *KeyFilter) forEachBit(entry []byte, set func(byteIndex int, mask byte) bool) {
+	digest := sha256.Sum256(entry)
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+
+	numBits := uint64(len(f.Bits)) * 8
+	for i := uint64(0); i < uint64(f.HashCount); i++ {
+		bit := (h1 + i*h2) % numBits
+		if !set(int(bit/8), byte(1)<<(bit%8)) {
+			return
+		}
+	}
+}
+
+// keyFilterEntry encodes the key of the database as an entry of a key filter. The name of the database is prefixed
+// with its length, so that no two pairs share an entry.
+func keyFilterEntry(dbName, key string) []byte {
+	entry := make([]byte, binary.MaxVarintLen64+len(dbName)+len(key))
+	n := binary.PutUvarint(entry, uint64(len(dbName)))
+	n += copy(entry[n:], dbName)
+	n += copy(entry[n:], key)
+	return entry[:n]
+}
+
+// CommitKeyFilter stores the key filter of a committed block
+func (s *Store) CommitKeyFilter(blockNumber uint64, filter *KeyFilter) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if blockNumber == 0 || blockNumber > s.lastCommittedBlockNum {
+		return errors.Errorf("block [%d] is not committed, the last committed block is [%d]", blockNumber, s.lastCommittedBlockNum)
+	}
+
+	filterBytes, err := proto.Marshal(filter)
+	if err != nil {
+		return errors.Wrapf(err, "can't marshal the key filter of block %d", blockNumber)
+	}
+
+	return s.blockHeaderDB.Put(constructKeyFilterKey(blockNumber), filterBytes, &opt.WriteOptions{Sync: true})
+}
+
+// GetKeyFilter returns the key filter of a block. A NotFoundErr is returned if the filter of the block was not
+// recorded, e.g., because the block was committed before the key filters were.
+func (s *Store) GetKeyFilter(blockNumber uint64) (*KeyFilter, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	val, err := s.blockHeaderDB.Get(constructKeyFilterKey(blockNumber), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("the key filter of block %d is not available", blockNumber)}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't access the key filter of block %d", blockNumber)
+	}
+
+	filter := &KeyFilter{}
+	if err := proto.Unmarshal(val, filter); err != nil {
+		return nil, errors.Wrap(err, "error while unmarshalling the key filter")
+	}
+	return filter, nil
+}
+
+// GetKeyBlockCandidates scans the key filters of the blocks from startBlockNum to endBlockNum, both included, and
+// returns the blocks whose filters match the key of the database, along with the blocks that have no filter. Any
+// other block of the range did not modify the key. A NotFoundErr is returned if the range is past the last committed
+// block.
+func (s *Store) GetKeyBlockCandidates(dbName, key string, startBlockNum, endBlockNum uint64) ([]*types.KeyBlockCandidate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if startBlockNum == 0 || startBlockNum > endBlockNum {
+		return nil, errors.Errorf("invalid block range [%d, %d]", startBlockNum, endBlockNum)
+	}
+	if endBlockNum > s.lastCommittedBlockNum {
+		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("block not found: %d", endBlockNum)}
+	}
+
+	itr := s.blockHeaderDB.NewIterator(
+		&util.Range{
+			Start: constructKeyFilterKey(startBlockNum),
+			Limit: constructKeyFilterKey(endBlockNum + 1),
+		},
+		nil,
+	)
+	defer itr.Release()
+
+	var candidates []*types.KeyBlockCandidate
+	addUnfiltered := func(from, to uint64) {
+		for blockNum := from; blockNum < to; blockNum++ {
+			candidates = append(candidates, &types.KeyBlockCandidate{
+				BlockNumber:              blockNum,
+				FalsePositiveProbability: 1,
+				Unfiltered:               true,
+			})
+		}
+	}
+
+	next := startBlockNum
+	for itr.Next() {
+		blockNum, _, err := decodeOrderPreservingVarUint64(itr.Key()[len(keyFilterNs):])
+		if err != nil {
+			return nil, errors.WithMessage(err, "error while decoding the key of a key filter")
+		}
+		filter := &KeyFilter{}
+		if err := proto.Unmarshal(itr.Value(), filter); err != nil {
+			return nil, errors.Wrapf(err, "error while unmarshalling the key filter of block %d", blockNum)
+		}
+
+		addUnfiltered(next, blockNum)
+		next = blockNum + 1
+		if filter.MayContain(dbName, key) {
+			candidates = append(candidates, &types.KeyBlockCandidate{
+				BlockNumber:              blockNum,
+				FalsePositiveProbability: filter.FalsePositiveProbability(),
+			})
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return nil, errors.Wrap(err, "error while scanning the key filters")
+	}
+	addUnfiltered(next, endBlockNum+1)
+
+	return candidates, nil
+}
+
+func constructKeyFilterKey(blockNum uint64) []byte {
+	return append(keyFilterNs, encodeOrderPreservingVarUint64(blockNum)...)
+}
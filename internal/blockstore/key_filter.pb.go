@@ -0,0 +1,173 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.15.8
+// source: key_filter.proto
+
+package blockstore
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// KeyFilter is the bloom filter of the keys a block modified, i.e., the (database, key) pairs the block wrote or
+// deleted. It is built by the committer from the state delta of the block, and is kept next to the block, but it is
+// neither part of the block bytes nor of the block hash.
+type KeyFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bits holds the bits of the filter, the bit i being the bit i%8 of the byte i/8
+	Bits []byte `protobuf:"bytes,1,opt,name=bits,proto3" json:"bits,omitempty"`
+	// hash_count is the number of bits each key sets
+	HashCount uint32 `protobuf:"varint,2,opt,name=hash_count,json=hashCount,proto3" json:"hash_count,omitempty"`
+	// keys is the number of distinct keys added to the filter
+	Keys uint64 `protobuf:"varint,3,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *KeyFilter) Reset() {
+	*x = KeyFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_key_filter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyFilter) ProtoMessage() {}
+
+func (x *KeyFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_key_filter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyFilter.ProtoReflect.Descriptor instead.
+func (*KeyFilter) Descriptor() ([]byte, []int) {
+	return file_key_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyFilter) GetBits() []byte {
+	if x != nil {
+		return x.Bits
+	}
+	return nil
+}
+
+func (x *KeyFilter) GetHashCount() uint32 {
+	if x != nil {
+		return x.HashCount
+	}
+	return 0
+}
+
+func (x *KeyFilter) GetKeys() uint64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+var File_key_filter_proto protoreflect.FileDescriptor
+
+var file_key_filter_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x52,
+	0x0a, 0x09, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x69, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_key_filter_proto_rawDescOnce sync.Once
+	file_key_filter_proto_rawDescData = file_key_filter_proto_rawDesc
+)
+
+func file_key_filter_proto_rawDescGZIP() []byte {
+	file_key_filter_proto_rawDescOnce.Do(func() {
+		file_key_filter_proto_rawDescData = protoimpl.X.CompressGZIP(file_key_filter_proto_rawDescData)
+	})
+	return file_key_filter_proto_rawDescData
+}
+
+var file_key_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_key_filter_proto_goTypes = []interface{}{
+	(*KeyFilter)(nil), // 0: blockstore.KeyFilter
+}
+var file_key_filter_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_key_filter_proto_init() }
+func file_key_filter_proto_init() {
+	if File_key_filter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_key_filter_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_key_filter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_key_filter_proto_goTypes,
+		DependencyIndexes: file_key_filter_proto_depIdxs,
+		MessageInfos:      file_key_filter_proto_msgTypes,
+	}.Build()
+	File_key_filter_proto = out.File
+	file_key_filter_proto_rawDesc = nil
+	file_key_filter_proto_goTypes = nil
+	file_key_filter_proto_depIdxs = nil
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeyFilter(t *testing.T) {
+	deltaOf := func(pairs ...[2]string) *types.StateDelta {
+		delta := &types.StateDelta{}
+		for _, p := range pairs {
+			delta.Keys = append(delta.Keys, &types.KeyStateDelta{DbName: p[0], Key: p[1]})
+		}
+		return delta
+	}
+
+	t.Run("the filter depends on the set of keys alone", func(t *testing.T) {
+		f1 := NewKeyFilter(deltaOf([2]string{"db1", "key1"}, [2]string{"db2", "key2"}))
+		f2 := NewKeyFilter(deltaOf([2]string{"db2", "key2"}, [2]string{"db1", "key1"}, [2]string{"db1", "key1"}))
+		require.True(t, proto.Equal(f1, f2), "expected %v, actual %v", f1, f2)
+		require.Equal(t, uint64(2), f1.Keys)
+		require.Len(t, f1.Bits, keyFilterMinBits/8)
+
+		require.True(t, f1.MayContain("db1", "key1"))
+		require.True(t, f1.MayContain("db2", "key2"))
+		// the name of the database is not mistaken for a prefix of the key
+		require.False(t, f1.MayContain("db", "1key1"))
+	})
+
+	t.Run("an empty filter matches no key", func(t *testing.T) {
+		f := NewKeyFilter(deltaOf())
+		require.Equal(t, uint64(0), f.Keys)
+		require.Len(t, f.Bits, keyFilterMinBits/8)
+		require.False(t, f.MayContain("db1", "key1"))
+		require.Equal(t, float64(0), f.FalsePositiveProbability())
+
+		// a filter without bits, by contrast, excludes no key
+		require.True(t, (&KeyFilter{}).MayContain("db1", "key1"))
+		require.Equal(t, float64(1), (&KeyFilter{}).FalsePositiveProbability())
+	})
+
+	t.Run("no false negative and a bounded false positive rate on a synthetic corpus", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		totalBlocks := 500
+		filters := make([]*KeyFilter, totalBlocks)
+		var estimated float64
+		for i := range filters {
+			delta := &types.StateDelta{}
+			for j := 0; j < 1+rnd.Intn(200); j++ {
+				delta.Keys = append(delta.Keys, &types.KeyStateDelta{
+					DbName: fmt.Sprintf("db%d", rnd.Intn(4)),
+					Key:    fmt.Sprintf("key-%d-%d", i, j),
+				})
+			}
+			filters[i] = NewKeyFilter(delta)
+			estimated += filters[i].FalsePositiveProbability()
+
+			for _, k := range delta.Keys {
+				require.True(t, filters[i].MayContain(k.DbName, k.Key), "block %d, db %s, key %s", i, k.DbName, k.Key)
+			}
+		}
+		estimated /= float64(totalBlocks)
+
+		falsePositives, probes := 0, 0
+		for i := 0; i < 20000; i++ {
+			f := filters[i%totalBlocks]
+			// the keys of the corpus are never suffixed with a letter
+			if f.MayContain(fmt.Sprintf("db%d", i%4), fmt.Sprintf("key-%d-%dx", i, i)) {
+				falsePositives++
+			}
+			probes++
+		}
+		rate := float64(falsePositives) / float64(probes)
+		t.Logf("false positive rate %.4f, estimated %.4f", rate, estimated)
+		require.Less(t, estimated, 0.02)
+		require.Less(t, rate, 0.02)
+		require.InDelta(t, estimated, rate, 0.005)
+	})
+}
+
+func TestGetKeyBlockCandidates(t *testing.T) {
+	env := newTestEnv(t)
+	defer func() {
+		env.cleanup(true)
+	}()
+
+	delta := &types.StateDelta{
+		Keys: []*types.KeyStateDelta{
+			{DbName: "db1", Key: "key1"},
+			{DbName: "db1", Key: "key2", Deleted: true},
+		},
+	}
+	require.EqualError(t, env.s.CommitKeyFilter(1, NewKeyFilter(delta)), "block [1] is not committed, the last committed block is [0]")
+
+	for blockNum := uint64(1); blockNum <= 6; blockNum++ {
+		b := createSampleDataTxBlock(blockNum, nil, nil, 2)
+		require.NoError(t, env.s.AddSkipListLinks(b))
+		require.NoError(t, env.s.Commit(b))
+	}
+
+	// blocks 2 and 5 modify the keys, blocks 1 and 6 have no filter
+	for _, blockNum := range []uint64{2, 5} {
+		require.NoError(t, env.s.CommitKeyFilter(blockNum, NewKeyFilter(delta)))
+	}
+	for _, blockNum := range []uint64{3, 4} {
+		require.NoError(t, env.s.CommitKeyFilter(blockNum, NewKeyFilter(&types.StateDelta{})))
+	}
+
+	filter, err := env.s.GetKeyFilter(1)
+	require.EqualError(t, err, "the key filter of block 1 is not available")
+	require.IsType(t, &errors.NotFoundErr{}, err)
+	require.Nil(t, filter)
+
+	env.closeAndReOpenStore(t)
+
+	filter, err = env.s.GetKeyFilter(2)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(NewKeyFilter(delta), filter))
+
+	unfiltered := func(blockNum uint64) *types.KeyBlockCandidate {
+		return &types.KeyBlockCandidate{BlockNumber: blockNum, FalsePositiveProbability: 1, Unfiltered: true}
+	}
+	filtered := &types.KeyBlockCandidate{FalsePositiveProbability: filter.FalsePositiveProbability()}
+	at := func(blockNum uint64) *types.KeyBlockCandidate {
+		c := proto.Clone(filtered).(*types.KeyBlockCandidate)
+		c.BlockNumber = blockNum
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		start    uint64
+		end      uint64
+		expected []*types.KeyBlockCandidate
+	}{
+		{name: "the whole chain", key: "key2", start: 1, end: 6, expected: []*types.KeyBlockCandidate{unfiltered(1), at(2), at(5), unfiltered(6)}},
+		{name: "filtered blocks", key: "key1", start: 2, end: 5, expected: []*types.KeyBlockCandidate{at(2), at(5)}},
+		{name: "no candidate", key: "key1", start: 3, end: 4},
+		{name: "a single unfiltered block", key: "key1", start: 6, end: 6, expected: []*types.KeyBlockCandidate{unfiltered(6)}},
+		{name: "an unmodified key", key: "key3", start: 1, end: 6, expected: []*types.KeyBlockCandidate{unfiltered(1), unfiltered(6)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates, err := env.s.GetKeyBlockCandidates("db1", tt.key, tt.start, tt.end)
+			require.NoError(t, err)
+			require.Len(t, candidates, len(tt.expected))
+			for i := range tt.expected {
+				require.True(t, proto.Equal(tt.expected[i], candidates[i]), "expected %v, actual %v", tt.expected[i], candidates[i])
+			}
+		})
+	}
+
+	_, err = env.s.GetKeyBlockCandidates("db1", "key1", 5, 7)
+	require.EqualError(t, err, "block not found: 7")
+	require.IsType(t, &errors.NotFoundErr{}, err)
+	_, err = env.s.GetKeyBlockCandidates("db1", "key1", 3, 2)
+	require.EqualError(t, err, "invalid block range [3, 2]")
+}
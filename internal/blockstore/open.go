@@ -3,7 +3,6 @@
 package blockstore
 
 import (
-	"encoding/binary"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -13,6 +12,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/internal/fileops"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
@@ -23,9 +23,11 @@ var (
 	// blocks are stored in an append-only file. As the
 	// the file size could grow significantly in a longer
 	// run, we use file chunks so that it would be easy
-	// to archive chunks to free some storage space
-	chunkPrefix    = "chunk_"
-	chunkSizeLimit = int64(64 * 1024 * 1024)
+	// to archive chunks to free some storage space. A chunk
+	// is rolled over once the next block would take it past
+	// the size limit of the store, see Config.MaxFileSize
+	chunkPrefix           = "chunk_"
+	defaultChunkSizeLimit = int64(64 * 1024 * 1024)
 
 	// block file chunks are stored inside fileChunksDir
 	// while the index to the block file's offset to fetch
@@ -42,6 +44,12 @@ var (
 	// before creating a new store
 	underCreationFlag = "undercreation"
 
+	// indexRebuildCheckpointName is the file which records
+	// the progress of a rebuild of the block index. If it
+	// exists, the last rebuild was interrupted, and it is
+	// resumed on open
+	indexRebuildCheckpointName = "indexrebuild"
+
 	// Namespaces for block header and block hash storage:
 	// number -> header bytes
 	headerBytesNs = []byte{0}
@@ -53,6 +61,14 @@ var (
 	headerBaseHashNs = []byte{3}
 	// number -> block tx ids array
 	blockTxsIDNs = []byte{4}
+	// number -> producer metadata
+	producerMetadataNs = []byte{5}
+	// number -> state delta of the block
+	stateDeltaNs = []byte{6}
+	// number -> composition of the batch of the block
+	batchCompositionNs = []byte{7}
+	// number -> key filter of the block
+	keyFilterNs = []byte{8}
 )
 
 // Store maintains a chain of blocks in an append-only
@@ -62,11 +78,12 @@ type Store struct {
 	currentFileChunk      *os.File
 	currentOffset         int64
 	currentChunkNum       uint64
+	chunkSizeLimit        int64
 	lastCommittedBlockNum uint64
 	blockIndexDB          *leveldb.DB
 	blockHeaderDB         *leveldb.DB
 	txValidationInfoDB    *leveldb.DB
-	reusableBuffer        []byte
+	compressionFlag       byte
 	logger                *logger.SugarLogger
 	mu                    sync.RWMutex
 }
@@ -74,11 +91,66 @@ type Store struct {
 // Config holds the configuration of a block store
 type Config struct {
 	StoreDir string
-	Logger   *logger.SugarLogger
+	// TrustedCheckpointsFile, if set, is the path of a trusted checkpoints file, against which the stored headers are
+	// verified on open
+	TrustedCheckpointsFile string
+	// VerifyChain, if set, verifies the chain of the stored blocks on open, see Store.VerifyChain
+	VerifyChain bool
+	// IndexRebuildWorkers is the number of workers which rebuild the block index from the file chunks on open, if the
+	// block index is missing. Zero means one worker per CPU.
+	IndexRebuildWorkers int
+	// MaxFileSize is the size, in bytes, beyond which a file chunk is not appended to: the block which would take the
+	// current file chunk past it is appended to a new file chunk. A block larger than MaxFileSize takes a file chunk
+	// of its own. The chunks written before a change of the size keep their size. Zero means the default of 64 MiB.
+	MaxFileSize int64
+	// Compression is the compression of the blocks appended to the file chunks. The blocks appended before a change
+	// of the compression are read as they were stored. Empty means snappy.
+	Compression Compression
+	Logger      *logger.SugarLogger
 }
 
-// Open opens the store to maintains a chain of blocks
+// Open opens the store to maintains a chain of blocks. If a trusted checkpoints file is configured, the store is
+// opened only if its headers match the checkpoints, and if the verification of the chain is configured, only if the
+// chain of its blocks is verified.
 func Open(c *Config) (*Store, error) {
+	var checkpoints []*types.TrustedCheckpoint
+	if c.TrustedCheckpointsFile != "" {
+		var err error
+		if checkpoints, err = ReadTrustedCheckpoints(c.TrustedCheckpointsFile); err != nil {
+			return nil, err
+		}
+	}
+
+	s, err := open(c)
+	if err != nil || (checkpoints == nil && !c.VerifyChain) {
+		return s, err
+	}
+
+	if err := s.verifyOnOpen(checkpoints, c.VerifyChain); err != nil {
+		if closeErr := s.Close(); closeErr != nil {
+			c.Logger.Warnf("failed to close the block store: %s", closeErr)
+		}
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) verifyOnOpen(checkpoints []*types.TrustedCheckpoint, verifyChain bool) error {
+	if checkpoints != nil {
+		if err := s.VerifyTrustedCheckpoints(checkpoints); err != nil {
+			return err
+		}
+		s.logger.Infof("verified the block store against %d trusted checkpoints", len(checkpoints))
+	}
+
+	if verifyChain {
+		return s.VerifyChain()
+	}
+	return nil
+}
+
+func open(c *Config) (*Store, error) {
 	exist, err := fileops.Exists(c.StoreDir)
 	if err != nil {
 		return nil, err
@@ -114,6 +186,11 @@ func isExistingStoreCreatedPartially(storeDir string) (bool, error) {
 }
 
 func openNewStore(c *Config) (*Store, error) {
+	compressionFlag, err := c.Compression.recordFlag()
+	if err != nil {
+		return nil, err
+	}
+
 	if err := fileops.CreateDir(c.StoreDir); err != nil {
 		return nil, errors.WithMessagef(err, "error while creating directory [%s]", c.StoreDir)
 	}
@@ -161,16 +238,22 @@ func openNewStore(c *Config) (*Store, error) {
 		currentFileChunk:      file,
 		currentOffset:         0,
 		currentChunkNum:       0,
+		chunkSizeLimit:        chunkSizeLimit(c),
 		lastCommittedBlockNum: 0,
 		blockIndexDB:          indexDB,
 		blockHeaderDB:         headersDB,
 		txValidationInfoDB:    txValidationInfoDB,
-		reusableBuffer:        make([]byte, binary.MaxVarintLen64),
+		compressionFlag:       compressionFlag,
 		logger:                c.Logger,
 	}, nil
 }
 
 func openExistingStore(c *Config) (*Store, error) {
+	compressionFlag, err := c.Compression.recordFlag()
+	if err != nil {
+		return nil, err
+	}
+
 	fileChunksDirPath := filepath.Join(c.StoreDir, fileChunksDirName)
 	blockIndexDBPath := filepath.Join(c.StoreDir, blockIndexDBName)
 	blockHeaderDBPath := filepath.Join(c.StoreDir, blockHeaderDBName)
@@ -186,7 +269,19 @@ func openExistingStore(c *Config) (*Store, error) {
 		return nil, errors.Wrapf(err, "error while getting the metadata of file [%s]", currentFileChunk.Name())
 	}
 
-	indexDB, err := leveldb.OpenFile(blockIndexDBPath, &opt.Options{ErrorIfMissing: true})
+	// a missing block index, e.g., one removed by an operator, is rebuilt from the file chunks
+	checkpointPath := filepath.Join(c.StoreDir, indexRebuildCheckpointName)
+	indexExists, err := fileops.Exists(blockIndexDBPath)
+	if err != nil {
+		return nil, err
+	}
+	rebuildInterrupted, err := fileops.Exists(checkpointPath)
+	if err != nil {
+		return nil, err
+	}
+	rebuildIndex := !indexExists || rebuildInterrupted
+
+	indexDB, err := leveldb.OpenFile(blockIndexDBPath, &opt.Options{ErrorIfMissing: !rebuildIndex})
 	if err != nil {
 		return nil, errors.WithMessage(err, "error while opening the existing leveldb file for the block index")
 	}
@@ -206,15 +301,31 @@ func openExistingStore(c *Config) (*Store, error) {
 		currentFileChunk:   currentFileChunk,
 		currentOffset:      chunkFileInfo.Size(),
 		currentChunkNum:    currentChunkNum,
+		chunkSizeLimit:     chunkSizeLimit(c),
 		blockIndexDB:       indexDB,
 		blockHeaderDB:      headersDB,
 		txValidationInfoDB: txValidationInfoDB,
-		reusableBuffer:     make([]byte, binary.MaxVarintLen64),
+		compressionFlag:    compressionFlag,
 		logger:             c.Logger,
 	}
+	if rebuildIndex {
+		if _, err := s.rebuildBlockIndex(checkpointPath, c.IndexRebuildWorkers); err != nil {
+			if closeErr := s.Close(); closeErr != nil {
+				c.Logger.Warnf("failed to close the block store: %s", closeErr)
+			}
+			return nil, err
+		}
+	}
 	return s, s.recover()
 }
 
+func chunkSizeLimit(c *Config) int64 {
+	if c.MaxFileSize > 0 {
+		return c.MaxFileSize
+	}
+	return defaultChunkSizeLimit
+}
+
 func (s *Store) recover() error {
 	lastBlockNumberInIndex, lastBlockLocation, err := s.getLastBlockLocationInIndex()
 	if err != nil {
@@ -270,12 +381,16 @@ func (s *Store) recover() error {
 		}
 		s.currentOffset = lastBlockLocation.Offset + lastBlockLocation.Length
 
-		block, err := s.Get(lastBlockNumberInIndex)
+		block, err := s.GetForReplay(lastBlockNumberInIndex)
 		if err != nil {
 			return err
 		}
 
-		if err = s.storeMetadataInDB(block, lastBlockLocation); err != nil {
+		txSizes, err := s.indexedTxSizes(block)
+		if err != nil {
+			return err
+		}
+		if err = s.storeMetadataInDB(block, lastBlockLocation, nil, txSizes); err != nil {
 			return err
 		}
 
@@ -297,12 +412,18 @@ func (s *Store) recover() error {
 		}
 
 		location := &BlockLocation{
-			FileChunkNum: nextBlockAndLocation.fileChunkNum,
-			Offset:       nextBlockAndLocation.blockStartOffset,
-			Length:       nextBlockAndLocation.blockEndOffset - nextBlockAndLocation.blockStartOffset,
+			FileChunkNum:  nextBlockAndLocation.fileChunkNum,
+			Offset:        nextBlockAndLocation.blockStartOffset,
+			Length:        nextBlockAndLocation.blockEndOffset - nextBlockAndLocation.blockStartOffset,
+			LogicalLength: nextBlockAndLocation.logicalLength,
+			HeaderOnly:    nextBlockAndLocation.block.GetPayload() == nil,
 		}
 
-		if err = s.storeMetadataInDB(nextBlockAndLocation.block, location); err != nil {
+		txSizes, err := s.indexedTxSizes(nextBlockAndLocation.block)
+		if err != nil {
+			return err
+		}
+		if err = s.storeMetadataInDB(nextBlockAndLocation.block, location, nil, txSizes); err != nil {
 			return err
 		}
 
@@ -343,8 +464,39 @@ func (s *Store) recover() error {
 	return nil
 }
 
+// indexedTxSizes returns the sizes of the transactions of the block which are already recorded in the index of the
+// transactions, so that the block replayed onto the index keeps them. The sizes are only known to the committer, hence,
+// nil is returned unless the sizes of all the transactions of the block were recorded.
+func (s *Store) indexedTxSizes(block *types.Block) ([]*types.TxSizes, error) {
+	txIDs, err := blockTxIDs(block)
+	if err != nil || len(txIDs) == 0 {
+		return nil, err
+	}
+
+	txSizes := make([]*types.TxSizes, 0, len(txIDs))
+	for _, txID := range txIDs {
+		val, err := s.txValidationInfoDB.Get([]byte(txID), nil)
+		if err == leveldb.ErrNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while fetching the info of transaction %s", txID)
+		}
+
+		txInfo := &TxInfo{}
+		if err := proto.Unmarshal(val, txInfo); err != nil {
+			return nil, errors.Wrapf(err, "error while unmarshaling the info of transaction %s", txID)
+		}
+		if txInfo.GetBlockNumber() != block.GetHeader().GetBaseHeader().GetNumber() || txInfo.GetSizes() == nil {
+			return nil, nil
+		}
+		txSizes = append(txSizes, txInfo.GetSizes())
+	}
+	return txSizes, nil
+}
+
 func (s *Store) getLastBlockLocationInIndex() (uint64, *BlockLocation, error) {
-	itr := s.blockIndexDB.NewIterator(&util.Range{}, &opt.ReadOptions{})
+	itr := s.blockIndexDB.NewIterator(&util.Range{Limit: blockLocationKeysLimit}, &opt.ReadOptions{})
 	if err := itr.Error(); err != nil {
 		return 0, nil, errors.Wrap(err, "error while finding the last committed block number in the index")
 	}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
@@ -22,7 +23,7 @@ import (
 )
 
 func TestMain(t *testing.M) {
-	chunkSizeLimit = 4096
+	defaultChunkSizeLimit = 4096
 	os.Exit(t.Run())
 }
 
@@ -218,6 +219,133 @@ func TestOpenStore(t *testing.T) {
 	})
 }
 
+func TestMaxFileSize(t *testing.T) {
+	t.Parallel()
+
+	lc := &logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	}
+	logger, err := logger.New(lc)
+	require.NoError(t, err)
+
+	storeDir, err := ioutil.TempDir("", "maxfilesize")
+	require.NoError(t, err)
+	defer os.RemoveAll(storeDir)
+
+	openStore := func(maxFileSize int64) *Store {
+		s, err := Open(&Config{StoreDir: storeDir, MaxFileSize: maxFileSize, Logger: logger})
+		require.NoError(t, err)
+		return s
+	}
+
+	// every seventh block carries an incompressible value larger than the file chunks
+	rnd := rand.New(rand.NewSource(1))
+	var blocks []*types.Block
+	commit := func(s *Store, count int) {
+		for i := 0; i < count; i++ {
+			blockNum := uint64(len(blocks) + 1)
+			b := createSampleDataTxBlock(blockNum, nil, nil, 1+rnd.Intn(20))
+			if blockNum%7 == 0 {
+				value := make([]byte, 3000)
+				rnd.Read(value)
+				b.GetDataTxEnvelopes().Envelopes[0].Payload.DbOperations = []*types.DBOperation{
+					{DbName: "db1", DataWrites: []*types.DataWrite{{Key: "key1", Value: value}}},
+				}
+			}
+			require.NoError(t, s.AddSkipListLinks(b))
+			require.NoError(t, s.Commit(b))
+			blocks = append(blocks, b)
+		}
+	}
+
+	// requireBlocks checks that the blocks are served across the chunks, and that a chunk exceeds the size it was
+	// written with only to hold a single block
+	requireBlocks := func(s *Store, sizes map[uint64]int64) {
+		height, err := s.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(blocks)), height)
+
+		chunkEnds := make(map[uint64]int64)
+		for _, expected := range blocks {
+			blockNum := expected.GetHeader().GetBaseHeader().GetNumber()
+			block, err := s.Get(blockNum)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(expected, block), "block %d", blockNum)
+
+			hash, err := s.GetHash(blockNum)
+			require.NoError(t, err)
+			expectedHash, err := ComputeBlockHash(expected)
+			require.NoError(t, err)
+			require.Equal(t, expectedHash, hash, "block %d", blockNum)
+
+			location, err := s.getLocation(blockNum)
+			require.NoError(t, err)
+			require.Equal(t, chunkEnds[location.FileChunkNum], location.Offset, "block %d", blockNum)
+			chunkEnds[location.FileChunkNum] = location.Offset + location.Length
+			if location.Offset > 0 {
+				require.Less(t, chunkEnds[location.FileChunkNum], sizes[location.FileChunkNum], "block %d", blockNum)
+			}
+		}
+
+		require.Len(t, chunkEnds, int(s.currentChunkNum)+1)
+		for chunkNum, end := range chunkEnds {
+			info, err := os.Stat(constructBlockFileChunkPath(s.fileChunksDirPath, chunkNum))
+			require.NoError(t, err)
+			require.Equal(t, end, info.Size(), "chunk %d", chunkNum)
+		}
+	}
+
+	sizes := make(map[uint64]int64)
+	setSize := func(s *Store, size int64) {
+		for chunkNum := s.currentChunkNum; chunkNum < s.currentChunkNum+100; chunkNum++ {
+			sizes[chunkNum] = size
+		}
+	}
+
+	s := openStore(2048)
+	require.Equal(t, int64(2048), s.chunkSizeLimit)
+	setSize(s, 2048)
+	commit(s, 50)
+	requireBlocks(s, sizes)
+	require.Greater(t, s.currentChunkNum, uint64(10))
+	require.NoError(t, s.Close())
+
+	// the chunks written before a change of the size keep their size
+	s = openStore(8192)
+	setSize(s, 8192)
+	commit(s, 50)
+	requireBlocks(s, sizes)
+	lastChunkNum := s.currentChunkNum
+	require.NoError(t, s.Close())
+
+	// a block partially written at the end of the last chunk is discarded on open
+	chunkPath := constructBlockFileChunkPath(filepath.Join(storeDir, fileChunksDirName), lastChunkNum)
+	info, err := os.Stat(chunkPath)
+	require.NoError(t, err)
+	f, err := os.OpenFile(chunkPath, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, 1000)
+	_, err = f.Write(append(buf[:n], []byte("partial")...))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s = openStore(8192)
+	defer s.Close()
+	require.Equal(t, lastChunkNum, s.currentChunkNum)
+	require.Equal(t, info.Size(), s.currentOffset)
+	requireBlocks(s, sizes)
+	commit(s, 10)
+	requireBlocks(s, sizes)
+
+	defaultStore := newTestEnv(t)
+	defer defaultStore.cleanup(true)
+	require.Equal(t, defaultChunkSizeLimit, defaultStore.s.chunkSizeLimit)
+}
+
 func TestRecovery(t *testing.T) {
 	// scenario 1:
 	//  - append block 1 to the file and store only the index for block 1
@@ -239,10 +367,11 @@ func TestRecovery(t *testing.T) {
 		blockLocation, err := env.s.appendBlock(1, content)
 		require.NoError(t, err)
 
-		require.NoError(t, env.s.storeIndexForBlock(1, blockLocation))
+		require.NoError(t, env.s.storeIndexForBlock(block, blockLocation))
 		txID := block.GetUserAdministrationTxEnvelope().Payload.TxId
 
 		assertIndexExist(t, env.s, 1, blockLocation)
+		assertTxLocationExist(t, env.s, txID, 1, 0)
 		assertHashDoesNotExist(t, env.s, 1)
 		assertHeaderDoesNotExist(t, env.s, 1)
 		assertValidationInfoDoesNotExist(t, env.s, txID)
@@ -272,6 +401,8 @@ func TestRecovery(t *testing.T) {
 		content := append(buf[:n], encodedBlock...)
 		blockLocation, err := env.s.appendBlock(1, content)
 		require.NoError(t, err)
+		// the recovery records the size of the block in the index
+		blockLocation.LogicalLength = int64(len(b))
 
 		txID := block.GetUserAdministrationTxEnvelope().Payload.TxId
 		assertBlockMetadataDoesNotExist(t, env.s, 1, txID)
@@ -311,6 +442,8 @@ func TestRecovery(t *testing.T) {
 		content := append(buf[:n], encodedBlock...)
 		block2Location, err := env.s.appendBlock(1, content)
 		require.NoError(t, err)
+		// the recovery records the size of the block in the index
+		block2Location.LogicalLength = int64(len(b))
 
 		txID2 := block2.GetUserAdministrationTxEnvelope().Payload.TxId
 		assertBlockMetadataDoesNotExist(t, env.s, 2, txID2)
@@ -375,7 +508,7 @@ func TestRecovery(t *testing.T) {
 	//  - ensure that the partially written block 2 is deleted and the offset is set to 0
 	t.Run("file boundary", func(t *testing.T) {
 		setup := func(s *Store) *types.Block {
-			totalBlocks := uint64(44)
+			totalBlocks := uint64(43)
 			var preBlockBaseHash, preBlockHash []byte
 
 			for blockNumber := uint64(1); blockNumber <= totalBlocks; blockNumber++ {
@@ -403,7 +536,7 @@ func TestRecovery(t *testing.T) {
 			require.FileExists(t, constructBlockFileChunkPath(s.fileChunksDirPath, 1))
 			require.NoFileExists(t, constructBlockFileChunkPath(s.fileChunksDirPath, 2))
 
-			return createSampleUserTxBlock(45, preBlockBaseHash, preBlockHash)
+			return createSampleUserTxBlock(44, preBlockBaseHash, preBlockHash)
 		}
 
 		tests := []struct {
@@ -446,21 +579,23 @@ func TestRecovery(t *testing.T) {
 					require.NoError(t, err)
 
 					txID := block.GetUserAdministrationTxEnvelope().Payload.TxId
-					assertBlockMetadataDoesNotExist(t, env.s, 45, txID)
+					assertBlockMetadataDoesNotExist(t, env.s, 44, txID)
 
 					env.closeAndReOpenStore(t)
 					defer env.cleanup(true)
 
-					assertBlockMetadataDoesNotExist(t, env.s, 45, txID)
+					assertBlockMetadataDoesNotExist(t, env.s, 44, txID)
 					require.NoFileExists(t, constructBlockFileChunkPath(env.s.fileChunksDirPath, 2))
 					return
 				}
 
 				location, err := env.s.appendBlock(1, content)
 				require.NoError(t, err)
+				// the recovery records the size of the block in the index
+				location.LogicalLength = int64(len(b))
 
 				txID := block.GetUserAdministrationTxEnvelope().Payload.TxId
-				assertBlockMetadataDoesNotExist(t, env.s, 45, txID)
+				assertBlockMetadataDoesNotExist(t, env.s, 44, txID)
 
 				env.closeAndReOpenStore(t)
 				defer env.cleanup(true)
@@ -576,6 +711,7 @@ func TestRecovery(t *testing.T) {
 
 func assertBlockMetadataDoesNotExist(t *testing.T, s *Store, blockNum uint64, txID string) {
 	assertIndexDoesNotExist(t, s, blockNum)
+	assertTxLocationDoesNotExist(t, s, txID)
 	assertHashDoesNotExist(t, s, blockNum)
 	assertHeaderDoesNotExist(t, s, blockNum)
 	assertValidationInfoDoesNotExist(t, s, txID)
@@ -588,6 +724,12 @@ func assertIndexDoesNotExist(t *testing.T, s *Store, blockNum uint64) {
 	require.Nil(t, location)
 }
 
+func assertTxLocationDoesNotExist(t *testing.T, s *Store, txID string) {
+	_, _, err := s.GetTxLocation(txID)
+	require.EqualError(t, err, fmt.Sprintf("txID not found: %s", txID))
+	require.IsType(t, &errors.NotFoundErr{}, err)
+}
+
 func assertHashDoesNotExist(t *testing.T, s *Store, blockNum uint64) {
 	baseHeaderHash, err := s.GetBaseHeaderHash(blockNum)
 	require.EqualError(t, err, fmt.Sprintf("block header base hash not found: %d", blockNum))
@@ -623,6 +765,7 @@ func assertBlockMetadataExist(t *testing.T, s *Store, block *types.Block, expect
 	txID := block.GetUserAdministrationTxEnvelope().Payload.TxId
 
 	assertIndexExist(t, s, blockNum, expectedLocation)
+	assertTxLocationExist(t, s, txID, blockNum, 0)
 	assertHashExist(t, s, block)
 	assertHeaderExist(t, s, block)
 	assertValidationInfoExist(t, s, txID, block.Header.ValidationInfo[0])
@@ -634,6 +777,13 @@ func assertIndexExist(t *testing.T, s *Store, blockNum uint64, expectedLocation
 	require.True(t, proto.Equal(expectedLocation, location))
 }
 
+func assertTxLocationExist(t *testing.T, s *Store, txID string, expectedBlockNum uint64, expectedTxIndex int) {
+	blockNum, txIndex, err := s.GetTxLocation(txID)
+	require.NoError(t, err)
+	require.Equal(t, expectedBlockNum, blockNum)
+	require.Equal(t, expectedTxIndex, txIndex)
+}
+
 func assertHashExist(t *testing.T, s *Store, block *types.Block) {
 	blockNum := block.Header.BaseHeader.Number
 
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.15.8
+// source: producer_metadata.proto
+
+package blockstore
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProducerMetadata records how the local node produced the validation results of a block it committed. It is kept
+// next to the block, but it is neither part of the block bytes nor of the block hash.
+type ProducerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// debug_deterministic denotes that the block was validated and committed in the deterministic execution mode,
+	// in which every parallel or batched step runs sequentially
+	DebugDeterministic bool `protobuf:"varint,1,opt,name=debug_deterministic,json=debugDeterministic,proto3" json:"debug_deterministic,omitempty"`
+	// validator_node_id is the node whose signature is header_signature
+	ValidatorNodeId string `protobuf:"bytes,2,opt,name=validator_node_id,json=validatorNodeId,proto3" json:"validator_node_id,omitempty"`
+	// header_signature is the signature of the node over the header of the block, which holds the validation info of
+	// the block. It attests that the node produced, or accepted, the validation results, which the replay of the block
+	// onto a lagging state database then applies without validating the block anew.
+	HeaderSignature []byte `protobuf:"bytes,3,opt,name=header_signature,json=headerSignature,proto3" json:"header_signature,omitempty"`
+}
+
+func (x *ProducerMetadata) Reset() {
+	*x = ProducerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_producer_metadata_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProducerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProducerMetadata) ProtoMessage() {}
+
+func (x *ProducerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_producer_metadata_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProducerMetadata.ProtoReflect.Descriptor instead.
+func (*ProducerMetadata) Descriptor() ([]byte, []int) {
+	return file_producer_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProducerMetadata) GetDebugDeterministic() bool {
+	if x != nil {
+		return x.DebugDeterministic
+	}
+	return false
+}
+
+func (x *ProducerMetadata) GetValidatorNodeId() string {
+	if x != nil {
+		return x.ValidatorNodeId
+	}
+	return ""
+}
+
+func (x *ProducerMetadata) GetHeaderSignature() []byte {
+	if x != nil {
+		return x.HeaderSignature
+	}
+	return nil
+}
+
+var File_producer_metadata_proto protoreflect.FileDescriptor
+
+var file_producer_metadata_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x62, 0x75, 0x67, 0x44, 0x65,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_producer_metadata_proto_rawDescOnce sync.Once
+	file_producer_metadata_proto_rawDescData = file_producer_metadata_proto_rawDesc
+)
+
+func file_producer_metadata_proto_rawDescGZIP() []byte {
+	file_producer_metadata_proto_rawDescOnce.Do(func() {
+		file_producer_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(file_producer_metadata_proto_rawDescData)
+	})
+	return file_producer_metadata_proto_rawDescData
+}
+
+var file_producer_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_producer_metadata_proto_goTypes = []interface{}{
+	(*ProducerMetadata)(nil), // 0: blockstore.ProducerMetadata
+}
+var file_producer_metadata_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_producer_metadata_proto_init() }
+func file_producer_metadata_proto_init() {
+	if File_producer_metadata_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_producer_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProducerMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_producer_metadata_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_producer_metadata_proto_goTypes,
+		DependencyIndexes: file_producer_metadata_proto_depIdxs,
+		MessageInfos:      file_producer_metadata_proto_msgTypes,
+	}.Build()
+	File_producer_metadata_proto = out.File
+	file_producer_metadata_proto_rawDesc = nil
+	file_producer_metadata_proto_goTypes = nil
+	file_producer_metadata_proto_depIdxs = nil
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockstore
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// BlockIterator iterates over a range of stored blocks, in order. The blocks are read sequentially from the file
+// chunks, which are opened once each, instead of looking up and seeking to the location of every block as Get does.
+// An iterator is not safe for concurrent use, and must be closed once done.
+type BlockIterator struct {
+	stream *blockfileStream
+	next   uint64
+	end    uint64
+}
+
+// GetRange returns an iterator over the blocks [start, end]. The end is clamped to the height of the store, hence,
+// the blocks committed while the range is iterated are not part of it. A start beyond the height of the store
+// returns a *RangeStartBeyondHeightError.
+func (s *Store) GetRange(start, end uint64) (*BlockIterator, error) {
+	if start == 0 {
+		return nil, errors.New("the range must start at block 1 or after")
+	}
+	if start > end {
+		return nil, errors.Errorf("the start of the range [%d] cannot be greater than its end [%d]", start, end)
+	}
+
+	s.mu.RLock()
+	height := s.lastCommittedBlockNum
+	if start > height {
+		s.mu.RUnlock()
+		return nil, &RangeStartBeyondHeightError{Start: start, Height: height}
+	}
+	location, err := s.getLocation(start)
+	s.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if end > height {
+		end = height
+	}
+
+	// the stream opens the file chunks on its own, hence, it reads concurrently with the commits, which only append
+	// to the file chunks the blocks beyond the range
+	stream, err := newBlockfileStream(s.logger, s.fileChunksDirPath, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockIterator{
+		stream: stream,
+		next:   start,
+		end:    end,
+	}, nil
+}
+
+// Next returns the next block of the range, or nil once the range is exhausted
+func (it *BlockIterator) Next() (*types.Block, error) {
+	if it.next > it.end {
+		return nil, nil
+	}
+
+	blockWithLocation, err := it.stream.nextBlockWithLocation()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while reading block %d", it.next)
+	}
+	if blockWithLocation == nil {
+		return nil, errors.Errorf("the block files end before block %d", it.next)
+	}
+
+	block := blockWithLocation.block
+	if blockNum := block.GetHeader().GetBaseHeader().GetNumber(); blockNum != it.next {
+		return nil, errors.Errorf("expected block %d in the block files but read block %d", it.next, blockNum)
+	}
+	it.next++
+
+	return block, nil
+}
+
+// End returns the last block of the range, once clamped to the height of the store
+func (it *BlockIterator) End() uint64 {
+	return it.end
+}
+
+// Close releases the file chunk held by the iterator
+func (it *BlockIterator) Close() error {
+	return it.stream.close()
+}
+
+// RangeStartBeyondHeightError is returned by GetRange when the range starts beyond the height of the store
+type RangeStartBeyondHeightError struct {
+	Start  uint64
+	Height uint64
+}
+
+func (e *RangeStartBeyondHeightError) Error() string {
+	return fmt.Sprintf("the start of the range [%d] is beyond the height of the block store [%d]", e.Start, e.Height)
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRange(t *testing.T) {
+	commitBlocks := func(t *testing.T, s *Store, first, last uint64) {
+		for blockNum := first; blockNum <= last; blockNum++ {
+			b := createSampleDataTxBlock(blockNum, nil, nil, 20)
+			require.NoError(t, s.AddSkipListLinks(b))
+			require.NoError(t, s.Commit(b))
+		}
+	}
+
+	readRange := func(t *testing.T, s *Store, start, end uint64) []*types.Block {
+		it, err := s.GetRange(start, end)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, it.Close())
+		}()
+
+		var blocks []*types.Block
+		for {
+			block, err := it.Next()
+			require.NoError(t, err)
+			if block == nil {
+				return blocks
+			}
+			blocks = append(blocks, block)
+		}
+	}
+
+	t.Run("ranges spanning several file chunks", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		commitBlocks(t, env.s, 1, 60)
+		lastLocation, err := env.s.getLocation(60)
+		require.NoError(t, err)
+		require.True(t, lastLocation.FileChunkNum > 1, "chunk: %d", lastLocation.FileChunkNum)
+
+		for _, r := range []struct{ start, end uint64 }{{1, 60}, {1, 1}, {7, 45}, {60, 60}, {30, 100}} {
+			blocks := readRange(t, env.s, r.start, r.end)
+			end := r.end
+			if end > 60 {
+				end = 60
+			}
+			require.Len(t, blocks, int(end-r.start+1))
+			for i, block := range blocks {
+				expected, err := env.s.Get(r.start + uint64(i))
+				require.NoError(t, err)
+				require.True(t, proto.Equal(expected, block))
+			}
+		}
+	})
+
+	t.Run("invalid ranges", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		_, err := env.s.GetRange(1, 1)
+		require.EqualError(t, err, "the start of the range [1] is beyond the height of the block store [0]")
+
+		commitBlocks(t, env.s, 1, 5)
+
+		_, err = env.s.GetRange(4, 3)
+		require.EqualError(t, err, "the start of the range [4] cannot be greater than its end [3]")
+		_, err = env.s.GetRange(0, 3)
+		require.EqualError(t, err, "the range must start at block 1 or after")
+
+		_, err = env.s.GetRange(6, 10)
+		require.Error(t, err)
+		beyondErr, ok := err.(*RangeStartBeyondHeightError)
+		require.True(t, ok)
+		require.Equal(t, &RangeStartBeyondHeightError{Start: 6, Height: 5}, beyondErr)
+	})
+
+	t.Run("a range ending at the height while blocks are committed", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		commitBlocks(t, env.s, 1, 30)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			commitBlocks(t, env.s, 31, 90)
+		}()
+
+		for i := 0; i < 20; i++ {
+			height, err := env.s.Height()
+			require.NoError(t, err)
+
+			it, err := env.s.GetRange(1, height)
+			require.NoError(t, err)
+			require.Equal(t, height, it.End())
+			for blockNum := uint64(1); blockNum <= height; blockNum++ {
+				block, err := it.Next()
+				require.NoError(t, err)
+				require.Equal(t, blockNum, block.GetHeader().GetBaseHeader().GetNumber())
+			}
+			block, err := it.Next()
+			require.NoError(t, err)
+			require.Nil(t, block)
+			require.NoError(t, it.Close())
+		}
+		wg.Wait()
+
+		require.Len(t, readRange(t, env.s, 25, 90), 66)
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// CommitStateDelta stores the state delta of a committed block, i.e., the keys the block wrote and deleted. The
+// deltas of a range of blocks are folded into the net state delta a lagging node applies to catch up, without the
+// payloads of the blocks.
+func (s *Store) CommitStateDelta(blockNumber uint64, delta *types.StateDelta) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if blockNumber == 0 || blockNumber > s.lastCommittedBlockNum {
+		return errors.Errorf("block [%d] is not committed, the last committed block is [%d]", blockNumber, s.lastCommittedBlockNum)
+	}
+
+	deltaBytes, err := proto.Marshal(delta)
+	if err != nil {
+		return errors.Wrapf(err, "can't marshal the state delta of block %d", blockNumber)
+	}
+
+	return s.blockHeaderDB.Put(constructStateDeltaKey(blockNumber), deltaBytes, &opt.WriteOptions{Sync: true})
+}
+
+// GetStateDelta returns the state delta of a block. A NotFoundErr is returned if the delta of the block was not
+// recorded, e.g., because the block was committed while the recording was disabled.
+func (s *Store) GetStateDelta(blockNumber uint64) (*types.StateDelta, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	val, err := s.blockHeaderDB.Get(constructStateDeltaKey(blockNumber), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, &interrors.NotFoundErr{Message: fmt.Sprintf("the state delta of block %d is not available", blockNumber)}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't access the state delta of block %d", blockNumber)
+	}
+
+	delta := &types.StateDelta{}
+	if err := proto.Unmarshal(val, delta); err != nil {
+		return nil, errors.Wrap(err, "error while unmarshalling the state delta")
+	}
+	return delta, nil
+}
+
+func constructStateDeltaKey(blockNum uint64) []byte {
+	return append(stateDeltaNs, encodeOrderPreservingVarUint64(blockNum)...)
+}
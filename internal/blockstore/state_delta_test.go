@@ -0,0 +1,109 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateDelta(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(false)
+
+	delta := &types.StateDelta{
+		StartBlockNum: 1,
+		EndBlockNum:   1,
+		Keys: []*types.KeyStateDelta{
+			{DbName: "db1", Key: "key1", Value: []byte("value1"), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}}},
+			{DbName: "db1", Key: "key2", Deleted: true},
+		},
+	}
+	require.EqualError(t, env.s.CommitStateDelta(1, delta), "block [1] is not committed, the last committed block is [0]")
+
+	b := createSampleDataTxBlock(1, nil, nil, 2)
+	require.NoError(t, env.s.AddSkipListLinks(b))
+	require.NoError(t, env.s.Commit(b))
+
+	actual, err := env.s.GetStateDelta(1)
+	require.EqualError(t, err, "the state delta of block 1 is not available")
+	require.IsType(t, &errors.NotFoundErr{}, err)
+	require.Nil(t, actual)
+
+	require.NoError(t, env.s.CommitStateDelta(1, delta))
+	env.closeAndReOpenStore(t)
+
+	actual, err = env.s.GetStateDelta(1)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(delta, actual), "expected %v, actual %v", delta, actual)
+	require.NoError(t, env.s.Close())
+}
+
+func TestCommitBlockWithoutPayload(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup(false)
+
+	b1 := createSampleDataTxBlock(1, nil, nil, 2)
+	require.NoError(t, env.s.AddSkipListLinks(b1))
+	require.NoError(t, env.s.Commit(b1))
+
+	hash1, err := env.s.GetHash(1)
+	require.NoError(t, err)
+	baseHash1, err := ComputeBlockBaseHash(b1)
+	require.NoError(t, err)
+
+	// a block fast-forwarded by a state sync carries only its header
+	b2 := createSampleDataTxBlock(2, baseHash1, hash1, 2)
+	require.NoError(t, env.s.AddSkipListLinks(b2))
+	b2TxID := b2.GetDataTxEnvelopes().GetEnvelopes()[0].GetPayload().GetTxId()
+	require.NoError(t, env.s.CommitHeader(b2.GetHeader()))
+	b2.Payload = nil
+
+	requireHeaderOnly := func() {
+		height, err := env.s.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), height)
+
+		// the block is neither served nor read in full
+		committed, err := env.s.Get(2)
+		require.EqualError(t, err, "the payload of block [2] is not available, as the block was synced by the state delta of its range")
+		require.IsType(t, &errors.NotFoundErr{}, err)
+		require.Nil(t, committed)
+		raw, err := env.s.GetRaw(2)
+		require.IsType(t, &errors.NotFoundErr{}, err)
+		require.Nil(t, raw)
+
+		committed, err = env.s.GetForReplay(2)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(b2, committed))
+
+		// the block continues the chain, and indexes no transaction
+		header, err := env.s.GetHeader(2)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(b2.GetHeader(), header))
+		augmentedHeader, err := env.s.GetAugmentedHeader(2)
+		require.NoError(t, err)
+		require.Empty(t, augmentedHeader.GetTxIds())
+		_, err = env.s.GetTxInfo(b2TxID)
+		require.EqualError(t, err, "txID not found: "+b2TxID)
+	}
+	requireHeaderOnly()
+
+	env.closeAndReOpenStore(t)
+	requireHeaderOnly()
+
+	// a rebuilt index marks the block as well
+	logger := env.s.logger
+	require.NoError(t, env.s.Close())
+	require.NoError(t, os.RemoveAll(filepath.Join(env.storeDir, blockIndexDBName)))
+	env.s, err = Open(&Config{StoreDir: env.storeDir, Logger: logger})
+	require.NoError(t, err)
+	requireHeaderOnly()
+	require.NoError(t, env.s.Close())
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package blockstore
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var (
+	// The locations of the transactions are stored in the block index, next to the locations of the blocks, so that
+	// both are written in a single batch, which commits the block to the index:
+	// txLocationNs | len(txID) | txID | number | index -> nil
+	// The location is part of the key, hence, the locations of a transaction ID committed more than once are all kept,
+	// ordered by block number and index, and the first one is the first committed. The namespace sorts after the keys
+	// of the locations of the blocks, whose first byte, the length of the block number, is at most 8.
+	txLocationNs = []byte{0x80}
+	// blockLocationKeysLimit bounds the keys of the locations of the blocks in the block index
+	blockLocationKeysLimit = []byte{0x09}
+)
+
+// GetTxLocation returns the number of the block holding the transaction with the given ID, and the index of the
+// transaction in the block. If several committed transactions carry the ID, the location of the first one committed
+// is returned. An ID which no committed transaction carries returns an *errors.NotFoundErr.
+func (s *Store) GetTxLocation(txID string) (uint64, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prefix := txLocationPrefix(txID)
+	itr := s.blockIndexDB.NewIterator(util.BytesPrefix(prefix), nil)
+	defer itr.Release()
+	if itr.First() {
+		blockNum, txIndex, err := decodeTxLocation(itr.Key()[len(prefix):])
+		if err != nil {
+			return 0, 0, errors.WithMessagef(err, "error while decoding the location of txID [%s]", txID)
+		}
+		return blockNum, int(txIndex), nil
+	}
+	if err := itr.Error(); err != nil {
+		return 0, 0, errors.Wrapf(err, "error while fetching the location of txID [%s] from the block index", txID)
+	}
+
+	// the transactions committed before their locations were indexed are located by the index of their validation
+	// info, which holds the last one committed
+	val, err := s.txValidationInfoDB.Get([]byte(txID), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, 0, &interrors.NotFoundErr{Message: fmt.Sprintf("txID not found: %s", txID)}
+	}
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "error while fetching validation info of txID [%s] from the block store", txID)
+	}
+	txInfo := &TxInfo{}
+	if err := proto.Unmarshal(val, txInfo); err != nil {
+		return 0, 0, errors.Wrapf(err, "error while unmarshalling stored validation info of txID [%s]", txID)
+	}
+	return txInfo.GetBlockNumber(), int(txInfo.GetTxIndex()), nil
+}
+
+// addTxLocations adds the locations of the transactions of the block to the batch
+func addTxLocations(batch *leveldb.Batch, block *types.Block) error {
+	txIDs, err := blockTxIDs(block)
+	if err != nil {
+		return err
+	}
+
+	blockNum := block.GetHeader().GetBaseHeader().GetNumber()
+	for txIndex, txID := range txIDs {
+		key := txLocationPrefix(txID)
+		key = append(key, encodeOrderPreservingVarUint64(blockNum)...)
+		key = append(key, encodeOrderPreservingVarUint64(uint64(txIndex))...)
+		batch.Put(key, nil)
+	}
+	return nil
+}
+
+// txLocationPrefix returns the prefix of the keys of the locations of the transaction ID. The ID is prefixed with its
+// length, so that the locations of an ID are not mistaken for the ones of an ID it is a prefix of.
+func txLocationPrefix(txID string) []byte {
+	prefix := make([]byte, len(txLocationNs)+binary.MaxVarintLen64+len(txID))
+	n := copy(prefix, txLocationNs)
+	n += binary.PutUvarint(prefix[n:], uint64(len(txID)))
+	n += copy(prefix[n:], txID)
+	return prefix[:n]
+}
+
+func decodeTxLocation(b []byte) (uint64, uint64, error) {
+	blockNum, n, err := decodeOrderPreservingVarUint64(b)
+	if err != nil {
+		return 0, 0, err
+	}
+	txIndex, m, err := decodeOrderPreservingVarUint64(b[n:])
+	if err != nil {
+		return 0, 0, err
+	}
+	if n+m != len(b) {
+		return 0, 0, errors.Errorf("the location holds %d trailing bytes", len(b)-n-m)
+	}
+	return blockNum, txIndex, nil
+}
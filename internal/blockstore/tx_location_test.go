@@ -0,0 +1,117 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package blockstore
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func TestGetTxLocation(t *testing.T) {
+	// commitWithTxIDs commits a data transaction block whose transactions carry the given IDs
+	commitWithTxIDs := func(t *testing.T, s *Store, blockNum uint64, txIDs ...string) {
+		b := createSampleDataTxBlock(blockNum, nil, nil, len(txIDs))
+		for i, txID := range txIDs {
+			b.GetDataTxEnvelopes().Envelopes[i].Payload.TxId = txID
+		}
+		require.NoError(t, s.AddSkipListLinks(b))
+		require.NoError(t, s.Commit(b))
+	}
+
+	requireLocation := func(t *testing.T, s *Store, txID string, expectedBlockNum uint64, expectedTxIndex int) {
+		blockNum, txIndex, err := s.GetTxLocation(txID)
+		require.NoError(t, err)
+		require.Equal(t, expectedBlockNum, blockNum, txID)
+		require.Equal(t, expectedTxIndex, txIndex, txID)
+	}
+
+	t.Run("the transactions are located in their blocks", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer func() {
+			env.cleanup(true)
+		}()
+
+		for blockNum := uint64(1); blockNum <= 40; blockNum++ {
+			b := createSampleDataTxBlock(blockNum, nil, nil, 5)
+			require.NoError(t, env.s.AddSkipListLinks(b))
+			require.NoError(t, env.s.Commit(b))
+		}
+		require.NoError(t, env.s.Commit(createSampleUserTxBlock(41, nil, nil)))
+
+		requireLocation(t, env.s, "tx-1-0", 1, 0)
+		requireLocation(t, env.s, "tx-17-3", 17, 3)
+		requireLocation(t, env.s, "tx-40-4", 40, 4)
+		requireLocation(t, env.s, "txid-41", 41, 0)
+
+		_, _, err := env.s.GetTxLocation("tx-41-0")
+		require.EqualError(t, err, "txID not found: tx-41-0")
+		require.IsType(t, &errors.NotFoundErr{}, err)
+
+		// the height is found past the locations of the transactions
+		env.closeAndReOpenStore(t)
+		height, err := env.s.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(41), height)
+		requireLocation(t, env.s, "tx-17-3", 17, 3)
+	})
+
+	t.Run("a duplicate transaction ID is located at its first commit", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		commitWithTxIDs(t, env.s, 1, "a", "b")
+		commitWithTxIDs(t, env.s, 2, "c", "b", "c", "a")
+		commitWithTxIDs(t, env.s, 3, "ab", "d")
+
+		requireLocation(t, env.s, "a", 1, 0)
+		requireLocation(t, env.s, "b", 1, 1)
+		requireLocation(t, env.s, "c", 2, 0)
+		requireLocation(t, env.s, "ab", 3, 0)
+		requireLocation(t, env.s, "d", 3, 1)
+
+		// the index of the validation info holds the last commit of a duplicate transaction ID
+		txInfo, err := env.s.GetTxInfo("a")
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), txInfo.BlockNumber)
+	})
+
+	t.Run("the transactions committed before the index are located by their validation info", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		commitWithTxIDs(t, env.s, 1, "a", "b")
+		commitWithTxIDs(t, env.s, 2, "c")
+
+		itr := env.s.blockIndexDB.NewIterator(util.BytesPrefix(txLocationNs), nil)
+		batch := &leveldb.Batch{}
+		for itr.Next() {
+			batch.Delete(append([]byte{}, itr.Key()...))
+		}
+		itr.Release()
+		require.Equal(t, 3, batch.Len())
+		require.NoError(t, env.s.blockIndexDB.Write(batch, nil))
+
+		requireLocation(t, env.s, "b", 1, 1)
+		requireLocation(t, env.s, "c", 2, 0)
+		_, _, err := env.s.GetTxLocation("d")
+		require.IsType(t, &errors.NotFoundErr{}, err)
+	})
+
+	t.Run("a block without payload has no transaction to locate", func(t *testing.T) {
+		env := newTestEnv(t)
+		defer env.cleanup(true)
+
+		b := createSampleDataTxBlock(1, nil, nil, 2)
+		b.Payload = nil
+		b.Header.ValidationInfo = []*types.ValidationInfo{}
+		require.NoError(t, env.s.Commit(b))
+
+		_, _, err := env.s.GetTxLocation("tx-1-0")
+		require.IsType(t, &errors.NotFoundErr{}, err)
+	})
+}
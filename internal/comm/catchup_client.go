@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"mime"
@@ -16,6 +17,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -187,6 +189,101 @@ func (c *catchUpClient) GetBlocks(ctx context.Context, targetID, start, end uint
 	return multipartResponseToBlocks(c.logger, resp)
 }
 
+// GetStateDelta retrieves the headers and the net state delta of the blocks [start, end] from the target
+func (c *catchUpClient) GetStateDelta(ctx context.Context, targetID, start, end uint64) ([]*types.BlockHeader, *types.StateDelta, error) {
+	baseURL := c.getMemberURL(targetID)
+	if baseURL == nil {
+		return nil, nil, errors.Errorf("target ID [%d] not found", targetID)
+	}
+
+	q := make(url.Values)
+	q.Add("start", strconv.FormatUint(start, 10))
+	q.Add("end", strconv.FormatUint(end, 10))
+	url := baseURL.ResolveReference(
+		&url.URL{
+			Path:     GetStateDeltaPath,
+			RawQuery: q.Encode(),
+		},
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Add("Accept", utils.MultiPartFormData)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		eRes := &types.HttpResponseErr{}
+		if err = json.NewDecoder(resp.Body).Decode(eRes); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, eRes
+	}
+
+	headers, delta, err := multipartResponseToStateDelta(c.logger, resp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(headers)) != end-start+1 {
+		return nil, nil, errors.Errorf("expected %d headers, received %d", end-start+1, len(headers))
+	}
+	delta.StartBlockNum = start
+	delta.EndBlockNum = end
+
+	return headers, delta, nil
+}
+
+// GetDigests retrieves the state digests of the given databases as of the given block from the target
+func (c *catchUpClient) GetDigests(ctx context.Context, targetID, blockNum uint64, dbNames []string) (map[string][]byte, error) {
+	baseURL := c.getMemberURL(targetID)
+	if baseURL == nil {
+		return nil, errors.Errorf("target ID [%d] not found", targetID)
+	}
+
+	q := make(url.Values)
+	q.Add("block", strconv.FormatUint(blockNum, 10))
+	for _, dbName := range dbNames {
+		q.Add("db", dbName)
+	}
+	url := baseURL.ResolveReference(
+		&url.URL{
+			Path:     GetDigestsPath,
+			RawQuery: q.Encode(),
+		},
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		eRes := &types.HttpResponseErr{}
+		if err = json.NewDecoder(resp.Body).Decode(eRes); err != nil {
+			return nil, err
+		}
+		return nil, eRes
+	}
+
+	dRes := &DigestsResponse{}
+	if err = json.NewDecoder(resp.Body).Decode(dRes); err != nil {
+		return nil, err
+	}
+
+	return dRes.Digests, nil
+}
+
 func (c *catchUpClient) GetHeight(ctx context.Context, targetID uint64) (uint64, error) {
 	baseURL := c.getMemberURL(targetID)
 	if baseURL == nil {
@@ -280,3 +377,59 @@ func multipartResponseToBlocks(lg *logger.SugarLogger, resp *http.Response) ([]*
 
 	return blocks, nil
 }
+
+func multipartResponseToStateDelta(lg *logger.SugarLogger, resp *http.Response) ([]*types.BlockHeader, *types.StateDelta, error) {
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse Content-Type header")
+	}
+	if mediaType != utils.MultiPartFormData {
+		return nil, nil, errors.Errorf("unexpected Content-Type: [%s], expected %s", mediaType, utils.MultiPartFormData)
+	}
+	boundary, ok := params["boundary"]
+	if !ok {
+		return nil, nil, errors.Errorf("%s boundary not found", utils.MultiPartFormData)
+	}
+
+	// unlike blocks, a partial delta cannot be used, hence any error while reading the parts fails the response
+	mr := multipart.NewReader(resp.Body, boundary)
+	var headers []*types.BlockHeader
+	delta := &types.StateDelta{}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to read the next part")
+		}
+
+		partBytes, err := ioutil.ReadAll(part)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to read part: %s", part.FormName())
+		}
+
+		switch kind := strings.SplitN(part.FormName(), "-", 2)[0]; kind {
+		case stateDeltaHeaderPart:
+			if len(delta.Keys) > 0 {
+				return nil, nil, errors.Errorf("unexpected header after the keys, part name: %s", part.FormName())
+			}
+			header := &types.BlockHeader{}
+			if err := proto.Unmarshal(partBytes, header); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to unmarshal header, part name: %s", part.FormName())
+			}
+			headers = append(headers, header)
+		case stateDeltaKeyPart:
+			key := &types.KeyStateDelta{}
+			if err := proto.Unmarshal(partBytes, key); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to unmarshal key, part name: %s", part.FormName())
+			}
+			delta.Keys = append(delta.Keys, key)
+		default:
+			return nil, nil, errors.Errorf("unexpected part: %s", part.FormName())
+		}
+	}
+
+	lg.Debugf("num headers: %d, num keys: %d", len(headers), len(delta.Keys))
+	return headers, delta, nil
+}
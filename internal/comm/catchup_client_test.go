@@ -167,6 +167,158 @@ func TestCatchUpClient_GetBlocks(t *testing.T) {
 	})
 }
 
+func TestCatchUpClient_GetStateDelta(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	localConfigs, sharedConfig := newTestSetup(t, 2)
+
+	source := &memStateDeltaSource{
+		keys: []*types.KeyStateDelta{
+			{DbName: "db1", Key: "key1", Value: []byte("value1"), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 3}}},
+			{DbName: "db1", Key: "key2", Value: []byte("value2"), Deleted: true},
+			{DbName: "db2", Key: "key1", Value: []byte("value3"), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 4}}},
+		},
+	}
+	ledger := &memLedger{}
+	for n := uint64(1); n <= 5; n++ {
+		ledger.Append(&types.Block{Header: &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: n}}})
+	}
+	tr1, err := comm.NewHTTPTransport(&comm.Config{
+		LocalConf:        localConfigs[0],
+		Logger:           lg,
+		LedgerReader:     ledger,
+		StateDeltaSource: source,
+	})
+	require.NoError(t, err)
+	require.NoError(t, tr1.SetConsensusListener(&mocks.ConsensusListener{}))
+	require.NoError(t, tr1.SetClusterConfig(sharedConfig))
+	require.NoError(t, tr1.Start())
+	defer tr1.Close()
+
+	tr2, _, err := startTransportWithLedger(t, lg, localConfigs, sharedConfig, 1, 5)
+	require.NoError(t, err)
+	defer tr2.Close()
+
+	cc := comm.NewCatchUpClient(lg, nil)
+	require.NotNil(t, cc)
+	err = cc.UpdateMembers(sharedConfig.ConsensusConfig.Members)
+	require.NoError(t, err)
+
+	t.Run("headers and delta", func(t *testing.T) {
+		headers, delta, err := cc.GetStateDelta(context.Background(), 1, 2, 4)
+		require.NoError(t, err)
+		require.Len(t, headers, 3)
+		for i, header := range headers {
+			require.Equal(t, uint64(2+i), header.GetBaseHeader().GetNumber())
+		}
+		expected := &types.StateDelta{StartBlockNum: 2, EndBlockNum: 4, Keys: source.keys}
+		require.True(t, proto.Equal(expected, delta), "expected: %s, actual: %s", expected, delta)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		_, _, err := cc.GetStateDelta(context.Background(), 1, 2, 6)
+		require.EqualError(t, err, "requested endId [6] is out of range, height is [5]")
+	})
+
+	t.Run("state deltas are not recorded", func(t *testing.T) {
+		_, _, err := cc.GetStateDelta(context.Background(), 2, 2, 4)
+		require.Error(t, err)
+	})
+}
+
+// memStateDeltaSource serves the same state delta for every range of blocks
+type memStateDeltaSource struct {
+	keys []*types.KeyStateDelta
+}
+
+func (s *memStateDeltaSource) GetHeaders(start, end uint64) ([]*types.BlockHeader, error) {
+	var headers []*types.BlockHeader
+	for n := start; n <= end; n++ {
+		headers = append(headers, &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: n}})
+	}
+	return headers, nil
+}
+
+func (s *memStateDeltaSource) NetStateDelta(start, end uint64) (*types.StateDelta, error) {
+	return &types.StateDelta{StartBlockNum: start, EndBlockNum: end, Keys: s.keys}, nil
+}
+
+func TestCatchUpClient_GetDigests(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	localConfigs, sharedConfig := newTestSetup(t, 2)
+
+	source := &memDigestSource{
+		height:  5,
+		digests: map[string][]byte{"db1": []byte("digest-of-db1"), "db2": []byte("digest-of-db2")},
+	}
+	tr1, err := comm.NewHTTPTransport(&comm.Config{
+		LocalConf:    localConfigs[0],
+		Logger:       lg,
+		LedgerReader: &memLedger{},
+		DigestSource: source,
+	})
+	require.NoError(t, err)
+	require.NoError(t, tr1.SetConsensusListener(&mocks.ConsensusListener{}))
+	require.NoError(t, tr1.SetClusterConfig(sharedConfig))
+	require.NoError(t, tr1.Start())
+	defer tr1.Close()
+
+	tr2, _, err := startTransportWithLedger(t, lg, localConfigs, sharedConfig, 1, 5)
+	require.NoError(t, err)
+	defer tr2.Close()
+
+	t.Run("digests", func(t *testing.T) {
+		digests, err := tr2.PullDigests(context.Background(), "node1", 4, []string{"db1", "db2"})
+		require.NoError(t, err)
+		require.Equal(t, source.digests, digests)
+		require.Equal(t, uint64(4), source.blockNum)
+	})
+
+	t.Run("above the height", func(t *testing.T) {
+		_, err := tr2.PullDigests(context.Background(), "node1", 6, []string{"db1"})
+		require.EqualError(t, err, "requested block [6] is above the height of the state database [5]")
+	})
+
+	t.Run("digests are not served", func(t *testing.T) {
+		_, err := tr1.PullDigests(context.Background(), "node2", 4, []string{"db1"})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		_, err := tr2.PullDigests(context.Background(), "node3", 4, []string{"db1"})
+		require.Error(t, err)
+	})
+}
+
+// memDigestSource serves the same state digests for every block up to its height
+type memDigestSource struct {
+	height   uint64
+	digests  map[string][]byte
+	blockNum uint64
+}
+
+func (s *memDigestSource) Height() (uint64, error) {
+	return s.height, nil
+}
+
+func (s *memDigestSource) GetDigest(dbName string, blockNumber uint64) ([]byte, uint64, error) {
+	s.blockNum = blockNumber
+	return s.digests[dbName], blockNumber, nil
+}
+
 func TestCatchUpClient_PullBlocks(t *testing.T) {
 	lg, err := logger.New(&logger.Config{
 		Level:         "info",
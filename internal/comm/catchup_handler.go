@@ -19,8 +19,15 @@ const (
 	BCDBPeerEndpoint = "/bcdb-peer/"
 	GetBlocksPath    = BCDBPeerEndpoint + "blocks"
 	GetHeightPath    = BCDBPeerEndpoint + "height"
+	// GetStateDeltaPath serves the block headers and the net state delta of a range of blocks
+	GetStateDeltaPath = BCDBPeerEndpoint + "state-delta"
+	// GetDigestsPath serves the state digests of databases as of a block
+	GetDigestsPath = BCDBPeerEndpoint + "digests"
 
 	maxResponseBytesDefault = 100 * 1024 * 1024 // protects the server against huge requests from a client
+
+	stateDeltaHeaderPart = "header"
+	stateDeltaKeyPart    = "key"
 )
 
 //go:generate counterfeiter -o mocks/ledger_reader.go --fake-name LedgerReader . LedgerReader
@@ -28,6 +35,23 @@ const (
 type LedgerReader interface {
 	Height() (uint64, error)
 	Get(blockNumber uint64) (*types.Block, error)
+	// GetRaw returns the marshaled block as stored in the ledger, so that it can be served without re-marshaling.
+	GetRaw(blockNumber uint64) ([]byte, error)
+}
+
+// StateDeltaSource provides the block headers and the net state delta of a range of committed blocks
+type StateDeltaSource interface {
+	GetHeaders(start, end uint64) ([]*types.BlockHeader, error)
+	NetStateDelta(start, end uint64) (*types.StateDelta, error)
+}
+
+// DigestSource provides the state digests of the databases, as recorded by the committed blocks
+type DigestSource interface {
+	// Height returns the number of the last block committed to the state database
+	Height() (uint64, error)
+	// GetDigest returns the state digest of the database as of the given block, along with the number of the block
+	// that recorded it
+	GetDigest(dbName string, blockNumber uint64) ([]byte, uint64, error)
 }
 
 type catchupHandler struct {
@@ -35,6 +59,8 @@ type catchupHandler struct {
 	lg               *logger.SugarLogger
 	ledgerReader     LedgerReader
 	maxResponseBytes int
+	stateDeltaSource StateDeltaSource
+	digestSource     DigestSource
 }
 
 func NewCatchupHandler(lg *logger.SugarLogger, ledgerReader LedgerReader, maxResponseBytes int) *catchupHandler {
@@ -55,6 +81,19 @@ func NewCatchupHandler(lg *logger.SugarLogger, ledgerReader LedgerReader, maxRes
 	return h
 }
 
+// serveStateDeltas serves the state deltas provided by the source, which is only available on a node that records
+// the state deltas of its blocks.
+func (h *catchupHandler) serveStateDeltas(source StateDeltaSource) {
+	h.stateDeltaSource = source
+	h.router.HandleFunc(GetStateDeltaPath, h.stateDeltaRequest).Methods(http.MethodGet).Headers("Accept", "multipart/form-data").Queries("start", "{startId:[0-9]+}", "end", "{endId:[0-9]+}")
+}
+
+// serveDigests serves the state digests provided by the source.
+func (h *catchupHandler) serveDigests(source DigestSource) {
+	h.digestSource = source
+	h.router.HandleFunc(GetDigestsPath, h.digestsRequest).Methods(http.MethodGet).Queries("block", "{blockId:[0-9]+}")
+}
+
 func (h *catchupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.lg.Debugf("request: %s", r.URL)
 	h.router.ServeHTTP(w, r)
@@ -90,13 +129,7 @@ func (h *catchupHandler) blocksRequest(response http.ResponseWriter, request *ht
 		if i > height {
 			break
 		}
-		block, err := h.ledgerReader.Get(i)
-		if err != nil {
-			utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
-			return
-
-		}
-		blockBytes, err := proto.Marshal(block)
+		blockBytes, err := h.ledgerReader.GetRaw(i)
 		if err != nil {
 			utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
 			return
@@ -139,6 +172,126 @@ func sendHTTPMultiPartResponse(w http.ResponseWriter, blocks [][]byte, startBloc
 	}
 }
 
+// stateDeltaRequest streams the headers of the requested blocks, one part each, followed by the net state delta of
+// the blocks, one part per key.
+func (h *catchupHandler) stateDeltaRequest(response http.ResponseWriter, request *http.Request) {
+	params := mux.Vars(request)
+	startBlockNum, endBlockNum, err := utils.GetStartAndEndBlockNum(params)
+	if err != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	height, err := h.ledgerReader.Height()
+	if err != nil {
+		utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	if startBlockNum < 1 {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: fmt.Sprintf("requested startId [%d] must be greater than 0", startBlockNum)})
+		return
+	}
+	if endBlockNum > height {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: fmt.Sprintf("requested endId [%d] is out of range, height is [%d]", endBlockNum, height)})
+		return
+	}
+
+	headers, err := h.stateDeltaSource.GetHeaders(startBlockNum, endBlockNum)
+	if err != nil {
+		utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+	delta, err := h.stateDeltaSource.NetStateDelta(startBlockNum, endBlockNum)
+	if err != nil {
+		utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	var parts [][]byte
+	for _, header := range headers {
+		headerBytes, err := proto.Marshal(header)
+		if err != nil {
+			utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+			return
+		}
+		parts = append(parts, headerBytes)
+	}
+	for _, key := range delta.GetKeys() {
+		keyBytes, err := proto.Marshal(key)
+		if err != nil {
+			utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+			return
+		}
+		parts = append(parts, keyBytes)
+	}
+
+	h.lg.Debugf("serving the state delta of blocks [%d, %d]: %d keys", startBlockNum, endBlockNum, len(delta.GetKeys()))
+	sendStateDeltaMultiPartResponse(response, parts, len(headers))
+}
+
+func sendStateDeltaMultiPartResponse(w http.ResponseWriter, parts [][]byte, numHeaders int) {
+	mw := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", mw.FormDataContentType())
+	for i, partBytes := range parts {
+		formName := fmt.Sprintf("%s-%d", stateDeltaHeaderPart, i)
+		if i >= numHeaders {
+			formName = fmt.Sprintf("%s-%d", stateDeltaKeyPart, i-numHeaders)
+		}
+		fw, err := mw.CreateFormField(formName)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+			return
+		}
+		if _, err := fw.Write(partBytes); err != nil {
+			utils.SendHTTPResponse(w, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+			return
+		}
+	}
+	if err := mw.Close(); err != nil {
+		utils.SendHTTPResponse(w, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+}
+
+// DigestsResponse holds the state digests of databases as of a block, by the name of the database
+type DigestsResponse struct {
+	Digests map[string][]byte
+}
+
+// digestsRequest serves the state digests of the requested databases, given by the repeated "db" query parameter, as
+// of the requested block. A block above the height of the state database is refused, as its digests may not all be
+// recorded yet.
+func (h *catchupHandler) digestsRequest(w http.ResponseWriter, r *http.Request) {
+	blockNum, paramErr := utils.GetUintParam("blockId", mux.Vars(r))
+	if paramErr != nil {
+		utils.SendHTTPResponse(w, http.StatusBadRequest, paramErr)
+		return
+	}
+
+	height, err := h.digestSource.Height()
+	if err != nil {
+		utils.SendHTTPResponse(w, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+	if blockNum > height {
+		utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: fmt.Sprintf("requested block [%d] is above the height of the state database [%d]", blockNum, height)})
+		return
+	}
+
+	resp := DigestsResponse{Digests: make(map[string][]byte)}
+	for _, dbName := range r.URL.Query()["db"] {
+		digest, _, err := h.digestSource.GetDigest(dbName, blockNum)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+			return
+		}
+		resp.Digests[dbName] = digest
+	}
+
+	utils.SendHTTPResponse(w, http.StatusOK, resp)
+}
+
 type HeightResponse struct {
 	Height uint64
 }
@@ -247,6 +247,58 @@ func TestCatchupHandler_ServeHTTP_Blocks(t *testing.T) {
 		}
 		require.Equal(t, uint64(6), bNum)
 	})
+
+	t.Run("valid: stored bytes are served as is", func(t *testing.T) {
+		ledgerReader := &mocks.LedgerReader{}
+		ledgerReader.HeightReturns(3, nil)
+		ledgerReader.GetRawCalls(func(blockNum uint64) ([]byte, error) {
+			return []byte(fmt.Sprintf("stored-block-%d", blockNum)), nil
+		})
+		h := comm.NewCatchupHandler(lg, ledgerReader, 0)
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, comm.GetBlocksPath, nil)
+		q := req.URL.Query()
+		q.Add("start", "2")
+		q.Add("end", "3")
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Accept", utils.MultiPartFormData)
+
+		h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Result().StatusCode)
+
+		_, params, err := mime.ParseMediaType(resp.Result().Header.Get("Content-Type"))
+		require.NoError(t, err)
+		mr := multipart.NewReader(resp.Result().Body, params["boundary"])
+		bNum := uint64(2)
+		for part, errP := mr.NextPart(); errP == nil; part, errP = mr.NextPart() {
+			blockBytes, err := ioutil.ReadAll(part)
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("stored-block-%d", bNum)), blockBytes)
+			bNum++
+		}
+		require.Equal(t, uint64(4), bNum)
+		require.Equal(t, 2, ledgerReader.GetRawCallCount())
+		require.Equal(t, 0, ledgerReader.GetCallCount())
+	})
+
+	t.Run("error: stored block cannot be read", func(t *testing.T) {
+		ledgerReader := &mocks.LedgerReader{}
+		ledgerReader.HeightReturns(3, nil)
+		ledgerReader.GetRawReturns(nil, errors.New("oops"))
+		h := comm.NewCatchupHandler(lg, ledgerReader, 0)
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, comm.GetBlocksPath, nil)
+		q := req.URL.Query()
+		q.Add("start", "2")
+		q.Add("end", "3")
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set("Accept", utils.MultiPartFormData)
+
+		h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusInternalServerError, resp.Result().StatusCode)
+	})
 }
 
 func TestCatchupHandler_ServeHTTP_LargeResponse(t *testing.T) {
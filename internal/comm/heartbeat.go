@@ -0,0 +1,105 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package comm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	SubmitHeartbeatPath = BCDBPeerEndpoint + "heartbeat"
+
+	maxHeartbeatRequestBytes = 64 * 1024 // a heartbeat is small, this protects the server against huge requests
+)
+
+// HeartbeatListener receives the heartbeats which the other nodes forward to the leader.
+type HeartbeatListener interface {
+	SubmitHeartbeat(env *types.HeartbeatTxEnvelope) error
+}
+
+type heartbeatHandler struct {
+	lg       *logger.SugarLogger
+	listener HeartbeatListener
+}
+
+func (h *heartbeatHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		utils.SendHTTPResponse(w, http.StatusMethodNotAllowed, &types.HttpResponseErr{ErrMsg: "method not allowed: " + r.Method})
+		return
+	}
+
+	if h.listener == nil {
+		utils.SendHTTPResponse(w, http.StatusServiceUnavailable, &types.HttpResponseErr{ErrMsg: "heartbeats are not accepted by this node"})
+		return
+	}
+
+	envBytes, err := io.ReadAll(io.LimitReader(r.Body, maxHeartbeatRequestBytes+1))
+	if err != nil {
+		utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+	if len(envBytes) > maxHeartbeatRequestBytes {
+		utils.SendHTTPResponse(w, http.StatusRequestEntityTooLarge, &types.HttpResponseErr{ErrMsg: "heartbeat exceeds the maximal size"})
+		return
+	}
+
+	env := &types.HeartbeatTxEnvelope{}
+	if err := proto.Unmarshal(envBytes, env); err != nil {
+		utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	h.lg.Debugf("received heartbeat from node [%s]", env.GetPayload().GetNodeId())
+	if err := h.listener.SubmitHeartbeat(env); err != nil {
+		utils.SendHTTPResponse(w, http.StatusServiceUnavailable, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// SendHeartbeat forwards a heartbeat to the member with the given Raft ID, which is expected to be the leader.
+func (c *catchUpClient) SendHeartbeat(ctx context.Context, targetID uint64, env *types.HeartbeatTxEnvelope) error {
+	baseURL := c.getMemberURL(targetID)
+	if baseURL == nil {
+		return errors.Errorf("target ID [%d] not found", targetID)
+	}
+
+	envBytes, err := proto.Marshal(env)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal heartbeat")
+	}
+
+	url := baseURL.ResolveReference(&url.URL{Path: SubmitHeartbeatPath})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), bytes.NewReader(envBytes))
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		eRes := &types.HttpResponseErr{}
+		if err = json.NewDecoder(resp.Body).Decode(eRes); err != nil {
+			return err
+		}
+		return eRes
+	}
+
+	return nil
+}
@@ -39,13 +39,13 @@ type ConsensusListener interface {
 // catch-up (i.e. state transfer).
 //
 // The HTTPTransport is operated in the following way:
-// - Create a *HTTPTransport with NewHTTPTransport;
-// - Set an initial cluster configuration with SetClusterConfig;
-// - Register a listener to receive incoming messages with SetConsensusListener; and finally,
-// - Start the component with Start. An HTTP server start serving requests, messages can now be sent and received.
-// - Configuration changes to the cluster's peers - adding a peer, removing a peer, or changing a peer's endpoints -
-//   are applied using UpdatePeers.
-// - To stop the component call Close,
+//   - Create a *HTTPTransport with NewHTTPTransport;
+//   - Set an initial cluster configuration with SetClusterConfig;
+//   - Register a listener to receive incoming messages with SetConsensusListener; and finally,
+//   - Start the component with Start. An HTTP server start serving requests, messages can now be sent and received.
+//   - Configuration changes to the cluster's peers - adding a peer, removing a peer, or changing a peer's endpoints -
+//     are applied using UpdatePeers.
+//   - To stop the component call Close,
 //
 // The component is thread safe.
 type HTTPTransport struct {
@@ -57,13 +57,14 @@ type HTTPTransport struct {
 
 	raftID uint64
 
-	tlsInfo         transport.TLSInfo //for use as a rafthttp client
-	tlsServerConfig *tls.Config       //for use as a server
-	tlsClientConfig *tls.Config       //for use as a catchup client
-	transport       *rafthttp.Transport
-	catchUpClient   *catchUpClient
-	catchupHandler  *catchupHandler
-	httpServer      *http.Server
+	tlsInfo          transport.TLSInfo //for use as a rafthttp client
+	tlsServerConfig  *tls.Config       //for use as a server
+	tlsClientConfig  *tls.Config       //for use as a catchup client
+	transport        *rafthttp.Transport
+	catchUpClient    *catchUpClient
+	catchupHandler   *catchupHandler
+	heartbeatHandler *heartbeatHandler
+	httpServer       *http.Server
 
 	stopCh chan struct{} // signals HTTPTransport to shut-down
 	doneCh chan struct{} // signals HTTPTransport shutdown complete
@@ -75,6 +76,10 @@ type Config struct {
 	LocalConf    *config.LocalConfiguration
 	Logger       *logger.SugarLogger
 	LedgerReader LedgerReader
+	// StateDeltaSource, if set, serves the net state deltas of block ranges to the peers
+	StateDeltaSource StateDeltaSource
+	// DigestSource, if set, serves the state digests of the databases to the peers
+	DigestSource DigestSource
 }
 
 // NewHTTPTransport creates a new instance of HTTPTransport.
@@ -84,12 +89,19 @@ func NewHTTPTransport(config *Config) (*HTTPTransport, error) {
 	}
 
 	tr := &HTTPTransport{
-		logger:         config.Logger,
-		localConf:      config.LocalConf,
-		catchUpClient:  NewCatchUpClient(config.Logger, nil),
-		catchupHandler: NewCatchupHandler(config.Logger, config.LedgerReader, 0), //TODO make max-response-bytes configurable
-		stopCh:         make(chan struct{}),
-		doneCh:         make(chan struct{}),
+		logger:           config.Logger,
+		localConf:        config.LocalConf,
+		catchUpClient:    NewCatchUpClient(config.Logger, nil),
+		catchupHandler:   NewCatchupHandler(config.Logger, config.LedgerReader, 0), //TODO make max-response-bytes configurable
+		heartbeatHandler: &heartbeatHandler{lg: config.Logger},
+		stopCh:           make(chan struct{}),
+		doneCh:           make(chan struct{}),
+	}
+	if config.StateDeltaSource != nil {
+		tr.catchupHandler.serveStateDeltas(config.StateDeltaSource)
+	}
+	if config.DigestSource != nil {
+		tr.catchupHandler.serveDigests(config.DigestSource)
 	}
 
 	if config.LocalConf.Replication.TLS.Enabled {
@@ -180,6 +192,22 @@ func (p *HTTPTransport) SetConsensusListener(l ConsensusListener) error {
 	return nil
 }
 
+// SetHeartbeatListener sets the listener which receives the heartbeats that the other nodes forward to this node
+// while it is the leader. Without a listener, forwarded heartbeats are rejected.
+//
+// This must be called before the call to Start().
+func (p *HTTPTransport) SetHeartbeatListener(l HeartbeatListener) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.heartbeatHandler.listener != nil {
+		return errors.New("HeartbeatListener already set")
+	}
+	p.heartbeatHandler.listener = l
+
+	return nil
+}
+
 // SetClusterConfig sets the initial types.ClusterConfig into the HTTPTransport for the first time.
 // In this invocation the  HTTPTransport detects what is its local RaftID by collating its local ID (string) with
 // the member set in the ClusterConfig.
@@ -263,6 +291,7 @@ func (p *HTTPTransport) Start() error {
 	mux.Handle(rafthttp.RaftPrefix, raftHandler)     // match "/raft"
 	mux.Handle(rafthttp.RaftPrefix+"/", raftHandler) // match the stream, snapshot, and probing URLs
 	mux.Handle(BCDBPeerEndpoint, p.catchupHandler)
+	mux.Handle(SubmitHeartbeatPath, p.heartbeatHandler)
 
 	p.httpServer = &http.Server{
 		Handler:   mux,
@@ -390,6 +419,31 @@ func (p *HTTPTransport) PullBlocks(ctx context.Context, startBlock, endBlock, le
 	return p.catchUpClient.PullBlocks(ctx, startBlock, endBlock, leaderID)
 }
 
+// PullStateDelta retrieves the headers and the net state delta of the blocks [startBlock, endBlock] from a member,
+// identified by its Raft ID, which must record the state deltas of its blocks. The delta is not verified; the caller
+// verifies it against the headers when it applies it.
+func (p *HTTPTransport) PullStateDelta(ctx context.Context, memberID, startBlock, endBlock uint64) ([]*types.BlockHeader, *types.StateDelta, error) {
+	return p.catchUpClient.GetStateDelta(ctx, memberID, startBlock, endBlock)
+}
+
+// PullDigests retrieves the state digests of the given databases as of the given block from a member, identified by
+// its node ID, which must serve them.
+func (p *HTTPTransport) PullDigests(ctx context.Context, nodeID string, blockNum uint64, dbNames []string) (map[string][]byte, error) {
+	p.mutex.Lock()
+	memberID, err := MemberRaftID(nodeID, p.clusterConfig)
+	p.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.catchUpClient.GetDigests(ctx, memberID, blockNum, dbNames)
+}
+
+// SendHeartbeat forwards a heartbeat of this node to the leader, identified by its Raft ID.
+func (p *HTTPTransport) SendHeartbeat(ctx context.Context, leaderID uint64, env *types.HeartbeatTxEnvelope) error {
+	return p.catchUpClient.SendHeartbeat(ctx, leaderID, env)
+}
+
 // ActivePeers returns the peers that are active for more than `minDuration`.
 // The returned peers  include the self node if includeSelf==true.
 func (p *HTTPTransport) ActivePeers(minDuration time.Duration, includeSelf bool) map[string]*types.PeerConfig {
@@ -266,3 +266,11 @@ func (l *memLedger) Get(blockNum uint64) (*types.Block, error) {
 	}
 	return l.ledger[blockNum-1], nil
 }
+
+func (l *memLedger) GetRaw(blockNum uint64) ([]byte, error) {
+	block, err := l.Get(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(block)
+}
@@ -22,6 +22,19 @@ type LedgerReader struct {
 		result1 *types.Block
 		result2 error
 	}
+	GetRawStub        func(uint64) ([]byte, error)
+	getRawMutex       sync.RWMutex
+	getRawArgsForCall []struct {
+		arg1 uint64
+	}
+	getRawReturns struct {
+		result1 []byte
+		result2 error
+	}
+	getRawReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	HeightStub        func() (uint64, error)
 	heightMutex       sync.RWMutex
 	heightArgsForCall []struct {
@@ -101,6 +114,69 @@ func (fake *LedgerReader) GetReturnsOnCall(i int, result1 *types.Block, result2
 	}{result1, result2}
 }
 
+func (fake *LedgerReader) GetRaw(arg1 uint64) ([]byte, error) {
+	fake.getRawMutex.Lock()
+	ret, specificReturn := fake.getRawReturnsOnCall[len(fake.getRawArgsForCall)]
+	fake.getRawArgsForCall = append(fake.getRawArgsForCall, struct {
+		arg1 uint64
+	}{arg1})
+	fake.recordInvocation("GetRaw", []interface{}{arg1})
+	fake.getRawMutex.Unlock()
+	if fake.GetRawStub != nil {
+		return fake.GetRawStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getRawReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *LedgerReader) GetRawCallCount() int {
+	fake.getRawMutex.RLock()
+	defer fake.getRawMutex.RUnlock()
+	return len(fake.getRawArgsForCall)
+}
+
+func (fake *LedgerReader) GetRawCalls(stub func(uint64) ([]byte, error)) {
+	fake.getRawMutex.Lock()
+	defer fake.getRawMutex.Unlock()
+	fake.GetRawStub = stub
+}
+
+func (fake *LedgerReader) GetRawArgsForCall(i int) uint64 {
+	fake.getRawMutex.RLock()
+	defer fake.getRawMutex.RUnlock()
+	argsForCall := fake.getRawArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *LedgerReader) GetRawReturns(result1 []byte, result2 error) {
+	fake.getRawMutex.Lock()
+	defer fake.getRawMutex.Unlock()
+	fake.GetRawStub = nil
+	fake.getRawReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *LedgerReader) GetRawReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.getRawMutex.Lock()
+	defer fake.getRawMutex.Unlock()
+	fake.GetRawStub = nil
+	if fake.getRawReturnsOnCall == nil {
+		fake.getRawReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.getRawReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *LedgerReader) Height() (uint64, error) {
 	fake.heightMutex.Lock()
 	ret, specificReturn := fake.heightReturnsOnCall[len(fake.heightArgsForCall)]
@@ -161,6 +237,8 @@ func (fake *LedgerReader) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
+	fake.getRawMutex.RLock()
+	defer fake.getRawMutex.RUnlock()
 	fake.heightMutex.RLock()
 	defer fake.heightMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cursor implements the pagination cursors of the listings served by the node, i.e., of the range, index,
+// history, admin audit and dead-letter queries. A cursor is an opaque token which the client hands back to get the
+// next page of a listing. It holds the version of its own format, the listing and the hash of the shape of the query
+// it was issued for, the format of the positions of the listing, and the position the next page starts at, and it is
+// signed with an HMAC under a secret of the server, so that a client can neither forge nor alter it.
+//
+// A cursor which is not signed by the server, or which is handed back for another query, is rejected as a bad
+// request. A cursor of another version, of another format of the listing, or whose position was pruned from the
+// listing since it was issued, is rejected as expired, with errors.CursorExpiredError, so that the client restarts
+// the listing rather than silently skipping entries.
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the version of the format of the cursors issued by the node. A cursor of another version is expired.
+const Version = 1
+
+// The listings paged by cursors
+const (
+	Range      = "range"
+	Index      = "index"
+	History    = "history"
+	AdminAudit = "admin-audit"
+	DroppedTxs = "dropped-txs"
+)
+
+// secretSize is the size, in bytes, of a secret generated by the node
+const secretSize = 32
+
+// Listing describes a listing, as queried by a client, which a cursor is issued for or resumed on
+type Listing struct {
+	// Name is the name of the listing, one of Range, Index, History, AdminAudit and DroppedTxs
+	Name string
+	// Shape holds the parameters of the query, but for its limit and cursor, in a fixed order
+	Shape []string
+	// Format is the format of the positions of the listing. A cursor issued for another format is expired.
+	Format string
+	// Boundary is the retention boundary of the listing: the entries whose ordinal is lower were pruned. Zero for a
+	// listing which is never pruned.
+	Boundary uint64
+}
+
+// Codec issues and resumes the cursors of the node
+type Codec struct {
+	secret []byte
+}
+
+// NewCodec returns a codec which signs the cursors with the given secret
+func NewCodec(secret []byte) *Codec {
+	return &Codec{secret: secret}
+}
+
+// LoadOrGenerateSecret reads the secret which signs the cursors from the file. If the file does not exist, a random
+// secret is generated and written to the file, so that the cursors survive a restart of the node.
+func LoadOrGenerateSecret(path string) ([]byte, error) {
+	secret, err := ioutil.ReadFile(path)
+	if err == nil {
+		if len(secret) == 0 {
+			return nil, errors.Errorf("the cursor secret file %s is empty", path)
+		}
+		return secret, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "error while reading the cursor secret file %s", path)
+	}
+
+	secret = make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(err, "error while generating the cursor secret")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Wrapf(err, "error while creating the directory of the cursor secret file %s", path)
+	}
+	if err := ioutil.WriteFile(path, secret, 0600); err != nil {
+		return nil, errors.Wrapf(err, "error while writing the cursor secret file %s", path)
+	}
+	return secret, nil
+}
+
+// Issue returns the cursor of the listing at the given position, whose ordinal is its order along which the retention
+// boundary of the listing moves
+func (c *Codec) Issue(l *Listing, position []byte, ordinal uint64) (string, error) {
+	cursorBytes, err := proto.Marshal(&Cursor{
+		Version:  Version,
+		Listing:  l.Name,
+		Shape:    shapeHash(l.Shape),
+		Format:   l.Format,
+		Position: position,
+		Ordinal:  ordinal,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error while marshaling the cursor")
+	}
+
+	signed, err := proto.Marshal(&SignedCursor{
+		Cursor: cursorBytes,
+		Mac:    c.mac(cursorBytes),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error while marshaling the signed cursor")
+	}
+	return base64.RawURLEncoding.EncodeToString(signed), nil
+}
+
+// Resume returns the position of the cursor, which must have been issued by the node for the listing. An
+// errors.BadRequestError is returned if the cursor was not issued by the node, or was issued for another query, and
+// an errors.CursorExpiredError if the cursor is of another version, if the format of the listing changed, or if the
+// retention boundary of the listing moved past its position.
+func (c *Codec) Resume(l *Listing, token string) ([]byte, error) {
+	signedBytes, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the cursor is malformed: " + err.Error()}
+	}
+	signed := &SignedCursor{}
+	if err := proto.Unmarshal(signedBytes, signed); err != nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the cursor is malformed: " + err.Error()}
+	}
+	if !hmac.Equal(signed.GetMac(), c.mac(signed.GetCursor())) {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the cursor was not issued by this server, or was altered"}
+	}
+
+	cur := &Cursor{}
+	if err := proto.Unmarshal(signed.GetCursor(), cur); err != nil {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the cursor is malformed: " + err.Error()}
+	}
+
+	if cur.GetVersion() != Version {
+		return nil, &ierrors.CursorExpiredError{
+			ErrMsg: fmt.Sprintf("cursor expired: the cursor is of version %d while the server issues version %d, restart the listing", cur.GetVersion(), Version),
+		}
+	}
+	if cur.GetListing() != l.Name || !hmac.Equal(cur.GetShape(), shapeHash(l.Shape)) {
+		return nil, &ierrors.BadRequestError{ErrMsg: "the cursor was issued for another query"}
+	}
+	if cur.GetFormat() != l.Format {
+		return nil, &ierrors.CursorExpiredError{
+			ErrMsg: fmt.Sprintf("cursor expired: the format of the listing changed from [%s] to [%s], restart the listing", cur.GetFormat(), l.Format),
+		}
+	}
+	if cur.GetOrdinal() < l.Boundary {
+		return nil, &ierrors.CursorExpiredError{
+			ErrMsg: "cursor expired: the entries at the position of the cursor were pruned, restart the listing",
+		}
+	}
+
+	return cur.GetPosition(), nil
+}
+
+func (c *Codec) mac(cursorBytes []byte) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write(cursorBytes)
+	return h.Sum(nil)
+}
+
+// shapeHash returns the hash of the parameters of a query, each prefixed with its length, so that no two distinct
+// shapes hash the same bytes
+func shapeHash(shape []string) []byte {
+	h := sha256.New()
+	var length [8]byte
+	for _, s := range shape {
+		binary.BigEndian.PutUint64(length[:], uint64(len(s)))
+		h.Write(length[:])
+		h.Write([]byte(s))
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cursor
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testListing() *Listing {
+	return &Listing{
+		Name:   Range,
+		Shape:  []string{"db1", "alice", "key1", "key9"},
+		Format: "keys-v1:BYTES",
+	}
+}
+
+func TestIssueAndResume(t *testing.T) {
+	c := NewCodec([]byte("secret"))
+	l := testListing()
+
+	token, err := c.Issue(l, []byte("key5"), 0)
+	require.NoError(t, err)
+
+	position, err := c.Resume(l, token)
+	require.NoError(t, err)
+	require.Equal(t, []byte("key5"), position)
+
+	// a cursor survives a restart of the node with the same secret
+	position, err = NewCodec([]byte("secret")).Resume(l, token)
+	require.NoError(t, err)
+	require.Equal(t, []byte("key5"), position)
+}
+
+func TestResumeRejected(t *testing.T) {
+	c := NewCodec([]byte("secret"))
+
+	t.Run("tampered cursor", func(t *testing.T) {
+		token, err := c.Issue(testListing(), []byte("key5"), 0)
+		require.NoError(t, err)
+
+		signedBytes, err := base64.RawURLEncoding.DecodeString(token)
+		require.NoError(t, err)
+		signed := &SignedCursor{}
+		require.NoError(t, proto.Unmarshal(signedBytes, signed))
+		cur := &Cursor{}
+		require.NoError(t, proto.Unmarshal(signed.Cursor, cur))
+		cur.Position = []byte("key0")
+		signed.Cursor, err = proto.Marshal(cur)
+		require.NoError(t, err)
+		signedBytes, err = proto.Marshal(signed)
+		require.NoError(t, err)
+
+		_, err = c.Resume(testListing(), base64.RawURLEncoding.EncodeToString(signedBytes))
+		require.EqualError(t, err, "the cursor was not issued by this server, or was altered")
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+	})
+
+	t.Run("cursor of another secret", func(t *testing.T) {
+		token, err := NewCodec([]byte("other")).Issue(testListing(), []byte("key5"), 0)
+		require.NoError(t, err)
+
+		_, err = c.Resume(testListing(), token)
+		require.EqualError(t, err, "the cursor was not issued by this server, or was altered")
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+	})
+
+	t.Run("malformed cursor", func(t *testing.T) {
+		_, err := c.Resume(testListing(), "not a cursor!")
+		require.Contains(t, err.Error(), "the cursor is malformed")
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+	})
+
+	t.Run("cursor of another query", func(t *testing.T) {
+		token, err := c.Issue(testListing(), []byte("key5"), 0)
+		require.NoError(t, err)
+
+		other := testListing()
+		other.Shape = []string{"db1", "alice", "key1", "key8"}
+		_, err = c.Resume(other, token)
+		require.EqualError(t, err, "the cursor was issued for another query")
+		require.IsType(t, &ierrors.BadRequestError{}, err)
+
+		other = testListing()
+		other.Name = Index
+		_, err = c.Resume(other, token)
+		require.EqualError(t, err, "the cursor was issued for another query")
+	})
+
+	t.Run("cursor of an old version", func(t *testing.T) {
+		cursorBytes, err := proto.Marshal(&Cursor{
+			Version:  Version - 1,
+			Listing:  Range,
+			Shape:    shapeHash(testListing().Shape),
+			Format:   testListing().Format,
+			Position: []byte("key5"),
+		})
+		require.NoError(t, err)
+		signedBytes, err := proto.Marshal(&SignedCursor{Cursor: cursorBytes, Mac: c.mac(cursorBytes)})
+		require.NoError(t, err)
+
+		_, err = c.Resume(testListing(), base64.RawURLEncoding.EncodeToString(signedBytes))
+		require.EqualError(t, err, "cursor expired: the cursor is of version 0 while the server issues version 1, restart the listing")
+		require.IsType(t, &ierrors.CursorExpiredError{}, err)
+	})
+
+	t.Run("format changed", func(t *testing.T) {
+		token, err := c.Issue(testListing(), []byte("key5"), 0)
+		require.NoError(t, err)
+
+		l := testListing()
+		l.Format = "keys-v1:NUMERIC"
+		_, err = c.Resume(l, token)
+		require.EqualError(t, err, "cursor expired: the format of the listing changed from [keys-v1:BYTES] to [keys-v1:NUMERIC], restart the listing")
+		require.IsType(t, &ierrors.CursorExpiredError{}, err)
+	})
+
+	t.Run("position pruned", func(t *testing.T) {
+		l := testListing()
+		l.Boundary = 10
+		token, err := c.Issue(l, []byte("key5"), 15)
+		require.NoError(t, err)
+
+		l.Boundary = 15
+		position, err := c.Resume(l, token)
+		require.NoError(t, err)
+		require.Equal(t, []byte("key5"), position)
+
+		l.Boundary = 16
+		_, err = c.Resume(l, token)
+		require.EqualError(t, err, "cursor expired: the entries at the position of the cursor were pruned, restart the listing")
+		require.IsType(t, &ierrors.CursorExpiredError{}, err)
+	})
+}
+
+func TestShapeHash(t *testing.T) {
+	require.Equal(t, shapeHash([]string{"a", "b"}), shapeHash([]string{"a", "b"}))
+	require.NotEqual(t, shapeHash([]string{"ab", ""}), shapeHash([]string{"a", "b"}))
+	require.NotEqual(t, shapeHash([]string{"ab"}), shapeHash([]string{"a", "b"}))
+}
+
+func TestLoadOrGenerateSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cursor")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "ledger", "cursorsecret")
+	secret, err := LoadOrGenerateSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, secretSize)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadOrGenerateSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	require.NoError(t, ioutil.WriteFile(path, nil, 0600))
+	_, err = LoadOrGenerateSecret(path)
+	require.EqualError(t, err, "the cursor secret file "+path+" is empty")
+}
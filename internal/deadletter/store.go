@@ -0,0 +1,320 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deadletter keeps the dead-letter records of a node: a record of every transaction that the node accepted
+// but dropped before it was included in a block, so that the submitter can learn that, and why, the transaction will
+// never commit. The records are local to the node and are purged once older than the retention period.
+//
+// The key schema of the store is:
+//
+//	tx/<txID>        the record of the dropped transaction, a marshaled types.DroppedTx
+//	time/<t>/<txID>  an empty record which orders the drops by time, where <t> is the time of the drop in nanoseconds
+//	                 since the Unix epoch, as a big-endian uint64
+//	purged           the time, in nanoseconds since the Unix epoch, before which records were purged, as a big-endian
+//	                 uint64
+//
+// The two records of a drop are written by a single batch, along with the deletion of the records of an earlier drop
+// of the same transaction. The purge time is written by the batch that purges the records.
+package deadletter
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	txKeyPrefix   = "tx/"
+	timeKeyPrefix = "time/"
+	purgedKey     = "purged"
+
+	// DefaultJanitorInterval is the interval between two purges of the expired records, unless configured
+	DefaultJanitorInterval = time.Hour
+)
+
+// Store holds the dead-letter records of a node
+type Store struct {
+	db              *leveldb.DB
+	retention       time.Duration
+	janitorInterval time.Duration
+	now             func() time.Time
+	// writeMu serializes the writes, as a record replaces the earlier record of the same transaction
+	writeMu   sync.Mutex
+	stop      chan struct{}
+	stopped   sync.WaitGroup
+	closeOnce sync.Once
+	logger    *logger.SugarLogger
+}
+
+// Config holds the configuration of the dead-letter store
+type Config struct {
+	StoreDir string
+	// Retention is the period for which a record is kept after the drop. Zero keeps the records forever.
+	Retention time.Duration
+	// JanitorInterval is the interval between two purges of the records older than the retention period. Zero
+	// defaults to DefaultJanitorInterval.
+	JanitorInterval time.Duration
+	Logger          *logger.SugarLogger
+}
+
+// Open opens, or creates, the dead-letter store, and starts the janitor that purges the expired records if a
+// retention period is set
+func Open(c *Config) (*Store, error) {
+	db, err := leveldb.OpenFile(c.StoreDir, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while opening the dead-letter store at %s", c.StoreDir)
+	}
+
+	s := &Store{
+		db:              db,
+		retention:       c.Retention,
+		janitorInterval: c.JanitorInterval,
+		now:             time.Now,
+		stop:            make(chan struct{}),
+		logger:          c.Logger,
+	}
+	if s.janitorInterval == 0 {
+		s.janitorInterval = DefaultJanitorInterval
+	}
+
+	if s.retention > 0 {
+		s.stopped.Add(1)
+		go s.runJanitor()
+	}
+
+	return s, nil
+}
+
+// Put records the given dropped transactions. The record of a transaction that was dropped before, and resubmitted
+// since, replaces the earlier record.
+func (s *Store) Put(records []*types.DroppedTx) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	batch := &leveldb.Batch{}
+	for _, r := range records {
+		prev, err := s.get(r.GetTxId())
+		if err != nil {
+			return err
+		}
+		if prev != nil {
+			batch.Delete(timeKey(prev.GetDroppedAt(), prev.GetTxId()))
+		}
+
+		value, err := proto.Marshal(r)
+		if err != nil {
+			return errors.Wrapf(err, "error while marshaling the dead-letter record of transaction %s", r.GetTxId())
+		}
+		batch.Put(txKey(r.GetTxId()), value)
+		batch.Put(timeKey(r.GetDroppedAt(), r.GetTxId()), nil)
+	}
+
+	if err := s.db.Write(batch, nil); err != nil {
+		return errors.Wrap(err, "error while writing the dead-letter records")
+	}
+	return nil
+}
+
+// Get returns the record of the dropped transaction. A NotFoundErr is returned if the node holds no record of it.
+func (s *Store) Get(txID string) (*types.DroppedTx, error) {
+	r, err := s.get(txID)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, &interrors.NotFoundErr{Message: "no dead-letter record of transaction " + txID + " on this node"}
+	}
+	return r, nil
+}
+
+func (s *Store) get(txID string) (*types.DroppedTx, error) {
+	value, err := s.db.Get(txKey(txID), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while retrieving the dead-letter record of transaction %s", txID)
+	}
+
+	r := &types.DroppedTx{}
+	if err := proto.Unmarshal(value, r); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the dead-letter record of transaction %s", txID)
+	}
+	return r, nil
+}
+
+// Position is the position of a record in the order of the drops
+type Position struct {
+	// DroppedAt is the time of the drop, in nanoseconds since the Unix epoch
+	DroppedAt int64
+	TxID      string
+}
+
+// List returns, in the order of the drops, the records of the transactions dropped at or after since, in
+// nanoseconds since the Unix epoch. At most limit records are returned, along with true if more records follow them.
+// A zero limit returns all the records.
+func (s *Store) List(since int64, limit uint64) ([]*types.DroppedTx, bool, error) {
+	records, next, err := s.ListFrom(&Position{DroppedAt: since}, limit)
+	return records, next != nil, err
+}
+
+// ListFrom returns, in the order of the drops, the records at or after the given position. At most limit records are
+// returned, along with the position of the record that follows them, if any. A zero limit returns all the records.
+func (s *Store) ListFrom(from *Position, limit uint64) ([]*types.DroppedTx, *Position, error) {
+	since := from.DroppedAt
+	if since < 0 {
+		since = 0
+	}
+	it := s.db.NewIterator(&util.Range{Start: timeKey(since, from.TxID), Limit: util.BytesPrefix([]byte(timeKeyPrefix)).Limit}, nil)
+	defer it.Release()
+
+	var records []*types.DroppedTx
+	for it.Next() {
+		droppedAt, txID, err := decodeTimeKey(it.Key())
+		if err != nil {
+			return nil, nil, err
+		}
+		if limit > 0 && uint64(len(records)) == limit {
+			return records, &Position{DroppedAt: droppedAt, TxID: txID}, nil
+		}
+
+		r, err := s.get(txID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if r != nil {
+			records = append(records, r)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, errors.Wrap(err, "error while iterating over the dead-letter records")
+	}
+
+	return records, nil, nil
+}
+
+// PurgedBefore returns the time, in nanoseconds since the Unix epoch, before which records were purged, or zero if no
+// record was ever purged
+func (s *Store) PurgedBefore() (int64, error) {
+	value, err := s.db.Get([]byte(purgedKey), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "error while retrieving the purge time of the dead-letter records")
+	}
+	if len(value) != 8 {
+		return 0, errors.Errorf("malformed purge time of the dead-letter records %x", value)
+	}
+	return int64(binary.BigEndian.Uint64(value)), nil
+}
+
+// Purge deletes the records of the transactions dropped before the given time, and returns their number
+func (s *Store) Purge(before time.Time) (int, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	it := s.db.NewIterator(&util.Range{Start: []byte(timeKeyPrefix), Limit: timeKey(before.UnixNano(), "")}, nil)
+	defer it.Release()
+
+	batch := &leveldb.Batch{}
+	purged := 0
+	for it.Next() {
+		_, txID, err := decodeTimeKey(it.Key())
+		if err != nil {
+			return 0, err
+		}
+		batch.Delete(append([]byte(nil), it.Key()...))
+		batch.Delete(txKey(txID))
+		purged++
+	}
+	if err := it.Error(); err != nil {
+		return 0, errors.Wrap(err, "error while iterating over the dead-letter records")
+	}
+	if purged == 0 {
+		return 0, nil
+	}
+
+	purgedBefore, err := s.PurgedBefore()
+	if err != nil {
+		return 0, err
+	}
+	if before.UnixNano() > purgedBefore {
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, uint64(before.UnixNano()))
+		batch.Put([]byte(purgedKey), value)
+	}
+
+	if err := s.db.Write(batch, nil); err != nil {
+		return 0, errors.Wrap(err, "error while purging the dead-letter records")
+	}
+	return purged, nil
+}
+
+func (s *Store) runJanitor() {
+	defer s.stopped.Done()
+
+	ticker := time.NewTicker(s.janitorInterval)
+	defer ticker.Stop()
+
+	for {
+		s.purgeExpired()
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Store) purgeExpired() {
+	purged, err := s.Purge(s.now().Add(-s.retention))
+	if err != nil {
+		s.logger.Errorf("error while purging the expired dead-letter records: %s", err)
+		return
+	}
+	if purged > 0 {
+		s.logger.Infof("purged %d dead-letter records older than %s", purged, s.retention)
+	}
+}
+
+// Close stops the janitor and closes the store
+func (s *Store) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		s.stopped.Wait()
+		err = s.db.Close()
+	})
+	return err
+}
+
+func txKey(txID string) []byte {
+	return []byte(txKeyPrefix + txID)
+}
+
+func timeKey(droppedAt int64, txID string) []byte {
+	key := make([]byte, len(timeKeyPrefix)+8+1+len(txID))
+	copy(key, timeKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(timeKeyPrefix):], uint64(droppedAt))
+	key[len(timeKeyPrefix)+8] = '/'
+	copy(key[len(timeKeyPrefix)+9:], txID)
+	return key
+}
+
+func decodeTimeKey(key []byte) (int64, string, error) {
+	if len(key) < len(timeKeyPrefix)+9 {
+		return 0, "", errors.Errorf("malformed dead-letter time key %x", key)
+	}
+	droppedAt := int64(binary.BigEndian.Uint64(key[len(timeKeyPrefix):]))
+	return droppedAt, string(key[len(timeKeyPrefix)+9:]), nil
+}
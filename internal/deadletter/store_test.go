@@ -0,0 +1,209 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T, dir string, retention, janitorInterval time.Duration) *Store {
+	lg, err := logger.New(&logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	s, err := Open(&Config{
+		StoreDir:        dir,
+		Retention:       retention,
+		JanitorInterval: janitorInterval,
+		Logger:          lg,
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func droppedTx(txID string, droppedAt int64) *types.DroppedTx {
+	return &types.DroppedTx{
+		TxId:      txID,
+		Submitter: "alice",
+		Stage:     "queued",
+		Reason:    "the deadline of the transaction passed before it was included in a block",
+		DroppedAt: droppedAt,
+	}
+}
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deadletter")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s := newTestStore(t, dir, 0, 0)
+
+	r, err := s.Get("tx1")
+	require.EqualError(t, err, "no dead-letter record of transaction tx1 on this node")
+	require.IsType(t, &interrors.NotFoundErr{}, err)
+	require.Nil(t, r)
+
+	var records []*types.DroppedTx
+	for i := 1; i <= 5; i++ {
+		records = append(records, droppedTx(fmt.Sprintf("tx%d", i), int64(i*100)))
+	}
+	require.NoError(t, s.Put(records[:3]))
+	require.NoError(t, s.Put(records[3:]))
+
+	// the records survive a restart
+	require.NoError(t, s.Close())
+	s = newTestStore(t, dir, 0, 0)
+	defer s.Close()
+
+	for _, expected := range records {
+		r, err := s.Get(expected.GetTxId())
+		require.NoError(t, err)
+		require.True(t, proto.Equal(expected, r), "expected %v, actual %v", expected, r)
+	}
+
+	t.Run("list", func(t *testing.T) {
+		listed, more, err := s.List(0, 0)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Len(t, listed, 5)
+		for i := range records {
+			require.True(t, proto.Equal(records[i], listed[i]))
+		}
+
+		listed, more, err = s.List(200, 2)
+		require.NoError(t, err)
+		require.True(t, more)
+		require.Len(t, listed, 2)
+		require.Equal(t, "tx2", listed[0].GetTxId())
+		require.Equal(t, "tx3", listed[1].GetTxId())
+
+		listed, more, err = s.List(400, 2)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Len(t, listed, 2)
+
+		listed, more, err = s.List(501, 0)
+		require.NoError(t, err)
+		require.False(t, more)
+		require.Empty(t, listed)
+	})
+
+	t.Run("list from a position", func(t *testing.T) {
+		listed, next, err := s.ListFrom(&Position{}, 2)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		require.Equal(t, &Position{DroppedAt: 300, TxID: "tx3"}, next)
+
+		listed, next, err = s.ListFrom(next, 2)
+		require.NoError(t, err)
+		require.Equal(t, "tx3", listed[0].GetTxId())
+		require.Equal(t, "tx4", listed[1].GetTxId())
+		require.Equal(t, &Position{DroppedAt: 500, TxID: "tx5"}, next)
+
+		listed, next, err = s.ListFrom(next, 2)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Nil(t, next)
+	})
+
+	t.Run("a later drop replaces the record", func(t *testing.T) {
+		require.NoError(t, s.Put([]*types.DroppedTx{droppedTx("tx2", 600)}))
+
+		r, err := s.Get("tx2")
+		require.NoError(t, err)
+		require.Equal(t, int64(600), r.GetDroppedAt())
+
+		listed, _, err := s.List(0, 0)
+		require.NoError(t, err)
+		var txIDs []string
+		for _, r := range listed {
+			txIDs = append(txIDs, r.GetTxId())
+		}
+		require.Equal(t, []string{"tx1", "tx3", "tx4", "tx5", "tx2"}, txIDs)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		purgedBefore, err := s.PurgedBefore()
+		require.NoError(t, err)
+		require.Zero(t, purgedBefore)
+
+		purged, err := s.Purge(time.Unix(0, 400))
+		require.NoError(t, err)
+		require.Equal(t, 2, purged)
+		purgedBefore, err = s.PurgedBefore()
+		require.NoError(t, err)
+		require.Equal(t, int64(400), purgedBefore)
+
+		for _, txID := range []string{"tx1", "tx3"} {
+			_, err := s.Get(txID)
+			require.IsType(t, &interrors.NotFoundErr{}, err)
+		}
+		listed, _, err := s.List(0, 0)
+		require.NoError(t, err)
+		require.Len(t, listed, 3)
+
+		purged, err = s.Purge(time.Unix(0, 400))
+		require.NoError(t, err)
+		require.Equal(t, 0, purged)
+
+		// a purge of an earlier time leaves the purge time as is
+		require.NoError(t, s.Put([]*types.DroppedTx{droppedTx("tx0", 50)}))
+		purged, err = s.Purge(time.Unix(0, 100))
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+		purgedBefore, err = s.PurgedBefore()
+		require.NoError(t, err)
+		require.Equal(t, int64(400), purgedBefore)
+	})
+}
+
+func TestStoreJanitor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deadletter")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	s := newTestStore(t, dir, 0, 0)
+	require.NoError(t, s.Put([]*types.DroppedTx{
+		droppedTx("old", now.Add(-2*time.Hour).UnixNano()),
+		droppedTx("recent", now.Add(-time.Minute).UnixNano()),
+	}))
+	require.NoError(t, s.Close())
+
+	// the janitor purges the records older than the retention period on start, and then periodically
+	s = newTestStore(t, dir, time.Hour, 10*time.Millisecond)
+	defer s.Close()
+	require.Eventually(t, func() bool {
+		_, err := s.Get("old")
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = s.Get("recent")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Put([]*types.DroppedTx{droppedTx("expiring", now.Add(-time.Hour+100*time.Millisecond).UnixNano())}))
+	require.Eventually(t, func() bool {
+		_, err := s.Get("expiring")
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = s.Get("recent")
+	require.NoError(t, err)
+
+	// the janitor is stopped by close, which is idempotent
+	require.NoError(t, s.Close())
+	require.NoError(t, s.Close())
+}
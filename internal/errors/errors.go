@@ -2,7 +2,12 @@
 // SPDX-License-Identifier: Apache-2.0
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
 
 type NotFoundErr struct {
 	Message string
@@ -12,6 +17,17 @@ func (e *NotFoundErr) Error() string {
 	return e.Message
 }
 
+// VersionNotFoundErr denotes that a key did not hold a value at a given version. It carries, as a hint, the
+// nearest version of the key below the requested one, or nil if there is none.
+type VersionNotFoundErr struct {
+	ErrMsg         string
+	NearestVersion *types.Version
+}
+
+func (e *VersionNotFoundErr) Error() string {
+	return e.ErrMsg
+}
+
 type PermissionErr struct {
 	ErrMsg string
 }
@@ -20,8 +36,13 @@ func (e *PermissionErr) Error() string {
 	return e.ErrMsg
 }
 
+// TimeoutErr denotes that waiting for an operation has timed out. When waiting for a transaction to commit, it
+// carries the last stage the transaction was observed at, and the number of the block that includes it, which is zero
+// if not yet assigned.
 type TimeoutErr struct {
-	ErrMsg string
+	ErrMsg      string
+	Stage       string
+	BlockNumber uint64
 }
 
 func (t *TimeoutErr) Error() string {
@@ -83,3 +104,57 @@ type ServerRestrictionError struct {
 func (c *ServerRestrictionError) Error() string {
 	return c.ErrMsg
 }
+
+// OverloadedError denotes that the server rejected a transaction as its transaction pipeline is out of room. It carries
+// the interval the client should wait before it resubmits the transaction, which is derived from the recent commit
+// throughput and the backlog of the server.
+type OverloadedError struct {
+	ErrMsg     string
+	RetryAfter time.Duration
+}
+
+func (o *OverloadedError) Error() string {
+	return o.ErrMsg
+}
+
+// FencedError denotes that a block was refused because the epoch of the node that produced it is lower than the epoch
+// committed to the cluster config, e.g., the node is a former primary that was replaced by a promotion.
+type FencedError struct {
+	ErrMsg string
+}
+
+func (f *FencedError) Error() string {
+	return f.ErrMsg
+}
+
+// TxExpiredError denotes that a transaction was dropped before it was included in a block, because the deadline set
+// by the client passed while the transaction was queued.
+type TxExpiredError struct {
+	ErrMsg string
+}
+
+func (t *TxExpiredError) Error() string {
+	return t.ErrMsg
+}
+
+// TxRejectedError denotes that a transaction was dropped before it was included in a block, because it was found
+// invalid along with all the other transactions of its batch, and the node drops such batches rather than committing
+// them.
+type TxRejectedError struct {
+	ErrMsg string
+}
+
+func (t *TxRejectedError) Error() string {
+	return t.ErrMsg
+}
+
+// CursorExpiredError denotes that a pagination cursor can no longer be resumed, as the format of the listing it pages
+// through changed, or the entries it was about to return were pruned, since it was issued. The client is expected to
+// restart the listing from its beginning.
+type CursorExpiredError struct {
+	ErrMsg string
+}
+
+func (c *CursorExpiredError) Error() string {
+	return c.ErrMsg
+}
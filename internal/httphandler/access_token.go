@@ -0,0 +1,90 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hyperledger-labs/orion-server/internal/accesstoken"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// accessTokenContextKey is the key of the claims of the verified access token in the context of a request
+type accessTokenContextKey struct{}
+
+// AuthenticateAccessTokens verifies the access token of the requests served by the given handler, if they carry one
+// in the AccessTokenHeader. A request whose token is invalid, expired, or revoked is rejected with 401. Otherwise, the
+// claims of the token are passed along the request, and the queries of the request are authenticated by them in place
+// of a signature, see extractVerifiedQueryPayload. The transactions are never authenticated by a token, as their
+// handlers verify the signatures of their envelopes.
+func AuthenticateAccessTokens(next http.Handler, db bcdb.DB) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(constants.AccessTokenHeader)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := db.VerifyAccessToken(token)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if _, ok := err.(*accesstoken.InvalidTokenError); ok {
+				status = http.StatusUnauthorized
+			}
+			utils.SendHTTPResponse(w, status, &types.HttpResponseErr{ErrMsg: err.Error()})
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), accessTokenContextKey{}, claims)))
+	})
+}
+
+// accessTokenClaims returns the claims of the access token of the request, verified by AuthenticateAccessTokens, or
+// nil if the request carries none
+func accessTokenClaims(r *http.Request) *types.AccessToken {
+	claims, _ := r.Context().Value(accessTokenContextKey{}).(*types.AccessToken)
+	return claims
+}
+
+// authorizeAccessTokenQuery checks that the claims of an access token cover the query: its endpoint, the database it
+// names, if any, and the user set in its UserHeader, if any. It returns the user of the token, or the status and the
+// error of the response which rejects the query.
+func authorizeAccessTokenQuery(claims *types.AccessToken, h *http.Header, queryType string) (string, int, error) {
+	if userID := h.Get(constants.UserHeader); userID != "" && userID != claims.GetUserId() {
+		return "", http.StatusUnauthorized, &types.HttpResponseErr{
+			ErrMsg: constants.UserHeader + " [" + userID + "] differs from the user of the access token [" + claims.GetUserId() + "]",
+		}
+	}
+
+	if !containsString(claims.GetEndpoints(), queryType) {
+		return "", http.StatusForbidden, &types.HttpResponseErr{
+			ErrMsg: "the access token does not cover the endpoint [" + queryType + "]",
+		}
+	}
+	return claims.GetUserId(), http.StatusOK, nil
+}
+
+// authorizeAccessTokenDB checks that the claims of an access token cover the database which the query names, if any
+func authorizeAccessTokenDB(claims *types.AccessToken, payload interface{}) (int, error) {
+	query, ok := payload.(interface{ GetDbName() string })
+	if !ok || query.GetDbName() == "" || containsString(claims.GetDbNames(), query.GetDbName()) {
+		return http.StatusOK, nil
+	}
+	return http.StatusForbidden, &types.HttpResponseErr{
+		ErrMsg: "the access token does not cover the database [" + query.GetDbName() + "]",
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
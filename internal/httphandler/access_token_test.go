@@ -0,0 +1,217 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/accesstoken"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// accessTokenDB serves the access tokens of a mocked DB from an access token authority
+type accessTokenDB struct {
+	*mocks.DB
+	authority *accesstoken.Authority
+}
+
+func (d *accessTokenDB) IssueAccessToken(query *types.IssueAccessTokenQuery) (*types.IssueAccessTokenResponseEnvelope, error) {
+	lifetime := time.Duration(query.LifetimeSeconds) * time.Second
+	token, claims, err := d.authority.Issue(query.UserId, query.DbNames, query.Endpoints, lifetime)
+	if err != nil {
+		return nil, err
+	}
+	return &types.IssueAccessTokenResponseEnvelope{
+		Response: &types.IssueAccessTokenResponse{Token: token, Claims: claims},
+	}, nil
+}
+
+func (d *accessTokenDB) VerifyAccessToken(token string) (*types.AccessToken, error) {
+	return d.authority.Verify(token)
+}
+
+func (d *accessTokenDB) RevokeAccessToken(_, token string) (*types.RevokeAccessTokenResponseEnvelope, error) {
+	claims, err := d.authority.Decode(token)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.authority.Revoke(claims); err != nil {
+		return nil, err
+	}
+	return &types.RevokeAccessTokenResponseEnvelope{
+		Response: &types.RevokeAccessTokenResponse{TokenId: claims.TokenId},
+	}, nil
+}
+
+func TestAccessTokens(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "httphandler-accesstokens")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice", "node1"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+	nodeCert, nodeSigner := testutils.LoadTestCrypto(t, cryptoDir, "node1")
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	authority, err := accesstoken.Open(&accesstoken.Config{
+		StoreDir:        filepath.Join(testDir, "accesstokens"),
+		NodeID:          "node1",
+		Signer:          nodeSigner,
+		NodeCertificate: nodeCert.Raw,
+		MaxLifetime:     time.Minute,
+		Logger:          logger,
+	})
+	require.NoError(t, err)
+	defer authority.Close()
+
+	getDataResponse := &types.GetDataResponseEnvelope{
+		Response: &types.GetDataResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Value:  []byte("bar"),
+		},
+	}
+	db := &accessTokenDB{DB: &mocks.DB{}, authority: authority}
+	db.On("GetCertificate", "alice").Return(aliceCert, nil)
+	db.On("IsDBExists", "db1").Return(true)
+	db.On("IsDBExists", "db2").Return(true)
+	db.On("GetData", "db1", "alice", "foo").Return(getDataResponse, nil)
+
+	mux := http.NewServeMux()
+	mux.Handle(constants.DataEndpoint, NewDataRequestHandler(db, logger))
+	mux.Handle(constants.SessionEndpoint, NewSessionRequestHandler(db, logger))
+	handler := AuthenticateAccessTokens(mux, db)
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	requireErr := func(t *testing.T, rr *httptest.ResponseRecorder, expectedStatus int, expectedErr string) {
+		require.Equal(t, expectedStatus, rr.Code)
+		respErr := &types.HttpResponseErr{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+		require.Equal(t, expectedErr, respErr.ErrMsg)
+	}
+
+	issue := func(t *testing.T, lifetimeSeconds uint64) string {
+		query := &types.IssueAccessTokenQuery{
+			UserId:          "alice",
+			DbNames:         []string{"db1"},
+			Endpoints:       []string{constants.GetData},
+			LifetimeSeconds: lifetimeSeconds,
+		}
+		body, err := json.Marshal(&types.IssueAccessTokenRequest{
+			DBNames:         query.DbNames,
+			Endpoints:       query.Endpoints,
+			LifetimeSeconds: lifetimeSeconds,
+		})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, constants.PostIssueAccessToken, bytes.NewReader(body))
+		req.Header.Set(constants.UserHeader, "alice")
+		sig := testutils.SignatureFromQuery(t, aliceSigner, query)
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+
+		rr := serve(req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		res := &types.IssueAccessTokenResponseEnvelope{}
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+		require.Equal(t, "alice", res.Response.Claims.UserId)
+		return res.Response.Token
+	}
+
+	getData := func(token, dbName string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.URLForGetData(dbName, "foo"), nil)
+		req.Header.Set(constants.AccessTokenHeader, token)
+		return req
+	}
+
+	token := issue(t, 30)
+
+	t.Run("the token authenticates the queries it covers", func(t *testing.T) {
+		rr := serve(getData(token, "db1"))
+		require.Equal(t, http.StatusOK, rr.Code)
+		res := &types.GetDataResponseEnvelope{}
+		require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+		require.Equal(t, []byte("bar"), res.Response.Value)
+
+		req := getData(token, "db1")
+		req.Header.Set(constants.UserHeader, "alice")
+		require.Equal(t, http.StatusOK, serve(req).Code)
+	})
+
+	t.Run("the token is rejected out of its scope", func(t *testing.T) {
+		requireErr(t, serve(getData(token, "db2")), http.StatusForbidden, "the access token does not cover the database [db2]")
+
+		req := httptest.NewRequest(http.MethodGet, constants.URLForGetDataRange("db1", "a", "z", 10), nil)
+		req.Header.Set(constants.AccessTokenHeader, token)
+		requireErr(t, serve(req), http.StatusForbidden, "the access token does not cover the endpoint ["+constants.GetDataRange+"]")
+
+		req = getData(token, "db1")
+		req.Header.Set(constants.UserHeader, "bob")
+		requireErr(t, serve(req), http.StatusUnauthorized, "UserID [bob] differs from the user of the access token [alice]")
+
+		// a token cannot issue another token
+		req = httptest.NewRequest(http.MethodPost, constants.PostIssueAccessToken, bytes.NewReader([]byte(`{"endpoints":["`+constants.GetData+`"]}`)))
+		req.Header.Set(constants.AccessTokenHeader, token)
+		requireErr(t, serve(req), http.StatusForbidden, "the access token does not cover the endpoint ["+constants.PostIssueAccessToken+"]")
+	})
+
+	t.Run("the token does not authenticate a transaction", func(t *testing.T) {
+		txEnv := &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds: []string{"alice"},
+				TxId:            "tx1",
+				DbOperations: []*types.DBOperation{
+					{DbName: "db1", DataWrites: []*types.DataWrite{{Key: "foo", Value: []byte("baz")}}},
+				},
+			},
+		}
+		txBytes, err := marshal.DefaultMarshaler().Marshal(txEnv)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, constants.PostDataTx, bytes.NewReader(txBytes))
+		req.Header.Set(constants.AccessTokenHeader, token)
+		requireErr(t, serve(req), http.StatusUnauthorized, "users [alice] in the must sign list have not signed the transaction")
+		db.AssertNotCalled(t, "SubmitTransaction")
+	})
+
+	t.Run("an expired token is rejected", func(t *testing.T) {
+		shortLived := issue(t, 1)
+		require.Equal(t, http.StatusOK, serve(getData(shortLived, "db1")).Code)
+
+		time.Sleep(time.Second)
+		requireErr(t, serve(getData(shortLived, "db1")), http.StatusUnauthorized, "the access token expired")
+	})
+
+	t.Run("a revoked token is rejected", func(t *testing.T) {
+		body, err := json.Marshal(&types.RevokeAccessTokenRequest{Token: token})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, constants.PostRevokeAccessToken, bytes.NewReader(body))
+		req.Header.Set(constants.UserHeader, "alice")
+		sig := testutils.SignatureFromQuery(t, aliceSigner, &types.RevokeAccessTokenQuery{UserId: "alice", Token: token})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		require.Equal(t, http.StatusOK, serve(req).Code)
+
+		requireErr(t, serve(getData(token, "db1")), http.StatusUnauthorized, "the access token was revoked")
+
+		requireErr(t, serve(getData("bogus", "db1")), http.StatusUnauthorized, "the access token is not encoded correctly")
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// serveAudited serves an administrative action, i.e., any admin request but a GET, and records it in the admin audit
+// chain of the node along with its result, whether the action succeeded or not. As the record covers the response, the
+// response is buffered until the record is written, and is then sent labeled with the hash of the record, as a
+// receipt. The action is performed before it is recorded, hence, a failure to record it is only logged, and the
+// response is sent without a receipt.
+func (a *adminRequestHandler) serveAudited(response http.ResponseWriter, request *http.Request) {
+	body, respondedErr := readRequestBody(response, request)
+	if respondedErr {
+		return
+	}
+	if request.Body != nil {
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	buffered := &bufferedResponseWriter{header: make(http.Header), statusCode: http.StatusOK}
+	a.router.ServeHTTP(buffered, request)
+
+	digest := sha256.Sum256(buffered.body.Bytes())
+	hash, err := a.db.RecordAdminAction(&types.AdminAuditRecord{
+		UserId:         request.Header.Get(constants.UserHeader),
+		Method:         request.Method,
+		Endpoint:       request.URL.Path,
+		Parameters:     request.URL.RawQuery,
+		Body:           body,
+		StatusCode:     int32(buffered.statusCode),
+		ResponseDigest: digest[:],
+	})
+	if err != nil {
+		a.logger.Errorf("failed to record the admin action '%s %s' of [%s] in the admin audit chain: %s",
+			request.Method, request.URL.Path, request.Header.Get(constants.UserHeader), err)
+	}
+
+	for key, values := range buffered.header {
+		response.Header()[key] = values
+	}
+	if hash != nil {
+		response.Header().Set(constants.AdminAuditReceiptHeader, hex.EncodeToString(hash))
+	}
+	response.WriteHeader(buffered.statusCode)
+	if _, err := response.Write(buffered.body.Bytes()); err != nil {
+		a.logger.Warnf("failed to write the response of the admin action to the response writer: %s", err)
+	}
+}
+
+// bufferedResponseWriter holds a response until it is sent to the actual response writer
+type bufferedResponseWriter struct {
+	header      http.Header
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.statusCode = statusCode
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
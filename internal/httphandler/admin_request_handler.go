@@ -0,0 +1,443 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package httphandler
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+const txLatencyMetricName = "orion_tx_latency_seconds"
+
+// adminRequestHandler handles the queries that help admins operate the server
+type adminRequestHandler struct {
+	db           bcdb.DB
+	queryLimiter *QueryLimiter
+	sigVerifier  *cryptoservice.SignatureVerifier
+	router       *mux.Router
+	logger       *logger.SugarLogger
+}
+
+// NewAdminRequestHandler returns the admin queries request handler. The metrics of the query limiter, if any, and the
+// latency histograms of the sampled transactions are served along with the storage metrics.
+func NewAdminRequestHandler(db bcdb.DB, queryLimiter *QueryLimiter, logger *logger.SugarLogger) http.Handler {
+	handler := &adminRequestHandler{
+		db:           db,
+		queryLimiter: queryLimiter,
+		sigVerifier:  cryptoservice.NewVerifier(db, logger),
+		router:       mux.NewRouter(),
+		logger:       logger,
+	}
+
+	// HTTP GET "/admin/storage/stats" returns the raw internal statistics of the state database, for debugging
+	handler.router.HandleFunc(constants.GetStorageStats, handler.storageStatsQuery).Methods(http.MethodGet)
+	// HTTP GET "/admin/storage/metrics" returns the storage metrics, the metrics of the query limiter, and the latency
+	// histograms of the sampled transactions, in the Prometheus text exposition format
+	handler.router.HandleFunc(constants.GetStorageMetrics, handler.storageMetricsQuery).Methods(http.MethodGet)
+	// HTTP GET "/admin/storage/handles" returns the snapshots and iterators of the state database which are not yet
+	// released, along with their owners and creation times
+	handler.router.HandleFunc(constants.GetStorageHandles, handler.storageHandlesQuery).Methods(http.MethodGet)
+	// HTTP GET "/admin/storage/blocks" returns the number of blocks in the block store, and the space they take on disk
+	// and before compression
+	handler.router.HandleFunc(constants.GetBlockStoreStats, handler.blockStoreStatsQuery).Methods(http.MethodGet)
+	// HTTP POST "/admin/trace-validation" re-runs the validation of a committed block and returns the checks performed
+	// on each of its transactions
+	handler.router.HandleFunc(constants.PostTraceValidation, handler.traceValidation).Methods(http.MethodPost)
+	// HTTP POST "/admin/divergence/accept" resumes the commits halted on a divergence, by committing the peer's version
+	// of the diverging block
+	handler.router.HandleFunc(constants.PostAcceptPeerHeader, handler.acceptPeerHeader).Methods(http.MethodPost)
+	// HTTP POST "/admin/resync" commits to a database which is unavailable, as a commit to it failed, the updates it
+	// missed, and makes it available again
+	handler.router.HandleFunc(constants.PostResyncDB, handler.resyncDB).Methods(http.MethodPost)
+	// HTTP GET "/admin/checkpoints?interval={interval}" returns the trusted checkpoints of the ledger, for distribution
+	// as a trusted checkpoints file
+	handler.router.HandleFunc(constants.GetTrustedCheckpoints, handler.trustedCheckpointsQuery).Methods(http.MethodGet).Queries("interval", "{interval:[0-9]+}")
+	handler.router.HandleFunc(constants.GetTrustedCheckpoints, handler.trustedCheckpointsQuery).Methods(http.MethodGet)
+	// HTTP GET "/admin/migration" returns the status of the last migration of the records of the state database to the
+	// current encoding
+	handler.router.HandleFunc(constants.StateMigration, handler.stateMigrationQuery).Methods(http.MethodGet)
+	// HTTP POST "/admin/migration" starts, or resumes, the migration of the records of the state database to the current
+	// encoding in the background, or aborts the running migration
+	handler.router.HandleFunc(constants.StateMigration, handler.migrateState).Methods(http.MethodPost)
+	// HTTP GET "/admin/scrub" returns the status of the last scrub of a state database
+	handler.router.HandleFunc(constants.StateScrub, handler.stateScrubQuery).Methods(http.MethodGet)
+	// HTTP POST "/admin/scrub" starts the scrub of a state database which is in the integrity mode, i.e., the
+	// verification of the stored hashes of its values, in the background
+	handler.router.HandleFunc(constants.StateScrub, handler.scrubState).Methods(http.MethodPost)
+	// HTTP GET "/admin/dropped?since={since}&limit={limit}" lists the dead-letter records of the transactions the node
+	// dropped before they were included in a block, in the order of the drops
+	handler.router.HandleFunc(constants.GetDroppedTxs, handler.droppedTxsQuery).Methods(http.MethodGet).Queries("since", "{since:[0-9]+}", "limit", "{limit:[0-9]+}")
+	handler.router.HandleFunc(constants.GetDroppedTxs, handler.droppedTxsQuery).Methods(http.MethodGet)
+	// HTTP GET "/admin/audit?hash={hash}" returns the admin audit record whose hash, hex-encoded, is given, e.g., the
+	// receipt of an administrative action, and HTTP GET "/admin/audit?since={since}&limit={limit}" lists the admin
+	// audit records in the order of the chain
+	handler.router.HandleFunc(constants.GetAdminAudit, handler.adminAuditQuery).Methods(http.MethodGet).Queries("hash", "{hash}")
+	handler.router.HandleFunc(constants.GetAdminAudit, handler.adminAuditQuery).Methods(http.MethodGet).Queries("since", "{since:[0-9]+}", "limit", "{limit:[0-9]+}")
+	handler.router.HandleFunc(constants.GetAdminAudit, handler.adminAuditQuery).Methods(http.MethodGet)
+	// HTTP GET "/admin/logging" returns the logging level of every module of the server
+	handler.router.HandleFunc(constants.LogLevels, handler.logLevelsQuery).Methods(http.MethodGet)
+	// HTTP PUT "/admin/logging" sets the logging levels of some modules of the server, all at once
+	handler.router.HandleFunc(constants.LogLevels, handler.setLogLevels).Methods(http.MethodPut)
+
+	return handler
+}
+
+// ServeHTTP serves the queries as is, and the administrative actions, i.e., the requests of any other method, through
+// the admin audit chain, so that every admin endpoint that performs an action is audited
+func (a *adminRequestHandler) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if request.Method == http.MethodGet {
+		a.router.ServeHTTP(response, request)
+		return
+	}
+	a.serveAudited(response, request)
+}
+
+func (a *adminRequestHandler) storageStatsQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetStorageStats, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetStorageStatsQuery)
+
+	stats, err := a.db.GetStorageStats(query.GetUserId())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, stats)
+}
+
+func (a *adminRequestHandler) storageHandlesQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetStorageHandles, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetStorageStatsQuery)
+
+	handles, err := a.db.GetStorageHandles(query.GetUserId())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, handles)
+}
+
+func (a *adminRequestHandler) blockStoreStatsQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetBlockStoreStats, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetStorageStatsQuery)
+
+	stats, err := a.db.GetBlockStoreStats(query.GetUserId())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, stats)
+}
+
+func (a *adminRequestHandler) storageMetricsQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetStorageMetrics, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetStorageStatsQuery)
+
+	metrics, err := a.db.GetStorageMetrics(query.GetUserId())
+	latencies := txLatencyMetrics(a.db.TxLatencyHistograms())
+	if err != nil {
+		// the metrics of the query limiter and the latencies are served even if the sampling of the storage metrics
+		// is disabled
+		if _, disabled := err.(*ierrors.ServerRestrictionError); !disabled || (a.queryLimiter == nil && len(latencies) == 0) {
+			a.sendError(response, request, err)
+			return
+		}
+	}
+	metrics = append(latencies, metrics...)
+	if a.queryLimiter != nil {
+		metrics = append(a.queryLimiter.Metrics(), metrics...)
+	}
+
+	exposition := &bytes.Buffer{}
+	if err := leveldb.WriteStorageMetrics(exposition, metrics); err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	response.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	response.WriteHeader(http.StatusOK)
+	if _, err := response.Write(exposition.Bytes()); err != nil {
+		a.logger.Warnf("failed to write the storage metrics to the response writer: %s", err)
+	}
+}
+
+// txLatencyMetrics returns the latency histograms of the sampled transactions as Prometheus histograms, one per stage
+// and validation outcome, whose series are grouped by name: the cumulative buckets, the sums, and the counts.
+func txLatencyMetrics(histograms []*queue.LatencyHistogram) []*leveldb.StorageMetric {
+	var buckets, sums, counts []*leveldb.StorageMetric
+	for _, h := range histograms {
+		var cumulative uint64
+		for i, count := range h.Buckets {
+			cumulative += count
+			le := "+Inf"
+			if i < len(queue.LatencyBucketBounds) {
+				le = strconv.FormatFloat(queue.LatencyBucketBounds[i].Seconds(), 'g', -1, 64)
+			}
+			buckets = append(buckets, &leveldb.StorageMetric{
+				Name:   txLatencyMetricName + "_bucket",
+				Help:   "Number of sampled transactions which spent at most le seconds in the stage.",
+				Type:   leveldb.MetricTypeCounter,
+				Labels: map[string]string{"stage": h.Stage, "outcome": h.Outcome, "le": le},
+				Value:  float64(cumulative),
+			})
+		}
+		sums = append(sums, &leveldb.StorageMetric{
+			Name:   txLatencyMetricName + "_sum",
+			Help:   "Total seconds the sampled transactions spent in the stage.",
+			Type:   leveldb.MetricTypeCounter,
+			Labels: map[string]string{"stage": h.Stage, "outcome": h.Outcome},
+			Value:  h.Sum.Seconds(),
+		})
+		counts = append(counts, &leveldb.StorageMetric{
+			Name:   txLatencyMetricName + "_count",
+			Help:   "Number of sampled transactions which went through the stage.",
+			Type:   leveldb.MetricTypeCounter,
+			Labels: map[string]string{"stage": h.Stage, "outcome": h.Outcome},
+			Value:  float64(h.Count),
+		})
+	}
+
+	return append(append(buckets, sums...), counts...)
+}
+
+func (a *adminRequestHandler) traceValidation(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.PostTraceValidation, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.TraceValidationQuery)
+
+	report, err := a.db.TraceValidation(query.GetUserId(), query.GetBlockNumber())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, report)
+}
+
+func (a *adminRequestHandler) acceptPeerHeader(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.PostAcceptPeerHeader, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.AcceptPeerHeaderQuery)
+
+	resp, err := a.db.AcceptPeerHeader(query.GetUserId(), query.GetBlockNumber())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) resyncDB(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.PostResyncDB, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.ResyncDBQuery)
+
+	resp, err := a.db.ResyncDB(query.GetUserId(), query.GetDbName())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) trustedCheckpointsQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetTrustedCheckpoints, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetTrustedCheckpointsQuery)
+
+	resp, err := a.db.GetTrustedCheckpoints(query.GetUserId(), query.GetInterval())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) stateMigrationQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.StateMigration, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetStateMigrationQuery)
+
+	resp, err := a.db.GetStateMigrationStatus(query.GetUserId())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) migrateState(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.StateMigration, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.StateMigrationQuery)
+
+	resp, err := a.db.MigrateState(query.GetUserId(), query.GetAbort())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) stateScrubQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.StateScrub, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetStateScrubQuery)
+
+	resp, err := a.db.GetStateScrubStatus(query.GetUserId())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) scrubState(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.StateScrub, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.StateScrubQuery)
+
+	resp, err := a.db.ScrubState(query.GetUserId(), query.GetDbName())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) droppedTxsQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetDroppedTxs, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDroppedTxsQuery)
+
+	resp, err := a.db.GetDroppedTxs(query.GetUserId(), query.GetSince(), query.GetLimit(), query.GetCursor())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) adminAuditQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetAdminAudit, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetAdminAuditRecordsQuery)
+
+	resp, err := a.db.GetAdminAuditRecords(query.GetUserId(), query.GetHash(), query.GetSince(), query.GetLimit(), query.GetCursor())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) logLevelsQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.LogLevels, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetLogLevelsQuery)
+
+	resp, err := a.db.GetLogLevels(query.GetUserId())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) setLogLevels(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.LogLevels, a.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.SetLogLevelsQuery)
+
+	resp, err := a.db.SetLogLevels(query.GetUserId(), query.GetLevels())
+	if err != nil {
+		a.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
+
+func (a *adminRequestHandler) sendError(response http.ResponseWriter, request *http.Request, err error) {
+	var status int
+
+	switch err.(type) {
+	case *ierrors.PermissionErr:
+		status = http.StatusForbidden
+	case *ierrors.BadRequestError:
+		status = http.StatusBadRequest
+	case *ierrors.CursorExpiredError:
+		status = http.StatusGone
+	case *ierrors.ServerRestrictionError:
+		status = http.StatusServiceUnavailable
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	utils.SendHTTPResponse(
+		response,
+		status,
+		&types.HttpResponseErr{
+			ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+		})
+}
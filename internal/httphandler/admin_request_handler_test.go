@@ -0,0 +1,1603 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package httphandler
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/adminaudit"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/txvalidation"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAdminRequestHandler_GetStorageStats(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	stats := map[string]*goleveldb.DBStats{
+		"bdb": {
+			IOWrite:           4096,
+			IORead:            1024,
+			BlockCacheSize:    512,
+			OpenedTablesCount: 2,
+			LevelSizes:        []int64{100, 200},
+			LevelTablesCounts: []int{1, 1},
+			LevelRead:         []int64{0, 300},
+			LevelWrite:        []int64{100, 200},
+			LevelDurations:    []time.Duration{10 * time.Millisecond, 20 * time.Millisecond},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: admin retrieves the storage stats",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetStorageStats, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, adminSigner, &types.GetStorageStatsQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetStorageStats", submittingUserName).Return(stats, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetStorageStats, nil)
+				req.Header.Set(constants.UserHeader, "alice")
+				sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetStorageStatsQuery{UserId: "alice"})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("GetStorageStats", "alice").Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to read the storage statistics"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /admin/storage/stats' because the user [alice] has no permission to read the storage statistics",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetStorageStats, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetStorageStatsQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("GetStorageStats %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := make(map[string]*goleveldb.DBStats)
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+			require.Equal(t, stats, res)
+		})
+	}
+}
+
+func TestAdminRequestHandler_GetStorageMetrics(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.GetStorageMetrics, nil)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, adminSigner, &types.GetStorageStatsQuery{UserId: submittingUserName})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	t.Run("metrics in the text exposition format", func(t *testing.T) {
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+		db.On("TxLatencyHistograms").Return(nil)
+		db.On("GetStorageMetrics", submittingUserName).Return([]*leveldb.StorageMetric{
+			{
+				Name:   "orion_storage_leveldb_compaction_write_bytes_total",
+				Help:   "Bytes written by the compactions into a level.",
+				Type:   leveldb.MetricTypeCounter,
+				Labels: map[string]string{"db": "bdb", "level": "0"},
+				Value:  2048,
+			},
+			{
+				Name:   "orion_storage_leveldb_compaction_write_bytes_total",
+				Help:   "Bytes written by the compactions into a level.",
+				Type:   leveldb.MetricTypeCounter,
+				Labels: map[string]string{"db": "bdb", "level": "1"},
+				Value:  1024,
+			},
+		}, nil)
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, nil, logger).ServeHTTP(rr, newRequest())
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "text/plain; version=0.0.4", rr.Header().Get("Content-Type"))
+		require.Equal(t, "# HELP orion_storage_leveldb_compaction_write_bytes_total Bytes written by the compactions into a level.\n"+
+			"# TYPE orion_storage_leveldb_compaction_write_bytes_total counter\n"+
+			"orion_storage_leveldb_compaction_write_bytes_total{db=\"bdb\",level=\"0\"} 2048\n"+
+			"orion_storage_leveldb_compaction_write_bytes_total{db=\"bdb\",level=\"1\"} 1024\n",
+			rr.Body.String())
+	})
+
+	t.Run("metrics disabled", func(t *testing.T) {
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+		db.On("TxLatencyHistograms").Return(nil)
+		db.On("GetStorageMetrics", submittingUserName).Return(nil, &interrors.ServerRestrictionError{ErrMsg: "storage metrics are disabled on this server"})
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, nil, logger).ServeHTTP(rr, newRequest())
+
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		respErr := &types.HttpResponseErr{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+		require.Equal(t, "error while processing 'GET /admin/storage/metrics' because storage metrics are disabled on this server", respErr.ErrMsg)
+	})
+
+	t.Run("query limiter metrics", func(t *testing.T) {
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+		db.On("TxLatencyHistograms").Return(nil)
+		db.On("GetStorageMetrics", submittingUserName).Return(nil, &interrors.ServerRestrictionError{ErrMsg: "storage metrics are disabled on this server"})
+		limiter := NewQueryLimiter(&config.QueryConcurrencyConf{HistoryQueries: 2}, logger)
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, limiter, logger).ServeHTTP(rr, newRequest())
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), "# TYPE orion_query_limiter_limit gauge\n"+
+			"orion_query_limiter_limit{class=\"history\"} 2\n")
+		require.Contains(t, rr.Body.String(), "orion_query_limiter_shed_total{class=\"history\"} 0\n")
+	})
+
+	t.Run("transaction latency histograms", func(t *testing.T) {
+		buckets := make([]uint64, len(queue.LatencyBucketBounds)+1)
+		buckets[0] = 2
+		buckets[3] = 1
+		buckets[len(queue.LatencyBucketBounds)] = 1
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+		db.On("TxLatencyHistograms").Return([]*queue.LatencyHistogram{
+			{
+				Stage:   queue.TxLatencyEndToEnd,
+				Outcome: types.Flag_VALID.String(),
+				Buckets: buckets,
+				Count:   4,
+				Sum:     20*time.Second + 10*time.Millisecond,
+			},
+		})
+		db.On("GetStorageMetrics", submittingUserName).Return(nil, &interrors.ServerRestrictionError{ErrMsg: "storage metrics are disabled on this server"})
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, nil, logger).ServeHTTP(rr, newRequest())
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		body := rr.Body.String()
+		require.Contains(t, body, "# TYPE orion_tx_latency_seconds_bucket counter\n"+
+			"orion_tx_latency_seconds_bucket{le=\"0.001\",outcome=\"VALID\",stage=\"end-to-end\"} 2\n"+
+			"orion_tx_latency_seconds_bucket{le=\"0.002\",outcome=\"VALID\",stage=\"end-to-end\"} 2\n")
+		require.Contains(t, body, "orion_tx_latency_seconds_bucket{le=\"0.01\",outcome=\"VALID\",stage=\"end-to-end\"} 3\n")
+		require.Contains(t, body, "orion_tx_latency_seconds_bucket{le=\"10\",outcome=\"VALID\",stage=\"end-to-end\"} 3\n"+
+			"orion_tx_latency_seconds_bucket{le=\"+Inf\",outcome=\"VALID\",stage=\"end-to-end\"} 4\n")
+		require.Contains(t, body, "orion_tx_latency_seconds_sum{outcome=\"VALID\",stage=\"end-to-end\"} 20.01\n")
+		require.Contains(t, body, "orion_tx_latency_seconds_count{outcome=\"VALID\",stage=\"end-to-end\"} 4\n")
+	})
+}
+
+func TestAdminRequestHandler_GetStorageHandles(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func(userID string, signer crypto.Signer) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.GetStorageHandles, nil)
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.GetStorageStatsQuery{UserId: userID})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	t.Run("live handles", func(t *testing.T) {
+		handles := []*leveldb.HandleInfo{
+			{
+				ID:        3,
+				Kind:      leveldb.HandleKindSnapshot,
+				Owner:     "internal/bcdb.(*worldstateQueryProcessor).getData",
+				DBNames:   []string{"_users", "bdb"},
+				CreatedAt: time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:        7,
+				Kind:      leveldb.HandleKindIterator,
+				Owner:     "internal/bcdb.(*worldstateQueryProcessor).executeJSONQuery",
+				DBNames:   []string{"bdb"},
+				CreatedAt: time.Date(2021, 6, 1, 10, 5, 0, 0, time.UTC),
+			},
+		}
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+		db.On("GetStorageHandles", submittingUserName).Return(handles, nil)
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, nil, logger).ServeHTTP(rr, newRequest(submittingUserName, adminSigner))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var res []*leveldb.HandleInfo
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+		require.Equal(t, handles, res)
+	})
+
+	t.Run("non-admin user", func(t *testing.T) {
+		db := &mocks.DB{}
+		db.On("GetCertificate", "alice").Return(aliceCert, nil)
+		db.On("GetStorageHandles", "alice").Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to read the storage statistics"})
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, nil, logger).ServeHTTP(rr, newRequest("alice", aliceSigner))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+		respErr := &types.HttpResponseErr{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+		require.Equal(t, "error while processing 'GET /admin/storage/handles' because the user [alice] has no permission to read the storage statistics", respErr.ErrMsg)
+	})
+}
+
+func TestAdminRequestHandler_GetBlockStoreStats(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func(userID string, signer crypto.Signer) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.GetBlockStoreStats, nil)
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.GetStorageStatsQuery{UserId: userID})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	t.Run("block store stats", func(t *testing.T) {
+		stats := &blockstore.Stats{Blocks: 10, StoredBytes: 4096, LogicalBytes: 10240}
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+		db.On("GetBlockStoreStats", submittingUserName).Return(stats, nil)
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, nil, logger).ServeHTTP(rr, newRequest(submittingUserName, adminSigner))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		res := &blockstore.Stats{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(res))
+		require.Equal(t, stats, res)
+	})
+
+	t.Run("non-admin user", func(t *testing.T) {
+		db := &mocks.DB{}
+		db.On("GetCertificate", "alice").Return(aliceCert, nil)
+		db.On("GetBlockStoreStats", "alice").Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to read the storage statistics"})
+
+		rr := httptest.NewRecorder()
+		NewAdminRequestHandler(db, nil, logger).ServeHTTP(rr, newRequest("alice", aliceSigner))
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+		respErr := &types.HttpResponseErr{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+		require.Equal(t, "error while processing 'GET /admin/storage/blocks' because the user [alice] has no permission to read the storage statistics", respErr.ErrMsg)
+	})
+}
+
+func TestAdminRequestHandler_TraceValidation(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	report := &txvalidation.TraceReport{
+		BlockNumber: 5,
+		Transactions: []*txvalidation.TxTrace{
+			{
+				TxNum: 0,
+				TxID:  "tx1",
+				Checks: []*txvalidation.TraceCheck{
+					{
+						Name:    "signature",
+						Inputs:  map[string]string{"user": "alice", "certificate": "sha256:00"},
+						Outcome: types.Flag_VALID.String(),
+					},
+					{
+						Name:    "mvcc",
+						Inputs:  map[string]string{"db": "bdb", "key": "key1", "readVersion": "{blockNum: 2, txNum: 0}", "actualVersion": "{blockNum: 3, txNum: 0}"},
+						Outcome: types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE.String(),
+						Reason:  "mvcc conflict has occurred as the committed state for the key [key1] in database [bdb] changed",
+					},
+				},
+				RecordedFlag:    types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE.String(),
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE.String(),
+				ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the key [key1] in database [bdb] changed",
+			},
+		},
+		Matches: true,
+	}
+
+	newRequest := func(userID string, signer crypto.Signer, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, constants.PostTraceValidation, strings.NewReader(body))
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.TraceValidationQuery{UserId: userID, BlockNumber: 5})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: admin traces a block",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"blockNum": 5}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("TraceValidation", submittingUserName, uint64(5)).Return(report, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newRequest("alice", aliceSigner, `{"blockNum": 5}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("TraceValidation", "alice", uint64(5)).Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to trace the validation of a block"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'POST /admin/trace-validation' because the user [alice] has no permission to trace the validation of a block",
+		},
+		{
+			name: "invalid: block is not committed",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"blockNum": 5}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("TraceValidation", submittingUserName, uint64(5)).Return(nil, &interrors.BadRequestError{ErrMsg: "block [5] is not committed, the height of the ledger is [4]"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'POST /admin/trace-validation' because block [5] is not committed, the height of the ledger is [4]",
+		},
+		{
+			name: "invalid: another trace is running",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"blockNum": 5}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("TraceValidation", submittingUserName, uint64(5)).Return(nil, &interrors.ServerRestrictionError{ErrMsg: "the validation of another block is being traced, try again later"})
+				return db
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedErr:        "error while processing 'POST /admin/trace-validation' because the validation of another block is being traced, try again later",
+		},
+		{
+			name: "invalid: malformed request",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"blockNum": "five"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal string into Go struct field TraceValidationRequest.blockNum of type uint64",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, aliceSigner, `{"blockNum": 5}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("TraceValidation %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+			// the actions are recorded in the admin audit chain
+			db.(*mocks.DB).On("RecordAdminAction", mock.Anything).Return([]byte("receipt"), nil)
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &txvalidation.TraceReport{}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(res))
+			require.Equal(t, report, res)
+		})
+	}
+}
+
+func TestAdminRequestHandler_AcceptPeerHeader(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	envelope := &types.AcceptPeerHeaderResponseEnvelope{
+		Response: &types.AcceptPeerHeaderResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Divergence: &types.StateDivergence{
+				BlockNumber: 7,
+				PeerId:      "node2",
+				Fields: []*types.HeaderFieldDivergence{
+					{
+						Field:      "state_merkel_tree_root_hash",
+						LocalValue: "AAAA",
+						PeerValue:  "AQEB",
+					},
+				},
+			},
+		},
+		Signature: []byte{0},
+	}
+
+	newRequest := func(userID string, signer crypto.Signer, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, constants.PostAcceptPeerHeader, strings.NewReader(body))
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.AcceptPeerHeaderQuery{UserId: userID, BlockNumber: 7})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: admin accepts the peer's header",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"blockNum": 7}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("AcceptPeerHeader", submittingUserName, uint64(7)).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newRequest("alice", aliceSigner, `{"blockNum": 7}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("AcceptPeerHeader", "alice", uint64(7)).Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to accept the peer's version of a block"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'POST /admin/divergence/accept' because the user [alice] has no permission to accept the peer's version of a block",
+		},
+		{
+			name: "invalid: node has not halted",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"blockNum": 7}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("AcceptPeerHeader", submittingUserName, uint64(7)).Return(nil, &interrors.BadRequestError{ErrMsg: "the node has not halted on a divergence"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'POST /admin/divergence/accept' because the node has not halted on a divergence",
+		},
+		{
+			name: "invalid: malformed request",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"blockNum": "seven"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal string into Go struct field AcceptPeerHeaderRequest.blockNum of type uint64",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, aliceSigner, `{"blockNum": 7}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("AcceptPeerHeader %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+			// the actions are recorded in the admin audit chain
+			db.(*mocks.DB).On("RecordAdminAction", mock.Anything).Return([]byte("receipt"), nil)
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.AcceptPeerHeaderResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestAdminRequestHandler_ResyncDB(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	envelope := &types.ResyncDBResponseEnvelope{
+		Response: &types.ResyncDBResponse{
+			Header:         &types.ResponseHeader{NodeId: "node1"},
+			DbName:         "db1",
+			ResyncedBlocks: []uint64{4, 5},
+		},
+		Signature: []byte{0},
+	}
+
+	newRequest := func(userID string, signer crypto.Signer, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, constants.PostResyncDB, strings.NewReader(body))
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.ResyncDBQuery{UserId: userID, DbName: "db1"})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: admin resyncs a database",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"dbName": "db1"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("ResyncDB", submittingUserName, "db1").Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newRequest("alice", aliceSigner, `{"dbName": "db1"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("ResyncDB", "alice", "db1").Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to resync a database"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'POST /admin/resync' because the user [alice] has no permission to resync a database",
+		},
+		{
+			name: "invalid: database has no skipped commit",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"dbName": "db1"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("ResyncDB", submittingUserName, "db1").Return(nil, &interrors.BadRequestError{ErrMsg: "the database [db1] has no skipped commit to resync"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'POST /admin/resync' because the database [db1] has no skipped commit to resync",
+		},
+		{
+			name: "invalid: malformed request",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, `{"dbName": 1}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal number into Go struct field ResyncDBRequest.dbName of type string",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, aliceSigner, `{"dbName": "db1"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("ResyncDB %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+			// the actions are recorded in the admin audit chain
+			db.(*mocks.DB).On("RecordAdminAction", mock.Anything).Return([]byte("receipt"), nil)
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.ResyncDBResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestAdminRequestHandler_GetTrustedCheckpoints(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	envelope := &types.GetTrustedCheckpointsResponseEnvelope{
+		Response: &types.GetTrustedCheckpointsResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Checkpoints: &types.TrustedCheckpoints{
+				Checkpoints: []*types.TrustedCheckpoint{
+					{BlockNumber: 10, HeaderHash: []byte("hash10")},
+					{BlockNumber: 15, HeaderHash: []byte("hash15")},
+				},
+			},
+		},
+		Signature: []byte{0},
+	}
+
+	newRequest := func(userID string, signer crypto.Signer, interval uint64) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.URLForGetTrustedCheckpoints(interval), nil)
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.GetTrustedCheckpointsQuery{UserId: userID, Interval: interval})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: default interval",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, 0)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetTrustedCheckpoints", submittingUserName, uint64(0)).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "valid: interval of 10 blocks",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, 10)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetTrustedCheckpoints", submittingUserName, uint64(10)).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newRequest("alice", aliceSigner, 0)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("GetTrustedCheckpoints", "alice", uint64(0)).Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to get the trusted checkpoints"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /admin/checkpoints' because the user [alice] has no permission to get the trusted checkpoints",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, aliceSigner, 0)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("GetTrustedCheckpoints %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetTrustedCheckpointsResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestAdminRequestHandler_LogLevels(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	envelope := &types.GetLogLevelsResponseEnvelope{
+		Response: &types.GetLogLevelsResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Levels: map[string]string{"committer": "debug", "reorderer": "info"},
+		},
+		Signature: []byte{0},
+	}
+	committerToDebug := map[string]string{"committer": "debug"}
+
+	newGetRequest := func(userID string, signer crypto.Signer) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.LogLevels, nil)
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.GetLogLevelsQuery{UserId: userID})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+	newPutRequest := func(userID string, signer crypto.Signer, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPut, constants.LogLevels, strings.NewReader(body))
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.SetLogLevelsQuery{UserId: userID, Levels: committerToDebug})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: admin gets the levels",
+			requestFactory: func() *http.Request {
+				return newGetRequest(submittingUserName, adminSigner)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetLogLevels", submittingUserName).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "valid: admin sets the levels",
+			requestFactory: func() *http.Request {
+				return newPutRequest(submittingUserName, adminSigner, `{"levels": {"committer": "debug"}}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("SetLogLevels", submittingUserName, committerToDebug).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newPutRequest("alice", aliceSigner, `{"levels": {"committer": "debug"}}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("SetLogLevels", "alice", committerToDebug).Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to set the logging levels"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'PUT /admin/logging' because the user [alice] has no permission to set the logging levels",
+		},
+		{
+			name: "invalid: unknown module",
+			requestFactory: func() *http.Request {
+				return newPutRequest(submittingUserName, adminSigner, `{"levels": {"committer": "debug"}}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("SetLogLevels", submittingUserName, committerToDebug).Return(nil, &interrors.BadRequestError{ErrMsg: "unknown logging module [committer]"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'PUT /admin/logging' because unknown logging module [committer]",
+		},
+		{
+			name: "invalid: malformed request",
+			requestFactory: func() *http.Request {
+				return newPutRequest(submittingUserName, adminSigner, `{"levels": ["debug"]}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal array into Go struct field SetLogLevelsRequest.levels of type map[string]string",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newGetRequest(submittingUserName, aliceSigner)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("LogLevels %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+			// the actions are recorded in the admin audit chain
+			db.(*mocks.DB).On("RecordAdminAction", mock.Anything).Return([]byte("receipt"), nil)
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetLogLevelsResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestAdminRequestHandler_GetDroppedTxs(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	envelope := &types.GetDroppedTxsResponseEnvelope{
+		Response: &types.GetDroppedTxsResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			DroppedTxs: []*types.DroppedTx{
+				{TxId: "tx1", Submitter: "alice", Stage: "queued", Reason: "expired", DroppedAt: 1000},
+				{TxId: "tx2", Submitter: "bob", Stage: "proposed", Reason: "not a leader", DroppedAt: 2000},
+			},
+			More: true,
+		},
+		Signature: []byte{0},
+	}
+
+	newRequest := func(userID string, signer crypto.Signer, url string, since int64, limit uint64) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.GetDroppedTxsQuery{UserId: userID, Since: since, Limit: limit})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: a page of the records",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, constants.URLForGetDroppedTxs(1000, 2), 1000, 2)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetDroppedTxs", submittingUserName, int64(1000), uint64(2), "").Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "valid: all the records",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, adminSigner, constants.GetDroppedTxs, 0, 0)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetDroppedTxs", submittingUserName, int64(0), uint64(0), "").Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newRequest("alice", aliceSigner, constants.GetDroppedTxs, 0, 0)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("GetDroppedTxs", "alice", int64(0), uint64(0), "").Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to list the dropped transactions"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /admin/dropped' because the user [alice] has no permission to list the dropped transactions",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newRequest(submittingUserName, aliceSigner, constants.GetDroppedTxs, 0, 0)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("GetDroppedTxs %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetDroppedTxsResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestAdminRequestHandler_StateMigration(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	envelope := &types.StateMigrationResponseEnvelope{
+		Response: &types.StateMigrationResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Status: &types.StateMigrationStatus{
+				State:            types.StateMigrationStatus_RUNNING,
+				MigratedDbs:      []string{"_config", "_dbs"},
+				CurrentDb:        "_users",
+				RewrittenRecords: 12,
+			},
+		},
+		Signature: []byte{0},
+	}
+
+	newGetRequest := func(userID string, signer crypto.Signer) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.StateMigration, nil)
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.GetStateMigrationQuery{UserId: userID})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+	newPostRequest := func(userID string, signer crypto.Signer, abort bool, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, constants.StateMigration, strings.NewReader(body))
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.StateMigrationQuery{UserId: userID, Abort: abort})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: admin gets the status",
+			requestFactory: func() *http.Request {
+				return newGetRequest(submittingUserName, adminSigner)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetStateMigrationStatus", submittingUserName).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "valid: admin starts the migration",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, false, `{}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("MigrateState", submittingUserName, false).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "valid: admin aborts the migration",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, true, `{"abort": true}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("MigrateState", submittingUserName, true).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newPostRequest("alice", aliceSigner, false, `{}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("MigrateState", "alice", false).Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to start the migration of the state database"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'POST /admin/migration' because the user [alice] has no permission to start the migration of the state database",
+		},
+		{
+			name: "invalid: malformed request",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, true, `{"abort": "yes"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal string into Go struct field StateMigrationRequest.abort of type bool",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, false, `{"abort": true}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("StateMigration %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+			// the actions are recorded in the admin audit chain
+			db.(*mocks.DB).On("RecordAdminAction", mock.Anything).Return([]byte("receipt"), nil)
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.StateMigrationResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestAdminRequestHandler_StateScrub(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "alice"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	envelope := &types.StateScrubResponseEnvelope{
+		Response: &types.StateScrubResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Status: &types.StateScrubStatus{
+				State:            types.StateScrubStatus_COMPLETED,
+				DbName:           "db1",
+				VerifiedRecords:  10,
+				UnhashedRecords:  2,
+				CorruptedRecords: 1,
+				CorruptedValues: []*types.CorruptedValue{
+					{Key: "key1", StoredHash: []byte{1}, ComputedHash: []byte{2}},
+				},
+			},
+		},
+		Signature: []byte{0},
+	}
+
+	newGetRequest := func(userID string, signer crypto.Signer) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.StateScrub, nil)
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.GetStateScrubQuery{UserId: userID})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+	newPostRequest := func(userID string, signer crypto.Signer, dbName string, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, constants.StateScrub, strings.NewReader(body))
+		req.Header.Set(constants.UserHeader, userID)
+		sig := testutils.SignatureFromQuery(t, signer, &types.StateScrubQuery{UserId: userID, DbName: dbName})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: admin gets the status",
+			requestFactory: func() *http.Request {
+				return newGetRequest(submittingUserName, adminSigner)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetStateScrubStatus", submittingUserName).Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "valid: admin starts the scrub",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, "db1", `{"db_name": "db1"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("ScrubState", submittingUserName, "db1").Return(envelope, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: database not in the integrity mode",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, "db2", `{"db_name": "db2"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("ScrubState", submittingUserName, "db2").Return(nil, &interrors.BadRequestError{ErrMsg: "database [db2] is not in the integrity mode"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'POST /admin/scrub' because database [db2] is not in the integrity mode",
+		},
+		{
+			name: "invalid: non-admin user",
+			requestFactory: func() *http.Request {
+				return newPostRequest("alice", aliceSigner, "db1", `{"db_name": "db1"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("ScrubState", "alice", "db1").Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to scrub the state database"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'POST /admin/scrub' because the user [alice] has no permission to scrub the state database",
+		},
+		{
+			name: "invalid: malformed request",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, "db1", `{"db_name": 1}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal number into Go struct field StateScrubRequest.db_name of type string",
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				return newPostRequest(submittingUserName, adminSigner, "db1", `{"db_name": "db2"}`)
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("StateScrub %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+			db.(*mocks.DB).On("RecordAdminAction", mock.Anything).Return([]byte("receipt"), nil)
+
+			rr := httptest.NewRecorder()
+			handler := NewAdminRequestHandler(db, nil, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.StateScrubResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestAdminRequestHandler_AdminAudit(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "node1"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	nodeCert, nodeSigner := testutils.LoadTestCrypto(t, cryptoDir, "node1")
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	storeDir, err := ioutil.TempDir("", "adminaudit")
+	require.NoError(t, err)
+	defer os.RemoveAll(storeDir)
+	store, err := adminaudit.Open(&adminaudit.Config{
+		StoreDir: storeDir,
+		NodeID:   "node1",
+		Signer:   nodeSigner,
+		Logger:   logger,
+	})
+	require.NoError(t, err)
+	defer store.Close()
+
+	levelsEnvelope := &types.GetLogLevelsResponseEnvelope{
+		Response: &types.GetLogLevelsResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Levels: map[string]string{"committer": "debug"},
+		},
+		Signature: []byte{0},
+	}
+	committerToDebug := map[string]string{"committer": "debug"}
+
+	db := &mocks.DB{}
+	db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+	db.On("GetLogLevels", submittingUserName).Return(levelsEnvelope, nil)
+	db.On("SetLogLevels", submittingUserName, committerToDebug).Return(levelsEnvelope, nil)
+	db.On("ResyncDB", submittingUserName, "db1").Return(nil, &interrors.BadRequestError{ErrMsg: "the database [db1] has no skipped commit to resync"})
+	var recordErr error
+	db.On("RecordAdminAction", mock.Anything).Return(
+		func(record *types.AdminAuditRecord) []byte {
+			var hash []byte
+			hash, recordErr = store.Append(record)
+			return hash
+		},
+		func(record *types.AdminAuditRecord) error {
+			return recordErr
+		},
+	)
+	handler := NewAdminRequestHandler(db, nil, logger)
+
+	// a query is not an action, and is not recorded
+	req := httptest.NewRequest(http.MethodGet, constants.LogLevels, nil)
+	req.Header.Set(constants.UserHeader, submittingUserName)
+	sig := testutils.SignatureFromQuery(t, adminSigner, &types.GetLogLevelsQuery{UserId: submittingUserName})
+	req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Empty(t, rr.Header().Get(constants.AdminAuditReceiptHeader))
+
+	setLevelsBody := `{"levels": {"committer": "debug"}}`
+	req = httptest.NewRequest(http.MethodPut, constants.LogLevels, strings.NewReader(setLevelsBody))
+	req.Header.Set(constants.UserHeader, submittingUserName)
+	sig = testutils.SignatureFromQuery(t, adminSigner, &types.SetLogLevelsQuery{UserId: submittingUserName, Levels: committerToDebug})
+	req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	res := &types.GetLogLevelsResponseEnvelope{}
+	require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+	require.True(t, proto.Equal(levelsEnvelope, res))
+	receipt1, err := hex.DecodeString(rr.Header().Get(constants.AdminAuditReceiptHeader))
+	require.NoError(t, err)
+	digest1 := sha256.Sum256(rr.Body.Bytes())
+
+	// a failed action is recorded as well
+	req = httptest.NewRequest(http.MethodPost, constants.PostResyncDB, strings.NewReader(`{"dbName": "db1"}`))
+	req.Header.Set(constants.UserHeader, submittingUserName)
+	sig = testutils.SignatureFromQuery(t, adminSigner, &types.ResyncDBQuery{UserId: submittingUserName, DbName: "db1"})
+	req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	receipt2, err := hex.DecodeString(rr.Header().Get(constants.AdminAuditReceiptHeader))
+	require.NoError(t, err)
+
+	record1, err := store.Get(receipt1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), record1.GetSequence())
+	require.Equal(t, submittingUserName, record1.GetUserId())
+	require.Equal(t, http.MethodPut, record1.GetMethod())
+	require.Equal(t, constants.LogLevels, record1.GetEndpoint())
+	require.Equal(t, []byte(setLevelsBody), record1.GetBody())
+	require.Equal(t, int32(http.StatusOK), record1.GetStatusCode())
+	require.Equal(t, digest1[:], record1.GetResponseDigest())
+
+	record2, err := store.Get(receipt2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), record2.GetSequence())
+	require.Equal(t, receipt1, record2.GetPreviousHash())
+	require.Equal(t, constants.PostResyncDB, record2.GetEndpoint())
+	require.Equal(t, int32(http.StatusBadRequest), record2.GetStatusCode())
+	require.NoError(t, adminaudit.VerifyChain([]*types.AdminAuditRecord{record1, record2}, nodeCert.Raw))
+
+	// the records are served to the admin
+	auditEnvelope := &types.GetAdminAuditRecordsResponseEnvelope{
+		Response: &types.GetAdminAuditRecordsResponse{
+			Header:  &types.ResponseHeader{NodeId: "node1"},
+			Records: []*types.AdminAuditRecord{record2},
+		},
+		Signature: []byte{0},
+	}
+	db.On("GetAdminAuditRecords", submittingUserName, receipt2, uint64(0), uint64(0), "").Return(auditEnvelope, nil)
+
+	req = httptest.NewRequest(http.MethodGet, constants.GetAdminAudit+"?hash="+hex.EncodeToString(receipt2), nil)
+	req.Header.Set(constants.UserHeader, submittingUserName)
+	sig = testutils.SignatureFromQuery(t, adminSigner, &types.GetAdminAuditRecordsQuery{UserId: submittingUserName, Hash: receipt2})
+	req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	auditRes := &types.GetAdminAuditRecordsResponseEnvelope{}
+	require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), auditRes))
+	require.True(t, proto.Equal(auditEnvelope, auditRes))
+
+	req = httptest.NewRequest(http.MethodGet, constants.GetAdminAudit+"?hash=xyz", nil)
+	req.Header.Set(constants.UserHeader, submittingUserName)
+	req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	respErr := &types.HttpResponseErr{}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+	require.Equal(t, "hash is not a valid hex string: encoding/hex: invalid byte: U+0078 'x'", respErr.ErrMsg)
+}
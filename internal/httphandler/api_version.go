@@ -0,0 +1,188 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// responseAdapter reshapes, in place, a message of the next API version into its shape in an earlier version
+type responseAdapter func(m protoreflect.Message)
+
+// responseAdapters holds, for each API version but the current one, the adapters that reshape the messages of the
+// next version into the shape of that version, by the full name of the type of the message. A response is reshaped
+// into a version by applying the adapters of every version from the current one down to the requested one.
+var responseAdapters = map[string]map[protoreflect.FullName]responseAdapter{
+	constants.APIVersion1_0: {
+		(&types.ValidationInfo{}).ProtoReflect().Descriptor().FullName(): func(m protoreflect.Message) {
+			info := m.Interface().(*types.ValidationInfo)
+			info.ReasonIfInvalid = ""
+			info.WriteSetDigest = nil
+			info.ConflictingReads = nil
+			info.Dependency = nil
+		},
+		(&types.TxReceipt{}).ProtoReflect().Descriptor().FullName(): func(m protoreflect.Message) {
+			receipt := m.Interface().(*types.TxReceipt)
+			receipt.WriteSetDigest = nil
+			receipt.ConflictingReads = nil
+			receipt.Sizes = nil
+		},
+	},
+}
+
+var majorVersionPrefix = regexp.MustCompile(`^/v[0-9]+(/|$)`)
+
+// VersionAPI serves the requests to the given handler in the version of the HTTP API they negotiate. The major
+// version is selected by the path prefix, which is stripped before the request is routed, and the minor version by
+// the APIVersionHeader. A request for a version the server does not serve is rejected with a problem detail that
+// lists the supported versions. The responses of the handler are shaped into the negotiated version.
+func VersionAPI(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if prefix := majorVersionPrefix.FindString(r.URL.Path); prefix != "" {
+			if strings.TrimSuffix(prefix, "/") != constants.APIVersionPrefix {
+				sendUnsupportedVersion(w, fmt.Sprintf("the major version of the path [%s] is not supported", r.URL.Path))
+				return
+			}
+
+			r2 := new(http.Request)
+			*r2 = *r
+			r2.URL = new(url.URL)
+			*r2.URL = *r.URL
+			r2.URL.Path = strings.TrimPrefix(r.URL.Path, constants.APIVersionPrefix)
+			r2.URL.RawPath = strings.TrimPrefix(r.URL.RawPath, constants.APIVersionPrefix)
+			r = r2
+		}
+
+		version := r.Header.Get(constants.APIVersionHeader)
+		if version == "" {
+			version = constants.CurrentAPIVersion
+		}
+		if !isSupportedAPIVersion(version) {
+			sendUnsupportedVersion(w, fmt.Sprintf("the API version [%s] is not supported", version))
+			return
+		}
+
+		w.Header().Set(constants.APIVersionHeader, version)
+		if version != constants.CurrentAPIVersion {
+			w = &shapingResponseWriter{ResponseWriter: w, version: version}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isSupportedAPIVersion(version string) bool {
+	for _, v := range constants.SupportedAPIVersions() {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+func sendUnsupportedVersion(w http.ResponseWriter, detail string) {
+	utils.SendHTTPProblem(w, &utils.Problem{
+		Type:              "about:blank",
+		Title:             "Unsupported API Version",
+		Status:            http.StatusBadRequest,
+		Detail:            detail,
+		SupportedVersions: constants.SupportedAPIVersions(),
+	})
+}
+
+// shapingResponseWriter shapes the responses into an earlier version of the HTTP API.
+type shapingResponseWriter struct {
+	http.ResponseWriter
+	version string
+}
+
+// ShapeResponse returns a copy of the message, reshaped by the adapters of every version from the current one down
+// to the version of the writer, or the message itself if none of the adapters applies to it.
+func (w *shapingResponseWriter) ShapeResponse(m proto.Message) proto.Message {
+	versions := constants.SupportedAPIVersions()
+	var adapters []map[protoreflect.FullName]responseAdapter
+	for i := len(versions) - 2; i >= 0; i-- {
+		adapters = append(adapters, responseAdapters[versions[i]])
+		if versions[i] == w.version {
+			break
+		}
+	}
+
+	if !needsShaping(m.ProtoReflect().Descriptor(), adapters, make(map[protoreflect.FullName]bool)) {
+		return m
+	}
+	shaped := proto.Clone(m)
+	for _, a := range adapters {
+		shapeMessage(shaped.ProtoReflect(), a)
+	}
+	return shaped
+}
+
+// Flush serves the streamed responses.
+func (w *shapingResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// needsShaping returns true if a message of the given type may hold a message of a type that one of the adapters
+// applies to
+func needsShaping(desc protoreflect.MessageDescriptor, adapters []map[protoreflect.FullName]responseAdapter, visited map[protoreflect.FullName]bool) bool {
+	if visited[desc.FullName()] {
+		return false
+	}
+	visited[desc.FullName()] = true
+
+	for _, a := range adapters {
+		if _, ok := a[desc.FullName()]; ok {
+			return true
+		}
+	}
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && needsShaping(fd.Message(), adapters, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// shapeMessage applies the adapters to the message and to the messages it holds, depth first
+func shapeMessage(m protoreflect.Message, adapters map[protoreflect.FullName]responseAdapter) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				shapeMessage(list.Get(i).Message(), adapters)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				shapeMessage(mv.Message(), adapters)
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			shapeMessage(v.Message(), adapters)
+		}
+		return true
+	})
+
+	if adapt, ok := adapters[m.Descriptor().FullName()]; ok {
+		adapt(m)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVersionAPI(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	receipt := &types.TxReceiptResponseEnvelope{
+		Response: &types.TxReceiptResponse{
+			Header: &types.ResponseHeader{
+				NodeId: "testNodeID",
+			},
+			Receipt: &types.TxReceipt{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number: 2,
+					},
+					ValidationInfo: []*types.ValidationInfo{
+						{
+							Flag:           types.Flag_VALID,
+							WriteSetDigest: []byte{1, 2, 3},
+						},
+						{
+							Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+							ReasonIfInvalid: "the version of key [key1] changed",
+							ConflictingReads: []*types.ConflictingRead{
+								{
+									DbName: "bdb",
+									Key:    "key1",
+								},
+							},
+						},
+					},
+				},
+				TxIndex: 1,
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName: "bdb",
+						Key:    "key1",
+					},
+				},
+			},
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	db := &mocks.DB{}
+	db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+	db.On("GetTxReceipt", submittingUserName, "tx1").Return(receipt, nil)
+	handler := VersionAPI(NewLedgerRequestHandler(db, logger))
+
+	newRequest := func(url, version string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetTxReceiptQuery{
+			UserId: submittingUserName,
+			TxId:   "tx1",
+		})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		if version != "" {
+			req.Header.Set(constants.APIVersionHeader, version)
+		}
+		return req
+	}
+
+	getReceipt := func(t *testing.T, req *http.Request, expectedVersion string) (*types.TxReceiptResponseEnvelope, map[string]interface{}) {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, expectedVersion, rr.Header().Get(constants.APIVersionHeader))
+
+		body, err := ioutil.ReadAll(rr.Body)
+		require.NoError(t, err)
+		res := &types.TxReceiptResponseEnvelope{}
+		require.NoError(t, protojson.Unmarshal(body, res))
+		raw := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(body, &raw))
+		return res, raw
+	}
+
+	validationInfo := func(raw map[string]interface{}) []interface{} {
+		rcpt := raw["response"].(map[string]interface{})["receipt"].(map[string]interface{})
+		return rcpt["header"].(map[string]interface{})["validation_info"].([]interface{})
+	}
+
+	t.Run("current version", func(t *testing.T) {
+		for _, req := range []*http.Request{
+			newRequest(constants.URLForGetTransactionReceipt("tx1"), ""),
+			newRequest(constants.URLForGetTransactionReceipt("tx1"), constants.APIVersion1_1),
+			newRequest(constants.APIVersionPrefix+constants.URLForGetTransactionReceipt("tx1"), constants.APIVersion1_1),
+		} {
+			res, raw := getReceipt(t, req, constants.APIVersion1_1)
+			require.True(t, proto.Equal(receipt, res))
+
+			info := validationInfo(raw)
+			require.Contains(t, info[0], "write_set_digest")
+			require.Contains(t, info[1], "reason_if_invalid")
+			require.Contains(t, info[1], "conflicting_reads")
+		}
+	})
+
+	t.Run("previous version", func(t *testing.T) {
+		for _, req := range []*http.Request{
+			newRequest(constants.URLForGetTransactionReceipt("tx1"), constants.APIVersion1_0),
+			newRequest(constants.APIVersionPrefix+constants.URLForGetTransactionReceipt("tx1"), constants.APIVersion1_0),
+		} {
+			res, raw := getReceipt(t, req, constants.APIVersion1_0)
+
+			info := validationInfo(raw)
+			// the flag of a valid transaction is the default value, hence it is omitted
+			require.Empty(t, info[0])
+			require.Equal(t, map[string]interface{}{"flag": "INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE"}, info[1])
+			require.Empty(t, res.Response.Receipt.ConflictingReads)
+			require.Equal(t, uint64(1), res.Response.Receipt.TxIndex)
+			require.Equal(t, uint64(2), res.Response.Receipt.Header.BaseHeader.Number)
+		}
+
+		// the response held by the database is not reshaped
+		require.Equal(t, "the version of key [key1] changed", receipt.Response.Receipt.Header.ValidationInfo[1].ReasonIfInvalid)
+		require.Len(t, receipt.Response.Receipt.ConflictingReads, 1)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		for _, req := range []*http.Request{
+			newRequest(constants.URLForGetTransactionReceipt("tx1"), "0.9"),
+			newRequest(constants.URLForGetTransactionReceipt("tx1"), "2.0"),
+			newRequest("/v2"+constants.URLForGetTransactionReceipt("tx1"), ""),
+		} {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Equal(t, utils.ProblemJSON, rr.Header().Get("Content-Type"))
+
+			problem := &utils.Problem{}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(problem))
+			require.Equal(t, http.StatusBadRequest, problem.Status)
+			require.Equal(t, []string{constants.APIVersion1_0, constants.APIVersion1_1}, problem.SupportedVersions)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package httphandler
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// clusterRequestHandler handles the queries about the liveness of the nodes in the cluster
+type clusterRequestHandler struct {
+	db          bcdb.DB
+	sigVerifier *cryptoservice.SignatureVerifier
+	router      *mux.Router
+	logger      *logger.SugarLogger
+}
+
+// NewClusterRequestHandler returns the cluster queries request handler
+func NewClusterRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handler {
+	handler := &clusterRequestHandler{
+		db:          db,
+		sigVerifier: cryptoservice.NewVerifier(db, logger),
+		router:      mux.NewRouter(),
+		logger:      logger,
+	}
+
+	// HTTP GET "/cluster/status" returns the last heartbeat of each node and its staleness
+	handler.router.HandleFunc(constants.GetClusterHeartbeats, handler.heartbeatsQuery).Methods(http.MethodGet)
+
+	return handler
+}
+
+func (c *clusterRequestHandler) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	c.router.ServeHTTP(response, request)
+}
+
+func (c *clusterRequestHandler) heartbeatsQuery(response http.ResponseWriter, request *http.Request) {
+	_, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetClusterHeartbeats, c.sigVerifier)
+	if respondedErr {
+		return
+	}
+
+	heartbeats, err := c.db.GetClusterHeartbeats()
+	if err != nil {
+		utils.SendHTTPResponse(
+			response,
+			http.StatusInternalServerError,
+			&types.HttpResponseErr{ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error()},
+		)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, heartbeats)
+}
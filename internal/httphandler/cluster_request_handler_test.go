@@ -0,0 +1,136 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package httphandler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClusterRequestHandler_GetClusterHeartbeats(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice", "bob"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+	_, bobSigner := testutils.LoadTestCrypto(t, cryptoDir, "bob")
+
+	heartbeats := &types.GetClusterHeartbeatsResponseEnvelope{
+		Response: &types.GetClusterHeartbeatsResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			Heartbeats: []*types.NodeHeartbeat{
+				{
+					NodeId:              "node1",
+					Version:             "0.1",
+					LastCommittedHeight: 10,
+					BlockNumber:         11,
+					Staleness:           1,
+				},
+				{
+					NodeId:    "node2",
+					Staleness: 12,
+				},
+			},
+			Height: 12,
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: a user retrieves the heartbeats",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetClusterHeartbeats, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetClusterHeartbeatsQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetClusterHeartbeats").Return(heartbeats, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetClusterHeartbeats, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, bobSigner, &types.GetClusterHeartbeatsQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+		{
+			name: "invalid: error while reading the heartbeats",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetClusterHeartbeats, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetClusterHeartbeatsQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetClusterHeartbeats").Return(nil, errors.New("leveldb closed"))
+				return db
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedErr:        "error while processing 'GET /cluster/status' because leveldb closed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("GetClusterHeartbeats %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+
+			rr := httptest.NewRecorder()
+			handler := NewClusterRequestHandler(db, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetClusterHeartbeatsResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(heartbeats, res))
+		})
+	}
+}
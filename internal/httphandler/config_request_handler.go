@@ -4,7 +4,6 @@ package httphandler
 
 import (
 	"fmt"
-	"io/ioutil"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -43,6 +42,8 @@ func NewConfigRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handle
 	handler.router.HandleFunc(constants.GetConfig, handler.configQuery).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.GetLastConfigBlock, handler.configBlockQuery).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.GetNodeConfig, handler.nodeQuery).Methods(http.MethodGet)
+	// HTTP GET "/config/limits" returns the limits on data transactions
+	handler.router.HandleFunc(constants.GetConfigLimits, handler.limitsQuery).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.PostConfigTx, handler.configTransaction).Methods(http.MethodPost)
 	// HTTP GET "/config/cluster?nocert=true" returns nodes without certificates
 	handler.router.HandleFunc(constants.GetClusterStatus, handler.clusterStatusQuery).Methods(http.MethodGet).Queries("nocert", "{noCertificates:true|false}")
@@ -109,6 +110,23 @@ func (c *configRequestHandler) configBlockQuery(response http.ResponseWriter, re
 	utils.SendHTTPResponse(response, http.StatusOK, configBlockResponseEnvelope)
 }
 
+func (c *configRequestHandler) limitsQuery(response http.ResponseWriter, request *http.Request) {
+	if _, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetConfigLimits, c.sigVerifier); respondedErr {
+		return
+	}
+
+	limits, err := c.db.GetConfigLimits()
+	if err != nil {
+		utils.SendHTTPResponse(
+			response,
+			http.StatusInternalServerError,
+			&types.HttpResponseErr{ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error()},
+		)
+		return
+	}
+	utils.SendHTTPResponse(response, http.StatusOK, limits)
+}
+
 func (c *configRequestHandler) clusterStatusQuery(response http.ResponseWriter, request *http.Request) {
 	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetClusterStatus, c.sigVerifier)
 	if respondedErr {
@@ -158,9 +176,8 @@ func (c *configRequestHandler) configTransaction(response http.ResponseWriter, r
 		return
 	}
 
-	requestBytes, err := ioutil.ReadAll(request.Body)
-	if err != nil {
-		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+	requestBytes, done := readRequestBody(response, request)
+	if done {
 		return
 	}
 
@@ -5,18 +5,20 @@ package httphandler
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/hyperledger-labs/orion-server/internal/bcdb"
 	"github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -29,6 +31,8 @@ type dataRequestHandler struct {
 	router      *mux.Router
 	txHandler   *txHandler
 	logger      *logger.SugarLogger
+	// minHeightTimeout bounds the wait of a height-pinned query
+	minHeightTimeout time.Duration
 }
 
 // NewDataRequestHandler returns handler capable to serve incoming data requests
@@ -40,7 +44,8 @@ func NewDataRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handler
 		txHandler: &txHandler{
 			db: db,
 		},
-		logger: logger,
+		logger:           logger,
+		minHeightTimeout: defaultMinHeightTimeout,
 	}
 
 	rangeKeys := []string{
@@ -49,10 +54,32 @@ func NewDataRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handler
 		"limit", "{limit}",
 	}
 
+	// HTTP GET "/data/{dbname}?startkey={startkey}&endkey={endkey}&limit={limit}&asof={asOf}" gets a range of
+	// values as of a past block
+	handler.router.HandleFunc(constants.GetDataRange, handler.dataRangeQuery).Methods(http.MethodGet).Queries(append(rangeKeys, "asof", "{asOf:[0-9]+}")...)
+	// HTTP GET "/data/{dbname}?startkey={startkey}&endkey={endkey}&limit={limit}&analytical={analytical}" gets a
+	// range of values from the read replica of the state database
+	handler.router.HandleFunc(constants.GetDataRange, handler.dataRangeQuery).Methods(http.MethodGet).Queries(append(rangeKeys, "analytical", "{analytical:true|false}")...)
 	handler.router.HandleFunc(constants.GetDataRange, handler.dataRangeQuery).Methods(http.MethodGet).Queries(rangeKeys...)
+	// HTTP GET "/data/{dbname}/count?startkey={startkey}&endkey={endkey}", "/data/{dbname}/count?prefix={prefix}", and
+	// "/data/{dbname}/count?query={query}" count the readable keys of a range, of a prefix, or matching a JSON query;
+	// "/data/{dbname}/exists" takes the same parameters and checks whether any such key exists. Without any of these
+	// parameters, "count" and "exists" are keys read by GetData.
+	for _, endpoint := range []string{constants.GetDataCount, constants.GetDataExists} {
+		handler.router.HandleFunc(endpoint, handler.dataCountQuery).Methods(http.MethodGet).Queries("startkey", "{startkey}", "endkey", "{endkey}")
+		handler.router.HandleFunc(endpoint, handler.dataCountQuery).Methods(http.MethodGet).Queries("prefix", "{prefix}")
+		handler.router.HandleFunc(endpoint, handler.dataCountQuery).Methods(http.MethodGet).Queries("query", "{query}")
+	}
+	// HTTP GET "/data/{dbname}/{key}?asof={asOf}" gets the value of a key as of a past block
+	handler.router.HandleFunc(constants.GetData, handler.dataQuery).Methods(http.MethodGet).Queries("asof", "{asOf:[0-9]+}")
 	handler.router.HandleFunc(constants.GetData, handler.dataQuery).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.PostDataTx, handler.dataTransaction).Methods(http.MethodPost)
+	handler.router.HandleFunc(constants.PostVoidTx, handler.voidTransaction).Methods(http.MethodPost)
 	handler.router.HandleFunc(constants.PostDataQuery, handler.dataJSONQuery).Methods(http.MethodPost)
+	// HTTP POST "/data/{dbname}/multiget" reads the keys given in the body from a single snapshot of the state
+	handler.router.HandleFunc(constants.PostDataMultiGet, handler.dataMultiQuery).Methods(http.MethodPost)
+	// HTTP POST "/data/{dbname}/subscribe" streams the changes of the subscribed keys until the client disconnects
+	handler.router.HandleFunc(constants.PostSubscribeKeys, handler.subscribeKeys).Methods(http.MethodPost)
 
 	return handler
 }
@@ -75,13 +102,27 @@ func (d *dataRequestHandler) dataQuery(response http.ResponseWriter, request *ht
 		return
 	}
 
-	data, err := d.db.GetData(query.DbName, query.UserId, query.Key)
+	if query.AsOf == 0 && awaitMinHeight(response, request, d.db, d.minHeightTimeout) {
+		return
+	}
+
+	var data *types.GetDataResponseEnvelope
+	var err error
+	if query.AsOf > 0 {
+		data, err = d.db.GetDataAsOf(query.DbName, query.UserId, query.Key, query.AsOf)
+	} else {
+		data, err = d.db.GetData(query.DbName, query.UserId, query.Key)
+	}
 	if err != nil {
 		var status int
 
 		switch err.(type) {
 		case *errors.PermissionErr:
 			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		case *errors.ServerRestrictionError:
+			status = http.StatusServiceUnavailable
 		default:
 			status = http.StatusInternalServerError
 		}
@@ -112,13 +153,130 @@ func (d *dataRequestHandler) dataRangeQuery(response http.ResponseWriter, reques
 		return
 	}
 
-	data, err := d.db.GetDataRange(query.DbName, query.UserId, query.StartKey, query.EndKey, query.Limit)
+	// the replica lags behind the state database by design, hence, an analytical query does not await a height
+	if query.AsOf == 0 && !query.Analytical && awaitMinHeight(response, request, d.db, d.minHeightTimeout) {
+		return
+	}
+
+	var data *types.GetDataRangeResponseEnvelope
+	var err error
+	switch {
+	case query.AsOf > 0:
+		data, err = d.db.GetDataRangeAsOf(query.DbName, query.UserId, query.StartKey, query.EndKey, query.Limit, query.AsOf, query.Cursor)
+	case query.Analytical:
+		data, err = d.db.GetDataRangeAnalytical(query.DbName, query.UserId, query.StartKey, query.EndKey, query.Limit, query.Cursor)
+	default:
+		data, err = d.db.GetDataRange(query.DbName, query.UserId, query.StartKey, query.EndKey, query.Limit, query.Cursor)
+	}
 	if err != nil {
 		var status int
 
 		switch err.(type) {
 		case *errors.PermissionErr:
 			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		case *errors.CursorExpiredError:
+			status = http.StatusGone
+		case *errors.ServerRestrictionError:
+			status = http.StatusServiceUnavailable
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
+func (d *dataRequestHandler) dataCountQuery(response http.ResponseWriter, request *http.Request) {
+	queryType := constants.GetDataCount
+	if strings.HasSuffix(request.URL.Path, "/exists") {
+		queryType = constants.GetDataExists
+	}
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, queryType, d.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDataCountQuery)
+
+	if !d.db.IsDBExists(query.DbName) {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{
+			ErrMsg: "error db '" + query.DbName + "' doesn't exist",
+		})
+		return
+	}
+
+	if awaitMinHeight(response, request, d.db, d.minHeightTimeout) {
+		return
+	}
+
+	startKey, endKey := query.StartKey, query.EndKey
+	if query.Prefix != "" {
+		startKey, endKey = worldstate.PrefixRange(query.Prefix)
+	}
+
+	data, err := d.db.CountData(query.DbName, query.UserId, startKey, endKey, query.Query, query.ExistsOnly)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
+func (d *dataRequestHandler) dataMultiQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.PostDataMultiGet, d.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDataMultiQuery)
+
+	if !d.db.IsDBExists(query.DbName) {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{
+			ErrMsg: "error db '" + query.DbName + "' doesn't exist",
+		})
+		return
+	}
+
+	if awaitMinHeight(response, request, d.db, d.minHeightTimeout) {
+		return
+	}
+
+	data, err := d.db.GetDataMulti(query.DbName, query.UserId, query.Keys)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		case *errors.ServerRestrictionError:
+			status = http.StatusServiceUnavailable
 		default:
 			status = http.StatusInternalServerError
 		}
@@ -142,13 +300,9 @@ func (d *dataRequestHandler) dataTransaction(response http.ResponseWriter, reque
 		return
 	}
 
-	// requestData := json.NewDecoder(request.Body)
-	// requestData.DisallowUnknownFields()
-
-	requestBody, err := ioutil.ReadAll(request.Body)
-	if err != nil {
-		utils.SendHTTPResponse(response, http.StatusBadRequest,
-			&types.HttpResponseErr{ErrMsg: err.Error()})
+	requestBody, done := readRequestBody(response, request)
+	if done {
+		return
 	}
 
 	txEnv := &types.DataTxEnvelope{}
@@ -169,6 +323,12 @@ func (d *dataRequestHandler) dataTransaction(response http.ResponseWriter, reque
 		return
 	}
 
+	if txEnv.ImportProof != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: "an import proof is only attached by a node to the transactions of a database import"})
+		return
+	}
+
 	var notSigned []string
 	for _, user := range txEnv.Payload.MustSignUserIds {
 		if user == "" {
@@ -195,6 +355,56 @@ func (d *dataRequestHandler) dataTransaction(response http.ResponseWriter, reque
 		}
 	}
 
+	if hints := parseReadHintsHeader(&request.Header); len(hints) > 0 {
+		d.db.PrefetchReads(hints)
+	}
+
+	d.txHandler.handleTransaction(response, request, txEnv, timeout)
+}
+
+// voidTransaction submits a void transaction, which reserves its ID, and the sequence number it carries, without
+// writing to the state.
+func (d *dataRequestHandler) voidTransaction(response http.ResponseWriter, request *http.Request) {
+	timeout, err := validateAndParseTxPostHeader(&request.Header)
+	if err != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	requestBody, done := readRequestBody(response, request)
+	if done {
+		return
+	}
+
+	txEnv := &types.VoidTxEnvelope{}
+	if err := protojson.Unmarshal(requestBody, txEnv); err != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	if txEnv.Payload == nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing transaction envelope payload (%T)", txEnv.Payload)})
+		return
+	}
+
+	if txEnv.Payload.UserId == "" {
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing UserID in transaction envelope payload (%T)", txEnv.Payload)})
+		return
+	}
+
+	if len(txEnv.Signature) == 0 {
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing Signature in transaction envelope payload (%T)", txEnv.Payload)})
+		return
+	}
+
+	if err, code := VerifyRequestSignature(d.sigVerifier, txEnv.Payload.UserId, txEnv.Signature, txEnv.Payload); err != nil {
+		utils.SendHTTPResponse(response, code, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
 	d.txHandler.handleTransaction(response, request, txEnv, timeout)
 }
 
@@ -212,8 +422,12 @@ func (d *dataRequestHandler) dataJSONQuery(response http.ResponseWriter, request
 		return
 	}
 
+	if awaitMinHeight(response, request, d.db, d.minHeightTimeout) {
+		return
+	}
+
 	parent := request.Context()
-	data, err := d.db.DataQuery(parent, query.DbName, query.UserId, []byte(query.Query))
+	data, err := d.db.DataQuery(parent, query.DbName, query.UserId, []byte(query.Query), query.Limit, query.Cursor)
 
 	select {
 	case <-parent.Done():
@@ -231,6 +445,10 @@ func (d *dataRequestHandler) dataJSONQuery(response http.ResponseWriter, request
 			switch err.(type) {
 			case *errors.PermissionErr:
 				status = http.StatusForbidden
+			case *errors.BadRequestError:
+				status = http.StatusBadRequest
+			case *errors.CursorExpiredError:
+				status = http.StatusGone
 			default:
 				status = http.StatusInternalServerError
 			}
@@ -247,3 +465,80 @@ func (d *dataRequestHandler) dataJSONQuery(response http.ResponseWriter, request
 		utils.SendHTTPResponse(response, http.StatusOK, data)
 	}
 }
+
+// subscribeKeys streams a notification of the subscribed keys for every committed block that changes any of them, one
+// JSON encoded KeyChangesResponseEnvelope per line. The subscription is removed once the client disconnects, and the
+// stream ends if the subscription is dropped, e.g., when the client does not keep up with the notifications.
+func (d *dataRequestHandler) subscribeKeys(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.PostSubscribeKeys, d.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.SubscribeKeysQuery)
+
+	flusher, ok := response.(http.Flusher)
+	if !ok {
+		utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{
+			ErrMsg: "the connection does not support streaming",
+		})
+		return
+	}
+
+	if !d.db.IsDBExists(query.DbName) {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{
+			ErrMsg: "'" + query.DbName + "' does not exist",
+		})
+		return
+	}
+
+	notifications, cancel, err := d.db.SubscribeKeys(query.UserId, query.DbName, query.Keys, query.Prefixes)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+	defer cancel()
+
+	response.Header().Set("Content-Type", "application/x-ndjson")
+	response.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-request.Context().Done():
+			d.logger.Debugf("the subscriber [%s] to database [%s] disconnected", query.UserId, query.DbName)
+			return
+
+		case envelope, ok := <-notifications:
+			if !ok {
+				d.logger.Debugf("the subscription of [%s] to database [%s] was dropped", query.UserId, query.DbName)
+				return
+			}
+			line, err := marshal.DefaultMarshaler().Marshal(envelope)
+			if err != nil {
+				d.logger.Errorf("error while marshaling a notification: %s", err)
+				return
+			}
+			if _, err = response.Write(append(line, '\n')); err != nil {
+				d.logger.Debugf("error while writing a notification to the subscriber [%s]: %s", query.UserId, err)
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
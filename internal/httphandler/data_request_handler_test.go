@@ -17,6 +17,7 @@ import (
 	"github.com/hyperledger-labs/orion-server/internal/bcdb"
 	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
 	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
 	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/marshal"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
@@ -24,6 +25,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestDataRequestHandler_DataQuery(t *testing.T) {
@@ -40,6 +42,13 @@ func TestDataRequestHandler_DataQuery(t *testing.T) {
 		Key:    "foo",
 	})
 
+	sigFooAsOf := testutils.SignatureFromQuery(t, aliceSigner, &types.GetDataQuery{
+		UserId: submittingUserName,
+		DbName: dbName,
+		Key:    "foo",
+		AsOf:   5,
+	})
+
 	testCases := []struct {
 		name               string
 		requestFactory     func() (*http.Request, error)
@@ -83,6 +92,62 @@ func TestDataRequestHandler_DataQuery(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name: "valid get data request as of a past block",
+			expectedResponse: &types.GetDataResponseEnvelope{
+				Response: &types.GetDataResponse{
+					Header: &types.ResponseHeader{
+						NodeId: "testNodeID",
+					},
+					Value: []byte("bar"),
+					Metadata: &types.Metadata{
+						Version: &types.Version{
+							TxNum:    0,
+							BlockNum: 3,
+						},
+					},
+				},
+				Signature: []byte{0, 0, 0},
+			},
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataAsOf(dbName, "foo", 5), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFooAsOf))
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataAsOf", dbName, submittingUserName, "foo", uint64(5)).Return(response, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "get data as of a block above the current height",
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataAsOf(dbName, "foo", 5), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFooAsOf))
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("GetDataAsOf", dbName, submittingUserName, "foo", uint64(5)).Return(nil, &interrors.BadRequestError{ErrMsg: "block [5] is greater than the current height [4]"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'GET /data/test_database/foo?asof=5' because block [5] is greater than the current height [4]",
+		},
 		{
 			name: "submitting user is not eligible to update the key",
 			requestFactory: func() (*http.Request, error) {
@@ -243,6 +308,186 @@ func TestDataRequestHandler_DataQuery(t *testing.T) {
 	}
 }
 
+func TestDataRequestHandler_DataQueryWithMinHeight(t *testing.T) {
+	dbName := "test_database"
+
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	sigFoo := testutils.SignatureFromQuery(t, aliceSigner, &types.GetDataQuery{
+		UserId: submittingUserName,
+		DbName: dbName,
+		Key:    "foo",
+	})
+
+	dataResponse := &types.GetDataResponseEnvelope{
+		Response: &types.GetDataResponse{
+			Header: &types.ResponseHeader{
+				NodeId: "testNodeID",
+			},
+			Value: []byte("bar"),
+			Metadata: &types.Metadata{
+				Version: &types.Version{
+					TxNum:    0,
+					BlockNum: 3,
+				},
+			},
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	// the committer is behind: the state is at height 3 while the client requires height 5, which is committed after
+	// the third poll of the height
+	committerBehind := func(db *mocks.DB) {
+		db.On("Height").Return(uint64(3), nil).Times(3)
+		db.On("Height").Return(uint64(5), nil)
+	}
+	committerStuck := func(db *mocks.DB) {
+		db.On("Height").Return(uint64(3), nil)
+	}
+
+	testCases := []struct {
+		name                string
+		minHeight           string
+		readCommitted       string
+		heightMock          func(db *mocks.DB)
+		expectedStatusCode  int
+		expectedServed      string
+		expectedStaleness   string
+		expectedHeightCalls int
+		expectedErr         string
+	}{
+		{
+			name:                "read committed: served without waiting and labeled as stale",
+			minHeight:           "5",
+			readCommitted:       "true",
+			heightMock:          committerBehind,
+			expectedStatusCode:  http.StatusOK,
+			expectedServed:      "3",
+			expectedStaleness:   "2",
+			expectedHeightCalls: 1,
+		},
+		{
+			name:                "consistent read: waits for the committer",
+			minHeight:           "5",
+			heightMock:          committerBehind,
+			expectedStatusCode:  http.StatusOK,
+			expectedServed:      "5",
+			expectedStaleness:   "0",
+			expectedHeightCalls: 4,
+		},
+		{
+			name:                "read committed set to false: waits for the committer",
+			minHeight:           "5",
+			readCommitted:       "false",
+			heightMock:          committerBehind,
+			expectedStatusCode:  http.StatusOK,
+			expectedServed:      "5",
+			expectedStaleness:   "0",
+			expectedHeightCalls: 4,
+		},
+		{
+			name:                "min height already reached",
+			minHeight:           "2",
+			heightMock:          committerStuck,
+			expectedStatusCode:  http.StatusOK,
+			expectedServed:      "3",
+			expectedStaleness:   "0",
+			expectedHeightCalls: 1,
+		},
+		{
+			name:               "consistent read: the committer does not catch up in time",
+			minHeight:          "5",
+			heightMock:         committerStuck,
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedErr: "the height of the committed state [3] did not reach the requested height [5] within 200ms; " +
+				"set X-Read-Committed to read the committed state without waiting",
+		},
+		{
+			name:               "invalid min height",
+			minHeight:          "five",
+			heightMock:         committerStuck,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "min height is not a valid block number \"five\"",
+		},
+		{
+			name:               "invalid read committed flag",
+			minHeight:          "5",
+			readCommitted:      "maybe",
+			heightMock:         committerStuck,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "read committed flag is not a boolean \"maybe\"",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+	require.NotNil(t, logger)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constants.URLForGetData(dbName, "foo"), nil)
+			require.NoError(t, err)
+			req.Header.Set(constants.UserHeader, submittingUserName)
+			req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFoo))
+			req.Header.Set(constants.MinHeightHeader, tt.minHeight)
+			if tt.readCommitted != "" {
+				req.Header.Set(constants.ReadCommittedHeader, tt.readCommitted)
+			}
+
+			db := &mocks.DB{}
+			db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+			db.On("GetData", dbName, submittingUserName, "foo").Return(dataResponse, nil)
+			db.On("IsDBExists", dbName).Return(true)
+			tt.heightMock(db)
+
+			rr := httptest.NewRecorder()
+			handler := NewDataRequestHandler(db, logger)
+			handler.(*dataRequestHandler).minHeightTimeout = 200 * time.Millisecond
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				db.AssertNotCalled(t, "GetData", dbName, submittingUserName, "foo")
+				return
+			}
+
+			require.Equal(t, tt.expectedServed, rr.Header().Get(constants.ServedHeightHeader))
+			require.Equal(t, tt.expectedStaleness, rr.Header().Get(constants.StalenessHeader))
+			db.AssertNumberOfCalls(t, "Height", tt.expectedHeightCalls)
+
+			res := &types.GetDataResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(dataResponse, res))
+		})
+	}
+
+	t.Run("no min height: the response is not labeled", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, constants.URLForGetData(dbName, "foo"), nil)
+		require.NoError(t, err)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFoo))
+		req.Header.Set(constants.ReadCommittedHeader, "true")
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+		db.On("GetData", dbName, submittingUserName, "foo").Return(dataResponse, nil)
+		db.On("IsDBExists", dbName).Return(true)
+
+		rr := httptest.NewRecorder()
+		NewDataRequestHandler(db, logger).ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Empty(t, rr.Header().Get(constants.ServedHeightHeader))
+		require.Empty(t, rr.Header().Get(constants.StalenessHeader))
+		db.AssertNotCalled(t, "Height")
+	})
+}
+
 func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 	dbName := "test_database"
 
@@ -259,6 +504,24 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 		Limit:    10,
 	})
 
+	sigFooAsOf := testutils.SignatureFromQuery(t, aliceSigner, &types.GetDataRangeQuery{
+		UserId:   submittingUserName,
+		DbName:   dbName,
+		StartKey: "key1",
+		EndKey:   "key10",
+		Limit:    10,
+		AsOf:     5,
+	})
+
+	sigFooAnalytical := testutils.SignatureFromQuery(t, aliceSigner, &types.GetDataRangeQuery{
+		UserId:     submittingUserName,
+		DbName:     dbName,
+		StartKey:   "key1",
+		EndKey:     "key10",
+		Limit:      10,
+		Analytical: true,
+	})
+
 	sigFooNoLimits := testutils.SignatureFromQuery(t, aliceSigner, &types.GetDataRangeQuery{
 		UserId:   submittingUserName,
 		DbName:   dbName,
@@ -327,12 +590,125 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
-				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(10)).Return(response, nil)
+				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(10), "").Return(response, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "valid get data range as of a past block",
+			expectedResponse: &types.GetDataRangeResponseEnvelope{
+				Response: &types.GetDataRangeResponse{
+					Header: &types.ResponseHeader{
+						NodeId: "testNodeID",
+					},
+					KVs: []*types.KVWithMetadata{
+						{
+							Key:   "key2",
+							Value: []byte("value2"),
+						},
+					},
+				},
+				Signature: []byte{0, 0, 0},
+			},
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataRangeAsOf(dbName, "key1", "key10", 10, 5), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFooAsOf))
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataRangeAsOf", dbName, submittingUserName, "key1", "key10", uint64(10), uint64(5), "").Return(response, nil)
 				db.On("IsDBExists", dbName).Return(true)
 				return db
 			},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name: "get data range as of a past block exceeds the cost limit",
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataRangeAsOf(dbName, "key1", "key10", 10, 5), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFooAsOf))
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataRangeAsOf", dbName, submittingUserName, "key1", "key10", uint64(10), uint64(5), "").
+					Return(nil, &interrors.ServerRestrictionError{ErrMsg: "the cost of the query exceeds the limit"})
+				db.On("IsDBExists", dbName).Return(true)
+				return db
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedErr:        "error while processing 'GET /data/test_database?startkey=\"key1\"&endkey=\"key10\"&limit=10&asof=5' because the cost of the query exceeds the limit",
+		},
+		{
+			name: "valid get data range from the read replica",
+			expectedResponse: &types.GetDataRangeResponseEnvelope{
+				Response: &types.GetDataRangeResponse{
+					Header: &types.ResponseHeader{
+						NodeId: "testNodeID",
+					},
+					KVs: []*types.KVWithMetadata{
+						{
+							Key:   "key2",
+							Value: []byte("value2"),
+						},
+					},
+					ReplicaHeight: 8,
+				},
+				Signature: []byte{0, 0, 0},
+			},
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataRangeAnalytical(dbName, "key1", "key10", 10), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFooAnalytical))
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataRangeAnalytical", dbName, submittingUserName, "key1", "key10", uint64(10), "").Return(response, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "get data range from a disabled read replica",
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataRangeAnalytical(dbName, "key1", "key10", 10), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sigFooAnalytical))
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataRangeAnalytical", dbName, submittingUserName, "key1", "key10", uint64(10), "").
+					Return(nil, &interrors.BadRequestError{ErrMsg: "the read replica is disabled on this node"})
+				db.On("IsDBExists", dbName).Return(true)
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'GET /data/test_database?startkey=\"key1\"&endkey=\"key10\"&limit=10&analytical=true' because the read replica is disabled on this node",
+		},
 		{
 			name: "valid get data range with a limit and empty start key",
 			expectedResponse: &types.GetDataRangeResponseEnvelope{
@@ -361,7 +737,7 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
-				db.On("GetDataRange", dbName, submittingUserName, "", "key10", uint64(10)).Return(response, nil)
+				db.On("GetDataRange", dbName, submittingUserName, "", "key10", uint64(10), "").Return(response, nil)
 				db.On("IsDBExists", dbName).Return(true)
 				return db
 			},
@@ -395,7 +771,7 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
-				db.On("GetDataRange", dbName, submittingUserName, "key1", "", uint64(10)).Return(response, nil)
+				db.On("GetDataRange", dbName, submittingUserName, "key1", "", uint64(10), "").Return(response, nil)
 				db.On("IsDBExists", dbName).Return(true)
 				return db
 			},
@@ -429,7 +805,7 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
-				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(0)).Return(response, nil)
+				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(0), "").Return(response, nil)
 				db.On("IsDBExists", dbName).Return(true)
 				return db
 			},
@@ -463,7 +839,7 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
-				db.On("GetDataRange", dbName, submittingUserName, "", "", uint64(0)).Return(response, nil)
+				db.On("GetDataRange", dbName, submittingUserName, "", "", uint64(0), "").Return(response, nil)
 				db.On("IsDBExists", dbName).Return(true)
 				return db
 			},
@@ -484,7 +860,7 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
 				db.On("IsDBExists", dbName).Return(true)
-				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(10)).Return(nil, &interrors.PermissionErr{ErrMsg: "access forbidden"})
+				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(10), "").Return(nil, &interrors.PermissionErr{ErrMsg: "access forbidden"})
 				return db
 			},
 			expectedStatusCode: http.StatusForbidden,
@@ -505,7 +881,7 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
 				db.On("IsDBExists", dbName).Return(true)
-				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(10)).
+				db.On("GetDataRange", dbName, submittingUserName, "key1", "key10", uint64(10), "").
 					Return(nil, errors.New("failed to get data"))
 				return db
 			},
@@ -572,25 +948,457 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 				return &mocks.DB{}
 			},
 			expectedStatusCode: http.StatusBadRequest,
-			expectedErr:        "UserID is not set in the http request header",
+			expectedErr:        "UserID is not set in the http request header",
+		},
+		{
+			name:             "missing signature http header",
+			expectedResponse: nil,
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataRange(dbName, "key1", "key10", uint64(10)), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "Signature is not set in the http request header",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+	require.NotNil(t, logger)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.requestFactory()
+			require.NoError(t, err)
+			require.NotNil(t, req)
+
+			db := tt.dbMockFactory(tt.expectedResponse)
+			rr := httptest.NewRecorder()
+			handler := NewDataRequestHandler(db, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				err := json.NewDecoder(rr.Body).Decode(respErr)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+			}
+
+			if tt.expectedResponse != nil {
+				requestBody, err := ioutil.ReadAll(rr.Body)
+				require.NoError(t, err)
+				res := &types.GetDataRangeResponseEnvelope{}
+				require.NoError(t, protojson.Unmarshal(requestBody, res))
+				require.Equal(t, tt.expectedResponse, res)
+				//TODO verify signature on response
+			}
+		})
+	}
+}
+
+func TestDataRequestHandler_DataCountQuery(t *testing.T) {
+	dbName := "test_database"
+	openQuery := `{"selector":{"status":{"$eq":"open"}}}`
+
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func(url string, query *types.GetDataCountQuery) func() (*http.Request, error) {
+		return func() (*http.Request, error) {
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set(constants.UserHeader, submittingUserName)
+			req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(testutils.SignatureFromQuery(t, aliceSigner, query)))
+			return req, nil
+		}
+	}
+
+	countResponse := &types.GetDataCountResponseEnvelope{
+		Response: &types.GetDataCountResponse{
+			Header: &types.ResponseHeader{
+				NodeId: "testNodeID",
+			},
+			Count:  7,
+			Exists: true,
+		},
+		Signature: []byte{0, 0, 0},
+	}
+	existsResponse := &types.GetDataCountResponseEnvelope{
+		Response: &types.GetDataCountResponse{
+			Header: &types.ResponseHeader{
+				NodeId: "testNodeID",
+			},
+			Truncated: true,
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() (*http.Request, error)
+		dbMockFactory      func(response *types.GetDataCountResponseEnvelope) bcdb.DB
+		expectedResponse   *types.GetDataCountResponseEnvelope
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:             "count a range",
+			expectedResponse: countResponse,
+			requestFactory: newRequest(constants.URLForGetDataCountRange(dbName, "key1", "key9"), &types.GetDataCountQuery{
+				UserId:   submittingUserName,
+				DbName:   dbName,
+				StartKey: "key1",
+				EndKey:   "key9",
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("CountData", dbName, submittingUserName, "key1", "key9", "", false).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:             "count a prefix",
+			expectedResponse: countResponse,
+			requestFactory: newRequest(constants.URLForGetDataCountPrefix(dbName, "key"), &types.GetDataCountQuery{
+				UserId: submittingUserName,
+				DbName: dbName,
+				Prefix: "key",
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("CountData", dbName, submittingUserName, "key", "kez", "", false).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:             "count the matches of a JSON query",
+			expectedResponse: countResponse,
+			requestFactory: newRequest(constants.URLForGetDataCountQuery(dbName, openQuery), &types.GetDataCountQuery{
+				UserId: submittingUserName,
+				DbName: dbName,
+				Query:  openQuery,
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("CountData", dbName, submittingUserName, "", "", openQuery, false).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:             "exists in a range, truncated",
+			expectedResponse: existsResponse,
+			requestFactory: newRequest(constants.URLForGetDataExistsRange(dbName, "", ""), &types.GetDataCountQuery{
+				UserId:     submittingUserName,
+				DbName:     dbName,
+				ExistsOnly: true,
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("CountData", dbName, submittingUserName, "", "", "", true).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:             "exists for a JSON query",
+			expectedResponse: countResponse,
+			requestFactory: newRequest(constants.URLForGetDataExistsQuery(dbName, openQuery), &types.GetDataCountQuery{
+				UserId:     submittingUserName,
+				DbName:     dbName,
+				Query:      openQuery,
+				ExistsOnly: true,
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("CountData", dbName, submittingUserName, "", "", openQuery, true).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:             "a signature of a count query does not verify an existence query",
+			expectedResponse: nil,
+			requestFactory: newRequest(constants.URLForGetDataExistsPrefix(dbName, "key"), &types.GetDataCountQuery{
+				UserId: submittingUserName,
+				DbName: dbName,
+				Prefix: "key",
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+		{
+			name:             "the key is not quoted",
+			expectedResponse: nil,
+			requestFactory: newRequest(constants.DataEndpoint+dbName+"/count?prefix=key", &types.GetDataCountQuery{
+				UserId: submittingUserName,
+				DbName: dbName,
+				Prefix: "key",
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "the prefix must be enclosed in double quotes",
+		},
+		{
+			name:             "database does not exist",
+			expectedResponse: nil,
+			requestFactory: newRequest(constants.URLForGetDataCountPrefix(dbName, "key"), &types.GetDataCountQuery{
+				UserId: submittingUserName,
+				DbName: dbName,
+				Prefix: "key",
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(false)
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error db '" + dbName + "' doesn't exist",
+		},
+		{
+			name:             "user has no permission to read from the database",
+			expectedResponse: nil,
+			requestFactory: newRequest(constants.URLForGetDataCountPrefix(dbName, "key"), &types.GetDataCountQuery{
+				UserId: submittingUserName,
+				DbName: dbName,
+				Prefix: "key",
+			}),
+			dbMockFactory: func(response *types.GetDataCountResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("CountData", dbName, submittingUserName, "key", "kez", "", false).Return(nil, &interrors.PermissionErr{
+					ErrMsg: "the user [alice] has no permission to read from database [" + dbName + "]",
+				})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr: "error while processing 'GET " + constants.URLForGetDataCountPrefix(dbName, "key") +
+				"' because the user [alice] has no permission to read from database [" + dbName + "]",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+	require.NotNil(t, logger)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.requestFactory()
+			require.NoError(t, err)
+			require.NotNil(t, req)
+
+			db := tt.dbMockFactory(tt.expectedResponse)
+			rr := httptest.NewRecorder()
+			handler := NewDataRequestHandler(db, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				err := json.NewDecoder(rr.Body).Decode(respErr)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+			}
+
+			if tt.expectedResponse != nil {
+				requestBody, err := ioutil.ReadAll(rr.Body)
+				require.NoError(t, err)
+				res := &types.GetDataCountResponseEnvelope{}
+				require.NoError(t, protojson.Unmarshal(requestBody, res))
+				require.True(t, proto.Equal(tt.expectedResponse, res), "expected: %v, actual: %v", tt.expectedResponse, res)
+			}
+		})
+	}
+
+	t.Run("a key named count is read without count parameters", func(t *testing.T) {
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+		db.On("IsDBExists", dbName).Return(true)
+		db.On("GetData", dbName, submittingUserName, "count").Return(&types.GetDataResponseEnvelope{}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, constants.URLForGetData(dbName, "count"), nil)
+		require.NoError(t, err)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(testutils.SignatureFromQuery(t, aliceSigner, &types.GetDataQuery{
+			UserId: submittingUserName,
+			DbName: dbName,
+			Key:    "count",
+		})))
+
+		rr := httptest.NewRecorder()
+		NewDataRequestHandler(db, logger).ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		db.AssertCalled(t, "GetData", dbName, submittingUserName, "count")
+		db.AssertNotCalled(t, "CountData", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDataRequestHandler_DataMultiQuery(t *testing.T) {
+	dbName := "test_database"
+	keys := []string{"key1", "key2", "key3"}
+
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func(body string, query *types.GetDataMultiQuery) func() (*http.Request, error) {
+		return func() (*http.Request, error) {
+			req, err := http.NewRequest(http.MethodPost, constants.URLForDataMultiGet(dbName), bytes.NewReader([]byte(body)))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set(constants.UserHeader, submittingUserName)
+			req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(testutils.SignatureFromQuery(t, aliceSigner, query)))
+			return req, nil
+		}
+	}
+	query := &types.GetDataMultiQuery{
+		UserId: submittingUserName,
+		DbName: dbName,
+		Keys:   keys,
+	}
+
+	multiResponse := &types.GetDataMultiResponseEnvelope{
+		Response: &types.GetDataMultiResponse{
+			Header: &types.ResponseHeader{
+				NodeId: "testNodeID",
+			},
+			Height: 5,
+			Entries: []*types.MultiGetEntry{
+				{
+					Key:      "key1",
+					Value:    []byte("value1"),
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 5, TxNum: 1}},
+				},
+				{Key: "key2", Status: types.MultiGetEntry_FORBIDDEN},
+				{Key: "key3", Status: types.MultiGetEntry_NOT_FOUND},
+			},
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() (*http.Request, error)
+		dbMockFactory      func(response *types.GetDataMultiResponseEnvelope) bcdb.DB
+		expectedResponse   *types.GetDataMultiResponseEnvelope
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:             "valid multi-key read",
+			expectedResponse: multiResponse,
+			requestFactory:   newRequest(`{"keys":["key1","key2","key3"]}`, query),
+			dbMockFactory: func(response *types.GetDataMultiResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("GetDataMulti", dbName, submittingUserName, keys).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:             "the signature does not cover the keys",
+			expectedResponse: nil,
+			requestFactory:   newRequest(`{"keys":["key1","key2"]}`, query),
+			dbMockFactory: func(response *types.GetDataMultiResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+		{
+			name:             "the request cannot be decoded",
+			expectedResponse: nil,
+			requestFactory:   newRequest(`{"keys":"key1"}`, query),
+			dbMockFactory: func(response *types.GetDataMultiResponseEnvelope) bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal string into Go struct field GetDataMultiRequest.keys of type []string",
+		},
+		{
+			name:             "database does not exist",
+			expectedResponse: nil,
+			requestFactory:   newRequest(`{"keys":["key1","key2","key3"]}`, query),
+			dbMockFactory: func(response *types.GetDataMultiResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(false)
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error db '" + dbName + "' doesn't exist",
 		},
 		{
-			name:             "missing signature http header",
+			name:             "too many keys",
 			expectedResponse: nil,
-			requestFactory: func() (*http.Request, error) {
-				req, err := http.NewRequest(http.MethodGet, constants.URLForGetDataRange(dbName, "key1", "key10", uint64(10)), nil)
-				if err != nil {
-					return nil, err
-				}
-				req.Header.Set(constants.UserHeader, submittingUserName)
-
-				return req, nil
-			},
-			dbMockFactory: func(response *types.GetDataRangeResponseEnvelope) bcdb.DB {
-				return &mocks.DB{}
+			requestFactory:   newRequest(`{"keys":["key1","key2","key3"]}`, query),
+			dbMockFactory: func(response *types.GetDataMultiResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("GetDataMulti", dbName, submittingUserName, keys).Return(nil, &interrors.BadRequestError{
+					ErrMsg: "the number of keys [3] exceeds the limit of a multi-key read [2]",
+				})
+				return db
 			},
 			expectedStatusCode: http.StatusBadRequest,
-			expectedErr:        "Signature is not set in the http request header",
+			expectedErr: "error while processing 'POST " + constants.URLForDataMultiGet(dbName) +
+				"' because the number of keys [3] exceeds the limit of a multi-key read [2]",
+		},
+		{
+			name:             "user has no permission to read from the database",
+			expectedResponse: nil,
+			requestFactory:   newRequest(`{"keys":["key1","key2","key3"]}`, query),
+			dbMockFactory: func(response *types.GetDataMultiResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("GetDataMulti", dbName, submittingUserName, keys).Return(nil, &interrors.PermissionErr{
+					ErrMsg: "the user [alice] has no permission to read from database [" + dbName + "]",
+				})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr: "error while processing 'POST " + constants.URLForDataMultiGet(dbName) +
+				"' because the user [alice] has no permission to read from database [" + dbName + "]",
 		},
 	}
 
@@ -620,10 +1428,9 @@ func TestDataRequestHandler_DataRangeQuery(t *testing.T) {
 			if tt.expectedResponse != nil {
 				requestBody, err := ioutil.ReadAll(rr.Body)
 				require.NoError(t, err)
-				res := &types.GetDataRangeResponseEnvelope{}
+				res := &types.GetDataMultiResponseEnvelope{}
 				require.NoError(t, protojson.Unmarshal(requestBody, res))
-				require.Equal(t, tt.expectedResponse, res)
-				//TODO verify signature on response
+				require.True(t, proto.Equal(tt.expectedResponse, res), "expected: %v, actual: %v", tt.expectedResponse, res)
 			}
 		})
 	}
@@ -690,7 +1497,7 @@ func TestDataRequestHandler_DataJSONQuery(t *testing.T) {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
 				db.On("IsDBExists", dbName).Return(true)
-				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q)).Return(response, nil)
+				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q), uint64(0), "").Return(response, nil)
 				return db
 			},
 			expectedStatusCode: http.StatusOK,
@@ -734,7 +1541,7 @@ func TestDataRequestHandler_DataJSONQuery(t *testing.T) {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
 				db.On("IsDBExists", dbName).Return(true)
-				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q)).
+				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q), uint64(0), "").
 					Return(nil, &interrors.PermissionErr{ErrMsg: "access forbidden"})
 				return db
 			},
@@ -758,7 +1565,7 @@ func TestDataRequestHandler_DataJSONQuery(t *testing.T) {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
 				db.On("IsDBExists", dbName).Return(true)
-				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q)).
+				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q), uint64(0), "").
 					Return(nil, errors.New("failed to execute the query"))
 				return db
 			},
@@ -947,8 +1754,10 @@ func TestDataRequestHandler_DataTransaction(t *testing.T) {
 		txRespFactory           func() *types.TxReceiptResponseEnvelope
 		createMockAndInstrument func(t *testing.T, dataTxEnv interface{}, txRespEnv interface{}, timeout time.Duration) bcdb.DB
 		timeoutStr              string
+		readHints               string
 		expectedCode            int
 		expectedErr             string
+		expectedRetryAfter      string
 	}{
 		{
 			name: "submit valid data transaction",
@@ -980,6 +1789,37 @@ func TestDataRequestHandler_DataTransaction(t *testing.T) {
 			},
 			expectedCode: http.StatusOK,
 		},
+		{
+			name: "submit valid data transaction with read hints",
+			txEnvFactory: func() *types.DataTxEnvelope {
+				return &types.DataTxEnvelope{
+					Payload: dataTx,
+					Signatures: map[string][]byte{
+						alice: aliceSig,
+						bob:   bobSig,
+					},
+				}
+			},
+			txRespFactory: func() *types.TxReceiptResponseEnvelope {
+				return correctTxRespEnv
+			},
+			createMockAndInstrument: func(t *testing.T, dataTxEnv interface{}, txRespEnv interface{}, timeout time.Duration) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", alice).Return(aliceCert, nil)
+				db.On("GetCertificate", bob).Return(bobCert, nil)
+				db.On("PrefetchReads", []*types.ReadHint{
+					{DBName: "testDB", Key: "bar"},
+					{DBName: "testDB", Key: "a/b,c"},
+				}).Return()
+				db.On("SubmitTransaction", mock.Anything, mock.Anything).Return(txRespEnv, nil)
+				return db
+			},
+			readHints: constants.ReadHintsHeaderValue([]*types.ReadHint{
+				{DBName: "testDB", Key: "bar"},
+				{DBName: "testDB", Key: "a/b,c"},
+			}) + ",malformed",
+			expectedCode: http.StatusOK,
+		},
 		{
 			name: "transaction timeout",
 			txEnvFactory: func() *types.DataTxEnvelope {
@@ -1011,6 +1851,37 @@ func TestDataRequestHandler_DataTransaction(t *testing.T) {
 			expectedCode: http.StatusAccepted,
 			expectedErr:  "Transaction processing timeout",
 		},
+		{
+			name: "transaction timeout with progress",
+			txEnvFactory: func() *types.DataTxEnvelope {
+				return &types.DataTxEnvelope{
+					Payload: dataTx,
+					Signatures: map[string][]byte{
+						alice: aliceSig,
+						bob:   bobSig,
+					},
+				}
+			},
+			txRespFactory: func() *types.TxReceiptResponseEnvelope {
+				return nil
+			},
+			createMockAndInstrument: func(t *testing.T, dataTxEnv interface{}, txRespEnv interface{}, timeout time.Duration) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", alice).Return(aliceCert, nil)
+				db.On("GetCertificate", bob).Return(bobCert, nil)
+				db.On("SubmitTransaction", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						tx := args[0].(*types.DataTxEnvelope)
+						require.Equal(t, dataTxEnv, tx)
+						require.Equal(t, timeout, args[1].(time.Duration))
+					}).
+					Return(txRespEnv, &interrors.TimeoutErr{ErrMsg: "Timeout error", Stage: "validating", BlockNumber: 12})
+				return db
+			},
+			timeoutStr:   "1s",
+			expectedCode: http.StatusAccepted,
+			expectedErr:  "Transaction processing timeout, last observed stage: validating, block number: 12",
+		},
 		{
 			name: "transaction timeout invalid",
 			txEnvFactory: func() *types.DataTxEnvelope {
@@ -1279,6 +2150,89 @@ func TestDataRequestHandler_DataTransaction(t *testing.T) {
 			},
 			expectedCode: http.StatusTemporaryRedirect,
 		},
+		{
+			name: "server overloaded",
+			txEnvFactory: func() *types.DataTxEnvelope {
+				return &types.DataTxEnvelope{
+					Payload: dataTx,
+					Signatures: map[string][]byte{
+						alice:   aliceSig,
+						bob:     bobSig,
+						charlie: charlieSig,
+					},
+				}
+			},
+			txRespFactory: func() *types.TxReceiptResponseEnvelope {
+				return nil
+			},
+			createMockAndInstrument: func(t *testing.T, dataTxEnv interface{}, txRespEnv interface{}, timeout time.Duration) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", alice).Return(aliceCert, nil)
+				db.On("GetCertificate", bob).Return(bobCert, nil)
+				db.On("SubmitTransaction", mock.Anything, mock.Anything).Return(nil, &interrors.OverloadedError{
+					ErrMsg:     "transaction queue is full",
+					RetryAfter: 4200 * time.Millisecond,
+				})
+				return db
+			},
+			expectedCode:       http.StatusServiceUnavailable,
+			expectedErr:        "transaction queue is full",
+			expectedRetryAfter: "5",
+		},
+		{
+			name: "transaction expired",
+			txEnvFactory: func() *types.DataTxEnvelope {
+				return &types.DataTxEnvelope{
+					Payload: dataTx,
+					Signatures: map[string][]byte{
+						alice:   aliceSig,
+						bob:     bobSig,
+						charlie: charlieSig,
+					},
+				}
+			},
+			txRespFactory: func() *types.TxReceiptResponseEnvelope {
+				return nil
+			},
+			createMockAndInstrument: func(t *testing.T, dataTxEnv interface{}, txRespEnv interface{}, timeout time.Duration) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", alice).Return(aliceCert, nil)
+				db.On("GetCertificate", bob).Return(bobCert, nil)
+				db.On("SubmitTransaction", mock.Anything, mock.Anything).Return(nil, &interrors.TxExpiredError{
+					ErrMsg: "the deadline of the transaction passed before it was included in a block",
+				})
+				return db
+			},
+			expectedCode: http.StatusGone,
+			expectedErr:  "the deadline of the transaction passed before it was included in a block",
+		},
+		{
+			name: "transaction rejected with its batch",
+			txEnvFactory: func() *types.DataTxEnvelope {
+				return &types.DataTxEnvelope{
+					Payload: dataTx,
+					Signatures: map[string][]byte{
+						alice:   aliceSig,
+						bob:     bobSig,
+						charlie: charlieSig,
+					},
+				}
+			},
+			txRespFactory: func() *types.TxReceiptResponseEnvelope {
+				return nil
+			},
+			createMockAndInstrument: func(t *testing.T, dataTxEnv interface{}, txRespEnv interface{}, timeout time.Duration) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", alice).Return(aliceCert, nil)
+				db.On("GetCertificate", bob).Return(bobCert, nil)
+				db.On("SubmitTransaction", mock.Anything, mock.Anything).Return(nil, &interrors.TxRejectedError{
+					ErrMsg: "the transaction was rejected before it was included in a block, as it is invalid, flag: INVALID_NO_PERMISSION, reason: no permission",
+				})
+				return db
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+			expectedErr:  "the transaction was rejected before it was included in a block, as it is invalid, flag: INVALID_NO_PERMISSION, reason: no permission",
+		},
 	}
 
 	logger, err := createLogger("debug")
@@ -1320,6 +2274,10 @@ func TestDataRequestHandler_DataTransaction(t *testing.T) {
 				}
 			}
 
+			if tt.readHints != "" {
+				req.Header.Set(constants.ReadHintsHeader, tt.readHints)
+			}
+
 			db := tt.createMockAndInstrument(t, txEnv, txResp, timeout)
 			handler := NewDataRequestHandler(db, logger)
 			handler.ServeHTTP(rr, req)
@@ -1334,6 +2292,19 @@ func TestDataRequestHandler_DataTransaction(t *testing.T) {
 			} else if tt.expectedCode == http.StatusTemporaryRedirect {
 				locationUrl := rr.Header().Get("Location")
 				require.Equal(t, "http://server3.example.com:6091/data/tx", locationUrl)
+			} else if tt.expectedRetryAfter != "" {
+				require.Equal(t, utils.ProblemJSON, rr.Header().Get("Content-Type"))
+				require.Equal(t, tt.expectedRetryAfter, rr.Header().Get("Retry-After"))
+				problem := &utils.Problem{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(problem))
+				require.Equal(t, &utils.Problem{
+					Type:        "about:blank",
+					Title:       "Service Unavailable",
+					Status:      http.StatusServiceUnavailable,
+					Detail:      tt.expectedErr,
+					RetryAfter:  5,
+					RetryJitter: 3,
+				}, problem)
 			} else {
 				respErr := &types.HttpResponseErr{}
 				err := json.NewDecoder(rr.Body).Decode(respErr)
@@ -1344,6 +2315,96 @@ func TestDataRequestHandler_DataTransaction(t *testing.T) {
 	}
 }
 
+func TestDataRequestHandler_VoidTransaction(t *testing.T) {
+	alice := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice", "bob"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+	_, bobSigner := testutils.LoadTestCrypto(t, cryptoDir, "bob")
+
+	voidTx := &types.VoidTx{
+		UserId:   alice,
+		TxId:     "1",
+		Sequence: 3,
+	}
+	aliceSig := testutils.SignatureFromTx(t, aliceSigner, voidTx)
+	bobSig := testutils.SignatureFromTx(t, bobSigner, voidTx)
+
+	testCases := []struct {
+		name         string
+		txEnv        *types.VoidTxEnvelope
+		expectedCode int
+		expectedErr  string
+	}{
+		{
+			name:         "submit valid void transaction",
+			txEnv:        &types.VoidTxEnvelope{Payload: voidTx, Signature: aliceSig},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "submit void tx with missing payload",
+			txEnv:        &types.VoidTxEnvelope{Signature: aliceSig},
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "missing transaction envelope payload (*types.VoidTx)",
+		},
+		{
+			name:         "submit void tx with missing userID",
+			txEnv:        &types.VoidTxEnvelope{Payload: &types.VoidTx{TxId: "1"}, Signature: aliceSig},
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "missing UserID in transaction envelope payload (*types.VoidTx)",
+		},
+		{
+			name:         "submit void tx with missing signature",
+			txEnv:        &types.VoidTxEnvelope{Payload: voidTx},
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "missing Signature in transaction envelope payload (*types.VoidTx)",
+		},
+		{
+			name:         "submit void tx signed by another user",
+			txEnv:        &types.VoidTxEnvelope{Payload: voidTx, Signature: bobSig},
+			expectedCode: http.StatusUnauthorized,
+			expectedErr:  "signature verification failed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			txBytes, err := marshal.DefaultMarshaler().Marshal(tt.txEnv)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, constants.PostVoidTx, bytes.NewReader(txBytes))
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+
+			db := &mocks.DB{}
+			db.On("GetCertificate", alice).Return(aliceCert, nil)
+			db.On("SubmitTransaction", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					require.True(t, proto.Equal(tt.txEnv, args[0].(*types.VoidTxEnvelope)))
+				}).
+				Return(correctTxRespEnv, nil)
+
+			NewDataRequestHandler(db, logger).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedCode, rr.Code)
+			if tt.expectedCode == http.StatusOK {
+				resp := &types.TxReceiptResponseEnvelope{}
+				require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), resp))
+				require.True(t, proto.Equal(correctTxRespEnv, resp))
+				db.AssertNumberOfCalls(t, "SubmitTransaction", 1)
+				return
+			}
+
+			respErr := &types.HttpResponseErr{}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+			require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+			db.AssertNotCalled(t, "SubmitTransaction", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestDataRequestHandler_DataJSONQueryWithContext(t *testing.T) {
 	dbName := "test_database"
 
@@ -1392,7 +2453,7 @@ func TestDataRequestHandler_DataJSONQueryWithContext(t *testing.T) {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
 				db.On("IsDBExists", dbName).Return(true)
-				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q)).Return(response, nil)
+				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q), uint64(0), "").Return(response, nil)
 				return db
 			},
 			expectedResponse: &types.DataQueryResponseEnvelope{
@@ -1428,7 +2489,7 @@ func TestDataRequestHandler_DataJSONQueryWithContext(t *testing.T) {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
 				db.On("IsDBExists", dbName).Return(true)
-				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q)).Return(response, nil)
+				db.On("DataQuery", mock.Anything, dbName, submittingUserName, []byte(q), uint64(0), "").Return(response, nil)
 				return db
 			},
 			useCancelledContext: true,
@@ -1486,3 +2547,156 @@ func TestDataRequestHandler_DataJSONQueryWithContext(t *testing.T) {
 		})
 	}
 }
+
+func TestDataRequestHandler_SubscribeKeys(t *testing.T) {
+	dbName := "test_database"
+
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	keys := []string{"key1"}
+	prefixes := []string{"order/"}
+	body := `{"keys":["key1"],"prefixes":["order/"]}`
+	sig := testutils.SignatureFromQuery(t, aliceSigner, &types.SubscribeKeysQuery{
+		UserId:   submittingUserName,
+		DbName:   dbName,
+		Keys:     keys,
+		Prefixes: prefixes,
+	})
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, constants.URLForSubscribeKeys(dbName), bytes.NewReader([]byte(body)))
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	notification := func(blockNum uint64, key string) *types.KeyChangesResponseEnvelope {
+		return &types.KeyChangesResponseEnvelope{
+			Response: &types.KeyChangesResponse{
+				Header:      &types.ResponseHeader{NodeId: "testNodeID"},
+				DbName:      dbName,
+				BlockNumber: blockNum,
+				Changes: []*types.KeyChange{
+					{Key: key, Version: &types.Version{BlockNum: blockNum}, Value: []byte("value")},
+				},
+			},
+			Signature: []byte{0, 0, 0},
+		}
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	t.Run("valid: the notifications are streamed until the subscription is dropped", func(t *testing.T) {
+		notifications := make(chan *types.KeyChangesResponseEnvelope, 2)
+		notifications <- notification(5, "key1")
+		notifications <- notification(7, "order/1")
+		close(notifications)
+		var canceled bool
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+		db.On("IsDBExists", dbName).Return(true)
+		db.On("SubscribeKeys", submittingUserName, dbName, keys, prefixes).Return((<-chan *types.KeyChangesResponseEnvelope)(notifications), func() { canceled = true }, nil)
+
+		rr := httptest.NewRecorder()
+		NewDataRequestHandler(db, logger).ServeHTTP(rr, newRequest(body))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		lines := bytes.Split(bytes.TrimSpace(rr.Body.Bytes()), []byte("\n"))
+		require.Len(t, lines, 2)
+		for i, expected := range []*types.KeyChangesResponseEnvelope{notification(5, "key1"), notification(7, "order/1")} {
+			res := &types.KeyChangesResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(lines[i], res))
+			require.True(t, proto.Equal(expected, res))
+		}
+		require.True(t, canceled)
+	})
+
+	t.Run("valid: the subscription is canceled once the client disconnects", func(t *testing.T) {
+		canceled := make(chan struct{})
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+		db.On("IsDBExists", dbName).Return(true)
+		db.On("SubscribeKeys", submittingUserName, dbName, keys, prefixes).Return(make(<-chan *types.KeyChangesResponseEnvelope), func() { close(canceled) }, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req := newRequest(body).WithContext(ctx)
+		rr := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			NewDataRequestHandler(db, logger).ServeHTTP(rr, req)
+		}()
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the stream did not end once the client disconnected")
+		}
+		select {
+		case <-canceled:
+		default:
+			t.Fatal("the subscription was not canceled")
+		}
+	})
+
+	testCases := []struct {
+		name               string
+		body               string
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "invalid: no permission",
+			body: body,
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(true)
+				db.On("SubscribeKeys", submittingUserName, dbName, keys, prefixes).Return(nil, nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to read from database [test_database]"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'POST /data/test_database/subscribe' because the user [alice] has no permission to read from database [test_database]",
+		},
+		{
+			name: "invalid: database does not exist",
+			body: body,
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("IsDBExists", dbName).Return(false)
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "'test_database' does not exist",
+		},
+		{
+			name: "invalid: malformed request",
+			body: `{"keys":"key1"}`,
+			dbMockFactory: func() bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while decoding the request: json: cannot unmarshal string into Go struct field SubscribeKeysRequest.keys of type []string",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			NewDataRequestHandler(tt.dbMockFactory(), logger).ServeHTTP(rr, newRequest(tt.body))
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			respErr := &types.HttpResponseErr{}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+			require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+		})
+	}
+}
@@ -4,7 +4,6 @@ package httphandler
 
 import (
 	"fmt"
-	"io/ioutil"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -14,6 +13,7 @@ import (
 	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -42,7 +42,15 @@ func NewDBRequestHandler(db backend.DB, logger *logger.SugarLogger) http.Handler
 
 	handler.router.HandleFunc(constants.GetDBStatus, handler.dbStatus).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.GetDBIndex, handler.dbIndex).Methods(http.MethodGet)
+	handler.router.HandleFunc(constants.GetDBDescriptor, handler.dbDescriptor).Methods(http.MethodGet).Queries("asof", "{asOf:[0-9]+}")
+	handler.router.HandleFunc(constants.GetDBDescriptor, handler.dbDescriptor).Methods(http.MethodGet)
+	handler.router.HandleFunc(constants.GetDBDescriptorHistory, handler.dbDescriptorHistory).Methods(http.MethodGet)
+	handler.router.HandleFunc(constants.GetDBDigest, handler.dbDigest).Methods(http.MethodGet).Queries("block", "{block:[0-9]+}")
+	handler.router.HandleFunc(constants.GetDBDigest, handler.dbDigest).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.PostDBTx, handler.dbTransaction).Methods(http.MethodPost)
+	handler.router.HandleFunc(constants.GetDBExport, handler.dbExport).Methods(http.MethodGet).Queries("provenance", "{provenance:true|false}")
+	handler.router.HandleFunc(constants.GetDBExport, handler.dbExport).Methods(http.MethodGet)
+	handler.router.HandleFunc(constants.PostDBImport, handler.dbImport).Methods(http.MethodPost)
 
 	return handler
 }
@@ -104,6 +112,77 @@ func (d *dbRequestHandler) dbIndex(response http.ResponseWriter, request *http.R
 	utils.SendHTTPResponse(response, http.StatusOK, dbIndex)
 }
 
+func (d *dbRequestHandler) dbDescriptor(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetDBDescriptor, d.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDBDescriptorQuery)
+
+	descriptor, err := d.db.GetDBDescriptor(query.DbName, query.UserId, query.AsOf)
+	if err != nil {
+		d.sendQueryError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, descriptor)
+}
+
+func (d *dbRequestHandler) dbDescriptorHistory(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetDBDescriptorHistory, d.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDBDescriptorHistoryQuery)
+
+	history, err := d.db.GetDBDescriptorHistory(query.DbName, query.UserId)
+	if err != nil {
+		d.sendQueryError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, history)
+}
+
+func (d *dbRequestHandler) dbDigest(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetDBDigest, d.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDBDigestQuery)
+
+	digest, err := d.db.GetDBDigest(query.DbName, query.UserId, query.BlockNumber)
+	if err != nil {
+		d.sendQueryError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, digest)
+}
+
+func (d *dbRequestHandler) sendQueryError(response http.ResponseWriter, request *http.Request, err error) {
+	var status int
+
+	switch err.(type) {
+	case *errors.PermissionErr:
+		status = http.StatusForbidden
+	case *errors.BadRequestError:
+		status = http.StatusBadRequest
+	case *errors.ServerRestrictionError:
+		status = http.StatusServiceUnavailable
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	utils.SendHTTPResponse(
+		response,
+		status,
+		&types.HttpResponseErr{
+			ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+		},
+	)
+}
+
 func (d *dbRequestHandler) dbTransaction(response http.ResponseWriter, request *http.Request) {
 	timeout, err := validateAndParseTxPostHeader(&request.Header)
 	if err != nil {
@@ -111,9 +190,8 @@ func (d *dbRequestHandler) dbTransaction(response http.ResponseWriter, request *
 		return
 	}
 
-	requestBytes, err := ioutil.ReadAll(request.Body)
-	if err != nil {
-		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+	requestBytes, done := readRequestBody(response, request)
+	if done {
 		return
 	}
 
@@ -141,6 +219,12 @@ func (d *dbRequestHandler) dbTransaction(response http.ResponseWriter, request *
 		return
 	}
 
+	if txEnv.ImportProof != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: "an import proof is only attached by a node to the transactions of a database import"})
+		return
+	}
+
 	if err, code := VerifyRequestSignature(d.sigVerifier, txEnv.Payload.UserId, txEnv.Signature, txEnv.Payload); err != nil {
 		utils.SendHTTPResponse(response, code, &types.HttpResponseErr{ErrMsg: err.Error()})
 		return
@@ -148,3 +232,108 @@ func (d *dbRequestHandler) dbTransaction(response http.ResponseWriter, request *
 
 	d.txHandler.handleTransaction(response, request, txEnv, timeout)
 }
+
+// dbExport streams the export of a database, a record per line. An error that occurs once the stream has started
+// truncates it, and the missing trailer tells the client that the export is incomplete.
+func (d *dbRequestHandler) dbExport(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetDBExport, d.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDBExportQuery)
+
+	flusher, ok := response.(http.Flusher)
+	if !ok {
+		utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{
+			ErrMsg: "the connection does not support streaming",
+		})
+		return
+	}
+
+	started := false
+	err := d.db.ExportDB(query.UserId, query.DbName, query.Provenance, func(record *types.DBExportRecord) error {
+		if !started {
+			response.Header().Set("Content-Type", "application/x-ndjson")
+			response.WriteHeader(http.StatusOK)
+			started = true
+		}
+
+		line, err := marshal.DefaultMarshaler().Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = response.Write(append(line, '\n'))
+		return err
+	})
+	if err == nil {
+		flusher.Flush()
+		return
+	}
+
+	if started {
+		d.logger.Warnf("the export of database [%s] to [%s] stopped: %s", query.DbName, query.UserId, err)
+		return
+	}
+	d.sendQueryError(response, request, err)
+}
+
+func (d *dbRequestHandler) dbImport(response http.ResponseWriter, request *http.Request) {
+	timeout, err := validateAndParseTxPostHeader(&request.Header)
+	if err != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	requestBytes, done := readRequestBody(response, request)
+	if done {
+		return
+	}
+
+	importEnv := &types.DBImportRequestEnvelope{}
+	if err := protojson.Unmarshal(requestBytes, importEnv); err != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	switch {
+	case importEnv.Payload == nil:
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing import request envelope payload (%T)", importEnv.Payload)})
+		return
+	case importEnv.Payload.UserId == "":
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing UserID in import request envelope payload (%T)", importEnv.Payload)})
+		return
+	case len(importEnv.Signature) == 0:
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing Signature in import request envelope payload (%T)", importEnv.Payload)})
+		return
+	}
+
+	if err, code := VerifyRequestSignature(d.sigVerifier, importEnv.Payload.UserId, importEnv.Signature, importEnv.Payload); err != nil {
+		utils.SendHTTPResponse(response, code, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	resp, err := d.db.ImportDB(importEnv, timeout)
+	if err != nil {
+		switch err.(type) {
+		case *errors.PermissionErr:
+			utils.SendHTTPResponse(response, http.StatusForbidden, &types.HttpResponseErr{ErrMsg: err.Error()})
+		case *errors.BadRequestError:
+			utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		case *errors.NotLeaderError:
+			leaderErr := err.(*errors.NotLeaderError)
+			if leaderErr.GetLeaderID() == 0 {
+				utils.SendHTTPResponse(response, http.StatusServiceUnavailable, &types.HttpResponseErr{ErrMsg: "Cluster leader unavailable"})
+			} else {
+				utils.SendHTTPRedirectServer(response, request, leaderErr.GetLeaderHostPort())
+			}
+		default:
+			utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+		}
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
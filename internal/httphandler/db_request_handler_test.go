@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestDBRequestHandler_DBStatus(t *testing.T) {
@@ -421,6 +422,300 @@ func TestDBRequestHandler_DBIndex(t *testing.T) {
 	}
 }
 
+func TestDBRequestHandler_DBDescriptor(t *testing.T) {
+	submittingUserName := "alice"
+	dbName := "testDBName"
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	descriptor := &types.DBDescriptor{
+		DefaultAcl: &types.AccessControl{
+			ReadUsers: map[string]bool{"alice": true},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		url                string
+		query              proto.Message
+		dbMockFactory      func() bcdb.DB
+		expectedResponse   proto.Message
+		responseTemplate   proto.Message
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:  "valid descriptor request",
+			url:   constants.URLForGetDBDescriptor(dbName),
+			query: &types.GetDBDescriptorQuery{UserId: submittingUserName, DbName: dbName},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDescriptor", dbName, submittingUserName, uint64(0)).Return(&types.GetDBDescriptorResponseEnvelope{
+					Response: &types.GetDBDescriptorResponse{
+						Header:       &types.ResponseHeader{NodeId: "testNodeID"},
+						DbDescriptor: descriptor,
+						Version:      &types.Version{BlockNum: 2},
+					},
+				}, nil)
+				return db
+			},
+			expectedResponse: &types.GetDBDescriptorResponseEnvelope{
+				Response: &types.GetDBDescriptorResponse{
+					Header:       &types.ResponseHeader{NodeId: "testNodeID"},
+					DbDescriptor: descriptor,
+					Version:      &types.Version{BlockNum: 2},
+				},
+			},
+			responseTemplate:   &types.GetDBDescriptorResponseEnvelope{},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:  "valid descriptor request as of a block",
+			url:   constants.URLForGetDBDescriptorAsOf(dbName, 5),
+			query: &types.GetDBDescriptorQuery{UserId: submittingUserName, DbName: dbName, AsOf: 5},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDescriptor", dbName, submittingUserName, uint64(5)).Return(&types.GetDBDescriptorResponseEnvelope{
+					Response: &types.GetDBDescriptorResponse{
+						Header: &types.ResponseHeader{NodeId: "testNodeID"},
+					},
+				}, nil)
+				return db
+			},
+			expectedResponse: &types.GetDBDescriptorResponseEnvelope{
+				Response: &types.GetDBDescriptorResponse{
+					Header: &types.ResponseHeader{NodeId: "testNodeID"},
+				},
+			},
+			responseTemplate:   &types.GetDBDescriptorResponseEnvelope{},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:  "descriptor of a system database",
+			url:   constants.URLForGetDBDescriptor("_users"),
+			query: &types.GetDBDescriptorQuery{UserId: submittingUserName, DbName: "_users"},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDescriptor", "_users", submittingUserName, uint64(0)).Return(nil, &interrors.PermissionErr{ErrMsg: "no descriptor for the system database [_users]"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /db/_users/descriptor' because no descriptor for the system database [_users]",
+		},
+		{
+			name:  "valid descriptor history request",
+			url:   constants.URLForGetDBDescriptorHistory(dbName),
+			query: &types.GetDBDescriptorHistoryQuery{UserId: submittingUserName, DbName: dbName},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDescriptorHistory", dbName, submittingUserName).Return(&types.GetDBDescriptorHistoryResponseEnvelope{
+					Response: &types.GetDBDescriptorHistoryResponse{
+						Header: &types.ResponseHeader{NodeId: "testNodeID"},
+						Changes: []*types.DBDescriptorChange{
+							{DbDescriptor: descriptor, Version: &types.Version{BlockNum: 2}, TxId: "tx1", UserId: "admin"},
+						},
+					},
+				}, nil)
+				return db
+			},
+			expectedResponse: &types.GetDBDescriptorHistoryResponseEnvelope{
+				Response: &types.GetDBDescriptorHistoryResponse{
+					Header: &types.ResponseHeader{NodeId: "testNodeID"},
+					Changes: []*types.DBDescriptorChange{
+						{DbDescriptor: descriptor, Version: &types.Version{BlockNum: 2}, TxId: "tx1", UserId: "admin"},
+					},
+				},
+			},
+			responseTemplate:   &types.GetDBDescriptorHistoryResponseEnvelope{},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:  "descriptor history without a provenance store",
+			url:   constants.URLForGetDBDescriptorHistory(dbName),
+			query: &types.GetDBDescriptorHistoryQuery{UserId: submittingUserName, DbName: dbName},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDescriptorHistory", dbName, submittingUserName).Return(nil, &interrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"})
+				return db
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedErr:        "error while processing 'GET /db/testDBName/descriptor/history' because provenance store is disabled on this server",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+	require.NotNil(t, logger)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+			req.Header.Set(constants.UserHeader, submittingUserName)
+			sig := testutils.SignatureFromQuery(t, aliceSigner, tt.query)
+			req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+
+			handler := NewDBRequestHandler(tt.dbMockFactory(), logger)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				err := json.NewDecoder(rr.Body).Decode(respErr)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			requestBody, err := ioutil.ReadAll(rr.Body)
+			require.NoError(t, err)
+			require.NoError(t, protojson.Unmarshal(requestBody, tt.responseTemplate))
+			require.True(t, proto.Equal(tt.expectedResponse, tt.responseTemplate))
+		})
+	}
+}
+
+func TestDBRequestHandler_DBDigest(t *testing.T) {
+	submittingUserName := "alice"
+	dbName := "testDBName"
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	testCases := []struct {
+		name               string
+		url                string
+		query              proto.Message
+		dbMockFactory      func() bcdb.DB
+		expectedResponse   proto.Message
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:  "valid digest request",
+			url:   constants.URLForGetDBDigest(dbName, 0),
+			query: &types.GetDBDigestQuery{UserId: submittingUserName, DbName: dbName},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDigest", dbName, submittingUserName, uint64(0)).Return(&types.GetDBDigestResponseEnvelope{
+					Response: &types.GetDBDigestResponse{
+						Header:      &types.ResponseHeader{NodeId: "testNodeID"},
+						BlockNumber: 7,
+						Digest:      []byte("digest"),
+						UpdatedAt:   5,
+					},
+				}, nil)
+				return db
+			},
+			expectedResponse: &types.GetDBDigestResponseEnvelope{
+				Response: &types.GetDBDigestResponse{
+					Header:      &types.ResponseHeader{NodeId: "testNodeID"},
+					BlockNumber: 7,
+					Digest:      []byte("digest"),
+					UpdatedAt:   5,
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:  "valid digest request as of a block",
+			url:   constants.URLForGetDBDigest(dbName, 3),
+			query: &types.GetDBDigestQuery{UserId: submittingUserName, DbName: dbName, BlockNumber: 3},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDigest", dbName, submittingUserName, uint64(3)).Return(&types.GetDBDigestResponseEnvelope{
+					Response: &types.GetDBDigestResponse{
+						Header:      &types.ResponseHeader{NodeId: "testNodeID"},
+						BlockNumber: 3,
+						Digest:      []byte("digest"),
+						UpdatedAt:   2,
+					},
+				}, nil)
+				return db
+			},
+			expectedResponse: &types.GetDBDigestResponseEnvelope{
+				Response: &types.GetDBDigestResponse{
+					Header:      &types.ResponseHeader{NodeId: "testNodeID"},
+					BlockNumber: 3,
+					Digest:      []byte("digest"),
+					UpdatedAt:   2,
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:  "block above the height",
+			url:   constants.URLForGetDBDigest(dbName, 9),
+			query: &types.GetDBDigestQuery{UserId: submittingUserName, DbName: dbName, BlockNumber: 9},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDigest", dbName, submittingUserName, uint64(9)).Return(nil, &interrors.BadRequestError{ErrMsg: "block [9] is greater than the current height [7]"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'GET /db/testDBName/digest?block=9' because block [9] is greater than the current height [7]",
+		},
+		{
+			name:  "no permission",
+			url:   constants.URLForGetDBDigest(dbName, 0),
+			query: &types.GetDBDigestQuery{UserId: submittingUserName, DbName: dbName},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDBDigest", dbName, submittingUserName, uint64(0)).Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no permission to read from database [testDBName]"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /db/testDBName/digest' because the user [alice] has no permission to read from database [testDBName]",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+	require.NotNil(t, logger)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+			req.Header.Set(constants.UserHeader, submittingUserName)
+			sig := testutils.SignatureFromQuery(t, aliceSigner, tt.query)
+			req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+
+			handler := NewDBRequestHandler(tt.dbMockFactory(), logger)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				err := json.NewDecoder(rr.Body).Decode(respErr)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			requestBody, err := ioutil.ReadAll(rr.Body)
+			require.NoError(t, err)
+			resp := &types.GetDBDigestResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(requestBody, resp))
+			require.True(t, proto.Equal(tt.expectedResponse, resp))
+		})
+	}
+}
+
 func TestDBRequestHandler_DBTransaction(t *testing.T) {
 	userID := "alice"
 	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
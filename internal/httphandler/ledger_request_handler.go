@@ -37,6 +37,8 @@ func NewLedgerRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handle
 	handler.router.HandleFunc(constants.GetBlockHeader, handler.blockQuery).Methods(http.MethodGet).Queries("augmented", "{isAugmented:true|false}")
 	// HTTP GET "/ledger/block/{blockId}" gets block header
 	handler.router.HandleFunc(constants.GetBlockHeader, handler.blockQuery).Methods(http.MethodGet)
+	// HTTP GET "/ledger/block/{blockId}/composition" gets the composition of the batch of a block
+	handler.router.HandleFunc(constants.GetBlockComposition, handler.blockCompositionQuery).Methods(http.MethodGet)
 	// HTTP GET "/ledger/block/last" gets last ledger block header
 	handler.router.HandleFunc(constants.GetLastBlockHeader, handler.lastBlockQuery).Methods(http.MethodGet)
 	// HTTP GET "/ledger/path?start={startId}&end={endId}" gets shortest path between blocks
@@ -50,6 +52,25 @@ func NewLedgerRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handle
 	handler.router.HandleFunc(constants.GetDataProof, handler.dataProof).Methods(http.MethodGet).Queries("block", "{blockId:[0-9]+}")
 	// HTTP GET "/ledger/tx/receipt/{txId}" gets transaction receipt
 	handler.router.HandleFunc(constants.GetTxReceipt, handler.txReceipt).Methods(http.MethodGet)
+	// HTTP GET "/ledger/tx/writeset/{txId}" verifies the write-set digest of a transaction
+	handler.router.HandleFunc(constants.GetTxWriteSetDigest, handler.txWriteSetDigest).Methods(http.MethodGet)
+	// HTTP GET "/ledger/tx/dropped/{txId}" gets the dead-letter record of a transaction dropped before it was included in
+	// a block
+	handler.router.HandleFunc(constants.GetDroppedTx, handler.droppedTx).Methods(http.MethodGet)
+	// HTTP GET "/ledger/rollups?start={date}&end={date}" gets the daily rollups of the ledger
+	handler.router.HandleFunc(constants.GetLedgerRollups, handler.ledgerRollups).Methods(http.MethodGet).Queries("start", "{start:[0-9-]+}", "end", "{end:[0-9-]+}")
+	// HTTP GET "/ledger/rollups?start={date}&end={date}" with invalid query params
+	handler.router.HandleFunc(constants.GetLedgerRollups, handler.invalidLedgerRollups).Methods(http.MethodGet)
+	// HTTP GET "/ledger/usage/{userId}?start={startId}&end={endId}" sums the sizes of the transactions submitted by a
+	// user in a range of blocks
+	handler.router.HandleFunc(constants.GetLedgerUsage, handler.ledgerUsage).Methods(http.MethodGet).Queries("start", "{startId:[0-9]+}", "end", "{endId:[0-9]+}")
+	// HTTP GET "/ledger/usage/{userId}?start={startId}&end={endId}" with invalid query params
+	handler.router.HandleFunc(constants.GetLedgerUsage, handler.invalidPathQuery).Methods(http.MethodGet)
+	// HTTP GET "/ledger/keyblocks/{dbname}/{key}?start={startId}&end={endId}" finds the blocks which may have modified a
+	// key in a range of blocks
+	handler.router.HandleFunc(constants.GetKeyBlocks, handler.keyBlocks).Methods(http.MethodGet).Queries("start", "{startId:[0-9]+}", "end", "{endId:[0-9]+}")
+	// HTTP GET "/ledger/keyblocks/{dbname}/{key}?start={startId}&end={endId}" with invalid query params
+	handler.router.HandleFunc(constants.GetKeyBlocks, handler.invalidPathQuery).Methods(http.MethodGet)
 	// HTTP GET "/ledger/path?start={startId}&end={endId}" with invalid query params
 	handler.router.HandleFunc(constants.GetPath, handler.invalidPathQuery).Methods(http.MethodGet)
 	// HTTP GET "/ledger/proof/tx/{blockId}?idx={idx}" with invalid query params
@@ -108,6 +129,38 @@ func (p *ledgerRequestHandler) blockQuery(response http.ResponseWriter, request
 	utils.SendHTTPResponse(response, http.StatusOK, data)
 }
 
+func (p *ledgerRequestHandler) blockCompositionQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetBlockComposition, p.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetBlockCompositionQuery)
+
+	data, err := p.db.GetBlockComposition(query.UserId, query.BlockNumber)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.NotFoundErr:
+			status = http.StatusNotFound
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
 func (p *ledgerRequestHandler) lastBlockQuery(response http.ResponseWriter, request *http.Request) {
 	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetLastBlockHeader, p.sigVerifier)
 	if respondedErr {
@@ -276,6 +329,178 @@ func (p *ledgerRequestHandler) txReceipt(response http.ResponseWriter, request *
 	utils.SendHTTPResponse(response, http.StatusOK, data)
 }
 
+func (p *ledgerRequestHandler) droppedTx(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetDroppedTx, p.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDroppedTxQuery)
+
+	data, err := p.db.GetDroppedTx(query.UserId, query.TxId)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.NotFoundErr:
+			status = http.StatusNotFound
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
+func (p *ledgerRequestHandler) txWriteSetDigest(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetTxWriteSetDigest, p.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetTxWriteSetDigestQuery)
+
+	data, err := p.db.VerifyTxWriteSetDigest(query.UserId, query.TxId)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.NotFoundErr:
+			status = http.StatusNotFound
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		case *errors.ServerRestrictionError:
+			status = http.StatusServiceUnavailable
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
+func (p *ledgerRequestHandler) ledgerRollups(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetLedgerRollups, p.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetLedgerRollupsQuery)
+
+	data, err := p.db.GetLedgerRollups(query.UserId, query.StartDate, query.EndDate)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		case *errors.ServerRestrictionError:
+			status = http.StatusServiceUnavailable
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
+func (p *ledgerRequestHandler) ledgerUsage(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetLedgerUsage, p.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetLedgerUsageQuery)
+
+	data, err := p.db.GetLedgerUsage(query.UserId, query.TargetUserId, query.StartBlockNumber, query.EndBlockNumber)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		case *errors.NotFoundErr:
+			status = http.StatusNotFound
+		case *errors.ServerRestrictionError:
+			status = http.StatusServiceUnavailable
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
+func (p *ledgerRequestHandler) keyBlocks(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetKeyBlocks, p.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetKeyBlocksQuery)
+
+	data, err := p.db.GetKeyBlocks(query.UserId, query.DbName, query.Key, query.StartBlockNumber, query.EndBlockNumber)
+	if err != nil {
+		var status int
+
+		switch err.(type) {
+		case *errors.PermissionErr:
+			status = http.StatusForbidden
+		case *errors.BadRequestError:
+			status = http.StatusBadRequest
+		case *errors.NotFoundErr:
+			status = http.StatusNotFound
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		utils.SendHTTPResponse(
+			response,
+			status,
+			&types.HttpResponseErr{
+				ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error(),
+			})
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, data)
+}
+
 func (p *ledgerRequestHandler) invalidPathQuery(response http.ResponseWriter, request *http.Request) {
 	err := &types.HttpResponseErr{
 		ErrMsg: "query error - bad or missing start/end block number",
@@ -283,6 +508,13 @@ func (p *ledgerRequestHandler) invalidPathQuery(response http.ResponseWriter, re
 	utils.SendHTTPResponse(response, http.StatusBadRequest, err)
 }
 
+func (p *ledgerRequestHandler) invalidLedgerRollups(response http.ResponseWriter, request *http.Request) {
+	err := &types.HttpResponseErr{
+		ErrMsg: "ledger rollups query error - bad or missing start/end date, expected YYYY-MM-DD",
+	}
+	utils.SendHTTPResponse(response, http.StatusBadRequest, err)
+}
+
 func (p *ledgerRequestHandler) invalidTxProof(response http.ResponseWriter, request *http.Request) {
 	err := &types.HttpResponseErr{
 		ErrMsg: "tx proof query error - bad or missing query parameter",
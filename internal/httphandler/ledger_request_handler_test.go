@@ -780,6 +780,48 @@ func TestDataProofQuery(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name: "valid get proof request, key absent",
+			expectedResponse: &types.GetDataProofResponseEnvelope{
+				Response: &types.GetDataProofResponse{
+					Header: &types.ResponseHeader{
+						NodeId: "testNodeID",
+					},
+					NonInclusionPath: []*types.MPTrieProofElement{
+						{
+							Hashes: [][]byte{[]byte("hash1"), []byte("hash2")},
+						},
+						{
+							Hashes: [][]byte{[]byte("hash3"), {}, []byte("hash4")},
+						},
+					},
+				},
+				Signature: []byte{0, 0, 0},
+			},
+			requestFactory: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodGet, constants.URLDataProof(2, "bdb", "key2", false), nil)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetDataProofQuery{
+					UserId:      submittingUserName,
+					BlockNumber: 2,
+					DbName:      "bdb",
+					Key:         "key2",
+					IsDeleted:   false,
+				})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req, nil
+			},
+			dbMockFactory: func(response *types.GetDataProofResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataProof", submittingUserName, uint64(2), "bdb", "key2", false).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
 		{
 			name: "valid get proof request, deleted true",
 			expectedResponse: &types.GetDataProofResponseEnvelope{
@@ -1158,3 +1200,667 @@ func TestTxReceiptQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestDroppedTxQuery(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.URLForGetDroppedTx("tx1"), nil)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetDroppedTxQuery{
+			UserId: submittingUserName,
+			TxId:   "tx1",
+		})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	envelope := &types.GetDroppedTxResponseEnvelope{
+		Response: &types.GetDroppedTxResponse{
+			Header: &types.ResponseHeader{NodeId: "testNodeID"},
+			DroppedTx: &types.DroppedTx{
+				TxId:      "tx1",
+				Submitter: submittingUserName,
+				Stage:     "queued",
+				Reason:    "the deadline of the transaction passed before it was included in a block",
+				DroppedAt: 1000,
+			},
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	testCases := []struct {
+		name               string
+		dbErr              error
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:               "valid get dropped tx request",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "not the submitter",
+			dbErr:              &interrors.PermissionErr{ErrMsg: "the user [alice] is neither the submitter of the transaction [tx1] nor an admin"},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /ledger/tx/dropped/tx1' because the user [alice] is neither the submitter of the transaction [tx1] nor an admin",
+		},
+		{
+			name:               "no record",
+			dbErr:              &interrors.NotFoundErr{Message: "no dead-letter record of transaction tx1 on this node"},
+			expectedStatusCode: http.StatusNotFound,
+			expectedErr:        "error while processing 'GET /ledger/tx/dropped/tx1' because no dead-letter record of transaction tx1 on this node",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &mocks.DB{}
+			db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+			if tt.dbErr != nil {
+				db.On("GetDroppedTx", submittingUserName, "tx1").Return(nil, tt.dbErr)
+			} else {
+				db.On("GetDroppedTx", submittingUserName, "tx1").Return(envelope, nil)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := NewLedgerRequestHandler(db, logger)
+			handler.ServeHTTP(rr, newRequest())
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetDroppedTxResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestLedgerRollupsQuery(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func(url, startDate, endDate string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetLedgerRollupsQuery{
+			UserId:    submittingUserName,
+			StartDate: startDate,
+			EndDate:   endDate,
+		})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	envelope := &types.GetLedgerRollupsResponseEnvelope{
+		Response: &types.GetLedgerRollupsResponse{
+			Header: &types.ResponseHeader{NodeId: "testNodeID"},
+			Rollups: []*types.LedgerDailyRollup{
+				{
+					Date:              "2026-10-15",
+					Blocks:            2,
+					Transactions:      3,
+					ValidTransactions: 2,
+					ActiveUsers:       3,
+					BytesWritten:      10,
+					FirstBlock:        1,
+					LastBlock:         2,
+				},
+			},
+			RollupHeight: 2,
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	testCases := []struct {
+		name               string
+		url                string
+		startDate, endDate string
+		dbErr              error
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:               "valid get ledger rollups request",
+			url:                constants.URLForGetLedgerRollups("2026-10-15", "2026-10-16"),
+			startDate:          "2026-10-15",
+			endDate:            "2026-10-16",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "missing end date",
+			url:                constants.GetLedgerRollups + "?start=2026-10-15",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "ledger rollups query error - bad or missing start/end date, expected YYYY-MM-DD",
+		},
+		{
+			name:               "bad date",
+			url:                constants.URLForGetLedgerRollups("2026-10-15", "2026-10-32"),
+			startDate:          "2026-10-15",
+			endDate:            "2026-10-32",
+			dbErr:              &interrors.BadRequestError{ErrMsg: "the date [2026-10-32] is not a date since 1970-01-01 given as YYYY-MM-DD"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'GET /ledger/rollups?start=2026-10-15&end=2026-10-32' because the date [2026-10-32] is not a date since 1970-01-01 given as YYYY-MM-DD",
+		},
+		{
+			name:               "rollups not recorded",
+			url:                constants.URLForGetLedgerRollups("2026-10-15", "2026-10-16"),
+			startDate:          "2026-10-15",
+			endDate:            "2026-10-16",
+			dbErr:              &interrors.ServerRestrictionError{ErrMsg: "the node does not record the ledger rollups"},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedErr:        "error while processing 'GET /ledger/rollups?start=2026-10-15&end=2026-10-16' because the node does not record the ledger rollups",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &mocks.DB{}
+			db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+			if tt.dbErr != nil {
+				db.On("GetLedgerRollups", submittingUserName, tt.startDate, tt.endDate).Return(nil, tt.dbErr)
+			} else {
+				db.On("GetLedgerRollups", submittingUserName, "2026-10-15", "2026-10-16").Return(envelope, nil)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := NewLedgerRequestHandler(db, logger)
+			handler.ServeHTTP(rr, newRequest(tt.url, tt.startDate, tt.endDate))
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetLedgerRollupsResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestLedgerUsageQuery(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func(url, targetUserID string, startBlockNum, endBlockNum uint64) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetLedgerUsageQuery{
+			UserId:           submittingUserName,
+			TargetUserId:     targetUserID,
+			StartBlockNumber: startBlockNum,
+			EndBlockNumber:   endBlockNum,
+		})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	envelope := &types.GetLedgerUsageResponseEnvelope{
+		Response: &types.GetLedgerUsageResponse{
+			Header:           &types.ResponseHeader{NodeId: "testNodeID"},
+			TargetUserId:     "alice",
+			StartBlockNumber: 2,
+			EndBlockNumber:   5,
+			Transactions:     3,
+			EnvelopeBytes:    1200,
+			WriteBytes:       300,
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	testCases := []struct {
+		name               string
+		url                string
+		targetUserID       string
+		start, end         uint64
+		dbErr              error
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:               "valid get ledger usage request",
+			url:                constants.URLForGetLedgerUsage("alice", 2, 5),
+			targetUserID:       "alice",
+			start:              2,
+			end:                5,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "missing end block",
+			url:                "/ledger/usage/alice?start=2",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "query error - bad or missing start/end block number",
+		},
+		{
+			name:               "end block before start block",
+			url:                constants.URLForGetLedgerUsage("alice", 5, 2),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "query error: startId=5 > endId=2",
+		},
+		{
+			name:               "usage of another user",
+			url:                constants.URLForGetLedgerUsage("bob", 2, 5),
+			targetUserID:       "bob",
+			start:              2,
+			end:                5,
+			dbErr:              &interrors.PermissionErr{ErrMsg: "user alice is neither an admin nor querying its own usage of the ledger, only an admin can query the usage of user bob"},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /ledger/usage/bob?start=2&end=5' because user alice is neither an admin nor querying its own usage of the ledger, only an admin can query the usage of user bob",
+		},
+		{
+			name:               "provenance disabled",
+			url:                constants.URLForGetLedgerUsage("alice", 2, 5),
+			targetUserID:       "alice",
+			start:              2,
+			end:                5,
+			dbErr:              &interrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedErr:        "error while processing 'GET /ledger/usage/alice?start=2&end=5' because provenance store is disabled on this server",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &mocks.DB{}
+			db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+			if tt.dbErr != nil {
+				db.On("GetLedgerUsage", submittingUserName, tt.targetUserID, tt.start, tt.end).Return(nil, tt.dbErr)
+			} else {
+				db.On("GetLedgerUsage", submittingUserName, "alice", uint64(2), uint64(5)).Return(envelope, nil)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := NewLedgerRequestHandler(db, logger)
+			handler.ServeHTTP(rr, newRequest(tt.url, tt.targetUserID, tt.start, tt.end))
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetLedgerUsageResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestKeyBlocksQuery(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	newRequest := func(url, key string, startBlockNum, endBlockNum uint64) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetKeyBlocksQuery{
+			UserId:           submittingUserName,
+			DbName:           "db1",
+			Key:              key,
+			StartBlockNumber: startBlockNum,
+			EndBlockNumber:   endBlockNum,
+		})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	envelope := &types.GetKeyBlocksResponseEnvelope{
+		Response: &types.GetKeyBlocksResponse{
+			Header:           &types.ResponseHeader{NodeId: "testNodeID"},
+			DbName:           "db1",
+			Key:              "key1",
+			StartBlockNumber: 2,
+			EndBlockNumber:   5,
+			Candidates: []*types.KeyBlockCandidate{
+				{BlockNumber: 2, FalsePositiveProbability: 1, Unfiltered: true},
+				{BlockNumber: 4, FalsePositiveProbability: 0.008},
+			},
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	testCases := []struct {
+		name               string
+		url                string
+		key                string
+		start, end         uint64
+		dbErr              error
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name:               "valid get key blocks request",
+			url:                constants.URLForGetKeyBlocks("db1", "key1", 2, 5),
+			key:                "key1",
+			start:              2,
+			end:                5,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "missing end block",
+			url:                "/ledger/keyblocks/db1/key1?start=2",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "query error - bad or missing start/end block number",
+		},
+		{
+			name:               "end block before start block",
+			url:                constants.URLForGetKeyBlocks("db1", "key1", 5, 2),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "query error: startId=5 > endId=2",
+		},
+		{
+			name:               "no read permission",
+			url:                constants.URLForGetKeyBlocks("db1", "key2", 2, 5),
+			key:                "key2",
+			start:              2,
+			end:                5,
+			dbErr:              &interrors.PermissionErr{ErrMsg: "user alice has no permission to read from database db1"},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /ledger/keyblocks/db1/key2?start=2&end=5' because user alice has no permission to read from database db1",
+		},
+		{
+			name:               "range past the last block",
+			url:                constants.URLForGetKeyBlocks("db1", "key1", 2, 50),
+			key:                "key1",
+			start:              2,
+			end:                50,
+			dbErr:              &interrors.NotFoundErr{Message: "block not found: 50"},
+			expectedStatusCode: http.StatusNotFound,
+			expectedErr:        "error while processing 'GET /ledger/keyblocks/db1/key1?start=2&end=50' because block not found: 50",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &mocks.DB{}
+			db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+			if tt.dbErr != nil {
+				db.On("GetKeyBlocks", submittingUserName, "db1", tt.key, tt.start, tt.end).Return(nil, tt.dbErr)
+			} else {
+				db.On("GetKeyBlocks", submittingUserName, "db1", "key1", uint64(2), uint64(5)).Return(envelope, nil)
+			}
+
+			rr := httptest.NewRecorder()
+			handler := NewLedgerRequestHandler(db, logger)
+			handler.ServeHTTP(rr, newRequest(tt.url, tt.key, tt.start, tt.end))
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetKeyBlocksResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(envelope, res))
+		})
+	}
+}
+
+func TestTxWriteSetDigestQuery(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	requestFactory := func() (*http.Request, error) {
+		req, err := http.NewRequest(http.MethodGet, constants.URLForVerifyTxWriteSetDigest("tx1"), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetTxWriteSetDigestQuery{
+			UserId: submittingUserName,
+			TxId:   "tx1",
+		})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req, nil
+	}
+
+	testCases := []struct {
+		name               string
+		dbMockFactory      func(response *types.GetTxWriteSetDigestResponseEnvelope) bcdb.DB
+		expectedResponse   *types.GetTxWriteSetDigestResponseEnvelope
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid verification request",
+			expectedResponse: &types.GetTxWriteSetDigestResponseEnvelope{
+				Response: &types.GetTxWriteSetDigestResponse{
+					Header: &types.ResponseHeader{
+						NodeId: "testNodeID",
+					},
+					CommittedDigest:  []byte{1, 2, 3},
+					RecomputedDigest: []byte{1, 2, 3},
+					Verified:         true,
+				},
+				Signature: []byte{0, 0, 0},
+			},
+			dbMockFactory: func(response *types.GetTxWriteSetDigestResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("VerifyTxWriteSetDigest", submittingUserName, "tx1").Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid tx",
+			dbMockFactory: func(response *types.GetTxWriteSetDigestResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("VerifyTxWriteSetDigest", submittingUserName, "tx1").Return(nil, &interrors.BadRequestError{ErrMsg: "transaction tx1 is invalid and has no write-set digest"})
+				return db
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "error while processing 'GET /ledger/tx/writeset/tx1' because transaction tx1 is invalid and has no write-set digest",
+		},
+		{
+			name: "provenance disabled",
+			dbMockFactory: func(response *types.GetTxWriteSetDigestResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("VerifyTxWriteSetDigest", submittingUserName, "tx1").Return(nil, &interrors.ServerRestrictionError{ErrMsg: "provenance store is disabled on this server"})
+				return db
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedErr:        "error while processing 'GET /ledger/tx/writeset/tx1' because provenance store is disabled on this server",
+		},
+		{
+			name: "tx not exist",
+			dbMockFactory: func(response *types.GetTxWriteSetDigestResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("VerifyTxWriteSetDigest", submittingUserName, "tx1").Return(nil, &interrors.NotFoundErr{Message: "tx not found"})
+				return db
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedErr:        "error while processing 'GET /ledger/tx/writeset/tx1' because tx not found",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+	require.NotNil(t, logger)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := requestFactory()
+			require.NoError(t, err)
+			require.NotNil(t, req)
+
+			db := tt.dbMockFactory(tt.expectedResponse)
+			rr := httptest.NewRecorder()
+			handler := NewLedgerRequestHandler(db, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				err := json.NewDecoder(rr.Body).Decode(respErr)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+			}
+
+			if tt.expectedResponse != nil {
+				requestBody, err := ioutil.ReadAll(rr.Body)
+				require.NoError(t, err)
+				res := &types.GetTxWriteSetDigestResponseEnvelope{}
+				require.NoError(t, protojson.Unmarshal(requestBody, res))
+				require.True(t, proto.Equal(tt.expectedResponse, res))
+			}
+		})
+	}
+}
+
+func TestBlockCompositionQuery(t *testing.T) {
+	submittingUserName := "admin"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+
+	requestFactory := func() (*http.Request, error) {
+		req, err := http.NewRequest(http.MethodGet, constants.URLForGetBlockComposition(5), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		sig := testutils.SignatureFromQuery(t, adminSigner, &types.GetBlockCompositionQuery{
+			UserId:      submittingUserName,
+			BlockNumber: 5,
+		})
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req, nil
+	}
+
+	testCases := []struct {
+		name               string
+		dbMockFactory      func(response *types.GetBlockCompositionResponseEnvelope) bcdb.DB
+		expectedResponse   *types.GetBlockCompositionResponseEnvelope
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid request",
+			expectedResponse: &types.GetBlockCompositionResponseEnvelope{
+				Response: &types.GetBlockCompositionResponse{
+					Header: &types.ResponseHeader{
+						NodeId: "testNodeID",
+					},
+					Composition: &types.BatchComposition{
+						BlockNumber:        5,
+						CutReason:          types.BatchComposition_TIMEOUT,
+						TxCount:            3,
+						TxCountPerUser:     map[string]uint32{"alice": 2, "bob": 1},
+						QueueWaitP50Micros: 1200,
+						QueueWaitP95Micros: 3400,
+					},
+				},
+				Signature: []byte{0, 0, 0},
+			},
+			dbMockFactory: func(response *types.GetBlockCompositionResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetBlockComposition", submittingUserName, uint64(5)).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "not an admin",
+			dbMockFactory: func(response *types.GetBlockCompositionResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetBlockComposition", submittingUserName, uint64(5)).Return(nil, &interrors.PermissionErr{ErrMsg: "user admin has no permission to access the batch composition of a block"})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET /ledger/block/5/composition' because user admin has no permission to access the batch composition of a block",
+		},
+		{
+			name: "composition not recorded",
+			dbMockFactory: func(response *types.GetBlockCompositionResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetBlockComposition", submittingUserName, uint64(5)).Return(nil, &interrors.NotFoundErr{Message: "the batch composition of block 5 is not recorded on this node"})
+				return db
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedErr:        "error while processing 'GET /ledger/block/5/composition' because the batch composition of block 5 is not recorded on this node",
+		},
+		{
+			name: "internal error",
+			dbMockFactory: func(response *types.GetBlockCompositionResponseEnvelope) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
+				db.On("GetBlockComposition", submittingUserName, uint64(5)).Return(nil, errors.New("oops, something went wrong"))
+				return db
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedErr:        "error while processing 'GET /ledger/block/5/composition' because oops, something went wrong",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+	require.NotNil(t, logger)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := requestFactory()
+			require.NoError(t, err)
+			require.NotNil(t, req)
+
+			db := tt.dbMockFactory(tt.expectedResponse)
+			rr := httptest.NewRecorder()
+			handler := NewLedgerRequestHandler(db, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				err := json.NewDecoder(rr.Body).Decode(respErr)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+			}
+
+			if tt.expectedResponse != nil {
+				requestBody, err := ioutil.ReadAll(rr.Body)
+				require.NoError(t, err)
+				res := &types.GetBlockCompositionResponseEnvelope{}
+				require.NoError(t, protojson.Unmarshal(requestBody, res))
+				require.True(t, proto.Equal(tt.expectedResponse, res))
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@
 package httphandler
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -59,6 +60,7 @@ func NewProvenanceRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Ha
 	handler.router.HandleFunc(constants.GetHistoricalData, handler.getHistoricalData).Methods(http.MethodGet).Queries(versionAndDirectionMatcher[:4]...)
 	handler.router.HandleFunc(constants.GetHistoricalData, handler.getHistoricalData).Methods(http.MethodGet).Queries("onlydeletes", "{onlydeletes:true}")
 	handler.router.HandleFunc(constants.GetHistoricalData, handler.getHistoricalData).Methods(http.MethodGet)
+	handler.router.HandleFunc(constants.GetDataByVersion, handler.getDataByVersion).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.GetDataReaders, handler.getDataReaders).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.GetDataWriters, handler.getDataWriters).Methods(http.MethodGet)
 	handler.router.HandleFunc(constants.GetDataReadBy, handler.getDataReadByUser).Methods(http.MethodGet)
@@ -86,9 +88,9 @@ func (p *provenanceRequestHandler) getHistoricalData(w http.ResponseWriter, r *h
 
 	switch {
 	case query.OnlyDeletes:
-		response, err = p.db.GetDeletedValues(query.UserId, query.DbName, query.Key)
+		response, err = p.db.GetDeletedValues(query.UserId, query.DbName, query.Key, query.Limit, query.Cursor)
 	case query.Version == nil:
-		response, err = p.db.GetValues(query.UserId, query.DbName, query.Key)
+		response, err = p.db.GetValues(query.UserId, query.DbName, query.Key, query.Limit, query.Cursor)
 	case query.Direction == "" && query.MostRecent:
 		response, err = p.db.GetMostRecentValueAtOrBelow(query.UserId, query.DbName, query.Key, query.Version)
 	case query.Direction == "":
@@ -111,6 +113,25 @@ func (p *provenanceRequestHandler) getHistoricalData(w http.ResponseWriter, r *h
 	utils.SendHTTPResponse(w, http.StatusOK, response)
 }
 
+func (p *provenanceRequestHandler) getDataByVersion(w http.ResponseWriter, r *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(w, r, constants.GetDataByVersion, p.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetDataByVersionQuery)
+
+	response, err := p.db.GetDataByVersion(query.UserId, query.DbName, query.Key, query.Version)
+	if err != nil {
+		if notFoundErr, ok := err.(*ierrors.VersionNotFoundErr); ok && notFoundErr.NearestVersion != nil {
+			w.Header().Set(constants.NearestVersionHeader, fmt.Sprintf("%d/%d", notFoundErr.NearestVersion.BlockNum, notFoundErr.NearestVersion.TxNum))
+		}
+		handleError(w, r, err)
+		return
+	}
+
+	utils.SendHTTPResponse(w, http.StatusOK, response)
+}
+
 func (p *provenanceRequestHandler) getDataReaders(w http.ResponseWriter, r *http.Request) {
 	payload, respondedErr := extractVerifiedQueryPayload(w, r, constants.GetDataReaders, p.sigVerifier)
 	if respondedErr {
@@ -215,6 +236,12 @@ func handleError(w http.ResponseWriter, r *http.Request, err error) {
 		status = http.StatusServiceUnavailable
 	case *ierrors.PermissionErr:
 		status = http.StatusForbidden
+	case *ierrors.VersionNotFoundErr:
+		status = http.StatusNotFound
+	case *ierrors.BadRequestError:
+		status = http.StatusBadRequest
+	case *ierrors.CursorExpiredError:
+		status = http.StatusGone
 	default:
 		status = http.StatusInternalServerError
 	}
@@ -32,6 +32,7 @@ type testCase struct {
 	expectedStatusCode int
 	expectedResponse   interface{}
 	expectedErr        string
+	expectedHeaders    map[string]string
 }
 
 func TestGetHistoricalData(t *testing.T) {
@@ -77,7 +78,7 @@ func TestGetHistoricalData(t *testing.T) {
 			dbMockFactory: func(response interface{}) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
-				db.On("GetValues", submittingUserName, dbName, key).Return(genericResponse, nil)
+				db.On("GetValues", submittingUserName, dbName, key, uint64(0), "").Return(genericResponse, nil)
 				return db
 			},
 			expectedStatusCode: http.StatusOK,
@@ -100,7 +101,7 @@ func TestGetHistoricalData(t *testing.T) {
 			dbMockFactory: func(response interface{}) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
-				db.On("GetDeletedValues", submittingUserName, dbName, key).Return(genericResponse, nil)
+				db.On("GetDeletedValues", submittingUserName, dbName, key, uint64(0), "").Return(genericResponse, nil)
 				return db
 			},
 			expectedStatusCode: http.StatusOK,
@@ -217,7 +218,7 @@ func TestGetHistoricalData(t *testing.T) {
 			dbMockFactory: func(response interface{}) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
-				db.On("GetValues", submittingUserName, dbName, key).Return(nil, errors.New("error in provenance db"))
+				db.On("GetValues", submittingUserName, dbName, key, uint64(0), "").Return(nil, errors.New("error in provenance db"))
 				return db
 			},
 			expectedStatusCode: http.StatusInternalServerError,
@@ -239,7 +240,7 @@ func TestGetHistoricalData(t *testing.T) {
 			dbMockFactory: func(response interface{}) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
-				db.On("GetValues", submittingUserName, dbName, key).Return(nil, &ierrors.PermissionErr{ErrMsg: "no permission: only admin can access historical data"})
+				db.On("GetValues", submittingUserName, dbName, key, uint64(0), "").Return(nil, &ierrors.PermissionErr{ErrMsg: "no permission: only admin can access historical data"})
 				return db
 			},
 			expectedStatusCode: http.StatusForbidden,
@@ -261,7 +262,7 @@ func TestGetHistoricalData(t *testing.T) {
 			dbMockFactory: func(response interface{}) bcdb.DB {
 				db := &mocks.DB{}
 				db.On("GetCertificate", submittingUserName).Return(adminCert, nil)
-				db.On("GetValues", submittingUserName, dbName, key).Return(nil, &ierrors.ServerRestrictionError{ErrMsg: "disabled store"})
+				db.On("GetValues", submittingUserName, dbName, key, uint64(0), "").Return(nil, &ierrors.ServerRestrictionError{ErrMsg: "disabled store"})
 				return db
 			},
 			expectedStatusCode: http.StatusServiceUnavailable,
@@ -281,6 +282,109 @@ func TestGetHistoricalData(t *testing.T) {
 	}
 }
 
+func TestGetDataByVersion(t *testing.T) {
+	t.Parallel()
+
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	dbName := "db1"
+	key := "key1"
+	version := &types.Version{
+		BlockNum: 5,
+		TxNum:    2,
+	}
+	query := &types.GetDataByVersionQuery{
+		UserId:  submittingUserName,
+		DbName:  dbName,
+		Key:     key,
+		Version: version,
+	}
+	url := constants.URLForGetDataByVersion(dbName, key, version)
+
+	testCases := []testCase{
+		{
+			name:    "valid: live version",
+			request: constructRequestForTestCase(t, url, query, aliceSigner, submittingUserName),
+			dbMockFactory: func(response interface{}) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataByVersion", submittingUserName, dbName, key, version).Return(response, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: &types.GetDataByVersionResponseEnvelope{
+				Response: &types.GetDataByVersionResponse{
+					Header: &types.ResponseHeader{
+						NodeId: "testNodeID",
+					},
+					Value: &types.ValueWithMetadata{
+						Value: []byte("value1"),
+						Metadata: &types.Metadata{
+							Version: version,
+						},
+					},
+					TxId:   "tx1",
+					IsLive: true,
+				},
+			},
+		},
+		{
+			name:    "missing version with a hint",
+			request: constructRequestForTestCase(t, url, query, aliceSigner, submittingUserName),
+			dbMockFactory: func(response interface{}) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataByVersion", submittingUserName, dbName, key, version).Return(nil, &ierrors.VersionNotFoundErr{
+					ErrMsg:         "the key [key1] in database [db1] did not hold a value at version (5, 2)",
+					NearestVersion: &types.Version{BlockNum: 4, TxNum: 7},
+				})
+				return db
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedErr:        "error while processing 'GET " + url + "' because the key [key1] in database [db1] did not hold a value at version (5, 2)",
+			expectedHeaders:    map[string]string{constants.NearestVersionHeader: "4/7"},
+		},
+		{
+			name:    "missing version without a hint",
+			request: constructRequestForTestCase(t, url, query, aliceSigner, submittingUserName),
+			dbMockFactory: func(response interface{}) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataByVersion", submittingUserName, dbName, key, version).Return(nil, &ierrors.VersionNotFoundErr{
+					ErrMsg: "the key [key1] in database [db1] did not hold a value at version (5, 2)",
+				})
+				return db
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedErr:        "error while processing 'GET " + url + "' because the key [key1] in database [db1] did not hold a value at version (5, 2)",
+			expectedHeaders:    map[string]string{constants.NearestVersionHeader: ""},
+		},
+		{
+			name:    "permission error",
+			request: constructRequestForTestCase(t, url, query, aliceSigner, submittingUserName),
+			dbMockFactory: func(response interface{}) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetDataByVersion", submittingUserName, dbName, key, version).Return(nil, &ierrors.PermissionErr{
+					ErrMsg: "the user [alice] has no permission to read key [key1] from database [db1] at version (5, 2)",
+				})
+				return db
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "error while processing 'GET " + url + "' because the user [alice] has no permission to read key [key1] from database [db1] at version (5, 2)",
+		},
+		constructTestCaseForSigVerificationFailure(t, url, submittingUserName),
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assertTestCase(t, tt, &types.GetDataByVersionResponseEnvelope{})
+		})
+	}
+}
+
 func TestGetDataReaders(t *testing.T) {
 	t.Parallel()
 
@@ -1026,6 +1130,9 @@ func assertTestCase(t *testing.T, tt testCase, responseType interface{}) {
 	handler.ServeHTTP(rr, tt.request)
 
 	require.Equal(t, tt.expectedStatusCode, rr.Code)
+	for name, value := range tt.expectedHeaders {
+		require.Equal(t, value, rr.Header().Get(name), name)
+	}
 	if tt.expectedStatusCode != http.StatusOK {
 		respErr := &types.HttpResponseErr{}
 		err := json.NewDecoder(rr.Body).Decode(respErr)
@@ -0,0 +1,361 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+)
+
+const (
+	defaultQueryQueueTimeout = 100 * time.Millisecond
+	defaultQueryRetryAfter   = time.Second
+
+	queryLimiterMetricPrefix = "orion_query_limiter_"
+)
+
+// queryClass groups the queries by their cost to the state database
+type queryClass int
+
+const (
+	unlimitedQuery queryClass = iota
+	pointQuery
+	rangeQuery
+	historyQuery
+)
+
+func (c queryClass) String() string {
+	switch c {
+	case pointQuery:
+		return "point"
+	case rangeQuery:
+		return "range"
+	case historyQuery:
+		return "history"
+	default:
+		return "unlimited"
+	}
+}
+
+// queryClassPattern matches the requests of a class of queries by method and path, the path being stripped of the
+// major version prefix
+type queryClassPattern struct {
+	method string
+	path   *regexp.Regexp
+	class  queryClass
+}
+
+// queryClassPatterns are matched in order; a request that matches none is not limited. The subscriptions are long
+// lived, hence, they are not limited, nor are the transactions, which are throttled by the transaction queue.
+var queryClassPatterns = []*queryClassPattern{
+	{method: http.MethodGet, path: regexp.MustCompile(`^/provenance/`), class: historyQuery},
+	{method: http.MethodGet, path: regexp.MustCompile(`^/db/[^/]+/descriptor/history$`), class: historyQuery},
+	{method: http.MethodGet, path: regexp.MustCompile(`^/db/[^/]+/digest$`), class: historyQuery},
+	{method: http.MethodGet, path: regexp.MustCompile(`^/ledger/usage/`), class: historyQuery},
+	{method: http.MethodGet, path: regexp.MustCompile(`^/data/[^/]+(/count|/exists)?$`), class: rangeQuery},
+	{method: http.MethodPost, path: regexp.MustCompile(`^/data/[^/]+/(jsonquery|multiget)$`), class: rangeQuery},
+	{method: http.MethodGet, path: regexp.MustCompile(`^/(data|db|user|config|ledger)/`), class: pointQuery},
+}
+
+func classifyQuery(r *http.Request) queryClass {
+	for _, p := range queryClassPatterns {
+		if r.Method == p.method && p.path.MatchString(r.URL.Path) {
+			return p.class
+		}
+	}
+	return unlimitedQuery
+}
+
+// QueryLimiter bounds the number of queries of each class served concurrently. A query that finds its class at the
+// limit waits for a query of the class to complete, up to the queue timeout, and is then shed with 503 and a
+// Retry-After header. The limiter only sees the requests of the clients; the committer reads and writes the state
+// database directly.
+//
+// With the commit priority, the limit of each class and the queue timeout are halved while the commits of the blocks
+// are under pressure, and restored once they are relieved, see SetCommitPressure.
+type QueryLimiter struct {
+	// the counters are accessed atomically, hence, they come first to be 64-bit aligned
+	pressureTotal uint64
+	reliefTotal   uint64
+	underPressure int32
+
+	classes        map[queryClass]*queryClassLimiter
+	queueTimeout   time.Duration
+	retryAfter     time.Duration
+	commitPriority bool
+	logger         *logger.SugarLogger
+}
+
+type queryClassLimiter struct {
+	// the counters are accessed atomically, hence, they come first to be 64-bit aligned
+	inFlight    int64
+	queued      int64
+	withheld    int64
+	queuedTotal uint64
+	shedTotal   uint64
+
+	class queryClass
+	slots chan struct{}
+
+	// withholding is closed to stop the goroutine that withholds the slots of the class, and withheldAll is closed
+	// once the goroutine exits
+	mu          sync.Mutex
+	withholding chan struct{}
+	withheldAll chan struct{}
+}
+
+// NewQueryLimiter creates a limiter with the limits of the configuration. A class with a zero limit is not limited.
+func NewQueryLimiter(conf *config.QueryConcurrencyConf, logger *logger.SugarLogger) *QueryLimiter {
+	l := &QueryLimiter{
+		classes:        make(map[queryClass]*queryClassLimiter),
+		queueTimeout:   conf.QueueTimeout,
+		retryAfter:     conf.RetryAfter,
+		commitPriority: conf.CommitPriority.Enabled,
+		logger:         logger,
+	}
+	if l.queueTimeout == 0 {
+		l.queueTimeout = defaultQueryQueueTimeout
+	}
+	if l.retryAfter == 0 {
+		l.retryAfter = defaultQueryRetryAfter
+	}
+
+	for class, limit := range map[queryClass]uint32{
+		pointQuery:   conf.PointReads,
+		rangeQuery:   conf.RangeQueries,
+		historyQuery: conf.HistoryQueries,
+	} {
+		if limit > 0 {
+			l.classes[class] = &queryClassLimiter{
+				class: class,
+				slots: make(chan struct{}, limit),
+			}
+		}
+	}
+
+	return l
+}
+
+// Limit serves the requests to the given handler within the limits of their class
+func (l *QueryLimiter) Limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, ok := l.classes[classifyQuery(r)]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		queueTimeout := l.queueTimeout
+		if atomic.LoadInt32(&l.underPressure) == 1 {
+			queueTimeout /= 2
+		}
+
+		if !c.acquire(r.Context(), queueTimeout) {
+			limit := c.limit()
+			l.logger.Debugf("shed the %s query [%s %s], as %d queries of its class are in flight", c.class, r.Method, r.URL.Path, limit)
+			utils.SendHTTPProblem(w, &utils.Problem{
+				Type:       "about:blank",
+				Title:      http.StatusText(http.StatusServiceUnavailable),
+				Status:     http.StatusServiceUnavailable,
+				Detail:     fmt.Sprintf("the server is serving the maximal number of %s queries, %d, retry later", c.class, limit),
+				RetryAfter: int64(math.Ceil(l.retryAfter.Seconds())),
+			})
+			return
+		}
+		defer c.release()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// acquire takes a slot of the class, waiting for one up to the timeout, and returns false if no slot was freed in
+// time or the request was canceled
+func (c *queryClassLimiter) acquire(ctx context.Context, timeout time.Duration) bool {
+	select {
+	case c.slots <- struct{}{}:
+		atomic.AddInt64(&c.inFlight, 1)
+		return true
+	default:
+	}
+
+	atomic.AddInt64(&c.queued, 1)
+	atomic.AddUint64(&c.queuedTotal, 1)
+	defer atomic.AddInt64(&c.queued, -1)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case c.slots <- struct{}{}:
+		atomic.AddInt64(&c.inFlight, 1)
+		return true
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	atomic.AddUint64(&c.shedTotal, 1)
+	return false
+}
+
+func (c *queryClassLimiter) release() {
+	atomic.AddInt64(&c.inFlight, -1)
+	<-c.slots
+}
+
+// limit returns the number of queries of the class that can be served concurrently, i.e., the slots of the class
+// which are not withheld
+func (c *queryClassLimiter) limit() int {
+	return cap(c.slots) - int(atomic.LoadInt64(&c.withheld))
+}
+
+// withhold takes n slots of the class in the background, one at a time as the queries in flight release them, until
+// all are taken or the slots are restored. The withheld slots are not available to the queries.
+func (c *queryClassLimiter) withhold(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.withholding != nil || n == 0 {
+		return
+	}
+	stop, done := make(chan struct{}), make(chan struct{})
+	c.withholding, c.withheldAll = stop, done
+
+	go func() {
+		defer close(done)
+		for i := 0; i < n; i++ {
+			select {
+			case c.slots <- struct{}{}:
+				atomic.AddInt64(&c.withheld, 1)
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// restore stops withholding the slots of the class, and frees the slots already withheld
+func (c *queryClassLimiter) restore() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.withholding == nil {
+		return
+	}
+	close(c.withholding)
+	<-c.withheldAll
+	c.withholding, c.withheldAll = nil, nil
+
+	for atomic.LoadInt64(&c.withheld) > 0 {
+		atomic.AddInt64(&c.withheld, -1)
+		<-c.slots
+	}
+}
+
+// SetCommitPressure halves the limit of each class, and the queue timeout, when the commits of the blocks come under
+// pressure, so that fewer queries compete with the committer for the state database and more of them are shed, and
+// restores them when the commits are relieved. The limit of a class falls as its queries in flight complete, as
+// these are never interrupted. It does nothing unless the commit priority is enabled.
+func (l *QueryLimiter) SetCommitPressure(underPressure bool) {
+	if !l.commitPriority {
+		return
+	}
+
+	var state int32
+	if underPressure {
+		state = 1
+	}
+	if atomic.SwapInt32(&l.underPressure, state) == state {
+		return
+	}
+
+	if underPressure {
+		atomic.AddUint64(&l.pressureTotal, 1)
+		l.logger.Infof("the commits are under pressure, halving the limits and the queue timeout of the queries")
+	} else {
+		atomic.AddUint64(&l.reliefTotal, 1)
+		l.logger.Infof("the commits are relieved, restoring the limits and the queue timeout of the queries")
+	}
+
+	for _, c := range l.classes {
+		if underPressure {
+			c.withhold(cap(c.slots) / 2)
+		} else {
+			c.restore()
+		}
+	}
+}
+
+// Metrics returns the metrics of the commit priority, if enabled, and of the limited classes, ordered by name and
+// class, in the model of the storage metrics
+func (l *QueryLimiter) Metrics() []*leveldb.StorageMetric {
+	definitions := []struct {
+		name       string
+		help       string
+		metricType string
+		value      func(c *queryClassLimiter) float64
+	}{
+		{"in_flight", "Number of queries being served.", leveldb.MetricTypeGauge,
+			func(c *queryClassLimiter) float64 { return float64(atomic.LoadInt64(&c.inFlight)) }},
+		{"limit", "Maximal number of queries served concurrently.", leveldb.MetricTypeGauge,
+			func(c *queryClassLimiter) float64 { return float64(c.limit()) }},
+		{"queued", "Number of queries waiting to be served.", leveldb.MetricTypeGauge,
+			func(c *queryClassLimiter) float64 { return float64(atomic.LoadInt64(&c.queued)) }},
+		{"queued_total", "Number of queries that waited to be served.", leveldb.MetricTypeCounter,
+			func(c *queryClassLimiter) float64 { return float64(atomic.LoadUint64(&c.queuedTotal)) }},
+		{"shed_total", "Number of queries rejected as they waited too long to be served.", leveldb.MetricTypeCounter,
+			func(c *queryClassLimiter) float64 { return float64(atomic.LoadUint64(&c.shedTotal)) }},
+	}
+
+	var metrics []*leveldb.StorageMetric
+	if l.commitPriority && len(l.classes) > 0 {
+		metrics = append(metrics,
+			&leveldb.StorageMetric{
+				Name:  queryLimiterMetricPrefix + "commit_pressure",
+				Help:  "Whether the limits are halved as the commits are under pressure.",
+				Type:  leveldb.MetricTypeGauge,
+				Value: float64(atomic.LoadInt32(&l.underPressure)),
+			},
+			&leveldb.StorageMetric{
+				Name:  queryLimiterMetricPrefix + "commit_pressure_total",
+				Help:  "Number of times the limits were halved as the commits came under pressure.",
+				Type:  leveldb.MetricTypeCounter,
+				Value: float64(atomic.LoadUint64(&l.pressureTotal)),
+			},
+			&leveldb.StorageMetric{
+				Name:  queryLimiterMetricPrefix + "commit_relief_total",
+				Help:  "Number of times the limits were restored as the commits were relieved.",
+				Type:  leveldb.MetricTypeCounter,
+				Value: float64(atomic.LoadUint64(&l.reliefTotal)),
+			},
+		)
+	}
+	for _, d := range definitions {
+		for _, class := range []queryClass{historyQuery, pointQuery, rangeQuery} {
+			c, ok := l.classes[class]
+			if !ok {
+				continue
+			}
+			metrics = append(metrics, &leveldb.StorageMetric{
+				Name:   queryLimiterMetricPrefix + d.name,
+				Help:   d.help,
+				Type:   d.metricType,
+				Labels: map[string]string{"class": class.String()},
+				Value:  d.value(c),
+			})
+		}
+	}
+
+	return metrics
+}
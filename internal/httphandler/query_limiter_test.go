@@ -0,0 +1,361 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyQuery(t *testing.T) {
+	for _, tt := range []struct {
+		method   string
+		path     string
+		expected queryClass
+	}{
+		{http.MethodGet, "/data/db1/key1", pointQuery},
+		{http.MethodGet, "/user/alice", pointQuery},
+		{http.MethodGet, "/db/db1", pointQuery},
+		{http.MethodGet, "/db/index/db1", pointQuery},
+		{http.MethodGet, "/config/tx", pointQuery},
+		{http.MethodGet, "/ledger/block/5", pointQuery},
+		{http.MethodGet, "/ledger/proof/data/db1/key1", pointQuery},
+		{http.MethodGet, "/data/db1", rangeQuery},
+		{http.MethodGet, "/data/db1/count", rangeQuery},
+		{http.MethodGet, "/data/db1/exists", rangeQuery},
+		{http.MethodPost, "/data/db1/jsonquery", rangeQuery},
+		{http.MethodPost, "/data/db1/multiget", rangeQuery},
+		{http.MethodGet, "/provenance/data/history/db1/key1", historyQuery},
+		{http.MethodGet, "/provenance/data/written/alice", historyQuery},
+		{http.MethodGet, "/ledger/usage/alice", historyQuery},
+		{http.MethodGet, "/db/db1/descriptor/history", historyQuery},
+		{http.MethodGet, "/db/db1/digest", historyQuery},
+		{http.MethodPost, "/data/tx", unlimitedQuery},
+		{http.MethodPost, "/user/tx", unlimitedQuery},
+		{http.MethodPost, "/data/db1/subscribe", unlimitedQuery},
+		{http.MethodGet, "/admin/storage/metrics", unlimitedQuery},
+		{http.MethodGet, "/cluster/status", unlimitedQuery},
+		{http.MethodGet, "/session/bootstrap", unlimitedQuery},
+	} {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			require.Equal(t, tt.expected, classifyQuery(httptest.NewRequest(tt.method, tt.path, nil)))
+		})
+	}
+}
+
+func TestQueryLimiter(t *testing.T) {
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	limiter := NewQueryLimiter(&config.QueryConcurrencyConf{
+		HistoryQueries: 2,
+		QueueTimeout:   50 * time.Millisecond,
+		RetryAfter:     1500 * time.Millisecond,
+	}, logger)
+
+	// the history queries block until released, while the other requests are served at once
+	release := make(chan struct{})
+	var inFlight, maxInFlight int32
+	handler := limiter.Limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if classifyQuery(r) == historyQuery {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			<-release
+			atomic.AddInt32(&inFlight, -1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	const exports = 100
+	responses := make(chan *httptest.ResponseRecorder, exports)
+	var wg sync.WaitGroup
+	for i := 0; i < exports; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/provenance/data/history/db1/key1", nil))
+			responses <- rr
+		}()
+	}
+
+	// all but two of the queries are shed once they waited for the queue timeout
+	var shed []*httptest.ResponseRecorder
+	for len(shed) < exports-2 {
+		select {
+		case rr := <-responses:
+			shed = append(shed, rr)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("only %d queries were shed", len(shed))
+		}
+	}
+	for _, rr := range shed {
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		require.Equal(t, "2", rr.Header().Get("Retry-After"))
+		problem := &utils.Problem{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(problem))
+		require.Equal(t, "the server is serving the maximal number of history queries, 2, retry later", problem.Detail)
+	}
+
+	// the transactions and the other classes of queries do not wait for the history queries
+	for _, r := range []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/data/tx", nil),
+		httptest.NewRequest(http.MethodGet, "/data/db1/key1", nil),
+		httptest.NewRequest(http.MethodGet, "/data/db1", nil),
+	} {
+		start := time.Now()
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, r)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Less(t, int64(time.Since(start)), int64(50*time.Millisecond))
+	}
+
+	metricValues := func() map[string]float64 {
+		values := make(map[string]float64)
+		for _, m := range limiter.Metrics() {
+			require.Equal(t, map[string]string{"class": "history"}, m.Labels)
+			values[m.Name] = m.Value
+		}
+		return values
+	}
+	require.Equal(t, map[string]float64{
+		"orion_query_limiter_in_flight":    2,
+		"orion_query_limiter_limit":        2,
+		"orion_query_limiter_queued":       0,
+		"orion_query_limiter_queued_total": exports - 2,
+		"orion_query_limiter_shed_total":   exports - 2,
+	}, metricValues())
+
+	close(release)
+	wg.Wait()
+	close(responses)
+	for rr := range responses {
+		require.Equal(t, http.StatusOK, rr.Code)
+	}
+	require.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+	require.Equal(t, float64(0), metricValues()["orion_query_limiter_in_flight"])
+}
+
+func TestQueryLimiterQueue(t *testing.T) {
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	limiter := NewQueryLimiter(&config.QueryConcurrencyConf{HistoryQueries: 2, QueueTimeout: time.Minute}, logger)
+	release := make(chan struct{})
+	handler := limiter.Limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+
+	// a query waiting in the queue is served once a slot is freed
+	done := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/db/db1/digest", nil))
+			done <- rr.Code
+		}()
+	}
+	queued := func() float64 {
+		for _, m := range limiter.Metrics() {
+			if m.Name == "orion_query_limiter_queued" {
+				return m.Value
+			}
+		}
+		return -1
+	}
+	require.Eventually(t, func() bool { return queued() == 1 }, 5*time.Second, time.Millisecond)
+
+	close(release)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusOK, <-done)
+	}
+	require.Equal(t, float64(0), queued())
+}
+
+func TestQueryLimiterUnlimited(t *testing.T) {
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	limiter := NewQueryLimiter(&config.QueryConcurrencyConf{}, logger)
+	require.Empty(t, limiter.Metrics())
+
+	served := 0
+	handler := limiter.Limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/provenance/data/history/db1/key1", nil))
+	require.Equal(t, 1, served)
+}
+
+func TestQueryLimiterCommitPressure(t *testing.T) {
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	newLimiter := func(queueTimeout time.Duration) (*QueryLimiter, func() map[string]float64) {
+		limiter := NewQueryLimiter(&config.QueryConcurrencyConf{
+			HistoryQueries: 4,
+			QueueTimeout:   queueTimeout,
+			CommitPriority: config.CommitPriorityConf{Enabled: true},
+		}, logger)
+		metricValues := func() map[string]float64 {
+			values := make(map[string]float64)
+			for _, m := range limiter.Metrics() {
+				values[m.Name] = m.Value
+			}
+			return values
+		}
+		return limiter, metricValues
+	}
+
+	// the fake load holds each query until it is released
+	newLoad := func(limiter *QueryLimiter) (query func(), release chan struct{}, done chan int) {
+		release = make(chan struct{})
+		done = make(chan int, 100)
+		handler := limiter.Limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		query = func() {
+			go func() {
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/db/db1/digest", nil))
+				done <- rr.Code
+			}()
+		}
+		return query, release, done
+	}
+
+	t.Run("cycles of pressure and relief", func(t *testing.T) {
+		limiter, metricValues := newLimiter(time.Minute)
+		query, release, done := newLoad(limiter)
+
+		requireMetrics := func(expected map[string]float64) {
+			require.Eventually(t, func() bool {
+				values := metricValues()
+				for name, value := range expected {
+					if values["orion_query_limiter_"+name] != value {
+						return false
+					}
+				}
+				return true
+			}, 5*time.Second, time.Millisecond, "expected %v, actual %v", expected, metricValues())
+		}
+
+		requireMetrics(map[string]float64{"commit_pressure": 0, "limit": 4})
+		for i := 0; i < 4; i++ {
+			query()
+		}
+		requireMetrics(map[string]float64{"in_flight": 4, "queued": 0})
+
+		for cycle := float64(1); cycle <= 3; cycle++ {
+			// the commits come under pressure once their mean latency reaches 100 milliseconds over at least two
+			// commits, and are relieved once it falls below 50 milliseconds
+			stats := queue.NewCommitStats(time.Hour, time.Second, time.Minute)
+			stats.SetPressureThresholds(100*time.Millisecond, 50*time.Millisecond, 2)
+			stats.SetPressureListener(limiter.SetCommitPressure)
+
+			stats.RecordCommit(1, 200*time.Millisecond)
+			require.False(t, stats.UnderPressure())
+			stats.RecordCommit(1, 200*time.Millisecond)
+			require.True(t, stats.UnderPressure())
+
+			// the queries in flight are not interrupted, hence, the limit falls as they complete
+			requireMetrics(map[string]float64{"commit_pressure": 1, "commit_pressure_total": cycle, "commit_relief_total": cycle - 1, "limit": 4})
+			for i := 0; i < 2; i++ {
+				release <- struct{}{}
+				require.Equal(t, http.StatusOK, <-done)
+			}
+			requireMetrics(map[string]float64{"limit": 2, "in_flight": 2})
+
+			// the queries beyond the halved limit wait
+			query()
+			query()
+			requireMetrics(map[string]float64{"in_flight": 2, "queued": 2})
+
+			// the mean latency falls below the pressure latency, but not yet below the relief latency
+			for stats.MeanLatency() >= 60*time.Millisecond {
+				stats.RecordCommit(1, 0)
+			}
+			require.True(t, stats.UnderPressure())
+			requireMetrics(map[string]float64{"commit_pressure": 1, "limit": 2, "in_flight": 2, "queued": 2})
+
+			// once the commits are relieved, the waiting queries are served
+			for stats.UnderPressure() {
+				stats.RecordCommit(1, 0)
+			}
+			requireMetrics(map[string]float64{"commit_pressure": 0, "commit_pressure_total": cycle, "commit_relief_total": cycle, "limit": 4})
+			requireMetrics(map[string]float64{"in_flight": 4, "queued": 0})
+		}
+
+		close(release)
+		for i := 0; i < 4; i++ {
+			require.Equal(t, http.StatusOK, <-done)
+		}
+		requireMetrics(map[string]float64{"in_flight": 0, "shed_total": 0})
+	})
+
+	t.Run("the queries beyond the halved limit are shed", func(t *testing.T) {
+		limiter, metricValues := newLimiter(100 * time.Millisecond)
+		query, release, done := newLoad(limiter)
+		defer close(release)
+
+		limiter.SetCommitPressure(true)
+		limiter.SetCommitPressure(true)
+		require.Eventually(t, func() bool { return metricValues()["orion_query_limiter_limit"] == 2 }, 5*time.Second, time.Millisecond)
+		require.Equal(t, float64(1), metricValues()["orion_query_limiter_commit_pressure_total"])
+
+		query()
+		query()
+		require.Eventually(t, func() bool { return metricValues()["orion_query_limiter_in_flight"] == 2 }, 5*time.Second, time.Millisecond)
+
+		rr := httptest.NewRecorder()
+		limiter.Limit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).
+			ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/db/db1/digest", nil))
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		problem := &utils.Problem{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(problem))
+		require.Equal(t, "the server is serving the maximal number of history queries, 2, retry later", problem.Detail)
+
+		limiter.SetCommitPressure(false)
+		query()
+		require.Eventually(t, func() bool { return metricValues()["orion_query_limiter_in_flight"] == 3 }, 5*time.Second, time.Millisecond)
+		release <- struct{}{}
+		require.Equal(t, http.StatusOK, <-done)
+		require.Equal(t, map[string]float64{
+			"orion_query_limiter_commit_pressure":       0,
+			"orion_query_limiter_commit_pressure_total": 1,
+			"orion_query_limiter_commit_relief_total":   1,
+			"orion_query_limiter_in_flight":             2,
+			"orion_query_limiter_limit":                 4,
+			"orion_query_limiter_queued":                0,
+			"orion_query_limiter_queued_total":          1,
+			"orion_query_limiter_shed_total":            1,
+		}, metricValues())
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		limiter := NewQueryLimiter(&config.QueryConcurrencyConf{HistoryQueries: 4}, logger)
+		limiter.SetCommitPressure(true)
+		for _, m := range limiter.Metrics() {
+			require.NotContains(t, m.Name, "commit")
+			if m.Name == "orion_query_limiter_limit" {
+				require.Equal(t, float64(4), m.Value)
+			}
+		}
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// requestBodyHeadroom is added to the encoded size of the largest transaction to cover the field names of the JSON
+// envelope and the signatures.
+const requestBodyHeadroom = 64 * 1024
+
+// MaxRequestBodySize returns the maximal size of the body of a request, given the maximal size of a transaction. A
+// transaction is posted as JSON, which encodes its byte fields in base64, hence, its body may be larger than the
+// transaction by a third.
+func MaxRequestBodySize(maxTxSizeBytes uint64) int64 {
+	return int64(maxTxSizeBytes/3*4) + requestBodyHeadroom
+}
+
+// LimitRequestBody bounds the body of the requests served by the given handler to maxBytes. A request that declares
+// a larger body is rejected with 413 before its body is read, and the body of any other request fails to be read past
+// maxBytes, so that the memory spent on a request is bounded no matter how large its body is.
+func LimitRequestBody(next http.Handler, maxBytes int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > maxBytes {
+			utils.SendHTTPResponse(w, http.StatusRequestEntityTooLarge, &types.HttpResponseErr{ErrMsg: requestBodyTooLargeMsg(maxBytes)})
+			return
+		}
+
+		if r.Body != nil {
+			r.Body = &limitedRequestBody{
+				ReadCloser: http.MaxBytesReader(w, r.Body, maxBytes),
+				limit:      maxBytes,
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limitedRequestBody tells the failure of http.MaxBytesReader on a body that exceeds the limit apart from other read
+// failures.
+type limitedRequestBody struct {
+	io.ReadCloser
+	limit int64
+	read  int64
+}
+
+func (b *limitedRequestBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	if err != nil && err != io.EOF && b.read >= b.limit {
+		return n, &requestBodyTooLargeError{limit: b.limit}
+	}
+	return n, err
+}
+
+type requestBodyTooLargeError struct {
+	limit int64
+}
+
+func (e *requestBodyTooLargeError) Error() string {
+	return requestBodyTooLargeMsg(e.limit)
+}
+
+func requestBodyTooLargeMsg(limit int64) string {
+	return fmt.Sprintf("the request body exceeds the maximal size of %d bytes", limit)
+}
+
+// readRequestBody reads the body of the request. It responds with 413 when the body exceeds the limit set by
+// LimitRequestBody, and with 400 on any other failure, in which case it returns true.
+func readRequestBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Body == nil {
+		return nil, false
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		if _, ok := err.(*requestBodyTooLargeError); ok {
+			utils.SendHTTPResponse(w, http.StatusRequestEntityTooLarge, &types.HttpResponseErr{ErrMsg: err.Error()})
+			return nil, true
+		}
+		utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return nil, true
+	}
+
+	return body, false
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package httphandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// endlessBody produces size bytes of a JSON string without holding them in memory.
+type endlessBody struct {
+	size int64
+	read int64
+}
+
+func (b *endlessBody) Read(p []byte) (int, error) {
+	if b.read >= b.size {
+		return 0, io.EOF
+	}
+	n := int64(len(p))
+	if remaining := b.size - b.read; n > remaining {
+		n = remaining
+	}
+	for i := int64(0); i < n; i++ {
+		p[i] = 'a'
+	}
+	if b.read == 0 && n > 0 {
+		p[0] = '"'
+	}
+	b.read += n
+	return int(n), nil
+}
+
+func TestLimitRequestBody(t *testing.T) {
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	const maxTxSizeBytes = 1024
+	maxBytes := MaxRequestBodySize(maxTxSizeBytes)
+	require.Equal(t, int64(1364+requestBodyHeadroom), maxBytes)
+
+	newHandler := func() http.Handler {
+		return LimitRequestBody(NewDataRequestHandler(&mocks.DB{}, logger), maxBytes)
+	}
+
+	txHandlers := map[string]http.Handler{
+		constants.PostDataTx:   NewDataRequestHandler(&mocks.DB{}, logger),
+		constants.PostUserTx:   NewUsersRequestHandler(&mocks.DB{}, logger),
+		constants.PostDBTx:     NewDBRequestHandler(&mocks.DB{}, logger),
+		constants.PostConfigTx: NewConfigRequestHandler(&mocks.DB{}, logger),
+	}
+	for path, txHandler := range txHandlers {
+		path, txHandler := path, txHandler
+		t.Run("oversized body of unknown length "+path, func(t *testing.T) {
+			// the body is 2GB long, while the memory allocated to serve the request must stay within a few multiples of
+			// the limit
+			const bodySize = 2 * 1024 * 1024 * 1024
+			const allocationBudget = 8 * 1024 * 1024
+
+			handler := LimitRequestBody(txHandler, maxBytes)
+
+			body := &endlessBody{size: bodySize}
+			req := httptest.NewRequest(http.MethodPost, path, body)
+			require.Equal(t, int64(-1), req.ContentLength)
+			rr := httptest.NewRecorder()
+
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			handler.ServeHTTP(rr, req)
+			runtime.ReadMemStats(&after)
+
+			require.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+			respErr := &types.HttpResponseErr{}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+			require.Equal(t, "the request body exceeds the maximal size of 66900 bytes", respErr.ErrMsg)
+			require.Less(t, body.read, int64(bodySize))
+			require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(allocationBudget))
+		})
+	}
+
+	t.Run("declared oversized body is rejected before it is read", func(t *testing.T) {
+		body := &endlessBody{size: maxBytes + 1}
+		req := httptest.NewRequest(http.MethodPost, constants.PostDataTx, body)
+		req.ContentLength = maxBytes + 1
+		rr := httptest.NewRecorder()
+
+		newHandler().ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+		require.Equal(t, int64(0), body.read)
+	})
+
+	t.Run("body within the limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, constants.PostDataTx, bytes.NewReader([]byte(`{"payload":`+strings.Repeat(" ", int(maxBytes)-12)+`}`)))
+		rr := httptest.NewRecorder()
+
+		newHandler().ServeHTTP(rr, req)
+
+		// the body is read in full, and the request fails on its content
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		respErr := &types.HttpResponseErr{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+		require.NotContains(t, respErr.ErrMsg, "exceeds the maximal size")
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package httphandler
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// sessionRequestHandler handles the queries a client issues when it starts a session
+type sessionRequestHandler struct {
+	db          bcdb.DB
+	sigVerifier *cryptoservice.SignatureVerifier
+	router      *mux.Router
+	logger      *logger.SugarLogger
+}
+
+// NewSessionRequestHandler returns the session queries request handler
+func NewSessionRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handler {
+	handler := &sessionRequestHandler{
+		db:          db,
+		sigVerifier: cryptoservice.NewVerifier(db, logger),
+		router:      mux.NewRouter(),
+		logger:      logger,
+	}
+
+	// HTTP GET "/session/bootstrap" returns the user record, the readable databases, and the limits of the querier
+	handler.router.HandleFunc(constants.GetSessionBootstrap, handler.bootstrapQuery).Methods(http.MethodGet)
+	// HTTP GET "/session/privileges/changes" streams the changes of the privileges of the querier until the client
+	// disconnects
+	handler.router.HandleFunc(constants.GetPrivilegeChanges, handler.subscribePrivilegeChanges).Methods(http.MethodGet)
+	// HTTP POST "/session/token" issues an access token to the querier
+	handler.router.HandleFunc(constants.PostIssueAccessToken, handler.issueAccessToken).Methods(http.MethodPost)
+	// HTTP POST "/session/token/revoke" revokes an access token
+	handler.router.HandleFunc(constants.PostRevokeAccessToken, handler.revokeAccessToken).Methods(http.MethodPost)
+
+	return handler
+}
+
+func (s *sessionRequestHandler) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	s.router.ServeHTTP(response, request)
+}
+
+func (s *sessionRequestHandler) bootstrapQuery(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetSessionBootstrap, s.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.GetSessionBootstrapQuery)
+
+	bootstrap, err := s.db.GetSessionBootstrap(query.UserId)
+	if err != nil {
+		utils.SendHTTPResponse(
+			response,
+			http.StatusInternalServerError,
+			&types.HttpResponseErr{ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error()},
+		)
+		s.logger.Errorf("failed to process request, due to %s", err.Error())
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, bootstrap)
+}
+
+// subscribePrivilegeChanges streams a notification to the querier for every committed block that may change its
+// privileges, one JSON encoded PrivilegeChangesResponseEnvelope per line, upon which the client refreshes its session
+// bootstrap. The session is removed once the client disconnects, and the stream ends if the session is dropped, e.g.,
+// when the user is deleted, or when the client does not keep up with the notifications.
+func (s *sessionRequestHandler) subscribePrivilegeChanges(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.GetPrivilegeChanges, s.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.SubscribePrivilegeChangesQuery)
+
+	flusher, ok := response.(http.Flusher)
+	if !ok {
+		utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{
+			ErrMsg: "the connection does not support streaming",
+		})
+		return
+	}
+
+	notifications, cancel, err := s.db.SubscribePrivilegeChanges(query.UserId)
+	if err != nil {
+		s.sendError(response, request, err)
+		return
+	}
+	defer cancel()
+
+	response.Header().Set("Content-Type", "application/x-ndjson")
+	response.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-request.Context().Done():
+			s.logger.Debugf("the session of [%s] disconnected", query.UserId)
+			return
+
+		case envelope, ok := <-notifications:
+			if !ok {
+				s.logger.Debugf("the session of [%s] was dropped", query.UserId)
+				return
+			}
+			line, err := marshal.DefaultMarshaler().Marshal(envelope)
+			if err != nil {
+				s.logger.Errorf("error while marshaling a notification: %s", err)
+				return
+			}
+			if _, err = response.Write(append(line, '\n')); err != nil {
+				s.logger.Debugf("error while writing a notification to the session of [%s]: %s", query.UserId, err)
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func (s *sessionRequestHandler) issueAccessToken(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.PostIssueAccessToken, s.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.IssueAccessTokenQuery)
+
+	token, err := s.db.IssueAccessToken(query)
+	if err != nil {
+		s.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, token)
+}
+
+func (s *sessionRequestHandler) revokeAccessToken(response http.ResponseWriter, request *http.Request) {
+	payload, respondedErr := extractVerifiedQueryPayload(response, request, constants.PostRevokeAccessToken, s.sigVerifier)
+	if respondedErr {
+		return
+	}
+	query := payload.(*types.RevokeAccessTokenQuery)
+
+	revoked, err := s.db.RevokeAccessToken(query.UserId, query.Token)
+	if err != nil {
+		s.sendError(response, request, err)
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, revoked)
+}
+
+func (s *sessionRequestHandler) sendError(response http.ResponseWriter, request *http.Request, err error) {
+	var status int
+	switch err.(type) {
+	case *ierrors.PermissionErr:
+		status = http.StatusForbidden
+	case *ierrors.BadRequestError:
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusInternalServerError
+		s.logger.Errorf("failed to process request, due to %s", err.Error())
+	}
+
+	utils.SendHTTPResponse(
+		response,
+		status,
+		&types.HttpResponseErr{ErrMsg: "error while processing '" + request.Method + " " + request.URL.String() + "' because " + err.Error()},
+	)
+}
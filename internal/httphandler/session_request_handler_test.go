@@ -0,0 +1,248 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package httphandler
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSessionRequestHandler_GetSessionBootstrap(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice", "bob"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+	_, bobSigner := testutils.LoadTestCrypto(t, cryptoDir, "bob")
+
+	bootstrap := &types.GetSessionBootstrapResponseEnvelope{
+		Response: &types.GetSessionBootstrapResponse{
+			Header: &types.ResponseHeader{NodeId: "node1"},
+			User: &types.User{
+				Id: submittingUserName,
+				Privilege: &types.Privilege{
+					DbPermission: map[string]types.Privilege_Access{
+						"db1": types.Privilege_Read,
+						"db2": types.Privilege_ReadWrite,
+					},
+				},
+			},
+			UserMetadata: &types.Metadata{Version: &types.Version{BlockNum: 2}},
+			Databases: []*types.DatabaseAccess{
+				{Name: "db1", Access: types.Privilege_Read},
+				{Name: "db2", Access: types.Privilege_ReadWrite},
+			},
+			Limits: &types.SessionLimits{
+				ResponseSizeLimitInBytes:    1024,
+				HistoricalQueryCostLimit:    100,
+				MaxBlockSize:                2048,
+				MaxTransactionCountPerBlock: 10,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		requestFactory     func() *http.Request
+		dbMockFactory      func() bcdb.DB
+		expectedStatusCode int
+		expectedErr        string
+	}{
+		{
+			name: "valid: a user retrieves its session bootstrap",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetSessionBootstrap, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetSessionBootstrapQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetSessionBootstrap", submittingUserName).Return(bootstrap, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "invalid: signature verification failure",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetSessionBootstrap, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, bobSigner, &types.GetSessionBootstrapQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				return db
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedErr:        "signature verification failed",
+		},
+		{
+			name: "invalid: error while reading the session bootstrap",
+			requestFactory: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, constants.GetSessionBootstrap, nil)
+				req.Header.Set(constants.UserHeader, submittingUserName)
+				sig := testutils.SignatureFromQuery(t, aliceSigner, &types.GetSessionBootstrapQuery{UserId: submittingUserName})
+				req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+				return req
+			},
+			dbMockFactory: func() bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+				db.On("GetSessionBootstrap", submittingUserName).Return(nil, errors.New("leveldb closed"))
+				return db
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedErr:        "error while processing 'GET /session/bootstrap' because leveldb closed",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("GetSessionBootstrap %s", tt.name), func(t *testing.T) {
+			req := tt.requestFactory()
+			db := tt.dbMockFactory()
+
+			rr := httptest.NewRecorder()
+			handler := NewSessionRequestHandler(db, logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+				return
+			}
+
+			res := &types.GetSessionBootstrapResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), res))
+			require.True(t, proto.Equal(bootstrap, res))
+		})
+	}
+}
+
+func TestSessionRequestHandler_SubscribePrivilegeChanges(t *testing.T) {
+	submittingUserName := "alice"
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+
+	sig := testutils.SignatureFromQuery(t, aliceSigner, &types.SubscribePrivilegeChangesQuery{UserId: submittingUserName})
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, constants.GetPrivilegeChanges, nil)
+		req.Header.Set(constants.UserHeader, submittingUserName)
+		req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(sig))
+		return req
+	}
+
+	notification := func(blockNum uint64, change *types.PrivilegeChange) *types.PrivilegeChangesResponseEnvelope {
+		return &types.PrivilegeChangesResponseEnvelope{
+			Response: &types.PrivilegeChangesResponse{
+				Header:      &types.ResponseHeader{NodeId: "node1"},
+				UserId:      submittingUserName,
+				BlockNumber: blockNum,
+				Changes:     []*types.PrivilegeChange{change},
+			},
+			Signature: []byte{0, 0, 0},
+		}
+	}
+	expected := []*types.PrivilegeChangesResponseEnvelope{
+		notification(5, &types.PrivilegeChange{Kind: types.PrivilegeChange_USER_UPDATED}),
+		notification(7, &types.PrivilegeChange{Kind: types.PrivilegeChange_DB_DELETED, DbName: "db1"}),
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	t.Run("valid: the notifications are streamed until the session is dropped", func(t *testing.T) {
+		notifications := make(chan *types.PrivilegeChangesResponseEnvelope, 2)
+		for _, n := range expected {
+			notifications <- n
+		}
+		close(notifications)
+		var canceled bool
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+		db.On("SubscribePrivilegeChanges", submittingUserName).Return((<-chan *types.PrivilegeChangesResponseEnvelope)(notifications), func() { canceled = true }, nil)
+
+		rr := httptest.NewRecorder()
+		NewSessionRequestHandler(db, logger).ServeHTTP(rr, newRequest())
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		lines := bytes.Split(bytes.TrimSpace(rr.Body.Bytes()), []byte("\n"))
+		require.Len(t, lines, 2)
+		for i, e := range expected {
+			res := &types.PrivilegeChangesResponseEnvelope{}
+			require.NoError(t, protojson.Unmarshal(lines[i], res))
+			require.True(t, proto.Equal(e, res))
+		}
+		require.True(t, canceled)
+	})
+
+	t.Run("valid: the session is closed once the client disconnects", func(t *testing.T) {
+		canceled := make(chan struct{})
+
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+		db.On("SubscribePrivilegeChanges", submittingUserName).Return(make(<-chan *types.PrivilegeChangesResponseEnvelope), func() { close(canceled) }, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req := newRequest().WithContext(ctx)
+		rr := httptest.NewRecorder()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			NewSessionRequestHandler(db, logger).ServeHTTP(rr, req)
+		}()
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the stream did not end once the client disconnected")
+		}
+		select {
+		case <-canceled:
+		default:
+			t.Fatal("the session was not closed")
+		}
+	})
+
+	t.Run("invalid: the session cannot be opened", func(t *testing.T) {
+		db := &mocks.DB{}
+		db.On("GetCertificate", submittingUserName).Return(aliceCert, nil)
+		db.On("SubscribePrivilegeChanges", submittingUserName).Return(nil, nil, errors.New("the user [alice] does not exist"))
+
+		rr := httptest.NewRecorder()
+		NewSessionRequestHandler(db, logger).ServeHTTP(rr, newRequest())
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		respErr := &types.HttpResponseErr{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+		require.Equal(t, "error while processing 'GET /session/privileges/changes' because the user [alice] does not exist", respErr.ErrMsg)
+	})
+}
@@ -4,6 +4,8 @@
 package httphandler
 
 import (
+	"fmt"
+	"math"
 	"net/http"
 	"time"
 
@@ -27,8 +29,16 @@ func (t *txHandler) handleTransaction(w http.ResponseWriter, request *http.Reque
 			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
 		case *internalerror.DuplicateTxIDError:
 			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		case *internalerror.ServerRestrictionError:
+			utils.SendHTTPResponse(w, http.StatusServiceUnavailable, &types.HttpResponseErr{ErrMsg: err.Error()})
+		case *internalerror.OverloadedError:
+			utils.SendHTTPProblem(w, overloadedProblem(err.(*internalerror.OverloadedError)))
+		case *internalerror.TxExpiredError:
+			utils.SendHTTPResponse(w, http.StatusGone, &types.HttpResponseErr{ErrMsg: err.Error()})
+		case *internalerror.TxRejectedError:
+			utils.SendHTTPResponse(w, http.StatusUnprocessableEntity, &types.HttpResponseErr{ErrMsg: err.Error()})
 		case *internalerror.TimeoutErr:
-			utils.SendHTTPResponse(w, http.StatusAccepted, &types.HttpResponseErr{ErrMsg: "Transaction processing timeout"})
+			utils.SendHTTPResponse(w, http.StatusAccepted, &types.HttpResponseErr{ErrMsg: timeoutErrMsg(err.(*internalerror.TimeoutErr))})
 		case *internalerror.NotLeaderError:
 			leaderErr := err.(*internalerror.NotLeaderError)
 			if leaderErr.GetLeaderID() == 0 {
@@ -43,3 +53,35 @@ func (t *txHandler) handleTransaction(w http.ResponseWriter, request *http.Reque
 	}
 	utils.SendHTTPResponse(w, http.StatusOK, resp)
 }
+
+// overloadedProblem asks the client to wait for the interval derived by the server before it resubmits the
+// transaction, plus a random jitter of up to half of the interval, so that the clients rejected together do not all
+// retry together.
+func overloadedProblem(err *internalerror.OverloadedError) *utils.Problem {
+	retryAfter := int64(math.Ceil(err.RetryAfter.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	return &utils.Problem{
+		Type:        "about:blank",
+		Title:       http.StatusText(http.StatusServiceUnavailable),
+		Status:      http.StatusServiceUnavailable,
+		Detail:      err.Error(),
+		RetryAfter:  retryAfter,
+		RetryJitter: (retryAfter + 1) / 2,
+	}
+}
+
+// timeoutErrMsg reports the progress the transaction made before the wait timed out, if known, so that the client
+// can poll for the receipt instead of resubmitting the transaction.
+func timeoutErrMsg(err *internalerror.TimeoutErr) string {
+	switch {
+	case err.Stage == "":
+		return "Transaction processing timeout"
+	case err.BlockNumber == 0:
+		return fmt.Sprintf("Transaction processing timeout, last observed stage: %s", err.Stage)
+	default:
+		return fmt.Sprintf("Transaction processing timeout, last observed stage: %s, block number: %d", err.Stage, err.BlockNumber)
+	}
+}
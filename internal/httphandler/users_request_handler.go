@@ -4,7 +4,6 @@ package httphandler
 
 import (
 	"fmt"
-	"io/ioutil"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -44,6 +43,8 @@ func NewUsersRequestHandler(db bcdb.DB, logger *logger.SugarLogger) http.Handler
 	handler.router.HandleFunc(constants.GetUser, handler.getUser).Methods(http.MethodGet)
 	// HTTP POST "user/tx" submit user creation transaction
 	handler.router.HandleFunc(constants.PostUserTx, handler.userTransaction).Methods(http.MethodPost)
+	// HTTP POST "user/import" submit the user administration transactions of a bulk user import
+	handler.router.HandleFunc(constants.PostUserImport, handler.importUsers).Methods(http.MethodPost)
 
 	return handler
 }
@@ -89,9 +90,8 @@ func (u *usersRequestHandler) userTransaction(response http.ResponseWriter, requ
 		return
 	}
 
-	requestBytes, err := ioutil.ReadAll(request.Body)
-	if err != nil {
-		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+	requestBytes, done := readRequestBody(response, request)
+	if done {
 		return
 	}
 
@@ -129,3 +129,64 @@ func (u *usersRequestHandler) userTransaction(response http.ResponseWriter, requ
 
 	u.txHandler.handleTransaction(response, request, txEnv, timeout)
 }
+
+func (u *usersRequestHandler) importUsers(response http.ResponseWriter, request *http.Request) {
+	timeout, err := validateAndParseTxPostHeader(&request.Header)
+	if err != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	requestBytes, done := readRequestBody(response, request)
+	if done {
+		return
+	}
+
+	importEnv := &types.UserImportRequestEnvelope{}
+	if err := protojson.Unmarshal(requestBytes, importEnv); err != nil {
+		utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	switch {
+	case importEnv.Payload == nil:
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing import request envelope payload (%T)", importEnv.Payload)})
+		return
+	case importEnv.Payload.UserId == "":
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing UserID in import request envelope payload (%T)", importEnv.Payload)})
+		return
+	case len(importEnv.Signature) == 0:
+		utils.SendHTTPResponse(response, http.StatusBadRequest,
+			&types.HttpResponseErr{ErrMsg: fmt.Sprintf("missing Signature in import request envelope payload (%T)", importEnv.Payload)})
+		return
+	}
+
+	if err, code := VerifyRequestSignature(u.sigVerifier, importEnv.Payload.UserId, importEnv.Signature, importEnv.Payload); err != nil {
+		utils.SendHTTPResponse(response, code, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return
+	}
+
+	resp, err := u.db.ImportUsers(importEnv, timeout)
+	if err != nil {
+		switch err.(type) {
+		case *errors.PermissionErr:
+			utils.SendHTTPResponse(response, http.StatusForbidden, &types.HttpResponseErr{ErrMsg: err.Error()})
+		case *errors.BadRequestError:
+			utils.SendHTTPResponse(response, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		case *errors.NotLeaderError:
+			leaderErr := err.(*errors.NotLeaderError)
+			if leaderErr.GetLeaderID() == 0 {
+				utils.SendHTTPResponse(response, http.StatusServiceUnavailable, &types.HttpResponseErr{ErrMsg: "Cluster leader unavailable"})
+			} else {
+				utils.SendHTTPRedirectServer(response, request, leaderErr.GetLeaderHostPort())
+			}
+		default:
+			utils.SendHTTPResponse(response, http.StatusInternalServerError, &types.HttpResponseErr{ErrMsg: err.Error()})
+		}
+		return
+	}
+
+	utils.SendHTTPResponse(response, http.StatusOK, resp)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestUsersRequestHandler_GetUser(t *testing.T) {
@@ -549,3 +550,134 @@ func TestUsersRequestHandler_SubmitUserTx(t *testing.T) {
 		})
 	}
 }
+
+func TestUsersRequestHandler_ImportUsers(t *testing.T) {
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"alice", "user1"})
+	aliceCert, aliceSigner := testutils.LoadTestCrypto(t, cryptoDir, "alice")
+	user1Cert, _ := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+
+	users := []*types.User{{Id: "user1", Certificate: user1Cert.Raw}}
+	importRequest, err := types.NewUserImportRequest("alice", "import1", users)
+	require.NoError(t, err)
+	importEnv := &types.UserImportRequestEnvelope{
+		Payload:   importRequest,
+		Signature: testutils.SignatureFromTx(t, aliceSigner, importRequest),
+		Users:     users,
+	}
+	importResp := &types.UserImportResponseEnvelope{
+		Response: &types.UserImportResponse{
+			Header: &types.ResponseHeader{NodeId: "testNodeID"},
+			TxIds:  []string{"import1-0"},
+		},
+		Signature: []byte{0, 0, 0},
+	}
+
+	testCases := []struct {
+		name                    string
+		importEnv               *types.UserImportRequestEnvelope
+		createMockAndInstrument func(t *testing.T) bcdb.DB
+		expectedCode            int
+		expectedErr             string
+	}{
+		{
+			name:      "valid import",
+			importEnv: importEnv,
+			createMockAndInstrument: func(t *testing.T) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("ImportUsers", mock.Anything, time.Second).Run(func(args mock.Arguments) {
+					require.True(t, proto.Equal(importEnv, args[0].(*types.UserImportRequestEnvelope)))
+				}).Return(importResp, nil)
+				return db
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "missing signature",
+			importEnv: &types.UserImportRequestEnvelope{
+				Payload: importRequest,
+				Users:   users,
+			},
+			createMockAndInstrument: func(t *testing.T) bcdb.DB {
+				return &mocks.DB{}
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "missing Signature in import request envelope payload (*types.UserImportRequest)",
+		},
+		{
+			name: "wrong signature",
+			importEnv: &types.UserImportRequestEnvelope{
+				Payload:   importRequest,
+				Signature: []byte{0, 0, 0},
+				Users:     users,
+			},
+			createMockAndInstrument: func(t *testing.T) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				return db
+			},
+			expectedCode: http.StatusUnauthorized,
+			expectedErr:  "signature verification failed",
+		},
+		{
+			name:      "no privilege",
+			importEnv: importEnv,
+			createMockAndInstrument: func(t *testing.T) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("ImportUsers", mock.Anything, time.Second).
+					Return(nil, &interrors.PermissionErr{ErrMsg: "the user [alice] has no privilege to perform user administrative operations"})
+				return db
+			},
+			expectedCode: http.StatusForbidden,
+			expectedErr:  "the user [alice] has no privilege to perform user administrative operations",
+		},
+		{
+			name:      "bad request",
+			importEnv: importEnv,
+			createMockAndInstrument: func(t *testing.T) bcdb.DB {
+				db := &mocks.DB{}
+				db.On("GetCertificate", "alice").Return(aliceCert, nil)
+				db.On("ImportUsers", mock.Anything, time.Second).
+					Return(nil, &interrors.BadRequestError{ErrMsg: "the import request holds 1 users but 2 digests"})
+				return db
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "the import request holds 1 users but 2 digests",
+		},
+	}
+
+	logger, err := createLogger("debug")
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			requestBytes, err := protojson.Marshal(tt.importEnv)
+			require.NoError(t, err)
+
+			reqUrl := &url.URL{
+				Scheme: "http",
+				Host:   "server1.example.com:6091",
+				Path:   constants.PostUserImport,
+			}
+			req, err := http.NewRequest(http.MethodPost, reqUrl.String(), bytes.NewReader(requestBytes))
+			require.NoError(t, err)
+			req.Header.Set(constants.TimeoutHeader, "1s")
+
+			rr := httptest.NewRecorder()
+			handler := NewUsersRequestHandler(tt.createMockAndInstrument(t), logger)
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedCode, rr.Code)
+			if tt.expectedCode == http.StatusOK {
+				resp := &types.UserImportResponseEnvelope{}
+				require.NoError(t, protojson.Unmarshal(rr.Body.Bytes(), resp))
+				require.True(t, proto.Equal(importResp, resp))
+			} else {
+				respErr := &types.HttpResponseErr{}
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(respErr))
+				require.Equal(t, tt.expectedErr, respErr.ErrMsg)
+			}
+		})
+	}
+}
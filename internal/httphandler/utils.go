@@ -4,14 +4,20 @@
 package httphandler
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
-	"io"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/hyperledger-labs/orion-server/internal/bcdb"
 	"github.com/hyperledger-labs/orion-server/internal/utils"
 	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
@@ -20,9 +26,20 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// extractVerifiedQueryPayload returns the query of the request, which is authenticated either by the signature of the
+// querier or by the claims of an access token, verified by AuthenticateAccessTokens, which must cover the query.
 func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryType string, signVerifier *cryptoservice.SignatureVerifier) (interface{}, bool) {
-	querierUserID, signature, err := validateAndParseHeader(&r.Header)
-	if err != nil {
+	var querierUserID string
+	var signature []byte
+	var err error
+	claims := accessTokenClaims(r)
+	if claims != nil {
+		var status int
+		if querierUserID, status, err = authorizeAccessTokenQuery(claims, &r.Header, queryType); err != nil {
+			utils.SendHTTPResponse(w, status, err)
+			return nil, true
+		}
+	} else if querierUserID, signature, err = validateAndParseHeader(&r.Header); err != nil {
 		utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
 		return nil, true
 	}
@@ -32,10 +49,17 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 
 	switch queryType {
 	case constants.GetData:
+		asOf, err := utils.GetAsOf(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
 		payload = &types.GetDataQuery{
 			UserId: querierUserID,
 			DbName: params["dbname"],
 			Key:    params["key"],
+			AsOf:   asOf,
 		}
 	case constants.GetDataRange:
 		limit, err := strconv.ParseUint(params["limit"], 10, 64)
@@ -44,12 +68,50 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 			return nil, true
 		}
 
+		asOf, err := utils.GetAsOf(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
+		analytical, err := utils.GetAnalytical(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
 		payload = &types.GetDataRangeQuery{
-			UserId:   querierUserID,
-			DbName:   params["dbname"],
-			StartKey: params["startkey"][1 : len(params["startkey"])-1],
-			EndKey:   params["endkey"][1 : len(params["endkey"])-1],
-			Limit:    limit,
+			UserId:     querierUserID,
+			DbName:     params["dbname"],
+			StartKey:   params["startkey"][1 : len(params["startkey"])-1],
+			EndKey:     params["endkey"][1 : len(params["endkey"])-1],
+			Limit:      limit,
+			AsOf:       asOf,
+			Analytical: analytical,
+			Cursor:     r.URL.Query().Get("cursor"),
+		}
+	case constants.GetDataCount, constants.GetDataExists:
+		var quotedKeys [3]string
+		for i, name := range []string{"startkey", "endkey", "prefix"} {
+			v, ok := params[name]
+			if !ok {
+				continue
+			}
+			if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "the " + name + " must be enclosed in double quotes"})
+				return nil, true
+			}
+			quotedKeys[i] = v[1 : len(v)-1]
+		}
+
+		payload = &types.GetDataCountQuery{
+			UserId:     querierUserID,
+			DbName:     params["dbname"],
+			StartKey:   quotedKeys[0],
+			EndKey:     quotedKeys[1],
+			Prefix:     quotedKeys[2],
+			Query:      params["query"],
+			ExistsOnly: queryType == constants.GetDataExists,
 		}
 	case constants.GetUser:
 		payload = &types.GetUserQuery{
@@ -66,10 +128,65 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 			UserId: querierUserID,
 			DbName: params["dbname"],
 		}
+	case constants.GetDBDescriptor:
+		asOf, err := utils.GetAsOf(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
+		payload = &types.GetDBDescriptorQuery{
+			UserId: querierUserID,
+			DbName: params["dbname"],
+			AsOf:   asOf,
+		}
+	case constants.GetDBDescriptorHistory:
+		payload = &types.GetDBDescriptorHistoryQuery{
+			UserId: querierUserID,
+			DbName: params["dbname"],
+		}
+	case constants.GetDBDigest:
+		var blockNum uint64
+		if _, ok := params["block"]; ok {
+			var err *types.HttpResponseErr
+			if blockNum, err = utils.GetUintParam("block", params); err != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+				return nil, true
+			}
+		}
+
+		payload = &types.GetDBDigestQuery{
+			UserId:      querierUserID,
+			DbName:      params["dbname"],
+			BlockNumber: blockNum,
+		}
+	case constants.GetDBExport:
+		provenance := false
+		if value, ok := params["provenance"]; ok {
+			provenance, err = strconv.ParseBool(value)
+			if err != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+				return nil, true
+			}
+		}
+
+		payload = &types.GetDBExportQuery{
+			UserId:     querierUserID,
+			DbName:     params["dbname"],
+			Provenance: provenance,
+		}
 	case constants.GetConfig:
 		payload = &types.GetConfigQuery{
 			UserId: querierUserID,
 		}
+	case constants.GetConfigLimits:
+		payload = &types.GetConfigLimitsQuery{
+			UserId: querierUserID,
+		}
+	case constants.GetStorageStats, constants.GetStorageMetrics, constants.GetStorageHandles, constants.GetBlockStoreStats:
+		payload = &types.GetStorageStatsQuery{
+			UserId: querierUserID,
+		}
 	case constants.GetNodeConfig:
 		payload = &types.GetNodeConfigQuery{
 			UserId: querierUserID,
@@ -94,6 +211,18 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 			UserId:         querierUserID,
 			NoCertificates: noCertificates,
 		}
+	case constants.GetClusterHeartbeats:
+		payload = &types.GetClusterHeartbeatsQuery{
+			UserId: querierUserID,
+		}
+	case constants.GetSessionBootstrap:
+		payload = &types.GetSessionBootstrapQuery{
+			UserId: querierUserID,
+		}
+	case constants.GetPrivilegeChanges:
+		payload = &types.SubscribePrivilegeChangesQuery{
+			UserId: querierUserID,
+		}
 	case constants.GetBlockHeader:
 		blockNum, err := utils.GetBlockNum(params)
 		if err != nil {
@@ -171,6 +300,60 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 			UserId: querierUserID,
 			TxId:   params["txId"],
 		}
+	case constants.GetDroppedTx:
+		payload = &types.GetDroppedTxQuery{
+			UserId: querierUserID,
+			TxId:   params["txId"],
+		}
+	case constants.GetLedgerRollups:
+		payload = &types.GetLedgerRollupsQuery{
+			UserId:    querierUserID,
+			StartDate: params["start"],
+			EndDate:   params["end"],
+		}
+	case constants.GetLedgerUsage:
+		startBlockNum, endBlockNum, err := utils.GetStartAndEndBlockNum(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
+		payload = &types.GetLedgerUsageQuery{
+			UserId:           querierUserID,
+			TargetUserId:     params["userId"],
+			StartBlockNumber: startBlockNum,
+			EndBlockNumber:   endBlockNum,
+		}
+	case constants.GetKeyBlocks:
+		startBlockNum, endBlockNum, err := utils.GetStartAndEndBlockNum(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
+		payload = &types.GetKeyBlocksQuery{
+			UserId:           querierUserID,
+			DbName:           params["dbname"],
+			Key:              params["key"],
+			StartBlockNumber: startBlockNum,
+			EndBlockNumber:   endBlockNum,
+		}
+	case constants.GetBlockComposition:
+		blockNum, err := utils.GetBlockNum(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
+		payload = &types.GetBlockCompositionQuery{
+			UserId:      querierUserID,
+			BlockNumber: blockNum,
+		}
+	case constants.GetTxWriteSetDigest:
+		payload = &types.GetTxWriteSetDigestQuery{
+			UserId: querierUserID,
+			TxId:   params["txId"],
+		}
 	case constants.GetHistoricalData:
 		version, err := utils.GetVersion(params)
 		if err != nil {
@@ -188,6 +371,12 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 
 		_, isMostRecentSet := params["mostrecent"]
 
+		limit, pageCursor, respErr := pageParams(r)
+		if respErr != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, respErr)
+			return nil, true
+		}
+
 		payload = &types.GetHistoricalDataQuery{
 			UserId:      querierUserID,
 			DbName:      params["dbname"],
@@ -196,6 +385,21 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 			Direction:   params["direction"],
 			OnlyDeletes: isOnlyDeletesSet,
 			MostRecent:  isMostRecentSet,
+			Limit:       limit,
+			Cursor:      pageCursor,
+		}
+	case constants.GetDataByVersion:
+		version, err := utils.GetVersion(params)
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+			return nil, true
+		}
+
+		payload = &types.GetDataByVersionQuery{
+			UserId:  querierUserID,
+			DbName:  params["dbname"],
+			Key:     params["key"],
+			Version: version,
 		}
 	case constants.GetDataReaders:
 		payload = &types.GetDataReadersQuery{
@@ -255,9 +459,8 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 			return nil, true
 		}
 
-		b, err := io.ReadAll(r.Body)
-		if err != nil {
-			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
+		b, done := readRequestBody(w, r)
+		if done {
 			return nil, true
 		}
 
@@ -266,11 +469,254 @@ func extractVerifiedQueryPayload(w http.ResponseWriter, r *http.Request, queryTy
 			utils.SendHTTPResponse(w, http.StatusBadRequest, err)
 			return nil, true
 		}
+		limit, pageCursor, respErr := pageParams(r)
+		if respErr != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, respErr)
+			return nil, true
+		}
 		payload = &types.DataJSONQuery{
 			UserId: querierUserID,
 			DbName: params["dbname"],
 			Query:  q,
+			Limit:  limit,
+			Cursor: pageCursor,
+		}
+	case constants.PostSubscribeKeys:
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.SubscribeKeysRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.SubscribeKeysQuery{
+			UserId:   querierUserID,
+			DbName:   params["dbname"],
+			Keys:     req.Keys,
+			Prefixes: req.Prefixes,
+		}
+	case constants.PostDataMultiGet:
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.GetDataMultiRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.GetDataMultiQuery{
+			UserId: querierUserID,
+			DbName: params["dbname"],
+			Keys:   req.Keys,
+		}
+	case constants.PostTraceValidation:
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.TraceValidationRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.TraceValidationQuery{
+			UserId:      querierUserID,
+			BlockNumber: req.BlockNum,
+		}
+	case constants.GetTrustedCheckpoints:
+		var interval uint64
+		if _, ok := params["interval"]; ok {
+			var respErr *types.HttpResponseErr
+			if interval, respErr = utils.GetUintParam("interval", params); respErr != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, respErr)
+				return nil, true
+			}
+		}
+		payload = &types.GetTrustedCheckpointsQuery{
+			UserId:   querierUserID,
+			Interval: interval,
+		}
+	case constants.GetDroppedTxs:
+		var since, limit uint64
+		if _, ok := params["since"]; ok {
+			var respErr *types.HttpResponseErr
+			if since, respErr = utils.GetUintParam("since", params); respErr != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, respErr)
+				return nil, true
+			}
+			if limit, respErr = utils.GetUintParam("limit", params); respErr != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, respErr)
+				return nil, true
+			}
+		}
+		payload = &types.GetDroppedTxsQuery{
+			UserId: querierUserID,
+			Since:  int64(since),
+			Limit:  limit,
+			Cursor: r.URL.Query().Get("cursor"),
+		}
+	case constants.GetAdminAudit:
+		query := &types.GetAdminAuditRecordsQuery{UserId: querierUserID}
+		if _, ok := params["hash"]; ok {
+			hash, err := hex.DecodeString(params["hash"])
+			if err != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "hash is not a valid hex string: " + err.Error()})
+				return nil, true
+			}
+			query.Hash = hash
+		}
+		if _, ok := params["since"]; ok {
+			var respErr *types.HttpResponseErr
+			if query.Since, respErr = utils.GetUintParam("since", params); respErr != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, respErr)
+				return nil, true
+			}
+			if query.Limit, respErr = utils.GetUintParam("limit", params); respErr != nil {
+				utils.SendHTTPResponse(w, http.StatusBadRequest, respErr)
+				return nil, true
+			}
+		}
+		query.Cursor = r.URL.Query().Get("cursor")
+		payload = query
+	case constants.LogLevels:
+		if r.Method != http.MethodPut {
+			payload = &types.GetLogLevelsQuery{
+				UserId: querierUserID,
+			}
+			break
+		}
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.SetLogLevelsRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.SetLogLevelsQuery{
+			UserId: querierUserID,
+			Levels: req.Levels,
+		}
+	case constants.StateMigration:
+		if r.Method != http.MethodPost {
+			payload = &types.GetStateMigrationQuery{
+				UserId: querierUserID,
+			}
+			break
+		}
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.StateMigrationRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.StateMigrationQuery{
+			UserId: querierUserID,
+			Abort:  req.Abort,
+		}
+	case constants.StateScrub:
+		if r.Method != http.MethodPost {
+			payload = &types.GetStateScrubQuery{
+				UserId: querierUserID,
+			}
+			break
+		}
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.StateScrubRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.StateScrubQuery{
+			UserId: querierUserID,
+			DbName: req.DBName,
+		}
+	case constants.PostAcceptPeerHeader:
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.AcceptPeerHeaderRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.AcceptPeerHeaderQuery{
+			UserId:      querierUserID,
+			BlockNumber: req.BlockNum,
+		}
+	case constants.PostResyncDB:
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.ResyncDBRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.ResyncDBQuery{
+			UserId: querierUserID,
+			DbName: req.DBName,
+		}
+	case constants.PostIssueAccessToken:
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.IssueAccessTokenRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.IssueAccessTokenQuery{
+			UserId:          querierUserID,
+			DbNames:         req.DBNames,
+			Endpoints:       req.Endpoints,
+			LifetimeSeconds: req.LifetimeSeconds,
+		}
+	case constants.PostRevokeAccessToken:
+		if r.Body == nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "request is empty"})
+			return nil, true
+		}
+
+		req := &types.RevokeAccessTokenRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: "error while decoding the request: " + err.Error()})
+			return nil, true
+		}
+		payload = &types.RevokeAccessTokenQuery{
+			UserId: querierUserID,
+			Token:  req.Token,
+		}
+	}
+
+	if claims != nil {
+		if status, err := authorizeAccessTokenDB(claims, payload); err != nil {
+			utils.SendHTTPResponse(w, status, err)
+			return nil, true
 		}
+		return payload, false
 	}
 
 	err, status := VerifyRequestSignature(signVerifier, querierUserID, signature, payload)
@@ -339,3 +785,126 @@ func validateAndParseTxPostHeader(h *http.Header) (time.Duration, error) {
 	}
 	return timeout, nil
 }
+
+// parseReadHintsHeader parses the ReadHintsHeader of a data transaction submission. The hints are advisory, hence, a
+// malformed entry is skipped rather than failing the submission.
+func parseReadHintsHeader(h *http.Header) []*types.ReadHint {
+	value := h.Get(constants.ReadHintsHeader)
+	if value == "" {
+		return nil
+	}
+
+	var hints []*types.ReadHint
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), "/")
+		if len(parts) != 2 {
+			continue
+		}
+		dbName, err := url.PathUnescape(parts[0])
+		if err != nil || dbName == "" {
+			continue
+		}
+		key, err := url.PathUnescape(parts[1])
+		if err != nil {
+			continue
+		}
+		hints = append(hints, &types.ReadHint{DBName: dbName, Key: key})
+	}
+	return hints
+}
+
+const (
+	// defaultMinHeightTimeout is the maximal time a height-pinned data query waits for the node to reach the
+	// requested height
+	defaultMinHeightTimeout = 30 * time.Second
+	minHeightPollInterval   = 10 * time.Millisecond
+)
+
+// awaitMinHeight serves the height pinning of a data query. When the request carries a MinHeightHeader, it waits
+// until the state database reaches the requested height, unless the client sets the ReadCommittedHeader and accepts
+// a potentially stale read of the current committed state. In both cases, the response is labeled with the served
+// height and the staleness, which is the requested height minus the served height. The served height is the height
+// of the state at the time the query was admitted; a block committed concurrently may be observed too.
+func awaitMinHeight(w http.ResponseWriter, r *http.Request, db bcdb.DB, timeout time.Duration) bool {
+	minHeight, readCommitted, err := validateAndParseMinHeightHeader(&r.Header)
+	if err != nil {
+		utils.SendHTTPResponse(w, http.StatusBadRequest, &types.HttpResponseErr{ErrMsg: err.Error()})
+		return true
+	}
+	if minHeight == 0 {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	var height uint64
+	for {
+		height, err = db.Height()
+		if err != nil {
+			utils.SendHTTPResponse(w, http.StatusInternalServerError, &types.HttpResponseErr{
+				ErrMsg: "error while fetching the height of the state database: " + err.Error(),
+			})
+			return true
+		}
+		if height >= minHeight || readCommitted {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			utils.SendHTTPResponse(w, http.StatusServiceUnavailable, &types.HttpResponseErr{
+				ErrMsg: fmt.Sprintf("the height of the committed state [%d] did not reach the requested height [%d] within %s; "+
+					"set %s to read the committed state without waiting", height, minHeight, timeout, constants.ReadCommittedHeader),
+			})
+			return true
+		case <-time.After(minHeightPollInterval):
+		}
+	}
+
+	var staleness uint64
+	if minHeight > height {
+		staleness = minHeight - height
+	}
+	w.Header().Set(constants.ServedHeightHeader, strconv.FormatUint(height, 10))
+	w.Header().Set(constants.StalenessHeader, strconv.FormatUint(staleness, 10))
+	return false
+}
+
+func validateAndParseMinHeightHeader(h *http.Header) (uint64, bool, error) {
+	minHeightStr := h.Get(constants.MinHeightHeader)
+	if len(minHeightStr) == 0 {
+		return 0, false, nil
+	}
+
+	minHeight, err := strconv.ParseUint(minHeightStr, 10, 64)
+	if err != nil {
+		return 0, false, errors.New("min height is not a valid block number " + strconv.Quote(minHeightStr))
+	}
+
+	readCommittedStr := h.Get(constants.ReadCommittedHeader)
+	if len(readCommittedStr) == 0 {
+		return minHeight, false, nil
+	}
+
+	readCommitted, err := strconv.ParseBool(readCommittedStr)
+	if err != nil {
+		return 0, false, errors.New("read committed flag is not a boolean " + strconv.Quote(readCommittedStr))
+	}
+	return minHeight, readCommitted, nil
+}
+
+// pageParams returns the optional limit and pagination cursor of a paged query, given as the "limit" and "cursor"
+// URL query parameters
+func pageParams(r *http.Request) (uint64, string, *types.HttpResponseErr) {
+	values := r.URL.Query()
+
+	var limit uint64
+	if v := values.Get("limit"); v != "" {
+		var err error
+		if limit, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return 0, "", &types.HttpResponseErr{ErrMsg: "the limit must be a non-negative integer: " + err.Error()}
+		}
+	}
+	return limit, values.Get("cursor"), nil
+}
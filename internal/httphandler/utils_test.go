@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger-labs/orion-server/internal/bcdb/mocks"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
@@ -86,3 +87,24 @@ func init() {
 		},
 	}
 }
+
+func TestParseReadHintsHeader(t *testing.T) {
+	hints := []*types.ReadHint{
+		{DBName: "db1", Key: "key1"},
+		{DBName: "db1", Key: "a/b,c d%"},
+		{DBName: "db2", Key: ""},
+	}
+
+	h := http.Header{}
+	require.Nil(t, parseReadHintsHeader(&h))
+
+	h.Set(constants.ReadHintsHeader, constants.ReadHintsHeaderValue(hints))
+	require.Equal(t, hints, parseReadHintsHeader(&h))
+
+	// the malformed entries are skipped
+	h.Set(constants.ReadHintsHeader, "db1/key1,,no-separator,/key2,db1/a/b,db1/%zz,db2/")
+	require.Equal(t, []*types.ReadHint{
+		{DBName: "db1", Key: "key1"},
+		{DBName: "db2", Key: ""},
+	}, parseReadHintsHeader(&h))
+}
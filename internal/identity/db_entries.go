@@ -3,6 +3,7 @@
 package identity
 
 import (
+	"encoding/binary"
 	"strings"
 
 	"github.com/hyperledger-labs/orion-server/internal/provenance"
@@ -17,8 +18,25 @@ var (
 	UserNamespace = []byte{0}
 	// NodeNamespace holds the node identity information in the config db
 	NodeNamespace = []byte{0}
+	// SequenceNamespace holds the last committed transaction sequence number of each user in the user db
+	SequenceNamespace = []byte{1}
 )
 
+// ConstructDBEntryForUserSequence constructs the database entry that records the sequence number of the last
+// committed transaction of the given user
+func ConstructDBEntryForUserSequence(userID string, sequence uint64, version *types.Version) *worldstate.KVWithMetadata {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, sequence)
+
+	return &worldstate.KVWithMetadata{
+		Key:   string(SequenceNamespace) + userID,
+		Value: value,
+		Metadata: &types.Metadata{
+			Version: version,
+		},
+	}
+}
+
 // ConstructDBEntriesForUserAdminTx constructs database entries for the transaction that manipulates
 // user information
 func ConstructDBEntriesForUserAdminTx(tx *types.UserAdministrationTx, version *types.Version) (*worldstate.DBUpdates, error) {
@@ -26,7 +44,7 @@ func ConstructDBEntriesForUserAdminTx(tx *types.UserAdministrationTx, version *t
 	var userDeletes []string
 
 	for _, w := range tx.UserWrites {
-		userSerialized, err := proto.Marshal(w.User)
+		userSerialized, err := worldstate.MarshalValue(w.User)
 		if err != nil {
 			return nil, errors.Wrap(err, "error while marshaling user")
 		}
@@ -77,7 +95,7 @@ func ConstructProvenanceEntriesForUserAdminTx(
 	}
 
 	for _, write := range tx.UserWrites {
-		userSerialized, err := proto.Marshal(write.User)
+		userSerialized, err := worldstate.MarshalValue(write.User)
 		if err != nil {
 			return nil, errors.Wrap(err, "error while marshaling user")
 		}
@@ -139,7 +157,12 @@ func ConstructDBEntriesForClusterAdmins(oldAdmins, newAdmins []*types.Admin, ver
 		deletes = append(deletes, string(UserNamespace)+oldAdm.Id)
 	}
 
-	for _, admin := range newAdms {
+	// the admins are visited in the order of the configuration, rather than the order of the map, so that the
+	// entries are the same on every node
+	for _, admin := range newAdmins {
+		if newAdms[admin.Id] != admin {
+			continue
+		}
 		u := &types.User{
 			Id:          admin.Id,
 			Certificate: admin.Certificate,
@@ -148,7 +171,7 @@ func ConstructDBEntriesForClusterAdmins(oldAdmins, newAdmins []*types.Admin, ver
 			},
 		}
 
-		value, err := proto.Marshal(u)
+		value, err := worldstate.MarshalValue(u)
 		if err != nil {
 			return nil, errors.New("error marshaling admin user")
 		}
@@ -252,8 +275,11 @@ func ConstructDBEntriesForNodes(oldNodes, newNodes []*types.NodeConfig, version
 		deletes = append(deletes, string(NodeNamespace)+oldNode.Id)
 	}
 
-	for _, n := range nodes {
-		value, err := proto.Marshal(n)
+	for _, n := range newNodes {
+		if nodes[n.Id] != n {
+			continue
+		}
+		value, err := worldstate.MarshalValue(n)
 		if err != nil {
 			return nil, err
 		}
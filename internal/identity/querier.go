@@ -4,6 +4,7 @@ package identity
 
 import (
 	"crypto/x509"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
@@ -99,6 +100,24 @@ func (q *Querier) GetUserVersion(userID string) (*types.Version, error) {
 	return metadata.Version, nil
 }
 
+// GetLastSequence returns the sequence number of the last committed transaction of the given userID, or 0 if the
+// user has never committed a transaction with a sequence number
+func (q *Querier) GetLastSequence(userID string) (uint64, error) {
+	val, _, err := q.db.Get(worldstate.UsersDBName, string(SequenceNamespace)+userID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error while fetching the last sequence number of userID [%s]", userID)
+	}
+
+	if val == nil {
+		return 0, nil
+	}
+	if len(val) != 8 {
+		return 0, errors.Errorf("the persisted sequence number of userID [%s] is corrupted, found %d bytes", userID, len(val))
+	}
+
+	return binary.BigEndian.Uint64(val), nil
+}
+
 // HasReadAccessOnDataDB returns true if the given userID has read access on the given
 // dbName. Otherwise, it returns false
 func (q *Querier) HasReadAccessOnDataDB(userID, dbName string) (bool, error) {
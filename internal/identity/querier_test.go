@@ -362,3 +362,38 @@ func TestQuerierNonExistingUser(t *testing.T) {
 		require.False(t, perm)
 	})
 }
+
+func TestQuerierLastSequence(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	last, err := env.q.GetLastSequence("user1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), last)
+
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				ConstructDBEntryForUserSequence("user1", 42, &types.Version{BlockNum: 2}),
+				{
+					Key:   string(SequenceNamespace) + "user2",
+					Value: []byte("corrupted"),
+				},
+			},
+		},
+	}, 2))
+
+	last, err = env.q.GetLastSequence("user1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), last)
+
+	// the sequence number does not make the user exist
+	exist, err := env.q.DoesUserExist("user1")
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	_, err = env.q.GetLastSequence("user2")
+	require.EqualError(t, err, "the persisted sequence number of userID [user2] is corrupted, found 9 bytes")
+}
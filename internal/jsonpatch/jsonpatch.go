@@ -0,0 +1,442 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonpatch applies JSON merge patches (RFC 7386) and JSON patches (RFC 6902) to JSON documents. Numbers are
+// kept in their textual form, so that a patch never changes the precision of a field it does not touch, and the
+// resulting document is encoded with sorted object keys, so that all the nodes derive the same bytes from the same
+// document and patch.
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+// ApplyDataPatch applies the patch of a data transaction to the value of its key and returns the resulting value.
+// A data patch carries either a merge patch or a list of operations, but not both.
+func ApplyDataPatch(value []byte, p *types.DataPatch) ([]byte, error) {
+	switch {
+	case len(p.GetMergePatch()) > 0 && len(p.GetOperations()) > 0:
+		return nil, errors.New("the patch carries both a merge patch and a list of operations")
+	case len(p.GetMergePatch()) > 0:
+		return MergePatch(value, p.GetMergePatch())
+	case len(p.GetOperations()) > 0:
+		return Apply(value, p.GetOperations())
+	default:
+		return nil, errors.New("the patch carries neither a merge patch nor a list of operations")
+	}
+}
+
+// MergePatch applies the JSON merge patch to the JSON document and returns the resulting document.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	d, err := decode(doc)
+	if err != nil {
+		return nil, errors.WithMessage(err, "the value is not a JSON document")
+	}
+	p, err := decode(patch)
+	if err != nil {
+		return nil, errors.WithMessage(err, "the merge patch is not a JSON document")
+	}
+
+	return encode(mergePatch(d, p))
+}
+
+func mergePatch(doc, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	docObj, ok := doc.(map[string]interface{})
+	if !ok {
+		docObj = make(map[string]interface{})
+	}
+
+	for name, value := range patchObj {
+		if value == nil {
+			delete(docObj, name)
+			continue
+		}
+		docObj[name] = mergePatch(docObj[name], value)
+	}
+
+	return docObj
+}
+
+// Apply applies the JSON patch operations to the JSON document in the given order and returns the resulting
+// document. The operations are applied all or none: if an operation fails, including a failing test operation, an
+// error is returned.
+func Apply(doc []byte, ops []*types.JSONPatchOperation) ([]byte, error) {
+	d, err := decode(doc)
+	if err != nil {
+		return nil, errors.WithMessage(err, "the value is not a JSON document")
+	}
+
+	for i, op := range ops {
+		if d, err = applyOperation(d, op); err != nil {
+			return nil, errors.WithMessagef(err, "operation %d [%s] failed", i, op.GetOp())
+		}
+	}
+
+	return encode(d)
+}
+
+func applyOperation(doc interface{}, op *types.JSONPatchOperation) (interface{}, error) {
+	if op == nil {
+		return nil, errors.New("the operation is empty")
+	}
+
+	path, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case OpAdd:
+		value, err := operationValue(op)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case OpRemove:
+		return remove(doc, path)
+
+	case OpReplace:
+		value, err := operationValue(op)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := get(doc, path); err != nil {
+			return nil, err
+		}
+		return set(doc, path, value)
+
+	case OpMove:
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		if isProperPrefix(from, path) {
+			return nil, errors.Errorf("the location [%s] cannot be moved into one of its children [%s]", op.From, op.Path)
+		}
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = remove(doc, from); err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case OpCopy:
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, deepCopy(value))
+
+	case OpTest:
+		value, err := operationValue(op)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(actual, value) {
+			return nil, errors.Errorf("the value at the path [%s] is not the expected one", op.Path)
+		}
+		return doc, nil
+
+	default:
+		return nil, errors.Errorf("unknown operation, expected one of [%s, %s, %s, %s, %s, %s]",
+			OpAdd, OpRemove, OpReplace, OpMove, OpCopy, OpTest)
+	}
+}
+
+func operationValue(op *types.JSONPatchOperation) (interface{}, error) {
+	if len(op.Value) == 0 {
+		return nil, errors.New("the operation requires a value")
+	}
+	value, err := decode(op.Value)
+	if err != nil {
+		return nil, errors.WithMessage(err, "the value of the operation is not a JSON document")
+	}
+	return value, nil
+}
+
+// parsePointer splits the JSON pointer (RFC 6901) into its unescaped reference tokens. The empty pointer refers to
+// the whole document.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errors.Errorf("the path [%s] is not a JSON pointer as it does not start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func isProperPrefix(prefix, path []string) bool {
+	if len(prefix) >= len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func get(doc interface{}, path []string) (interface{}, error) {
+	current := doc
+	for i, token := range path {
+		switch c := current.(type) {
+		case map[string]interface{}:
+			v, ok := c[token]
+			if !ok {
+				return nil, errors.Errorf("the path [%s] does not exist", pointerOf(path[:i+1]))
+			}
+			current = v
+		case []interface{}:
+			idx, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "the path [%s] does not exist", pointerOf(path[:i+1]))
+			}
+			current = c[idx]
+		default:
+			return nil, errors.Errorf("the path [%s] does not exist", pointerOf(path[:i+1]))
+		}
+	}
+	return current, nil
+}
+
+// set replaces the value at the existing location and returns the resulting document
+func set(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[last] = value
+	case []interface{}:
+		idx, err := arrayIndex(last, len(p)-1)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "the path [%s] does not exist", pointerOf(path))
+		}
+		p[idx] = value
+	default:
+		return nil, errors.Errorf("the path [%s] does not exist", pointerOf(path))
+	}
+	return doc, nil
+}
+
+func add(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	parentPath := path[:len(path)-1]
+	parent, err := get(doc, parentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[last] = value
+		return doc, nil
+	case []interface{}:
+		idx := len(p)
+		if last != "-" {
+			if idx, err = arrayIndex(last, len(p)); err != nil {
+				return nil, errors.WithMessagef(err, "the path [%s] cannot be added", pointerOf(path))
+			}
+		}
+		updated := make([]interface{}, 0, len(p)+1)
+		updated = append(updated, p[:idx]...)
+		updated = append(updated, value)
+		updated = append(updated, p[idx:]...)
+		return set(doc, parentPath, updated)
+	default:
+		return nil, errors.Errorf("the path [%s] cannot be added as its parent is neither an object nor an array", pointerOf(path))
+	}
+}
+
+func remove(doc interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, errors.New("the whole document cannot be removed")
+	}
+
+	parentPath := path[:len(path)-1]
+	parent, err := get(doc, parentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := p[last]; !ok {
+			return nil, errors.Errorf("the path [%s] does not exist", pointerOf(path))
+		}
+		delete(p, last)
+		return doc, nil
+	case []interface{}:
+		idx, err := arrayIndex(last, len(p)-1)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "the path [%s] does not exist", pointerOf(path))
+		}
+		updated := make([]interface{}, 0, len(p)-1)
+		updated = append(updated, p[:idx]...)
+		updated = append(updated, p[idx+1:]...)
+		return set(doc, parentPath, updated)
+	default:
+		return nil, errors.Errorf("the path [%s] does not exist", pointerOf(path))
+	}
+}
+
+// arrayIndex parses the reference token as an index of an array, which must not be greater than max
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, errors.Errorf("[%s] is not a valid array index", token)
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 {
+		return 0, errors.Errorf("[%s] is not a valid array index", token)
+	}
+	if idx > max {
+		return 0, errors.Errorf("the array index [%d] is out of bounds", idx)
+	}
+	return idx, nil
+}
+
+func pointerOf(tokens []string) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		b.WriteString("/")
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(v))
+		for name, field := range v {
+			c[name] = deepCopy(field)
+		}
+		return c
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i, elem := range v {
+			c[i] = deepCopy(elem)
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// equal compares two JSON values as defined by the test operation, where numbers are equal if their values are
+// numerically equal
+func equal(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for name, field := range av {
+			other, ok := bv[name]
+			if !ok || !equal(field, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !equal(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if av == bv {
+			return true
+		}
+		af, errA := av.Float64()
+		bf, errB := bv.Float64()
+		return errA == nil && errB == nil && af == bf
+	default:
+		return a == b
+	}
+}
+
+func decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, errors.Wrap(err, "error while decoding JSON")
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("error while decoding JSON: unexpected data after the top-level value")
+	}
+	return v, nil
+}
+
+func encode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, errors.Wrap(err, "error while encoding JSON")
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonpatch
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		doc           string
+		patch         string
+		expectedDoc   string
+		expectedError string
+	}{
+		{
+			name:        "nested field updates",
+			doc:         `{"name":"alice","address":{"city":"haifa","zip":"3200003"},"age":30}`,
+			patch:       `{"address":{"city":"tel aviv","street":"herzl"},"age":31}`,
+			expectedDoc: `{"address":{"city":"tel aviv","street":"herzl","zip":"3200003"},"age":31,"name":"alice"}`,
+		},
+		{
+			name:        "null removes a field",
+			doc:         `{"a":{"b":1,"c":2}}`,
+			patch:       `{"a":{"b":null}}`,
+			expectedDoc: `{"a":{"c":2}}`,
+		},
+		{
+			name:        "an array is replaced as a whole",
+			doc:         `{"tags":["a","b"]}`,
+			patch:       `{"tags":["c"]}`,
+			expectedDoc: `{"tags":["c"]}`,
+		},
+		{
+			name:        "a non-object patch replaces the document",
+			doc:         `{"a":1}`,
+			patch:       `[1,2]`,
+			expectedDoc: `[1,2]`,
+		},
+		{
+			name:        "numbers keep their textual form",
+			doc:         `{"big":12345678901234567890,"f":1.50}`,
+			patch:       `{"g":2}`,
+			expectedDoc: `{"big":12345678901234567890,"f":1.50,"g":2}`,
+		},
+		{
+			name:          "the document is not JSON",
+			doc:           `not-json`,
+			patch:         `{"a":1}`,
+			expectedError: "the value is not a JSON document",
+		},
+		{
+			name:          "the patch is not JSON",
+			doc:           `{"a":1}`,
+			patch:         `{"a":`,
+			expectedError: "the merge patch is not a JSON document",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedDoc, string(doc))
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		doc           string
+		ops           []*types.JSONPatchOperation
+		expectedDoc   string
+		expectedError string
+	}{
+		{
+			name: "nested field updates",
+			doc:  `{"a":{"b":{"c":1}},"d":"x"}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpReplace, Path: "/a/b/c", Value: []byte(`2`)},
+				{Op: OpAdd, Path: "/a/b/e", Value: []byte(`{"f":true}`)},
+				{Op: OpRemove, Path: "/d"},
+			},
+			expectedDoc: `{"a":{"b":{"c":2,"e":{"f":true}}}}`,
+		},
+		{
+			name: "array operations",
+			doc:  `{"list":[1,2,3]}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpAdd, Path: "/list/1", Value: []byte(`10`)},
+				{Op: OpAdd, Path: "/list/-", Value: []byte(`20`)},
+				{Op: OpRemove, Path: "/list/0"},
+				{Op: OpReplace, Path: "/list/2", Value: []byte(`30`)},
+			},
+			expectedDoc: `{"list":[10,2,30,20]}`,
+		},
+		{
+			name: "arrays nested in arrays",
+			doc:  `{"m":[[1],[2,3]]}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpAdd, Path: "/m/1/0", Value: []byte(`0`)},
+				{Op: OpRemove, Path: "/m/0/0"},
+			},
+			expectedDoc: `{"m":[[],[0,2,3]]}`,
+		},
+		{
+			name: "move and copy",
+			doc:  `{"a":{"x":1},"b":[]}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpCopy, From: "/a/x", Path: "/b/0"},
+				{Op: OpMove, From: "/a", Path: "/c"},
+			},
+			expectedDoc: `{"b":[1],"c":{"x":1}}`,
+		},
+		{
+			name: "a copied value is independent of its source",
+			doc:  `{"a":{"x":1}}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpCopy, From: "/a", Path: "/b"},
+				{Op: OpReplace, Path: "/b/x", Value: []byte(`2`)},
+			},
+			expectedDoc: `{"a":{"x":1},"b":{"x":2}}`,
+		},
+		{
+			name: "escaped reference tokens",
+			doc:  `{"a/b":{"c~d":1}}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpReplace, Path: "/a~1b/c~0d", Value: []byte(`2`)},
+			},
+			expectedDoc: `{"a/b":{"c~d":2}}`,
+		},
+		{
+			name: "successful test with numerically equal numbers",
+			doc:  `{"a":[1.0,{"b":"c"}]}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpTest, Path: "/a", Value: []byte(`[1,{"b":"c"}]`)},
+			},
+			expectedDoc: `{"a":[1.0,{"b":"c"}]}`,
+		},
+		{
+			name: "replace the whole document",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpReplace, Path: "", Value: []byte(`"s"`)},
+			},
+			expectedDoc: `"s"`,
+		},
+		{
+			name: "failing test",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpReplace, Path: "/a", Value: []byte(`2`)},
+				{Op: OpTest, Path: "/a", Value: []byte(`1`)},
+			},
+			expectedError: "operation 1 [test] failed: the value at the path [/a] is not the expected one",
+		},
+		{
+			name: "replace a missing field",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpReplace, Path: "/b", Value: []byte(`2`)},
+			},
+			expectedError: "operation 0 [replace] failed: the path [/b] does not exist",
+		},
+		{
+			name: "add to a missing parent",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpAdd, Path: "/b/c", Value: []byte(`2`)},
+			},
+			expectedError: "the path [/b] does not exist",
+		},
+		{
+			name: "array index out of bounds",
+			doc:  `{"list":[1]}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpAdd, Path: "/list/2", Value: []byte(`2`)},
+			},
+			expectedError: "the array index [2] is out of bounds",
+		},
+		{
+			name: "array index with a leading zero",
+			doc:  `{"list":[1,2]}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpRemove, Path: "/list/01"},
+			},
+			expectedError: "[01] is not a valid array index",
+		},
+		{
+			name: "move into a child",
+			doc:  `{"a":{"b":1}}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpMove, From: "/a", Path: "/a/b/c"},
+			},
+			expectedError: "the location [/a] cannot be moved into one of its children [/a/b/c]",
+		},
+		{
+			name: "remove the whole document",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpRemove, Path: ""},
+			},
+			expectedError: "the whole document cannot be removed",
+		},
+		{
+			name: "missing value",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpAdd, Path: "/b"},
+			},
+			expectedError: "the operation requires a value",
+		},
+		{
+			name: "path is not a pointer",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpRemove, Path: "a"},
+			},
+			expectedError: "the path [a] is not a JSON pointer as it does not start with '/'",
+		},
+		{
+			name: "unknown operation",
+			doc:  `{"a":1}`,
+			ops: []*types.JSONPatchOperation{
+				{Op: "increment", Path: "/a"},
+			},
+			expectedError: "operation 0 [increment] failed: unknown operation",
+		},
+		{
+			name: "the document is not JSON",
+			doc:  `{"a":1} trailing`,
+			ops: []*types.JSONPatchOperation{
+				{Op: OpRemove, Path: "/a"},
+			},
+			expectedError: "the value is not a JSON document",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := Apply([]byte(tt.doc), tt.ops)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedDoc, string(doc))
+		})
+	}
+}
+
+func TestApplyDataPatch(t *testing.T) {
+	doc := []byte(`{"a":1}`)
+
+	value, err := ApplyDataPatch(doc, &types.DataPatch{Key: "k", MergePatch: []byte(`{"b":2}`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"a":1,"b":2}`, string(value))
+
+	value, err = ApplyDataPatch(doc, &types.DataPatch{
+		Key:        "k",
+		Operations: []*types.JSONPatchOperation{{Op: OpRemove, Path: "/a"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{}`, string(value))
+
+	_, err = ApplyDataPatch(doc, &types.DataPatch{
+		Key:        "k",
+		MergePatch: []byte(`{"b":2}`),
+		Operations: []*types.JSONPatchOperation{{Op: OpRemove, Path: "/a"}},
+	})
+	require.EqualError(t, err, "the patch carries both a merge patch and a list of operations")
+
+	_, err = ApplyDataPatch(doc, &types.DataPatch{Key: "k"})
+	require.EqualError(t, err, "the patch carries neither a merge patch nor a list of operations")
+}
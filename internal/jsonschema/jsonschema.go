@@ -0,0 +1,489 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonschema validates JSON documents against a subset of JSON Schema (draft 2020-12). The supported
+// keywords are type, enum, const, properties, required, additionalProperties, items, minItems, maxItems, minimum,
+// maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength and pattern, where pattern is an RE2 regular
+// expression. A schema that uses any other validation keyword is rejected rather than partially enforced, and the
+// annotation keywords, such as title and description, are ignored.
+//
+// The validation is deterministic: the properties of an object are visited in the lexicographic order of their names,
+// so that all the nodes report the same first violation for the same document.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// annotations are the keywords that do not take part in the validation.
+var annotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+	"deprecated":  true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+var types = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+	"number":  true,
+	"string":  true,
+	"integer": true,
+}
+
+// Schema is a compiled JSON schema. It is immutable and safe for concurrent use.
+type Schema struct {
+	// alwaysFails is set by the boolean schema false
+	alwaysFails bool
+
+	types            []string
+	enum             []interface{}
+	constValue       interface{}
+	hasConst         bool
+	properties       map[string]*Schema
+	propertyNames    []string
+	required         []string
+	additionalProps  *Schema
+	items            *Schema
+	minItems         *int
+	maxItems         *int
+	minimum          *big.Rat
+	maximum          *big.Rat
+	exclusiveMinimum *big.Rat
+	exclusiveMaximum *big.Rat
+	minLength        *int
+	maxLength        *int
+	pattern          *regexp.Regexp
+}
+
+// Violation describes the first part of a document that does not conform to a schema.
+type Violation struct {
+	// Path is the JSON pointer (RFC 6901) of the violating value. The empty path refers to the whole document.
+	Path   string
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("the value at [%s] %s", v.Path, v.Reason)
+}
+
+// Compile parses the given JSON schema.
+func Compile(schema []byte) (*Schema, error) {
+	s, err := decode(schema)
+	if err != nil {
+		return nil, errors.WithMessage(err, "the schema is not a JSON document")
+	}
+
+	return compile(s, "")
+}
+
+func compile(s interface{}, path string) (*Schema, error) {
+	switch s := s.(type) {
+	case bool:
+		return &Schema{alwaysFails: !s}, nil
+	case map[string]interface{}:
+		return compileObject(s, path)
+	default:
+		return nil, errors.Errorf("the schema at [%s] is neither an object nor a boolean", path)
+	}
+}
+
+func compileObject(s map[string]interface{}, path string) (*Schema, error) {
+	c := &Schema{}
+
+	keywords := make([]string, 0, len(s))
+	for k := range s {
+		keywords = append(keywords, k)
+	}
+	sort.Strings(keywords)
+
+	for _, k := range keywords {
+		v := s[k]
+		kPath := path + "/" + k
+
+		var err error
+		switch k {
+		case "type":
+			c.types, err = compileType(v, kPath)
+		case "enum":
+			values, ok := v.([]interface{})
+			if !ok || len(values) == 0 {
+				err = errors.Errorf("the keyword at [%s] must be a non-empty array", kPath)
+			}
+			c.enum = values
+		case "const":
+			c.constValue = v
+			c.hasConst = true
+		case "properties":
+			err = c.compileProperties(v, kPath)
+		case "required":
+			c.required, err = compileRequired(v, kPath)
+		case "additionalProperties":
+			c.additionalProps, err = compile(v, kPath)
+		case "items":
+			c.items, err = compile(v, kPath)
+		case "minItems":
+			c.minItems, err = compileCount(v, kPath)
+		case "maxItems":
+			c.maxItems, err = compileCount(v, kPath)
+		case "minLength":
+			c.minLength, err = compileCount(v, kPath)
+		case "maxLength":
+			c.maxLength, err = compileCount(v, kPath)
+		case "minimum":
+			c.minimum, err = compileNumber(v, kPath)
+		case "maximum":
+			c.maximum, err = compileNumber(v, kPath)
+		case "exclusiveMinimum":
+			c.exclusiveMinimum, err = compileNumber(v, kPath)
+		case "exclusiveMaximum":
+			c.exclusiveMaximum, err = compileNumber(v, kPath)
+		case "pattern":
+			p, ok := v.(string)
+			if !ok {
+				err = errors.Errorf("the keyword at [%s] must be a string", kPath)
+				break
+			}
+			if c.pattern, err = regexp.Compile(p); err != nil {
+				err = errors.Wrapf(err, "the pattern at [%s] is not a valid regular expression", kPath)
+			}
+		default:
+			if !annotations[k] {
+				err = errors.Errorf("the keyword at [%s] is not supported", kPath)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func compileType(v interface{}, path string) ([]string, error) {
+	var names []interface{}
+	switch v := v.(type) {
+	case string:
+		names = []interface{}{v}
+	case []interface{}:
+		names = v
+	}
+	if len(names) == 0 {
+		return nil, errors.Errorf("the keyword at [%s] must be a type name or a non-empty array of type names", path)
+	}
+
+	var ts []string
+	for _, n := range names {
+		name, ok := n.(string)
+		if !ok || !types[name] {
+			return nil, errors.Errorf("the keyword at [%s] holds an unknown type %v", path, n)
+		}
+		ts = append(ts, name)
+	}
+	return ts, nil
+}
+
+func (c *Schema) compileProperties(v interface{}, path string) error {
+	props, ok := v.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("the keyword at [%s] must be an object", path)
+	}
+
+	c.properties = make(map[string]*Schema)
+	for name, p := range props {
+		s, err := compile(p, path+"/"+escape(name))
+		if err != nil {
+			return err
+		}
+		c.properties[name] = s
+		c.propertyNames = append(c.propertyNames, name)
+	}
+	sort.Strings(c.propertyNames)
+	return nil
+}
+
+func compileRequired(v interface{}, path string) ([]string, error) {
+	names, ok := v.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("the keyword at [%s] must be an array of property names", path)
+	}
+
+	var required []string
+	for _, n := range names {
+		name, ok := n.(string)
+		if !ok {
+			return nil, errors.Errorf("the keyword at [%s] must be an array of property names", path)
+		}
+		required = append(required, name)
+	}
+	sort.Strings(required)
+	return required, nil
+}
+
+func compileCount(v interface{}, path string) (*int, error) {
+	n, ok := v.(json.Number)
+	if ok {
+		if i, err := strconv.Atoi(n.String()); err == nil && i >= 0 {
+			return &i, nil
+		}
+	}
+	return nil, errors.Errorf("the keyword at [%s] must be a non-negative integer", path)
+}
+
+func compileNumber(v interface{}, path string) (*big.Rat, error) {
+	if n, ok := v.(json.Number); ok {
+		if r, ok := new(big.Rat).SetString(n.String()); ok {
+			return r, nil
+		}
+	}
+	return nil, errors.Errorf("the keyword at [%s] must be a number", path)
+}
+
+// Validate checks that the given JSON document conforms to the schema, and returns the first violation found, if
+// any. A value that is not a JSON document violates every schema.
+func (c *Schema) Validate(doc []byte) *Violation {
+	d, err := decode(doc)
+	if err != nil {
+		return &Violation{Reason: "is not a JSON document"}
+	}
+
+	return c.validate(d, "")
+}
+
+func (c *Schema) validate(v interface{}, path string) *Violation {
+	if c.alwaysFails {
+		return &Violation{Path: path, Reason: "is not allowed"}
+	}
+
+	if len(c.types) > 0 && !c.matchesType(v) {
+		return &Violation{Path: path, Reason: fmt.Sprintf("is of type %s, while the schema expects %s", typeOf(v), strings.Join(c.types, " or "))}
+	}
+
+	if c.enum != nil {
+		found := false
+		for _, e := range c.enum {
+			if equal(v, e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &Violation{Path: path, Reason: "is not one of the values allowed by the schema"}
+		}
+	}
+
+	if c.hasConst && !equal(v, c.constValue) {
+		return &Violation{Path: path, Reason: "is not the value required by the schema"}
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return c.validateObject(v, path)
+	case []interface{}:
+		return c.validateArray(v, path)
+	case json.Number:
+		return c.validateNumber(v, path)
+	case string:
+		return c.validateString(v, path)
+	}
+
+	return nil
+}
+
+func (c *Schema) validateObject(obj map[string]interface{}, path string) *Violation {
+	for _, name := range c.required {
+		if _, ok := obj[name]; !ok {
+			return &Violation{Path: path, Reason: fmt.Sprintf("misses the required property %q", name)}
+		}
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s, ok := c.properties[name]
+		if !ok {
+			s = c.additionalProps
+		}
+		if s == nil {
+			continue
+		}
+		if violation := s.validate(obj[name], path+"/"+escape(name)); violation != nil {
+			if !ok && s.alwaysFails {
+				violation.Reason = "is an additional property, which the schema does not allow"
+			}
+			return violation
+		}
+	}
+
+	return nil
+}
+
+func (c *Schema) validateArray(arr []interface{}, path string) *Violation {
+	if c.minItems != nil && len(arr) < *c.minItems {
+		return &Violation{Path: path, Reason: fmt.Sprintf("has %d items, fewer than the minimum of %d", len(arr), *c.minItems)}
+	}
+	if c.maxItems != nil && len(arr) > *c.maxItems {
+		return &Violation{Path: path, Reason: fmt.Sprintf("has %d items, more than the maximum of %d", len(arr), *c.maxItems)}
+	}
+
+	if c.items == nil {
+		return nil
+	}
+	for i, item := range arr {
+		if violation := c.items.validate(item, path+"/"+strconv.Itoa(i)); violation != nil {
+			return violation
+		}
+	}
+
+	return nil
+}
+
+func (c *Schema) validateNumber(n json.Number, path string) *Violation {
+	r, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return &Violation{Path: path, Reason: "is not a valid number"}
+	}
+
+	switch {
+	case c.minimum != nil && r.Cmp(c.minimum) < 0:
+		return &Violation{Path: path, Reason: fmt.Sprintf("is %s, less than the minimum of %s", n, c.minimum.RatString())}
+	case c.maximum != nil && r.Cmp(c.maximum) > 0:
+		return &Violation{Path: path, Reason: fmt.Sprintf("is %s, greater than the maximum of %s", n, c.maximum.RatString())}
+	case c.exclusiveMinimum != nil && r.Cmp(c.exclusiveMinimum) <= 0:
+		return &Violation{Path: path, Reason: fmt.Sprintf("is %s, not greater than the exclusive minimum of %s", n, c.exclusiveMinimum.RatString())}
+	case c.exclusiveMaximum != nil && r.Cmp(c.exclusiveMaximum) >= 0:
+		return &Violation{Path: path, Reason: fmt.Sprintf("is %s, not less than the exclusive maximum of %s", n, c.exclusiveMaximum.RatString())}
+	}
+
+	return nil
+}
+
+func (c *Schema) validateString(s string, path string) *Violation {
+	length := utf8.RuneCountInString(s)
+	switch {
+	case c.minLength != nil && length < *c.minLength:
+		return &Violation{Path: path, Reason: fmt.Sprintf("is %d characters long, shorter than the minimum of %d", length, *c.minLength)}
+	case c.maxLength != nil && length > *c.maxLength:
+		return &Violation{Path: path, Reason: fmt.Sprintf("is %d characters long, longer than the maximum of %d", length, *c.maxLength)}
+	case c.pattern != nil && !c.pattern.MatchString(s):
+		return &Violation{Path: path, Reason: fmt.Sprintf("does not match the pattern %q", c.pattern.String())}
+	}
+
+	return nil
+}
+
+func (c *Schema) matchesType(v interface{}) bool {
+	actual := typeOf(v)
+	for _, t := range c.types {
+		if t == actual {
+			return true
+		}
+		if actual == "integer" && t == "number" {
+			return true
+		}
+	}
+	return false
+}
+
+// typeOf returns the JSON type of the given decoded value, where a number with a zero fractional part is an integer.
+func typeOf(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		if r, ok := new(big.Rat).SetString(v.String()); ok && r.IsInt() {
+			return "integer"
+		}
+		return "number"
+	default:
+		return reflect.TypeOf(v).String()
+	}
+}
+
+func equal(a, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		ra, okA := new(big.Rat).SetString(a.String())
+		rb, okB := new(big.Rat).SetString(b.String())
+		return okA && okB && ra.Cmp(rb) == 0
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, va := range a {
+			vb, ok := b[k]
+			if !ok || !equal(va, vb) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+func escape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, errors.Wrap(err, "error while decoding JSON")
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("error while decoding JSON: unexpected data after the top-level value")
+	}
+	return v, nil
+}
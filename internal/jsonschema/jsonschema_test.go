@@ -0,0 +1,221 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        string
+		expectedError string
+	}{
+		{
+			name:   "annotations are ignored",
+			schema: `{"$schema":"https://json-schema.org/draft/2020-12/schema","title":"user","description":"a user","type":"object"}`,
+		},
+		{
+			name:   "boolean schema",
+			schema: `true`,
+		},
+		{
+			name:          "not a JSON document",
+			schema:        `{"type":`,
+			expectedError: "the schema is not a JSON document: error while decoding JSON: unexpected EOF",
+		},
+		{
+			name:          "not an object",
+			schema:        `[]`,
+			expectedError: "the schema at [] is neither an object nor a boolean",
+		},
+		{
+			name:          "unsupported keyword",
+			schema:        `{"properties":{"a":{"oneOf":[{"type":"string"}]}}}`,
+			expectedError: "the keyword at [/properties/a/oneOf] is not supported",
+		},
+		{
+			name:          "unknown type",
+			schema:        `{"type":["string","date"]}`,
+			expectedError: "the keyword at [/type] holds an unknown type date",
+		},
+		{
+			name:          "negative count",
+			schema:        `{"maxLength":-1}`,
+			expectedError: "the keyword at [/maxLength] must be a non-negative integer",
+		},
+		{
+			name:          "invalid pattern",
+			schema:        `{"pattern":"(a"}`,
+			expectedError: "the pattern at [/pattern] is not a valid regular expression: error parsing regexp: missing closing ): `(a`",
+		},
+		{
+			name:          "required is not an array of names",
+			schema:        `{"required":["a",1]}`,
+			expectedError: "the keyword at [/required] must be an array of property names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Compile([]byte(tt.schema))
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				require.Nil(t, s)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, s)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	userSchema := `{
+		"type": "object",
+		"required": ["name", "age"],
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "maxLength": 8, "pattern": "^[a-z]+$"},
+			"age": {"type": "integer", "minimum": 0, "exclusiveMaximum": 150},
+			"score": {"type": "number", "exclusiveMinimum": 0, "maximum": 1.5},
+			"role": {"enum": ["admin", "user", null]},
+			"kind": {"const": {"v": 1}},
+			"tags": {"type": "array", "minItems": 1, "maxItems": 2, "items": {"type": "string"}}
+		}
+	}`
+
+	tests := []struct {
+		name              string
+		doc               string
+		expectedViolation *Violation
+	}{
+		{
+			name: "valid",
+			doc:  `{"name":"alice","age":30,"score":1.5,"role":null,"kind":{"v":1.0},"tags":["a","b"]}`,
+		},
+		{
+			name:              "not a JSON document",
+			doc:               `{"name":"alice"`,
+			expectedViolation: &Violation{Path: "", Reason: "is not a JSON document"},
+		},
+		{
+			name:              "wrong type of the document",
+			doc:               `["alice"]`,
+			expectedViolation: &Violation{Path: "", Reason: "is of type array, while the schema expects object"},
+		},
+		{
+			name:              "missing required property",
+			doc:               `{"name":"alice"}`,
+			expectedViolation: &Violation{Path: "", Reason: `misses the required property "age"`},
+		},
+		{
+			name:              "additional property",
+			doc:               `{"name":"alice","age":30,"email":"a@b"}`,
+			expectedViolation: &Violation{Path: "/email", Reason: "is an additional property, which the schema does not allow"},
+		},
+		{
+			name:              "not an integer",
+			doc:               `{"name":"alice","age":30.5}`,
+			expectedViolation: &Violation{Path: "/age", Reason: "is of type number, while the schema expects integer"},
+		},
+		{
+			name: "an integer with a zero fraction",
+			doc:  `{"name":"alice","age":30.0}`,
+		},
+		{
+			name:              "below minimum",
+			doc:               `{"name":"alice","age":-1}`,
+			expectedViolation: &Violation{Path: "/age", Reason: "is -1, less than the minimum of 0"},
+		},
+		{
+			name:              "at exclusive maximum",
+			doc:               `{"name":"alice","age":150}`,
+			expectedViolation: &Violation{Path: "/age", Reason: "is 150, not less than the exclusive maximum of 150"},
+		},
+		{
+			name:              "above maximum",
+			doc:               `{"name":"alice","age":1,"score":1.50001}`,
+			expectedViolation: &Violation{Path: "/score", Reason: "is 1.50001, greater than the maximum of 3/2"},
+		},
+		{
+			name:              "at exclusive minimum",
+			doc:               `{"name":"alice","age":1,"score":0}`,
+			expectedViolation: &Violation{Path: "/score", Reason: "is 0, not greater than the exclusive minimum of 0"},
+		},
+		{
+			name:              "too short",
+			doc:               `{"name":"","age":1}`,
+			expectedViolation: &Violation{Path: "/name", Reason: "is 0 characters long, shorter than the minimum of 1"},
+		},
+		{
+			name:              "too long",
+			doc:               `{"name":"abcdefghi","age":1}`,
+			expectedViolation: &Violation{Path: "/name", Reason: "is 9 characters long, longer than the maximum of 8"},
+		},
+		{
+			name:              "pattern mismatch",
+			doc:               `{"name":"Alice","age":1}`,
+			expectedViolation: &Violation{Path: "/name", Reason: `does not match the pattern "^[a-z]+$"`},
+		},
+		{
+			name:              "not in enum",
+			doc:               `{"name":"alice","age":1,"role":"root"}`,
+			expectedViolation: &Violation{Path: "/role", Reason: "is not one of the values allowed by the schema"},
+		},
+		{
+			name:              "not the const",
+			doc:               `{"name":"alice","age":1,"kind":{"v":2}}`,
+			expectedViolation: &Violation{Path: "/kind", Reason: "is not the value required by the schema"},
+		},
+		{
+			name:              "too few items",
+			doc:               `{"name":"alice","age":1,"tags":[]}`,
+			expectedViolation: &Violation{Path: "/tags", Reason: "has 0 items, fewer than the minimum of 1"},
+		},
+		{
+			name:              "too many items",
+			doc:               `{"name":"alice","age":1,"tags":["a","b","c"]}`,
+			expectedViolation: &Violation{Path: "/tags", Reason: "has 3 items, more than the maximum of 2"},
+		},
+		{
+			name:              "wrong item type",
+			doc:               `{"name":"alice","age":1,"tags":["a",2]}`,
+			expectedViolation: &Violation{Path: "/tags/1", Reason: "is of type integer, while the schema expects string"},
+		},
+		{
+			name:              "the first violation in the order of the property names",
+			doc:               `{"tags":[1],"name":"Alice","age":-1}`,
+			expectedViolation: &Violation{Path: "/age", Reason: "is -1, less than the minimum of 0"},
+		},
+	}
+
+	s, err := Compile([]byte(userSchema))
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedViolation, s.Validate([]byte(tt.doc)))
+		})
+	}
+}
+
+func TestValidateNestedPaths(t *testing.T) {
+	s, err := Compile([]byte(`{"properties":{"a/b":{"additionalProperties":{"type":"array","items":{"type":"object","properties":{"x~y":false}}}}}}`))
+	require.NoError(t, err)
+
+	require.Nil(t, s.Validate([]byte(`{"a/b":{"k":[{"z":1}]}}`)))
+	require.Equal(t,
+		&Violation{Path: "/a~1b/k/1/x~0y", Reason: "is not allowed"},
+		s.Validate([]byte(`{"a/b":{"k":[{"z":1},{"x~y":1}]}}`)),
+	)
+	require.Equal(t, "the value at [/a~1b/k/1/x~0y] is not allowed", (&Violation{Path: "/a~1b/k/1/x~0y", Reason: "is not allowed"}).Error())
+
+	falseSchema, err := Compile([]byte(`false`))
+	require.NoError(t, err)
+	require.Equal(t, &Violation{Reason: "is not allowed"}, falseSchema.Validate([]byte(`1`)))
+}
@@ -34,3 +34,27 @@ func (t *MPTrie) GetProof(key []byte, isDeleted bool) (*state.Proof, error) {
 
 	return state.NewProof(resPath), nil
 }
+
+// GetNonInclusionProof calculates the proof that the key is absent from the trie, i.e., the path from the node where
+// the lookup of the key terminates to the root node. A key which was deleted is absent, and its proof terminates at
+// its value node, which is marked as deleted. If the key is present, no proof is calculated.
+func (t *MPTrie) GetNonInclusionProof(key []byte) (*state.NonInclusionProof, error) {
+	hexKey := convertByteToHex(key)
+	path, node, err := t.getPath(hexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if node != nil && len(node.getValuePtr()) > 0 && !node.isDeleted() {
+		return nil, nil
+	}
+
+	resPath := make([]*types.MPTrieProofElement, 0)
+	for i := len(path) - 1; i >= 0; i-- {
+		resPath = append(resPath, &types.MPTrieProofElement{
+			Hashes: path[i].bytes(),
+		})
+	}
+
+	return state.NewNonInclusionProof(resPath), nil
+}
@@ -1,11 +1,14 @@
 package mptrie
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/pkg/state"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -168,6 +171,158 @@ func TestMPTrieGetProof(t *testing.T) {
 
 }
 
+func TestMPTrieGetNonInclusionProof(t *testing.T) {
+	// keys of the same length, as the composite keys of the state, some of which share long prefixes so that the trie
+	// holds extension nodes
+	sharedPrefixKey := func(lastByte byte) []byte {
+		key := bytes.Repeat([]byte{0x11}, 32)
+		key[31] = lastByte
+		return key
+	}
+
+	var keys [][]byte
+	for i := 0; i < 50; i++ {
+		key, err := state.ConstructCompositeKey("db", fmt.Sprintf("key%d", i))
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	keys = append(keys, sharedPrefixKey(0x12), sharedPrefixKey(0x21))
+	deletedKeys := [][]byte{keys[3], keys[17], sharedPrefixKey(0x21)}
+
+	var absentKeys [][]byte
+	for i := 50; i < 100; i++ {
+		key, err := state.ConstructCompositeKey("db", fmt.Sprintf("key%d", i))
+		require.NoError(t, err)
+		absentKeys = append(absentKeys, key)
+	}
+	divergingKey := sharedPrefixKey(0x12)
+	divergingKey[10] = 0x22
+	// a key whose lookup ends at the branch node below the extension node, at the value node of another key, and in the
+	// middle of the extension node, respectively
+	absentKeys = append(absentKeys, sharedPrefixKey(0x31), sharedPrefixKey(0x13), divergingKey)
+
+	store := newMockStore()
+	trie, err := NewTrie(nil, store)
+	require.NoError(t, err)
+	for _, key := range keys {
+		require.NoError(t, trie.Update(key, []byte("value")))
+	}
+	rootHashBeforeDelete, err := trie.Hash()
+	require.NoError(t, err)
+	require.NoError(t, trie.Commit(1))
+
+	for _, key := range deletedKeys {
+		_, err := trie.Delete(key)
+		require.NoError(t, err)
+	}
+	rootHashAfterDelete, err := trie.Hash()
+	require.NoError(t, err)
+	require.NoError(t, trie.Commit(2))
+
+	// the path of a proof, as it is received by a client
+	receivedPath := func(path []*types.MPTrieProofElement) []*types.MPTrieProofElement {
+		var received []*types.MPTrieProofElement
+		for _, element := range path {
+			b, err := proto.Marshal(element)
+			require.NoError(t, err)
+			e := &types.MPTrieProofElement{}
+			require.NoError(t, proto.Unmarshal(b, e))
+			received = append(received, e)
+		}
+		return received
+	}
+
+	t.Run("absent keys", func(t *testing.T) {
+		for _, rootHash := range [][]byte{rootHashBeforeDelete, rootHashAfterDelete} {
+			trie, err := NewTrie(rootHash, store)
+			require.NoError(t, err)
+
+			for _, key := range absentKeys {
+				proof, err := trie.GetNonInclusionProof(key)
+				require.NoError(t, err)
+				require.NotNil(t, proof)
+
+				isValid, err := state.NewNonInclusionProof(receivedPath(proof.GetPath())).Verify(key, rootHash)
+				require.NoError(t, err)
+				require.True(t, isValid, "key %x", key)
+			}
+		}
+	})
+
+	t.Run("keys deleted at an earlier height", func(t *testing.T) {
+		trie, err := NewTrie(rootHashAfterDelete, store)
+		require.NoError(t, err)
+
+		for _, key := range deletedKeys {
+			proof, err := trie.GetNonInclusionProof(key)
+			require.NoError(t, err)
+			require.NotNil(t, proof)
+
+			isValid, err := state.NewNonInclusionProof(receivedPath(proof.GetPath())).Verify(key, rootHashAfterDelete)
+			require.NoError(t, err)
+			require.True(t, isValid, "key %x", key)
+
+			// the keys were present before their delete
+			isValid, err = proof.Verify(key, rootHashBeforeDelete)
+			require.NoError(t, err)
+			require.False(t, isValid)
+		}
+
+		trie, err = NewTrie(rootHashBeforeDelete, store)
+		require.NoError(t, err)
+		for _, key := range deletedKeys {
+			proof, err := trie.GetNonInclusionProof(key)
+			require.NoError(t, err)
+			require.Nil(t, proof)
+		}
+	})
+
+	t.Run("present keys", func(t *testing.T) {
+		trie, err := NewTrie(rootHashAfterDelete, store)
+		require.NoError(t, err)
+
+		absentProof, err := trie.GetNonInclusionProof(absentKeys[0])
+		require.NoError(t, err)
+
+		for _, key := range keys {
+			if containsKey(deletedKeys, key) {
+				continue
+			}
+
+			proof, err := trie.GetNonInclusionProof(key)
+			require.NoError(t, err)
+			require.Nil(t, proof)
+
+			// neither the inclusion proof of the key, nor the non-inclusion proof of another key, prove its absence
+			inclusionProof, err := trie.GetProof(key, false)
+			require.NoError(t, err)
+			require.NotNil(t, inclusionProof)
+			isValid, err := state.NewNonInclusionProof(inclusionProof.GetPath()).Verify(key, rootHashAfterDelete)
+			require.NoError(t, err)
+			require.False(t, isValid, "key %x", key)
+
+			isValid, err = absentProof.Verify(key, rootHashAfterDelete)
+			require.NoError(t, err)
+			require.False(t, isValid, "key %x", key)
+		}
+	})
+
+	t.Run("empty proof", func(t *testing.T) {
+		isValid, err := state.NewNonInclusionProof(nil).Verify(absentKeys[0], rootHashAfterDelete)
+		require.EqualError(t, err, "proof can't be empty")
+		require.False(t, isValid)
+	})
+}
+
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
 func convertKeyToHex(t *testing.T, key []byte) []byte {
 	res := make([]byte, hex.EncodedLen(len(key)))
 	hex.Encode(res, key)
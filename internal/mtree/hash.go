@@ -31,6 +31,15 @@ func calculateBlockTxHashes(block *types.Block) ([][]byte, error) {
 			return nil, errors.Wrapf(err, "can't calculate msg hash %v", userTx.GetPayload())
 		}
 		return [][]byte{h}, nil
+	case *types.Block_UserAdministrationTxEnvelopes:
+		for i, tx := range block.GetUserAdministrationTxEnvelopes().GetEnvelopes() {
+			h, err := calculateTxHash(tx, block.GetHeader().GetValidationInfo()[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "can't calculate msg hash %v", tx.GetPayload())
+			}
+			hashes = append(hashes, h)
+		}
+		return hashes, nil
 	case *types.Block_DbAdministrationTxEnvelope:
 		dbTx := block.GetDbAdministrationTxEnvelope()
 		h, err := calculateTxHash(dbTx, block.GetHeader().GetValidationInfo()[0])
@@ -45,6 +54,24 @@ func calculateBlockTxHashes(block *types.Block) ([][]byte, error) {
 			return nil, errors.Wrapf(err, "can't calculate msg hash %v", configTx.GetPayload())
 		}
 		return [][]byte{h}, nil
+	case *types.Block_HeartbeatTxEnvelopes:
+		for i, tx := range block.GetHeartbeatTxEnvelopes().GetEnvelopes() {
+			h, err := calculateTxHash(tx, block.GetHeader().GetValidationInfo()[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "can't calculate msg hash %v", tx.GetPayload())
+			}
+			hashes = append(hashes, h)
+		}
+		return hashes, nil
+	case *types.Block_VoidTxEnvelopes:
+		for i, tx := range block.GetVoidTxEnvelopes().GetEnvelopes() {
+			h, err := calculateTxHash(tx, block.GetHeader().GetValidationInfo()[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "can't calculate msg hash %v", tx.GetPayload())
+			}
+			hashes = append(hashes, h)
+		}
+		return hashes, nil
 	default:
 		return nil, errors.Errorf("unexpected transaction envelope in the block")
 	}
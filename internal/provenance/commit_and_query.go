@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"math"
 	"sort"
 	"strings"
 
@@ -40,6 +41,10 @@ const (
 	// PREVIOUS edge from one to another
 	// denotes that the previous version of the value
 	PREVIOUS = "p"
+	// KEYS edge from dbName to key
+	// denotes that the key has been written
+	// in the db at least once
+	KEYS = "k"
 )
 
 // TxDataForProvenance holds the transaction data that is
@@ -61,6 +66,23 @@ type KeyWithVersion struct {
 	Version *types.Version
 }
 
+// VersionedValue holds the value of a key at a particular version, the id of the
+// transaction which wrote the value, and whether the value is still live, i.e.,
+// the key was neither updated nor deleted after the value was written
+type VersionedValue struct {
+	Value  *types.ValueWithMetadata
+	TxID   string
+	IsLive bool
+}
+
+// WrittenValue holds a value of a key along with the id of the transaction which wrote the value and the user who
+// submitted the transaction. Both ids are empty for a value recorded by a state sync, which has no transaction.
+type WrittenValue struct {
+	Value  *types.ValueWithMetadata
+	TxID   string
+	UserID string
+}
+
 // TxIDLocation refers to the location of a TxID
 // in the block
 type TxIDLocation struct {
@@ -77,6 +99,7 @@ type TxIDLocation struct {
 //  6. key--(version)-->value
 //  7. value<--(previous)--value
 //  8. value--(next)-->value
+//  9. dbName--(keys)-->key
 func (s *Store) Commit(blockNum uint64, txsData []*TxDataForProvenance) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -115,6 +138,31 @@ func (s *Store) Commit(blockNum uint64, txsData []*TxDataForProvenance) error {
 	return batch.Close()
 }
 
+// SyncedValue holds a value of a key that was written by a block whose transactions are not available, such as a
+// block that a state sync fast-forwarded over, along with the version of the key that the value replaced, if any
+type SyncedValue struct {
+	DBName     string
+	Write      *types.KVWithMetadata
+	OldVersion *types.Version
+}
+
+// CommitSyncedValues records values of keys that were written by blocks whose transactions are not available. Each
+// value is linked to the previous value of its key, if known, but not to any transaction, user, or block, hence the
+// history of a key skips the values written and replaced within the blocks.
+func (s *Store) CommitSyncedValues(values []*SyncedValue) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	batch := graph.NewWriter(s.cayleyGraph.QuadWriter)
+	for _, v := range values {
+		if _, err := s.addValue(v.DBName, v.Write, v.OldVersion, batch); err != nil {
+			return err
+		}
+	}
+
+	return batch.Close()
+}
+
 func (s *Store) addReads(tx *TxDataForProvenance, batch graph.BatchWriter) error {
 	for _, read := range tx.Reads {
 		value, err := s.getValueVertex(tx.DBName, read.Key, read.Version)
@@ -131,58 +179,70 @@ func (s *Store) addReads(tx *TxDataForProvenance, batch graph.BatchWriter) error
 
 func (s *Store) addWrites(tx *TxDataForProvenance, batch graph.BatchWriter) error {
 	for _, write := range tx.Writes {
-		actualKey := write.Key
-		write.Key = constructCompositeKey(tx.DBName, write.Key)
-		newValue, err := json.Marshal(write)
+		newValue, err := s.addValue(tx.DBName, write, tx.OldVersionOfWrites[write.Key], batch)
 		if err != nil {
 			return err
 		}
 
-		newVersion, err := json.Marshal(write.Metadata.Version)
-		if err != nil {
-			return err
-		}
-		s.logger.Debugf("key[%s]---(version[%s])--->value[%s]", write.Key, string(newVersion), string(newValue))
-		batch.WriteQuad(quad.Make(write.Key, string(newVersion), string(newValue), ""))
-
-		s.logger.Debugf("txID[%s]---(writes)--->value[%s]", tx.TxID, string(newValue))
-		batch.WriteQuad(quad.Make(tx.TxID, WRITES, string(newValue), ""))
-
-		oldVersion, ok := tx.OldVersionOfWrites[actualKey]
-		if !ok {
-			// old version would not have been passed if it was deleted in the worldstate database already
-			// but we can find the old version from the provenance store even if it was deleted already
-			s.logger.Debug("fetching last deleted version of key [" + actualKey + "] from db [" + tx.DBName + "]")
-			lastVer, err := s.getLastDeletedVersion(tx.DBName, actualKey)
-			if err != nil {
-				return err
-			}
-			if lastVer == nil {
-				s.logger.Debug("previous version of key [" + actualKey + "] does not exist in db [" + tx.DBName + "]")
-				continue
-			}
+		s.logger.Debugf("txID[%s]---(writes)--->value[%s]", tx.TxID, newValue)
+		batch.WriteQuad(quad.Make(tx.TxID, WRITES, newValue, ""))
+	}
 
-			oldVersion = lastVer
-		}
+	return nil
+}
 
-		oldValue, err := s.getValueVertex(tx.DBName, actualKey, oldVersion)
+// addValue adds the value of a write and links it to the value at the old version of the key. If the old version is
+// nil, the value is linked to the last deleted value of the key, if any.
+func (s *Store) addValue(dbName string, write *types.KVWithMetadata, oldVersion *types.Version, batch graph.BatchWriter) (string, error) {
+	actualKey := write.Key
+	write.Key = constructCompositeKey(dbName, write.Key)
+	newValue, err := json.Marshal(write)
+	if err != nil {
+		return "", err
+	}
+
+	newVersion, err := json.Marshal(write.Metadata.Version)
+	if err != nil {
+		return "", err
+	}
+	s.logger.Debugf("key[%s]---(version[%s])--->value[%s]", write.Key, string(newVersion), string(newValue))
+	batch.WriteQuad(quad.Make(write.Key, string(newVersion), string(newValue), ""))
+
+	if oldVersion == nil {
+		// old version would not have been passed if it was deleted in the worldstate database already
+		// but we can find the old version from the provenance store even if it was deleted already
+		s.logger.Debug("fetching last deleted version of key [" + actualKey + "] from db [" + dbName + "]")
+		lastVer, err := s.getLastDeletedVersion(dbName, actualKey)
 		if err != nil {
-			return err
+			return "", err
 		}
-
-		if oldValue == nil {
-			s.logger.Debugf("key [%s] version [%d,%d] for which oldValue is not found", actualKey, oldVersion.BlockNum, oldVersion.TxNum)
-			return errors.Errorf("error while finding the previous version of the key[%s]", write.Key)
+		if lastVer == nil {
+			s.logger.Debug("previous version of key [" + actualKey + "] does not exist in db [" + dbName + "]")
+			s.logger.Debugf("dbName[%s]---(keys)--->key[%s]", dbName, write.Key)
+			batch.WriteQuad(quad.Make(dbName, KEYS, write.Key, ""))
+			return string(newValue), nil
 		}
 
-		s.logger.Debugf("oldValue[%s]<---(previous)---newValue[%s]", quad.NativeOf(oldValue), string(newValue))
-		batch.WriteQuad(quad.Make(string(newValue), PREVIOUS, oldValue, ""))
+		oldVersion = lastVer
+	}
 
-		s.logger.Debugf("oldValue[%s]---(next)--->newValue[%s]", quad.NativeOf(oldValue), string(newValue))
-		batch.WriteQuad(quad.Make(oldValue, NEXT, string(newValue), ""))
+	oldValue, err := s.getValueVertex(dbName, actualKey, oldVersion)
+	if err != nil {
+		return "", err
 	}
 
-	return nil
+	if oldValue == nil {
+		s.logger.Debugf("key [%s] version [%d,%d] for which oldValue is not found", actualKey, oldVersion.BlockNum, oldVersion.TxNum)
+		return "", errors.Errorf("error while finding the previous version of the key[%s]", write.Key)
+	}
+
+	s.logger.Debugf("oldValue[%s]<---(previous)---newValue[%s]", quad.NativeOf(oldValue), string(newValue))
+	batch.WriteQuad(quad.Make(string(newValue), PREVIOUS, oldValue, ""))
+
+	s.logger.Debugf("oldValue[%s]---(next)--->newValue[%s]", quad.NativeOf(oldValue), string(newValue))
+	batch.WriteQuad(quad.Make(oldValue, NEXT, string(newValue), ""))
+
+	return string(newValue), nil
 }
 
 func (s *Store) addDeletes(tx *TxDataForProvenance, batch graph.BatchWriter) error {
@@ -220,6 +280,56 @@ func (s *Store) GetValues(dbName, key string) ([]*types.ValueWithMetadata, error
 	return verticesToValues(valueVertices)
 }
 
+// GetWrittenValues returns all values associated with a given key, in the order of their versions, along with the
+// transaction which wrote each value and the user who submitted it
+func (s *Store) GetWrittenValues(dbName, key string) ([]*WrittenValue, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	s.logger.Debugf("fetch all historical values and their writers associated with the key [%s] in db [%s]", key, dbName)
+	cKey := constructCompositeKey(dbName, key)
+	valueVertices, err := cayley.StartPath(s.cayleyGraph, quad.String(cKey)).Out().Iterate(context.Background()).AllValues(s.cayleyGraph)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []*WrittenValue
+	for _, valueVertex := range valueVertices {
+		v, err := vertexToValue(valueVertex)
+		if err != nil {
+			return nil, err
+		}
+		value := &WrittenValue{Value: v}
+
+		txIDVertex, err := cayley.StartPath(s.cayleyGraph, valueVertex).In(quad.String(WRITES)).Iterate(context.Background()).FirstValue(s.cayleyGraph)
+		if err != nil {
+			return nil, err
+		}
+		if txIDVertex != nil {
+			value.TxID = quad.ToString(txIDVertex)
+
+			userIDVertex, err := cayley.StartPath(s.cayleyGraph, txIDVertex).In(quad.String(SUBMITTED)).Iterate(context.Background()).FirstValue(s.cayleyGraph)
+			if err != nil {
+				return nil, err
+			}
+			if userIDVertex != nil {
+				value.UserID = quad.ToString(userIDVertex)
+			}
+		}
+
+		values = append(values, value)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		vi := values[i].Value.GetMetadata().GetVersion()
+		vj := values[j].Value.GetMetadata().GetVersion()
+		return vi.GetBlockNum() < vj.GetBlockNum() ||
+			(vi.GetBlockNum() == vj.GetBlockNum() && vi.GetTxNum() < vj.GetTxNum())
+	})
+
+	return values, nil
+}
+
 // GetPreviousValues returns previous values of a given key and a version. The number of records returned would be limited
 // by the limit parameters.
 func (s *Store) GetPreviousValues(dbName, key string, version *types.Version, limit int) ([]*types.ValueWithMetadata, error) {
@@ -256,6 +366,54 @@ func (s *Store) GetValueAt(dbName, key string, version *types.Version) (*types.V
 	return vertexToValue(valueVertex)
 }
 
+// GetVersionedValue returns the value of a given key at a particular version along with the transaction
+// which wrote it and whether it is still live. A nil value is returned if the key did not hold a value at
+// the version
+func (s *Store) GetVersionedValue(dbName, key string, version *types.Version) (*VersionedValue, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	s.logger.Debugf("fetch versioned value of key [%s] at version (%d, %d)", key, version.BlockNum, version.TxNum)
+	valueVertex, err := s.getValueVertex(dbName, key, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if valueVertex == nil {
+		return nil, nil
+	}
+
+	value, err := vertexToValue(valueVertex)
+	if err != nil {
+		return nil, err
+	}
+
+	txIDVertex, err := cayley.StartPath(s.cayleyGraph, valueVertex).In(quad.String(WRITES)).Iterate(context.Background()).FirstValue(s.cayleyGraph)
+	if err != nil {
+		return nil, err
+	}
+	if txIDVertex == nil {
+		return nil, errors.Errorf("error while finding the transaction which wrote the key [%s] at version (%d, %d)", key, version.BlockNum, version.TxNum)
+	}
+
+	// a value is superseded by the next version of the key, which links to the value even when the
+	// key was deleted in between, or by a transaction which deleted the key
+	nextVertex, err := cayley.StartPath(s.cayleyGraph, valueVertex).Out(quad.String(NEXT)).Iterate(context.Background()).FirstValue(s.cayleyGraph)
+	if err != nil {
+		return nil, err
+	}
+	deleterVertex, err := cayley.StartPath(s.cayleyGraph, valueVertex).In(quad.String(DELETES)).Iterate(context.Background()).FirstValue(s.cayleyGraph)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VersionedValue{
+		Value:  value,
+		TxID:   quad.ToString(txIDVertex),
+		IsLive: nextVertex == nil && deleterVertex == nil,
+	}, nil
+}
+
 // GetValuesReadByUser returns all values read by a given user
 func (s *Store) GetValuesReadByUser(userID string) (map[string]*types.KVsWithMetadata, error) {
 	s.mutex.RLock()
@@ -295,6 +453,22 @@ func (s *Store) GetValuesDeletedByUser(userID string) (map[string]*types.KVsWith
 	return s.outEdgesFrom(txIDs, DELETES)
 }
 
+// GetValuesWrittenByTx returns all values written by a given transaction
+func (s *Store) GetValuesWrittenByTx(txID string) (map[string]*types.KVsWithMetadata, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.outEdgesFrom([]string{txID}, WRITES)
+}
+
+// GetValuesDeletedByTx returns all values deleted by a given transaction
+func (s *Store) GetValuesDeletedByTx(txID string) (map[string]*types.KVsWithMetadata, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.outEdgesFrom([]string{txID}, DELETES)
+}
+
 // GetDeletedValues returns all deleted values associated with a given key present in the
 // given database name
 func (s *Store) GetDeletedValues(dbName, key string) ([]*types.ValueWithMetadata, error) {
@@ -441,6 +615,106 @@ func (s *Store) GetMostRecentValueAtOrBelow(dbName, key string, version *types.V
 	return nil, nil
 }
 
+// GetKeys returns, in sorted order, the keys of the given db which have been written at least once and which fall
+// in the range [startKey, endKey). An empty startKey or endKey denotes an open end of the range. It also returns the
+// number of keys of the db that were examined, which is the cost of the lookup. As the keys are not stored in order,
+// all the keys of the db are examined, and once the cost exceeds costLimit, the lookup stops and no keys are returned.
+func (s *Store) GetKeys(dbName, startKey, endKey string, costLimit uint64) ([]string, uint64, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	s.logger.Debugf("fetch all keys in the range [%s, %s) of db [%s]", startKey, endKey, dbName)
+	// one key beyond the limit is enough to tell that the limit is exceeded
+	limit := -1
+	if costLimit < math.MaxInt32 {
+		limit = int(costLimit) + 1
+	}
+	p := cayley.StartPath(s.cayleyGraph, quad.String(dbName)).Out(quad.String(KEYS))
+	keyVertices, err := p.Iterate(context.Background()).Limit(limit).AllValues(s.cayleyGraph)
+	if err != nil {
+		return nil, 0, err
+	}
+	cost := uint64(len(keyVertices))
+	if cost > costLimit {
+		return nil, cost, nil
+	}
+
+	var keys []string
+	for _, kv := range keyVertices {
+		_, key := splitCompositeKey(quad.ToString(kv))
+		if (startKey != "" && key < startKey) || (endKey != "" && key >= endKey) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, cost, nil
+}
+
+// GetValueAsOf returns the value held by the given key at the end of the given block, i.e., the most recent value
+// written at or below the block, provided that it was not deleted at or below the block. A nil value is returned
+// if the key did not exist at that point. It also returns the number of versions of the key that were examined,
+// which is the cost of the lookup.
+func (s *Store) GetValueAsOf(dbName, key string, blockNum uint64) (*types.ValueWithMetadata, uint64, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	s.logger.Debugf("fetch value of key [%s] in db [%s] as of block [%d]", key, dbName, blockNum)
+	cKey := constructCompositeKey(dbName, key)
+	valueVertices, err := cayley.StartPath(s.cayleyGraph, quad.String(cKey)).Out().Iterate(context.Background()).AllValues(s.cayleyGraph)
+	if err != nil {
+		return nil, 0, err
+	}
+	cost := uint64(len(valueVertices))
+
+	var latest *types.ValueWithMetadata
+	var latestVertex quad.Value
+	for _, vertex := range valueVertices {
+		v, err := vertexToValue(vertex)
+		if err != nil {
+			return nil, cost, err
+		}
+
+		ver := v.GetMetadata().GetVersion()
+		if ver.GetBlockNum() > blockNum {
+			continue
+		}
+
+		if latest == nil ||
+			ver.GetBlockNum() > latest.Metadata.Version.BlockNum ||
+			(ver.GetBlockNum() == latest.Metadata.Version.BlockNum && ver.GetTxNum() > latest.Metadata.Version.TxNum) {
+			latest = v
+			latestVertex = vertex
+		}
+	}
+
+	if latest == nil {
+		return nil, cost, nil
+	}
+
+	// the value is not visible if the transaction which deleted it was committed at or below the block. The
+	// deleting transaction cannot precede the value it deletes.
+	p := cayley.StartPath(s.cayleyGraph, latestVertex).In(quad.String(DELETES)).In(quad.String(INCLUDES))
+	locVertices, err := p.Iterate(context.Background()).AllValues(s.cayleyGraph)
+	if err != nil {
+		return nil, cost, err
+	}
+
+	for _, locVertex := range locVertices {
+		loc, err := vertexToTxIDLocation(locVertex)
+		if err != nil {
+			return nil, cost, err
+		}
+
+		if loc.BlockNum >= latest.Metadata.Version.BlockNum && loc.BlockNum <= blockNum {
+			return nil, cost, nil
+		}
+	}
+
+	return latest, cost, nil
+}
+
 func (s *Store) getLastDeletedVersion(dbName, key string) (*types.Version, error) {
 	valuesWithMetadata, err := s.getDeletedValuesWithoutLock(dbName, key)
 	if err != nil {
@@ -581,6 +855,6 @@ func constructCompositeKey(dbName, key string) string {
 }
 
 func splitCompositeKey(dbNameKey string) (dbName string, key string) {
-	strs := strings.Split(dbNameKey, separator)
+	strs := strings.SplitN(dbNameKey, separator, 2)
 	return strs[0], strs[1]
 }
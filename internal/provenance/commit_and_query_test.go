@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/stretchr/testify/require"
@@ -409,6 +410,149 @@ func TestGetValueAt(t *testing.T) {
 	}
 }
 
+func TestGetVersionedValue(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	setup(t, env.s)
+
+	tests := []struct {
+		name          string
+		dbName        string
+		key           string
+		version       *types.Version
+		expectedValue *VersionedValue
+	}{
+		{
+			name:   "live value",
+			dbName: "db1",
+			key:    "key2",
+			version: &types.Version{
+				BlockNum: 3,
+				TxNum:    0,
+			},
+			expectedValue: &VersionedValue{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value2"),
+					Metadata: &types.Metadata{
+						AccessControl: &types.AccessControl{
+							ReadWriteUsers: map[string]bool{
+								"user1": true,
+								"user2": true,
+							},
+						},
+						Version: &types.Version{
+							BlockNum: 3,
+							TxNum:    0,
+						},
+					},
+				},
+				TxID:   "tx5",
+				IsLive: true,
+			},
+		},
+		{
+			name:   "superseded value",
+			dbName: "db1",
+			key:    "key1",
+			version: &types.Version{
+				BlockNum: 1,
+				TxNum:    0,
+			},
+			expectedValue: &VersionedValue{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value1"),
+					Metadata: &types.Metadata{
+						Version: &types.Version{
+							BlockNum: 1,
+							TxNum:    0,
+						},
+					},
+				},
+				TxID: "tx1",
+			},
+		},
+		{
+			name:   "deleted value which was written again later",
+			dbName: "db1",
+			key:    "key1",
+			version: &types.Version{
+				BlockNum: 3,
+				TxNum:    0,
+			},
+			expectedValue: &VersionedValue{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value4"),
+					Metadata: &types.Metadata{
+						Version: &types.Version{
+							BlockNum: 3,
+							TxNum:    0,
+						},
+					},
+				},
+				TxID: "tx5",
+			},
+		},
+		{
+			name:   "last value of a deleted key",
+			dbName: "db1",
+			key:    "key1",
+			version: &types.Version{
+				BlockNum: 5,
+				TxNum:    0,
+			},
+			expectedValue: &VersionedValue{
+				Value: &types.ValueWithMetadata{
+					Value: []byte("value5"),
+					Metadata: &types.Metadata{
+						Version: &types.Version{
+							BlockNum: 5,
+							TxNum:    0,
+						},
+					},
+				},
+				TxID: "tx6",
+			},
+		},
+		{
+			name:   "missing version",
+			dbName: "db1",
+			key:    "key1",
+			version: &types.Version{
+				BlockNum: 4,
+				TxNum:    0,
+			},
+			expectedValue: nil,
+		},
+		{
+			name:   "non-existing key",
+			dbName: "db2",
+			key:    "key2",
+			version: &types.Version{
+				BlockNum: 1,
+				TxNum:    0,
+			},
+			expectedValue: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := env.s.GetVersionedValue(tt.dbName, tt.key, tt.version)
+			require.NoError(t, err)
+			if tt.expectedValue == nil {
+				require.Nil(t, value)
+				return
+			}
+			require.Equal(t, tt.expectedValue.TxID, value.TxID)
+			require.Equal(t, tt.expectedValue.IsLive, value.IsLive)
+			require.True(t, proto.Equal(tt.expectedValue.Value, value.Value))
+		})
+	}
+}
+
 func TestGetValues(t *testing.T) {
 	t.Parallel()
 	env := newTestEnv(t)
@@ -520,6 +664,57 @@ func TestGetValues(t *testing.T) {
 	}
 }
 
+func TestGetWrittenValues(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	setup(t, env.s)
+
+	// key1 of db1 is written in blocks 1 and 2 by user1, and in blocks 3 and 5 by user2
+	values, err := env.s.GetWrittenValues("db1", "key1")
+	require.NoError(t, err)
+
+	expected := []struct {
+		blockNum uint64
+		txID     string
+		userID   string
+	}{
+		{blockNum: 1, txID: "tx1", userID: "user1"},
+		{blockNum: 2, txID: "tx3", userID: "user1"},
+		{blockNum: 3, txID: "tx5", userID: "user2"},
+		{blockNum: 5, txID: "tx6", userID: "user2"},
+	}
+	require.Len(t, values, len(expected))
+	for i, e := range expected {
+		require.Equal(t, e.blockNum, values[i].Value.GetMetadata().GetVersion().GetBlockNum())
+		require.Equal(t, e.txID, values[i].TxID)
+		require.Equal(t, e.userID, values[i].UserID)
+	}
+
+	// a value recorded by a state sync has no writer
+	require.NoError(t, env.s.CommitSyncedValues([]*SyncedValue{
+		{
+			DBName: "db3",
+			Write: &types.KVWithMetadata{
+				Key:      "key1",
+				Value:    []byte("synced"),
+				Metadata: &types.Metadata{Version: &types.Version{BlockNum: 7}},
+			},
+		},
+	}))
+	values, err = env.s.GetWrittenValues("db3", "key1")
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	require.Equal(t, []byte("synced"), values[0].Value.GetValue())
+	require.Empty(t, values[0].TxID)
+	require.Empty(t, values[0].UserID)
+
+	values, err = env.s.GetWrittenValues("db1", "key100")
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
 func TestGetTxSubmittedByUser(t *testing.T) {
 	t.Parallel()
 	env := newTestEnv(t)
@@ -1522,3 +1717,141 @@ func TestGetMostRecentValueAtOrBelow(t *testing.T) {
 		})
 	}
 }
+
+func TestGetKeys(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	setup(t, env.s)
+
+	tests := []struct {
+		name         string
+		dbName       string
+		startKey     string
+		endKey       string
+		expectedKeys []string
+	}{
+		{
+			name:         "all keys including deleted ones",
+			dbName:       "db1",
+			expectedKeys: []string{"key1", "key2"},
+		},
+		{
+			name:         "keys from the start key",
+			dbName:       "db1",
+			startKey:     "key2",
+			expectedKeys: []string{"key2"},
+		},
+		{
+			name:         "keys till before the end key",
+			dbName:       "db1",
+			endKey:       "key2",
+			expectedKeys: []string{"key1"},
+		},
+		{
+			name:         "keys of another db",
+			dbName:       "db2",
+			expectedKeys: []string{"key1"},
+		},
+		{
+			name:   "db does not exist",
+			dbName: "db3",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			keys, _, err := env.s.GetKeys(tt.dbName, tt.startKey, tt.endKey, 100)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedKeys, keys)
+		})
+	}
+
+	t.Run("every key of the db is examined", func(t *testing.T) {
+		keys, cost, err := env.s.GetKeys("db1", "key2", "", 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{"key2"}, keys)
+		require.Equal(t, uint64(2), cost)
+
+		keys, cost, err = env.s.GetKeys("db1", "key2", "", 1)
+		require.NoError(t, err)
+		require.Nil(t, keys)
+		require.Equal(t, uint64(2), cost)
+	})
+}
+
+func TestGetValueAsOf(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	setup(t, env.s)
+
+	valueAt := func(blockNum uint64) *types.ValueWithMetadata {
+		v, err := env.s.GetValueAt("db1", "key1", &types.Version{BlockNum: blockNum, TxNum: 0})
+		require.NoError(t, err)
+		require.NotNil(t, v)
+		return v
+	}
+
+	// key1 of db1 is written in blocks 1, 2, 3, and 5, and deleted in blocks 4 and 6
+	tests := []struct {
+		name          string
+		blockNum      uint64
+		expectedValue *types.ValueWithMetadata
+	}{
+		{
+			name:          "value written in the block",
+			blockNum:      2,
+			expectedValue: valueAt(2),
+		},
+		{
+			name:          "value written in a previous block",
+			blockNum:      3,
+			expectedValue: valueAt(3),
+		},
+		{
+			name:     "value deleted in the block",
+			blockNum: 4,
+		},
+		{
+			name:          "value written again after a delete",
+			blockNum:      5,
+			expectedValue: valueAt(5),
+		},
+		{
+			name:     "value deleted in a previous block",
+			blockNum: 7,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			value, cost, err := env.s.GetValueAsOf("db1", "key1", tt.blockNum)
+			require.NoError(t, err)
+			require.Equal(t, uint64(4), cost)
+			if tt.expectedValue == nil {
+				require.Nil(t, value)
+				return
+			}
+			require.True(t, proto.Equal(tt.expectedValue, value))
+		})
+	}
+
+	value, cost, err := env.s.GetValueAsOf("db1", "key3", 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), cost)
+	require.Nil(t, value)
+}
+
+func TestCompositeKeyWithSeparator(t *testing.T) {
+	cKey := constructCompositeKey("db1", "key$with$separators")
+	require.Equal(t, "db1$key$with$separators", cKey)
+
+	dbName, key := splitCompositeKey(cKey)
+	require.Equal(t, "db1", dbName)
+	require.Equal(t, "key$with$separators", key)
+}
@@ -3,15 +3,19 @@
 package provenance
 
 import (
+	"context"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/cayleygraph/cayley"
 	"github.com/cayleygraph/cayley/graph"
 	"github.com/cayleygraph/cayley/graph/kv"
 	db "github.com/cayleygraph/cayley/graph/kv/leveldb"
+	"github.com/cayleygraph/quad"
 	"github.com/hidal-go/hidalgo/kv/flat/leveldb"
 	"github.com/hyperledger-labs/orion-server/internal/fileops"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
@@ -37,6 +41,10 @@ var (
 	// disabled is used to mark that the provenance store is disabled.
 	// Once disabled, the provenance store cannot be er-enabled.
 	disabledFlag = "disabled"
+
+	// keysIndexedFlag is used to mark that every key in the provenance store has its KEYS edge. A store created
+	// by a node that predates the edge lacks the flag, and the edges of its keys are added once, when it is opened.
+	keysIndexedFlag = "keysindexed"
 )
 
 // Store holds information about the provenance store, i.e., a
@@ -134,6 +142,10 @@ func openNewProvenanceStore(c *Config) (*Store, error) {
 		return nil, err
 	}
 
+	if err := fileops.CreateFile(filepath.Join(c.StoreDir, keysIndexedFlag)); err != nil {
+		return nil, err
+	}
+
 	if err := fileops.Remove(underCreationFlagPath); err != nil {
 		return nil, errors.WithMessagef(err, "error while removing the under creation flag [%s]", underCreationFlagPath)
 	}
@@ -160,11 +172,70 @@ func openExistingLevelDBInstance(c *Config) (*Store, error) {
 		return nil, err
 	}
 
-	return &Store{
+	s := &Store{
 		rootDir:     c.StoreDir,
 		cayleyGraph: cayleyGraph,
 		logger:      c.Logger,
-	}, nil
+	}
+
+	keysIndexedFlagPath := filepath.Join(c.StoreDir, keysIndexedFlag)
+	exists, err = fileops.Exists(keysIndexedFlagPath)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while checking keys indexed flag: %s", keysIndexedFlagPath)
+	}
+	if !exists {
+		if err := s.indexKeys(); err != nil {
+			cayleyGraph.Close()
+			return nil, errors.WithMessage(err, "error while adding the KEYS edges of the keys written before the upgrade")
+		}
+		if err := fileops.CreateFile(keysIndexedFlagPath); err != nil {
+			cayleyGraph.Close()
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// indexKeys adds the missing dbName--(keys)-->key edges, i.e., the edges of the keys that were first written by a
+// node that predates the edge. Every key has a key--(version)-->value edge per value, whose predicate is the JSON
+// encoding of the version, hence, the keys are found by a single scan of all the edges.
+func (s *Store) indexKeys() error {
+	written := make(map[string]struct{})
+	indexed := make(map[string]struct{})
+	err := graph.Iterate(context.Background(), s.cayleyGraph.QuadsAllIterator()).Each(func(ref graph.Ref) {
+		q := s.cayleyGraph.Quad(ref)
+		predicate := quad.ToString(q.Predicate)
+		switch {
+		case predicate == KEYS:
+			indexed[quad.ToString(q.Object)] = struct{}{}
+		case strings.HasPrefix(predicate, "{"):
+			written[quad.ToString(q.Subject)] = struct{}{}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for cKey := range written {
+		if _, ok := indexed[cKey]; !ok {
+			missing = append(missing, cKey)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+
+	s.logger.Infof("adding the KEYS edges of %d keys written before the upgrade of the provenance store", len(missing))
+	batch := graph.NewWriter(s.cayleyGraph.QuadWriter)
+	for _, cKey := range missing {
+		dbName, _ := splitCompositeKey(cKey)
+		batch.WriteQuad(quad.Make(dbName, KEYS, cKey, ""))
+	}
+
+	return batch.Close()
 }
 
 // Close closes the database instance by closing all leveldb databases
@@ -275,4 +275,48 @@ func TestOpenStore(t *testing.T) {
 		require.Len(t, quadValues, 4)
 		require.ElementsMatch(t, expectedNodes, quadValues)
 	})
+
+	t.Run("reopen a store written before the KEYS edges", func(t *testing.T) {
+		t.Parallel()
+
+		testDir, err := ioutil.TempDir("", "opentest")
+		require.NoError(t, err)
+		defer os.RemoveAll(testDir)
+
+		storeDir := filepath.Join(testDir, "reopen-store-without-keys")
+		c := &Config{
+			StoreDir: storeDir,
+			Logger:   logger,
+		}
+		s, err := Open(c)
+		require.NoError(t, err)
+		require.FileExists(t, filepath.Join(storeDir, keysIndexedFlag))
+
+		setup(t, s)
+		keys, _, err := s.GetKeys("db1", "", "", 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{"key1", "key2"}, keys)
+
+		// a node that predates the edges wrote neither the edges nor the flag
+		for _, key := range []string{"db1$key1", "db1$key2"} {
+			require.NoError(t, s.cayleyGraph.RemoveQuad(quad.Make("db1", KEYS, key, "")))
+		}
+		require.NoError(t, fileops.Remove(filepath.Join(storeDir, keysIndexedFlag)))
+		keys, _, err = s.GetKeys("db1", "", "", 100)
+		require.NoError(t, err)
+		require.Nil(t, keys)
+
+		require.NoError(t, s.Close())
+		s, err = Open(c)
+		require.NoError(t, err)
+		defer s.Close()
+		require.FileExists(t, filepath.Join(storeDir, keysIndexedFlag))
+
+		keys, _, err = s.GetKeys("db1", "", "", 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{"key1", "key2"}, keys)
+		keys, _, err = s.GetKeys("db2", "", "", 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{"key1"}, keys)
+	})
 }
@@ -0,0 +1,301 @@
+package queryexecutor
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultIntersectionCap is the default maximum estimated number of index entries scanned to
+	// intersect the keys of a condition with the candidate keys
+	defaultIntersectionCap = 10000
+	// maxEstimatedValues is the maximum number of distinct values whose counts are summed up to
+	// estimate the number of index entries matching the conditions on an attribute. Beyond it, the
+	// number of index entries of the attribute is used as the estimate.
+	maxEstimatedValues = 256
+)
+
+type conjunctionStep struct {
+	attribute string
+	conds     *attributeTypeAndConditions
+	estimate  uint64
+}
+
+// executeConjunction executes a conjunction of conditions on attributes. The conditions of the
+// indexed attributes are executed in the ascending order of the estimated number of matching index
+// entries. The most selective condition produces the candidate keys, and each of the following
+// conditions narrows down the candidates as long as its estimate is within the intersection cap.
+// The remaining conditions, as well as the conditions of the attributes which are not indexed, are
+// verified against the values of the candidate keys.
+func (e *WorldStateJSONQueryExecutor) executeConjunction(ctx context.Context, dbName string, indexed, unindexed attributeToConditions) (*QueryResult, error) {
+	steps, err := e.planConjunction(dbName, indexed)
+	if err != nil {
+		return nil, err
+	}
+
+	postFiltered := make(attributeToConditions)
+	for attr, conds := range unindexed {
+		postFiltered[attr] = conds
+	}
+	var intersected []*conjunctionStep
+	for _, s := range steps[1:] {
+		if s.estimate > e.intersectionCap {
+			postFiltered[s.attribute] = s.conds
+			continue
+		}
+		intersected = append(intersected, s)
+	}
+
+	result := &QueryResult{}
+	for attr := range postFiltered {
+		result.PostFilteredAttributes = append(result.PostFilteredAttributes, attr)
+	}
+	sort.Strings(result.PostFilteredAttributes)
+
+	keys, err := e.execute(ctx, dbName, steps[0].attribute, cloneConditions(steps[0].conds))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range intersected {
+		if len(keys) == 0 {
+			break
+		}
+
+		if keys, err = e.executeWithin(ctx, dbName, s.attribute, cloneConditions(s.conds), keys); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(keys) > 0 && len(postFiltered) > 0 {
+		if keys, err = e.postFilter(ctx, dbName, keys, postFiltered); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(keys) > 0 {
+		result.Keys = keys
+	}
+	return result, nil
+}
+
+// planConjunction orders the conditions of the indexed attributes by the estimated number of index
+// entries matching them, breaking ties by the name of the attribute
+func (e *WorldStateJSONQueryExecutor) planConjunction(dbName string, indexed attributeToConditions) ([]*conjunctionStep, error) {
+	var steps []*conjunctionStep
+	for attr, conds := range indexed {
+		estimate, err := e.estimate(dbName, attr, conds)
+		if err != nil {
+			return nil, err
+		}
+
+		steps = append(steps, &conjunctionStep{
+			attribute: attr,
+			conds:     conds,
+			estimate:  estimate,
+		})
+	}
+
+	sort.Slice(steps, func(i, j int) bool {
+		if steps[i].estimate != steps[j].estimate {
+			return steps[i].estimate < steps[j].estimate
+		}
+		return steps[i].attribute < steps[j].attribute
+	})
+
+	e.logger.Debugf("plan of the conjunction on the database [%s]:", dbName)
+	for _, s := range steps {
+		e.logger.Debugf("  attribute [%s] with an estimate of [%d] index entries", s.attribute, s.estimate)
+	}
+	return steps, nil
+}
+
+// estimate returns the estimated number of index entries matching the conditions on the attribute,
+// which is the sum of the counts of the values in the range of the conditions. The values excluded
+// by $neq are not subtracted, hence a $neq condition is estimated as a full scan.
+func (e *WorldStateJSONQueryExecutor) estimate(dbName, attribute string, conds *attributeTypeAndConditions) (uint64, error) {
+	plan, err := createQueryPlan(attribute, cloneConditions(conds))
+	if err != nil {
+		return 0, err
+	}
+
+	startKey, err := stateindex.ValueCardinalityKey(plan.startKey)
+	if err != nil {
+		return 0, err
+	}
+	endKey, err := stateindex.ValueCardinalityKey(plan.endKey)
+	if err != nil {
+		return 0, err
+	}
+
+	iter, err := e.db.GetIterator(stateindex.IndexDB(dbName), startKey, endKey)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Release()
+
+	var estimate uint64
+	for numValues := 0; iter.Next(); numValues++ {
+		if numValues == maxEstimatedValues {
+			return e.cardinality(dbName, stateindex.AttributeCardinalityKey(attribute))
+		}
+
+		persisted := &types.ValueWithMetadata{}
+		if err := proto.Unmarshal(iter.Value(), persisted); err != nil {
+			return 0, err
+		}
+		count, err := stateindex.DecodeCardinality(persisted.Value)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error while decoding the count of [%s]", iter.Key())
+		}
+		estimate += count
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	return estimate, nil
+}
+
+func (e *WorldStateJSONQueryExecutor) cardinality(dbName, key string) (uint64, error) {
+	v, _, err := e.db.Get(stateindex.IndexDB(dbName), key)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := stateindex.DecodeCardinality(v)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error while decoding the count of [%s]", key)
+	}
+	return count, nil
+}
+
+// postFilter returns the keys whose values match the conditions on all the given attributes
+func (e *WorldStateJSONQueryExecutor) postFilter(ctx context.Context, dbName string, keys map[string]bool, attrsConds attributeToConditions) (map[string]bool, error) {
+	matched := make(map[string]bool)
+
+	for k := range keys {
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		default:
+			value, _, err := e.db.Get(dbName, k)
+			if err != nil {
+				return nil, err
+			}
+
+			if valueMatches(value, attrsConds) {
+				matched[k] = true
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+// valueMatches returns true if, for each attribute, the value holds a value of the attribute which
+// matches all the conditions on the attribute, which is how an index evaluates them
+func valueMatches(value []byte, attrsConds attributeToConditions) bool {
+	for attr, conds := range attrsConds {
+		found := false
+		for _, v := range stateindex.AttributeValues(value, attr, conds.valueType) {
+			if attributeValueMatches(v, conds.conditions) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func attributeValueMatches(v interface{}, conditions map[string]interface{}) bool {
+	for opr, c := range conditions {
+		switch opr {
+		case constants.QueryOpEqual:
+			if compareValues(v, c) != 0 {
+				return false
+			}
+		case constants.QueryOpGreaterThan:
+			if compareValues(v, c) <= 0 {
+				return false
+			}
+		case constants.QueryOpGreaterThanOrEqual:
+			if compareValues(v, c) < 0 {
+				return false
+			}
+		case constants.QueryOpLesserThan:
+			if compareValues(v, c) >= 0 {
+				return false
+			}
+		case constants.QueryOpLesserThanOrEqual:
+			if compareValues(v, c) > 0 {
+				return false
+			}
+		case constants.QueryOpNotEqual:
+			switch excluded := c.(type) {
+			case []string:
+				for _, x := range excluded {
+					if compareValues(v, x) == 0 {
+						return false
+					}
+				}
+			case []bool:
+				for _, x := range excluded {
+					if compareValues(v, x) == 0 {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// compareValues compares two values of the same type in the order of the index entries: numbers
+// are encoded to strings which sort in the order of the numbers, and false sorts before true
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case string:
+		b := b.(string)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case bool:
+		b := b.(bool)
+		switch {
+		case !a && b:
+			return -1
+		case a && !b:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// cloneConditions returns a copy of the conditions which the planning of a range query can modify
+func cloneConditions(conds *attributeTypeAndConditions) *attributeTypeAndConditions {
+	c := &attributeTypeAndConditions{
+		valueType:  conds.valueType,
+		conditions: make(map[string]interface{}, len(conds.conditions)),
+	}
+	for opr, v := range conds.conditions {
+		c.conditions[opr] = v
+	}
+	return c
+}
@@ -0,0 +1,435 @@
+package queryexecutor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupDBForTestingConjunctions creates the database with the given index, and commits the values
+// along with their index entries and counts
+func setupDBForTestingConjunctions(t *testing.T, db worldstate.DB, dbName string, indexDef map[string]types.IndexAttributeType, values map[string][]byte) {
+	marshaledIndexDef, err := json.Marshal(indexDef)
+	require.NoError(t, err)
+
+	require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: dbName, Value: marshaledIndexDef},
+				{Key: stateindex.IndexDB(dbName)},
+			},
+		},
+	}, 1))
+
+	dbUpdates := &worldstate.DBUpdates{}
+	for k, v := range values {
+		dbUpdates.Writes = append(dbUpdates.Writes, &worldstate.KVWithMetadata{Key: k, Value: v})
+	}
+	updates := map[string]*worldstate.DBUpdates{dbName: dbUpdates}
+	indexUpdates, err := stateindex.ConstructIndexEntries(updates, db)
+	require.NoError(t, err)
+	for indexDB, u := range indexUpdates {
+		updates[indexDB] = u
+	}
+	require.NoError(t, db.Commit(updates, 2))
+}
+
+func newConjunctionTestExecutor(t *testing.T, env *testEnv, dbName string) *WorldStateJSONQueryExecutor {
+	snapshots, err := env.db.GetDBsSnapshot([]string{worldstate.DatabasesDBName, dbName, stateindex.IndexDB(dbName)})
+	require.NoError(t, err)
+	t.Cleanup(snapshots.Release)
+
+	return NewWorldStateJSONQueryExecutor(snapshots, env.l)
+}
+
+// fiftyItems are 45 blue and 5 red items of sizes 0 to 49, where the red ones are the items of
+// sizes 0, 10, 20, 30, and 40
+func fiftyItems() map[string][]byte {
+	values := make(map[string][]byte)
+	for i := 0; i < 50; i++ {
+		color := "blue"
+		if i%10 == 0 {
+			color = "red"
+		}
+		values[fmt.Sprintf("item%02d", i)] = []byte(fmt.Sprintf(`{"color":"%s","size":%d,"round":%t}`, color, i, i%2 == 0))
+	}
+	return values
+}
+
+var fiftyItemsIndex = map[string]types.IndexAttributeType{
+	"color": types.IndexAttributeType_STRING,
+	"size":  types.IndexAttributeType_NUMBER,
+}
+
+func TestPlanConjunction(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	dbName := "testdb"
+	setupDBForTestingConjunctions(t, env.db, dbName, fiftyItemsIndex, fiftyItems())
+	qExecutor := newConjunctionTestExecutor(t, env, dbName)
+
+	tests := []struct {
+		name              string
+		attrsConds        attributeToConditions
+		expectedAttrs     []string
+		expectedEstimates []uint64
+	}{
+		{
+			name: "equal on a rare value comes first",
+			attrsConds: attributeToConditions{
+				"color": {
+					valueType:  types.IndexAttributeType_STRING,
+					conditions: map[string]interface{}{"$eq": "red"},
+				},
+				"size": {
+					valueType:  types.IndexAttributeType_NUMBER,
+					conditions: map[string]interface{}{"$gte": stateindex.EncodeInt64(10)},
+				},
+			},
+			expectedAttrs:     []string{"color", "size"},
+			expectedEstimates: []uint64{5, 40},
+		},
+		{
+			name: "narrow range comes first",
+			attrsConds: attributeToConditions{
+				"color": {
+					valueType:  types.IndexAttributeType_STRING,
+					conditions: map[string]interface{}{"$eq": "blue"},
+				},
+				"size": {
+					valueType: types.IndexAttributeType_NUMBER,
+					conditions: map[string]interface{}{
+						"$gt": stateindex.EncodeInt64(10),
+						"$lt": stateindex.EncodeInt64(14),
+					},
+				},
+			},
+			expectedAttrs:     []string{"size", "color"},
+			expectedEstimates: []uint64{3, 45},
+		},
+		{
+			name: "not equal is estimated as a full scan",
+			attrsConds: attributeToConditions{
+				"color": {
+					valueType:  types.IndexAttributeType_STRING,
+					conditions: map[string]interface{}{"$neq": []string{"blue"}},
+				},
+				"size": {
+					valueType:  types.IndexAttributeType_NUMBER,
+					conditions: map[string]interface{}{"$lte": stateindex.EncodeInt64(48)},
+				},
+			},
+			expectedAttrs:     []string{"size", "color"},
+			expectedEstimates: []uint64{49, 50},
+		},
+		{
+			name: "ties are broken by the attribute",
+			attrsConds: attributeToConditions{
+				"size": {
+					valueType:  types.IndexAttributeType_NUMBER,
+					conditions: map[string]interface{}{"$eq": stateindex.EncodeInt64(100)},
+				},
+				"color": {
+					valueType:  types.IndexAttributeType_STRING,
+					conditions: map[string]interface{}{"$eq": "green"},
+				},
+			},
+			expectedAttrs:     []string{"color", "size"},
+			expectedEstimates: []uint64{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			steps, err := qExecutor.planConjunction(dbName, tt.attrsConds)
+			require.NoError(t, err)
+
+			var attrs []string
+			var estimates []uint64
+			for _, s := range steps {
+				attrs = append(attrs, s.attribute)
+				estimates = append(estimates, s.estimate)
+			}
+			require.Equal(t, tt.expectedAttrs, attrs)
+			require.Equal(t, tt.expectedEstimates, estimates)
+		})
+	}
+
+	t.Run("planning does not modify the conditions", func(t *testing.T) {
+		attrsConds := attributeToConditions{
+			"color": {
+				valueType:  types.IndexAttributeType_STRING,
+				conditions: map[string]interface{}{"$neq": []string{"blue"}},
+			},
+		}
+		_, err := qExecutor.planConjunction(dbName, attrsConds)
+		require.NoError(t, err)
+		require.Equal(t, []string{"blue"}, attrsConds["color"].conditions["$neq"])
+	})
+}
+
+func TestEstimateBeyondMaxEstimatedValues(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	values := make(map[string][]byte)
+	for i := 0; i < maxEstimatedValues+50; i++ {
+		values[fmt.Sprintf("item%03d", i)] = []byte(fmt.Sprintf(`{"size":%d}`, i))
+	}
+	dbName := "testdb"
+	setupDBForTestingConjunctions(t, env.db, dbName, map[string]types.IndexAttributeType{"size": types.IndexAttributeType_NUMBER}, values)
+	qExecutor := newConjunctionTestExecutor(t, env, dbName)
+
+	estimate, err := qExecutor.estimate(dbName, "size", &attributeTypeAndConditions{
+		valueType:  types.IndexAttributeType_NUMBER,
+		conditions: map[string]interface{}{"$lt": stateindex.EncodeInt64(maxEstimatedValues)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(maxEstimatedValues), estimate)
+
+	// the counts of too many distinct values are not summed up, the attribute is estimated as a whole
+	estimate, err = qExecutor.estimate(dbName, "size", &attributeTypeAndConditions{
+		valueType:  types.IndexAttributeType_NUMBER,
+		conditions: map[string]interface{}{"$lt": stateindex.EncodeInt64(maxEstimatedValues + 10)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(maxEstimatedValues+50), estimate)
+}
+
+func TestExecuteConjunction(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	dbName := "testdb"
+	setupDBForTestingConjunctions(t, env.db, dbName, fiftyItemsIndex, fiftyItems())
+
+	tests := []struct {
+		name                 string
+		intersectionCap      uint64
+		query                string
+		expectedKeys         map[string]bool
+		expectedPostFiltered []string
+	}{
+		{
+			name:            "all indexed conditions are intersected within the cap",
+			intersectionCap: defaultIntersectionCap,
+			query:           `{"selector":{"$and":{"color":{"$eq":"blue"},"size":{"$lt":5}}}}`,
+			expectedKeys: map[string]bool{
+				"item01": true, "item02": true, "item03": true, "item04": true,
+			},
+		},
+		{
+			name:            "the condition beyond the cap is post-filtered",
+			intersectionCap: 10,
+			query:           `{"selector":{"$and":{"color":{"$eq":"blue"},"size":{"$lt":5}}}}`,
+			expectedKeys: map[string]bool{
+				"item01": true, "item02": true, "item03": true, "item04": true,
+			},
+			expectedPostFiltered: []string{"color"},
+		},
+		{
+			name:            "the most selective condition is not capped",
+			intersectionCap: 1,
+			query:           `{"selector":{"color":{"$eq":"red"},"size":{"$gt":5}}}`,
+			expectedKeys: map[string]bool{
+				"item10": true, "item20": true, "item30": true, "item40": true,
+			},
+			expectedPostFiltered: []string{"size"},
+		},
+		{
+			name:            "unindexed attributes are post-filtered",
+			intersectionCap: defaultIntersectionCap,
+			query:           `{"selector":{"$and":{"color":{"$eq":"red"},"round":{"$eq":true},"shape":{"$neq":["square"]}}}}`,
+			expectedKeys:    nil,
+			// no item has a shape
+			expectedPostFiltered: []string{"round", "shape"},
+		},
+		{
+			name:            "unindexed attribute with a range",
+			intersectionCap: defaultIntersectionCap,
+			query:           `{"selector":{"color":{"$eq":"red"},"round":{"$gte":true}}}`,
+			expectedKeys: map[string]bool{
+				"item00": true, "item10": true, "item20": true, "item30": true, "item40": true,
+			},
+			expectedPostFiltered: []string{"round"},
+		},
+		{
+			name:                 "no key matches the driving condition",
+			intersectionCap:      defaultIntersectionCap,
+			query:                `{"selector":{"color":{"$eq":"green"},"size":{"$lt":5},"round":{"$eq":true}}}`,
+			expectedKeys:         nil,
+			expectedPostFiltered: []string{"round"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			qExecutor := newConjunctionTestExecutor(t, env, dbName)
+			qExecutor.intersectionCap = tt.intersectionCap
+
+			result, err := qExecutor.Execute(context.Background(), dbName, []byte(tt.query))
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedKeys, result.Keys)
+			require.Equal(t, tt.expectedPostFiltered, result.PostFilteredAttributes)
+		})
+	}
+
+	t.Run("unindexed attributes are not allowed in a disjunction", func(t *testing.T) {
+		qExecutor := newConjunctionTestExecutor(t, env, dbName)
+		_, err := qExecutor.Execute(context.Background(), dbName, []byte(`{"selector":{"$or":{"color":{"$eq":"red"},"round":{"$eq":true}}}}`))
+		require.EqualError(t, err, "attribute [round] given in the query condition is not indexed")
+	})
+
+	t.Run("the type of an unindexed attribute cannot be inferred", func(t *testing.T) {
+		qExecutor := newConjunctionTestExecutor(t, env, dbName)
+		_, err := qExecutor.Execute(context.Background(), dbName, []byte(`{"selector":{"color":{"$eq":"red"},"round":{"$neq":[]}}}`))
+		require.EqualError(t, err, "the type of the attribute [round], which is not indexed, cannot be inferred from the values provided in the query")
+	})
+
+	t.Run("the values of an unindexed attribute are not of the same type", func(t *testing.T) {
+		qExecutor := newConjunctionTestExecutor(t, env, dbName)
+		_, err := qExecutor.Execute(context.Background(), dbName, []byte(`{"selector":{"color":{"$eq":"red"},"weight":{"$gt":5,"$lt":"heavy"}}}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "attribute [weight] is not indexed and the values provided in the query are not of the same type")
+	})
+}
+
+// predicate is a condition of a randomly generated query, along with its evaluation on a value
+type predicate struct {
+	attr    string
+	cond    string
+	matches func(item *bruteForceItem) bool
+}
+
+type bruteForceItem struct {
+	Level  int    `json:"level"`
+	Team   string `json:"team"`
+	Active bool   `json:"active"`
+	Score  int    `json:"score"`
+}
+
+func randomPredicate(r *rand.Rand, attr string) *predicate {
+	teams := []string{"ant", "bee", "cat", "dog", "eel"}
+
+	switch attr {
+	case "level", "score":
+		get := func(i *bruteForceItem) int { return i.Level }
+		max := 10
+		if attr == "score" {
+			get = func(i *bruteForceItem) int { return i.Score }
+			max = 200
+		}
+		a, b := r.Intn(max)-max/4, r.Intn(max)-max/4
+		switch r.Intn(5) {
+		case 0:
+			return &predicate{attr, fmt.Sprintf(`{"$eq":%d}`, a), func(i *bruteForceItem) bool { return get(i) == a }}
+		case 1:
+			return &predicate{attr, fmt.Sprintf(`{"$gt":%d,"$lte":%d}`, a, b), func(i *bruteForceItem) bool { return get(i) > a && get(i) <= b }}
+		case 2:
+			return &predicate{attr, fmt.Sprintf(`{"$gte":%d}`, a), func(i *bruteForceItem) bool { return get(i) >= a }}
+		case 3:
+			return &predicate{attr, fmt.Sprintf(`{"$lt":%d}`, a), func(i *bruteForceItem) bool { return get(i) < a }}
+		default:
+			return &predicate{attr, fmt.Sprintf(`{"$neq":[%d,%d],"$gte":%d}`, a, b, a-max/2), func(i *bruteForceItem) bool {
+				return get(i) != a && get(i) != b && get(i) >= a-max/2
+			}}
+		}
+	case "team":
+		a, b := teams[r.Intn(len(teams))], teams[r.Intn(len(teams))]
+		switch r.Intn(3) {
+		case 0:
+			return &predicate{attr, fmt.Sprintf(`{"$eq":"%s"}`, a), func(i *bruteForceItem) bool { return i.Team == a }}
+		case 1:
+			return &predicate{attr, fmt.Sprintf(`{"$gte":"%s","$lt":"%s"}`, a, b), func(i *bruteForceItem) bool { return i.Team >= a && i.Team < b }}
+		default:
+			return &predicate{attr, fmt.Sprintf(`{"$neq":["%s","%s"]}`, a, b), func(i *bruteForceItem) bool { return i.Team != a && i.Team != b }}
+		}
+	default:
+		a := r.Intn(2) == 0
+		if r.Intn(2) == 0 {
+			return &predicate{attr, fmt.Sprintf(`{"$eq":%t}`, a), func(i *bruteForceItem) bool { return i.Active == a }}
+		}
+		return &predicate{attr, fmt.Sprintf(`{"$neq":[%t]}`, a), func(i *bruteForceItem) bool { return i.Active != a }}
+	}
+}
+
+// Scenario: random conjunctions over indexed and unindexed attributes return the same keys as a
+// scan over all values, whichever conditions the cap leaves to be post-filtered
+func TestExecuteConjunctionAgainstBruteForce(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	r := rand.New(rand.NewSource(7))
+	teams := []string{"ant", "bee", "cat", "dog", "eel"}
+	items := make(map[string]*bruteForceItem)
+	values := make(map[string][]byte)
+	for i := 0; i < 300; i++ {
+		item := &bruteForceItem{
+			Level:  r.Intn(10) - 2,
+			Team:   teams[r.Intn(len(teams))],
+			Active: r.Intn(3) == 0,
+			Score:  r.Intn(200) - 50,
+		}
+		key := fmt.Sprintf("item%03d", i)
+		items[key] = item
+		v, err := json.Marshal(item)
+		require.NoError(t, err)
+		values[key] = v
+	}
+
+	dbName := "testdb"
+	setupDBForTestingConjunctions(t, env.db, dbName, map[string]types.IndexAttributeType{
+		"level":  types.IndexAttributeType_NUMBER,
+		"team":   types.IndexAttributeType_STRING,
+		"active": types.IndexAttributeType_BOOLEAN,
+	}, values)
+
+	attrs := []string{"level", "team", "active", "score"}
+	for n := 0; n < 200; n++ {
+		var predicates []*predicate
+		var conds []string
+		for _, i := range r.Perm(len(attrs))[:1+r.Intn(len(attrs))] {
+			p := randomPredicate(r, attrs[i])
+			predicates = append(predicates, p)
+			conds = append(conds, fmt.Sprintf(`"%s":%s`, p.attr, p.cond))
+		}
+		if len(predicates) == 1 && predicates[0].attr == "score" {
+			continue
+		}
+		query := `{"selector":{"$and":{` + strings.Join(conds, ",") + `}}}`
+
+		var expectedKeys map[string]bool
+		for k, item := range items {
+			matches := true
+			for _, p := range predicates {
+				matches = matches && p.matches(item)
+			}
+			if matches {
+				if expectedKeys == nil {
+					expectedKeys = make(map[string]bool)
+				}
+				expectedKeys[k] = true
+			}
+		}
+
+		for _, intersectionCap := range []uint64{0, 50, defaultIntersectionCap} {
+			qExecutor := newConjunctionTestExecutor(t, env, dbName)
+			qExecutor.intersectionCap = intersectionCap
+
+			result, err := qExecutor.Execute(context.Background(), dbName, []byte(query))
+			require.NoError(t, err, query)
+			require.Equal(t, expectedKeys, result.Keys, "query %s with the intersection cap %d", query, intersectionCap)
+		}
+	}
+}
@@ -149,6 +149,12 @@ func (e *WorldStateJSONQueryExecutor) executeAllConditions(ctx context.Context,
 }
 
 func (e *WorldStateJSONQueryExecutor) execute(ctx context.Context, dbName string, attribute string, conds *attributeTypeAndConditions) (map[string]bool, error) {
+	return e.executeWithin(ctx, dbName, attribute, conds, nil)
+}
+
+// executeWithin executes the conditions on the attribute, and returns the matching keys which are
+// among the given candidates. When no candidates are given, all matching keys are returned.
+func (e *WorldStateJSONQueryExecutor) executeWithin(ctx context.Context, dbName string, attribute string, conds *attributeTypeAndConditions, candidates map[string]bool) (map[string]bool, error) {
 	plan, err := createQueryPlan(attribute, conds)
 	if err != nil {
 		return nil, err
@@ -172,6 +178,11 @@ func (e *WorldStateJSONQueryExecutor) execute(ctx context.Context, dbName string
 	}
 
 	keys := make(map[string]bool)
+	addKey := func(k string) {
+		if candidates == nil || candidates[k] {
+			keys[k] = true
+		}
+	}
 
 	for iter.Next() {
 		select {
@@ -188,14 +199,14 @@ func (e *WorldStateJSONQueryExecutor) execute(ctx context.Context, dbName string
 			}
 
 			if len(plan.excludeKeys) == 0 {
-				keys[indexEntry.Key] = true
+				addKey(indexEntry.Key)
 				continue
 			}
 
 			// we may need to skip entries continously
 			for {
 				if _, ok := plan.excludeKeys[indexEntry.Value]; !ok {
-					keys[indexEntry.Key] = true
+					addKey(indexEntry.Key)
 					break
 				}
 
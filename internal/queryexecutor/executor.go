@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hyperledger-labs/orion-server/internal/stateindex"
@@ -21,16 +22,41 @@ import (
 type WorldStateJSONQueryExecutor struct {
 	db     worldstate.DBsSnapshot
 	logger *logger.SugarLogger
+	// intersectionCap is the maximum estimated number of index entries which are scanned to
+	// intersect the keys of a condition with the keys found so far. Beyond it, the condition is
+	// verified against the values of the keys found so far.
+	intersectionCap uint64
+}
+
+// QueryResult holds the keys whose values match a query. PostFilteredAttributes are the attributes
+// of a conjunction whose conditions were verified against the values of the keys instead of being
+// evaluated on an index, either because the attribute is not indexed, or because its index was
+// estimated to hold too many matching entries.
+type QueryResult struct {
+	Keys                   map[string]bool
+	PostFilteredAttributes []string
 }
 
 func NewWorldStateJSONQueryExecutor(db worldstate.DBsSnapshot, l *logger.SugarLogger) *WorldStateJSONQueryExecutor {
 	return &WorldStateJSONQueryExecutor{
-		db:     db,
-		logger: l,
+		db:              db,
+		logger:          l,
+		intersectionCap: defaultIntersectionCap,
 	}
 }
 
+// ExecuteQuery returns the keys whose values match the given query
 func (e *WorldStateJSONQueryExecutor) ExecuteQuery(ctx context.Context, dbName string, selector []byte) (map[string]bool, error) {
+	result, err := e.Execute(ctx, dbName, selector)
+	if err != nil {
+		return nil, err
+	}
+	return result.Keys, nil
+}
+
+// Execute returns the keys whose values match the given query, along with the attributes whose
+// conditions were verified against the values of the keys
+func (e *WorldStateJSONQueryExecutor) Execute(ctx context.Context, dbName string, selector []byte) (*QueryResult, error) {
 	query := make(map[string]interface{})
 	decoder := json.NewDecoder(bytes.NewBuffer(selector))
 	decoder.UseNumber()
@@ -45,6 +71,10 @@ func (e *WorldStateJSONQueryExecutor) ExecuteQuery(ctx context.Context, dbName s
 
 	// in the future, we will allow nested "$and", "$or" semantics
 
+	// the attributes of a conjunction need not all be indexed, as long as one of them is.
+	// The conditions of the attributes which are not indexed are verified against the values
+	// of the keys matching the conditions of the indexed attributes.
+
 	if _, ok := query[constants.QueryFieldSelector]; !ok {
 		return nil, errors.New("selector field is missing in the query")
 	}
@@ -60,18 +90,14 @@ func (e *WorldStateJSONQueryExecutor) ExecuteQuery(ctx context.Context, dbName s
 	_, and := query[constants.QueryOpAnd]
 	_, or := query[constants.QueryOpOr]
 
-	var keys map[string]bool
-
 	switch {
 	case !and && !or:
 		// default is $and
-		disectedConditions, err := e.validateAndDisectConditions(dbName, query)
+		indexed, unindexed, err := e.disectConditions(dbName, query, true)
 		if err != nil {
 			return nil, err
 		}
-		if keys, err = e.executeAND(ctx, dbName, disectedConditions); err != nil {
-			return nil, err
-		}
+		return e.executeConjunction(ctx, dbName, indexed, unindexed)
 	case and && or:
 		// not supported yet
 		return nil, errors.New("there must be a single upper level combination operator")
@@ -81,14 +107,12 @@ func (e *WorldStateJSONQueryExecutor) ExecuteQuery(ctx context.Context, dbName s
 			return nil, errors.New("query syntax error near $and")
 		}
 
-		disectedConditions, err := e.validateAndDisectConditions(dbName, c)
+		indexed, unindexed, err := e.disectConditions(dbName, c, true)
 		if err != nil {
 			return nil, err
 		}
-		if keys, err = e.executeAND(ctx, dbName, disectedConditions); err != nil {
-			return nil, err
-		}
-	case or:
+		return e.executeConjunction(ctx, dbName, indexed, unindexed)
+	default:
 		c, ok := query[constants.QueryOpOr].(map[string]interface{})
 		if !ok {
 			return nil, errors.New("query syntax error near $or")
@@ -98,12 +122,12 @@ func (e *WorldStateJSONQueryExecutor) ExecuteQuery(ctx context.Context, dbName s
 		if err != nil {
 			return nil, err
 		}
-		if keys, err = e.executeOR(ctx, dbName, disectedConditions); err != nil {
+		keys, err := e.executeOR(ctx, dbName, disectedConditions)
+		if err != nil {
 			return nil, err
 		}
+		return &QueryResult{Keys: keys}, nil
 	}
-
-	return keys, nil
 }
 
 type attributeToConditions map[string]*attributeTypeAndConditions
@@ -114,37 +138,61 @@ type attributeTypeAndConditions struct {
 }
 
 func (e *WorldStateJSONQueryExecutor) validateAndDisectConditions(dbName string, conditions map[string]interface{}) (attributeToConditions, error) {
+	indexed, _, err := e.disectConditions(dbName, conditions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexed, nil
+}
+
+// disectConditions splits the conditions per attribute into the ones of the indexed attributes and
+// the ones of the attributes which are not indexed. Unless allowUnindexed is set, all attributes
+// must be indexed. Otherwise, at least one attribute must be indexed, and the type of an attribute
+// which is not indexed is the type of the values given in its conditions.
+func (e *WorldStateJSONQueryExecutor) disectConditions(dbName string, conditions map[string]interface{}, allowUnindexed bool) (attributeToConditions, attributeToConditions, error) {
 	// when we reach here, we assume that the given dbName exist
 	marshledIndexDef, _, err := e.db.GetIndexDefinition(dbName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if marshledIndexDef == nil {
-		return nil, errors.New("no index has been defined on the database " + dbName)
+		return nil, nil, errors.New("no index has been defined on the database " + dbName)
 	}
 
 	indexDef := map[string]types.IndexAttributeType{}
 	if err := json.Unmarshal(marshledIndexDef, &indexDef); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	queryConditions := make(attributeToConditions)
+	unindexedConditions := make(attributeToConditions)
 	for attr, c := range conditions {
-		if _, ok := indexDef[attr]; !ok {
-			return nil, errors.New("attribute [" + attr + "] given in the query condition is not indexed")
+		attrType, indexed := indexDef[attr]
+		if !indexed && !allowUnindexed {
+			return nil, nil, errors.New("attribute [" + attr + "] given in the query condition is not indexed")
 		}
 
 		cond, ok := c.(map[string]interface{})
 		if !ok {
-			return nil, errors.New("query syntax error near the attribute [" + attr + "]")
+			return nil, nil, errors.New("query syntax error near the attribute [" + attr + "]")
 		}
 
 		if len(cond) == 0 {
-			return nil, errors.New("no condition provided for the attribute [" + attr + "]. All given attributes must have a condition")
+			return nil, nil, errors.New("no condition provided for the attribute [" + attr + "]. All given attributes must have a condition")
+		}
+
+		sliceTypeErr := "attribute [" + attr + "] is indexed but incorrect value type provided in the query"
+		nonSliceTypeErr := "attribute [" + attr + "] is indexed but the value type provided in the query does not match the actual indexed type"
+		if !indexed {
+			if attrType, ok = typeOfConditions(cond); !ok {
+				return nil, nil, errors.New("the type of the attribute [" + attr + "], which is not indexed, cannot be inferred from the values provided in the query")
+			}
+			sliceTypeErr = "attribute [" + attr + "] is not indexed and the values provided in the query are not of the same type"
+			nonSliceTypeErr = sliceTypeErr
 		}
 
-		attrType := indexDef[attr]
 		conds := &attributeTypeAndConditions{
 			valueType:  attrType,
 			conditions: make(map[string]interface{}),
@@ -152,19 +200,19 @@ func (e *WorldStateJSONQueryExecutor) validateAndDisectConditions(dbName string,
 
 		for opr, v := range cond {
 			if !isValidLogicalOperator(opr) {
-				return nil, errors.New("invalid logical operator [" + opr + "] provided for the attribute [" + attr + "]")
+				return nil, nil, errors.New("invalid logical operator [" + opr + "] provided for the attribute [" + attr + "]")
 			}
 
 			var internalVal interface{}
 			if opr == constants.QueryOpNotEqual {
 				internalVal, err = constructInternalValueForSliceType(v, attrType)
 				if err != nil {
-					return nil, errors.WithMessage(err, "attribute ["+attr+"] is indexed but incorrect value type provided in the query")
+					return nil, nil, errors.WithMessage(err, sliceTypeErr)
 				}
 			} else {
 				internalVal, err = constructInternalValueForNonSliceType(v, attrType)
 				if err != nil {
-					return nil, errors.WithMessage(err, "attribute ["+attr+"] is indexed but the value type provided in the query does not match the actual indexed type")
+					return nil, nil, errors.WithMessage(err, nonSliceTypeErr)
 				}
 			}
 
@@ -174,12 +222,49 @@ func (e *WorldStateJSONQueryExecutor) validateAndDisectConditions(dbName string,
 		}
 
 		if err := validateAttrConditions(conds.conditions); err != nil {
-			return nil, errors.WithMessage(err, "query syntax error near attribute ["+attr+"]")
+			return nil, nil, errors.WithMessage(err, "query syntax error near attribute ["+attr+"]")
+		}
+
+		if indexed {
+			queryConditions[attr] = conds
+		} else {
+			unindexedConditions[attr] = conds
 		}
-		queryConditions[attr] = conds
 	}
 
-	return queryConditions, nil
+	if len(queryConditions) == 0 {
+		var attrs []string
+		for attr := range unindexedConditions {
+			attrs = append(attrs, attr)
+		}
+		sort.Strings(attrs)
+		return nil, nil, errors.New("none of the attributes [" + strings.Join(attrs, ", ") + "] given in the query condition is indexed")
+	}
+
+	return queryConditions, unindexedConditions, nil
+}
+
+// typeOfConditions returns the type of the values given in the conditions on an attribute,
+// preferring a single value over the items of a $neq array, which may be empty
+func typeOfConditions(cond map[string]interface{}) (types.IndexAttributeType, bool) {
+	var items []interface{}
+	for _, v := range cond {
+		switch v := v.(type) {
+		case json.Number:
+			return types.IndexAttributeType_NUMBER, true
+		case string:
+			return types.IndexAttributeType_STRING, true
+		case bool:
+			return types.IndexAttributeType_BOOLEAN, true
+		case []interface{}:
+			items = append(items, v...)
+		}
+	}
+
+	if len(items) == 0 {
+		return 0, false
+	}
+	return typeOfConditions(map[string]interface{}{"": items[0]})
 }
 
 func isValidLogicalOperator(opt string) bool {
@@ -334,7 +334,7 @@ func TestExecuteJSONQueryErrorCases(t *testing.T) {
 			expectedError: "query syntax error near $or",
 		},
 		{
-			name: "attribute used in and is not indexed",
+			name: "no attribute used in and is indexed",
 			query: []byte(
 				`{
 					"selector": {
@@ -346,7 +346,7 @@ func TestExecuteJSONQueryErrorCases(t *testing.T) {
 					}
 				}`,
 			),
-			expectedError: "attribute [attr5] given in the query condition is not indexed",
+			expectedError: "none of the attributes [attr5] given in the query condition is indexed",
 		},
 		{
 			name: "attribute used in or is not indexed",
@@ -364,7 +364,7 @@ func TestExecuteJSONQueryErrorCases(t *testing.T) {
 			expectedError: "attribute [attr5] given in the query condition is not indexed",
 		},
 		{
-			name: "attribute used in default combination is not indexed",
+			name: "no attribute used in default combination is indexed",
 			query: []byte(
 				`{
 					"selector": {
@@ -374,7 +374,7 @@ func TestExecuteJSONQueryErrorCases(t *testing.T) {
 					}
 				}`,
 			),
-			expectedError: "attribute [attr5] given in the query condition is not indexed",
+			expectedError: "none of the attributes [attr5] given in the query condition is indexed",
 		},
 		{
 			name: "selector field is missing",
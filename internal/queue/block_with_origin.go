@@ -0,0 +1,91 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// BlockOrigin identifies the path by which a block reached the block processor.
+type BlockOrigin int
+
+const (
+	// BlockOriginLocal marks a block that was created by the block creator of this node, i.e., this node was the
+	// leader that proposed it.
+	BlockOriginLocal BlockOrigin = iota
+	// BlockOriginReplication marks a block that was proposed by another node and delivered to this node by consensus.
+	BlockOriginReplication
+	// BlockOriginCatchUp marks a block that was pulled from a peer while catching up from a snapshot or on-boarding.
+	BlockOriginCatchUp
+)
+
+func (o BlockOrigin) String() string {
+	switch o {
+	case BlockOriginLocal:
+		return "local"
+	case BlockOriginReplication:
+		return "replication"
+	case BlockOriginCatchUp:
+		return "catch-up"
+	default:
+		return "unknown"
+	}
+}
+
+// BlockWithOrigin is the entry passed over the block OneQueueBarrier, from the replication layer to the block
+// processor. Besides the block, it carries information about where the block came from, which is used for logging
+// and for per-origin accounting.
+type BlockWithOrigin struct {
+	Block      *types.Block
+	Origin     BlockOrigin
+	ReceivedAt time.Time
+	// PeerID is the node ID of the peer the block was received from; empty for locally created blocks, or when the
+	// peer is unknown.
+	PeerID string
+	// Backlog is the number of blocks that the replication layer holds ready to be enqueued after this one, e.g.,
+	// the rest of the blocks being pulled during catch-up. Zero when unknown.
+	Backlog uint64
+}
+
+// NewBlockWithOrigin wraps a block with its origin, stamping it with the current time.
+func NewBlockWithOrigin(block *types.Block, origin BlockOrigin, peerID string) *BlockWithOrigin {
+	return &BlockWithOrigin{
+		Block:      block,
+		Origin:     origin,
+		ReceivedAt: time.Now(),
+		PeerID:     peerID,
+	}
+}
+
+// StateDeltaWithOrigin is the entry passed over the block OneQueueBarrier, in place of a block, to fast-forward the
+// ledger over a range of blocks by the net state delta of the range, along with the headers of its blocks, as pulled
+// from a peer during catch-up. The block processor replies with a *StateDeltaResult.
+type StateDeltaWithOrigin struct {
+	Headers    []*types.BlockHeader
+	Delta      *types.StateDelta
+	ReceivedAt time.Time
+	// PeerID is the node ID of the peer the state delta was pulled from
+	PeerID string
+}
+
+// NewStateDeltaWithOrigin wraps the headers and the net state delta of a range of blocks pulled from a peer, stamping
+// them with the current time.
+func NewStateDeltaWithOrigin(headers []*types.BlockHeader, delta *types.StateDelta, peerID string) *StateDeltaWithOrigin {
+	return &StateDeltaWithOrigin{
+		Headers:    headers,
+		Delta:      delta,
+		ReceivedAt: time.Now(),
+		PeerID:     peerID,
+	}
+}
+
+// StateDeltaResult is the reply of the block processor to a StateDeltaWithOrigin
+type StateDeltaResult struct {
+	// Err is set if the state delta was not applied, e.g., because the resulting state does not match the headers
+	Err error
+	// ClusterConfig is the cluster config committed by the range of blocks, if the range changed it
+	ClusterConfig *types.ClusterConfig
+}
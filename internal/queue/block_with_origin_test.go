@@ -0,0 +1,36 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlockWithOrigin(t *testing.T) {
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: 10,
+			},
+		},
+	}
+
+	before := time.Now()
+	b := NewBlockWithOrigin(block, BlockOriginReplication, "node2")
+	require.Same(t, block, b.Block)
+	require.Equal(t, BlockOriginReplication, b.Origin)
+	require.Equal(t, "node2", b.PeerID)
+	require.False(t, b.ReceivedAt.Before(before))
+}
+
+func TestBlockOrigin_String(t *testing.T) {
+	require.Equal(t, "local", BlockOriginLocal.String())
+	require.Equal(t, "replication", BlockOriginReplication.String())
+	require.Equal(t, "catch-up", BlockOriginCatchUp.String())
+	require.Equal(t, "unknown", BlockOrigin(100).String())
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// commitSample records the number of transactions committed by a block, the time of the commit, and the time the
+// block took to be validated and committed.
+type commitSample struct {
+	at      time.Time
+	txCount int
+	latency time.Duration
+}
+
+// CommitStats aggregates the transactions committed by the node over a sliding window, from which it derives the
+// interval a client should wait before it resubmits a transaction that was rejected for lack of room in the
+// transaction pipeline. The interval is the time the node needs to commit its backlog at the recent commit rate,
+// clamped to a configured range. Both the recording of a commit and the derivation of an interval are cheap, as the
+// samples that leave the window are dropped as they are encountered.
+//
+// The aggregator also detects the pressure on the commits: once the thresholds are set, the commits are under
+// pressure when the mean latency of the commits of the window reaches the pressure latency, and are relieved when it
+// falls below the relief latency, which is lower, so that a latency that hovers around a single threshold does not
+// flip the state on every commit. The state is evaluated on each commit, and each transition is signaled to the
+// pressure listener.
+type CommitStats struct {
+	window        time.Duration
+	minRetryAfter time.Duration
+	maxRetryAfter time.Duration
+	now           func() time.Time
+
+	mu           sync.Mutex
+	startedAt    time.Time
+	samples      []commitSample
+	txCount      int
+	totalLatency time.Duration
+
+	pressureLatency  time.Duration
+	reliefLatency    time.Duration
+	minCommits       int
+	underPressure    bool
+	pressureListener func(underPressure bool)
+}
+
+// NewCommitStats creates an aggregator of the commits of the last window, which derives intervals in the range
+// [minRetryAfter, maxRetryAfter].
+func NewCommitStats(window, minRetryAfter, maxRetryAfter time.Duration) *CommitStats {
+	return newCommitStats(window, minRetryAfter, maxRetryAfter, time.Now)
+}
+
+func newCommitStats(window, minRetryAfter, maxRetryAfter time.Duration, now func() time.Time) *CommitStats {
+	return &CommitStats{
+		window:        window,
+		minRetryAfter: minRetryAfter,
+		maxRetryAfter: maxRetryAfter,
+		now:           now,
+		startedAt:     now(),
+	}
+}
+
+// SetPressureThresholds enables the detection of the pressure on the commits. The commits come under pressure once
+// the window holds at least minCommits commits whose mean latency is at least pressureLatency, and are relieved once
+// the mean latency falls below reliefLatency.
+func (s *CommitStats) SetPressureThresholds(pressureLatency, reliefLatency time.Duration, minCommits int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pressureLatency = pressureLatency
+	s.reliefLatency = reliefLatency
+	s.minCommits = minCommits
+}
+
+// SetPressureListener sets the function called on each transition of the pressure on the commits, with true when
+// the commits come under pressure, and false when they are relieved. The listener is called by the goroutine that
+// records the commits, hence, it must not block.
+func (s *CommitStats) SetPressureListener(listener func(underPressure bool)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pressureListener = listener
+}
+
+// RecordCommit records the commit of a block that holds the given number of transactions, and which took the given
+// latency to be validated and committed.
+func (s *CommitStats) RecordCommit(txCount int, latency time.Duration) {
+	s.mu.Lock()
+	now := s.now()
+	s.prune(now)
+	s.samples = append(s.samples, commitSample{at: now, txCount: txCount, latency: latency})
+	s.txCount += txCount
+	s.totalLatency += latency
+
+	transitioned := s.updatePressure()
+	underPressure, listener := s.underPressure, s.pressureListener
+	s.mu.Unlock()
+
+	if transitioned && listener != nil {
+		listener(underPressure)
+	}
+}
+
+// UnderPressure returns true if the commits were under pressure as of the last commit
+func (s *CommitStats) UnderPressure() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.underPressure
+}
+
+// MeanLatency returns the mean latency of the commits of the window, or zero if no block was committed over the
+// window.
+func (s *CommitStats) MeanLatency() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(s.now())
+	return s.meanLatency()
+}
+
+func (s *CommitStats) meanLatency() time.Duration {
+	if len(s.samples) == 0 {
+		return 0
+	}
+	return s.totalLatency / time.Duration(len(s.samples))
+}
+
+// updatePressure evaluates the pressure on the commits of the window, and returns true if it changed
+func (s *CommitStats) updatePressure() bool {
+	if s.pressureLatency == 0 {
+		return false
+	}
+
+	mean := s.meanLatency()
+	switch {
+	case !s.underPressure && len(s.samples) >= s.minCommits && mean >= s.pressureLatency:
+		s.underPressure = true
+	case s.underPressure && mean < s.reliefLatency:
+		s.underPressure = false
+	default:
+		return false
+	}
+	return true
+}
+
+// TxRate returns the number of transactions committed per second over the window, or over the time since the
+// aggregator was created if it is shorter than the window.
+func (s *CommitStats) TxRate() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.txRate(s.now())
+}
+
+// RetryAfter returns the interval a client should wait before it resubmits a transaction, given the number of
+// transactions the node has yet to commit. If no transaction was committed over the window, the maximal interval is
+// returned.
+func (s *CommitStats) RetryAfter(backlog int) time.Duration {
+	s.mu.Lock()
+	rate := s.txRate(s.now())
+	s.mu.Unlock()
+
+	if rate == 0 {
+		return s.maxRetryAfter
+	}
+
+	seconds := float64(backlog) / rate
+	if seconds >= s.maxRetryAfter.Seconds() {
+		return s.maxRetryAfter
+	}
+	retryAfter := time.Duration(math.Ceil(seconds * float64(time.Second)))
+	if retryAfter < s.minRetryAfter {
+		return s.minRetryAfter
+	}
+	return retryAfter
+}
+
+func (s *CommitStats) txRate(now time.Time) float64 {
+	s.prune(now)
+
+	elapsed := now.Sub(s.startedAt)
+	if elapsed > s.window {
+		elapsed = s.window
+	}
+	if elapsed <= 0 || s.txCount == 0 {
+		return 0
+	}
+	return float64(s.txCount) / elapsed.Seconds()
+}
+
+// prune drops the samples that left the window
+func (s *CommitStats) prune(now time.Time) {
+	i := 0
+	for ; i < len(s.samples) && now.Sub(s.samples[i].at) > s.window; i++ {
+		s.txCount -= s.samples[i].txCount
+		s.totalLatency -= s.samples[i].latency
+	}
+	if i > 0 {
+		s.samples = append(s.samples[:0], s.samples[i:]...)
+	}
+}
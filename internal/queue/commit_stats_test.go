@@ -0,0 +1,115 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitStats(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	clock := func() time.Time { return now }
+
+	t.Run("no commits", func(t *testing.T) {
+		s := newCommitStats(10*time.Second, time.Second, time.Minute, clock)
+		require.Zero(t, s.TxRate())
+		require.Equal(t, time.Minute, s.RetryAfter(0))
+		require.Equal(t, time.Minute, s.RetryAfter(100))
+	})
+
+	t.Run("retry after scales with the backlog", func(t *testing.T) {
+		s := newCommitStats(10*time.Second, time.Second, time.Minute, clock)
+		// 100 transactions over 5 seconds, i.e., 20 transactions per second
+		for i := 0; i < 10; i++ {
+			now = now.Add(500 * time.Millisecond)
+			s.RecordCommit(10, 0)
+		}
+		require.Equal(t, float64(20), s.TxRate())
+
+		require.Equal(t, time.Second, s.RetryAfter(0))
+		require.Equal(t, time.Second, s.RetryAfter(10))
+		require.Equal(t, 5*time.Second, s.RetryAfter(100))
+		require.Equal(t, 10*time.Second, s.RetryAfter(200))
+		require.Equal(t, 50*time.Second, s.RetryAfter(1000))
+		require.Equal(t, time.Minute, s.RetryAfter(10000))
+	})
+
+	t.Run("commits leave the window", func(t *testing.T) {
+		s := newCommitStats(10*time.Second, time.Second, time.Minute, clock)
+		now = now.Add(10 * time.Second)
+		s.RecordCommit(100, 0)
+		now = now.Add(5 * time.Second)
+		s.RecordCommit(50, 0)
+		require.Equal(t, float64(15), s.TxRate())
+
+		// the first commit leaves the window, hence the rate drops, and the interval grows
+		now = now.Add(6 * time.Second)
+		require.Equal(t, float64(5), s.TxRate())
+		require.Equal(t, 20*time.Second, s.RetryAfter(100))
+
+		now = now.Add(5 * time.Second)
+		require.Zero(t, s.TxRate())
+		require.Equal(t, time.Minute, s.RetryAfter(100))
+		require.Empty(t, s.samples)
+	})
+	t.Run("pressure on the commits", func(t *testing.T) {
+		s := newCommitStats(10*time.Second, time.Second, time.Minute, clock)
+		var transitions []bool
+		s.SetPressureListener(func(underPressure bool) { transitions = append(transitions, underPressure) })
+
+		// without thresholds, no latency puts the commits under pressure
+		now = now.Add(time.Second)
+		s.RecordCommit(1, time.Minute)
+		require.False(t, s.UnderPressure())
+		require.Equal(t, time.Minute, s.MeanLatency())
+
+		now = now.Add(11 * time.Second)
+		require.Zero(t, s.MeanLatency())
+		s.SetPressureThresholds(time.Second, 500*time.Millisecond, 3)
+
+		commit := func(latency time.Duration) {
+			now = now.Add(time.Second)
+			s.RecordCommit(1, latency)
+		}
+
+		// a single slow commit is not enough
+		commit(2 * time.Second)
+		commit(2 * time.Second)
+		require.False(t, s.UnderPressure())
+		commit(2 * time.Second)
+		require.True(t, s.UnderPressure())
+		require.Equal(t, []bool{true}, transitions)
+
+		// the mean, 1.5 seconds, then 1.2 seconds, then 1 second, stays above the relief latency
+		commit(0)
+		commit(0)
+		commit(0)
+		require.Equal(t, time.Second, s.MeanLatency())
+		require.True(t, s.UnderPressure())
+		require.Equal(t, []bool{true}, transitions)
+
+		// the slow commits leave the window, and the mean falls below the relief latency
+		now = now.Add(7 * time.Second)
+		commit(100 * time.Millisecond)
+		require.Equal(t, 25*time.Millisecond, s.MeanLatency())
+		require.False(t, s.UnderPressure())
+		require.Equal(t, []bool{true, false}, transitions)
+
+		// a mean between the thresholds neither puts the commits under pressure, nor relieves them
+		now = now.Add(11 * time.Second)
+		commit(900 * time.Millisecond)
+		commit(900 * time.Millisecond)
+		commit(900 * time.Millisecond)
+		require.False(t, s.UnderPressure())
+		commit(1300 * time.Millisecond)
+		require.True(t, s.UnderPressure())
+		commit(600 * time.Millisecond)
+		require.Equal(t, 920*time.Millisecond, s.MeanLatency())
+		require.True(t, s.UnderPressure())
+		require.Equal(t, []bool{true, false, true}, transitions)
+	})
+}
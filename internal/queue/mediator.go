@@ -0,0 +1,53 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import "time"
+
+// Mediator schedules the operations on the queues it mediates, in place of the go scheduler. Every enqueue and
+// dequeue on a mediated queue waits for the mediator, which lets one operation at a time proceed, and only once the
+// operation cannot block: an enqueue once the queue has room, unless it is non-blocking, and a dequeue once the queue
+// holds an entry, or once its wait limit is to expire. A simulation of the transaction pipeline hence controls the
+// interleaving of the go-routines that hand entries over to one another, and replays it from the same choices.
+type Mediator interface {
+	// Await blocks the calling go-routine until the mediator schedules the operation. It returns true if a dequeue
+	// with a wait limit is to time out instead of dequeuing an entry. Once the mediator is closed, Await returns
+	// false right away, and the operations proceed on the queues as if they were not mediated.
+	Await(op *Op) (timedOut bool)
+}
+
+// OpKind is the kind of an operation on a queue
+type OpKind int
+
+const (
+	OpEnqueue OpKind = iota
+	OpDequeue
+)
+
+func (k OpKind) String() string {
+	if k == OpEnqueue {
+		return "enqueue"
+	}
+	return "dequeue"
+}
+
+// Op is an operation on a mediated queue, awaiting its turn
+type Op struct {
+	Queue *Queue
+	Kind  OpKind
+	// Entry is the entry of an enqueue
+	Entry interface{}
+	// WaitLimit is the wait limit of a dequeue, and zero for a dequeue that waits as long as the queue is empty
+	WaitLimit time.Duration
+	// NonBlocking is set for an enqueue that fails on a full queue instead of waiting, which the mediator may
+	// schedule whether or not the queue has room
+	NonBlocking bool
+}
+
+// NewMediated creates a queue of the given size whose operations are scheduled by the mediator
+func NewMediated(size uint32, mediator Mediator) *Queue {
+	q := New(size)
+	q.mediator = mediator
+	return q
+}
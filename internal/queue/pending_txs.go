@@ -4,31 +4,186 @@
 package queue
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 )
 
+// TxStage denotes how far a pending transaction has progressed in the transaction pipeline.
+type TxStage int
+
+const (
+	// TxStageQueued means the transaction waits in the transaction queue to be batched.
+	TxStageQueued TxStage = iota
+	// TxStageBatched means the transaction is in a batch that waits to be turned into a block.
+	TxStageBatched
+	// TxStageProposed means the transaction is in a block that was numbered and proposed for replication.
+	TxStageProposed
+	// TxStageValidating means the transaction is in a block that was agreed upon by the cluster, and waits for, or
+	// is under, validation and commit.
+	TxStageValidating
+	// TxStageCommitting means the transaction is in a block that was validated, and waits for the block to be
+	// committed to the stores.
+	TxStageCommitting
+)
+
+func (s TxStage) String() string {
+	switch s {
+	case TxStageQueued:
+		return "queued"
+	case TxStageBatched:
+		return "batched"
+	case TxStageProposed:
+		return "proposed"
+	case TxStageValidating:
+		return "validating"
+	case TxStageCommitting:
+		return "committing"
+	default:
+		return "unknown"
+	}
+}
+
+type pendingTx struct {
+	promise     *CompletionPromise
+	submitter   string
+	stage       TxStage
+	blockNumber uint64
+	addedAt     time.Time
+	// enteredAt holds the time the transaction entered each stage, for sampled transactions only.
+	enteredAt []time.Time
+}
+
+// maxExpiredTxs is the number of the most recently expired transactions whose expiry is reported.
+const maxExpiredTxs = 10000
+
+// maxRejectedTxs is the number of the most recently rejected transactions whose rejection is reported.
+const maxRejectedTxs = 10000
+
 type PendingTxs struct {
 	sync.RWMutex
-	txs map[string]*CompletionPromise
+	txs       map[string]*pendingTx
+	txLatency *TxLatencyTracker
+	// expired holds the IDs of the transactions dropped as expired, in the order of their expiry, and
+	// expiredSet the same IDs for lookup.
+	expired    []string
+	expiredSet map[string]struct{}
+	// rejected holds the IDs of the transactions dropped as invalid along with the rest of their batch, in the order
+	// of their rejection, and rejections the validation result of each, for lookup.
+	rejected   []string
+	rejections map[string]*types.ValidationInfo
+	// recordDrops, if set, is called with the dead-letter records of the transactions dropped before they were
+	// included in a block
+	recordDrops func(drops []*types.DroppedTx)
 
 	logger *logger.SugarLogger
 }
 
 func NewPendingTxs(logger *logger.SugarLogger) *PendingTxs {
 	return &PendingTxs{
-		txs:    make(map[string]*CompletionPromise),
-		logger: logger,
+		txs:        make(map[string]*pendingTx),
+		expiredSet: make(map[string]struct{}),
+		rejections: make(map[string]*types.ValidationInfo),
+		logger:     logger,
+	}
+}
+
+// Add adds a transaction that was accepted by the node. The submitter is the user who submitted it, or the node for
+// a heartbeat, and is recorded if the transaction is dropped.
+func (p *PendingTxs) Add(txID, submitter string, promise *CompletionPromise) {
+	p.Lock()
+	defer p.Unlock()
+
+	tx := &pendingTx{
+		promise:   promise,
+		submitter: submitter,
+		stage:     TxStageQueued,
+		addedAt:   time.Now(),
+	}
+	if p.txLatency != nil && p.txLatency.sample() {
+		tx.enteredAt = make([]time.Time, numTxStages)
+		tx.enteredAt[TxStageQueued] = tx.addedAt
+	}
+	p.txs[txID] = tx
+	// a resubmission of an expired or rejected transaction starts afresh
+	delete(p.expiredSet, txID)
+	delete(p.rejections, txID)
+}
+
+// SetLatencyTracker sets the tracker that samples the transactions added from now on, and records the time they
+// spend in each stage until their block commits.
+func (p *PendingTxs) SetLatencyTracker(tracker *TxLatencyTracker) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.txLatency = tracker
+}
+
+// SetDropRecorder sets the function which is called with the dead-letter records of the transactions released with
+// an error or as expired from now on. It is called outside of the lock of the pending transactions.
+func (p *PendingTxs) SetDropRecorder(recordDrops func(drops []*types.DroppedTx)) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.recordDrops = recordDrops
+}
+
+// UpdateStage records the stage reached by the given transactions, and the number of the block they are included
+// in, if already assigned. Transactions that are not pending are ignored, e.g., on a node that did not receive them
+// from the client.
+func (p *PendingTxs) UpdateStage(txIDs []string, stage TxStage, blockNumber uint64) {
+	p.Lock()
+	defer p.Unlock()
+
+	var now time.Time
+	for _, txID := range txIDs {
+		if tx, ok := p.txs[txID]; ok {
+			tx.stage = stage
+			tx.blockNumber = blockNumber
+			if tx.enteredAt != nil && tx.enteredAt[stage].IsZero() {
+				if now.IsZero() {
+					now = time.Now()
+				}
+				tx.enteredAt[stage] = now
+			}
+		}
+	}
+}
+
+// QueuedFor returns the time elapsed between the submission of each of the given transactions and the given time.
+// Transactions that are not pending are skipped.
+func (p *PendingTxs) QueuedFor(txIDs []string, at time.Time) []time.Duration {
+	p.RLock()
+	defer p.RUnlock()
+
+	waits := make([]time.Duration, 0, len(txIDs))
+	for _, txID := range txIDs {
+		if tx, ok := p.txs[txID]; ok {
+			waits = append(waits, at.Sub(tx.addedAt))
+		}
 	}
+	return waits
 }
 
-func (p *PendingTxs) Add(txID string, promise *CompletionPromise) {
+// DetachOnTimeout is called when waiting for a transaction has timed out. It detaches the waiting promise from the
+// pending transaction, which remains pending until its block commits, and returns the last stage observed for it and
+// its block number, or zero if not yet assigned. If the transaction is no longer pending, i.e., it was completed
+// concurrently with the timeout, false is returned.
+func (p *PendingTxs) DetachOnTimeout(txID string) (TxStage, uint64, bool) {
 	p.Lock()
 	defer p.Unlock()
 
-	p.txs[txID] = promise
+	tx, ok := p.txs[txID]
+	if !ok {
+		return 0, 0, false
+	}
+	tx.promise = nil
+
+	return tx.stage, tx.blockNumber, true
 }
 
 // DoneWithReceipt is called after the commit of a block.
@@ -39,13 +194,31 @@ func (p *PendingTxs) DoneWithReceipt(txIDs []string, blockHeader *types.BlockHea
 	p.Lock()
 	defer p.Unlock()
 
+	committedAt := time.Now()
+	validationInfo := blockHeader.GetValidationInfo()
 	for txIndex, txID := range txIDs {
-		p.txs[txID].done(
-			&types.TxReceipt{
-				Header:  blockHeader,
-				TxIndex: uint64(txIndex),
-			},
-		)
+		var writeSetDigest []byte
+		var conflictingReads []*types.ConflictingRead
+		flag := types.Flag_VALID
+		if txIndex < len(validationInfo) {
+			writeSetDigest = validationInfo[txIndex].GetWriteSetDigest()
+			conflictingReads = validationInfo[txIndex].GetConflictingReads()
+			flag = validationInfo[txIndex].GetFlag()
+		}
+
+		if tx, ok := p.txs[txID]; ok {
+			if tx.enteredAt != nil && p.txLatency != nil {
+				p.txLatency.record(tx.enteredAt, committedAt, flag)
+			}
+			tx.promise.done(
+				&types.TxReceipt{
+					Header:           blockHeader,
+					TxIndex:          uint64(txIndex),
+					WriteSetDigest:   writeSetDigest,
+					ConflictingReads: conflictingReads,
+				},
+			)
+		}
 
 		delete(p.txs, txID)
 	}
@@ -54,17 +227,136 @@ func (p *PendingTxs) DoneWithReceipt(txIDs []string, blockHeader *types.BlockHea
 // ReleaseWithError is called when block replication fails with an error, typically NotLeaderError.
 // This may come from the block replicator or the block creator.
 // The `txIDs` slice does not have to be in the same order that transactions appear in the block.
+// A dead-letter record of each released transaction is recorded, see SetDropRecorder.
 func (p *PendingTxs) ReleaseWithError(txIDs []string, err error) {
 	p.logger.Debugf("Release with error: %s; txIDs: %v", err, txIDs)
 
+	p.Lock()
+	drops, recordDrops := p.release(txIDs, err)
+	p.Unlock()
+
+	if recordDrops != nil && len(drops) > 0 {
+		recordDrops(drops)
+	}
+}
+
+// Withdraw is called when the given transaction is rejected before it was accepted by the node, e.g., as the
+// transaction queue is full. Unlike ReleaseWithError, no dead-letter record is recorded, as the submitter is
+// notified of the rejection by the submission itself.
+func (p *PendingTxs) Withdraw(txID string, err error) {
 	p.Lock()
 	defer p.Unlock()
 
+	if tx, ok := p.txs[txID]; ok {
+		tx.promise.error(err)
+	}
+	delete(p.txs, txID)
+}
+
+// release completes the promises of the given transactions with the error, removes them, and returns their
+// dead-letter records, if a recorder is set, along with the recorder. It must be called with the lock held.
+func (p *PendingTxs) release(txIDs []string, err error) ([]*types.DroppedTx, func([]*types.DroppedTx)) {
+	var drops []*types.DroppedTx
+	droppedAt := time.Now().UnixNano()
 	for _, txID := range txIDs {
-		p.txs[txID].error(err)
+		if tx, ok := p.txs[txID]; ok {
+			tx.promise.error(err)
+			if p.recordDrops != nil {
+				drops = append(drops, &types.DroppedTx{
+					TxId:      txID,
+					Submitter: tx.submitter,
+					Stage:     tx.stage.String(),
+					Reason:    err.Error(),
+					DroppedAt: droppedAt,
+				})
+			}
+		}
 
 		delete(p.txs, txID)
 	}
+
+	return drops, p.recordDrops
+}
+
+// ReleaseExpired is called when the given transactions are dropped because their deadline passed before they
+// could be included in a block. The expiry of the most recent ones is recorded, see IsExpired, along with a
+// dead-letter record of each, see SetDropRecorder.
+func (p *PendingTxs) ReleaseExpired(txIDs []string, err error) {
+	p.logger.Debugf("Release expired: %s; txIDs: %v", err, txIDs)
+
+	p.Lock()
+	drops, recordDrops := p.release(txIDs, err)
+	for _, txID := range txIDs {
+		if _, ok := p.expiredSet[txID]; ok {
+			continue
+		}
+		p.expired = append(p.expired, txID)
+		p.expiredSet[txID] = struct{}{}
+	}
+
+	for len(p.expired) > maxExpiredTxs {
+		delete(p.expiredSet, p.expired[0])
+		p.expired = p.expired[1:]
+	}
+	p.Unlock()
+
+	if recordDrops != nil && len(drops) > 0 {
+		recordDrops(drops)
+	}
+}
+
+// IsExpired returns true if the transaction was recently dropped because its deadline passed.
+func (p *PendingTxs) IsExpired(txID string) bool {
+	p.RLock()
+	defer p.RUnlock()
+
+	_, ok := p.expiredSet[txID]
+	return ok
+}
+
+// ReleaseRejected is called when the given transactions are dropped because they were found invalid, along with the
+// rest of their batch, before they were included in a block. The `valInfo` slice holds the validation result of each
+// transaction, in the order of `txIDs`. Each transaction is released with a TxRejectedError which carries the reason
+// of its rejection. The rejection of the most recent ones is recorded, see Rejection, along with a dead-letter record
+// of each, see SetDropRecorder.
+func (p *PendingTxs) ReleaseRejected(txIDs []string, valInfo []*types.ValidationInfo) {
+	p.logger.Debugf("Release rejected: txIDs: %v", txIDs)
+
+	p.Lock()
+	var drops []*types.DroppedTx
+	var recordDrops func([]*types.DroppedTx)
+	for i, txID := range txIDs {
+		var txDrops []*types.DroppedTx
+		txDrops, recordDrops = p.release([]string{txID}, &ierrors.TxRejectedError{
+			ErrMsg: fmt.Sprintf("the transaction was rejected before it was included in a block, as it is invalid, flag: %s, reason: %s",
+				valInfo[i].GetFlag(), valInfo[i].GetReasonIfInvalid()),
+		})
+		drops = append(drops, txDrops...)
+
+		if _, ok := p.rejections[txID]; !ok {
+			p.rejected = append(p.rejected, txID)
+		}
+		p.rejections[txID] = valInfo[i]
+	}
+
+	for len(p.rejected) > maxRejectedTxs {
+		delete(p.rejections, p.rejected[0])
+		p.rejected = p.rejected[1:]
+	}
+	p.Unlock()
+
+	if recordDrops != nil && len(drops) > 0 {
+		recordDrops(drops)
+	}
+}
+
+// Rejection returns the validation result of a transaction that was recently dropped because it was found invalid
+// along with the rest of its batch, or nil.
+func (p *PendingTxs) Rejection(txID string) *types.ValidationInfo {
+	p.RLock()
+	defer p.RUnlock()
+
+	return p.rejections[txID]
 }
 
 func (p *PendingTxs) Has(txID string) bool {
@@ -75,6 +367,26 @@ func (p *PendingTxs) Has(txID string) bool {
 	return ok
 }
 
+// TxIDs returns the IDs of the pending transactions, in no particular order.
+func (p *PendingTxs) TxIDs() []string {
+	p.RLock()
+	defer p.RUnlock()
+
+	txIDs := make([]string, 0, len(p.txs))
+	for txID := range p.txs {
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs
+}
+
+// Len returns the number of pending transactions.
+func (p *PendingTxs) Len() int {
+	p.RLock()
+	defer p.RUnlock()
+
+	return len(p.txs)
+}
+
 func (p *PendingTxs) Empty() bool {
 	p.RLock()
 	defer p.RUnlock()
@@ -20,10 +20,10 @@ func TestPendingTxs_Async(t *testing.T) {
 
 	var p *queue.CompletionPromise
 	require.True(t, pendingTxs.Empty())
-	pendingTxs.Add("tx1", p)
+	pendingTxs.Add("tx1", "", p)
 	require.True(t, pendingTxs.Has("tx1"))
 	require.False(t, pendingTxs.Has("tx2"))
-	pendingTxs.Add("tx2", p)
+	pendingTxs.Add("tx2", "", p)
 	require.True(t, pendingTxs.Has("tx2"))
 	pendingTxs.DoneWithReceipt([]string{"tx1", "tx2"}, nil)
 	require.True(t, pendingTxs.Empty())
@@ -45,7 +45,7 @@ func TestPendingTxs_Sync(t *testing.T) {
 
 	t.Run("Wait before Done", func(t *testing.T) {
 		p := queue.NewCompletionPromise(time.Hour)
-		pendingTxs.Add("tx3", p)
+		pendingTxs.Add("tx3", "", p)
 
 		go func() {
 			time.Sleep(10 * time.Millisecond)
@@ -59,7 +59,7 @@ func TestPendingTxs_Sync(t *testing.T) {
 
 	t.Run("Done before Wait", func(t *testing.T) {
 		p := queue.NewCompletionPromise(time.Hour)
-		pendingTxs.Add("tx3", p)
+		pendingTxs.Add("tx3", "", p)
 		pendingTxs.DoneWithReceipt([]string{"tx3"}, blockHeader)
 		actualReceipt, err := p.Wait()
 		require.NoError(t, err)
@@ -68,7 +68,7 @@ func TestPendingTxs_Sync(t *testing.T) {
 
 	t.Run("Wait before Release with Error", func(t *testing.T) {
 		p := queue.NewCompletionPromise(time.Hour)
-		pendingTxs.Add("tx3", p)
+		pendingTxs.Add("tx3", "", p)
 
 		go func() {
 			time.Sleep(10 * time.Millisecond)
@@ -82,7 +82,7 @@ func TestPendingTxs_Sync(t *testing.T) {
 
 	t.Run("Release with Error before Wait", func(t *testing.T) {
 		p := queue.NewCompletionPromise(time.Hour)
-		pendingTxs.Add("tx3", p)
+		pendingTxs.Add("tx3", "", p)
 		pendingTxs.ReleaseWithError([]string{"tx3"}, &ierrors.NotLeaderError{LeaderID: 1, LeaderHostPort: "10.10.10.10:666"})
 		actualReceipt, err := p.Wait()
 		require.EqualError(t, err, "not a leader, leader is RaftID: 1, with HostPort: 10.10.10.10:666")
@@ -94,7 +94,7 @@ func TestPendingTxs_Timeout(t *testing.T) {
 	pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
 
 	p := queue.NewCompletionPromise(1 * time.Millisecond)
-	pendingTxs.Add("tx3", p)
+	pendingTxs.Add("tx3", "", p)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -108,3 +108,165 @@ func TestPendingTxs_Timeout(t *testing.T) {
 	wg.Wait()
 	require.False(t, pendingTxs.Empty())
 }
+
+func TestPendingTxs_ReleaseExpired(t *testing.T) {
+	pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+
+	p := queue.NewCompletionPromise(time.Hour)
+	pendingTxs.Add("tx1", "", p)
+	pendingTxs.Add("tx2", "", nil)
+	require.False(t, pendingTxs.IsExpired("tx1"))
+
+	pendingTxs.ReleaseExpired([]string{"tx1", "tx2"}, &ierrors.TxExpiredError{ErrMsg: "expired"})
+	receipt, err := p.Wait()
+	require.EqualError(t, err, "expired")
+	require.IsType(t, &ierrors.TxExpiredError{}, err)
+	require.Nil(t, receipt)
+	require.True(t, pendingTxs.Empty())
+	require.True(t, pendingTxs.IsExpired("tx1"))
+	require.True(t, pendingTxs.IsExpired("tx2"))
+	require.False(t, pendingTxs.IsExpired("tx3"))
+
+	// a resubmission clears the expiry
+	pendingTxs.Add("tx1", "", nil)
+	require.False(t, pendingTxs.IsExpired("tx1"))
+	require.True(t, pendingTxs.IsExpired("tx2"))
+}
+
+func TestPendingTxs_ReleaseRejected(t *testing.T) {
+	pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+
+	var drops []*types.DroppedTx
+	pendingTxs.SetDropRecorder(func(d []*types.DroppedTx) {
+		drops = append(drops, d...)
+	})
+
+	p := queue.NewCompletionPromise(time.Hour)
+	pendingTxs.Add("tx1", "alice", p)
+	pendingTxs.Add("tx2", "bob", nil)
+	pendingTxs.UpdateStage([]string{"tx1", "tx2"}, queue.TxStageBatched, 0)
+	require.Nil(t, pendingTxs.Rejection("tx1"))
+
+	valInfo := []*types.ValidationInfo{
+		{Flag: types.Flag_INVALID_NO_PERMISSION, ReasonIfInvalid: "no permission"},
+		{Flag: types.Flag_INVALID_DATABASE_DOES_NOT_EXIST, ReasonIfInvalid: "no such database"},
+	}
+	pendingTxs.ReleaseRejected([]string{"tx1", "tx2"}, valInfo)
+	receipt, err := p.Wait()
+	require.EqualError(t, err, "the transaction was rejected before it was included in a block, as it is invalid, flag: INVALID_NO_PERMISSION, reason: no permission")
+	require.IsType(t, &ierrors.TxRejectedError{}, err)
+	require.Nil(t, receipt)
+	require.True(t, pendingTxs.Empty())
+	require.True(t, proto.Equal(valInfo[0], pendingTxs.Rejection("tx1")))
+	require.True(t, proto.Equal(valInfo[1], pendingTxs.Rejection("tx2")))
+	require.Nil(t, pendingTxs.Rejection("tx3"))
+
+	// each transaction is dead-lettered with the reason of its own rejection
+	require.Len(t, drops, 2)
+	require.Equal(t, "tx1", drops[0].GetTxId())
+	require.Equal(t, "alice", drops[0].GetSubmitter())
+	require.Equal(t, "batched", drops[0].GetStage())
+	require.Equal(t, err.Error(), drops[0].GetReason())
+	require.Equal(t, "tx2", drops[1].GetTxId())
+	require.Contains(t, drops[1].GetReason(), "flag: INVALID_DATABASE_DOES_NOT_EXIST, reason: no such database")
+
+	// a resubmission clears the rejection
+	pendingTxs.Add("tx1", "alice", nil)
+	require.Nil(t, pendingTxs.Rejection("tx1"))
+	require.NotNil(t, pendingTxs.Rejection("tx2"))
+}
+
+func TestPendingTxs_StageAndDetachOnTimeout(t *testing.T) {
+	pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+
+	p := queue.NewCompletionPromise(time.Millisecond)
+	pendingTxs.Add("tx1", "", p)
+	pendingTxs.Add("tx2", "", nil)
+
+	pendingTxs.UpdateStage([]string{"tx1", "tx2", "not-pending"}, queue.TxStageBatched, 0)
+	pendingTxs.UpdateStage([]string{"tx1"}, queue.TxStageProposed, 7)
+
+	receipt, err := p.Wait()
+	require.EqualError(t, err, "timeout has occurred while waiting for the transaction receipt")
+	require.Nil(t, receipt)
+
+	stage, blockNumber, pending := pendingTxs.DetachOnTimeout("tx1")
+	require.True(t, pending)
+	require.Equal(t, queue.TxStageProposed, stage)
+	require.Equal(t, "proposed", stage.String())
+	require.Equal(t, uint64(7), blockNumber)
+
+	stage, blockNumber, pending = pendingTxs.DetachOnTimeout("tx2")
+	require.True(t, pending)
+	require.Equal(t, queue.TxStageBatched, stage)
+	require.Equal(t, uint64(0), blockNumber)
+
+	// the detached transaction remains pending until its block commits
+	require.True(t, pendingTxs.Has("tx1"))
+	pendingTxs.UpdateStage([]string{"tx1", "tx2"}, queue.TxStageValidating, 7)
+	pendingTxs.DoneWithReceipt([]string{"tx1", "tx2", "not-pending"}, &types.BlockHeader{
+		BaseHeader: &types.BlockHeaderBase{Number: 7},
+	})
+	require.True(t, pendingTxs.Empty())
+
+	_, _, pending = pendingTxs.DetachOnTimeout("tx1")
+	require.False(t, pending)
+}
+
+func TestPendingTxs_QueuedFor(t *testing.T) {
+	pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+
+	pendingTxs.Add("tx1", "", nil)
+	time.Sleep(20 * time.Millisecond)
+	pendingTxs.Add("tx2", "", nil)
+
+	now := time.Now()
+	waits := pendingTxs.QueuedFor([]string{"tx1", "not-pending", "tx2"}, now)
+	require.Len(t, waits, 2)
+	require.GreaterOrEqual(t, waits[0], 20*time.Millisecond)
+	require.Less(t, waits[1], waits[0])
+	require.GreaterOrEqual(t, waits[1], time.Duration(0))
+
+	pendingTxs.DoneWithReceipt([]string{"tx1", "tx2"}, &types.BlockHeader{
+		BaseHeader: &types.BlockHeaderBase{Number: 2},
+	})
+	require.Empty(t, pendingTxs.QueuedFor([]string{"tx1", "tx2"}, now))
+}
+
+func TestPendingTxs_DropRecorder(t *testing.T) {
+	pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+
+	var drops []*types.DroppedTx
+	pendingTxs.SetDropRecorder(func(d []*types.DroppedTx) {
+		// the recorder is called outside of the lock
+		require.False(t, pendingTxs.Has(d[0].GetTxId()))
+		drops = append(drops, d...)
+	})
+
+	pendingTxs.Add("tx1", "alice", nil)
+	pendingTxs.Add("tx2", "bob", nil)
+	pendingTxs.Add("tx3", "carol", nil)
+	pendingTxs.Add("tx4", "dave", nil)
+	pendingTxs.UpdateStage([]string{"tx2"}, queue.TxStageProposed, 5)
+
+	before := time.Now().UnixNano()
+	pendingTxs.ReleaseExpired([]string{"tx1", "not-pending"}, &ierrors.TxExpiredError{ErrMsg: "expired"})
+	pendingTxs.ReleaseWithError([]string{"tx2", "not-pending"}, &ierrors.NotLeaderError{LeaderID: 1, LeaderHostPort: "10.10.10.10:666"})
+	// a withdrawn transaction was never accepted, hence it is not dead-lettered
+	pendingTxs.Withdraw("tx3", &ierrors.ServerRestrictionError{ErrMsg: "queue is full"})
+	// a committed transaction is not dropped
+	pendingTxs.DoneWithReceipt([]string{"tx4"}, &types.BlockHeader{BaseHeader: &types.BlockHeaderBase{Number: 6}})
+	require.True(t, pendingTxs.Empty())
+
+	require.Len(t, drops, 2)
+	require.Equal(t, "tx1", drops[0].GetTxId())
+	require.Equal(t, "alice", drops[0].GetSubmitter())
+	require.Equal(t, "queued", drops[0].GetStage())
+	require.Equal(t, "expired", drops[0].GetReason())
+	require.GreaterOrEqual(t, drops[0].GetDroppedAt(), before)
+	require.Equal(t, "tx2", drops[1].GetTxId())
+	require.Equal(t, "bob", drops[1].GetSubmitter())
+	require.Equal(t, "proposed", drops[1].GetStage())
+	require.Equal(t, (&ierrors.NotLeaderError{LeaderID: 1, LeaderHostPort: "10.10.10.10:666"}).Error(), drops[1].GetReason())
+	require.GreaterOrEqual(t, drops[1].GetDroppedAt(), drops[0].GetDroppedAt())
+}
@@ -2,12 +2,19 @@
 // SPDX-License-Identifier: Apache-2.0
 package queue
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrFull is returned by TryEnqueue when the queue has no room for the entry
+var ErrFull = errors.New("queue is full")
 
 // Queue is queue data structure implemented
 // using go channels
 type Queue struct {
-	entries chan interface{}
+	entries  chan interface{}
+	mediator Mediator // nil unless the queue is mediated, see NewMediated
 }
 
 // New creates a new queue of given size
@@ -19,12 +26,33 @@ func New(size uint32) *Queue {
 
 // Enqueue adds the entry to the tail of the queue
 func (q *Queue) Enqueue(entry interface{}) {
+	if q.mediator != nil {
+		q.mediator.Await(&Op{Queue: q, Kind: OpEnqueue, Entry: entry})
+	}
 	q.entries <- entry
 }
 
+// TryEnqueue adds the entry to the tail of the queue if the queue has room for it, and returns ErrFull otherwise. The
+// check and the addition are a single operation, hence, concurrent producers never overshoot the capacity of the
+// queue, and never block on a queue that a consumer drains meanwhile, as they would with IsFull followed by Enqueue.
+func (q *Queue) TryEnqueue(entry interface{}) error {
+	if q.mediator != nil {
+		q.mediator.Await(&Op{Queue: q, Kind: OpEnqueue, Entry: entry, NonBlocking: true})
+	}
+	select {
+	case q.entries <- entry:
+		return nil
+	default:
+		return ErrFull
+	}
+}
+
 // Dequeue removes and returns an entry from
 // the head of the queue
 func (q *Queue) Dequeue() interface{} {
+	if q.mediator != nil {
+		q.mediator.Await(&Op{Queue: q, Kind: OpDequeue})
+	}
 	return <-q.entries
 }
 
@@ -32,6 +60,10 @@ func (q *Queue) Dequeue() interface{} {
 // an entry from the queue. If the queue has been empty for the
 // specified duration, it will return nil
 func (q *Queue) DequeueWithWaitLimit(d time.Duration) interface{} {
+	if q.mediator != nil && q.mediator.Await(&Op{Queue: q, Kind: OpDequeue, WaitLimit: d}) {
+		return nil
+	}
+
 	ticker := time.NewTicker(d)
 	defer ticker.Stop()
 
@@ -48,7 +80,8 @@ func (q *Queue) Size() int {
 	return len(q.entries)
 }
 
-// IsFull returns true if the queue is full
+// IsFull returns true if the queue is full. As the queue may be consumed and produced concurrently, the result is a
+// snapshot; to enqueue only if there is room, use TryEnqueue.
 func (q *Queue) IsFull() bool {
 	return q.Size() == cap(q.entries)
 }
@@ -3,6 +3,8 @@
 package queue
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -69,3 +71,93 @@ func TestTransactionQueue(t *testing.T) {
 	// immediately as the queue is closed
 	require.Eventually(t, blockedDequeueWithWaitLimit, 1*time.Second, 100*time.Millisecond)
 }
+
+func TestTryEnqueue(t *testing.T) {
+	q := New(2)
+	require.NoError(t, q.TryEnqueue(1))
+	require.NoError(t, q.TryEnqueue(2))
+	require.Equal(t, ErrFull, q.TryEnqueue(3))
+	require.Equal(t, 2, q.Size())
+
+	require.Equal(t, 1, q.Dequeue())
+	require.NoError(t, q.TryEnqueue(3))
+	require.Equal(t, 2, q.Dequeue())
+	require.Equal(t, 3, q.Dequeue())
+}
+
+func TestTryEnqueueWithConcurrentProducers(t *testing.T) {
+	const (
+		producers          = 100
+		entriesPerProducer = 100
+		capacity           = 10
+	)
+	q := New(capacity)
+
+	var enqueued, rejected, dequeued int64
+	var maxSize int64
+	observeSize := func() {
+		size := int64(q.Size())
+		for {
+			observed := atomic.LoadInt64(&maxSize)
+			if size <= observed || atomic.CompareAndSwapInt64(&maxSize, observed, size) {
+				return
+			}
+		}
+	}
+
+	// the consumer drains the queue meanwhile, so that the occupancy changes while the producers decide
+	stopConsumer := make(chan struct{})
+	consumerDone := make(chan struct{})
+	go func() {
+		defer close(consumerDone)
+		for {
+			select {
+			case <-stopConsumer:
+				return
+			default:
+			}
+			if q.DequeueWithWaitLimit(time.Millisecond) != nil {
+				atomic.AddInt64(&dequeued, 1)
+			}
+			observeSize()
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < entriesPerProducer; i++ {
+				switch err := q.TryEnqueue(p*entriesPerProducer + i); err {
+				case nil:
+					atomic.AddInt64(&enqueued, 1)
+				case ErrFull:
+					atomic.AddInt64(&rejected, 1)
+				default:
+					t.Errorf("unexpected error: %s", err)
+				}
+				observeSize()
+			}
+		}(p)
+	}
+
+	// no producer blocks, even while the queue is full
+	producersDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(producersDone)
+	}()
+	select {
+	case <-producersDone:
+	case <-time.After(30 * time.Second):
+		t.Fatal("the producers are blocked")
+	}
+	close(stopConsumer)
+	<-consumerDone
+
+	require.LessOrEqual(t, atomic.LoadInt64(&maxSize), int64(capacity))
+	require.Equal(t, int64(producers*entriesPerProducer), enqueued+rejected)
+	require.NotZero(t, rejected)
+	require.Equal(t, enqueued, dequeued+int64(q.Size()))
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+const (
+	// TxLatencyEndToEnd is the stage name of the histograms that measure the time from the submission of a
+	// transaction until the commit of its block.
+	TxLatencyEndToEnd = "end-to-end"
+
+	numTxStages = int(TxStageCommitting) + 1
+)
+
+// LatencyBucketBounds are the upper bounds of the buckets of a LatencyHistogram. The last bucket of a histogram
+// counts the durations that exceed the last bound.
+var LatencyBucketBounds = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	20 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	200 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2 * time.Second,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// LatencyHistogram holds the durations that sampled transactions with a given validation outcome spent in a stage
+// of the transaction pipeline, or end-to-end.
+type LatencyHistogram struct {
+	// Stage is the name of a TxStage, or TxLatencyEndToEnd.
+	Stage string
+	// Outcome is the name of the validation flag of the transactions.
+	Outcome string
+	// Buckets holds len(LatencyBucketBounds)+1 counters.
+	Buckets []uint64
+	Count   uint64
+	Sum     time.Duration
+	Min     time.Duration
+	Max     time.Duration
+}
+
+func (h *LatencyHistogram) observe(d time.Duration) {
+	i := sort.Search(len(LatencyBucketBounds), func(i int) bool { return d <= LatencyBucketBounds[i] })
+	h.Buckets[i]++
+	if h.Count == 0 || d < h.Min {
+		h.Min = d
+	}
+	if d > h.Max {
+		h.Max = d
+	}
+	h.Count++
+	h.Sum += d
+}
+
+type latencyHistogramKey struct {
+	stage   string
+	outcome types.Flag
+}
+
+// TxLatencyTracker samples transactions at submission, and accumulates the time the sampled transactions spend
+// in each stage of the transaction pipeline into histograms, per stage and validation outcome. A transaction is
+// either sampled when it is added to the PendingTxs, or not tracked at all.
+type TxLatencyTracker struct {
+	sampleRate float64
+
+	mu         sync.Mutex
+	rand       *rand.Rand
+	histograms map[latencyHistogramKey]*LatencyHistogram
+}
+
+// NewTxLatencyTracker creates a tracker that samples the given fraction of the transactions, between 0 and 1.
+func NewTxLatencyTracker(sampleRate float64) *TxLatencyTracker {
+	return &TxLatencyTracker{
+		sampleRate: sampleRate,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		histograms: make(map[latencyHistogramKey]*LatencyHistogram),
+	}
+}
+
+func (t *TxLatencyTracker) sample() bool {
+	if t.sampleRate >= 1 {
+		return true
+	}
+	if t.sampleRate <= 0 {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rand.Float64() < t.sampleRate
+}
+
+// record accumulates the stage durations of a committed transaction, given the times it entered each stage. A
+// stage the transaction was not observed in, e.g., because the stage was skipped, is accounted with a zero duration.
+func (t *TxLatencyTracker) record(enteredAt []time.Time, committedAt time.Time, outcome types.Flag) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stageEnd := committedAt
+	for stage := numTxStages - 1; stage >= 0; stage-- {
+		stageStart := enteredAt[stage]
+		if stageStart.IsZero() || stageStart.After(stageEnd) {
+			stageStart = stageEnd
+		}
+		t.histogram(TxStage(stage).String(), outcome).observe(stageEnd.Sub(stageStart))
+		stageEnd = stageStart
+	}
+	t.histogram(TxLatencyEndToEnd, outcome).observe(committedAt.Sub(stageEnd))
+}
+
+func (t *TxLatencyTracker) histogram(stage string, outcome types.Flag) *LatencyHistogram {
+	key := latencyHistogramKey{stage: stage, outcome: outcome}
+	h, ok := t.histograms[key]
+	if !ok {
+		h = &LatencyHistogram{
+			Stage:   stage,
+			Outcome: outcome.String(),
+			Buckets: make([]uint64, len(LatencyBucketBounds)+1),
+		}
+		t.histograms[key] = h
+	}
+	return h
+}
+
+// Histograms returns a copy of the histograms accumulated so far, ordered by outcome and then by stage, with the
+// end-to-end histogram last.
+func (t *TxLatencyTracker) Histograms() []*LatencyHistogram {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	histograms := make([]*LatencyHistogram, 0, len(t.histograms))
+	for _, h := range t.histograms {
+		c := *h
+		c.Buckets = append([]uint64(nil), h.Buckets...)
+		histograms = append(histograms, &c)
+	}
+
+	stageOrder := func(stage string) int {
+		for s := 0; s < numTxStages; s++ {
+			if TxStage(s).String() == stage {
+				return s
+			}
+		}
+		return numTxStages
+	}
+	sort.Slice(histograms, func(i, j int) bool {
+		if histograms[i].Outcome != histograms[j].Outcome {
+			return histograms[i].Outcome < histograms[j].Outcome
+		}
+		return stageOrder(histograms[i].Stage) < stageOrder(histograms[j].Stage)
+	})
+
+	return histograms
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxLatencyTracker(t *testing.T) {
+	stages := []string{"queued", "batched", "proposed", "validating", "committing", queue.TxLatencyEndToEnd}
+
+	t.Run("all transactions sampled", func(t *testing.T) {
+		pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+		tracker := queue.NewTxLatencyTracker(1.0)
+		pendingTxs.SetLatencyTracker(tracker)
+
+		start := time.Now()
+		pendingTxs.Add("tx1", "", nil)
+		pendingTxs.Add("tx2", "", nil)
+		for _, stage := range []queue.TxStage{queue.TxStageBatched, queue.TxStageProposed, queue.TxStageValidating, queue.TxStageCommitting} {
+			time.Sleep(5 * time.Millisecond)
+			pendingTxs.UpdateStage([]string{"tx1", "tx2"}, stage, 3)
+		}
+		time.Sleep(5 * time.Millisecond)
+		pendingTxs.DoneWithReceipt([]string{"tx1", "tx2"}, &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{Number: 3},
+			ValidationInfo: []*types.ValidationInfo{
+				{Flag: types.Flag_VALID},
+				{Flag: types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE},
+			},
+		})
+		elapsed := time.Since(start)
+
+		histograms := tracker.Histograms()
+		require.Len(t, histograms, 12)
+		for i, h := range histograms {
+			if i < 6 {
+				require.Equal(t, types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE.String(), h.Outcome)
+			} else {
+				require.Equal(t, types.Flag_VALID.String(), h.Outcome)
+			}
+			require.Equal(t, stages[i%6], h.Stage)
+			require.Equal(t, uint64(1), h.Count)
+			require.Len(t, h.Buckets, len(queue.LatencyBucketBounds)+1)
+			require.GreaterOrEqual(t, h.Min, 5*time.Millisecond)
+			require.LessOrEqual(t, h.Max, elapsed)
+		}
+
+		var stagesSum time.Duration
+		for _, h := range histograms[6:11] {
+			stagesSum += h.Sum
+		}
+		require.Equal(t, histograms[11].Sum, stagesSum)
+	})
+
+	t.Run("skipped stages and unsampled transactions", func(t *testing.T) {
+		pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+		pendingTxs.Add("not-sampled", "", nil)
+
+		tracker := queue.NewTxLatencyTracker(1.0)
+		pendingTxs.SetLatencyTracker(tracker)
+		pendingTxs.Add("tx1", "", nil)
+		time.Sleep(5 * time.Millisecond)
+		pendingTxs.UpdateStage([]string{"tx1", "not-sampled"}, queue.TxStageValidating, 3)
+		pendingTxs.DoneWithReceipt([]string{"tx1", "not-sampled"}, &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{Number: 3},
+		})
+
+		histograms := tracker.Histograms()
+		require.Len(t, histograms, 6)
+		for i, h := range histograms {
+			require.Equal(t, stages[i], h.Stage)
+			require.Equal(t, uint64(1), h.Count)
+		}
+		require.GreaterOrEqual(t, histograms[0].Sum, 5*time.Millisecond)
+		require.Equal(t, time.Duration(0), histograms[1].Sum)
+		require.Equal(t, time.Duration(0), histograms[2].Sum)
+		require.Equal(t, time.Duration(0), histograms[4].Sum)
+	})
+
+	t.Run("sampling disabled", func(t *testing.T) {
+		pendingTxs := queue.NewPendingTxs(testLogger(t, "debug"))
+		tracker := queue.NewTxLatencyTracker(0)
+		pendingTxs.SetLatencyTracker(tracker)
+		pendingTxs.Add("tx1", "", nil)
+		pendingTxs.DoneWithReceipt([]string{"tx1"}, &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{Number: 3},
+		})
+		require.Empty(t, tracker.Histograms())
+	})
+}
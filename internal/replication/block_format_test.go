@@ -0,0 +1,111 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// oldFormatTestDataBlock returns a data block as produced by a node of the previous minor version, which stamps
+// neither the producer version nor the rules version.
+func oldFormatTestDataBlock(number uint64) *types.Block {
+	return fencingTestDataBlock(number, 0)
+}
+
+// commitBlockFormatTestBlock commits the block, standing in for the block processor, and returns the block the
+// processor got.
+func commitBlockFormatTestBlock(br *BlockReplicator, block *types.Block) (*types.Block, error) {
+	processed := make(chan *types.Block, 1)
+	go func() {
+		entry, err := br.oneQueueBarrier.Dequeue()
+		if err != nil {
+			return
+		}
+		processed <- entry.(*queue.BlockWithOrigin).Block
+		br.oneQueueBarrier.Reply(nil)
+	}()
+
+	if err := br.commitBlock(queue.NewBlockWithOrigin(block, queue.BlockOriginReplication, "node2"), true); err != nil {
+		return nil, err
+	}
+	return <-processed, nil
+}
+
+// Scenario: during a rolling upgrade, the upgraded receiver gets blocks from a producer of the previous minor version.
+func TestBlockReplicator_BlockFormat(t *testing.T) {
+	t.Run("produced blocks are stamped with the full header", func(t *testing.T) {
+		br, _ := newFencingTestReplicator(t, 0)
+
+		dataBlock := fencingTestDataBlock(0, 0)
+		_, _, doPropose := br.prepareProposal(dataBlock)
+		require.True(t, doPropose)
+		require.Equal(t, constants.ServerVersion, dataBlock.GetHeader().GetBaseHeader().GetProducerVersion())
+		require.Equal(t, constants.RulesVersion, dataBlock.GetHeader().GetBaseHeader().GetRulesVersion())
+	})
+
+	t.Run("tolerant receiver accepts an old-format block and validates it with the legacy rules", func(t *testing.T) {
+		br, _ := newFencingTestReplicator(t, 0)
+
+		processed, err := commitBlockFormatTestBlock(br, oldFormatTestDataBlock(2))
+		require.NoError(t, err)
+		require.Equal(t, constants.LegacyRulesVersion, utils.RulesVersion(processed.GetHeader().GetBaseHeader()))
+		require.Equal(t, uint64(2), br.getLastCommittedBlockNumber())
+
+		current := fencingTestDataBlock(3, 0)
+		current.Header.BaseHeader.ProducerVersion = constants.ServerVersion
+		current.Header.BaseHeader.RulesVersion = constants.RulesVersion
+		processed, err = commitBlockFormatTestBlock(br, current)
+		require.NoError(t, err)
+		require.Equal(t, constants.RulesVersion, utils.RulesVersion(processed.GetHeader().GetBaseHeader()))
+		require.Equal(t, uint64(3), br.getLastCommittedBlockNumber())
+	})
+
+	t.Run("tolerant receiver rejects an old-format block of another major version", func(t *testing.T) {
+		br, _ := newFencingTestReplicator(t, 0)
+
+		block := oldFormatTestDataBlock(2)
+		block.Header.BaseHeader.ProducerVersion = "99.0"
+		err := br.commitBlock(queue.NewBlockWithOrigin(block, queue.BlockOriginReplication, "node2"), true)
+		require.EqualError(t, err, "block [2] lacks a rules version and was produced by version [99.0], which is more than one minor version older than ["+constants.ServerVersion+"]")
+		require.Equal(t, uint64(1), br.getLastCommittedBlockNumber())
+	})
+
+	t.Run("strict receiver rejects an old-format block", func(t *testing.T) {
+		br, _ := newFencingTestReplicator(t, 0)
+		br.strictBlockFormat = true
+
+		// the block is rejected before it is enqueued for commit
+		err := br.commitBlock(queue.NewBlockWithOrigin(oldFormatTestDataBlock(2), queue.BlockOriginReplication, "node2"), true)
+		require.EqualError(t, err, "block [2] lacks a rules version, and old-format blocks are refused in strict mode")
+		require.Equal(t, uint64(1), br.getLastCommittedBlockNumber())
+
+		current := fencingTestDataBlock(2, 0)
+		current.Header.BaseHeader.ProducerVersion = constants.ServerVersion
+		current.Header.BaseHeader.RulesVersion = constants.RulesVersion
+		processed, err := commitBlockFormatTestBlock(br, current)
+		require.NoError(t, err)
+		require.Equal(t, constants.RulesVersion, utils.RulesVersion(processed.GetHeader().GetBaseHeader()))
+		require.Equal(t, uint64(2), br.getLastCommittedBlockNumber())
+	})
+
+	t.Run("receiver rejects a block that requires newer rules in both modes", func(t *testing.T) {
+		for _, strict := range []bool{false, true} {
+			br, _ := newFencingTestReplicator(t, 0)
+			br.strictBlockFormat = strict
+
+			block := fencingTestDataBlock(2, 0)
+			block.Header.BaseHeader.RulesVersion = constants.RulesVersion + 1
+			err := br.commitBlock(queue.NewBlockWithOrigin(block, queue.BlockOriginReplication, "node2"), true)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "requires the validation rules version")
+			require.Equal(t, uint64(1), br.getLastCommittedBlockNumber())
+		}
+	})
+}
@@ -20,6 +20,7 @@ import (
 	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/queue"
 	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
@@ -37,13 +38,16 @@ const (
 
 type BlockLedgerReader interface {
 	Height() (uint64, error)
-	Get(blockNumber uint64) (*types.Block, error)
+	// GetForReplay returns the block, or a block that holds its header alone if the node synced the block by the state
+	// delta of its range, see blockstore.Store.GetForReplay
+	GetForReplay(blockNumber uint64) (*types.Block, error)
 }
 
 //go:generate counterfeiter -o mocks/pending_txs.go --fake-name PendingTxsReleaser . PendingTxsReleaser
 
 type PendingTxsReleaser interface {
 	ReleaseWithError(txIDs []string, err error)
+	UpdateStage(txIDs []string, stage queue.TxStage, blockNumber uint64)
 }
 
 //go:generate counterfeiter -o mocks/config_tx_validator.go --fake-name ConfigTxValidator . ConfigTxValidator
@@ -89,6 +93,10 @@ type BlockReplicator struct {
 	numInFlightBlocks               uint32 // number of in-flight blocks
 	inFlightConfigBlockNumber       uint64 // the block number of the in-flight config, if any; 0 if none
 	condTooManyInFlightBlocks       *sync.Cond
+	committedEpoch                  uint64 // the fencing epoch of the last config committed
+	producerEpoch                   uint64 // the fencing epoch at which this node produces blocks as the leader
+	strictBlockFormat               bool   // refuse the blocks of nodes of the previous minor version
+	stateDeltaCatchUpThreshold      uint64 // catch up by the net state delta of a range at least this long; 0 if never
 
 	appliedIndex uint64
 
@@ -144,26 +152,30 @@ func NewBlockReplicator(conf *Config) (*BlockReplicator, error) {
 	}
 
 	br := &BlockReplicator{
-		localConf:            conf.LocalConf,
-		joinBlock:            conf.JoinBlock,
-		joinBlockNumber:      conf.JoinBlock.GetHeader().GetBaseHeader().GetNumber(), // if joinBlock==nil => 0
-		proposeCh:            make(chan *types.Block, 1),
-		raftID:               raftID,
-		raftStorage:          storage,
-		oneQueueBarrier:      conf.BlockOneQueueBarrier,
-		transport:            conf.Transport,
-		ledgerReader:         conf.LedgerReader,
-		pendingTxs:           conf.PendingTxs,
-		configTxValidator:    conf.ConfigValidator,
-		stopCh:               make(chan struct{}),
-		doneProposeCh:        make(chan struct{}),
-		doneEventCh:          make(chan struct{}),
-		clusterConfig:        conf.ClusterConfig,
-		cancelProposeContext: func() {}, //NOOP
-		sizeLimit:            conf.ClusterConfig.ConsensusConfig.RaftConfig.SnapshotIntervalSize,
-		lastSnapBlockNum:     snapBlkNum,
-		confState:            confState,
-		lg:                   lg,
+		localConf:                  conf.LocalConf,
+		joinBlock:                  conf.JoinBlock,
+		joinBlockNumber:            conf.JoinBlock.GetHeader().GetBaseHeader().GetNumber(), // if joinBlock==nil => 0
+		proposeCh:                  make(chan *types.Block, 1),
+		raftID:                     raftID,
+		raftStorage:                storage,
+		oneQueueBarrier:            conf.BlockOneQueueBarrier,
+		transport:                  conf.Transport,
+		ledgerReader:               conf.LedgerReader,
+		pendingTxs:                 conf.PendingTxs,
+		configTxValidator:          conf.ConfigValidator,
+		stopCh:                     make(chan struct{}),
+		doneProposeCh:              make(chan struct{}),
+		doneEventCh:                make(chan struct{}),
+		clusterConfig:              conf.ClusterConfig,
+		committedEpoch:             conf.ClusterConfig.GetEpoch(),
+		producerEpoch:              conf.ClusterConfig.GetEpoch(),
+		strictBlockFormat:          conf.LocalConf.Replication.StrictBlockFormat,
+		stateDeltaCatchUpThreshold: conf.LocalConf.Replication.StateDeltaCatchUpThreshold,
+		cancelProposeContext:       func() {}, //NOOP
+		sizeLimit:                  conf.ClusterConfig.ConsensusConfig.RaftConfig.SnapshotIntervalSize,
+		lastSnapBlockNum:           snapBlkNum,
+		confState:                  confState,
+		lg:                         lg,
 	}
 	br.condTooManyInFlightBlocks = sync.NewCond(&br.mutex)
 
@@ -173,7 +185,7 @@ func NewBlockReplicator(conf *Config) (*BlockReplicator, error) {
 	}
 
 	if height > 0 {
-		br.lastCommittedBlock, err = br.ledgerReader.Get(height)
+		br.lastCommittedBlock, err = br.ledgerReader.GetForReplay(height)
 		if err != nil {
 			br.lg.Panicf("Failed to read last block: %s", err)
 		}
@@ -505,6 +517,7 @@ func (br *BlockReplicator) processLeaderJustElected() {
 			br.lg.Infof("Start accepting requests as Raft new leader at block [%d]", br.lastCommittedBlock.GetHeader().GetBaseHeader().GetNumber())
 			br.justElectedInFlightBlocks = false
 			br.resetLastProposed()
+			br.producerEpoch = br.committedEpoch
 		}
 	}
 
@@ -534,6 +547,8 @@ func (br *BlockReplicator) processLeaderChanges(leader uint64) {
 			} else {
 				br.lg.Debugf("There are no in flight blocks, Raft new leader will serve requests, lastIndex: %d, appliedIndex: %d", lastIndex, br.appliedIndex)
 			}
+			// a node elected by the cluster produces blocks at the epoch it committed
+			br.producerEpoch = br.committedEpoch
 		}
 
 		if lostLeadership || assumedLeadership {
@@ -642,17 +657,25 @@ func (br *BlockReplicator) onBoard(joinBlock *types.Block) error {
 // When catching-up to a snapshot, we update `replication` and `comm` with each config block we bring.
 // When pulling blocks during on-boarding, we do not, because the latest cluster-config comes from the join-block.
 func (br *BlockReplicator) catchUpToBlock(initBlockNumber, targetBlockNumber uint64, updateConfig bool) error {
+	initBlockNumber, err := br.catchUpByStateDelta(initBlockNumber, targetBlockNumber, updateConfig)
+	if err != nil {
+		return err
+	}
+
 	for nextBlockNumber := initBlockNumber + 1; nextBlockNumber <= targetBlockNumber; {
 		var blocks []*types.Block
 		var err error
 		blocksReadyCh := make(chan struct{})
 		ctx, cancel := context.WithCancel(context.Background())
+		// The leader is only a hint to the puller, which may fetch the blocks from any other member.
+		leaderID := br.GetLeaderID()
+		peerID := br.nodeIDFromRaftID(leaderID)
 
 		//Try to pull some blocks in a go-routine so that we may cancel it if the server shuts down.
 		//Note that `PullBlocks` will not necessarily return all the blocks we requested, hence the enclosing loop.
 		go func() {
 			defer close(blocksReadyCh)
-			blocks, err = br.transport.PullBlocks(ctx, nextBlockNumber, targetBlockNumber, br.GetLeaderID())
+			blocks, err = br.transport.PullBlocks(ctx, nextBlockNumber, targetBlockNumber, leaderID)
 		}()
 
 		select {
@@ -680,7 +703,9 @@ func (br *BlockReplicator) catchUpToBlock(initBlockNumber, targetBlockNumber uin
 					blockToCommit.GetHeader().GetBaseHeader().GetNumber(),
 					blockToCommit.GetConsensusMetadata())
 
-				if err := br.commitBlock(blockToCommit, updateConfig); err != nil {
+				blockWithOrigin := queue.NewBlockWithOrigin(blockToCommit, queue.BlockOriginCatchUp, peerID)
+				blockWithOrigin.Backlog = targetBlockNumber - blockToCommit.GetHeader().GetBaseHeader().GetNumber()
+				if err := br.commitBlock(blockWithOrigin, updateConfig); err != nil {
 					lastBlockNumber := br.getLastCommittedBlockNumber()
 					switch err.(type) {
 					case *ierrors.ClosedError:
@@ -738,7 +763,11 @@ func (br *BlockReplicator) deliverEntries(committedEntries []raftpb.Entry) bool
 				RaftIndex: committedEntries[i].Index,
 			}
 
-			err := br.commitBlock(block, true)
+			origin, peerID := br.deliveredBlockOrigin()
+			blockWithOrigin := queue.NewBlockWithOrigin(block, origin, peerID)
+			// the entries which follow in the batch are an upper bound of the blocks waiting behind this one
+			blockWithOrigin.Backlog = uint64(len(committedEntries) - i - 1)
+			err := br.commitBlock(blockWithOrigin, true)
 			if err != nil {
 				br.lg.Errorf("commit block error: %s, stopping block replicator", err.Error())
 				return false
@@ -792,7 +821,8 @@ func (br *BlockReplicator) deliverEntries(committedEntries []raftpb.Entry) bool
 					RaftIndex: committedEntries[i].Index,
 				}
 
-				err := br.commitBlock(block, true) // transport is reconfigured within after the block commits.
+				origin, peerID := br.deliveredBlockOrigin()
+				err := br.commitBlock(queue.NewBlockWithOrigin(block, origin, peerID), true) // transport is reconfigured within after the block commits.
 				if err != nil {
 					br.lg.Errorf("commit block error: %s, stopping block replicator", err.Error())
 					return false
@@ -1005,6 +1035,16 @@ func (br *BlockReplicator) releasePendingTXs(blockToPropose *types.Block, reason
 	br.pendingTxs.ReleaseWithError(txIDs, reasonErr)
 }
 
+func (br *BlockReplicator) updatePendingTXsStage(block *types.Block, stage queue.TxStage) {
+	txIDs, err := utils.BlockPayloadToTxIDs(block.GetPayload())
+	if err != nil {
+		br.lg.Errorf("Failed to extract TxIDs from block: %v; error: %s", block.GetHeader(), err)
+		return
+	}
+
+	br.pendingTxs.UpdateStage(txIDs, stage, block.GetHeader().GetBaseHeader().GetNumber())
+}
+
 // prepareProposal Prepares the Raft proposal context and bytes, and determine whether to propose (only the leader can
 // propose). This also numbers the block and sets the base header hash.
 func (br *BlockReplicator) prepareProposal(blockToPropose *types.Block) (ctx context.Context, blockBytes []byte, doPropose bool) {
@@ -1018,6 +1058,15 @@ func (br *BlockReplicator) prepareProposal(blockToPropose *types.Block) (ctx con
 		return nil, nil, false //skip proposing
 	}
 
+	if errFenced := br.isFenced(); errFenced != nil {
+		br.mutex.Unlock() //do not call the pendingTxs component with a mutex locked
+
+		br.lg.Errorf("Refusing to produce blocks: %s", errFenced)
+		br.releasePendingTXs(blockToPropose, "Declined to propose block", errFenced)
+
+		return nil, nil, false //skip proposing
+	}
+
 	// number the block and set the base header hash
 	br.insertBlockBaseHeader(blockToPropose)
 
@@ -1031,6 +1080,8 @@ func (br *BlockReplicator) prepareProposal(blockToPropose *types.Block) (ctx con
 
 	br.mutex.Unlock()
 
+	br.updatePendingTXsStage(blockToPropose, queue.TxStageProposed)
+
 	return ctx, blockBytes, true
 }
 
@@ -1177,24 +1228,48 @@ func (br *BlockReplicator) GetClusterStatus() (leaderID uint64, activePeers map[
 // If the block is a config block, update the cluster config if `updateConfig` is true.
 // When catching-up to a snapshot, we update `replication` and `comm` with each config block we bring.
 // When pulling blocks during on-boarding, we do not, because the latest cluster-config comes from the join-block.
-func (br *BlockReplicator) commitBlock(block *types.Block, updateConfig bool) error {
+func (br *BlockReplicator) commitBlock(blockWithOrigin *queue.BlockWithOrigin, updateConfig bool) error {
+	block := blockWithOrigin.Block
 	blockNumber := block.GetHeader().GetBaseHeader().GetNumber()
-	br.lg.Infof("Enqueue for commit block [%d], ConsensusMetadata: %+v ",
-		blockNumber, block.GetConsensusMetadata())
+	br.lg.Infof("Enqueue for commit block [%d], origin: %s, peer: %s, ConsensusMetadata: %+v ",
+		blockNumber, blockWithOrigin.Origin, blockWithOrigin.PeerID, block.GetConsensusMetadata())
+
+	// When pulling blocks during on-boarding, the blocks precede the join-block, which the cluster already committed,
+	// and their producer epoch is lower than the epoch of the latest cluster-config, which comes from the join-block.
+	if updateConfig {
+		if err := br.checkProducerEpoch(block); err != nil {
+			br.lg.Errorf("Refusing to commit block [%d], origin: %s, peer: %s: %s", blockNumber, blockWithOrigin.Origin, blockWithOrigin.PeerID, err)
+			return err
+		}
+	}
+
+	// A block the local node cannot validate the way its producer did must not reach the block processor. The strict
+	// mode does not apply to the blocks pulled during on-boarding, which precede the join-block and may have been
+	// produced by older nodes long before.
+	strict := br.strictBlockFormat && updateConfig
+	if err := utils.CheckBlockFormat(block.GetHeader().GetBaseHeader(), constants.ServerVersion, constants.RulesVersion, strict); err != nil {
+		br.lg.Errorf("Refusing to commit block [%d], origin: %s, peer: %s: %s", blockNumber, blockWithOrigin.Origin, blockWithOrigin.PeerID, err)
+		return err
+	}
+
+	br.updatePendingTXsStage(block, queue.TxStageValidating)
 
 	// we can only get a valid config transaction
-	reConfig, err := br.oneQueueBarrier.EnqueueWait(block)
+	reConfig, err := br.oneQueueBarrier.EnqueueWait(blockWithOrigin)
 	if err != nil {
 		return err
 	}
 
-	br.setLastCommittedBlock(block)
+	var clusterConfig *types.ClusterConfig
+	if reConfig != nil {
+		clusterConfig = reConfig.(*types.ClusterConfig)
+	}
+	br.setLastCommittedBlock(block, clusterConfig)
 
-	if reConfig == nil {
+	if clusterConfig == nil {
 		return nil
 	}
 
-	clusterConfig := reConfig.(*types.ClusterConfig)
 	if !updateConfig {
 		br.lg.Infof("Skipping re-config update: block number [%d], ClusterConfig: %+v", blockNumber, clusterConfig)
 		return nil
@@ -1207,12 +1282,112 @@ func (br *BlockReplicator) commitBlock(block *types.Block, updateConfig bool) er
 	return nil
 }
 
-func (br *BlockReplicator) setLastCommittedBlock(block *types.Block) {
+// catchUpByStateDelta fast-forwards the ledger over the blocks that precede the target block by the net state delta of
+// their range, pulled from the leader, if the node lags behind by at least the state-delta catch-up threshold. The
+// target block is left to be pulled whole, so that the last committed block always carries its consensus metadata.
+// It returns the number of the last committed block, which is initBlockNumber if the delta could not be pulled or
+// applied, in which case the blocks are pulled instead.
+func (br *BlockReplicator) catchUpByStateDelta(initBlockNumber, targetBlockNumber uint64, updateConfig bool) (uint64, error) {
+	if br.stateDeltaCatchUpThreshold == 0 || targetBlockNumber < initBlockNumber+br.stateDeltaCatchUpThreshold ||
+		targetBlockNumber < initBlockNumber+2 {
+		return initBlockNumber, nil
+	}
+	leaderID := br.GetLeaderID()
+	if leaderID == 0 {
+		return initBlockNumber, nil
+	}
+	peerID := br.nodeIDFromRaftID(leaderID)
+	startBlockNumber, endBlockNumber := initBlockNumber+1, targetBlockNumber-1
+
+	var headers []*types.BlockHeader
+	var delta *types.StateDelta
+	var err error
+	deltaReadyCh := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer close(deltaReadyCh)
+		headers, delta, err = br.transport.PullStateDelta(ctx, leaderID, startBlockNumber, endBlockNumber)
+	}()
+
+	select {
+	case <-br.stopCh:
+		cancel()
+		<-deltaReadyCh
+		return initBlockNumber, &ierrors.ClosedError{ErrMsg: "server stopped during catch-up"}
+	case <-deltaReadyCh:
+		cancel()
+	}
+	if err != nil {
+		br.lg.Warnf("Failed to pull the state delta of blocks [%d, %d] from the leader [%s], pulling the blocks instead: %s",
+			startBlockNumber, endBlockNumber, peerID, err)
+		return initBlockNumber, nil
+	}
+	if len(headers) == 0 {
+		br.lg.Warnf("The leader [%s] returned no headers for the state delta of blocks [%d, %d], pulling the blocks instead",
+			peerID, startBlockNumber, endBlockNumber)
+		return initBlockNumber, nil
+	}
+
+	// The headers are checked as the blocks would be; the delta itself is verified against them by the block processor.
+	strict := br.strictBlockFormat && updateConfig
+	for _, header := range headers {
+		if updateConfig {
+			if err := br.checkProducerEpoch(&types.Block{Header: header}); err != nil {
+				br.lg.Warnf("Refusing the state delta of blocks [%d, %d] from peer [%s], pulling the blocks instead: %s",
+					startBlockNumber, endBlockNumber, peerID, err)
+				return initBlockNumber, nil
+			}
+		}
+		if err := utils.CheckBlockFormat(header.GetBaseHeader(), constants.ServerVersion, constants.RulesVersion, strict); err != nil {
+			br.lg.Warnf("Refusing the state delta of blocks [%d, %d] from peer [%s], pulling the blocks instead: %s",
+				startBlockNumber, endBlockNumber, peerID, err)
+			return initBlockNumber, nil
+		}
+	}
+
+	br.lg.Infof("Enqueue for commit the state delta of blocks [%d, %d], peer: %s, keys: %d",
+		startBlockNumber, endBlockNumber, peerID, len(delta.GetKeys()))
+	reply, err := br.oneQueueBarrier.EnqueueWait(queue.NewStateDeltaWithOrigin(headers, delta, peerID))
+	if err != nil {
+		return initBlockNumber, err
+	}
+	result := reply.(*queue.StateDeltaResult)
+	if result.Err != nil {
+		br.lg.Warnf("Failed to apply the state delta of blocks [%d, %d] from peer [%s], pulling the blocks instead: %s",
+			startBlockNumber, endBlockNumber, peerID, result.Err)
+		return initBlockNumber, nil
+	}
+
+	lastHeader := headers[len(headers)-1]
+	br.setLastCommittedBlock(&types.Block{Header: lastHeader}, result.ClusterConfig)
+	if result.ClusterConfig != nil {
+		if !updateConfig {
+			br.lg.Infof("Skipping re-config update: state delta of blocks [%d, %d], ClusterConfig: %+v",
+				startBlockNumber, endBlockNumber, result.ClusterConfig)
+		} else if err := br.updateClusterConfig(result.ClusterConfig); err != nil {
+			br.lg.Panicf("Failed to update to ClusterConfig during catch-up by state delta: error: %s", err)
+		}
+	}
+
+	return lastHeader.GetBaseHeader().GetNumber(), nil
+}
+
+// setLastCommittedBlock records the last block committed, and the epoch of the cluster-config it committed, if any.
+// The epoch is updated together with the release of the in-flight config, so that the leader numbers its next blocks
+// with the epoch of the config it proposed, i.e., a leader that bumps the epoch adopts it.
+func (br *BlockReplicator) setLastCommittedBlock(block *types.Block, committedConfig *types.ClusterConfig) {
 	br.mutex.Lock()
 	defer br.mutex.Unlock()
 
 	br.lastCommittedBlock = block
 
+	var ownConfig bool
+	if committedConfig.GetEpoch() > br.committedEpoch {
+		br.lg.Infof("Committed fencing epoch [%d] at block [%d], previous epoch: [%d]",
+			committedConfig.GetEpoch(), block.GetHeader().GetBaseHeader().GetNumber(), br.committedEpoch)
+		br.committedEpoch = committedConfig.GetEpoch()
+	}
+
 	var doBroadcast bool
 
 	if br.numInFlightBlocks > 0 { // only reduce on the leader
@@ -1223,6 +1398,7 @@ func (br *BlockReplicator) setLastCommittedBlock(block *types.Block) {
 	if br.inFlightConfigBlockNumber > 0 && utils.IsConfigBlock(block) { // only check on the leader
 		if br.inFlightConfigBlockNumber == block.GetHeader().GetBaseHeader().GetNumber() {
 			br.inFlightConfigBlockNumber = 0
+			ownConfig = block.GetHeader().GetBaseHeader().GetProducerEpoch() == br.producerEpoch
 			doBroadcast = true
 		} else {
 			br.lg.Panicf("A config block committed, but it is not the one expected to be in-flight! committed: %d, expected: %d",
@@ -1230,11 +1406,45 @@ func (br *BlockReplicator) setLastCommittedBlock(block *types.Block) {
 		}
 	}
 
+	if ownConfig && br.producerEpoch < br.committedEpoch {
+		br.lg.Infof("Producing blocks at fencing epoch [%d]", br.committedEpoch)
+		br.producerEpoch = br.committedEpoch
+	}
+
 	if doBroadcast {
 		br.condTooManyInFlightBlocks.Broadcast()
 	}
 }
 
+// isFenced returns an error if this node produces blocks at an epoch lower than the committed epoch, i.e., another node
+// was promoted in its place, and the cluster did not elect it since. Called inside a br.mutex.Lock().
+func (br *BlockReplicator) isFenced() *ierrors.FencedError {
+	if br.producerEpoch < br.committedEpoch {
+		return &ierrors.FencedError{
+			ErrMsg: fmt.Sprintf("this node produces blocks at epoch [%d], which is lower than the committed epoch [%d]", br.producerEpoch, br.committedEpoch),
+		}
+	}
+
+	return nil
+}
+
+// checkProducerEpoch returns an error if the block was produced at an epoch lower than the committed epoch, i.e., by a
+// node that was replaced by a promotion.
+func (br *BlockReplicator) checkProducerEpoch(block *types.Block) *ierrors.FencedError {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+
+	producerEpoch := block.GetHeader().GetBaseHeader().GetProducerEpoch()
+	if producerEpoch < br.committedEpoch {
+		return &ierrors.FencedError{
+			ErrMsg: fmt.Sprintf("block [%d] was produced at epoch [%d], which is lower than the committed epoch [%d]",
+				block.GetHeader().GetBaseHeader().GetNumber(), producerEpoch, br.committedEpoch),
+		}
+	}
+
+	return nil
+}
+
 func (br *BlockReplicator) getLastCommittedBlockNumber() uint64 {
 	br.mutex.Lock()
 	defer br.mutex.Unlock()
@@ -1265,19 +1475,40 @@ func (br *BlockReplicator) updateClusterConfig(clusterConfig *types.ClusterConfi
 	return nil
 }
 
-func (br *BlockReplicator) nodeHostPortFromRaftID(raftID uint64) string {
+// deliveredBlockOrigin determines the origin of a block delivered by consensus. Only the leader proposes blocks, hence
+// a block delivered to the leader was created locally; otherwise, it was replicated from the leader. Entries proposed
+// by a previous leader and delivered after a leader change are attributed to the current leader.
+func (br *BlockReplicator) deliveredBlockOrigin() (queue.BlockOrigin, string) {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+
+	if br.lastKnownLeader == br.raftID {
+		return queue.BlockOriginLocal, ""
+	}
+
+	return queue.BlockOriginReplication, br.nodeIDFromRaftID(br.lastKnownLeader)
+}
+
+func (br *BlockReplicator) nodeIDFromRaftID(raftID uint64) string {
 	if raftID == 0 {
 		return ""
 	}
 
-	var nodeID string
 	for _, p := range br.clusterConfig.ConsensusConfig.Members {
 		if p.RaftId == raftID {
-			nodeID = p.NodeId
-			break
+			return p.NodeId
 		}
 	}
 
+	return ""
+}
+
+func (br *BlockReplicator) nodeHostPortFromRaftID(raftID uint64) string {
+	if raftID == 0 {
+		return ""
+	}
+
+	nodeID := br.nodeIDFromRaftID(raftID)
 	if nodeID == "" {
 		br.lg.Warnf("not found: no member with RaftID: %d", raftID)
 		return ""
@@ -1375,6 +1606,10 @@ func (br *BlockReplicator) insertBlockBaseHeader(proposedBlock *types.Block) {
 	baseHeader := &types.BlockHeaderBase{
 		Number:                 blockNum,
 		PreviousBaseHeaderHash: br.lastProposedBlockHeaderBaseHash,
+		ProducerEpoch:          br.producerEpoch,
+		Timestamp:              time.Now().UnixNano(),
+		ProducerVersion:        constants.ServerVersion,
+		RulesVersion:           constants.RulesVersion,
 	}
 
 	if blockNum > 1 {
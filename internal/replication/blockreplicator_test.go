@@ -15,6 +15,7 @@ import (
 	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/queue"
 	"github.com/hyperledger-labs/orion-server/internal/replication"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -149,9 +150,10 @@ func TestBlockReplicator_Submit(t *testing.T) {
 		block2commit, err := env.conf.BlockOneQueueBarrier.Dequeue()
 		require.NoError(t, err)
 		require.NotNil(t, block2commit)
-		require.True(t, proto.Equal(proposeBlock.GetHeader(), block2commit.(*types.Block).GetHeader()), "in: %+v, out: %+v", proposeBlock, block2commit)
-		require.NotNil(t, block2commit.(*types.Block).GetConsensusMetadata())
-		raftIndex := block2commit.(*types.Block).GetConsensusMetadata().GetRaftIndex()
+		require.True(t, proto.Equal(proposeBlock.GetHeader(), block2commit.(*queue.BlockWithOrigin).Block.GetHeader()), "in: %+v, out: %+v", proposeBlock, block2commit)
+		require.NotNil(t, block2commit.(*queue.BlockWithOrigin).Block.GetConsensusMetadata())
+		require.Equal(t, queue.BlockOriginLocal, block2commit.(*queue.BlockWithOrigin).Origin)
+		raftIndex := block2commit.(*queue.BlockWithOrigin).Block.GetConsensusMetadata().GetRaftIndex()
 		require.True(t, raftIndex > 0)
 		err = env.conf.BlockOneQueueBarrier.Reply(nil)
 		require.NoError(t, err)
@@ -169,9 +171,9 @@ func TestBlockReplicator_Submit(t *testing.T) {
 		block2commit, err = env.conf.BlockOneQueueBarrier.Dequeue()
 		require.NoError(t, err)
 		require.NotNil(t, block2commit)
-		require.True(t, proto.Equal(proposeBlock.GetHeader(), block2commit.(*types.Block).GetHeader()), "in: %+v, out: %+v", proposeBlock, block2commit)
-		require.NotNil(t, block2commit.(*types.Block).GetConsensusMetadata())
-		require.True(t, block2commit.(*types.Block).GetConsensusMetadata().GetRaftIndex() > raftIndex)
+		require.True(t, proto.Equal(proposeBlock.GetHeader(), block2commit.(*queue.BlockWithOrigin).Block.GetHeader()), "in: %+v, out: %+v", proposeBlock, block2commit)
+		require.NotNil(t, block2commit.(*queue.BlockWithOrigin).Block.GetConsensusMetadata())
+		require.True(t, block2commit.(*queue.BlockWithOrigin).Block.GetConsensusMetadata().GetRaftIndex() > raftIndex)
 		err = env.conf.BlockOneQueueBarrier.Reply(nil)
 		require.NoError(t, err)
 
@@ -270,7 +272,7 @@ func TestBlockReplicator_Submit(t *testing.T) {
 		block2commit, err := env.conf.BlockOneQueueBarrier.Dequeue()
 		require.NoError(t, err)
 		require.NotNil(t, block2commit)
-		require.Equal(t, uint64(2), block2commit.(*types.Block).GetHeader().GetBaseHeader().GetNumber())
+		require.Equal(t, uint64(2), block2commit.(*queue.BlockWithOrigin).Block.GetHeader().GetBaseHeader().GetNumber())
 		err = env.conf.BlockOneQueueBarrier.Reply(nil)
 		require.NoError(t, err)
 
@@ -308,7 +310,7 @@ func TestBlockReplicator_Submit(t *testing.T) {
 
 		block2commit, err := env.conf.BlockOneQueueBarrier.Dequeue()
 		require.NoError(t, err)
-		err = env.ledger.Append(block2commit.(*types.Block))
+		err = env.ledger.Append(block2commit.(*queue.BlockWithOrigin).Block)
 		require.NoError(t, err)
 		err = env.conf.BlockOneQueueBarrier.Reply(nil)
 		require.NoError(t, err)
@@ -324,7 +326,7 @@ func TestBlockReplicator_Submit(t *testing.T) {
 		require.NoError(t, err)
 		block2commit, err = env.conf.BlockOneQueueBarrier.Dequeue()
 		require.NoError(t, err)
-		err = env.ledger.Append(block2commit.(*types.Block))
+		err = env.ledger.Append(block2commit.(*queue.BlockWithOrigin).Block)
 		require.NoError(t, err)
 		err = env.conf.BlockOneQueueBarrier.Reply(nil)
 		require.NoError(t, err)
@@ -366,7 +368,7 @@ func TestBlockReplicator_Submit(t *testing.T) {
 
 		block2commit, err = env.conf.BlockOneQueueBarrier.Dequeue()
 		require.NoError(t, err)
-		err = env.ledger.Append(block2commit.(*types.Block))
+		err = env.ledger.Append(block2commit.(*queue.BlockWithOrigin).Block)
 		require.NoError(t, err)
 		err = env.conf.BlockOneQueueBarrier.Reply(nil)
 		require.NoError(t, err)
@@ -566,6 +568,8 @@ func TestBlockReplicator_Snapshots(t *testing.T) {
 					PreviousBaseHeaderHash: make([]byte, 32), //just to get the length right
 					LastCommittedBlockHash: make([]byte, 32), //just to get the length right
 					LastCommittedBlockNum:  0,
+					ProducerVersion:        constants.ServerVersion, //just to get the length right
+					RulesVersion:           constants.RulesVersion,  //just to get the length right
 				},
 			},
 		}
@@ -124,7 +124,7 @@ func (n *nodeEnv) Restart() error {
 	//recreate
 	n.conf.Transport, _ = comm.NewHTTPTransport(
 		&comm.Config{
-			LedgerReader: n.conf.LedgerReader,
+			LedgerReader: n.conf.LedgerReader.(comm.LedgerReader),
 			LocalConf:    n.conf.LocalConf,
 			Logger:       n.conf.Logger,
 		},
@@ -170,7 +170,7 @@ func (n *nodeEnv) ServeCommit() {
 				lg.Errorf("Stopping to serve commit loop, error: %s", err)
 				return
 			}
-			block2commit := b.(*types.Block)
+			block2commit := b.(*queue.BlockWithOrigin).Block
 			err = n.ledger.Append(block2commit)
 			if err != nil {
 				lg.Panicf("Stopping to serve commit loop, error: %s", err)
@@ -689,6 +689,18 @@ func (l *memLedger) Get(blockNum uint64) (*types.Block, error) {
 	return l.ledger[blockNum-1], nil
 }
 
+func (l *memLedger) GetForReplay(blockNum uint64) (*types.Block, error) {
+	return l.Get(blockNum)
+}
+
+func (l *memLedger) GetRaw(blockNum uint64) ([]byte, error) {
+	block, err := l.Get(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(block)
+}
+
 func testLogger(t *testing.T, level string, opts ...zap.Option) *logger.SugarLogger {
 	c := &logger.Config{
 		Level:         level,
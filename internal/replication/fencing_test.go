@@ -0,0 +1,195 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package replication
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/raft"
+)
+
+type releasedTxs struct {
+	mutex sync.Mutex
+	errs  []error
+}
+
+func (r *releasedTxs) ReleaseWithError(_ []string, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.errs = append(r.errs, err)
+}
+
+func (r *releasedTxs) UpdateStage(_ []string, _ queue.TxStage, _ uint64) {}
+
+// newFencingTestReplicator creates a replicator which is the leader, and committed the genesis block with the given
+// epoch, but does not run raft. The blocks are proposed with prepareProposal, and committed with commitBlock.
+func newFencingTestReplicator(t *testing.T, epoch uint64) (*BlockReplicator, *releasedTxs) {
+	fencingLogger, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	clusterConfig := testClusterConfig()
+	clusterConfig.Epoch = epoch
+	released := &releasedTxs{}
+	br := &BlockReplicator{
+		raftID:               1,
+		raftStorage:          &RaftStorage{MemoryStorage: raft.NewMemoryStorage()},
+		oneQueueBarrier:      queue.NewOneQueueBarrier(fencingLogger),
+		pendingTxs:           released,
+		clusterConfig:        clusterConfig,
+		committedEpoch:       epoch,
+		producerEpoch:        epoch,
+		lastKnownLeader:      1,
+		cancelProposeContext: func() {},
+		lastCommittedBlock:   fencingTestConfigBlock(1, 0, clusterConfig),
+		lg:                   fencingLogger,
+	}
+	br.condTooManyInFlightBlocks = sync.NewCond(&br.mutex)
+	br.resetLastProposed()
+
+	return br, released
+}
+
+func fencingTestConfigBlock(number, producerEpoch uint64, clusterConfig *types.ClusterConfig) *types.Block {
+	return &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{Number: number, ProducerEpoch: producerEpoch},
+		},
+		Payload: &types.Block_ConfigTxEnvelope{
+			ConfigTxEnvelope: &types.ConfigTxEnvelope{
+				Payload: &types.ConfigTx{
+					TxId:      "config",
+					NewConfig: clusterConfig,
+				},
+			},
+		},
+	}
+}
+
+func fencingTestDataBlock(number, producerEpoch uint64) *types.Block {
+	return &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{Number: number, ProducerEpoch: producerEpoch},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{
+					{Payload: &types.DataTx{TxId: "data"}},
+				},
+			},
+		},
+	}
+}
+
+// commitFencingTestBlock commits the block, standing in for the block processor, which replies with the new config of
+// a config block. The transport is not set up, hence a config is not applied to it.
+func commitFencingTestBlock(br *BlockReplicator, block *types.Block) error {
+	go func() {
+		entry, err := br.oneQueueBarrier.Dequeue()
+		if err != nil {
+			return
+		}
+
+		var reply interface{}
+		if clusterConfig := entry.(*queue.BlockWithOrigin).Block.GetConfigTxEnvelope().GetPayload().GetNewConfig(); clusterConfig != nil {
+			reply = clusterConfig
+		}
+		br.oneQueueBarrier.Reply(reply)
+	}()
+
+	return br.commitBlock(queue.NewBlockWithOrigin(block, queue.BlockOriginReplication, "node2"), !utils.IsConfigBlock(block))
+}
+
+// Scenario: a node is promoted by a config transaction that bumps the epoch. The former primary, which comes back
+// without having been elected, refuses to produce blocks, and the blocks it produced before are rejected.
+func TestBlockReplicator_Fencing(t *testing.T) {
+	t.Run("promoted leader produces blocks at the epoch it committed", func(t *testing.T) {
+		br, released := newFencingTestReplicator(t, 0)
+
+		newConfig := proto.Clone(br.clusterConfig).(*types.ClusterConfig)
+		newConfig.Epoch = 1
+		configBlock := fencingTestConfigBlock(0, 0, newConfig)
+		_, _, doPropose := br.prepareProposal(configBlock)
+		require.True(t, doPropose)
+		require.Equal(t, uint64(2), configBlock.GetHeader().GetBaseHeader().GetNumber())
+		require.Equal(t, uint64(0), configBlock.GetHeader().GetBaseHeader().GetProducerEpoch())
+
+		// mark the config in-flight, as updateLastProposal does, without waiting for it to commit
+		br.mutex.Lock()
+		br.lastProposedBlockNumber = 2
+		br.numInFlightBlocks = 1
+		br.inFlightConfigBlockNumber = 2
+		br.mutex.Unlock()
+
+		require.NoError(t, commitFencingTestBlock(br, configBlock))
+		require.Equal(t, uint64(1), br.committedEpoch)
+		require.Equal(t, uint64(1), br.producerEpoch)
+
+		dataBlock := fencingTestDataBlock(0, 0)
+		_, _, doPropose = br.prepareProposal(dataBlock)
+		require.True(t, doPropose)
+		require.Equal(t, uint64(3), dataBlock.GetHeader().GetBaseHeader().GetNumber())
+		require.Equal(t, uint64(1), dataBlock.GetHeader().GetBaseHeader().GetProducerEpoch())
+		require.Empty(t, released.errs)
+	})
+
+	t.Run("former leader refuses to produce blocks until it is elected again", func(t *testing.T) {
+		br, released := newFencingTestReplicator(t, 0)
+
+		// the config which bumps the epoch was produced by the promoted node
+		newConfig := proto.Clone(br.clusterConfig).(*types.ClusterConfig)
+		newConfig.Epoch = 1
+		require.NoError(t, commitFencingTestBlock(br, fencingTestConfigBlock(2, 0, newConfig)))
+		require.Equal(t, uint64(1), br.committedEpoch)
+		require.Equal(t, uint64(0), br.producerEpoch)
+
+		_, _, doPropose := br.prepareProposal(fencingTestDataBlock(0, 0))
+		require.False(t, doPropose)
+		require.Len(t, released.errs, 1)
+		require.Equal(t, &ierrors.FencedError{
+			ErrMsg: "this node produces blocks at epoch [0], which is lower than the committed epoch [1]",
+		}, released.errs[0])
+
+		// once the cluster elects it, the node produces blocks at the committed epoch
+		br.processLeaderChanges(2)
+		br.processLeaderChanges(1)
+		dataBlock := fencingTestDataBlock(0, 0)
+		_, _, doPropose = br.prepareProposal(dataBlock)
+		require.True(t, doPropose)
+		require.Equal(t, uint64(1), dataBlock.GetHeader().GetBaseHeader().GetProducerEpoch())
+		require.Len(t, released.errs, 1)
+	})
+
+	t.Run("receiver rejects the blocks of the former leader", func(t *testing.T) {
+		br, _ := newFencingTestReplicator(t, 0)
+		br.processLeaderChanges(2)
+
+		newConfig := proto.Clone(br.clusterConfig).(*types.ClusterConfig)
+		newConfig.Epoch = 1
+		require.NoError(t, commitFencingTestBlock(br, fencingTestConfigBlock(2, 0, newConfig)))
+
+		// the block is rejected before it is enqueued for commit
+		err := br.commitBlock(queue.NewBlockWithOrigin(fencingTestDataBlock(3, 0), queue.BlockOriginCatchUp, "node1"), true)
+		require.Equal(t, &ierrors.FencedError{
+			ErrMsg: "block [3] was produced at epoch [0], which is lower than the committed epoch [1]",
+		}, err)
+		require.Equal(t, uint64(2), br.getLastCommittedBlockNumber())
+
+		require.NoError(t, commitFencingTestBlock(br, fencingTestDataBlock(3, 1)))
+		require.Equal(t, uint64(3), br.getLastCommittedBlockNumber())
+	})
+}
@@ -4,6 +4,7 @@ package mocks
 import (
 	"sync"
 
+	"github.com/hyperledger-labs/orion-server/internal/queue"
 	"github.com/hyperledger-labs/orion-server/internal/replication"
 )
 
@@ -14,6 +15,13 @@ type PendingTxsReleaser struct {
 		arg1 []string
 		arg2 error
 	}
+	UpdateStageStub        func([]string, queue.TxStage, uint64)
+	updateStageMutex       sync.RWMutex
+	updateStageArgsForCall []struct {
+		arg1 []string
+		arg2 queue.TxStage
+		arg3 uint64
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -55,11 +63,51 @@ func (fake *PendingTxsReleaser) ReleaseWithErrorArgsForCall(i int) ([]string, er
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *PendingTxsReleaser) UpdateStage(arg1 []string, arg2 queue.TxStage, arg3 uint64) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.updateStageMutex.Lock()
+	fake.updateStageArgsForCall = append(fake.updateStageArgsForCall, struct {
+		arg1 []string
+		arg2 queue.TxStage
+		arg3 uint64
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("UpdateStage", []interface{}{arg1Copy, arg2, arg3})
+	fake.updateStageMutex.Unlock()
+	if fake.UpdateStageStub != nil {
+		fake.UpdateStageStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *PendingTxsReleaser) UpdateStageCallCount() int {
+	fake.updateStageMutex.RLock()
+	defer fake.updateStageMutex.RUnlock()
+	return len(fake.updateStageArgsForCall)
+}
+
+func (fake *PendingTxsReleaser) UpdateStageCalls(stub func([]string, queue.TxStage, uint64)) {
+	fake.updateStageMutex.Lock()
+	defer fake.updateStageMutex.Unlock()
+	fake.UpdateStageStub = stub
+}
+
+func (fake *PendingTxsReleaser) UpdateStageArgsForCall(i int) ([]string, queue.TxStage, uint64) {
+	fake.updateStageMutex.RLock()
+	defer fake.updateStageMutex.RUnlock()
+	argsForCall := fake.updateStageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *PendingTxsReleaser) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.releaseWithErrorMutex.RLock()
 	defer fake.releaseWithErrorMutex.RUnlock()
+	fake.updateStageMutex.RLock()
+	defer fake.updateStageMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package stateindex
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/pkg/errors"
+)
+
+// The index database of a user database holds, next to the index entries, the number of index entries per attribute
+// and per attribute value, which the query planner uses to estimate the selectivity of a condition. As an index entry
+// is a JSON object, the counts are kept under keys that start with '~', which sort after all the index entries, and
+// hence never show up in a range scan over the index entries.
+const (
+	attributeCardinalityPrefix = "~a~"
+	valueCardinalityPrefix     = "~v~"
+)
+
+// AttributeCardinalityKey returns the key under which the number of index entries of the attribute is kept
+func AttributeCardinalityKey(attribute string) string {
+	return attributeCardinalityPrefix + attribute
+}
+
+// ValueCardinalityKey returns the key under which the number of index entries of the attribute value of the given
+// entry is kept, i.e., the entry without its key. The keys of the counts of an attribute sort in the order of the
+// values, like the index entries, hence the counts of the values in a range are found by a range scan between the
+// ValueCardinalityKey of the bounds of the range of the index entries.
+func ValueCardinalityKey(e *IndexEntry) (string, error) {
+	valueEntry := &IndexEntry{
+		Attribute:     e.Attribute,
+		Type:          e.Type,
+		ValuePosition: e.ValuePosition,
+		Value:         e.Value,
+		KeyPosition:   e.KeyPosition,
+	}
+
+	s, err := valueEntry.String()
+	if err != nil {
+		return "", err
+	}
+	return valueCardinalityPrefix + s, nil
+}
+
+// IsCardinalityKey returns true if the key of the index database holds a count rather than an index entry
+func IsCardinalityKey(key string) bool {
+	return strings.HasPrefix(key, attributeCardinalityPrefix) || strings.HasPrefix(key, valueCardinalityPrefix)
+}
+
+// DecodeCardinality decodes a count kept in the index database. An absent count decodes to zero.
+func DecodeCardinality(v []byte) (uint64, error) {
+	if len(v) == 0 {
+		return 0, nil
+	}
+	return strconv.ParseUint(string(v), 10, 64)
+}
+
+// cardinalityUpdates returns the updates of the counts of the index entries that the given index entries change. The
+// index entries to create do not exist, and the ones to delete exist, in the index database. An index database that
+// was populated before the counts were maintained has no counts for its existing entries, hence a count never drops
+// below zero, and the estimates are only as good as the entries created since.
+func cardinalityUpdates(toCreate, toDelete []string, db worldstate.DB, indexDBName string) ([]*worldstate.KVWithMetadata, []string, error) {
+	var keys []string
+	deltas := make(map[string]int64)
+
+	addDelta := func(entries []string, delta int64) error {
+		for _, entry := range entries {
+			e := &IndexEntry{}
+			if err := e.Load([]byte(entry)); err != nil {
+				return errors.Wrapf(err, "error while decoding the index entry [%s]", entry)
+			}
+			valueKey, err := ValueCardinalityKey(e)
+			if err != nil {
+				return err
+			}
+
+			for _, k := range []string{AttributeCardinalityKey(e.Attribute), valueKey} {
+				if _, ok := deltas[k]; !ok {
+					keys = append(keys, k)
+				}
+				deltas[k] += delta
+			}
+		}
+		return nil
+	}
+	if err := addDelta(toCreate, 1); err != nil {
+		return nil, nil, err
+	}
+	if err := addDelta(toDelete, -1); err != nil {
+		return nil, nil, err
+	}
+
+	var writes []*worldstate.KVWithMetadata
+	var deletes []string
+	for _, k := range keys {
+		if deltas[k] == 0 {
+			continue
+		}
+
+		v, _, err := db.Get(indexDBName, k)
+		if err != nil {
+			return nil, nil, err
+		}
+		current, err := DecodeCardinality(v)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error while decoding the count of [%s]", k)
+		}
+
+		updated := int64(current) + deltas[k]
+		if updated <= 0 {
+			if current > 0 {
+				deletes = append(deletes, k)
+			}
+			continue
+		}
+		writes = append(writes, &worldstate.KVWithMetadata{
+			Key:   k,
+			Value: []byte(strconv.FormatUint(uint64(updated), 10)),
+		})
+	}
+
+	return writes, deletes, nil
+}
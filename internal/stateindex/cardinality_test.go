@@ -0,0 +1,117 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package stateindex
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinality(t *testing.T) {
+	env := newIndexTestEnv(t)
+	defer env.cleanup()
+
+	index, err := json.Marshal(map[string]types.IndexAttributeType{
+		"a1": types.IndexAttributeType_NUMBER,
+		"a2": types.IndexAttributeType_STRING,
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: "db1", Value: index},
+				{Key: IndexDB("db1")},
+			},
+		},
+	}, 1))
+
+	commit := func(updates *worldstate.DBUpdates, blockNum uint64) {
+		dbsUpdates := map[string]*worldstate.DBUpdates{"db1": updates}
+		indexUpdates, err := ConstructIndexEntries(dbsUpdates, env.db)
+		require.NoError(t, err)
+		for indexDB, u := range indexUpdates {
+			dbsUpdates[indexDB] = u
+		}
+		require.NoError(t, env.db.Commit(dbsUpdates, blockNum))
+	}
+
+	cardinality := func(key string) uint64 {
+		v, _, err := env.db.Get(IndexDB("db1"), key)
+		require.NoError(t, err)
+		n, err := DecodeCardinality(v)
+		require.NoError(t, err)
+		return n
+	}
+	valueCardinality := func(attr string, attrType types.IndexAttributeType, value interface{}) uint64 {
+		key, err := ValueCardinalityKey(&IndexEntry{
+			Attribute:     attr,
+			Type:          attrType,
+			ValuePosition: Existing,
+			Value:         value,
+			KeyPosition:   Existing,
+		})
+		require.NoError(t, err)
+		return cardinality(key)
+	}
+
+	commit(&worldstate.DBUpdates{
+		Writes: []*worldstate.KVWithMetadata{
+			{Key: "person1", Value: []byte(`{"a1":10,"a2":"ten"}`)},
+			{Key: "person2", Value: []byte(`{"a1":10,"a2":"two"}`)},
+			{Key: "person3", Value: []byte(`{"a1":11}`)},
+			{Key: "person4", Value: []byte(`not a json value`)},
+		},
+	}, 2)
+	require.Equal(t, uint64(3), cardinality(AttributeCardinalityKey("a1")))
+	require.Equal(t, uint64(2), cardinality(AttributeCardinalityKey("a2")))
+	require.Equal(t, uint64(2), valueCardinality("a1", types.IndexAttributeType_NUMBER, EncodeInt64(10)))
+	require.Equal(t, uint64(1), valueCardinality("a1", types.IndexAttributeType_NUMBER, EncodeInt64(11)))
+	require.Equal(t, uint64(1), valueCardinality("a2", types.IndexAttributeType_STRING, "ten"))
+	require.Equal(t, uint64(1), valueCardinality("a2", types.IndexAttributeType_STRING, "two"))
+
+	// an update moves the key to another value, and a delete removes the key from all its values
+	commit(&worldstate.DBUpdates{
+		Writes: []*worldstate.KVWithMetadata{
+			{Key: "person1", Value: []byte(`{"a1":11,"a2":"ten"}`)},
+		},
+		Deletes: []string{"person2"},
+	}, 3)
+	require.Equal(t, uint64(2), cardinality(AttributeCardinalityKey("a1")))
+	require.Equal(t, uint64(1), cardinality(AttributeCardinalityKey("a2")))
+	require.Equal(t, uint64(0), valueCardinality("a1", types.IndexAttributeType_NUMBER, EncodeInt64(10)))
+	require.Equal(t, uint64(2), valueCardinality("a1", types.IndexAttributeType_NUMBER, EncodeInt64(11)))
+	require.Equal(t, uint64(1), valueCardinality("a2", types.IndexAttributeType_STRING, "ten"))
+	require.Equal(t, uint64(0), valueCardinality("a2", types.IndexAttributeType_STRING, "two"))
+
+	// the counts are not part of a range scan over the index entries
+	start, err := (&IndexEntry{Attribute: "a1", Type: types.IndexAttributeType_NUMBER, ValuePosition: Beginning}).String()
+	require.NoError(t, err)
+	end, err := (&IndexEntry{Attribute: "a2", Type: types.IndexAttributeType_STRING, ValuePosition: Ending}).String()
+	require.NoError(t, err)
+	itr, err := env.db.GetIterator(IndexDB("db1"), start, end)
+	require.NoError(t, err)
+	defer itr.Release()
+
+	var keys []string
+	for itr.Next() {
+		e := &IndexEntry{}
+		require.NoError(t, e.Load(itr.Key()))
+		keys = append(keys, e.Key)
+	}
+	require.Equal(t, []string{"person1", "person3", "person1"}, keys)
+}
+
+func TestAttributeValues(t *testing.T) {
+	value := []byte(`{"owner":"alice","size":7,"nested":{"owner":"bob"},"open":true}`)
+
+	require.ElementsMatch(t, []interface{}{"alice", "bob"}, AttributeValues(value, "owner", types.IndexAttributeType_STRING))
+	require.Equal(t, []interface{}{EncodeInt64(7)}, AttributeValues(value, "size", types.IndexAttributeType_NUMBER))
+	require.Equal(t, []interface{}{true}, AttributeValues(value, "open", types.IndexAttributeType_BOOLEAN))
+	require.Nil(t, AttributeValues(value, "size", types.IndexAttributeType_STRING))
+	require.Nil(t, AttributeValues(value, "missing", types.IndexAttributeType_STRING))
+	require.Nil(t, AttributeValues([]byte(`not a json value`), "owner", types.IndexAttributeType_STRING))
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
@@ -63,6 +64,15 @@ func ConstructIndexEntries(updates map[string]*worldstate.DBUpdates, db worldsta
 		}
 		dbUpdates.Deletes = append(dbUpdates.Deletes, oldIndexToBeDeleted...)
 
+		if db.Exist(IndexDB(dbName)) {
+			cardinalityWrites, cardinalityDeletes, err := cardinalityUpdates(newIndexToBeCreated, oldIndexToBeDeleted, db, IndexDB(dbName))
+			if err != nil {
+				return nil, err
+			}
+			dbUpdates.Writes = append(dbUpdates.Writes, cardinalityWrites...)
+			dbUpdates.Deletes = append(dbUpdates.Deletes, cardinalityDeletes...)
+		}
+
 		if len(dbUpdates.Writes) > 0 || len(dbUpdates.Deletes) > 0 {
 			indexEntries[IndexDB(dbName)] = dbUpdates
 		}
@@ -234,6 +244,17 @@ func partialIndexEntriesForValue(v reflect.Value, index map[string]types.IndexAt
 	return partialIndexEntries
 }
 
+// AttributeValues returns the values of the attribute in the JSON value, in the form used by the index entries, i.e.,
+// the values the index would hold for the attribute if it were indexed with the given type. As with the index, the
+// attribute is looked up in the nested objects too.
+func AttributeValues(value []byte, attribute string, t types.IndexAttributeType) []interface{} {
+	var values []interface{}
+	for _, e := range decodeJSONAndConstructIndexEntries("", value, map[string]types.IndexAttributeType{attribute: t}) {
+		values = append(values, e.Value)
+	}
+	return values
+}
+
 // GetValue returns the value used by the index creator and the associated metadata
 func GetValue(value interface{}, t types.IndexAttributeType) interface{} {
 	if t != types.IndexAttributeType_NUMBER {
@@ -343,3 +364,8 @@ func toStrings(indexEntries []*IndexEntry) ([]string, error) {
 func IndexDB(dbName string) string {
 	return indexDBPrefix + dbName
 }
+
+// IsIndexDB returns true if the given database holds the index entries of a user database
+func IsIndexDB(dbName string) bool {
+	return strings.HasPrefix(dbName, indexDBPrefix)
+}
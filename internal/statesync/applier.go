@@ -0,0 +1,331 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package statesync
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/mptrie"
+	"github.com/hyperledger-labs/orion-server/internal/provenance"
+	"github.com/hyperledger-labs/orion-server/internal/stateindex"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/state"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Applier applies net state deltas to the stores of a lagging node. The stores must not be used by a running
+// transaction pipeline while a delta is applied.
+type Applier struct {
+	db              worldstate.DB
+	blockStore      *blockstore.Store
+	stateTrieStore  mptrie.Store
+	provenanceStore *provenance.Store
+	logger          *logger.SugarLogger
+}
+
+// Config holds the stores an applier updates. The provenance store is nil if provenance is disabled.
+type Config struct {
+	DB              worldstate.DB
+	BlockStore      *blockstore.Store
+	StateTrieStore  mptrie.Store
+	ProvenanceStore *provenance.Store
+	Logger          *logger.SugarLogger
+}
+
+// NewApplier creates an applier
+func NewApplier(conf *Config) *Applier {
+	return &Applier{
+		db:              conf.DB,
+		blockStore:      conf.BlockStore,
+		stateTrieStore:  conf.StateTrieStore,
+		provenanceStore: conf.ProvenanceStore,
+		logger:          conf.Logger,
+	}
+}
+
+// Apply applies the net state delta of the blocks that follow the last committed block, whose headers are given in
+// order, and fast-forwards the ledger to the last of them:
+//   - the headers must be chained to the last committed block and to each other;
+//   - the delta is applied to the state trie in memory, and the resulting state root must match the state root of
+//     the last header, or else nothing is applied;
+//   - the written values are recorded in the provenance store, without the transactions that wrote them;
+//   - the delta is committed to the state database, the state trie is persisted, and the blocks are committed to the
+//     block store by their headers, as their payloads are not available, see blockstore.Store.CommitHeader. Hence,
+//     the node neither serves these blocks to its peers nor finds their transactions.
+//
+// The state database is committed first so that a failure in the middle leaves it ahead of the block store, which
+// prevents the node from starting; applying the same delta again completes the sync.
+func (a *Applier) Apply(headers []*types.BlockHeader, delta *types.StateDelta) error {
+	if a.stateTrieStore.IsDisabled() {
+		return errors.New("the state trie is disabled, hence the state delta cannot be verified")
+	}
+
+	height, err := a.blockStore.Height()
+	if err != nil {
+		return err
+	}
+	if err = a.checkStoresHeight(height); err != nil {
+		return err
+	}
+
+	start, end := delta.GetStartBlockNum(), delta.GetEndBlockNum()
+	if start != height+1 || end < start {
+		return errors.Errorf("the state delta of blocks [%d, %d] does not follow the last committed block [%d]", start, end, height)
+	}
+	if uint64(len(headers)) != end-start+1 {
+		return errors.Errorf("the state delta of blocks [%d, %d] comes with %d headers", start, end, len(headers))
+	}
+	if err = a.verifyHeadersChain(height, headers); err != nil {
+		return err
+	}
+
+	lastHeader, err := a.blockStore.GetHeader(height)
+	if err != nil {
+		return err
+	}
+	trie, err := mptrie.NewTrie(lastHeader.GetStateMerkelTreeRootHash(), a.stateTrieStore)
+	if err != nil {
+		return err
+	}
+
+	dbsUpdates, err := applyOnTrie(trie, delta)
+	if err != nil {
+		return a.rollback(err)
+	}
+
+	root, err := trie.Hash()
+	if err != nil {
+		return a.rollback(err)
+	}
+	if expected := headers[len(headers)-1].GetStateMerkelTreeRootHash(); !bytes.Equal(root, expected) {
+		return a.rollback(errors.Errorf("the state root after applying the state delta [%x] does not match the state root of block %d [%x]", root, end, expected))
+	}
+
+	if err = a.commitToProvenanceStore(dbsUpdates); err != nil {
+		return err
+	}
+	if err = a.commitToStateDB(height, end, dbsUpdates); err != nil {
+		return err
+	}
+	if err = trie.Commit(end); err != nil {
+		return err
+	}
+	for _, header := range headers {
+		if err = a.blockStore.CommitHeader(header); err != nil {
+			return err
+		}
+	}
+
+	a.logger.Infof("Applied the state delta of blocks [%d, %d], %d keys", start, end, len(delta.GetKeys()))
+	return nil
+}
+
+func (a *Applier) checkStoresHeight(height uint64) error {
+	stateDBHeight, err := a.db.Height()
+	if err != nil {
+		return err
+	}
+	trieHeight, err := a.stateTrieStore.Height()
+	if err != nil {
+		return err
+	}
+	if stateDBHeight != height || trieHeight != height {
+		return errors.Errorf("the state database [%d] and the state trie [%d] must be at the height of the block store [%d]",
+			stateDBHeight, trieHeight, height)
+	}
+
+	return nil
+}
+
+// verifyHeadersChain checks that each header links to the hash of the header of the previous block, which starts
+// from the last committed block, and that its cumulative counters add up from the counters of the previous header
+func (a *Applier) verifyHeadersChain(height uint64, headers []*types.BlockHeader) error {
+	prevHash, err := a.blockStore.GetHash(height)
+	if err != nil {
+		return err
+	}
+	prevHeader, err := a.blockStore.GetHeader(height)
+	if err != nil {
+		return err
+	}
+
+	for i, header := range headers {
+		blockNum := height + 1 + uint64(i)
+		if header.GetBaseHeader().GetNumber() != blockNum {
+			return errors.Errorf("expected the header of block [%d] but received [%d]", blockNum, header.GetBaseHeader().GetNumber())
+		}
+		if links := header.GetSkipchainHashes(); len(links) == 0 || !bytes.Equal(links[0], prevHash) {
+			return errors.Errorf("the header of block [%d] is not chained to the header of block [%d]", blockNum, blockNum-1)
+		}
+		if err = blockstore.VerifyHeaderCumulativeCounters(prevHeader, header); err != nil {
+			return err
+		}
+		prevHeader = header
+
+		if prevHash, err = blockstore.ComputeBlockHash(&types.Block{Header: header}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyOnTrie applies the delta to the state trie as the committer applies the updates of a block, and returns the
+// updates of the state database. A deleted key is first updated with the value it held when it was deleted, if the
+// delta carries it, as the trie keeps the hash of that value.
+func applyOnTrie(trie *mptrie.MPTrie, delta *types.StateDelta) (map[string]*worldstate.DBUpdates, error) {
+	dbsUpdates := make(map[string]*worldstate.DBUpdates)
+
+	for _, k := range delta.GetKeys() {
+		updates, ok := dbsUpdates[k.DbName]
+		if !ok {
+			updates = &worldstate.DBUpdates{}
+			dbsUpdates[k.DbName] = updates
+		}
+
+		key, err := state.ConstructCompositeKey(k.DbName, k.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		if !k.Deleted {
+			if err = trie.Update(key, k.Value); err != nil {
+				return nil, err
+			}
+			updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+				Key:      k.Key,
+				Value:    k.Value,
+				Metadata: k.Metadata,
+			})
+			continue
+		}
+
+		if k.Value != nil {
+			if err = trie.Update(key, k.Value); err != nil {
+				return nil, err
+			}
+		}
+		if _, err = trie.Delete(key); err != nil {
+			return nil, err
+		}
+		updates.Deletes = append(updates.Deletes, k.Key)
+	}
+
+	return dbsUpdates, nil
+}
+
+// commitToProvenanceStore records the values written by the delta, each linked to the value it replaced in the state
+// database, under the keys the provenance store knows them by.
+func (a *Applier) commitToProvenanceStore(dbsUpdates map[string]*worldstate.DBUpdates) error {
+	if a.provenanceStore == nil {
+		return nil
+	}
+
+	var values []*provenance.SyncedValue
+	for dbName, updates := range dbsUpdates {
+		for _, write := range updates.Writes {
+			key, ok := provenanceKey(dbName, write.Key)
+			if !ok {
+				continue
+			}
+
+			value := &provenance.SyncedValue{
+				DBName: dbName,
+				Write: &types.KVWithMetadata{
+					Key:      key,
+					Value:    write.Value,
+					Metadata: write.Metadata,
+				},
+			}
+			if a.db.Exist(dbName) {
+				version, err := a.db.GetVersion(dbName, write.Key)
+				if err != nil {
+					return err
+				}
+				value.OldVersion = version
+			}
+			values = append(values, value)
+		}
+	}
+
+	if err := a.provenanceStore.CommitSyncedValues(values); err != nil {
+		return errors.WithMessage(err, "error while committing the state delta to the provenance store")
+	}
+
+	return nil
+}
+
+// provenanceKey maps a key of the state database to the key of the provenance store, as the committer does. The
+// databases themselves, the records the storage reserves, and the sequences have no provenance.
+func provenanceKey(dbName, key string) (string, bool) {
+	switch dbName {
+	case worldstate.DatabasesDBName:
+		return "", false
+	case worldstate.UsersDBName:
+		if strings.HasPrefix(key, string(identity.UserNamespace)) {
+			return strings.TrimPrefix(key, string(identity.UserNamespace)), true
+		}
+		return "", false
+	case worldstate.ConfigDBName:
+		if key == worldstate.ConfigKey {
+			return key, true
+		}
+		if strings.HasPrefix(key, string(identity.NodeNamespace)) {
+			return strings.TrimPrefix(key, string(identity.NodeNamespace)), true
+		}
+		return "", false
+	default:
+		return key, !strings.HasPrefix(key, worldstate.ReservedKeyPrefix)
+	}
+}
+
+// commitToStateDB commits the updates of the state database along with the index entries derived from them. The
+// databases created by the delta are created first, by a commit that keeps the height, as a database must exist
+// before its keys are committed. The state digests of the updated databases are recorded at the last block of the
+// delta before anything else, as the committer records the digests of a block.
+func (a *Applier) commitToStateDB(height, end uint64, dbsUpdates map[string]*worldstate.DBUpdates) error {
+	digests, err := worldstate.ComputeDigests(a.db, end, dbsUpdates)
+	if err != nil {
+		return errors.WithMessage(err, "error while computing the state digests of the state delta")
+	}
+	if err = a.db.CommitDigests(digests, end); err != nil {
+		return errors.WithMessage(err, "error while recording the state digests of the state delta")
+	}
+
+	if dbs, ok := dbsUpdates[worldstate.DatabasesDBName]; ok && len(dbs.Writes) > 0 {
+		created := map[string]*worldstate.DBUpdates{
+			worldstate.DatabasesDBName: {Writes: dbs.Writes},
+		}
+		if err := a.db.Commit(created, height); err != nil {
+			return errors.WithMessage(err, "error while creating the databases of the state delta")
+		}
+		dbs.Writes = nil
+	}
+
+	indexUpdates, err := stateindex.ConstructIndexEntries(dbsUpdates, a.db)
+	if err != nil {
+		return errors.WithMessage(err, "failed to create index updates")
+	}
+	for indexDB, updates := range indexUpdates {
+		dbsUpdates[indexDB] = updates
+	}
+
+	if err = a.db.Commit(dbsUpdates, end); err != nil {
+		return errors.WithMessagef(err, "error while committing the state delta to the state database")
+	}
+
+	return nil
+}
+
+func (a *Applier) rollback(err error) error {
+	if rbErr := a.stateTrieStore.RollbackChanges(); rbErr != nil {
+		a.logger.Errorf("Failed to roll back the changes to the state trie: %s", rbErr)
+	}
+	return err
+}
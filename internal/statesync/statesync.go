@@ -0,0 +1,109 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package statesync lets a node that lags behind the ledger of its peers catch up by the net state delta of the
+// blocks it is missing, instead of by the blocks themselves. A source node records the state delta of every block it
+// commits. A lagging node pulls the headers of the missing blocks along with the net state delta of the range, i.e.,
+// the final state of every key the blocks changed, applies the delta, verifies the resulting state root against the
+// header of the last block, and fast-forwards its ledger over the range.
+package statesync
+
+import (
+	"sort"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// DeltaReader reads the state deltas recorded for committed blocks
+type DeltaReader interface {
+	GetStateDelta(blockNumber uint64) (*types.StateDelta, error)
+}
+
+// NetStateDelta folds the state deltas of the blocks in the range [start, end] into the net state delta of the
+// range. A key that is deleted by the range carries the value it was last written with in the range, if any, as the
+// state trie keeps the hash of the last value of a deleted key.
+func NetStateDelta(reader DeltaReader, start, end uint64) (*types.StateDelta, error) {
+	if start == 0 || start > end {
+		return nil, errors.Errorf("invalid block range [%d, %d]", start, end)
+	}
+
+	type dbKey struct {
+		dbName string
+		key    string
+	}
+	net := make(map[dbKey]*types.KeyStateDelta)
+
+	for blockNum := start; blockNum <= end; blockNum++ {
+		delta, err := reader.GetStateDelta(blockNum)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range delta.GetKeys() {
+			id := dbKey{dbName: k.DbName, key: k.Key}
+			if !k.Deleted {
+				net[id] = k
+				continue
+			}
+
+			deleted := &types.KeyStateDelta{
+				DbName:  k.DbName,
+				Key:     k.Key,
+				Deleted: true,
+			}
+			if prev, ok := net[id]; ok {
+				deleted.Value = prev.Value
+				deleted.Metadata = prev.Metadata
+			}
+			net[id] = deleted
+		}
+	}
+
+	netDelta := &types.StateDelta{
+		StartBlockNum: start,
+		EndBlockNum:   end,
+	}
+	for _, k := range net {
+		netDelta.Keys = append(netDelta.Keys, k)
+	}
+	sort.Slice(netDelta.Keys, func(i, j int) bool {
+		if netDelta.Keys[i].DbName != netDelta.Keys[j].DbName {
+			return netDelta.Keys[i].DbName < netDelta.Keys[j].DbName
+		}
+		return netDelta.Keys[i].Key < netDelta.Keys[j].Key
+	})
+
+	return netDelta, nil
+}
+
+// Source serves the headers and the net state deltas of ranges of blocks from the block store of a node that
+// records the state deltas of the blocks it commits.
+type Source struct {
+	blockStore *blockstore.Store
+}
+
+// NewSource creates a source on top of the block store
+func NewSource(blockStore *blockstore.Store) *Source {
+	return &Source{blockStore: blockStore}
+}
+
+// GetHeaders returns the headers of the blocks in the range [start, end]
+func (s *Source) GetHeaders(start, end uint64) ([]*types.BlockHeader, error) {
+	var headers []*types.BlockHeader
+	for blockNum := start; blockNum <= end; blockNum++ {
+		header, err := s.blockStore.GetHeader(blockNum)
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, header)
+	}
+
+	return headers, nil
+}
+
+// NetStateDelta returns the net state delta of the blocks in the range [start, end]
+func (s *Source) NetStateDelta(start, end uint64) (*types.StateDelta, error) {
+	return NetStateDelta(s.blockStore, start, end)
+}
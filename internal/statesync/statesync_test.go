@@ -0,0 +1,95 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package statesync
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type memDeltaReader map[uint64]*types.StateDelta
+
+func (r memDeltaReader) GetStateDelta(blockNumber uint64) (*types.StateDelta, error) {
+	delta, ok := r[blockNumber]
+	if !ok {
+		return nil, &interrors.NotFoundErr{Message: "not found"}
+	}
+	return delta, nil
+}
+
+func TestNetStateDelta(t *testing.T) {
+	metadata := func(blockNum uint64) *types.Metadata {
+		return &types.Metadata{Version: &types.Version{BlockNum: blockNum}}
+	}
+
+	reader := memDeltaReader{
+		2: {
+			StartBlockNum: 2,
+			EndBlockNum:   2,
+			Keys: []*types.KeyStateDelta{
+				{DbName: "db1", Key: "key1", Value: []byte("value1-2"), Metadata: metadata(2)},
+				{DbName: "db1", Key: "key2", Value: []byte("value2-2"), Metadata: metadata(2)},
+				{DbName: "db2", Key: "key1", Value: []byte("value1-2"), Metadata: metadata(2)},
+			},
+		},
+		3: {
+			StartBlockNum: 3,
+			EndBlockNum:   3,
+			Keys: []*types.KeyStateDelta{
+				{DbName: "db1", Key: "key1", Value: []byte("value1-3"), Metadata: metadata(3)},
+				{DbName: "db1", Key: "key2", Deleted: true},
+				{DbName: "db1", Key: "key3", Deleted: true},
+			},
+		},
+		4: {
+			StartBlockNum: 4,
+			EndBlockNum:   4,
+			Keys: []*types.KeyStateDelta{
+				{DbName: "db1", Key: "key3", Value: []byte("value3-4"), Metadata: metadata(4)},
+				{DbName: "db2", Key: "key1", Deleted: true},
+			},
+		},
+	}
+
+	t.Run("range of blocks", func(t *testing.T) {
+		delta, err := NetStateDelta(reader, 2, 4)
+		require.NoError(t, err)
+
+		expected := &types.StateDelta{
+			StartBlockNum: 2,
+			EndBlockNum:   4,
+			Keys: []*types.KeyStateDelta{
+				{DbName: "db1", Key: "key1", Value: []byte("value1-3"), Metadata: metadata(3)},
+				{DbName: "db1", Key: "key2", Value: []byte("value2-2"), Metadata: metadata(2), Deleted: true},
+				{DbName: "db1", Key: "key3", Value: []byte("value3-4"), Metadata: metadata(4)},
+				{DbName: "db2", Key: "key1", Value: []byte("value1-2"), Metadata: metadata(2), Deleted: true},
+			},
+		}
+		require.True(t, proto.Equal(expected, delta), "expected: %s, actual: %s", expected, delta)
+	})
+
+	t.Run("key deleted before the range", func(t *testing.T) {
+		delta, err := NetStateDelta(reader, 3, 3)
+		require.NoError(t, err)
+
+		require.Len(t, delta.GetKeys(), 3)
+		require.True(t, delta.GetKeys()[1].GetDeleted())
+		require.Nil(t, delta.GetKeys()[1].GetValue())
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		_, err := NetStateDelta(reader, 4, 3)
+		require.EqualError(t, err, "invalid block range [4, 3]")
+
+		_, err = NetStateDelta(reader, 0, 3)
+		require.EqualError(t, err, "invalid block range [0, 3]")
+
+		_, err = NetStateDelta(reader, 4, 5)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,507 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sysstate defines the records that the server keeps about itself in the reserved system database of the
+// state database, worldstate.SystemDBName, and the accessor functions to read and write them. The records are local
+// to a node: they are neither part of a block nor of the state trie, and a data transaction cannot reach them.
+//
+// The key schema of the system database is:
+//
+//	height          the number of the last block committed to the state database, uvarint encoded
+//	lastCommitInfo  the number of the last committed block and the time of its commit, as two big-endian
+//	                uint64 values, where the time is in nanoseconds since the Unix epoch, or zero if unknown
+//	digest/<db>/<n> the state digest of the database <db> once the block <n> is committed, where <n> is a big-endian
+//	                uint64, recorded only by the blocks that update the database
+//	skipped/<db>/<n> an empty record which marks that the updates of the block <n> to the database <db> were not
+//	                committed, as the commit to the database failed, where <n> is a big-endian uint64
+//	migration       the state of the last migration of the records to the current encoding, as one byte, followed by
+//	                the reason of the failure of a failed migration
+//	migration/<db>  the progress of the migration of the database <db>: one byte which is 1 once all its records are
+//	                migrated, followed by the last stored key migrated
+//	rollupHeight    the number of the last block aggregated into the daily rollups, uvarint encoded
+//	rollup/<d>      the daily rollup of the blocks whose timestamps fall on the day <d>, where <d> is the number of
+//	                days since the Unix epoch, in UTC, as a big-endian uint64; the numbers of blocks, transactions,
+//	                valid transactions, active users, and bytes written, and the numbers of the first and the last
+//	                block, as seven big-endian uint64 values
+//	rollupUser/<d>/<u> an empty record which marks that the user <u> signed a transaction of a block of the day <d>,
+//	                where <d> is a big-endian uint64
+//
+// The first two records are written by a single batch on each commit of the state database, while the digests of a
+// block are written by a batch of their own before the updates of the block are committed. The skipped commits of a
+// block are written by a batch of their own before the commit of the block advances the height. The migration records
+// are written by the migration job, after each batch of records it migrates. The rollup records of a block are written
+// by a single batch, along with the rollup height, once the block is committed. A new record must be added to the
+// schema above along with its accessor functions.
+package sysstate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var (
+	heightKey         = []byte("height")
+	lastCommitInfoKey = []byte("lastCommitInfo")
+	digestKeyPrefix   = "digest/"
+	skippedKeyPrefix  = "skipped/"
+	migrationKey      = []byte("migration")
+	migrationPrefix   = "migration/"
+	rollupHeightKey   = []byte("rollupHeight")
+	rollupKeyPrefix   = "rollup/"
+	rollupUserPrefix  = "rollupUser/"
+
+	// legacyHeightKey is the key under which the height was recorded in the metadata database, before the system
+	// database was introduced
+	legacyHeightKey = []byte("lastCommittedBlockNumber")
+)
+
+// Reader reads the raw records of the system database. A goleveldb database or snapshot satisfies it, and returns
+// leveldb.ErrNotFound for a missing record.
+type Reader interface {
+	Get(key []byte, ro *opt.ReadOptions) ([]byte, error)
+}
+
+// RangeReader reads the raw records of the system database which are looked up by a range of keys. A goleveldb
+// database or snapshot satisfies it.
+type RangeReader interface {
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+// Writer writes the raw records of the system database. A goleveldb batch satisfies it.
+type Writer interface {
+	Put(key, value []byte)
+}
+
+// Deleter deletes the raw records of the system database. A goleveldb batch satisfies it.
+type Deleter interface {
+	Delete(key []byte)
+}
+
+// CommitInfo describes the last commit to the state database.
+type CommitInfo struct {
+	// BlockNumber is the number of the last committed block
+	BlockNumber uint64
+	// CommittedAt is the time of the commit. It is zero for a commit which was recorded before the system database
+	// was introduced.
+	CommittedAt time.Time
+}
+
+// Height returns the number of the last block committed to the state database, or 0 if no block was committed.
+func Height(r Reader) (uint64, error) {
+	value, err := r.Get(heightKey, &opt.ReadOptions{})
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "error while retrieving the state database height")
+	}
+
+	return decodeHeight(value)
+}
+
+// GetLastCommitInfo returns the description of the last commit to the state database, or nil if no block was
+// committed.
+func GetLastCommitInfo(r Reader) (*CommitInfo, error) {
+	value, err := r.Get(lastCommitInfoKey, &opt.ReadOptions{})
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error while retrieving the last commit info")
+	}
+
+	if len(value) != 16 {
+		return nil, errors.Errorf("error while decoding the last commit info, expected 16 bytes, found %d", len(value))
+	}
+
+	info := &CommitInfo{
+		BlockNumber: binary.BigEndian.Uint64(value[:8]),
+	}
+	if nanos := binary.BigEndian.Uint64(value[8:]); nanos != 0 {
+		info.CommittedAt = time.Unix(0, int64(nanos))
+	}
+	return info, nil
+}
+
+// PutCommit records the given commit as the last commit to the state database, which updates the height as well.
+func PutCommit(w Writer, info *CommitInfo) {
+	height := make([]byte, binary.MaxVarintLen64)
+	w.Put(heightKey, height[:binary.PutUvarint(height, info.BlockNumber)])
+
+	var nanos int64
+	if !info.CommittedAt.IsZero() {
+		nanos = info.CommittedAt.UnixNano()
+	}
+	value := make([]byte, 16)
+	binary.BigEndian.PutUint64(value[:8], info.BlockNumber)
+	binary.BigEndian.PutUint64(value[8:], uint64(nanos))
+	w.Put(lastCommitInfoKey, value)
+}
+
+// GetDigest returns the state digest of the database recorded by the last block at or below the given block that
+// recorded one, along with the number of that block. It returns nil and zero if no such block recorded a digest.
+func GetDigest(r RangeReader, dbName string, blockNumber uint64) ([]byte, uint64, error) {
+	prefix := digestKeyPrefix + dbName + "/"
+	limit := digestKey(dbName, blockNumber)
+	limit = append(limit, 0) // the limit of the range is exclusive, and the key of the given block is included
+
+	itr := r.NewIterator(&util.Range{Start: []byte(prefix), Limit: limit}, &opt.ReadOptions{})
+	defer itr.Release()
+
+	if !itr.Last() {
+		if err := itr.Error(); err != nil {
+			return nil, 0, errors.Wrapf(err, "error while retrieving the state digest of database [%s] as of block [%d]", dbName, blockNumber)
+		}
+		return nil, 0, nil
+	}
+
+	key := itr.Key()
+	if len(key) != len(prefix)+8 {
+		return nil, 0, errors.Errorf("error while decoding the state digest key of database [%s], expected %d bytes, found %d", dbName, len(prefix)+8, len(key))
+	}
+	digest := make([]byte, len(itr.Value()))
+	copy(digest, itr.Value())
+
+	return digest, binary.BigEndian.Uint64(key[len(prefix):]), nil
+}
+
+// PutDigest records the state digest of the database once the given block is committed
+func PutDigest(w Writer, dbName string, blockNumber uint64, digest []byte) {
+	w.Put(digestKey(dbName, blockNumber), digest)
+}
+
+func digestKey(dbName string, blockNumber uint64) []byte {
+	return blockKey(digestKeyPrefix, dbName, blockNumber)
+}
+
+// GetSkippedCommits returns, for each database with a skipped commit, the numbers of the blocks whose updates to the
+// database were not committed, in ascending order.
+func GetSkippedCommits(r RangeReader) (map[string][]uint64, error) {
+	itr := r.NewIterator(util.BytesPrefix([]byte(skippedKeyPrefix)), &opt.ReadOptions{})
+	defer itr.Release()
+
+	skipped := make(map[string][]uint64)
+	for itr.Next() {
+		key := itr.Key()[len(skippedKeyPrefix):]
+		if len(key) < 10 || key[len(key)-9] != '/' {
+			return nil, errors.Errorf("error while decoding the skipped commit key [%s]", itr.Key())
+		}
+		dbName := string(key[:len(key)-9])
+		skipped[dbName] = append(skipped[dbName], binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+	if err := itr.Error(); err != nil {
+		return nil, errors.Wrap(err, "error while retrieving the skipped commits")
+	}
+
+	return skipped, nil
+}
+
+// PutSkippedCommit records that the updates of the given block to the database were not committed
+func PutSkippedCommit(w Writer, dbName string, blockNumber uint64) {
+	w.Put(blockKey(skippedKeyPrefix, dbName, blockNumber), []byte{})
+}
+
+// DeleteSkippedCommit removes the record of a skipped commit, once the updates of the block are committed to the
+// database
+func DeleteSkippedCommit(d Deleter, dbName string, blockNumber uint64) {
+	d.Delete(blockKey(skippedKeyPrefix, dbName, blockNumber))
+}
+
+func blockKey(prefix, dbName string, blockNumber uint64) []byte {
+	key := make([]byte, 0, len(prefix)+len(dbName)+1+8)
+	key = append(key, prefix+dbName+"/"...)
+	var num [8]byte
+	binary.BigEndian.PutUint64(num[:], blockNumber)
+	return append(key, num[:]...)
+}
+
+// MigrationState is the state of a migration of the records of the state database to the current encoding
+type MigrationState uint8
+
+const (
+	MigrationRunning MigrationState = iota + 1
+	MigrationAborted
+	MigrationCompleted
+	MigrationFailed
+)
+
+// Migration describes the last migration of the records of the state database to the current encoding.
+type Migration struct {
+	State MigrationState
+	// Err is the reason of the failure of a failed migration
+	Err string
+}
+
+// MigrationProgress describes the progress of the migration of a database.
+type MigrationProgress struct {
+	// LastKey is the last stored key that was migrated
+	LastKey []byte
+	// Done is true once all the records of the database are migrated
+	Done bool
+}
+
+// GetMigration returns the description of the last migration, or nil if no migration was started.
+func GetMigration(r Reader) (*Migration, error) {
+	value, err := r.Get(migrationKey, &opt.ReadOptions{})
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error while retrieving the migration record")
+	}
+
+	if len(value) == 0 {
+		return nil, errors.New("error while decoding the migration record, found an empty record")
+	}
+	return &Migration{
+		State: MigrationState(value[0]),
+		Err:   string(value[1:]),
+	}, nil
+}
+
+// PutMigration records the description of the last migration
+func PutMigration(w Writer, m *Migration) {
+	w.Put(migrationKey, append([]byte{byte(m.State)}, m.Err...))
+}
+
+// GetMigrationProgress returns the progress of the migration of each database that was migrated, at least in part.
+func GetMigrationProgress(r RangeReader) (map[string]*MigrationProgress, error) {
+	itr := r.NewIterator(util.BytesPrefix([]byte(migrationPrefix)), &opt.ReadOptions{})
+	defer itr.Release()
+
+	progress := make(map[string]*MigrationProgress)
+	for itr.Next() {
+		value := itr.Value()
+		if len(value) == 0 {
+			return nil, errors.Errorf("error while decoding the migration progress record [%s], found an empty record", itr.Key())
+		}
+		progress[string(itr.Key()[len(migrationPrefix):])] = &MigrationProgress{
+			LastKey: append([]byte(nil), value[1:]...),
+			Done:    value[0] == 1,
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return nil, errors.Wrap(err, "error while retrieving the migration progress")
+	}
+
+	return progress, nil
+}
+
+// PutMigrationProgress records the progress of the migration of the database
+func PutMigrationProgress(w Writer, dbName string, p *MigrationProgress) {
+	value := make([]byte, 1, len(p.LastKey)+1)
+	if p.Done {
+		value[0] = 1
+	}
+	w.Put([]byte(migrationPrefix+dbName), append(value, p.LastKey...))
+}
+
+// DeleteMigrationProgress removes the record of the progress of the migration of the database, such that the next
+// migration starts from its first record
+func DeleteMigrationProgress(d Deleter, dbName string) {
+	d.Delete([]byte(migrationPrefix + dbName))
+}
+
+// DailyRollup aggregates the blocks whose timestamps fall on a day, in UTC.
+type DailyRollup struct {
+	// Day is the number of days since the Unix epoch
+	Day    uint64
+	Blocks uint64
+	// Transactions counts the transactions of the blocks, valid or not, and ValidTransactions the valid ones
+	Transactions      uint64
+	ValidTransactions uint64
+	// ActiveUsers counts the distinct users who signed a transaction of the blocks
+	ActiveUsers uint64
+	// BytesWritten sums the sizes of the keys and the values written by the valid transactions of the blocks
+	BytesWritten uint64
+	FirstBlock   uint64
+	LastBlock    uint64
+}
+
+const dailyRollupSize = 7 * 8
+
+// GetRollupHeight returns the number of the last block aggregated into the daily rollups, and false if the rollups
+// were never recorded.
+func GetRollupHeight(r Reader) (uint64, bool, error) {
+	value, err := r.Get(rollupHeightKey, &opt.ReadOptions{})
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrap(err, "error while retrieving the rollup height")
+	}
+
+	height, err := decodeHeight(value)
+	if err != nil {
+		return 0, false, err
+	}
+	return height, true, nil
+}
+
+// PutRollupHeight records the number of the last block aggregated into the daily rollups
+func PutRollupHeight(w Writer, blockNumber uint64) {
+	height := make([]byte, binary.MaxVarintLen64)
+	w.Put(rollupHeightKey, height[:binary.PutUvarint(height, blockNumber)])
+}
+
+// GetDailyRollup returns the rollup of the given day, or nil if no block of the day was aggregated.
+func GetDailyRollup(r Reader, day uint64) (*DailyRollup, error) {
+	value, err := r.Get(dayKey(rollupKeyPrefix, day), &opt.ReadOptions{})
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while retrieving the rollup of day [%d]", day)
+	}
+
+	return decodeDailyRollup(day, value)
+}
+
+// GetDailyRollups returns the rollups of the days from startDay to endDay, both included, in ascending order. The days
+// on which no block was aggregated are left out.
+func GetDailyRollups(r RangeReader, startDay, endDay uint64) ([]*DailyRollup, error) {
+	limit := dayKey(rollupKeyPrefix, endDay)
+	limit = append(limit, 0) // the limit of the range is exclusive, and the end day is included
+
+	itr := r.NewIterator(&util.Range{Start: dayKey(rollupKeyPrefix, startDay), Limit: limit}, &opt.ReadOptions{})
+	defer itr.Release()
+
+	var rollups []*DailyRollup
+	for itr.Next() {
+		key := itr.Key()
+		if len(key) != len(rollupKeyPrefix)+8 {
+			return nil, errors.Errorf("error while decoding the rollup key [%s], expected %d bytes, found %d", key, len(rollupKeyPrefix)+8, len(key))
+		}
+		rollup, err := decodeDailyRollup(binary.BigEndian.Uint64(key[len(rollupKeyPrefix):]), itr.Value())
+		if err != nil {
+			return nil, err
+		}
+		rollups = append(rollups, rollup)
+	}
+	if err := itr.Error(); err != nil {
+		return nil, errors.Wrap(err, "error while retrieving the daily rollups")
+	}
+
+	return rollups, nil
+}
+
+// PutDailyRollup records the rollup of its day
+func PutDailyRollup(w Writer, rollup *DailyRollup) {
+	value := make([]byte, dailyRollupSize)
+	for i, v := range []uint64{
+		rollup.Blocks,
+		rollup.Transactions,
+		rollup.ValidTransactions,
+		rollup.ActiveUsers,
+		rollup.BytesWritten,
+		rollup.FirstBlock,
+		rollup.LastBlock,
+	} {
+		binary.BigEndian.PutUint64(value[i*8:], v)
+	}
+	w.Put(dayKey(rollupKeyPrefix, rollup.Day), value)
+}
+
+// HasRollupUser returns true if the user was counted as an active user of the given day
+func HasRollupUser(r Reader, day uint64, userID string) (bool, error) {
+	_, err := r.Get(rollupUserKey(day, userID), &opt.ReadOptions{})
+	if err == leveldb.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "error while retrieving the active user [%s] of day [%d]", userID, day)
+	}
+	return true, nil
+}
+
+// PutRollupUser records that the user was counted as an active user of the given day
+func PutRollupUser(w Writer, day uint64, userID string) {
+	w.Put(rollupUserKey(day, userID), []byte{})
+}
+
+func decodeDailyRollup(day uint64, value []byte) (*DailyRollup, error) {
+	if len(value) != dailyRollupSize {
+		return nil, errors.Errorf("error while decoding the rollup of day [%d], expected %d bytes, found %d", day, dailyRollupSize, len(value))
+	}
+
+	field := func(i int) uint64 {
+		return binary.BigEndian.Uint64(value[i*8:])
+	}
+	return &DailyRollup{
+		Day:               day,
+		Blocks:            field(0),
+		Transactions:      field(1),
+		ValidTransactions: field(2),
+		ActiveUsers:       field(3),
+		BytesWritten:      field(4),
+		FirstBlock:        field(5),
+		LastBlock:         field(6),
+	}, nil
+}
+
+func dayKey(prefix string, day uint64) []byte {
+	key := make([]byte, 0, len(prefix)+8)
+	key = append(key, prefix...)
+	var num [8]byte
+	binary.BigEndian.PutUint64(num[:], day)
+	return append(key, num[:]...)
+}
+
+func rollupUserKey(day uint64, userID string) []byte {
+	key := dayKey(rollupUserPrefix, day)
+	key = append(key, '/')
+	return append(key, userID...)
+}
+
+// MigrateLegacyRecords moves the records that were kept in the metadata database, before the system database was
+// introduced, into the system database. It returns true if there was a record to move. The records are first written
+// to the system database and then deleted from the metadata database, hence, a migration which was interrupted by a
+// crash is completed on the next call, without overwriting the records written in between.
+func MigrateLegacyRecords(metadataDB, systemDB *leveldb.DB) (bool, error) {
+	legacyHeight, err := metadataDB.Get(legacyHeightKey, &opt.ReadOptions{})
+	if err == leveldb.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "error while retrieving the legacy height record")
+	}
+
+	_, err = systemDB.Get(heightKey, &opt.ReadOptions{})
+	switch {
+	case err == leveldb.ErrNotFound:
+		height, err := decodeHeight(legacyHeight)
+		if err != nil {
+			return false, err
+		}
+
+		batch := &leveldb.Batch{}
+		PutCommit(batch, &CommitInfo{BlockNumber: height})
+		if err := systemDB.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+			return false, errors.Wrapf(err, "error while writing the migrated height [%d] to the system database", height)
+		}
+	case err != nil:
+		return false, errors.Wrap(err, "error while retrieving the height record")
+	}
+
+	if err := metadataDB.Delete(legacyHeightKey, &opt.WriteOptions{Sync: true}); err != nil {
+		return false, errors.Wrap(err, "error while deleting the legacy height record")
+	}
+
+	return true, nil
+}
+
+func decodeHeight(value []byte) (uint64, error) {
+	height, err := binary.ReadUvarint(bytes.NewBuffer(value))
+	if err != nil {
+		return 0, errors.Wrap(err, "error while decoding the stored height")
+	}
+
+	return height, nil
+}
@@ -0,0 +1,290 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sysstate
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+func newTestDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "sysstate")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func openDB(t *testing.T, path string) *leveldb.DB {
+	db, err := leveldb.OpenFile(path, &opt.Options{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+	return db
+}
+
+func TestCommitRecords(t *testing.T) {
+	t.Parallel()
+
+	db := openDB(t, filepath.Join(newTestDir(t), "system"))
+
+	height, err := Height(db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), height)
+	info, err := GetLastCommitInfo(db)
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	committedAt := time.Unix(1700000000, 42)
+	batch := &leveldb.Batch{}
+	PutCommit(batch, &CommitInfo{BlockNumber: 300, CommittedAt: committedAt})
+	require.NoError(t, db.Write(batch, nil))
+
+	height, err = Height(db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), height)
+	info, err = GetLastCommitInfo(db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), info.BlockNumber)
+	require.True(t, committedAt.Equal(info.CommittedAt))
+
+	// a commit of unknown time is read back with a zero time
+	batch = &leveldb.Batch{}
+	PutCommit(batch, &CommitInfo{BlockNumber: 301})
+	require.NoError(t, db.Write(batch, nil))
+	info, err = GetLastCommitInfo(db)
+	require.NoError(t, err)
+	require.Equal(t, &CommitInfo{BlockNumber: 301}, info)
+
+	require.NoError(t, db.Put(lastCommitInfoKey, []byte("short"), nil))
+	_, err = GetLastCommitInfo(db)
+	require.EqualError(t, err, "error while decoding the last commit info, expected 16 bytes, found 5")
+}
+
+func TestRollupRecords(t *testing.T) {
+	t.Parallel()
+
+	db := openDB(t, filepath.Join(newTestDir(t), "system"))
+
+	height, recorded, err := GetRollupHeight(db)
+	require.NoError(t, err)
+	require.False(t, recorded)
+	require.Equal(t, uint64(0), height)
+	rollup, err := GetDailyRollup(db, 20000)
+	require.NoError(t, err)
+	require.Nil(t, rollup)
+
+	rollups := []*DailyRollup{
+		{Day: 19999, Blocks: 1, Transactions: 1, ValidTransactions: 1, ActiveUsers: 1, FirstBlock: 1, LastBlock: 1},
+		{Day: 20000, Blocks: 3, Transactions: 10, ValidTransactions: 8, ActiveUsers: 2, BytesWritten: 512, FirstBlock: 2, LastBlock: 4},
+		{Day: 20002, Blocks: 1, Transactions: 2, ValidTransactions: 2, ActiveUsers: 1, BytesWritten: 64, FirstBlock: 5, LastBlock: 5},
+	}
+	batch := &leveldb.Batch{}
+	for _, r := range rollups {
+		PutDailyRollup(batch, r)
+	}
+	PutRollupUser(batch, 20000, "alice")
+	PutRollupHeight(batch, 5)
+	require.NoError(t, db.Write(batch, nil))
+
+	height, recorded, err = GetRollupHeight(db)
+	require.NoError(t, err)
+	require.True(t, recorded)
+	require.Equal(t, uint64(5), height)
+
+	rollup, err = GetDailyRollup(db, 20000)
+	require.NoError(t, err)
+	require.Equal(t, rollups[1], rollup)
+	for _, tt := range []struct {
+		startDay, endDay uint64
+		expected         []*DailyRollup
+	}{
+		{0, 30000, rollups},
+		{19999, 20000, rollups[:2]},
+		{20000, 20002, rollups[1:]},
+		{20001, 20001, nil},
+	} {
+		found, err := GetDailyRollups(db, tt.startDay, tt.endDay)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, found, "days %d to %d", tt.startDay, tt.endDay)
+	}
+
+	active, err := HasRollupUser(db, 20000, "alice")
+	require.NoError(t, err)
+	require.True(t, active)
+	active, err = HasRollupUser(db, 20001, "alice")
+	require.NoError(t, err)
+	require.False(t, active)
+	active, err = HasRollupUser(db, 20000, "ali")
+	require.NoError(t, err)
+	require.False(t, active)
+
+	require.NoError(t, db.Put(dayKey(rollupKeyPrefix, 20001), []byte("short"), nil))
+	_, err = GetDailyRollups(db, 20001, 20001)
+	require.EqualError(t, err, "error while decoding the rollup of day [20001], expected 56 bytes, found 5")
+}
+
+func TestMigrateLegacyRecords(t *testing.T) {
+	t.Parallel()
+
+	putLegacyHeight := func(db *leveldb.DB, height uint64) {
+		b := make([]byte, binary.MaxVarintLen64)
+		binary.PutUvarint(b, height)
+		require.NoError(t, db.Put(legacyHeightKey, b, nil))
+	}
+
+	t.Run("nothing to migrate", func(t *testing.T) {
+		t.Parallel()
+
+		dir := newTestDir(t)
+		metadataDB := openDB(t, filepath.Join(dir, "metadata"))
+		systemDB := openDB(t, filepath.Join(dir, "system"))
+
+		migrated, err := MigrateLegacyRecords(metadataDB, systemDB)
+		require.NoError(t, err)
+		require.False(t, migrated)
+
+		height, err := Height(systemDB)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), height)
+	})
+
+	t.Run("legacy height is moved", func(t *testing.T) {
+		t.Parallel()
+
+		dir := newTestDir(t)
+		metadataDB := openDB(t, filepath.Join(dir, "metadata"))
+		systemDB := openDB(t, filepath.Join(dir, "system"))
+		putLegacyHeight(metadataDB, 12)
+
+		migrated, err := MigrateLegacyRecords(metadataDB, systemDB)
+		require.NoError(t, err)
+		require.True(t, migrated)
+
+		height, err := Height(systemDB)
+		require.NoError(t, err)
+		require.Equal(t, uint64(12), height)
+		info, err := GetLastCommitInfo(systemDB)
+		require.NoError(t, err)
+		require.Equal(t, &CommitInfo{BlockNumber: 12}, info)
+
+		_, err = metadataDB.Get(legacyHeightKey, nil)
+		require.Equal(t, leveldb.ErrNotFound, err)
+
+		migrated, err = MigrateLegacyRecords(metadataDB, systemDB)
+		require.NoError(t, err)
+		require.False(t, migrated)
+	})
+
+	t.Run("interrupted migration is completed", func(t *testing.T) {
+		t.Parallel()
+
+		dir := newTestDir(t)
+		metadataDB := openDB(t, filepath.Join(dir, "metadata"))
+		systemDB := openDB(t, filepath.Join(dir, "system"))
+
+		// the legacy record was not deleted before a crash, while the system database has moved on since
+		putLegacyHeight(metadataDB, 12)
+		batch := &leveldb.Batch{}
+		PutCommit(batch, &CommitInfo{BlockNumber: 14, CommittedAt: time.Now()})
+		require.NoError(t, systemDB.Write(batch, nil))
+
+		migrated, err := MigrateLegacyRecords(metadataDB, systemDB)
+		require.NoError(t, err)
+		require.True(t, migrated)
+
+		height, err := Height(systemDB)
+		require.NoError(t, err)
+		require.Equal(t, uint64(14), height)
+		_, err = metadataDB.Get(legacyHeightKey, nil)
+		require.Equal(t, leveldb.ErrNotFound, err)
+	})
+}
+
+func TestSkippedCommitRecords(t *testing.T) {
+	t.Parallel()
+
+	db := openDB(t, filepath.Join(newTestDir(t), "system"))
+
+	skipped, err := GetSkippedCommits(db)
+	require.NoError(t, err)
+	require.Empty(t, skipped)
+
+	batch := &leveldb.Batch{}
+	PutDigest(batch, "db1", 5, []byte("digest"))
+	PutSkippedCommit(batch, "db1", 300)
+	PutSkippedCommit(batch, "db1", 5)
+	PutSkippedCommit(batch, "db-2.x", 7)
+	require.NoError(t, db.Write(batch, nil))
+
+	skipped, err = GetSkippedCommits(db)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]uint64{
+		"db1":    {5, 300},
+		"db-2.x": {7},
+	}, skipped)
+
+	batch = &leveldb.Batch{}
+	DeleteSkippedCommit(batch, "db1", 5)
+	DeleteSkippedCommit(batch, "db1", 300)
+	require.NoError(t, db.Write(batch, nil))
+
+	skipped, err = GetSkippedCommits(db)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]uint64{"db-2.x": {7}}, skipped)
+
+	// the digests are left as is
+	digest, recordedAt, err := GetDigest(db, "db1", 10)
+	require.NoError(t, err)
+	require.Equal(t, []byte("digest"), digest)
+	require.Equal(t, uint64(5), recordedAt)
+}
+
+func TestMigrationRecords(t *testing.T) {
+	t.Parallel()
+
+	db := openDB(t, filepath.Join(newTestDir(t), "system"))
+
+	m, err := GetMigration(db)
+	require.NoError(t, err)
+	require.Nil(t, m)
+	progress, err := GetMigrationProgress(db)
+	require.NoError(t, err)
+	require.Empty(t, progress)
+
+	batch := &leveldb.Batch{}
+	PutMigration(batch, &Migration{State: MigrationFailed, Err: "mismatch"})
+	PutMigrationProgress(batch, "db1", &MigrationProgress{LastKey: []byte{0x01, 0x00, 'k'}})
+	PutMigrationProgress(batch, "_users", &MigrationProgress{LastKey: []byte("alice"), Done: true})
+	PutSkippedCommit(batch, "db1", 5)
+	require.NoError(t, db.Write(batch, nil))
+
+	m, err = GetMigration(db)
+	require.NoError(t, err)
+	require.Equal(t, &Migration{State: MigrationFailed, Err: "mismatch"}, m)
+	progress, err = GetMigrationProgress(db)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*MigrationProgress{
+		"db1":    {LastKey: []byte{0x01, 0x00, 'k'}},
+		"_users": {LastKey: []byte("alice"), Done: true},
+	}, progress)
+
+	batch = &leveldb.Batch{}
+	PutMigration(batch, &Migration{State: MigrationRunning})
+	DeleteMigrationProgress(batch, "db1")
+	require.NoError(t, db.Write(batch, nil))
+
+	m, err = GetMigration(db)
+	require.NoError(t, err)
+	require.Equal(t, &Migration{State: MigrationRunning}, m)
+	progress, err = GetMigrationProgress(db)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*MigrationProgress{"_users": {LastKey: []byte("alice"), Done: true}}, progress)
+}
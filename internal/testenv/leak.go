@@ -0,0 +1,115 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package testenv
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// leakCheckTimeout bounds the wait for the goroutines of the environment to exit, as some, e.g., of the idle HTTP
+// connections, exit shortly after the nodes are stopped
+const leakCheckTimeout = 10 * time.Second
+
+// leakIgnored are the functions of goroutines which are not started by the environment, or which outlive any
+// environment by design
+var leakIgnored = []string{
+	"testing.(*T).Run",
+	"testing.tRunner",
+	"testing.runTests",
+	"testing.(*M).",
+	"runtime.goexit0",
+	"os/signal.signal_recv",
+	"os/signal.loop",
+	"runtime.ensureSigM",
+	"created by github.com/golang/glog",
+	"go.opencensus.io/stats/view.(*worker).start",
+}
+
+// goroutineIDs returns the IDs of the running goroutines
+func goroutineIDs() map[uint64]bool {
+	ids := make(map[uint64]bool)
+	for _, g := range goroutineStacks() {
+		ids[g.id] = true
+	}
+	return ids
+}
+
+type goroutine struct {
+	id    uint64
+	stack string
+}
+
+func goroutineStacks() []goroutine {
+	buf := make([]byte, 1<<20)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	var goroutines []goroutine
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		// a stack starts with "goroutine <id> [<state>]:"
+		fields := strings.Fields(string(stack))
+		if len(fields) < 2 || fields[0] != "goroutine" {
+			continue
+		}
+		id, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		goroutines = append(goroutines, goroutine{id: id, stack: string(stack)})
+	}
+	return goroutines
+}
+
+// leakedGoroutines returns the stacks of the goroutines started after the baseline was taken, except for the current
+// goroutine and the ignored ones
+func leakedGoroutines(baseline map[uint64]bool) []string {
+	var leaked []string
+	for i, g := range goroutineStacks() {
+		// the current goroutine is always the first
+		if i == 0 || baseline[g.id] || ignoredGoroutine(g.stack) {
+			continue
+		}
+		leaked = append(leaked, g.stack)
+	}
+	return leaked
+}
+
+func ignoredGoroutine(stack string) bool {
+	for _, f := range leakIgnored {
+		if strings.Contains(stack, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkGoroutineLeaks fails the test if goroutines started after the baseline was taken are still running once the
+// leak check timeout elapsed
+func checkGoroutineLeaks(t *testing.T, baseline map[uint64]bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(leakCheckTimeout)
+	for {
+		leaked := leakedGoroutines(baseline)
+		if len(leaked) == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Errorf("%d goroutines outlived the environment:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
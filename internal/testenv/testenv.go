@@ -0,0 +1,498 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testenv runs complete BCDB servers in the test process, and drives them through the public HTTP API only.
+// An environment generates the crypto material of the nodes, the admin and the users, writes the ledgers of the nodes
+// to a temporary directory, and starts every node with its configuration, genesis bootstrap, HTTP listener and
+// transaction processor, as the server binary does. The tests then act through typed clients of pkg/client, so that
+// a regression in the routing, authentication or serialization of the API fails them, and not only a regression of
+// the engine.
+//
+// An environment of several nodes forms a Raft cluster, whose nodes can be stopped and restarted to exercise the
+// replication and the recovery. The environment is torn down by the cleanup of the test, which stops the nodes,
+// removes the temporary directory, and fails the test if a goroutine started by the environment outlives it. As the
+// goroutines are told apart from those of other tests by the time they were started, a test with an environment must
+// not run in parallel with other tests.
+package testenv
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/pkg/client"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/server"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// AdminID is the ID of the admin of the cluster
+	AdminID = "admin"
+
+	// DefaultTimeout bounds the waits of the environment, e.g., for a leader to be elected, and the synchronous
+	// submissions of the helpers
+	DefaultTimeout = 30 * time.Second
+)
+
+// Options holds the parameters of an environment. The zero value runs a single node.
+type Options struct {
+	// Nodes is the number of nodes of the cluster; zero stands for a single node
+	Nodes int
+	// DisableProvenance disables the provenance store of the nodes
+	DisableProvenance bool
+	// LogLevel is the log level of the nodes; empty stands for "err", so that the output of the test stays readable
+	LogLevel string
+	// Configure, if set, is called with the configuration of each node before the node is first started, to adjust
+	// the settings a test exercises
+	Configure func(node int, conf *config.Configurations)
+	// SkipLeakCheck disables the check for the goroutines that outlive the environment
+	SkipLeakCheck bool
+}
+
+// Env is a cluster of BCDB servers running in the test process
+type Env struct {
+	t       *testing.T
+	dir     string
+	caPair  tls.Certificate
+	caCert  []byte
+	nodes   []*Node
+	timeout time.Duration
+
+	mu      sync.Mutex
+	signers map[string]crypto.Signer
+	clients []*client.Client
+}
+
+// Node is a node of the cluster of an environment
+type Node struct {
+	// ID is the ID of the node
+	ID string
+	// Conf is the configuration the node was first started with. A restart of the node uses its local
+	// configuration only, as the node then joins with the configuration held by its ledger.
+	Conf *config.Configurations
+
+	cert   []byte
+	server *server.BCDBHTTPServer
+}
+
+// URL returns the base URL of the API of the node
+func (n *Node) URL() string {
+	return fmt.Sprintf("http://%s:%d", n.Conf.LocalConfig.Server.Network.Address, n.Conf.LocalConfig.Server.Network.Port)
+}
+
+// Running returns true if the node is started
+func (n *Node) Running() bool {
+	return n.server != nil
+}
+
+// Start starts an environment, and waits for its cluster to elect a leader. The environment is torn down by the
+// cleanup of the test.
+func Start(t *testing.T, opts *Options) *Env {
+	if opts == nil {
+		opts = &Options{}
+	}
+	numNodes := opts.Nodes
+	if numNodes == 0 {
+		numNodes = 1
+	}
+	logLevel := opts.LogLevel
+	if logLevel == "" {
+		logLevel = "err"
+	}
+
+	var baseline map[uint64]bool
+	if !opts.SkipLeakCheck {
+		baseline = goroutineIDs()
+	}
+
+	dir, err := ioutil.TempDir("", "testenv")
+	require.NoError(t, err)
+
+	env := &Env{
+		t:       t,
+		dir:     dir,
+		timeout: DefaultTimeout,
+		signers: make(map[string]crypto.Signer),
+	}
+	// the cleanups run in the reverse order, hence, the directory is removed and the leaks are checked once the nodes
+	// are stopped
+	t.Cleanup(func() {
+		if baseline != nil {
+			checkGoroutineLeaks(t, baseline)
+		}
+	})
+	t.Cleanup(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("error while removing the directory of the environment: %s", err)
+		}
+	})
+	t.Cleanup(env.close)
+
+	env.caCert, env.caPair = env.generateRootCA()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ca.pem"), env.caCert, 0600))
+	env.issueIdentity(AdminID)
+
+	ports := freePorts(t, 2*numNodes)
+	for i := 0; i < numNodes; i++ {
+		nodeID := fmt.Sprintf("node%d", i+1)
+		env.issueIdentity(nodeID)
+		env.nodes = append(env.nodes, &Node{
+			ID:   nodeID,
+			cert: env.certificate(nodeID),
+		})
+	}
+
+	shared := &config.SharedConfiguration{
+		Admin: config.AdminConf{
+			ID:              AdminID,
+			CertificatePath: filepath.Join(dir, AdminID+".pem"),
+		},
+		CAConfig: config.CAConfiguration{
+			RootCACertsPath: []string{filepath.Join(dir, "ca.pem")},
+		},
+		Consensus: &config.ConsensusConf{
+			Algorithm: "raft",
+			RaftConfig: &config.RaftConf{
+				TickInterval:         "100ms",
+				ElectionTicks:        10,
+				HeartbeatTicks:       1,
+				MaxInflightBlocks:    50,
+				SnapshotIntervalSize: math.MaxUint64,
+			},
+		},
+	}
+	for i, n := range env.nodes {
+		shared.Nodes = append(shared.Nodes, &config.NodeConf{
+			NodeID:          n.ID,
+			Host:            "127.0.0.1",
+			Port:            ports[2*i],
+			CertificatePath: filepath.Join(dir, n.ID+".pem"),
+		})
+		shared.Consensus.Members = append(shared.Consensus.Members, &config.PeerConf{
+			NodeId:   n.ID,
+			RaftId:   uint64(i + 1),
+			PeerHost: "127.0.0.1",
+			PeerPort: ports[2*i+1],
+		})
+	}
+
+	for i, n := range env.nodes {
+		nodeDir := filepath.Join(dir, n.ID)
+		n.Conf = &config.Configurations{
+			LocalConfig: &config.LocalConfiguration{
+				Server: config.ServerConf{
+					Identity: config.IdentityConf{
+						ID:              n.ID,
+						CertificatePath: filepath.Join(dir, n.ID+".pem"),
+						KeyPath:         filepath.Join(dir, n.ID+".key"),
+					},
+					Database: config.DatabaseConf{
+						Name:            "leveldb",
+						LedgerDirectory: filepath.Join(nodeDir, "ledger"),
+					},
+					Provenance: config.ProvenanceConf{
+						Disabled: opts.DisableProvenance,
+					},
+					Network: config.NetworkConf{
+						Address: "127.0.0.1",
+						Port:    ports[2*i],
+					},
+					QueueLength: config.QueueLengthConf{
+						Block:                     10,
+						Transaction:               100,
+						ReorderedTransactionBatch: 10,
+					},
+					LogLevel: logLevel,
+				},
+				BlockCreation: config.BlockCreationConf{
+					BlockTimeout:                50 * time.Millisecond,
+					MaxBlockSize:                1024 * 1024,
+					MaxTransactionCountPerBlock: 10,
+				},
+				Replication: config.ReplicationConf{
+					WALDir:  filepath.Join(nodeDir, "raft", "wal"),
+					SnapDir: filepath.Join(nodeDir, "raft", "snap"),
+					AuxDir:  filepath.Join(nodeDir, "aux"),
+					Network: config.NetworkConf{Address: "127.0.0.1", Port: ports[2*i+1]},
+					TLS:     config.TLSConf{Enabled: false},
+				},
+			},
+			SharedConfig: shared,
+		}
+		if opts.Configure != nil {
+			opts.Configure(i, n.Conf)
+		}
+	}
+
+	// the nodes of a cluster are started together, as a node waits for the genesis block, which needs a quorum
+	var wg sync.WaitGroup
+	errs := make([]error, len(env.nodes))
+	for i, n := range env.nodes {
+		wg.Add(1)
+		go func(i int, n *Node) {
+			defer wg.Done()
+			errs[i] = n.start(n.Conf)
+		}(i, n)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		require.NoError(t, err, "error while starting node [%s]", env.nodes[i].ID)
+	}
+
+	env.Leader()
+	return env
+}
+
+func (n *Node) start(conf *config.Configurations) error {
+	s, err := server.New(conf)
+	if err != nil {
+		return errors.WithMessagef(err, "error while creating the server of node [%s]", n.ID)
+	}
+	if err := s.Start(); err != nil {
+		s.Stop()
+		return errors.WithMessagef(err, "error while starting the server of node [%s]", n.ID)
+	}
+	n.server = s
+	return nil
+}
+
+func (n *Node) stop() error {
+	if n.server == nil {
+		return nil
+	}
+	err := n.server.Stop()
+	n.server = nil
+	return err
+}
+
+// Nodes returns the nodes of the cluster
+func (e *Env) Nodes() []*Node {
+	return e.nodes
+}
+
+// Node returns the node of the given index
+func (e *Env) Node(i int) *Node {
+	return e.nodes[i]
+}
+
+// Dir returns the temporary directory of the environment, which holds the crypto material and the ledgers of the
+// nodes
+func (e *Env) Dir() string {
+	return e.dir
+}
+
+// Leader waits for a running node to be the leader of the cluster, and returns its index
+func (e *Env) Leader() int {
+	e.t.Helper()
+
+	leader := -1
+	require.Eventually(e.t, func() bool {
+		for i, n := range e.nodes {
+			if n.Running() && n.server.IsLeader() == nil {
+				leader = i
+				return true
+			}
+		}
+		return false
+	}, e.timeout, 100*time.Millisecond, "no leader was elected")
+	return leader
+}
+
+// StopNode stops the node, keeping its ledger, e.g., to exercise its recovery by RestartNode
+func (e *Env) StopNode(i int) {
+	e.t.Helper()
+	require.NoError(e.t, e.nodes[i].stop(), "error while stopping node [%s]", e.nodes[i].ID)
+}
+
+// RestartNode starts a stopped node again, from its ledger. The API of the node is served once it recovered its
+// ledger and state.
+func (e *Env) RestartNode(i int) {
+	e.t.Helper()
+
+	n := e.nodes[i]
+	require.False(e.t, n.Running(), "node [%s] is running", n.ID)
+	localOnly := &config.Configurations{LocalConfig: n.Conf.LocalConfig}
+	require.NoError(e.t, n.start(localOnly))
+}
+
+// WaitForHeight waits for every running node to reach the given ledger height
+func (e *Env) WaitForHeight(height uint64) {
+	e.t.Helper()
+
+	for _, n := range e.nodes {
+		if !n.Running() {
+			continue
+		}
+		c := e.Client(AdminID, n)
+		require.Eventually(e.t, func() bool {
+			resp, err := c.GetLastBlockHeader(context.Background())
+			return err == nil && resp.GetResponse().GetBlockHeader().GetBaseHeader().GetNumber() >= height
+		}, e.timeout, 100*time.Millisecond, "node [%s] did not reach height %d", n.ID, height)
+	}
+}
+
+// AdminClient returns a client of the admin of the cluster, connected to the leader
+func (e *Env) AdminClient() *client.Client {
+	e.t.Helper()
+	return e.Client(AdminID, e.nodes[e.Leader()])
+}
+
+// Client returns a client of the user, connected to the node. The client verifies the signatures of the nodes on
+// the responses. The user must have an identity issued by the environment, see AddUser.
+func (e *Env) Client(userID string, n *Node) *client.Client {
+	e.t.Helper()
+
+	e.mu.Lock()
+	signer, ok := e.signers[userID]
+	e.mu.Unlock()
+	require.True(e.t, ok, "the user [%s] has no identity in the environment", userID)
+
+	nodeCerts := make(map[string][]byte)
+	for _, node := range e.nodes {
+		nodeCerts[node.ID] = node.cert
+	}
+	c, err := client.New(&client.Config{
+		URL:              n.URL(),
+		Signer:           signer,
+		NodeCertificates: nodeCerts,
+	})
+	require.NoError(e.t, err)
+
+	e.mu.Lock()
+	e.clients = append(e.clients, c)
+	e.mu.Unlock()
+	return c
+}
+
+// AddUser issues an identity to the user, creates the user with the given database privileges by a transaction of
+// the admin, and returns a client of the user connected to the leader
+func (e *Env) AddUser(userID string, dbPermissions map[string]types.Privilege_Access) *client.Client {
+	e.t.Helper()
+
+	e.issueIdentity(userID)
+	receipt, err := e.AdminClient().SubmitUserAdministrationTx(context.Background(), &types.UserAdministrationTx{
+		UserId: AdminID,
+		TxId:   "create-user-" + userID,
+		UserWrites: []*types.UserWrite{
+			{
+				User: &types.User{
+					Id:          userID,
+					Certificate: e.certificate(userID),
+					Privilege:   &types.Privilege{DbPermission: dbPermissions},
+				},
+			},
+		},
+	}, e.timeout)
+	require.NoError(e.t, err)
+	requireValid(e.t, receipt)
+
+	return e.Client(userID, e.nodes[e.Leader()])
+}
+
+// CreateDBs creates the databases by a transaction of the admin
+func (e *Env) CreateDBs(dbNames ...string) {
+	e.t.Helper()
+
+	receipt, err := e.AdminClient().SubmitDBAdministrationTx(context.Background(), &types.DBAdministrationTx{
+		UserId:    AdminID,
+		TxId:      fmt.Sprintf("create-dbs-%d", time.Now().UnixNano()),
+		CreateDbs: dbNames,
+	}, e.timeout)
+	require.NoError(e.t, err)
+	requireValid(e.t, receipt)
+}
+
+func requireValid(t *testing.T, receipt *types.TxReceiptResponseEnvelope) {
+	t.Helper()
+
+	r := receipt.GetResponse().GetReceipt()
+	flag := r.GetHeader().GetValidationInfo()[r.GetTxIndex()]
+	require.Equal(t, types.Flag_VALID, flag.GetFlag(), flag.GetReasonIfInvalid())
+}
+
+func (e *Env) close() {
+	e.mu.Lock()
+	for _, c := range e.clients {
+		c.Close()
+	}
+	e.clients = nil
+	e.mu.Unlock()
+
+	for _, n := range e.nodes {
+		if err := n.stop(); err != nil {
+			e.t.Errorf("error while stopping node [%s]: %s", n.ID, err)
+		}
+	}
+}
+
+func (e *Env) generateRootCA() ([]byte, tls.Certificate) {
+	pemCert, pemKey, err := testutils.GenerateRootCA("Orion Test RootCA", "127.0.0.1")
+	require.NoError(e.t, err)
+	pair, err := tls.X509KeyPair(pemCert, pemKey)
+	require.NoError(e.t, err)
+	return pemCert, pair
+}
+
+// issueIdentity issues a certificate to the node or user by the CA of the environment, and loads its signer
+func (e *Env) issueIdentity(id string) {
+	pemCert, pemKey, err := testutils.IssueCertificate("Orion Test "+id, "127.0.0.1", e.caPair)
+	require.NoError(e.t, err)
+	require.NoError(e.t, ioutil.WriteFile(filepath.Join(e.dir, id+".pem"), pemCert, 0600))
+	require.NoError(e.t, ioutil.WriteFile(filepath.Join(e.dir, id+".key"), pemKey, 0600))
+
+	signer, err := crypto.NewSigner(&crypto.SignerOptions{
+		Identity:    id,
+		KeyFilePath: filepath.Join(e.dir, id+".key"),
+	})
+	require.NoError(e.t, err)
+
+	e.mu.Lock()
+	e.signers[id] = signer
+	e.mu.Unlock()
+}
+
+// certificate returns the DER encoded certificate issued to the node or user
+func (e *Env) certificate(id string) []byte {
+	pemCert, err := ioutil.ReadFile(filepath.Join(e.dir, id+".pem"))
+	require.NoError(e.t, err)
+	block, _ := pem.Decode(pemCert)
+	require.NotNil(e.t, block)
+	_, err = x509.ParseCertificate(block.Bytes)
+	require.NoError(e.t, err)
+	return block.Bytes
+}
+
+// freePorts returns ports which are free on the loopback interface. The listeners are all held until every port is
+// picked, so that the ports are distinct, and closed before the nodes listen on them.
+func freePorts(t *testing.T, n int) []uint32 {
+	var listeners []net.Listener
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+
+	var ports []uint32
+	for i := 0; i < n; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		listeners = append(listeners, l)
+		ports = append(ports, uint32(l.Addr().(*net.TCPAddr).Port))
+	}
+	return ports
+}
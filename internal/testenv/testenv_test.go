@@ -0,0 +1,204 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package testenv
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/pkg/client"
+	"github.com/hyperledger-labs/orion-server/pkg/state"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func writeKey(t *testing.T, c *client.Client, txID, dbName, key string, value []byte) *types.TxReceipt {
+	t.Helper()
+
+	resp, err := c.SubmitDataTx(context.Background(), &types.DataTx{
+		MustSignUserIds: []string{c.UserID()},
+		TxId:            txID,
+		DbOperations: []*types.DBOperation{
+			{
+				DbName:     dbName,
+				DataWrites: []*types.DataWrite{{Key: key, Value: value}},
+			},
+		},
+	}, DefaultTimeout)
+	require.NoError(t, err)
+	requireValid(t, resp)
+	return resp.GetResponse().GetReceipt()
+}
+
+func requireValue(t *testing.T, c *client.Client, dbName, key string, value []byte) {
+	t.Helper()
+
+	resp, err := c.GetData(context.Background(), dbName, key)
+	require.NoError(t, err)
+	require.Equal(t, value, resp.GetResponse().GetValue())
+}
+
+func TestHappyPath(t *testing.T) {
+	env := Start(t, nil)
+	env.CreateDBs("db1")
+	alice := env.AddUser("alice", map[string]types.Privilege_Access{"db1": types.Privilege_ReadWrite})
+	bob := env.AddUser("bob", map[string]types.Privilege_Access{"db1": types.Privilege_Read})
+
+	receipt := writeKey(t, alice, "tx1", "db1", "key1", []byte("value1"))
+	blockNum := receipt.GetHeader().GetBaseHeader().GetNumber()
+	require.True(t, blockNum > 1)
+
+	// the value is read by the users of the database, and only by them
+	requireValue(t, alice, "db1", "key1", []byte("value1"))
+	requireValue(t, bob, "db1", "key1", []byte("value1"))
+	resp, err := alice.GetData(context.Background(), "db1", "key1")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&types.Version{BlockNum: blockNum, TxNum: receipt.GetTxIndex()}, resp.GetResponse().GetMetadata().GetVersion()))
+
+	charlie := env.AddUser("charlie", nil)
+	_, err = charlie.GetData(context.Background(), "db1", "key1")
+	require.Error(t, err)
+	respErr, ok := err.(*client.ResponseError)
+	require.True(t, ok, err.Error())
+	require.Equal(t, http.StatusForbidden, respErr.StatusCode)
+
+	// a user without the write privilege is rejected by the validation of the block
+	bobResp, err := bob.SubmitDataTx(context.Background(), &types.DataTx{
+		MustSignUserIds: []string{"bob"},
+		TxId:            "tx2",
+		DbOperations: []*types.DBOperation{
+			{DbName: "db1", DataWrites: []*types.DataWrite{{Key: "key1", Value: []byte("value2")}}},
+		},
+	}, DefaultTimeout)
+	require.NoError(t, err)
+	bobReceipt := bobResp.GetResponse().GetReceipt()
+	require.Equal(t, types.Flag_INVALID_NO_PERMISSION, bobReceipt.GetHeader().GetValidationInfo()[bobReceipt.GetTxIndex()].GetFlag())
+	requireValue(t, alice, "db1", "key1", []byte("value1"))
+
+	// the receipt is served again, and the value is proven against the state root of its block
+	receiptResp, err := bob.GetTxReceipt(context.Background(), "tx1")
+	require.NoError(t, err)
+	require.Equal(t, blockNum, receiptResp.GetResponse().GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+
+	proofResp, err := alice.GetDataProof(context.Background(), blockNum, "db1", "key1", false)
+	require.NoError(t, err)
+	compositeKey, err := state.ConstructCompositeKey("db1", "key1")
+	require.NoError(t, err)
+	valueHash, err := state.CalculateKeyValueHash(compositeKey, []byte("value1"))
+	require.NoError(t, err)
+	verified, err := state.NewProof(proofResp.GetResponse().GetPath()).Verify(valueHash, receipt.GetHeader().GetStateMerkelTreeRootHash(), false)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	// a transaction with a duplicate ID is rejected by the API
+	_, err = alice.SubmitDataTx(context.Background(), &types.DataTx{
+		MustSignUserIds: []string{"alice"},
+		TxId:            "tx1",
+		DbOperations:    []*types.DBOperation{{DbName: "db1"}},
+	}, DefaultTimeout)
+	require.Error(t, err)
+	respErr, ok = err.(*client.ResponseError)
+	require.True(t, ok, err.Error())
+	require.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+	require.Contains(t, respErr.ErrMsg, "the transaction has a duplicate txID [tx1]")
+}
+
+func TestRecovery(t *testing.T) {
+	env := Start(t, nil)
+	env.CreateDBs("db1")
+	alice := env.AddUser("alice", map[string]types.Privilege_Access{"db1": types.Privilege_ReadWrite})
+
+	for i := 1; i <= 3; i++ {
+		writeKey(t, alice, fmt.Sprintf("tx%d", i), "db1", fmt.Sprintf("key%d", i), []byte(fmt.Sprintf("value%d", i)))
+	}
+	header, err := alice.GetLastBlockHeader(context.Background())
+	require.NoError(t, err)
+	height := header.GetResponse().GetBlockHeader().GetBaseHeader().GetNumber()
+
+	// the node recovers its ledger and state after a restart, and commits again
+	env.StopNode(0)
+	env.RestartNode(0)
+	env.Leader()
+
+	alice = env.Client("alice", env.Node(0))
+	header, err = alice.GetLastBlockHeader(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, height, header.GetResponse().GetBlockHeader().GetBaseHeader().GetNumber())
+	for i := 1; i <= 3; i++ {
+		requireValue(t, alice, "db1", fmt.Sprintf("key%d", i), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	receipt := writeKey(t, alice, "tx4", "db1", "key4", []byte("value4"))
+	require.Equal(t, height+1, receipt.GetHeader().GetBaseHeader().GetNumber())
+	requireValue(t, alice, "db1", "key4", []byte("value4"))
+
+	// the transaction IDs committed before the restart are still known
+	_, err = alice.SubmitDataTx(context.Background(), &types.DataTx{
+		MustSignUserIds: []string{"alice"},
+		TxId:            "tx1",
+		DbOperations:    []*types.DBOperation{{DbName: "db1"}},
+	}, DefaultTimeout)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the transaction has a duplicate txID [tx1]")
+}
+
+func TestReplication(t *testing.T) {
+	env := Start(t, &Options{Nodes: 3})
+	env.CreateDBs("db1")
+	env.AddUser("alice", map[string]types.Privilege_Access{"db1": types.Privilege_ReadWrite})
+
+	leader := env.Leader()
+	follower := (leader + 1) % 3
+
+	// a transaction submitted to a follower is redirected to the leader, and replicated to all nodes
+	alice := env.Client("alice", env.Node(follower))
+	receipt := writeKey(t, alice, "tx1", "db1", "key1", []byte("value1"))
+	env.WaitForHeight(receipt.GetHeader().GetBaseHeader().GetNumber())
+	for _, n := range env.Nodes() {
+		requireValue(t, env.Client("alice", n), "db1", "key1", []byte("value1"))
+	}
+
+	// a stopped follower catches up with the blocks committed while it was down
+	env.StopNode(follower)
+	alice = env.Client("alice", env.Node(leader))
+	for i := 2; i <= 4; i++ {
+		receipt = writeKey(t, alice, fmt.Sprintf("tx%d", i), "db1", fmt.Sprintf("key%d", i), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	env.RestartNode(follower)
+	env.WaitForHeight(receipt.GetHeader().GetBaseHeader().GetNumber())
+	followerClient := env.Client("alice", env.Node(follower))
+	for i := 1; i <= 4; i++ {
+		requireValue(t, followerClient, "db1", fmt.Sprintf("key%d", i), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	require.Eventually(t, func() bool {
+		status, err := alice.GetClusterStatus(context.Background(), true)
+		return err == nil && len(status.GetResponse().GetNodes()) == 3 && len(status.GetResponse().GetActive()) == 3 &&
+			status.GetResponse().GetLeader() == env.Node(leader).ID
+	}, DefaultTimeout, 100*time.Millisecond)
+
+	// the cluster commits with a node down
+	env.StopNode(follower)
+	writeKey(t, alice, "tx5", "db1", "key5", []byte("value5"))
+	requireValue(t, alice, "db1", "key5", []byte("value5"))
+}
+
+func TestLeakCheck(t *testing.T) {
+	baseline := goroutineIDs()
+	require.Empty(t, leakedGoroutines(baseline))
+
+	stop := make(chan struct{})
+	go func() { <-stop }()
+	leaked := leakedGoroutines(baseline)
+	require.Len(t, leaked, 1)
+	require.Contains(t, leaked[0], "testenv.TestLeakCheck")
+
+	close(stop)
+	require.Eventually(t, func() bool { return len(leakedGoroutines(baseline)) == 0 }, time.Second, 10*time.Millisecond)
+}
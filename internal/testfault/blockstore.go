@@ -0,0 +1,166 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package testfault
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// BlockStoreAPI holds the exported methods of the block store.
+type BlockStoreAPI interface {
+	Commit(block *types.Block) error
+	AddSkipListLinks(block *types.Block) error
+	Height() (uint64, error)
+	Get(blockNumber uint64) (*types.Block, error)
+	GetRaw(blockNumber uint64) ([]byte, error)
+	GetHeader(blockNumber uint64) (*types.BlockHeader, error)
+	GetAugmentedHeader(blockNumber uint64) (*types.AugmentedBlockHeader, error)
+	GetHash(blockNumber uint64) ([]byte, error)
+	GetHeaderHash(blockNumber uint64) ([]byte, error)
+	GetBaseHeaderHash(blockNumber uint64) ([]byte, error)
+	GetHeaderByHash(blockHash []byte) (*types.BlockHeader, error)
+	DoesTxIDExist(txID string) (bool, error)
+	GetValidationInfo(txID string) (*types.ValidationInfo, error)
+	GetTxInfo(txID string) (*blockstore.TxInfo, error)
+	Close() error
+}
+
+var (
+	_ BlockStoreAPI = (*blockstore.Store)(nil)
+	_ BlockStoreAPI = (*BlockStore)(nil)
+)
+
+// BlockStore wraps a block store and injects faults into the calls to it.
+type BlockStore struct {
+	*Injector
+	store BlockStoreAPI
+}
+
+// NewBlockStore wraps the given block store. No fault is injected until one is added.
+func NewBlockStore(store BlockStoreAPI) *BlockStore {
+	return &BlockStore{
+		Injector: newInjector(),
+		store:    store,
+	}
+}
+
+// Unwrap returns the wrapped block store.
+func (s *BlockStore) Unwrap() BlockStoreAPI {
+	return s.store
+}
+
+// Commit commits the block to the wrapped block store. A faulty call with a PartialWrite commits the block before it
+// returns the error of the fault.
+func (s *BlockStore) Commit(block *types.Block) error {
+	f := s.enter("Commit")
+	if f == nil || f.Err == nil {
+		return s.store.Commit(block)
+	}
+
+	if f.PartialWrite > 0 {
+		if err := s.store.Commit(block); err != nil {
+			return err
+		}
+	}
+	return f.Err
+}
+
+func (s *BlockStore) AddSkipListLinks(block *types.Block) error {
+	if err := s.before("AddSkipListLinks"); err != nil {
+		return err
+	}
+	return s.store.AddSkipListLinks(block)
+}
+
+func (s *BlockStore) Height() (uint64, error) {
+	if err := s.before("Height"); err != nil {
+		return 0, err
+	}
+	return s.store.Height()
+}
+
+func (s *BlockStore) Get(blockNumber uint64) (*types.Block, error) {
+	if err := s.before("Get"); err != nil {
+		return nil, err
+	}
+	return s.store.Get(blockNumber)
+}
+
+func (s *BlockStore) GetRaw(blockNumber uint64) ([]byte, error) {
+	if err := s.before("GetRaw"); err != nil {
+		return nil, err
+	}
+	return s.store.GetRaw(blockNumber)
+}
+
+func (s *BlockStore) GetHeader(blockNumber uint64) (*types.BlockHeader, error) {
+	if err := s.before("GetHeader"); err != nil {
+		return nil, err
+	}
+	return s.store.GetHeader(blockNumber)
+}
+
+func (s *BlockStore) GetAugmentedHeader(blockNumber uint64) (*types.AugmentedBlockHeader, error) {
+	if err := s.before("GetAugmentedHeader"); err != nil {
+		return nil, err
+	}
+	return s.store.GetAugmentedHeader(blockNumber)
+}
+
+func (s *BlockStore) GetHash(blockNumber uint64) ([]byte, error) {
+	if err := s.before("GetHash"); err != nil {
+		return nil, err
+	}
+	return s.store.GetHash(blockNumber)
+}
+
+func (s *BlockStore) GetHeaderHash(blockNumber uint64) ([]byte, error) {
+	if err := s.before("GetHeaderHash"); err != nil {
+		return nil, err
+	}
+	return s.store.GetHeaderHash(blockNumber)
+}
+
+func (s *BlockStore) GetBaseHeaderHash(blockNumber uint64) ([]byte, error) {
+	if err := s.before("GetBaseHeaderHash"); err != nil {
+		return nil, err
+	}
+	return s.store.GetBaseHeaderHash(blockNumber)
+}
+
+func (s *BlockStore) GetHeaderByHash(blockHash []byte) (*types.BlockHeader, error) {
+	if err := s.before("GetHeaderByHash"); err != nil {
+		return nil, err
+	}
+	return s.store.GetHeaderByHash(blockHash)
+}
+
+func (s *BlockStore) DoesTxIDExist(txID string) (bool, error) {
+	if err := s.before("DoesTxIDExist"); err != nil {
+		return false, err
+	}
+	return s.store.DoesTxIDExist(txID)
+}
+
+func (s *BlockStore) GetValidationInfo(txID string) (*types.ValidationInfo, error) {
+	if err := s.before("GetValidationInfo"); err != nil {
+		return nil, err
+	}
+	return s.store.GetValidationInfo(txID)
+}
+
+func (s *BlockStore) GetTxInfo(txID string) (*blockstore.TxInfo, error) {
+	if err := s.before("GetTxInfo"); err != nil {
+		return nil, err
+	}
+	return s.store.GetTxInfo(txID)
+}
+
+func (s *BlockStore) Close() error {
+	if err := s.before("Close"); err != nil {
+		return err
+	}
+	return s.store.Close()
+}
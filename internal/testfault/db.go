@@ -0,0 +1,220 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package testfault
+
+import (
+	"sort"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// DB wraps a state database and injects faults into the calls to it. The methods which do not return an error,
+// i.e., Exist, ListDBs, IsUnavailable, and ValidDBName, are only delayed by a fault.
+type DB struct {
+	*Injector
+	db worldstate.DB
+}
+
+var _ worldstate.DB = (*DB)(nil)
+
+// NewDB wraps the given state database. No fault is injected until one is added.
+func NewDB(db worldstate.DB) *DB {
+	return &DB{
+		Injector: newInjector(),
+		db:       db,
+	}
+}
+
+// Unwrap returns the wrapped state database.
+func (d *DB) Unwrap() worldstate.DB {
+	return d.db
+}
+
+func (d *DB) Exist(dbName string) bool {
+	d.enter("Exist")
+	return d.db.Exist(dbName)
+}
+
+func (d *DB) ListDBs() []string {
+	d.enter("ListDBs")
+	return d.db.ListDBs()
+}
+
+func (d *DB) Get(dbName, key string) ([]byte, *types.Metadata, error) {
+	if err := d.before("Get"); err != nil {
+		return nil, nil, err
+	}
+	return d.db.Get(dbName, key)
+}
+
+func (d *DB) GetVersion(dbName, key string) (*types.Version, error) {
+	if err := d.before("GetVersion"); err != nil {
+		return nil, err
+	}
+	return d.db.GetVersion(dbName, key)
+}
+
+func (d *DB) GetACL(dbName, key string) (*types.AccessControl, error) {
+	if err := d.before("GetACL"); err != nil {
+		return nil, err
+	}
+	return d.db.GetACL(dbName, key)
+}
+
+func (d *DB) Has(dbName, key string) (bool, error) {
+	if err := d.before("Has"); err != nil {
+		return false, err
+	}
+	return d.db.Has(dbName, key)
+}
+
+func (d *DB) GetConfig() (*types.ClusterConfig, *types.Metadata, error) {
+	if err := d.before("GetConfig"); err != nil {
+		return nil, nil, err
+	}
+	return d.db.GetConfig()
+}
+
+func (d *DB) GetIndexDefinition(dbName string) ([]byte, *types.Metadata, error) {
+	if err := d.before("GetIndexDefinition"); err != nil {
+		return nil, nil, err
+	}
+	return d.db.GetIndexDefinition(dbName)
+}
+
+func (d *DB) GetIterator(dbName string, startKey, endKey string) (worldstate.Iterator, error) {
+	if err := d.before("GetIterator"); err != nil {
+		return nil, err
+	}
+	return d.db.GetIterator(dbName, startKey, endKey)
+}
+
+func (d *DB) GetDBsSnapshot(dbNames []string) (worldstate.DBsSnapshot, error) {
+	if err := d.before("GetDBsSnapshot"); err != nil {
+		return nil, err
+	}
+	return d.db.GetDBsSnapshot(dbNames)
+}
+
+func (d *DB) GetDBsSnapshotWithHeight(dbNames []string) (worldstate.DBsSnapshot, uint64, error) {
+	if err := d.before("GetDBsSnapshotWithHeight"); err != nil {
+		return nil, 0, err
+	}
+	return d.db.GetDBsSnapshotWithHeight(dbNames)
+}
+
+func (d *DB) CompactRange(dbName string, startKey, endKey string) error {
+	if err := d.before("CompactRange"); err != nil {
+		return err
+	}
+	return d.db.CompactRange(dbName, startKey, endKey)
+}
+
+// Commit commits the updates to the wrapped state database. A faulty call with a PartialWrite writes the updates of
+// the first PartialWrite databases, without advancing the height, before it returns the error of the fault.
+func (d *DB) Commit(dbsUpdates map[string]*worldstate.DBUpdates, blockNumber uint64) error {
+	dbNames := make(map[string]bool, len(dbsUpdates))
+	for dbName := range dbsUpdates {
+		dbNames[dbName] = true
+	}
+
+	f := d.enterDBs("Commit", dbNames)
+	if f == nil || f.Err == nil {
+		return d.db.Commit(dbsUpdates, blockNumber)
+	}
+
+	if f.PartialWrite > 0 {
+		if err := d.commitPartially(dbsUpdates, f.PartialWrite); err != nil {
+			return err
+		}
+	}
+	return f.Err
+}
+
+func (d *DB) commitPartially(dbsUpdates map[string]*worldstate.DBUpdates, numDBs int) error {
+	var dbNames []string
+	for dbName := range dbsUpdates {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+	if numDBs < len(dbNames) {
+		dbNames = dbNames[:numDBs]
+	}
+
+	partialUpdates := make(map[string]*worldstate.DBUpdates)
+	for _, dbName := range dbNames {
+		partialUpdates[dbName] = dbsUpdates[dbName]
+	}
+
+	// the height is left as is, as a torn write does not reach the update of the height
+	height, err := d.db.Height()
+	if err != nil {
+		return errors.WithMessage(err, "error while fetching the height of the state database")
+	}
+	if err := d.db.Commit(partialUpdates, height); err != nil {
+		return errors.WithMessage(err, "error while writing the partial updates")
+	}
+	return nil
+}
+
+func (d *DB) GetDigest(dbName string, blockNumber uint64) ([]byte, uint64, error) {
+	if err := d.before("GetDigest"); err != nil {
+		return nil, 0, err
+	}
+	return d.db.GetDigest(dbName, blockNumber)
+}
+
+func (d *DB) CommitDigests(digests map[string][]byte, blockNumber uint64) error {
+	if err := d.before("CommitDigests"); err != nil {
+		return err
+	}
+	return d.db.CommitDigests(digests, blockNumber)
+}
+
+func (d *DB) RecordSkippedCommits(dbNames []string, blockNumbers []uint64) error {
+	if err := d.before("RecordSkippedCommits"); err != nil {
+		return err
+	}
+	return d.db.RecordSkippedCommits(dbNames, blockNumbers)
+}
+
+func (d *DB) GetSkippedCommits() (map[string][]uint64, error) {
+	if err := d.before("GetSkippedCommits"); err != nil {
+		return nil, err
+	}
+	return d.db.GetSkippedCommits()
+}
+
+func (d *DB) ClearSkippedCommits(dbName string) error {
+	if err := d.before("ClearSkippedCommits"); err != nil {
+		return err
+	}
+	return d.db.ClearSkippedCommits(dbName)
+}
+
+func (d *DB) IsUnavailable(dbName string) bool {
+	d.enter("IsUnavailable")
+	return d.db.IsUnavailable(dbName)
+}
+
+func (d *DB) Height() (uint64, error) {
+	if err := d.before("Height"); err != nil {
+		return 0, err
+	}
+	return d.db.Height()
+}
+
+func (d *DB) ValidDBName(dbName string) bool {
+	d.enter("ValidDBName")
+	return d.db.ValidDBName(dbName)
+}
+
+func (d *DB) Close() error {
+	if err := d.before("Close"); err != nil {
+		return err
+	}
+	return d.db.Close()
+}
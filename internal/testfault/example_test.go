@@ -0,0 +1,196 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package testfault_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/testfault"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func ExampleInjector_FailNthCall() {
+	dir, db := openLevelDB()
+	defer os.RemoveAll(dir)
+	defer db.Close()
+
+	faultyDB := testfault.NewDB(db)
+	faultyDB.FailNthCall("Height", 2, errors.New("disk failure"))
+
+	for i := 0; i < 3; i++ {
+		height, err := faultyDB.Height()
+		fmt.Println(height, err)
+	}
+	fmt.Println("calls:", faultyDB.Calls("Height"))
+
+	// Output:
+	// 0 <nil>
+	// 0 disk failure
+	// 0 <nil>
+	// calls: 3
+}
+
+func ExampleInjector_FailMethod() {
+	dir, db := openLevelDB()
+	defer os.RemoveAll(dir)
+	defer db.Close()
+
+	faultyDB := testfault.NewDB(db)
+	faultyDB.FailMethod("Get", errors.New("disk failure"))
+
+	_, _, err := faultyDB.Get(worldstate.DefaultDBName, "key1")
+	fmt.Println(err)
+
+	faultyDB.Clear()
+	_, _, err = faultyDB.Get(worldstate.DefaultDBName, "key1")
+	fmt.Println(err)
+
+	// Output:
+	// disk failure
+	// <nil>
+}
+
+func ExampleInjector_InjectLatency() {
+	dir, db := openLevelDB()
+	defer os.RemoveAll(dir)
+	defer db.Close()
+
+	faultyDB := testfault.NewDB(db)
+	faultyDB.InjectLatency("Has", 50*time.Millisecond)
+
+	start := time.Now()
+	exist, err := faultyDB.Has(worldstate.DefaultDBName, "key1")
+	fmt.Println(exist, err, time.Since(start) >= 50*time.Millisecond)
+
+	// Output:
+	// false <nil> true
+}
+
+func ExampleDB_Commit() {
+	dir, db := openLevelDB()
+	defer os.RemoveAll(dir)
+	defer db.Close()
+
+	faultyDB := testfault.NewDB(db)
+	// the first database, in lexicographic order, is written but the height is not advanced
+	faultyDB.Inject(&testfault.Fault{
+		Method:       "Commit",
+		Err:          errors.New("crash"),
+		PartialWrite: 1,
+	})
+
+	err := faultyDB.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DefaultDBName: {
+			Writes: []*worldstate.KVWithMetadata{{Key: "key1", Value: []byte("value1")}},
+		},
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{{Key: "user1", Value: []byte("user")}},
+		},
+	}, 1)
+	fmt.Println(err)
+
+	faultyDB.Clear()
+	value, _, _ := faultyDB.Get(worldstate.UsersDBName, "user1")
+	fmt.Printf("%s: %q\n", worldstate.UsersDBName, value)
+	value, _, _ = faultyDB.Get(worldstate.DefaultDBName, "key1")
+	fmt.Printf("%s: %q\n", worldstate.DefaultDBName, value)
+	height, _ := faultyDB.Height()
+	fmt.Println("height:", height)
+
+	// Output:
+	// crash
+	// _users: "user"
+	// bdb: ""
+	// height: 0
+}
+
+func ExampleBlockStore_Commit() {
+	dir, err := ioutil.TempDir("", "testfault")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := blockstore.Open(&blockstore.Config{
+		StoreDir: filepath.Join(dir, "blockstore"),
+		Logger:   newLogger(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer store.Close()
+
+	faultyStore := testfault.NewBlockStore(store)
+	// the block is committed, yet the commit reports a failure
+	faultyStore.Inject(&testfault.Fault{
+		Method:       "Commit",
+		Call:         1,
+		Err:          errors.New("fsync failed"),
+		PartialWrite: 1,
+	})
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader:     &types.BlockHeaderBase{Number: 1},
+			ValidationInfo: []*types.ValidationInfo{{Flag: types.Flag_VALID}},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{{Payload: &types.DataTx{TxId: "tx1"}}},
+			},
+		},
+	}
+	if err := faultyStore.AddSkipListLinks(block); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(faultyStore.Commit(block))
+	height, err := faultyStore.Height()
+	fmt.Println(height, err)
+
+	// Output:
+	// fsync failed
+	// 1 <nil>
+}
+
+func openLevelDB() (string, *leveldb.LevelDB) {
+	dir, err := ioutil.TempDir("", "testfault")
+	if err != nil {
+		panic(err)
+	}
+
+	db, err := leveldb.Open(&leveldb.Config{
+		DBRootDir: filepath.Join(dir, "leveldb"),
+		Logger:    newLogger(),
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		panic(err)
+	}
+
+	return dir, db
+}
+
+func newLogger() *logger.SugarLogger {
+	// the logs are written to the stderr, as the examples compare the stdout to their output
+	lg, err := logger.New(&logger.Config{
+		Level:         "err",
+		OutputPath:    []string{"stderr"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return lg
+}
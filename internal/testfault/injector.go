@@ -0,0 +1,137 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testfault provides wrappers of the state database and the block store which inject failures into the
+// calls to the wrapped instance. The wrappers are meant for tests of the handling of storage failures, e.g., the
+// recovery of a node that crashed in the middle of a commit.
+package testfault
+
+import (
+	"sync"
+	"time"
+)
+
+// Fault describes a failure injected into the calls to a method of a wrapped store.
+type Fault struct {
+	// Method is the name of the faulty method, e.g., "Commit".
+	Method string
+	// Call is the number of the faulty call to the method, counting from 1 since the wrapper was created. When zero,
+	// every call to the method is faulty.
+	Call int
+	// Latency delays the faulty call before it reaches the wrapped store.
+	Latency time.Duration
+	// Err is returned by the faulty call instead of calling the wrapped store. When nil, the call is only delayed.
+	Err error
+	// PartialWrite, when positive, makes a faulty Commit write a part of its updates before it returns Err. The state
+	// database writes the updates of the first PartialWrite databases, in lexicographic order, without advancing its
+	// height. The block store writes the whole block, i.e., the failure is reported after the write.
+	PartialWrite int
+	// DBName, when set, restricts a fault of the state database's Commit to the calls that update the database, e.g.,
+	// to fail the commits to a single database whose storage went read-only. The calls that do not update it are
+	// neither faulty nor counted by Call.
+	DBName string
+}
+
+// Injector counts the calls to each method of a wrapped store and holds the faults to inject into them. It is safe
+// for concurrent use.
+type Injector struct {
+	mu    sync.Mutex
+	calls map[string]int
+	// dbCalls counts the calls that update each database, by the method and the name of the database
+	dbCalls map[[2]string]int
+	faults  []*Fault
+}
+
+func newInjector() *Injector {
+	return &Injector{
+		calls:   make(map[string]int),
+		dbCalls: make(map[[2]string]int),
+	}
+}
+
+// Inject adds a fault. When several faults match a call, the first one added is injected.
+func (i *Injector) Inject(f *Fault) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.faults = append(i.faults, f)
+}
+
+// FailNthCall makes the n-th call to the method return err.
+func (i *Injector) FailNthCall(method string, n int, err error) {
+	i.Inject(&Fault{Method: method, Call: n, Err: err})
+}
+
+// FailMethod makes every call to the method return err.
+func (i *Injector) FailMethod(method string, err error) {
+	i.Inject(&Fault{Method: method, Err: err})
+}
+
+// InjectLatency delays every call to the method by the given latency.
+func (i *Injector) InjectLatency(method string, latency time.Duration) {
+	i.Inject(&Fault{Method: method, Latency: latency})
+}
+
+// Clear removes all faults. The call counts are kept.
+func (i *Injector) Clear() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.faults = nil
+}
+
+// Calls returns the number of calls to the method, including the faulty ones.
+func (i *Injector) Calls(method string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.calls[method]
+}
+
+// enter counts a call to the method and returns the fault to inject into it, if any, after applying its latency.
+func (i *Injector) enter(method string) *Fault {
+	return i.enterDBs(method, nil)
+}
+
+// enterDBs is enter for a call that updates the given databases, which counts the call for the faults restricted to
+// one of the databases as well.
+func (i *Injector) enterDBs(method string, dbNames map[string]bool) *Fault {
+	i.mu.Lock()
+	i.calls[method]++
+	n := i.calls[method]
+	for dbName := range dbNames {
+		i.dbCalls[[2]string{method, dbName}]++
+	}
+
+	var fault *Fault
+	for _, f := range i.faults {
+		if f.Method != method {
+			continue
+		}
+		call := n
+		if f.DBName != "" {
+			if !dbNames[f.DBName] {
+				continue
+			}
+			call = i.dbCalls[[2]string{method, f.DBName}]
+		}
+		if f.Call == 0 || f.Call == call {
+			fault = f
+			break
+		}
+	}
+	i.mu.Unlock()
+
+	if fault != nil && fault.Latency > 0 {
+		time.Sleep(fault.Latency)
+	}
+	return fault
+}
+
+// before counts a call to the method and returns the error to inject into it, if any.
+func (i *Injector) before(method string) error {
+	if f := i.enter(method); f != nil {
+		return f.Err
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package testfault
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjector(t *testing.T) {
+	t.Run("fail nth call", func(t *testing.T) {
+		i := newInjector()
+		i.FailNthCall("Get", 2, errors.New("failure"))
+
+		require.NoError(t, i.before("Get"))
+		require.EqualError(t, i.before("Get"), "failure")
+		require.NoError(t, i.before("Get"))
+		require.NoError(t, i.before("Height"))
+		require.Equal(t, 3, i.Calls("Get"))
+		require.Equal(t, 1, i.Calls("Height"))
+		require.Equal(t, 0, i.Calls("Commit"))
+	})
+
+	t.Run("fail method", func(t *testing.T) {
+		i := newInjector()
+		i.FailMethod("Get", errors.New("failure"))
+
+		require.EqualError(t, i.before("Get"), "failure")
+		require.EqualError(t, i.before("Get"), "failure")
+		require.NoError(t, i.before("Height"))
+
+		i.Clear()
+		require.NoError(t, i.before("Get"))
+		require.Equal(t, 3, i.Calls("Get"))
+	})
+
+	t.Run("the first matching fault is injected", func(t *testing.T) {
+		i := newInjector()
+		i.FailNthCall("Get", 1, errors.New("first"))
+		i.FailMethod("Get", errors.New("every"))
+
+		require.EqualError(t, i.before("Get"), "first")
+		require.EqualError(t, i.before("Get"), "every")
+	})
+
+	t.Run("fault restricted to a database", func(t *testing.T) {
+		i := newInjector()
+		i.Inject(&Fault{Method: "Commit", Call: 2, DBName: "db1", Err: errors.New("read-only")})
+
+		require.Nil(t, i.enterDBs("Commit", map[string]bool{"db1": true}))
+		require.Nil(t, i.enterDBs("Commit", map[string]bool{"db2": true}))
+		require.Nil(t, i.enterDBs("Commit", map[string]bool{"db2": true, "db3": true}))
+		f := i.enterDBs("Commit", map[string]bool{"db1": true, "db2": true})
+		require.NotNil(t, f)
+		require.EqualError(t, f.Err, "read-only")
+		require.Nil(t, i.enterDBs("Commit", map[string]bool{"db1": true}))
+		require.Equal(t, 5, i.Calls("Commit"))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		i := newInjector()
+		i.InjectLatency("Get", 100*time.Millisecond)
+
+		start := time.Now()
+		require.NoError(t, i.before("Get"))
+		require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+		start = time.Now()
+		require.NoError(t, i.before("Height"))
+		require.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("concurrent calls", func(t *testing.T) {
+		i := newInjector()
+		i.FailNthCall("Get", 50, errors.New("failure"))
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		failures := 0
+		for n := 0; n < 100; n++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := i.before("Get"); err != nil {
+					mu.Lock()
+					failures++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, 100, i.Calls("Get"))
+		require.Equal(t, 1, failures)
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package txreorderer
+
+import "time"
+
+// arrivalRateWindow is the window over which the arrival rate of the transactions is measured
+const arrivalRateWindow = time.Second
+
+// lowLatencyMode decides whether a dequeued data transaction is cut into a batch of its own. The mode is entered
+// when a transaction arrives after the queue has been quiet for the quiet period, and left when more than the
+// maximal arrival rate of transactions arrive within a second. As entering requires a quiet period, which a rate
+// above the maximum never leaves, the mode does not flap around the threshold.
+type lowLatencyMode struct {
+	quietPeriod    time.Duration
+	maxArrivalRate uint32
+
+	active      bool
+	lastArrival time.Time
+	// arrivals holds the arrival times within the last window, while the mode is active
+	arrivals []time.Time
+}
+
+func newLowLatencyMode(quietPeriod time.Duration, maxArrivalRate uint32) *lowLatencyMode {
+	return &lowLatencyMode{
+		quietPeriod:    quietPeriod,
+		maxArrivalRate: maxArrivalRate,
+	}
+}
+
+// arrive records the arrival of a transaction at the given time, and returns true if it is to be cut immediately
+func (m *lowLatencyMode) arrive(now time.Time) bool {
+	if m.quietPeriod <= 0 {
+		return false
+	}
+
+	quiet := m.lastArrival.IsZero() || now.Sub(m.lastArrival) >= m.quietPeriod
+	m.lastArrival = now
+
+	if !m.active {
+		if !quiet {
+			return false
+		}
+		m.active = true
+		m.arrivals = m.arrivals[:0]
+	}
+
+	windowStart := now.Add(-arrivalRateWindow)
+	i := 0
+	for i < len(m.arrivals) && !m.arrivals[i].After(windowStart) {
+		i++
+	}
+	m.arrivals = append(m.arrivals[i:], now)
+
+	if uint32(len(m.arrivals)) > m.maxArrivalRate {
+		m.active = false
+		return false
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package txreorderer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// partialBatchFile persists the partial batch of the reorderer, i.e., the data or void transactions dequeued from
+// the transaction queue but not yet cut into a batch, so that a restart does not lose them. The batch is written
+// as the payload of a block, to a temporary file that replaces the file, and the file is removed once the batch is
+// cut, as the transactions of a cut batch are tracked by the components further down the pipeline.
+type partialBatchFile struct {
+	path string
+}
+
+// write replaces the content of the file with the given batch, or removes the file if the batch is nil
+func (f *partialBatchFile) write(batch interface{}) error {
+	if batch == nil {
+		exists, err := fileops.Exists(f.path)
+		if err != nil || !exists {
+			return err
+		}
+		return fileops.Remove(f.path)
+	}
+
+	block := &types.Block{}
+	switch b := batch.(type) {
+	case *types.DataTxEnvelopes:
+		block.Payload = &types.Block_DataTxEnvelopes{DataTxEnvelopes: b}
+	case *types.VoidTxEnvelopes:
+		block.Payload = &types.Block_VoidTxEnvelopes{VoidTxEnvelopes: b}
+	default:
+		return errors.Errorf("unexpected partial batch type %T", batch)
+	}
+	content, err := proto.Marshal(block)
+	if err != nil {
+		return errors.Wrap(err, "error while marshaling the partial batch")
+	}
+
+	tmpPath := f.path + ".tmp"
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		return errors.Wrapf(err, "error while creating the file [%s]", tmpPath)
+	}
+	if _, err := fileops.Write(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "error while closing the file [%s]", tmpPath)
+	}
+	if err := os.Rename(tmpPath, f.path); err != nil {
+		return errors.Wrapf(err, "error while renaming the file [%s] to [%s]", tmpPath, f.path)
+	}
+
+	return fileops.SyncDir(filepath.Dir(f.path))
+}
+
+// read returns the data and void transactions of the persisted partial batch, if any. At most one of them is
+// non-empty.
+func (f *partialBatchFile) read() (*types.DataTxEnvelopes, *types.VoidTxEnvelopes, error) {
+	content, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error while reading the partial batch file [%s]", f.path)
+	}
+
+	block := &types.Block{}
+	if err := proto.Unmarshal(content, block); err != nil {
+		return nil, nil, errors.Wrapf(err, "error while unmarshaling the partial batch file [%s]", f.path)
+	}
+
+	return block.GetDataTxEnvelopes(), block.GetVoidTxEnvelopes(), nil
+}
@@ -3,25 +3,80 @@
 package txreorderer
 
 import (
+	"sort"
 	"time"
 
+	ierrors "github.com/hyperledger-labs/orion-server/internal/errors"
 	"github.com/hyperledger-labs/orion-server/internal/queue"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
 )
 
 // TxReorderer holds queue and other components needed to reorder
 // transactions before creating a next batch of transactions to be
-// included in the block
+// included in the block. The data transactions are batched in the
+// order in which they were dequeued, within and across batches, so
+// the ordered transactions of a user, i.e., those carrying a sequence
+// number, reach the validator in the order of their submission. The
+// only exception is a transaction on which an earlier transaction of
+// the same batch depends, which is moved ahead of its dependent, see
+// orderByDependencies.
+//
+// Void transactions are batched in blocks of their own. The pending data
+// transactions are cut ahead of a void transaction, and the pending void
+// transactions are cut ahead of a data transaction, so that the voids keep
+// their place among the data transactions of the same user.
+//
+// In the low-latency mode, a data transaction that arrives while the node
+// is lightly loaded is cut into a batch of its own as soon as it is
+// dequeued, instead of waiting for the block timeout, see lowLatencyMode.
+//
+// If a stats channel is configured, the reorderer emits the composition
+// of each batch of data transactions it cuts, see BatchStats.
+//
+// If a partial batch file is configured, the pending data or void
+// transactions are persisted on every append, and are recovered ahead
+// of any new submission on a restart, see RecoverPartialBatch.
+//
+// If the ledger height is configured, the data transactions whose deadline
+// passed are dropped when their batch is cut, see dropExpiredDataTxs.
+//
+// If a part of each block is reserved for admin transactions, a user
+// administration transaction that arrives while data transactions are
+// pending waits in a sub-queue of its own, and rides along with the data
+// transactions: the batch of data transactions is cut short by the
+// reserved capacity, and a mini-batch of the pending user administration
+// transactions is cut right after it. The user administration transactions
+// that arrive behind a pending one join it, and are cut on their own once
+// they fill the reserved capacity or on the block timeout. Hence, a backlog of user
+// administration transactions drains under a sustained data load, rather
+// than each waiting for a block of its own. The database administration
+// and config transactions keep their blocks of their own, and are cut
+// after any pending user administration transaction. The pending user
+// administration transactions are not persisted in the partial batch.
 type TxReorderer struct {
 	txQueue            *queue.Queue
 	txBatchQueue       *queue.Queue
+	pendingTxs         *queue.PendingTxs
 	maxTxCountPerBatch uint32
 	batchTimeout       time.Duration
 	started            chan struct{}
 	stop               chan struct{}
 	stopped            chan struct{}
 	pendingDataTxs     *types.DataTxEnvelopes
+	pendingVoidTxs     *types.VoidTxEnvelopes
+	pendingHeartbeats  map[string]*types.HeartbeatTxEnvelope
+	pendingUserAdmins  *types.UserAdministrationTxEnvelopes
+	reservedAdminTxs   uint32
+	stats              chan<- *BatchStats
+	lowLatency         *lowLatencyMode
+	partialBatch       *partialBatchFile
+	isTxCommitted      func(txID string) (bool, error)
+	ledgerHeight       func() (uint64, error)
+	now                func() time.Time
+	newTicker          func(d time.Duration) Ticker
 	logger             *logger.SugarLogger
 	// TODO:
 	// tx merkle tree
@@ -34,23 +89,161 @@ type TxReorderer struct {
 type Config struct {
 	TxQueue            *queue.Queue
 	TxBatchQueue       *queue.Queue
+	PendingTxs         *queue.PendingTxs // optional, used to track the stage of pending transactions
 	MaxTxCountPerBatch uint32
 	BatchTimeout       time.Duration
-	Logger             *logger.SugarLogger
+	Stats              chan<- *BatchStats // optional, receives the composition of each batch of data transactions
+	// LowLatencyQuietPeriod and LowLatencyMaxArrivalRate configure the low-latency mode, which is disabled by a zero
+	// quiet period
+	LowLatencyQuietPeriod    time.Duration
+	LowLatencyMaxArrivalRate uint32
+	// AdminReservationPercent is the percentage of MaxTxCountPerBatch reserved for the user administration
+	// transactions while data transactions are pending. Zero disables the reservation, and each user administration
+	// transaction is then cut into a batch of its own.
+	AdminReservationPercent uint32
+	// PartialBatchFile is the file on which the partial batch is persisted across restarts. It is optional, and
+	// IsTxCommitted, which filters out the recovered transactions that have already been committed, is required
+	// along with it.
+	PartialBatchFile string
+	IsTxCommitted    func(txID string) (bool, error)
+	// LedgerHeight is optional, and is used to drop the data transactions whose deadline passed before they were
+	// batched
+	LedgerHeight func() (uint64, error)
+	// NewTicker is optional, and creates the ticker of the block timeout in place of a *time.Ticker, e.g., for a
+	// simulation to decide when the block timeout occurs
+	NewTicker func(d time.Duration) Ticker
+	Logger    *logger.SugarLogger
+}
+
+// Ticker delivers the block timeouts of the reorderer, as a *time.Ticker does
+type Ticker interface {
+	Chan() <-chan time.Time
+	Reset(d time.Duration)
+	Stop()
+}
+
+type timeTicker struct {
+	*time.Ticker
+}
+
+func newTimeTicker(d time.Duration) Ticker {
+	return &timeTicker{Ticker: time.NewTicker(d)}
+}
+
+func (t *timeTicker) Chan() <-chan time.Time {
+	return t.C
+}
+
+// BatchStats holds the composition of a batch of data transactions at the time the batch was cut. The batch is
+// identified by its first transaction, as the block number is assigned only once the batch is replicated.
+type BatchStats struct {
+	FirstTxID   string
+	Composition *types.BatchComposition
 }
 
 // New creates a transaction reorderer
 func New(conf *Config) *TxReorderer {
-	return &TxReorderer{
+	r := &TxReorderer{
 		txQueue:            conf.TxQueue,
 		txBatchQueue:       conf.TxBatchQueue,
+		pendingTxs:         conf.PendingTxs,
 		maxTxCountPerBatch: conf.MaxTxCountPerBatch,
 		batchTimeout:       conf.BatchTimeout,
+		stats:              conf.Stats,
+		lowLatency:         newLowLatencyMode(conf.LowLatencyQuietPeriod, conf.LowLatencyMaxArrivalRate),
+		isTxCommitted:      conf.IsTxCommitted,
+		ledgerHeight:       conf.LedgerHeight,
+		now:                time.Now,
+		newTicker:          newTimeTicker,
 		started:            make(chan struct{}),
 		stop:               make(chan struct{}),
 		stopped:            make(chan struct{}),
+		pendingDataTxs:     &types.DataTxEnvelopes{},
+		pendingVoidTxs:     &types.VoidTxEnvelopes{},
+		pendingHeartbeats:  make(map[string]*types.HeartbeatTxEnvelope),
+		pendingUserAdmins:  &types.UserAdministrationTxEnvelopes{},
+		reservedAdminTxs:   reservedAdminTxs(conf.MaxTxCountPerBatch, conf.AdminReservationPercent),
 		logger:             conf.Logger,
 	}
+	if conf.NewTicker != nil {
+		r.newTicker = conf.NewTicker
+	}
+	if conf.PartialBatchFile != "" {
+		r.partialBatch = &partialBatchFile{path: conf.PartialBatchFile}
+	}
+
+	return r
+}
+
+// reservedAdminTxs returns the number of transactions of a batch reserved for the user administration transactions.
+// A non-zero percentage reserves at least one transaction, yet never the whole batch.
+func reservedAdminTxs(maxTxCountPerBatch, percent uint32) uint32 {
+	if percent == 0 || maxTxCountPerBatch < 2 {
+		return 0
+	}
+
+	reserved := maxTxCountPerBatch * percent / 100
+	if reserved == 0 {
+		reserved = 1
+	}
+	if reserved >= maxTxCountPerBatch {
+		reserved = maxTxCountPerBatch - 1
+	}
+	return reserved
+}
+
+// RecoverPartialBatch loads the partial batch persisted before a restart as the pending batch, so that its
+// transactions are batched ahead of any transaction dequeued after the restart. The transactions that have been
+// committed meanwhile are dropped, and the others are added to the pending transactions, so that a resubmission of
+// any of them is rejected as a duplicate. It must be called before the reorderer is started, and before any
+// transaction is submitted.
+func (r *TxReorderer) RecoverPartialBatch() error {
+	if r.partialBatch == nil {
+		return nil
+	}
+
+	dataTxs, voidTxs, err := r.partialBatch.read()
+	if err != nil {
+		return err
+	}
+
+	isPending := func(txID, submitter string) (bool, error) {
+		committed, err := r.isTxCommitted(txID)
+		if err != nil || committed {
+			return false, err
+		}
+		if r.pendingTxs != nil && !r.pendingTxs.Has(txID) {
+			r.pendingTxs.Add(txID, submitter, nil)
+		}
+		return true, nil
+	}
+
+	for _, env := range dataTxs.GetEnvelopes() {
+		pending, err := isPending(env.GetPayload().GetTxId(), utils.TxSubmitter(env))
+		if err != nil {
+			return err
+		}
+		if pending {
+			r.pendingDataTxs.Envelopes = append(r.pendingDataTxs.Envelopes, env)
+		}
+	}
+	for _, env := range voidTxs.GetEnvelopes() {
+		pending, err := isPending(env.GetPayload().GetTxId(), utils.TxSubmitter(env))
+		if err != nil {
+			return err
+		}
+		if pending {
+			r.pendingVoidTxs.Envelopes = append(r.pendingVoidTxs.Envelopes, env)
+		}
+	}
+
+	if recovered := len(r.pendingDataTxs.Envelopes) + len(r.pendingVoidTxs.Envelopes); recovered > 0 {
+		r.logger.Infof("recovered a partial batch of [%d] data and [%d] void transactions, out of [%d] persisted before the restart",
+			len(r.pendingDataTxs.Envelopes), len(r.pendingVoidTxs.Envelopes), len(dataTxs.GetEnvelopes())+len(voidTxs.GetEnvelopes()))
+	}
+	r.persistPartialBatch()
+
+	return nil
 }
 
 // Start starts the transactions batch creator
@@ -59,20 +252,21 @@ func (r *TxReorderer) Start() {
 	r.logger.Info("starting the transactions reorderer")
 	close(r.started)
 
-	ticker := time.NewTicker(r.batchTimeout)
+	ticker := r.newTicker(r.batchTimeout)
 	defer ticker.Stop()
 
-	r.pendingDataTxs = &types.DataTxEnvelopes{}
-
 	for {
 		select {
 		case <-r.stop:
 			r.logger.Info("stopping the transaction reorderer")
 			return
 
-		case <-ticker.C:
+		case <-ticker.Chan():
 			r.logger.Debug("block timeout has occurred")
-			r.enqueueAndResetPendingDataTxBatch()
+			r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_TIMEOUT)
+			r.enqueueAllPendingUserAdminTxs()
+			r.enqueueAndResetPendingVoidTxBatch()
+			r.enqueueAndResetPendingHeartbeatBatch()
 
 		default:
 			tx := r.txQueue.DequeueWithWaitLimit(r.batchTimeout)
@@ -82,18 +276,58 @@ func (r *TxReorderer) Start() {
 
 			switch env := tx.(type) {
 			case *types.DataTxEnvelope:
+				r.enqueueAndResetPendingVoidTxBatch()
 				r.pendingDataTxs.Envelopes = append(r.pendingDataTxs.Envelopes, env)
+				r.persistPartialBatch()
+
+				if r.lowLatency.arrive(r.now()) {
+					r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_LOW_LATENCY)
+				} else if uint32(len(r.pendingDataTxs.Envelopes)) >= r.dataTxBatchLimit() {
+					r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_TX_COUNT)
+					// under a sustained load the ticker is reset before it fires, hence the heartbeats are batched
+					// along with the data transactions.
+					r.enqueueAndResetPendingHeartbeatBatch()
+					ticker.Reset(r.batchTimeout)
+				}
+
+			case *types.VoidTxEnvelope:
+				r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_VOID_TX)
+				r.enqueueAllPendingUserAdminTxs()
+				r.pendingVoidTxs.Envelopes = append(r.pendingVoidTxs.Envelopes, env)
+				r.persistPartialBatch()
 
-				if uint32(len(r.pendingDataTxs.Envelopes)) == r.maxTxCountPerBatch {
-					r.enqueueAndResetPendingDataTxBatch()
+				if uint32(len(r.pendingVoidTxs.Envelopes)) == r.maxTxCountPerBatch {
+					r.enqueueAndResetPendingVoidTxBatch()
 					ticker.Reset(r.batchTimeout)
 				}
 
+			case *types.HeartbeatTxEnvelope:
+				r.addPendingHeartbeat(env)
+
 			case *types.UserAdministrationTxEnvelope:
-				r.enqueueAndResetPendingDataTxBatch()
+				if r.reservedAdminTxs > 0 && (len(r.pendingDataTxs.Envelopes) > 0 || len(r.pendingUserAdmins.Envelopes) > 0) {
+					r.pendingUserAdmins.Envelopes = append(r.pendingUserAdmins.Envelopes, env)
+					switch {
+					case len(r.pendingDataTxs.Envelopes) == 0:
+						// the backlog left over from the previous batch of data transactions is cut on its own once
+						// it fills the reserved capacity, and otherwise waits for the data transactions or the timeout
+						if uint32(len(r.pendingUserAdmins.Envelopes)) >= r.reservedAdminTxs {
+							r.enqueuePendingUserAdminTxBatch()
+						}
+					case uint32(len(r.pendingDataTxs.Envelopes)) >= r.dataTxBatchLimit():
+						r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_TX_COUNT)
+						r.enqueueAndResetPendingHeartbeatBatch()
+						ticker.Reset(r.batchTimeout)
+					}
+					continue
+				}
+
+				r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_ADMIN_FAST_PATH)
+				r.enqueueAllPendingUserAdminTxs()
+				r.enqueueAndResetPendingVoidTxBatch()
 
 				r.logger.Debug("enqueueing user administrative transaction")
-				r.txBatchQueue.Enqueue(
+				r.enqueueBatch(
 					&types.Block_UserAdministrationTxEnvelope{
 						UserAdministrationTxEnvelope: env,
 					},
@@ -101,10 +335,12 @@ func (r *TxReorderer) Start() {
 				ticker.Reset(r.batchTimeout)
 
 			case *types.DBAdministrationTxEnvelope:
-				r.enqueueAndResetPendingDataTxBatch()
+				r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_ADMIN_FAST_PATH)
+				r.enqueueAllPendingUserAdminTxs()
+				r.enqueueAndResetPendingVoidTxBatch()
 
 				r.logger.Debug("enqueueing db administrative transaction")
-				r.txBatchQueue.Enqueue(
+				r.enqueueBatch(
 					&types.Block_DbAdministrationTxEnvelope{
 						DbAdministrationTxEnvelope: env,
 					},
@@ -112,10 +348,12 @@ func (r *TxReorderer) Start() {
 				ticker.Reset(r.batchTimeout)
 
 			case *types.ConfigTxEnvelope:
-				r.enqueueAndResetPendingDataTxBatch()
+				r.enqueueAndResetPendingDataTxBatch(types.BatchComposition_ADMIN_FAST_PATH)
+				r.enqueueAllPendingUserAdminTxs()
+				r.enqueueAndResetPendingVoidTxBatch()
 
 				r.logger.Debug("enqueueing cluster config transaction")
-				r.txBatchQueue.Enqueue(
+				r.enqueueBatch(
 					&types.Block_ConfigTxEnvelope{
 						ConfigTxEnvelope: env,
 					},
@@ -138,17 +376,298 @@ func (r *TxReorderer) Stop() {
 	<-r.stopped
 }
 
-func (r *TxReorderer) enqueueAndResetPendingDataTxBatch() {
+func (r *TxReorderer) enqueueAndResetPendingDataTxBatch(reason types.BatchComposition_CutReason) {
 	if len(r.pendingDataTxs.Envelopes) == 0 {
 		return
 	}
 
+	r.dropExpiredDataTxs()
+	if len(r.pendingDataTxs.Envelopes) == 0 {
+		r.persistPartialBatch()
+		r.enqueuePendingUserAdminTxBatch()
+		return
+	}
+
+	r.pendingDataTxs.Envelopes = orderByDependencies(r.pendingDataTxs.Envelopes)
+
+	// the stats are emitted ahead of the batch, hence, before the block of the batch can commit
+	r.emitBatchStats(reason)
+
 	r.logger.Debugf("enqueueing [%d] data transactions", len(r.pendingDataTxs.Envelopes))
-	r.txBatchQueue.Enqueue(
+	r.enqueueBatch(
 		&types.Block_DataTxEnvelopes{
 			DataTxEnvelopes: r.pendingDataTxs,
 		},
 	)
 
 	r.pendingDataTxs = &types.DataTxEnvelopes{}
+	r.persistPartialBatch()
+
+	r.enqueuePendingUserAdminTxBatch()
+}
+
+// dataTxBatchLimit returns the number of pending data transactions at which their batch is cut. The capacity reserved
+// for the user administration transactions is taken off the batch only as far as they are pending.
+func (r *TxReorderer) dataTxBatchLimit() uint32 {
+	reserved := uint32(len(r.pendingUserAdmins.Envelopes))
+	if reserved > r.reservedAdminTxs {
+		reserved = r.reservedAdminTxs
+	}
+	return r.maxTxCountPerBatch - reserved
+}
+
+// enqueuePendingUserAdminTxBatch cuts a mini-batch of up to the reserved number of the pending user administration
+// transactions, in the order of their arrival. The rest remain pending for the next batch of data transactions.
+func (r *TxReorderer) enqueuePendingUserAdminTxBatch() {
+	count := len(r.pendingUserAdmins.Envelopes)
+	if count == 0 {
+		return
+	}
+	if count > int(r.reservedAdminTxs) {
+		count = int(r.reservedAdminTxs)
+	}
+
+	batch := &types.UserAdministrationTxEnvelopes{
+		Envelopes: r.pendingUserAdmins.Envelopes[:count:count],
+	}
+	r.pendingUserAdmins.Envelopes = r.pendingUserAdmins.Envelopes[count:]
+
+	r.logger.Debugf("enqueueing [%d] user administrative transactions, [%d] remain pending", count, len(r.pendingUserAdmins.Envelopes))
+	r.enqueueBatch(
+		&types.Block_UserAdministrationTxEnvelopes{
+			UserAdministrationTxEnvelopes: batch,
+		},
+	)
+}
+
+// enqueueAllPendingUserAdminTxs cuts all the pending user administration transactions into mini-batches, so that
+// none is overtaken by a transaction cut into a block of its own, or is held back once no data transaction is pending.
+func (r *TxReorderer) enqueueAllPendingUserAdminTxs() {
+	for len(r.pendingUserAdmins.Envelopes) > 0 {
+		r.enqueuePendingUserAdminTxBatch()
+	}
+}
+
+// dropExpiredDataTxs drops the pending data transactions whose deadline has already passed, as they would be
+// invalidated by the validator. The block of the batch cannot be numbered lower than the ledger height plus one, nor
+// be timestamped earlier than now, so that a transaction dropped here is certain to have expired. The submitters of
+// the dropped transactions are released with a TxExpiredError.
+func (r *TxReorderer) dropExpiredDataTxs() {
+	if r.ledgerHeight == nil {
+		return
+	}
+
+	height, err := r.ledgerHeight()
+	if err != nil {
+		r.logger.Errorf("error while fetching the ledger height, the deadlines of the batch are left to the validator: %s", err)
+		return
+	}
+	now := r.now().UnixNano()
+
+	var expiredTxIDs []string
+	kept := r.pendingDataTxs.Envelopes[:0]
+	for _, env := range r.pendingDataTxs.Envelopes {
+		if env.GetPayload().GetNotCommittedAfter().PassedAt(height+1, now) {
+			expiredTxIDs = append(expiredTxIDs, env.GetPayload().GetTxId())
+			continue
+		}
+		kept = append(kept, env)
+	}
+	if len(expiredTxIDs) == 0 {
+		return
+	}
+	r.pendingDataTxs.Envelopes = kept
+
+	r.logger.Infof("dropping [%d] expired data transactions: %v", len(expiredTxIDs), expiredTxIDs)
+	if r.pendingTxs != nil {
+		r.pendingTxs.ReleaseExpired(expiredTxIDs, &ierrors.TxExpiredError{
+			ErrMsg: "the deadline of the transaction passed before it was included in a block",
+		})
+	}
+}
+
+// orderByDependencies orders the data transactions of a batch such that a transaction follows the transaction it
+// depends on, if both are in the batch, and otherwise keeps the order of submission. The transactions of a
+// dependency cycle are invalidated by the validator whatever their order.
+func orderByDependencies(envs []*types.DataTxEnvelope) []*types.DataTxEnvelope {
+	txNums := make(map[string]int)
+	dependents := false
+	for txNum, env := range envs {
+		if _, ok := txNums[env.GetPayload().GetTxId()]; !ok {
+			txNums[env.GetPayload().GetTxId()] = txNum
+		}
+		if env.GetPayload().GetDependsOnTxId() != "" {
+			dependents = true
+		}
+	}
+	if !dependents {
+		return envs
+	}
+
+	ordered := make([]*types.DataTxEnvelope, 0, len(envs))
+	placed := make([]bool, len(envs))
+	visiting := make([]bool, len(envs))
+	var place func(txNum int)
+	place = func(txNum int) {
+		if placed[txNum] || visiting[txNum] {
+			return
+		}
+		visiting[txNum] = true
+		if depTxNum, ok := txNums[envs[txNum].GetPayload().GetDependsOnTxId()]; ok {
+			place(depTxNum)
+		}
+		visiting[txNum] = false
+
+		placed[txNum] = true
+		ordered = append(ordered, envs[txNum])
+	}
+	for txNum := range envs {
+		place(txNum)
+	}
+
+	return ordered
+}
+
+func (r *TxReorderer) enqueueAndResetPendingVoidTxBatch() {
+	if len(r.pendingVoidTxs.Envelopes) == 0 {
+		return
+	}
+
+	r.logger.Debugf("enqueueing [%d] void transactions", len(r.pendingVoidTxs.Envelopes))
+	r.enqueueBatch(
+		&types.Block_VoidTxEnvelopes{
+			VoidTxEnvelopes: r.pendingVoidTxs,
+		},
+	)
+
+	r.pendingVoidTxs = &types.VoidTxEnvelopes{}
+	r.persistPartialBatch()
+}
+
+// persistPartialBatch writes the pending data or void transactions to the partial batch file, or removes the file
+// if none is pending. A failure is logged rather than holding back the pipeline, as it risks only the loss of the
+// partial batch on a crash.
+func (r *TxReorderer) persistPartialBatch() {
+	if r.partialBatch == nil {
+		return
+	}
+
+	var batch interface{}
+	switch {
+	case len(r.pendingDataTxs.Envelopes) > 0:
+		batch = r.pendingDataTxs
+	case len(r.pendingVoidTxs.Envelopes) > 0:
+		batch = r.pendingVoidTxs
+	}
+
+	if err := r.partialBatch.write(batch); err != nil {
+		r.logger.Errorf("failed to persist the partial batch: %s", err)
+	}
+}
+
+// emitBatchStats sends the composition of the pending batch of data transactions to the stats channel. If the
+// channel is full, the composition is dropped rather than holding back the batch.
+func (r *TxReorderer) emitBatchStats(reason types.BatchComposition_CutReason) {
+	if r.stats == nil {
+		return
+	}
+
+	envs := r.pendingDataTxs.Envelopes
+	composition := &types.BatchComposition{
+		CutReason:      reason,
+		TxCount:        uint32(len(envs)),
+		TxCountPerUser: make(map[string]uint32),
+	}
+
+	txIDs := make([]string, len(envs))
+	for i, env := range envs {
+		txIDs[i] = env.GetPayload().GetTxId()
+		var userID string
+		if userIDs := env.GetPayload().GetMustSignUserIds(); len(userIDs) > 0 {
+			userID = userIDs[0]
+		}
+		composition.TxCountPerUser[userID]++
+	}
+
+	if r.pendingTxs != nil {
+		waits := r.pendingTxs.QueuedFor(txIDs, time.Now())
+		sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+		composition.QueueWaitP50Micros = uint64(percentile(waits, 50).Microseconds())
+		composition.QueueWaitP95Micros = uint64(percentile(waits, 95).Microseconds())
+	}
+
+	select {
+	case r.stats <- &BatchStats{FirstTxID: txIDs[0], Composition: composition}:
+	default:
+		r.logger.Warnf("the stats channel is full, dropping the composition of the batch starting with transaction [%s]", txIDs[0])
+	}
+}
+
+// percentile returns the nearest-rank percentile of the given sorted durations, or zero if there are none
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// addPendingHeartbeat keeps only the newest pending heartbeat of each node, so that heartbeats cannot flood the
+// blocks. A heartbeat which is superseded is released from the pending transactions.
+func (r *TxReorderer) addPendingHeartbeat(env *types.HeartbeatTxEnvelope) {
+	nodeID := env.GetPayload().GetNodeId()
+	if prev, ok := r.pendingHeartbeats[nodeID]; ok {
+		r.logger.Debugf("heartbeat [%s] of node [%s] is superseded by heartbeat [%s]", prev.GetPayload().GetTxId(), nodeID, env.GetPayload().GetTxId())
+		if r.pendingTxs != nil {
+			r.pendingTxs.ReleaseWithError(
+				[]string{prev.GetPayload().GetTxId()},
+				errors.Errorf("heartbeat is superseded by a newer heartbeat [%s] of node [%s]", env.GetPayload().GetTxId(), nodeID),
+			)
+		}
+	}
+
+	r.pendingHeartbeats[nodeID] = env
+}
+
+func (r *TxReorderer) enqueueAndResetPendingHeartbeatBatch() {
+	if len(r.pendingHeartbeats) == 0 {
+		return
+	}
+
+	var nodeIDs []string
+	for nodeID := range r.pendingHeartbeats {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Strings(nodeIDs)
+
+	batch := &types.HeartbeatTxEnvelopes{}
+	for _, nodeID := range nodeIDs {
+		batch.Envelopes = append(batch.Envelopes, r.pendingHeartbeats[nodeID])
+	}
+
+	r.logger.Debugf("enqueueing [%d] heartbeat transactions", len(batch.Envelopes))
+	r.enqueueBatch(
+		&types.Block_HeartbeatTxEnvelopes{
+			HeartbeatTxEnvelopes: batch,
+		},
+	)
+
+	r.pendingHeartbeats = make(map[string]*types.HeartbeatTxEnvelope)
+}
+
+// enqueueBatch enqueues a batch for block creation. The stage of its transactions is updated before the batch is
+// enqueued, so that it never overrides a later stage.
+func (r *TxReorderer) enqueueBatch(batch interface{}) {
+	if r.pendingTxs != nil {
+		if txIDs, err := utils.BlockPayloadToTxIDs(batch); err == nil {
+			r.pendingTxs.UpdateStage(txIDs, queue.TxStageBatched, 0)
+		} else {
+			r.logger.Errorf("failed to extract TxIDs from batch: %s", err)
+		}
+	}
+
+	r.txBatchQueue.Enqueue(batch)
 }
@@ -3,6 +3,10 @@
 package txreorderer
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -145,6 +149,29 @@ func TestTxReorderer(t *testing.T) {
 		},
 	}
 
+	voidTx1 := &types.VoidTxEnvelope{
+		Payload: &types.VoidTx{
+			UserId:   "user1",
+			TxId:     "tx1",
+			Sequence: 1,
+		},
+	}
+
+	voidTx2 := &types.VoidTxEnvelope{
+		Payload: &types.VoidTx{
+			UserId:   "user1",
+			TxId:     "tx2",
+			Sequence: 2,
+		},
+	}
+
+	voidTx3 := &types.VoidTxEnvelope{
+		Payload: &types.VoidTx{
+			UserId: "user2",
+			TxId:   "tx3",
+		},
+	}
+
 	dbAdminTx := &types.DBAdministrationTxEnvelope{
 		Payload: &types.DBAdministrationTx{
 			UserId:    "user1",
@@ -236,6 +263,53 @@ func TestTxReorderer(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:               "void transactions keep their order relative to data transactions",
+			maxTxCountPerBatch: 2,
+			timeout:            500 * time.Millisecond,
+			txs: []interface{}{
+				dataTx1,
+				voidTx1,
+				voidTx2,
+				voidTx3,
+				dataTx2,
+				userAdminTx,
+			},
+			expectedTxBatches: []interface{}{
+				&types.Block_DataTxEnvelopes{
+					DataTxEnvelopes: &types.DataTxEnvelopes{
+						Envelopes: []*types.DataTxEnvelope{
+							dataTx1,
+						},
+					},
+				},
+				&types.Block_VoidTxEnvelopes{
+					VoidTxEnvelopes: &types.VoidTxEnvelopes{
+						Envelopes: []*types.VoidTxEnvelope{
+							voidTx1,
+							voidTx2,
+						},
+					},
+				},
+				&types.Block_VoidTxEnvelopes{
+					VoidTxEnvelopes: &types.VoidTxEnvelopes{
+						Envelopes: []*types.VoidTxEnvelope{
+							voidTx3,
+						},
+					},
+				},
+				&types.Block_DataTxEnvelopes{
+					DataTxEnvelopes: &types.DataTxEnvelopes{
+						Envelopes: []*types.DataTxEnvelope{
+							dataTx2,
+						},
+					},
+				},
+				&types.Block_UserAdministrationTxEnvelope{
+					UserAdministrationTxEnvelope: userAdminTx,
+				},
+			},
+		},
 		{
 			name:               "batch timeout reached",
 			maxTxCountPerBatch: 1000,
@@ -297,3 +371,899 @@ func TestTxReorderer(t *testing.T) {
 		})
 	}
 }
+
+func TestTxReordererCoalescesHeartbeats(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	pendingTxs := queue.NewPendingTxs(lg)
+	drops := &droppedTxs{}
+	pendingTxs.SetDropRecorder(drops.record)
+	r := New(&Config{
+		TxQueue:            queue.New(10),
+		TxBatchQueue:       queue.New(10),
+		PendingTxs:         pendingTxs,
+		MaxTxCountPerBatch: 10,
+		BatchTimeout:       500 * time.Millisecond,
+		Logger:             lg,
+	})
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	heartbeat := func(nodeID, txID string, height uint64) *types.HeartbeatTxEnvelope {
+		return &types.HeartbeatTxEnvelope{
+			Payload: &types.HeartbeatTx{
+				NodeId:              nodeID,
+				TxId:                txID,
+				LastCommittedHeight: height,
+			},
+			Signature: []byte("sig"),
+		}
+	}
+	txs := []*types.HeartbeatTxEnvelope{
+		heartbeat("node2", "tx1", 5),
+		heartbeat("node1", "tx2", 5),
+		heartbeat("node2", "tx3", 6),
+		heartbeat("node2", "tx4", 7),
+	}
+	for _, tx := range txs {
+		pendingTxs.Add(tx.Payload.TxId, tx.Payload.NodeId, nil)
+		r.txQueue.Enqueue(tx)
+	}
+
+	require.Eventually(t, func() bool { return r.txBatchQueue.Size() == 1 }, 2*time.Second, 100*time.Millisecond)
+	require.Equal(t,
+		&types.Block_HeartbeatTxEnvelopes{
+			HeartbeatTxEnvelopes: &types.HeartbeatTxEnvelopes{
+				Envelopes: []*types.HeartbeatTxEnvelope{txs[1], txs[3]},
+			},
+		},
+		r.txBatchQueue.Dequeue(),
+	)
+
+	// the superseded heartbeats are no longer pending
+	require.False(t, pendingTxs.Has("tx1"))
+	require.False(t, pendingTxs.Has("tx3"))
+	require.True(t, pendingTxs.Has("tx2"))
+	require.True(t, pendingTxs.Has("tx4"))
+
+	// and are dead-lettered
+	dropped := drops.get("tx1")
+	require.NotNil(t, dropped)
+	require.Equal(t, "node2", dropped.GetSubmitter())
+	require.Equal(t, "queued", dropped.GetStage())
+	require.Equal(t, "heartbeat is superseded by a newer heartbeat [tx3] of node [node2]", dropped.GetReason())
+	require.Equal(t, "heartbeat is superseded by a newer heartbeat [tx4] of node [node2]", drops.get("tx3").GetReason())
+	require.Nil(t, drops.get("tx2"))
+}
+
+// droppedTxs collects the dead-letter records of the pending transactions
+type droppedTxs struct {
+	sync.Mutex
+	drops map[string]*types.DroppedTx
+}
+
+func (d *droppedTxs) record(drops []*types.DroppedTx) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.drops == nil {
+		d.drops = make(map[string]*types.DroppedTx)
+	}
+	for _, dropped := range drops {
+		d.drops[dropped.GetTxId()] = dropped
+	}
+}
+
+func (d *droppedTxs) get(txID string) *types.DroppedTx {
+	d.Lock()
+	defer d.Unlock()
+
+	return d.drops[txID]
+}
+
+func TestTxReordererKeepsUserSequenceOrder(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	const chainLength = 100
+	r := New(&Config{
+		TxQueue:            queue.New(10),
+		TxBatchQueue:       queue.New(3 * chainLength),
+		MaxTxCountPerBatch: 7,
+		BatchTimeout:       50 * time.Millisecond,
+		Logger:             lg,
+	})
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	dataTx := func(userID, txID string, sequence uint64) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds: []string{userID},
+				TxId:            txID,
+				Sequence:        sequence,
+			},
+		}
+	}
+
+	// the dependent chain of user1 is submitted while other users submit unordered transactions
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for seq := uint64(1); seq <= chainLength; seq++ {
+			r.txQueue.Enqueue(dataTx("user1", fmt.Sprintf("user1-tx%d", seq), seq))
+		}
+	}()
+	for _, userID := range []string{"user2", "user3"} {
+		userID := userID
+		go func() {
+			defer wg.Done()
+			for i := 0; i < chainLength; i++ {
+				r.txQueue.Enqueue(dataTx(userID, fmt.Sprintf("%s-tx%d", userID, i), 0))
+			}
+		}()
+	}
+	wg.Wait()
+
+	var sequences []uint64
+	txCount := 0
+	require.Eventually(t, func() bool {
+		for !r.txBatchQueue.IsEmpty() {
+			batch := r.txBatchQueue.Dequeue().(*types.Block_DataTxEnvelopes)
+			for _, env := range batch.DataTxEnvelopes.Envelopes {
+				txCount++
+				if env.Payload.MustSignUserIds[0] == "user1" {
+					sequences = append(sequences, env.Payload.Sequence)
+				}
+			}
+		}
+		return txCount == 3*chainLength
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Len(t, sequences, chainLength)
+	for i, seq := range sequences {
+		require.Equal(t, uint64(i+1), seq)
+	}
+}
+
+func TestTxReordererEmitsBatchStats(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	pendingTxs := queue.NewPendingTxs(lg)
+	stats := make(chan *BatchStats, 4)
+	r := New(&Config{
+		TxQueue:            queue.New(20),
+		TxBatchQueue:       queue.New(20),
+		PendingTxs:         pendingTxs,
+		MaxTxCountPerBatch: 5,
+		BatchTimeout:       300 * time.Millisecond,
+		Stats:              stats,
+		Logger:             lg,
+	})
+
+	dataTx := func(userID, txID string) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds: []string{userID},
+				TxId:            txID,
+			},
+		}
+	}
+
+	// skewed traffic: alice submits most of the transactions
+	var txs []interface{}
+	for i := 0; i < 4; i++ {
+		txs = append(txs, dataTx("alice", fmt.Sprintf("alice-tx%d", i)))
+	}
+	txs = append(txs, dataTx("bob", "bob-tx0"))
+	for i := 4; i < 8; i++ {
+		txs = append(txs, dataTx("alice", fmt.Sprintf("alice-tx%d", i)))
+	}
+	txs = append(txs, dataTx("carol", "carol-tx0"), dataTx("alice", "alice-tx8"))
+	for _, tx := range txs {
+		pendingTxs.Add(tx.(*types.DataTxEnvelope).Payload.TxId, "", nil)
+	}
+	// the transactions wait in the queue before the reorderer starts
+	time.Sleep(50 * time.Millisecond)
+	for _, tx := range txs {
+		r.txQueue.Enqueue(tx)
+	}
+
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	batchCompositions := func(count int) []*BatchStats {
+		var received []*BatchStats
+		require.Eventually(t, func() bool {
+			for {
+				select {
+				case s := <-stats:
+					received = append(received, s)
+				default:
+					return len(received) == count
+				}
+			}
+		}, 2*time.Second, 10*time.Millisecond)
+		return received
+	}
+
+	received := batchCompositions(3)
+	require.Equal(t, "alice-tx0", received[0].FirstTxID)
+	require.Equal(t, types.BatchComposition_TX_COUNT, received[0].Composition.CutReason)
+	require.Equal(t, uint32(5), received[0].Composition.TxCount)
+	require.Equal(t, map[string]uint32{"alice": 4, "bob": 1}, received[0].Composition.TxCountPerUser)
+
+	require.Equal(t, "alice-tx4", received[1].FirstTxID)
+	require.Equal(t, types.BatchComposition_TX_COUNT, received[1].Composition.CutReason)
+	require.Equal(t, map[string]uint32{"alice": 4, "carol": 1}, received[1].Composition.TxCountPerUser)
+
+	require.Equal(t, "alice-tx8", received[2].FirstTxID)
+	require.Equal(t, types.BatchComposition_TIMEOUT, received[2].Composition.CutReason)
+	require.Equal(t, uint32(1), received[2].Composition.TxCount)
+	require.Equal(t, map[string]uint32{"alice": 1}, received[2].Composition.TxCountPerUser)
+
+	for _, s := range received {
+		require.GreaterOrEqual(t, s.Composition.QueueWaitP50Micros, uint64(50*time.Millisecond/time.Microsecond))
+		require.GreaterOrEqual(t, s.Composition.QueueWaitP95Micros, s.Composition.QueueWaitP50Micros)
+		require.Zero(t, s.Composition.BlockNumber)
+	}
+
+	// the batches follow the stats of their transactions
+	for i := 0; i < 3; i++ {
+		batch := r.txBatchQueue.Dequeue().(*types.Block_DataTxEnvelopes)
+		require.Equal(t, received[i].FirstTxID, batch.DataTxEnvelopes.Envelopes[0].Payload.TxId)
+		require.Len(t, batch.DataTxEnvelopes.Envelopes, int(received[i].Composition.TxCount))
+	}
+
+	// an administration transaction cuts the pending data transactions ahead of it
+	r.txQueue.Enqueue(dataTx("bob", "bob-tx1"))
+	r.txQueue.Enqueue(dataTx("bob", "bob-tx2"))
+	r.txQueue.Enqueue(&types.UserAdministrationTxEnvelope{Payload: &types.UserAdministrationTx{UserId: "admin", TxId: "admin-tx"}})
+
+	received = batchCompositions(1)
+	require.Equal(t, "bob-tx1", received[0].FirstTxID)
+	require.Equal(t, types.BatchComposition_ADMIN_FAST_PATH, received[0].Composition.CutReason)
+	require.Equal(t, map[string]uint32{"bob": 2}, received[0].Composition.TxCountPerUser)
+	// the transactions are not pending, hence their queue wait is unknown
+	require.Zero(t, received[0].Composition.QueueWaitP95Micros)
+}
+
+func TestTxReordererDropsBatchStatsWhenChannelIsFull(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	stats := make(chan *BatchStats, 1)
+	r := New(&Config{
+		TxQueue:            queue.New(10),
+		TxBatchQueue:       queue.New(10),
+		MaxTxCountPerBatch: 1,
+		BatchTimeout:       time.Second,
+		Stats:              stats,
+		Logger:             lg,
+	})
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	for i := 0; i < 3; i++ {
+		r.txQueue.Enqueue(&types.DataTxEnvelope{Payload: &types.DataTx{MustSignUserIds: []string{"alice"}, TxId: fmt.Sprintf("tx%d", i)}})
+	}
+
+	// the batches are not held back by the full stats channel
+	require.Eventually(t, func() bool { return r.txBatchQueue.Size() == 3 }, 2*time.Second, 10*time.Millisecond)
+	require.Len(t, stats, 1)
+	require.Equal(t, "tx0", (<-stats).FirstTxID)
+}
+
+func TestPercentile(t *testing.T) {
+	require.Zero(t, percentile(nil, 50))
+
+	var sorted []time.Duration
+	for i := 1; i <= 20; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	require.Equal(t, 10*time.Millisecond, percentile(sorted, 50))
+	require.Equal(t, 19*time.Millisecond, percentile(sorted, 95))
+	require.Equal(t, time.Millisecond, percentile(sorted[:1], 95))
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	dataTx := func(txID, dependsOnTxID string) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				TxId:          txID,
+				DependsOnTxId: dependsOnTxID,
+			},
+		}
+	}
+	txIDs := func(envs []*types.DataTxEnvelope) []string {
+		var ids []string
+		for _, env := range envs {
+			ids = append(ids, env.Payload.TxId)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name     string
+		envs     []*types.DataTxEnvelope
+		expected []string
+	}{
+		{
+			name:     "no dependency",
+			envs:     []*types.DataTxEnvelope{dataTx("tx1", ""), dataTx("tx2", ""), dataTx("tx3", "")},
+			expected: []string{"tx1", "tx2", "tx3"},
+		},
+		{
+			name:     "dependency on an earlier transaction or outside the batch",
+			envs:     []*types.DataTxEnvelope{dataTx("tx1", ""), dataTx("tx2", "tx1"), dataTx("tx3", "tx0")},
+			expected: []string{"tx1", "tx2", "tx3"},
+		},
+		{
+			name: "dependencies on later transactions",
+			envs: []*types.DataTxEnvelope{
+				dataTx("tx1", "tx3"),
+				dataTx("tx2", ""),
+				dataTx("tx3", "tx4"),
+				dataTx("tx4", ""),
+				dataTx("tx5", "tx1"),
+			},
+			expected: []string{"tx4", "tx3", "tx1", "tx2", "tx5"},
+		},
+		{
+			name:     "dependency cycle",
+			envs:     []*types.DataTxEnvelope{dataTx("tx1", "tx2"), dataTx("tx2", "tx1"), dataTx("tx3", "")},
+			expected: []string{"tx2", "tx1", "tx3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, txIDs(orderByDependencies(tt.envs)))
+		})
+	}
+}
+
+func TestLowLatencyMode(t *testing.T) {
+	start := time.Now()
+	at := func(d time.Duration) time.Time {
+		return start.Add(d)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		m := newLowLatencyMode(0, 10)
+		require.False(t, m.arrive(at(0)))
+		require.False(t, m.arrive(at(time.Hour)))
+	})
+
+	t.Run("hysteresis", func(t *testing.T) {
+		m := newLowLatencyMode(100*time.Millisecond, 3)
+
+		// the first arrival follows an idle queue
+		require.True(t, m.arrive(at(0)))
+		require.True(t, m.arrive(at(10*time.Millisecond)))
+		require.True(t, m.arrive(at(20*time.Millisecond)))
+		// the fourth arrival within a second exceeds the rate
+		require.False(t, m.arrive(at(30*time.Millisecond)))
+		// a rate below the maximum is not enough to switch back, the queue must be quiet
+		require.False(t, m.arrive(at(90*time.Millisecond)))
+		require.False(t, m.arrive(at(180*time.Millisecond)))
+		require.True(t, m.arrive(at(300*time.Millisecond)))
+		// the arrivals before the switch do not count towards the rate
+		require.True(t, m.arrive(at(310*time.Millisecond)))
+		require.True(t, m.arrive(at(320*time.Millisecond)))
+		require.False(t, m.arrive(at(330*time.Millisecond)))
+	})
+
+	t.Run("arrivals leave the window", func(t *testing.T) {
+		m := newLowLatencyMode(100*time.Millisecond, 2)
+
+		for i := 0; i < 10; i++ {
+			require.True(t, m.arrive(at(time.Duration(i)*600*time.Millisecond)), "arrival %d", i)
+		}
+	})
+}
+
+func TestTxReordererLowLatency(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	// the block timeout never fires during the test, hence, every batch is cut by the low-latency mode or by the
+	// transaction count
+	r := New(&Config{
+		TxQueue:                  queue.New(10),
+		TxBatchQueue:             queue.New(10),
+		MaxTxCountPerBatch:       3,
+		BatchTimeout:             time.Hour,
+		LowLatencyQuietPeriod:    100 * time.Millisecond,
+		LowLatencyMaxArrivalRate: 3,
+		Logger:                   lg,
+	})
+
+	// the fake clock yields the arrival time of each data transaction in turn
+	start := time.Now()
+	arrivals := []time.Duration{
+		0,
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		30 * time.Millisecond,
+		40 * time.Millisecond,
+		50 * time.Millisecond,
+		time.Second,
+	}
+	var next int
+	r.now = func() time.Time {
+		now := start.Add(arrivals[next])
+		next++
+		return now
+	}
+
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	dataTx := func(txID string) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds: []string{"user1"},
+				TxId:            txID,
+			},
+		}
+	}
+	requireBatch := func(txIDs ...string) {
+		var batch interface{}
+		require.Eventually(t, func() bool {
+			if r.txBatchQueue.IsEmpty() {
+				return false
+			}
+			batch = r.txBatchQueue.Dequeue()
+			return true
+		}, 5*time.Second, time.Millisecond)
+
+		var actual []string
+		for _, env := range batch.(*types.Block_DataTxEnvelopes).DataTxEnvelopes.Envelopes {
+			actual = append(actual, env.Payload.TxId)
+		}
+		require.Equal(t, txIDs, actual)
+	}
+
+	// while the load is light, each transaction is cut as soon as it arrives, without waiting for the block timeout
+	for _, txID := range []string{"tx1", "tx2", "tx3"} {
+		enqueued := time.Now()
+		r.txQueue.Enqueue(dataTx(txID))
+		requireBatch(txID)
+		require.Less(t, int64(time.Since(enqueued)), int64(5*time.Second))
+	}
+
+	// the fourth transaction within a second reverts to the regular batching
+	r.txQueue.Enqueue(dataTx("tx4"))
+	r.txQueue.Enqueue(dataTx("tx5"))
+	r.txQueue.Enqueue(dataTx("tx6"))
+	requireBatch("tx4", "tx5", "tx6")
+
+	// after a quiet period, the low-latency mode resumes
+	r.txQueue.Enqueue(dataTx("tx7"))
+	requireBatch("tx7")
+	require.True(t, r.txBatchQueue.IsEmpty())
+}
+
+func TestTxReordererDropsExpiredTxs(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	pendingTxs := queue.NewPendingTxs(lg)
+	drops := &droppedTxs{}
+	pendingTxs.SetDropRecorder(drops.record)
+	r := New(&Config{
+		TxQueue:            queue.New(10),
+		TxBatchQueue:       queue.New(10),
+		PendingTxs:         pendingTxs,
+		MaxTxCountPerBatch: 5,
+		BatchTimeout:       50 * time.Millisecond,
+		LedgerHeight: func() (uint64, error) {
+			return 5, nil
+		},
+		Logger: lg,
+	})
+	now := time.Now()
+	r.now = func() time.Time {
+		return now
+	}
+
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	dataTx := func(txID string, deadline *types.TxDeadline) *types.DataTxEnvelope {
+		pendingTxs.Add(txID, "user1", nil)
+		return &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds:   []string{"user1"},
+				TxId:              txID,
+				NotCommittedAfter: deadline,
+			},
+		}
+	}
+
+	// the next block is numbered 6 at the earliest
+	r.txQueue.Enqueue(dataTx("tx-no-deadline", nil))
+	r.txQueue.Enqueue(dataTx("tx-block-passed", &types.TxDeadline{BlockNumber: 5}))
+	r.txQueue.Enqueue(dataTx("tx-block-ok", &types.TxDeadline{BlockNumber: 6}))
+	r.txQueue.Enqueue(dataTx("tx-time-passed", &types.TxDeadline{Timestamp: now.Add(-time.Second).UnixNano()}))
+	r.txQueue.Enqueue(dataTx("tx-time-ok", &types.TxDeadline{Timestamp: now.Add(time.Hour).UnixNano()}))
+
+	var batch interface{}
+	require.Eventually(t, func() bool {
+		if r.txBatchQueue.IsEmpty() {
+			return false
+		}
+		batch = r.txBatchQueue.Dequeue()
+		return true
+	}, 5*time.Second, time.Millisecond)
+
+	var txIDs []string
+	for _, env := range batch.(*types.Block_DataTxEnvelopes).DataTxEnvelopes.Envelopes {
+		txIDs = append(txIDs, env.Payload.TxId)
+	}
+	require.Equal(t, []string{"tx-no-deadline", "tx-block-ok", "tx-time-ok"}, txIDs)
+	require.True(t, pendingTxs.IsExpired("tx-block-passed"))
+	require.True(t, pendingTxs.IsExpired("tx-time-passed"))
+	require.False(t, pendingTxs.Has("tx-block-passed"))
+	require.False(t, pendingTxs.IsExpired("tx-block-ok"))
+	for _, txID := range []string{"tx-block-passed", "tx-time-passed"} {
+		dropped := drops.get(txID)
+		require.NotNil(t, dropped)
+		require.Equal(t, "user1", dropped.GetSubmitter())
+		require.Equal(t, "queued", dropped.GetStage())
+		require.Equal(t, "the deadline of the transaction passed before it was included in a block", dropped.GetReason())
+	}
+	require.Nil(t, drops.get("tx-block-ok"))
+
+	// a batch of expired transactions only is not enqueued at all
+	r.txQueue.Enqueue(dataTx("tx-expired", &types.TxDeadline{BlockNumber: 1}))
+	require.Eventually(t, func() bool {
+		return pendingTxs.IsExpired("tx-expired")
+	}, 5*time.Second, time.Millisecond)
+	require.True(t, r.txBatchQueue.IsEmpty())
+}
+
+func TestTxReordererRecoversPartialBatch(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	partialBatchPath := filepath.Join(t.TempDir(), "partialbatch")
+	committed := map[string]bool{}
+	isTxCommitted := func(txID string) (bool, error) {
+		return committed[txID], nil
+	}
+	// newReorderer creates a reorderer on the partial batch file, as after a restart of the node
+	newReorderer := func(blockTimeout time.Duration) (*TxReorderer, *queue.PendingTxs) {
+		pendingTxs := queue.NewPendingTxs(lg)
+		r := New(&Config{
+			TxQueue:            queue.New(10),
+			TxBatchQueue:       queue.New(10),
+			PendingTxs:         pendingTxs,
+			MaxTxCountPerBatch: 10,
+			BatchTimeout:       blockTimeout,
+			PartialBatchFile:   partialBatchPath,
+			IsTxCommitted:      isTxCommitted,
+			Logger:             lg,
+		})
+		require.NoError(t, r.RecoverPartialBatch())
+		go r.Start()
+		r.WaitTillStart()
+		return r, pendingTxs
+	}
+	dataTx := func(txID string) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{Payload: &types.DataTx{TxId: txID, MustSignUserIds: []string{"user1"}}}
+	}
+	voidTx := func(txID string) *types.VoidTxEnvelope {
+		return &types.VoidTxEnvelope{Payload: &types.VoidTx{TxId: txID, UserId: "user1"}}
+	}
+	txIDs := func(envs []*types.DataTxEnvelope) []string {
+		var ids []string
+		for _, env := range envs {
+			ids = append(ids, env.GetPayload().GetTxId())
+		}
+		return ids
+	}
+	persisted := func() []string {
+		dataTxs, voidTxs, err := (&partialBatchFile{path: partialBatchPath}).read()
+		require.NoError(t, err)
+		var txIDs []string
+		for _, env := range dataTxs.GetEnvelopes() {
+			txIDs = append(txIDs, env.GetPayload().GetTxId())
+		}
+		for _, env := range voidTxs.GetEnvelopes() {
+			txIDs = append(txIDs, env.GetPayload().GetTxId())
+		}
+		return txIDs
+	}
+
+	// the node stops mid-batch, i.e., before the block timeout cuts the pending data transactions
+	r, _ := newReorderer(time.Hour)
+	for _, txID := range []string{"tx1", "tx2", "tx3"} {
+		r.txQueue.Enqueue(dataTx(txID))
+	}
+	require.Eventually(t, func() bool { return len(persisted()) == 3 }, 2*time.Second, 10*time.Millisecond)
+	r.Stop()
+	require.Equal(t, []string{"tx1", "tx2", "tx3"}, persisted())
+
+	// tx1 committed before the crash, hence, only tx2 and tx3 are recovered, and they are pending, so that a
+	// resubmission of either is a duplicate
+	committed["tx1"] = true
+	r, pendingTxs := newReorderer(200 * time.Millisecond)
+	require.False(t, pendingTxs.Has("tx1"))
+	require.True(t, pendingTxs.Has("tx2"))
+	require.True(t, pendingTxs.Has("tx3"))
+
+	// the recovered transactions are batched ahead of those submitted after the restart
+	r.txQueue.Enqueue(dataTx("tx4"))
+	batch := r.txBatchQueue.Dequeue().(*types.Block_DataTxEnvelopes)
+	require.Equal(t, []string{"tx2", "tx3", "tx4"}, txIDs(batch.DataTxEnvelopes.Envelopes))
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(partialBatchPath)
+		return os.IsNotExist(err)
+	}, 2*time.Second, 10*time.Millisecond)
+	r.Stop()
+
+	// a partial batch of void transactions is recovered as well
+	r, _ = newReorderer(time.Hour)
+	r.txQueue.Enqueue(voidTx("tx5"))
+	require.Eventually(t, func() bool { return len(persisted()) == 1 }, 2*time.Second, 10*time.Millisecond)
+	r.Stop()
+
+	r, pendingTxs = newReorderer(200 * time.Millisecond)
+	defer r.Stop()
+	require.True(t, pendingTxs.Has("tx5"))
+	r.txQueue.Enqueue(dataTx("tx6"))
+	voidBatch := r.txBatchQueue.Dequeue().(*types.Block_VoidTxEnvelopes)
+	require.Len(t, voidBatch.VoidTxEnvelopes.Envelopes, 1)
+	require.Equal(t, "tx5", voidBatch.VoidTxEnvelopes.Envelopes[0].GetPayload().GetTxId())
+	batch = r.txBatchQueue.Dequeue().(*types.Block_DataTxEnvelopes)
+	require.Equal(t, []string{"tx6"}, txIDs(batch.DataTxEnvelopes.Envelopes))
+}
+
+func TestReservedAdminTxs(t *testing.T) {
+	tests := []struct {
+		maxTxCountPerBatch uint32
+		percent            uint32
+		expected           uint32
+	}{
+		{maxTxCountPerBatch: 100, percent: 0, expected: 0},
+		{maxTxCountPerBatch: 100, percent: 5, expected: 5},
+		{maxTxCountPerBatch: 10, percent: 5, expected: 1},
+		{maxTxCountPerBatch: 10, percent: 99, expected: 9},
+		{maxTxCountPerBatch: 1, percent: 50, expected: 0},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, reservedAdminTxs(tt.maxTxCountPerBatch, tt.percent), "%d%% of %d", tt.percent, tt.maxTxCountPerBatch)
+	}
+}
+
+func TestTxReordererReservesCapacityForUserAdminTxs(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	r := New(&Config{
+		TxQueue:                 queue.New(50),
+		TxBatchQueue:            queue.New(50),
+		MaxTxCountPerBatch:      20,
+		BatchTimeout:            time.Second,
+		AdminReservationPercent: 10,
+		Logger:                  lg,
+	})
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	dataTx := func(txNum int) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{Payload: &types.DataTx{TxId: fmt.Sprintf("data%d", txNum), MustSignUserIds: []string{"user1"}}}
+	}
+	userAdminTx := func(txID string) *types.UserAdministrationTxEnvelope {
+		return &types.UserAdministrationTxEnvelope{Payload: &types.UserAdministrationTx{TxId: txID, UserId: "admin"}}
+	}
+	dequeue := func() interface{} {
+		batch := r.txBatchQueue.DequeueWithWaitLimit(3 * time.Second)
+		require.NotNil(t, batch)
+		return batch
+	}
+
+	// with no pending data transaction, a user administration transaction is cut into a block of its own
+	admin0 := userAdminTx("admin0")
+	r.txQueue.Enqueue(admin0)
+	require.Equal(t, &types.Block_UserAdministrationTxEnvelope{UserAdministrationTxEnvelope: admin0}, dequeue())
+
+	// the data transactions pending, the user administration transactions wait for the batch of data transactions,
+	// which is cut short by the capacity reserved for them
+	var dataTxs []*types.DataTxEnvelope
+	for i := 0; i < 5; i++ {
+		dataTxs = append(dataTxs, dataTx(i))
+		r.txQueue.Enqueue(dataTxs[i])
+	}
+	admins := []*types.UserAdministrationTxEnvelope{userAdminTx("admin1"), userAdminTx("admin2"), userAdminTx("admin3")}
+	for _, env := range admins {
+		r.txQueue.Enqueue(env)
+	}
+	for i := 5; i < 19; i++ {
+		dataTxs = append(dataTxs, dataTx(i))
+		r.txQueue.Enqueue(dataTxs[i])
+	}
+
+	require.Equal(t, &types.Block_DataTxEnvelopes{DataTxEnvelopes: &types.DataTxEnvelopes{Envelopes: dataTxs[:18]}}, dequeue())
+	require.Equal(t,
+		&types.Block_UserAdministrationTxEnvelopes{
+			UserAdministrationTxEnvelopes: &types.UserAdministrationTxEnvelopes{Envelopes: admins[:2]},
+		},
+		dequeue(),
+	)
+
+	// the remaining user administration transaction rides along with the next batch, cut on the timeout
+	require.Equal(t, &types.Block_DataTxEnvelopes{DataTxEnvelopes: &types.DataTxEnvelopes{Envelopes: dataTxs[18:]}}, dequeue())
+	require.Equal(t,
+		&types.Block_UserAdministrationTxEnvelopes{
+			UserAdministrationTxEnvelopes: &types.UserAdministrationTxEnvelopes{Envelopes: admins[2:]},
+		},
+		dequeue(),
+	)
+
+	// a database administration transaction is cut after the pending user administration transactions
+	data19 := dataTx(19)
+	admin4 := userAdminTx("admin4")
+	dbAdmin := &types.DBAdministrationTxEnvelope{Payload: &types.DBAdministrationTx{TxId: "dbadmin", UserId: "admin"}}
+	r.txQueue.Enqueue(data19)
+	r.txQueue.Enqueue(admin4)
+	r.txQueue.Enqueue(dbAdmin)
+
+	require.Equal(t, &types.Block_DataTxEnvelopes{DataTxEnvelopes: &types.DataTxEnvelopes{Envelopes: []*types.DataTxEnvelope{data19}}}, dequeue())
+	require.Equal(t,
+		&types.Block_UserAdministrationTxEnvelopes{
+			UserAdministrationTxEnvelopes: &types.UserAdministrationTxEnvelopes{Envelopes: []*types.UserAdministrationTxEnvelope{admin4}},
+		},
+		dequeue(),
+	)
+	require.Equal(t, &types.Block_DbAdministrationTxEnvelope{DbAdministrationTxEnvelope: dbAdmin}, dequeue())
+}
+
+func TestTxReordererDrainsUserAdminTxsUnderDataLoad(t *testing.T) {
+	lg, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	const (
+		maxTxCountPerBatch = 100
+		reserved           = 5
+		userAdminTxCount   = 100
+	)
+	r := New(&Config{
+		TxQueue:                 queue.New(1000),
+		TxBatchQueue:            queue.New(100),
+		MaxTxCountPerBatch:      maxTxCountPerBatch,
+		BatchTimeout:            time.Second,
+		AdminReservationPercent: 5,
+		Logger:                  lg,
+	})
+	go r.Start()
+	r.WaitTillStart()
+	defer r.Stop()
+
+	// the data transactions saturate the transaction queue, and the user administration transactions are queued
+	// among them. The producer is stopped before the reorderer, which closes the transaction queue.
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	defer func() {
+		close(stop)
+		<-stopped
+	}()
+	enqueue := func(tx interface{}) bool {
+		for r.txQueue.TryEnqueue(tx) != nil {
+			select {
+			case <-stop:
+				return false
+			default:
+				time.Sleep(10 * time.Microsecond)
+			}
+		}
+		return true
+	}
+	start := time.Now()
+	go func() {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if i%10 == 5 && i/10 < userAdminTxCount {
+				if !enqueue(&types.UserAdministrationTxEnvelope{
+					Payload: &types.UserAdministrationTx{TxId: fmt.Sprintf("admin%d", i/10), UserId: "admin"},
+				}) {
+					return
+				}
+			}
+			if !enqueue(&types.DataTxEnvelope{
+				Payload: &types.DataTx{TxId: fmt.Sprintf("data%d", i), MustSignUserIds: []string{"user1"}},
+			}) {
+				return
+			}
+		}
+	}()
+
+	var nextAdmin, dataBatches, adminBatches int
+	for nextAdmin < userAdminTxCount {
+		batch := r.txBatchQueue.DequeueWithWaitLimit(5 * time.Second)
+		require.NotNil(t, batch)
+
+		switch b := batch.(type) {
+		case *types.Block_DataTxEnvelopes:
+			require.LessOrEqual(t, len(b.DataTxEnvelopes.Envelopes), maxTxCountPerBatch)
+			dataBatches++
+
+		case *types.Block_UserAdministrationTxEnvelopes:
+			adminBatches++
+			envs := b.UserAdministrationTxEnvelopes.Envelopes
+			require.LessOrEqual(t, len(envs), reserved)
+			for _, env := range envs {
+				require.Equal(t, fmt.Sprintf("admin%d", nextAdmin), env.Payload.TxId)
+				nextAdmin++
+			}
+
+		default:
+			require.Failf(t, "unexpected batch", "%T", batch)
+		}
+	}
+
+	elapsed := time.Since(start)
+	t.Logf("batched %d user administration transactions in %d mini-batches, along with %d batches of data transactions, in %s",
+		userAdminTxCount, adminBatches, dataBatches, elapsed)
+	// none of the user administration transactions waits for a block timeout, nor takes a block of its own
+	require.Less(t, int64(elapsed), int64(time.Second))
+	require.Equal(t, userAdminTxCount/reserved, adminBatches)
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// MaxAttestationPayloadSize is the maximal size, in bytes, of the payload of an attestation transaction.
+const MaxAttestationPayloadSize = 4096
+
+// validateAttestation checks the entries of an attestation transaction, which must name a single database without
+// any operation on it, so that its validation reduces to the signatures and the permissions on the database. An
+// attestation does not commit to the state, hence, it cannot carry a sequence number either.
+func validateAttestation(tx *types.DataTx) *types.ValidationInfo {
+	invalid := func(reason string) *types.ValidationInfo {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: reason,
+		}
+	}
+
+	if size := len(tx.AttestationPayload); size > MaxAttestationPayloadSize {
+		return invalid(fmt.Sprintf("the attestation payload has [%d] bytes, which exceeds the limit of [%d] bytes",
+			size, MaxAttestationPayloadSize))
+	}
+	if len(tx.DbOperations) != 1 {
+		return invalid(fmt.Sprintf("an attestation must name a single database, but the transaction has operations on [%d] databases",
+			len(tx.DbOperations)))
+	}
+	ops := tx.DbOperations[0]
+	if len(ops.DataReads) > 0 || len(ops.DataWrites) > 0 || len(ops.DataDeletes) > 0 ||
+		len(ops.DataDeleteRanges) > 0 || len(ops.DataPatches) > 0 {
+		return invalid("an attestation must not read or modify the database [" + ops.DbName + "]")
+	}
+	if tx.Sequence > 0 {
+		return invalid("an attestation cannot carry a sequence number, as it does not commit to the state")
+	}
+
+	return &types.ValidationInfo{Flag: types.Flag_VALID}
+}
@@ -4,6 +4,7 @@
 package txvalidation
 
 import (
+	"bytes"
 	"fmt"
 	"hash/crc32"
 	"net"
@@ -24,6 +25,7 @@ type ConfigTxValidator struct {
 	db              worldstate.DB
 	identityQuerier *identity.Querier
 	sigValidator    *txSigValidator
+	tracer          *tracer
 	logger          *logger.SugarLogger
 }
 
@@ -39,11 +41,14 @@ func (v *ConfigTxValidator) Validate(txEnv *types.ConfigTxEnvelope) (*types.Vali
 		return nil, errors.WithMessagef(err, "error while checking cluster administrative privilege for user [%s]", tx.UserId)
 	}
 	if !hasPerm {
-		return &types.ValidationInfo{
+		vi := &types.ValidationInfo{
 			Flag:            types.Flag_INVALID_NO_PERMISSION,
 			ReasonIfInvalid: "the user [" + tx.UserId + "] has no privilege to perform cluster administrative operations",
-		}, nil
+		}
+		v.tracer.recordPrivilege(tx.UserId, "admin", false, vi)
+		return vi, nil
 	}
+	v.tracer.recordPrivilege(tx.UserId, "admin", true, tracedValid)
 
 	if tx.NewConfig == nil {
 		return &types.ValidationInfo{
@@ -66,11 +71,12 @@ func (v *ConfigTxValidator) Validate(txEnv *types.ConfigTxEnvelope) (*types.Vali
 	if err != nil {
 		return nil, err
 	}
+	v.tracer.recordVersion("mvcc", worldstate.ConfigDBName, worldstate.ConfigKey, tx.ReadOldConfigVersion, configMetadata.GetVersion(), vi)
 	if vi.Flag != types.Flag_VALID {
 		return vi, nil
 	}
 
-	return v.validateConfigTransitionRules(clusterConfig, tx.NewConfig)
+	return v.validateConfigTransitionRules(tx.UserId, clusterConfig, tx.NewConfig)
 }
 
 func (v *ConfigTxValidator) validateGenesis(txEnv *types.ConfigTxEnvelope) ([]*types.ValidationInfo, error) {
@@ -176,6 +182,9 @@ func validateNodeConfig(nodes []*types.NodeConfig, caCertCollection *certificate
 					ReasonIfInvalid: "the node [" + n.Id + "] has an invalid certificate: " + err.Error(),
 				}
 			}
+			if vi := validateNodeCertRotation(n, caCertCollection); vi.Flag != types.Flag_VALID {
+				return vi
+			}
 		}
 
 		if err := validateHostPort(n.Address, n.Port); err != nil {
@@ -210,6 +219,46 @@ func validateNodeConfig(nodes []*types.NodeConfig, caCertCollection *certificate
 	}
 }
 
+// validateNodeCertRotation checks the previous certificate of a node whose certificate is being rotated, which must
+// come with a cutover block, and vice versa.
+func validateNodeCertRotation(n *types.NodeConfig, caCertCollection *certificateauthority.CACertCollection) *types.ValidationInfo {
+	switch {
+	case len(n.PreviousCertificate) == 0 && n.CertificateCutoverBlock != 0:
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the node [" + n.Id + "] has a certificate cutover block but no previous certificate",
+		}
+
+	case len(n.PreviousCertificate) == 0:
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}
+
+	case n.CertificateCutoverBlock == 0:
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the node [" + n.Id + "] has a previous certificate but no certificate cutover block",
+		}
+
+	case bytes.Equal(n.PreviousCertificate, n.Certificate):
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the previous certificate of the node [" + n.Id + "] is the same as its certificate",
+		}
+	}
+
+	if err := caCertCollection.VerifyLeafCert(n.PreviousCertificate); err != nil {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the node [" + n.Id + "] has an invalid previous certificate: " + err.Error(),
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
 func validateAdminConfig(admins []*types.Admin, caCertCollection *certificateauthority.CACertCollection) *types.ValidationInfo {
 	if len(admins) == 0 {
 		return &types.ValidationInfo{
@@ -507,11 +556,25 @@ func validateHostPort(host string, port uint32) error {
 }
 
 // validate whether the transition from currentConfig to updatedConfig is valid and safe.
-func (v *ConfigTxValidator) validateConfigTransitionRules(currentConfig, updatedConfig *types.ClusterConfig) (*types.ValidationInfo, error) {
+func (v *ConfigTxValidator) validateConfigTransitionRules(submitterID string, currentConfig, updatedConfig *types.ClusterConfig) (*types.ValidationInfo, error) {
 	nodes, consensus, ca, admins := replication.ClassifyClusterReConfig(currentConfig, updatedConfig)
 
+	if updatedConfig.GetEpoch() < currentConfig.GetEpoch() {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: fmt.Sprintf("the epoch of the cluster cannot decrease: current: %d, updated: %d", currentConfig.GetEpoch(), updatedConfig.GetEpoch()),
+		}, nil
+	}
+	if updatedConfig.GetEpoch() > currentConfig.GetEpoch() {
+		v.logger.Infof("ClusterConfig epoch bumped: current: %d; updated: %d", currentConfig.GetEpoch(), updatedConfig.GetEpoch())
+	}
+
 	if nodes {
 		v.logger.Debugf("ClusterConfig Nodes changed: current: %s; updated: %s", nodeConfigSliceToString(currentConfig.Nodes), nodeConfigSliceToString(updatedConfig.Nodes))
+		if vi := validateNodeCertRotationsTransition(currentConfig.Nodes, updatedConfig.Nodes); vi.Flag != types.Flag_VALID {
+			v.logger.Debugf("ClusterConfig Nodes rejected change request: %s", vi.ReasonIfInvalid)
+			return vi, nil
+		}
 		// TODO add rules for nodes re-config safety
 	}
 	if ca {
@@ -521,7 +584,10 @@ func (v *ConfigTxValidator) validateConfigTransitionRules(currentConfig, updated
 
 	if admins {
 		v.logger.Debugf("ClusterConfig Admins changed: current: %v; updated: %v", currentConfig.Admins, updatedConfig.Admins)
-		// TODO add rules for admin re-config safety: https://github.com/hyperledger-labs/orion-server/issues/262
+		if vi := validateAdminsTransition(submitterID, currentConfig.Admins, updatedConfig.Admins); vi.Flag != types.Flag_VALID {
+			v.logger.Debugf("ClusterConfig Admins rejected change request: %s", vi.ReasonIfInvalid)
+			return vi, nil
+		}
 	}
 
 	if consensus {
@@ -542,6 +608,99 @@ func (v *ConfigTxValidator) validateConfigTransitionRules(currentConfig, updated
 	}, nil
 }
 
+// validateAdminsTransition protects the cluster from losing all the admins that are able to sign a config transaction,
+// e.g., by replacing the only admin with an admin whose certificate is wrong. The certificates of the updated admins
+// were already verified to chain to the CA and to be unexpired, but only an admin that is retained from the current
+// config, with the same certificate, is known to hold the matching private key. Therefore:
+//   - at least one of the current admins must be retained, and
+//   - the submitter cannot remove or replace itself in the same transaction that adds admins.
+//
+// An admin is therefore rotated in two phases: the first config transaction adds the new admin alongside the old
+// one, and the second, signed by the new admin, removes the old one. See types.AdminRotationConfigs.
+func validateAdminsTransition(submitterID string, currentAdmins, updatedAdmins []*types.Admin) *types.ValidationInfo {
+	current := make(map[string][]byte)
+	for _, a := range currentAdmins {
+		current[a.Id] = a.Certificate
+	}
+
+	var retained, added []string
+	updated := make(map[string][]byte)
+	for _, a := range updatedAdmins {
+		updated[a.Id] = a.Certificate
+		if cert, ok := current[a.Id]; ok && bytes.Equal(cert, a.Certificate) {
+			retained = append(retained, a.Id)
+		} else {
+			added = append(added, a.Id)
+		}
+	}
+
+	if len(retained) == 0 {
+		return &types.ValidationInfo{
+			Flag: types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the updated admin config does not retain any of the current admins with the same certificate. " +
+				"At least one current admin must be retained; add the new admins first, and remove the current admins in a subsequent transaction signed by a new admin",
+		}
+	}
+
+	submitterCert, isAdmin := current[submitterID]
+	if !isAdmin || len(added) == 0 {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}
+	}
+
+	if cert, ok := updated[submitterID]; !ok || !bytes.Equal(cert, submitterCert) {
+		return &types.ValidationInfo{
+			Flag: types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: fmt.Sprintf("the admin [%s] cannot remove or replace itself in the same transaction that adds the admins %v. "+
+				"Add the new admins first, and remove the admin [%s] in a subsequent transaction signed by a new admin", submitterID, added, submitterID),
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+// validateNodeCertRotationsTransition checks that a rotation of the certificate of a node, which the updated config
+// starts, replaces the certificate the node currently has, so that the previous certificate, which is still accepted
+// until the cutover block, is one the cluster already trusted for the node. A rotation which the current config
+// already holds is carried over as is.
+func validateNodeCertRotationsTransition(currentNodes, updatedNodes []*types.NodeConfig) *types.ValidationInfo {
+	current := make(map[string]*types.NodeConfig)
+	for _, n := range currentNodes {
+		current[n.Id] = n
+	}
+
+	for _, n := range updatedNodes {
+		if len(n.PreviousCertificate) == 0 {
+			continue
+		}
+
+		c, ok := current[n.Id]
+		if !ok {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the node [" + n.Id + "] is added to the cluster with a previous certificate. Only the certificate of a current node can be rotated",
+			}
+		}
+
+		retained := bytes.Equal(c.PreviousCertificate, n.PreviousCertificate) &&
+			bytes.Equal(c.Certificate, n.Certificate) &&
+			c.CertificateCutoverBlock == n.CertificateCutoverBlock
+		if !retained && !bytes.Equal(c.Certificate, n.PreviousCertificate) {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the previous certificate of the node [" + n.Id + "] is not its current certificate. A rotation must replace the certificate the node currently has",
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
 func nodeConfigToString(n *types.NodeConfig) string {
 	return fmt.Sprintf("Id: %s, Address: %s, Port: %d, Cert-hash: %x", n.Id, n.Address, n.Port, crc32.ChecksumIEEE(n.Certificate))
 }
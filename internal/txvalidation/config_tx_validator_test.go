@@ -598,6 +598,422 @@ func TestValidateConfigTx(t *testing.T) {
 	}
 }
 
+func TestValidateConfigTxAdminRotation(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"oldAdmin", "newAdmin", "otherAdmin", "node"})
+	oldAdminCert, oldAdminSigner := testutils.LoadTestCrypto(t, cryptoDir, "oldAdmin")
+	newAdminCert, newAdminSigner := testutils.LoadTestCrypto(t, cryptoDir, "newAdmin")
+	otherAdminCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "otherAdmin")
+	nodeCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "node")
+	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
+
+	genesisConfig := &types.ClusterConfig{
+		Nodes: []*types.NodeConfig{
+			{
+				Id:          "node1",
+				Address:     "127.0.0.1",
+				Port:        6090,
+				Certificate: nodeCert.Raw,
+			},
+		},
+		Admins: []*types.Admin{
+			{
+				Id:          "oldAdmin",
+				Certificate: oldAdminCert.Raw,
+			},
+		},
+		CertAuthConfig: &types.CAConfig{
+			Roots: [][]byte{caCert.Raw},
+		},
+		ConsensusConfig: &types.ConsensusConfig{
+			Algorithm: "raft",
+			Members: []*types.PeerConfig{
+				{
+					NodeId:   "node1",
+					RaftId:   1,
+					PeerHost: "127.0.0.1",
+					PeerPort: 7090,
+				},
+			},
+			RaftConfig: &types.RaftConfig{
+				TickInterval:   "100ms",
+				ElectionTicks:  100,
+				HeartbeatTicks: 10,
+			},
+		},
+	}
+
+	// commitConfig mimics the commit of a config transaction, which also updates the admin users
+	commitConfig := func(db worldstate.DB, oldConfig, newConfig *types.ClusterConfig, blockNum uint64) {
+		version := &types.Version{BlockNum: blockNum, TxNum: 0}
+		adminUpdates, err := identity.ConstructDBEntriesForClusterAdmins(oldConfig.GetAdmins(), newConfig.Admins, version)
+		require.NoError(t, err)
+		configSerialized, err := proto.Marshal(newConfig)
+		require.NoError(t, err)
+
+		require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.UsersDBName: adminUpdates,
+			worldstate.ConfigDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      worldstate.ConfigKey,
+						Value:    configSerialized,
+						Metadata: &types.Metadata{Version: version},
+					},
+				},
+			},
+		}, blockNum))
+	}
+
+	t.Run("two-phase rotation", func(t *testing.T) {
+		t.Parallel()
+
+		env := newValidatorTestEnv(t)
+		defer env.cleanup()
+		commitConfig(env.db, nil, genesisConfig, 1)
+
+		addConfig, removeConfig, err := types.AdminRotationConfigs(genesisConfig, "oldAdmin", &types.Admin{
+			Id:          "newAdmin",
+			Certificate: newAdminCert.Raw,
+		})
+		require.NoError(t, err)
+		require.Len(t, genesisConfig.Admins, 1)
+		require.Len(t, addConfig.Admins, 2)
+		require.Len(t, removeConfig.Admins, 1)
+		require.Equal(t, "newAdmin", removeConfig.Admins[0].Id)
+
+		// phase one: the old admin adds the new admin
+		result, err := env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, oldAdminSigner, &types.ConfigTx{
+			UserId:               "oldAdmin",
+			ReadOldConfigVersion: &types.Version{BlockNum: 1, TxNum: 0},
+			NewConfig:            addConfig,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, &types.ValidationInfo{Flag: types.Flag_VALID}, result)
+		commitConfig(env.db, genesisConfig, addConfig, 2)
+
+		// phase two: the new admin removes the old admin
+		result, err = env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, newAdminSigner, &types.ConfigTx{
+			UserId:               "newAdmin",
+			ReadOldConfigVersion: &types.Version{BlockNum: 2, TxNum: 0},
+			NewConfig:            removeConfig,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, &types.ValidationInfo{Flag: types.Flag_VALID}, result)
+		commitConfig(env.db, addConfig, removeConfig, 3)
+
+		// the old admin can no longer submit config transactions
+		result, err = env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, oldAdminSigner, &types.ConfigTx{
+			UserId:               "oldAdmin",
+			ReadOldConfigVersion: &types.Version{BlockNum: 3, TxNum: 0},
+			NewConfig:            genesisConfig,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_INVALID_UNAUTHORISED, result.Flag)
+	})
+
+	t.Run("invalid: single-shot self-replacement", func(t *testing.T) {
+		t.Parallel()
+
+		env := newValidatorTestEnv(t)
+		defer env.cleanup()
+		commitConfig(env.db, nil, genesisConfig, 1)
+
+		replaceConfig := proto.Clone(genesisConfig).(*types.ClusterConfig)
+		replaceConfig.Admins = []*types.Admin{
+			{
+				Id:          "newAdmin",
+				Certificate: newAdminCert.Raw,
+			},
+		}
+		result, err := env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, oldAdminSigner, &types.ConfigTx{
+			UserId:               "oldAdmin",
+			ReadOldConfigVersion: &types.Version{BlockNum: 1, TxNum: 0},
+			NewConfig:            replaceConfig,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, &types.ValidationInfo{
+			Flag: types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the updated admin config does not retain any of the current admins with the same certificate. " +
+				"At least one current admin must be retained; add the new admins first, and remove the current admins in a subsequent transaction signed by a new admin",
+		}, result)
+
+		// replacing the certificate of the submitter, with the same ID, is rejected as well
+		replaceConfig.Admins = []*types.Admin{
+			{
+				Id:          "oldAdmin",
+				Certificate: newAdminCert.Raw,
+			},
+		}
+		result, err = env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, oldAdminSigner, &types.ConfigTx{
+			UserId:               "oldAdmin",
+			ReadOldConfigVersion: &types.Version{BlockNum: 1, TxNum: 0},
+			NewConfig:            replaceConfig,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_INVALID_INCORRECT_ENTRIES, result.Flag)
+	})
+
+	t.Run("invalid: admin removes itself while adding an admin", func(t *testing.T) {
+		t.Parallel()
+
+		env := newValidatorTestEnv(t)
+		defer env.cleanup()
+
+		twoAdminsConfig := proto.Clone(genesisConfig).(*types.ClusterConfig)
+		twoAdminsConfig.Admins = append(twoAdminsConfig.Admins, &types.Admin{
+			Id:          "otherAdmin",
+			Certificate: otherAdminCert.Raw,
+		})
+		commitConfig(env.db, nil, twoAdminsConfig, 1)
+
+		replaceConfig := proto.Clone(twoAdminsConfig).(*types.ClusterConfig)
+		replaceConfig.Admins[0] = &types.Admin{
+			Id:          "newAdmin",
+			Certificate: newAdminCert.Raw,
+		}
+		result, err := env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, oldAdminSigner, &types.ConfigTx{
+			UserId:               "oldAdmin",
+			ReadOldConfigVersion: &types.Version{BlockNum: 1, TxNum: 0},
+			NewConfig:            replaceConfig,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, &types.ValidationInfo{
+			Flag: types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the admin [oldAdmin] cannot remove or replace itself in the same transaction that adds the admins [newAdmin]. " +
+				"Add the new admins first, and remove the admin [oldAdmin] in a subsequent transaction signed by a new admin",
+		}, result)
+
+		// an admin may remove another admin, as long as no admin is added in the same transaction
+		removeOnlyConfig := proto.Clone(twoAdminsConfig).(*types.ClusterConfig)
+		removeOnlyConfig.Admins = removeOnlyConfig.Admins[:1]
+		result, err = env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, oldAdminSigner, &types.ConfigTx{
+			UserId:               "oldAdmin",
+			ReadOldConfigVersion: &types.Version{BlockNum: 1, TxNum: 0},
+			NewConfig:            removeOnlyConfig,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, &types.ValidationInfo{Flag: types.Flag_VALID}, result)
+	})
+
+	t.Run("rotation configs errors", func(t *testing.T) {
+		_, _, err := types.AdminRotationConfigs(genesisConfig, "oldAdmin", &types.Admin{Id: "oldAdmin"})
+		require.EqualError(t, err, "the new admin must have an ID that differs from the old admin [oldAdmin]")
+		_, _, err = types.AdminRotationConfigs(genesisConfig, "noAdmin", &types.Admin{Id: "newAdmin"})
+		require.EqualError(t, err, "the old admin [noAdmin] does not exist in the cluster config")
+		_, _, err = types.AdminRotationConfigs(genesisConfig, "oldAdmin", nil)
+		require.EqualError(t, err, "the new admin must have a non-empty ID")
+	})
+}
+
+func TestValidateConfigTxNodeCertRotation(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"admin", "node", "nodeNew", "nodeOther"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "admin")
+	nodeCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "node")
+	nodeNewCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "nodeNew")
+	nodeOtherCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "nodeOther")
+	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
+
+	genesisConfig := &types.ClusterConfig{
+		Nodes: []*types.NodeConfig{
+			{
+				Id:          "node1",
+				Address:     "127.0.0.1",
+				Port:        6090,
+				Certificate: nodeCert.Raw,
+			},
+		},
+		Admins: []*types.Admin{
+			{
+				Id:          "admin",
+				Certificate: adminCert.Raw,
+			},
+		},
+		CertAuthConfig: &types.CAConfig{
+			Roots: [][]byte{caCert.Raw},
+		},
+		ConsensusConfig: &types.ConsensusConfig{
+			Algorithm: "raft",
+			Members: []*types.PeerConfig{
+				{
+					NodeId:   "node1",
+					RaftId:   1,
+					PeerHost: "127.0.0.1",
+					PeerPort: 7090,
+				},
+			},
+			RaftConfig: &types.RaftConfig{
+				TickInterval:   "100ms",
+				ElectionTicks:  100,
+				HeartbeatTicks: 10,
+			},
+		},
+	}
+
+	commitConfig := func(db worldstate.DB, config *types.ClusterConfig, blockNum uint64) {
+		adminUpdates, err := identity.ConstructDBEntriesForClusterAdmins(nil, config.Admins, &types.Version{BlockNum: blockNum})
+		require.NoError(t, err)
+		configSerialized, err := proto.Marshal(config)
+		require.NoError(t, err)
+
+		require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.UsersDBName: adminUpdates,
+			worldstate.ConfigDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      worldstate.ConfigKey,
+						Value:    configSerialized,
+						Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum, TxNum: 0}},
+					},
+				},
+			},
+		}, blockNum))
+	}
+
+	validate := func(env *validatorTestEnv, config *types.ClusterConfig, readVersion uint64) *types.ValidationInfo {
+		result, err := env.validator.configTxValidator.Validate(testutils.SignedConfigTxEnvelope(t, adminSigner, &types.ConfigTx{
+			UserId:               "admin",
+			ReadOldConfigVersion: &types.Version{BlockNum: readVersion, TxNum: 0},
+			NewConfig:            config,
+		}))
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("rotation with an overlap window", func(t *testing.T) {
+		t.Parallel()
+
+		env := newValidatorTestEnv(t)
+		defer env.cleanup()
+		commitConfig(env.db, genesisConfig, 1)
+
+		rotateConfig, err := types.NodeCertRotationConfig(genesisConfig, "node1", nodeNewCert.Raw, 10)
+		require.NoError(t, err)
+		require.Equal(t, nodeCert.Raw, genesisConfig.Nodes[0].Certificate)
+		require.Equal(t, nodeNewCert.Raw, rotateConfig.Nodes[0].Certificate)
+		require.Equal(t, nodeCert.Raw, rotateConfig.Nodes[0].PreviousCertificate)
+
+		require.Equal(t, &types.ValidationInfo{Flag: types.Flag_VALID}, validate(env, rotateConfig, 1))
+		commitConfig(env.db, rotateConfig, 2)
+
+		// a later config which carries the rotation over is valid, and so is the one that drops the previous
+		// certificate once the cutover block is committed
+		otherChangeConfig := proto.Clone(rotateConfig).(*types.ClusterConfig)
+		otherChangeConfig.ConsensusConfig.RaftConfig.ElectionTicks = 50
+		require.Equal(t, &types.ValidationInfo{Flag: types.Flag_VALID}, validate(env, otherChangeConfig, 2))
+
+		dropConfig := proto.Clone(rotateConfig).(*types.ClusterConfig)
+		dropConfig.Nodes[0].PreviousCertificate = nil
+		dropConfig.Nodes[0].CertificateCutoverBlock = 0
+		require.Equal(t, &types.ValidationInfo{Flag: types.Flag_VALID}, validate(env, dropConfig, 2))
+	})
+
+	t.Run("invalid: previous certificate is not the current one", func(t *testing.T) {
+		t.Parallel()
+
+		env := newValidatorTestEnv(t)
+		defer env.cleanup()
+		commitConfig(env.db, genesisConfig, 1)
+
+		rotateConfig := proto.Clone(genesisConfig).(*types.ClusterConfig)
+		rotateConfig.Nodes[0].Certificate = nodeNewCert.Raw
+		rotateConfig.Nodes[0].PreviousCertificate = nodeOtherCert.Raw
+		rotateConfig.Nodes[0].CertificateCutoverBlock = 10
+		require.Equal(t, &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the previous certificate of the node [node1] is not its current certificate. A rotation must replace the certificate the node currently has",
+		}, validate(env, rotateConfig, 1))
+	})
+
+	t.Run("invalid: new node with a previous certificate", func(t *testing.T) {
+		t.Parallel()
+
+		env := newValidatorTestEnv(t)
+		defer env.cleanup()
+		commitConfig(env.db, genesisConfig, 1)
+
+		addConfig := proto.Clone(genesisConfig).(*types.ClusterConfig)
+		addConfig.Nodes = append(addConfig.Nodes, &types.NodeConfig{
+			Id:                      "node2",
+			Address:                 "127.0.0.1",
+			Port:                    6091,
+			Certificate:             nodeNewCert.Raw,
+			PreviousCertificate:     nodeOtherCert.Raw,
+			CertificateCutoverBlock: 10,
+		})
+		addConfig.ConsensusConfig.Members = append(addConfig.ConsensusConfig.Members, &types.PeerConfig{
+			NodeId:   "node2",
+			RaftId:   2,
+			PeerHost: "127.0.0.1",
+			PeerPort: 7091,
+		})
+		require.Equal(t, &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the node [node2] is added to the cluster with a previous certificate. Only the certificate of a current node can be rotated",
+		}, validate(env, addConfig, 1))
+	})
+
+	t.Run("rotation config errors", func(t *testing.T) {
+		_, err := types.NodeCertRotationConfig(genesisConfig, "node1", nil, 10)
+		require.EqualError(t, err, "the new certificate of the node cannot be empty")
+		_, err = types.NodeCertRotationConfig(genesisConfig, "node1", nodeNewCert.Raw, 0)
+		require.EqualError(t, err, "the cutover block must be greater than zero")
+		_, err = types.NodeCertRotationConfig(genesisConfig, "node1", nodeCert.Raw, 10)
+		require.EqualError(t, err, "the node [node1] already uses the new certificate")
+		_, err = types.NodeCertRotationConfig(genesisConfig, "node2", nodeNewCert.Raw, 10)
+		require.EqualError(t, err, "the node [node2] does not exist in the cluster config")
+	})
+}
+
+func TestValidateConfigTxEpochTransition(t *testing.T) {
+	t.Parallel()
+
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	currentConfig := &types.ClusterConfig{Epoch: 2}
+	tests := []struct {
+		name           string
+		epoch          uint64
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:  "invalid: epoch decreases",
+			epoch: 1,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the epoch of the cluster cannot decrease: current: 2, updated: 1",
+			},
+		},
+		{
+			name:  "valid: epoch is retained",
+			epoch: 2,
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name:  "valid: epoch is bumped",
+			epoch: 3,
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := env.validator.configTxValidator.validateConfigTransitionRules("admin", currentConfig, &types.ClusterConfig{Epoch: tt.epoch})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
 func TestValidateCAConfig(t *testing.T) {
 	t.Parallel()
 
@@ -685,8 +1101,9 @@ func TestValidateCAConfig(t *testing.T) {
 func TestValidateNodeConfig(t *testing.T) {
 	t.Parallel()
 
-	cryptoDir := testutils.GenerateTestCrypto(t, []string{"node"})
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"node", "nodeNew"})
 	nodeCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "node")
+	nodeNewCert, _ := testutils.LoadTestCrypto(t, cryptoDir, "nodeNew")
 	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
 	caCertCollection, err := certificateauthority.NewCACertCollection([][]byte{caCert.Raw}, nil)
 	require.NoError(t, err)
@@ -856,6 +1273,71 @@ func TestValidateNodeConfig(t *testing.T) {
 				Flag: types.Flag_VALID,
 			},
 		},
+		{
+			name: "invalid: cutover block without a previous certificate",
+			nodes: []*types.NodeConfig{
+				{
+					Id:                      "node1",
+					Address:                 "127.0.0.1",
+					Port:                    6090,
+					Certificate:             nodeCert.Raw,
+					CertificateCutoverBlock: 10,
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the node [node1] has a certificate cutover block but no previous certificate",
+			},
+		},
+		{
+			name: "invalid: previous certificate without a cutover block",
+			nodes: []*types.NodeConfig{
+				{
+					Id:                  "node1",
+					Address:             "127.0.0.1",
+					Port:                6090,
+					Certificate:         nodeNewCert.Raw,
+					PreviousCertificate: nodeCert.Raw,
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the node [node1] has a previous certificate but no certificate cutover block",
+			},
+		},
+		{
+			name: "invalid: previous certificate is the same as the certificate",
+			nodes: []*types.NodeConfig{
+				{
+					Id:                      "node1",
+					Address:                 "127.0.0.1",
+					Port:                    6090,
+					Certificate:             nodeCert.Raw,
+					PreviousCertificate:     nodeCert.Raw,
+					CertificateCutoverBlock: 10,
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the previous certificate of the node [node1] is the same as its certificate",
+			},
+		},
+		{
+			name: "valid: certificate rotation",
+			nodes: []*types.NodeConfig{
+				{
+					Id:                      "node1",
+					Address:                 "127.0.0.1",
+					Port:                    6090,
+					Certificate:             nodeNewCert.Raw,
+					PreviousCertificate:     nodeCert.Raw,
+					CertificateCutoverBlock: 10,
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
 		{
 			name: "valid IP",
 			nodes: []*types.NodeConfig{
@@ -4,25 +4,50 @@
 package txvalidation
 
 import (
+	"bytes"
+	"fmt"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/jsonpatch"
+	"github.com/hyperledger-labs/orion-server/internal/jsonschema"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
 )
 
+// DefaultMaxKeysPerRangeDelete is the maximal number of keys that a single range delete may delete, when the cluster
+// configuration does not set another limit.
+const DefaultMaxKeysPerRangeDelete = uint64(1000)
+
 type dataTxValidator struct {
 	db              worldstate.DB
 	identityQuerier *identity.Querier
 	sigValidator    *txSigValidator
+	schemas         *schemaCache
+	tracer          *tracer
 	logger          *logger.SugarLogger
 }
 
-func (v *dataTxValidator) validate(txEnv *types.DataTxEnvelope, userIDsWithValidSign []string, pendingOps *pendingOperations) (*types.ValidationInfo, error) {
+// validate validates the operations of the data transaction with the given version of the rules, i.e., the rules the
+// block holding the transaction must be validated with.
+func (v *dataTxValidator) validate(
+	txEnv *types.DataTxEnvelope,
+	userIDsWithValidSign []string,
+	pendingOps *pendingOperations,
+	rulesVersion uint32,
+) (*types.ValidationInfo, error) {
+	valRes, err := v.validateSequence(txEnv.Payload, pendingOps, rulesVersion)
+	if err != nil || valRes.Flag != types.Flag_VALID {
+		return valRes, err
+	}
+
 	dbs := make(map[string]bool)
 	for _, ops := range txEnv.Payload.DbOperations {
 		if !dbs[ops.DbName] {
@@ -36,50 +61,164 @@ func (v *dataTxValidator) validate(txEnv *types.DataTxEnvelope, userIDsWithValid
 		}, nil
 	}
 
+	// the remaining operations are validated after an invalid one, so that the client learns about the conflicting
+	// reads of every operation at once. The flag and the reason are those of the first invalid operation. The rules
+	// that predate the conflicting reads stop at the first invalid operation, and leave them out of the validation
+	// info, as the nodes that produced the blocks of these rules did.
+	withConflictingReads := rulesVersion >= constants.ConflictingReadsRulesVersion
+	var invalid *types.ValidationInfo
+	var conflictingReads []*types.ConflictingRead
 	for _, ops := range txEnv.Payload.DbOperations {
-		valRes, err := v.validateDBName(ops.DbName)
+		valRes, err := v.validateDBOperation(txEnv, ops, userIDsWithValidSign, pendingOps, rulesVersion)
 		if err != nil {
 			return nil, err
 		}
-		if valRes.Flag != types.Flag_VALID {
-			return valRes, nil
+		if valRes.Flag == types.Flag_VALID {
+			continue
 		}
+		if invalid == nil {
+			invalid = valRes
+		}
+		if !withConflictingReads {
+			break
+		}
+		conflictingReads = append(conflictingReads, valRes.ConflictingReads...)
+	}
+	if invalid != nil {
+		invalid.ConflictingReads = conflictingReads
+		return invalid, nil
+	}
 
-		var usersWithDBAccess []string
-		sort.Strings(userIDsWithValidSign)
+	return &types.ValidationInfo{Flag: types.Flag_VALID}, nil
+}
 
-		for _, userID := range userIDsWithValidSign {
-			// note that the transaction could have been signed by many users and a data tx can manipulate
-			// multiple databases. Not all users in the transaction might have read-write access on all databases
-			// manipulated by the transaction. Hence, while validating operations associated with a given database,
-			// we need to consider only users who have read-write access to it. If none of the user has a
-			// read-write permission on a given database, the transaction would be marked invalid.
-			hasPerm, err := v.identityQuerier.HasReadWriteAccess(userID, ops.DbName)
-			if err != nil {
-				return nil, err
-			}
-			if hasPerm {
-				usersWithDBAccess = append(usersWithDBAccess, userID)
-			}
+// validateDBOperation validates the operations of the transaction on a single database
+func (v *dataTxValidator) validateDBOperation(
+	txEnv *types.DataTxEnvelope,
+	ops *types.DBOperation,
+	userIDsWithValidSign []string,
+	pendingOps *pendingOperations,
+	rulesVersion uint32,
+) (*types.ValidationInfo, error) {
+	valRes, err := v.validateDBName(ops.DbName)
+	if err != nil {
+		return nil, err
+	}
+	v.tracer.recordDB("database", ops.DbName, valRes)
+	if valRes.Flag != types.Flag_VALID {
+		return valRes, nil
+	}
+
+	if txEnv.ImportProof != nil {
+		return v.validateImportedOps(ops)
+	}
+
+	var usersWithDBAccess []string
+	sort.Strings(userIDsWithValidSign)
+
+	for _, userID := range userIDsWithValidSign {
+		// note that the transaction could have been signed by many users and a data tx can manipulate
+		// multiple databases. Not all users in the transaction might have read-write access on all databases
+		// manipulated by the transaction. Hence, while validating operations associated with a given database,
+		// we need to consider only users who have read-write access to it. If none of the user has a
+		// read-write permission on a given database, the transaction would be marked invalid.
+		hasPerm, err := v.identityQuerier.HasReadWriteAccess(userID, ops.DbName)
+		if err != nil {
+			return nil, err
 		}
+		if hasPerm {
+			usersWithDBAccess = append(usersWithDBAccess, userID)
+		}
+	}
 
-		if len(usersWithDBAccess) == 0 {
-			return &types.ValidationInfo{
-				Flag:            types.Flag_INVALID_NO_PERMISSION,
-				ReasonIfInvalid: "none of the user in [" + strings.Join(userIDsWithValidSign, ", ") + "] has read-write permission on the database [" + ops.DbName + "]",
-			}, nil
+	if len(usersWithDBAccess) == 0 {
+		valRes = &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_NO_PERMISSION,
+			ReasonIfInvalid: "none of the user in [" + strings.Join(userIDsWithValidSign, ", ") + "] has read-write permission on the database [" + ops.DbName + "]",
 		}
+	}
+	if v.tracer.enabled() {
+		v.tracer.record("database permission", map[string]string{
+			"db":                 ops.DbName,
+			"signers":            "[" + strings.Join(userIDsWithValidSign, ", ") + "]",
+			"usersWithReadWrite": "[" + strings.Join(usersWithDBAccess, ", ") + "]",
+		}, valRes)
+	}
+	if valRes.Flag != types.Flag_VALID {
+		return valRes, nil
+	}
+
+	return v.validateOps(usersWithDBAccess, ops, pendingOps, rulesVersion)
+}
 
-		valRes, err = v.validateOps(usersWithDBAccess, ops, pendingOps)
-		if err != nil || valRes.Flag != types.Flag_VALID {
-			return valRes, err
+// validateSequence checks that the sequence number of an ordered transaction is the successor of the last sequence
+// number of the first must-sign user, either committed or used by a valid transaction earlier in the block. As only
+// valid transactions consume a sequence number, the transactions following an invalid one of the same user are
+// invalid too, which is what a client chaining dependent transactions expects. The sequence number is ignored under
+// the rules that precede it.
+func (v *dataTxValidator) validateSequence(tx *types.DataTx, pendingOps *pendingOperations, rulesVersion uint32) (*types.ValidationInfo, error) {
+	if tx.Sequence == 0 || rulesVersion < constants.SequenceRulesVersion {
+		return &types.ValidationInfo{Flag: types.Flag_VALID}, nil
+	}
+
+	if len(tx.MustSignUserIds) == 0 {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the transaction carries a sequence number but no must sign user to which the sequence belongs",
+		}, nil
+	}
+
+	return validateSequenceOfUser(v.identityQuerier, v.tracer, tx.MustSignUserIds[0], tx.Sequence, pendingOps)
+}
+
+// validateSequenceOfUser checks that the given sequence number is the successor of the last sequence number of the
+// user, either committed or used by a valid transaction earlier in the block.
+func validateSequenceOfUser(
+	identityQuerier *identity.Querier,
+	tr *tracer,
+	userID string,
+	sequence uint64,
+	pendingOps *pendingOperations,
+) (*types.ValidationInfo, error) {
+	last, ok := pendingOps.sequence(userID)
+	if !ok {
+		var err error
+		if last, err = identityQuerier.GetLastSequence(userID); err != nil {
+			return nil, err
 		}
 	}
 
-	return &types.ValidationInfo{Flag: types.Flag_VALID}, nil
+	valInfo := &types.ValidationInfo{Flag: types.Flag_VALID}
+	switch {
+	case sequence <= last:
+		valInfo = &types.ValidationInfo{
+			Flag: types.Flag_INVALID_OUT_OF_ORDER,
+			ReasonIfInvalid: fmt.Sprintf("the sequence number [%d] of the user [%s] is not greater than the last sequence number [%d]",
+				sequence, userID, last),
+		}
+	case sequence > last+1:
+		valInfo = &types.ValidationInfo{
+			Flag: types.Flag_INVALID_OUT_OF_ORDER,
+			ReasonIfInvalid: fmt.Sprintf("the sequence number [%d] of the user [%s] leaves a gap after the last sequence number [%d]",
+				sequence, userID, last),
+		}
+	}
+
+	if tr.enabled() {
+		tr.record("sequence", map[string]string{
+			"user":         userID,
+			"sequence":     strconv.FormatUint(sequence, 10),
+			"lastSequence": strconv.FormatUint(last, 10),
+		}, valInfo)
+	}
+	return valInfo, nil
 }
 
 func (v *dataTxValidator) validateSignatures(txEnv *types.DataTxEnvelope) ([]string, *types.ValidationInfo, error) {
+	if txEnv.ImportProof != nil {
+		return v.validateImportProof(txEnv)
+	}
+
 	var userIDsWithValidSign []string
 	for userID, signature := range txEnv.Signatures {
 		valRes, err := v.sigValidator.validate(userID, signature, txEnv.Payload)
@@ -119,6 +258,12 @@ func (v *dataTxValidator) validateDBName(dbName string) (*types.ValidationInfo,
 			ReasonIfInvalid: "the database [" + dbName + "] does not exist in the cluster",
 		}, nil
 
+	case v.db.IsUnavailable(dbName):
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_DB_UNAVAILABLE,
+			ReasonIfInvalid: "the database [" + dbName + "] is unavailable on the node, as a commit to it failed, until it is resynced",
+		}, nil
+
 	case worldstate.IsSystemDB(dbName):
 		return &types.ValidationInfo{
 			Flag: types.Flag_INVALID_NO_PERMISSION,
@@ -136,13 +281,33 @@ func (v *dataTxValidator) validateOps(
 	userIDs []string,
 	txOps *types.DBOperation,
 	pendingOps *pendingOperations,
+	rulesVersion uint32,
 ) (*types.ValidationInfo, error) {
 	dbName := txOps.DbName
 
+	if rulesVersion >= constants.KeyFormatRulesVersion {
+		r := validateKeysFormat(dbName, txOps)
+		v.tracer.recordDB("key format", dbName, r)
+		if r.Flag != types.Flag_VALID {
+			return r, nil
+		}
+	}
+
+	if rulesVersion >= constants.KeyCollationRulesVersion {
+		collation, err := v.keyCollation(dbName)
+		if err != nil {
+			return nil, err
+		}
+		if r := validateKeysCollation(dbName, collation, txOps); r.Flag != types.Flag_VALID {
+			return r, nil
+		}
+	}
+
 	r, err := v.validateFieldsInDataWrites(txOps.DataWrites)
 	if err != nil {
 		return nil, err
 	}
+	v.tracer.recordDB("write entries", dbName, r)
 	if r.Flag != types.Flag_VALID {
 		return r, nil
 	}
@@ -151,11 +316,13 @@ func (v *dataTxValidator) validateOps(
 	if err != nil {
 		return nil, err
 	}
+	v.tracer.recordDB("delete entries", dbName, r)
 	if r.Flag != types.Flag_VALID {
 		return r, nil
 	}
 
 	r = validateUniquenessInDataWritesAndDeletes(txOps.DataWrites, txOps.DataDeletes)
+	v.tracer.recordDB("unique keys", dbName, r)
 	if r.Flag != types.Flag_VALID {
 		return r, nil
 	}
@@ -184,9 +351,190 @@ func (v *dataTxValidator) validateOps(
 		return r, nil
 	}
 
+	if rulesVersion >= constants.ValueSizeRulesVersion {
+		r, err = v.validateSizeOfDataWrites(dbName, txOps.DataWrites)
+		if err != nil {
+			return nil, err
+		}
+		if r.Flag != types.Flag_VALID {
+			return r, nil
+		}
+	}
+
+	if rulesVersion >= constants.SchemaRulesVersion {
+		r, err = v.validateSchemaOfDataWrites(dbName, txOps.DataWrites)
+		if err != nil {
+			return nil, err
+		}
+		if r.Flag != types.Flag_VALID {
+			return r, nil
+		}
+	}
+
+	if rulesVersion >= constants.ImmutableDBRulesVersion {
+		r, err = v.validateImmutableOps(txOps)
+		if err != nil {
+			return nil, err
+		}
+		if r.Flag != types.Flag_VALID {
+			return r, nil
+		}
+	}
+
+	r, err = v.validateDataDeleteRanges(userIDs, txOps, pendingOps)
+	if err != nil {
+		return nil, err
+	}
+	if len(txOps.DataDeleteRanges) > 0 {
+		v.tracer.recordDB("range deletes", dbName, r)
+	}
+	if r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	r, err = v.validateDataPatches(userIDs, txOps, pendingOps)
+	if err != nil {
+		return nil, err
+	}
+	if len(txOps.DataPatches) > 0 {
+		v.tracer.recordDB("patches", dbName, r)
+	}
+	if r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
 	return v.mvccValidation(dbName, txOps, pendingOps)
 }
 
+// reservedKeyPrefixes begin the keys of records that are kept by the server, and cannot begin a key in a user
+// database.
+var reservedKeyPrefixes = []string{
+	worldstate.ReservedKeyPrefix,
+	string(identity.UserNamespace),
+	string(identity.NodeNamespace),
+}
+
+func validateKeysFormat(dbName string, txOps *types.DBOperation) *types.ValidationInfo {
+	var keys []string
+	for _, r := range txOps.DataReads {
+		keys = append(keys, r.GetKey())
+	}
+	for _, w := range txOps.DataWrites {
+		keys = append(keys, w.GetKey())
+	}
+	for _, d := range txOps.DataDeletes {
+		keys = append(keys, d.GetKey())
+	}
+	for _, p := range txOps.DataPatches {
+		keys = append(keys, p.GetKey())
+	}
+	for _, r := range txOps.DataDeleteRanges {
+		// an empty bound denotes the first or the last key in the database
+		for _, key := range []string{r.GetStartKey(), r.GetEndKey(), r.GetPrefix()} {
+			if key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if vi := validateKeyFormat(dbName, key); vi.Flag != types.Flag_VALID {
+			return vi
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+func validateKeyFormat(dbName, key string) *types.ValidationInfo {
+	if len(key) > worldstate.MaxKeyLength {
+		return &types.ValidationInfo{
+			Flag: types.Flag_INVALID_KEY_FORMAT,
+			ReasonIfInvalid: fmt.Sprintf("the key %q... in database [%s] is %d bytes long, which exceeds the maximal key length of %d bytes",
+				key[:32], dbName, len(key), worldstate.MaxKeyLength),
+		}
+	}
+
+	if !utf8.ValidString(key) {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_KEY_FORMAT,
+			ReasonIfInvalid: fmt.Sprintf("the key %q in database [%s] is not a valid UTF-8 string", key, dbName),
+		}
+	}
+
+	for _, prefix := range reservedKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_KEY_FORMAT,
+				ReasonIfInvalid: fmt.Sprintf("the key %q in database [%s] begins with the reserved prefix %q", key, dbName, prefix),
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+// validateKeysCollation checks that the keys and the range bounds of the operations on a database conform to its key
+// collation. As the keys of a database with a collation other than BINARY are not ordered byte by byte, a range delete
+// by a prefix is not supported on such a database.
+func validateKeysCollation(dbName string, collation *types.DBKeyCollation, txOps *types.DBOperation) *types.ValidationInfo {
+	if worldstate.IsBinaryCollation(collation) {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}
+	}
+
+	var keys []string
+	for _, r := range txOps.DataReads {
+		keys = append(keys, r.GetKey())
+	}
+	for _, w := range txOps.DataWrites {
+		keys = append(keys, w.GetKey())
+	}
+	for _, d := range txOps.DataDeletes {
+		keys = append(keys, d.GetKey())
+	}
+	for _, p := range txOps.DataPatches {
+		keys = append(keys, p.GetKey())
+	}
+	for _, key := range keys {
+		if _, err := worldstate.CollateKey(collation, key); err != nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_KEY_FORMAT,
+				ReasonIfInvalid: fmt.Sprintf("database [%s]: %s", dbName, err),
+			}
+		}
+	}
+
+	for _, r := range txOps.DataDeleteRanges {
+		if r.GetPrefix() != "" {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the range delete with the prefix [" + r.GetPrefix() + "] cannot be processed as the keys of the database [" + dbName + "] are ordered by a key collation",
+			}
+		}
+		for _, bound := range []string{r.GetStartKey(), r.GetEndKey()} {
+			if bound == "" {
+				continue
+			}
+			if _, err := worldstate.CollateRangeBound(collation, bound); err != nil {
+				return &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_KEY_FORMAT,
+					ReasonIfInvalid: fmt.Sprintf("database [%s]: %s", dbName, err),
+				}
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
 func (v *dataTxValidator) validateFieldsInDataWrites(DataWrites []*types.DataWrite) (*types.ValidationInfo, error) {
 	existingUser := make(map[string]bool)
 
@@ -325,6 +673,7 @@ func (v *dataTxValidator) validateACLOnDataReads(userIDs []string, dbName string
 			return nil, errors.WithMessagef(err, "error while validating ACL on the key [%s] in the reads", r.Key)
 		}
 		if acl == nil {
+			v.tracer.recordACL("read acl", dbName, r.Key, nil, userIDs, tracedValid)
 			continue
 		}
 
@@ -338,13 +687,16 @@ func (v *dataTxValidator) validateACLOnDataReads(userIDs []string, dbName string
 		}
 
 		if hasPerm {
+			v.tracer.recordACL("read acl", dbName, r.Key, acl, userIDs, tracedValid)
 			continue
 		}
 
-		return &types.ValidationInfo{
+		valRes := &types.ValidationInfo{
 			Flag:            types.Flag_INVALID_NO_PERMISSION,
 			ReasonIfInvalid: "none of the user in [" + strings.Join(userIDs, ",") + "] has a read permission on key [" + r.Key + "] present in the database [" + dbName + "]",
-		}, nil
+		}
+		v.tracer.recordACL("read acl", dbName, r.Key, acl, userIDs, valRes)
+		return valRes, nil
 	}
 
 	return &types.ValidationInfo{
@@ -392,22 +744,473 @@ func (v *dataTxValidator) validateACLOnDataDeletes(userIDs []string, dbName stri
 	}, nil
 }
 
+// validateDataDeleteRanges validates the range deletes of the operations on a database. The keys in a range are
+// fetched from the committed state, and the submitters must be allowed to delete each one of them. As a key can be
+// modified only once within a block, a range cannot hold a key that is written or deleted by the transaction itself
+// or by a previous transaction in the block.
+func (v *dataTxValidator) validateDataDeleteRanges(
+	userIDs []string,
+	txOps *types.DBOperation,
+	pendingOps *pendingOperations,
+) (*types.ValidationInfo, error) {
+	if len(txOps.DataDeleteRanges) == 0 {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}, nil
+	}
+
+	dbName := txOps.DbName
+	maxKeys, err := v.maxKeysPerRangeDelete()
+	if err != nil {
+		return nil, err
+	}
+	collation, err := v.keyCollation(dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeKeys := make(map[string]bool)
+	for _, r := range txOps.DataDeleteRanges {
+		if r == nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "there is an empty entry in the delete range list",
+			}, nil
+		}
+
+		startKey, endKey := worldstate.DeleteRangeBounds(r)
+		switch {
+		case r.Prefix != "" && (r.StartKey != "" || r.EndKey != ""):
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the range delete with the prefix [" + r.Prefix + "] in the database [" + dbName + "] sets a start key or an end key as well. Only one of a prefix or a key range can be set",
+			}, nil
+
+		case endKey != "" && worldstate.CompareKeys(collation, startKey, endKey) >= 0:
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the start key [" + startKey + "] of the range delete in the database [" + dbName + "] is not lower than its end key [" + endKey + "]",
+			}, nil
+		}
+
+		for _, w := range txOps.DataWrites {
+			if worldstate.InCollatedRange(collation, w.Key, startKey, endKey) {
+				return &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+					ReasonIfInvalid: "the key [" + w.Key + "] is being updated as well as deleted by a range delete. Only one operation per key is allowed within a transaction",
+				}, nil
+			}
+		}
+		for _, d := range txOps.DataDeletes {
+			if worldstate.InCollatedRange(collation, d.Key, startKey, endKey) {
+				return &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+					ReasonIfInvalid: "the key [" + d.Key + "] is being deleted as well as deleted by a range delete. Only one operation per key is allowed within a transaction",
+				}, nil
+			}
+		}
+		for _, p := range txOps.DataPatches {
+			if worldstate.InCollatedRange(collation, p.GetKey(), startKey, endKey) {
+				return &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+					ReasonIfInvalid: "the key [" + p.GetKey() + "] is being patched as well as deleted by a range delete. Only one operation per key is allowed within a transaction",
+				}, nil
+			}
+		}
+
+		if key, ok := pendingOps.existInRange(dbName, collation, startKey, endKey); ok {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [" + key + "] in database [" + dbName + "], which is in a range delete. Within a block, a key can be modified only once",
+			}, nil
+		}
+
+		// one key more than the maximum is fetched to detect a range that exceeds the maximum
+		keys, err := worldstate.DeleteRangeKeys(v.db, dbName, r, int(maxKeys)+1)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error while fetching the keys of the range delete")
+		}
+		if uint64(len(keys)) > maxKeys {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: fmt.Sprintf("the range delete [%s, %s) in the database [%s] covers more than %d keys, which is the maximal number of keys per range delete", startKey, endKey, dbName, maxKeys),
+			}, nil
+		}
+
+		for _, key := range keys {
+			if rangeKeys[key] {
+				return &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+					ReasonIfInvalid: "the key [" + key + "] is deleted by more than one range delete. The ranges in a transaction cannot overlap",
+				}, nil
+			}
+			rangeKeys[key] = true
+
+			valRes, err := v.validateACLForWriteOrDelete(userIDs, dbName, key)
+			if err != nil {
+				return nil, err
+			}
+			if valRes.Flag != types.Flag_VALID {
+				return valRes, nil
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}, nil
+}
+
+// validateDataPatches validates the patches of the operations on a database. A patch reads and writes its key, and
+// hence, the submitters must be allowed to write the key, the key must not be modified by a previous transaction in
+// the block, and the version read by the client must be the committed version of the key. As the committed value is
+// the base to which the committer applies the patch, the patch is applied here as well, so that a patch which cannot
+// be applied, e.g., to a value which is not a JSON document, invalidates the transaction rather than the commit.
+func (v *dataTxValidator) validateDataPatches(
+	userIDs []string,
+	txOps *types.DBOperation,
+	pendingOps *pendingOperations,
+) (*types.ValidationInfo, error) {
+	if len(txOps.DataPatches) == 0 {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}, nil
+	}
+
+	dbName := txOps.DbName
+	otherKeys := make(map[string]string)
+	for _, w := range txOps.DataWrites {
+		otherKeys[w.Key] = "updated"
+	}
+	for _, d := range txOps.DataDeletes {
+		otherKeys[d.Key] = "deleted"
+	}
+
+	patchKeys := make(map[string]bool)
+	for _, p := range txOps.DataPatches {
+		if p == nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "there is an empty entry in the patch list",
+			}, nil
+		}
+
+		if patchKeys[p.Key] {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [" + p.Key + "] is duplicated in the patch list. The keys in the patch list must be unique",
+			}, nil
+		}
+		patchKeys[p.Key] = true
+
+		if op, ok := otherKeys[p.Key]; ok {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [" + p.Key + "] is being " + op + " as well as patched. Only one operation per key is allowed within a transaction",
+			}, nil
+		}
+
+		valRes, err := v.validateACLForWriteOrDelete(userIDs, dbName, p.Key)
+		if err != nil {
+			return nil, err
+		}
+		if valRes.Flag != types.Flag_VALID {
+			return valRes, nil
+		}
+
+		if pendingVersion, ok := pendingOps.version(dbName, p.Key); ok {
+			valRes := &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+				ReasonIfInvalid: "mvcc conflict has occurred within the block for the patched key [" + p.Key + "] in database [" + dbName + "]. Within a block, a key can be modified only once",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          dbName,
+						Key:             p.Key,
+						ExpectedVersion: p.Version,
+						ActualVersion:   pendingVersion,
+					},
+				},
+			}
+			v.tracer.recordVersion("patch mvcc", dbName, p.Key, p.Version, pendingVersion, valRes)
+			return valRes, nil
+		}
+
+		value, metadata, err := v.db.Get(dbName, p.Key)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while fetching the value of the patched key [%s] in the database [%s]", p.Key, dbName)
+		}
+		if metadata == nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [" + p.Key + "] does not exist in the database [" + dbName + "] and hence, it cannot be patched",
+			}, nil
+		}
+		if !proto.Equal(p.Version, metadata.GetVersion()) {
+			valRes := &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+				ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the patched key [" + p.Key + "] in database [" + dbName + "] changed",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          dbName,
+						Key:             p.Key,
+						ExpectedVersion: p.Version,
+						ActualVersion:   metadata.GetVersion(),
+					},
+				},
+			}
+			v.tracer.recordVersion("patch mvcc", dbName, p.Key, p.Version, metadata.GetVersion(), valRes)
+			return valRes, nil
+		}
+		v.tracer.recordVersion("patch mvcc", dbName, p.Key, p.Version, metadata.GetVersion(), tracedValid)
+
+		patched, err := jsonpatch.ApplyDataPatch(value, p)
+		if err != nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the patch of the key [" + p.Key + "] in the database [" + dbName + "] cannot be applied: " + err.Error(),
+			}, nil
+		}
+
+		// the patched value must conform to the schema of the database as a written one does
+		schema, _, err := v.schemas.get(v.db, dbName)
+		if err != nil {
+			return nil, err
+		}
+		if schema != nil {
+			if violation := schema.Validate(patched); violation != nil {
+				return schemaViolation(dbName, p.Key, violation), nil
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}, nil
+}
+
+// validateSizeOfDataWrites checks that the values written to a database with a value size cap do not exceed it. The cap
+// is read from the committed descriptor of the database, hence, a cap set in a block applies from the next block on,
+// and the values committed before remain as they are.
+func (v *dataTxValidator) validateSizeOfDataWrites(dbName string, dataWrites []*types.DataWrite) (*types.ValidationInfo, error) {
+	if len(dataWrites) == 0 {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}, nil
+	}
+
+	descriptor, err := v.descriptor(dbName)
+	if err != nil {
+		return nil, err
+	}
+	maxSize := descriptor.GetMaxValueSizeBytes()
+	if maxSize == 0 {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}, nil
+	}
+
+	valRes := &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+	for _, w := range dataWrites {
+		if size := uint64(len(w.Value)); size > maxSize {
+			valRes = &types.ValidationInfo{
+				Flag: types.Flag_INVALID_VALUE_TOO_LARGE,
+				ReasonIfInvalid: fmt.Sprintf("the value of the key [%s] in the database [%s] is %d bytes long, which exceeds the cap of the database of %d bytes",
+					w.Key, dbName, size, maxSize),
+			}
+			break
+		}
+	}
+	if v.tracer.enabled() {
+		v.tracer.record("value size", map[string]string{
+			"db":           dbName,
+			"maxValueSize": fmt.Sprint(maxSize),
+		}, valRes)
+	}
+
+	return valRes, nil
+}
+
+// validateSchemaOfDataWrites checks that the values written to a database with a registered schema conform to it. The
+// schema is read from the committed descriptor of the database, hence, a schema registered in a block applies from the
+// next block on.
+func (v *dataTxValidator) validateSchemaOfDataWrites(dbName string, dataWrites []*types.DataWrite) (*types.ValidationInfo, error) {
+	schema, version, err := v.schemas.get(v.db, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}, nil
+	}
+
+	valRes := &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+	for _, w := range dataWrites {
+		if violation := schema.Validate(w.Value); violation != nil {
+			valRes = schemaViolation(dbName, w.Key, violation)
+			break
+		}
+	}
+	if v.tracer.enabled() {
+		v.tracer.record("schema", map[string]string{
+			"db":            dbName,
+			"schemaVersion": traceVersion(version),
+		}, valRes)
+	}
+
+	return valRes, nil
+}
+
+// validateImmutableOps checks that the operations on an immutable database leave its committed keys as they are. A key
+// of an immutable database can only be written again with the value and the access control it was created with, which
+// is a no-op, while a delete, a range delete, or a patch of a key are invalid. A key written by a previous transaction
+// of the block cannot be written again, as with any database.
+func (v *dataTxValidator) validateImmutableOps(txOps *types.DBOperation) (*types.ValidationInfo, error) {
+	dbName := txOps.DbName
+
+	descriptor, err := v.descriptor(dbName)
+	if err != nil {
+		return nil, err
+	}
+	if !descriptor.GetImmutable() {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}, nil
+	}
+
+	valRes := &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+	switch {
+	case len(txOps.DataDeletes) > 0:
+		valRes = &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+			ReasonIfInvalid: "the key [" + txOps.DataDeletes[0].Key + "] cannot be deleted as the database [" + dbName + "] is immutable",
+		}
+	case len(txOps.DataDeleteRanges) > 0:
+		valRes = &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+			ReasonIfInvalid: "a range of keys cannot be deleted as the database [" + dbName + "] is immutable",
+		}
+	case len(txOps.DataPatches) > 0:
+		valRes = &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+			ReasonIfInvalid: "the key [" + txOps.DataPatches[0].Key + "] cannot be patched as the database [" + dbName + "] is immutable",
+		}
+	default:
+		for _, w := range txOps.DataWrites {
+			value, metadata, err := v.db.Get(dbName, w.Key)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "error while fetching the key [%s] of the immutable database [%s]", w.Key, dbName)
+			}
+			if metadata == nil {
+				continue
+			}
+			if !bytes.Equal(value, w.Value) || !proto.Equal(metadata.GetAccessControl(), w.Acl) {
+				valRes = &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+					ReasonIfInvalid: "the key [" + w.Key + "] cannot be modified as the database [" + dbName + "] is immutable, and the key exists with another value or access control",
+				}
+				break
+			}
+		}
+	}
+	v.tracer.recordDB("immutable keys", dbName, valRes)
+
+	return valRes, nil
+}
+
+func schemaViolation(dbName, key string, violation *jsonschema.Violation) *types.ValidationInfo {
+	return &types.ValidationInfo{
+		Flag:            types.Flag_INVALID_SCHEMA_VIOLATION,
+		ReasonIfInvalid: "the value of the key [" + key + "] in the database [" + dbName + "] violates the schema of the database: " + violation.Error(),
+	}
+}
+
+// maxKeysPerRangeDelete returns the maximal number of keys per range delete, as set in the committed cluster
+// configuration, so that all the nodes use the same limit.
+func (v *dataTxValidator) maxKeysPerRangeDelete() (uint64, error) {
+	config, _, err := v.db.GetConfig()
+	if err != nil {
+		return 0, errors.WithMessage(err, "error while fetching the cluster configuration")
+	}
+
+	if maxKeys := config.GetLedgerConfig().GetMaxKeysPerRangeDelete(); maxKeys > 0 {
+		return maxKeys, nil
+	}
+	return DefaultMaxKeysPerRangeDelete, nil
+}
+
+// validateACLForWriteOrDelete validates the write or delete of a key against its ACL or, if the key carries no ACL, the
+// default ACL of the database.
 func (v *dataTxValidator) validateACLForWriteOrDelete(userIDs []string, dbName, key string) (*types.ValidationInfo, error) {
 	acl, err := v.db.GetACL(dbName, key)
 	if err != nil {
 		return nil, err
 	}
+	if acl == nil {
+		if acl, err = v.defaultACL(dbName); err != nil {
+			return nil, err
+		}
+	}
+
+	valRes := evaluateACLForWriteOrDelete(acl, userIDs, dbName, key)
+	v.tracer.recordACL("write acl", dbName, key, acl, userIDs, valRes)
+	return valRes, nil
+}
+
+// defaultACL returns the default ACL of the database, as set in its committed descriptor. As the descriptor is read from
+// the state committed by the previous block, the validation of a block uses the descriptor in place as of that block.
+func (v *dataTxValidator) defaultACL(dbName string) (*types.AccessControl, error) {
+	descriptor, err := v.descriptor(dbName)
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.GetDefaultAcl(), nil
+}
+
+// keyCollation returns the key collation of the database, or nil if its keys are ordered byte by byte
+func (v *dataTxValidator) keyCollation(dbName string) (*types.DBKeyCollation, error) {
+	descriptor, err := v.descriptor(dbName)
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.GetKeyCollation(), nil
+}
+
+// descriptor returns the committed descriptor of the database, or nil if the database has none
+func (v *dataTxValidator) descriptor(dbName string) (*types.DBDescriptor, error) {
+	value, metadata, err := v.db.Get(worldstate.DBDescriptorsDBName, dbName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while fetching the descriptor of the database [%s]", dbName)
+	}
+	if metadata == nil {
+		return nil, nil
+	}
+
+	descriptor := &types.DBDescriptor{}
+	if err := proto.Unmarshal(value, descriptor); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the descriptor of the database [%s]", dbName)
+	}
+	return descriptor, nil
+}
+
+func evaluateACLForWriteOrDelete(acl *types.AccessControl, userIDs []string, dbName, key string) *types.ValidationInfo {
 	if acl == nil {
 		return &types.ValidationInfo{
 			Flag: types.Flag_VALID,
-		}, nil
+		}
 	}
 
 	if len(acl.ReadWriteUsers) == 0 {
 		return &types.ValidationInfo{
 			Flag:            types.Flag_INVALID_NO_PERMISSION,
 			ReasonIfInvalid: "no user can write or delete the key [" + key + "]",
-		}, nil
+		}
 	}
 
 	switch acl.SignPolicyForWrite {
@@ -425,7 +1228,7 @@ func (v *dataTxValidator) validateACLForWriteOrDelete(userIDs []string, dbName,
 			return &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_NO_PERMISSION,
 				ReasonIfInvalid: "none of the user in [" + strings.Join(userIDs, ",") + "] has a write/delete permission on key [" + key + "] present in the database [" + dbName + "]",
-			}, nil
+			}
 		}
 
 	case types.AccessControl_ALL:
@@ -450,37 +1253,81 @@ func (v *dataTxValidator) validateACLForWriteOrDelete(userIDs []string, dbName,
 				return &types.ValidationInfo{
 					Flag:            types.Flag_INVALID_NO_PERMISSION,
 					ReasonIfInvalid: "not all required users in [" + strings.Join(targetUserIDs, ",") + "] have signed the transaction to write/delete key [" + key + "] present in the database [" + dbName + "]",
-				}, nil
+				}
 			}
 		}
 	}
 
 	return &types.ValidationInfo{
 		Flag: types.Flag_VALID,
-	}, nil
+	}
 }
 
+// mvccValidation validates the reads of the transaction against the writes and deletes of the valid transactions
+// positioned earlier in the block, and then against the committed state. A read of a key which was modified earlier in
+// the block is a conflict whatever its version, even when it matches the committed state. Hence, the valid transactions
+// of a block are serializable in their order within the block, and a write skew, i.e., two transactions each reading the
+// key written by the other, invalidates the later transaction.
 func (v *dataTxValidator) mvccValidation(dbName string, txOps *types.DBOperation, pendingOps *pendingOperations) (*types.ValidationInfo, error) {
+	// all reads are checked, rather than stopping at the first conflict, so that the client learns about every
+	// stale read at once. The flag and the reason are determined by the first conflicting read.
+	var result *types.ValidationInfo
 	for _, r := range txOps.DataReads {
-		if pendingOps.exist(dbName, r.Key) {
-			return &types.ValidationInfo{
-				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
-				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [" + r.Key + "] in database [" + dbName + "]",
-			}, nil
+		if pendingVersion, ok := pendingOps.version(dbName, r.Key); ok {
+			if result == nil {
+				result = &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [" + r.Key + "] in database [" + dbName + "]",
+				}
+			}
+			result.ConflictingReads = append(result.ConflictingReads, &types.ConflictingRead{
+				DbName:          dbName,
+				Key:             r.Key,
+				ExpectedVersion: r.Version,
+				ActualVersion:   pendingVersion,
+			})
+			if v.tracer.enabled() {
+				v.tracer.recordVersion("mvcc", dbName, r.Key, r.Version, pendingVersion, &types.ValidationInfo{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "the key was modified by a previous transaction in the block",
+				})
+			}
+			continue
 		}
 
-		committedVersion, err := v.db.GetVersion(dbName, r.Key)
-		if err != nil {
-			return nil, err
+		committedVersion, ok := pendingOps.committedVersion(dbName, r.Key)
+		if !ok {
+			var err error
+			if committedVersion, err = v.db.GetVersion(dbName, r.Key); err != nil {
+				return nil, err
+			}
 		}
 		if proto.Equal(r.Version, committedVersion) {
+			v.tracer.recordVersion("mvcc", dbName, r.Key, r.Version, committedVersion, tracedValid)
 			continue
 		}
+		if v.tracer.enabled() {
+			v.tracer.recordVersion("mvcc", dbName, r.Key, r.Version, committedVersion, &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+				ReasonIfInvalid: "the committed version of the key differs from the version read",
+			})
+		}
 
-		return &types.ValidationInfo{
-			Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
-			ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the key [" + r.Key + "] in database [" + dbName + "] changed",
-		}, nil
+		if result == nil {
+			result = &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+				ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the key [" + r.Key + "] in database [" + dbName + "] changed",
+			}
+		}
+		result.ConflictingReads = append(result.ConflictingReads, &types.ConflictingRead{
+			DbName:          dbName,
+			Key:             r.Key,
+			ExpectedVersion: r.Version,
+			ActualVersion:   committedVersion,
+		})
+	}
+	if result != nil {
+		return result, nil
 	}
 
 	// as state trie generation work at the boundary of block, we cannot allow more than one write per key. This is because, the state trie
@@ -489,18 +1336,22 @@ func (v *dataTxValidator) mvccValidation(dbName string, txOps *types.DBOperation
 	// rare, we allow only one write per key within a block. In general, user reads the key before writing to it.
 	for _, w := range txOps.DataWrites {
 		if pendingOps.exist(dbName, w.Key) {
-			return &types.ValidationInfo{
+			valRes := &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
 				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [" + w.Key + "] in database [" + dbName + "]. Within a block, a key can be modified only once",
-			}, nil
+			}
+			v.tracer.recordKey("single write per block", dbName, w.Key, valRes)
+			return valRes, nil
 		}
 	}
 	for _, d := range txOps.DataDeletes {
 		if pendingOps.exist(dbName, d.Key) {
-			return &types.ValidationInfo{
+			valRes := &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
 				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [" + d.Key + "] in database [" + dbName + "]. Within a block, a key can be modified only once",
-			}, nil
+			}
+			v.tracer.recordKey("single write per block", dbName, d.Key, valRes)
+			return valRes, nil
 		}
 	}
 
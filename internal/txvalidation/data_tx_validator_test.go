@@ -4,11 +4,14 @@
 package txvalidation
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/testfault"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
@@ -136,6 +139,44 @@ func TestValidateDataTx(t *testing.T) {
 				ReasonIfInvalid: "the database [db1] does not exist in the cluster",
 			},
 		},
+		{
+			name: "invalid: database is unavailable",
+			setup: func(db worldstate.DB) {
+				addUserWithCorrectPrivilege(db)
+				createDB := map[string]*worldstate.DBUpdates{
+					worldstate.DatabasesDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key: "db1",
+							},
+						},
+					},
+				}
+				require.NoError(t, db.Commit(createDB, 2))
+				require.NoError(t, db.RecordSkippedCommits([]string{"db1"}, []uint64{3}))
+			},
+			txEnv: testutils.SignedDataTxEnvelope(t, []crypto.Signer{aliceSigner}, &types.DataTx{
+				MustSignUserIds: []string{alice},
+				DbOperations: []*types.DBOperation{
+					{
+						DbName: worldstate.DefaultDBName,
+					},
+					{
+						DbName: "db1",
+						DataReads: []*types.DataRead{
+							{
+								Key: "key1",
+							},
+						},
+					},
+				},
+			}),
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_DB_UNAVAILABLE,
+				ReasonIfInvalid: "the database [db1] is unavailable on the node, as a commit to it failed, until it is resynced",
+			},
+		},
 		{
 			name: "invalid: system database name cannot be used in a transaction",
 			setup: func(db worldstate.DB) {
@@ -163,6 +204,30 @@ func TestValidateDataTx(t *testing.T) {
 				ReasonIfInvalid: "the database [" + worldstate.ConfigDBName + "] is a system database and no user can write to a system database via data transaction. Use appropriate transaction type to modify the system database",
 			},
 		},
+		{
+			name: "invalid: the server-internal system database cannot be read in a transaction",
+			setup: func(db worldstate.DB) {
+				addUserWithCorrectPrivilege(db)
+			},
+			txEnv: testutils.SignedDataTxEnvelope(t, []crypto.Signer{aliceSigner}, &types.DataTx{
+				MustSignUserIds: []string{alice},
+				DbOperations: []*types.DBOperation{
+					{
+						DbName: worldstate.SystemDBName,
+						DataReads: []*types.DataRead{
+							{
+								Key: "height",
+							},
+						},
+					},
+				},
+			}),
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_NO_PERMISSION,
+				ReasonIfInvalid: "the database [" + worldstate.SystemDBName + "] is a system database and no user can write to a system database via data transaction. Use appropriate transaction type to modify the system database",
+			},
+		},
 		{
 			name: "Invalid signature from must sign user",
 			setup: func(db worldstate.DB) {
@@ -332,6 +397,30 @@ func TestValidateDataTx(t *testing.T) {
 				ReasonIfInvalid: "none of the user in [alice, bob] has read-write permission on the database [" + worldstate.DefaultDBName + "]",
 			},
 		},
+		{
+			name: "invalid: key collides with a user record",
+			setup: func(db worldstate.DB) {
+				addUserWithCorrectPrivilege(db)
+			},
+			txEnv: testutils.SignedDataTxEnvelope(t, []crypto.Signer{aliceSigner}, &types.DataTx{
+				MustSignUserIds: []string{alice},
+				DbOperations: []*types.DBOperation{
+					{
+						DbName: worldstate.DefaultDBName,
+						DataWrites: []*types.DataWrite{
+							{
+								Key: string(identity.UserNamespace) + alice,
+							},
+						},
+					},
+				},
+			}),
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_KEY_FORMAT,
+				ReasonIfInvalid: `the key "\x00alice" in database [bdb] begins with the reserved prefix "\x00"`,
+			},
+		},
 		{
 			name: "invalid: incorrect fields in the data write",
 			setup: func(db worldstate.DB) {
@@ -588,15 +677,98 @@ func TestValidateDataTx(t *testing.T) {
 				},
 			}),
 			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
-					constructCompositeKey("db1", "key2"):                    true,
+				pendingWrites: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
+					constructCompositeKey("db1", "key2"):                    {BlockNum: 2, TxNum: 0},
+				},
+				pendingDeletes: map[string]*types.Version{},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [key1] in database [" + worldstate.DefaultDBName + "]",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:        worldstate.DefaultDBName,
+						Key:           "key1",
+						ActualVersion: &types.Version{BlockNum: 2, TxNum: 0},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid: the conflicting reads of all the operations are reported",
+			setup: func(db worldstate.DB) {
+				addUserWithCorrectPrivilege(db)
+				db1 := map[string]*worldstate.DBUpdates{
+					worldstate.DatabasesDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key: "db1",
+							},
+						},
+					},
+				}
+				require.NoError(t, db.Commit(db1, 1))
+
+				data := map[string]*worldstate.DBUpdates{
+					"db1": {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key: "key3",
+								Metadata: &types.Metadata{
+									Version: &types.Version{
+										BlockNum: 1,
+										TxNum:    1,
+									},
+								},
+							},
+						},
+					},
+				}
+				require.NoError(t, db.Commit(data, 1))
+			},
+			txEnv: testutils.SignedDataTxEnvelope(t, []crypto.Signer{aliceSigner}, &types.DataTx{
+				MustSignUserIds: []string{alice},
+				DbOperations: []*types.DBOperation{
+					{
+						DbName: worldstate.DefaultDBName,
+						DataReads: []*types.DataRead{
+							{
+								Key: "key1",
+							},
+						},
+					},
+					{
+						DbName: "db1",
+						DataReads: []*types.DataRead{
+							{
+								Key: "key3",
+							},
+						},
+					},
+				},
+			}),
+			pendingOps: &pendingOperations{
+				pendingWrites: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
 				},
-				pendingDeletes: map[string]bool{},
+				pendingDeletes: map[string]*types.Version{},
 			},
 			expectedResult: &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
 				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [key1] in database [" + worldstate.DefaultDBName + "]",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:        worldstate.DefaultDBName,
+						Key:           "key1",
+						ActualVersion: &types.Version{BlockNum: 2, TxNum: 0},
+					},
+					{
+						DbName:        "db1",
+						Key:           "key3",
+						ActualVersion: &types.Version{BlockNum: 1, TxNum: 1},
+					},
+				},
 			},
 		},
 		{
@@ -1017,9 +1189,9 @@ func TestValidateDataTx(t *testing.T) {
 				return
 			}
 
-			result, err := env.validator.dataTxValidator.validate(tt.txEnv, usersWithValidSignTx, tt.pendingOps)
+			result, err := env.validator.dataTxValidator.validate(tt.txEnv, usersWithValidSignTx, tt.pendingOps, constants.RulesVersion)
 			require.NoError(t, err)
-			require.Equal(t, tt.expectedResult, result)
+			require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
 		})
 	}
 }
@@ -1171,9 +1343,9 @@ func TestValidateFieldsInDataDeletes(t *testing.T) {
 				},
 			},
 			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{},
-				pendingDeletes: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
+				pendingWrites: map[string]*types.Version{},
+				pendingDeletes: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
 				},
 			},
 			expectedResult: &types.ValidationInfo{
@@ -1332,6 +1504,114 @@ func TestValidateUniquenessInDataWritesAndDeletes(t *testing.T) {
 	}
 }
 
+func TestValidateKeysFormat(t *testing.T) {
+	t.Parallel()
+
+	longKey := strings.Repeat("k", worldstate.MaxKeyLength+1)
+
+	tests := []struct {
+		name           string
+		txOps          *types.DBOperation
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name: "invalid: read key collides with a user record",
+			txOps: &types.DBOperation{
+				DataReads: []*types.DataRead{
+					{
+						Key: string(identity.UserNamespace) + "alice",
+					},
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_KEY_FORMAT,
+				ReasonIfInvalid: `the key "\x00alice" in database [db1] begins with the reserved prefix "\x00"`,
+			},
+		},
+		{
+			name: "invalid: write key collides with a record reserved by the storage layer",
+			txOps: &types.DBOperation{
+				DataWrites: []*types.DataWrite{
+					{
+						Key: "key1",
+					},
+					{
+						Key: worldstate.ReservedKeyPrefix + "version",
+					},
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_KEY_FORMAT,
+				ReasonIfInvalid: `the key "\x00version" in database [db1] begins with the reserved prefix "\x00"`,
+			},
+		},
+		{
+			name: "invalid: delete key is not a valid UTF-8 string",
+			txOps: &types.DBOperation{
+				DataDeletes: []*types.DataDelete{
+					{
+						Key: "key\xff",
+					},
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_KEY_FORMAT,
+				ReasonIfInvalid: `the key "key\xff" in database [db1] is not a valid UTF-8 string`,
+			},
+		},
+		{
+			name: "invalid: key is too long",
+			txOps: &types.DBOperation{
+				DataWrites: []*types.DataWrite{
+					{
+						Key: longKey,
+					},
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_KEY_FORMAT,
+				ReasonIfInvalid: `the key "kkkkkkkkkkkkkkkkkkkkkkkkkkkkkkkk"... in database [db1] is 1025 bytes long, which exceeds the maximal key length of 1024 bytes`,
+			},
+		},
+		{
+			name: "valid",
+			txOps: &types.DBOperation{
+				DataReads: []*types.DataRead{
+					{
+						Key: "key$1",
+					},
+				},
+				DataWrites: []*types.DataWrite{
+					{
+						Key: "\x01key2",
+					},
+					{
+						Key: longKey[1:],
+					},
+				},
+				DataDeletes: []*types.DataDelete{
+					{
+						Key: "ключ3",
+					},
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := validateKeysFormat("db1", tt.txOps)
+			require.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
 func TestValidateAClOnDataReads(t *testing.T) {
 	sampleVersion := &types.Version{
 		BlockNum: 1,
@@ -1995,54 +2275,619 @@ func TestValidateAClOnDataDeletes(t *testing.T) {
 	}
 }
 
-func TestMVCCOnDataTx(t *testing.T) {
+func TestValidateDataDeleteRanges(t *testing.T) {
 	t.Parallel()
 
-	version1 := &types.Version{
+	sampleVersion := &types.Version{
 		BlockNum: 1,
 		TxNum:    1,
 	}
 
-	version2 := &types.Version{
-		BlockNum: 2,
-		TxNum:    1,
-	}
+	// commits the keys, where the key "k/acl" can be deleted by user1 only
+	setupKeys := func(keys ...string) func(db worldstate.DB) {
+		return func(db worldstate.DB) {
+			updates := &worldstate.DBUpdates{}
+			for _, key := range keys {
+				kv := &worldstate.KVWithMetadata{
+					Key:   key,
+					Value: []byte("value-" + key),
+					Metadata: &types.Metadata{
+						Version: sampleVersion,
+					},
+				}
+				if key == "k/acl" {
+					kv.Metadata.AccessControl = &types.AccessControl{
+						ReadWriteUsers: map[string]bool{
+							"user1": true,
+						},
+					}
+				}
+				updates.Writes = append(updates.Writes, kv)
+			}
 
-	version3 := &types.Version{
-		BlockNum: 3,
-		TxNum:    1,
+			require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{worldstate.DefaultDBName: updates}, 1))
+		}
 	}
 
 	tests := []struct {
 		name           string
 		setup          func(db worldstate.DB)
 		txOps          *types.DBOperation
-		dataReads      []*types.DataRead
-		dataWrites     []*types.DataWrite
-		dataDeletes    []*types.DataDelete
 		pendingOps     *pendingOperations
 		expectedResult *types.ValidationInfo
 	}{
 		{
-			name:  "invalid: conflict writes within the block",
-			setup: func(db worldstate.DB) {},
+			name:  "valid: empty range",
+			setup: setupKeys("a1", "c1"),
 			txOps: &types.DBOperation{
-				DataReads: []*types.DataRead{
+				DataDeleteRanges: []*types.DataDeleteRange{
 					{
-						Key:     "key1",
-						Version: version1,
+						StartKey: "b",
+						EndKey:   "c",
 					},
 				},
 			},
-			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
-				},
-				pendingDeletes: map[string]bool{},
-			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name:  "valid: keys in the range and with the prefix",
+			setup: setupKeys("a1", "a2", "b1", "b2", "c1"),
+			txOps: &types.DBOperation{
+				DataWrites: []*types.DataWrite{
+					{
+						Key: "c1",
+					},
+				},
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						StartKey: "a1",
+						EndKey:   "b",
+					},
+					{
+						Prefix: "b",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name:  "invalid: empty entry",
+			setup: setupKeys(),
+			txOps: &types.DBOperation{
+				DataDeleteRanges: []*types.DataDeleteRange{nil},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "there is an empty entry in the delete range list",
+			},
+		},
+		{
+			name:  "invalid: prefix together with a key range",
+			setup: setupKeys(),
+			txOps: &types.DBOperation{
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						StartKey: "a",
+						Prefix:   "b",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the range delete with the prefix [b] in the database [" + worldstate.DefaultDBName + "] sets a start key or an end key as well. Only one of a prefix or a key range can be set",
+			},
+		},
+		{
+			name:  "invalid: start key is not lower than the end key",
+			setup: setupKeys(),
+			txOps: &types.DBOperation{
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						StartKey: "b",
+						EndKey:   "b",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the start key [b] of the range delete in the database [" + worldstate.DefaultDBName + "] is not lower than its end key [b]",
+			},
+		},
+		{
+			name:  "invalid: written key in the range",
+			setup: setupKeys("a1"),
+			txOps: &types.DBOperation{
+				DataWrites: []*types.DataWrite{
+					{
+						Key: "a2",
+					},
+				},
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						Prefix: "a",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [a2] is being updated as well as deleted by a range delete. Only one operation per key is allowed within a transaction",
+			},
+		},
+		{
+			name:  "invalid: deleted key in the range",
+			setup: setupKeys("a1"),
+			txOps: &types.DBOperation{
+				DataDeletes: []*types.DataDelete{
+					{
+						Key: "a1",
+					},
+				},
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						StartKey: "a",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [a1] is being deleted as well as deleted by a range delete. Only one operation per key is allowed within a transaction",
+			},
+		},
+		{
+			name:  "invalid: overlapping ranges",
+			setup: setupKeys("a1", "a2"),
+			txOps: &types.DBOperation{
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						StartKey: "a",
+						EndKey:   "a3",
+					},
+					{
+						StartKey: "a2",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [a2] is deleted by more than one range delete. The ranges in a transaction cannot overlap",
+			},
+		},
+		{
+			name:  "invalid: key in the range is modified by a previous transaction in the block",
+			setup: setupKeys("a1"),
+			txOps: &types.DBOperation{
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						Prefix: "a",
+					},
+				},
+			},
+			pendingOps: func() *pendingOperations {
+				pendingOps := newPendingOperations()
+				pendingOps.addWrite(worldstate.DefaultDBName, "b1", sampleVersion)
+				pendingOps.addWrite(worldstate.DefaultDBName, "a3", sampleVersion)
+				pendingOps.addDelete(worldstate.DefaultDBName, "a2", sampleVersion)
+				pendingOps.addWrite("db1", "a1", sampleVersion)
+				return pendingOps
+			}(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [a2] in database [" + worldstate.DefaultDBName + "], which is in a range delete. Within a block, a key can be modified only once",
+			},
+		},
+		{
+			name:  "invalid: key with an acl that excludes the user is in the range",
+			setup: setupKeys("k/1", "k/acl", "k/z"),
+			txOps: &types.DBOperation{
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						Prefix: "k/",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_NO_PERMISSION,
+				ReasonIfInvalid: "none of the user in [operatingUser] has a write/delete permission on key [k/acl] present in the database [" + worldstate.DefaultDBName + "]",
+			},
+		},
+		{
+			name: "invalid: range exceeds the maximal number of keys",
+			setup: func(db worldstate.DB) {
+				setupKeys("a1", "a2", "a3")(db)
+
+				config, err := proto.Marshal(&types.ClusterConfig{
+					LedgerConfig: &types.LedgerConfig{
+						MaxKeysPerRangeDelete: 2,
+					},
+				})
+				require.NoError(t, err)
+				require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+					worldstate.ConfigDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key:   worldstate.ConfigKey,
+								Value: config,
+							},
+						},
+					},
+				}, 2))
+			},
+			txOps: &types.DBOperation{
+				DataDeleteRanges: []*types.DataDeleteRange{
+					{
+						StartKey: "a1",
+						EndKey:   "a3",
+					},
+					{
+						Prefix: "a",
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the range delete [a, b) in the database [" + worldstate.DefaultDBName + "] covers more than 2 keys, which is the maximal number of keys per range delete",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+
+			tt.setup(env.db)
+
+			tt.txOps.DbName = worldstate.DefaultDBName
+			result, err := env.validator.dataTxValidator.validateDataDeleteRanges([]string{"operatingUser"}, tt.txOps, tt.pendingOps)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+		})
+	}
+}
+
+func TestValidateDataPatches(t *testing.T) {
+	t.Parallel()
+
+	committedVersion := &types.Version{
+		BlockNum: 1,
+		TxNum:    1,
+	}
+
+	// commits the JSON documents "doc" and "doc/acl", where "doc/acl" can be written by user1 only, and the
+	// non-JSON value "raw"
+	setup := func(db worldstate.DB) {
+		require.NoError(t, db.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.DefaultDBName: {
+				Writes: []*worldstate.KVWithMetadata{
+					{
+						Key:      "doc",
+						Value:    []byte(`{"name":"alice","address":{"city":"haifa"},"tags":["a","b"]}`),
+						Metadata: &types.Metadata{Version: committedVersion},
+					},
+					{
+						Key:   "doc/acl",
+						Value: []byte(`{"a":1}`),
+						Metadata: &types.Metadata{
+							Version: committedVersion,
+							AccessControl: &types.AccessControl{
+								ReadWriteUsers: map[string]bool{
+									"user1": true,
+								},
+							},
+						},
+					},
+					{
+						Key:      "raw",
+						Value:    []byte("not a JSON document"),
+						Metadata: &types.Metadata{Version: committedVersion},
+					},
+				},
+			},
+		}, 1))
+	}
+
+	pendingWriteOn := func(key string) *pendingOperations {
+		pendingOps := newPendingOperations()
+		pendingOps.addWrite(worldstate.DefaultDBName, key, &types.Version{BlockNum: 2, TxNum: 0})
+		return pendingOps
+	}
+
+	tests := []struct {
+		name           string
+		txOps          *types.DBOperation
+		pendingOps     *pendingOperations
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name: "valid: merge patch and operations on nested fields and arrays",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{
+						Key:        "doc",
+						Version:    committedVersion,
+						MergePatch: []byte(`{"address":{"zip":"3200003"}}`),
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "valid: array operations",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{
+						Key:     "doc",
+						Version: committedVersion,
+						Operations: []*types.JSONPatchOperation{
+							{Op: "add", Path: "/tags/-", Value: []byte(`"c"`)},
+							{Op: "remove", Path: "/tags/0"},
+						},
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: empty entry",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{nil},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "there is an empty entry in the patch list",
+			},
+		},
+		{
+			name: "invalid: duplicated key",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{Key: "doc", Version: committedVersion, MergePatch: []byte(`{"a":1}`)},
+					{Key: "doc", Version: committedVersion, MergePatch: []byte(`{"b":1}`)},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [doc] is duplicated in the patch list. The keys in the patch list must be unique",
+			},
+		},
+		{
+			name: "invalid: key is written and patched",
+			txOps: &types.DBOperation{
+				DataWrites: []*types.DataWrite{
+					{Key: "doc", Value: []byte(`{}`)},
+				},
+				DataPatches: []*types.DataPatch{
+					{Key: "doc", Version: committedVersion, MergePatch: []byte(`{"a":1}`)},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [doc] is being updated as well as patched. Only one operation per key is allowed within a transaction",
+			},
+		},
+		{
+			name: "invalid: no write permission",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{Key: "doc/acl", Version: committedVersion, MergePatch: []byte(`{"a":2}`)},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_NO_PERMISSION,
+				ReasonIfInvalid: "none of the user in [operatingUser] has a write/delete permission on key [doc/acl] present in the database [" + worldstate.DefaultDBName + "]",
+			},
+		},
+		{
+			name: "invalid: key modified earlier in the block",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{Key: "doc", Version: committedVersion, MergePatch: []byte(`{"a":1}`)},
+				},
+			},
+			pendingOps: pendingWriteOn("doc"),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+				ReasonIfInvalid: "mvcc conflict has occurred within the block for the patched key [doc] in database [" + worldstate.DefaultDBName + "]. Within a block, a key can be modified only once",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "doc",
+						ExpectedVersion: committedVersion,
+						ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid: stale version",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{Key: "doc", Version: &types.Version{BlockNum: 1, TxNum: 0}, MergePatch: []byte(`{"a":1}`)},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+				ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the patched key [doc] in database [" + worldstate.DefaultDBName + "] changed",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "doc",
+						ExpectedVersion: &types.Version{BlockNum: 1, TxNum: 0},
+						ActualVersion:   committedVersion,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid: key does not exist",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{Key: "missing", MergePatch: []byte(`{"a":1}`)},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [missing] does not exist in the database [" + worldstate.DefaultDBName + "] and hence, it cannot be patched",
+			},
+		},
+		{
+			name: "invalid: value is not a JSON document",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{Key: "raw", Version: committedVersion, MergePatch: []byte(`{"a":1}`)},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the patch of the key [raw] in the database [" + worldstate.DefaultDBName + "] cannot be applied: the value is not a JSON document: error while decoding JSON: invalid character 'o' in literal null (expecting 'u')",
+			},
+		},
+		{
+			name: "invalid: failing operation",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{
+						Key:     "doc",
+						Version: committedVersion,
+						Operations: []*types.JSONPatchOperation{
+							{Op: "test", Path: "/name", Value: []byte(`"bob"`)},
+							{Op: "replace", Path: "/name", Value: []byte(`"carol"`)},
+						},
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the patch of the key [doc] in the database [" + worldstate.DefaultDBName + "] cannot be applied: operation 0 [test] failed: the value at the path [/name] is not the expected one",
+			},
+		},
+		{
+			name: "invalid: both a merge patch and operations",
+			txOps: &types.DBOperation{
+				DataPatches: []*types.DataPatch{
+					{
+						Key:        "doc",
+						Version:    committedVersion,
+						MergePatch: []byte(`{"a":1}`),
+						Operations: []*types.JSONPatchOperation{
+							{Op: "remove", Path: "/name"},
+						},
+					},
+				},
+			},
+			pendingOps: newPendingOperations(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the patch of the key [doc] in the database [" + worldstate.DefaultDBName + "] cannot be applied: the patch carries both a merge patch and a list of operations",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+
+			setup(env.db)
+
+			tt.txOps.DbName = worldstate.DefaultDBName
+			result, err := env.validator.dataTxValidator.validateDataPatches([]string{"operatingUser"}, tt.txOps, tt.pendingOps)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+		})
+	}
+}
+
+func TestMVCCOnDataTx(t *testing.T) {
+	t.Parallel()
+
+	version1 := &types.Version{
+		BlockNum: 1,
+		TxNum:    1,
+	}
+
+	version2 := &types.Version{
+		BlockNum: 2,
+		TxNum:    1,
+	}
+
+	version3 := &types.Version{
+		BlockNum: 3,
+		TxNum:    1,
+	}
+
+	tests := []struct {
+		name           string
+		setup          func(db worldstate.DB)
+		txOps          *types.DBOperation
+		dataReads      []*types.DataRead
+		dataWrites     []*types.DataWrite
+		dataDeletes    []*types.DataDelete
+		pendingOps     *pendingOperations
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:  "invalid: conflict writes within the block",
+			setup: func(db worldstate.DB) {},
+			txOps: &types.DBOperation{
+				DataReads: []*types.DataRead{
+					{
+						Key:     "key1",
+						Version: version1,
+					},
+				},
+			},
+			pendingOps: &pendingOperations{
+				pendingWrites: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
+				},
+				pendingDeletes: map[string]*types.Version{},
+			},
 			expectedResult: &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
 				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [key1] in database [" + worldstate.DefaultDBName + "]",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key1",
+						ExpectedVersion: version1,
+						ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+					},
+				},
 			},
 		},
 		{
@@ -2057,14 +2902,22 @@ func TestMVCCOnDataTx(t *testing.T) {
 				},
 			},
 			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{},
-				pendingDeletes: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
+				pendingWrites: map[string]*types.Version{},
+				pendingDeletes: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
 				},
 			},
 			expectedResult: &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
 				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [key1] in database [" + worldstate.DefaultDBName + "]",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key1",
+						ExpectedVersion: version1,
+						ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+					},
+				},
 			},
 		},
 		{
@@ -2079,9 +2932,9 @@ func TestMVCCOnDataTx(t *testing.T) {
 				},
 			},
 			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{},
-				pendingDeletes: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
+				pendingWrites: map[string]*types.Version{},
+				pendingDeletes: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
 				},
 			},
 			expectedResult: &types.ValidationInfo{
@@ -2101,10 +2954,10 @@ func TestMVCCOnDataTx(t *testing.T) {
 				},
 			},
 			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
+				pendingWrites: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
 				},
-				pendingDeletes: map[string]bool{},
+				pendingDeletes: map[string]*types.Version{},
 			},
 			expectedResult: &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
@@ -2122,10 +2975,10 @@ func TestMVCCOnDataTx(t *testing.T) {
 				},
 			},
 			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
+				pendingWrites: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
 				},
-				pendingDeletes: map[string]bool{},
+				pendingDeletes: map[string]*types.Version{},
 			},
 			expectedResult: &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
@@ -2143,9 +2996,9 @@ func TestMVCCOnDataTx(t *testing.T) {
 				},
 			},
 			pendingOps: &pendingOperations{
-				pendingWrites: map[string]bool{},
-				pendingDeletes: map[string]bool{
-					constructCompositeKey(worldstate.DefaultDBName, "key1"): true,
+				pendingWrites: map[string]*types.Version{},
+				pendingDeletes: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 2, TxNum: 0},
 				},
 			},
 			expectedResult: &types.ValidationInfo{
@@ -2168,6 +3021,13 @@ func TestMVCCOnDataTx(t *testing.T) {
 			expectedResult: &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
 				ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the key [key1] in database [" + worldstate.DefaultDBName + "] changed",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key1",
+						ExpectedVersion: version1,
+					},
+				},
 			},
 		},
 		{
@@ -2210,6 +3070,106 @@ func TestMVCCOnDataTx(t *testing.T) {
 			expectedResult: &types.ValidationInfo{
 				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
 				ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the key [key2] in database [" + worldstate.DefaultDBName + "] changed",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key2",
+						ExpectedVersion: version1,
+						ActualVersion:   version3,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid: all conflicting reads are reported",
+			setup: func(db worldstate.DB) {
+				data := map[string]*worldstate.DBUpdates{
+					worldstate.DefaultDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key: "key1",
+								Metadata: &types.Metadata{
+									Version: version2,
+								},
+							},
+							{
+								Key: "key2",
+								Metadata: &types.Metadata{
+									Version: version3,
+								},
+							},
+							{
+								Key: "key3",
+								Metadata: &types.Metadata{
+									Version: version1,
+								},
+							},
+						},
+					},
+				}
+
+				require.NoError(t, db.Commit(data, 1))
+			},
+			txOps: &types.DBOperation{
+				DataReads: []*types.DataRead{
+					{
+						Key:     "key1",
+						Version: version2,
+					},
+					{
+						Key:     "key2",
+						Version: version1,
+					},
+					{
+						Key:     "key3",
+						Version: version1,
+					},
+					{
+						Key:     "key4",
+						Version: version2,
+					},
+					{
+						Key:     "key5",
+						Version: nil,
+					},
+				},
+			},
+			pendingOps: &pendingOperations{
+				pendingWrites: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key3"): {BlockNum: 4, TxNum: 0},
+				},
+				pendingDeletes: map[string]*types.Version{
+					constructCompositeKey(worldstate.DefaultDBName, "key1"): {BlockNum: 4, TxNum: 2},
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+				ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [key1] in database [" + worldstate.DefaultDBName + "]",
+				ConflictingReads: []*types.ConflictingRead{
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key1",
+						ExpectedVersion: version2,
+						ActualVersion:   &types.Version{BlockNum: 4, TxNum: 2},
+					},
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key2",
+						ExpectedVersion: version1,
+						ActualVersion:   version3,
+					},
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key3",
+						ExpectedVersion: version1,
+						ActualVersion:   &types.Version{BlockNum: 4, TxNum: 0},
+					},
+					{
+						DbName:          worldstate.DefaultDBName,
+						Key:             "key4",
+						ExpectedVersion: version2,
+					},
+				},
 			},
 		},
 		{
@@ -2268,10 +3228,32 @@ func TestMVCCOnDataTx(t *testing.T) {
 			defer env.cleanup()
 
 			tt.setup(env.db)
-
-			result, err := env.validator.dataTxValidator.mvccValidation(worldstate.DefaultDBName, tt.txOps, tt.pendingOps)
-			require.NoError(t, err)
-			require.Equal(t, tt.expectedResult, result)
+			db := testfault.NewDB(env.db)
+			env.validator.dataTxValidator.db = db
+
+			t.Run("lookup per read", func(t *testing.T) {
+				result, err := env.validator.dataTxValidator.mvccValidation(worldstate.DefaultDBName, tt.txOps, tt.pendingOps)
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+			})
+
+			t.Run("prefetched versions", func(t *testing.T) {
+				pendingOps := &pendingOperations{
+					pendingWrites:     tt.pendingOps.pendingWrites,
+					pendingDeletes:    tt.pendingOps.pendingDeletes,
+					committedVersions: make(map[string]*types.Version),
+				}
+				txOps := proto.Clone(tt.txOps).(*types.DBOperation)
+				txOps.DbName = worldstate.DefaultDBName
+				txEnvs := []*types.DataTxEnvelope{{Payload: &types.DataTx{DbOperations: []*types.DBOperation{txOps}}}}
+
+				getVersionCalls := db.Calls("GetVersion")
+				require.NoError(t, env.validator.prefetchReadVersions(txEnvs, []*types.ValidationInfo{{Flag: types.Flag_VALID}}, pendingOps))
+				result, err := env.validator.dataTxValidator.mvccValidation(worldstate.DefaultDBName, tt.txOps, pendingOps)
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+				require.Equal(t, getVersionCalls, db.Calls("GetVersion"))
+			})
 		})
 	}
 }
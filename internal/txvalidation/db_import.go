@@ -0,0 +1,172 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// validateImportProof validates a data transaction generated by a node from a database import, which carries the
+// signed import request in place of signatures. The user of the request, who must be an admin, must be the only must
+// sign user of the transaction, and the transaction may only write to the database of the request. The user of the
+// request is returned as the only user with a valid signature.
+func (v *dataTxValidator) validateImportProof(txEnv *types.DataTxEnvelope) ([]string, *types.ValidationInfo, error) {
+	tx := txEnv.Payload
+	request := txEnv.ImportProof.GetRequest()
+
+	valInfo, err := validateDBImportRequest(v.sigValidator, request, txEnv.ImportProof.GetSignature(), tx.TxId)
+	if err != nil || valInfo.Flag != types.Flag_VALID {
+		return nil, valInfo, err
+	}
+
+	if len(tx.MustSignUserIds) != 1 || tx.MustSignUserIds[0] != request.UserId {
+		return nil, &types.ValidationInfo{
+			Flag: types.Flag_INVALID_UNAUTHORISED,
+			ReasonIfInvalid: "the import request of the user [" + request.UserId + "] cannot authorize a transaction of the users [" +
+				strings.Join(tx.MustSignUserIds, ", ") + "]",
+		}, nil
+	}
+
+	isAdmin, err := v.identityQuerier.HasAdministrationPrivilege(request.UserId)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "error while checking database administrative privilege for user [%s]", request.UserId)
+	}
+	if !isAdmin {
+		return nil, &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_NO_PERMISSION,
+			ReasonIfInvalid: "the user [" + request.UserId + "] has no privilege to import a database",
+		}, nil
+	}
+
+	for _, ops := range tx.DbOperations {
+		if ops.DbName != request.DbName {
+			return nil, &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a transaction of the import of the database [" + request.DbName + "] cannot operate on the database [" + ops.DbName + "]",
+			}, nil
+		}
+		if len(ops.DataReads) > 0 || len(ops.DataDeletes) > 0 || len(ops.DataDeleteRanges) > 0 || len(ops.DataPatches) > 0 {
+			return nil, &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a transaction of a database import can only hold data writes",
+			}, nil
+		}
+	}
+
+	return []string{request.UserId}, &types.ValidationInfo{Flag: types.Flag_VALID}, nil
+}
+
+// validateImportedOps validates the writes of a transaction of a database import. The keys are written with the
+// access control they were exported with, by the admin who signed the import request, hence, neither the permission
+// on the database nor the access control of the keys apply. Likewise, the values were validated against the schema
+// and the value size cap of the database when they were written on the source, possibly before the current settings.
+func (v *dataTxValidator) validateImportedOps(txOps *types.DBOperation) (*types.ValidationInfo, error) {
+	dbName := txOps.DbName
+
+	r := validateKeysFormat(dbName, txOps)
+	v.tracer.recordDB("key format", dbName, r)
+	if r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	collation, err := v.keyCollation(dbName)
+	if err != nil {
+		return nil, err
+	}
+	r = validateKeysCollation(dbName, collation, txOps)
+	if r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	r, err = v.validateFieldsInDataWrites(txOps.DataWrites)
+	if err != nil {
+		return nil, err
+	}
+	v.tracer.recordDB("write entries", dbName, r)
+	if r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	r = validateUniquenessInDataWritesAndDeletes(txOps.DataWrites, nil)
+	v.tracer.recordDB("unique keys", dbName, r)
+	if r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	return v.validateImmutableOps(txOps)
+}
+
+// validateImportProof validates the database administration transaction generated by a node to create the database
+// of a database import, which carries the signed import request in place of a signature. The transaction may only
+// create the database of the request, and set its index and its settings.
+func (v *dbAdminTxValidator) validateImportProof(txEnv *types.DBAdministrationTxEnvelope) (*types.ValidationInfo, error) {
+	tx := txEnv.Payload
+	request := txEnv.ImportProof.GetRequest()
+
+	valInfo, err := validateDBImportRequest(v.sigValidator, request, txEnv.ImportProof.GetSignature(), tx.TxId)
+	if err != nil || valInfo.Flag != types.Flag_VALID {
+		return valInfo, err
+	}
+
+	if request.UserId != tx.UserId {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_UNAUTHORISED,
+			ReasonIfInvalid: "the import request of the user [" + request.UserId + "] cannot authorize a transaction of the user [" + tx.UserId + "]",
+		}, nil
+	}
+
+	incorrect := &types.ValidationInfo{
+		Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+		ReasonIfInvalid: "a transaction of the import of the database [" + request.DbName + "] can only create the database",
+	}
+	if len(tx.CreateDbs) != 1 || tx.CreateDbs[0] != request.DbName || len(tx.DeleteDbs) > 0 {
+		return incorrect, nil
+	}
+	for _, dbName := range tx.ImmutableDbs {
+		if dbName != request.DbName {
+			return incorrect, nil
+		}
+	}
+	var settings []string
+	for dbName := range tx.DbsIndex {
+		settings = append(settings, dbName)
+	}
+	for dbName := range tx.DbsSchema {
+		settings = append(settings, dbName)
+	}
+	for dbName := range tx.DbsDefaultAcl {
+		settings = append(settings, dbName)
+	}
+	for dbName := range tx.DbsMaxValueSize {
+		settings = append(settings, dbName)
+	}
+	for dbName := range tx.DbsKeyCollation {
+		settings = append(settings, dbName)
+	}
+	for _, dbName := range settings {
+		if dbName != request.DbName {
+			return incorrect, nil
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}, nil
+}
+
+// validateDBImportRequest checks that the transaction is part of the import, by its ID, and that the user of the
+// import request signed it.
+func validateDBImportRequest(sigValidator *txSigValidator, request *types.DBImportRequest, signature []byte, txID string) (*types.ValidationInfo, error) {
+	if request == nil || request.ImportId == "" || !strings.HasPrefix(txID, request.ImportId+"-") {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the transaction [" + txID + "] is not part of the database import [" + request.GetImportId() + "]",
+		}, nil
+	}
+
+	return sigValidator.validate(request.UserId, signature, request)
+}
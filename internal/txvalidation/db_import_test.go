@@ -0,0 +1,350 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDBImportDataTx(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"adminUser", "nonAdminUser"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "adminUser")
+	nonAdminCert, nonAdminSigner := testutils.LoadTestCrypto(t, cryptoDir, "nonAdminUser")
+	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
+
+	adminUserSerialized, err := proto.Marshal(&types.User{
+		Id:          "adminUser",
+		Certificate: adminCert.Raw,
+		Privilege: &types.Privilege{
+			Admin: true,
+		},
+	})
+	require.NoError(t, err)
+	nonAdminUserSerialized, err := proto.Marshal(&types.User{
+		Id:          "nonAdminUser",
+		Certificate: nonAdminCert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{"db1": types.Privilege_ReadWrite},
+		},
+	})
+	require.NoError(t, err)
+
+	manifest := &types.DBExportManifest{DbName: "source", Height: 10}
+	signedProof := func(userID string, signer crypto.Signer) *types.DBImportProof {
+		request, err := types.NewDBImportRequest(userID, "import1", "db1", manifest)
+		require.NoError(t, err)
+		return &types.DBImportProof{
+			Request:   request,
+			Signature: testutils.SignatureFromTx(t, signer, request),
+		}
+	}
+	adminProof := signedProof("adminUser", adminSigner)
+
+	// the admin has no permission on db1, and the ACL of the key names another user
+	aclWrite := &types.DataWrite{
+		Key:   "key1",
+		Value: []byte("value1"),
+		Acl: &types.AccessControl{
+			ReadWriteUsers: map[string]bool{"nonAdminUser": true},
+		},
+	}
+	importTx := func(proof *types.DBImportProof, txID string, ops ...*types.DBOperation) *types.DataTxEnvelope {
+		return &types.DataTxEnvelope{
+			Payload: &types.DataTx{
+				MustSignUserIds: []string{"adminUser"},
+				TxId:            txID,
+				DbOperations:    ops,
+			},
+			ImportProof: proof,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		txEnv          *types.DataTxEnvelope
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name: "valid",
+			txEnv: importTx(adminProof, "import1-1", &types.DBOperation{
+				DbName:     "db1",
+				DataWrites: []*types.DataWrite{aclWrite, {Key: "key2", Value: []byte("value2")}},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: transaction of another import",
+			txEnv: importTx(adminProof, "import2-1", &types.DBOperation{
+				DbName:     "db1",
+				DataWrites: []*types.DataWrite{aclWrite},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the transaction [import2-1] is not part of the database import [import1]",
+			},
+		},
+		{
+			name: "invalid: request not signed by the importing user",
+			txEnv: importTx(&types.DBImportProof{
+				Request:   adminProof.Request,
+				Signature: testutils.SignatureFromTx(t, nonAdminSigner, adminProof.Request),
+			}, "import1-1", &types.DBOperation{
+				DbName:     "db1",
+				DataWrites: []*types.DataWrite{aclWrite},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "signature verification failed: x509: ECDSA verification failure",
+			},
+		},
+		{
+			name: "invalid: another must sign user",
+			txEnv: func() *types.DataTxEnvelope {
+				env := importTx(adminProof, "import1-1", &types.DBOperation{
+					DbName:     "db1",
+					DataWrites: []*types.DataWrite{aclWrite},
+				})
+				env.Payload.MustSignUserIds = []string{"adminUser", "nonAdminUser"}
+				return env
+			}(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "the import request of the user [adminUser] cannot authorize a transaction of the users [adminUser, nonAdminUser]",
+			},
+		},
+		{
+			name: "invalid: request of a non-admin user",
+			txEnv: func() *types.DataTxEnvelope {
+				env := importTx(signedProof("nonAdminUser", nonAdminSigner), "import1-1", &types.DBOperation{
+					DbName:     "db1",
+					DataWrites: []*types.DataWrite{aclWrite},
+				})
+				env.Payload.MustSignUserIds = []string{"nonAdminUser"}
+				return env
+			}(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_NO_PERMISSION,
+				ReasonIfInvalid: "the user [nonAdminUser] has no privilege to import a database",
+			},
+		},
+		{
+			name: "invalid: write to another database",
+			txEnv: importTx(adminProof, "import1-1", &types.DBOperation{
+				DbName:     worldstate.DefaultDBName,
+				DataWrites: []*types.DataWrite{aclWrite},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a transaction of the import of the database [db1] cannot operate on the database [" + worldstate.DefaultDBName + "]",
+			},
+		},
+		{
+			name: "invalid: delete in the transaction",
+			txEnv: importTx(adminProof, "import1-1", &types.DBOperation{
+				DbName:      "db1",
+				DataWrites:  []*types.DataWrite{aclWrite},
+				DataDeletes: []*types.DataDelete{{Key: "key2"}},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a transaction of a database import can only hold data writes",
+			},
+		},
+		{
+			name: "invalid: duplicate key",
+			txEnv: importTx(adminProof, "import1-1", &types.DBOperation{
+				DbName:     "db1",
+				DataWrites: []*types.DataWrite{aclWrite, aclWrite},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the key [key1] is duplicated in the write list. The keys in the write list must be unique",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setupClusterConfigCA(t, env, caCert)
+			require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.UsersDBName: {
+					Writes: []*worldstate.KVWithMetadata{
+						{
+							Key:   string(identity.UserNamespace) + "adminUser",
+							Value: adminUserSerialized,
+						},
+						{
+							Key:   string(identity.UserNamespace) + "nonAdminUser",
+							Value: nonAdminUserSerialized,
+						},
+					},
+				},
+				worldstate.DatabasesDBName: {
+					Writes: []*worldstate.KVWithMetadata{
+						{
+							Key: "db1",
+						},
+					},
+				},
+			}, 2))
+
+			usersWithValidSignTx, valInfo, err := env.validator.dataTxValidator.validateSignatures(tt.txEnv)
+			require.NoError(t, err)
+			if valInfo.Flag != types.Flag_VALID {
+				require.True(t, proto.Equal(tt.expectedResult, valInfo), "expected: %v, actual: %v", tt.expectedResult, valInfo)
+				return
+			}
+			require.Equal(t, []string{"adminUser"}, usersWithValidSignTx)
+
+			result, err := env.validator.dataTxValidator.validate(tt.txEnv, usersWithValidSignTx, newPendingOperations(), constants.RulesVersion)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+		})
+	}
+}
+
+func TestValidateDBImportDBAdminTx(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"adminUser"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "adminUser")
+	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
+
+	adminUserSerialized, err := proto.Marshal(&types.User{
+		Id:          "adminUser",
+		Certificate: adminCert.Raw,
+		Privilege: &types.Privilege{
+			Admin: true,
+		},
+	})
+	require.NoError(t, err)
+
+	request, err := types.NewDBImportRequest("adminUser", "import1", "db1", &types.DBExportManifest{DbName: "source", Height: 10})
+	require.NoError(t, err)
+	proof := &types.DBImportProof{
+		Request:   request,
+		Signature: testutils.SignatureFromTx(t, adminSigner, request),
+	}
+
+	importTx := func(tx *types.DBAdministrationTx) *types.DBAdministrationTxEnvelope {
+		tx.UserId = "adminUser"
+		tx.TxId = "import1-0"
+		return &types.DBAdministrationTxEnvelope{
+			Payload:     tx,
+			ImportProof: proof,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		txEnv          *types.DBAdministrationTxEnvelope
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name: "valid",
+			txEnv: importTx(&types.DBAdministrationTx{
+				CreateDbs: []string{"db1"},
+				DbsIndex: map[string]*types.DBIndex{
+					"db1": {AttributeAndType: map[string]types.IndexAttributeType{"age": types.IndexAttributeType_NUMBER}},
+				},
+				DbsDefaultAcl: map[string]*types.DBDefaultACL{
+					"db1": {Acl: &types.AccessControl{ReadUsers: map[string]bool{"adminUser": true}}},
+				},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: creates another database",
+			txEnv: importTx(&types.DBAdministrationTx{
+				CreateDbs: []string{"db1", "db2"},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a transaction of the import of the database [db1] can only create the database",
+			},
+		},
+		{
+			name: "invalid: sets the index of another database",
+			txEnv: importTx(&types.DBAdministrationTx{
+				CreateDbs: []string{"db1"},
+				DbsIndex: map[string]*types.DBIndex{
+					"db2": {AttributeAndType: map[string]types.IndexAttributeType{"age": types.IndexAttributeType_NUMBER}},
+				},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a transaction of the import of the database [db1] can only create the database",
+			},
+		},
+		{
+			name: "invalid: transaction of another user",
+			txEnv: func() *types.DBAdministrationTxEnvelope {
+				env := importTx(&types.DBAdministrationTx{CreateDbs: []string{"db1"}})
+				env.Payload.UserId = "nonAdminUser"
+				return env
+			}(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "the import request of the user [adminUser] cannot authorize a transaction of the user [nonAdminUser]",
+			},
+		},
+		{
+			name: "invalid: transaction of another import",
+			txEnv: func() *types.DBAdministrationTxEnvelope {
+				env := importTx(&types.DBAdministrationTx{CreateDbs: []string{"db1"}})
+				env.Payload.TxId = "import10"
+				return env
+			}(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the transaction [import10] is not part of the database import [import1]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setupClusterConfigCA(t, env, caCert)
+			require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.UsersDBName: {
+					Writes: []*worldstate.KVWithMetadata{
+						{
+							Key:   string(identity.UserNamespace) + "adminUser",
+							Value: adminUserSerialized,
+						},
+					},
+				},
+			}, 2))
+
+			result, err := env.validator.dbAdminTxValidator.validate(tt.txEnv)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+		})
+	}
+}
@@ -4,7 +4,10 @@
 package txvalidation
 
 import (
+	"sort"
+
 	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/jsonschema"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
@@ -15,11 +18,18 @@ type dbAdminTxValidator struct {
 	db              worldstate.DB
 	identityQuerier *identity.Querier
 	sigValidator    *txSigValidator
+	tracer          *tracer
 	logger          *logger.SugarLogger
 }
 
 func (v *dbAdminTxValidator) validate(txEnv *types.DBAdministrationTxEnvelope) (*types.ValidationInfo, error) {
-	valInfo, err := v.sigValidator.validate(txEnv.Payload.UserId, txEnv.Signature, txEnv.Payload)
+	var valInfo *types.ValidationInfo
+	var err error
+	if txEnv.ImportProof != nil {
+		valInfo, err = v.validateImportProof(txEnv)
+	} else {
+		valInfo, err = v.sigValidator.validate(txEnv.Payload.UserId, txEnv.Signature, txEnv.Payload)
+	}
 	if err != nil || valInfo.Flag != types.Flag_VALID {
 		return valInfo, err
 	}
@@ -30,11 +40,14 @@ func (v *dbAdminTxValidator) validate(txEnv *types.DBAdministrationTxEnvelope) (
 		return nil, errors.WithMessagef(err, "error while checking database administrative privilege for user [%s]", tx.UserId)
 	}
 	if !hasPerm {
-		return &types.ValidationInfo{
+		vi := &types.ValidationInfo{
 			Flag:            types.Flag_INVALID_NO_PERMISSION,
 			ReasonIfInvalid: "the user [" + tx.UserId + "] has no privilege to perform database administrative operations",
-		}, nil
+		}
+		v.tracer.recordPrivilege(tx.UserId, "admin", false, vi)
+		return vi, nil
 	}
+	v.tracer.recordPrivilege(tx.UserId, "admin", true, tracedValid)
 
 	if r := v.validateCreateDBEntries(tx.CreateDbs); r.Flag != types.Flag_VALID {
 		return r, nil
@@ -44,7 +57,27 @@ func (v *dbAdminTxValidator) validate(txEnv *types.DBAdministrationTxEnvelope) (
 		return r, nil
 	}
 
-	return v.validateIndexEntries(tx.DbsIndex, tx.CreateDbs, tx.DeleteDbs), nil
+	if r := v.validateIndexEntries(tx.DbsIndex, tx.CreateDbs, tx.DeleteDbs); r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	if r := v.validateSchemaEntries(tx.DbsSchema, tx.CreateDbs, tx.DeleteDbs); r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	if r := v.validateDefaultACLEntries(tx.DbsDefaultAcl, tx.CreateDbs, tx.DeleteDbs); r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	if r := v.validateMaxValueSizeEntries(tx.DbsMaxValueSize, tx.CreateDbs, tx.DeleteDbs); r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	if r := v.validateKeyCollationEntries(tx.DbsKeyCollation, tx.CreateDbs, tx.DeleteDbs); r.Flag != types.Flag_VALID {
+		return r, nil
+	}
+
+	return v.validateImmutableEntries(tx.ImmutableDbs, tx.CreateDbs, tx.DeleteDbs), nil
 }
 
 func (v *dbAdminTxValidator) validateCreateDBEntries(toCreateDBs []string) *types.ValidationInfo {
@@ -205,3 +238,189 @@ func (v *dbAdminTxValidator) validateIndexEntries(dbsIndex map[string]*types.DBI
 		Flag: types.Flag_VALID,
 	}
 }
+
+// validateSchemaEntries checks that every schema is registered on a user database that exists, or is created, and is
+// not deleted by the transaction, and that every non-empty schema compiles.
+func (v *dbAdminTxValidator) validateSchemaEntries(dbsSchema map[string]*types.DBSchema, toCreateDBs, toDeleteDBs []string) *types.ValidationInfo {
+	// the databases are visited in order so that all the nodes report the same invalid entry
+	var dbNames []string
+	for dbName := range dbsSchema {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	for _, dbName := range dbNames {
+		if r := v.validateDescriptorTarget("schema", dbName, toCreateDBs, toDeleteDBs); r.Flag != types.Flag_VALID {
+			return r
+		}
+
+		schema := dbsSchema[dbName].GetJsonSchema()
+		if schema == "" {
+			continue
+		}
+		if _, err := jsonschema.Compile([]byte(schema)); err != nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "schema provided for database [" + dbName + "] is not valid: " + err.Error(),
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+// validateDefaultACLEntries checks that every default ACL is set on a user database that exists, or is created, and
+// is not deleted by the transaction, and that every sign policy is known.
+func (v *dbAdminTxValidator) validateDefaultACLEntries(dbsDefaultACL map[string]*types.DBDefaultACL, toCreateDBs, toDeleteDBs []string) *types.ValidationInfo {
+	var dbNames []string
+	for dbName := range dbsDefaultACL {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	for _, dbName := range dbNames {
+		if r := v.validateDescriptorTarget("default ACL", dbName, toCreateDBs, toDeleteDBs); r.Flag != types.Flag_VALID {
+			return r
+		}
+
+		acl := dbsDefaultACL[dbName].GetAcl()
+		if acl == nil {
+			continue
+		}
+		if _, ok := types.AccessControlWritePolicy_name[int32(acl.SignPolicyForWrite)]; !ok {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "default ACL provided for database [" + dbName + "] has an unknown sign policy for write",
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+func (v *dbAdminTxValidator) validateMaxValueSizeEntries(dbsMaxValueSize map[string]*types.DBMaxValueSize, toCreateDBs, toDeleteDBs []string) *types.ValidationInfo {
+	var dbNames []string
+	for dbName := range dbsMaxValueSize {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	for _, dbName := range dbNames {
+		if r := v.validateDescriptorTarget("max value size", dbName, toCreateDBs, toDeleteDBs); r.Flag != types.Flag_VALID {
+			return r
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+// validateKeyCollationEntries checks that a key collation is well-formed and is provided only for a database created by
+// the transaction, as the stored keys of a database are encoded by its collation.
+func (v *dbAdminTxValidator) validateKeyCollationEntries(dbsKeyCollation map[string]*types.DBKeyCollation, toCreateDBs, toDeleteDBs []string) *types.ValidationInfo {
+	var dbNames []string
+	for dbName := range dbsKeyCollation {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	for _, dbName := range dbNames {
+		if r := v.validateDescriptorTarget("key collation", dbName, toCreateDBs, toDeleteDBs); r.Flag != types.Flag_VALID {
+			return r
+		}
+
+		if v.db.Exist(dbName) {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "key collation provided for database [" + dbName + "] cannot be processed as the key collation of an existing database cannot be changed",
+			}
+		}
+
+		if err := worldstate.ValidateKeyCollation(dbsKeyCollation[dbName]); err != nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "key collation provided for database [" + dbName + "] is invalid: " + err.Error(),
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+// validateImmutableEntries checks that a database is declared immutable only by the transaction that creates it, as
+// the keys of an existing database may have been modified or deleted already.
+func (v *dbAdminTxValidator) validateImmutableEntries(immutableDBs, toCreateDBs, toDeleteDBs []string) *types.ValidationInfo {
+	immutableDBsLookup := make(map[string]bool)
+
+	for _, dbName := range immutableDBs {
+		if immutableDBsLookup[dbName] {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the database [" + dbName + "] is duplicated in the immutable list",
+			}
+		}
+		immutableDBsLookup[dbName] = true
+
+		if r := v.validateDescriptorTarget("immutability", dbName, toCreateDBs, toDeleteDBs); r.Flag != types.Flag_VALID {
+			return r
+		}
+
+		if v.db.Exist(dbName) {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "immutability provided for database [" + dbName + "] cannot be processed as an existing database cannot be made immutable",
+			}
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
+// validateDescriptorTarget checks that the database on which a setting of the descriptor is provided is a user
+// database that exists, or is created, and is not deleted by the transaction.
+func (v *dbAdminTxValidator) validateDescriptorTarget(setting, dbName string, toCreateDBs, toDeleteDBs []string) *types.ValidationInfo {
+	created, deleted := false, false
+	for _, name := range toCreateDBs {
+		if name == dbName {
+			created = true
+		}
+	}
+	for _, name := range toDeleteDBs {
+		if name == dbName {
+			deleted = true
+		}
+	}
+
+	switch {
+	case worldstate.IsSystemDB(dbName):
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: setting + " provided for database [" + dbName + "] cannot be processed as the database is a system database",
+		}
+
+	case !v.db.Exist(dbName) && !created:
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: setting + " provided for database [" + dbName + "] cannot be processed as the database neither exists nor is in the create DB list",
+		}
+
+	case deleted:
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: setting + " provided for database [" + dbName + "] cannot be processed as the database is present in the delete list",
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
@@ -550,3 +550,413 @@ func TestValidateIndexDBEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSchemaDBEntries(t *testing.T) {
+	t.Parallel()
+
+	setup := func(db worldstate.DB) {
+		createDB := map[string]*worldstate.DBUpdates{worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}, {Key: "db2"}}}}
+		require.NoError(t, db.Commit(createDB, 1))
+	}
+
+	tests := []struct {
+		name           string
+		toCreateDBs    []string
+		toDeleteDBs    []string
+		dbsSchema      map[string]*types.DBSchema
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:        "valid: schemas on an existing and a new database, and a schema removal",
+			toCreateDBs: []string{"db3"},
+			dbsSchema: map[string]*types.DBSchema{
+				"db1": {JsonSchema: `{"type":"object"}`},
+				"db2": {},
+				"db3": {JsonSchema: `{"type":"object","required":["name"]}`},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: db does not exist already and also does not appear in the createDB list",
+			dbsSchema: map[string]*types.DBSchema{
+				"db3": {JsonSchema: `{"type":"object"}`},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "schema provided for database [db3] cannot be processed as the database neither exists nor is in the create DB list",
+			},
+		},
+		{
+			name:        "invalid: db appears in the deleteDB list",
+			toDeleteDBs: []string{"db2"},
+			dbsSchema: map[string]*types.DBSchema{
+				"db2": {JsonSchema: `{"type":"object"}`},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "schema provided for database [db2] cannot be processed as the database is present in the delete list",
+			},
+		},
+		{
+			name: "invalid: system database",
+			dbsSchema: map[string]*types.DBSchema{
+				worldstate.UsersDBName: {JsonSchema: `{"type":"object"}`},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "schema provided for database [_users] cannot be processed as the database is a system database",
+			},
+		},
+		{
+			name: "invalid: the first invalid schema in the order of the database names",
+			dbsSchema: map[string]*types.DBSchema{
+				"db2": {JsonSchema: `{"type":"date"}`},
+				"db1": {JsonSchema: `{"type":"object","anyOf":[]}`},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "schema provided for database [db1] is not valid: the keyword at [/anyOf] is not supported",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setup(env.db)
+
+			result := env.validator.dbAdminTxValidator.validateSchemaEntries(tt.dbsSchema, tt.toCreateDBs, tt.toDeleteDBs)
+			require.True(t, proto.Equal(tt.expectedResult, result), "%v", result)
+		})
+	}
+}
+
+func TestValidateDefaultACLDBEntries(t *testing.T) {
+	t.Parallel()
+
+	setup := func(db worldstate.DB) {
+		createDB := map[string]*worldstate.DBUpdates{worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}, {Key: "db2"}}}}
+		require.NoError(t, db.Commit(createDB, 1))
+	}
+
+	tests := []struct {
+		name           string
+		toCreateDBs    []string
+		toDeleteDBs    []string
+		dbsDefaultACL  map[string]*types.DBDefaultACL
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:        "valid: default ACLs on an existing and a new database, and a default ACL removal",
+			toCreateDBs: []string{"db3"},
+			dbsDefaultACL: map[string]*types.DBDefaultACL{
+				"db1": {Acl: &types.AccessControl{ReadWriteUsers: map[string]bool{"alice": true}}},
+				"db2": {},
+				"db3": {Acl: &types.AccessControl{SignPolicyForWrite: types.AccessControl_ALL}},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: db does not exist already and also does not appear in the createDB list",
+			dbsDefaultACL: map[string]*types.DBDefaultACL{
+				"db3": {Acl: &types.AccessControl{}},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "default ACL provided for database [db3] cannot be processed as the database neither exists nor is in the create DB list",
+			},
+		},
+		{
+			name:        "invalid: db appears in the deleteDB list",
+			toDeleteDBs: []string{"db2"},
+			dbsDefaultACL: map[string]*types.DBDefaultACL{
+				"db2": {Acl: &types.AccessControl{}},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "default ACL provided for database [db2] cannot be processed as the database is present in the delete list",
+			},
+		},
+		{
+			name: "invalid: system database",
+			dbsDefaultACL: map[string]*types.DBDefaultACL{
+				worldstate.UsersDBName: {Acl: &types.AccessControl{}},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "default ACL provided for database [_users] cannot be processed as the database is a system database",
+			},
+		},
+		{
+			name: "invalid: unknown sign policy",
+			dbsDefaultACL: map[string]*types.DBDefaultACL{
+				"db1": {Acl: &types.AccessControl{SignPolicyForWrite: 7}},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "default ACL provided for database [db1] has an unknown sign policy for write",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setup(env.db)
+
+			result := env.validator.dbAdminTxValidator.validateDefaultACLEntries(tt.dbsDefaultACL, tt.toCreateDBs, tt.toDeleteDBs)
+			require.True(t, proto.Equal(tt.expectedResult, result), "%v", result)
+		})
+	}
+}
+
+func TestValidateMaxValueSizeDBEntries(t *testing.T) {
+	t.Parallel()
+
+	setup := func(db worldstate.DB) {
+		createDB := map[string]*worldstate.DBUpdates{worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}, {Key: "db2"}}}}
+		require.NoError(t, db.Commit(createDB, 1))
+	}
+
+	tests := []struct {
+		name            string
+		toCreateDBs     []string
+		toDeleteDBs     []string
+		dbsMaxValueSize map[string]*types.DBMaxValueSize
+		expectedResult  *types.ValidationInfo
+	}{
+		{
+			name:        "valid: caps on an existing and a new database, and a cap removal",
+			toCreateDBs: []string{"db3"},
+			dbsMaxValueSize: map[string]*types.DBMaxValueSize{
+				"db1": {MaxValueSizeBytes: 1024},
+				"db2": {},
+				"db3": {MaxValueSizeBytes: 1},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: db does not exist already and also does not appear in the createDB list",
+			dbsMaxValueSize: map[string]*types.DBMaxValueSize{
+				"db3": {MaxValueSizeBytes: 1024},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "max value size provided for database [db3] cannot be processed as the database neither exists nor is in the create DB list",
+			},
+		},
+		{
+			name:        "invalid: db appears in the deleteDB list",
+			toDeleteDBs: []string{"db2"},
+			dbsMaxValueSize: map[string]*types.DBMaxValueSize{
+				"db2": {MaxValueSizeBytes: 1024},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "max value size provided for database [db2] cannot be processed as the database is present in the delete list",
+			},
+		},
+		{
+			name: "invalid: system database",
+			dbsMaxValueSize: map[string]*types.DBMaxValueSize{
+				worldstate.UsersDBName: {MaxValueSizeBytes: 1024},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "max value size provided for database [_users] cannot be processed as the database is a system database",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setup(env.db)
+
+			result := env.validator.dbAdminTxValidator.validateMaxValueSizeEntries(tt.dbsMaxValueSize, tt.toCreateDBs, tt.toDeleteDBs)
+			require.True(t, proto.Equal(tt.expectedResult, result), "%v", result)
+		})
+	}
+}
+
+func TestValidateKeyCollationDBEntries(t *testing.T) {
+	t.Parallel()
+
+	setup := func(db worldstate.DB) {
+		createDB := map[string]*worldstate.DBUpdates{worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}}}}
+		require.NoError(t, db.Commit(createDB, 1))
+	}
+
+	tests := []struct {
+		name            string
+		toCreateDBs     []string
+		toDeleteDBs     []string
+		dbsKeyCollation map[string]*types.DBKeyCollation
+		expectedResult  *types.ValidationInfo
+	}{
+		{
+			name:        "valid: collations of new databases",
+			toCreateDBs: []string{"db2", "db3"},
+			dbsKeyCollation: map[string]*types.DBKeyCollation{
+				"db2": {Mode: types.DBKeyCollation_NUMERIC},
+				"db3": {
+					Mode:      types.DBKeyCollation_COMPOSITE,
+					Segments:  []types.DBKeyCollation_SegmentType{types.DBKeyCollation_TEXT, types.DBKeyCollation_NUMBER},
+					Separator: "/",
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: the collation of an existing database cannot be changed",
+			dbsKeyCollation: map[string]*types.DBKeyCollation{
+				"db1": {Mode: types.DBKeyCollation_NUMERIC},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "key collation provided for database [db1] cannot be processed as the key collation of an existing database cannot be changed",
+			},
+		},
+		{
+			name: "invalid: db does not exist already and also does not appear in the createDB list",
+			dbsKeyCollation: map[string]*types.DBKeyCollation{
+				"db2": {Mode: types.DBKeyCollation_NUMERIC},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "key collation provided for database [db2] cannot be processed as the database neither exists nor is in the create DB list",
+			},
+		},
+		{
+			name:        "invalid: composite collation without a separator",
+			toCreateDBs: []string{"db2"},
+			dbsKeyCollation: map[string]*types.DBKeyCollation{
+				"db2": {
+					Mode:     types.DBKeyCollation_COMPOSITE,
+					Segments: []types.DBKeyCollation_SegmentType{types.DBKeyCollation_TEXT},
+				},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "key collation provided for database [db2] is invalid: the COMPOSITE key collation must define a separator",
+			},
+		},
+		{
+			name: "invalid: system database",
+			dbsKeyCollation: map[string]*types.DBKeyCollation{
+				worldstate.UsersDBName: {Mode: types.DBKeyCollation_NUMERIC},
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "key collation provided for database [_users] cannot be processed as the database is a system database",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setup(env.db)
+
+			result := env.validator.dbAdminTxValidator.validateKeyCollationEntries(tt.dbsKeyCollation, tt.toCreateDBs, tt.toDeleteDBs)
+			require.True(t, proto.Equal(tt.expectedResult, result), "%v", result)
+		})
+	}
+}
+
+func TestValidateImmutableDBEntries(t *testing.T) {
+	t.Parallel()
+
+	setup := func(db worldstate.DB) {
+		createDB := map[string]*worldstate.DBUpdates{worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}}}}
+		require.NoError(t, db.Commit(createDB, 1))
+	}
+
+	tests := []struct {
+		name           string
+		toCreateDBs    []string
+		toDeleteDBs    []string
+		immutableDBs   []string
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:         "valid: new immutable databases",
+			toCreateDBs:  []string{"db2", "db3", "db4"},
+			immutableDBs: []string{"db2", "db4"},
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name:         "invalid: an existing database cannot be made immutable",
+			immutableDBs: []string{"db1"},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "immutability provided for database [db1] cannot be processed as an existing database cannot be made immutable",
+			},
+		},
+		{
+			name:         "invalid: db does not exist already and also does not appear in the createDB list",
+			immutableDBs: []string{"db2"},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "immutability provided for database [db2] cannot be processed as the database neither exists nor is in the create DB list",
+			},
+		},
+		{
+			name:         "invalid: duplicated database",
+			toCreateDBs:  []string{"db2"},
+			immutableDBs: []string{"db2", "db2"},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the database [db2] is duplicated in the immutable list",
+			},
+		},
+		{
+			name:         "invalid: system database",
+			immutableDBs: []string{worldstate.UsersDBName},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "immutability provided for database [_users] cannot be processed as the database is a system database",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setup(env.db)
+
+			result := env.validator.dbAdminTxValidator.validateImmutableEntries(tt.immutableDBs, tt.toCreateDBs, tt.toDeleteDBs)
+			require.True(t, proto.Equal(tt.expectedResult, result), "%v", result)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	interrors "github.com/hyperledger-labs/orion-server/internal/errors"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// txDependencies resolves the dependencies declared by the data transactions of a block. A dependency is satisfied
+// only by a transaction that was committed as valid, either in an earlier block or earlier in the same block. As the
+// transactions of a block are validated in order, the validation info of a transaction earlier in the block is final
+// by the time its dependents are validated.
+type txDependencies struct {
+	blockNum   uint64
+	txNums     map[string]int
+	envs       []*types.DataTxEnvelope
+	valInfo    []*types.ValidationInfo
+	blockStore *blockstore.Store
+}
+
+func newTxDependencies(blockNum uint64, envs []*types.DataTxEnvelope, valInfo []*types.ValidationInfo, blockStore *blockstore.Store) *txDependencies {
+	txNums := make(map[string]int)
+	for txNum, env := range envs {
+		if _, ok := txNums[env.Payload.TxId]; !ok {
+			txNums[env.Payload.TxId] = txNum
+		}
+	}
+
+	return &txDependencies{
+		blockNum:   blockNum,
+		txNums:     txNums,
+		envs:       envs,
+		valInfo:    valInfo,
+		blockStore: blockStore,
+	}
+}
+
+// validate checks the dependency of the transaction at the given index of the block, and returns the outcome of the
+// dependency to be referenced by the validation info of the transaction, if it declares one.
+func (d *txDependencies) validate(txNum int) (*types.ValidationInfo, *types.TxDependency, error) {
+	tx := d.envs[txNum].Payload
+	if tx.DependsOnTxId == "" {
+		return &types.ValidationInfo{Flag: types.Flag_VALID}, nil, nil
+	}
+
+	dependency := &types.TxDependency{TxId: tx.DependsOnTxId}
+	if d.inCycle(txNum) {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: fmt.Sprintf("the dependency [%s] of the transaction forms a cycle within the block", tx.DependsOnTxId),
+		}, dependency, nil
+	}
+
+	if depTxNum, ok := d.txNums[tx.DependsOnTxId]; ok {
+		if depTxNum > txNum {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+				ReasonIfInvalid: fmt.Sprintf("the dependency [%s] is ordered after the transaction in the block", tx.DependsOnTxId),
+			}, dependency, nil
+		}
+
+		dependency.BlockNumber = d.blockNum
+		dependency.TxIndex = uint64(depTxNum)
+		dependency.Flag = d.valInfo[depTxNum].Flag
+		return satisfied(dependency), dependency, nil
+	}
+
+	if d.blockStore == nil {
+		return nil, nil, errors.New("the validator has no block store to look up the committed dependencies")
+	}
+
+	txInfo, err := d.blockStore.GetTxInfo(tx.DependsOnTxId)
+	if err != nil {
+		if _, ok := err.(*interrors.NotFoundErr); !ok {
+			return nil, nil, err
+		}
+	}
+	// the block store may hold the blocks that follow this one when a block is validated anew, e.g., while tracing
+	if txInfo == nil || txInfo.BlockNumber >= d.blockNum {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: fmt.Sprintf("the dependency [%s] is not committed", tx.DependsOnTxId),
+		}, dependency, nil
+	}
+
+	dependency.BlockNumber = txInfo.BlockNumber
+	dependency.TxIndex = txInfo.TxIndex
+	dependency.Flag = txInfo.GetValidation().GetFlag()
+	return satisfied(dependency), dependency, nil
+}
+
+// inCycle returns true if following the dependencies within the block from the given transaction leads back to it
+func (d *txDependencies) inCycle(txNum int) bool {
+	visited := make(map[int]bool)
+	for next := txNum; ; {
+		visited[next] = true
+
+		depTxID := d.envs[next].Payload.DependsOnTxId
+		if depTxID == "" {
+			return false
+		}
+		depTxNum, ok := d.txNums[depTxID]
+		if !ok {
+			return false
+		}
+		if depTxNum == txNum {
+			return true
+		}
+		if visited[depTxNum] {
+			// a cycle which the transaction leads to, but is not part of
+			return false
+		}
+		next = depTxNum
+	}
+}
+
+func satisfied(dependency *types.TxDependency) *types.ValidationInfo {
+	if dependency.Flag != types.Flag_VALID {
+		return &types.ValidationInfo{
+			Flag: types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: fmt.Sprintf("the dependency [%s] at block [%d], index [%d], is invalid with flag [%s]",
+				dependency.TxId, dependency.BlockNumber, dependency.TxIndex, dependency.Flag),
+		}
+	}
+
+	return &types.ValidationInfo{Flag: types.Flag_VALID}
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+type heartbeatTxValidator struct {
+	identityQuerier *identity.Querier
+	logger          *logger.SugarLogger
+}
+
+// validate checks that the heartbeat is signed by a node which is in the cluster configuration. The signature is
+// verified against the certificate of the node, not against a user certificate, or, while the certificate of the node
+// is being rotated, against its previous certificate too if the block `blockNum` precedes the cutover block.
+func (v *heartbeatTxValidator) validate(txEnv *types.HeartbeatTxEnvelope, blockNum uint64) (*types.ValidationInfo, error) {
+	tx := txEnv.GetPayload()
+	if tx.GetNodeId() == "" {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the node ID of the heartbeat cannot be empty",
+		}, nil
+	}
+
+	if len(txEnv.GetSignature()) == 0 {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_MISSING_SIGNATURE,
+			ReasonIfInvalid: "the heartbeat of node [" + tx.NodeId + "] is not signed",
+		}, nil
+	}
+
+	node, _, err := v.identityQuerier.GetNode(tx.NodeId)
+	if err != nil {
+		if _, ok := err.(*identity.NotFoundErr); ok {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "the node [" + tx.NodeId + "] is not in the cluster configuration",
+			}, nil
+		}
+		return nil, errors.WithMessagef(err, "error while fetching the configuration of node [%s]", tx.NodeId)
+	}
+
+	txBytes, err := marshal.DefaultMarshaler().Marshal(tx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal heartbeat: %s", tx)
+	}
+
+	if err := cryptoservice.VerifyNodeSignature(node, blockNum, txEnv.Signature, txBytes); err != nil {
+		v.logger.Debugf("Failed to verify heartbeat (Flag_INVALID_UNAUTHORISED): node: %s, block: %d, error: %s", tx.NodeId, blockNum, err)
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_UNAUTHORISED,
+			ReasonIfInvalid: fmt.Sprintf("signature verification failed: %s", err.Error()),
+		}, nil
+	}
+
+	return &types.ValidationInfo{Flag: types.Flag_VALID}, nil
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateHeartbeatTx(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"node1", "node2"})
+	node1Cert, node1Signer := testutils.LoadTestCrypto(t, cryptoDir, "node1")
+	_, node2Signer := testutils.LoadTestCrypto(t, cryptoDir, "node2")
+
+	heartbeat := func(nodeID string) *types.HeartbeatTx {
+		return &types.HeartbeatTx{
+			NodeId:              nodeID,
+			TxId:                "tx-" + nodeID,
+			Version:             "0.1",
+			LastCommittedHeight: 10,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		txEnv          *types.HeartbeatTxEnvelope
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:  "invalid: empty node ID",
+			txEnv: testutils.SignedHeartbeatTxEnvelope(t, node1Signer, heartbeat("")),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the node ID of the heartbeat cannot be empty",
+			},
+		},
+		{
+			name: "invalid: missing signature",
+			txEnv: &types.HeartbeatTxEnvelope{
+				Payload: heartbeat("node1"),
+			},
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_MISSING_SIGNATURE,
+				ReasonIfInvalid: "the heartbeat of node [node1] is not signed",
+			},
+		},
+		{
+			name:  "invalid: node is not in the cluster configuration",
+			txEnv: testutils.SignedHeartbeatTxEnvelope(t, node2Signer, heartbeat("node2")),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "the node [node2] is not in the cluster configuration",
+			},
+		},
+		{
+			name:  "invalid: signed by another node",
+			txEnv: testutils.SignedHeartbeatTxEnvelope(t, node2Signer, heartbeat("node1")),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "signature verification failed: x509: ECDSA verification failure",
+			},
+		},
+		{
+			name:  "valid",
+			txEnv: testutils.SignedHeartbeatTxEnvelope(t, node1Signer, heartbeat("node1")),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+
+			node, err := proto.Marshal(&types.NodeConfig{
+				Id:          "node1",
+				Address:     "127.0.0.1",
+				Port:        6090,
+				Certificate: node1Cert.Raw,
+			})
+			require.NoError(t, err)
+			require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.ConfigDBName: {
+					Writes: []*worldstate.KVWithMetadata{
+						{
+							Key:   string(identity.NodeNamespace) + "node1",
+							Value: node,
+							Metadata: &types.Metadata{
+								Version: &types.Version{BlockNum: 1},
+							},
+						},
+					},
+				},
+			}, 1))
+
+			result, err := env.validator.heartbeatTxValidator.validate(tt.txEnv, 2)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestValidateHeartbeatTxAcrossCertificateCutover(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"node1", "node1new"})
+	oldCert, oldSigner := testutils.LoadTestCrypto(t, cryptoDir, "node1")
+	newCert, newSigner := testutils.LoadTestCrypto(t, cryptoDir, "node1new")
+
+	heartbeat := &types.HeartbeatTx{
+		NodeId:              "node1",
+		TxId:                "tx-node1",
+		Version:             "0.1",
+		LastCommittedHeight: 8,
+	}
+	signedWithOldCert := testutils.SignedHeartbeatTxEnvelope(t, oldSigner, heartbeat)
+	signedWithNewCert := testutils.SignedHeartbeatTxEnvelope(t, newSigner, heartbeat)
+
+	const cutoverBlock = 10
+
+	tests := []struct {
+		name           string
+		txEnv          *types.HeartbeatTxEnvelope
+		blockNum       uint64
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:     "valid: old certificate before the cutover block",
+			txEnv:    signedWithOldCert,
+			blockNum: cutoverBlock - 1,
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name:     "invalid: old certificate at the cutover block",
+			txEnv:    signedWithOldCert,
+			blockNum: cutoverBlock,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "signature verification failed: x509: ECDSA verification failure",
+			},
+		},
+		{
+			name:     "invalid: old certificate after the cutover block",
+			txEnv:    signedWithOldCert,
+			blockNum: cutoverBlock + 1,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "signature verification failed: x509: ECDSA verification failure",
+			},
+		},
+		{
+			name:     "valid: new certificate before the cutover block",
+			txEnv:    signedWithNewCert,
+			blockNum: cutoverBlock - 1,
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name:     "valid: new certificate at the cutover block",
+			txEnv:    signedWithNewCert,
+			blockNum: cutoverBlock,
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+
+			node, err := proto.Marshal(&types.NodeConfig{
+				Id:                      "node1",
+				Address:                 "127.0.0.1",
+				Port:                    6090,
+				Certificate:             newCert.Raw,
+				PreviousCertificate:     oldCert.Raw,
+				CertificateCutoverBlock: cutoverBlock,
+			})
+			require.NoError(t, err)
+			require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.ConfigDBName: {
+					Writes: []*worldstate.KVWithMetadata{
+						{
+							Key:   string(identity.NodeNamespace) + "node1",
+							Value: node,
+							Metadata: &types.Metadata{
+								Version: &types.Version{BlockNum: 1},
+							},
+						},
+					},
+				},
+			}, 1))
+
+			result, err := env.validator.heartbeatTxValidator.validate(tt.txEnv, tt.blockNum)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// ValidateOperationLimits checks the number of operations of the data transaction against the limits set in the
+// committed cluster configuration. It allows the submission of a transaction that would be invalidated by the
+// validation of its block to be rejected upfront.
+func (v *Validator) ValidateOperationLimits(tx *types.DataTx) (*types.ValidationInfo, error) {
+	limits, err := operationLimits(v.dataTxValidator.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return validateOperationLimits(tx, limits), nil
+}
+
+// operationLimits returns the limits on the operations of a data transaction, as set in the committed cluster
+// configuration, so that all the nodes use the same limits.
+func operationLimits(db worldstate.DB) (*types.TxOperationLimits, error) {
+	config, _, err := db.GetConfig()
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while fetching the cluster configuration")
+	}
+
+	return config.GetLedgerConfig().GetTxOperationLimits(), nil
+}
+
+// validateOperationLimits counts the operations of the transaction over all its databases. A patch counts as a write
+// and a range delete as a single delete. The transaction is invalid if any count exceeds its limit, where a zero
+// limit, as well as a nil set of limits, means no limit.
+func validateOperationLimits(tx *types.DataTx, limits *types.TxOperationLimits) *types.ValidationInfo {
+	if limits == nil {
+		return &types.ValidationInfo{Flag: types.Flag_VALID}
+	}
+
+	var reads, writes, deletes uint64
+	for _, ops := range tx.DbOperations {
+		reads += uint64(len(ops.DataReads))
+		writes += uint64(len(ops.DataWrites) + len(ops.DataPatches))
+		deletes += uint64(len(ops.DataDeletes) + len(ops.DataDeleteRanges))
+	}
+
+	counts := []struct {
+		kind  string
+		count uint64
+		limit uint64
+	}{
+		{kind: "reads", count: reads, limit: limits.MaxReads},
+		{kind: "writes", count: writes, limit: limits.MaxWrites},
+		{kind: "deletes", count: deletes, limit: limits.MaxDeletes},
+		{kind: "operations", count: reads + writes + deletes, limit: limits.MaxOperations},
+	}
+	for _, c := range counts {
+		if c.limit > 0 && c.count > c.limit {
+			return &types.ValidationInfo{
+				Flag: types.Flag_INVALID_TX_TOO_MANY_OPERATIONS,
+				ReasonIfInvalid: fmt.Sprintf("the transaction has [%d] %s, which exceeds the limit of [%d] %s per transaction",
+					c.count, c.kind, c.limit, c.kind),
+			}
+		}
+	}
+
+	return &types.ValidationInfo{Flag: types.Flag_VALID}
+}
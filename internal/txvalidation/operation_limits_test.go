@@ -0,0 +1,231 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOperationLimits(t *testing.T) {
+	t.Parallel()
+
+	ops := func(reads, writes, patches, deletes, rangeDeletes int) *types.DataTx {
+		op := &types.DBOperation{DbName: worldstate.DefaultDBName}
+		for i := 0; i < reads; i++ {
+			op.DataReads = append(op.DataReads, &types.DataRead{Key: fmt.Sprintf("r%d", i)})
+		}
+		for i := 0; i < writes; i++ {
+			op.DataWrites = append(op.DataWrites, &types.DataWrite{Key: fmt.Sprintf("w%d", i)})
+		}
+		for i := 0; i < patches; i++ {
+			op.DataPatches = append(op.DataPatches, &types.DataPatch{Key: fmt.Sprintf("p%d", i)})
+		}
+		for i := 0; i < deletes; i++ {
+			op.DataDeletes = append(op.DataDeletes, &types.DataDelete{Key: fmt.Sprintf("d%d", i)})
+		}
+		for i := 0; i < rangeDeletes; i++ {
+			op.DataDeleteRanges = append(op.DataDeleteRanges, &types.DataDeleteRange{Prefix: fmt.Sprintf("dr%d", i)})
+		}
+		return &types.DataTx{DbOperations: []*types.DBOperation{op}}
+	}
+
+	limits := &types.TxOperationLimits{
+		MaxReads:      3,
+		MaxWrites:     3,
+		MaxDeletes:    3,
+		MaxOperations: 8,
+	}
+
+	tests := []struct {
+		name           string
+		tx             *types.DataTx
+		limits         *types.TxOperationLimits
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:           "valid: no limits",
+			tx:             ops(100, 100, 0, 100, 0),
+			limits:         nil,
+			expectedResult: &types.ValidationInfo{Flag: types.Flag_VALID},
+		},
+		{
+			name:           "valid: zero limits",
+			tx:             ops(100, 100, 0, 100, 0),
+			limits:         &types.TxOperationLimits{},
+			expectedResult: &types.ValidationInfo{Flag: types.Flag_VALID},
+		},
+		{
+			name:           "valid: exactly at the limits",
+			tx:             ops(3, 2, 1, 1, 1),
+			limits:         limits,
+			expectedResult: &types.ValidationInfo{Flag: types.Flag_VALID},
+		},
+		{
+			name:   "invalid: one read over the limit",
+			tx:     ops(4, 0, 0, 0, 0),
+			limits: limits,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_TX_TOO_MANY_OPERATIONS,
+				ReasonIfInvalid: "the transaction has [4] reads, which exceeds the limit of [3] reads per transaction",
+			},
+		},
+		{
+			name:   "invalid: one write over the limit, counting the patches",
+			tx:     ops(0, 2, 2, 0, 0),
+			limits: limits,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_TX_TOO_MANY_OPERATIONS,
+				ReasonIfInvalid: "the transaction has [4] writes, which exceeds the limit of [3] writes per transaction",
+			},
+		},
+		{
+			name:   "invalid: one delete over the limit, counting the range deletes",
+			tx:     ops(0, 0, 0, 3, 1),
+			limits: limits,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_TX_TOO_MANY_OPERATIONS,
+				ReasonIfInvalid: "the transaction has [4] deletes, which exceeds the limit of [3] deletes per transaction",
+			},
+		},
+		{
+			name:   "invalid: one operation over the limit",
+			tx:     ops(3, 3, 0, 3, 0),
+			limits: limits,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_TX_TOO_MANY_OPERATIONS,
+				ReasonIfInvalid: "the transaction has [9] operations, which exceeds the limit of [8] operations per transaction",
+			},
+		},
+		{
+			name: "invalid: operations are summed over the databases",
+			tx: &types.DataTx{
+				DbOperations: []*types.DBOperation{
+					ops(2, 0, 0, 0, 0).DbOperations[0],
+					ops(2, 0, 0, 0, 0).DbOperations[0],
+				},
+			},
+			limits: limits,
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_TX_TOO_MANY_OPERATIONS,
+				ReasonIfInvalid: "the transaction has [4] reads, which exceeds the limit of [3] reads per transaction",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := validateOperationLimits(tt.tx, tt.limits)
+			require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+		})
+	}
+}
+
+// TestValidateBlockWithOversizedTx validates a block as it arrives from a peer, whose oversized transaction is
+// invalidated while the rest of the block is validated as usual.
+func TestValidateBlockWithOversizedTx(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1"})
+	cert, signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	user, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+			},
+		},
+	})
+	require.NoError(t, err)
+	config, err := proto.Marshal(&types.ClusterConfig{
+		LedgerConfig: &types.LedgerConfig{
+			TxOperationLimits: &types.TxOperationLimits{MaxWrites: 2},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: string(identity.UserNamespace) + "user1", Value: user},
+			},
+		},
+		worldstate.ConfigDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: worldstate.ConfigKey, Value: config},
+			},
+		},
+	}, 1))
+
+	dataTx := func(txID string, keys ...string) *types.DataTxEnvelope {
+		op := &types.DBOperation{DbName: worldstate.DefaultDBName}
+		for _, key := range keys {
+			op.DataWrites = append(op.DataWrites, &types.DataWrite{Key: key, Value: []byte("value")})
+		}
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signer}, &types.DataTx{
+			MustSignUserIds: []string{"user1"},
+			TxId:            txID,
+			DbOperations:    []*types.DBOperation{op},
+		})
+	}
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number:       2,
+				RulesVersion: constants.OperationLimitsRulesVersion,
+			},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{
+					dataTx("tx1", "key1", "key2"),
+					dataTx("tx2", "key3", "key4", "key5"),
+					dataTx("tx3", "key3"),
+				},
+			},
+		},
+	}
+	results, err := env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+
+	expectedResults := []*types.ValidationInfo{
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag:            types.Flag_INVALID_TX_TOO_MANY_OPERATIONS,
+			ReasonIfInvalid: "the transaction has [3] writes, which exceeds the limit of [2] writes per transaction",
+		},
+		{
+			// the writes of the invalid transaction do not conflict with the following ones
+			Flag: types.Flag_VALID,
+		},
+	}
+	require.Len(t, results, len(expectedResults))
+	for i := range expectedResults {
+		require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+	}
+
+	// a block of older rules ignores the limits, as the nodes that produced it did
+	block.Header.BaseHeader.RulesVersion = constants.TxDependenciesRulesVersion
+	results, err = env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, results[1].Flag, "actual: %v", results[1])
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/jsonschema"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// schemaCache holds the compiled schema of each database, so that a schema is compiled once rather than per
+// transaction. An entry is keyed by the version of the committed descriptor it was compiled from, and is compiled anew
+// when the descriptor changes.
+type schemaCache struct {
+	mu      sync.Mutex
+	schemas map[string]*compiledSchema
+}
+
+type compiledSchema struct {
+	version *types.Version
+	schema  *jsonschema.Schema
+}
+
+func newSchemaCache() *schemaCache {
+	return &schemaCache{
+		schemas: make(map[string]*compiledSchema),
+	}
+}
+
+// get returns the compiled schema registered on the database in the committed descriptor, and its version. It returns
+// a nil schema when the database has no schema.
+func (c *schemaCache) get(db worldstate.DB, dbName string) (*jsonschema.Schema, *types.Version, error) {
+	value, metadata, err := db.Get(worldstate.DBDescriptorsDBName, dbName)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "error while fetching the descriptor of the database [%s]", dbName)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if metadata == nil {
+		delete(c.schemas, dbName)
+		return nil, nil, nil
+	}
+
+	version := metadata.GetVersion()
+	if cached, ok := c.schemas[dbName]; ok && proto.Equal(cached.version, version) {
+		return cached.schema, version, nil
+	}
+
+	descriptor := &types.DBDescriptor{}
+	if err := proto.Unmarshal(value, descriptor); err != nil {
+		return nil, nil, errors.Wrapf(err, "error while unmarshaling the descriptor of the database [%s]", dbName)
+	}
+
+	var schema *jsonschema.Schema
+	if descriptor.GetJsonSchema() != "" {
+		// the schema was validated when it was registered, hence, it compiles
+		if schema, err = jsonschema.Compile([]byte(descriptor.GetJsonSchema())); err != nil {
+			return nil, nil, errors.WithMessagef(err, "error while compiling the schema of the database [%s]", dbName)
+		}
+	}
+	c.schemas[dbName] = &compiledSchema{version: version, schema: schema}
+
+	return schema, version, nil
+}
@@ -6,6 +6,7 @@ package txvalidation
 import (
 	"fmt"
 
+	"github.com/hyperledger-labs/orion-server/internal/identity"
 	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/marshal"
@@ -16,7 +17,10 @@ import (
 
 type txSigValidator struct {
 	sigVerifier *cryptoservice.SignatureVerifier
-	logger      *logger.SugarLogger
+	// identityQuerier fetches the certificate that a signature is verified against, when the validation is traced
+	identityQuerier *identity.Querier
+	tracer          *tracer
+	logger          *logger.SugarLogger
 }
 
 func (s *txSigValidator) validate(
@@ -30,15 +34,22 @@ func (s *txSigValidator) validate(
 		return nil, errors.Wrapf(err, "failed to Marshal Tx: %s", txPayload)
 	}
 
+	valInfo := &types.ValidationInfo{Flag: types.Flag_VALID}
 	err = s.sigVerifier.Verify(user, signature, requestBytes)
 	if err != nil {
 		s.logger.Debugf("Failed to verify Tx (Flag_INVALID_UNAUTHORISED): user: %s, sig: %x, payload: %s, error: %s",
 			user, signature, txPayload, err)
-		return &types.ValidationInfo{
+		valInfo = &types.ValidationInfo{
 			Flag:            types.Flag_INVALID_UNAUTHORISED,
 			ReasonIfInvalid: fmt.Sprintf("signature verification failed: %s", err.Error()),
-		}, nil
+		}
 	}
 
-	return &types.ValidationInfo{Flag: types.Flag_VALID}, nil
+	if s.tracer.enabled() {
+		// a user who does not exist has no certificate
+		u, _, _ := s.identityQuerier.GetUser(user)
+		s.tracer.recordSignature(user, u.GetCertificate(), valInfo)
+	}
+
+	return valInfo, nil
 }
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// TraceSink collects the checks performed by the validator on each transaction of a block. A validator created
+// without a trace sink does not build any trace, and hence, the sink costs nothing on the commit path.
+type TraceSink interface {
+	// BeginTx is called before the checks of the transaction at the given index of the block are recorded.
+	BeginTx(txNum int, txID string)
+	// Check records a check performed on the current transaction.
+	Check(check *TraceCheck)
+}
+
+// TraceCheck describes a single check performed by the validator: the inputs it consulted, e.g., the versions read,
+// the certificates used, and the ACLs evaluated, and its outcome.
+type TraceCheck struct {
+	Name    string            `json:"name"`
+	Inputs  map[string]string `json:"inputs,omitempty"`
+	Outcome string            `json:"outcome"`
+	Reason  string            `json:"reason,omitempty"`
+}
+
+// TxTrace holds the checks performed on a transaction, along with the flag recorded in the block when the block was
+// committed and the flag of the re-run.
+type TxTrace struct {
+	TxNum           int           `json:"txNum"`
+	TxID            string        `json:"txId"`
+	Checks          []*TraceCheck `json:"checks"`
+	RecordedFlag    string        `json:"recordedFlag"`
+	Flag            string        `json:"flag"`
+	ReasonIfInvalid string        `json:"reasonIfInvalid,omitempty"`
+}
+
+// TraceReport is the decision trace of a block
+type TraceReport struct {
+	BlockNumber  uint64     `json:"blockNumber"`
+	Transactions []*TxTrace `json:"transactions"`
+	// Matches is true if the flag of every transaction in the re-run equals the flag recorded in the block
+	Matches bool `json:"matches"`
+}
+
+// TraceRecorder is a TraceSink which keeps the checks in memory to build a TraceReport.
+type TraceRecorder struct {
+	txs []*TxTrace
+	cur *TxTrace
+}
+
+// NewTraceRecorder creates a TraceRecorder
+func NewTraceRecorder() *TraceRecorder {
+	return &TraceRecorder{}
+}
+
+// BeginTx starts the trace of a transaction, or continues it if the transaction was traced before, e.g., when the
+// signatures of all transactions of the block are validated ahead of their other checks.
+func (r *TraceRecorder) BeginTx(txNum int, txID string) {
+	for _, t := range r.txs {
+		if t.TxNum == txNum {
+			r.cur = t
+			return
+		}
+	}
+
+	r.cur = &TxTrace{
+		TxNum: txNum,
+		TxID:  txID,
+	}
+	r.txs = append(r.txs, r.cur)
+}
+
+// Check adds a check to the trace of the current transaction
+func (r *TraceRecorder) Check(check *TraceCheck) {
+	if r.cur == nil {
+		r.BeginTx(0, "")
+	}
+	r.cur.Checks = append(r.cur.Checks, check)
+}
+
+// Report builds the report of the block from the recorded checks and the validation info returned by the re-run.
+func (r *TraceRecorder) Report(block *types.Block, valInfo []*types.ValidationInfo) *TraceReport {
+	report := &TraceReport{
+		BlockNumber: block.GetHeader().GetBaseHeader().GetNumber(),
+		Matches:     true,
+	}
+
+	recorded := block.GetHeader().GetValidationInfo()
+	for txNum, info := range valInfo {
+		var txTrace *TxTrace
+		for _, t := range r.txs {
+			if t.TxNum == txNum {
+				txTrace = t
+				break
+			}
+		}
+		if txTrace == nil {
+			txTrace = &TxTrace{TxNum: txNum}
+		}
+
+		txTrace.Flag = info.GetFlag().String()
+		txTrace.ReasonIfInvalid = info.GetReasonIfInvalid()
+		if txNum < len(recorded) {
+			txTrace.RecordedFlag = recorded[txNum].GetFlag().String()
+		}
+		if txTrace.RecordedFlag != txTrace.Flag {
+			report.Matches = false
+		}
+		report.Transactions = append(report.Transactions, txTrace)
+	}
+
+	return report
+}
+
+// tracedValid is the outcome of the checks that passed, shared by the calls to the tracer so that a check which
+// passes allocates nothing when the tracer is nil. It must never be returned by a validator.
+var tracedValid = &types.ValidationInfo{Flag: types.Flag_VALID}
+
+// tracer passes the checks of the validators to the trace sink. The validators hold a nil tracer unless a trace sink
+// is configured, and every method of a nil tracer returns immediately. As building the inputs of a check has a cost
+// of its own, the callers build them only when the tracer is enabled.
+type tracer struct {
+	sink TraceSink
+}
+
+func newTracer(sink TraceSink) *tracer {
+	if sink == nil {
+		return nil
+	}
+	return &tracer{sink: sink}
+}
+
+func (t *tracer) enabled() bool {
+	return t != nil
+}
+
+func (t *tracer) beginTx(txNum int, txID string) {
+	if t == nil {
+		return
+	}
+	t.sink.BeginTx(txNum, txID)
+}
+
+// record records the check with the outcome given by the validation info
+func (t *tracer) record(name string, inputs map[string]string, valInfo *types.ValidationInfo) {
+	if t == nil {
+		return
+	}
+	t.sink.Check(&TraceCheck{
+		Name:    name,
+		Inputs:  inputs,
+		Outcome: valInfo.GetFlag().String(),
+		Reason:  valInfo.GetReasonIfInvalid(),
+	})
+}
+
+// recordSignature records the verification of the signature of a user along with the certificate of the user it was
+// verified against
+func (t *tracer) recordSignature(userID string, certificate []byte, valInfo *types.ValidationInfo) {
+	if t == nil {
+		return
+	}
+	t.record("signature", map[string]string{
+		"user":        userID,
+		"certificate": traceCertificate(certificate),
+	}, valInfo)
+}
+
+// recordDB records a check on the operations of the transaction on a database
+func (t *tracer) recordDB(name, dbName string, valInfo *types.ValidationInfo) {
+	if t == nil {
+		return
+	}
+	t.record(name, map[string]string{"db": dbName}, valInfo)
+}
+
+// recordKey records a check on a key operated by the transaction
+func (t *tracer) recordKey(name, dbName, key string, valInfo *types.ValidationInfo) {
+	if t == nil {
+		return
+	}
+	t.record(name, map[string]string{"db": dbName, "key": key}, valInfo)
+}
+
+// recordPrivilege records the check of a privilege of a user, e.g., the administration privilege or the read-write
+// privilege on a database
+func (t *tracer) recordPrivilege(userID, privilege string, granted bool, valInfo *types.ValidationInfo) {
+	if t == nil {
+		return
+	}
+	t.record("privilege", map[string]string{
+		"user":      userID,
+		"privilege": privilege,
+		"granted":   strconv.FormatBool(granted),
+	}, valInfo)
+}
+
+// recordACL records the evaluation of the ACL of a key against the users who signed the transaction
+func (t *tracer) recordACL(name, dbName, key string, acl *types.AccessControl, userIDs []string, valInfo *types.ValidationInfo) {
+	if t == nil {
+		return
+	}
+	t.record(name, map[string]string{
+		"db":    dbName,
+		"key":   key,
+		"acl":   traceACL(acl),
+		"users": "[" + strings.Join(userIDs, ", ") + "]",
+	}, valInfo)
+}
+
+// recordVersion records the comparison of the version read by the transaction with the version of the key, either
+// committed or written by a previous transaction in the block
+func (t *tracer) recordVersion(name, dbName, key string, readVersion, actualVersion *types.Version, valInfo *types.ValidationInfo) {
+	if t == nil {
+		return
+	}
+	t.record(name, map[string]string{
+		"db":            dbName,
+		"key":           key,
+		"readVersion":   traceVersion(readVersion),
+		"actualVersion": traceVersion(actualVersion),
+	}, valInfo)
+}
+
+func traceVersion(version *types.Version) string {
+	if version == nil {
+		return "none"
+	}
+	return fmt.Sprintf("{blockNum: %d, txNum: %d}", version.BlockNum, version.TxNum)
+}
+
+func traceACL(acl *types.AccessControl) string {
+	if acl == nil {
+		return "none"
+	}
+
+	users := func(m map[string]bool) string {
+		var ids []string
+		for id := range m {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return "[" + strings.Join(ids, ", ") + "]"
+	}
+	return fmt.Sprintf("{readUsers: %s, readWriteUsers: %s, signPolicyForWrite: %s}",
+		users(acl.ReadUsers), users(acl.ReadWriteUsers), acl.SignPolicyForWrite)
+}
+
+// traceCertificate identifies a certificate by the SHA-256 fingerprint of its DER bytes
+func traceCertificate(der []byte) string {
+	if len(der) == 0 {
+		return "none"
+	}
+	fingerprint := sha256.Sum256(der)
+	return "sha256:" + hex.EncodeToString(fingerprint[:])
+}
@@ -0,0 +1,28 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"fmt"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// validateDeadline checks the deadline set by the client against the number and the timestamp of the block which
+// holds the transaction. Both are fixed when the block is numbered, so that all the nodes reach the same result.
+func validateDeadline(tx *types.DataTx, baseHeader *types.BlockHeaderBase) *types.ValidationInfo {
+	deadline := tx.GetNotCommittedAfter()
+	if !deadline.PassedAt(baseHeader.GetNumber(), baseHeader.GetTimestamp()) {
+		return &types.ValidationInfo{
+			Flag: types.Flag_VALID,
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_INVALID_EXPIRED,
+		ReasonIfInvalid: fmt.Sprintf("the transaction must not be committed after block [%d] or timestamp [%d], "+
+			"but the block [%d] has the timestamp [%d]",
+			deadline.GetBlockNumber(), deadline.GetTimestamp(), baseHeader.GetNumber(), baseHeader.GetTimestamp()),
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// validateImportProof validates a user administration transaction generated by a node from a user import, which
+// carries the signed import request in place of a signature over the transaction. The transaction may only create
+// users whose digests are in the signed request. As the users must not exist, replaying the request, or any part of
+// it, creates no user twice.
+func (v *userAdminTxValidator) validateImportProof(txEnv *types.UserAdministrationTxEnvelope) (*types.ValidationInfo, error) {
+	tx := txEnv.Payload
+	proof := txEnv.ImportProof
+
+	if proof.GetRequest().GetUserId() != tx.UserId {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_UNAUTHORISED,
+			ReasonIfInvalid: "the import request of the user [" + proof.GetRequest().GetUserId() + "] cannot authorize a transaction of the user [" + tx.UserId + "]",
+		}, nil
+	}
+
+	valInfo, err := v.sigValidator.validate(tx.UserId, proof.Signature, proof.Request)
+	if err != nil || valInfo.Flag != types.Flag_VALID {
+		return valInfo, err
+	}
+
+	if len(tx.UserReads) > 0 || len(tx.UserDeletes) > 0 {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "a transaction of a user import can only hold user writes",
+		}, nil
+	}
+
+	digests := make(map[string]bool, len(proof.Request.UserDigests))
+	for _, d := range proof.Request.UserDigests {
+		digests[string(d)] = true
+	}
+
+	for _, w := range tx.UserWrites {
+		if w.GetUser() == nil || w.Acl != nil {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a write of a user import must hold a user and no access control",
+			}, nil
+		}
+
+		digest, err := types.UserImportDigest(w.User)
+		if err != nil {
+			return nil, err
+		}
+		if !digests[string(digest)] {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the user [" + w.User.Id + "] is not part of the signed import request",
+			}, nil
+		}
+
+		exists, err := v.identityQuerier.DoesUserExist(w.User.Id)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error while checking the existence of the user [%s]", w.User.Id)
+		}
+		if exists {
+			return &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the user [" + w.User.Id + "] already exists, a user import can only create users",
+			}, nil
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}, nil
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUserImportTx(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"adminUser", "nonAdminUser", "user1", "user2"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "adminUser")
+	_, nonAdminSigner := testutils.LoadTestCrypto(t, cryptoDir, "nonAdminUser")
+	user1Cert, _ := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	user2Cert, _ := testutils.LoadTestCrypto(t, cryptoDir, "user2")
+	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
+
+	adminUserSerialized, err := proto.Marshal(&types.User{
+		Id:          "adminUser",
+		Certificate: adminCert.Raw,
+		Privilege: &types.Privilege{
+			Admin: true,
+		},
+	})
+	require.NoError(t, err)
+
+	user1 := &types.User{Id: "user1", Certificate: user1Cert.Raw}
+	user2 := &types.User{Id: "user2", Certificate: user2Cert.Raw}
+	user1Serialized, err := proto.Marshal(user1)
+	require.NoError(t, err)
+
+	request, err := types.NewUserImportRequest("adminUser", "import1", []*types.User{user1, user2})
+	require.NoError(t, err)
+	proof := &types.UserImportProof{
+		Request:   request,
+		Signature: testutils.SignatureFromTx(t, adminSigner, request),
+	}
+
+	importTx := func(proof *types.UserImportProof, writes ...*types.UserWrite) *types.UserAdministrationTxEnvelope {
+		return &types.UserAdministrationTxEnvelope{
+			Payload: &types.UserAdministrationTx{
+				UserId:     "adminUser",
+				TxId:       "import1-0",
+				UserWrites: writes,
+			},
+			ImportProof: proof,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		existingUsers  []*worldstate.KVWithMetadata
+		txEnv          *types.UserAdministrationTxEnvelope
+		expectedResult *types.ValidationInfo
+	}{
+		{
+			name:  "valid",
+			txEnv: importTx(proof, &types.UserWrite{User: user1}, &types.UserWrite{User: user2}),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name:  "valid: part of the users of the request",
+			txEnv: importTx(proof, &types.UserWrite{User: user2}),
+			expectedResult: &types.ValidationInfo{
+				Flag: types.Flag_VALID,
+			},
+		},
+		{
+			name: "invalid: request of another user",
+			txEnv: func() *types.UserAdministrationTxEnvelope {
+				env := importTx(proof, &types.UserWrite{User: user1})
+				env.Payload.UserId = "nonAdminUser"
+				return env
+			}(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "the import request of the user [adminUser] cannot authorize a transaction of the user [nonAdminUser]",
+			},
+		},
+		{
+			name: "invalid: request not signed by the importing user",
+			txEnv: importTx(&types.UserImportProof{
+				Request:   request,
+				Signature: testutils.SignatureFromTx(t, nonAdminSigner, request),
+			}, &types.UserWrite{User: user1}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_UNAUTHORISED,
+				ReasonIfInvalid: "signature verification failed: x509: ECDSA verification failure",
+			},
+		},
+		{
+			name: "invalid: user not in the request",
+			txEnv: importTx(proof, &types.UserWrite{User: &types.User{
+				Id:          "user1",
+				Certificate: user1Cert.Raw,
+				Privilege:   &types.Privilege{Admin: true},
+			}}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the user [user1] is not part of the signed import request",
+			},
+		},
+		{
+			name: "invalid: user with access control",
+			txEnv: importTx(proof, &types.UserWrite{
+				User: user1,
+				Acl:  &types.AccessControl{ReadUsers: map[string]bool{"user2": true}},
+			}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a write of a user import must hold a user and no access control",
+			},
+		},
+		{
+			name: "invalid: delete in the transaction",
+			txEnv: func() *types.UserAdministrationTxEnvelope {
+				env := importTx(proof, &types.UserWrite{User: user1})
+				env.Payload.UserDeletes = []*types.UserDelete{{UserId: "user2"}}
+				return env
+			}(),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "a transaction of a user import can only hold user writes",
+			},
+		},
+		{
+			name: "invalid: user already exists",
+			existingUsers: []*worldstate.KVWithMetadata{
+				{
+					Key:   string(identity.UserNamespace) + "user1",
+					Value: user1Serialized,
+				},
+			},
+			txEnv: importTx(proof, &types.UserWrite{User: user1}),
+			expectedResult: &types.ValidationInfo{
+				Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+				ReasonIfInvalid: "the user [user1] already exists, a user import can only create users",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+			setupClusterConfigCA(t, env, caCert)
+			require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+				worldstate.UsersDBName: {
+					Writes: append([]*worldstate.KVWithMetadata{
+						{
+							Key:   string(identity.UserNamespace) + "adminUser",
+							Value: adminUserSerialized,
+						},
+					}, tt.existingUsers...),
+				},
+			}, 1))
+
+			result, err := env.validator.userAdminTxValidator.validate(tt.txEnv)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expectedResult, result), "expected: %v, actual: %v", tt.expectedResult, result)
+		})
+	}
+}
@@ -17,11 +17,18 @@ type userAdminTxValidator struct {
 	db              worldstate.DB
 	identityQuerier *identity.Querier
 	sigValidator    *txSigValidator
+	tracer          *tracer
 	logger          *logger.SugarLogger
 }
 
 func (v *userAdminTxValidator) validate(txEnv *types.UserAdministrationTxEnvelope) (*types.ValidationInfo, error) {
-	valInfo, err := v.sigValidator.validate(txEnv.Payload.UserId, txEnv.Signature, txEnv.Payload)
+	var valInfo *types.ValidationInfo
+	var err error
+	if txEnv.ImportProof != nil {
+		valInfo, err = v.validateImportProof(txEnv)
+	} else {
+		valInfo, err = v.sigValidator.validate(txEnv.Payload.UserId, txEnv.Signature, txEnv.Payload)
+	}
 	if err != nil || valInfo.Flag != types.Flag_VALID {
 		return valInfo, err
 	}
@@ -32,11 +39,14 @@ func (v *userAdminTxValidator) validate(txEnv *types.UserAdministrationTxEnvelop
 		return nil, errors.WithMessagef(err, "error while checking user administrative privilege for user [%s]", tx.UserId)
 	}
 	if !hasPerm {
-		return &types.ValidationInfo{
+		vi := &types.ValidationInfo{
 			Flag:            types.Flag_INVALID_NO_PERMISSION,
 			ReasonIfInvalid: "the user [" + tx.UserId + "] has no privilege to perform user administrative operations",
-		}, nil
+		}
+		v.tracer.recordPrivilege(tx.UserId, "admin", false, vi)
+		return vi, nil
 	}
+	v.tracer.recordPrivilege(tx.UserId, "admin", true, tracedValid)
 
 	r, err := v.validateFieldsInUserWrites(tx.UserWrites)
 	if err != nil {
@@ -81,6 +91,42 @@ func (v *userAdminTxValidator) validate(txEnv *types.UserAdministrationTxEnvelop
 	return v.mvccValidation(tx.UserReads)
 }
 
+// conflictWithinBlock checks whether a user administration transaction of a mini-batch touches a user that a previous
+// transaction of the block has written or deleted. As the transactions of a mini-batch are validated against the
+// state committed before the block, such a transaction, including one submitted by a user whose privileges were
+// changed by a previous transaction, is invalidated rather than validated against a stale state.
+func conflictWithinBlock(tx *types.UserAdministrationTx, modifiedUsers map[string]bool) *types.ValidationInfo {
+	conflict := func(userID string) *types.ValidationInfo {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+			ReasonIfInvalid: "the user [" + userID + "] is already modified by some previous transaction in the block",
+		}
+	}
+
+	if modifiedUsers[tx.GetUserId()] {
+		return conflict(tx.GetUserId())
+	}
+	for _, r := range tx.GetUserReads() {
+		if modifiedUsers[r.GetUserId()] {
+			return conflict(r.GetUserId())
+		}
+	}
+	for _, w := range tx.GetUserWrites() {
+		if modifiedUsers[w.GetUser().GetId()] {
+			return conflict(w.GetUser().GetId())
+		}
+	}
+	for _, d := range tx.GetUserDeletes() {
+		if modifiedUsers[d.GetUserId()] {
+			return conflict(d.GetUserId())
+		}
+	}
+
+	return &types.ValidationInfo{
+		Flag: types.Flag_VALID,
+	}
+}
+
 func (v *userAdminTxValidator) validateFieldsInUserWrites(userWrites []*types.UserWrite) (*types.ValidationInfo, error) {
 	config, _, err := v.db.GetConfig()
 	if err != nil {
@@ -4,10 +4,15 @@
 package txvalidation
 
 import (
+	"sort"
+	"strings"
 	"sync"
 
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/utils"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
@@ -22,21 +27,44 @@ type Validator struct {
 	dbAdminTxValidator   *dbAdminTxValidator
 	userAdminTxValidator *userAdminTxValidator
 	dataTxValidator      *dataTxValidator
+	heartbeatTxValidator *heartbeatTxValidator
+	voidTxValidator      *voidTxValidator
 	signValidator        *txSigValidator
+	blockStore           *blockstore.Store
+	executionMode        ExecutionMode
+	tracer               *tracer
 	logger               *logger.SugarLogger
 }
 
+// ExecutionMode holds the switches that govern how blocks are validated and committed. It is held by the Validator
+// and read from it by the block processor, so that every component of the commit pipeline follows the same mode.
+type ExecutionMode struct {
+	// Deterministic runs the validation and commit of blocks strictly sequentially: signatures are validated one
+	// transaction after the other, the read versions are fetched per read instead of in bulk, and the commits of
+	// consecutive blocks are never coalesced.
+	Deterministic bool
+}
+
 type Config struct {
-	DB     worldstate.DB
-	Logger *logger.SugarLogger
+	DB worldstate.DB
+	// BlockStore is used to look up the committed transactions on which the data transactions depend
+	BlockStore    *blockstore.Store
+	ExecutionMode ExecutionMode
+	// TraceSink, if set, receives the checks performed on each transaction. As the sink is not expected to be
+	// safe for concurrent use, the signatures of the transactions are then validated sequentially.
+	TraceSink TraceSink
+	Logger    *logger.SugarLogger
 }
 
 // NewValidator creates a new Validator
 func NewValidator(conf *Config) *Validator {
 	idQuerier := identity.NewQuerier(conf.DB)
+	tr := newTracer(conf.TraceSink)
 	txSigValidator := &txSigValidator{
-		sigVerifier: cryptoservice.NewVerifier(idQuerier, conf.Logger),
-		logger:      conf.Logger,
+		sigVerifier:     cryptoservice.NewVerifier(idQuerier, conf.Logger),
+		identityQuerier: idQuerier,
+		tracer:          tr,
+		logger:          conf.Logger,
 	}
 
 	return &Validator{
@@ -44,6 +72,7 @@ func NewValidator(conf *Config) *Validator {
 			db:              conf.DB,
 			identityQuerier: idQuerier,
 			sigValidator:    txSigValidator,
+			tracer:          tr,
 			logger:          conf.Logger,
 		},
 
@@ -51,6 +80,7 @@ func NewValidator(conf *Config) *Validator {
 			db:              conf.DB,
 			identityQuerier: idQuerier,
 			sigValidator:    txSigValidator,
+			tracer:          tr,
 			logger:          conf.Logger,
 		},
 
@@ -58,6 +88,7 @@ func NewValidator(conf *Config) *Validator {
 			db:              conf.DB,
 			identityQuerier: idQuerier,
 			sigValidator:    txSigValidator,
+			tracer:          tr,
 			logger:          conf.Logger,
 		},
 
@@ -65,10 +96,27 @@ func NewValidator(conf *Config) *Validator {
 			db:              conf.DB,
 			identityQuerier: idQuerier,
 			sigValidator:    txSigValidator,
+			schemas:         newSchemaCache(),
+			tracer:          tr,
+			logger:          conf.Logger,
+		},
+
+		heartbeatTxValidator: &heartbeatTxValidator{
+			identityQuerier: idQuerier,
+			logger:          conf.Logger,
+		},
+
+		voidTxValidator: &voidTxValidator{
+			identityQuerier: idQuerier,
+			sigValidator:    txSigValidator,
+			tracer:          tr,
 			logger:          conf.Logger,
 		},
 
 		signValidator: txSigValidator,
+		blockStore:    conf.BlockStore,
+		executionMode: conf.ExecutionMode,
+		tracer:        tr,
 
 		logger: conf.Logger,
 	}
@@ -77,44 +125,171 @@ func NewValidator(conf *Config) *Validator {
 // ValidateBlock validates each transaction present in the block to ensure
 // the request isolation level
 func (v *Validator) ValidateBlock(block *types.Block) ([]*types.ValidationInfo, error) {
+	return v.validateBlock(block, nil)
+}
+
+// TxResultHandler is called with the validation result of a transaction of a block once the result is final
+type TxResultHandler func(txNum int, valInfo *types.ValidationInfo)
+
+// ValidateBlockStreaming validates the block as ValidateBlock does, and calls the handler with the result of each
+// transaction, in the order of the transactions, as soon as the result is final, which is before the validation of the
+// subsequent transactions completes. The handler is called from the validating goroutine, and it must not modify the
+// result, except for the fields the validator does not set, e.g., the write-set digest. All the results are handed
+// out before the validation returns, unless the validation fails, in which case only some may be.
+func (v *Validator) ValidateBlockStreaming(block *types.Block, handler TxResultHandler) ([]*types.ValidationInfo, error) {
+	results := &txResults{handler: handler}
+	valInfo, err := v.validateBlock(block, results)
+	if err != nil {
+		return nil, err
+	}
+
+	results.flush(valInfo)
+	return valInfo, nil
+}
+
+func (v *Validator) validateBlock(block *types.Block, results *txResults) ([]*types.ValidationInfo, error) {
 	if block.Header.BaseHeader.Number == 1 {
 		// for the genesis block, which is created by the node itself, we cannot
 		// do a regular validation, but we still need to validate the entries.
-		return v.configTxValidator.validateGenesis(block.GetConfigTxEnvelope())
+		v.tracer.beginTx(0, block.GetConfigTxEnvelope().GetPayload().GetTxId())
+		valInfo, err := v.configTxValidator.validateGenesis(block.GetConfigTxEnvelope())
+		if err == nil {
+			v.tracer.record("genesis configuration", nil, valInfo[0])
+		}
+		return valInfo, err
 	}
 
 	switch block.Payload.(type) {
 	case *types.Block_DataTxEnvelopes:
 		dataTxEnvs := block.GetDataTxEnvelopes().Envelopes
-		valInfoArray, usersWithValidSigPerTX, err := v.parallelSigValidation(dataTxEnvs)
+		sigValidation := v.parallelSigValidation
+		if v.executionMode.Deterministic || v.tracer.enabled() {
+			sigValidation = v.sequentialSigValidation
+		}
+		valInfoArray, usersWithValidSigPerTX, err := sigValidation(dataTxEnvs)
 		if err != nil {
 			return nil, err
 		}
 
+		// each rule is checked only in the blocks validated with the rules that introduced it, so that a block produced
+		// by an older node, e.g., one that did not expire transactions, is validated the way that node did
+		baseHeader := block.GetHeader().GetBaseHeader()
+		rulesVersion := utils.RulesVersion(baseHeader)
+		if rulesVersion >= constants.TxDeadlinesRulesVersion {
+			for txNum, txEnv := range dataTxEnvs {
+				if valInfoArray[txNum].Flag != types.Flag_VALID {
+					continue
+				}
+				if valRes := validateDeadline(txEnv.Payload, baseHeader); valRes.Flag != types.Flag_VALID {
+					v.tracer.beginTx(txNum, txEnv.Payload.TxId)
+					v.tracer.record("deadline", nil, valRes)
+					v.logger.Debugf("data transaction [%s] is invalid due to [%s]", txEnv.Payload.TxId, valRes.ReasonIfInvalid)
+					valInfoArray[txNum] = valRes
+				}
+			}
+		}
+
+		for txNum, txEnv := range dataTxEnvs {
+			if valInfoArray[txNum].Flag != types.Flag_VALID || !utils.IsAttestation(txEnv.Payload, baseHeader) {
+				continue
+			}
+			if valRes := validateAttestation(txEnv.Payload); valRes.Flag != types.Flag_VALID {
+				v.tracer.beginTx(txNum, txEnv.Payload.TxId)
+				v.tracer.record("attestation", nil, valRes)
+				v.logger.Debugf("data transaction [%s] is invalid due to [%s]", txEnv.Payload.TxId, valRes.ReasonIfInvalid)
+				valInfoArray[txNum] = valRes
+			}
+		}
+
+		// the operation limits are enforced before any read version is fetched, so that an oversized transaction,
+		// e.g., in a block of a peer, cannot inflate the structures that track the operations of the block
+		if rulesVersion >= constants.OperationLimitsRulesVersion {
+			limits, err := operationLimits(v.dataTxValidator.db)
+			if err != nil {
+				return nil, err
+			}
+			for txNum, txEnv := range dataTxEnvs {
+				if valInfoArray[txNum].Flag != types.Flag_VALID {
+					continue
+				}
+				if valRes := validateOperationLimits(txEnv.Payload, limits); valRes.Flag != types.Flag_VALID {
+					v.tracer.beginTx(txNum, txEnv.Payload.TxId)
+					v.tracer.record("operation limits", nil, valRes)
+					v.logger.Debugf("data transaction [%s] is invalid due to [%s]", txEnv.Payload.TxId, valRes.ReasonIfInvalid)
+					valInfoArray[txNum] = valRes
+				}
+			}
+		}
+
 		pendingOps := newPendingOperations()
+		// in the deterministic mode, the MVCC validation of each transaction fetches the versions of its reads
+		if !v.executionMode.Deterministic {
+			if err := v.prefetchReadVersions(dataTxEnvs, valInfoArray, pendingOps); err != nil {
+				return nil, err
+			}
+		}
+		dependencies := newTxDependencies(block.Header.BaseHeader.Number, dataTxEnvs, valInfoArray, v.blockStore)
 		for txNum, txEnv := range dataTxEnvs {
 			if valInfoArray[txNum].Flag != types.Flag_VALID {
+				results.final(txNum, valInfoArray[txNum])
 				continue
 			}
 
-			valRes, err := v.dataTxValidator.validate(txEnv, usersWithValidSigPerTX[txNum], pendingOps)
-			if err != nil {
-				return nil, errors.WithMessage(err, "error while validating data transaction")
+			v.tracer.beginTx(txNum, txEnv.Payload.TxId)
+			valRes := &types.ValidationInfo{Flag: types.Flag_VALID}
+			var dependency *types.TxDependency
+			if rulesVersion >= constants.TxDependenciesRulesVersion {
+				valRes, dependency, err = dependencies.validate(txNum)
+				if err != nil {
+					return nil, errors.WithMessage(err, "error while validating the dependency of data transaction")
+				}
+			}
+			if v.tracer.enabled() && dependency != nil {
+				v.tracer.record("dependency", map[string]string{"txID": dependency.TxId}, valRes)
 			}
+			if valRes.Flag == types.Flag_VALID {
+				valRes, err = v.dataTxValidator.validate(txEnv, usersWithValidSigPerTX[txNum], pendingOps, rulesVersion)
+				if err != nil {
+					return nil, errors.WithMessage(err, "error while validating data transaction")
+				}
+			}
+			valRes.Dependency = dependency
 
 			valInfoArray[txNum] = valRes
+			results.final(txNum, valRes)
 			if valRes.Flag != types.Flag_VALID {
 				v.logger.Debugf("data transaction [%v] is invalid due to [%s]", txEnv.Payload, valRes.ReasonIfInvalid)
 				continue
 			}
 
+			txVersion := &types.Version{
+				BlockNum: block.Header.BaseHeader.Number,
+				TxNum:    uint64(txNum),
+			}
+			if utils.IsOrdered(txEnv.Payload, baseHeader) {
+				pendingOps.addSequence(txEnv.Payload.MustSignUserIds[0], txEnv.Payload.Sequence)
+			}
 			for _, ops := range txEnv.Payload.DbOperations {
 				for _, w := range ops.DataWrites {
-					pendingOps.addWrite(ops.DbName, w.Key)
+					pendingOps.addWrite(ops.DbName, w.Key, txVersion)
 				}
 
 				for _, d := range ops.DataDeletes {
-					pendingOps.addDelete(ops.DbName, d.Key)
+					pendingOps.addDelete(ops.DbName, d.Key, txVersion)
+				}
+
+				for _, p := range ops.DataPatches {
+					pendingOps.addWrite(ops.DbName, p.Key, txVersion)
+				}
+
+				for _, r := range ops.DataDeleteRanges {
+					keys, err := worldstate.DeleteRangeKeys(v.dataTxValidator.db, ops.DbName, r, 0)
+					if err != nil {
+						return nil, errors.WithMessage(err, "error while fetching the keys of the range delete")
+					}
+					for _, key := range keys {
+						pendingOps.addDelete(ops.DbName, key, txVersion)
+					}
 				}
 			}
 		}
@@ -123,10 +298,12 @@ func (v *Validator) ValidateBlock(block *types.Block) ([]*types.ValidationInfo,
 
 	case *types.Block_UserAdministrationTxEnvelope:
 		userTxEnv := block.GetUserAdministrationTxEnvelope()
+		v.tracer.beginTx(0, userTxEnv.GetPayload().GetTxId())
 		valRes, err := v.userAdminTxValidator.validate(userTxEnv)
 		if err != nil {
 			return nil, errors.WithMessage(err, "error while validating user administrative transaction")
 		}
+		v.tracer.record("user administration", nil, valRes)
 
 		if valRes.Flag != types.Flag_VALID {
 			v.logger.Debugf("user administration transaction [%v] is invalid due to [%s]", userTxEnv.Payload, valRes.ReasonIfInvalid)
@@ -136,12 +313,45 @@ func (v *Validator) ValidateBlock(block *types.Block) ([]*types.ValidationInfo,
 			valRes,
 		}, nil
 
+	case *types.Block_UserAdministrationTxEnvelopes:
+		modifiedUsers := make(map[string]bool)
+		var valInfoArray []*types.ValidationInfo
+		for txNum, txEnv := range block.GetUserAdministrationTxEnvelopes().Envelopes {
+			v.tracer.beginTx(txNum, txEnv.GetPayload().GetTxId())
+			valRes := conflictWithinBlock(txEnv.Payload, modifiedUsers)
+			if valRes.Flag == types.Flag_VALID {
+				var err error
+				valRes, err = v.userAdminTxValidator.validate(txEnv)
+				if err != nil {
+					return nil, errors.WithMessage(err, "error while validating user administrative transaction")
+				}
+			}
+			v.tracer.record("user administration", nil, valRes)
+
+			if valRes.Flag != types.Flag_VALID {
+				v.logger.Debugf("user administration transaction [%v] is invalid due to [%s]", txEnv.Payload, valRes.ReasonIfInvalid)
+			} else {
+				for _, w := range txEnv.Payload.UserWrites {
+					modifiedUsers[w.GetUser().GetId()] = true
+				}
+				for _, d := range txEnv.Payload.UserDeletes {
+					modifiedUsers[d.GetUserId()] = true
+				}
+			}
+			valInfoArray = append(valInfoArray, valRes)
+			results.final(txNum, valRes)
+		}
+
+		return valInfoArray, nil
+
 	case *types.Block_DbAdministrationTxEnvelope:
 		dbTxEnv := block.GetDbAdministrationTxEnvelope()
+		v.tracer.beginTx(0, dbTxEnv.GetPayload().GetTxId())
 		valRes, err := v.dbAdminTxValidator.validate(dbTxEnv)
 		if err != nil {
 			return nil, errors.WithMessage(err, "error while validating db administrative transaction")
 		}
+		v.tracer.record("database administration", nil, valRes)
 
 		if valRes.Flag != types.Flag_VALID {
 			v.logger.Debugf("database administration transaction [%v] is invalid due to [%s]", dbTxEnv.Payload, valRes.ReasonIfInvalid)
@@ -153,10 +363,12 @@ func (v *Validator) ValidateBlock(block *types.Block) ([]*types.ValidationInfo,
 
 	case *types.Block_ConfigTxEnvelope:
 		configTxEnv := block.GetConfigTxEnvelope()
+		v.tracer.beginTx(0, configTxEnv.GetPayload().GetTxId())
 		valRes, err := v.configTxValidator.Validate(configTxEnv)
 		if err != nil {
 			return nil, errors.WithMessage(err, "error while validating config transaction")
 		}
+		v.tracer.record("cluster configuration", nil, valRes)
 
 		if valRes.Flag != types.Flag_VALID {
 			v.logger.Debugf("cluster config transaction [%v] is invalid due to [%s]", configTxEnv, valRes.ReasonIfInvalid)
@@ -166,16 +378,86 @@ func (v *Validator) ValidateBlock(block *types.Block) ([]*types.ValidationInfo,
 			valRes,
 		}, nil
 
+	case *types.Block_HeartbeatTxEnvelopes:
+		var valInfoArray []*types.ValidationInfo
+		for txNum, txEnv := range block.GetHeartbeatTxEnvelopes().Envelopes {
+			v.tracer.beginTx(txNum, "")
+			valRes, err := v.heartbeatTxValidator.validate(txEnv, block.GetHeader().GetBaseHeader().GetNumber())
+			if err != nil {
+				return nil, errors.WithMessage(err, "error while validating heartbeat transaction")
+			}
+			if v.tracer.enabled() {
+				v.tracer.record("heartbeat", map[string]string{"node": txEnv.GetPayload().GetNodeId()}, valRes)
+			}
+
+			if valRes.Flag != types.Flag_VALID {
+				v.logger.Debugf("heartbeat transaction [%v] is invalid due to [%s]", txEnv.Payload, valRes.ReasonIfInvalid)
+			}
+			valInfoArray = append(valInfoArray, valRes)
+			results.final(txNum, valRes)
+		}
+
+		return valInfoArray, nil
+
+	case *types.Block_VoidTxEnvelopes:
+		pendingOps := newPendingOperations()
+		var valInfoArray []*types.ValidationInfo
+		for txNum, txEnv := range block.GetVoidTxEnvelopes().Envelopes {
+			v.tracer.beginTx(txNum, txEnv.GetPayload().GetTxId())
+			valRes, err := v.voidTxValidator.validate(txEnv, pendingOps)
+			if err != nil {
+				return nil, errors.WithMessage(err, "error while validating void transaction")
+			}
+			v.tracer.record("void", nil, valRes)
+
+			if valRes.Flag != types.Flag_VOIDED {
+				v.logger.Debugf("void transaction [%v] is invalid due to [%s]", txEnv.Payload, valRes.ReasonIfInvalid)
+			} else if seq := txEnv.Payload.Sequence; seq > 0 {
+				pendingOps.addSequence(txEnv.Payload.UserId, seq)
+			}
+			valInfoArray = append(valInfoArray, valRes)
+			results.final(txNum, valRes)
+		}
+
+		return valInfoArray, nil
+
 	default:
 		return nil, errors.Errorf("unexpected transaction envelope in the block")
 	}
 }
 
+// txResults hands out the final validation results of the transactions of a block, in the order of the transactions
+type txResults struct {
+	handler TxResultHandler
+	next    int
+}
+
+func (r *txResults) final(txNum int, valInfo *types.ValidationInfo) {
+	if r == nil || r.handler == nil {
+		return
+	}
+	r.handler(txNum, valInfo)
+	r.next = txNum + 1
+}
+
+// flush hands out the results that were not handed out as the block was validated, e.g., the result of the single
+// transaction of a block
+func (r *txResults) flush(valInfo []*types.ValidationInfo) {
+	for txNum := r.next; txNum < len(valInfo); txNum++ {
+		r.final(txNum, valInfo[txNum])
+	}
+}
+
 // ConfigValidator provides a pointer to the internal validator that verifies config transactions.
 func (v *Validator) ConfigValidator() *ConfigTxValidator {
 	return v.configTxValidator
 }
 
+// ExecutionMode returns the execution mode the validator was created with.
+func (v *Validator) ExecutionMode() ExecutionMode {
+	return v.executionMode
+}
+
 func (v *Validator) parallelSigValidation(dataTxEnvs []*types.DataTxEnvelope) ([]*types.ValidationInfo, [][]string, error) {
 	valInfoPerTx := make([]*types.ValidationInfo, len(dataTxEnvs))
 	usersWithValidSigPerTX := make([][]string, len(dataTxEnvs))
@@ -212,36 +494,183 @@ func (v *Validator) parallelSigValidation(dataTxEnvs []*types.DataTxEnvelope) ([
 	return valInfoPerTx, usersWithValidSigPerTX, nil
 }
 
+// sequentialSigValidation validates the signatures of the transactions one after the other, in the order of the
+// block, and is used in place of parallelSigValidation in the deterministic execution mode.
+func (v *Validator) sequentialSigValidation(dataTxEnvs []*types.DataTxEnvelope) ([]*types.ValidationInfo, [][]string, error) {
+	valInfoPerTx := make([]*types.ValidationInfo, len(dataTxEnvs))
+	usersWithValidSigPerTX := make([][]string, len(dataTxEnvs))
+
+	for txNum, txEnv := range dataTxEnvs {
+		v.tracer.beginTx(txNum, txEnv.Payload.TxId)
+		usersWithValidSignTx, vInfo, err := v.dataTxValidator.validateSignatures(txEnv)
+		if err != nil {
+			v.logger.Errorf("error validating signatures in tx number %d, error: %s", txNum, err)
+			return nil, nil, err
+		}
+
+		usersWithValidSigPerTX[txNum] = usersWithValidSignTx
+		valInfoPerTx[txNum] = vInfo
+		if vInfo.Flag != types.Flag_VALID {
+			v.logger.Debugf("data transaction [%v] is invalid due to [%s]", txEnv.Payload, vInfo.ReasonIfInvalid)
+		}
+	}
+	return valInfoPerTx, usersWithValidSigPerTX, nil
+}
+
+// prefetchReadVersions fetches the committed versions of all the keys read by the transactions of the block which
+// passed the signature validation, with a single lookup on a snapshot of the state, instead of a lookup per read. The
+// versions are recorded in the pending operations, where the MVCC validation of each transaction finds them.
+func (v *Validator) prefetchReadVersions(dataTxEnvs []*types.DataTxEnvelope, valInfoArray []*types.ValidationInfo, pendingOps *pendingOperations) error {
+	reads := make(map[string]map[string]struct{})
+	for txNum, txEnv := range dataTxEnvs {
+		if valInfoArray[txNum].Flag != types.Flag_VALID {
+			continue
+		}
+
+		for _, ops := range txEnv.Payload.DbOperations {
+			if len(ops.DataReads) == 0 {
+				continue
+			}
+			keys, ok := reads[ops.DbName]
+			if !ok {
+				// a read of a database which does not exist makes the transaction invalid before its MVCC validation
+				if !v.dataTxValidator.db.Exist(ops.DbName) {
+					continue
+				}
+				keys = make(map[string]struct{})
+				reads[ops.DbName] = keys
+			}
+			for _, r := range ops.DataReads {
+				keys[r.Key] = struct{}{}
+			}
+		}
+	}
+	if len(reads) == 0 {
+		return nil
+	}
+
+	dbNames := make([]string, 0, len(reads))
+	for dbName := range reads {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	snap, err := v.dataTxValidator.db.GetDBsSnapshot(dbNames)
+	if err != nil {
+		return errors.WithMessage(err, "error while taking a snapshot of the state to fetch the versions of the reads")
+	}
+	defer snap.Release()
+
+	for _, dbName := range dbNames {
+		for key := range reads[dbName] {
+			_, metadata, err := snap.Get(dbName, key)
+			if err != nil {
+				return errors.WithMessagef(err, "error while fetching the version of the key [%s] in the database [%s]", key, dbName)
+			}
+			pendingOps.addCommittedVersion(dbName, key, metadata.GetVersion())
+		}
+	}
+
+	return nil
+}
+
+// pendingOperations tracks the writes, deletes, and sequence numbers of the valid transactions of the block being
+// validated, along with the committed versions of the keys read by its transactions.
 type pendingOperations struct {
-	pendingWrites  map[string]bool
-	pendingDeletes map[string]bool
+	pendingWrites     map[string]*types.Version
+	pendingDeletes    map[string]*types.Version
+	committedVersions map[string]*types.Version
+	pendingSequences  map[string]uint64
 }
 
 func newPendingOperations() *pendingOperations {
 	return &pendingOperations{
-		pendingWrites:  make(map[string]bool),
-		pendingDeletes: make(map[string]bool),
+		pendingWrites:     make(map[string]*types.Version),
+		pendingDeletes:    make(map[string]*types.Version),
+		committedVersions: make(map[string]*types.Version),
+		pendingSequences:  make(map[string]uint64),
 	}
 }
 
-func (p *pendingOperations) addWrite(dbName, key string) {
+// addSequence records the sequence number of a valid transaction of the user.
+func (p *pendingOperations) addSequence(userID string, sequence uint64) {
+	p.pendingSequences[userID] = sequence
+}
+
+// sequence returns the sequence number of the last valid transaction of the user in the block, if any.
+func (p *pendingOperations) sequence(userID string) (uint64, bool) {
+	seq, ok := p.pendingSequences[userID]
+	return seq, ok
+}
+
+// addCommittedVersion records the committed version of the key, where a nil version denotes a key which does not
+// exist in the committed state.
+func (p *pendingOperations) addCommittedVersion(dbName, key string, version *types.Version) {
+	p.committedVersions[constructCompositeKey(dbName, key)] = version
+}
+
+// committedVersion returns the committed version of the key, if it was fetched before the validation of the block.
+func (p *pendingOperations) committedVersion(dbName, key string) (*types.Version, bool) {
+	ver, ok := p.committedVersions[constructCompositeKey(dbName, key)]
+	return ver, ok
+}
+
+// addWrite records a write on the key by the transaction with the given version, i.e., the block number
+// and the index of the transaction in the block.
+func (p *pendingOperations) addWrite(dbName, key string, version *types.Version) {
 	ckey := constructCompositeKey(dbName, key)
-	p.pendingWrites[ckey] = true
+	p.pendingWrites[ckey] = version
 }
 
-func (p *pendingOperations) addDelete(dbName, key string) {
+// addDelete records a delete of the key by the transaction with the given version.
+func (p *pendingOperations) addDelete(dbName, key string, version *types.Version) {
 	ckey := constructCompositeKey(dbName, key)
-	p.pendingDeletes[ckey] = true
+	p.pendingDeletes[ckey] = version
 }
 
 func (p *pendingOperations) existDelete(dbName, key string) bool {
 	ckey := constructCompositeKey(dbName, key)
-	return p.pendingDeletes[ckey]
+	_, ok := p.pendingDeletes[ckey]
+	return ok
 }
 
 func (p *pendingOperations) exist(dbName, key string) bool {
+	_, ok := p.version(dbName, key)
+	return ok
+}
+
+// version returns the version of the transaction in the block that wrote or deleted the key, if any.
+func (p *pendingOperations) version(dbName, key string) (*types.Version, bool) {
 	ckey := constructCompositeKey(dbName, key)
-	return p.pendingWrites[ckey] || p.pendingDeletes[ckey]
+	if ver, ok := p.pendingWrites[ckey]; ok {
+		return ver, true
+	}
+	ver, ok := p.pendingDeletes[ckey]
+	return ver, ok
+}
+
+// existInRange returns the lowest key, by the key collation of the database, in the range [startKey, endKey) of the
+// database that was written or deleted by a transaction in the block, if any.
+func (p *pendingOperations) existInRange(dbName string, collation *types.DBKeyCollation, startKey, endKey string) (string, bool) {
+	prefix := constructCompositeKey(dbName, "")
+	var keys []string
+	for _, ops := range []map[string]*types.Version{p.pendingWrites, p.pendingDeletes} {
+		for ckey := range ops {
+			if !strings.HasPrefix(ckey, prefix) {
+				continue
+			}
+			if key := ckey[len(prefix):]; worldstate.InCollatedRange(collation, key, startKey, endKey) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return "", false
+	}
+
+	// the keys are sorted as the key is reported in the validation info, which must be equal on all nodes
+	worldstate.SortKeys(collation, keys)
+	return keys[0], true
 }
 
 func constructCompositeKey(dbName, key string) string {
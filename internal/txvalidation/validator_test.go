@@ -4,15 +4,19 @@
 package txvalidation
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
 	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/testfault"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate/leveldb"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
@@ -21,13 +25,14 @@ import (
 )
 
 type validatorTestEnv struct {
-	db        *leveldb.LevelDB
-	path      string
-	validator *Validator
-	cleanup   func()
+	db         *leveldb.LevelDB
+	blockStore *blockstore.Store
+	path       string
+	validator  *Validator
+	cleanup    func()
 }
 
-func newValidatorTestEnv(t *testing.T) *validatorTestEnv {
+func newValidatorTestEnv(t testing.TB) *validatorTestEnv {
 	c := &logger.Config{
 		Level:         "debug",
 		OutputPath:    []string{"stdout"},
@@ -54,22 +59,40 @@ func newValidatorTestEnv(t *testing.T) *validatorTestEnv {
 		t.Fatalf("failed to create leveldb with path %s", path)
 	}
 
+	blockStore, err := blockstore.Open(
+		&blockstore.Config{
+			StoreDir: filepath.Join(dir, "blockstore"),
+			Logger:   logger,
+		},
+	)
+	if err != nil {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("failed to remove directory %s, %v", dir, err)
+		}
+		t.Fatalf("failed to create the block store, %v", err)
+	}
+
 	cleanup := func() {
 		if err := db.Close(); err != nil {
 			t.Errorf("failed to close the db instance, %v", err)
 		}
+		if err := blockStore.Close(); err != nil {
+			t.Errorf("failed to close the block store, %v", err)
+		}
 		if err := os.RemoveAll(dir); err != nil {
 			t.Errorf("failed to remove directory %s, %v", dir, err)
 		}
 	}
 
 	return &validatorTestEnv{
-		db:   db,
-		path: path,
+		db:         db,
+		blockStore: blockStore,
+		path:       path,
 		validator: NewValidator(
 			&Config{
-				DB:     db,
-				Logger: logger,
+				DB:         db,
+				BlockStore: blockStore,
+				Logger:     logger,
 			},
 		),
 		cleanup: cleanup,
@@ -476,7 +499,8 @@ func TestValidateDataBlock(t *testing.T) {
 			block: &types.Block{
 				Header: &types.BlockHeader{
 					BaseHeader: &types.BlockHeaderBase{
-						Number: 2,
+						Number:       2,
+						RulesVersion: constants.RulesVersion,
 					},
 				},
 				Payload: &types.Block_DataTxEnvelopes{
@@ -616,10 +640,26 @@ func TestValidateDataBlock(t *testing.T) {
 				{
 					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
 					ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [key1] in database [" + worldstate.DefaultDBName + "]",
+					ConflictingReads: []*types.ConflictingRead{
+						{
+							DbName:          worldstate.DefaultDBName,
+							Key:             "key1",
+							ExpectedVersion: &types.Version{BlockNum: 1, TxNum: 1},
+							ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+						},
+					},
 				},
 				{
 					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
 					ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [key2] in database [" + worldstate.DefaultDBName + "]",
+					ConflictingReads: []*types.ConflictingRead{
+						{
+							DbName:          worldstate.DefaultDBName,
+							Key:             "key2",
+							ExpectedVersion: &types.Version{BlockNum: 1, TxNum: 1},
+							ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+						},
+					},
 				},
 				{
 					Flag:            types.Flag_INVALID_DATABASE_DOES_NOT_EXIST,
@@ -635,155 +675,1898 @@ func TestValidateDataBlock(t *testing.T) {
 				},
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			env := newValidatorTestEnv(t)
-			defer env.cleanup()
-
-			tt.setup(env.db)
-
-			results, err := env.validator.ValidateBlock(tt.block)
-			require.NoError(t, err)
-			require.Equal(t, tt.expectedResults, results)
-		})
-	}
-}
-
-func TestValidateUserBlock(t *testing.T) {
-	t.Parallel()
-
-	cryptoDir := testutils.GenerateTestCrypto(t, []string{"adminUser"})
-	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "adminUser")
-	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
-	require.True(t, caCert.IsCA)
-
-	adminUser := &types.User{
-		Id:          "adminUser",
-		Certificate: adminCert.Raw,
-		Privilege: &types.Privilege{
-			Admin: true,
-		},
-	}
-	adminUserSerialized, err := proto.Marshal(adminUser)
-	require.NoError(t, err)
-
-	sampleVersion := &types.Version{
-		BlockNum: 2,
-		TxNum:    1,
-	}
-
-	tests := []struct {
-		name            string
-		setup           func(db worldstate.DB)
-		block           *types.Block
-		expectedResults []*types.ValidationInfo
-	}{
 		{
-			name: "user block with an invalid transaction",
+			name: "data block with a write skew",
 			setup: func(db worldstate.DB) {
-				newUsers := map[string]*worldstate.DBUpdates{
-					worldstate.UsersDBName: {
+				addUserWithCorrectPrivilege(db)
+				data := map[string]*worldstate.DBUpdates{
+					worldstate.DatabasesDBName: {
 						Writes: []*worldstate.KVWithMetadata{
 							{
-								Key:   string(identity.UserNamespace) + "adminUser",
-								Value: adminUserSerialized,
+								Key: "db1",
 							},
-							constructUserForTest(t, "user1", nil, nil, sampleVersion, &types.AccessControl{
-								ReadUsers: map[string]bool{
-									"adminUser": true,
-								},
-							}),
 						},
 					},
 				}
+				require.NoError(t, db.Commit(data, 1))
 
-				require.NoError(t, db.Commit(newUsers, 1))
+				data = map[string]*worldstate.DBUpdates{
+					"db1": {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key: "x",
+								Metadata: &types.Metadata{
+									Version: &types.Version{
+										BlockNum: 1,
+										TxNum:    1,
+									},
+								},
+							},
+							{
+								Key: "y",
+								Metadata: &types.Metadata{
+									Version: &types.Version{
+										BlockNum: 1,
+										TxNum:    1,
+									},
+								},
+							},
+						},
+					},
+				}
+				require.NoError(t, db.Commit(data, 1))
 			},
+			// each transaction reads the key written by the other one at its pre-block version, which would break an
+			// invariant over both keys if both were committed. The later one is rejected as its read was written by the
+			// earlier one, even though the read version matches the committed state.
 			block: &types.Block{
 				Header: &types.BlockHeader{
 					BaseHeader: &types.BlockHeaderBase{
-						Number: 2,
+						Number:       2,
+						RulesVersion: constants.RulesVersion,
 					},
 				},
-				Payload: &types.Block_UserAdministrationTxEnvelope{
-					UserAdministrationTxEnvelope: testutils.SignedUserAdministrationTxEnvelope(t, adminSigner,
-						&types.UserAdministrationTx{
-							UserId: "adminUser",
-							UserReads: []*types.UserRead{
-								{
-									UserId: "user1",
-									Version: &types.Version{
-										BlockNum: 100,
-										TxNum:    1000,
+				Payload: &types.Block_DataTxEnvelopes{
+					DataTxEnvelopes: &types.DataTxEnvelopes{
+						Envelopes: []*types.DataTxEnvelope{
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataReads: []*types.DataRead{
+											{
+												Key:     "x",
+												Version: &types.Version{BlockNum: 1, TxNum: 1},
+											},
+										},
+										DataWrites: []*types.DataWrite{
+											{
+												Key:   "y",
+												Value: []byte("from-x"),
+											},
+										},
 									},
 								},
-							},
+							}),
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataReads: []*types.DataRead{
+											{
+												Key:     "y",
+												Version: &types.Version{BlockNum: 1, TxNum: 1},
+											},
+										},
+										DataWrites: []*types.DataWrite{
+											{
+												Key:   "x",
+												Value: []byte("from-y"),
+											},
+										},
+									},
+								},
+							}),
 						},
-					),
+					},
 				},
 			},
 			expectedResults: []*types.ValidationInfo{
 				{
-					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
-					ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the user [user1] has changed",
+					Flag: types.Flag_VALID,
+				},
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [y] in database [db1]",
+					ConflictingReads: []*types.ConflictingRead{
+						{
+							DbName:          "db1",
+							Key:             "y",
+							ExpectedVersion: &types.Version{BlockNum: 1, TxNum: 1},
+							ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "user block with an valid transaction",
+			name: "data block with conflicting patches",
 			setup: func(db worldstate.DB) {
-				newUsers := map[string]*worldstate.DBUpdates{
-					worldstate.UsersDBName: {
+				addUserWithCorrectPrivilege(db)
+				data := map[string]*worldstate.DBUpdates{
+					worldstate.DatabasesDBName: {
 						Writes: []*worldstate.KVWithMetadata{
 							{
-								Key:   string(identity.UserNamespace) + "adminUser",
-								Value: adminUserSerialized,
+								Key: "db1",
 							},
 						},
 					},
 				}
+				require.NoError(t, db.Commit(data, 1))
 
-				require.NoError(t, db.Commit(newUsers, 1))
+				data = map[string]*worldstate.DBUpdates{
+					"db1": {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key:   "doc",
+								Value: []byte(`{"counter":1,"items":[]}`),
+								Metadata: &types.Metadata{
+									Version: &types.Version{
+										BlockNum: 1,
+										TxNum:    1,
+									},
+								},
+							},
+						},
+					},
+				}
+				require.NoError(t, db.Commit(data, 1))
 			},
+			// both patches are based on the same committed version of the document. The patched key is modified by the
+			// first one, hence, the second patch and a later read of the key conflict with it.
 			block: &types.Block{
 				Header: &types.BlockHeader{
 					BaseHeader: &types.BlockHeaderBase{
-						Number: 2,
+						Number:       2,
+						RulesVersion: constants.RulesVersion,
 					},
 				},
-				Payload: &types.Block_UserAdministrationTxEnvelope{
-					UserAdministrationTxEnvelope: testutils.SignedUserAdministrationTxEnvelope(t, adminSigner,
-						&types.UserAdministrationTx{
-							UserId: "adminUser",
-							UserReads: []*types.UserRead{
-								{
-									UserId: "user1",
+				Payload: &types.Block_DataTxEnvelopes{
+					DataTxEnvelopes: &types.DataTxEnvelopes{
+						Envelopes: []*types.DataTxEnvelope{
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataPatches: []*types.DataPatch{
+											{
+												Key:        "doc",
+												Version:    &types.Version{BlockNum: 1, TxNum: 1},
+												MergePatch: []byte(`{"counter":2}`),
+											},
+										},
+									},
 								},
-								{
-									UserId: "user2",
+							}),
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataPatches: []*types.DataPatch{
+											{
+												Key:     "doc",
+												Version: &types.Version{BlockNum: 1, TxNum: 1},
+												Operations: []*types.JSONPatchOperation{
+													{Op: "add", Path: "/items/-", Value: []byte(`"x"`)},
+												},
+											},
+										},
+									},
 								},
-							},
+							}),
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataReads: []*types.DataRead{
+											{
+												Key:     "doc",
+												Version: &types.Version{BlockNum: 1, TxNum: 1},
+											},
+										},
+									},
+								},
+							}),
 						},
-					),
+					},
 				},
 			},
 			expectedResults: []*types.ValidationInfo{
 				{
 					Flag: types.Flag_VALID,
 				},
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "mvcc conflict has occurred within the block for the patched key [doc] in database [db1]. Within a block, a key can be modified only once",
+					ConflictingReads: []*types.ConflictingRead{
+						{
+							DbName:          "db1",
+							Key:             "doc",
+							ExpectedVersion: &types.Version{BlockNum: 1, TxNum: 1},
+							ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+						},
+					},
+				},
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [doc] in database [db1]",
+					ConflictingReads: []*types.ConflictingRead{
+						{
+							DbName:          "db1",
+							Key:             "doc",
+							ExpectedVersion: &types.Version{BlockNum: 1, TxNum: 1},
+							ActualVersion:   &types.Version{BlockNum: 2, TxNum: 0},
+						},
+					},
+				},
 			},
 		},
-	}
+		{
+			name: "data block with range deletes",
+			setup: func(db worldstate.DB) {
+				addUserWithCorrectPrivilege(db)
+				data := map[string]*worldstate.DBUpdates{
+					worldstate.DatabasesDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key: "db1",
+							},
+						},
+					},
+				}
+				require.NoError(t, db.Commit(data, 1))
 
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
+				data = map[string]*worldstate.DBUpdates{
+					"db1": {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key: "r/1",
+								Metadata: &types.Metadata{
+									Version: &types.Version{
+										BlockNum: 1,
+										TxNum:    1,
+									},
+								},
+							},
+							{
+								Key: "r/2",
+								Metadata: &types.Metadata{
+									Version: &types.Version{
+										BlockNum: 1,
+										TxNum:    1,
+									},
+								},
+							},
+						},
+					},
+				}
+				require.NoError(t, db.Commit(data, 1))
+			},
+			block: &types.Block{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number: 2,
+					},
+				},
+				Payload: &types.Block_DataTxEnvelopes{
+					DataTxEnvelopes: &types.DataTxEnvelopes{
+						Envelopes: []*types.DataTxEnvelope{
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataDeleteRanges: []*types.DataDeleteRange{
+											{
+												Prefix: "r/",
+											},
+										},
+									},
+								},
+							}),
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataWrites: []*types.DataWrite{
+											{
+												Key:   "r/2",
+												Value: []byte("new-val"),
+											},
+										},
+									},
+								},
+							}),
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataWrites: []*types.DataWrite{
+											{
+												Key:   "r/3",
+												Value: []byte("new-val"),
+											},
+										},
+									},
+								},
+							}),
+							testutils.SignedDataTxEnvelope(t, []crypto.Signer{userSigner}, &types.DataTx{
+								MustSignUserIds: []string{"operatingUser"},
+								DbOperations: []*types.DBOperation{
+									{
+										DbName: "db1",
+										DataDeleteRanges: []*types.DataDeleteRange{
+											{
+												StartKey: "r/",
+											},
+										},
+									},
+								},
+							}),
+						},
+					},
+				},
+			},
+			expectedResults: []*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [r/2] in database [db1]. Within a block, a key can be modified only once",
+				},
+				{
+					Flag: types.Flag_VALID,
+				},
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "mvcc conflict has occurred within the block for the key [r/1] in database [db1], which is in a range delete. Within a block, a key can be modified only once",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newValidatorTestEnv(t)
+			defer env.cleanup()
+
+			tt.setup(env.db)
+
+			results, err := env.validator.ValidateBlock(tt.block)
+			require.NoError(t, err)
+			require.Len(t, results, len(tt.expectedResults))
+			for i := range tt.expectedResults {
+				require.True(t, proto.Equal(tt.expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, tt.expectedResults[i], results[i])
+			}
+
+			// the streamed results are the final ones, in the order of the transactions
+			requireStreamedResults(t, env.validator, tt.block, results)
+
+			// the rules that predate the conflicting reads yield the same validation info without them
+			if tt.block.GetHeader().GetBaseHeader().GetRulesVersion() < constants.ConflictingReadsRulesVersion {
+				return
+			}
+			legacyBlock := proto.Clone(tt.block).(*types.Block)
+			legacyBlock.Header.BaseHeader.RulesVersion = constants.ConflictingReadsRulesVersion - 1
+			legacyResults, err := env.validator.ValidateBlock(legacyBlock)
+			require.NoError(t, err)
+			require.Len(t, legacyResults, len(tt.expectedResults))
+			for i := range tt.expectedResults {
+				expected := proto.Clone(tt.expectedResults[i]).(*types.ValidationInfo)
+				expected.ConflictingReads = nil
+				require.True(t, proto.Equal(expected, legacyResults[i]), "tx %d, expected: %v, actual: %v", i, expected, legacyResults[i])
+			}
+		})
+	}
+}
+
+func requireStreamedResults(t *testing.T, validator *Validator, block *types.Block, expected []*types.ValidationInfo) {
+	var streamed []*types.ValidationInfo
+	results, err := validator.ValidateBlockStreaming(block, func(txNum int, valInfo *types.ValidationInfo) {
+		require.Equal(t, len(streamed), txNum)
+		streamed = append(streamed, valInfo)
+	})
+	require.NoError(t, err)
+	require.Len(t, results, len(expected))
+	require.Len(t, streamed, len(expected))
+	for i := range expected {
+		require.True(t, proto.Equal(expected[i], results[i]), "tx %d, expected: %v, actual: %v", i, expected[i], results[i])
+		require.Same(t, results[i], streamed[i], "tx %d", i)
+	}
+}
+
+func TestValidateSequencedDataTxs(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1", "user2"})
+	user1Cert, user1Signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	user2Cert, user2Signer := testutils.LoadTestCrypto(t, cryptoDir, "user2")
+
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	var userWrites []*worldstate.KVWithMetadata
+	for userID, cert := range map[string][]byte{"user1": user1Cert.Raw, "user2": user2Cert.Raw} {
+		user, err := proto.Marshal(&types.User{
+			Id:          userID,
+			Certificate: cert,
+			Privilege: &types.Privilege{
+				DbPermission: map[string]types.Privilege_Access{
+					worldstate.DefaultDBName: types.Privilege_ReadWrite,
+				},
+			},
+		})
+		require.NoError(t, err)
+		userWrites = append(userWrites, &worldstate.KVWithMetadata{
+			Key:   string(identity.UserNamespace) + userID,
+			Value: user,
+		})
+	}
+	// user1 has committed the transactions up to the sequence number 3, while user2 has never used one
+	userWrites = append(userWrites, identity.ConstructDBEntryForUserSequence("user1", 3, &types.Version{BlockNum: 1}))
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {Writes: userWrites},
+		worldstate.DefaultDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:      "key1",
+					Value:    []byte("value1"),
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1, TxNum: 1}},
+				},
+			},
+		},
+	}, 1))
+
+	sequencedTx := func(signer crypto.Signer, userID, key string, sequence uint64, reads ...*types.DataRead) *types.DataTxEnvelope {
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signer}, &types.DataTx{
+			MustSignUserIds: []string{userID},
+			TxId:            fmt.Sprintf("%s-%s-%d", userID, key, sequence),
+			Sequence:        sequence,
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataReads:  reads,
+					DataWrites: []*types.DataWrite{{Key: key, Value: []byte("value")}},
+				},
+			},
+		})
+	}
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number:       2,
+				RulesVersion: constants.SequenceRulesVersion,
+			},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{
+					sequencedTx(user1Signer, "user1", "key2", 4),
+					sequencedTx(user1Signer, "user1", "key3", 5),
+					sequencedTx(user1Signer, "user1", "key4", 5),
+					sequencedTx(user1Signer, "user1", "key5", 7),
+					sequencedTx(user1Signer, "user1", "key6", 0),
+					sequencedTx(user1Signer, "user1", "key7", 6, &types.DataRead{Key: "key1", Version: &types.Version{BlockNum: 1}}),
+					sequencedTx(user1Signer, "user1", "key8", 7),
+					sequencedTx(user2Signer, "user2", "key9", 2),
+					sequencedTx(user2Signer, "user2", "key10", 1),
+				},
+			},
+		},
+	}
+
+	expectedResults := []*types.ValidationInfo{
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag:            types.Flag_INVALID_OUT_OF_ORDER,
+			ReasonIfInvalid: "the sequence number [5] of the user [user1] is not greater than the last sequence number [5]",
+		},
+		{
+			Flag:            types.Flag_INVALID_OUT_OF_ORDER,
+			ReasonIfInvalid: "the sequence number [7] of the user [user1] leaves a gap after the last sequence number [5]",
+		},
+		{
+			Flag: types.Flag_VALID,
+		},
+		// the rules of the block predate the conflicting reads
+		{
+			Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+			ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the key [key1] in database [bdb] changed",
+		},
+		// an invalid transaction does not consume its sequence number, hence, the transactions that follow it in the
+		// chain are invalid too
+		{
+			Flag:            types.Flag_INVALID_OUT_OF_ORDER,
+			ReasonIfInvalid: "the sequence number [7] of the user [user1] leaves a gap after the last sequence number [5]",
+		},
+		{
+			Flag:            types.Flag_INVALID_OUT_OF_ORDER,
+			ReasonIfInvalid: "the sequence number [2] of the user [user2] leaves a gap after the last sequence number [0]",
+		},
+		{
+			Flag: types.Flag_VALID,
+		},
+	}
+
+	results, err := env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	require.Len(t, results, len(expectedResults))
+	for i := range expectedResults {
+		require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+	}
+
+	// a block of older rules ignores the sequence numbers, as the nodes that produced it did
+	block.Header.BaseHeader.RulesVersion = constants.KeyFormatRulesVersion
+	results, err = env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	for _, i := range []int{2, 3, 6, 7} {
+		require.Equal(t, types.Flag_VALID, results[i].Flag, "tx %d, actual: %v", i, results[i])
+	}
+}
+
+func TestValidateVoidBlock(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1", "user2"})
+	user1Cert, user1Signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	_, user2Signer := testutils.LoadTestCrypto(t, cryptoDir, "user2")
+
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	user1, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: user1Cert.Raw,
+	})
+	require.NoError(t, err)
+	// user1 has committed the transactions up to the sequence number 3
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:   string(identity.UserNamespace) + "user1",
+					Value: user1,
+				},
+				identity.ConstructDBEntryForUserSequence("user1", 3, &types.Version{BlockNum: 1}),
+			},
+		},
+	}, 1))
+
+	voidTx := func(signer crypto.Signer, userID, txID string, sequence uint64) *types.VoidTxEnvelope {
+		return testutils.SignedVoidTxEnvelope(t, signer, &types.VoidTx{
+			UserId:   userID,
+			TxId:     txID,
+			Sequence: sequence,
+		})
+	}
+
+	unsigned := voidTx(user1Signer, "user1", "tx6", 0)
+	unsigned.Signature = nil
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number: 2,
+			},
+		},
+		Payload: &types.Block_VoidTxEnvelopes{
+			VoidTxEnvelopes: &types.VoidTxEnvelopes{
+				Envelopes: []*types.VoidTxEnvelope{
+					voidTx(user1Signer, "user1", "tx1", 4),
+					voidTx(user1Signer, "user1", "tx2", 4),
+					voidTx(user1Signer, "user1", "tx3", 0),
+					voidTx(user1Signer, "user1", "tx4", 5),
+					voidTx(user2Signer, "user1", "tx5", 6),
+					unsigned,
+					voidTx(user2Signer, "user2", "tx7", 0),
+					voidTx(user1Signer, "", "tx8", 0),
+				},
+			},
+		},
+	}
+
+	expectedResults := []*types.ValidationInfo{
+		{
+			Flag: types.Flag_VOIDED,
+		},
+		{
+			Flag:            types.Flag_INVALID_OUT_OF_ORDER,
+			ReasonIfInvalid: "the sequence number [4] of the user [user1] is not greater than the last sequence number [4]",
+		},
+		// a void without a sequence number reserves its ID only
+		{
+			Flag: types.Flag_VOIDED,
+		},
+		{
+			Flag: types.Flag_VOIDED,
+		},
+		{
+			Flag:            types.Flag_INVALID_UNAUTHORISED,
+			ReasonIfInvalid: "signature verification failed: x509: ECDSA verification failure",
+		},
+		{
+			Flag:            types.Flag_INVALID_UNAUTHORISED,
+			ReasonIfInvalid: "signature verification failed: x509: ECDSA verification failure",
+		},
+		{
+			Flag:            types.Flag_INVALID_UNAUTHORISED,
+			ReasonIfInvalid: "signature verification failed: the user [user2] does not exist",
+		},
+		{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the user ID of the void transaction cannot be empty",
+		},
+	}
+
+	results, err := env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	require.Len(t, results, len(expectedResults))
+	for i := range expectedResults {
+		require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+	}
+}
+
+func TestValidateDataTxsAgainstSchema(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1"})
+	user1Cert, user1Signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	user, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: user1Cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	descriptorEntry := func(schema string, blockNum uint64) *worldstate.KVWithMetadata {
+		descriptor, err := proto.Marshal(&types.DBDescriptor{JsonSchema: schema})
+		require.NoError(t, err)
+		return &worldstate.KVWithMetadata{
+			Key:      worldstate.DefaultDBName,
+			Value:    descriptor,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum}},
+		}
+	}
+
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{{Key: string(identity.UserNamespace) + "user1", Value: user}},
+		},
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{descriptorEntry(`{"type":"object","required":["name"]}`, 1)},
+		},
+		worldstate.DefaultDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:      "alice",
+					Value:    []byte(`{"name":"alice","age":30}`),
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}},
+				},
+			},
+		},
+	}, 1))
+
+	txCount := 0
+	dataTx := func(writes []*types.DataWrite, patches []*types.DataPatch) *types.DataTxEnvelope {
+		txCount++
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{user1Signer}, &types.DataTx{
+			MustSignUserIds: []string{"user1"},
+			TxId:            fmt.Sprintf("tx%d", txCount),
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:      worldstate.DefaultDBName,
+					DataWrites:  writes,
+					DataPatches: patches,
+				},
+			},
+		})
+	}
+	write := func(key, value string) []*types.DataWrite {
+		return []*types.DataWrite{{Key: key, Value: []byte(value)}}
+	}
+	dataBlock := func(number uint64, envs ...*types.DataTxEnvelope) *types.Block {
+		return &types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number:       number,
+					RulesVersion: constants.SchemaRulesVersion,
+				},
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{
+					Envelopes: envs,
+				},
+			},
+		}
+	}
+	validate := func(block *types.Block, expectedResults []*types.ValidationInfo) {
+		results, err := env.validator.ValidateBlock(block)
+		require.NoError(t, err)
+		require.Len(t, results, len(expectedResults))
+		for i := range expectedResults {
+			require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+		}
+	}
+
+	// a value that is valid under the first schema, but not under the second one
+	namedBob := write("bob", `{"name":"bob"}`)
+
+	validate(
+		dataBlock(2,
+			dataTx(namedBob, nil),
+			dataTx(write("carol", `{"age":20}`), nil),
+			dataTx(write("dave", `not json`), nil),
+			dataTx(nil, []*types.DataPatch{{Key: "alice", Version: &types.Version{BlockNum: 1}, MergePatch: []byte(`{"name":null}`)}}),
+		),
+		[]*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+			{
+				Flag:            types.Flag_INVALID_SCHEMA_VIOLATION,
+				ReasonIfInvalid: `the value of the key [carol] in the database [bdb] violates the schema of the database: the value at [] misses the required property "name"`,
+			},
+			{
+				Flag:            types.Flag_INVALID_SCHEMA_VIOLATION,
+				ReasonIfInvalid: "the value of the key [dave] in the database [bdb] violates the schema of the database: the value at [] is not a JSON document",
+			},
+			{
+				Flag:            types.Flag_INVALID_SCHEMA_VIOLATION,
+				ReasonIfInvalid: `the value of the key [alice] in the database [bdb] violates the schema of the database: the value at [] misses the required property "name"`,
+			},
+		},
+	)
+
+	// a block of older rules ignores the schema, as the nodes that produced it did
+	legacyBlock := dataBlock(2, dataTx(write("carol", `{"age":20}`), nil))
+	legacyBlock.Header.BaseHeader.RulesVersion = constants.SequenceRulesVersion
+	validate(legacyBlock, []*types.ValidationInfo{{Flag: types.Flag_VALID}})
+
+	// the schema evolves: the age becomes required, and must be at least 18
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				descriptorEntry(`{"type":"object","required":["name","age"],"properties":{"age":{"type":"integer","minimum":18}}}`, 3),
+			},
+		},
+	}, 3))
+
+	validate(
+		dataBlock(4,
+			dataTx(namedBob, nil),
+			dataTx(write("bob", `{"name":"bob","age":17}`), nil),
+			dataTx(write("bob", `{"name":"bob","age":18}`), nil),
+			dataTx(nil, []*types.DataPatch{{Key: "alice", Version: &types.Version{BlockNum: 1}, MergePatch: []byte(`{"age":null}`)}}),
+		),
+		[]*types.ValidationInfo{
+			{
+				Flag:            types.Flag_INVALID_SCHEMA_VIOLATION,
+				ReasonIfInvalid: `the value of the key [bob] in the database [bdb] violates the schema of the database: the value at [] misses the required property "age"`,
+			},
+			{
+				Flag:            types.Flag_INVALID_SCHEMA_VIOLATION,
+				ReasonIfInvalid: "the value of the key [bob] in the database [bdb] violates the schema of the database: the value at [/age] is 17, less than the minimum of 18",
+			},
+			{
+				Flag: types.Flag_VALID,
+			},
+			{
+				Flag:            types.Flag_INVALID_SCHEMA_VIOLATION,
+				ReasonIfInvalid: `the value of the key [alice] in the database [bdb] violates the schema of the database: the value at [] misses the required property "age"`,
+			},
+		},
+	)
+
+	// once the schema is removed, any value can be written
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DBDescriptorsDBName: {
+			Deletes: []string{worldstate.DefaultDBName},
+		},
+	}, 5))
+
+	validate(
+		dataBlock(6,
+			dataTx(write("dave", `not json`), nil),
+		),
+		[]*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+		},
+	)
+}
+
+func TestValidateDataTxsAgainstDefaultACL(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1", "user2"})
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	signers := make(map[string]crypto.Signer)
+	var users []*worldstate.KVWithMetadata
+	for _, userID := range []string{"user1", "user2"} {
+		cert, signer := testutils.LoadTestCrypto(t, cryptoDir, userID)
+		signers[userID] = signer
+		user, err := proto.Marshal(&types.User{
+			Id:          userID,
+			Certificate: cert.Raw,
+			Privilege: &types.Privilege{
+				DbPermission: map[string]types.Privilege_Access{
+					worldstate.DefaultDBName: types.Privilege_ReadWrite,
+				},
+			},
+		})
+		require.NoError(t, err)
+		users = append(users, &worldstate.KVWithMetadata{Key: string(identity.UserNamespace) + userID, Value: user})
+	}
+
+	descriptorEntry := func(defaultACL *types.AccessControl, blockNum uint64) *worldstate.KVWithMetadata {
+		descriptor, err := proto.Marshal(&types.DBDescriptor{DefaultAcl: defaultACL})
+		require.NoError(t, err)
+		return &worldstate.KVWithMetadata{
+			Key:      worldstate.DefaultDBName,
+			Value:    descriptor,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum}},
+		}
+	}
+
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: users,
+		},
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				descriptorEntry(&types.AccessControl{ReadWriteUsers: map[string]bool{"user1": true}}, 1),
+			},
+		},
+		worldstate.DefaultDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:   "shared",
+					Value: []byte("value"),
+					Metadata: &types.Metadata{
+						Version:       &types.Version{BlockNum: 1},
+						AccessControl: &types.AccessControl{ReadWriteUsers: map[string]bool{"user2": true}},
+					},
+				},
+			},
+		},
+	}, 1))
+
+	txCount := 0
+	dataTx := func(userID string, op *types.DBOperation) *types.DataTxEnvelope {
+		txCount++
+		op.DbName = worldstate.DefaultDBName
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signers[userID]}, &types.DataTx{
+			MustSignUserIds: []string{userID},
+			TxId:            fmt.Sprintf("tx%d", txCount),
+			DbOperations:    []*types.DBOperation{op},
+		})
+	}
+	write := func(key string) *types.DBOperation {
+		return &types.DBOperation{DataWrites: []*types.DataWrite{{Key: key, Value: []byte("value")}}}
+	}
+	validate := func(number uint64, envs []*types.DataTxEnvelope, expectedResults []*types.ValidationInfo) {
+		results, err := env.validator.ValidateBlock(&types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number: number,
+				},
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{
+					Envelopes: envs,
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, len(expectedResults))
+		for i := range expectedResults {
+			require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+		}
+	}
+
+	// the default ACL applies to the keys without an ACL of their own
+	validate(2,
+		[]*types.DataTxEnvelope{
+			dataTx("user2", write("key1")),
+			dataTx("user1", write("key1")),
+			dataTx("user2", &types.DBOperation{DataDeletes: []*types.DataDelete{{Key: "shared"}}}),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag:            types.Flag_INVALID_NO_PERMISSION,
+				ReasonIfInvalid: "none of the user in [user2] has a write/delete permission on key [key1] present in the database [bdb]",
+			},
+			{
+				Flag: types.Flag_VALID,
+			},
+			{
+				Flag: types.Flag_VALID,
+			},
+		},
+	)
+
+	// once the default ACL is removed, any user with a write privilege on the database can write
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{descriptorEntry(nil, 3)},
+		},
+	}, 3))
+
+	validate(4,
+		[]*types.DataTxEnvelope{
+			dataTx("user2", write("key1")),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+		},
+	)
+}
+
+func TestValidateDataTxsAgainstMaxValueSize(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1"})
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	cert, signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	user, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	descriptorEntry := func(maxValueSize, blockNum uint64) *worldstate.KVWithMetadata {
+		descriptor, err := proto.Marshal(&types.DBDescriptor{MaxValueSizeBytes: maxValueSize})
+		require.NoError(t, err)
+		return &worldstate.KVWithMetadata{
+			Key:      worldstate.DefaultDBName,
+			Value:    descriptor,
+			Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum}},
+		}
+	}
+
+	largeValue := make([]byte, 100)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{{Key: string(identity.UserNamespace) + "user1", Value: user}},
+		},
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{descriptorEntry(100, 1)},
+		},
+		worldstate.DefaultDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:      "large",
+					Value:    largeValue,
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}},
+				},
+			},
+		},
+	}, 1))
+
+	txCount := 0
+	write := func(key string, size int) *types.DataTxEnvelope {
+		txCount++
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signer}, &types.DataTx{
+			MustSignUserIds: []string{"user1"},
+			TxId:            fmt.Sprintf("tx%d", txCount),
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: key, Value: make([]byte, size)}},
+				},
+			},
+		})
+	}
+	rulesVersion := constants.ValueSizeRulesVersion
+	validate := func(number uint64, envs []*types.DataTxEnvelope, expectedResults []*types.ValidationInfo) {
+		results, err := env.validator.ValidateBlock(&types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number:       number,
+					RulesVersion: rulesVersion,
+				},
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{
+					Envelopes: envs,
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, len(expectedResults))
+		for i := range expectedResults {
+			require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+		}
+	}
+
+	// values up to the cap are valid
+	validate(2,
+		[]*types.DataTxEnvelope{
+			write("large", 100),
+			write("key1", 101),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+			{
+				Flag:            types.Flag_INVALID_VALUE_TOO_LARGE,
+				ReasonIfInvalid: "the value of the key [key1] in the database [bdb] is 101 bytes long, which exceeds the cap of the database of 100 bytes",
+			},
+		},
+	)
+
+	// a block of older rules ignores the cap, as the nodes that produced it did
+	rulesVersion = constants.OperationLimitsRulesVersion
+	validate(2,
+		[]*types.DataTxEnvelope{
+			write("key1", 101),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+		},
+	)
+	rulesVersion = constants.ValueSizeRulesVersion
+
+	// once the cap is lowered below the size of the committed value, the value remains, but it cannot be rewritten at
+	// its old size
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{descriptorEntry(10, 3)},
+		},
+	}, 3))
+
+	value, _, err := env.db.Get(worldstate.DefaultDBName, "large")
+	require.NoError(t, err)
+	require.Equal(t, largeValue, value)
+
+	validate(4,
+		[]*types.DataTxEnvelope{
+			write("large", 100),
+			write("large", 10),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag:            types.Flag_INVALID_VALUE_TOO_LARGE,
+				ReasonIfInvalid: "the value of the key [large] in the database [bdb] is 100 bytes long, which exceeds the cap of the database of 10 bytes",
+			},
+			{
+				Flag: types.Flag_VALID,
+			},
+		},
+	)
+
+	// once the cap is removed, the database behaves as a database without a cap
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{descriptorEntry(0, 5)},
+		},
+	}, 5))
+
+	validate(6,
+		[]*types.DataTxEnvelope{
+			write("large", 1000),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+		},
+	)
+}
+
+func TestValidateDataTxsOnImmutableDB(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1"})
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	cert, signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	user, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+			},
+		},
+	})
+	require.NoError(t, err)
+	descriptor, err := proto.Marshal(&types.DBDescriptor{Immutable: true})
+	require.NoError(t, err)
+
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{{Key: string(identity.UserNamespace) + "user1", Value: user}},
+		},
+		worldstate.DBDescriptorsDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:      worldstate.DefaultDBName,
+					Value:    descriptor,
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}},
+				},
+			},
+		},
+		worldstate.DefaultDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:      "digest1",
+					Value:    []byte("content1"),
+					Metadata: &types.Metadata{Version: &types.Version{BlockNum: 1}},
+				},
+			},
+		},
+	}, 1))
+
+	txCount := 0
+	dataTx := func(ops *types.DBOperation) *types.DataTxEnvelope {
+		txCount++
+		ops.DbName = worldstate.DefaultDBName
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signer}, &types.DataTx{
+			MustSignUserIds: []string{"user1"},
+			TxId:            fmt.Sprintf("tx%d", txCount),
+			DbOperations:    []*types.DBOperation{ops},
+		})
+	}
+	write := func(key, value string) *types.DataTxEnvelope {
+		return dataTx(&types.DBOperation{DataWrites: []*types.DataWrite{{Key: key, Value: []byte(value)}}})
+	}
+	rulesVersion := constants.ImmutableDBRulesVersion
+	validate := func(number uint64, envs []*types.DataTxEnvelope, expectedResults []*types.ValidationInfo) {
+		results, err := env.validator.ValidateBlock(&types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number:       number,
+					RulesVersion: rulesVersion,
+				},
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{
+					Envelopes: envs,
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, len(expectedResults))
+		for i := range expectedResults {
+			require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+		}
+	}
+
+	// a rewrite of a key with its value, and a write of a new key, are valid
+	for blockNum := uint64(2); blockNum <= 4; blockNum++ {
+		validate(blockNum,
+			[]*types.DataTxEnvelope{
+				write("digest1", "content1"),
+				write(fmt.Sprintf("digest%d", blockNum), "content"),
+			},
+			[]*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+				{
+					Flag: types.Flag_VALID,
+				},
+			},
+		)
+	}
+
+	// the key can neither be modified nor deleted
+	validate(5,
+		[]*types.DataTxEnvelope{
+			write("digest1", "mutated"),
+			dataTx(&types.DBOperation{
+				DataWrites: []*types.DataWrite{
+					{
+						Key:   "digest1",
+						Value: []byte("content1"),
+						Acl:   &types.AccessControl{ReadWriteUsers: map[string]bool{"user1": true}},
+					},
+				},
+			}),
+			dataTx(&types.DBOperation{DataDeletes: []*types.DataDelete{{Key: "digest1"}}}),
+			dataTx(&types.DBOperation{DataDeleteRanges: []*types.DataDeleteRange{{Prefix: "digest"}}}),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+				ReasonIfInvalid: "the key [digest1] cannot be modified as the database [bdb] is immutable, and the key exists with another value or access control",
+			},
+			{
+				Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+				ReasonIfInvalid: "the key [digest1] cannot be modified as the database [bdb] is immutable, and the key exists with another value or access control",
+			},
+			{
+				Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+				ReasonIfInvalid: "the key [digest1] cannot be deleted as the database [bdb] is immutable",
+			},
+			{
+				Flag:            types.Flag_INVALID_IMMUTABLE_KEY,
+				ReasonIfInvalid: "a range of keys cannot be deleted as the database [bdb] is immutable",
+			},
+		},
+	)
+
+	// a block of older rules ignores the immutability, as the nodes that produced it did
+	rulesVersion = constants.KeyCollationRulesVersion
+	validate(5,
+		[]*types.DataTxEnvelope{
+			write("digest1", "mutated"),
+		},
+		[]*types.ValidationInfo{
+			{
+				Flag: types.Flag_VALID,
+			},
+		},
+	)
+}
+
+func TestValidateDependentDataTxs(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1"})
+	user1Cert, user1Signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	user, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: user1Cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:   string(identity.UserNamespace) + "user1",
+					Value: user,
+				},
+			},
+		},
+	}, 1))
+
+	dependentTx := func(txID, dependsOnTxID string) *types.DataTxEnvelope {
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{user1Signer}, &types.DataTx{
+			MustSignUserIds: []string{"user1"},
+			TxId:            txID,
+			DependsOnTxId:   dependsOnTxID,
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: txID, Value: []byte("value")}},
+				},
+			},
+		})
+	}
+	dataBlock := func(blockNum uint64, envs ...*types.DataTxEnvelope) *types.Block {
+		return &types.Block{
+			Header: &types.BlockHeader{
+				BaseHeader: &types.BlockHeaderBase{
+					Number:       blockNum,
+					RulesVersion: constants.TxDependenciesRulesVersion,
+				},
+			},
+			Payload: &types.Block_DataTxEnvelopes{
+				DataTxEnvelopes: &types.DataTxEnvelopes{
+					Envelopes: envs,
+				},
+			},
+		}
+	}
+
+	// block 1 holds a valid and an invalid transaction
+	block1 := dataBlock(1, dependentTx("txA-valid", ""), dependentTx("txA-invalid", ""))
+	block1.Header.ValidationInfo = []*types.ValidationInfo{
+		{Flag: types.Flag_VALID},
+		{Flag: types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE},
+	}
+	require.NoError(t, env.blockStore.Commit(block1))
+
+	block2 := dataBlock(2,
+		dependentTx("txB-on-valid", "txA-valid"),
+		dependentTx("txB-on-invalid", "txA-invalid"),
+		dependentTx("txB-on-missing", "txA-missing"),
+		dependentTx("txB-on-later-block", "txA-later"),
+		dependentTx("txB-on-later-tx", "txD"),
+		dependentTx("txC", ""),
+		dependentTx("txD", "txC"),
+		dependentTx("txX", "txY"),
+		dependentTx("txY", "txX"),
+		dependentTx("txE", "txB-on-invalid"),
+	)
+
+	expectedResults := []*types.ValidationInfo{
+		{
+			Flag:       types.Flag_VALID,
+			Dependency: &types.TxDependency{TxId: "txA-valid", BlockNumber: 1, TxIndex: 0, Flag: types.Flag_VALID},
+		},
+		{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: "the dependency [txA-invalid] at block [1], index [1], is invalid with flag [INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE]",
+			Dependency: &types.TxDependency{
+				TxId:        "txA-invalid",
+				BlockNumber: 1,
+				TxIndex:     1,
+				Flag:        types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+			},
+		},
+		{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: "the dependency [txA-missing] is not committed",
+			Dependency:      &types.TxDependency{TxId: "txA-missing"},
+		},
+		{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: "the dependency [txA-later] is not committed",
+			Dependency:      &types.TxDependency{TxId: "txA-later"},
+		},
+		{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: "the dependency [txD] is ordered after the transaction in the block",
+			Dependency:      &types.TxDependency{TxId: "txD"},
+		},
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag:       types.Flag_VALID,
+			Dependency: &types.TxDependency{TxId: "txC", BlockNumber: 2, TxIndex: 5, Flag: types.Flag_VALID},
+		},
+		{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: "the dependency [txY] of the transaction forms a cycle within the block",
+			Dependency:      &types.TxDependency{TxId: "txY"},
+		},
+		{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: "the dependency [txX] of the transaction forms a cycle within the block",
+			Dependency:      &types.TxDependency{TxId: "txX"},
+		},
+		{
+			Flag:            types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			ReasonIfInvalid: "the dependency [txB-on-invalid] at block [2], index [1], is invalid with flag [INVALID_DEPENDENCY_NOT_SATISFIED]",
+			Dependency: &types.TxDependency{
+				TxId:        "txB-on-invalid",
+				BlockNumber: 2,
+				TxIndex:     1,
+				Flag:        types.Flag_INVALID_DEPENDENCY_NOT_SATISFIED,
+			},
+		},
+	}
+
+	requireResults := func() {
+		results, err := env.validator.ValidateBlock(block2)
+		require.NoError(t, err)
+		require.Len(t, results, len(expectedResults))
+		for i := range expectedResults {
+			require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+		}
+	}
+	requireResults()
+
+	// once block 3 commits the dependency, validating block 2 anew, e.g., to trace it, yields the same results
+	block2.Header.ValidationInfo = expectedResults
+	require.NoError(t, env.blockStore.Commit(block2))
+	block3 := dataBlock(3, dependentTx("txA-later", ""))
+	block3.Header.ValidationInfo = []*types.ValidationInfo{{Flag: types.Flag_VALID}}
+	require.NoError(t, env.blockStore.Commit(block3))
+	requireResults()
+
+	// a block of older rules ignores the dependencies, as the nodes that produced it did
+	block4 := dataBlock(4, dependentTx("txF", "txA-missing"), dependentTx("txG", "txG"))
+	block4.Header.BaseHeader.RulesVersion = constants.SchemaRulesVersion
+	results, err := env.validator.ValidateBlock(block4)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for i := range results {
+		require.True(t, proto.Equal(&types.ValidationInfo{Flag: types.Flag_VALID}, results[i]), "tx %d, actual: %v", i, results[i])
+	}
+}
+
+func TestValidateExpiredDataTxs(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1"})
+	user1Cert, user1Signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	user, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: user1Cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:   string(identity.UserNamespace) + "user1",
+					Value: user,
+				},
+			},
+		},
+	}, 1))
+
+	txWithDeadline := func(txID string, deadline *types.TxDeadline) *types.DataTxEnvelope {
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{user1Signer}, &types.DataTx{
+			MustSignUserIds:   []string{"user1"},
+			TxId:              txID,
+			NotCommittedAfter: deadline,
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     worldstate.DefaultDBName,
+					DataWrites: []*types.DataWrite{{Key: txID, Value: []byte("value")}},
+				},
+			},
+		})
+	}
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number:       5,
+				Timestamp:    1000,
+				RulesVersion: constants.TxDeadlinesRulesVersion,
+			},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{
+					txWithDeadline("tx-no-deadline", nil),
+					txWithDeadline("tx-block-reached", &types.TxDeadline{BlockNumber: 5}),
+					txWithDeadline("tx-block-passed", &types.TxDeadline{BlockNumber: 4}),
+					txWithDeadline("tx-time-reached", &types.TxDeadline{Timestamp: 1000}),
+					txWithDeadline("tx-time-passed", &types.TxDeadline{Timestamp: 999}),
+					txWithDeadline("tx-either-passed", &types.TxDeadline{BlockNumber: 10, Timestamp: 999}),
+				},
+			},
+		},
+	}
+
+	expectedResults := []*types.ValidationInfo{
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag:            types.Flag_INVALID_EXPIRED,
+			ReasonIfInvalid: "the transaction must not be committed after block [4] or timestamp [0], but the block [5] has the timestamp [1000]",
+		},
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag:            types.Flag_INVALID_EXPIRED,
+			ReasonIfInvalid: "the transaction must not be committed after block [0] or timestamp [999], but the block [5] has the timestamp [1000]",
+		},
+		{
+			Flag:            types.Flag_INVALID_EXPIRED,
+			ReasonIfInvalid: "the transaction must not be committed after block [10] or timestamp [999], but the block [5] has the timestamp [1000]",
+		},
+	}
+
+	results, err := env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	require.Len(t, results, len(expectedResults))
+	for i := range expectedResults {
+		require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+	}
+
+	// a block produced by a node of the previous minor version, which lacks the rules version, is validated with the
+	// legacy rules, which do not expire transactions
+	block.Header.BaseHeader.RulesVersion = 0
+	results, err = env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	require.Len(t, results, len(expectedResults))
+	for i := range results {
+		require.Equal(t, types.Flag_VALID, results[i].Flag, "tx %d, actual: %v", i, results[i])
+	}
+}
+
+func TestValidateAttestationDataTxs(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"user1", "user2"})
+	user1Cert, user1Signer := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	user2Cert, user2Signer := testutils.LoadTestCrypto(t, cryptoDir, "user2")
+
+	env := newValidatorTestEnv(t)
+	defer env.cleanup()
+
+	user1, err := proto.Marshal(&types.User{
+		Id:          "user1",
+		Certificate: user1Cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_ReadWrite,
+			},
+		},
+	})
+	require.NoError(t, err)
+	user2, err := proto.Marshal(&types.User{
+		Id:          "user2",
+		Certificate: user2Cert.Raw,
+		Privilege: &types.Privilege{
+			DbPermission: map[string]types.Privilege_Access{
+				worldstate.DefaultDBName: types.Privilege_Read,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.UsersDBName: {
+			Writes: []*worldstate.KVWithMetadata{
+				{
+					Key:   string(identity.UserNamespace) + "user1",
+					Value: user1,
+				},
+				{
+					Key:   string(identity.UserNamespace) + "user2",
+					Value: user2,
+				},
+			},
+		},
+	}, 1))
+
+	attestation := func(txID string, signer crypto.Signer, userID string, payload []byte, ops ...*types.DBOperation) *types.DataTxEnvelope {
+		if len(ops) == 0 {
+			ops = []*types.DBOperation{{DbName: worldstate.DefaultDBName}}
+		}
+		return testutils.SignedDataTxEnvelope(t, []crypto.Signer{signer}, &types.DataTx{
+			MustSignUserIds:    []string{userID},
+			TxId:               txID,
+			DbOperations:       ops,
+			AttestationPayload: payload,
+		})
+	}
+	digest := []byte("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+
+	sequenced := attestation("tx-sequence", user1Signer, "user1", digest)
+	sequenced.Payload.Sequence = 1
+	sequenced = testutils.SignedDataTxEnvelope(t, []crypto.Signer{user1Signer}, sequenced.Payload)
+
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			BaseHeader: &types.BlockHeaderBase{
+				Number:       2,
+				RulesVersion: constants.AttestationRulesVersion,
+			},
+		},
+		Payload: &types.Block_DataTxEnvelopes{
+			DataTxEnvelopes: &types.DataTxEnvelopes{
+				Envelopes: []*types.DataTxEnvelope{
+					attestation("tx-valid", user1Signer, "user1", digest),
+					attestation("tx-max-size", user1Signer, "user1", make([]byte, MaxAttestationPayloadSize)),
+					attestation("tx-too-large", user1Signer, "user1", make([]byte, MaxAttestationPayloadSize+1)),
+					attestation("tx-two-dbs", user1Signer, "user1", digest,
+						&types.DBOperation{DbName: worldstate.DefaultDBName}, &types.DBOperation{DbName: "db1"}),
+					attestation("tx-write", user1Signer, "user1", digest, &types.DBOperation{
+						DbName:     worldstate.DefaultDBName,
+						DataWrites: []*types.DataWrite{{Key: "key1", Value: []byte("value1")}},
+					}),
+					attestation("tx-read", user1Signer, "user1", digest, &types.DBOperation{
+						DbName:    worldstate.DefaultDBName,
+						DataReads: []*types.DataRead{{Key: "key1", Version: &types.Version{BlockNum: 1}}},
+					}),
+					sequenced,
+					attestation("tx-no-permission", user2Signer, "user2", digest),
+				},
+			},
+		},
+	}
+
+	expectedResults := []*types.ValidationInfo{
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag: types.Flag_VALID,
+		},
+		{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the attestation payload has [4097] bytes, which exceeds the limit of [4096] bytes",
+		},
+		{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "an attestation must name a single database, but the transaction has operations on [2] databases",
+		},
+		{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "an attestation must not read or modify the database [" + worldstate.DefaultDBName + "]",
+		},
+		{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "an attestation must not read or modify the database [" + worldstate.DefaultDBName + "]",
+		},
+		{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "an attestation cannot carry a sequence number, as it does not commit to the state",
+		},
+		{
+			Flag:            types.Flag_INVALID_NO_PERMISSION,
+			ReasonIfInvalid: "none of the user in [user2] has read-write permission on the database [" + worldstate.DefaultDBName + "]",
+		},
+	}
+
+	results, err := env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	require.Len(t, results, len(expectedResults))
+	for i := range expectedResults {
+		require.True(t, proto.Equal(expectedResults[i], results[i]), "tx %d, expected: %v, actual: %v", i, expectedResults[i], results[i])
+	}
+
+	// a block of older rules ignores the attestation payload, as the nodes that produced it did, hence, the write is
+	// validated as such
+	block.Header.BaseHeader.RulesVersion = constants.TxDeadlinesRulesVersion
+	results, err = env.validator.ValidateBlock(block)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, results[2].Flag, "actual: %v", results[2])
+	require.Equal(t, types.Flag_VALID, results[4].Flag, "actual: %v", results[4])
+}
+
+func TestValidateUserBlock(t *testing.T) {
+	t.Parallel()
+
+	cryptoDir := testutils.GenerateTestCrypto(t, []string{"adminUser", "user1", "user2"})
+	adminCert, adminSigner := testutils.LoadTestCrypto(t, cryptoDir, "adminUser")
+	user1Cert, _ := testutils.LoadTestCrypto(t, cryptoDir, "user1")
+	user2Cert, _ := testutils.LoadTestCrypto(t, cryptoDir, "user2")
+	caCert, _ := testutils.LoadTestCA(t, cryptoDir, testutils.RootCAFileName)
+	require.True(t, caCert.IsCA)
+
+	adminUser := &types.User{
+		Id:          "adminUser",
+		Certificate: adminCert.Raw,
+		Privilege: &types.Privilege{
+			Admin: true,
+		},
+	}
+	adminUserSerialized, err := proto.Marshal(adminUser)
+	require.NoError(t, err)
+
+	sampleVersion := &types.Version{
+		BlockNum: 2,
+		TxNum:    1,
+	}
+
+	tests := []struct {
+		name            string
+		setup           func(db worldstate.DB)
+		block           *types.Block
+		expectedResults []*types.ValidationInfo
+	}{
+		{
+			name: "user block with an invalid transaction",
+			setup: func(db worldstate.DB) {
+				newUsers := map[string]*worldstate.DBUpdates{
+					worldstate.UsersDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key:   string(identity.UserNamespace) + "adminUser",
+								Value: adminUserSerialized,
+							},
+							constructUserForTest(t, "user1", nil, nil, sampleVersion, &types.AccessControl{
+								ReadUsers: map[string]bool{
+									"adminUser": true,
+								},
+							}),
+						},
+					},
+				}
+
+				require.NoError(t, db.Commit(newUsers, 1))
+			},
+			block: &types.Block{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number: 2,
+					},
+				},
+				Payload: &types.Block_UserAdministrationTxEnvelope{
+					UserAdministrationTxEnvelope: testutils.SignedUserAdministrationTxEnvelope(t, adminSigner,
+						&types.UserAdministrationTx{
+							UserId: "adminUser",
+							UserReads: []*types.UserRead{
+								{
+									UserId: "user1",
+									Version: &types.Version{
+										BlockNum: 100,
+										TxNum:    1000,
+									},
+								},
+							},
+						},
+					),
+				},
+			},
+			expectedResults: []*types.ValidationInfo{
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+					ReasonIfInvalid: "mvcc conflict has occurred as the committed state for the user [user1] has changed",
+				},
+			},
+		},
+		{
+			name: "user block with an valid transaction",
+			setup: func(db worldstate.DB) {
+				newUsers := map[string]*worldstate.DBUpdates{
+					worldstate.UsersDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key:   string(identity.UserNamespace) + "adminUser",
+								Value: adminUserSerialized,
+							},
+						},
+					},
+				}
+
+				require.NoError(t, db.Commit(newUsers, 1))
+			},
+			block: &types.Block{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number: 2,
+					},
+				},
+				Payload: &types.Block_UserAdministrationTxEnvelope{
+					UserAdministrationTxEnvelope: testutils.SignedUserAdministrationTxEnvelope(t, adminSigner,
+						&types.UserAdministrationTx{
+							UserId: "adminUser",
+							UserReads: []*types.UserRead{
+								{
+									UserId: "user1",
+								},
+								{
+									UserId: "user2",
+								},
+							},
+						},
+					),
+				},
+			},
+			expectedResults: []*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+			},
+		},
+		{
+			name: "user block with a mini-batch of transactions",
+			setup: func(db worldstate.DB) {
+				newUsers := map[string]*worldstate.DBUpdates{
+					worldstate.UsersDBName: {
+						Writes: []*worldstate.KVWithMetadata{
+							{
+								Key:   string(identity.UserNamespace) + "adminUser",
+								Value: adminUserSerialized,
+							},
+						},
+					},
+				}
+
+				require.NoError(t, db.Commit(newUsers, 1))
+			},
+			block: &types.Block{
+				Header: &types.BlockHeader{
+					BaseHeader: &types.BlockHeaderBase{
+						Number: 2,
+					},
+				},
+				Payload: &types.Block_UserAdministrationTxEnvelopes{
+					UserAdministrationTxEnvelopes: &types.UserAdministrationTxEnvelopes{
+						Envelopes: []*types.UserAdministrationTxEnvelope{
+							testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+								UserId:     "adminUser",
+								TxId:       "tx1",
+								UserWrites: []*types.UserWrite{{User: &types.User{Id: "user1", Certificate: user1Cert.Raw}}},
+							}),
+							testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+								UserId:     "adminUser",
+								TxId:       "tx2",
+								UserWrites: []*types.UserWrite{{User: &types.User{Id: "user1", Certificate: user2Cert.Raw}}},
+							}),
+							testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+								UserId:    "adminUser",
+								TxId:      "tx3",
+								UserReads: []*types.UserRead{{UserId: "user1"}},
+							}),
+							testutils.SignedUserAdministrationTxEnvelope(t, adminSigner, &types.UserAdministrationTx{
+								UserId:     "adminUser",
+								TxId:       "tx4",
+								UserWrites: []*types.UserWrite{{User: &types.User{Id: "user2", Certificate: user2Cert.Raw}}},
+							}),
+						},
+					},
+				},
+			},
+			expectedResults: []*types.ValidationInfo{
+				{
+					Flag: types.Flag_VALID,
+				},
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "the user [user1] is already modified by some previous transaction in the block",
+				},
+				{
+					Flag:            types.Flag_INVALID_MVCC_CONFLICT_WITHIN_BLOCK,
+					ReasonIfInvalid: "the user [user1] is already modified by some previous transaction in the block",
+				},
+				{
+					Flag: types.Flag_VALID,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
 			env := newValidatorTestEnv(t)
 			defer env.cleanup()
@@ -793,6 +2576,8 @@ func TestValidateUserBlock(t *testing.T) {
 			results, err := env.validator.ValidateBlock(tt.block)
 			require.NoError(t, err)
 			require.Equal(t, tt.expectedResults, results)
+
+			requireStreamedResults(t, env.validator, tt.block, results)
 		})
 	}
 }
@@ -946,6 +2731,12 @@ func TestValidateConfigBlock(t *testing.T) {
 					Certificate: nodeCert.Raw,
 				},
 			},
+			Admins: []*types.Admin{
+				{
+					Id:          "admin1",
+					Certificate: adminCert.Raw,
+				},
+			},
 			ConsensusConfig: &types.ConsensusConfig{
 				Algorithm: "raft",
 				Members: []*types.PeerConfig{
@@ -1141,3 +2932,70 @@ func TestValidateConfigBlock(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkMVCCValidationOfBlock(b *testing.B) {
+	env := newValidatorTestEnv(b)
+	defer env.cleanup()
+
+	// a block of 1000 transactions, each reading 5 keys, where half of the keys are read by two transactions
+	const numTxs, readsPerTx = 1000, 5
+	version := &types.Version{BlockNum: 1, TxNum: 0}
+	var writes []*worldstate.KVWithMetadata
+	for i := 0; i < numTxs*readsPerTx; i++ {
+		writes = append(writes, &worldstate.KVWithMetadata{
+			Key:      fmt.Sprintf("key%d", i),
+			Value:    []byte("value"),
+			Metadata: &types.Metadata{Version: version},
+		})
+	}
+	require.NoError(b, env.db.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DefaultDBName: {Writes: writes},
+	}, 1))
+
+	txEnvs := make([]*types.DataTxEnvelope, numTxs)
+	valInfo := make([]*types.ValidationInfo, numTxs)
+	for txNum := range txEnvs {
+		ops := &types.DBOperation{DbName: worldstate.DefaultDBName}
+		for r := 0; r < readsPerTx; r++ {
+			ops.DataReads = append(ops.DataReads, &types.DataRead{
+				Key:     fmt.Sprintf("key%d", (txNum*readsPerTx+r)/2),
+				Version: version,
+			})
+		}
+		txEnvs[txNum] = &types.DataTxEnvelope{Payload: &types.DataTx{DbOperations: []*types.DBOperation{ops}}}
+		valInfo[txNum] = &types.ValidationInfo{Flag: types.Flag_VALID}
+	}
+
+	db := testfault.NewDB(env.db)
+	env.validator.dataTxValidator.db = db
+
+	validate := func(b *testing.B, pendingOps *pendingOperations) {
+		for _, txEnv := range txEnvs {
+			ops := txEnv.Payload.DbOperations[0]
+			res, err := env.validator.dataTxValidator.mvccValidation(ops.DbName, ops, pendingOps)
+			require.NoError(b, err)
+			require.Equal(b, types.Flag_VALID, res.Flag)
+		}
+	}
+	worldstateCalls := func() int {
+		return db.Calls("GetVersion") + db.Calls("GetDBsSnapshot") + db.Calls("Exist")
+	}
+
+	b.Run("lookup per read", func(b *testing.B) {
+		start := worldstateCalls()
+		for i := 0; i < b.N; i++ {
+			validate(b, newPendingOperations())
+		}
+		b.ReportMetric(float64(worldstateCalls()-start)/float64(b.N), "worldstate-calls/op")
+	})
+
+	b.Run("prefetched reads", func(b *testing.B) {
+		start := worldstateCalls()
+		for i := 0; i < b.N; i++ {
+			pendingOps := newPendingOperations()
+			require.NoError(b, env.validator.prefetchReadVersions(txEnvs, valInfo, pendingOps))
+			validate(b, pendingOps)
+		}
+		b.ReportMetric(float64(worldstateCalls()-start)/float64(b.N), "worldstate-calls/op")
+	})
+}
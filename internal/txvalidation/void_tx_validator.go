@@ -0,0 +1,43 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package txvalidation
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+type voidTxValidator struct {
+	identityQuerier *identity.Querier
+	sigValidator    *txSigValidator
+	tracer          *tracer
+	logger          *logger.SugarLogger
+}
+
+// validate checks that the void transaction is signed by its user and, if it carries a sequence number, that it is
+// the next one of the user. A valid void is flagged VOIDED, as it reserves its ID but writes nothing.
+func (v *voidTxValidator) validate(txEnv *types.VoidTxEnvelope, pendingOps *pendingOperations) (*types.ValidationInfo, error) {
+	tx := txEnv.GetPayload()
+	if tx.GetUserId() == "" {
+		return &types.ValidationInfo{
+			Flag:            types.Flag_INVALID_INCORRECT_ENTRIES,
+			ReasonIfInvalid: "the user ID of the void transaction cannot be empty",
+		}, nil
+	}
+
+	valInfo, err := v.sigValidator.validate(tx.UserId, txEnv.Signature, tx)
+	if err != nil || valInfo.Flag != types.Flag_VALID {
+		return valInfo, err
+	}
+
+	if tx.Sequence > 0 {
+		valInfo, err = validateSequenceOfUser(v.identityQuerier, v.tracer, tx.UserId, tx.Sequence, pendingOps)
+		if err != nil || valInfo.Flag != types.Flag_VALID {
+			return valInfo, err
+		}
+	}
+
+	return &types.ValidationInfo{Flag: types.Flag_VOIDED}, nil
+}
@@ -5,10 +5,14 @@ package utils
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 func BlockPayloadToTxIDs(blockPayload interface{}) ([]string, error) {
@@ -43,6 +47,23 @@ func BlockPayloadToTxIDs(blockPayload interface{}) ([]string, error) {
 		}
 		txIDs = append(txIDs, id)
 
+	case *types.Block_UserAdministrationTxEnvelopes:
+		for i, uEnv := range env.UserAdministrationTxEnvelopes.GetEnvelopes() {
+			p := uEnv.GetPayload()
+			if p == nil {
+				return nil, errors.Errorf("empty payload in index [%d]: %+v", i, env)
+			}
+			id := p.GetTxId()
+			if id == "" {
+				return nil, errors.Errorf("missing TxId in index [%d]: %+v", i, uEnv)
+			}
+			txIDs = append(txIDs, id)
+		}
+
+		if len(txIDs) == 0 {
+			return nil, errors.Errorf("empty payload in: %+v", blockPayload)
+		}
+
 	case *types.Block_ConfigTxEnvelope:
 		p := env.ConfigTxEnvelope.GetPayload()
 		if p == nil {
@@ -65,6 +86,40 @@ func BlockPayloadToTxIDs(blockPayload interface{}) ([]string, error) {
 		}
 		txIDs = append(txIDs, id)
 
+	case *types.Block_HeartbeatTxEnvelopes:
+		for i, hEnv := range env.HeartbeatTxEnvelopes.GetEnvelopes() {
+			p := hEnv.GetPayload()
+			if p == nil {
+				return nil, errors.Errorf("empty payload in index [%d]: %+v", i, env)
+			}
+			id := p.GetTxId()
+			if id == "" {
+				return nil, errors.Errorf("missing TxId in index [%d]: %+v", i, hEnv)
+			}
+			txIDs = append(txIDs, id)
+		}
+
+		if len(txIDs) == 0 {
+			return nil, errors.Errorf("empty payload in: %+v", blockPayload)
+		}
+
+	case *types.Block_VoidTxEnvelopes:
+		for i, vEnv := range env.VoidTxEnvelopes.GetEnvelopes() {
+			p := vEnv.GetPayload()
+			if p == nil {
+				return nil, errors.Errorf("empty payload in index [%d]: %+v", i, env)
+			}
+			id := p.GetTxId()
+			if id == "" {
+				return nil, errors.Errorf("missing TxId in index [%d]: %+v", i, vEnv)
+			}
+			txIDs = append(txIDs, id)
+		}
+
+		if len(txIDs) == 0 {
+			return nil, errors.Errorf("empty payload in: %+v", blockPayload)
+		}
+
 	default:
 		return nil, errors.Errorf("unexpected envelope type: %v", env)
 	}
@@ -72,6 +127,62 @@ func BlockPayloadToTxIDs(blockPayload interface{}) ([]string, error) {
 	return txIDs, nil
 }
 
+// TxSubmitter returns the submitter of a transaction envelope: the first signer of a data transaction, the user of
+// an administration, config, or void transaction, or the node of a heartbeat. An empty string is returned for an
+// unknown envelope.
+func TxSubmitter(txEnv interface{}) string {
+	switch env := txEnv.(type) {
+	case *types.DataTxEnvelope:
+		if signers := env.GetPayload().GetMustSignUserIds(); len(signers) > 0 {
+			return signers[0]
+		}
+	case *types.UserAdministrationTxEnvelope:
+		return env.GetPayload().GetUserId()
+	case *types.DBAdministrationTxEnvelope:
+		return env.GetPayload().GetUserId()
+	case *types.ConfigTxEnvelope:
+		return env.GetPayload().GetUserId()
+	case *types.VoidTxEnvelope:
+		return env.GetPayload().GetUserId()
+	case *types.HeartbeatTxEnvelope:
+		return env.GetPayload().GetNodeId()
+	}
+
+	return ""
+}
+
+// BlockEnvelopes returns the transaction envelopes of the block, in the order of the transactions, or nil for an
+// unknown payload
+func BlockEnvelopes(block *types.Block) []protov2.Message {
+	var envelopes []protov2.Message
+	switch block.GetPayload().(type) {
+	case *types.Block_DataTxEnvelopes:
+		for _, env := range block.GetDataTxEnvelopes().GetEnvelopes() {
+			envelopes = append(envelopes, env)
+		}
+	case *types.Block_HeartbeatTxEnvelopes:
+		for _, env := range block.GetHeartbeatTxEnvelopes().GetEnvelopes() {
+			envelopes = append(envelopes, env)
+		}
+	case *types.Block_VoidTxEnvelopes:
+		for _, env := range block.GetVoidTxEnvelopes().GetEnvelopes() {
+			envelopes = append(envelopes, env)
+		}
+	case *types.Block_UserAdministrationTxEnvelopes:
+		for _, env := range block.GetUserAdministrationTxEnvelopes().GetEnvelopes() {
+			envelopes = append(envelopes, env)
+		}
+	case *types.Block_UserAdministrationTxEnvelope:
+		envelopes = append(envelopes, block.GetUserAdministrationTxEnvelope())
+	case *types.Block_DbAdministrationTxEnvelope:
+		envelopes = append(envelopes, block.GetDbAdministrationTxEnvelope())
+	case *types.Block_ConfigTxEnvelope:
+		envelopes = append(envelopes, block.GetConfigTxEnvelope())
+	}
+
+	return envelopes
+}
+
 func IsConfigBlock(block *types.Block) bool {
 	switch block.GetPayload().(type) {
 	case *types.Block_ConfigTxEnvelope:
@@ -81,6 +192,110 @@ func IsConfigBlock(block *types.Block) bool {
 	}
 }
 
+// RulesVersion returns the version of the validation rules the block must be validated with. A block that does not
+// record it was produced by a node that predates the field, and is validated with the legacy rules.
+func RulesVersion(baseHeader *types.BlockHeaderBase) uint32 {
+	if v := baseHeader.GetRulesVersion(); v != 0 {
+		return v
+	}
+	return constants.LegacyRulesVersion
+}
+
+// IsAttestation returns true if the data transaction is an attestation under the rules the block holding it must be
+// validated with. The attestation payload of a transaction in a block of older rules is ignored, as it was by the nodes
+// that produced the block.
+func IsAttestation(tx *types.DataTx, baseHeader *types.BlockHeaderBase) bool {
+	return len(tx.GetAttestationPayload()) > 0 && RulesVersion(baseHeader) >= constants.AttestationRulesVersion
+}
+
+// IsOrdered returns true if the data transaction consumes a sequence number under the rules the block holding it must
+// be validated with. The sequence number of a transaction in a block of older rules is ignored, as it was by the nodes
+// that produced the block.
+func IsOrdered(tx *types.DataTx, baseHeader *types.BlockHeaderBase) bool {
+	return tx.GetSequence() > 0 && RulesVersion(baseHeader) >= constants.SequenceRulesVersion
+}
+
+// HasWriteSetDigests returns true if the valid data transactions carry a write-set digest in their validation info under
+// the rules the block must be validated with
+func HasWriteSetDigests(baseHeader *types.BlockHeaderBase) bool {
+	return RulesVersion(baseHeader) >= constants.WriteSetDigestRulesVersion
+}
+
+// RulesValidationInfo returns the validation info without the fields that the rules the block must be validated with
+// do not define, so that the validation info computed by a node is compared with the one computed by an older node,
+// e.g., the producer of the block, by the fields that both compute. The given validation info is not modified.
+func RulesValidationInfo(info *types.ValidationInfo, baseHeader *types.BlockHeaderBase) *types.ValidationInfo {
+	rulesVersion := RulesVersion(baseHeader)
+	if info == nil || rulesVersion >= constants.ConflictingReadsRulesVersion {
+		return info
+	}
+
+	defined := protov2.Clone(info).(*types.ValidationInfo)
+	defined.ConflictingReads = nil
+	if rulesVersion < constants.WriteSetDigestRulesVersion {
+		defined.WriteSetDigest = nil
+	}
+	if rulesVersion < constants.TxDependenciesRulesVersion {
+		defined.Dependency = nil
+	}
+	return defined
+}
+
+// CheckBlockFormat returns an error if a node of the given software and rules versions cannot validate the block.
+// A block that requires rules newer than the node's is always refused. A block in the old format, i.e., without a
+// rules version, is refused in strict mode, and otherwise accepted only if it was produced by a node at most one minor
+// version older. A block without a producer version was produced by a node of the minor version that preceded the
+// field, hence one minor version older than the one that introduced it.
+func CheckBlockFormat(baseHeader *types.BlockHeaderBase, localVersion string, localRulesVersion uint32, strict bool) error {
+	if rulesVersion := baseHeader.GetRulesVersion(); rulesVersion != 0 {
+		if rulesVersion > localRulesVersion {
+			return errors.Errorf("block [%d] requires the validation rules version [%d], but this node implements version [%d]",
+				baseHeader.GetNumber(), rulesVersion, localRulesVersion)
+		}
+		return nil
+	}
+
+	if strict {
+		return errors.Errorf("block [%d] lacks a rules version, and old-format blocks are refused in strict mode", baseHeader.GetNumber())
+	}
+
+	if baseHeader.GetProducerVersion() == "" {
+		return nil
+	}
+
+	localMajor, localMinor, err := parseVersion(localVersion)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse the local version")
+	}
+	producerMajor, producerMinor, err := parseVersion(baseHeader.GetProducerVersion())
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the producer version of block [%d]", baseHeader.GetNumber())
+	}
+	if producerMajor != localMajor || producerMinor+1 < localMinor {
+		return errors.Errorf("block [%d] lacks a rules version and was produced by version [%s], which is more than one minor version older than [%s]",
+			baseHeader.GetNumber(), baseHeader.GetProducerVersion(), localVersion)
+	}
+
+	return nil
+}
+
+// parseVersion parses the major and minor numbers of a version in the form "major.minor[.patch]".
+func parseVersion(version string) (uint64, uint64, error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, errors.Errorf("version [%s] is not in the form major.minor", version)
+	}
+	major, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("version [%s] has an invalid major number", version)
+	}
+	minor, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("version [%s] has an invalid minor number", version)
+	}
+	return major, minor, nil
+}
+
 func MarshalOrPanic(m proto.Message) []byte {
 	bytes, err := proto.Marshal(m)
 	if err != nil {
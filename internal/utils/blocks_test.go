@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/internal/utils"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/stretchr/testify/require"
 )
@@ -255,3 +257,149 @@ func TestIsConfigBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestTxSubmitter(t *testing.T) {
+	require.Equal(t, "alice", utils.TxSubmitter(&types.DataTxEnvelope{Payload: &types.DataTx{MustSignUserIds: []string{"alice", "bob"}}}))
+	require.Equal(t, "", utils.TxSubmitter(&types.DataTxEnvelope{Payload: &types.DataTx{}}))
+	require.Equal(t, "admin", utils.TxSubmitter(&types.UserAdministrationTxEnvelope{Payload: &types.UserAdministrationTx{UserId: "admin"}}))
+	require.Equal(t, "admin", utils.TxSubmitter(&types.DBAdministrationTxEnvelope{Payload: &types.DBAdministrationTx{UserId: "admin"}}))
+	require.Equal(t, "admin", utils.TxSubmitter(&types.ConfigTxEnvelope{Payload: &types.ConfigTx{UserId: "admin"}}))
+	require.Equal(t, "alice", utils.TxSubmitter(&types.VoidTxEnvelope{Payload: &types.VoidTx{UserId: "alice"}}))
+	require.Equal(t, "node1", utils.TxSubmitter(&types.HeartbeatTxEnvelope{Payload: &types.HeartbeatTx{NodeId: "node1"}}))
+	require.Equal(t, "", utils.TxSubmitter(&types.Block{}))
+}
+
+func TestRulesVersion(t *testing.T) {
+	require.Equal(t, constants.LegacyRulesVersion, utils.RulesVersion(nil))
+	require.Equal(t, constants.LegacyRulesVersion, utils.RulesVersion(&types.BlockHeaderBase{ProducerVersion: "1.4"}))
+	require.Equal(t, constants.TxDeadlinesRulesVersion, utils.RulesVersion(&types.BlockHeaderBase{RulesVersion: constants.TxDeadlinesRulesVersion}))
+}
+
+func TestIsAttestation(t *testing.T) {
+	attestation := &types.DataTx{AttestationPayload: []byte("digest")}
+	current := &types.BlockHeaderBase{RulesVersion: constants.AttestationRulesVersion}
+
+	require.True(t, utils.IsAttestation(attestation, current))
+	require.False(t, utils.IsAttestation(&types.DataTx{}, current))
+	require.False(t, utils.IsAttestation(attestation, &types.BlockHeaderBase{RulesVersion: constants.TxDeadlinesRulesVersion}))
+	require.False(t, utils.IsAttestation(attestation, &types.BlockHeaderBase{ProducerVersion: "1.4"}))
+}
+
+func TestIsOrdered(t *testing.T) {
+	ordered := &types.DataTx{MustSignUserIds: []string{"alice"}, Sequence: 4}
+	current := &types.BlockHeaderBase{RulesVersion: constants.SequenceRulesVersion}
+
+	require.True(t, utils.IsOrdered(ordered, current))
+	require.False(t, utils.IsOrdered(&types.DataTx{MustSignUserIds: []string{"alice"}}, current))
+	require.False(t, utils.IsOrdered(ordered, &types.BlockHeaderBase{RulesVersion: constants.KeyFormatRulesVersion}))
+	require.False(t, utils.IsOrdered(ordered, &types.BlockHeaderBase{ProducerVersion: "1.4"}))
+}
+
+func TestHasWriteSetDigests(t *testing.T) {
+	require.True(t, utils.HasWriteSetDigests(&types.BlockHeaderBase{RulesVersion: constants.WriteSetDigestRulesVersion}))
+	require.False(t, utils.HasWriteSetDigests(&types.BlockHeaderBase{RulesVersion: constants.ImmutableDBRulesVersion}))
+	require.False(t, utils.HasWriteSetDigests(&types.BlockHeaderBase{ProducerVersion: "1.4"}))
+}
+
+func TestRulesValidationInfo(t *testing.T) {
+	info := &types.ValidationInfo{
+		Flag:             types.Flag_INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE,
+		ReasonIfInvalid:  "mvcc conflict",
+		WriteSetDigest:   []byte("digest"),
+		ConflictingReads: []*types.ConflictingRead{{DbName: "db1", Key: "key1"}},
+		Dependency:       &types.TxDependency{TxId: "tx1"},
+	}
+
+	require.Same(t, info, utils.RulesValidationInfo(info, &types.BlockHeaderBase{RulesVersion: constants.ConflictingReadsRulesVersion}))
+	require.True(t, proto.Equal(&types.ValidationInfo{
+		Flag:            info.Flag,
+		ReasonIfInvalid: info.ReasonIfInvalid,
+		WriteSetDigest:  info.WriteSetDigest,
+		Dependency:      info.Dependency,
+	}, utils.RulesValidationInfo(info, &types.BlockHeaderBase{RulesVersion: constants.WriteSetDigestRulesVersion})))
+	require.True(t, proto.Equal(&types.ValidationInfo{
+		Flag:            info.Flag,
+		ReasonIfInvalid: info.ReasonIfInvalid,
+		Dependency:      info.Dependency,
+	}, utils.RulesValidationInfo(info, &types.BlockHeaderBase{RulesVersion: constants.TxDependenciesRulesVersion})))
+	require.True(t, proto.Equal(&types.ValidationInfo{
+		Flag:            info.Flag,
+		ReasonIfInvalid: info.ReasonIfInvalid,
+	}, utils.RulesValidationInfo(info, &types.BlockHeaderBase{ProducerVersion: "1.4"})))
+	// the given validation info is left as it is
+	require.Len(t, info.ConflictingReads, 1)
+	require.Nil(t, utils.RulesValidationInfo(nil, nil))
+}
+
+func TestCheckBlockFormat(t *testing.T) {
+	testCases := []struct {
+		name          string
+		baseHeader    *types.BlockHeaderBase
+		strict        bool
+		expectedError string
+	}{
+		{
+			name:       "current format",
+			baseHeader: &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.5", RulesVersion: 2},
+		},
+		{
+			name:       "current format, strict",
+			baseHeader: &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.5", RulesVersion: 2},
+			strict:     true,
+		},
+		{
+			name:       "older rules",
+			baseHeader: &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.4", RulesVersion: 1},
+			strict:     true,
+		},
+		{
+			name:          "newer rules",
+			baseHeader:    &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.6", RulesVersion: 3},
+			expectedError: "block [3] requires the validation rules version [3], but this node implements version [2]",
+		},
+		{
+			name:       "old format, previous minor",
+			baseHeader: &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.4.2"},
+		},
+		{
+			name:       "old format, same minor",
+			baseHeader: &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.5"},
+		},
+		{
+			name:       "old format, no producer version",
+			baseHeader: &types.BlockHeaderBase{Number: 3},
+		},
+		{
+			name:          "old format, two minors older",
+			baseHeader:    &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.3"},
+			expectedError: "block [3] lacks a rules version and was produced by version [1.3], which is more than one minor version older than [1.5]",
+		},
+		{
+			name:          "old format, other major",
+			baseHeader:    &types.BlockHeaderBase{Number: 3, ProducerVersion: "0.5"},
+			expectedError: "block [3] lacks a rules version and was produced by version [0.5], which is more than one minor version older than [1.5]",
+		},
+		{
+			name:          "old format, bad producer version",
+			baseHeader:    &types.BlockHeaderBase{Number: 3, ProducerVersion: "1"},
+			expectedError: "failed to parse the producer version of block [3]: version [1] is not in the form major.minor",
+		},
+		{
+			name:          "old format, strict",
+			baseHeader:    &types.BlockHeaderBase{Number: 3, ProducerVersion: "1.4"},
+			strict:        true,
+			expectedError: "block [3] lacks a rules version, and old-format blocks are refused in strict mode",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := utils.CheckBlockFormat(tc.baseHeader, "1.5", 2, tc.strict)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
@@ -16,12 +16,38 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-const MultiPartFormData = "multipart/form-data"
+const (
+	MultiPartFormData = "multipart/form-data"
+	// ProblemJSON is the media type of an RFC 7807 problem detail
+	ProblemJSON = "application/problem+json"
+)
+
+// Problem is an RFC 7807 problem detail. RetryAfter and RetryJitter are extension members, in seconds, that ask the
+// client to wait for RetryAfter, plus a random interval of up to RetryJitter, before it retries the request.
+// SupportedVersions is an extension member that lists the versions of the HTTP API a request may ask for.
+type Problem struct {
+	Type              string   `json:"type"`
+	Title             string   `json:"title"`
+	Status            int      `json:"status"`
+	Detail            string   `json:"detail,omitempty"`
+	RetryAfter        int64    `json:"retry_after,omitempty"`
+	RetryJitter       int64    `json:"retry_jitter,omitempty"`
+	SupportedVersions []string `json:"supported_versions,omitempty"`
+}
+
+// ResponseShaper is implemented by the response writers that shape the responses into the version of the HTTP API
+// the client negotiated. The message is returned as is if its shape is the same in that version.
+type ResponseShaper interface {
+	ShapeResponse(m proto.Message) proto.Message
+}
 
 // SendHTTPResponse writes HTTP response back including HTTP code number and encode payload
 func SendHTTPResponse(w http.ResponseWriter, code int, payload interface{}) {
 	var response []byte
 	if p, ok := payload.(proto.Message); ok {
+		if shaper, ok := w.(ResponseShaper); ok {
+			p = shaper.ShapeResponse(p)
+		}
 		response, _ = marshal.DefaultMarshaler().Marshal(p)
 	} else {
 		response, _ = json.Marshal(payload)
@@ -33,6 +59,20 @@ func SendHTTPResponse(w http.ResponseWriter, code int, payload interface{}) {
 	}
 }
 
+// SendHTTPProblem writes the problem detail as the response, along with a Retry-After header if the problem asks
+// the client to retry later.
+func SendHTTPProblem(w http.ResponseWriter, problem *Problem) {
+	response, _ := json.Marshal(problem)
+	w.Header().Set("Content-Type", ProblemJSON)
+	if problem.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.FormatInt(problem.RetryAfter, 10))
+	}
+	w.WriteHeader(problem.Status)
+	if _, err := w.Write(response); err != nil {
+		log.Printf("Warning: failed to write response [%v] to the response writer\n", w)
+	}
+}
+
 // SendHTTPRedirectServer replaces the Host in the request URL with hostPort, and redirects using
 // StatusTemporaryRedirect (307).
 func SendHTTPRedirectServer(w http.ResponseWriter, r *http.Request, hostPort string) {
@@ -126,3 +166,34 @@ func GetVersion(params map[string]string) (*types.Version, error) {
 		TxNum:    txNum,
 	}, nil
 }
+
+// GetAsOf returns the block number of a point-in-time query, or 0 if the query is of the current state
+func GetAsOf(params map[string]string) (uint64, error) {
+	if _, ok := params["asOf"]; !ok {
+		return 0, nil
+	}
+
+	asOf, err := GetUintParam("asOf", params)
+	if err != nil {
+		return 0, err
+	}
+
+	return asOf, nil
+}
+
+// GetAnalytical returns whether a query is routed to the read replica of the state database
+func GetAnalytical(params map[string]string) (bool, error) {
+	valStr, ok := params["analytical"]
+	if !ok {
+		return false, nil
+	}
+
+	analytical, err := strconv.ParseBool(valStr)
+	if err != nil {
+		return false, &types.HttpResponseErr{
+			ErrMsg: "query error - bad or missing literal: analytical " + err.Error(),
+		}
+	}
+
+	return analytical, nil
+}
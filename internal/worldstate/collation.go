@@ -0,0 +1,326 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package worldstate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// The keys of a database with a key collation other than BINARY are collated, i.e., encoded into a representation
+// whose lexicographic order is the order of the collation, see types.DBKeyCollation. The storage layer stores and
+// ranges over the collated keys, while the original keys are still the ones written, read, and returned.
+//
+// A numeric key is collated into the number of its significant digits, the significant digits, and the number of its
+// leading zeros, where both numbers are two-byte big-endian integers, as a key is at most MaxKeyLength bytes long. A
+// composite key is collated into the concatenation of its collated segments, where a numeric segment is collated as a
+// numeric key, and a text segment is followed by textTerminator, with each of its 0x00 bytes escaped by
+// textEscapedZero. Hence, the collation of a segment is never a prefix of the collation of another one, and the
+// collated keys are ordered segment by segment.
+var (
+	textTerminator  = []byte{0x00, 0x01}
+	textEscapedZero = []byte{0x00, 0xff}
+)
+
+// ErrKeyNotCollatable denotes that a key does not conform to the key collation of its database
+type ErrKeyNotCollatable struct {
+	Key    string
+	Reason string
+}
+
+func (e *ErrKeyNotCollatable) Error() string {
+	return fmt.Sprintf("the key [%s] does not conform to the key collation of the database: %s", e.Key, e.Reason)
+}
+
+// KeyFormatVersion is the version of the format of the keys returned by the range and the index queries, i.e., of
+// their escaping and of their collation. It is bumped by a change of either that changes the order or the form of the
+// keys returned, which expires the pagination cursors issued before the change, see KeyFormat.
+const KeyFormatVersion = 1
+
+// KeyFormat returns the format of the keys of a database with the given key collation, as held by the pagination
+// cursors of its range and index queries. A database which is deleted and created again with another collation has
+// another format.
+func KeyFormat(c *types.DBKeyCollation) string {
+	format := fmt.Sprintf("keys-v%d:%s", KeyFormatVersion, c.GetMode())
+	if c.GetMode() == types.DBKeyCollation_COMPOSITE {
+		for _, s := range c.GetSegments() {
+			format += ":" + s.String()
+		}
+		format += ":" + c.GetSeparator()
+	}
+	return format
+}
+
+// IsBinaryCollation returns true if the keys are ordered byte by byte, i.e., if they are stored as they are
+func IsBinaryCollation(c *types.DBKeyCollation) bool {
+	return c.GetMode() == types.DBKeyCollation_BINARY
+}
+
+// GetKeyCollation returns the key collation declared in the committed descriptor of the database, or nil if the
+// database has none. As the collation cannot change after the creation of the database, it can be read outside of the
+// snapshot which the keys are read from.
+func GetKeyCollation(db DB, dbName string) (*types.DBKeyCollation, error) {
+	descriptor, err := getDBDescriptor(db, dbName)
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.GetKeyCollation(), nil
+}
+
+// IsImmutableDB returns true if the database is declared immutable in its committed descriptor. As a database is
+// declared immutable at its creation only, it can be read outside of the snapshot which the keys are read from.
+func IsImmutableDB(db DB, dbName string) (bool, error) {
+	descriptor, err := getDBDescriptor(db, dbName)
+	if err != nil {
+		return false, err
+	}
+	return descriptor.GetImmutable(), nil
+}
+
+// getDBDescriptor returns the committed descriptor of a user database, or nil if the database has none
+func getDBDescriptor(db DB, dbName string) (*types.DBDescriptor, error) {
+	if IsSystemDB(dbName) {
+		return nil, nil
+	}
+
+	value, metadata, err := db.Get(DBDescriptorsDBName, dbName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while fetching the descriptor of the database [%s]", dbName)
+	}
+	if metadata == nil {
+		return nil, nil
+	}
+
+	descriptor := &types.DBDescriptor{}
+	if err := protov2.Unmarshal(value, descriptor); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the descriptor of the database [%s]", dbName)
+	}
+	return descriptor, nil
+}
+
+// ValidateKeyCollation checks that the collation is well-formed
+func ValidateKeyCollation(c *types.DBKeyCollation) error {
+	switch c.GetMode() {
+	case types.DBKeyCollation_BINARY, types.DBKeyCollation_NUMERIC:
+		if len(c.GetSegments()) > 0 || c.GetSeparator() != "" {
+			return errors.Errorf("the %s key collation takes neither segments nor a separator", c.GetMode())
+		}
+	case types.DBKeyCollation_COMPOSITE:
+		if len(c.GetSegments()) == 0 {
+			return errors.New("the COMPOSITE key collation must define at least one segment")
+		}
+		if c.GetSeparator() == "" {
+			return errors.New("the COMPOSITE key collation must define a separator")
+		}
+		for _, s := range c.GetSegments() {
+			if s != types.DBKeyCollation_TEXT && s != types.DBKeyCollation_NUMBER {
+				return errors.Errorf("unknown segment type [%d]", s)
+			}
+		}
+	default:
+		return errors.Errorf("unknown key collation mode [%d]", c.GetMode())
+	}
+
+	return nil
+}
+
+// CollateKey returns the collated key, which must be a whole key of the database
+func CollateKey(c *types.DBKeyCollation, key string) ([]byte, error) {
+	return collate(c, key, false)
+}
+
+// CollateRangeBound returns the collated range bound. Unlike a key, a bound of a COMPOSITE collation may hold fewer
+// segments than a key, and then bounds the keys that begin with them.
+func CollateRangeBound(c *types.DBKeyCollation, bound string) ([]byte, error) {
+	return collate(c, bound, true)
+}
+
+func collate(c *types.DBKeyCollation, key string, partial bool) ([]byte, error) {
+	switch c.GetMode() {
+	case types.DBKeyCollation_BINARY:
+		return []byte(key), nil
+
+	case types.DBKeyCollation_NUMERIC:
+		if err := validateNumber(key); err != nil {
+			return nil, &ErrKeyNotCollatable{Key: key, Reason: err.Error()}
+		}
+		return appendNumber(nil, key), nil
+
+	case types.DBKeyCollation_COMPOSITE:
+		segments := strings.Split(key, c.GetSeparator())
+		if len(segments) > len(c.Segments) || (!partial && len(segments) < len(c.Segments)) {
+			return nil, &ErrKeyNotCollatable{
+				Key:    key,
+				Reason: fmt.Sprintf("it has %d segments while the keys have %d", len(segments), len(c.Segments)),
+			}
+		}
+
+		var collated []byte
+		for i, segment := range segments {
+			if c.Segments[i] == types.DBKeyCollation_NUMBER {
+				if err := validateNumber(segment); err != nil {
+					return nil, &ErrKeyNotCollatable{Key: key, Reason: fmt.Sprintf("segment %d: %s", i, err)}
+				}
+				collated = appendNumber(collated, segment)
+				continue
+			}
+			collated = append(collated, bytes.ReplaceAll([]byte(segment), []byte{0x00}, textEscapedZero)...)
+			collated = append(collated, textTerminator...)
+		}
+		return collated, nil
+
+	default:
+		return nil, errors.Errorf("unknown key collation mode [%d]", c.GetMode())
+	}
+}
+
+// UncollateKey returns the original key of the collated key
+func UncollateKey(c *types.DBKeyCollation, collated []byte) (string, error) {
+	switch c.GetMode() {
+	case types.DBKeyCollation_BINARY:
+		return string(collated), nil
+
+	case types.DBKeyCollation_NUMERIC:
+		key, rest, err := readNumber(collated)
+		if err != nil {
+			return "", err
+		}
+		if len(rest) != 0 {
+			return "", errors.New("the collated numeric key has trailing bytes")
+		}
+		return key, nil
+
+	case types.DBKeyCollation_COMPOSITE:
+		var segments []string
+		rest := collated
+		for _, segmentType := range c.Segments {
+			if len(rest) == 0 && len(segments) > 0 {
+				// a partial range bound
+				break
+			}
+
+			var segment string
+			var err error
+			if segmentType == types.DBKeyCollation_NUMBER {
+				segment, rest, err = readNumber(rest)
+			} else {
+				segment, rest, err = readText(rest)
+			}
+			if err != nil {
+				return "", err
+			}
+			segments = append(segments, segment)
+		}
+		if len(rest) != 0 {
+			return "", errors.New("the collated composite key has trailing bytes")
+		}
+		return strings.Join(segments, c.Separator), nil
+
+	default:
+		return "", errors.Errorf("unknown key collation mode [%d]", c.GetMode())
+	}
+}
+
+// CompareKeys compares two keys, or range bounds, of a database by its key collation. A key which does not conform to
+// the collation is ordered by its bytes.
+func CompareKeys(c *types.DBKeyCollation, a, b string) int {
+	if IsBinaryCollation(c) {
+		return strings.Compare(a, b)
+	}
+
+	collatedA, errA := CollateRangeBound(c, a)
+	collatedB, errB := CollateRangeBound(c, b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return bytes.Compare(collatedA, collatedB)
+}
+
+// SortKeys orders the keys of a database by its key collation
+func SortKeys(c *types.DBKeyCollation, keys []string) {
+	if IsBinaryCollation(c) {
+		sort.Strings(keys)
+		return
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return CompareKeys(c, keys[i], keys[j]) < 0
+	})
+}
+
+// InCollatedRange returns true if the key is in the range [startKey, endKey) of a database with the given key
+// collation, where an empty startKey or endKey denotes the first or the last key in the database, respectively.
+func InCollatedRange(c *types.DBKeyCollation, key, startKey, endKey string) bool {
+	if IsBinaryCollation(c) {
+		return InRange(key, startKey, endKey)
+	}
+
+	return (startKey == "" || CompareKeys(c, key, startKey) >= 0) &&
+		(endKey == "" || CompareKeys(c, key, endKey) < 0)
+}
+
+func validateNumber(s string) error {
+	if s == "" {
+		return errors.New("a number cannot be empty")
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return errors.Errorf("[%s] is not a number, as it holds characters other than the decimal digits", s)
+		}
+	}
+	return nil
+}
+
+func appendNumber(collated []byte, number string) []byte {
+	significant := strings.TrimLeft(number, "0")
+	collated = appendUint16(collated, len(significant))
+	collated = append(collated, significant...)
+	return appendUint16(collated, len(number)-len(significant))
+}
+
+func appendUint16(b []byte, n int) []byte {
+	var buf [2]byte
+	binary.BigEndian.PutUint16(buf[:], uint16(n))
+	return append(b, buf[:]...)
+}
+
+func readNumber(collated []byte) (string, []byte, error) {
+	if len(collated) < 2 {
+		return "", nil, errors.New("the collated number is truncated")
+	}
+	n := int(binary.BigEndian.Uint16(collated))
+	if len(collated) < 4+n {
+		return "", nil, errors.New("the collated number is truncated")
+	}
+	significant := string(collated[2 : 2+n])
+	zeros := int(binary.BigEndian.Uint16(collated[2+n:]))
+	return strings.Repeat("0", zeros) + significant, collated[4+n:], nil
+}
+
+func readText(collated []byte) (string, []byte, error) {
+	var text []byte
+	for i := 0; i+1 < len(collated); i++ {
+		if collated[i] != 0x00 {
+			text = append(text, collated[i])
+			continue
+		}
+
+		switch collated[i+1] {
+		case textTerminator[1]:
+			return string(text), collated[i+2:], nil
+		case textEscapedZero[1]:
+			text = append(text, 0x00)
+			i++
+		default:
+			return "", nil, errors.New("the collated text holds an invalid escape sequence")
+		}
+	}
+	return "", nil, errors.New("the collated text is not terminated")
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package worldstate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollateKey(t *testing.T) {
+	numeric := &types.DBKeyCollation{Mode: types.DBKeyCollation_NUMERIC}
+	composite := &types.DBKeyCollation{
+		Mode:      types.DBKeyCollation_COMPOSITE,
+		Segments:  []types.DBKeyCollation_SegmentType{types.DBKeyCollation_TEXT, types.DBKeyCollation_NUMBER},
+		Separator: "/",
+	}
+
+	tests := []struct {
+		name       string
+		collation  *types.DBKeyCollation
+		sortedKeys []string
+	}{
+		{
+			name:       "binary",
+			collation:  nil,
+			sortedKeys: []string{"", "10", "2", "a", "b"},
+		},
+		{
+			name:       "numeric",
+			collation:  numeric,
+			sortedKeys: []string{"0", "00", "1", "01", "2", "9", "10", "099", "100", "1000"},
+		},
+		{
+			name:       "composite",
+			collation:  composite,
+			sortedKeys: []string{"a/2", "a/10", "a\x00b/1", "ab/1", "b/0", "b/9", "b/11"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var previous []byte
+			for i, key := range tt.sortedKeys {
+				collated, err := CollateKey(tt.collation, key)
+				require.NoError(t, err)
+				if i > 0 {
+					require.Equal(t, -1, bytes.Compare(previous, collated), "key %q", key)
+					require.Equal(t, -1, CompareKeys(tt.collation, tt.sortedKeys[i-1], key))
+				}
+				previous = collated
+
+				uncollated, err := UncollateKey(tt.collation, collated)
+				require.NoError(t, err)
+				require.Equal(t, key, uncollated)
+			}
+
+			keys := append([]string(nil), tt.sortedKeys...)
+			for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+				keys[i], keys[j] = keys[j], keys[i]
+			}
+			SortKeys(tt.collation, keys)
+			require.Equal(t, tt.sortedKeys, keys)
+		})
+	}
+}
+
+func TestCollateKeyErrors(t *testing.T) {
+	numeric := &types.DBKeyCollation{Mode: types.DBKeyCollation_NUMERIC}
+	composite := &types.DBKeyCollation{
+		Mode:      types.DBKeyCollation_COMPOSITE,
+		Segments:  []types.DBKeyCollation_SegmentType{types.DBKeyCollation_TEXT, types.DBKeyCollation_NUMBER},
+		Separator: "/",
+	}
+
+	_, err := CollateKey(numeric, "")
+	require.EqualError(t, err, "the key [] does not conform to the key collation of the database: a number cannot be empty")
+
+	_, err = CollateKey(numeric, "-1")
+	require.EqualError(t, err, "the key [-1] does not conform to the key collation of the database: [-1] is not a number, as it holds characters other than the decimal digits")
+
+	_, err = CollateKey(composite, "a")
+	require.EqualError(t, err, "the key [a] does not conform to the key collation of the database: it has 1 segments while the keys have 2")
+
+	_, err = CollateKey(composite, "a/b")
+	require.EqualError(t, err, "the key [a/b] does not conform to the key collation of the database: segment 1: [b] is not a number, as it holds characters other than the decimal digits")
+
+	// a range bound may hold the first segments only, and then bounds the keys that begin with them
+	bound, err := CollateRangeBound(composite, "b")
+	require.NoError(t, err)
+	for _, key := range []string{"b/0", "b/100"} {
+		collated, err := CollateKey(composite, key)
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(collated, bound))
+	}
+	require.True(t, InCollatedRange(composite, "b/100", "b", "c"))
+	require.False(t, InCollatedRange(composite, "a/100", "b", "c"))
+	require.True(t, InCollatedRange(numeric, "99", "10", "100"))
+	require.False(t, InCollatedRange(numeric, "100", "10", "100"))
+}
+
+func TestValidateKeyCollation(t *testing.T) {
+	require.NoError(t, ValidateKeyCollation(nil))
+	require.NoError(t, ValidateKeyCollation(&types.DBKeyCollation{Mode: types.DBKeyCollation_NUMERIC}))
+	require.EqualError(t, ValidateKeyCollation(&types.DBKeyCollation{Mode: types.DBKeyCollation_NUMERIC, Separator: "/"}),
+		"the NUMERIC key collation takes neither segments nor a separator")
+	require.EqualError(t, ValidateKeyCollation(&types.DBKeyCollation{Mode: types.DBKeyCollation_COMPOSITE, Separator: "/"}),
+		"the COMPOSITE key collation must define at least one segment")
+	require.EqualError(t, ValidateKeyCollation(&types.DBKeyCollation{Mode: 7}), "unknown key collation mode [7]")
+}
@@ -3,7 +3,10 @@
 package worldstate
 
 import (
+	"sort"
+
 	"github.com/hyperledger-labs/orion-server/pkg/types"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 const (
@@ -15,8 +18,19 @@ const (
 	// the configuration details
 	ConfigDBName = "_config"
 	// MetadataDBName holds the name of the database that holds
-	// the metadata about the worldstate database
+	// the metadata about the worldstate database. Its records
+	// were moved to the SystemDBName
 	MetadataDBName = "_metadata"
+	// SystemDBName holds the name of the database that holds the
+	// server-internal records of the node, as defined by the
+	// sysstate package
+	SystemDBName = "_system"
+	// HeartbeatsDBName holds the name of the database that holds
+	// the last heartbeat of each node
+	HeartbeatsDBName = "_heartbeats"
+	// DBDescriptorsDBName holds the name of the database that holds
+	// the descriptor of each user database, such as its schema
+	DBDescriptorsDBName = "_dbdescriptors"
 	// DefaultDBName is the default database created during
 	// node bootstrap
 	DefaultDBName = "bdb"
@@ -26,6 +40,11 @@ const (
 	// AllowedCharsInDBName holds the regexp for allowed characters
 	// in a database name
 	AllowedCharsInDBName = `^[0-9a-zA-Z_-.]+$`
+	// MaxKeyLength is the maximal length, in bytes, of a key in a user database
+	MaxKeyLength = 1024
+	// ReservedKeyPrefix begins the keys that the storage layer reserves for its own records. A key in a user
+	// database cannot begin with it
+	ReservedKeyPrefix = "\x00"
 )
 
 // DB provides method to create and access states stored in
@@ -61,8 +80,34 @@ type DB interface {
 	// The content of snapshot are guaranteed to be consistent.
 	// The snapshot must be released after use, by calling Release method on the DBSnapshot.
 	GetDBsSnapshot(dbNames []string) (DBsSnapshot, error)
+	// GetDBsSnapshotWithHeight returns a snapshot of the given databases, like GetDBsSnapshot, along with the height
+	// of the state it holds. Unlike GetDBsSnapshot, the snapshot never holds the updates of a block to some of the
+	// databases only, hence, the versions it holds are not above the returned height.
+	GetDBsSnapshotWithHeight(dbNames []string) (DBsSnapshot, uint64, error)
+	// CompactRange compacts the underlying storage of the given database for the key range [startKey, endKey).
+	// An empty startKey or endKey denotes the first or the last key in the database, respectively.
+	CompactRange(dbName string, startKey, endKey string) error
 	// Commit commits the updates to each database
 	Commit(dbsUpdates map[string]*DBUpdates, blockNumber uint64) error
+	// GetDigest returns the state digest of the database as of the given block, which is the digest recorded by the
+	// last block at or below it that updated the database, along with the number of that block. It returns the
+	// digest of an empty database and zero if no such block recorded a digest
+	GetDigest(dbName string, blockNumber uint64) ([]byte, uint64, error)
+	// CommitDigests records the state digests of the databases updated by the given block, as computed by
+	// ComputeDigests. They must be recorded before the updates of the block are committed
+	CommitDigests(digests map[string][]byte, blockNumber uint64) error
+	// RecordSkippedCommits records that the updates of the given blocks to each of the given databases were not
+	// committed, as the commit to the database failed. A database with a skipped commit is unavailable until its
+	// skipped commits are cleared. The records must be recorded before the height moves past the blocks
+	RecordSkippedCommits(dbNames []string, blockNumbers []uint64) error
+	// GetSkippedCommits returns, for each unavailable database, the numbers of the blocks whose updates to it were
+	// not committed, in ascending order
+	GetSkippedCommits() (map[string][]uint64, error)
+	// ClearSkippedCommits removes the records of the skipped commits of the database, once their updates are
+	// committed, which makes the database available again
+	ClearSkippedCommits(dbName string) error
+	// IsUnavailable returns true if the database has a skipped commit
+	IsUnavailable(dbName string) bool
 	// Height returns the state database block height. In other
 	// words, it returns the last committed block number
 	Height() (uint64, error)
@@ -105,6 +150,43 @@ type DBUpdates struct {
 	Deletes []string
 }
 
+// Sort orders the writes and the deletes by key. The sort is stable, and hence, of the writes of the same key, the
+// last one is still applied last.
+func (u *DBUpdates) Sort() {
+	sort.SliceStable(u.Writes, func(i, j int) bool {
+		return u.Writes[i].Key < u.Writes[j].Key
+	})
+	sort.Strings(u.Deletes)
+}
+
+// SortDBsUpdates orders the writes and the deletes of each database by key, so that the updates of a block are the
+// same on every node, whatever the order in which they were constructed. The databases themselves must be visited
+// in the order returned by SortedDBNames.
+func SortDBsUpdates(dbsUpdates map[string]*DBUpdates) {
+	for _, updates := range dbsUpdates {
+		if updates != nil {
+			updates.Sort()
+		}
+	}
+}
+
+// SortedDBNames returns the names of the updated databases in lexicographic order, which is the order in which the
+// updates of a block are applied
+func SortedDBNames(dbsUpdates map[string]*DBUpdates) []string {
+	dbNames := make([]string, 0, len(dbsUpdates))
+	for dbName := range dbsUpdates {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+	return dbNames
+}
+
+// MarshalValue serializes a message which is stored as the value of a key. The serialization is deterministic, i.e.,
+// the entries of a map field are serialized in the order of their keys, so that every node stores the same bytes.
+func MarshalValue(m protov2.Message) ([]byte, error) {
+	return protov2.MarshalOptions{Deterministic: true}.Marshal(m)
+}
+
 // Iterator provides methods to fetch a range of key-value pairs
 type Iterator interface {
 	// Key returns the key of the current key/value pair, or nil if done.
@@ -138,7 +220,10 @@ func IsSystemDB(dbName string) bool {
 	return dbName == UsersDBName ||
 		dbName == DatabasesDBName ||
 		dbName == ConfigDBName ||
-		dbName == MetadataDBName
+		dbName == MetadataDBName ||
+		dbName == HeartbeatsDBName ||
+		dbName == DBDescriptorsDBName ||
+		dbName == SystemDBName
 }
 
 // IsDefaultWorldStateDB returns true if the given db is the default
@@ -154,5 +239,8 @@ func SystemDBs() []string {
 		DatabasesDBName,
 		ConfigDBName,
 		MetadataDBName,
+		HeartbeatsDBName,
+		DBDescriptorsDBName,
+		SystemDBName,
 	}
 }
@@ -29,6 +29,11 @@ func TestIsSystemDBs(t *testing.T) {
 			dbName:   UsersDBName,
 			expected: true,
 		},
+		{
+			name:     "SystemDB",
+			dbName:   SystemDBName,
+			expected: true,
+		},
 		{
 			name:     "non-system DB",
 			dbName:   "random",
@@ -72,3 +77,29 @@ func TestIsDefaultWorldStateDB(t *testing.T) {
 		})
 	}
 }
+
+func TestSortDBsUpdates(t *testing.T) {
+	dbsUpdates := map[string]*DBUpdates{
+		"db2": {
+			Writes: []*KVWithMetadata{
+				{Key: "key3", Value: []byte("value3")},
+				{Key: "key1", Value: []byte("value1-first")},
+				{Key: "key2", Value: []byte("value2")},
+				{Key: "key1", Value: []byte("value1-last")},
+			},
+			Deletes: []string{"key6", "key4", "key5"},
+		},
+		"db1":       {},
+		UsersDBName: nil,
+	}
+
+	SortDBsUpdates(dbsUpdates)
+	require.Equal(t, []*KVWithMetadata{
+		{Key: "key1", Value: []byte("value1-first")},
+		{Key: "key1", Value: []byte("value1-last")},
+		{Key: "key2", Value: []byte("value2")},
+		{Key: "key3", Value: []byte("value3")},
+	}, dbsUpdates["db2"].Writes)
+	require.Equal(t, []string{"key4", "key5", "key6"}, dbsUpdates["db2"].Deletes)
+	require.Equal(t, []string{UsersDBName, "db1", "db2"}, SortedDBNames(dbsUpdates))
+}
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package worldstate
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// DigestSize is the size, in bytes, of the state digest of a database.
+//
+// The state digest of a database is the sum, modulo 2^256, of the hashes of its entries, where the hash of an entry
+// covers the name of the database, the key, the value, and the metadata. As the sum does not depend on the order of
+// the entries, two nodes that hold the same state hold the same digest, however they reached it. A write or a delete
+// updates the digest without reading the other entries of the database: the hash of the entry that is replaced or
+// deleted is subtracted, and the hash of the written entry is added. The digest of an empty database is zero.
+const DigestSize = sha256.Size
+
+// EmptyDigest returns the state digest of an empty database
+func EmptyDigest() []byte {
+	return make([]byte, DigestSize)
+}
+
+// ComputeDigests returns the state digests of the databases updated by a block, as they are once the given updates of
+// the block are committed on top of the state database. The digest of each database is derived from the digest
+// recorded by the last block that updated it, and from the entries that the updates replace. Hence, it must be called
+// before the updates are committed, and the digests must be recorded before the updates too. A digest that was
+// already recorded for the block, by a commit that was interrupted, is returned as recorded, since the state database
+// may already hold some of the updates. A database that is deleted by the block gets the digest of an empty database.
+func ComputeDigests(db DB, blockNum uint64, dbsUpdates map[string]*DBUpdates) (map[string][]byte, error) {
+	digests := make(map[string][]byte)
+
+	for dbName, updates := range dbsUpdates {
+		recorded, recordedAt, err := db.GetDigest(dbName, blockNum)
+		if err != nil {
+			return nil, err
+		}
+		if recordedAt == blockNum {
+			digests[dbName] = recorded
+			continue
+		}
+
+		var digest [DigestSize]byte
+		copy(digest[:], recorded)
+
+		// a key that is both written and deleted by the block is deleted, as the deletes are committed last
+		entries := make(map[string]*KVWithMetadata)
+		for _, kv := range updates.Writes {
+			entries[kv.Key] = kv
+		}
+		for _, key := range updates.Deletes {
+			entries[key] = nil
+		}
+
+		exists := db.Exist(dbName)
+		for key, kv := range entries {
+			if exists {
+				if err := removeCommittedEntry(db, dbName, key, &digest); err != nil {
+					return nil, err
+				}
+			}
+			if kv == nil {
+				continue
+			}
+
+			h, err := entryHash(dbName, key, kv.Value, kv.Metadata)
+			if err != nil {
+				return nil, err
+			}
+			addToDigest(&digest, &h)
+		}
+
+		digests[dbName] = digest[:]
+	}
+
+	if dbs, ok := dbsUpdates[DatabasesDBName]; ok {
+		for _, dbName := range dbs.Deletes {
+			digests[dbName] = EmptyDigest()
+		}
+	}
+
+	return digests, nil
+}
+
+// removeCommittedEntry subtracts the hash of the committed entry of the key, if any, from the digest
+func removeCommittedEntry(db DB, dbName, key string, digest *[DigestSize]byte) error {
+	value, metadata, err := db.Get(dbName, key)
+	if err != nil {
+		return err
+	}
+	if value == nil && metadata == nil {
+		// a key with neither a value nor a metadata reads as a missing key
+		exists, err := db.Has(dbName, key)
+		if err != nil || !exists {
+			return err
+		}
+	}
+
+	h, err := entryHash(dbName, key, value, metadata)
+	if err != nil {
+		return err
+	}
+	subtractFromDigest(digest, &h)
+	return nil
+}
+
+// entryHash hashes the length-prefixed name of the database, key, value, and metadata of an entry. The metadata is
+// marshaled deterministically, as the access control lists it holds are maps.
+func entryHash(dbName, key string, value []byte, metadata *types.Metadata) ([DigestSize]byte, error) {
+	var metadataBytes []byte
+	if metadata != nil {
+		var err error
+		if metadataBytes, err = (protov2.MarshalOptions{Deterministic: true}).Marshal(metadata); err != nil {
+			return [DigestSize]byte{}, errors.Wrapf(err, "error while marshaling the metadata of key [%s] in database [%s]", key, dbName)
+		}
+	}
+
+	h := sha256.New()
+	var length [binary.MaxVarintLen64]byte
+	for _, field := range [][]byte{[]byte(dbName), []byte(key), value, metadataBytes} {
+		h.Write(length[:binary.PutUvarint(length[:], uint64(len(field)))])
+		h.Write(field)
+	}
+
+	var sum [DigestSize]byte
+	copy(sum[:], h.Sum(nil))
+	return sum, nil
+}
+
+// addToDigest adds h to the digest, both read as big-endian integers, modulo 2^256
+func addToDigest(digest, h *[DigestSize]byte) {
+	carry := 0
+	for i := DigestSize - 1; i >= 0; i-- {
+		sum := int(digest[i]) + int(h[i]) + carry
+		digest[i] = byte(sum)
+		carry = sum >> 8
+	}
+}
+
+// subtractFromDigest subtracts h from the digest, both read as big-endian integers, modulo 2^256
+func subtractFromDigest(digest, h *[DigestSize]byte) {
+	borrow := 0
+	for i := DigestSize - 1; i >= 0; i-- {
+		diff := int(digest[i]) - int(h[i]) - borrow
+		borrow = 0
+		if diff < 0 {
+			diff += 256
+			borrow = 1
+		}
+		digest[i] = byte(diff)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package worldstate
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestArithmetic(t *testing.T) {
+	var h1, h2 [DigestSize]byte
+	h1[DigestSize-1] = 0xff
+	h2[DigestSize-1] = 0x01
+	h2[0] = 0x80
+
+	// the carry propagates across the bytes, and a sum wraps around modulo 2^256
+	var digest [DigestSize]byte
+	addToDigest(&digest, &h1)
+	addToDigest(&digest, &h2)
+	require.Equal(t, byte(0x80), digest[0])
+	require.Equal(t, byte(0x01), digest[DigestSize-2])
+	require.Equal(t, byte(0x00), digest[DigestSize-1])
+	addToDigest(&digest, &h2)
+	require.Equal(t, byte(0x00), digest[0])
+
+	// the sum does not depend on the order of the hashes, and a subtraction undoes an addition
+	var reordered [DigestSize]byte
+	addToDigest(&reordered, &h2)
+	addToDigest(&reordered, &h1)
+	addToDigest(&reordered, &h2)
+	require.Equal(t, digest, reordered)
+
+	subtractFromDigest(&digest, &h2)
+	subtractFromDigest(&digest, &h1)
+	subtractFromDigest(&digest, &h2)
+	require.Equal(t, EmptyDigest(), digest[:])
+}
+
+func TestEntryHash(t *testing.T) {
+	metadata := &types.Metadata{
+		Version:       &types.Version{BlockNum: 2, TxNum: 1},
+		AccessControl: &types.AccessControl{ReadUsers: map[string]bool{"alice": true, "bob": true, "carol": true}},
+	}
+	h, err := entryHash("db1", "key1", []byte("value1"), metadata)
+	require.NoError(t, err)
+
+	// the metadata is marshaled deterministically
+	for i := 0; i < 10; i++ {
+		other, err := entryHash("db1", "key1", []byte("value1"), metadata)
+		require.NoError(t, err)
+		require.Equal(t, h, other)
+	}
+
+	// the fields are length-prefixed, hence moving bytes from one field to another changes the hash
+	for _, other := range []struct{ dbName, key, value string }{
+		{"db1k", "ey1", "value1"},
+		{"db1", "key1v", "alue1"},
+		{"db2", "key1", "value1"},
+		{"db1", "key1", "value2"},
+	} {
+		otherHash, err := entryHash(other.dbName, other.key, []byte(other.value), metadata)
+		require.NoError(t, err)
+		require.NotEqual(t, h, otherHash)
+	}
+	otherHash, err := entryHash("db1", "key1", []byte("value1"), nil)
+	require.NoError(t, err)
+	require.NotEqual(t, h, otherHash)
+}
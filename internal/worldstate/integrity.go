@@ -0,0 +1,21 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package worldstate
+
+import (
+	"fmt"
+)
+
+// ErrValueCorrupted denotes that a value read from a database in the integrity mode does not match the hash stored
+// along with it when it was committed, e.g., due to a bit flip on the storage or in memory.
+type ErrValueCorrupted struct {
+	DBName       string
+	Key          string
+	StoredHash   []byte
+	ComputedHash []byte
+}
+
+func (e *ErrValueCorrupted) Error() string {
+	return fmt.Sprintf("the value of key [%s] in database [%s] is corrupted: its stored hash is [%x] while its hash is [%x]",
+		e.Key, e.DBName, e.StoredHash, e.ComputedHash)
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/syndtr/goleveldb/leveldb/cache"
+)
+
+// BlockCacheStats holds the hits and the misses of the block cache of a database since the database was opened
+type BlockCacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// blockCacheCounter is the block cacher of a database: it hands out an LRU cacher which counts the hits and the misses
+// of the block cache, as goleveldb does not count them.
+type blockCacheCounter struct {
+	hits   uint64
+	misses uint64
+}
+
+// New implements opt.Cacher
+func (c *blockCacheCounter) New(capacity int) cache.Cacher {
+	return &countingLRU{
+		capacity: capacity,
+		recent:   list.New(),
+		counter:  c,
+	}
+}
+
+func (c *blockCacheCounter) stats() *BlockCacheStats {
+	return &BlockCacheStats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
+
+// countingLRU is the LRU cacher of goleveldb, which also counts the lookups of the block cache. The cache promotes a
+// block on every lookup which returns it: the block is already held by the cacher on a hit, while it was just read from
+// the disk on a miss. Whether a block is held is only known to the cacher, under its lock, hence the LRU cacher of
+// goleveldb cannot merely be wrapped. As in goleveldb, the handles of the evicted blocks are released once the lock is
+// released, as releasing a handle may lock the cache.
+type countingLRU struct {
+	mu       sync.Mutex
+	capacity int
+	used     int
+	// recent holds the held blocks, from the most recently used one
+	recent  *list.List
+	counter *blockCacheCounter
+}
+
+// lruEntry is the cache data of a block known to the cacher. A banned block is never held.
+type lruEntry struct {
+	n   *cache.Node
+	h   *cache.Handle
+	elm *list.Element
+	ban bool
+}
+
+func (r *countingLRU) Capacity() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.capacity
+}
+
+func (r *countingLRU) SetCapacity(capacity int) {
+	r.mu.Lock()
+	r.capacity = capacity
+	evicted := r.evictOverCapacity()
+	r.mu.Unlock()
+
+	release(evicted)
+}
+
+func (r *countingLRU) Promote(n *cache.Node) {
+	var evicted []*lruEntry
+
+	r.mu.Lock()
+	if n.CacheData == nil {
+		atomic.AddUint64(&r.counter.misses, 1)
+		if n.Size() <= r.capacity {
+			e := &lruEntry{n: n, h: n.GetHandle()}
+			e.elm = r.recent.PushFront(e)
+			n.CacheData = unsafe.Pointer(e)
+			r.used += n.Size()
+			evicted = r.evictOverCapacity()
+		}
+	} else {
+		atomic.AddUint64(&r.counter.hits, 1)
+		if e := (*lruEntry)(n.CacheData); !e.ban {
+			r.recent.MoveToFront(e.elm)
+		}
+	}
+	r.mu.Unlock()
+
+	release(evicted)
+}
+
+func (r *countingLRU) Ban(n *cache.Node) {
+	r.mu.Lock()
+	if n.CacheData == nil {
+		n.CacheData = unsafe.Pointer(&lruEntry{n: n, ban: true})
+		r.mu.Unlock()
+		return
+	}
+
+	e := (*lruEntry)(n.CacheData)
+	if e.ban {
+		r.mu.Unlock()
+		return
+	}
+	r.recent.Remove(e.elm)
+	e.ban = true
+	r.used -= n.Size()
+	h := e.h
+	e.h = nil
+	r.mu.Unlock()
+
+	h.Release()
+}
+
+func (r *countingLRU) Evict(n *cache.Node) {
+	r.mu.Lock()
+	e := (*lruEntry)(n.CacheData)
+	if e == nil || e.ban {
+		r.mu.Unlock()
+		return
+	}
+	r.recent.Remove(e.elm)
+	r.used -= n.Size()
+	n.CacheData = nil
+	r.mu.Unlock()
+
+	e.h.Release()
+}
+
+func (r *countingLRU) EvictNS(ns uint64) {
+	r.mu.Lock()
+	var evicted []*lruEntry
+	for elm := r.recent.Back(); elm != nil; {
+		e := elm.Value.(*lruEntry)
+		elm = elm.Prev()
+		if e.n.NS() == ns {
+			evicted = append(evicted, r.remove(e))
+		}
+	}
+	r.mu.Unlock()
+
+	release(evicted)
+}
+
+func (r *countingLRU) EvictAll() {
+	r.mu.Lock()
+	var evicted []*lruEntry
+	for elm := r.recent.Back(); elm != nil; elm = elm.Prev() {
+		e := elm.Value.(*lruEntry)
+		e.n.CacheData = nil
+		evicted = append(evicted, e)
+	}
+	r.recent.Init()
+	r.used = 0
+	r.mu.Unlock()
+
+	release(evicted)
+}
+
+func (r *countingLRU) Close() error {
+	return nil
+}
+
+// evictOverCapacity evicts the least recently used blocks until the held blocks fit the capacity, and returns them.
+// It is called under the lock.
+func (r *countingLRU) evictOverCapacity() []*lruEntry {
+	var evicted []*lruEntry
+	for r.used > r.capacity {
+		evicted = append(evicted, r.remove(r.recent.Back().Value.(*lruEntry)))
+	}
+	return evicted
+}
+
+func (r *countingLRU) remove(e *lruEntry) *lruEntry {
+	r.recent.Remove(e.elm)
+	e.n.CacheData = nil
+	r.used -= e.n.Size()
+	return e
+}
+
+func release(evicted []*lruEntry) {
+	for _, e := range evicted {
+		e.h.Release()
+	}
+}
+
+// BlockCacheStats returns the hits and the misses of the block cache of each database since the database was opened
+func (l *LevelDB) BlockCacheStats() map[string]*BlockCacheStats {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	stats := make(map[string]*BlockCacheStats, len(l.dbs))
+	for name, db := range l.dbs {
+		stats[name] = db.blockCache.stats()
+	}
+	return stats
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// maxCheckpointAttempts bounds the attempts to checkpoint a database whose files are replaced by a background
+// compaction while they are linked
+const maxCheckpointAttempts = 10
+
+// leveldb never modifies a table file once it is written, hence, a table file is hard-linked into a checkpoint. The
+// other files, i.e., the journal, the manifest, and CURRENT, are appended to or replaced, and are copied.
+var tableFileRegex = regexp.MustCompile(`^[0-9]+\.(ldb|sst)$`)
+
+// Checkpoint creates, in the given directory, a copy of every database as of the last commit, which can be opened
+// with OpenReadOnly. As the table files are hard-linked, the directory must reside on the file system of the state
+// database, and the checkpoint takes little space and time. It must not be called concurrently with Commit.
+func (l *LevelDB) Checkpoint(dir string) error {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	if err := fileops.RemoveAll(dir); err != nil {
+		return errors.WithMessagef(err, "error while removing the existing checkpoint [%s]", dir)
+	}
+	if err := fileops.CreateDir(dir); err != nil {
+		return errors.WithMessagef(err, "failed to create directory %s", dir)
+	}
+
+	for dbName := range l.dbs {
+		if err := checkpointDB(filepath.Join(l.dbRootDir, dbName), filepath.Join(dir, dbName)); err != nil {
+			return errors.WithMessagef(err, "error while checkpointing database %s", dbName)
+		}
+	}
+
+	return nil
+}
+
+// checkpointDB links or copies the files of a database. A background compaction may add, remove, or replace files
+// while they are being linked or copied, in which case the checkpoint may miss a file referenced by the copied
+// manifest, or hold a torn copy of a file, and it is taken again.
+func checkpointDB(srcDir, dstDir string) error {
+	for attempt := 1; attempt <= maxCheckpointAttempts; attempt++ {
+		stable, err := tryCheckpointDB(srcDir, dstDir)
+		if err != nil {
+			return err
+		}
+		if stable {
+			return nil
+		}
+	}
+
+	return errors.Errorf("the files of the database kept changing during %d attempts", maxCheckpointAttempts)
+}
+
+// tryCheckpointDB returns false if the files of the database changed during the checkpoint. A table file is immutable,
+// hence, it is enough that it still exists. A copied file must have kept its size and its modification time, and the
+// size of its copy must match. As CURRENT is replaced by a file of the same size when the manifest is switched,
+// possibly within the resolution of the modification time, its copy must also match its content.
+func tryCheckpointDB(srcDir, dstDir string) (bool, error) {
+	if err := fileops.RemoveAll(dstDir); err != nil {
+		return false, err
+	}
+	if err := fileops.CreateDir(dstDir); err != nil {
+		return false, err
+	}
+
+	before, err := listDBFiles(srcDir)
+	if err != nil {
+		return false, err
+	}
+
+	copied := make(map[string]int64)
+	for _, f := range before {
+		src := filepath.Join(srcDir, f.Name())
+		dst := filepath.Join(dstDir, f.Name())
+		if tableFileRegex.MatchString(f.Name()) {
+			err = os.Link(src, dst)
+		} else {
+			copied[f.Name()], err = copyFile(src, dst)
+		}
+		if os.IsNotExist(errors.Cause(err)) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "error while checkpointing the file [%s]", src)
+		}
+	}
+
+	after, err := listDBFiles(srcDir)
+	if err != nil {
+		return false, err
+	}
+	if len(before) != len(after) {
+		return false, nil
+	}
+	for i := range before {
+		if before[i].Name() != after[i].Name() {
+			return false, nil
+		}
+		size, ok := copied[before[i].Name()]
+		if !ok {
+			continue
+		}
+		if size != before[i].Size() || size != after[i].Size() || !before[i].ModTime().Equal(after[i].ModTime()) {
+			return false, nil
+		}
+	}
+
+	if _, ok := copied["CURRENT"]; !ok {
+		return true, nil
+	}
+	srcCurrent, err := ioutil.ReadFile(filepath.Join(srcDir, "CURRENT"))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "error while reading the file [%s]", filepath.Join(srcDir, "CURRENT"))
+	}
+	dstCurrent, err := ioutil.ReadFile(filepath.Join(dstDir, "CURRENT"))
+	if err != nil {
+		return false, errors.Wrapf(err, "error while reading the file [%s]", filepath.Join(dstDir, "CURRENT"))
+	}
+
+	return bytes.Equal(srcCurrent, dstCurrent), nil
+}
+
+// listDBFiles returns the files of a database, in lexicographic order of their names, except for the lock file and
+// the info logs, which are not part of its content
+func listDBFiles(dir string) ([]os.FileInfo, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading dir [%s]", dir)
+	}
+
+	var dbFiles []os.FileInfo
+	for _, f := range files {
+		switch name := f.Name(); {
+		case f.IsDir(), name == "LOCK", name == "LOG", name == "LOG.old":
+		default:
+			dbFiles = append(dbFiles, f)
+		}
+	}
+
+	return dbFiles, nil
+}
+
+// copyFile copies the file and returns the number of bytes copied
+func copyFile(src, dst string) (int64, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		return 0, err
+	}
+
+	return n, out.Close()
+}
+
+// OpenReadOnly opens the state database in the given directory, e.g., a checkpoint, in read-only mode. Any commit to
+// it fails.
+func OpenReadOnly(conf *Config) (*LevelDB, error) {
+	l := &LevelDB{
+		dbRootDir:   conf.DBRootDir,
+		dbs:         make(map[string]*db),
+		logger:      conf.Logger,
+		dbNameRegex: regexp.MustCompile(allowedCharsInDBName),
+		skipped:     make(map[string][]uint64),
+		handles:     newHandleTracker(),
+
+		integrityChecked: integrityCheckedDBs(conf.IntegrityCheckedDBs),
+	}
+
+	dbNames, err := fileops.ListSubdirs(conf.DBRootDir)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to retrieve existing level dbs from %s", conf.DBRootDir)
+	}
+
+	for _, dbName := range dbNames {
+		blockCache := &blockCacheCounter{}
+		file, err := leveldb.OpenFile(
+			filepath.Join(l.dbRootDir, dbName),
+			&opt.Options{ReadOnly: true, ErrorIfMissing: true, BlockCacher: blockCache},
+		)
+		if err != nil {
+			l.Close()
+			return nil, errors.WithMessagef(err, "failed to open leveldb file for database %s in read-only mode", dbName)
+		}
+
+		l.dbs[dbName] = &db{
+			name:       dbName,
+			file:       file,
+			readOpts:   &opt.ReadOptions{},
+			writeOpts:  &opt.WriteOptions{Sync: true},
+			blockCache: blockCache,
+		}
+	}
+
+	for dbName, db := range l.dbs {
+		if db.collation, err = l.loadKeyCollation(dbName); err != nil {
+			l.Close()
+			return nil, err
+		}
+	}
+
+	if systemDB, ok := l.dbs[worldstate.SystemDBName]; ok {
+		if l.skipped, err = sysstate.GetSkippedCommits(systemDB.file); err != nil {
+			l.Close()
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoint(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	require.NoError(t, env.l.create("db1"))
+
+	writes := &worldstate.DBUpdates{}
+	for i := 0; i < 1000; i++ {
+		writes.Writes = append(writes.Writes, &worldstate.KVWithMetadata{
+			Key:   fmt.Sprintf("key%04d", i),
+			Value: []byte(fmt.Sprintf("value%04d-2", i)),
+		})
+	}
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{"db1": writes}, 2))
+	// the compaction moves the keys from the journal to table files
+	require.NoError(t, env.l.CompactRange("db1", "", ""))
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+		"db1": {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: "key0000", Value: []byte("value0000-3")},
+			},
+		},
+	}, 3))
+
+	dir := filepath.Join(filepath.Dir(env.l.dbRootDir), "checkpoint")
+	require.NoError(t, env.l.Checkpoint(dir))
+
+	// the table files are shared with the primary, while the journal is copied
+	files, err := ioutil.ReadDir(filepath.Join(dir, "db1"))
+	require.NoError(t, err)
+	var tables int
+	for _, f := range files {
+		if !tableFileRegex.MatchString(f.Name()) {
+			continue
+		}
+		primary, err := os.Stat(filepath.Join(env.l.dbRootDir, "db1", f.Name()))
+		require.NoError(t, err)
+		require.True(t, os.SameFile(primary, f))
+		tables++
+	}
+	require.NotZero(t, tables)
+
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+		"db1": {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: "key0001", Value: []byte("value0001-4")},
+			},
+			Deletes: []string{"key0002"},
+		},
+	}, 4))
+
+	replica, err := OpenReadOnly(&Config{DBRootDir: dir, Logger: env.l.logger})
+	require.NoError(t, err)
+	defer replica.Close()
+
+	height, err := replica.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), height)
+	height, err = env.l.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), height)
+
+	for key, expected := range map[string]string{
+		"key0000": "value0000-3",
+		"key0001": "value0001-2",
+		"key0002": "value0002-2",
+	} {
+		val, _, err := replica.Get("db1", key)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(val))
+	}
+
+	itr, err := replica.GetIterator("db1", "", "")
+	require.NoError(t, err)
+	var count int
+	for itr.Next() {
+		count++
+	}
+	itr.Release()
+	require.Equal(t, 1000, count)
+
+	require.Error(t, replica.Commit(map[string]*worldstate.DBUpdates{
+		"db1": {
+			Writes: []*worldstate.KVWithMetadata{
+				{Key: "key0001", Value: []byte("value0001-5")},
+			},
+		},
+	}, 5))
+
+	// a new checkpoint replaces the previous one
+	replica.Close()
+	require.NoError(t, env.l.Checkpoint(dir))
+	replica, err = OpenReadOnly(&Config{DBRootDir: dir, Logger: env.l.logger})
+	require.NoError(t, err)
+	defer replica.Close()
+	height, err = replica.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), height)
+	val, _, err := replica.Get("db1", "key0002")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// loadKeyCollation returns the key collation of a user database, as set in its descriptor. As the collation can only
+// be set when the database is created, and the descriptors are committed ahead of the databases they describe, the
+// collation is loaded once, when the database is created or opened. The caller must hold the lock on the list of
+// databases.
+func (l *LevelDB) loadKeyCollation(dbName string) (*types.DBKeyCollation, error) {
+	if worldstate.IsSystemDB(dbName) {
+		return nil, nil
+	}
+
+	descriptors, ok := l.dbs[worldstate.DBDescriptorsDBName]
+	if !ok {
+		return nil, nil
+	}
+
+	descriptors.mu.RLock()
+	defer descriptors.mu.RUnlock()
+
+	dbval, err := descriptors.file.Get(encodeKey(worldstate.DBDescriptorsDBName, []byte(dbName)), descriptors.readOpts)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to retrieve the descriptor of database [%s]", dbName)
+	}
+
+	persisted, err := decodeValue(worldstate.DBDescriptorsDBName, dbName, dbval, l.integrityChecked[worldstate.DBDescriptorsDBName])
+	if err != nil {
+		return nil, err
+	}
+	descriptor := &types.DBDescriptor{}
+	if err := proto.Unmarshal(persisted.Value, descriptor); err != nil {
+		return nil, errors.Wrapf(err, "error while unmarshaling the descriptor of database [%s]", dbName)
+	}
+
+	collation := descriptor.GetKeyCollation()
+	if worldstate.IsBinaryCollation(collation) {
+		return nil, nil
+	}
+	return collation, nil
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericKeyCollation(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	descriptor, err := worldstate.MarshalValue(&types.DBDescriptor{
+		KeyCollation: &types.DBKeyCollation{Mode: types.DBKeyCollation_NUMERIC},
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DBDescriptorsDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "numbers", Value: descriptor}}},
+		worldstate.DatabasesDBName:     {Writes: []*worldstate.KVWithMetadata{{Key: "numbers"}}},
+	}, 1))
+
+	updates := &worldstate.DBUpdates{}
+	for _, key := range []string{"100", "9", "1", "007", "10", "2"} {
+		updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+			Key:   key,
+			Value: []byte("value-of-" + key),
+			Metadata: &types.Metadata{
+				Version: &types.Version{BlockNum: 2, TxNum: 1},
+			},
+		})
+	}
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{"numbers": updates}, 2))
+
+	rangeKeys := func(startKey, endKey string) []string {
+		itr, err := env.l.GetIterator("numbers", startKey, endKey)
+		require.NoError(t, err)
+		defer itr.Release()
+
+		var keys []string
+		for itr.Next() {
+			keys = append(keys, string(itr.Key()))
+		}
+		require.NoError(t, itr.Error())
+		return keys
+	}
+
+	verify := func() {
+		// the keys are iterated in numeric order, and returned as they were written
+		require.Equal(t, []string{"1", "2", "007", "9", "10", "100"}, rangeKeys("", ""))
+		require.Equal(t, []string{"2", "007", "9", "10"}, rangeKeys("2", "100"))
+		require.Equal(t, []string{"10", "100"}, rangeKeys("10", ""))
+
+		// a point lookup uses the original key
+		val, metadata, err := env.l.Get("numbers", "007")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-of-007"), val)
+		require.Equal(t, uint64(2), metadata.GetVersion().GetBlockNum())
+
+		val, _, err = env.l.Get("numbers", "7")
+		require.NoError(t, err)
+		require.Nil(t, val)
+
+		exist, err := env.l.Has("numbers", "not-a-number")
+		require.NoError(t, err)
+		require.False(t, exist)
+
+		snap, err := env.l.GetDBsSnapshot([]string{"numbers"})
+		require.NoError(t, err)
+		defer snap.Release()
+		val, _, err = snap.Get("numbers", "100")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-of-100"), val)
+	}
+	verify()
+
+	// a bound which is not a number is rejected
+	_, err = env.l.GetIterator("numbers", "a", "")
+	require.IsType(t, &worldstate.ErrKeyNotCollatable{}, err)
+
+	// the collation is loaded again on a restart
+	require.NoError(t, env.l.Close())
+	env.l, err = Open(&Config{
+		DBRootDir: env.path,
+		Logger:    env.l.logger,
+	})
+	require.NoError(t, err)
+	verify()
+
+	// a key which cannot be collated cannot be committed
+	err = env.l.Commit(map[string]*worldstate.DBUpdates{
+		"numbers": {Writes: []*worldstate.KVWithMetadata{{Key: "ten", Value: []byte("value")}}},
+	}, 3)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the key [ten] does not conform to the key collation of the database")
+}
@@ -3,24 +3,19 @@
 package leveldb
 
 import (
-	"bytes"
-	"encoding/binary"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
-	"github.com/syndtr/goleveldb/leveldb/util"
-)
-
-var (
-	lastCommittedBlockNumberKey = []byte("lastCommittedBlockNumber")
 )
 
 // Exist returns true if the given database exist. Otherwise, it returns false.
@@ -59,29 +54,32 @@ func (l *LevelDB) Height() (uint64, error) {
 	l.dbsList.RLock()
 	defer l.dbsList.RUnlock()
 
-	db, ok := l.dbs[worldstate.MetadataDBName]
+	db, ok := l.dbs[worldstate.SystemDBName]
 	if !ok {
-		return 0, errors.Errorf("unable to retrieve the state database height due to missing metadataDB")
+		return 0, errors.Errorf("unable to retrieve the state database height due to missing systemDB")
 	}
 
 	db.mu.RLock()
 	defer db.mu.RUnlock()
 
-	blockNumberEnc, err := db.file.Get(lastCommittedBlockNumberKey, &opt.ReadOptions{})
-	if err != nil && err != leveldb.ErrNotFound {
-		return 0, errors.Wrap(err, "error while retrieving the state database height")
-	}
+	return sysstate.Height(db.file)
+}
 
-	if err == leveldb.ErrNotFound {
-		return 0, nil
-	}
+// GetLastCommitInfo returns the description of the last commit to the state database, or nil if no block was
+// committed
+func (l *LevelDB) GetLastCommitInfo() (*sysstate.CommitInfo, error) {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
 
-	blockNumberDec, err := binary.ReadUvarint(bytes.NewBuffer(blockNumberEnc))
-	if err != nil {
-		return 0, errors.Wrap(err, "error while decoding the stored height")
+	db, ok := l.dbs[worldstate.SystemDBName]
+	if !ok {
+		return nil, errors.Errorf("unable to retrieve the last commit info due to missing systemDB")
 	}
 
-	return blockNumberDec, nil
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return sysstate.GetLastCommitInfo(db.file)
 }
 
 // Get returns the value of the key present in the database.
@@ -99,7 +97,13 @@ func (l *LevelDB) Get(dbName string, key string) ([]byte, *types.Metadata, error
 	db.mu.RLock()
 	defer db.mu.RUnlock()
 
-	dbval, err := db.file.Get([]byte(key), db.readOpts)
+	storedKey, err := encodeCollatedKey(dbName, db.collation, []byte(key))
+	if err != nil {
+		// a key which does not conform to the key collation of the database cannot be stored
+		return nil, nil, nil
+	}
+
+	dbval, err := db.file.Get(storedKey, db.readOpts)
 	if err == leveldb.ErrNotFound {
 		return nil, nil, nil
 	}
@@ -107,8 +111,8 @@ func (l *LevelDB) Get(dbName string, key string) ([]byte, *types.Metadata, error
 		return nil, nil, errors.WithMessagef(err, "failed to retrieve leveldb key [%s] from database %s", key, dbName)
 	}
 
-	persisted := &types.ValueWithMetadata{}
-	if err := proto.Unmarshal(dbval, persisted); err != nil {
+	persisted, err := decodeValue(dbName, key, dbval, l.integrityChecked[dbName])
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -141,7 +145,11 @@ func (l *LevelDB) Has(dbName, key string) (bool, error) {
 	db := l.dbs[dbName]
 	l.dbsList.RUnlock()
 
-	return db.file.Has([]byte(key), nil)
+	storedKey, err := encodeCollatedKey(dbName, db.collation, []byte(key))
+	if err != nil {
+		return false, nil
+	}
+	return db.file.Has(storedKey, nil)
 }
 
 // GetConfig returns the cluster configuration
@@ -178,25 +186,44 @@ func (l *LevelDB) GetIterator(dbName string, startKey, endKey string) (worldstat
 		return nil, errors.Errorf("database %s does not exist", dbName)
 	}
 
-	r := &util.Range{}
-	if startKey == "" {
-		r.Start = nil
-	} else {
-		r.Start = []byte(startKey)
+	r, err := keyRange(dbName, db.collation, startKey, endKey)
+	if err != nil {
+		return nil, err
 	}
 
-	if endKey == "" {
-		r.Limit = nil
-	} else {
-		r.Limit = []byte(endKey)
+	return trackIterator(l.handles, dbName,
+		newKeyDecodingIterator(dbName, db.collation, db.file.NewIterator(r, &opt.ReadOptions{}))), nil
+}
+
+// CompactRange compacts the underlying storage of the given database for the key range [startKey, endKey).
+// An empty startKey or endKey denotes the first or the last key in the database, respectively.
+func (l *LevelDB) CompactRange(dbName string, startKey, endKey string) error {
+	l.dbsList.RLock()
+	db := l.dbs[dbName]
+	l.dbsList.RUnlock()
+
+	if db == nil {
+		return errors.Errorf("database %s does not exist", dbName)
 	}
 
-	return db.file.NewIterator(r, &opt.ReadOptions{}), nil
+	r, err := keyRange(dbName, db.collation, startKey, endKey)
+	if err != nil {
+		return err
+	}
+	if err := db.file.CompactRange(*r); err != nil {
+		return errors.Wrapf(err, "error while compacting database %s", dbName)
+	}
+
+	return nil
 }
 
-// Commit commits the updates to the database
+// Commit commits the updates to the database. The databases are committed in the order of their names.
 func (l *LevelDB) Commit(dbsUpdates map[string]*worldstate.DBUpdates, blockNumber uint64) error {
-	for dbName, updates := range dbsUpdates {
+	l.commitMu.Lock()
+	defer l.commitMu.Unlock()
+
+	for _, dbName := range worldstate.SortedDBNames(dbsUpdates) {
+		updates := dbsUpdates[dbName]
 		l.dbsList.RLock()
 		db := l.dbs[dbName]
 		l.dbsList.RUnlock()
@@ -214,44 +241,204 @@ func (l *LevelDB) Commit(dbsUpdates map[string]*worldstate.DBUpdates, blockNumbe
 	}
 
 	l.dbsList.RLock()
-	db, exists := l.dbs[worldstate.MetadataDBName]
+	db, exists := l.dbs[worldstate.SystemDBName]
+	l.dbsList.RUnlock()
+	if !exists {
+		l.logger.Errorf("system database does not exist, available dbs are [%+v]", l.dbs)
+		return errors.Errorf("system database does not exist")
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	batch := &leveldb.Batch{}
+	sysstate.PutCommit(batch, &sysstate.CommitInfo{BlockNumber: blockNumber, CommittedAt: time.Now()})
+	if err := db.file.Write(batch, &opt.WriteOptions{}); err != nil {
+		return errors.Wrapf(err, "error while storing the last committed block number [%d] to the systemDB", blockNumber)
+	}
+
+	return nil
+}
+
+// GetDigest returns the state digest of the database as of the given block, along with the number of the last block
+// at or below it that recorded one
+func (l *LevelDB) GetDigest(dbName string, blockNumber uint64) ([]byte, uint64, error) {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	db, ok := l.dbs[worldstate.SystemDBName]
+	if !ok {
+		return nil, 0, errors.Errorf("unable to retrieve the state digest of database [%s] due to missing systemDB", dbName)
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	digest, recordedAt, err := sysstate.GetDigest(db.file, dbName, blockNumber)
+	if err != nil {
+		return nil, 0, err
+	}
+	if digest == nil {
+		return worldstate.EmptyDigest(), 0, nil
+	}
+	return digest, recordedAt, nil
+}
+
+// CommitDigests records the state digests of the databases updated by the given block. The records are synced, as
+// they must reach the disk before the updates of the block.
+func (l *LevelDB) CommitDigests(digests map[string][]byte, blockNumber uint64) error {
+	l.dbsList.RLock()
+	db, exists := l.dbs[worldstate.SystemDBName]
+	l.dbsList.RUnlock()
+	if !exists {
+		return errors.Errorf("system database does not exist")
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	batch := &leveldb.Batch{}
+	for dbName, digest := range digests {
+		sysstate.PutDigest(batch, dbName, blockNumber, digest)
+	}
+	if err := db.file.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return errors.Wrapf(err, "error while storing the state digests of block [%d] to the systemDB", blockNumber)
+	}
+
+	return nil
+}
+
+// RecordSkippedCommits records that the updates of the given blocks to each of the given databases were not committed,
+// which makes the databases unavailable. The records are synced, as they must reach the disk before the height moves
+// past the blocks.
+func (l *LevelDB) RecordSkippedCommits(dbNames []string, blockNumbers []uint64) error {
+	l.dbsList.RLock()
+	db, exists := l.dbs[worldstate.SystemDBName]
 	l.dbsList.RUnlock()
 	if !exists {
-		l.logger.Errorf("metadata database does not exist, available dbs are [%+v]", l.dbs)
-		return errors.Errorf("metadata database does not exist")
+		return errors.Errorf("system database does not exist")
 	}
 
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	b := make([]byte, binary.MaxVarintLen64)
-	binary.PutUvarint(b, blockNumber)
-	if err := db.file.Put(lastCommittedBlockNumberKey, b, &opt.WriteOptions{}); err != nil {
-		return errors.Wrapf(err, "error while storing the last committed block number [%d] to the metadataDB", blockNumber)
+	batch := &leveldb.Batch{}
+	for _, dbName := range dbNames {
+		for _, blockNumber := range blockNumbers {
+			sysstate.PutSkippedCommit(batch, dbName, blockNumber)
+		}
+	}
+	if err := db.file.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return errors.Wrapf(err, "error while storing the skipped commits of databases %v to the systemDB", dbNames)
+	}
+
+	l.skippedMu.Lock()
+	defer l.skippedMu.Unlock()
+
+	for _, dbName := range dbNames {
+		recorded := make(map[uint64]bool)
+		for _, blockNumber := range l.skipped[dbName] {
+			recorded[blockNumber] = true
+		}
+		for _, blockNumber := range blockNumbers {
+			if !recorded[blockNumber] {
+				l.skipped[dbName] = append(l.skipped[dbName], blockNumber)
+			}
+		}
+		sort.Slice(l.skipped[dbName], func(i, j int) bool { return l.skipped[dbName][i] < l.skipped[dbName][j] })
 	}
 
 	return nil
 }
 
+// GetSkippedCommits returns, for each unavailable database, the numbers of the blocks whose updates to it were not
+// committed, in ascending order
+func (l *LevelDB) GetSkippedCommits() (map[string][]uint64, error) {
+	l.skippedMu.RLock()
+	defer l.skippedMu.RUnlock()
+
+	skipped := make(map[string][]uint64, len(l.skipped))
+	for dbName, blockNumbers := range l.skipped {
+		skipped[dbName] = append([]uint64(nil), blockNumbers...)
+	}
+	return skipped, nil
+}
+
+// ClearSkippedCommits removes the records of the skipped commits of the database, which makes it available
+func (l *LevelDB) ClearSkippedCommits(dbName string) error {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	return l.clearSkippedCommits(dbName)
+}
+
+// IsUnavailable returns true if the database has a skipped commit
+func (l *LevelDB) IsUnavailable(dbName string) bool {
+	l.skippedMu.RLock()
+	defer l.skippedMu.RUnlock()
+
+	_, ok := l.skipped[dbName]
+	return ok
+}
+
+// clearSkippedCommits removes the records of the skipped commits of the database. The caller must hold the lock on
+// the list of databases.
+func (l *LevelDB) clearSkippedCommits(dbName string) error {
+	l.skippedMu.Lock()
+	defer l.skippedMu.Unlock()
+
+	blockNumbers, ok := l.skipped[dbName]
+	if !ok {
+		return nil
+	}
+
+	db, exists := l.dbs[worldstate.SystemDBName]
+	if !exists {
+		return errors.Errorf("system database does not exist")
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	batch := &leveldb.Batch{}
+	for _, blockNumber := range blockNumbers {
+		sysstate.DeleteSkippedCommit(batch, dbName, blockNumber)
+	}
+	if err := db.file.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return errors.Wrapf(err, "error while removing the skipped commits of database [%s] from the systemDB", dbName)
+	}
+
+	delete(l.skipped, dbName)
+	return nil
+}
+
 func (l *LevelDB) commitToDB(dbName string, db *db, updates *worldstate.DBUpdates) error {
 	batch := &leveldb.Batch{}
 
+	integrityChecked := l.integrityChecked[dbName]
 	for _, kv := range updates.Writes {
-		dbval, err := proto.Marshal(
-			&types.ValueWithMetadata{
-				Value:    kv.Value,
-				Metadata: kv.Metadata,
-			},
-		)
+		var hash []byte
+		if integrityChecked {
+			hash = valueHash(kv.Value)
+		}
+		dbval, err := encodeValue(kv.Value, kv.Metadata, hash)
 		if err != nil {
 			return errors.WithMessagef(err, "failed to marshal the constructed dbValue [%v]", kv.Value)
 		}
 
-		batch.Put([]byte(kv.Key), dbval)
+		storedKey, err := encodeCollatedKey(dbName, db.collation, []byte(kv.Key))
+		if err != nil {
+			return errors.WithMessagef(err, "failed to store the key [%s] in database [%s]", kv.Key, dbName)
+		}
+		batch.Put(storedKey, dbval)
 	}
 
 	for _, key := range updates.Deletes {
-		batch.Delete([]byte(key))
+		storedKey, err := encodeCollatedKey(dbName, db.collation, []byte(key))
+		if err != nil {
+			return errors.WithMessagef(err, "failed to delete the key [%s] from database [%s]", key, dbName)
+		}
+		batch.Delete(storedKey)
 	}
 
 	db.mu.Lock()
@@ -302,16 +489,24 @@ func (l *LevelDB) create(dbName string) error {
 		return nil
 	}
 
-	file, err := leveldb.OpenFile(filepath.Join(l.dbRootDir, dbName), &opt.Options{})
+	collation, err := l.loadKeyCollation(dbName)
+	if err != nil {
+		return err
+	}
+
+	blockCache := &blockCacheCounter{}
+	file, err := leveldb.OpenFile(filepath.Join(l.dbRootDir, dbName), &opt.Options{BlockCacher: blockCache})
 	if err != nil {
 		return errors.WithMessagef(err, "failed to open leveldb file for database %s", dbName)
 	}
 
 	l.dbs[dbName] = &db{
-		name:      dbName,
-		file:      file,
-		readOpts:  &opt.ReadOptions{},
-		writeOpts: &opt.WriteOptions{Sync: true},
+		name:       dbName,
+		file:       file,
+		readOpts:   &opt.ReadOptions{},
+		writeOpts:  &opt.WriteOptions{Sync: true},
+		collation:  collation,
+		blockCache: blockCache,
 	}
 
 	return nil
@@ -342,7 +537,9 @@ func (l *LevelDB) delete(dbName string) error {
 		return errors.Wrapf(err, "error while deleting database [%s]", dbName)
 	}
 
-	return nil
+	// the skipped commits of a deleted database are not to be repaired, and a database created later with the same
+	// name must not inherit them
+	return l.clearSkippedCommits(dbName)
 }
 
 // DBNotFoundErr denotes that the given dbName is not present in the database
@@ -4,6 +4,7 @@
 package leveldb
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -853,3 +854,193 @@ func TestHeight(t *testing.T) {
 		})
 	}
 }
+
+func TestDigests(t *testing.T) {
+	t.Parallel()
+
+	commit := func(t *testing.T, l *LevelDB, dbsUpdates map[string]*worldstate.DBUpdates, blockNum uint64) map[string][]byte {
+		digests, err := worldstate.ComputeDigests(l, blockNum, dbsUpdates)
+		require.NoError(t, err)
+		require.NoError(t, l.CommitDigests(digests, blockNum))
+		require.NoError(t, l.Commit(dbsUpdates, blockNum))
+		return digests
+	}
+	kv := func(key, value string) *worldstate.KVWithMetadata {
+		return &worldstate.KVWithMetadata{
+			Key:   key,
+			Value: []byte(value),
+			Metadata: &types.Metadata{
+				Version:       &types.Version{BlockNum: 3},
+				AccessControl: &types.AccessControl{ReadUsers: map[string]bool{"alice": true, "bob": true}},
+			},
+		}
+	}
+	createDB := map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}}},
+	}
+
+	// env1 reaches the state through a key that is written and later deleted, and a value that is later replaced
+	env1 := newTestEnv(t)
+	defer env1.cleanup()
+	digest, updatedAt, err := env1.l.GetDigest("db1", 1)
+	require.NoError(t, err)
+	require.Equal(t, worldstate.EmptyDigest(), digest)
+	require.Equal(t, uint64(0), updatedAt)
+
+	commit(t, env1.l, createDB, 1)
+	commit(t, env1.l, map[string]*worldstate.DBUpdates{
+		"db1": {Writes: []*worldstate.KVWithMetadata{kv("key1", "value1"), kv("key2", "value2")}},
+	}, 2)
+	block3 := map[string]*worldstate.DBUpdates{
+		"db1": {
+			Writes:  []*worldstate.KVWithMetadata{kv("key3", "value3"), kv("key1", "value4")},
+			Deletes: []string{"key2"},
+		},
+	}
+	digests := commit(t, env1.l, block3, 3)
+	require.NoError(t, env1.l.Commit(nil, 4))
+
+	// env2 writes the same entries at once, in a different order
+	env2 := newTestEnv(t)
+	defer env2.cleanup()
+	commit(t, env2.l, createDB, 1)
+	commit(t, env2.l, map[string]*worldstate.DBUpdates{
+		"db1": {Writes: []*worldstate.KVWithMetadata{kv("key1", "value4"), kv("key3", "value3")}},
+	}, 2)
+
+	digest, updatedAt, err = env2.l.GetDigest("db1", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), updatedAt)
+	require.Equal(t, digests["db1"], digest)
+	digest, updatedAt, err = env1.l.GetDigest("db1", 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), updatedAt)
+	require.Equal(t, digests["db1"], digest)
+	digest, updatedAt, err = env1.l.GetDigest("db1", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), updatedAt)
+	require.NotEqual(t, digests["db1"], digest)
+
+	// env3 differs by a single value
+	env3 := newTestEnv(t)
+	defer env3.cleanup()
+	commit(t, env3.l, createDB, 1)
+	commit(t, env3.l, map[string]*worldstate.DBUpdates{
+		"db1": {Writes: []*worldstate.KVWithMetadata{kv("key1", "value4"), kv("key3", "value5")}},
+	}, 2)
+	digest, _, err = env3.l.GetDigest("db1", 2)
+	require.NoError(t, err)
+	require.NotEqual(t, digests["db1"], digest)
+
+	// a digest recorded by an interrupted commit of a block is not computed again on top of its applied updates
+	recomputed, err := worldstate.ComputeDigests(env1.l, 3, block3)
+	require.NoError(t, err)
+	require.Equal(t, digests, recomputed)
+
+	// the digest of a deleted database is the one of an empty database
+	digests = commit(t, env1.l, map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Deletes: []string{"db1"}},
+	}, 5)
+	require.Equal(t, worldstate.EmptyDigest(), digests["db1"])
+	digest, updatedAt, err = env1.l.GetDigest("db1", 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), updatedAt)
+	require.Equal(t, worldstate.EmptyDigest(), digest)
+}
+
+func TestSkippedCommits(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}, {Key: "db2"}}},
+	}, 1))
+
+	skipped, err := env.l.GetSkippedCommits()
+	require.NoError(t, err)
+	require.Empty(t, skipped)
+	require.False(t, env.l.IsUnavailable("db1"))
+
+	require.NoError(t, env.l.RecordSkippedCommits([]string{"db1"}, []uint64{3, 2}))
+	require.NoError(t, env.l.RecordSkippedCommits([]string{"db1", "db2"}, []uint64{3, 4}))
+	expected := map[string][]uint64{
+		"db1": {2, 3, 4},
+		"db2": {3, 4},
+	}
+	skipped, err = env.l.GetSkippedCommits()
+	require.NoError(t, err)
+	require.Equal(t, expected, skipped)
+	require.True(t, env.l.IsUnavailable("db1"))
+	require.True(t, env.l.IsUnavailable("db2"))
+	require.False(t, env.l.IsUnavailable(worldstate.DefaultDBName))
+
+	// the skipped commits are loaded again on a restart
+	require.NoError(t, env.l.Close())
+	env.l, err = Open(&Config{
+		DBRootDir: env.path,
+		Logger:    env.l.logger,
+	})
+	require.NoError(t, err)
+	skipped, err = env.l.GetSkippedCommits()
+	require.NoError(t, err)
+	require.Equal(t, expected, skipped)
+
+	require.NoError(t, env.l.ClearSkippedCommits("db1"))
+	require.False(t, env.l.IsUnavailable("db1"))
+	skipped, err = env.l.GetSkippedCommits()
+	require.NoError(t, err)
+	require.Equal(t, map[string][]uint64{"db2": {3, 4}}, skipped)
+
+	// the skipped commits of a deleted database are discarded with it
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Deletes: []string{"db2"}},
+	}, 5))
+	require.False(t, env.l.IsUnavailable("db2"))
+	skipped, err = env.l.GetSkippedCommits()
+	require.NoError(t, err)
+	require.Empty(t, skipped)
+}
+
+func TestCompactRange(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	var writes []*worldstate.KVWithMetadata
+	var deletes []string
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("key%03d", i)
+		writes = append(writes, &worldstate.KVWithMetadata{
+			Key:   key,
+			Value: []byte("value"),
+			Metadata: &types.Metadata{
+				Version: &types.Version{BlockNum: 1, TxNum: uint64(i)},
+			},
+		})
+		if i%2 == 0 {
+			deletes = append(deletes, key)
+		}
+	}
+
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DefaultDBName: {Writes: writes},
+	}, 1))
+	require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DefaultDBName: {Deletes: deletes},
+	}, 2))
+
+	require.NoError(t, env.l.CompactRange(worldstate.DefaultDBName, "key000", "key050"))
+	require.NoError(t, env.l.CompactRange(worldstate.DefaultDBName, "", ""))
+
+	for i := 0; i < 100; i++ {
+		exist, err := env.l.Has(worldstate.DefaultDBName, fmt.Sprintf("key%03d", i))
+		require.NoError(t, err)
+		require.Equal(t, i%2 != 0, exist)
+	}
+
+	err := env.l.CompactRange("db-not-exist", "", "")
+	require.EqualError(t, err, "database db-not-exist does not exist")
+}
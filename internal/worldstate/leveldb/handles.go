@@ -0,0 +1,330 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+)
+
+const (
+	// HandleKindSnapshot marks a snapshot of some databases, see GetDBsSnapshot
+	HandleKindSnapshot = "snapshot"
+	// HandleKindIterator marks an iterator over a database, or over the snapshot of a database
+	HandleKindIterator = "iterator"
+
+	modulePath  = "github.com/hyperledger-labs/orion-server/"
+	packagePath = modulePath + "internal/worldstate/leveldb."
+	// worldstatePath holds the helpers, e.g., the range deletes, which obtain an iterator on behalf of their caller
+	worldstatePath = modulePath + "internal/worldstate."
+)
+
+// ErrSnapshotReleased is returned on the use of a snapshot, or of an iterator, that was released by the server rather
+// than by its owner, as it was held for longer than the TTL of the handles.
+var ErrSnapshotReleased = errors.New("the snapshot was released by the server, as it was held for longer than the handle TTL")
+
+// HandleInfo describes a snapshot, or an iterator, handed out by the state database and not yet released. A live
+// handle pins the records it may read, which leveldb cannot drop while compacting, hence, an abandoned handle grows
+// the disk usage.
+type HandleInfo struct {
+	ID   uint64 `json:"id"`
+	Kind string `json:"kind"`
+	// Owner is the function which obtained the handle, i.e., the first caller outside the state database
+	Owner     string    `json:"owner"`
+	DBNames   []string  `json:"db_names"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// handleTracker keeps the handles that are not yet released, and releases those which are held for longer than the
+// TTL, if set, on behalf of their owners.
+type handleTracker struct {
+	now      func() time.Time
+	stop     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+
+	mu      sync.Mutex
+	nextID  uint64
+	handles map[uint64]*trackedHandle
+}
+
+type trackedHandle struct {
+	info *HandleInfo
+	// forceRelease releases the handle on behalf of its owner, whose next use of the handle fails with
+	// ErrSnapshotReleased
+	forceRelease func()
+}
+
+func newHandleTracker() *handleTracker {
+	return &handleTracker{
+		now:     time.Now,
+		handles: make(map[uint64]*trackedHandle),
+	}
+}
+
+func (t *handleTracker) track(kind string, dbNames []string, forceRelease func()) uint64 {
+	info := &HandleInfo{
+		Kind:    kind,
+		Owner:   handleOwner(),
+		DBNames: dbNames,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.nextID++
+	info.ID = t.nextID
+	info.CreatedAt = t.now()
+	t.handles[info.ID] = &trackedHandle{info: info, forceRelease: forceRelease}
+	return info.ID
+}
+
+func (t *handleTracker) untrack(id uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.handles, id)
+}
+
+func (t *handleTracker) list() []*HandleInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	handles := make([]*HandleInfo, 0, len(t.handles))
+	for _, h := range t.handles {
+		handles = append(handles, h.info)
+	}
+	sort.Slice(handles, func(i, j int) bool {
+		return handles[i].ID < handles[j].ID
+	})
+	return handles
+}
+
+// releaseOlderThan releases the handles created more than the given age ago. The handles are released outside the
+// lock of the tracker, as the release of a handle by its owner takes the lock of the handle first.
+func (t *handleTracker) releaseOlderThan(age time.Duration) []*trackedHandle {
+	t.mu.Lock()
+	var stale []*trackedHandle
+	cutoff := t.now().Add(-age)
+	for id, h := range t.handles {
+		if h.info.CreatedAt.Before(cutoff) {
+			stale = append(stale, h)
+			delete(t.handles, id)
+		}
+	}
+	t.mu.Unlock()
+
+	sort.Slice(stale, func(i, j int) bool {
+		return stale[i].info.ID < stale[j].info.ID
+	})
+	for _, h := range stale {
+		h.forceRelease()
+	}
+	return stale
+}
+
+// handleOwner returns the function which obtained a handle, i.e., the first caller outside this package and the
+// helpers of the worldstate package. A test of this package is an owner too.
+func handleOwner() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		internal := (strings.HasPrefix(frame.Function, packagePath) || strings.HasPrefix(frame.Function, worldstatePath)) &&
+			!strings.HasSuffix(frame.File, "_test.go")
+		if !internal {
+			return strings.TrimPrefix(frame.Function, modulePath)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// LiveHandles returns the snapshots and iterators handed out by the state database and not yet released, in the
+// order of their creation
+func (l *LevelDB) LiveHandles() []*HandleInfo {
+	return l.handles.list()
+}
+
+// ReleaseHandlesOlderThan releases the snapshots and iterators created more than the given age ago, and returns them.
+// The owner of a released handle gets ErrSnapshotReleased on its next use of the handle.
+func (l *LevelDB) ReleaseHandlesOlderThan(age time.Duration) []*HandleInfo {
+	var released []*HandleInfo
+	for _, h := range l.handles.releaseOlderThan(age) {
+		l.logger.Warnf("released the %s [%d] of [%s] on databases %v, held since %s, which is longer than %s",
+			h.info.Kind, h.info.ID, h.info.Owner, h.info.DBNames, h.info.CreatedAt.Format(time.RFC3339Nano), age)
+		released = append(released, h.info)
+	}
+	return released
+}
+
+// startHandleReaper releases the handles held for longer than the TTL. A handle is released at most half of the TTL
+// after it expires.
+func (l *LevelDB) startHandleReaper(ttl time.Duration) {
+	l.handles.stop = make(chan struct{})
+	l.handles.stopped = make(chan struct{})
+
+	go func() {
+		defer close(l.handles.stopped)
+
+		ticker := time.NewTicker(ttl / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-l.handles.stop:
+				return
+			case <-ticker.C:
+				l.ReleaseHandlesOlderThan(ttl)
+			}
+		}
+	}()
+}
+
+func (l *LevelDB) stopHandleReaper() {
+	if l.handles.stop == nil {
+		return
+	}
+
+	l.handles.stopOnce.Do(func() { close(l.handles.stop) })
+	<-l.handles.stopped
+}
+
+// handleMetrics returns the number of live handles and the age of the oldest of each kind
+func handleMetrics(handles []*HandleInfo, now time.Time) []*StorageMetric {
+	var metrics []*StorageMetric
+	for _, kind := range []string{HandleKindSnapshot, HandleKindIterator} {
+		var count float64
+		var oldest time.Duration
+		for _, h := range handles {
+			if h.Kind != kind {
+				continue
+			}
+			count++
+			if age := now.Sub(h.CreatedAt); age > oldest {
+				oldest = age
+			}
+		}
+
+		metrics = append(metrics,
+			&StorageMetric{
+				Name:   storageMetricPrefix + "live_handles",
+				Help:   "Number of snapshots and iterators handed out and not yet released.",
+				Type:   MetricTypeGauge,
+				Labels: map[string]string{"kind": kind},
+				Value:  count,
+			},
+			&StorageMetric{
+				Name:   storageMetricPrefix + "oldest_handle_age_seconds",
+				Help:   "Age of the oldest snapshot, or iterator, not yet released.",
+				Type:   MetricTypeGauge,
+				Labels: map[string]string{"kind": kind},
+				Value:  oldest.Seconds(),
+			},
+		)
+	}
+	return metrics
+}
+
+// trackedIterator is an iterator which the tracker may release on behalf of its owner. As a leveldb iterator is not
+// safe for concurrent use, every call is serialized with a release by the tracker. Once released by its owner, the
+// calls go to the leveldb iterator, which reports its own release.
+type trackedIterator struct {
+	mu       sync.Mutex
+	itr      iterator.Iterator
+	tracker  *handleTracker
+	id       uint64
+	released bool
+	err      error
+}
+
+func trackIterator(tracker *handleTracker, dbName string, itr iterator.Iterator) worldstate.Iterator {
+	t := &trackedIterator{
+		itr:     itr,
+		tracker: tracker,
+	}
+	t.id = tracker.track(HandleKindIterator, []string{dbName}, t.forceRelease)
+	return t
+}
+
+func (t *trackedIterator) Key() []byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.err != nil {
+		return nil
+	}
+	return t.itr.Key()
+}
+
+func (t *trackedIterator) Value() []byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.err != nil {
+		return nil
+	}
+	return t.itr.Value()
+}
+
+func (t *trackedIterator) Next() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.err != nil {
+		return false
+	}
+	return t.itr.Next()
+}
+
+func (t *trackedIterator) Seek(key []byte) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.err != nil {
+		return false
+	}
+	return t.itr.Seek(key)
+}
+
+func (t *trackedIterator) Error() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.err != nil {
+		return t.err
+	}
+	return t.itr.Error()
+}
+
+func (t *trackedIterator) Release() {
+	t.mu.Lock()
+	if !t.released {
+		t.released = true
+		t.itr.Release()
+	}
+	t.mu.Unlock()
+
+	t.tracker.untrack(t.id)
+}
+
+func (t *trackedIterator) forceRelease() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.released {
+		return
+	}
+	t.released = true
+	t.err = ErrSnapshotReleased
+	t.itr.Release()
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandles(t *testing.T) {
+	setup := func(t *testing.T) (*testEnv, *time.Time) {
+		env := newTestEnv(t)
+		t.Cleanup(env.cleanup)
+
+		require.NoError(t, env.l.create("db1"))
+		require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+			"db1": {
+				Writes: []*worldstate.KVWithMetadata{
+					{Key: "key1", Value: []byte("value1")},
+					{Key: "key2", Value: []byte("value2")},
+				},
+			},
+		}, 1))
+
+		clock := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+		env.l.handles.now = func() time.Time { return clock }
+		return env, &clock
+	}
+
+	t.Run("leaked snapshot is released after the TTL", func(t *testing.T) {
+		env, clock := setup(t)
+
+		snap, err := env.l.GetDBsSnapshot([]string{"db1", worldstate.DatabasesDBName})
+		require.NoError(t, err)
+		itr, err := snap.GetIterator("db1", "", "")
+		require.NoError(t, err)
+		require.True(t, itr.Next())
+
+		handles := env.l.LiveHandles()
+		require.Len(t, handles, 2)
+		require.Equal(t, HandleKindSnapshot, handles[0].Kind)
+		require.Equal(t, []string{"db1", worldstate.DatabasesDBName}, handles[0].DBNames)
+		require.Equal(t, *clock, handles[0].CreatedAt)
+		require.Contains(t, handles[0].Owner, "internal/worldstate/leveldb.TestHandles")
+		require.Equal(t, HandleKindIterator, handles[1].Kind)
+		require.Equal(t, []string{"db1"}, handles[1].DBNames)
+
+		*clock = clock.Add(time.Minute)
+		require.Empty(t, env.l.ReleaseHandlesOlderThan(time.Minute))
+		require.Len(t, env.l.LiveHandles(), 2)
+
+		*clock = clock.Add(time.Second)
+		released := env.l.ReleaseHandlesOlderThan(time.Minute)
+		require.Equal(t, handles, released)
+		require.Empty(t, env.l.LiveHandles())
+
+		_, _, err = snap.Get("db1", "key1")
+		require.Equal(t, ErrSnapshotReleased, err)
+		_, err = snap.GetIterator("db1", "", "")
+		require.Equal(t, ErrSnapshotReleased, err)
+		require.False(t, itr.Next())
+		require.Nil(t, itr.Key())
+		require.Equal(t, ErrSnapshotReleased, itr.Error())
+
+		// the release by the owner, once released by the server, is a no-op
+		itr.Release()
+		snap.Release()
+		require.Equal(t, ErrSnapshotReleased, itr.Error())
+	})
+
+	t.Run("released handles are no longer tracked", func(t *testing.T) {
+		env, clock := setup(t)
+
+		snap, err := env.l.GetDBsSnapshot([]string{"db1"})
+		require.NoError(t, err)
+		itr, err := env.l.GetIterator("db1", "", "")
+		require.NoError(t, err)
+		require.Len(t, env.l.LiveHandles(), 2)
+
+		itr.Release()
+		snap.Release()
+		require.Empty(t, env.l.LiveHandles())
+
+		*clock = clock.Add(time.Hour)
+		require.Empty(t, env.l.ReleaseHandlesOlderThan(time.Minute))
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		env, clock := setup(t)
+
+		snap, err := env.l.GetDBsSnapshot([]string{"db1"})
+		require.NoError(t, err)
+		defer snap.Release()
+		*clock = clock.Add(30 * time.Second)
+		for i := 0; i < 2; i++ {
+			itr, err := env.l.GetIterator("db1", "", "")
+			require.NoError(t, err)
+			defer itr.Release()
+		}
+		*clock = clock.Add(15 * time.Second)
+
+		values := make(map[string]float64)
+		for _, m := range handleMetrics(env.l.LiveHandles(), *clock) {
+			values[m.Name+"/"+m.Labels["kind"]] = m.Value
+		}
+		require.Equal(t, map[string]float64{
+			storageMetricPrefix + "live_handles/snapshot":              1,
+			storageMetricPrefix + "live_handles/iterator":              2,
+			storageMetricPrefix + "oldest_handle_age_seconds/snapshot": 45,
+			storageMetricPrefix + "oldest_handle_age_seconds/iterator": 15,
+		}, values)
+	})
+}
+
+func TestHandleReaper(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "handlestest")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	logger, err := logger.New(&logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	l, err := Open(&Config{
+		DBRootDir: filepath.Join(testDir, "leveldb"),
+		HandleTTL: 50 * time.Millisecond,
+		Logger:    logger,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+
+	snap, err := l.GetDBsSnapshot([]string{worldstate.DefaultDBName})
+	require.NoError(t, err)
+	require.Len(t, l.LiveHandles(), 1)
+
+	require.Eventually(t, func() bool { return len(l.LiveHandles()) == 0 }, 5*time.Second, 10*time.Millisecond)
+	_, _, err = snap.Get(worldstate.DefaultDBName, "key1")
+	require.Equal(t, ErrSnapshotReleased, err)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+)
+
+// IsIntegrityChecked returns true if the given database is in the integrity mode, i.e., the hash of each of its
+// values is stored along with the value and is verified whenever the value is read.
+func (l *LevelDB) IsIntegrityChecked(dbName string) bool {
+	return l.integrityChecked[dbName]
+}
+
+// IntegrityCheckedDBs returns the databases in the integrity mode, in ascending order
+func (l *LevelDB) IntegrityCheckedDBs() []string {
+	var dbNames []string
+	for dbName := range l.integrityChecked {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+	return dbNames
+}
+
+func integrityCheckedDBs(dbNames []string) map[string]bool {
+	if len(dbNames) == 0 {
+		return nil
+	}
+
+	checked := make(map[string]bool)
+	for _, dbName := range dbNames {
+		checked[dbName] = true
+	}
+	return checked
+}
+
+// valueHash returns the hash stored along with a value in a database in the integrity mode
+func valueHash(value []byte) []byte {
+	h := sha256.Sum256(value)
+	return h[:]
+}
+
+// decodeValue decodes the stored value of a key, and verifies its hash if the database is in the integrity mode. A
+// value stored without a hash, i.e., before the database was put in the mode, is returned unverified.
+func decodeValue(dbName, key string, dbval []byte, integrityChecked bool) (*types.ValueWithMetadata, error) {
+	persisted := &types.ValueWithMetadata{}
+	if err := proto.Unmarshal(dbval, persisted); err != nil {
+		return nil, err
+	}
+
+	if integrityChecked {
+		if err := verifyValueHash(dbName, key, persisted); err != nil {
+			return nil, err
+		}
+	}
+
+	return persisted, nil
+}
+
+func verifyValueHash(dbName, key string, persisted *types.ValueWithMetadata) error {
+	if len(persisted.ValueHash) == 0 {
+		return nil
+	}
+
+	if computed := valueHash(persisted.Value); !bytes.Equal(computed, persisted.ValueHash) {
+		return &worldstate.ErrValueCorrupted{
+			DBName:       dbName,
+			Key:          key,
+			StoredHash:   persisted.ValueHash,
+			ComputedHash: computed,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+func newIntegrityTestEnv(t *testing.T, integrityCheckedDBs []string) *LevelDB {
+	dir, err := ioutil.TempDir("/tmp", "ledger")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("failed to remove %s, %v", dir, err)
+		}
+	})
+
+	logger, err := logger.New(&logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	l, err := Open(&Config{
+		DBRootDir:           filepath.Join(dir, "leveldb"),
+		Logger:              logger,
+		IntegrityCheckedDBs: integrityCheckedDBs,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := l.Close(); err != nil {
+			t.Errorf("failed to close the database instance, %v", err)
+		}
+	})
+
+	require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DatabasesDBName: {Writes: []*worldstate.KVWithMetadata{{Key: "db1"}, {Key: "db2"}}},
+	}, 1))
+	require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{
+		"db1": {Writes: []*worldstate.KVWithMetadata{
+			{Key: "key1", Value: []byte("value1"), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2}}},
+			{Key: "key2", Value: []byte("value2"), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2}}},
+			{Key: "key3", Value: []byte("value3"), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2}}},
+		}},
+		"db2": {Writes: []*worldstate.KVWithMetadata{
+			{Key: "key1", Value: []byte("value1"), Metadata: &types.Metadata{Version: &types.Version{BlockNum: 2}}},
+		}},
+	}, 2))
+
+	return l
+}
+
+// putStoredValue stores a record directly in the database file, bypassing the commit
+func putStoredValue(t *testing.T, l *LevelDB, dbName, key string, value []byte, hash []byte) {
+	dbval, err := encodeValue(value, &types.Metadata{Version: &types.Version{BlockNum: 2}}, hash)
+	require.NoError(t, err)
+	require.NoError(t, l.dbs[dbName].file.Put(encodeKey(dbName, []byte(key)), dbval, &opt.WriteOptions{}))
+}
+
+func requireScrubState(t *testing.T, l *LevelDB, state types.StateScrubStatus_State) *types.StateScrubStatus {
+	var status *types.StateScrubStatus
+	require.Eventually(t, func() bool {
+		status = l.ScrubStatus()
+		return status.State == state
+	}, 10*time.Second, 10*time.Millisecond)
+	return status
+}
+
+func TestIntegrityCheckedGet(t *testing.T) {
+	t.Parallel()
+
+	l := newIntegrityTestEnv(t, []string{"db1"})
+	require.True(t, l.IsIntegrityChecked("db1"))
+	require.False(t, l.IsIntegrityChecked("db2"))
+	require.Equal(t, []string{"db1"}, l.IntegrityCheckedDBs())
+
+	stored, err := l.dbs["db1"].file.Get(encodeKey("db1", []byte("key1")), nil)
+	require.NoError(t, err)
+	persisted, err := decodeValue("db1", "key1", stored, false)
+	require.NoError(t, err)
+	require.Equal(t, valueHash([]byte("value1")), persisted.ValueHash)
+
+	// no hash is stored in a database which is not in the mode
+	stored, err = l.dbs["db2"].file.Get(encodeKey("db2", []byte("key1")), nil)
+	require.NoError(t, err)
+	persisted, err = decodeValue("db2", "key1", stored, false)
+	require.NoError(t, err)
+	require.Nil(t, persisted.ValueHash)
+
+	// the value rots while its hash is kept
+	putStoredValue(t, l, "db1", "key2", []byte("value-rotten"), valueHash([]byte("value2")))
+
+	value, metadata, err := l.Get("db1", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), value)
+	require.Equal(t, uint64(2), metadata.Version.BlockNum)
+
+	value, metadata, err = l.Get("db1", "key2")
+	require.Nil(t, value)
+	require.Nil(t, metadata)
+	corrupted, ok := err.(*worldstate.ErrValueCorrupted)
+	require.True(t, ok, "unexpected error %v", err)
+	require.Equal(t, "db1", corrupted.DBName)
+	require.Equal(t, "key2", corrupted.Key)
+	require.Equal(t, valueHash([]byte("value2")), corrupted.StoredHash)
+	require.Equal(t, valueHash([]byte("value-rotten")), corrupted.ComputedHash)
+	require.EqualError(t, err, "the value of key [key2] in database [db1] is corrupted: its stored hash is ["+
+		hexString(corrupted.StoredHash)+"] while its hash is ["+hexString(corrupted.ComputedHash)+"]")
+
+	snap, err := l.GetDBsSnapshot([]string{"db1"})
+	require.NoError(t, err)
+	defer snap.Release()
+	_, _, err = snap.Get("db1", "key2")
+	require.IsType(t, &worldstate.ErrValueCorrupted{}, err)
+
+	// a value stored before the database was put in the mode has no hash, and is returned unverified
+	putStoredValue(t, l, "db1", "key3", []byte("value-unhashed"), nil)
+	value, _, err = l.Get("db1", "key3")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-unhashed"), value)
+
+	// the values of a database which is not in the mode are not verified
+	putStoredValue(t, l, "db2", "key1", []byte("value-rotten"), valueHash([]byte("value1")))
+	value, _, err = l.Get("db2", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-rotten"), value)
+}
+
+func TestScrub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("corrupted values are reported", func(t *testing.T) {
+		t.Parallel()
+
+		l := newIntegrityTestEnv(t, []string{"db1"})
+		require.Equal(t, &types.StateScrubStatus{}, l.ScrubStatus())
+
+		putStoredValue(t, l, "db1", "key2", []byte("value-rotten"), valueHash([]byte("value2")))
+		putStoredValue(t, l, "db1", "key3", []byte("value-unhashed"), nil)
+		require.NoError(t, l.dbs["db1"].file.Put(encodeKey("db1", []byte("key4")), []byte("\xff\xff"), &opt.WriteOptions{}))
+
+		require.NoError(t, l.StartScrub("db1", &ScrubConfig{BatchSize: 1, BatchInterval: time.Millisecond}))
+		status := requireScrubState(t, l, types.StateScrubStatus_COMPLETED)
+		require.Equal(t, "db1", status.DbName)
+		require.Equal(t, uint64(1), status.VerifiedRecords)
+		require.Equal(t, uint64(1), status.UnhashedRecords)
+		require.Equal(t, uint64(2), status.CorruptedRecords)
+		require.Len(t, status.CorruptedValues, 2)
+		require.Equal(t, "key2", status.CorruptedValues[0].Key)
+		require.Equal(t, valueHash([]byte("value2")), status.CorruptedValues[0].StoredHash)
+		require.Equal(t, valueHash([]byte("value-rotten")), status.CorruptedValues[0].ComputedHash)
+		require.Equal(t, "key4", status.CorruptedValues[1].Key)
+		require.Nil(t, status.CorruptedValues[1].StoredHash)
+		require.Empty(t, status.Error)
+
+		// a scrub can be started again once completed
+		require.NoError(t, l.StartScrub("db1", nil))
+		status = requireScrubState(t, l, types.StateScrubStatus_COMPLETED)
+		require.Equal(t, uint64(2), status.CorruptedRecords)
+	})
+
+	t.Run("only a database in the mode is scrubbed", func(t *testing.T) {
+		t.Parallel()
+
+		l := newIntegrityTestEnv(t, []string{"db1", "db3"})
+		require.EqualError(t, l.StartScrub("db2", nil), "database [db2] is not in the integrity mode")
+		require.EqualError(t, l.StartScrub("db3", nil), "database db3 does not exist")
+		require.Equal(t, &types.StateScrubStatus{}, l.ScrubStatus())
+	})
+
+	t.Run("one scrub at a time", func(t *testing.T) {
+		t.Parallel()
+
+		l := newIntegrityTestEnv(t, []string{"db1", "db2"})
+		conf := &ScrubConfig{BatchSize: 1, BatchInterval: time.Hour}
+		require.NoError(t, l.StartScrub("db1", conf))
+		require.NoError(t, l.StartScrub("db1", conf))
+		require.EqualError(t, l.StartScrub("db2", conf), "the scrub of database [db1] is running")
+
+		status := l.ScrubStatus()
+		require.Equal(t, types.StateScrubStatus_RUNNING, status.State)
+		require.Equal(t, "db1", status.DbName)
+
+		// the running scrub is stopped on close
+		require.NoError(t, l.Close())
+		status = l.ScrubStatus()
+		require.Equal(t, types.StateScrubStatus_FAILED, status.State)
+		require.Equal(t, errScrubStopped.Error(), status.Error)
+	})
+}
+
+func hexString(b []byte) string {
+	return fmt.Sprintf("%x", b)
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// The keys of user databases are escape-encoded before they are stored, such that a stored key of a user database
+// never begins with reservedKeyByte, i.e., worldstate.ReservedKeyPrefix, which is reserved for records the storage
+// layer may keep alongside the states. A key that begins with either reservedKeyByte or keyEscapeByte is stored with
+// an additional leading keyEscapeByte. The encoding preserves the order of the keys and hence, range queries. The keys
+// of system databases are written by the server only, and are stored as is.
+const (
+	reservedKeyByte = 0x00
+	keyEscapeByte   = 0x01
+)
+
+func encodeKey(dbName string, key []byte) []byte {
+	if worldstate.IsSystemDB(dbName) || len(key) == 0 || key[0] > keyEscapeByte {
+		return key
+	}
+
+	encoded := make([]byte, len(key)+1)
+	encoded[0] = keyEscapeByte
+	copy(encoded[1:], key)
+	return encoded
+}
+
+func decodeKey(dbName string, key []byte) []byte {
+	if worldstate.IsSystemDB(dbName) || len(key) == 0 || key[0] != keyEscapeByte {
+		return key
+	}
+
+	return key[1:]
+}
+
+// encodeValue returns the stored value of a key, which is the deterministic protobuf encoding of the value along with
+// its metadata, and with its hash in a database in the integrity mode. As the encoding of the access control maps of
+// the metadata is deterministic, the same value and metadata are always stored as the same bytes.
+func encodeValue(value []byte, metadata *types.Metadata, valueHash []byte) ([]byte, error) {
+	return protov2.MarshalOptions{Deterministic: true}.Marshal(
+		&types.ValueWithMetadata{
+			Value:     value,
+			Metadata:  metadata,
+			ValueHash: valueHash,
+		},
+	)
+}
+
+// encodeCollatedKey returns the stored key of a key of a database with the given key collation. The key is collated,
+// see worldstate.CollateKey, and the collated key is then escape-encoded, which preserves the order of the collation.
+// A record which the storage layer keeps under a reserved key is stored as is. A key which does not conform to the
+// collation cannot be stored, and worldstate.ErrKeyNotCollatable is returned.
+func encodeCollatedKey(dbName string, collation *types.DBKeyCollation, key []byte) ([]byte, error) {
+	if worldstate.IsBinaryCollation(collation) || (len(key) > 0 && key[0] == reservedKeyByte) {
+		return encodeKey(dbName, key), nil
+	}
+
+	collated, err := worldstate.CollateKey(collation, string(key))
+	if err != nil {
+		return nil, err
+	}
+	return encodeKey(dbName, collated), nil
+}
+
+// decodeCollatedKey returns the key of a stored key of a database with the given key collation
+func decodeCollatedKey(dbName string, collation *types.DBKeyCollation, storedKey []byte) ([]byte, error) {
+	if worldstate.IsBinaryCollation(collation) || (len(storedKey) > 0 && storedKey[0] == reservedKeyByte) {
+		return decodeKey(dbName, storedKey), nil
+	}
+
+	key, err := worldstate.UncollateKey(collation, decodeKey(dbName, storedKey))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error while decoding the stored key [%x] of database [%s]", storedKey, dbName)
+	}
+	return []byte(key), nil
+}
+
+// keyRange returns the range of stored keys that holds the keys in [startKey, endKey), where an empty key denotes
+// the first or the last key in the database, respectively. The bounds are ordered by the key collation of the
+// database, and must conform to it, see worldstate.CollateRangeBound.
+func keyRange(dbName string, collation *types.DBKeyCollation, startKey, endKey string) (*util.Range, error) {
+	r := &util.Range{}
+	for _, bound := range []struct {
+		key    string
+		stored *[]byte
+	}{
+		{key: startKey, stored: &r.Start},
+		{key: endKey, stored: &r.Limit},
+	} {
+		if bound.key == "" {
+			continue
+		}
+		if worldstate.IsBinaryCollation(collation) {
+			*bound.stored = encodeKey(dbName, []byte(bound.key))
+			continue
+		}
+
+		collated, err := worldstate.CollateRangeBound(collation, bound.key)
+		if err != nil {
+			return nil, err
+		}
+		*bound.stored = encodeKey(dbName, collated)
+	}
+
+	return r, nil
+}
+
+// keyDecodingIterator iterates over the stored keys of a database, and returns them decoded. The keys of a database
+// with a key collation are returned in the order of the collation.
+type keyDecodingIterator struct {
+	iterator.Iterator
+	dbName    string
+	collation *types.DBKeyCollation
+	err       error
+}
+
+func newKeyDecodingIterator(dbName string, collation *types.DBKeyCollation, itr iterator.Iterator) *keyDecodingIterator {
+	return &keyDecodingIterator{
+		Iterator:  itr,
+		dbName:    dbName,
+		collation: collation,
+	}
+}
+
+func (i *keyDecodingIterator) Key() []byte {
+	key := i.Iterator.Key()
+	if key == nil {
+		return nil
+	}
+
+	decoded, err := decodeCollatedKey(i.dbName, i.collation, key)
+	if err != nil {
+		i.err = err
+		return decodeKey(i.dbName, key)
+	}
+	return decoded
+}
+
+// Seek moves the iterator to the first key which is greater than or equal to the given key by the key collation of
+// the database. A key which does not conform to the collation exhausts the iterator.
+func (i *keyDecodingIterator) Seek(key []byte) bool {
+	if worldstate.IsBinaryCollation(i.collation) || len(key) == 0 || key[0] == reservedKeyByte {
+		return i.Iterator.Seek(encodeKey(i.dbName, key))
+	}
+
+	collated, err := worldstate.CollateRangeBound(i.collation, string(key))
+	if err != nil {
+		i.err = err
+		i.Iterator.Last()
+		i.Iterator.Next()
+		return false
+	}
+	return i.Iterator.Seek(encodeKey(i.dbName, collated))
+}
+
+func (i *keyDecodingIterator) Error() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.Iterator.Error()
+}
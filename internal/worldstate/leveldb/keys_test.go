@@ -0,0 +1,119 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/identity"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+func TestEncodeAndDecodeKey(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"", "\x00", "\x00\x00", "\x00\xff", "\x01", "\x01\x00", "\x02", "a", "a\x00b", "b"}
+	for i, key := range keys {
+		encoded := encodeKey(worldstate.DefaultDBName, []byte(key))
+		if len(encoded) > 0 {
+			require.NotEqual(t, byte(reservedKeyByte), encoded[0], "key %q", key)
+		}
+		require.Equal(t, key, string(decodeKey(worldstate.DefaultDBName, encoded)))
+
+		// the encoding preserves the order of the keys
+		if i > 0 {
+			require.Less(t, string(encodeKey(worldstate.DefaultDBName, []byte(keys[i-1]))), string(encoded))
+		}
+	}
+
+	// the keys of system databases are not encoded
+	userKey := string(identity.UserNamespace) + "alice"
+	require.Equal(t, userKey, string(encodeKey(worldstate.UsersDBName, []byte(userKey))))
+	require.Equal(t, userKey, string(decodeKey(worldstate.UsersDBName, []byte(userKey))))
+}
+
+func TestKeysCollidingWithReservedRecords(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+	l := env.l
+
+	// a record the storage layer keeps in the database of the states, under a reserved key
+	reservedKey := string([]byte{reservedKeyByte}) + "version"
+	require.NoError(t, l.dbs[worldstate.DefaultDBName].file.Put([]byte(reservedKey), []byte("reserved-record"), &opt.WriteOptions{}))
+
+	// keys that, before being encoded, would have overwritten the reserved record, or would have been read from it
+	keys := []string{
+		reservedKey,
+		string(identity.UserNamespace) + "alice",
+		"\x01version",
+		"version",
+	}
+
+	updates := &worldstate.DBUpdates{}
+	for _, key := range keys {
+		updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+			Key:   key,
+			Value: []byte("value-of-" + key),
+			Metadata: &types.Metadata{
+				Version: &types.Version{BlockNum: 2, TxNum: 1},
+			},
+		})
+	}
+	require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{worldstate.DefaultDBName: updates}, 2))
+
+	for _, key := range keys {
+		val, metadata, err := l.Get(worldstate.DefaultDBName, key)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-of-"+key), val)
+		require.True(t, proto.Equal(&types.Version{BlockNum: 2, TxNum: 1}, metadata.GetVersion()))
+
+		exist, err := l.Has(worldstate.DefaultDBName, key)
+		require.NoError(t, err)
+		require.True(t, exist)
+	}
+
+	reserved, err := l.dbs[worldstate.DefaultDBName].file.Get([]byte(reservedKey), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("reserved-record"), reserved)
+
+	// iterators return the keys decoded and in order, and skip the reserved records
+	itr, err := l.GetIterator(worldstate.DefaultDBName, "\x00", "")
+	require.NoError(t, err)
+	var iterated []string
+	for itr.Next() {
+		iterated = append(iterated, string(itr.Key()))
+	}
+	itr.Release()
+	require.Equal(t, []string{string(identity.UserNamespace) + "alice", reservedKey, "\x01version", "version"}, iterated)
+
+	itr, err = l.GetIterator(worldstate.DefaultDBName, "", "")
+	require.NoError(t, err)
+	require.True(t, itr.Seek([]byte("\x01")))
+	require.Equal(t, "\x01version", string(itr.Key()))
+	itr.Release()
+
+	snap, err := l.GetDBsSnapshot([]string{worldstate.DefaultDBName})
+	require.NoError(t, err)
+	defer snap.Release()
+	val, _, err := snap.Get(worldstate.DefaultDBName, reservedKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-of-"+reservedKey), val)
+
+	// deletes are encoded as well, and leave the reserved record in place
+	require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{
+		worldstate.DefaultDBName: {Deletes: []string{reservedKey}},
+	}, 3))
+	val, _, err = l.Get(worldstate.DefaultDBName, reservedKey)
+	require.NoError(t, err)
+	require.Nil(t, val)
+	reserved, err = l.dbs[worldstate.DefaultDBName].file.Get([]byte(reservedKey), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("reserved-record"), reserved)
+}
@@ -0,0 +1,603 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// A migration rewrites, online, the records of the state database which are not in the current encoding of the keys
+// and of the values, i.e., the keys of user databases which were stored before the keys were escaped, and the values
+// which were not stored in the deterministic encoding. Each database, but the system database, is walked in batches
+// of records read from a snapshot of the database. The records of a batch which need a rewrite are rewritten while
+// the lock of the database is held, hence, between two commits, and a record which was changed by a commit since the
+// snapshot is left as is, as a commit writes the current encoding. The progress of each database is recorded in the
+// system database after each batch, and a migration which was running when the node stopped is resumed when the
+// node restarts. A migration rewrites a record at most once, as the rewritten record is in the current encoding, and
+// running it again leaves the state database as is. Once all databases are migrated, a sample of the records walked
+// is verified to be stored in the current encoding, byte for byte.
+
+// MigrationConfig holds the parameters of a migration
+type MigrationConfig struct {
+	// BatchSize is the number of records read from a snapshot of a database at once, and rewritten between two
+	// commits
+	BatchSize int
+	// BatchInterval is the pause between two batches, which leaves room for the commits
+	BatchInterval time.Duration
+	// VerifySamples is the number of the records walked that are sampled, and verified once all databases are migrated
+	VerifySamples int
+}
+
+var defaultMigrationConfig = &MigrationConfig{
+	BatchSize:     1000,
+	BatchInterval: 10 * time.Millisecond,
+	VerifySamples: 1000,
+}
+
+var errMigrationStopped = errors.New("the migration was stopped")
+
+// migrationJob is a migration which runs in the background
+type migrationJob struct {
+	conf     *MigrationConfig
+	stop     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+
+	mu      sync.RWMutex
+	aborted bool
+	status  *types.StateMigrationStatus
+	walked  int64
+	samples []*migrationSample
+}
+
+// migrationSample is a record, by its stored key, to be verified once all databases are migrated
+type migrationSample struct {
+	dbName string
+	key    []byte
+}
+
+// StartMigration starts the migration of the records of the state database to the current encoding, in the
+// background. A migration which was aborted is resumed, while a migration which completed or failed is started
+// again from the first record of each database. It is a no-op when a migration is running. A nil configuration
+// denotes the default one.
+func (l *LevelDB) StartMigration(conf *MigrationConfig) error {
+	l.migrationMu.Lock()
+	defer l.migrationMu.Unlock()
+
+	if l.migration != nil && l.migration.running() {
+		return nil
+	}
+	if conf == nil {
+		conf = defaultMigrationConfig
+	}
+
+	m, err := l.getMigration()
+	if err != nil {
+		return err
+	}
+	progress, err := l.getMigrationProgress()
+	if err != nil {
+		return err
+	}
+
+	batch := &leveldb.Batch{}
+	if m == nil || (m.State != sysstate.MigrationRunning && m.State != sysstate.MigrationAborted) {
+		for dbName := range progress {
+			sysstate.DeleteMigrationProgress(batch, dbName)
+		}
+		progress = nil
+	}
+	sysstate.PutMigration(batch, &sysstate.Migration{State: sysstate.MigrationRunning})
+	if err := l.writeMigrationRecords(batch); err != nil {
+		return err
+	}
+
+	job := &migrationJob{
+		conf:    conf,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		status: &types.StateMigrationStatus{
+			State: types.StateMigrationStatus_RUNNING,
+		},
+	}
+	for dbName, p := range progress {
+		if p.Done {
+			job.status.MigratedDbs = append(job.status.MigratedDbs, dbName)
+		}
+	}
+	sort.Strings(job.status.MigratedDbs)
+
+	l.migration = job
+	go l.runMigration(job, progress)
+
+	return nil
+}
+
+// AbortMigration stops the running migration, which can be resumed later by StartMigration. It is a no-op when no
+// migration is running.
+func (l *LevelDB) AbortMigration() {
+	l.migrationMu.Lock()
+	defer l.migrationMu.Unlock()
+
+	if l.migration == nil {
+		return
+	}
+
+	l.migration.mu.Lock()
+	l.migration.aborted = true
+	l.migration.mu.Unlock()
+
+	l.migration.stopAndWait()
+}
+
+// MigrationStatus returns the status of the last migration started on the node
+func (l *LevelDB) MigrationStatus() (*types.StateMigrationStatus, error) {
+	l.migrationMu.Lock()
+	defer l.migrationMu.Unlock()
+
+	if l.migration != nil {
+		l.migration.mu.RLock()
+		defer l.migration.mu.RUnlock()
+
+		return proto.Clone(l.migration.status).(*types.StateMigrationStatus), nil
+	}
+
+	m, err := l.getMigration()
+	if err != nil {
+		return nil, err
+	}
+	progress, err := l.getMigrationProgress()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &types.StateMigrationStatus{}
+	if m != nil {
+		status.State = migrationStatusState(m.State)
+		status.Error = m.Err
+	}
+	for dbName, p := range progress {
+		if p.Done {
+			status.MigratedDbs = append(status.MigratedDbs, dbName)
+		}
+	}
+	sort.Strings(status.MigratedDbs)
+
+	return status, nil
+}
+
+// resumeMigration resumes the migration which was running when the node stopped
+func (l *LevelDB) resumeMigration() error {
+	m, err := l.getMigration()
+	if err != nil {
+		return err
+	}
+	if m == nil || m.State != sysstate.MigrationRunning {
+		return nil
+	}
+
+	l.logger.Info("resuming the migration of the state database to the current encoding")
+	return l.StartMigration(nil)
+}
+
+// stopMigration stops the running migration without aborting it, such that it is resumed once the node restarts
+func (l *LevelDB) stopMigration() {
+	l.migrationMu.Lock()
+	defer l.migrationMu.Unlock()
+
+	if l.migration != nil {
+		l.migration.stopAndWait()
+	}
+}
+
+func (l *LevelDB) runMigration(job *migrationJob, progress map[string]*sysstate.MigrationProgress) {
+	defer close(job.stopped)
+
+	err := l.migrateDBs(job, progress)
+	if err == nil {
+		err = l.verifyMigration(job)
+	}
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	m := &sysstate.Migration{}
+	switch {
+	case err == errMigrationStopped && !job.aborted:
+		// the node is closing, and the migration is resumed once it restarts
+		return
+	case err == errMigrationStopped:
+		m.State = sysstate.MigrationAborted
+		l.logger.Info("the migration of the state database was aborted")
+	case err != nil:
+		m.State = sysstate.MigrationFailed
+		m.Err = err.Error()
+		l.logger.Errorf("the migration of the state database failed: %s", err)
+	default:
+		m.State = sysstate.MigrationCompleted
+		l.logger.Infof("the migration of the state database completed, %d records were rewritten", job.status.RewrittenRecords)
+	}
+
+	job.status.State = migrationStatusState(m.State)
+	job.status.Error = m.Err
+	job.status.CurrentDb = ""
+
+	batch := &leveldb.Batch{}
+	sysstate.PutMigration(batch, m)
+	if err := l.writeMigrationRecords(batch); err != nil {
+		l.logger.Errorf("failed to record the end of the migration of the state database: %s", err)
+	}
+}
+
+// migrateDBs migrates, one by one, all databases but the system database, and the databases which were already
+// migrated according to the given progress
+func (l *LevelDB) migrateDBs(job *migrationJob, progress map[string]*sysstate.MigrationProgress) error {
+	l.dbsList.RLock()
+	var dbNames []string
+	for dbName := range l.dbs {
+		if dbName != worldstate.SystemDBName {
+			dbNames = append(dbNames, dbName)
+		}
+	}
+	l.dbsList.RUnlock()
+	sort.Strings(dbNames)
+
+	for _, dbName := range dbNames {
+		p := progress[dbName]
+		if p != nil && p.Done {
+			continue
+		}
+
+		job.mu.Lock()
+		job.status.CurrentDb = dbName
+		job.mu.Unlock()
+
+		resume := p != nil
+		var lastKey []byte
+		if resume {
+			lastKey = p.LastKey
+		}
+		for {
+			select {
+			case <-job.stop:
+				return errMigrationStopped
+			default:
+			}
+
+			var done bool
+			var err error
+			if lastKey, done, err = l.migrateBatch(job, dbName, resume, lastKey); err != nil {
+				return err
+			}
+			resume = true
+
+			batch := &leveldb.Batch{}
+			sysstate.PutMigrationProgress(batch, dbName, &sysstate.MigrationProgress{LastKey: lastKey, Done: done})
+			if err := l.writeMigrationRecords(batch); err != nil {
+				return err
+			}
+			if done {
+				break
+			}
+
+			select {
+			case <-job.stop:
+				return errMigrationStopped
+			case <-time.After(job.conf.BatchInterval):
+			}
+		}
+
+		job.mu.Lock()
+		job.status.MigratedDbs = append(job.status.MigratedDbs, dbName)
+		sort.Strings(job.status.MigratedDbs)
+		job.mu.Unlock()
+	}
+
+	return nil
+}
+
+// migrateBatch migrates the next batch of records of the database, which follows the given stored key, or starts
+// from the first record if resume is false. It returns the last stored key of the batch, and whether it was the last
+// batch of the database. A database which was deleted has no batch left.
+//
+// The lock of the list of databases is not held along with the lock of the database, as a commit to the database of
+// the databases holds the latter while it creates or deletes a database. A database which is deleted in between is
+// closed, and its operations fail with leveldb.ErrClosed.
+func (l *LevelDB) migrateBatch(job *migrationJob, dbName string, resume bool, after []byte) ([]byte, bool, error) {
+	l.dbsList.RLock()
+	db, ok := l.dbs[dbName]
+	l.dbsList.RUnlock()
+	if !ok {
+		return after, true, nil
+	}
+
+	snap, err := db.file.GetSnapshot()
+	if err == leveldb.ErrClosed {
+		return after, true, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "error while taking a snapshot of database [%s]", dbName)
+	}
+	defer snap.Release()
+
+	itr := snap.NewIterator(nil, &opt.ReadOptions{})
+	defer itr.Release()
+
+	var more bool
+	if resume {
+		if more = itr.Seek(after); more && bytes.Equal(itr.Key(), after) {
+			more = itr.Next()
+		}
+	} else {
+		more = itr.First()
+	}
+
+	type rewrite struct {
+		key, value, newKey, newValue []byte
+	}
+	var rewrites []*rewrite
+	last := after
+	for n := 0; more && n < job.conf.BatchSize; more = itr.Next() {
+		n++
+		r := &rewrite{
+			key:   append([]byte(nil), itr.Key()...),
+			value: append([]byte(nil), itr.Value()...),
+		}
+		last = r.key
+
+		r.newKey = migrateKey(dbName, r.key)
+		if r.newValue, err = migrateValue(r.value); err != nil {
+			return nil, false, errors.WithMessagef(err, "error while decoding the record %q of database [%s]", r.key, dbName)
+		}
+		job.sample(dbName, r.newKey)
+
+		if !bytes.Equal(r.newKey, r.key) || !bytes.Equal(r.newValue, r.value) {
+			rewrites = append(rewrites, r)
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return nil, false, errors.Wrapf(err, "error while iterating over the snapshot of database [%s]", dbName)
+	}
+
+	if len(rewrites) == 0 {
+		return last, !more, nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	batch := &leveldb.Batch{}
+	rewritten := uint64(0)
+	for _, r := range rewrites {
+		current, err := db.file.Get(r.key, db.readOpts)
+		if err == leveldb.ErrClosed {
+			return after, true, nil
+		}
+		if err == leveldb.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "error while retrieving the record %q of database [%s]", r.key, dbName)
+		}
+		if !bytes.Equal(current, r.value) {
+			// the record was rewritten by a commit since the snapshot, in the current encoding
+			continue
+		}
+
+		if !bytes.Equal(r.newKey, r.key) {
+			batch.Delete(r.key)
+
+			// a commit since the escaping of the keys already wrote the key, which supersedes the stored record
+			exist, err := db.file.Has(r.newKey, db.readOpts)
+			if err != nil {
+				return nil, false, errors.Wrapf(err, "error while retrieving the record %q of database [%s]", r.newKey, dbName)
+			}
+			if exist {
+				continue
+			}
+		}
+		batch.Put(r.newKey, r.newValue)
+		rewritten++
+	}
+
+	if err := db.file.Write(batch, db.writeOpts); err == leveldb.ErrClosed {
+		return after, true, nil
+	} else if err != nil {
+		return nil, false, errors.Wrapf(err, "error while writing a migration batch to database [%s]", dbName)
+	}
+
+	job.mu.Lock()
+	job.status.RewrittenRecords += rewritten
+	job.mu.Unlock()
+
+	return last, !more, nil
+}
+
+// verifyMigration verifies that the sampled records are stored in the current encoding, byte for byte. A sampled
+// record which was deleted since is skipped.
+func (l *LevelDB) verifyMigration(job *migrationJob) error {
+	job.mu.RLock()
+	samples := job.samples
+	job.mu.RUnlock()
+
+	for _, s := range samples {
+		select {
+		case <-job.stop:
+			return errMigrationStopped
+		default:
+		}
+
+		value, err := l.getStoredRecord(s.dbName, s.key)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			continue
+		}
+
+		expected, err := migrateValue(value)
+		if err != nil {
+			return errors.WithMessagef(err, "error while decoding the record %q of database [%s]", s.key, s.dbName)
+		}
+		if !bytes.Equal(expected, value) || !bytes.Equal(migrateKey(s.dbName, s.key), s.key) {
+			return errors.Errorf("the record %q of database [%s] is not stored in the current encoding after the migration", s.key, s.dbName)
+		}
+
+		job.mu.Lock()
+		job.status.VerifiedRecords++
+		job.mu.Unlock()
+	}
+
+	return nil
+}
+
+// getStoredRecord returns the stored value of the given stored key, or nil if either the key or the database does
+// not exist
+func (l *LevelDB) getStoredRecord(dbName string, key []byte) ([]byte, error) {
+	l.dbsList.RLock()
+	db, ok := l.dbs[dbName]
+	l.dbsList.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	value, err := db.file.Get(key, db.readOpts)
+	if err == leveldb.ErrNotFound || err == leveldb.ErrClosed {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while retrieving the record %q of database [%s]", key, dbName)
+	}
+	return value, nil
+}
+
+func (l *LevelDB) getMigration() (*sysstate.Migration, error) {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	db, ok := l.dbs[worldstate.SystemDBName]
+	if !ok {
+		return nil, errors.Errorf("unable to retrieve the migration record due to missing systemDB")
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return sysstate.GetMigration(db.file)
+}
+
+func (l *LevelDB) getMigrationProgress() (map[string]*sysstate.MigrationProgress, error) {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	db, ok := l.dbs[worldstate.SystemDBName]
+	if !ok {
+		return nil, errors.Errorf("unable to retrieve the migration progress due to missing systemDB")
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return sysstate.GetMigrationProgress(db.file)
+}
+
+func (l *LevelDB) writeMigrationRecords(batch *leveldb.Batch) error {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	db, ok := l.dbs[worldstate.SystemDBName]
+	if !ok {
+		return errors.Errorf("system database does not exist")
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if err := db.file.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return errors.Wrap(err, "error while storing the migration records to the systemDB")
+	}
+	return nil
+}
+
+func (j *migrationJob) running() bool {
+	select {
+	case <-j.stopped:
+		return false
+	default:
+		return true
+	}
+}
+
+func (j *migrationJob) stopAndWait() {
+	j.stopOnce.Do(func() { close(j.stop) })
+	<-j.stopped
+}
+
+// sample samples the records walked uniformly, by reservoir sampling
+func (j *migrationJob) sample(dbName string, key []byte) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.walked++
+	s := &migrationSample{dbName: dbName, key: key}
+	switch {
+	case len(j.samples) < j.conf.VerifySamples:
+		j.samples = append(j.samples, s)
+	default:
+		if i := rand.Int63n(j.walked); i < int64(len(j.samples)) {
+			j.samples[i] = s
+		}
+	}
+}
+
+// migrateKey returns the given stored key in the current encoding. A key of a user database which was stored before
+// the keys were escaped begins either with reservedKeyByte, as the storage layer keeps no record under it yet, or with
+// keyEscapeByte followed by a greater byte, which the escaping never yields. A stored key which begins with
+// keyEscapeByte followed by either byte is taken as escaped, as the two cannot be told apart.
+func migrateKey(dbName string, key []byte) []byte {
+	if worldstate.IsSystemDB(dbName) || len(key) == 0 {
+		return key
+	}
+
+	if key[0] == reservedKeyByte || (key[0] == keyEscapeByte && (len(key) == 1 || key[1] > keyEscapeByte)) {
+		return encodeKey(dbName, key)
+	}
+	return key
+}
+
+// migrateValue returns the given stored value in the current encoding
+func migrateValue(value []byte) ([]byte, error) {
+	persisted := &types.ValueWithMetadata{}
+	if err := proto.Unmarshal(value, persisted); err != nil {
+		return nil, err
+	}
+
+	return encodeValue(persisted.Value, persisted.Metadata, persisted.ValueHash)
+}
+
+func migrationStatusState(state sysstate.MigrationState) types.StateMigrationStatus_State {
+	switch state {
+	case sysstate.MigrationRunning:
+		return types.StateMigrationStatus_RUNNING
+	case sysstate.MigrationAborted:
+		return types.StateMigrationStatus_ABORTED
+	case sysstate.MigrationCompleted:
+		return types.StateMigrationStatus_COMPLETED
+	case sysstate.MigrationFailed:
+		return types.StateMigrationStatus_FAILED
+	default:
+		return types.StateMigrationStatus_NONE
+	}
+}
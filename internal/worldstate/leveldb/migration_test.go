@@ -0,0 +1,289 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+func TestMigrateKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dbName   string
+		stored   string
+		expected string
+	}{
+		{dbName: "db1", stored: "key", expected: "key"},
+		{dbName: "db1", stored: "", expected: ""},
+		{dbName: "db1", stored: "\x00key", expected: "\x01\x00key"},
+		{dbName: "db1", stored: "\x01", expected: "\x01\x01"},
+		{dbName: "db1", stored: "\x01key", expected: "\x01\x01key"},
+		// escaped keys are left as is
+		{dbName: "db1", stored: "\x01\x00key", expected: "\x01\x00key"},
+		{dbName: "db1", stored: "\x01\x01key", expected: "\x01\x01key"},
+		// the keys of system databases are not escaped
+		{dbName: worldstate.UsersDBName, stored: "\x00key", expected: "\x00key"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, string(migrateKey(tt.dbName, []byte(tt.stored))), "key %q of database [%s]", tt.stored, tt.dbName)
+	}
+}
+
+// migrationTestState holds the logical state of a database, by key, as it is expected to be read
+type migrationTestState struct {
+	mu      sync.Mutex
+	entries map[string]*types.ValueWithMetadata
+}
+
+func (s *migrationTestState) set(key string, value *types.ValueWithMetadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = value
+}
+
+// putLegacyRecords stores, under the given stored keys, records which are not in the current encoding: the value of
+// each record follows its metadata
+func putLegacyRecords(t *testing.T, l *LevelDB, dbName string, state *migrationTestState, keys map[string]string) {
+	for storedKey, key := range keys {
+		value := &types.ValueWithMetadata{
+			Value: []byte("legacy-value-of-" + key),
+			Metadata: &types.Metadata{
+				Version: &types.Version{BlockNum: 1, TxNum: 1},
+				AccessControl: &types.AccessControl{
+					ReadUsers:      map[string]bool{"alice": true, "bob": true, "charlie": true},
+					ReadWriteUsers: map[string]bool{"alice": true, "dave": true},
+				},
+			},
+		}
+		metadataBytes, err := proto.Marshal(&types.ValueWithMetadata{Metadata: value.Metadata})
+		require.NoError(t, err)
+		valueBytes, err := proto.Marshal(&types.ValueWithMetadata{Value: value.Value})
+		require.NoError(t, err)
+
+		require.NoError(t, l.dbs[dbName].file.Put([]byte(storedKey), append(metadataBytes, valueBytes...), &opt.WriteOptions{}))
+		state.set(key, value)
+	}
+}
+
+func requireMigrationState(t *testing.T, l *LevelDB, state types.StateMigrationStatus_State) *types.StateMigrationStatus {
+	var status *types.StateMigrationStatus
+	require.Eventually(t, func() bool {
+		var err error
+		status, err = l.MigrationStatus()
+		require.NoError(t, err)
+		return status.State == state
+	}, 30*time.Second, 10*time.Millisecond)
+	return status
+}
+
+// requireMigratedState checks that the database reads the given logical state, and that all its records are stored
+// in the current encoding
+func requireMigratedState(t *testing.T, l *LevelDB, dbName string, state *migrationTestState) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	for key, expected := range state.entries {
+		value, metadata, err := l.Get(dbName, key)
+		require.NoError(t, err)
+		require.Equal(t, expected.Value, value, "key %q", key)
+		require.True(t, proto.Equal(expected.Metadata, metadata), "key %q", key)
+	}
+
+	itr, err := l.GetIterator(dbName, "", "")
+	require.NoError(t, err)
+	defer itr.Release()
+	keys := 0
+	for itr.Next() {
+		_, ok := state.entries[string(itr.Key())]
+		require.True(t, ok, "key %q", itr.Key())
+		keys++
+	}
+	require.Equal(t, len(state.entries), keys)
+
+	stored := l.dbs[dbName].file.NewIterator(nil, &opt.ReadOptions{})
+	defer stored.Release()
+	for stored.Next() {
+		require.Equal(t, stored.Key(), migrateKey(dbName, stored.Key()))
+		value, err := migrateValue(stored.Value())
+		require.NoError(t, err)
+		require.Equal(t, stored.Value(), value)
+	}
+}
+
+func dumpDB(t *testing.T, l *LevelDB, dbName string) map[string][]byte {
+	dump := make(map[string][]byte)
+	itr := l.dbs[dbName].file.NewIterator(nil, &opt.ReadOptions{})
+	defer itr.Release()
+	for itr.Next() {
+		dump[string(itr.Key())] = append([]byte(nil), itr.Value()...)
+	}
+	require.NoError(t, itr.Error())
+	return dump
+}
+
+func TestMigration(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T, l *LevelDB) *migrationTestState {
+		require.NoError(t, l.create("db1"))
+
+		state := &migrationTestState{entries: make(map[string]*types.ValueWithMetadata)}
+		keys := make(map[string]string)
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			keys[key] = key
+			keys["\x00"+key] = "\x00" + key
+			keys["\x01"+key] = "\x01" + key
+		}
+		putLegacyRecords(t, l, "db1", state, keys)
+		return state
+	}
+
+	t.Run("under concurrent commits, aborted and resumed", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		defer env.cleanup()
+		l := env.l
+		state := setup(t, l)
+
+		// blocks are committed along the migration, which write new keys, and overwrite or delete some of the keys
+		// stored in the legacy encoding
+		stopCommits := make(chan struct{})
+		commitsDone := make(chan struct{})
+		go func() {
+			defer close(commitsDone)
+			for blockNum := uint64(2); ; blockNum++ {
+				select {
+				case <-stopCommits:
+					return
+				default:
+				}
+
+				i := int(blockNum) % 100
+				metadata := &types.Metadata{Version: &types.Version{BlockNum: blockNum, TxNum: 0}}
+				updates := &worldstate.DBUpdates{
+					Writes: []*worldstate.KVWithMetadata{
+						{Key: fmt.Sprintf("live-%d", blockNum), Value: []byte("live"), Metadata: metadata},
+						{Key: fmt.Sprintf("key-%03d", i), Value: []byte(fmt.Sprintf("value-%d", blockNum)), Metadata: metadata},
+						{Key: fmt.Sprintf("\x01key-%03d", (i+1)%100), Value: []byte(fmt.Sprintf("value-%d", blockNum)), Metadata: metadata},
+					},
+				}
+				if blockNum%10 == 0 {
+					updates.Deletes = []string{fmt.Sprintf("live-%d", blockNum-1)}
+				}
+				require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{"db1": updates}, blockNum))
+
+				for _, kv := range updates.Writes {
+					state.set(kv.Key, &types.ValueWithMetadata{Value: kv.Value, Metadata: kv.Metadata})
+				}
+				for _, key := range updates.Deletes {
+					state.mu.Lock()
+					delete(state.entries, key)
+					state.mu.Unlock()
+				}
+			}
+		}()
+
+		conf := &MigrationConfig{BatchSize: 7, BatchInterval: time.Millisecond, VerifySamples: 50}
+		require.NoError(t, l.StartMigration(conf))
+		require.Eventually(t, func() bool {
+			status, err := l.MigrationStatus()
+			require.NoError(t, err)
+			return status.RewrittenRecords > 20
+		}, 30*time.Second, time.Millisecond)
+
+		l.AbortMigration()
+		status, err := l.MigrationStatus()
+		require.NoError(t, err)
+		require.Equal(t, types.StateMigrationStatus_ABORTED, status.State)
+		progress, err := l.getMigrationProgress()
+		require.NoError(t, err)
+		require.NotNil(t, progress[worldstate.DefaultDBName])
+
+		// the aborted migration is resumed
+		require.NoError(t, l.StartMigration(conf))
+		status = requireMigrationState(t, l, types.StateMigrationStatus_COMPLETED)
+		require.Empty(t, status.Error)
+		require.NotZero(t, status.VerifiedRecords)
+		require.LessOrEqual(t, status.VerifiedRecords, uint64(50))
+		require.Contains(t, status.MigratedDbs, "db1")
+		require.Contains(t, status.MigratedDbs, worldstate.UsersDBName)
+		require.NotContains(t, status.MigratedDbs, worldstate.SystemDBName)
+
+		close(stopCommits)
+		<-commitsDone
+		requireMigratedState(t, l, "db1", state)
+
+		// a migration started again rewrites nothing
+		before := dumpDB(t, l, "db1")
+		require.NoError(t, l.StartMigration(conf))
+		status = requireMigrationState(t, l, types.StateMigrationStatus_COMPLETED)
+		require.Equal(t, uint64(0), status.RewrittenRecords)
+		require.Equal(t, before, dumpDB(t, l, "db1"))
+	})
+
+	t.Run("resumed on restart", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		defer env.cleanup()
+		l := env.l
+		state := setup(t, l)
+
+		// the migration is stopped by the close of the instance while it waits after its first batch
+		require.NoError(t, l.StartMigration(&MigrationConfig{BatchSize: 10, BatchInterval: time.Hour, VerifySamples: 10}))
+		require.Eventually(t, func() bool {
+			progress, err := l.getMigrationProgress()
+			require.NoError(t, err)
+			return len(progress) > 0
+		}, 30*time.Second, time.Millisecond)
+		require.NoError(t, l.Close())
+
+		l, err := Open(&Config{DBRootDir: env.path, Logger: l.logger})
+		require.NoError(t, err)
+		defer l.Close()
+
+		status := requireMigrationState(t, l, types.StateMigrationStatus_COMPLETED)
+		require.NotZero(t, status.RewrittenRecords)
+		require.NotZero(t, status.VerifiedRecords)
+		requireMigratedState(t, l, "db1", state)
+
+		// the completed migration is not resumed on the next restart
+		require.NoError(t, l.Close())
+		l, err = Open(&Config{DBRootDir: env.path, Logger: l.logger})
+		require.NoError(t, err)
+		defer l.Close()
+
+		status, err = l.MigrationStatus()
+		require.NoError(t, err)
+		require.Equal(t, types.StateMigrationStatus_COMPLETED, status.State)
+		require.Contains(t, status.MigratedDbs, "db1")
+	})
+
+	t.Run("failed verification", func(t *testing.T) {
+		t.Parallel()
+
+		env := newTestEnv(t)
+		defer env.cleanup()
+		l := env.l
+		require.NoError(t, l.create("db1"))
+		require.NoError(t, l.dbs["db1"].file.Put([]byte("key"), []byte("not-a-record"), &opt.WriteOptions{}))
+
+		require.NoError(t, l.StartMigration(nil))
+		status := requireMigrationState(t, l, types.StateMigrationStatus_FAILED)
+		require.Contains(t, status.Error, "error while decoding the record \"key\" of database [db1]")
+	})
+}
@@ -6,10 +6,13 @@ import (
 	"path/filepath"
 	"regexp"
 	"sync"
+	"time"
 
 	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
@@ -34,6 +37,23 @@ type LevelDB struct {
 	logger      *logger.SugarLogger
 	dbsList     sync.RWMutex
 	dbNameRegex *regexp.Regexp
+	stats       *statsCollector
+	// skipped caches the skipped commits recorded in the system database, by the name of the database
+	skipped   map[string][]uint64
+	skippedMu sync.RWMutex
+	// migration is the last migration started since the instance was opened
+	migration   *migrationJob
+	migrationMu sync.Mutex
+	// handles tracks the snapshots and iterators handed out and not yet released
+	handles *handleTracker
+	// integrityChecked holds the databases in the integrity mode, whose values are stored along with their hashes
+	integrityChecked map[string]bool
+	// scrub is the last scrub started since the instance was opened
+	scrub   *scrubJob
+	scrubMu sync.Mutex
+	// commitMu is held by the commit of a block, which commits the databases one after the other, and by the
+	// snapshots which must hold all or none of the updates of each block, see GetDBsSnapshotWithHeight
+	commitMu sync.RWMutex
 }
 
 // db - a wrapper on an actual store
@@ -43,6 +63,10 @@ type db struct {
 	mu        sync.RWMutex
 	readOpts  *opt.ReadOptions
 	writeOpts *opt.WriteOptions
+	// collation is the key collation of the database, or nil if its keys are ordered byte by byte
+	collation *types.DBKeyCollation
+	// blockCache counts the hits and the misses of the block cache of the database
+	blockCache *blockCacheCounter
 }
 
 var (
@@ -54,11 +78,41 @@ var (
 
 type Config struct {
 	DBRootDir string
-	Logger    *logger.SugarLogger
+	// StatsSamplingInterval is the interval at which the internal statistics of the databases are sampled into
+	// metrics. Zero disables the sampling.
+	StatsSamplingInterval time.Duration
+	// HandleTTL is the maximal time a snapshot, or an iterator, may be held before it is released on behalf of its
+	// owner. Zero disables the release.
+	HandleTTL time.Duration
+	// IntegrityCheckedDBs are the databases in the integrity mode: the hash of each value is stored along with the
+	// value, and is verified whenever the value is read, see worldstate.ErrValueCorrupted. The values stored before a
+	// database was put in the mode are read unverified.
+	IntegrityCheckedDBs []string
+	Logger              *logger.SugarLogger
 }
 
 // Open opens a leveldb instance to maintain world state
 func Open(conf *Config) (*LevelDB, error) {
+	l, err := open(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.StatsSamplingInterval > 0 {
+		l.startStatsCollector(conf.StatsSamplingInterval)
+	}
+	if conf.HandleTTL > 0 {
+		l.startHandleReaper(conf.HandleTTL)
+	}
+
+	if err := l.resumeMigration(); err != nil {
+		return nil, errors.WithMessage(err, "error while resuming the migration of the state database")
+	}
+
+	return l, nil
+}
+
+func open(conf *Config) (*LevelDB, error) {
 	exist, err := fileops.Exists(conf.DBRootDir)
 	if err != nil {
 		return nil, err
@@ -112,6 +166,10 @@ func openNewLevelDBInstance(c *Config) (*LevelDB, error) {
 		dbs:         make(map[string]*db),
 		logger:      c.Logger,
 		dbNameRegex: regexp.MustCompile(allowedCharsInDBName),
+		skipped:     make(map[string][]uint64),
+		handles:     newHandleTracker(),
+
+		integrityChecked: integrityCheckedDBs(c.IntegrityCheckedDBs),
 	}
 
 	for _, dbName := range preCreateDBs {
@@ -133,6 +191,9 @@ func openExistingLevelDBInstance(c *Config) (*LevelDB, error) {
 		dbs:         make(map[string]*db),
 		logger:      c.Logger,
 		dbNameRegex: regexp.MustCompile(allowedCharsInDBName),
+		handles:     newHandleTracker(),
+
+		integrityChecked: integrityCheckedDBs(c.IntegrityCheckedDBs),
 	}
 
 	dbNames, err := fileops.ListSubdirs(c.DBRootDir)
@@ -141,27 +202,62 @@ func openExistingLevelDBInstance(c *Config) (*LevelDB, error) {
 	}
 
 	for _, dbName := range dbNames {
+		blockCache := &blockCacheCounter{}
 		file, err := leveldb.OpenFile(
 			filepath.Join(l.dbRootDir, dbName),
-			&opt.Options{ErrorIfMissing: false},
+			&opt.Options{ErrorIfMissing: false, BlockCacher: blockCache},
 		)
 		if err != nil {
 			return nil, errors.WithMessagef(err, "failed to open leveldb file for database %s", dbName)
 		}
 
 		l.dbs[dbName] = &db{
-			name:      dbName,
-			file:      file,
-			readOpts:  &opt.ReadOptions{},
-			writeOpts: &opt.WriteOptions{Sync: true},
+			name:       dbName,
+			file:       file,
+			readOpts:   &opt.ReadOptions{},
+			writeOpts:  &opt.WriteOptions{Sync: true},
+			blockCache: blockCache,
+		}
+	}
+
+	// system databases which were introduced after the instance was created are created on open
+	for _, dbName := range worldstate.SystemDBs() {
+		if err := l.create(dbName); err != nil {
+			return nil, err
+		}
+	}
+
+	for dbName, db := range l.dbs {
+		if db.collation, err = l.loadKeyCollation(dbName); err != nil {
+			return nil, err
 		}
 	}
 
+	migrated, err := sysstate.MigrateLegacyRecords(l.dbs[worldstate.MetadataDBName].file, l.dbs[worldstate.SystemDBName].file)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while migrating the legacy records to the system database")
+	}
+	if migrated {
+		l.logger.Info("migrated the legacy records of the metadata database to the system database")
+	}
+
+	if l.skipped, err = sysstate.GetSkippedCommits(l.dbs[worldstate.SystemDBName].file); err != nil {
+		return nil, err
+	}
+	for dbName, blockNumbers := range l.skipped {
+		l.logger.Warnf("database [%s] is unavailable, as the updates of blocks %v to it were not committed. It must be resynced", dbName, blockNumbers)
+	}
+
 	return l, nil
 }
 
 // Close closes the database instance by closing all leveldb databases
 func (l *LevelDB) Close() error {
+	l.stopStatsCollector()
+	l.stopHandleReaper()
+	l.stopMigration()
+	l.stopScrub()
+
 	l.dbsList.Lock()
 	defer l.dbsList.Unlock()
 
@@ -7,11 +7,14 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hyperledger-labs/orion-server/internal/fileops"
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
 	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 )
 
@@ -179,6 +182,97 @@ func TestOpenLevelDBInstance(t *testing.T) {
 	})
 }
 
+func TestOpenPreSystemDBInstance(t *testing.T) {
+	t.Parallel()
+
+	lg, err := logger.New(&logger.Config{
+		Level:         "debug",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	})
+	require.NoError(t, err)
+
+	testDir, err := ioutil.TempDir("", "opentest")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	// the fixture was created before the system database was introduced, with blocks 1 to 7 committed and the
+	// database db1 created by block 2. It is copied, as the open migrates it.
+	dbRootDir := filepath.Join(testDir, "pre-system-db")
+	copyDir(t, "testdata/pre-system-db", dbRootDir)
+	require.NoDirExists(t, filepath.Join(dbRootDir, worldstate.SystemDBName))
+
+	assertMigrated := func(l *LevelDB) {
+		height, err := l.Height()
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), height)
+
+		info, err := l.GetLastCommitInfo()
+		require.NoError(t, err)
+		require.Equal(t, &sysstate.CommitInfo{BlockNumber: 7}, info)
+
+		require.Equal(t, []string{"db1"}, l.ListDBs())
+		value, metadata, err := l.Get(worldstate.DefaultDBName, "key7")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+		require.Equal(t, uint64(7), metadata.GetVersion().GetBlockNum())
+
+		_, err = l.dbs[worldstate.MetadataDBName].file.Get([]byte("lastCommittedBlockNumber"), nil)
+		require.Equal(t, leveldb.ErrNotFound, err)
+	}
+
+	conf := &Config{
+		DBRootDir: dbRootDir,
+		Logger:    lg,
+	}
+	l, err := Open(conf)
+	require.NoError(t, err)
+	assertMigrated(l)
+
+	// the migration is done once, and the commits that follow are recorded in the system database only
+	require.NoError(t, l.Close())
+	l, err = Open(conf)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, l.Close())
+	}()
+	assertMigrated(l)
+
+	before := time.Now()
+	require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{}, 8))
+	height, err := l.Height()
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), height)
+	info, err := l.GetLastCommitInfo()
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), info.BlockNumber)
+	require.False(t, info.CommittedAt.Before(before))
+}
+
+func copyDir(t *testing.T, src, dst string) {
+	require.NoError(t, filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(target, content, 0644)
+	}))
+}
+
 func TestValidDBName(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// GetRollupHeight returns the number of the last block aggregated into the daily rollups, and false if the rollups
+// were never recorded
+func (l *LevelDB) GetRollupHeight() (uint64, bool, error) {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	db, ok := l.dbs[worldstate.SystemDBName]
+	if !ok {
+		return 0, false, errors.Errorf("unable to retrieve the rollup height due to missing systemDB")
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return sysstate.GetRollupHeight(db.file)
+}
+
+// GetDailyRollups returns the rollups of the days from startDay to endDay, both included, in days since the Unix
+// epoch. The days on which no block was aggregated are left out.
+func (l *LevelDB) GetDailyRollups(startDay, endDay uint64) ([]*sysstate.DailyRollup, error) {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	db, ok := l.dbs[worldstate.SystemDBName]
+	if !ok {
+		return nil, errors.Errorf("unable to retrieve the daily rollups due to missing systemDB")
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return sysstate.GetDailyRollups(db.file, startDay, endDay)
+}
+
+// CommitBlockRollup adds the summary of a block to the rollup of its day, counts the users who signed a transaction
+// of the block as active users of the day, and advances the rollup height to the block, all in a single batch. The
+// summary holds the counts of the block alone, and its number of active users is ignored. A nil summary, e.g., of a
+// block without a timestamp, only advances the height. A block at or below the rollup height was already aggregated,
+// and is ignored.
+func (l *LevelDB) CommitBlockRollup(blockNumber uint64, summary *sysstate.DailyRollup, users []string) error {
+	l.dbsList.RLock()
+	db, exists := l.dbs[worldstate.SystemDBName]
+	l.dbsList.RUnlock()
+	if !exists {
+		return errors.Errorf("system database does not exist")
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	height, recorded, err := sysstate.GetRollupHeight(db.file)
+	if err != nil {
+		return err
+	}
+	if recorded && blockNumber <= height {
+		return nil
+	}
+
+	batch := &leveldb.Batch{}
+	if summary != nil {
+		rollup, err := sysstate.GetDailyRollup(db.file, summary.Day)
+		if err != nil {
+			return err
+		}
+		if rollup == nil {
+			rollup = &sysstate.DailyRollup{Day: summary.Day, FirstBlock: blockNumber}
+		}
+		rollup.Blocks += summary.Blocks
+		rollup.Transactions += summary.Transactions
+		rollup.ValidTransactions += summary.ValidTransactions
+		rollup.BytesWritten += summary.BytesWritten
+		rollup.LastBlock = blockNumber
+
+		counted := make(map[string]bool)
+		for _, userID := range users {
+			if counted[userID] {
+				continue
+			}
+			counted[userID] = true
+
+			active, err := sysstate.HasRollupUser(db.file, summary.Day, userID)
+			if err != nil {
+				return err
+			}
+			if !active {
+				sysstate.PutRollupUser(batch, summary.Day, userID)
+				rollup.ActiveUsers++
+			}
+		}
+		sysstate.PutDailyRollup(batch, rollup)
+	}
+	sysstate.PutRollupHeight(batch, blockNumber)
+
+	if err := db.file.Write(batch, &opt.WriteOptions{}); err != nil {
+		return errors.Wrapf(err, "error while storing the rollup of block [%d] to the systemDB", blockNumber)
+	}
+	return nil
+}
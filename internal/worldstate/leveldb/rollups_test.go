@@ -0,0 +1,66 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package leveldb
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/internal/sysstate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitBlockRollup(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	requireHeight := func(expected uint64, expectedRecorded bool) {
+		height, recorded, err := env.l.GetRollupHeight()
+		require.NoError(t, err)
+		require.Equal(t, expectedRecorded, recorded)
+		require.Equal(t, expected, height)
+	}
+	requireHeight(0, false)
+
+	blockSummary := func(day, blockNum, txs, validTxs, bytesWritten uint64) *sysstate.DailyRollup {
+		return &sysstate.DailyRollup{
+			Day:               day,
+			Blocks:            1,
+			Transactions:      txs,
+			ValidTransactions: validTxs,
+			BytesWritten:      bytesWritten,
+			FirstBlock:        blockNum,
+			LastBlock:         blockNum,
+		}
+	}
+	require.NoError(t, env.l.CommitBlockRollup(1, blockSummary(100, 1, 1, 1, 0), []string{"admin"}))
+	require.NoError(t, env.l.CommitBlockRollup(2, blockSummary(100, 2, 3, 2, 30), []string{"alice", "bob", "alice"}))
+	// a block without a timestamp falls on no day
+	require.NoError(t, env.l.CommitBlockRollup(3, nil, nil))
+	require.NoError(t, env.l.CommitBlockRollup(4, blockSummary(101, 4, 2, 2, 12), []string{"alice"}))
+	requireHeight(4, true)
+
+	expected := []*sysstate.DailyRollup{
+		{Day: 100, Blocks: 2, Transactions: 4, ValidTransactions: 3, ActiveUsers: 3, BytesWritten: 30, FirstBlock: 1, LastBlock: 2},
+		{Day: 101, Blocks: 1, Transactions: 2, ValidTransactions: 2, ActiveUsers: 1, BytesWritten: 12, FirstBlock: 4, LastBlock: 4},
+	}
+	rollups, err := env.l.GetDailyRollups(0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, expected, rollups)
+
+	// a block that was already aggregated is ignored
+	require.NoError(t, env.l.CommitBlockRollup(2, blockSummary(100, 2, 3, 2, 30), []string{"carol"}))
+	rollups, err = env.l.GetDailyRollups(100, 100)
+	require.NoError(t, err)
+	require.Equal(t, expected[:1], rollups)
+	requireHeight(4, true)
+
+	rollups, err = env.l.GetDailyRollups(101, 200)
+	require.NoError(t, err)
+	require.Equal(t, expected[1:], rollups)
+	rollups, err = env.l.GetDailyRollups(102, 200)
+	require.NoError(t, err)
+	require.Empty(t, rollups)
+}
@@ -0,0 +1,227 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// A scrub walks a database in the integrity mode, from a snapshot of the database, and verifies the stored hash of
+// each of its values, to detect the values which rotted since they were committed, including those which are rarely
+// read. The records are walked in batches, between which the scrub pauses to leave room for the commits and the
+// queries. Unlike a migration, a scrub only reads the database, and a scrub which was running when the node stopped
+// is not resumed.
+
+// ScrubConfig holds the parameters of a scrub
+type ScrubConfig struct {
+	// BatchSize is the number of records verified between two pauses
+	BatchSize int
+	// BatchInterval is the pause between two batches
+	BatchInterval time.Duration
+}
+
+var defaultScrubConfig = &ScrubConfig{
+	BatchSize:     1000,
+	BatchInterval: 10 * time.Millisecond,
+}
+
+// maxReportedCorruptions bounds the number of corrupted values reported in the status of a scrub
+const maxReportedCorruptions = 100
+
+var errScrubStopped = errors.New("the scrub was stopped")
+
+// scrubJob is a scrub which runs in the background
+type scrubJob struct {
+	conf    *ScrubConfig
+	stop    chan struct{}
+	stopped chan struct{}
+
+	mu     sync.RWMutex
+	status *types.StateScrubStatus
+}
+
+// StartScrub starts the scrub of the given database in the background. It is a no-op when a scrub of the same
+// database is running, and it fails when a scrub of another database is running. A nil configuration denotes the
+// default one.
+func (l *LevelDB) StartScrub(dbName string, conf *ScrubConfig) error {
+	l.scrubMu.Lock()
+	defer l.scrubMu.Unlock()
+
+	if !l.integrityChecked[dbName] {
+		return errors.Errorf("database [%s] is not in the integrity mode", dbName)
+	}
+	if !l.Exist(dbName) {
+		return &DBNotFoundErr{dbName: dbName}
+	}
+	if l.scrub != nil && l.scrub.running() {
+		l.scrub.mu.RLock()
+		defer l.scrub.mu.RUnlock()
+
+		if l.scrub.status.DbName == dbName {
+			return nil
+		}
+		return errors.Errorf("the scrub of database [%s] is running", l.scrub.status.DbName)
+	}
+	if conf == nil {
+		conf = defaultScrubConfig
+	}
+
+	job := &scrubJob{
+		conf:    conf,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		status: &types.StateScrubStatus{
+			State:  types.StateScrubStatus_RUNNING,
+			DbName: dbName,
+		},
+	}
+	l.scrub = job
+	go l.runScrub(job, dbName)
+
+	return nil
+}
+
+// ScrubStatus returns the status of the last scrub started since the instance was opened
+func (l *LevelDB) ScrubStatus() *types.StateScrubStatus {
+	l.scrubMu.Lock()
+	defer l.scrubMu.Unlock()
+
+	if l.scrub == nil {
+		return &types.StateScrubStatus{}
+	}
+
+	l.scrub.mu.RLock()
+	defer l.scrub.mu.RUnlock()
+
+	return proto.Clone(l.scrub.status).(*types.StateScrubStatus)
+}
+
+// stopScrub stops the running scrub, as the instance is closing
+func (l *LevelDB) stopScrub() {
+	l.scrubMu.Lock()
+	defer l.scrubMu.Unlock()
+
+	if l.scrub != nil && l.scrub.running() {
+		close(l.scrub.stop)
+		<-l.scrub.stopped
+	}
+}
+
+func (l *LevelDB) runScrub(job *scrubJob, dbName string) {
+	defer close(job.stopped)
+
+	err := l.scrubDB(job, dbName)
+
+	job.mu.Lock()
+	defer job.mu.Unlock()
+
+	switch {
+	case err == errScrubStopped:
+		// the instance is closing
+		job.status.State = types.StateScrubStatus_FAILED
+		job.status.Error = err.Error()
+	case err != nil:
+		job.status.State = types.StateScrubStatus_FAILED
+		job.status.Error = err.Error()
+		l.logger.Errorf("the scrub of database [%s] failed: %s", dbName, err)
+	default:
+		job.status.State = types.StateScrubStatus_COMPLETED
+		l.logger.Infof("the scrub of database [%s] completed, %d records were verified, %d records have no hash, and %d records are corrupted",
+			dbName, job.status.VerifiedRecords, job.status.UnhashedRecords, job.status.CorruptedRecords)
+	}
+}
+
+// scrubDB verifies the records of the database as of a snapshot taken when the scrub starts. A record committed
+// since is verified whenever it is read.
+func (l *LevelDB) scrubDB(job *scrubJob, dbName string) error {
+	l.dbsList.RLock()
+	db, ok := l.dbs[dbName]
+	l.dbsList.RUnlock()
+	if !ok {
+		return &DBNotFoundErr{dbName: dbName}
+	}
+
+	snap, err := db.file.GetSnapshot()
+	if err != nil {
+		return errors.Wrapf(err, "error while taking a snapshot of database [%s]", dbName)
+	}
+	defer snap.Release()
+
+	itr := newKeyDecodingIterator(dbName, db.collation, snap.NewIterator(nil, &opt.ReadOptions{}))
+	defer itr.Release()
+
+	for more := itr.First(); more; {
+		for n := 0; more && n < job.conf.BatchSize; more = itr.Next() {
+			n++
+			if err := job.verify(dbName, itr.Key(), itr.Value()); err != nil {
+				l.logger.Errorf("%s", err)
+			}
+		}
+		if err := itr.Error(); err != nil {
+			return errors.Wrapf(err, "error while iterating over the snapshot of database [%s]", dbName)
+		}
+		if !more {
+			break
+		}
+
+		select {
+		case <-job.stop:
+			return errScrubStopped
+		case <-time.After(job.conf.BatchInterval):
+		}
+	}
+
+	return nil
+}
+
+// verify verifies the hash of a stored record, and records the outcome in the status of the scrub. It returns the
+// corruption of the record, if any. A record which cannot be decoded is corrupted as well.
+func (j *scrubJob) verify(dbName string, decodedKey, dbval []byte) error {
+	key := string(decodedKey)
+
+	var err error
+	persisted := &types.ValueWithMetadata{}
+	if decodeErr := proto.Unmarshal(dbval, persisted); decodeErr != nil {
+		err = errors.Wrapf(decodeErr, "error while decoding the value of key [%s] in database [%s]", key, dbName)
+	} else {
+		err = verifyValueHash(dbName, key, persisted)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	switch {
+	case err != nil:
+		j.status.CorruptedRecords++
+		if len(j.status.CorruptedValues) < maxReportedCorruptions {
+			corrupted := &types.CorruptedValue{Key: key}
+			if e, ok := err.(*worldstate.ErrValueCorrupted); ok {
+				corrupted.StoredHash = e.StoredHash
+				corrupted.ComputedHash = e.ComputedHash
+			}
+			j.status.CorruptedValues = append(j.status.CorruptedValues, corrupted)
+		}
+	case len(persisted.ValueHash) == 0:
+		j.status.UnhashedRecords++
+	default:
+		j.status.VerifiedRecords++
+	}
+
+	return err
+}
+
+func (j *scrubJob) running() bool {
+	select {
+	case <-j.stopped:
+		return false
+	default:
+		return true
+	}
+}
@@ -3,18 +3,24 @@ package leveldb
 import (
 	"sync"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-labs/orion-server/internal/worldstate"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
-	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 type Snapshots struct {
 	dbSnap map[string]*leveldb.Snapshot
 	sync.RWMutex
+	tracker *handleTracker
+	id      uint64
+	// released is set once the tracker released the snapshot on behalf of its owner
+	released bool
+	// integrityChecked holds the databases in the integrity mode, whose values are verified on read
+	integrityChecked map[string]bool
+	// collations holds the key collations of the snapshotted databases whose keys are collated
+	collations map[string]*types.DBKeyCollation
 }
 
 func (l *LevelDB) GetDBsSnapshot(dbNames []string) (worldstate.DBsSnapshot, error) {
@@ -22,7 +28,10 @@ func (l *LevelDB) GetDBsSnapshot(dbNames []string) (worldstate.DBsSnapshot, erro
 	defer l.dbsList.RUnlock()
 
 	snap := &Snapshots{
-		dbSnap: make(map[string]*leveldb.Snapshot),
+		dbSnap:           make(map[string]*leveldb.Snapshot),
+		tracker:          l.handles,
+		integrityChecked: l.integrityChecked,
+		collations:       make(map[string]*types.DBKeyCollation),
 	}
 
 	for _, dbName := range dbNames {
@@ -42,21 +51,55 @@ func (l *LevelDB) GetDBsSnapshot(dbNames []string) (worldstate.DBsSnapshot, erro
 		}
 
 		snap.dbSnap[dbName] = s
+		if db.collation != nil {
+			snap.collations[dbName] = db.collation
+		}
 	}
+	snap.id = l.handles.track(HandleKindSnapshot, dbNames, snap.forceRelease)
 
 	return snap, nil
 }
 
+// GetDBsSnapshotWithHeight returns a snapshot of the given databases along with the height of the state it holds. As
+// a block is committed to the databases one after the other, the snapshot waits for the commit of a block in progress,
+// so that it holds either all or none of the updates of each block.
+func (l *LevelDB) GetDBsSnapshotWithHeight(dbNames []string) (worldstate.DBsSnapshot, uint64, error) {
+	l.commitMu.RLock()
+	defer l.commitMu.RUnlock()
+
+	snap, err := l.GetDBsSnapshot(dbNames)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	height, err := l.Height()
+	if err != nil {
+		snap.Release()
+		return nil, 0, err
+	}
+
+	return snap, height, nil
+}
+
 func (s *Snapshots) Get(dbName, key string) ([]byte, *types.Metadata, error) {
 	s.RLock()
 	defer s.RUnlock()
 
+	if s.released {
+		return nil, nil, ErrSnapshotReleased
+	}
 	lSnap, ok := s.dbSnap[dbName]
 	if !ok {
 		return nil, nil, errors.New(dbName + " is needed to fetch the index definiton and is not snapshotted")
 	}
 
-	dbval, err := lSnap.Get([]byte(key), &opt.ReadOptions{})
+	storedKey, err := encodeCollatedKey(dbName, s.collations[dbName], []byte(key))
+	if err != nil {
+		// a key which does not conform to the key collation of the database cannot be stored
+		return nil, nil, nil
+	}
+
+	dbval, err := lSnap.Get(storedKey, &opt.ReadOptions{})
 	if err == leveldb.ErrNotFound {
 		return nil, nil, nil
 	}
@@ -64,8 +107,8 @@ func (s *Snapshots) Get(dbName, key string) ([]byte, *types.Metadata, error) {
 		return nil, nil, errors.WithMessagef(err, "failed to retrieve leveldb key [%s] from the snapshot of database [%s]", key, dbName)
 	}
 
-	persisted := &types.ValueWithMetadata{}
-	if err := proto.Unmarshal(dbval, persisted); err != nil {
+	persisted, err := decodeValue(dbName, key, dbval, s.integrityChecked[dbName])
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -80,31 +123,44 @@ func (s *Snapshots) GetIterator(dbName string, startKey, endKey string) (worldst
 	s.RLock()
 	defer s.RUnlock()
 
+	if s.released {
+		return nil, ErrSnapshotReleased
+	}
 	lSnap, ok := s.dbSnap[dbName]
 	if !ok {
 		return nil, errors.New(dbName + " database is not snapshotted")
 	}
 
-	r := &util.Range{}
-	if startKey == "" {
-		r.Start = nil
-	} else {
-		r.Start = []byte(startKey)
-	}
-
-	if endKey == "" {
-		r.Limit = nil
-	} else {
-		r.Limit = []byte(endKey)
+	r, err := keyRange(dbName, s.collations[dbName], startKey, endKey)
+	if err != nil {
+		return nil, err
 	}
 
-	return lSnap.NewIterator(r, &opt.ReadOptions{}), nil
+	return trackIterator(s.tracker, dbName,
+		newKeyDecodingIterator(dbName, s.collations[dbName], lSnap.NewIterator(r, &opt.ReadOptions{}))), nil
 }
 
 func (s *Snapshots) Release() {
+	s.Lock()
+	s.releaseSnapshots()
+	s.Unlock()
+
+	s.tracker.untrack(s.id)
+}
+
+// forceRelease releases the snapshot on behalf of its owner, whose next use of the snapshot fails with
+// ErrSnapshotReleased. The iterators already returned are tracked on their own.
+func (s *Snapshots) forceRelease() {
 	s.Lock()
 	defer s.Unlock()
 
+	if s.dbSnap != nil {
+		s.releaseSnapshots()
+		s.released = true
+	}
+}
+
+func (s *Snapshots) releaseSnapshots() {
 	for _, lSnap := range s.dbSnap {
 		lSnap.Release()
 	}
@@ -67,6 +67,46 @@ func TestSnapshots(t *testing.T) {
 	verifyEmptiness(t, s0)
 }
 
+func TestSnapshotWithHeight(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	require.NoError(t, env.l.create("db1"))
+	commit := func(blockNum uint64) {
+		require.NoError(t, env.l.Commit(map[string]*worldstate.DBUpdates{
+			"db1": {
+				Writes: []*worldstate.KVWithMetadata{
+					{Key: "key1", Metadata: &types.Metadata{Version: &types.Version{BlockNum: blockNum}}},
+				},
+			},
+		}, blockNum))
+	}
+
+	verify := func(snap worldstate.DBsSnapshot, height uint64) {
+		_, metadata, err := snap.Get("db1", "key1")
+		require.NoError(t, err)
+		require.Equal(t, height, metadata.GetVersion().GetBlockNum())
+	}
+
+	commit(1)
+	s1, height, err := env.l.GetDBsSnapshotWithHeight([]string{"db1"})
+	require.NoError(t, err)
+	defer s1.Release()
+	require.Equal(t, uint64(1), height)
+	verify(s1, 1)
+
+	commit(2)
+	s2, height, err := env.l.GetDBsSnapshotWithHeight([]string{"db1"})
+	require.NoError(t, err)
+	defer s2.Release()
+	require.Equal(t, uint64(2), height)
+	verify(s2, 2)
+	verify(s1, 1)
+
+	_, _, err = env.l.GetDBsSnapshotWithHeight([]string{"db2"})
+	require.EqualError(t, err, "database db2 does not exist")
+}
+
 func verifyEmptiness(t *testing.T, db snapshotTestAPIs) {
 	v, m, err := db.Get("db1", "key1")
 	require.NoError(t, err)
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const (
+	// MetricTypeGauge marks a metric whose value may go up and down
+	MetricTypeGauge = "gauge"
+	// MetricTypeCounter marks a metric whose value only goes up while the database is open
+	MetricTypeCounter = "counter"
+
+	storageMetricPrefix = "orion_storage_leveldb_"
+)
+
+// StorageMetric is a single sample of a gauge or a counter, in the Prometheus data model, derived from the internal
+// statistics of the leveldb instance of a database. Every metric carries the label `db`, and the per-level metrics
+// carry the label `level` as well. The metrics of the snapshots and iterators handed out, which may span databases,
+// carry the label `kind` instead.
+//
+// goleveldb does not expose the number of compactions nor the hits and misses of the block cache. The activity of
+// the compactions is reflected by the bytes read and written, and the time spent, by the compactions of each level,
+// while the hits and misses are counted by the block cacher of each database, see BlockCacheStats. The counters start
+// from zero every time the database is opened.
+type StorageMetric struct {
+	Name   string            `json:"name"`
+	Help   string            `json:"help"`
+	Type   string            `json:"type"`
+	Labels map[string]string `json:"labels"`
+	Value  float64           `json:"value"`
+}
+
+// statsCollector periodically samples the internal statistics of all databases and keeps the last sample
+type statsCollector struct {
+	interval time.Duration
+	stop     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+
+	mu      sync.RWMutex
+	metrics []*StorageMetric
+}
+
+func (l *LevelDB) startStatsCollector(interval time.Duration) {
+	l.stats = &statsCollector{
+		interval: interval,
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	go l.collectStats()
+}
+
+func (l *LevelDB) collectStats() {
+	defer close(l.stats.stopped)
+
+	ticker := time.NewTicker(l.stats.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stats.stop:
+			return
+		case <-ticker.C:
+			if err := l.sampleStats(); err != nil {
+				l.logger.Warnf("failed to sample the statistics of the state database: %s", err)
+			}
+		}
+	}
+}
+
+func (l *LevelDB) stopStatsCollector() {
+	if l.stats == nil {
+		return
+	}
+
+	l.stats.stopOnce.Do(func() { close(l.stats.stop) })
+	<-l.stats.stopped
+}
+
+func (l *LevelDB) sampleStats() error {
+	stats, err := l.RawStats()
+	if err != nil {
+		return err
+	}
+
+	metrics := storageMetrics(stats, l.BlockCacheStats(), l.LiveHandles(), l.handles.now())
+
+	l.stats.mu.Lock()
+	defer l.stats.mu.Unlock()
+	l.stats.metrics = metrics
+
+	return nil
+}
+
+// RawStats returns the internal statistics of the leveldb instance of each database, as reported by goleveldb, at
+// the time of the call. The statistics are collected on demand and do not depend on the periodic sampling.
+func (l *LevelDB) RawStats() (map[string]*leveldb.DBStats, error) {
+	l.dbsList.RLock()
+	defer l.dbsList.RUnlock()
+
+	stats := make(map[string]*leveldb.DBStats, len(l.dbs))
+	for name, db := range l.dbs {
+		s := &leveldb.DBStats{}
+		if err := db.file.Stats(s); err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve the statistics of database %s", name)
+		}
+		stats[name] = s
+	}
+
+	return stats, nil
+}
+
+// StorageMetrics returns the metrics of the last periodic sample, ordered by name and labels. It returns nil if the
+// periodic sampling is disabled or no sample was taken yet.
+func (l *LevelDB) StorageMetrics() []*StorageMetric {
+	if l.stats == nil {
+		return nil
+	}
+
+	l.stats.mu.RLock()
+	defer l.stats.mu.RUnlock()
+	return l.stats.metrics
+}
+
+// StorageMetricsEnabled returns true if the statistics of the databases are sampled periodically
+func (l *LevelDB) StorageMetricsEnabled() bool {
+	return l.stats != nil
+}
+
+func storageMetrics(stats map[string]*leveldb.DBStats, cacheStats map[string]*BlockCacheStats, handles []*HandleInfo, now time.Time) []*StorageMetric {
+	metrics := handleMetrics(handles, now)
+	add := func(name, help, metricType string, value float64, labels ...string) {
+		m := &StorageMetric{
+			Name:   storageMetricPrefix + name,
+			Help:   help,
+			Type:   metricType,
+			Labels: make(map[string]string),
+			Value:  value,
+		}
+		for i := 0; i+1 < len(labels); i += 2 {
+			m.Labels[labels[i]] = labels[i+1]
+		}
+		metrics = append(metrics, m)
+	}
+
+	for dbName, s := range stats {
+		for level := range s.LevelSizes {
+			lvl := strconv.Itoa(level)
+			add("level_size_bytes", "Total size of the tables of a level.", MetricTypeGauge,
+				float64(s.LevelSizes[level]), "db", dbName, "level", lvl)
+			add("level_tables", "Number of tables of a level.", MetricTypeGauge,
+				float64(s.LevelTablesCounts[level]), "db", dbName, "level", lvl)
+			add("compaction_read_bytes_total", "Bytes read by the compactions into a level.", MetricTypeCounter,
+				float64(s.LevelRead[level]), "db", dbName, "level", lvl)
+			add("compaction_write_bytes_total", "Bytes written by the compactions into a level.", MetricTypeCounter,
+				float64(s.LevelWrite[level]), "db", dbName, "level", lvl)
+			add("compaction_duration_seconds_total", "Time spent by the compactions into a level.", MetricTypeCounter,
+				s.LevelDurations[level].Seconds(), "db", dbName, "level", lvl)
+		}
+
+		add("block_cache_size_bytes", "Size of the block cache.", MetricTypeGauge,
+			float64(s.BlockCacheSize), "db", dbName)
+		if c, ok := cacheStats[dbName]; ok {
+			add("block_cache_hits_total", "Lookups of the block cache which found the block.", MetricTypeCounter,
+				float64(c.Hits), "db", dbName)
+			add("block_cache_misses_total", "Lookups of the block cache which read the block from the storage.", MetricTypeCounter,
+				float64(c.Misses), "db", dbName)
+		}
+		add("opened_tables", "Number of tables held open.", MetricTypeGauge,
+			float64(s.OpenedTablesCount), "db", dbName)
+		add("alive_snapshots", "Number of snapshots not yet released.", MetricTypeGauge,
+			float64(s.AliveSnapshots), "db", dbName)
+		add("alive_iterators", "Number of iterators not yet released.", MetricTypeGauge,
+			float64(s.AliveIterators), "db", dbName)
+		writePaused := 0.0
+		if s.WritePaused {
+			writePaused = 1
+		}
+		add("write_paused", "Whether writes are paused until the compactions catch up.", MetricTypeGauge,
+			writePaused, "db", dbName)
+		add("io_read_bytes_total", "Bytes read from the storage.", MetricTypeCounter,
+			float64(s.IORead), "db", dbName)
+		add("io_write_bytes_total", "Bytes written to the storage.", MetricTypeCounter,
+			float64(s.IOWrite), "db", dbName)
+		add("write_delays_total", "Number of writes delayed by the compactions.", MetricTypeCounter,
+			float64(s.WriteDelayCount), "db", dbName)
+		add("write_delay_seconds_total", "Time writes were delayed by the compactions.", MetricTypeCounter,
+			s.WriteDelayDuration.Seconds(), "db", dbName)
+	}
+
+	sort.SliceStable(metrics, func(i, j int) bool {
+		if metrics[i].Name != metrics[j].Name {
+			return metrics[i].Name < metrics[j].Name
+		}
+		return labelsString(metrics[i].Labels) < labelsString(metrics[j].Labels)
+	})
+
+	return metrics
+}
+
+// WriteStorageMetrics writes the metrics in the Prometheus text exposition format. The metrics must be ordered by
+// name, as returned by StorageMetrics.
+func WriteStorageMetrics(w io.Writer, metrics []*StorageMetric) error {
+	for i, m := range metrics {
+		if i == 0 || metrics[i-1].Name != m.Name {
+			if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.Name, m.Help, m.Name, m.Type); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s%s %s\n", m.Name, labelsString(m.Labels), strconv.FormatFloat(m.Value, 'g', -1, 64)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func labelsString(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + strconv.Quote(labels[name])
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
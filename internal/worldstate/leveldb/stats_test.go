@@ -0,0 +1,119 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package leveldb
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageStats(t *testing.T) {
+	c := &logger.Config{
+		Level:         "info",
+		OutputPath:    []string{"stdout"},
+		ErrOutputPath: []string{"stderr"},
+		Encoding:      "console",
+	}
+	logger, err := logger.New(c)
+	require.NoError(t, err)
+
+	openDB := func(t *testing.T, interval time.Duration) *LevelDB {
+		testDir, err := ioutil.TempDir("", "statstest")
+		require.NoError(t, err)
+		t.Cleanup(func() { os.RemoveAll(testDir) })
+
+		l, err := Open(&Config{
+			DBRootDir:             filepath.Join(testDir, "leveldb"),
+			StatsSamplingInterval: interval,
+			Logger:                logger,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, l.Close()) })
+		return l
+	}
+
+	metricValue := func(metrics []*StorageMetric, name, dbName, level string) float64 {
+		var value float64
+		for _, m := range metrics {
+			if m.Name == storageMetricPrefix+name && m.Labels["db"] == dbName && (level == "" || m.Labels["level"] == level) {
+				value += m.Value
+			}
+		}
+		return value
+	}
+
+	t.Run("compactions advance the counters", func(t *testing.T) {
+		l := openDB(t, 10*time.Millisecond)
+		require.True(t, l.StorageMetricsEnabled())
+
+		require.Eventually(t, func() bool { return l.StorageMetrics() != nil }, 5*time.Second, 10*time.Millisecond)
+		initial := l.StorageMetrics()
+		require.Equal(t, float64(0), metricValue(initial, "compaction_write_bytes_total", worldstate.DefaultDBName, ""))
+
+		// 24 MiB exceed several times the 4 MiB write buffer, such that the memtable is flushed into level-0 tables,
+		// which are compacted into level-1 once there are four of them
+		value := bytes.Repeat([]byte("v"), 256*1024)
+		for block := uint64(1); block <= 24; block++ {
+			updates := &worldstate.DBUpdates{}
+			for i := 0; i < 4; i++ {
+				updates.Writes = append(updates.Writes, &worldstate.KVWithMetadata{
+					Key:   fmt.Sprintf("key-%d-%d", block, i),
+					Value: value,
+				})
+			}
+			require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{worldstate.DefaultDBName: updates}, block))
+		}
+
+		require.Eventually(t, func() bool {
+			metrics := l.StorageMetrics()
+			return metricValue(metrics, "compaction_write_bytes_total", worldstate.DefaultDBName, "0") > 0 &&
+				metricValue(metrics, "compaction_write_bytes_total", worldstate.DefaultDBName, "1") > 0 &&
+				metricValue(metrics, "compaction_read_bytes_total", worldstate.DefaultDBName, "1") > 0
+		}, 10*time.Second, 10*time.Millisecond)
+
+		metrics := l.StorageMetrics()
+		require.Greater(t, metricValue(metrics, "io_write_bytes_total", worldstate.DefaultDBName, ""), float64(0))
+		require.Greater(t, metricValue(metrics, "level_size_bytes", worldstate.DefaultDBName, ""), float64(0))
+		require.Equal(t, float64(0), metricValue(metrics, "compaction_write_bytes_total", worldstate.UsersDBName, ""))
+
+		raw, err := l.RawStats()
+		require.NoError(t, err)
+		require.Len(t, raw, len(preCreateDBs))
+		require.Greater(t, raw[worldstate.DefaultDBName].LevelWrite[1], int64(0))
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, WriteStorageMetrics(buf, metrics))
+		exposition := buf.String()
+		require.Contains(t, exposition, "# TYPE orion_storage_leveldb_compaction_write_bytes_total counter\n")
+		require.Contains(t, exposition, "# TYPE orion_storage_leveldb_level_size_bytes gauge\n")
+		require.Contains(t, exposition, `orion_storage_leveldb_compaction_write_bytes_total{db="bdb",level="1"} `)
+		require.Equal(t, 1, strings.Count(exposition, "# HELP orion_storage_leveldb_io_write_bytes_total "))
+	})
+
+	t.Run("sampling disabled", func(t *testing.T) {
+		l := openDB(t, 0)
+		require.False(t, l.StorageMetricsEnabled())
+		require.Nil(t, l.stats)
+
+		require.NoError(t, l.Commit(map[string]*worldstate.DBUpdates{
+			worldstate.DefaultDBName: {
+				Writes: []*worldstate.KVWithMetadata{{Key: "key1", Value: []byte("value1")}},
+			},
+		}, 1))
+		require.Nil(t, l.StorageMetrics())
+
+		raw, err := l.RawStats()
+		require.NoError(t, err)
+		require.Len(t, raw, len(preCreateDBs))
+	})
+}
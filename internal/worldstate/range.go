@@ -0,0 +1,69 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package worldstate
+
+import (
+	"strings"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// PrefixRange returns the range [startKey, endKey) that holds all the keys which begin with the given prefix. An
+// empty endKey denotes the last key in the database.
+func PrefixRange(prefix string) (startKey, endKey string) {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return prefix, string(end[:i+1])
+		}
+	}
+
+	// the prefix is empty or consists of 0xff bytes only, hence, the range ends at the last key
+	return prefix, ""
+}
+
+// DeleteRangeBounds returns the range [startKey, endKey) of the given range delete.
+func DeleteRangeBounds(r *types.DataDeleteRange) (startKey, endKey string) {
+	if r.GetPrefix() != "" {
+		return PrefixRange(r.GetPrefix())
+	}
+	return r.GetStartKey(), r.GetEndKey()
+}
+
+// InRange returns true if the key is in the range [startKey, endKey), where an empty startKey or endKey denotes the
+// first or the last key in the database, respectively.
+func InRange(key, startKey, endKey string) bool {
+	return key >= startKey && (endKey == "" || key < endKey)
+}
+
+// DeleteRangeKeys returns the keys of the database which are deleted by the given range delete, in lexicographic
+// order. At most limit keys are returned, where zero means no limit. The records which the storage layer reserves
+// for itself are never part of a range.
+func DeleteRangeKeys(db DB, dbName string, r *types.DataDeleteRange, limit int) ([]string, error) {
+	startKey, endKey := DeleteRangeBounds(r)
+	itr, err := db.GetIterator(dbName, startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Release()
+
+	var keys []string
+	for itr.Next() {
+		key := string(itr.Key())
+		if strings.HasPrefix(key, ReservedKeyPrefix) {
+			continue
+		}
+
+		keys = append(keys, key)
+		if limit > 0 && len(keys) == limit {
+			break
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return nil, errors.Wrapf(err, "error while iterating over the keys of the database [%s]", dbName)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package worldstate
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		prefix        string
+		expectedStart string
+		expectedEnd   string
+	}{
+		{
+			name:          "empty prefix",
+			prefix:        "",
+			expectedStart: "",
+			expectedEnd:   "",
+		},
+		{
+			name:          "regular prefix",
+			prefix:        "user/",
+			expectedStart: "user/",
+			expectedEnd:   "user0",
+		},
+		{
+			name:          "prefix ending with 0xff",
+			prefix:        "ab\xff",
+			expectedStart: "ab\xff",
+			expectedEnd:   "ac",
+		},
+		{
+			name:          "prefix of 0xff only",
+			prefix:        "\xff\xff",
+			expectedStart: "\xff\xff",
+			expectedEnd:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start, end := PrefixRange(tt.prefix)
+			require.Equal(t, tt.expectedStart, start)
+			require.Equal(t, tt.expectedEnd, end)
+		})
+	}
+}
+
+func TestDeleteRangeBounds(t *testing.T) {
+	start, end := DeleteRangeBounds(&types.DataDeleteRange{StartKey: "a", EndKey: "c"})
+	require.Equal(t, "a", start)
+	require.Equal(t, "c", end)
+
+	start, end = DeleteRangeBounds(&types.DataDeleteRange{Prefix: "a"})
+	require.Equal(t, "a", start)
+	require.Equal(t, "b", end)
+}
+
+func TestInRange(t *testing.T) {
+	require.True(t, InRange("b", "a", "c"))
+	require.True(t, InRange("a", "a", "c"))
+	require.False(t, InRange("c", "a", "c"))
+	require.False(t, InRange("0", "a", "c"))
+	require.True(t, InRange("z", "a", ""))
+	require.True(t, InRange("a", "", "c"))
+	require.True(t, InRange("a", "", ""))
+}
@@ -0,0 +1,355 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package client provides a typed client of the HTTP API of a BCDB server. The client signs every query and
+// transaction with the signer of its user, retries the requests the server rejects as temporarily unavailable, and
+// decodes the errors of the server into *ResponseError. Given the certificates of the nodes, it also verifies the
+// signatures of the nodes on the responses.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a request is retried by default, after the server rejected it as
+	// temporarily unavailable
+	DefaultMaxRetries = 5
+	// DefaultRetryIntervalMin is the interval before the first retry of a request, by default. The interval is
+	// doubled on every retry, up to DefaultRetryIntervalMax.
+	DefaultRetryIntervalMin = 100 * time.Millisecond
+	// DefaultRetryIntervalMax is the maximal interval between retries of a request, by default
+	DefaultRetryIntervalMax = 5 * time.Second
+
+	problemJSON = "application/problem+json"
+)
+
+// Config holds the configuration of a client
+type Config struct {
+	// URL is the base URL of the server, e.g., "https://127.0.0.1:6001"
+	URL string
+	// Signer signs the queries and transactions of the user of the client; its identity is the user ID
+	Signer crypto.Signer
+	// TLSConfig is the TLS configuration of the connections to the server, nil for plain HTTP
+	TLSConfig *tls.Config
+	// LedgerPin, if set, pins the ledger the client trusts by the hash of one of its blocks. The client verifies the
+	// pin before its first request, and fails all requests if the ledger of the server does not match it.
+	LedgerPin *LedgerPin
+	// MaxRetries is the number of times a request is retried after the server rejected it as temporarily
+	// unavailable. Zero means DefaultMaxRetries; a negative value disables the retries.
+	MaxRetries int
+	// RetryIntervalMin and RetryIntervalMax bound the exponential back-off between retries, unless the server
+	// asks for a longer interval with a Retry-After header. Zero means the defaults.
+	RetryIntervalMin time.Duration
+	RetryIntervalMax time.Duration
+	// NodeCertificates, if set, holds the DER encoded certificates of the nodes of the cluster, by node ID. The client
+	// then verifies every signed response with VerifyResponse, and fails the requests whose responses do not verify.
+	NodeCertificates map[string][]byte
+}
+
+// LedgerPin identifies a ledger by the hash of one of its blocks, usually the genesis block
+type LedgerPin struct {
+	BlockNumber uint64
+	BlockHash   []byte
+}
+
+// ResponseError is the error of a request the server did not serve. It carries the status code and the error
+// message of the response.
+type ResponseError struct {
+	StatusCode int
+	types.HttpResponseErr
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("status: %d, error: %s", e.StatusCode, e.ErrMsg)
+}
+
+// IsCursorExpired returns true if the error is the rejection of an expired pagination cursor, in which case the
+// listing is to be restarted without a cursor
+func IsCursorExpired(err error) bool {
+	var respErr *ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusGone
+}
+
+// Client is a client of the HTTP API of a BCDB server, acting on behalf of a single user
+type Client struct {
+	baseURL          *url.URL
+	signer           crypto.Signer
+	httpClient       *http.Client
+	ledgerPin        *LedgerPin
+	maxRetries       int
+	retryIntervalMin time.Duration
+	retryIntervalMax time.Duration
+	nodeCerts        map[string]*x509.Certificate
+
+	pinMutex    sync.Mutex
+	pinVerified bool
+}
+
+// New creates a client. It does not connect to the server.
+func New(conf *Config) (*Client, error) {
+	if conf.Signer == nil {
+		return nil, errors.New("a signer must be provided")
+	}
+	baseURL, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while parsing the server URL [%s]", conf.URL)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, errors.Errorf("unsupported scheme [%s] in the server URL [%s]", baseURL.Scheme, conf.URL)
+	}
+	nodeCerts, err := parseNodeCertificates(conf.NodeCertificates)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		baseURL: baseURL,
+		signer:  conf.Signer,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				TLSClientConfig:     conf.TLSConfig,
+				MaxIdleConnsPerHost: 16,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+		ledgerPin:        conf.LedgerPin,
+		maxRetries:       conf.MaxRetries,
+		retryIntervalMin: conf.RetryIntervalMin,
+		retryIntervalMax: conf.RetryIntervalMax,
+		nodeCerts:        nodeCerts,
+	}
+	if c.maxRetries == 0 {
+		c.maxRetries = DefaultMaxRetries
+	}
+	if c.retryIntervalMin <= 0 {
+		c.retryIntervalMin = DefaultRetryIntervalMin
+	}
+	if c.retryIntervalMax <= 0 {
+		c.retryIntervalMax = DefaultRetryIntervalMax
+	}
+
+	return c, nil
+}
+
+// UserID returns the ID of the user of the client
+func (c *Client) UserID() string {
+	return c.signer.Identity()
+}
+
+// Close releases the idle connections of the client
+func (c *Client) Close() {
+	c.httpClient.CloseIdleConnections()
+}
+
+// VerifyLedgerPin verifies that the ledger of the server contains the pinned block. It is called before the first
+// request of the client, and is a no-op if the client has no pin or the pin was already verified.
+func (c *Client) VerifyLedgerPin(ctx context.Context) error {
+	if c.ledgerPin == nil {
+		return nil
+	}
+
+	c.pinMutex.Lock()
+	defer c.pinMutex.Unlock()
+
+	if c.pinVerified {
+		return nil
+	}
+
+	query := &types.GetBlockQuery{
+		UserId:      c.UserID(),
+		BlockNumber: c.ledgerPin.BlockNumber,
+	}
+	resp := &types.GetBlockResponseEnvelope{}
+	if err := c.doQuery(ctx, http.MethodGet, constants.URLForLedgerBlock(query.BlockNumber, false), query, nil, resp); err != nil {
+		return errors.WithMessagef(err, "error while fetching the pinned block [%d]", query.BlockNumber)
+	}
+
+	hash, err := blockstore.ComputeBlockHash(&types.Block{Header: resp.GetResponse().GetBlockHeader()})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, c.ledgerPin.BlockHash) {
+		return errors.Errorf("the hash of block [%d] on the server [%x] does not match the pinned hash [%x]",
+			c.ledgerPin.BlockNumber, hash, c.ledgerPin.BlockHash)
+	}
+
+	c.pinVerified = true
+	return nil
+}
+
+// query signs the query payload and issues it, after verifying the ledger pin
+func (c *Client) query(ctx context.Context, method, urlPath string, payload proto.Message, body []byte, resp interface{}) error {
+	if err := c.VerifyLedgerPin(ctx); err != nil {
+		return err
+	}
+
+	return c.doQuery(ctx, method, urlPath, payload, body, resp)
+}
+
+func (c *Client) doQuery(ctx context.Context, method, urlPath string, payload proto.Message, body []byte, resp interface{}) error {
+	signature, err := cryptoservice.SignQuery(c.signer, payload)
+	if err != nil {
+		return errors.WithMessage(err, "error while signing the query")
+	}
+
+	header := http.Header{}
+	header.Set(constants.UserHeader, c.UserID())
+	header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(signature))
+
+	return c.do(ctx, method, urlPath, header, body, resp)
+}
+
+// do issues the request, retries it while the server rejects it as temporarily unavailable, and decodes the
+// response into resp, which is either a proto message or a JSON value.
+func (c *Client) do(ctx context.Context, method, urlPath string, header http.Header, body []byte, resp interface{}) error {
+	parsedURL, err := url.Parse(urlPath)
+	if err != nil {
+		return errors.Wrapf(err, "error while parsing the request path [%s]", urlPath)
+	}
+	u := c.baseURL.ResolveReference(parsedURL)
+
+	retryInterval := c.retryIntervalMin
+	for retries := 0; ; retries++ {
+		req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		req.Header.Set("Accept", "application/json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		httpResp, err := c.httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if httpResp.StatusCode == http.StatusServiceUnavailable && retries < c.maxRetries {
+			wait := retryAfter(httpResp, retryInterval)
+			httpResp.Body.Close()
+
+			select {
+			case <-ctx.Done():
+				return errors.WithMessage(ctx.Err(), "request canceled while waiting to retry")
+			case <-time.After(wait):
+			}
+
+			retryInterval *= 2
+			if retryInterval > c.retryIntervalMax {
+				retryInterval = c.retryIntervalMax
+			}
+			continue
+		}
+
+		if err = decodeResponse(httpResp, resp); err != nil {
+			return err
+		}
+		return c.verifyResponse(resp)
+	}
+}
+
+// verifyResponse verifies the response if it is signed and the client has the certificates of the nodes
+func (c *Client) verifyResponse(resp interface{}) error {
+	m, ok := resp.(proto.Message)
+	if !ok || c.nodeCerts == nil || !isResponseEnvelope(m.ProtoReflect().Descriptor()) {
+		return nil
+	}
+	return VerifyResponse(m, c.nodeCerts)
+}
+
+// retryAfter returns the interval the server asked the client to wait with a Retry-After header, in seconds, if it
+// is longer than the back-off interval
+func retryAfter(resp *http.Response, backoff time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return backoff
+	}
+	if wait := time.Duration(seconds) * time.Second; wait > backoff {
+		return wait
+	}
+	return backoff
+}
+
+func decodeResponse(httpResp *http.Response, resp interface{}) error {
+	defer httpResp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "error while reading the response, status: %d", httpResp.StatusCode)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return decodeError(httpResp, bodyBytes)
+	}
+
+	if m, ok := resp.(proto.Message); ok {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(bodyBytes, m)
+	} else {
+		err = json.Unmarshal(bodyBytes, resp)
+	}
+	if err != nil {
+		return errors.Wrap(err, "error while decoding the response")
+	}
+	return nil
+}
+
+// decodeError decodes the body of an error response, which is either a types.HttpResponseErr or an RFC 7807 problem
+// detail, into a ResponseError
+func decodeError(httpResp *http.Response, bodyBytes []byte) error {
+	respErr := &ResponseError{StatusCode: httpResp.StatusCode}
+
+	mediaType, _, _ := mime.ParseMediaType(httpResp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		if err := json.Unmarshal(bodyBytes, &respErr.HttpResponseErr); err != nil {
+			respErr.ErrMsg = string(bodyBytes)
+		}
+	case problemJSON:
+		problem := struct {
+			Title  string `json:"title"`
+			Detail string `json:"detail"`
+		}{}
+		if err := json.Unmarshal(bodyBytes, &problem); err != nil {
+			respErr.ErrMsg = string(bodyBytes)
+			break
+		}
+		respErr.ErrMsg = problem.Detail
+		if respErr.ErrMsg == "" {
+			respErr.ErrMsg = problem.Title
+		}
+	default:
+		respErr.ErrMsg = string(bodyBytes)
+	}
+
+	if respErr.ErrMsg == "" {
+		respErr.ErrMsg = http.StatusText(httpResp.StatusCode)
+	}
+	return respErr
+}
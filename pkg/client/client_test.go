@@ -0,0 +1,1002 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/config"
+	"github.com/hyperledger-labs/orion-server/internal/blockstore"
+	"github.com/hyperledger-labs/orion-server/internal/worldstate"
+	"github.com/hyperledger-labs/orion-server/pkg/client"
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/server"
+	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type clientTestEnv struct {
+	serverURL   string
+	adminSigner crypto.Signer
+	aliceSigner crypto.Signer
+	aliceCert   []byte
+	bobSigner   crypto.Signer
+	bobCert     []byte
+	nodeID      string
+	nodeCert    []byte
+}
+
+// newClientTestEnv starts a single node server in-process, with the configuration changed by the updates
+func newClientTestEnv(t *testing.T, port uint32, updates ...func(conf *config.Configurations)) *clientTestEnv {
+	tempDir := t.TempDir()
+
+	rootCAPemCert, caPrivKey, err := testutils.GenerateRootCA("Orion RootCA", "127.0.0.1")
+	require.NoError(t, err)
+	caKeyPair, err := tls.X509KeyPair(rootCAPemCert, caPrivKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path.Join(tempDir, "rootCA.pem"), rootCAPemCert, 0666))
+
+	signers := make(map[string]crypto.Signer)
+	for _, name := range []string{"server", "admin", "alice", "bob"} {
+		pemCert, privKey, err := testutils.IssueCertificate("Orion "+name, "127.0.0.1", caKeyPair)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path.Join(tempDir, name+".pem"), pemCert, 0666))
+		require.NoError(t, os.WriteFile(path.Join(tempDir, name+".key"), privKey, 0666))
+
+		signers[name], err = crypto.NewSigner(&crypto.SignerOptions{Identity: name, KeyFilePath: path.Join(tempDir, name+".key")})
+		require.NoError(t, err)
+	}
+	aliceCert, _ := testutils.LoadTestCrypto(t, tempDir, "alice")
+	bobCert, _ := testutils.LoadTestCrypto(t, tempDir, "bob")
+	nodeCert, _ := testutils.LoadTestCrypto(t, tempDir, "server")
+
+	nodeID := "node1"
+	peerPort := port + 10000
+	conf := &config.Configurations{
+		LocalConfig: &config.LocalConfiguration{
+			Server: config.ServerConf{
+				Identity: config.IdentityConf{
+					ID:              nodeID,
+					CertificatePath: path.Join(tempDir, "server.pem"),
+					KeyPath:         path.Join(tempDir, "server.key"),
+				},
+				Database: config.DatabaseConf{
+					Name:            "leveldb",
+					LedgerDirectory: path.Join(tempDir, "ledger"),
+				},
+				Network: config.NetworkConf{
+					Address: "127.0.0.1",
+					Port:    port,
+				},
+				QueueLength: config.QueueLengthConf{
+					Block:                     10,
+					Transaction:               10,
+					ReorderedTransactionBatch: 10,
+				},
+				QueryProcessing: config.QueryProcessingConf{
+					ResponseSizeLimitInBytes: 1024 * 1024,
+				},
+				LogLevel: "info",
+			},
+			BlockCreation: config.BlockCreationConf{
+				BlockTimeout:                50 * time.Millisecond,
+				MaxBlockSize:                1,
+				MaxTransactionCountPerBlock: 1,
+			},
+			Replication: config.ReplicationConf{
+				WALDir:  path.Join(tempDir, "raft", "wal"),
+				SnapDir: path.Join(tempDir, "raft", "snap"),
+				AuxDir:  path.Join(tempDir, "aux"),
+				Network: config.NetworkConf{Address: "127.0.0.1", Port: peerPort},
+			},
+		},
+		SharedConfig: &config.SharedConfiguration{
+			Nodes: []*config.NodeConf{
+				{
+					NodeID:          nodeID,
+					Host:            "127.0.0.1",
+					Port:            port,
+					CertificatePath: path.Join(tempDir, "server.pem"),
+				},
+			},
+			Admin: config.AdminConf{
+				ID:              "admin",
+				CertificatePath: path.Join(tempDir, "admin.pem"),
+			},
+			CAConfig: config.CAConfiguration{
+				RootCACertsPath: []string{path.Join(tempDir, "rootCA.pem")},
+			},
+			Consensus: &config.ConsensusConf{
+				Algorithm: "raft",
+				Members: []*config.PeerConf{
+					{
+						NodeId:   nodeID,
+						RaftId:   1,
+						PeerHost: "127.0.0.1",
+						PeerPort: peerPort,
+					},
+				},
+				RaftConfig: &config.RaftConf{
+					TickInterval:         "20ms",
+					ElectionTicks:        10,
+					HeartbeatTicks:       1,
+					MaxInflightBlocks:    50,
+					SnapshotIntervalSize: math.MaxUint64,
+				},
+			},
+			Ledger: config.LedgerConf{
+				TxOperationLimits: config.TxOperationLimitsConf{
+					MaxWrites: 10,
+				},
+			},
+		},
+	}
+
+	for _, update := range updates {
+		update(conf)
+	}
+	srv, err := server.New(conf)
+	require.NoError(t, err)
+	require.NoError(t, srv.Start())
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop())
+	})
+	require.Eventually(t, func() bool { return srv.IsLeader() == nil }, 30*time.Second, 100*time.Millisecond)
+
+	return &clientTestEnv{
+		serverURL:   fmt.Sprintf("http://127.0.0.1:%d", port),
+		adminSigner: signers["admin"],
+		aliceSigner: signers["alice"],
+		aliceCert:   aliceCert.Raw,
+		bobSigner:   signers["bob"],
+		bobCert:     bobCert.Raw,
+		nodeID:      nodeID,
+		nodeCert:    nodeCert.Raw,
+	}
+}
+
+func TestClient(t *testing.T) {
+	env := newClientTestEnv(t, 7100)
+	ctx := context.Background()
+
+	// the clients verify the signature of the node on every signed response
+	nodeCerts := map[string][]byte{env.nodeID: env.nodeCert}
+	admin, err := client.New(&client.Config{URL: env.serverURL, Signer: env.adminSigner, NodeCertificates: nodeCerts})
+	require.NoError(t, err)
+	defer admin.Close()
+	alice, err := client.New(&client.Config{URL: env.serverURL, Signer: env.aliceSigner, NodeCertificates: nodeCerts})
+	require.NoError(t, err)
+	defer alice.Close()
+
+	// configuration queries
+	configResp, err := admin.GetConfig(ctx)
+	require.NoError(t, err)
+	require.Len(t, configResp.GetResponse().GetConfig().GetNodes(), 1)
+	nodeResp, err := admin.GetNodeConfig(ctx, env.nodeID)
+	require.NoError(t, err)
+	require.Equal(t, env.nodeID, nodeResp.GetResponse().GetNodeConfig().GetId())
+	statusResp, err := admin.GetClusterStatus(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, env.nodeID, statusResp.GetResponse().GetLeader())
+	require.Nil(t, statusResp.GetResponse().GetNodes()[0].GetCertificate())
+
+	// admin transactions
+	receipt, err := admin.SubmitDBAdministrationTx(ctx, &types.DBAdministrationTx{
+		UserId:    "admin",
+		TxId:      "db-tx",
+		CreateDbs: []string{"db1"},
+		DbsIndex: map[string]*types.DBIndex{
+			"db1": {AttributeAndType: map[string]types.IndexAttributeType{"color": types.IndexAttributeType_STRING}},
+		},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	receipt, err = admin.SubmitUserAdministrationTx(ctx, &types.UserAdministrationTx{
+		UserId: "admin",
+		TxId:   "user-tx",
+		UserWrites: []*types.UserWrite{
+			{
+				User: &types.User{
+					Id:          "alice",
+					Certificate: env.aliceCert,
+					Privilege: &types.Privilege{
+						DbPermission: map[string]types.Privilege_Access{"db1": types.Privilege_ReadWrite},
+					},
+				},
+			},
+		},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	receipt, err = admin.SubmitConfigTx(ctx, &types.ConfigTx{
+		UserId:               "admin",
+		TxId:                 "config-tx",
+		ReadOldConfigVersion: configResp.GetResponse().GetMetadata().GetVersion(),
+		NewConfig:            configResp.GetResponse().GetConfig(),
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.GetResponse().GetReceipt())
+
+	// data transactions and queries
+	receipt, err = alice.SubmitDataTx(ctx, &types.DataTx{
+		MustSignUserIds: []string{"alice"},
+		TxId:            "data-tx-1",
+		DbOperations: []*types.DBOperation{
+			{
+				DbName: "db1",
+				DataWrites: []*types.DataWrite{
+					{Key: "key1", Value: []byte(`{"color":"red"}`)},
+					{Key: "key2", Value: []byte(`{"color":"blue"}`)},
+				},
+			},
+		},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	dataBlockNum := receipt.GetResponse().GetReceipt().GetHeader().GetBaseHeader().GetNumber()
+
+	dataTx := &types.DataTx{
+		MustSignUserIds: []string{"alice"},
+		TxId:            "data-tx-2",
+		DbOperations: []*types.DBOperation{
+			{DbName: "db1", DataWrites: []*types.DataWrite{{Key: "key3", Value: []byte(`{"color":"red"}`)}}},
+		},
+	}
+	sig, err := cryptoservice.SignTx(env.aliceSigner, dataTx)
+	require.NoError(t, err)
+	receipt, err = alice.SubmitDataTxEnvelope(ctx, &types.DataTxEnvelope{
+		Payload:    dataTx,
+		Signatures: map[string][]byte{"alice": sig},
+	}, 0)
+	require.NoError(t, err)
+	require.Nil(t, receipt.GetResponse().GetReceipt())
+
+	_, err = alice.SubmitDataTxEnvelope(ctx, &types.DataTxEnvelope{
+		Payload:    &types.DataTx{MustSignUserIds: []string{"alice"}, TxId: "data-tx-3"},
+		Signatures: map[string][]byte{"alice": sig},
+	}, 0)
+	respErr, ok := err.(*client.ResponseError)
+	require.True(t, ok, "%T", err)
+	require.Equal(t, http.StatusUnauthorized, respErr.StatusCode)
+
+	receipt, err = alice.SubmitVoidTx(ctx, &types.VoidTx{UserId: "alice", TxId: "void-tx"}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VOIDED, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	dataResp, err := alice.GetData(ctx, "db1", "key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"color":"red"}`), dataResp.GetResponse().GetValue())
+
+	rangeResp, err := alice.GetDataRange(ctx, "db1", "key1", "key3", 10)
+	require.NoError(t, err)
+	require.Len(t, rangeResp.GetResponse().GetKVs(), 2)
+
+	multiResp, err := alice.GetDataMulti(ctx, "db1", []string{"key2", "key1", "missing"})
+	require.NoError(t, err)
+	entries := multiResp.GetResponse().GetEntries()
+	require.Len(t, entries, 3)
+	require.Equal(t, []byte(`{"color":"blue"}`), entries[0].GetValue())
+	require.Equal(t, []byte(`{"color":"red"}`), entries[1].GetValue())
+	require.Equal(t, types.MultiGetEntry_NOT_FOUND, entries[2].GetStatus())
+	require.Equal(t, dataBlockNum, entries[0].GetMetadata().GetVersion().GetBlockNum())
+	require.GreaterOrEqual(t, multiResp.GetResponse().GetHeight(), dataBlockNum)
+
+	queryResp, err := alice.ExecuteJSONQuery(ctx, "db1", `{"selector":{"color":{"$eq":"blue"}}}`)
+	require.NoError(t, err)
+	require.Len(t, queryResp.GetResponse().GetKVs(), 1)
+	require.Equal(t, "key2", queryResp.GetResponse().GetKVs()[0].GetKey())
+
+	queryResp, err = alice.ExecuteConjunctiveQuery(ctx, "db1",
+		&client.QueryPredicate{Attribute: "color", Op: constants.QueryOpGreaterThanOrEqual, Value: "blue"},
+		&client.QueryPredicate{Attribute: "color", Op: constants.QueryOpLesserThan, Value: "red"},
+	)
+	require.NoError(t, err)
+	require.Len(t, queryResp.GetResponse().GetKVs(), 1)
+	require.Equal(t, "key2", queryResp.GetResponse().GetKVs()[0].GetKey())
+	require.Empty(t, queryResp.GetResponse().GetPostFilteredAttributes())
+
+	queryResp, err = alice.ExecuteConjunctiveQuery(ctx, "db1",
+		&client.QueryPredicate{Attribute: "color", Op: constants.QueryOpEqual, Value: "red"},
+		&client.QueryPredicate{Attribute: "size", Op: constants.QueryOpNotEqual, Value: []int{1}},
+	)
+	require.NoError(t, err)
+	require.Empty(t, queryResp.GetResponse().GetKVs())
+	require.Equal(t, []string{"size"}, queryResp.GetResponse().GetPostFilteredAttributes())
+
+	userResp, err := admin.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, "alice", userResp.GetResponse().GetUser().GetId())
+
+	dbStatusResp, err := alice.GetDBStatus(ctx, "db1")
+	require.NoError(t, err)
+	require.True(t, dbStatusResp.GetResponse().GetExist())
+
+	dbIndexResp, err := alice.GetDBIndex(ctx, "db1")
+	require.NoError(t, err)
+	require.Contains(t, dbIndexResp.GetResponse().GetIndex(), "color")
+
+	bootstrapResp, err := alice.GetSessionBootstrap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "alice", bootstrapResp.GetResponse().GetUser().GetId())
+
+	historyResp, err := admin.GetHistoricalData(ctx, "db1", "key1")
+	require.NoError(t, err)
+	require.Len(t, historyResp.GetResponse().GetValues(), 1)
+
+	// receipts and proofs
+	receiptResp, err := alice.GetTxReceipt(ctx, "data-tx-1")
+	require.NoError(t, err)
+	require.Equal(t, dataBlockNum, receiptResp.GetResponse().GetReceipt().GetHeader().GetBaseHeader().GetNumber())
+
+	headerResp, err := alice.GetBlockHeader(ctx, dataBlockNum)
+	require.NoError(t, err)
+	require.Equal(t, dataBlockNum, headerResp.GetResponse().GetBlockHeader().GetBaseHeader().GetNumber())
+
+	require.Eventually(t, func() bool {
+		lastResp, err := alice.GetLastBlockHeader(ctx)
+		return err == nil && lastResp.GetResponse().GetBlockHeader().GetBaseHeader().GetNumber() > dataBlockNum
+	}, 5*time.Second, 50*time.Millisecond)
+
+	pathResp, err := alice.GetLedgerPath(ctx, 1, dataBlockNum)
+	require.NoError(t, err)
+	require.NotEmpty(t, pathResp.GetResponse().GetBlockHeaders())
+
+	txProofResp, err := alice.GetTxProof(ctx, dataBlockNum, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, txProofResp.GetResponse().GetHashes())
+
+	dataProofResp, err := alice.GetDataProof(ctx, dataBlockNum, "db1", "key1", false)
+	require.NoError(t, err)
+	require.NotEmpty(t, dataProofResp.GetResponse().GetPath())
+
+	stats, err := admin.GetStorageStats(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, stats)
+
+	// the signatures verify over the response bytes, however the JSON rendering of the responses is re-encoded
+	nodeCert, err := x509.ParseCertificate(env.nodeCert)
+	require.NoError(t, err)
+	verifyCerts := map[string]*x509.Certificate{env.nodeID: nodeCert}
+	for _, envelope := range []proto.Message{dataResp, rangeResp, userResp, receiptResp, headerResp, pathResp, txProofResp, dataProofResp} {
+		for _, opts := range []protojson.MarshalOptions{
+			{},
+			{Multiline: true, Indent: "    "},
+			{UseProtoNames: true, UseEnumNumbers: true, EmitUnpopulated: true},
+		} {
+			rendered, err := opts.Marshal(envelope)
+			require.NoError(t, err)
+			decoded := envelope.ProtoReflect().New().Interface()
+			require.NoError(t, protojson.Unmarshal(rendered, decoded))
+			require.NoError(t, client.VerifyResponse(decoded, verifyCerts), "%s", rendered)
+		}
+	}
+
+	tampered := proto.Clone(dataResp).(*types.GetDataResponseEnvelope)
+	tampered.Response.Value = []byte(`{"color":"green"}`)
+	require.EqualError(t, client.VerifyResponse(tampered, verifyCerts), "the response does not match the signed response bytes")
+	tampered = proto.Clone(dataResp).(*types.GetDataResponseEnvelope)
+	tampered.Signature[len(tampered.Signature)-1] ^= 1
+	require.Contains(t, client.VerifyResponse(tampered, verifyCerts).Error(), "the signature of node [node1] on the response is not valid")
+	tampered = proto.Clone(dataResp).(*types.GetDataResponseEnvelope)
+	tampered.ResponseBytes = nil
+	require.EqualError(t, client.VerifyResponse(tampered, verifyCerts), "the response is not signed")
+	require.EqualError(t, client.VerifyResponse(dataResp, nil), "the response is signed by the unknown node [node1]")
+
+	// errors of the server
+	_, err = alice.GetStorageStats(ctx)
+	require.Error(t, err)
+	respErr, ok = err.(*client.ResponseError)
+	require.True(t, ok, "%T", err)
+	require.Equal(t, http.StatusForbidden, respErr.StatusCode)
+
+	_, err = alice.GetTxReceipt(ctx, "no-such-tx")
+	respErr, ok = err.(*client.ResponseError)
+	require.True(t, ok, "%T", err)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+
+	// ledger pinning
+	genesisResp, err := admin.GetBlockHeader(ctx, 1)
+	require.NoError(t, err)
+	genesisHash, err := blockstore.ComputeBlockHash(&types.Block{Header: genesisResp.GetResponse().GetBlockHeader()})
+	require.NoError(t, err)
+
+	pinned, err := client.New(&client.Config{
+		URL:       env.serverURL,
+		Signer:    env.aliceSigner,
+		LedgerPin: &client.LedgerPin{BlockNumber: 1, BlockHash: genesisHash},
+	})
+	require.NoError(t, err)
+	_, err = pinned.GetData(ctx, "db1", "key1")
+	require.NoError(t, err)
+
+	wronglyPinned, err := client.New(&client.Config{
+		URL:       env.serverURL,
+		Signer:    env.aliceSigner,
+		LedgerPin: &client.LedgerPin{BlockNumber: 1, BlockHash: []byte("other ledger")},
+	})
+	require.NoError(t, err)
+	_, err = wronglyPinned.GetData(ctx, "db1", "key1")
+	require.EqualError(t, err, fmt.Sprintf("the hash of block [1] on the server [%x] does not match the pinned hash [%x]", genesisHash, []byte("other ledger")))
+	_, err = wronglyPinned.SubmitDataTx(ctx, &types.DataTx{MustSignUserIds: []string{"alice"}, TxId: "data-tx-4"}, 0)
+	require.Error(t, err)
+}
+
+func TestClientDBDescriptorHistory(t *testing.T) {
+	env := newClientTestEnv(t, 7150)
+	ctx := context.Background()
+
+	admin, err := client.New(&client.Config{URL: env.serverURL, Signer: env.adminSigner})
+	require.NoError(t, err)
+	defer admin.Close()
+	alice, err := client.New(&client.Config{URL: env.serverURL, Signer: env.aliceSigner})
+	require.NoError(t, err)
+	defer alice.Close()
+
+	requireFlag := func(receipt *types.TxReceiptResponseEnvelope, err error, flag types.Flag) uint64 {
+		require.NoError(t, err)
+		header := receipt.GetResponse().GetReceipt().GetHeader()
+		require.Equal(t, flag, header.GetValidationInfo()[receipt.GetResponse().GetReceipt().GetTxIndex()].GetFlag())
+		return header.GetBaseHeader().GetNumber()
+	}
+	setDefaultACL := func(txID string, acl *types.AccessControl) uint64 {
+		receipt, err := admin.SubmitDBAdministrationTx(ctx, &types.DBAdministrationTx{
+			UserId:        "admin",
+			TxId:          txID,
+			DbsDefaultAcl: map[string]*types.DBDefaultACL{"db1": {Acl: acl}},
+		}, 5*time.Second)
+		return requireFlag(receipt, err, types.Flag_VALID)
+	}
+	write := func(txID, key string, flag types.Flag) uint64 {
+		receipt, err := alice.SubmitDataTx(ctx, &types.DataTx{
+			MustSignUserIds: []string{"alice"},
+			TxId:            txID,
+			DbOperations: []*types.DBOperation{
+				{DbName: "db1", DataWrites: []*types.DataWrite{{Key: key, Value: []byte("value")}}},
+			},
+		}, 5*time.Second)
+		return requireFlag(receipt, err, flag)
+	}
+
+	receipt, err := admin.SubmitDBAdministrationTx(ctx, &types.DBAdministrationTx{
+		UserId:    "admin",
+		TxId:      "create-db1",
+		CreateDbs: []string{"db1"},
+	}, 5*time.Second)
+	requireFlag(receipt, err, types.Flag_VALID)
+	receipt, err = admin.SubmitUserAdministrationTx(ctx, &types.UserAdministrationTx{
+		UserId: "admin",
+		TxId:   "add-alice",
+		UserWrites: []*types.UserWrite{
+			{
+				User: &types.User{
+					Id:          "alice",
+					Certificate: env.aliceCert,
+					Privilege: &types.Privilege{
+						DbPermission: map[string]types.Privilege_Access{"db1": types.Privilege_ReadWrite},
+					},
+				},
+			},
+		},
+	}, 5*time.Second)
+	requireFlag(receipt, err, types.Flag_VALID)
+
+	// the default ACL restricts the writes of the keys without an ACL from the block after the one that sets it, until
+	// the block after the one that removes it
+	beforeACL := write("write-1", "key1", types.Flag_VALID)
+	aclBlock := setDefaultACL("set-acl", &types.AccessControl{ReadWriteUsers: map[string]bool{"admin": true}})
+	restricted := write("write-2", "key2", types.Flag_INVALID_NO_PERMISSION)
+	require.Equal(t, aclBlock+1, restricted)
+	clearBlock := setDefaultACL("clear-acl", nil)
+	write("write-3", "key2", types.Flag_VALID)
+
+	descriptorAsOf := func(asOf uint64) *types.GetDBDescriptorResponse {
+		resp, err := alice.GetDBDescriptor(ctx, "db1", asOf)
+		require.NoError(t, err)
+		return resp.GetResponse()
+	}
+	require.Nil(t, descriptorAsOf(beforeACL).GetDbDescriptor())
+	for _, asOf := range []uint64{aclBlock, restricted} {
+		descriptor := descriptorAsOf(asOf)
+		require.Equal(t, map[string]bool{"admin": true}, descriptor.GetDbDescriptor().GetDefaultAcl().GetReadWriteUsers())
+		require.Equal(t, aclBlock, descriptor.GetVersion().GetBlockNum())
+	}
+	current := descriptorAsOf(0)
+	require.NotNil(t, current.GetDbDescriptor())
+	require.Nil(t, current.GetDbDescriptor().GetDefaultAcl())
+	require.Equal(t, clearBlock, current.GetVersion().GetBlockNum())
+
+	historyResp, err := alice.GetDBDescriptorHistory(ctx, "db1")
+	require.NoError(t, err)
+	changes := historyResp.GetResponse().GetChanges()
+	require.Len(t, changes, 2)
+	require.Equal(t, "set-acl", changes[0].GetTxId())
+	require.Equal(t, "admin", changes[0].GetUserId())
+	require.Equal(t, aclBlock, changes[0].GetVersion().GetBlockNum())
+	require.Equal(t, map[string]bool{"admin": true}, changes[0].GetDbDescriptor().GetDefaultAcl().GetReadWriteUsers())
+	require.Equal(t, "clear-acl", changes[1].GetTxId())
+	require.Equal(t, "admin", changes[1].GetUserId())
+	require.Equal(t, clearBlock, changes[1].GetVersion().GetBlockNum())
+	require.Nil(t, changes[1].GetDbDescriptor().GetDefaultAcl())
+
+	// a system database has no descriptor
+	_, err = admin.GetDBDescriptor(ctx, "_users", 0)
+	respErr, ok := err.(*client.ResponseError)
+	require.True(t, ok, "%T", err)
+	require.Equal(t, http.StatusForbidden, respErr.StatusCode)
+}
+
+func TestClientTxOperationLimits(t *testing.T) {
+	env := newClientTestEnv(t, 7160)
+	ctx := context.Background()
+
+	admin, err := client.New(&client.Config{URL: env.serverURL, Signer: env.adminSigner})
+	require.NoError(t, err)
+	defer admin.Close()
+
+	limits, err := admin.GetConfigLimits(ctx)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&types.TxOperationLimits{MaxWrites: 10}, limits.GetResponse().GetTxOperationLimits()))
+	require.Equal(t, uint64(1000), limits.GetResponse().GetMaxKeysPerRangeDelete())
+
+	writes := func(count int) []*types.DataWrite {
+		var writes []*types.DataWrite
+		for i := 0; i < count; i++ {
+			writes = append(writes, &types.DataWrite{Key: fmt.Sprintf("key%d", i), Value: []byte("value")})
+		}
+		return writes
+	}
+
+	receipt, err := admin.SubmitDataTx(ctx, &types.DataTx{
+		MustSignUserIds: []string{"admin"},
+		TxId:            "at-limit",
+		DbOperations:    []*types.DBOperation{{DbName: worldstate.DefaultDBName, DataWrites: writes(10)}},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	// a transaction over the limit is rejected on submission
+	_, err = admin.SubmitDataTx(ctx, &types.DataTx{
+		MustSignUserIds: []string{"admin"},
+		TxId:            "over-limit",
+		DbOperations:    []*types.DBOperation{{DbName: worldstate.DefaultDBName, DataWrites: writes(11)}},
+	}, 5*time.Second)
+	require.EqualError(t, err, "status: 400, error: the transaction has [11] writes, which exceeds the limit of [10] writes per transaction")
+}
+
+func TestClientSubscribeKeys(t *testing.T) {
+	env := newClientTestEnv(t, 7170)
+	ctx := context.Background()
+
+	admin, err := client.New(&client.Config{URL: env.serverURL, Signer: env.adminSigner})
+	require.NoError(t, err)
+	defer admin.Close()
+	alice, err := client.New(&client.Config{URL: env.serverURL, Signer: env.aliceSigner})
+	require.NoError(t, err)
+	defer alice.Close()
+
+	receipt, err := admin.SubmitDBAdministrationTx(ctx, &types.DBAdministrationTx{
+		UserId:    "admin",
+		TxId:      "db-tx",
+		CreateDbs: []string{"orders", "other"},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	receipt, err = admin.SubmitUserAdministrationTx(ctx, &types.UserAdministrationTx{
+		UserId: "admin",
+		TxId:   "user-tx",
+		UserWrites: []*types.UserWrite{
+			{
+				User: &types.User{
+					Id:          "alice",
+					Certificate: env.aliceCert,
+					Privilege: &types.Privilege{
+						DbPermission: map[string]types.Privilege_Access{"orders": types.Privilege_Read},
+					},
+				},
+			},
+		},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	// only the data databases the user may read can be subscribed to
+	_, err = alice.SubscribeKeys(ctx, "other", nil, []string{"order/"})
+	require.EqualError(t, err, "status: 403, error: error while processing 'POST /data/other/subscribe' because the user [alice] has no permission to read from database [other]")
+	_, err = alice.SubscribeKeys(ctx, worldstate.UsersDBName, []string{"alice"}, nil)
+	require.EqualError(t, err, "status: 403, error: error while processing 'POST /data/_users/subscribe' because no user can subscribe to the changes of a system database [_users]")
+	_, err = alice.SubscribeKeys(ctx, "orders", nil, nil)
+	require.EqualError(t, err, "status: 400, error: error while processing 'POST /data/orders/subscribe' because the subscription holds neither keys nor prefixes")
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	notifications, err := alice.SubscribeKeys(subCtx, "orders", []string{"status"}, []string{"order/"})
+	require.NoError(t, err)
+
+	submit := func(txID string, ops ...*types.DBOperation) uint64 {
+		receipt, err := admin.SubmitDataTx(ctx, &types.DataTx{
+			MustSignUserIds: []string{"admin"},
+			TxId:            txID,
+			DbOperations:    ops,
+		}, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		return receipt.GetResponse().GetReceipt().GetHeader().GetBaseHeader().GetNumber()
+	}
+
+	block1 := submit("tx1", &types.DBOperation{
+		DbName: "orders",
+		DataWrites: []*types.DataWrite{
+			{Key: "order/1", Value: []byte("new")},
+			{Key: "order", Value: []byte("not under the prefix")},
+			{Key: "receipt/1", Value: []byte("not under the prefix")},
+		},
+	})
+	// neither the keys of another database nor the other keys of the database are notified
+	submit("tx2",
+		&types.DBOperation{DbName: "other", DataWrites: []*types.DataWrite{{Key: "order/1", Value: []byte("other")}}},
+		&types.DBOperation{DbName: "orders", DataWrites: []*types.DataWrite{{Key: "statuses", Value: []byte("other")}}},
+	)
+	block3 := submit("tx3", &types.DBOperation{
+		DbName: "orders",
+		DataWrites: []*types.DataWrite{
+			{Key: "order/2", Value: []byte("secret"), Acl: &types.AccessControl{ReadUsers: map[string]bool{"admin": true}}},
+			{Key: "status", Value: []byte("open")},
+		},
+	})
+	block4 := submit("tx4", &types.DBOperation{
+		DbName:      "orders",
+		DataDeletes: []*types.DataDelete{{Key: "order/1"}},
+	})
+
+	expected := []*types.KeyChangesResponse{
+		{
+			DbName:      "orders",
+			BlockNumber: block1,
+			Changes: []*types.KeyChange{
+				{Key: "order/1", Version: &types.Version{BlockNum: block1}, Value: []byte("new")},
+			},
+		},
+		{
+			DbName:      "orders",
+			BlockNumber: block3,
+			Changes: []*types.KeyChange{
+				// the value is withheld, as the access control of the key does not allow alice to read it
+				{Key: "order/2", Version: &types.Version{BlockNum: block3}, ValueWithheld: true},
+				{Key: "status", Version: &types.Version{BlockNum: block3}, Value: []byte("open")},
+			},
+		},
+		{
+			DbName:      "orders",
+			BlockNumber: block4,
+			Changes: []*types.KeyChange{
+				{Key: "order/1", Deleted: true},
+			},
+		},
+	}
+	for _, e := range expected {
+		select {
+		case n, ok := <-notifications:
+			require.True(t, ok)
+			require.NotEmpty(t, n.GetSignature())
+			e.Header = &types.ResponseHeader{NodeId: env.nodeID}
+			require.True(t, proto.Equal(e, n.GetResponse()), "expected: %v, actual: %v", e, n.GetResponse())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no notification of block %d", e.BlockNumber)
+		}
+	}
+
+	// the subscription ends once the client disconnects
+	cancel()
+	select {
+	case _, ok := <-notifications:
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the notifications channel was not closed")
+	}
+}
+
+func TestClientSubscribePrivilegeChanges(t *testing.T) {
+	env := newClientTestEnv(t, 7190)
+	ctx := context.Background()
+
+	admin, err := client.New(&client.Config{URL: env.serverURL, Signer: env.adminSigner})
+	require.NoError(t, err)
+	defer admin.Close()
+	alice, err := client.New(&client.Config{URL: env.serverURL, Signer: env.aliceSigner})
+	require.NoError(t, err)
+	defer alice.Close()
+	bob, err := client.New(&client.Config{URL: env.serverURL, Signer: env.bobSigner})
+	require.NoError(t, err)
+	defer bob.Close()
+
+	receipt, err := admin.SubmitDBAdministrationTx(ctx, &types.DBAdministrationTx{
+		UserId:    "admin",
+		TxId:      "db-tx",
+		CreateDbs: []string{"orders"},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+
+	setUsers := func(txID string, users ...*types.User) uint64 {
+		tx := &types.UserAdministrationTx{UserId: "admin", TxId: txID}
+		for _, u := range users {
+			tx.UserWrites = append(tx.UserWrites, &types.UserWrite{User: u})
+		}
+		receipt, err := admin.SubmitUserAdministrationTx(ctx, tx, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		return receipt.GetResponse().GetReceipt().GetHeader().GetBaseHeader().GetNumber()
+	}
+	readOrders := &types.Privilege{DbPermission: map[string]types.Privilege_Access{"orders": types.Privilege_Read}}
+	setUsers("users-tx",
+		&types.User{Id: "alice", Certificate: env.aliceCert, Privilege: readOrders},
+		&types.User{Id: "bob", Certificate: env.bobCert, Privilege: readOrders},
+	)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	aliceChanges, err := alice.SubscribePrivilegeChanges(subCtx)
+	require.NoError(t, err)
+	bobChanges, err := bob.SubscribePrivilegeChanges(subCtx)
+	require.NoError(t, err)
+
+	requireNotified := func(notifications <-chan *types.PrivilegeChangesResponseEnvelope, expected *types.PrivilegeChangesResponse) {
+		select {
+		case n, ok := <-notifications:
+			require.True(t, ok)
+			require.NotEmpty(t, n.GetSignature())
+			expected.Header = &types.ResponseHeader{NodeId: env.nodeID}
+			require.True(t, proto.Equal(expected, n.GetResponse()), "expected: %v, actual: %v", expected, n.GetResponse())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no notification of block %d", expected.BlockNumber)
+		}
+	}
+
+	// the access of alice is revoked, which only alice is notified of
+	revoked := setUsers("revoke-tx", &types.User{Id: "alice", Certificate: env.aliceCert})
+	requireNotified(aliceChanges, &types.PrivilegeChangesResponse{
+		UserId:      "alice",
+		BlockNumber: revoked,
+		Changes:     []*types.PrivilegeChange{{Kind: types.PrivilegeChange_USER_UPDATED}},
+	})
+	bootstrap, err := alice.GetSessionBootstrap(ctx)
+	require.NoError(t, err)
+	require.Empty(t, bootstrap.GetResponse().GetDatabases())
+
+	// the first notification of bob is that of the block that changes its own record
+	granted := setUsers("grant-tx", &types.User{
+		Id:          "bob",
+		Certificate: env.bobCert,
+		Privilege:   &types.Privilege{DbPermission: map[string]types.Privilege_Access{"orders": types.Privilege_ReadWrite}},
+	})
+	requireNotified(bobChanges, &types.PrivilegeChangesResponse{
+		UserId:      "bob",
+		BlockNumber: granted,
+		Changes:     []*types.PrivilegeChange{{Kind: types.PrivilegeChange_USER_UPDATED}},
+	})
+
+	// the deletion of a database is notified to the users that have a privilege on it
+	receipt, err = admin.SubmitDBAdministrationTx(ctx, &types.DBAdministrationTx{
+		UserId:    "admin",
+		TxId:      "delete-db-tx",
+		DeleteDbs: []string{"orders"},
+	}, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+	requireNotified(bobChanges, &types.PrivilegeChangesResponse{
+		UserId:      "bob",
+		BlockNumber: receipt.GetResponse().GetReceipt().GetHeader().GetBaseHeader().GetNumber(),
+		Changes:     []*types.PrivilegeChange{{Kind: types.PrivilegeChange_DB_DELETED, DbName: "orders"}},
+	})
+	select {
+	case n := <-aliceChanges:
+		t.Fatalf("unexpected notification: %v", n)
+	default:
+	}
+
+	// the subscriptions end once the clients disconnect
+	cancel()
+	for _, notifications := range []<-chan *types.PrivilegeChangesResponseEnvelope{aliceChanges, bobChanges} {
+		select {
+		case _, ok := <-notifications:
+			require.False(t, ok)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the notifications channel was not closed")
+		}
+	}
+}
+
+func TestClientQueryConcurrencyLimits(t *testing.T) {
+	env := newClientTestEnv(t, 7180, func(conf *config.Configurations) {
+		conf.LocalConfig.Server.QueryConcurrency = config.QueryConcurrencyConf{
+			RangeQueries: 2,
+			QueueTimeout: time.Nanosecond,
+			RetryAfter:   time.Second,
+		}
+	})
+	ctx := context.Background()
+
+	admin, err := client.New(&client.Config{URL: env.serverURL, Signer: env.adminSigner, MaxRetries: -1})
+	require.NoError(t, err)
+	defer admin.Close()
+
+	submit := func(txID, key string) time.Duration {
+		submitted := time.Now()
+		receipt, err := admin.SubmitDataTx(ctx, &types.DataTx{
+			MustSignUserIds: []string{"admin"},
+			TxId:            txID,
+			DbOperations: []*types.DBOperation{
+				{DbName: worldstate.DefaultDBName, DataWrites: []*types.DataWrite{{Key: key, Value: []byte("value")}}},
+			},
+		}, 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, types.Flag_VALID, receipt.GetResponse().GetReceipt().GetHeader().GetValidationInfo()[0].GetFlag())
+		return time.Since(submitted)
+	}
+	for i := 0; i < 5; i++ {
+		submit(fmt.Sprintf("setup-tx-%d", i), fmt.Sprintf("exported-%d", i))
+	}
+
+	// two JSON queries whose bodies are still being sent hold both range query slots
+	var holders sync.WaitGroup
+	var bodies []*io.PipeWriter
+	for i := 0; i < 2; i++ {
+		r, w := io.Pipe()
+		bodies = append(bodies, w)
+		req, err := http.NewRequest(http.MethodPost, env.serverURL+constants.URLForJSONQuery(worldstate.DefaultDBName), r)
+		require.NoError(t, err)
+		req.Header.Set(constants.UserHeader, "admin")
+		req.Header.Set(constants.SignatureHeader, "c2lnbmF0dXJl")
+		holders.Add(1)
+		go func() {
+			defer holders.Done()
+			resp, err := http.DefaultClient.Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+	}
+	exportErr := func(err error) bool {
+		respErr, ok := err.(*client.ResponseError)
+		return ok && respErr.StatusCode == http.StatusServiceUnavailable &&
+			respErr.ErrMsg == "the server is serving the maximal number of range queries, 2, retry later"
+	}
+	require.Eventually(t, func() bool {
+		_, err := admin.GetDataRange(ctx, worldstate.DefaultDBName, "exported-0", "exported-9", 0)
+		return exportErr(err)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a storm of exports is shed, while the commits, which are not limited, keep their latency
+	const exports = 100
+	var shed int32
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < exports; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := admin.GetDataRange(ctx, worldstate.DefaultDBName, "exported-0", "exported-9", 0)
+			if exportErr(err) {
+				atomic.AddInt32(&shed, 1)
+			}
+		}()
+	}
+	close(start)
+	for i := 0; i < 5; i++ {
+		latency := submit(fmt.Sprintf("storm-tx-%d", i), fmt.Sprintf("key-%d", i))
+		require.Less(t, int64(latency), int64(2*time.Second), "commit latency of tx %d", i)
+	}
+	wg.Wait()
+	require.Equal(t, int32(exports), shed)
+
+	// the exports are served once the slots are released
+	for _, w := range bodies {
+		require.NoError(t, w.Close())
+	}
+	holders.Wait()
+	resp, err := admin.GetDataRange(ctx, worldstate.DefaultDBName, "exported-0", "exported-9", 0)
+	require.NoError(t, err)
+	require.Len(t, resp.GetResponse().GetKVs(), 5)
+}
+
+func TestClientRetriesAndErrors(t *testing.T) {
+	signer := &testSigner{id: "alice"}
+
+	t.Run("retry while unavailable", func(t *testing.T) {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) <= 2 {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"error":"not yet"}`))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"response":{"value":"dmFsdWU="}}`))
+		}))
+		defer srv.Close()
+
+		c, err := client.New(&client.Config{URL: srv.URL, Signer: signer, RetryIntervalMin: time.Millisecond})
+		require.NoError(t, err)
+
+		resp, err := c.GetData(context.Background(), "db1", "key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), resp.GetResponse().GetValue())
+		require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("give up after the retries", func(t *testing.T) {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":"Cluster leader unavailable"}`))
+		}))
+		defer srv.Close()
+
+		c, err := client.New(&client.Config{URL: srv.URL, Signer: signer, MaxRetries: 2, RetryIntervalMin: time.Millisecond})
+		require.NoError(t, err)
+
+		_, err = c.GetData(context.Background(), "db1", "key1")
+		require.EqualError(t, err, "status: 503, error: Cluster leader unavailable")
+		require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("problem details", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"type":"about:blank","title":"Bad Request","status":400,"detail":"the key is too long"}`))
+		}))
+		defer srv.Close()
+
+		c, err := client.New(&client.Config{URL: srv.URL, Signer: signer})
+		require.NoError(t, err)
+
+		_, err = c.GetData(context.Background(), "db1", "key1")
+		require.Equal(t, &client.ResponseError{StatusCode: http.StatusBadRequest, HttpResponseErr: types.HttpResponseErr{ErrMsg: "the key is too long"}}, err)
+	})
+
+	t.Run("bad configuration", func(t *testing.T) {
+		_, err := client.New(&client.Config{URL: "http://127.0.0.1:1"})
+		require.EqualError(t, err, "a signer must be provided")
+
+		_, err = client.New(&client.Config{URL: "ftp://127.0.0.1:1", Signer: signer})
+		require.EqualError(t, err, "unsupported scheme [ftp] in the server URL [ftp://127.0.0.1:1]")
+	})
+}
+
+type testSigner struct {
+	id string
+}
+
+func (s *testSigner) Sign(msgBytes []byte) ([]byte, error) {
+	return []byte("signature"), nil
+}
+
+func (s *testSigner) Identity() string {
+	return s.id
+}
@@ -0,0 +1,358 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// GetData returns the value of the key in the database
+func (c *Client) GetData(ctx context.Context, dbName, key string) (*types.GetDataResponseEnvelope, error) {
+	query := &types.GetDataQuery{UserId: c.UserID(), DbName: dbName, Key: key}
+	resp := &types.GetDataResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetData(dbName, key), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDataRange returns the values of the keys in the range [startKey, endKey) of the database, up to limit keys
+func (c *Client) GetDataRange(ctx context.Context, dbName, startKey, endKey string, limit uint64) (*types.GetDataRangeResponseEnvelope, error) {
+	return c.GetDataRangePage(ctx, dbName, startKey, endKey, limit, "")
+}
+
+// GetDataRangePage returns a page of the values of the keys in the range [startKey, endKey) of the database, up to
+// limit keys, starting at the cursor returned with the previous page, or at startKey if the cursor is empty. The
+// cursor of the next page is returned in the response while the result is pending. A cursor expires, see
+// IsCursorExpired, once the format of the keys of the database changed, upon which the listing is to be restarted.
+func (c *Client) GetDataRangePage(ctx context.Context, dbName, startKey, endKey string, limit uint64, cursor string) (*types.GetDataRangeResponseEnvelope, error) {
+	query := &types.GetDataRangeQuery{UserId: c.UserID(), DbName: dbName, StartKey: startKey, EndKey: endKey, Limit: limit, Cursor: cursor}
+	resp := &types.GetDataRangeResponseEnvelope{}
+	urlPath := constants.URLWithCursor(constants.URLForGetDataRange(dbName, startKey, endKey, limit), cursor)
+	if err := c.query(ctx, http.MethodGet, urlPath, query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDataMulti returns the values of the keys of the database, all read from a single snapshot of the state, along
+// with the height of the snapshot. A key which does not exist, or which the user cannot read, is marked as such.
+func (c *Client) GetDataMulti(ctx context.Context, dbName string, keys []string) (*types.GetDataMultiResponseEnvelope, error) {
+	body, err := json.Marshal(&types.GetDataMultiRequest{Keys: keys})
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the request")
+	}
+
+	query := &types.GetDataMultiQuery{UserId: c.UserID(), DbName: dbName, Keys: keys}
+	resp := &types.GetDataMultiResponseEnvelope{}
+	if err := c.query(ctx, http.MethodPost, constants.URLForDataMultiGet(dbName), query, body, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ExecuteJSONQuery returns the key-value pairs of the database that match the JSON query
+func (c *Client) ExecuteJSONQuery(ctx context.Context, dbName, jsonQuery string) (*types.DataQueryResponseEnvelope, error) {
+	body, err := json.Marshal(jsonQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the query")
+	}
+
+	query := &types.DataJSONQuery{UserId: c.UserID(), DbName: dbName, Query: jsonQuery}
+	resp := &types.DataQueryResponseEnvelope{}
+	if err := c.query(ctx, http.MethodPost, constants.URLForJSONQuery(dbName), query, body, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ExecuteJSONQueryPage returns a page of at most limit key-value pairs of the database that match the JSON query,
+// ordered by key, starting at the cursor returned with the previous page, or at the first match if the cursor is
+// empty. The cursor of the next page is returned in the response, unless the page is the last one.
+func (c *Client) ExecuteJSONQueryPage(ctx context.Context, dbName, jsonQuery string, limit uint64, cursor string) (*types.DataQueryResponseEnvelope, error) {
+	body, err := json.Marshal(jsonQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the query")
+	}
+
+	query := &types.DataJSONQuery{UserId: c.UserID(), DbName: dbName, Query: jsonQuery, Limit: limit, Cursor: cursor}
+	resp := &types.DataQueryResponseEnvelope{}
+	urlPath := constants.URLWithCursor(constants.URLForJSONQueryPage(dbName, limit), cursor)
+	if err := c.query(ctx, http.MethodPost, urlPath, query, body, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// QueryPredicate is a condition of a conjunctive query on the attribute: the logical operator Op, one of
+// constants.QueryOpEqual, QueryOpNotEqual, QueryOpGreaterThan, QueryOpGreaterThanOrEqual, QueryOpLesserThan and
+// QueryOpLesserThanOrEqual, with the value to compare to. The value of a QueryOpNotEqual predicate is a slice of the
+// values to exclude.
+type QueryPredicate struct {
+	Attribute string
+	Op        string
+	Value     interface{}
+}
+
+// ExecuteConjunctiveQuery returns the key-value pairs of the database that match all the predicates. At least one of
+// the attributes must be indexed. The predicates on the other attributes are verified against the values, and these
+// attributes are listed in the post-filtered attributes of the response.
+func (c *Client) ExecuteConjunctiveQuery(ctx context.Context, dbName string, predicates ...*QueryPredicate) (*types.DataQueryResponseEnvelope, error) {
+	if len(predicates) == 0 {
+		return nil, errors.New("at least one predicate must be given")
+	}
+
+	conditions := make(map[string]map[string]interface{})
+	for _, p := range predicates {
+		if conditions[p.Attribute] == nil {
+			conditions[p.Attribute] = make(map[string]interface{})
+		}
+		if _, ok := conditions[p.Attribute][p.Op]; ok {
+			return nil, errors.Errorf("more than one predicate with the operator [%s] is given on the attribute [%s]", p.Op, p.Attribute)
+		}
+		conditions[p.Attribute][p.Op] = p.Value
+	}
+
+	jsonQuery, err := json.Marshal(map[string]interface{}{
+		constants.QueryFieldSelector: map[string]interface{}{
+			constants.QueryOpAnd: conditions,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the predicates")
+	}
+	return c.ExecuteJSONQuery(ctx, dbName, string(jsonQuery))
+}
+
+// GetUser returns the record of a user
+func (c *Client) GetUser(ctx context.Context, userID string) (*types.GetUserResponseEnvelope, error) {
+	query := &types.GetUserQuery{UserId: c.UserID(), TargetUserId: userID}
+	resp := &types.GetUserResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetUser(userID), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDBStatus returns whether the database exists
+func (c *Client) GetDBStatus(ctx context.Context, dbName string) (*types.GetDBStatusResponseEnvelope, error) {
+	query := &types.GetDBStatusQuery{UserId: c.UserID(), DbName: dbName}
+	resp := &types.GetDBStatusResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetDBStatus(dbName), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDBIndex returns the index definition of the database
+func (c *Client) GetDBIndex(ctx context.Context, dbName string) (*types.GetDBIndexResponseEnvelope, error) {
+	query := &types.GetDBIndexQuery{UserId: c.UserID(), DbName: dbName}
+	resp := &types.GetDBIndexResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetDBIndex(dbName), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDBDescriptor returns the descriptor of the database as of the given block, or the committed descriptor if asOf
+// is 0
+func (c *Client) GetDBDescriptor(ctx context.Context, dbName string, asOf uint64) (*types.GetDBDescriptorResponseEnvelope, error) {
+	query := &types.GetDBDescriptorQuery{UserId: c.UserID(), DbName: dbName, AsOf: asOf}
+	urlPath := constants.URLForGetDBDescriptor(dbName)
+	if asOf > 0 {
+		urlPath = constants.URLForGetDBDescriptorAsOf(dbName, asOf)
+	}
+	resp := &types.GetDBDescriptorResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, urlPath, query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDBDescriptorHistory returns the versions of the descriptor of the database and the admin who set each of them
+func (c *Client) GetDBDescriptorHistory(ctx context.Context, dbName string) (*types.GetDBDescriptorHistoryResponseEnvelope, error) {
+	query := &types.GetDBDescriptorHistoryQuery{UserId: c.UserID(), DbName: dbName}
+	resp := &types.GetDBDescriptorHistoryResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetDBDescriptorHistory(dbName), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDBDigest returns the state digest of the database as of the given block, or as of the last committed block if
+// blockNum is 0
+func (c *Client) GetDBDigest(ctx context.Context, dbName string, blockNum uint64) (*types.GetDBDigestResponseEnvelope, error) {
+	query := &types.GetDBDigestQuery{UserId: c.UserID(), DbName: dbName, BlockNumber: blockNum}
+	resp := &types.GetDBDigestResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetDBDigest(dbName, blockNum), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetConfig returns the cluster configuration
+func (c *Client) GetConfig(ctx context.Context) (*types.GetConfigResponseEnvelope, error) {
+	query := &types.GetConfigQuery{UserId: c.UserID()}
+	resp := &types.GetConfigResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetConfig(), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetNodeConfig returns the configuration of a node of the cluster
+func (c *Client) GetNodeConfig(ctx context.Context, nodeID string) (*types.GetNodeConfigResponseEnvelope, error) {
+	query := &types.GetNodeConfigQuery{UserId: c.UserID(), NodeId: nodeID}
+	resp := &types.GetNodeConfigResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForNodeConfigPath(nodeID), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetConfigLimits returns the limits on data transactions, as set in the cluster configuration
+func (c *Client) GetConfigLimits(ctx context.Context) (*types.GetConfigLimitsResponseEnvelope, error) {
+	query := &types.GetConfigLimitsQuery{UserId: c.UserID()}
+	resp := &types.GetConfigLimitsResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.GetConfigLimits, query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetClusterStatus returns the nodes of the cluster, its leader, and its active nodes
+func (c *Client) GetClusterStatus(ctx context.Context, noCertificates bool) (*types.GetClusterStatusResponseEnvelope, error) {
+	query := &types.GetClusterStatusQuery{UserId: c.UserID(), NoCertificates: noCertificates}
+	urlPath := constants.GetClusterStatus
+	if noCertificates {
+		urlPath += "?nocert=true"
+	}
+	resp := &types.GetClusterStatusResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, urlPath, query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetSessionBootstrap returns the record of the user of the client, the databases it can read, and its limits
+func (c *Client) GetSessionBootstrap(ctx context.Context) (*types.GetSessionBootstrapResponseEnvelope, error) {
+	query := &types.GetSessionBootstrapQuery{UserId: c.UserID()}
+	resp := &types.GetSessionBootstrapResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.GetSessionBootstrap, query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetTxReceipt returns the receipt of a committed transaction
+func (c *Client) GetTxReceipt(ctx context.Context, txID string) (*types.TxReceiptResponseEnvelope, error) {
+	query := &types.GetTxReceiptQuery{UserId: c.UserID(), TxId: txID}
+	resp := &types.TxReceiptResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetTransactionReceipt(txID), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetBlockHeader returns the header of a block
+func (c *Client) GetBlockHeader(ctx context.Context, blockNum uint64) (*types.GetBlockResponseEnvelope, error) {
+	query := &types.GetBlockQuery{UserId: c.UserID(), BlockNumber: blockNum}
+	resp := &types.GetBlockResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForLedgerBlock(blockNum, false), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetLastBlockHeader returns the header of the last committed block
+func (c *Client) GetLastBlockHeader(ctx context.Context) (*types.GetBlockResponseEnvelope, error) {
+	query := &types.GetLastBlockQuery{UserId: c.UserID()}
+	resp := &types.GetBlockResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForLastLedgerBlock(), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetLedgerPath returns the headers of the blocks that link block endBlockNum to block startBlockNum
+func (c *Client) GetLedgerPath(ctx context.Context, startBlockNum, endBlockNum uint64) (*types.GetLedgerPathResponseEnvelope, error) {
+	query := &types.GetLedgerPathQuery{UserId: c.UserID(), StartBlockNumber: startBlockNum, EndBlockNumber: endBlockNum}
+	resp := &types.GetLedgerPathResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForLedgerPath(startBlockNum, endBlockNum), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetTxProof returns the proof of existence of a transaction in a block
+func (c *Client) GetTxProof(ctx context.Context, blockNum, txIndex uint64) (*types.GetTxProofResponseEnvelope, error) {
+	query := &types.GetTxProofQuery{UserId: c.UserID(), BlockNumber: blockNum, TxIndex: txIndex}
+	resp := &types.GetTxProofResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLTxProof(blockNum, txIndex), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDataProof returns the proof of the value, or of the deletion, of a key in the state trie after a block
+func (c *Client) GetDataProof(ctx context.Context, blockNum uint64, dbName, key string, deleted bool) (*types.GetDataProofResponseEnvelope, error) {
+	query := &types.GetDataProofQuery{UserId: c.UserID(), BlockNumber: blockNum, DbName: dbName, Key: key, IsDeleted: deleted}
+	resp := &types.GetDataProofResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLDataProof(blockNum, dbName, key, deleted), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetHistoricalData returns all the values of the key in the database
+func (c *Client) GetHistoricalData(ctx context.Context, dbName, key string) (*types.GetHistoricalDataResponseEnvelope, error) {
+	query := &types.GetHistoricalDataQuery{UserId: c.UserID(), DbName: dbName, Key: key}
+	resp := &types.GetHistoricalDataResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetHistoricalData(dbName, key), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetHistoricalDataPage returns a page of at most limit values of the key in the database, ordered by their version,
+// starting at the cursor returned with the previous page, or at the first version if the cursor is empty. The cursor
+// of the next page is returned in the response, unless the page is the last one.
+func (c *Client) GetHistoricalDataPage(ctx context.Context, dbName, key string, limit uint64, cursor string) (*types.GetHistoricalDataResponseEnvelope, error) {
+	query := &types.GetHistoricalDataQuery{UserId: c.UserID(), DbName: dbName, Key: key, Limit: limit, Cursor: cursor}
+	resp := &types.GetHistoricalDataResponseEnvelope{}
+	urlPath := constants.URLWithCursor(constants.URLForGetHistoricalDataPage(dbName, key, limit), cursor)
+	if err := c.query(ctx, http.MethodGet, urlPath, query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetStorageStats returns the internal statistics of the storage of the node. The user of the client must be an
+// admin.
+func (c *Client) GetStorageStats(ctx context.Context) (map[string]*leveldb.DBStats, error) {
+	query := &types.GetStorageStatsQuery{UserId: c.UserID()}
+	stats := make(map[string]*leveldb.DBStats)
+	if err := c.query(ctx, http.MethodGet, constants.GetStorageStats, query, nil, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetTrustedCheckpoints returns the checkpoints of the ledger of the node at every interval blocks, to be distributed
+// as a trusted checkpoints file. The user of the client must be an admin.
+func (c *Client) GetTrustedCheckpoints(ctx context.Context, interval uint64) (*types.GetTrustedCheckpointsResponseEnvelope, error) {
+	query := &types.GetTrustedCheckpointsQuery{UserId: c.UserID(), Interval: interval}
+	resp := &types.GetTrustedCheckpointsResponseEnvelope{}
+	if err := c.query(ctx, http.MethodGet, constants.URLForGetTrustedCheckpoints(interval), query, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
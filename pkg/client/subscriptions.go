@@ -0,0 +1,144 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxNotificationSize bounds the size of a single notification of a key subscription
+const maxNotificationSize = 64 * 1024 * 1024
+
+// SubscribeKeys subscribes to the changes of the given keys, and of the keys with the given prefixes, of the
+// database. The server notifies the subscription of every committed block that writes or deletes any of the keys,
+// with the new versions, and with the new values the user may read. The notifications are delivered on the returned
+// channel, which is closed once the subscription ends: when ctx is canceled, or when the server drops the
+// subscription, e.g., because the client does not keep up with the notifications.
+func (c *Client) SubscribeKeys(ctx context.Context, dbName string, keys, prefixes []string) (<-chan *types.KeyChangesResponseEnvelope, error) {
+	if err := c.VerifyLedgerPin(ctx); err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(&types.SubscribeKeysRequest{Keys: keys, Prefixes: prefixes})
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the subscription")
+	}
+	query := &types.SubscribeKeysQuery{UserId: c.UserID(), DbName: dbName, Keys: keys, Prefixes: prefixes}
+	httpResp, err := c.openStream(ctx, http.MethodPost, constants.URLForSubscribeKeys(dbName), query, body)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make(chan *types.KeyChangesResponseEnvelope)
+	go func() {
+		defer close(notifications)
+		defer httpResp.Body.Close()
+
+		scanner := bufio.NewScanner(httpResp.Body)
+		scanner.Buffer(nil, maxNotificationSize)
+		for scanner.Scan() {
+			envelope := &types.KeyChangesResponseEnvelope{}
+			if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(scanner.Bytes(), envelope); err != nil {
+				return
+			}
+			if err := c.verifyResponse(envelope); err != nil {
+				return
+			}
+			select {
+			case notifications <- envelope:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return notifications, nil
+}
+
+// SubscribePrivilegeChanges subscribes to the changes of the privileges of the user of the client. The server notifies
+// the subscription of every committed block that rewrites or deletes the record of the user, or that changes the
+// default ACL of, or deletes, a database the user has a privilege on, upon which the client is expected to refresh its
+// session with GetSessionBootstrap. The notifications are delivered on the returned channel, which is closed once the
+// subscription ends: when ctx is canceled, when the user is deleted, or when the server drops the subscription, e.g.,
+// because the client does not keep up with the notifications.
+func (c *Client) SubscribePrivilegeChanges(ctx context.Context) (<-chan *types.PrivilegeChangesResponseEnvelope, error) {
+	if err := c.VerifyLedgerPin(ctx); err != nil {
+		return nil, err
+	}
+
+	query := &types.SubscribePrivilegeChangesQuery{UserId: c.UserID()}
+	httpResp, err := c.openStream(ctx, http.MethodGet, constants.GetPrivilegeChanges, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make(chan *types.PrivilegeChangesResponseEnvelope)
+	go func() {
+		defer close(notifications)
+		defer httpResp.Body.Close()
+
+		scanner := bufio.NewScanner(httpResp.Body)
+		scanner.Buffer(nil, maxNotificationSize)
+		for scanner.Scan() {
+			envelope := &types.PrivilegeChangesResponseEnvelope{}
+			if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(scanner.Bytes(), envelope); err != nil {
+				return
+			}
+			if err := c.verifyResponse(envelope); err != nil {
+				return
+			}
+			select {
+			case notifications <- envelope:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return notifications, nil
+}
+
+// openStream issues the signed query, and returns the response once the server starts streaming the notifications
+func (c *Client) openStream(ctx context.Context, method, urlPath string, query proto.Message, body []byte) (*http.Response, error) {
+	signature, err := cryptoservice.SignQuery(c.signer, query)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while signing the query")
+	}
+
+	parsedURL, err := url.Parse(urlPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while parsing the request path [%s]", urlPath)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL.ResolveReference(parsedURL).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(constants.UserHeader, c.UserID())
+	req.Header.Set(constants.SignatureHeader, base64.StdEncoding.EncodeToString(signature))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, decodeResponse(httpResp, nil)
+	}
+	return httpResp, nil
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hyperledger-labs/orion-server/pkg/constants"
+	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
+	"github.com/hyperledger-labs/orion-server/pkg/marshal"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// The transactions are submitted either synchronously, with a positive timeout, or asynchronously, with a zero
+// timeout. A synchronous submission returns the receipt of the transaction once it is committed; if it is not
+// committed within the timeout, the server responds with a *ResponseError whose status is http.StatusAccepted, and
+// the receipt can be polled with GetTxReceipt. An asynchronous submission returns once the server accepted the
+// transaction, with an empty receipt.
+
+// SubmitDataTx signs the data transaction by the user of the client and submits it
+func (c *Client) SubmitDataTx(ctx context.Context, tx *types.DataTx, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
+	signature, err := cryptoservice.SignTx(c.signer, tx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while signing the transaction")
+	}
+
+	return c.SubmitDataTxEnvelope(ctx, &types.DataTxEnvelope{
+		Payload:    tx,
+		Signatures: map[string][]byte{c.UserID(): signature},
+	}, timeout)
+}
+
+// SubmitDataTxEnvelope submits a data transaction that was already signed, e.g., by several users
+func (c *Client) SubmitDataTxEnvelope(ctx context.Context, env *types.DataTxEnvelope, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
+	return c.submit(ctx, constants.PostDataTx, env, timeout)
+}
+
+// SubmitVoidTx signs the void transaction by the user of the client and submits it. A void reserves its ID, and the
+// sequence number it carries, without writing to the state.
+func (c *Client) SubmitVoidTx(ctx context.Context, tx *types.VoidTx, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
+	signature, err := cryptoservice.SignTx(c.signer, tx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while signing the transaction")
+	}
+
+	return c.submit(ctx, constants.PostVoidTx, &types.VoidTxEnvelope{Payload: tx, Signature: signature}, timeout)
+}
+
+// SubmitUserAdministrationTx signs the user administration transaction by the user of the client and submits it
+func (c *Client) SubmitUserAdministrationTx(ctx context.Context, tx *types.UserAdministrationTx, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
+	signature, err := cryptoservice.SignTx(c.signer, tx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while signing the transaction")
+	}
+
+	return c.submit(ctx, constants.PostUserTx, &types.UserAdministrationTxEnvelope{Payload: tx, Signature: signature}, timeout)
+}
+
+// SubmitDBAdministrationTx signs the database administration transaction by the user of the client and submits it
+func (c *Client) SubmitDBAdministrationTx(ctx context.Context, tx *types.DBAdministrationTx, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
+	signature, err := cryptoservice.SignTx(c.signer, tx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while signing the transaction")
+	}
+
+	return c.submit(ctx, constants.PostDBTx, &types.DBAdministrationTxEnvelope{Payload: tx, Signature: signature}, timeout)
+}
+
+// SubmitConfigTx signs the configuration transaction by the user of the client and submits it
+func (c *Client) SubmitConfigTx(ctx context.Context, tx *types.ConfigTx, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
+	signature, err := cryptoservice.SignTx(c.signer, tx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while signing the transaction")
+	}
+
+	return c.submit(ctx, constants.PostConfigTx, &types.ConfigTxEnvelope{Payload: tx, Signature: signature}, timeout)
+}
+
+func (c *Client) submit(ctx context.Context, urlPath string, env proto.Message, timeout time.Duration) (*types.TxReceiptResponseEnvelope, error) {
+	if err := c.VerifyLedgerPin(ctx); err != nil {
+		return nil, err
+	}
+
+	envBytes, err := marshal.DefaultMarshaler().Marshal(env)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling the transaction envelope")
+	}
+
+	header := http.Header{}
+	if timeout > 0 {
+		header.Set(constants.TimeoutHeader, timeout.String())
+		// the server responds once the timeout expires, hence the request waits a little longer
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout+time.Second)
+		defer cancel()
+	}
+
+	receipt := &types.TxReceiptResponseEnvelope{}
+	if err := c.do(ctx, http.MethodPost, urlPath, header, envBytes, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"crypto/x509"
+
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// VerifyResponse verifies a response envelope of the server against the certificates of the nodes, by node ID. The
+// signature is verified over the response bytes of the envelope, the deterministic protobuf encoding of the response
+// the node signed. The response of the envelope, which is decoded from its JSON rendering, must be equal to the
+// message the response bytes encode, and the node that signed it must be the node in the header of the response.
+func VerifyResponse(envelope proto.Message, nodeCerts map[string]*x509.Certificate) error {
+	m := envelope.ProtoReflect()
+	if !isResponseEnvelope(m.Descriptor()) {
+		return errors.Errorf("[%s] is not a response envelope", m.Descriptor().FullName())
+	}
+	fields := m.Descriptor().Fields()
+	responseField := fields.ByName("response")
+	signature := m.Get(fields.ByName("signature")).Bytes()
+	responseBytes := m.Get(fields.ByName("response_bytes")).Bytes()
+	if len(signature) == 0 || len(responseBytes) == 0 {
+		return errors.New("the response is not signed")
+	}
+
+	signed := m.Get(responseField).Message().New().Interface()
+	if err := proto.Unmarshal(responseBytes, signed); err != nil {
+		return errors.Wrap(err, "error while decoding the signed response bytes")
+	}
+	header, ok := signed.(interface{ GetHeader() *types.ResponseHeader })
+	if !ok {
+		return errors.Errorf("the response [%s] has no header", signed.ProtoReflect().Descriptor().FullName())
+	}
+	nodeID := header.GetHeader().GetNodeId()
+	cert, ok := nodeCerts[nodeID]
+	if !ok {
+		return errors.Errorf("the response is signed by the unknown node [%s]", nodeID)
+	}
+	verifier := &crypto.Verifier{Certificate: cert}
+	if err := verifier.Verify(responseBytes, signature); err != nil {
+		return errors.Wrapf(err, "the signature of node [%s] on the response is not valid", nodeID)
+	}
+
+	var rendered proto.Message
+	if m.Has(responseField) {
+		rendered = m.Get(responseField).Message().Interface()
+	} else {
+		rendered = m.Get(responseField).Message().New().Interface()
+	}
+	if !proto.Equal(signed, rendered) {
+		return errors.New("the response does not match the signed response bytes")
+	}
+	return nil
+}
+
+// isResponseEnvelope returns true if the message is a signed response of the server, which carries the response, its
+// signed response bytes, and the signature
+func isResponseEnvelope(desc protoreflect.MessageDescriptor) bool {
+	fields := desc.Fields()
+	response := fields.ByName("response")
+	signature := fields.ByName("signature")
+	responseBytes := fields.ByName("response_bytes")
+
+	return response != nil && response.Kind() == protoreflect.MessageKind &&
+		signature != nil && signature.Kind() == protoreflect.BytesKind &&
+		responseBytes != nil && responseBytes.Kind() == protoreflect.BytesKind
+}
+
+// parseNodeCertificates parses the DER encoded certificates of the nodes, by node ID
+func parseNodeCertificates(rawCerts map[string][]byte) (map[string]*x509.Certificate, error) {
+	if len(rawCerts) == 0 {
+		return nil, nil
+	}
+
+	certs := make(map[string]*x509.Certificate, len(rawCerts))
+	for nodeID, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while parsing the certificate of node [%s]", nodeID)
+		}
+		certs[nodeID] = cert
+	}
+	return certs, nil
+}
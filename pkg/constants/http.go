@@ -5,8 +5,10 @@ package constants
 
 import (
 	"fmt"
+	"net/url"
 	"path"
 	"regexp"
+	"strings"
 
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/pkg/errors"
@@ -33,20 +35,76 @@ const (
 	SignatureHeader = "Signature"
 	TimeoutHeader   = "TxTimeout"
 
+	// MinHeightHeader pins a data query to the state committed at the given block height, or above it. The query
+	// waits until the node commits the block, unless ReadCommittedHeader is set.
+	MinHeightHeader = "X-Min-Height"
+	// ReadCommittedHeader, when set to true, makes a height-pinned data query accept a potentially stale read of the
+	// current committed state instead of waiting for MinHeightHeader to be reached.
+	ReadCommittedHeader = "X-Read-Committed"
+	// ServedHeightHeader labels the response of a height-pinned data query with the height of the committed state
+	// which served the query.
+	ServedHeightHeader = "X-Served-Height"
+	// StalenessHeader labels the response of a height-pinned data query with the number of blocks the served state
+	// lags behind the requested minimal height.
+	StalenessHeader = "X-Staleness"
+	// APIVersionHeader negotiates the minor version of the HTTP API, which decides the shape of the responses. A
+	// request without it is served in CurrentAPIVersion. The response is labeled with the version it is shaped in.
+	APIVersionHeader = "X-API-Version"
+	// APIVersionPrefix is the path prefix of the major version of the HTTP API. The paths without it are served as
+	// well, in the same major version.
+	APIVersionPrefix = "/v1"
+	// APIVersion1_0 is the first minor version of the HTTP API, in which the validation info of a transaction
+	// carries only its flag, and the receipt of a transaction only its block header and index.
+	APIVersion1_0 = "1.0"
+	// APIVersion1_1 adds the reason of the invalidation, the write-set digest, the conflicting reads, and the
+	// dependency to the validation info of a transaction, and the write-set digest and the conflicting reads to its
+	// receipt.
+	APIVersion1_1 = "1.1"
+	// CurrentAPIVersion is the latest minor version of the HTTP API.
+	CurrentAPIVersion = APIVersion1_1
+
+	// NearestVersionHeader labels the not found response of a query for a particular version of a key with the
+	// nearest earlier version of the key, formatted as "{blockNum}/{txNum}", if there is one.
+	NearestVersionHeader = "X-Nearest-Version"
+	// ReadHintsHeader carries the keys which a submitted data transaction reads, formatted by ReadHintsHeaderValue,
+	// so that the node prefetches them before the transaction is validated. The hints are advisory: a malformed or
+	// a bogus hint is ignored.
+	ReadHintsHeader = "X-Read-Hints"
+	// AdminAuditReceiptHeader labels the response of an administrative action with the hex-encoded hash of the admin
+	// audit record of the action, as a receipt which the admin verifies against the records served on GetAdminAudit.
+	AdminAuditReceiptHeader = "X-Admin-Audit-Receipt"
+	// AccessTokenHeader carries an access token, issued on PostIssueAccessToken, which authenticates a query in place
+	// of the UserHeader and the SignatureHeader. A token never authenticates a transaction.
+	AccessTokenHeader = "X-Access-Token"
+
 	UserEndpoint = "/user/"
 	GetUser      = "/user/{userid}"
 	PostUserTx   = "/user/tx"
-
-	DataEndpoint  = "/data/"
-	GetData       = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/{key}"
-	GetDataRange  = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}"
-	PostDataTx    = "/data/tx"
-	PostDataQuery = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/jsonquery"
-
-	DBEndpoint  = "/db/"
-	GetDBStatus = "/db/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}"
-	GetDBIndex  = "/db/index/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}"
-	PostDBTx    = "/db/tx"
+	// PostUserImport creates users in bulk from a signed UserImportRequestEnvelope.
+	PostUserImport = "/user/import"
+
+	DataEndpoint      = "/data/"
+	GetData           = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/{key}"
+	GetDataRange      = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}"
+	PostDataTx        = "/data/tx"
+	PostVoidTx        = "/data/void"
+	PostDataQuery     = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/jsonquery"
+	PostSubscribeKeys = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/subscribe"
+	GetDataCount      = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/count"
+	GetDataExists     = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/exists"
+	PostDataMultiGet  = "/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/multiget"
+
+	DBEndpoint             = "/db/"
+	GetDBStatus            = "/db/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}"
+	GetDBIndex             = "/db/index/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}"
+	GetDBDescriptor        = "/db/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/descriptor"
+	GetDBDescriptorHistory = "/db/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/descriptor/history"
+	GetDBDigest            = "/db/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/digest"
+	PostDBTx               = "/db/tx"
+	// GetDBExport streams the export of a database as newline-delimited DBExportRecord.
+	GetDBExport = "/db/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/export"
+	// PostDBImport creates a database from the export in a signed DBImportRequestEnvelope.
+	PostDBImport = "/db/import"
 
 	ConfigEndpoint     = "/config/"
 	PostConfigTx       = "/config/tx"
@@ -55,19 +113,27 @@ const (
 	GetNodeConfig      = "/config/node/{nodeId}"
 	GetLastConfigBlock = "/config/block/last"
 	GetClusterStatus   = "/config/cluster"
-
-	LedgerEndpoint     = "/ledger/"
-	GetBlockHeader     = "/ledger/block/{blockId:[0-9]+}"
-	GetLastBlockHeader = "/ledger/block/last"
-	GetPath            = "/ledger/path"
-	GetTxProofPrefix   = "/ledger/proof/tx"
-	GetTxProof         = "/ledger/proof/tx/{blockId:[0-9]+}"
-	GetDataProofPrefix = "/ledger/proof/data"
-	GetDataProof       = "/ledger/proof/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/{key}"
-	GetTxReceipt       = "/ledger/tx/receipt/{txId}"
+	GetConfigLimits    = "/config/limits"
+
+	LedgerEndpoint      = "/ledger/"
+	GetBlockHeader      = "/ledger/block/{blockId:[0-9]+}"
+	GetBlockComposition = "/ledger/block/{blockId:[0-9]+}/composition"
+	GetLastBlockHeader  = "/ledger/block/last"
+	GetPath             = "/ledger/path"
+	GetTxProofPrefix    = "/ledger/proof/tx"
+	GetTxProof          = "/ledger/proof/tx/{blockId:[0-9]+}"
+	GetDataProofPrefix  = "/ledger/proof/data"
+	GetDataProof        = "/ledger/proof/data/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/{key}"
+	GetTxReceipt        = "/ledger/tx/receipt/{txId}"
+	GetTxWriteSetDigest = "/ledger/tx/writeset/{txId}"
+	GetDroppedTx        = "/ledger/tx/dropped/{txId}"
+	GetLedgerRollups    = "/ledger/rollups"
+	GetLedgerUsage      = "/ledger/usage/{userId}"
+	GetKeyBlocks        = "/ledger/keyblocks/{dbname:" + `[0-9a-zA-Z_\-\.]+` + "}/{key}"
 
 	ProvenanceEndpoint      = "/provenance/"
 	GetHistoricalData       = "/provenance/data/history/{dbname}/{key}"
+	GetDataByVersion        = "/provenance/data/{dbname}/{key}/version/{blknum:[0-9]+}/{txnum:[0-9]+}"
 	GetDataReaders          = "/provenance/data/readers/{dbname}/{key}"
 	GetDataWriters          = "/provenance/data/writers/{dbname}/{key}"
 	GetDataReadBy           = "/provenance/data/read/{userId}"
@@ -75,14 +141,55 @@ const (
 	GetDataDeletedBy        = "/provenance/data/deleted/{userId}"
 	GetTxIDsSubmittedBy     = "/provenance/data/tx/{userId}"
 	GetMostRecentUserOrNode = "/provenance/{type:user|node}/{id}"
+
+	ClusterEndpoint      = "/cluster/"
+	GetClusterHeartbeats = "/cluster/status"
+
+	SessionEndpoint     = "/session/"
+	GetSessionBootstrap = "/session/bootstrap"
+	// GetPrivilegeChanges streams a notification to the querier for every committed block that may change its
+	// privileges, so that the client refreshes its session bootstrap.
+	GetPrivilegeChanges = "/session/privileges/changes"
+	// PostIssueAccessToken issues an access token to the querier from a signed IssueAccessTokenRequest.
+	PostIssueAccessToken = "/session/token"
+	// PostRevokeAccessToken revokes an access token, of the querier or of any user if the querier is an admin, from a
+	// signed RevokeAccessTokenRequest.
+	PostRevokeAccessToken = "/session/token/revoke"
+
+	AdminEndpoint         = "/admin/"
+	GetStorageStats       = "/admin/storage/stats"
+	GetStorageMetrics     = "/admin/storage/metrics"
+	GetStorageHandles     = "/admin/storage/handles"
+	GetBlockStoreStats    = "/admin/storage/blocks"
+	PostTraceValidation   = "/admin/trace-validation"
+	PostAcceptPeerHeader  = "/admin/divergence/accept"
+	PostResyncDB          = "/admin/resync"
+	GetTrustedCheckpoints = "/admin/checkpoints"
+	LogLevels             = "/admin/logging"
+	StateMigration        = "/admin/migration"
+	StateScrub            = "/admin/scrub"
+	GetDroppedTxs         = "/admin/dropped"
+	GetAdminAudit         = "/admin/audit"
 )
 
+// SupportedAPIVersions returns the minor versions of the HTTP API served by the server, from the oldest to the
+// current one
+func SupportedAPIVersions() []string {
+	return []string{APIVersion1_0, APIVersion1_1}
+}
+
 // URLForGetData returns url for GET request to retrieve
 // value of the key present in the dbName
 func URLForGetData(dbName, key string) string {
 	return DataEndpoint + path.Join(dbName, key)
 }
 
+// URLForGetDataAsOf returns url for GET request to retrieve
+// value of the key present in the dbName as of the given block
+func URLForGetDataAsOf(dbName, key string, asOf uint64) string {
+	return URLForGetData(dbName, key) + fmt.Sprintf("?asof=%d", asOf)
+}
+
 // URLForGetDataRange returns url for GET request to retrieve
 // a range of values.
 func URLForGetDataRange(dbName, startKey, endKey string, limit uint64) string {
@@ -90,6 +197,66 @@ func URLForGetDataRange(dbName, startKey, endKey string, limit uint64) string {
 		fmt.Sprintf("?startkey=\"%s\"&endkey=\"%s\"&limit=%d", startKey, endKey, limit)
 }
 
+// URLWithCursor returns the url of the next page of a paged listing, given the url of the listing and the cursor
+// returned with the previous page. The url is returned as it is when the cursor is empty.
+func URLWithCursor(u, cursor string) string {
+	if cursor == "" {
+		return u
+	}
+	if strings.Contains(u, "?") {
+		return u + "&cursor=" + url.QueryEscape(cursor)
+	}
+	return u + "?cursor=" + url.QueryEscape(cursor)
+}
+
+// URLForGetDataRangeAsOf returns url for GET request to retrieve
+// a range of values as of the given block.
+func URLForGetDataRangeAsOf(dbName, startKey, endKey string, limit, asOf uint64) string {
+	return URLForGetDataRange(dbName, startKey, endKey, limit) + fmt.Sprintf("&asof=%d", asOf)
+}
+
+// URLForGetDataRangeAnalytical returns url for GET request to retrieve
+// a range of values from the read replica of the state database.
+func URLForGetDataRangeAnalytical(dbName, startKey, endKey string, limit uint64) string {
+	return URLForGetDataRange(dbName, startKey, endKey, limit) + "&analytical=true"
+}
+
+// URLForGetDataCountRange returns url for GET request to count
+// the readable keys in the range [startKey, endKey)
+func URLForGetDataCountRange(dbName, startKey, endKey string) string {
+	return DataEndpoint + path.Join(dbName, "count") + fmt.Sprintf("?startkey=\"%s\"&endkey=\"%s\"", startKey, endKey)
+}
+
+// URLForGetDataCountPrefix returns url for GET request to count
+// the readable keys which begin with the prefix
+func URLForGetDataCountPrefix(dbName, prefix string) string {
+	return DataEndpoint + path.Join(dbName, "count") + fmt.Sprintf("?prefix=\"%s\"", prefix)
+}
+
+// URLForGetDataCountQuery returns url for GET request to count
+// the readable keys matching the given JSON query
+func URLForGetDataCountQuery(dbName, query string) string {
+	return DataEndpoint + path.Join(dbName, "count") + "?query=" + url.QueryEscape(query)
+}
+
+// URLForGetDataExistsRange returns url for GET request to check
+// whether any readable key exists in the range [startKey, endKey)
+func URLForGetDataExistsRange(dbName, startKey, endKey string) string {
+	return DataEndpoint + path.Join(dbName, "exists") + fmt.Sprintf("?startkey=\"%s\"&endkey=\"%s\"", startKey, endKey)
+}
+
+// URLForGetDataExistsPrefix returns url for GET request to check
+// whether any readable key begins with the prefix
+func URLForGetDataExistsPrefix(dbName, prefix string) string {
+	return DataEndpoint + path.Join(dbName, "exists") + fmt.Sprintf("?prefix=\"%s\"", prefix)
+}
+
+// URLForGetDataExistsQuery returns url for GET request to check
+// whether any readable key matches the given JSON query
+func URLForGetDataExistsQuery(dbName, query string) string {
+	return DataEndpoint + path.Join(dbName, "exists") + "?query=" + url.QueryEscape(query)
+}
+
 // URLForJSONQuery returns url for GET request to retrieve
 // key-value pairs present in the dbName which are matching the
 // given JSON query criteria
@@ -97,6 +264,25 @@ func URLForJSONQuery(dbName string) string {
 	return DataEndpoint + path.Join(dbName, "jsonquery")
 }
 
+// URLForJSONQueryPage returns url for POST request to retrieve
+// a page of at most limit key-value pairs present in the dbName
+// which are matching the given JSON query criteria
+func URLForJSONQueryPage(dbName string, limit uint64) string {
+	return URLForJSONQuery(dbName) + fmt.Sprintf("?limit=%d", limit)
+}
+
+// URLForSubscribeKeys returns url for POST request to subscribe
+// to the changes of keys of the dbName
+func URLForSubscribeKeys(dbName string) string {
+	return DataEndpoint + path.Join(dbName, "subscribe")
+}
+
+// URLForDataMultiGet returns url for POST request to read
+// several keys of the dbName from a single snapshot of the state
+func URLForDataMultiGet(dbName string) string {
+	return DataEndpoint + path.Join(dbName, "multiget")
+}
+
 // URLForGetUser returns url for GET request to retrieve
 // a user information
 func URLForGetUser(userID string) string {
@@ -115,6 +301,43 @@ func URLForGetDBIndex(dbName string) string {
 	return DBEndpoint + "index/" + dbName
 }
 
+// URLForGetDBDescriptor returns url for GET request to retrieve
+// the committed descriptor of a given database
+func URLForGetDBDescriptor(dbName string) string {
+	return DBEndpoint + path.Join(dbName, "descriptor")
+}
+
+// URLForGetDBDescriptorAsOf returns url for GET request to retrieve
+// the descriptor of a given database as of the given block
+func URLForGetDBDescriptorAsOf(dbName string, asOf uint64) string {
+	return URLForGetDBDescriptor(dbName) + fmt.Sprintf("?asof=%d", asOf)
+}
+
+// URLForGetDBDescriptorHistory returns url for GET request to retrieve
+// the change history of the descriptor of a given database
+func URLForGetDBDescriptorHistory(dbName string) string {
+	return DBEndpoint + path.Join(dbName, "descriptor", "history")
+}
+
+// URLForGetDBDigest returns url for GET request to retrieve the
+// state digest of a given database as of the given block, or as of
+// the last committed block when blockNum is 0
+func URLForGetDBDigest(dbName string, blockNum uint64) string {
+	if blockNum > 0 {
+		return DBEndpoint + path.Join(dbName, "digest") + fmt.Sprintf("?block=%d", blockNum)
+	}
+	return DBEndpoint + path.Join(dbName, "digest")
+}
+
+// URLForGetDBExport returns url for GET request to export a given
+// database, with the historical values of its keys if provenance is set
+func URLForGetDBExport(dbName string, provenance bool) string {
+	if provenance {
+		return DBEndpoint + path.Join(dbName, "export") + "?provenance=true"
+	}
+	return DBEndpoint + path.Join(dbName, "export")
+}
+
 // URLForGetConfig returns url for GET request to retrieve
 // the cluster configuration
 func URLForGetConfig() string {
@@ -128,6 +351,11 @@ func URLForLedgerBlock(blockNum uint64, augmented bool) string {
 	return LedgerEndpoint + fmt.Sprintf("block/%d", blockNum)
 }
 
+// URLForGetBlockComposition returns url for GET request to retrieve the composition of the batch of a given block
+func URLForGetBlockComposition(blockNum uint64) string {
+	return LedgerEndpoint + fmt.Sprintf("block/%d/composition", blockNum)
+}
+
 func URLForLastLedgerBlock() string {
 	return GetLastBlockHeader
 }
@@ -157,6 +385,13 @@ func URLForGetHistoricalData(dbName, key string) string {
 	return ProvenanceEndpoint + path.Join("data", "history", dbName, key)
 }
 
+// URLForGetHistoricalDataPage returns url for GET request to
+// retrieve a page of at most limit values associated with a given
+// key on a database, ordered by their version
+func URLForGetHistoricalDataPage(dbName, key string, limit uint64) string {
+	return URLForGetHistoricalData(dbName, key) + fmt.Sprintf("?limit=%d", limit)
+}
+
 // URLForGetHistoricalDeletedData returns url for GET request to
 // retrieve all deleted values associated with a given key on a database
 func URLForGetHistoricalDeletedData(dbName, key string) string {
@@ -178,6 +413,13 @@ func URLForGetHistoricalDataAtOrBelow(dbName, key string, version *types.Version
 		fmt.Sprintf("&mostrecent=true")
 }
 
+// URLForGetDataByVersion returns url for GET request to
+// retrieve the value of a given key on a database at a particular version
+func URLForGetDataByVersion(dbName, key string, version *types.Version) string {
+	return ProvenanceEndpoint + path.Join("data", dbName, key, "version") +
+		fmt.Sprintf("/%d/%d", version.BlockNum, version.TxNum)
+}
+
 // URLForGetPreviousHistoricalData returns url for GET request to
 // retrieve previous values for a given key on a database from a particular version
 func URLForGetPreviousHistoricalData(dbName, key string, version *types.Version) string {
@@ -234,6 +476,43 @@ func URLForGetTransactionReceipt(txId string) string {
 	return LedgerEndpoint + path.Join("tx", "receipt", txId)
 }
 
+// URLForGetDroppedTx returns url for GET request to retrieve the dead-letter record of a transaction that was dropped
+// before it was included in a block
+func URLForGetDroppedTx(txId string) string {
+	return LedgerEndpoint + path.Join("tx", "dropped", txId)
+}
+
+// URLForGetLedgerRollups returns url for GET request to retrieve the daily rollups of the ledger from startDate to
+// endDate, both included, each given as YYYY-MM-DD
+func URLForGetLedgerRollups(startDate, endDate string) string {
+	return GetLedgerRollups + fmt.Sprintf("?start=%s&end=%s", startDate, endDate)
+}
+
+// URLForGetLedgerUsage returns url for GET request to sum the sizes of the transactions submitted by userID in the blocks
+// from startBlockNum to endBlockNum, both included
+func URLForGetLedgerUsage(userID string, startBlockNum, endBlockNum uint64) string {
+	return LedgerEndpoint + path.Join("usage", userID) + fmt.Sprintf("?start=%d&end=%d", startBlockNum, endBlockNum)
+}
+
+// URLForGetKeyBlocks returns url for GET request to find the blocks which may have modified the key of the database,
+// from startBlockNum to endBlockNum, both included
+func URLForGetKeyBlocks(dbName, key string, startBlockNum, endBlockNum uint64) string {
+	return LedgerEndpoint + path.Join("keyblocks", dbName, key) + fmt.Sprintf("?start=%d&end=%d", startBlockNum, endBlockNum)
+}
+
+// URLForGetDroppedTxs returns url for GET request to list the dead-letter records of the node, starting with the
+// transactions dropped at or after since, in nanoseconds since the Unix epoch. A zero limit lists up to the default
+// limit of the server.
+func URLForGetDroppedTxs(since int64, limit uint64) string {
+	return GetDroppedTxs + fmt.Sprintf("?since=%d&limit=%d", since, limit)
+}
+
+// URLForVerifyTxWriteSetDigest returns url for GET request to
+// verify the write-set digest of a given transaction
+func URLForVerifyTxWriteSetDigest(txId string) string {
+	return LedgerEndpoint + path.Join("tx", "writeset", txId)
+}
+
 func URLForGetMostRecentUserInfo(userID string, version *types.Version) string {
 	return ProvenanceEndpoint + path.Join("user", userID) +
 		fmt.Sprintf("?blocknumber=%d&transactionnumber=%d", version.BlockNum, version.TxNum)
@@ -244,6 +523,25 @@ func URLForGetMostRecentNodeConfig(nodeID string, version *types.Version) string
 		fmt.Sprintf("?blocknumber=%d&transactionnumber=%d", version.BlockNum, version.TxNum)
 }
 
+// URLForGetTrustedCheckpoints returns url for GET request to retrieve the trusted checkpoints of the ledger, one every
+// interval blocks. Zero means the default interval of the server.
+func URLForGetTrustedCheckpoints(interval uint64) string {
+	if interval == 0 {
+		return GetTrustedCheckpoints
+	}
+	return GetTrustedCheckpoints + fmt.Sprintf("?interval=%d", interval)
+}
+
+// ReadHintsHeaderValue formats the value of the ReadHintsHeader: a comma separated list of "{dbName}/{key}", where
+// the name of the database and the key are escaped as URL path segments.
+func ReadHintsHeaderValue(hints []*types.ReadHint) string {
+	entries := make([]string, 0, len(hints))
+	for _, h := range hints {
+		entries = append(entries, url.PathEscape(h.DBName)+"/"+url.PathEscape(h.Key))
+	}
+	return strings.Join(entries, ",")
+}
+
 // SafeURLSegmentNZ checks that the string `s` is safe to use as a URL segment-nz.
 // For example: `http://example.com:8080/tx/my-id`, for s="my-id".
 // See: `https://www.ietf.org/rfc/rfc3986.txt`.
@@ -34,6 +34,13 @@ func TestURLConstruction(t *testing.T) {
 			},
 			expectedURL: "/data/db1?startkey=\"key1\"&endkey=\"key10\"&limit=10",
 		},
+		{
+			name: "GetDataRange with cursor",
+			execute: func() string {
+				return URLWithCursor(URLForGetDataRange("db1", "key1", "key10", 10), "a-b_c")
+			},
+			expectedURL: "/data/db1?startkey=\"key1\"&endkey=\"key10\"&limit=10&cursor=a-b_c",
+		},
 		{
 			name: "JSONQuery",
 			execute: func() string {
@@ -41,6 +48,20 @@ func TestURLConstruction(t *testing.T) {
 			},
 			expectedURL: "/data/db1/jsonquery",
 		},
+		{
+			name: "JSONQueryPage",
+			execute: func() string {
+				return URLWithCursor(URLForJSONQueryPage("db1", 5), "")
+			},
+			expectedURL: "/data/db1/jsonquery?limit=5",
+		},
+		{
+			name: "DataMultiGet",
+			execute: func() string {
+				return URLForDataMultiGet("db1")
+			},
+			expectedURL: "/data/db1/multiget",
+		},
 		{
 			name: "GetUser",
 			execute: func() string {
@@ -62,6 +83,27 @@ func TestURLConstruction(t *testing.T) {
 			},
 			expectedURL: "/db/index/db1",
 		},
+		{
+			name: "GetDBDescriptor",
+			execute: func() string {
+				return URLForGetDBDescriptor("db1")
+			},
+			expectedURL: "/db/db1/descriptor",
+		},
+		{
+			name: "GetDBDescriptorAsOf",
+			execute: func() string {
+				return URLForGetDBDescriptorAsOf("db1", 5)
+			},
+			expectedURL: "/db/db1/descriptor?asof=5",
+		},
+		{
+			name: "GetDBDescriptorHistory",
+			execute: func() string {
+				return URLForGetDBDescriptorHistory("db1")
+			},
+			expectedURL: "/db/db1/descriptor/history",
+		},
 		{
 			name: "URLForGetConfig",
 			execute: func() string {
@@ -132,6 +174,13 @@ func TestURLConstruction(t *testing.T) {
 			},
 			expectedURL: "/provenance/data/history/db1/key1",
 		},
+		{
+			name: "GetHistoricalDataPage",
+			execute: func() string {
+				return URLWithCursor(URLForGetHistoricalDataPage("db1", "key1", 2), "c+d=")
+			},
+			expectedURL: "/provenance/data/history/db1/key1?limit=2&cursor=c%2Bd%3D",
+		},
 		{
 			name: "URLForGetHistoricalDeletedData",
 			execute: func() string {
@@ -159,6 +208,16 @@ func TestURLConstruction(t *testing.T) {
 			},
 			expectedURL: "/provenance/data/history/db2/key2?blocknumber=10&transactionnumber=5&mostrecent=true",
 		},
+		{
+			name: "URLForGetDataByVersion",
+			execute: func() string {
+				return URLForGetDataByVersion("db2", "key2", &types.Version{
+					BlockNum: 10,
+					TxNum:    5,
+				})
+			},
+			expectedURL: "/provenance/data/db2/key2/version/10/5",
+		},
 		{
 			name: "URLForPreviousGetHistoricalData",
 			execute: func() string {
@@ -214,6 +273,41 @@ func TestURLConstruction(t *testing.T) {
 			},
 			expectedURL: "/ledger/tx/receipt/tx1",
 		},
+		{
+			name: "URLForGetDroppedTx",
+			execute: func() string {
+				return URLForGetDroppedTx("tx1")
+			},
+			expectedURL: "/ledger/tx/dropped/tx1",
+		},
+		{
+			name: "URLForGetLedgerRollups",
+			execute: func() string {
+				return URLForGetLedgerRollups("2026-10-15", "2026-10-16")
+			},
+			expectedURL: "/ledger/rollups?start=2026-10-15&end=2026-10-16",
+		},
+		{
+			name: "URLForGetLedgerUsage",
+			execute: func() string {
+				return URLForGetLedgerUsage("alice", 5, 10)
+			},
+			expectedURL: "/ledger/usage/alice?start=5&end=10",
+		},
+		{
+			name: "URLForGetKeyBlocks",
+			execute: func() string {
+				return URLForGetKeyBlocks("db1", "key1", 5, 10)
+			},
+			expectedURL: "/ledger/keyblocks/db1/key1?start=5&end=10",
+		},
+		{
+			name: "URLForGetDroppedTxs",
+			execute: func() string {
+				return URLForGetDroppedTxs(1000, 10)
+			},
+			expectedURL: "/admin/dropped?since=1000&limit=10",
+		},
 		{
 			name: "URLForGetMostRecentNodeInfo",
 			execute: func() string {
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// ServerVersion is the software version of the blockchain database server. It is printed by `bdb version`, and
+// recorded by each node in its heartbeats.
+const ServerVersion = "0.1"
+
+// The versions of the validation rules. A block records the version of the rules it must be validated with, so that
+// the nodes of a cluster in the middle of a rolling upgrade validate the blocks of the older nodes the way they did.
+const (
+	// LegacyRulesVersion denotes the rules of the blocks that do not record a rules version, i.e., blocks produced by
+	// nodes of the minor version that preceded the field.
+	LegacyRulesVersion uint32 = 1
+	// KeyFormatRulesVersion introduces the validation of the format of the keys of the data transactions, including
+	// their maximal length.
+	KeyFormatRulesVersion uint32 = 2
+	// SequenceRulesVersion introduces the ordered data transactions, see DataTx.sequence.
+	SequenceRulesVersion uint32 = 3
+	// SchemaRulesVersion introduces the validation of the values written to a database against its JSON schema.
+	SchemaRulesVersion uint32 = 4
+	// TxDependenciesRulesVersion introduces the dependencies of the data transactions, see DataTx.depends_on_tx_id.
+	TxDependenciesRulesVersion uint32 = 5
+	// OperationLimitsRulesVersion introduces the limits on the number of operations of a data transaction, see
+	// LedgerConfig.tx_operation_limits.
+	OperationLimitsRulesVersion uint32 = 6
+	// ValueSizeRulesVersion introduces the caps on the size of the values written to a database.
+	ValueSizeRulesVersion uint32 = 7
+	// TxDeadlinesRulesVersion introduces the expiry of the data transactions that are committed past their deadline,
+	// see DataTx.not_committed_after.
+	TxDeadlinesRulesVersion uint32 = 8
+	// AttestationRulesVersion introduces the data transactions that only attest a payload on the ledger, see
+	// DataTx.attestation_payload.
+	AttestationRulesVersion uint32 = 9
+	// KeyCollationRulesVersion introduces the validation of the keys written to a database against its key collation.
+	KeyCollationRulesVersion uint32 = 10
+	// ImmutableDBRulesVersion introduces the databases whose keys can only be inserted.
+	ImmutableDBRulesVersion uint32 = 11
+	// WriteSetDigestRulesVersion introduces the write-set digest of the valid data transactions, see
+	// ValidationInfo.write_set_digest.
+	WriteSetDigestRulesVersion uint32 = 12
+	// ConflictingReadsRulesVersion introduces the conflicting reads of the data transactions invalidated due to an
+	// mvcc conflict, see ValidationInfo.conflicting_reads.
+	ConflictingReadsRulesVersion uint32 = 13
+	// RulesVersion is the version of the rules implemented by this server, which it records in the blocks it produces.
+	RulesVersion = ConflictingReadsRulesVersion
+)
@@ -14,11 +14,23 @@ func SignQuery(querySigner crypto.Signer, query interface{}) ([]byte, error) {
 	switch v := query.(type) {
 	case *types.GetConfigQuery:
 	case *types.GetConfigBlockQuery:
+	case *types.GetConfigLimitsQuery:
 	case *types.GetClusterStatusQuery:
+	case *types.GetClusterHeartbeatsQuery:
+	case *types.GetSessionBootstrapQuery:
+	case *types.SubscribePrivilegeChangesQuery:
+	case *types.IssueAccessTokenQuery:
+	case *types.RevokeAccessTokenQuery:
 	case *types.GetDataQuery:
 	case *types.GetDataRangeQuery:
+	case *types.GetDataCountQuery:
+	case *types.GetDataMultiQuery:
 	case *types.GetDBStatusQuery:
 	case *types.GetDBIndexQuery:
+	case *types.GetDBDescriptorQuery:
+	case *types.GetDBDescriptorHistoryQuery:
+	case *types.GetDBDigestQuery:
+	case *types.GetDBExportQuery:
 	case *types.GetUserQuery:
 	case *types.GetBlockQuery:
 	case *types.GetLastBlockQuery:
@@ -26,7 +38,22 @@ func SignQuery(querySigner crypto.Signer, query interface{}) ([]byte, error) {
 	case *types.GetNodeConfigQuery:
 	case *types.GetTxProofQuery:
 	case *types.GetTxReceiptQuery:
+	case *types.GetTxWriteSetDigestQuery:
+	case *types.GetDroppedTxQuery:
+	case *types.GetDroppedTxsQuery:
+	case *types.GetLedgerRollupsQuery:
+	case *types.GetLedgerUsageQuery:
+	case *types.GetKeyBlocksQuery:
+	case *types.GetBlockCompositionQuery:
+	case *types.GetTrustedCheckpointsQuery:
+	case *types.GetLogLevelsQuery:
+	case *types.SetLogLevelsQuery:
+	case *types.GetStateMigrationQuery:
+	case *types.StateMigrationQuery:
+	case *types.GetStateScrubQuery:
+	case *types.StateScrubQuery:
 	case *types.GetHistoricalDataQuery:
+	case *types.GetDataByVersionQuery:
 	case *types.GetDataReadersQuery:
 	case *types.GetDataWritersQuery:
 	case *types.GetDataReadByQuery:
@@ -36,6 +63,12 @@ func SignQuery(querySigner crypto.Signer, query interface{}) ([]byte, error) {
 	case *types.GetMostRecentUserOrNodeQuery:
 	case *types.GetDataProofQuery:
 	case *types.DataJSONQuery:
+	case *types.SubscribeKeysQuery:
+	case *types.GetStorageStatsQuery:
+	case *types.TraceValidationQuery:
+	case *types.AcceptPeerHeaderQuery:
+	case *types.ResyncDBQuery:
+	case *types.GetAdminAuditRecordsQuery:
 
 	default:
 		return nil, errors.Errorf("unknown query type: %T", v)
@@ -49,7 +82,11 @@ func SignTx(txSigner crypto.Signer, tx interface{}) ([]byte, error) {
 	case *types.ConfigTx:
 	case *types.DataTx:
 	case *types.UserAdministrationTx:
+	case *types.UserImportRequest:
+	case *types.DBImportRequest:
 	case *types.DBAdministrationTx:
+	case *types.HeartbeatTx:
+	case *types.VoidTx:
 
 	default:
 		return nil, errors.Errorf("unknown transaction type: %T", v)
@@ -4,8 +4,11 @@ package cryptoservice
 
 import (
 	"crypto/x509"
+	"strings"
 
 	"github.com/hyperledger-labs/orion-server/pkg/logger"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
 
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 )
@@ -44,3 +47,24 @@ func (sv *SignatureVerifier) Verify(userID string, signature, body []byte) error
 	}
 	return err
 }
+
+// VerifyNodeSignature verifies a signature of the node over the content of the block `blockNum`, e.g., a heartbeat of
+// the node. While the certificate of the node is being rotated, the signature is accepted if either the previous or
+// the current certificate verifies it for the blocks numbered below the cutover block, and only if the current
+// certificate verifies it from the cutover block on. See types.NodeCertRotationConfig.
+func VerifyNodeSignature(node *types.NodeConfig, blockNum uint64, signature, body []byte) error {
+	var errs []string
+	for _, cert := range node.CertificatesAt(blockNum) {
+		verifier, err := crypto.NewVerifier(cert)
+		if err != nil {
+			errs = append(errs, "the certificate cannot be parsed: "+err.Error())
+			continue
+		}
+		if err = verifier.Verify(body, signature); err == nil {
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
@@ -10,10 +10,29 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// The modules of the server whose logging levels are set apart from each other at runtime
+const (
+	ModuleReorderer = "reorderer"
+	ModuleCreator   = "creator"
+	ModuleValidator = "validator"
+	ModuleCommitter = "committer"
+	ModuleHTTP      = "http"
+)
+
 type SugarLogger struct {
 	*zap.SugaredLogger
-	conf  zap.Config
-	mutex sync.RWMutex
+	// base is the logger before it is filtered by the level, from which the module loggers are derived
+	base    *zap.Logger
+	level   zap.AtomicLevel
+	modules *moduleRegistry
+	mutex   sync.RWMutex
+}
+
+// moduleRegistry holds the module loggers derived from a logger, so that their levels can be read and set by the
+// name of the module
+type moduleRegistry struct {
+	mutex   sync.RWMutex
+	loggers map[string]*SugarLogger
 }
 
 type Config struct {
@@ -31,8 +50,10 @@ func New(c *Config, opts ...zap.Option) (*SugarLogger, error) {
 	}
 
 	logCfg := zap.Config{
-		Encoding:         c.Encoding,
-		Level:            zap.NewAtomicLevelAt(logLevel),
+		Encoding: c.Encoding,
+		// the core admits every level, while each logger filters the entries by its own level, so that the level of
+		// a module logger can be lower than the level of the logger it is derived from
+		Level:            zap.NewAtomicLevelAt(zapcore.DebugLevel),
 		OutputPaths:      c.OutputPath,
 		ErrorOutputPaths: c.ErrOutputPath,
 		EncoderConfig: zapcore.EncoderConfig{
@@ -56,23 +77,37 @@ func New(c *Config, opts ...zap.Option) (*SugarLogger, error) {
 	}
 
 	l, err := logCfg.Build()
+	if err != nil {
+		return nil, errors.Wrap(err, "error while creating a logger")
+	}
 	if len(opts) > 0 {
 		l = l.WithOptions(opts...)
 	}
 
-	if err != nil {
-		return nil, errors.Wrap(err, "error while creating a logger")
-	}
+	return newSugarLogger(l.Named(c.Name), zap.NewAtomicLevelAt(logLevel), &moduleRegistry{
+		loggers: make(map[string]*SugarLogger),
+	}), nil
+}
+
+func newSugarLogger(base *zap.Logger, level zap.AtomicLevel, modules *moduleRegistry) *SugarLogger {
+	filtered := base.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &levelFilterCore{Core: core, level: level}
+	}))
 
 	return &SugarLogger{
-		SugaredLogger: l.Named(c.Name).Sugar(),
-		conf:          logCfg,
-	}, nil
+		SugaredLogger: filtered.Sugar(),
+		base:          base,
+		level:         level,
+		modules:       modules,
+	}
 }
 
 func (l *SugarLogger) With(args ...interface{}) *SugarLogger {
 	return &SugarLogger{
 		SugaredLogger: l.SugaredLogger.With(args...),
+		base:          l.base,
+		level:         l.level,
+		modules:       l.modules,
 	}
 }
 
@@ -84,11 +119,85 @@ func (l *SugarLogger) SetLogLevel(level string) error {
 		return err
 	}
 
-	l.conf.Level.SetLevel(logLevel)
+	l.level.SetLevel(logLevel)
 
 	return nil
 }
 
+// Module returns the logger of a module, named after the module, whose level is set apart from the level of this
+// logger and of the other modules by SetModuleLevels. The logger of a module starts at the current level of this
+// logger, and is created once, hence, the components of the same module share it.
+func (l *SugarLogger) Module(name string) *SugarLogger {
+	l.modules.mutex.Lock()
+	defer l.modules.mutex.Unlock()
+
+	if m, ok := l.modules.loggers[name]; ok {
+		return m
+	}
+	m := newSugarLogger(l.base.Named(name), zap.NewAtomicLevelAt(l.level.Level()), l.modules)
+	l.modules.loggers[name] = m
+	return m
+}
+
+// ModuleLevels returns the level of every module logger, by the name of the module
+func (l *SugarLogger) ModuleLevels() map[string]string {
+	l.modules.mutex.RLock()
+	defer l.modules.mutex.RUnlock()
+
+	levels := make(map[string]string, len(l.modules.loggers))
+	for name, m := range l.modules.loggers {
+		levels[name] = levelName(m.level.Level())
+	}
+	return levels
+}
+
+// SetModuleLevels sets the levels of the given modules. The modules and the levels are all checked before any level
+// is set, hence, either all the levels are set or none is, and a concurrent ModuleLevels observes either all the
+// levels before the change or all of them after it.
+func (l *SugarLogger) SetModuleLevels(levels map[string]string) error {
+	l.modules.mutex.Lock()
+	defer l.modules.mutex.Unlock()
+
+	zapLevels := make(map[*SugarLogger]zapcore.Level, len(levels))
+	for name, level := range levels {
+		m, ok := l.modules.loggers[name]
+		if !ok {
+			return errors.Errorf("unknown logging module [%s]", name)
+		}
+		zapLevel, err := getZapLogLevel(level)
+		if err != nil {
+			return errors.WithMessagef(err, "error while setting the level of the logging module [%s]", name)
+		}
+		zapLevels[m] = zapLevel
+	}
+
+	for m, zapLevel := range zapLevels {
+		m.level.SetLevel(zapLevel)
+	}
+	return nil
+}
+
+// levelFilterCore filters the entries written to a core that admits every level by the level of a single logger
+type levelFilterCore struct {
+	zapcore.Core
+	level zap.AtomicLevel
+}
+
+func (c *levelFilterCore) Enabled(level zapcore.Level) bool {
+	return c.level.Enabled(level)
+}
+
+func (c *levelFilterCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelFilterCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c *levelFilterCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.level.Enabled(entry.Level) {
+		return checked
+	}
+	return c.Core.Check(entry, checked)
+}
+
 func getZapLogLevel(level string) (zapcore.Level, error) {
 	var logLevel zapcore.Level
 
@@ -113,6 +222,22 @@ func getZapLogLevel(level string) (zapcore.Level, error) {
 	return logLevel, nil
 }
 
+// levelName returns the name of a level as accepted by getZapLogLevel
+func levelName(level zapcore.Level) string {
+	switch level {
+	case zapcore.DebugLevel:
+		return "debug"
+	case zapcore.InfoLevel:
+		return "info"
+	case zapcore.WarnLevel:
+		return "warn"
+	case zapcore.ErrorLevel:
+		return "err"
+	default:
+		return "panic"
+	}
+}
+
 func (l *SugarLogger) Warning(v ...interface{}) {
 	l.Warn(v...)
 }
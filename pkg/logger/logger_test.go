@@ -222,7 +222,7 @@ func TestDynamicLogger(t *testing.T) {
 
 			require.NoError(t, l.SetLogLevel(tt.newLevel))
 			level, _ := getZapLogLevel(tt.newLevel)
-			require.True(t, l.conf.Level.Enabled(level))
+			require.True(t, l.level.Enabled(level))
 
 			logStatements(l)
 			require.NoError(t, l.Sync())
@@ -241,6 +241,72 @@ func TestDynamicLogger(t *testing.T) {
 	}
 }
 
+func TestModuleLoggers(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "logger-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	logFile := path.Join(testDir, "modules.txt")
+	l, err := New(&Config{
+		Level:         "info",
+		OutputPath:    []string{logFile},
+		ErrOutputPath: []string{logFile},
+		Encoding:      "console",
+		Name:          "node1",
+	})
+	require.NoError(t, err)
+
+	committer := l.Module(ModuleCommitter)
+	reorderer := l.Module(ModuleReorderer)
+	require.Same(t, committer, l.Module(ModuleCommitter))
+	require.Equal(t, map[string]string{ModuleCommitter: "info", ModuleReorderer: "info"}, l.ModuleLevels())
+
+	readLog := func() string {
+		require.NoError(t, l.Sync())
+		content, err := ioutil.ReadFile(logFile)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(logFile, 0))
+		return string(content)
+	}
+
+	committer.Debug("committer debug message is logged")
+	reorderer.Debug("reorderer debug message is logged")
+	committer.With("block", 1).Info("committer info message is logged")
+	content := readLog()
+	require.NotContains(t, content, "debug message is logged")
+	require.Contains(t, content, "node1.committer")
+	require.Contains(t, content, "committer info message is logged")
+
+	// the committer is flipped to debug while the other modules, and the logger they derive from, stay at info
+	require.NoError(t, l.SetModuleLevels(map[string]string{ModuleCommitter: "debug"}))
+	require.Equal(t, map[string]string{ModuleCommitter: "debug", ModuleReorderer: "info"}, l.ModuleLevels())
+
+	committer.Debug("committer debug message is logged")
+	committer.With("block", 2).Debug("committer derived debug message is logged")
+	reorderer.Debug("reorderer debug message is logged")
+	l.Debug("root debug message is logged")
+	content = readLog()
+	require.Contains(t, content, "committer debug message is logged")
+	require.Contains(t, content, "committer derived debug message is logged")
+	require.NotContains(t, content, "reorderer debug message is logged")
+	require.NotContains(t, content, "root debug message is logged")
+
+	// a change with an unknown module or level sets none of the levels
+	err = l.SetModuleLevels(map[string]string{ModuleReorderer: "debug", "unknown": "debug"})
+	require.EqualError(t, err, "unknown logging module [unknown]")
+	err = l.SetModuleLevels(map[string]string{ModuleReorderer: "debug", ModuleCommitter: "verbose"})
+	require.EqualError(t, err, "error while setting the level of the logging module [committer]: unrecognized log level [verbose]. Only debug, info, warn, error, and panic log levels are supported")
+	require.Equal(t, map[string]string{ModuleCommitter: "debug", ModuleReorderer: "info"}, l.ModuleLevels())
+
+	// a module created after the levels are set starts at the level of the logger it derives from
+	require.NoError(t, l.SetLogLevel("warn"))
+	l.Module(ModuleHTTP).Info("http info message is logged")
+	require.Equal(t, "warn", l.ModuleLevels()[ModuleHTTP])
+	require.NotContains(t, readLog(), "http info message is logged")
+}
+
 func TestGetZapLogLevel(t *testing.T) {
 	t.Parallel()
 
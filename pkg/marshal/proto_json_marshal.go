@@ -39,3 +39,9 @@ func (o DefaultMarshal) Marshal(m proto.Message) ([]byte, error) {
 	}
 	return compactedPayloadBytes.Bytes(), nil
 }
+
+// DeterministicMarshal returns the deterministic protobuf encoding of the message, in which the entries of the maps
+// are ordered by key. The node signs the responses over this encoding.
+func DeterministicMarshal(m proto.Message) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(m)
+}
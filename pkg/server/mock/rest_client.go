@@ -3,6 +3,7 @@
 package mock
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/tls"
@@ -92,6 +93,39 @@ func (c *Client) GetDBIndex(e *types.GetDBIndexQueryEnvelope) (*types.GetDBIndex
 	return res, err
 }
 
+// ExportDB returns the records of the export of a database, in the order of the stream
+func (c *Client) ExportDB(e *types.GetDBExportQueryEnvelope) ([]*types.DBExportRecord, error) {
+	resp, err := c.handleGetRequest(
+		constants.URLForGetDBExport(e.Payload.DbName, e.Payload.Provenance),
+		e.Payload.UserId,
+		e.Signature,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	var records []*types.DBExportRecord
+	reader := bufio.NewReader(resp.Body)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			record := &types.DBExportRecord{}
+			if err := protojson.Unmarshal(line, record); err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
 func (c *Client) GetData(e *types.GetDataQueryEnvelope) (*types.GetDataResponseEnvelope, error) {
 	resp, err := c.handleGetRequest(
 		constants.URLForGetData(e.Payload.DbName, e.Payload.Key),
@@ -309,6 +343,23 @@ func (c *Client) GetClusterStatus(e *types.GetClusterStatusQueryEnvelope) (*type
 	return res, err
 }
 
+func (c *Client) GetSessionBootstrap(e *types.GetSessionBootstrapQueryEnvelope) (*types.GetSessionBootstrapResponseEnvelope, error) {
+	resp, err := c.handleGetRequest(
+		constants.GetSessionBootstrap,
+		e.Payload.UserId,
+		e.Signature,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while issuing "+constants.GetSessionBootstrap)
+	}
+
+	defer resp.Body.Close()
+
+	res := &types.GetSessionBootstrapResponseEnvelope{}
+	err = unMarshalResponse(resp, res)
+	return res, err
+}
+
 func (c *Client) GetConfig(e *types.GetConfigQueryEnvelope) (*types.GetConfigResponseEnvelope, error) {
 	resp, err := c.handleGetRequest(
 		constants.URLForGetConfig(),
@@ -360,6 +411,23 @@ func (c *Client) GetHistoricalData(urlPath string, e *types.GetHistoricalDataQue
 	return res, err
 }
 
+func (c *Client) GetDataByVersion(e *types.GetDataByVersionQueryEnvelope) (*types.GetDataByVersionResponseEnvelope, error) {
+	resp, err := c.handleGetRequest(
+		constants.URLForGetDataByVersion(e.Payload.DbName, e.Payload.Key, e.Payload.Version),
+		e.Payload.UserId,
+		e.Signature,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	res := &types.GetDataByVersionResponseEnvelope{}
+	err = unMarshalResponse(resp, res)
+	return res, err
+}
+
 func (c *Client) GetDataReadByUser(urlPath string, e *types.GetDataReadByQueryEnvelope) (*types.GetDataProvenanceResponseEnvelope, error) {
 	resp, err := c.handleGetRequest(
 		urlPath,
@@ -32,6 +32,10 @@ type BCDBHTTPServer struct {
 
 // New creates a object of BCDBHTTPServer
 func New(conf *config.Configurations) (*BCDBHTTPServer, error) {
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
 	c := &logger.Config{
 		Level:         conf.LocalConfig.Server.LogLevel,
 		OutputPath:    []string{"stdout"},
@@ -49,13 +53,20 @@ func New(conf *config.Configurations) (*BCDBHTTPServer, error) {
 		return nil, errors.Wrap(err, "error while creating the database object")
 	}
 
+	httpLogger := lg.Module(logger.ModuleHTTP)
+	queryLimiter := httphandler.NewQueryLimiter(&conf.LocalConfig.Server.QueryConcurrency, httpLogger)
+	db.OnCommitPressure(queryLimiter.SetCommitPressure)
 	mux := http.NewServeMux()
-	mux.Handle(constants.UserEndpoint, httphandler.NewUsersRequestHandler(db, lg))
-	mux.Handle(constants.DataEndpoint, httphandler.NewDataRequestHandler(db, lg))
-	mux.Handle(constants.DBEndpoint, httphandler.NewDBRequestHandler(db, lg))
-	mux.Handle(constants.ConfigEndpoint, httphandler.NewConfigRequestHandler(db, lg))
-	mux.Handle(constants.LedgerEndpoint, httphandler.NewLedgerRequestHandler(db, lg))
-	mux.Handle(constants.ProvenanceEndpoint, httphandler.NewProvenanceRequestHandler(db, lg))
+	mux.Handle(constants.UserEndpoint, httphandler.NewUsersRequestHandler(db, httpLogger))
+	mux.Handle(constants.DataEndpoint, httphandler.NewDataRequestHandler(db, httpLogger))
+	mux.Handle(constants.DBEndpoint, httphandler.NewDBRequestHandler(db, httpLogger))
+	mux.Handle(constants.ConfigEndpoint, httphandler.NewConfigRequestHandler(db, httpLogger))
+	mux.Handle(constants.LedgerEndpoint, httphandler.NewLedgerRequestHandler(db, httpLogger))
+	mux.Handle(constants.ProvenanceEndpoint, httphandler.NewProvenanceRequestHandler(db, httpLogger))
+	mux.Handle(constants.AdminEndpoint, httphandler.NewAdminRequestHandler(db, queryLimiter, httpLogger))
+	mux.Handle(constants.ClusterEndpoint, httphandler.NewClusterRequestHandler(db, httpLogger))
+	mux.Handle(constants.SessionEndpoint, httphandler.NewSessionRequestHandler(db, httpLogger))
+	versioned := httphandler.VersionAPI(queryLimiter.Limit(httphandler.AuthenticateAccessTokens(mux, db)))
 
 	netConf := conf.LocalConfig.Server.Network
 	addr := fmt.Sprintf("%s:%d", netConf.Address, netConf.Port)
@@ -66,8 +77,12 @@ func New(conf *config.Configurations) (*BCDBHTTPServer, error) {
 		return nil, errors.Wrapf(err, "error while creating a tcp listener on: %s", addr)
 	}
 
+	maxTxSizeBytes := conf.LocalConfig.BlockCreation.MaxTxSizeBytes
+	if maxTxSizeBytes == 0 {
+		maxTxSizeBytes = config.DefaultMaxTxSizeBytes
+	}
 	server := &http.Server{
-		Handler: mux,
+		Handler: httphandler.LimitRequestBody(versioned, httphandler.MaxRequestBodySize(maxTxSizeBytes)),
 	}
 
 	if conf.LocalConfig.Server.TLS.Enabled {
@@ -115,7 +130,7 @@ func New(conf *config.Configurations) (*BCDBHTTPServer, error) {
 
 	return &BCDBHTTPServer{
 		db:      db,
-		handler: mux,
+		handler: versioned,
 		listen:  netListener,
 		server:  server,
 		conf:    conf,
@@ -171,14 +186,16 @@ func (s *BCDBHTTPServer) Stop() error {
 
 	var errR error
 
+	// The database is shut down while the server is still listening, so that submissions are rejected with a clear
+	// error, and the progress of the shutdown is exposed by the cluster status.
 	s.logger.Infof("Stopping the server listening on: %s\n", s.listen.Addr().String())
-	if err := s.server.Close(); err != nil {
-		s.logger.Errorf("Failure while closing the http server: %s", err)
+	if err := s.db.Close(); err != nil {
+		s.logger.Errorf("Failure while closing the database: %s", err)
 		errR = err
 	}
 
-	if err := s.db.Close(); err != nil {
-		s.logger.Errorf("Failure while closing the database: %s", err)
+	if err := s.server.Close(); err != nil {
+		s.logger.Errorf("Failure while closing the http server: %s", err)
 		errR = err
 	}
 	return errR
@@ -25,11 +25,12 @@ import (
 	"github.com/hyperledger-labs/orion-server/pkg/constants"
 	"github.com/hyperledger-labs/orion-server/pkg/crypto"
 	"github.com/hyperledger-labs/orion-server/pkg/cryptoservice"
-	"github.com/hyperledger-labs/orion-server/pkg/marshal"
 	"github.com/hyperledger-labs/orion-server/pkg/server/mock"
 	"github.com/hyperledger-labs/orion-server/pkg/server/testutils"
 	"github.com/hyperledger-labs/orion-server/pkg/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 var basePort uint32 = 5100
@@ -411,9 +412,7 @@ func TestServerWithDataRequestAndProvenanceQueries(t *testing.T) {
 	require.NotNil(t, data)
 	require.NotNil(t, data.Response)
 
-	resp, err := marshal.DefaultMarshaler().Marshal(data.GetResponse())
-	require.NoError(t, err)
-	err = verifier.Verify(resp, data.GetSignature())
+	err = verifier.Verify(data.GetResponseBytes(), data.GetSignature())
 	require.NoError(t, err)
 
 	require.Nil(t, data.GetResponse().GetValue())
@@ -466,10 +465,7 @@ func TestServerWithDataRequestAndProvenanceQueries(t *testing.T) {
 			return false
 		}
 
-		dataB, err := marshal.DefaultMarshaler().Marshal(data.GetResponse())
-		require.NoError(t, err)
-
-		err = verifier.Verify(dataB, data.GetSignature())
+		err = verifier.Verify(data.GetResponseBytes(), data.GetSignature())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -493,10 +489,7 @@ func TestServerWithDataRequestAndProvenanceQueries(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	valuesB, err := marshal.DefaultMarshaler().Marshal(values.GetResponse())
-	require.NoError(t, err)
-
-	err = verifier.Verify(valuesB, values.GetSignature())
+	err = verifier.Verify(values.GetResponseBytes(), values.GetSignature())
 	require.NoError(t, err)
 
 	require.Len(t, values.GetResponse().GetValues(), 1)
@@ -527,9 +520,7 @@ func TestServerWithDataRequestAndProvenanceOff(t *testing.T) {
 	require.NotNil(t, data)
 	require.NotNil(t, data.Response)
 
-	resp, err := marshal.DefaultMarshaler().Marshal(data.GetResponse())
-	require.NoError(t, err)
-	err = verifier.Verify(resp, data.GetSignature())
+	err = verifier.Verify(data.GetResponseBytes(), data.GetSignature())
 	require.NoError(t, err)
 
 	require.Nil(t, data.GetResponse().GetValue())
@@ -582,10 +573,7 @@ func TestServerWithDataRequestAndProvenanceOff(t *testing.T) {
 			return false
 		}
 
-		dataB, err := marshal.DefaultMarshaler().Marshal(data.GetResponse())
-		require.NoError(t, err)
-
-		err = verifier.Verify(dataB, data.GetSignature())
+		err = verifier.Verify(data.GetResponseBytes(), data.GetSignature())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -681,10 +669,7 @@ func TestServerWithUserAdminRequest(t *testing.T) {
 			return false
 		}
 
-		userB, err := marshal.DefaultMarshaler().Marshal(user.GetResponse())
-		require.NoError(t, err)
-
-		err = verifier.Verify(userB, user.GetSignature())
+		err = verifier.Verify(user.GetResponseBytes(), user.GetSignature())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -698,6 +683,348 @@ func TestServerWithUserAdminRequest(t *testing.T) {
 	}, time.Minute, 100*time.Millisecond)
 }
 
+func TestServerWithUserImportRequest(t *testing.T) {
+	env := newServerTestEnv(t, false, false, false)
+	defer env.cleanup(t)
+	// the users are split into transactions that fit in a block
+	env.serverConfig.LocalConfig.BlockCreation.MaxBlockSize = 1024 * 1024
+	env.restart(t)
+
+	userCert, _, err := testutils.IssueCertificate("Orion User", "127.0.0.1", env.caKeys)
+	require.NoError(t, err)
+	certBlock, _ := pem.Decode(userCert)
+
+	const numUsers = 5000
+	var users []*types.User
+	for i := 0; i < numUsers; i++ {
+		users = append(users, &types.User{
+			Id:          fmt.Sprintf("user%04d", i),
+			Certificate: certBlock.Bytes,
+			Privilege: &types.Privilege{
+				DbPermission: map[string]types.Privilege_Access{
+					worldstate.DefaultDBName: types.Privilege_Read,
+				},
+			},
+		})
+	}
+	// two more users fail the checks of the node
+	users = append(users,
+		&types.User{Id: "badCertUser", Certificate: []byte("not a certificate")},
+		&types.User{Id: "user0000", Certificate: certBlock.Bytes},
+	)
+
+	request, err := types.NewUserImportRequest("admin", uuid.New().String(), users)
+	require.NoError(t, err)
+	httpResp, err := env.client.SubmitTransaction(
+		constants.PostUserImport,
+		&types.UserImportRequestEnvelope{
+			Payload:   request,
+			Signature: testutils.SignatureFromTx(t, env.adminSigner, request),
+			Users:     users,
+		},
+		30*time.Second,
+	)
+	require.NoError(t, err)
+	defer httpResp.Body.Close()
+	require.Equal(t, http.StatusOK, httpResp.StatusCode)
+	body, err := ioutil.ReadAll(httpResp.Body)
+	require.NoError(t, err)
+	importResp := &types.UserImportResponseEnvelope{}
+	require.NoError(t, protojson.Unmarshal(body, importResp))
+
+	verifier, err := env.getNodeSigVerifier(t)
+	require.NoError(t, err)
+	require.NoError(t, verifier.Verify(importResp.GetResponseBytes(), importResp.GetSignature()))
+
+	txIDs := importResp.GetResponse().GetTxIds()
+	require.Greater(t, len(txIDs), 1)
+	failures := importResp.GetResponse().GetFailures()
+	require.Len(t, failures, 2)
+	require.Equal(t, "badCertUser", failures[0].GetUserId())
+	require.Equal(t, uint32(numUsers), failures[0].GetIndex())
+	require.Contains(t, failures[0].GetReason(), "the certificate of the user is invalid")
+	require.Equal(t, "user0000", failures[1].GetUserId())
+	require.Equal(t, uint32(numUsers+1), failures[1].GetIndex())
+	require.Equal(t, "the user appears more than once in the import request", failures[1].GetReason())
+
+	// the transactions of the import are committed in blocks of their own
+	blockNums := make(map[uint64]bool)
+	for _, txID := range txIDs {
+		query := &types.GetTxReceiptQuery{UserId: "admin", TxId: txID}
+		receipt, err := env.client.GetTxReceipt(&types.GetTxReceiptQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, env.adminSigner, query),
+		})
+		require.NoError(t, err)
+		header := receipt.GetResponse().GetReceipt().GetHeader()
+		require.Equal(t, types.Flag_VALID, header.GetValidationInfo()[0].GetFlag())
+		blockNums[header.GetBaseHeader().GetNumber()] = true
+	}
+	require.Len(t, blockNums, len(txIDs))
+
+	for i := 0; i < numUsers; i++ {
+		query := &types.GetUserQuery{UserId: "admin", TargetUserId: users[i].Id}
+		user, err := env.client.GetUser(&types.GetUserQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, env.adminSigner, query),
+		})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(users[i], user.GetResponse().GetUser()), "user %d", i)
+	}
+
+	query := &types.GetUserQuery{UserId: "admin", TargetUserId: "badCertUser"}
+	user, err := env.client.GetUser(&types.GetUserQueryEnvelope{
+		Payload:   query,
+		Signature: testutils.SignatureFromQuery(t, env.adminSigner, query),
+	})
+	require.NoError(t, err)
+	require.Nil(t, user.GetResponse().GetUser())
+}
+
+func TestServerWithDBExportAndImport(t *testing.T) {
+	source := newServerTestEnv(t, false, false, false)
+	defer source.cleanup(t)
+	target := newServerTestEnv(t, false, false, false)
+	defer target.cleanup(t)
+	// the keys are split into transactions that fit in a block
+	target.serverConfig.LocalConfig.BlockCreation.MaxBlockSize = 1024 * 1024
+	target.restart(t)
+
+	submit := func(env *serverTestEnv, urlPath string, txEnv proto.Message) {
+		httpResp, err := env.client.SubmitTransaction(urlPath, txEnv, 30*time.Second)
+		require.NoError(t, err)
+		defer httpResp.Body.Close()
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+	}
+	addUsers := func(env *serverTestEnv, users ...*types.User) map[string]crypto.Signer {
+		signers := make(map[string]crypto.Signer)
+		userTx := &types.UserAdministrationTx{TxId: uuid.New().String(), UserId: "admin"}
+		for _, user := range users {
+			userCert, userKey, err := testutils.IssueCertificate("Orion User "+user.Id, "127.0.0.1", env.caKeys)
+			require.NoError(t, err)
+			keyPath := path.Join(env.tempDir, user.Id+".key")
+			require.NoError(t, os.WriteFile(keyPath, userKey, 0666))
+			signers[user.Id], err = crypto.NewSigner(&crypto.SignerOptions{Identity: user.Id, KeyFilePath: keyPath})
+			require.NoError(t, err)
+
+			certBlock, _ := pem.Decode(userCert)
+			user.Certificate = certBlock.Bytes
+			userTx.UserWrites = append(userTx.UserWrites, &types.UserWrite{User: user})
+		}
+		submit(env, constants.PostUserTx, &types.UserAdministrationTxEnvelope{
+			Payload:   userTx,
+			Signature: testutils.SignatureFromTx(t, env.adminSigner, userTx),
+		})
+		return signers
+	}
+	writeKey := func(env *serverTestEnv, dbName, key string, value []byte, acl *types.AccessControl) {
+		dataTx := &types.DataTx{
+			MustSignUserIds: []string{"admin"},
+			TxId:            uuid.New().String(),
+			DbOperations: []*types.DBOperation{
+				{
+					DbName:     dbName,
+					DataWrites: []*types.DataWrite{{Key: key, Value: value, Acl: acl}},
+				},
+			},
+		}
+		submit(env, constants.PostDataTx, &types.DataTxEnvelope{
+			Payload:    dataTx,
+			Signatures: map[string][]byte{"admin": testutils.SignatureFromTx(t, env.adminSigner, dataTx)},
+		})
+	}
+	getData := func(env *serverTestEnv, signer crypto.Signer, userID, dbName, key string) (*types.GetDataResponse, error) {
+		query := &types.GetDataQuery{UserId: userID, DbName: dbName, Key: key}
+		data, err := env.client.GetData(&types.GetDataQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, signer, query),
+		})
+		return data.GetResponse(), err
+	}
+	exportDB := func(env *serverTestEnv, dbName string) []*types.DBExportRecord {
+		query := &types.GetDBExportQuery{UserId: "admin", DbName: dbName, Provenance: true}
+		records, err := env.client.ExportDB(&types.GetDBExportQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, env.adminSigner, query),
+		})
+		require.NoError(t, err)
+		return records
+	}
+	getIndex := func(env *serverTestEnv, dbName string) string {
+		query := &types.GetDBIndexQuery{UserId: "admin", DbName: dbName}
+		index, err := env.client.GetDBIndex(&types.GetDBIndexQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, env.adminSigner, query),
+		})
+		require.NoError(t, err)
+		return index.GetResponse().GetIndex()
+	}
+
+	dbTx := &types.DBAdministrationTx{
+		TxId:      uuid.New().String(),
+		UserId:    "admin",
+		CreateDbs: []string{"sourceDB"},
+		DbsIndex: map[string]*types.DBIndex{
+			"sourceDB": {AttributeAndType: map[string]types.IndexAttributeType{"age": types.IndexAttributeType_NUMBER}},
+		},
+	}
+	submit(source, constants.PostDBTx, &types.DBAdministrationTxEnvelope{
+		Payload:   dbTx,
+		Signature: testutils.SignatureFromTx(t, source.adminSigner, dbTx),
+	})
+	addUsers(source,
+		&types.User{Id: "alice", Privilege: &types.Privilege{DbPermission: map[string]types.Privilege_Access{"sourceDB": types.Privilege_ReadWrite}}},
+		&types.User{Id: "bob", Privilege: &types.Privilege{DbPermission: map[string]types.Privilege_Access{"sourceDB": types.Privilege_Read}}},
+	)
+	writeKey(source, "sourceDB", "k1", []byte(`{"age":25}`), nil)
+	writeKey(source, "sourceDB", "k1", []byte(`{"age":30}`), &types.AccessControl{
+		ReadWriteUsers: map[string]bool{"alice": true},
+		ReadUsers:      map[string]bool{"bob": true},
+	})
+	writeKey(source, "sourceDB", "k2", []byte(`{"age":40}`), &types.AccessControl{
+		ReadWriteUsers: map[string]bool{"alice": true},
+	})
+	writeKey(source, "sourceDB", "k3", []byte(`{"age":50}`), nil)
+
+	records := exportDB(source, "sourceDB")
+	manifest, kvs, err := types.VerifyDBExport(records)
+	require.NoError(t, err)
+	require.Equal(t, "sourceDB", manifest.GetDbName())
+	require.True(t, manifest.GetProvenance())
+	require.Equal(t, map[string]types.IndexAttributeType{"age": types.IndexAttributeType_NUMBER}, manifest.GetIndex().GetAttributeAndType())
+	require.Len(t, kvs, 3)
+	require.Equal(t, "k1", kvs[0].GetKey())
+	require.Equal(t, []byte(`{"age":30}`), kvs[0].GetValue())
+	require.Len(t, kvs[0].GetHistory(), 1)
+	require.Equal(t, []byte(`{"age":25}`), kvs[0].GetHistory()[0].GetValue())
+	require.Equal(t, "k2", kvs[1].GetKey())
+	require.Empty(t, kvs[1].GetHistory())
+	require.Equal(t, "k3", kvs[2].GetKey())
+
+	// the users named by the access control of the keys exist on the target before the import
+	targetSigners := addUsers(target, &types.User{Id: "alice"}, &types.User{Id: "bob"})
+
+	importRequest := func(records []*types.DBExportRecord) *types.DBImportRequestEnvelope {
+		request, err := types.NewDBImportRequest("admin", uuid.New().String(), "targetDB", manifest)
+		require.NoError(t, err)
+		return &types.DBImportRequestEnvelope{
+			Payload:   request,
+			Signature: testutils.SignatureFromTx(t, target.adminSigner, request),
+			Records:   records,
+		}
+	}
+
+	t.Run("a tampered export is rejected", func(t *testing.T) {
+		tampered := proto.Clone(records[2]).(*types.DBExportRecord)
+		tampered.GetKv().Value = []byte(`{"age":41}`)
+		httpResp, err := target.client.SubmitTransaction(constants.PostDBImport,
+			importRequest([]*types.DBExportRecord{records[0], records[1], tampered, records[3], records[4]}), 30*time.Second)
+		require.NoError(t, err)
+		defer httpResp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, httpResp.StatusCode)
+		body, err := ioutil.ReadAll(httpResp.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), "the checksum of the export does not match its trailer")
+	})
+
+	importEnv := importRequest(records)
+	httpResp, err := target.client.SubmitTransaction(constants.PostDBImport, importEnv, 30*time.Second)
+	require.NoError(t, err)
+	defer httpResp.Body.Close()
+	require.Equal(t, http.StatusOK, httpResp.StatusCode)
+	body, err := ioutil.ReadAll(httpResp.Body)
+	require.NoError(t, err)
+	importResp := &types.DBImportResponseEnvelope{}
+	require.NoError(t, protojson.Unmarshal(body, importResp))
+
+	verifier, err := target.getNodeSigVerifier(t)
+	require.NoError(t, err)
+	require.NoError(t, verifier.Verify(importResp.GetResponseBytes(), importResp.GetSignature()))
+	require.Equal(t, "targetDB", importResp.GetResponse().GetDbName())
+	require.Equal(t, uint64(3), importResp.GetResponse().GetKvCount())
+	txIDs := importResp.GetResponse().GetTxIds()
+	require.GreaterOrEqual(t, len(txIDs), 2)
+	for i, txID := range txIDs {
+		require.Equal(t, fmt.Sprintf("%s-%d", importEnv.Payload.ImportId, i), txID)
+	}
+
+	// a transaction which carries an import proof is not accepted from a client
+	replayedTx := &types.DataTx{
+		MustSignUserIds: []string{"admin"},
+		TxId:            importEnv.Payload.ImportId + "-100",
+		DbOperations: []*types.DBOperation{
+			{DbName: "targetDB", DataWrites: []*types.DataWrite{{Key: "k4", Value: []byte(`{"age":60}`)}}},
+		},
+	}
+	httpResp, err = target.client.SubmitTransaction(constants.PostDataTx, &types.DataTxEnvelope{
+		Payload:     replayedTx,
+		ImportProof: &types.DBImportProof{Request: importEnv.Payload, Signature: importEnv.Signature},
+	}, 30*time.Second)
+	require.NoError(t, err)
+	defer httpResp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, httpResp.StatusCode)
+
+	// the users get their permissions on the imported database
+	userTx := &types.UserAdministrationTx{TxId: uuid.New().String(), UserId: "admin"}
+	for _, access := range []struct {
+		userID string
+		access types.Privilege_Access
+	}{{"alice", types.Privilege_ReadWrite}, {"bob", types.Privilege_Read}} {
+		query := &types.GetUserQuery{UserId: "admin", TargetUserId: access.userID}
+		user, err := target.client.GetUser(&types.GetUserQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, target.adminSigner, query),
+		})
+		require.NoError(t, err)
+		u := user.GetResponse().GetUser()
+		u.Privilege = &types.Privilege{DbPermission: map[string]types.Privilege_Access{"targetDB": access.access}}
+		userTx.UserWrites = append(userTx.UserWrites, &types.UserWrite{User: u})
+	}
+	submit(target, constants.PostUserTx, &types.UserAdministrationTxEnvelope{
+		Payload:   userTx,
+		Signature: testutils.SignatureFromTx(t, target.adminSigner, userTx),
+	})
+
+	// the keys keep their access control
+	data, err := getData(target, targetSigners["alice"], "alice", "targetDB", "k2")
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"age":40}`), data.GetValue())
+	_, err = getData(target, targetSigners["bob"], "bob", "targetDB", "k2")
+	require.EqualError(t, err, "error while processing 'GET /data/targetDB/k2' because the user [bob] has no permission to read key [k2] from database [targetDB]")
+	data, err = getData(target, targetSigners["bob"], "bob", "targetDB", "k1")
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"age":30}`), data.GetValue())
+
+	// the index is rebuilt on the target
+	require.Equal(t, getIndex(source, "sourceDB"), getIndex(target, "targetDB"))
+	jsonQuery := &types.DataJSONQuery{UserId: "alice", DbName: "targetDB", Query: `{"selector":{"age":{"$gt":35}}}`}
+	queryResp, err := target.client.ExecuteJSONQuery(
+		constants.URLForJSONQuery("targetDB"),
+		jsonQuery,
+		testutils.SignatureFromQuery(t, targetSigners["alice"], jsonQuery),
+	)
+	require.NoError(t, err)
+	var keys []string
+	for _, kv := range queryResp.GetResponse().GetKVs() {
+		keys = append(keys, kv.GetKey())
+	}
+	require.ElementsMatch(t, []string{"k2", "k3"}, keys)
+
+	// the target holds the same values, access control, and history
+	_, targetKVs, err := types.VerifyDBExport(exportDB(target, "targetDB"))
+	require.NoError(t, err)
+	require.Len(t, targetKVs, len(kvs))
+	for i, kv := range kvs {
+		require.Equal(t, kv.GetKey(), targetKVs[i].GetKey())
+		require.Equal(t, kv.GetValue(), targetKVs[i].GetValue())
+		require.True(t, proto.Equal(kv.GetMetadata().GetAccessControl(), targetKVs[i].GetMetadata().GetAccessControl()))
+		require.Len(t, targetKVs[i].GetHistory(), len(kv.GetHistory()))
+		for j, v := range kv.GetHistory() {
+			require.Equal(t, v.GetValue(), targetKVs[i].GetHistory()[j].GetValue())
+		}
+	}
+}
+
 func TestServerWithDBAdminRequest(t *testing.T) {
 	env := newServerTestEnv(t, false, false, false)
 	defer env.cleanup(t)
@@ -734,10 +1061,7 @@ func TestServerWithDBAdminRequest(t *testing.T) {
 			return false
 		}
 
-		dbB, err := marshal.DefaultMarshaler().Marshal(db.GetResponse())
-		require.NoError(t, err)
-
-		err = verifier.Verify(dbB, db.GetSignature())
+		err = verifier.Verify(db.GetResponseBytes(), db.GetSignature())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -791,10 +1115,7 @@ func TestServerWithDBAdminRequest(t *testing.T) {
 			return false
 		}
 
-		dataB, err := marshal.DefaultMarshaler().Marshal(data.GetResponse())
-		require.NoError(t, err)
-
-		err = verifier.Verify(dataB, data.GetSignature())
+		err = verifier.Verify(data.GetResponseBytes(), data.GetSignature())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -950,10 +1271,7 @@ func TestSyncTxWithServerTLS(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, user.GetResponse())
 
-	userBytes, err := marshal.DefaultMarshaler().Marshal(user.GetResponse())
-	require.NoError(t, err)
-
-	err = verifier.Verify(userBytes, user.GetSignature())
+	err = verifier.Verify(user.GetResponseBytes(), user.GetSignature())
 	require.NoError(t, err)
 
 	require.True(t, user.GetResponse().GetUser() != nil &&
@@ -1114,10 +1432,7 @@ func TestSyncTxWithServerAndClientTLS(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, user.GetResponse())
 
-	userBytes, err := marshal.DefaultMarshaler().Marshal(user.GetResponse())
-	require.NoError(t, err)
-
-	err = verifier.Verify(userBytes, user.GetSignature())
+	err = verifier.Verify(user.GetResponseBytes(), user.GetSignature())
 	require.NoError(t, err)
 
 	require.True(t, user.GetResponse().GetUser() != nil &&
@@ -1178,10 +1493,7 @@ func TestServerWithRestart(t *testing.T) {
 			return false
 		}
 
-		userB, err := marshal.DefaultMarshaler().Marshal(user.GetResponse())
-		require.NoError(t, err)
-
-		err = verifier.Verify(userB, user.GetSignature())
+		err = verifier.Verify(user.GetResponseBytes(), user.GetSignature())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1212,10 +1524,7 @@ func TestServerWithRestart(t *testing.T) {
 			return false
 		}
 
-		userB, err := marshal.DefaultMarshaler().Marshal(user.GetResponse())
-		require.NoError(t, err)
-
-		err = verifier.Verify(userB, user.GetSignature())
+		err = verifier.Verify(user.GetResponseBytes(), user.GetSignature())
 		if err != nil {
 			t.Fatal(err)
 		}
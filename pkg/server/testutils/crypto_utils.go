@@ -295,6 +295,22 @@ func SignedUserAdministrationTxEnvelope(t *testing.T, signer crypto.Signer, tx *
 	return env
 }
 
+func SignedHeartbeatTxEnvelope(t *testing.T, signer crypto.Signer, tx *types.HeartbeatTx) *types.HeartbeatTxEnvelope {
+	env := &types.HeartbeatTxEnvelope{
+		Payload:   tx,
+		Signature: SignatureFromTx(t, signer, tx),
+	}
+	return env
+}
+
+func SignedVoidTxEnvelope(t *testing.T, signer crypto.Signer, tx *types.VoidTx) *types.VoidTxEnvelope {
+	env := &types.VoidTxEnvelope{
+		Payload:   tx,
+		Signature: SignatureFromTx(t, signer, tx),
+	}
+	return env
+}
+
 func SignedDBAdministrationTxEnvelope(t *testing.T, signer crypto.Signer, tx *types.DBAdministrationTx) *types.DBAdministrationTxEnvelope {
 	env := &types.DBAdministrationTxEnvelope{
 		Payload:   tx,
@@ -77,6 +77,133 @@ func NewProof(path []*types.MPTrieProofElement) *Proof {
 	return &Proof{path: path}
 }
 
+// NonInclusionProof proves that a key is absent from the state, i.e., that it was never written, or that it was
+// deleted. Its path runs, like the path of Proof, from the node where the lookup of the key in the trie terminates up
+// to the root of the trie. The lookup terminates at a branch node without a child for the next nibble of the key, at
+// an extension or a value node whose key diverges from the key, or at the value node of the key, marked as deleted.
+//
+// Note that the hash of a branch node covers its children in order, but not the nibble each child is placed at. Hence,
+// a proof which terminates at a branch node without a child for the key is only as strong as that.
+type NonInclusionProof struct {
+	// Each node in path is represented the same way as in the path of Proof.
+	path []*types.MPTrieProofElement
+}
+
+// Verify checks that the path leads from the given root hash down to a node which terminates the lookup of the key,
+// and hence, that the key is absent from the state whose trie has the root hash. The key is the composite key of the
+// state, see ConstructCompositeKey. As all the keys of the trie have the same length, a value node is told apart from
+// an extension node by the length of its key.
+func (p *NonInclusionProof) Verify(key, rootHash []byte) (bool, error) {
+	pathLen := len(p.path)
+	if pathLen == 0 {
+		return false, errors.New("proof can't be empty")
+	}
+
+	rootNodeHash, err := CalcHash(p.path[pathLen-1].GetHashes())
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(rootHash, rootNodeHash) {
+		return false, nil
+	}
+
+	// walk down from the root, where each node but the last one must hold the hash of the node below it at the
+	// position given by the key
+	hexKey := convertByteToHex(key)
+	for i := pathLen - 1; i > 0; i-- {
+		hashes := p.path[i].GetHashes()
+		childHash, err := CalcHash(p.path[i-1].GetHashes())
+		if err != nil {
+			return false, err
+		}
+
+		switch {
+		case isBranchNode(hashes):
+			if len(hexKey) == 0 || !bytes.Equal(hashes[hexKey[0]], childHash) {
+				return false, nil
+			}
+			hexKey = hexKey[1:]
+		case len(hashes) == 2:
+			nodeKey := hashes[0]
+			if !isHexKey(nodeKey) || !bytes.HasPrefix(hexKey, nodeKey) || !bytes.Equal(hashes[1], childHash) {
+				return false, nil
+			}
+			hexKey = hexKey[len(nodeKey):]
+		default:
+			return false, nil
+		}
+	}
+
+	return terminatesLookup(p.path[0].GetHashes(), hexKey), nil
+}
+
+// GetPath returns the path of the proof, from the node which terminates the lookup of the key up to the root
+func (p *NonInclusionProof) GetPath() []*types.MPTrieProofElement {
+	return p.path
+}
+
+func NewNonInclusionProof(path []*types.MPTrieProofElement) *NonInclusionProof {
+	return &NonInclusionProof{path: path}
+}
+
+// terminatesLookup returns true if the lookup of the rest of a key ends at the given node without reaching a value,
+// or reaches a value which is marked as deleted
+func terminatesLookup(hashes [][]byte, hexKey []byte) bool {
+	isDeleted := len(hashes) > 0 && bytes.Equal(hashes[len(hashes)-1], KeyDeleteMarkerBytes)
+
+	if isBranchNode(hashes) {
+		if len(hexKey) == 0 {
+			// the value of a branch node follows its children
+			return len(hashes) == 16 || isDeleted
+		}
+		return len(hashes[hexKey[0]]) == 0
+	}
+
+	if len(hexKey) == 0 {
+		// a value node whose key is empty holds the value pointer, followed by the delete marker if deleted
+		return len(hashes) == 2 && isDeleted
+	}
+
+	if len(hashes) < 2 || !isHexKey(hashes[0]) {
+		return false
+	}
+	nodeKey := hashes[0]
+	switch {
+	case bytes.Equal(nodeKey, hexKey):
+		return len(hashes) == 3 && isDeleted
+	case bytes.HasPrefix(hexKey, nodeKey):
+		// an extension node on the path of the key, which the lookup goes through
+		return false
+	default:
+		return true
+	}
+}
+
+func isBranchNode(hashes [][]byte) bool {
+	return len(hashes) >= 16
+}
+
+func isHexKey(key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+	for _, b := range key {
+		if b > 0x0f {
+			return false
+		}
+	}
+	return true
+}
+
+func convertByteToHex(b []byte) []byte {
+	res := make([]byte, len(b)*2)
+	for i, v := range b {
+		res[2*i] = v >> 4
+		res[2*i+1] = v & 0x0f
+	}
+	return res
+}
+
 func CalcHash(bytes [][]byte) ([]byte, error) {
 	bytesToHash := make([]byte, 0)
 	for _, b := range bytes {
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/hyperledger-labs/orion-server/pkg/crypto"
+	"github.com/hyperledger-labs/orion-server/pkg/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const deleteEntryMarker byte = 0xff
+
+// CalculateWriteSetDigest computes a deterministic digest over the writes and the deletes applied by a transaction. The
+// writes and the deletes are given per database name. Entries are sorted by database name and key so that the digest
+// does not depend on the order in which they were collected; the writes of a database precede its deletes. Each write
+// contributes the database name, the key, the hash of the value, the version, and the access control list. Each
+// delete contributes the database name, the key, and the version of the deleted value; the value and the access
+// control list of a delete are ignored.
+func CalculateWriteSetDigest(dbsWrites, dbsDeletes map[string][]*types.KVWithMetadata) ([]byte, error) {
+	var dbNames []string
+	for dbName := range dbsWrites {
+		dbNames = append(dbNames, dbName)
+	}
+	for dbName := range dbsDeletes {
+		if _, ok := dbsWrites[dbName]; !ok {
+			dbNames = append(dbNames, dbName)
+		}
+	}
+	sort.Strings(dbNames)
+
+	var bytesToHash []byte
+	for _, dbName := range dbNames {
+		for _, w := range sortedByKey(dbsWrites[dbName]) {
+			entryHash, err := calculateWriteEntryHash(dbName, w)
+			if err != nil {
+				return nil, err
+			}
+			bytesToHash = append(bytesToHash, entryHash...)
+		}
+
+		for _, d := range sortedByKey(dbsDeletes[dbName]) {
+			entryHash, err := calculateDeleteEntryHash(dbName, d)
+			if err != nil {
+				return nil, err
+			}
+			bytesToHash = append(bytesToHash, entryHash...)
+		}
+	}
+
+	return crypto.ComputeSHA256Hash(bytesToHash)
+}
+
+func sortedByKey(kvs []*types.KVWithMetadata) []*types.KVWithMetadata {
+	sorted := make([]*types.KVWithMetadata, len(kvs))
+	copy(sorted, kvs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetKey() < sorted[j].GetKey()
+	})
+	return sorted
+}
+
+func calculateWriteEntryHash(dbName string, w *types.KVWithMetadata) ([]byte, error) {
+	valueHash, err := crypto.ComputeSHA256Hash(w.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	aclBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(w.GetMetadata().GetAccessControl())
+	if err != nil {
+		return nil, errors.Wrap(err, "error while marshaling access control")
+	}
+	aclHash, err := crypto.ComputeSHA256Hash(aclBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry []byte
+	entry = appendLengthPrefixed(entry, []byte(dbName))
+	entry = appendLengthPrefixed(entry, []byte(w.GetKey()))
+	entry = append(entry, valueHash...)
+	entry = appendUint64(entry, w.GetMetadata().GetVersion().GetBlockNum())
+	entry = appendUint64(entry, w.GetMetadata().GetVersion().GetTxNum())
+	entry = append(entry, aclHash...)
+
+	return crypto.ComputeSHA256Hash(entry)
+}
+
+// calculateDeleteEntryHash hashes a delete entry. The entry starts with a marker byte, which cannot start the length
+// prefix of the database name of a write entry, so that a delete never hashes as a write.
+func calculateDeleteEntryHash(dbName string, d *types.KVWithMetadata) ([]byte, error) {
+	entry := []byte{deleteEntryMarker}
+	entry = appendLengthPrefixed(entry, []byte(dbName))
+	entry = appendLengthPrefixed(entry, []byte(d.GetKey()))
+	entry = appendUint64(entry, d.GetMetadata().GetVersion().GetBlockNum())
+	entry = appendUint64(entry, d.GetMetadata().GetVersion().GetTxNum())
+
+	return crypto.ComputeSHA256Hash(entry)
+}
+
+func appendLengthPrefixed(dst, b []byte) []byte {
+	dst = appendUint64(dst, uint64(len(b)))
+	return append(dst, b...)
+}
+
+func appendUint64(dst []byte, v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return append(dst, b...)
+}
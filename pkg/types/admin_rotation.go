@@ -0,0 +1,48 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package types
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// AdminRotationConfigs prepares the two cluster configs that rotate the admin `oldAdminID` to `newAdmin`, starting
+// from the current cluster config, which is not modified.
+//
+// A config transaction must retain at least one of the current admins, and an admin cannot remove itself in the same
+// transaction that adds admins. Therefore, the rotation takes two config transactions:
+//   - the first, signed by the old admin, submits `addConfig`, in which the new admin is added alongside the old one;
+//   - the second, signed by the new admin after the first one is committed, submits `removeConfig`, in which the old
+//     admin is removed.
+//
+// The new admin must have an ID that differs from the ID of the old admin.
+func AdminRotationConfigs(current *ClusterConfig, oldAdminID string, newAdmin *Admin) (addConfig, removeConfig *ClusterConfig, err error) {
+	if newAdmin == nil || newAdmin.Id == "" {
+		return nil, nil, errors.New("the new admin must have a non-empty ID")
+	}
+	if newAdmin.Id == oldAdminID {
+		return nil, nil, errors.Errorf("the new admin must have an ID that differs from the old admin [%s]", oldAdminID)
+	}
+
+	oldAdminIndex := -1
+	for i, a := range current.GetAdmins() {
+		switch a.GetId() {
+		case oldAdminID:
+			oldAdminIndex = i
+		case newAdmin.Id:
+			return nil, nil, errors.Errorf("the new admin [%s] already exists in the cluster config", newAdmin.Id)
+		}
+	}
+	if oldAdminIndex < 0 {
+		return nil, nil, errors.Errorf("the old admin [%s] does not exist in the cluster config", oldAdminID)
+	}
+
+	addConfig = proto.Clone(current).(*ClusterConfig)
+	addConfig.Admins = append(addConfig.Admins, proto.Clone(newAdmin).(*Admin))
+
+	removeConfig = proto.Clone(addConfig).(*ClusterConfig)
+	removeConfig.Admins = append(removeConfig.Admins[:oldAdminIndex], removeConfig.Admins[oldAdminIndex+1:]...)
+
+	return addConfig, removeConfig, nil
+}
@@ -34,19 +34,47 @@ const (
 	Flag_INVALID_INCORRECT_ENTRIES                  Flag = 5
 	Flag_INVALID_UNAUTHORISED                       Flag = 6
 	Flag_INVALID_MISSING_SIGNATURE                  Flag = 7
+	Flag_INVALID_KEY_FORMAT                         Flag = 8
+	Flag_INVALID_OUT_OF_ORDER                       Flag = 9
+	Flag_INVALID_SCHEMA_VIOLATION                   Flag = 10
+	// VOIDED marks a valid void transaction, which reserves its ID without writing to the state.
+	Flag_VOIDED Flag = 11
+	// INVALID_DEPENDENCY_NOT_SATISFIED marks a transaction whose dependency is unknown, invalid, or not committed before it.
+	Flag_INVALID_DEPENDENCY_NOT_SATISFIED Flag = 12
+	// INVALID_TX_TOO_MANY_OPERATIONS marks a data transaction that exceeds the operation limits of the ledger configuration.
+	Flag_INVALID_TX_TOO_MANY_OPERATIONS Flag = 13
+	// INVALID_VALUE_TOO_LARGE marks a data transaction that writes a value larger than the cap of its database.
+	Flag_INVALID_VALUE_TOO_LARGE Flag = 14
+	// INVALID_DB_UNAVAILABLE marks a data transaction that operates on a database which is unavailable on the node, as a
+	// commit to the database failed and was skipped, until the database is resynced.
+	Flag_INVALID_DB_UNAVAILABLE Flag = 15
+	// INVALID_EXPIRED marks a data transaction in a block past the deadline set by the transaction.
+	Flag_INVALID_EXPIRED Flag = 16
+	// INVALID_IMMUTABLE_KEY marks a data transaction that modifies or deletes a key of an immutable database.
+	Flag_INVALID_IMMUTABLE_KEY Flag = 17
 )
 
 // Enum value maps for Flag.
 var (
 	Flag_name = map[int32]string{
-		0: "VALID",
-		1: "INVALID_MVCC_CONFLICT_WITHIN_BLOCK",
-		2: "INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE",
-		3: "INVALID_DATABASE_DOES_NOT_EXIST",
-		4: "INVALID_NO_PERMISSION",
-		5: "INVALID_INCORRECT_ENTRIES",
-		6: "INVALID_UNAUTHORISED",
-		7: "INVALID_MISSING_SIGNATURE",
+		0:  "VALID",
+		1:  "INVALID_MVCC_CONFLICT_WITHIN_BLOCK",
+		2:  "INVALID_MVCC_CONFLICT_WITH_COMMITTED_STATE",
+		3:  "INVALID_DATABASE_DOES_NOT_EXIST",
+		4:  "INVALID_NO_PERMISSION",
+		5:  "INVALID_INCORRECT_ENTRIES",
+		6:  "INVALID_UNAUTHORISED",
+		7:  "INVALID_MISSING_SIGNATURE",
+		8:  "INVALID_KEY_FORMAT",
+		9:  "INVALID_OUT_OF_ORDER",
+		10: "INVALID_SCHEMA_VIOLATION",
+		11: "VOIDED",
+		12: "INVALID_DEPENDENCY_NOT_SATISFIED",
+		13: "INVALID_TX_TOO_MANY_OPERATIONS",
+		14: "INVALID_VALUE_TOO_LARGE",
+		15: "INVALID_DB_UNAVAILABLE",
+		16: "INVALID_EXPIRED",
+		17: "INVALID_IMMUTABLE_KEY",
 	}
 	Flag_value = map[string]int32{
 		"VALID":                              0,
@@ -57,6 +85,16 @@ var (
 		"INVALID_INCORRECT_ENTRIES":                  5,
 		"INVALID_UNAUTHORISED":                       6,
 		"INVALID_MISSING_SIGNATURE":                  7,
+		"INVALID_KEY_FORMAT":                         8,
+		"INVALID_OUT_OF_ORDER":                       9,
+		"INVALID_SCHEMA_VIOLATION":                   10,
+		"VOIDED":                                     11,
+		"INVALID_DEPENDENCY_NOT_SATISFIED":           12,
+		"INVALID_TX_TOO_MANY_OPERATIONS":             13,
+		"INVALID_VALUE_TOO_LARGE":                    14,
+		"INVALID_DB_UNAVAILABLE":                     15,
+		"INVALID_EXPIRED":                            16,
+		"INVALID_IMMUTABLE_KEY":                      17,
 	}
 )
 
@@ -136,6 +174,101 @@ func (IndexAttributeType) EnumDescriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{1}
 }
 
+type DBKeyCollation_Mode int32
+
+const (
+	DBKeyCollation_BINARY    DBKeyCollation_Mode = 0
+	DBKeyCollation_NUMERIC   DBKeyCollation_Mode = 1
+	DBKeyCollation_COMPOSITE DBKeyCollation_Mode = 2
+)
+
+// Enum value maps for DBKeyCollation_Mode.
+var (
+	DBKeyCollation_Mode_name = map[int32]string{
+		0: "BINARY",
+		1: "NUMERIC",
+		2: "COMPOSITE",
+	}
+	DBKeyCollation_Mode_value = map[string]int32{
+		"BINARY":    0,
+		"NUMERIC":   1,
+		"COMPOSITE": 2,
+	}
+)
+
+func (x DBKeyCollation_Mode) Enum() *DBKeyCollation_Mode {
+	p := new(DBKeyCollation_Mode)
+	*p = x
+	return p
+}
+
+func (x DBKeyCollation_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DBKeyCollation_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_block_and_transaction_proto_enumTypes[2].Descriptor()
+}
+
+func (DBKeyCollation_Mode) Type() protoreflect.EnumType {
+	return &file_block_and_transaction_proto_enumTypes[2]
+}
+
+func (x DBKeyCollation_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DBKeyCollation_Mode.Descriptor instead.
+func (DBKeyCollation_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type DBKeyCollation_SegmentType int32
+
+const (
+	DBKeyCollation_TEXT   DBKeyCollation_SegmentType = 0
+	DBKeyCollation_NUMBER DBKeyCollation_SegmentType = 1
+)
+
+// Enum value maps for DBKeyCollation_SegmentType.
+var (
+	DBKeyCollation_SegmentType_name = map[int32]string{
+		0: "TEXT",
+		1: "NUMBER",
+	}
+	DBKeyCollation_SegmentType_value = map[string]int32{
+		"TEXT":   0,
+		"NUMBER": 1,
+	}
+)
+
+func (x DBKeyCollation_SegmentType) Enum() *DBKeyCollation_SegmentType {
+	p := new(DBKeyCollation_SegmentType)
+	*p = x
+	return p
+}
+
+func (x DBKeyCollation_SegmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DBKeyCollation_SegmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_block_and_transaction_proto_enumTypes[3].Descriptor()
+}
+
+func (DBKeyCollation_SegmentType) Type() protoreflect.EnumType {
+	return &file_block_and_transaction_proto_enumTypes[3]
+}
+
+func (x DBKeyCollation_SegmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DBKeyCollation_SegmentType.Descriptor instead.
+func (DBKeyCollation_SegmentType) EnumDescriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{31, 1}
+}
+
 type AccessControlWritePolicy int32
 
 const (
@@ -166,11 +299,11 @@ func (x AccessControlWritePolicy) String() string {
 }
 
 func (AccessControlWritePolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_block_and_transaction_proto_enumTypes[2].Descriptor()
+	return file_block_and_transaction_proto_enumTypes[4].Descriptor()
 }
 
 func (AccessControlWritePolicy) Type() protoreflect.EnumType {
-	return &file_block_and_transaction_proto_enumTypes[2]
+	return &file_block_and_transaction_proto_enumTypes[4]
 }
 
 func (x AccessControlWritePolicy) Number() protoreflect.EnumNumber {
@@ -179,7 +312,70 @@ func (x AccessControlWritePolicy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccessControlWritePolicy.Descriptor instead.
 func (AccessControlWritePolicy) EnumDescriptor() ([]byte, []int) {
-	return file_block_and_transaction_proto_rawDescGZIP(), []int{22, 0}
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type BatchComposition_CutReason int32
+
+const (
+	BatchComposition_UNKNOWN BatchComposition_CutReason = 0
+	// the batch reached the maximum number of transactions per block
+	BatchComposition_TX_COUNT BatchComposition_CutReason = 1
+	// the block timeout occurred
+	BatchComposition_TIMEOUT BatchComposition_CutReason = 2
+	// an administration transaction was dequeued, and the pending data transactions were cut ahead of it
+	BatchComposition_ADMIN_FAST_PATH BatchComposition_CutReason = 3
+	// a void transaction was dequeued, and the pending data transactions were cut ahead of it
+	BatchComposition_VOID_TX BatchComposition_CutReason = 4
+	// the low-latency mode cut the transaction into a batch of its own as soon as it was dequeued
+	BatchComposition_LOW_LATENCY BatchComposition_CutReason = 5
+)
+
+// Enum value maps for BatchComposition_CutReason.
+var (
+	BatchComposition_CutReason_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "TX_COUNT",
+		2: "TIMEOUT",
+		3: "ADMIN_FAST_PATH",
+		4: "VOID_TX",
+		5: "LOW_LATENCY",
+	}
+	BatchComposition_CutReason_value = map[string]int32{
+		"UNKNOWN":         0,
+		"TX_COUNT":        1,
+		"TIMEOUT":         2,
+		"ADMIN_FAST_PATH": 3,
+		"VOID_TX":         4,
+		"LOW_LATENCY":     5,
+	}
+)
+
+func (x BatchComposition_CutReason) Enum() *BatchComposition_CutReason {
+	p := new(BatchComposition_CutReason)
+	*p = x
+	return p
+}
+
+func (x BatchComposition_CutReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchComposition_CutReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_block_and_transaction_proto_enumTypes[5].Descriptor()
+}
+
+func (BatchComposition_CutReason) Type() protoreflect.EnumType {
+	return &file_block_and_transaction_proto_enumTypes[5]
+}
+
+func (x BatchComposition_CutReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchComposition_CutReason.Descriptor instead.
+func (BatchComposition_CutReason) EnumDescriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{56, 0}
 }
 
 // Block holds the chain information and transactions
@@ -195,6 +391,9 @@ type Block struct {
 	//	*Block_ConfigTxEnvelope
 	//	*Block_DbAdministrationTxEnvelope
 	//	*Block_UserAdministrationTxEnvelope
+	//	*Block_HeartbeatTxEnvelopes
+	//	*Block_VoidTxEnvelopes
+	//	*Block_UserAdministrationTxEnvelopes
 	Payload isBlock_Payload `protobuf_oneof:"Payload"`
 	// Consensus protocol metadata
 	ConsensusMetadata *ConsensusMetadata `protobuf:"bytes,6,opt,name=consensus_metadata,json=consensusMetadata,proto3" json:"consensus_metadata,omitempty"`
@@ -274,6 +473,27 @@ func (x *Block) GetUserAdministrationTxEnvelope() *UserAdministrationTxEnvelope
 	return nil
 }
 
+func (x *Block) GetHeartbeatTxEnvelopes() *HeartbeatTxEnvelopes {
+	if x, ok := x.GetPayload().(*Block_HeartbeatTxEnvelopes); ok {
+		return x.HeartbeatTxEnvelopes
+	}
+	return nil
+}
+
+func (x *Block) GetVoidTxEnvelopes() *VoidTxEnvelopes {
+	if x, ok := x.GetPayload().(*Block_VoidTxEnvelopes); ok {
+		return x.VoidTxEnvelopes
+	}
+	return nil
+}
+
+func (x *Block) GetUserAdministrationTxEnvelopes() *UserAdministrationTxEnvelopes {
+	if x, ok := x.GetPayload().(*Block_UserAdministrationTxEnvelopes); ok {
+		return x.UserAdministrationTxEnvelopes
+	}
+	return nil
+}
+
 func (x *Block) GetConsensusMetadata() *ConsensusMetadata {
 	if x != nil {
 		return x.ConsensusMetadata
@@ -301,6 +521,18 @@ type Block_UserAdministrationTxEnvelope struct {
 	UserAdministrationTxEnvelope *UserAdministrationTxEnvelope `protobuf:"bytes,5,opt,name=user_administration_tx_envelope,json=userAdministrationTxEnvelope,proto3,oneof"`
 }
 
+type Block_HeartbeatTxEnvelopes struct {
+	HeartbeatTxEnvelopes *HeartbeatTxEnvelopes `protobuf:"bytes,7,opt,name=heartbeat_tx_envelopes,json=heartbeatTxEnvelopes,proto3,oneof"`
+}
+
+type Block_VoidTxEnvelopes struct {
+	VoidTxEnvelopes *VoidTxEnvelopes `protobuf:"bytes,8,opt,name=void_tx_envelopes,json=voidTxEnvelopes,proto3,oneof"`
+}
+
+type Block_UserAdministrationTxEnvelopes struct {
+	UserAdministrationTxEnvelopes *UserAdministrationTxEnvelopes `protobuf:"bytes,9,opt,name=user_administration_tx_envelopes,json=userAdministrationTxEnvelopes,proto3,oneof"`
+}
+
 func (*Block_DataTxEnvelopes) isBlock_Payload() {}
 
 func (*Block_ConfigTxEnvelope) isBlock_Payload() {}
@@ -309,6 +541,12 @@ func (*Block_DbAdministrationTxEnvelope) isBlock_Payload() {}
 
 func (*Block_UserAdministrationTxEnvelope) isBlock_Payload() {}
 
+func (*Block_HeartbeatTxEnvelopes) isBlock_Payload() {}
+
+func (*Block_VoidTxEnvelopes) isBlock_Payload() {}
+
+func (*Block_UserAdministrationTxEnvelopes) isBlock_Payload() {}
+
 // BlockHeaderBase holds the block metadata and the chain information
 // that computed before transaction validation
 type BlockHeaderBase struct {
@@ -323,6 +561,17 @@ type BlockHeaderBase struct {
 	LastCommittedBlockHash []byte `protobuf:"bytes,3,opt,name=last_committed_block_hash,json=lastCommittedBlockHash,proto3" json:"last_committed_block_hash,omitempty"`
 	// Number of last block already committed to ledger
 	LastCommittedBlockNum uint64 `protobuf:"varint,4,opt,name=last_committed_block_num,json=lastCommittedBlockNum,proto3" json:"last_committed_block_num,omitempty"`
+	// The fencing epoch of the node that produced the block, see ClusterConfig.epoch
+	ProducerEpoch uint64 `protobuf:"varint,5,opt,name=producer_epoch,json=producerEpoch,proto3" json:"producer_epoch,omitempty"`
+	// The time, in nanoseconds since the Unix epoch, at which the node that produced the block numbered it. It is the
+	// time against which the deadlines of the transactions of the block are checked, see DataTx.not_committed_after.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The software version, see constants.ServerVersion, of the node that produced the block. It is empty for blocks
+	// produced by nodes that predate the field.
+	ProducerVersion string `protobuf:"bytes,7,opt,name=producer_version,json=producerVersion,proto3" json:"producer_version,omitempty"`
+	// The version of the validation rules the block must be validated with. A block that lacks it is validated with the
+	// rules in effect before the field was introduced, see constants.LegacyRulesVersion.
+	RulesVersion uint32 `protobuf:"varint,8,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
 }
 
 func (x *BlockHeaderBase) Reset() {
@@ -385,6 +634,34 @@ func (x *BlockHeaderBase) GetLastCommittedBlockNum() uint64 {
 	return 0
 }
 
+func (x *BlockHeaderBase) GetProducerEpoch() uint64 {
+	if x != nil {
+		return x.ProducerEpoch
+	}
+	return 0
+}
+
+func (x *BlockHeaderBase) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BlockHeaderBase) GetProducerVersion() string {
+	if x != nil {
+		return x.ProducerVersion
+	}
+	return ""
+}
+
+func (x *BlockHeaderBase) GetRulesVersion() uint32 {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return 0
+}
+
 // BlockHeader holds, in addition to base header, additional chain integrity information that is computed after transactions validation,
 // including the state and transaction Merkle trees roots, skip-chain hashes, and transaction validation information.
 type BlockHeader struct {
@@ -401,6 +678,9 @@ type BlockHeader struct {
 	StateMerkelTreeRootHash []byte `protobuf:"bytes,4,opt,name=state_merkel_tree_root_hash,json=stateMerkelTreeRootHash,proto3" json:"state_merkel_tree_root_hash,omitempty"`
 	// Validation info for transactions in block.
 	ValidationInfo []*ValidationInfo `protobuf:"bytes,5,rep,name=validation_info,json=validationInfo,proto3" json:"validation_info,omitempty"`
+	// The running totals of the ledger up to and including the block, derived from the totals of the previous block. A
+	// block committed by a node that predates the field lacks it.
+	Cumulative *CumulativeCounters `protobuf:"bytes,6,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
 }
 
 func (x *BlockHeader) Reset() {
@@ -470,6 +750,13 @@ func (x *BlockHeader) GetValidationInfo() []*ValidationInfo {
 	return nil
 }
 
+func (x *BlockHeader) GetCumulative() *CumulativeCounters {
+	if x != nil {
+		return x.Cumulative
+	}
+	return nil
+}
+
 type DataTxEnvelopes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -524,6 +811,9 @@ type DataTxEnvelope struct {
 
 	Payload    *DataTx           `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 	Signatures map[string][]byte `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// import_proof is set, in place of the signatures, on a transaction generated by a node from a database import. The
+	// transaction is then authorized by the signed import request.
+	ImportProof *DBImportProof `protobuf:"bytes,3,opt,name=import_proof,json=importProof,proto3" json:"import_proof,omitempty"`
 }
 
 func (x *DataTxEnvelope) Reset() {
@@ -572,6 +862,13 @@ func (x *DataTxEnvelope) GetSignatures() map[string][]byte {
 	return nil
 }
 
+func (x *DataTxEnvelope) GetImportProof() *DBImportProof {
+	if x != nil {
+		return x.ImportProof
+	}
+	return nil
+}
+
 type ConfigTxEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -634,6 +931,9 @@ type DBAdministrationTxEnvelope struct {
 
 	Payload   *DBAdministrationTx `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// import_proof is set, in place of the signature, on the transaction generated by a node to create the database of
+	// a database import. The transaction is then authorized by the signed import request.
+	ImportProof *DBImportProof `protobuf:"bytes,3,opt,name=import_proof,json=importProof,proto3" json:"import_proof,omitempty"`
 }
 
 func (x *DBAdministrationTxEnvelope) Reset() {
@@ -682,6 +982,13 @@ func (x *DBAdministrationTxEnvelope) GetSignature() []byte {
 	return nil
 }
 
+func (x *DBAdministrationTxEnvelope) GetImportProof() *DBImportProof {
+	if x != nil {
+		return x.ImportProof
+	}
+	return nil
+}
+
 type UserAdministrationTxEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -689,6 +996,9 @@ type UserAdministrationTxEnvelope struct {
 
 	Payload   *UserAdministrationTx `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 	Signature []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// import_proof is set, in place of the signature, on a transaction generated by a node from a user import. The
+	// transaction is then authorized by the signed import request.
+	ImportProof *UserImportProof `protobuf:"bytes,3,opt,name=import_proof,json=importProof,proto3" json:"import_proof,omitempty"`
 }
 
 func (x *UserAdministrationTxEnvelope) Reset() {
@@ -737,18 +1047,25 @@ func (x *UserAdministrationTxEnvelope) GetSignature() []byte {
 	return nil
 }
 
-type DataTx struct {
+func (x *UserAdministrationTxEnvelope) GetImportProof() *UserImportProof {
+	if x != nil {
+		return x.ImportProof
+	}
+	return nil
+}
+
+// UserAdministrationTxEnvelopes is a mini-batch of user administration transactions, cut into the capacity reserved
+// for them next to a batch of data transactions, see BlockCreationConf.AdminReservationPercent.
+type UserAdministrationTxEnvelopes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MustSignUserIds []string       `protobuf:"bytes,1,rep,name=must_sign_user_ids,json=mustSignUserIds,proto3" json:"must_sign_user_ids,omitempty"`
-	TxId            string         `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	DbOperations    []*DBOperation `protobuf:"bytes,3,rep,name=db_operations,json=dbOperations,proto3" json:"db_operations,omitempty"`
+	Envelopes []*UserAdministrationTxEnvelope `protobuf:"bytes,1,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
 }
 
-func (x *DataTx) Reset() {
-	*x = DataTx{}
+func (x *UserAdministrationTxEnvelopes) Reset() {
+	*x = UserAdministrationTxEnvelopes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -756,13 +1073,13 @@ func (x *DataTx) Reset() {
 	}
 }
 
-func (x *DataTx) String() string {
+func (x *UserAdministrationTxEnvelopes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataTx) ProtoMessage() {}
+func (*UserAdministrationTxEnvelopes) ProtoMessage() {}
 
-func (x *DataTx) ProtoReflect() protoreflect.Message {
+func (x *UserAdministrationTxEnvelopes) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -774,45 +1091,33 @@ func (x *DataTx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataTx.ProtoReflect.Descriptor instead.
-func (*DataTx) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserAdministrationTxEnvelopes.ProtoReflect.Descriptor instead.
+func (*UserAdministrationTxEnvelopes) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DataTx) GetMustSignUserIds() []string {
-	if x != nil {
-		return x.MustSignUserIds
-	}
-	return nil
-}
-
-func (x *DataTx) GetTxId() string {
-	if x != nil {
-		return x.TxId
-	}
-	return ""
-}
-
-func (x *DataTx) GetDbOperations() []*DBOperation {
+func (x *UserAdministrationTxEnvelopes) GetEnvelopes() []*UserAdministrationTxEnvelope {
 	if x != nil {
-		return x.DbOperations
+		return x.Envelopes
 	}
 	return nil
 }
 
-type DBOperation struct {
+// UserImportRequestEnvelope is posted to a node to create users in bulk. The node splits the users into user
+// administration transactions, each carrying the signed request as its proof, see UserImportProof.
+type UserImportRequestEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DbName      string        `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	DataReads   []*DataRead   `protobuf:"bytes,4,rep,name=data_reads,json=dataReads,proto3" json:"data_reads,omitempty"`
-	DataWrites  []*DataWrite  `protobuf:"bytes,5,rep,name=data_writes,json=dataWrites,proto3" json:"data_writes,omitempty"`
-	DataDeletes []*DataDelete `protobuf:"bytes,6,rep,name=data_deletes,json=dataDeletes,proto3" json:"data_deletes,omitempty"`
+	Payload   *UserImportRequest `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// users are the users to be created, in the order of their digests in the payload.
+	Users []*User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *DBOperation) Reset() {
-	*x = DBOperation{}
+func (x *UserImportRequestEnvelope) Reset() {
+	*x = UserImportRequestEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -820,13 +1125,13 @@ func (x *DBOperation) Reset() {
 	}
 }
 
-func (x *DBOperation) String() string {
+func (x *UserImportRequestEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DBOperation) ProtoMessage() {}
+func (*UserImportRequestEnvelope) ProtoMessage() {}
 
-func (x *DBOperation) ProtoReflect() protoreflect.Message {
+func (x *UserImportRequestEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -838,51 +1143,48 @@ func (x *DBOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DBOperation.ProtoReflect.Descriptor instead.
-func (*DBOperation) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserImportRequestEnvelope.ProtoReflect.Descriptor instead.
+func (*UserImportRequestEnvelope) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DBOperation) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *DBOperation) GetDataReads() []*DataRead {
+func (x *UserImportRequestEnvelope) GetPayload() *UserImportRequest {
 	if x != nil {
-		return x.DataReads
+		return x.Payload
 	}
 	return nil
 }
 
-func (x *DBOperation) GetDataWrites() []*DataWrite {
+func (x *UserImportRequestEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.DataWrites
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *DBOperation) GetDataDeletes() []*DataDelete {
+func (x *UserImportRequestEnvelope) GetUsers() []*User {
 	if x != nil {
-		return x.DataDeletes
+		return x.Users
 	}
 	return nil
 }
 
-// DataRead hold a read key and its version
-type DataRead struct {
+// UserImportRequest holds the digest of each user to be created, rather than the user itself, so that every
+// transaction of the import can carry the signed request without carrying all the users. The digest of a user is
+// computed by UserImportDigest.
+type UserImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Version *Version `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// import_id prefixes the IDs of the transactions of the import.
+	ImportId    string   `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	UserDigests [][]byte `protobuf:"bytes,3,rep,name=user_digests,json=userDigests,proto3" json:"user_digests,omitempty"`
 }
 
-func (x *DataRead) Reset() {
-	*x = DataRead{}
+func (x *UserImportRequest) Reset() {
+	*x = UserImportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -890,13 +1192,13 @@ func (x *DataRead) Reset() {
 	}
 }
 
-func (x *DataRead) String() string {
+func (x *UserImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataRead) ProtoMessage() {}
+func (*UserImportRequest) ProtoMessage() {}
 
-func (x *DataRead) ProtoReflect() protoreflect.Message {
+func (x *UserImportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -908,38 +1210,45 @@ func (x *DataRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataRead.ProtoReflect.Descriptor instead.
-func (*DataRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserImportRequest.ProtoReflect.Descriptor instead.
+func (*UserImportRequest) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DataRead) GetKey() string {
+func (x *UserImportRequest) GetUserId() string {
 	if x != nil {
-		return x.Key
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DataRead) GetVersion() *Version {
+func (x *UserImportRequest) GetImportId() string {
 	if x != nil {
-		return x.Version
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *UserImportRequest) GetUserDigests() [][]byte {
+	if x != nil {
+		return x.UserDigests
 	}
 	return nil
 }
 
-// DataWrite hold a write including a delete
-type DataWrite struct {
+// UserImportProof authorizes a user administration transaction that creates users of a user import. The transaction
+// may only create users whose digests are in the signed request, and which do not exist yet.
+type UserImportProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Acl   *AccessControl `protobuf:"bytes,3,opt,name=acl,proto3" json:"acl,omitempty"`
+	Request   *UserImportRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *DataWrite) Reset() {
-	*x = DataWrite{}
+func (x *UserImportProof) Reset() {
+	*x = UserImportProof{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -947,13 +1256,13 @@ func (x *DataWrite) Reset() {
 	}
 }
 
-func (x *DataWrite) String() string {
+func (x *UserImportProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataWrite) ProtoMessage() {}
+func (*UserImportProof) ProtoMessage() {}
 
-func (x *DataWrite) ProtoReflect() protoreflect.Message {
+func (x *UserImportProof) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -965,42 +1274,35 @@ func (x *DataWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataWrite.ProtoReflect.Descriptor instead.
-func (*DataWrite) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserImportProof.ProtoReflect.Descriptor instead.
+func (*UserImportProof) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DataWrite) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *DataWrite) GetValue() []byte {
+func (x *UserImportProof) GetRequest() *UserImportRequest {
 	if x != nil {
-		return x.Value
+		return x.Request
 	}
 	return nil
 }
 
-func (x *DataWrite) GetAcl() *AccessControl {
+func (x *UserImportProof) GetSignature() []byte {
 	if x != nil {
-		return x.Acl
+		return x.Signature
 	}
 	return nil
 }
 
-type DataDelete struct {
+type HeartbeatTxEnvelopes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Envelopes []*HeartbeatTxEnvelope `protobuf:"bytes,1,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
 }
 
-func (x *DataDelete) Reset() {
-	*x = DataDelete{}
+func (x *HeartbeatTxEnvelopes) Reset() {
+	*x = HeartbeatTxEnvelopes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1008,13 +1310,13 @@ func (x *DataDelete) Reset() {
 	}
 }
 
-func (x *DataDelete) String() string {
+func (x *HeartbeatTxEnvelopes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataDelete) ProtoMessage() {}
+func (*HeartbeatTxEnvelopes) ProtoMessage() {}
 
-func (x *DataDelete) ProtoReflect() protoreflect.Message {
+func (x *HeartbeatTxEnvelopes) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1026,31 +1328,30 @@ func (x *DataDelete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataDelete.ProtoReflect.Descriptor instead.
-func (*DataDelete) Descriptor() ([]byte, []int) {
+// Deprecated: Use HeartbeatTxEnvelopes.ProtoReflect.Descriptor instead.
+func (*HeartbeatTxEnvelopes) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DataDelete) GetKey() string {
+func (x *HeartbeatTxEnvelopes) GetEnvelopes() []*HeartbeatTxEnvelope {
 	if x != nil {
-		return x.Key
+		return x.Envelopes
 	}
-	return ""
+	return nil
 }
 
-type ConfigTx struct {
+type HeartbeatTxEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId               string         `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TxId                 string         `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	ReadOldConfigVersion *Version       `protobuf:"bytes,3,opt,name=read_old_config_version,json=readOldConfigVersion,proto3" json:"read_old_config_version,omitempty"`
-	NewConfig            *ClusterConfig `protobuf:"bytes,4,opt,name=new_config,json=newConfig,proto3" json:"new_config,omitempty"`
+	Payload *HeartbeatTx `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The signature of the node, verified against the certificate of the node in the ClusterConfig.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *ConfigTx) Reset() {
-	*x = ConfigTx{}
+func (x *HeartbeatTxEnvelope) Reset() {
+	*x = HeartbeatTxEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1058,13 +1359,13 @@ func (x *ConfigTx) Reset() {
 	}
 }
 
-func (x *ConfigTx) String() string {
+func (x *HeartbeatTxEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigTx) ProtoMessage() {}
+func (*HeartbeatTxEnvelope) ProtoMessage() {}
 
-func (x *ConfigTx) ProtoReflect() protoreflect.Message {
+func (x *HeartbeatTxEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1076,53 +1377,35 @@ func (x *ConfigTx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigTx.ProtoReflect.Descriptor instead.
-func (*ConfigTx) Descriptor() ([]byte, []int) {
+// Deprecated: Use HeartbeatTxEnvelope.ProtoReflect.Descriptor instead.
+func (*HeartbeatTxEnvelope) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ConfigTx) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *ConfigTx) GetTxId() string {
-	if x != nil {
-		return x.TxId
-	}
-	return ""
-}
-
-func (x *ConfigTx) GetReadOldConfigVersion() *Version {
+func (x *HeartbeatTxEnvelope) GetPayload() *HeartbeatTx {
 	if x != nil {
-		return x.ReadOldConfigVersion
+		return x.Payload
 	}
 	return nil
 }
 
-func (x *ConfigTx) GetNewConfig() *ClusterConfig {
+func (x *HeartbeatTxEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.NewConfig
+		return x.Signature
 	}
 	return nil
 }
 
-type DBAdministrationTx struct {
+type VoidTxEnvelopes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string              `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TxId      string              `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	CreateDbs []string            `protobuf:"bytes,3,rep,name=create_dbs,json=createDbs,proto3" json:"create_dbs,omitempty"`
-	DeleteDbs []string            `protobuf:"bytes,4,rep,name=delete_dbs,json=deleteDbs,proto3" json:"delete_dbs,omitempty"`
-	DbsIndex  map[string]*DBIndex `protobuf:"bytes,5,rep,name=dbs_index,json=dbsIndex,proto3" json:"dbs_index,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Envelopes []*VoidTxEnvelope `protobuf:"bytes,1,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
 }
 
-func (x *DBAdministrationTx) Reset() {
-	*x = DBAdministrationTx{}
+func (x *VoidTxEnvelopes) Reset() {
+	*x = VoidTxEnvelopes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1130,13 +1413,13 @@ func (x *DBAdministrationTx) Reset() {
 	}
 }
 
-func (x *DBAdministrationTx) String() string {
+func (x *VoidTxEnvelopes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DBAdministrationTx) ProtoMessage() {}
+func (*VoidTxEnvelopes) ProtoMessage() {}
 
-func (x *DBAdministrationTx) ProtoReflect() protoreflect.Message {
+func (x *VoidTxEnvelopes) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1148,56 +1431,29 @@ func (x *DBAdministrationTx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DBAdministrationTx.ProtoReflect.Descriptor instead.
-func (*DBAdministrationTx) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoidTxEnvelopes.ProtoReflect.Descriptor instead.
+func (*VoidTxEnvelopes) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DBAdministrationTx) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *DBAdministrationTx) GetTxId() string {
-	if x != nil {
-		return x.TxId
-	}
-	return ""
-}
-
-func (x *DBAdministrationTx) GetCreateDbs() []string {
-	if x != nil {
-		return x.CreateDbs
-	}
-	return nil
-}
-
-func (x *DBAdministrationTx) GetDeleteDbs() []string {
+func (x *VoidTxEnvelopes) GetEnvelopes() []*VoidTxEnvelope {
 	if x != nil {
-		return x.DeleteDbs
-	}
-	return nil
-}
-
-func (x *DBAdministrationTx) GetDbsIndex() map[string]*DBIndex {
-	if x != nil {
-		return x.DbsIndex
+		return x.Envelopes
 	}
 	return nil
 }
 
-type DBIndex struct {
+type VoidTxEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttributeAndType map[string]IndexAttributeType `protobuf:"bytes,1,rep,name=attribute_and_type,json=attributeAndType,proto3" json:"attribute_and_type,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=types.IndexAttributeType"`
+	Payload   *VoidTx `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *DBIndex) Reset() {
-	*x = DBIndex{}
+func (x *VoidTxEnvelope) Reset() {
+	*x = VoidTxEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1205,13 +1461,13 @@ func (x *DBIndex) Reset() {
 	}
 }
 
-func (x *DBIndex) String() string {
+func (x *VoidTxEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DBIndex) ProtoMessage() {}
+func (*VoidTxEnvelope) ProtoMessage() {}
 
-func (x *DBIndex) ProtoReflect() protoreflect.Message {
+func (x *VoidTxEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1223,32 +1479,53 @@ func (x *DBIndex) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DBIndex.ProtoReflect.Descriptor instead.
-func (*DBIndex) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoidTxEnvelope.ProtoReflect.Descriptor instead.
+func (*VoidTxEnvelope) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DBIndex) GetAttributeAndType() map[string]IndexAttributeType {
+func (x *VoidTxEnvelope) GetPayload() *VoidTx {
 	if x != nil {
-		return x.AttributeAndType
+		return x.Payload
 	}
 	return nil
 }
 
-type UserAdministrationTx struct {
+func (x *VoidTxEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type DataTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TxId        string        `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	UserReads   []*UserRead   `protobuf:"bytes,3,rep,name=user_reads,json=userReads,proto3" json:"user_reads,omitempty"`
-	UserWrites  []*UserWrite  `protobuf:"bytes,4,rep,name=user_writes,json=userWrites,proto3" json:"user_writes,omitempty"`
-	UserDeletes []*UserDelete `protobuf:"bytes,5,rep,name=user_deletes,json=userDeletes,proto3" json:"user_deletes,omitempty"`
+	MustSignUserIds []string       `protobuf:"bytes,1,rep,name=must_sign_user_ids,json=mustSignUserIds,proto3" json:"must_sign_user_ids,omitempty"`
+	TxId            string         `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	DbOperations    []*DBOperation `protobuf:"bytes,3,rep,name=db_operations,json=dbOperations,proto3" json:"db_operations,omitempty"`
+	// sequence optionally orders the transactions of the first user in must_sign_user_ids. When set, the transaction
+	// is valid only if its sequence is the successor of the last sequence committed for that user, starting at 1.
+	// Zero denotes a transaction that is not ordered.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// depends_on_tx_id optionally names a transaction that must be committed as valid, in an earlier block or earlier
+	// in the same block, for this transaction to be valid.
+	DependsOnTxId string `protobuf:"bytes,5,opt,name=depends_on_tx_id,json=dependsOnTxId,proto3" json:"depends_on_tx_id,omitempty"`
+	// not_committed_after optionally sets a deadline after which the transaction must not commit, so that a client that
+	// gives up waiting for it can safely take compensating actions. A transaction in a block past its deadline is
+	// invalidated with INVALID_EXPIRED, and a transaction whose deadline passes before it is batched is dropped.
+	NotCommittedAfter *TxDeadline `protobuf:"bytes,6,opt,name=not_committed_after,json=notCommittedAfter,proto3" json:"not_committed_after,omitempty"`
+	// attestation_payload optionally makes the transaction an attestation, which anchors an opaque payload, e.g., the
+	// hash of an external document, on the ledger. An attestation names a single database, on which its signers must
+	// have read-write permission, and carries no operation. It is excluded from the MVCC validation and from the commit
+	// of the state, but it has a receipt and a proof like any other transaction.
+	AttestationPayload []byte `protobuf:"bytes,7,opt,name=attestation_payload,json=attestationPayload,proto3" json:"attestation_payload,omitempty"`
 }
 
-func (x *UserAdministrationTx) Reset() {
-	*x = UserAdministrationTx{}
+func (x *DataTx) Reset() {
+	*x = DataTx{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,13 +1533,13 @@ func (x *UserAdministrationTx) Reset() {
 	}
 }
 
-func (x *UserAdministrationTx) String() string {
+func (x *DataTx) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAdministrationTx) ProtoMessage() {}
+func (*DataTx) ProtoMessage() {}
 
-func (x *UserAdministrationTx) ProtoReflect() protoreflect.Message {
+func (x *DataTx) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1274,57 +1551,75 @@ func (x *UserAdministrationTx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAdministrationTx.ProtoReflect.Descriptor instead.
-func (*UserAdministrationTx) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataTx.ProtoReflect.Descriptor instead.
+func (*DataTx) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UserAdministrationTx) GetUserId() string {
+func (x *DataTx) GetMustSignUserIds() []string {
 	if x != nil {
-		return x.UserId
+		return x.MustSignUserIds
 	}
-	return ""
+	return nil
 }
 
-func (x *UserAdministrationTx) GetTxId() string {
+func (x *DataTx) GetTxId() string {
 	if x != nil {
 		return x.TxId
 	}
 	return ""
 }
 
-func (x *UserAdministrationTx) GetUserReads() []*UserRead {
+func (x *DataTx) GetDbOperations() []*DBOperation {
 	if x != nil {
-		return x.UserReads
+		return x.DbOperations
 	}
 	return nil
 }
 
-func (x *UserAdministrationTx) GetUserWrites() []*UserWrite {
+func (x *DataTx) GetSequence() uint64 {
 	if x != nil {
-		return x.UserWrites
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DataTx) GetDependsOnTxId() string {
+	if x != nil {
+		return x.DependsOnTxId
+	}
+	return ""
+}
+
+func (x *DataTx) GetNotCommittedAfter() *TxDeadline {
+	if x != nil {
+		return x.NotCommittedAfter
 	}
 	return nil
 }
 
-func (x *UserAdministrationTx) GetUserDeletes() []*UserDelete {
+func (x *DataTx) GetAttestationPayload() []byte {
 	if x != nil {
-		return x.UserDeletes
+		return x.AttestationPayload
 	}
 	return nil
 }
 
-type UserRead struct {
+// TxDeadline is the deadline of a transaction, by block number, by block timestamp, or both. A zero field sets no
+// deadline.
+type TxDeadline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId  string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Version *Version `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The number of the last block that may hold the transaction.
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The latest timestamp, in nanoseconds since the Unix epoch, of a block that may hold the transaction.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *UserRead) Reset() {
-	*x = UserRead{}
+func (x *TxDeadline) Reset() {
+	*x = TxDeadline{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1332,13 +1627,13 @@ func (x *UserRead) Reset() {
 	}
 }
 
-func (x *UserRead) String() string {
+func (x *TxDeadline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRead) ProtoMessage() {}
+func (*TxDeadline) ProtoMessage() {}
 
-func (x *UserRead) ProtoReflect() protoreflect.Message {
+func (x *TxDeadline) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1350,36 +1645,40 @@ func (x *UserRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRead.ProtoReflect.Descriptor instead.
-func (*UserRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use TxDeadline.ProtoReflect.Descriptor instead.
+func (*TxDeadline) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UserRead) GetUserId() string {
+func (x *TxDeadline) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.BlockNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *UserRead) GetVersion() *Version {
+func (x *TxDeadline) GetTimestamp() int64 {
 	if x != nil {
-		return x.Version
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-type UserWrite struct {
+type DBOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Acl  *AccessControl `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+	DbName           string             `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	DataReads        []*DataRead        `protobuf:"bytes,4,rep,name=data_reads,json=dataReads,proto3" json:"data_reads,omitempty"`
+	DataWrites       []*DataWrite       `protobuf:"bytes,5,rep,name=data_writes,json=dataWrites,proto3" json:"data_writes,omitempty"`
+	DataDeletes      []*DataDelete      `protobuf:"bytes,6,rep,name=data_deletes,json=dataDeletes,proto3" json:"data_deletes,omitempty"`
+	DataDeleteRanges []*DataDeleteRange `protobuf:"bytes,7,rep,name=data_delete_ranges,json=dataDeleteRanges,proto3" json:"data_delete_ranges,omitempty"`
+	DataPatches      []*DataPatch       `protobuf:"bytes,8,rep,name=data_patches,json=dataPatches,proto3" json:"data_patches,omitempty"`
 }
 
-func (x *UserWrite) Reset() {
-	*x = UserWrite{}
+func (x *DBOperation) Reset() {
+	*x = DBOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1387,13 +1686,13 @@ func (x *UserWrite) Reset() {
 	}
 }
 
-func (x *UserWrite) String() string {
+func (x *DBOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserWrite) ProtoMessage() {}
+func (*DBOperation) ProtoMessage() {}
 
-func (x *UserWrite) ProtoReflect() protoreflect.Message {
+func (x *DBOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1405,35 +1704,65 @@ func (x *UserWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserWrite.ProtoReflect.Descriptor instead.
-func (*UserWrite) Descriptor() ([]byte, []int) {
+// Deprecated: Use DBOperation.ProtoReflect.Descriptor instead.
+func (*DBOperation) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UserWrite) GetUser() *User {
+func (x *DBOperation) GetDbName() string {
 	if x != nil {
-		return x.User
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DBOperation) GetDataReads() []*DataRead {
+	if x != nil {
+		return x.DataReads
 	}
 	return nil
 }
 
-func (x *UserWrite) GetAcl() *AccessControl {
+func (x *DBOperation) GetDataWrites() []*DataWrite {
 	if x != nil {
-		return x.Acl
+		return x.DataWrites
 	}
 	return nil
 }
 
-type UserDelete struct {
+func (x *DBOperation) GetDataDeletes() []*DataDelete {
+	if x != nil {
+		return x.DataDeletes
+	}
+	return nil
+}
+
+func (x *DBOperation) GetDataDeleteRanges() []*DataDeleteRange {
+	if x != nil {
+		return x.DataDeleteRanges
+	}
+	return nil
+}
+
+func (x *DBOperation) GetDataPatches() []*DataPatch {
+	if x != nil {
+		return x.DataPatches
+	}
+	return nil
+}
+
+// DataRead hold a read key and its version
+type DataRead struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version *Version `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *UserDelete) Reset() {
-	*x = UserDelete{}
+func (x *DataRead) Reset() {
+	*x = DataRead{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1441,13 +1770,13 @@ func (x *UserDelete) Reset() {
 	}
 }
 
-func (x *UserDelete) String() string {
+func (x *DataRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserDelete) ProtoMessage() {}
+func (*DataRead) ProtoMessage() {}
 
-func (x *UserDelete) ProtoReflect() protoreflect.Message {
+func (x *DataRead) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1459,29 +1788,38 @@ func (x *UserDelete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserDelete.ProtoReflect.Descriptor instead.
-func (*UserDelete) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataRead.ProtoReflect.Descriptor instead.
+func (*DataRead) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UserDelete) GetUserId() string {
+func (x *DataRead) GetKey() string {
 	if x != nil {
-		return x.UserId
+		return x.Key
 	}
 	return ""
 }
 
-type Metadata struct {
+func (x *DataRead) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// DataWrite hold a write including a delete
+type DataWrite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version       *Version       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	AccessControl *AccessControl `protobuf:"bytes,2,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
+	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Acl   *AccessControl `protobuf:"bytes,3,opt,name=acl,proto3" json:"acl,omitempty"`
 }
 
-func (x *Metadata) Reset() {
-	*x = Metadata{}
+func (x *DataWrite) Reset() {
+	*x = DataWrite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1489,13 +1827,13 @@ func (x *Metadata) Reset() {
 	}
 }
 
-func (x *Metadata) String() string {
+func (x *DataWrite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metadata) ProtoMessage() {}
+func (*DataWrite) ProtoMessage() {}
 
-func (x *Metadata) ProtoReflect() protoreflect.Message {
+func (x *DataWrite) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1507,36 +1845,42 @@ func (x *Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
-func (*Metadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataWrite.ProtoReflect.Descriptor instead.
+func (*DataWrite) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Metadata) GetVersion() *Version {
+func (x *DataWrite) GetKey() string {
 	if x != nil {
-		return x.Version
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DataWrite) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-func (x *Metadata) GetAccessControl() *AccessControl {
+func (x *DataWrite) GetAcl() *AccessControl {
 	if x != nil {
-		return x.AccessControl
+		return x.Acl
 	}
 	return nil
 }
 
-type Version struct {
+type DataDelete struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockNum uint64 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
-	TxNum    uint64 `protobuf:"varint,2,opt,name=tx_num,json=txNum,proto3" json:"tx_num,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *Version) Reset() {
-	*x = Version{}
+func (x *DataDelete) Reset() {
+	*x = DataDelete{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1544,13 +1888,13 @@ func (x *Version) Reset() {
 	}
 }
 
-func (x *Version) String() string {
+func (x *DataDelete) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Version) ProtoMessage() {}
+func (*DataDelete) ProtoMessage() {}
 
-func (x *Version) ProtoReflect() protoreflect.Message {
+func (x *DataDelete) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1562,37 +1906,33 @@ func (x *Version) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Version.ProtoReflect.Descriptor instead.
-func (*Version) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataDelete.ProtoReflect.Descriptor instead.
+func (*DataDelete) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Version) GetBlockNum() uint64 {
-	if x != nil {
-		return x.BlockNum
-	}
-	return 0
-}
-
-func (x *Version) GetTxNum() uint64 {
+func (x *DataDelete) GetKey() string {
 	if x != nil {
-		return x.TxNum
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-type AccessControl struct {
+// DataDeleteRange deletes all the keys in the range [start_key, end_key), or all the keys that begin with the prefix.
+// An empty start_key or end_key denotes the first or the last key in the database, respectively. The prefix cannot
+// be set together with start_key or end_key.
+type DataDeleteRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReadUsers          map[string]bool          `protobuf:"bytes,1,rep,name=read_users,json=readUsers,proto3" json:"read_users,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	ReadWriteUsers     map[string]bool          `protobuf:"bytes,2,rep,name=read_write_users,json=readWriteUsers,proto3" json:"read_write_users,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	SignPolicyForWrite AccessControlWritePolicy `protobuf:"varint,3,opt,name=sign_policy_for_write,json=signPolicyForWrite,proto3,enum=types.AccessControlWritePolicy" json:"sign_policy_for_write,omitempty"`
+	StartKey string `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   string `protobuf:"bytes,2,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	Prefix   string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
 }
 
-func (x *AccessControl) Reset() {
-	*x = AccessControl{}
+func (x *DataDeleteRange) Reset() {
+	*x = DataDeleteRange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1600,13 +1940,13 @@ func (x *AccessControl) Reset() {
 	}
 }
 
-func (x *AccessControl) String() string {
+func (x *DataDeleteRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessControl) ProtoMessage() {}
+func (*DataDeleteRange) ProtoMessage() {}
 
-func (x *AccessControl) ProtoReflect() protoreflect.Message {
+func (x *DataDeleteRange) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1618,44 +1958,49 @@ func (x *AccessControl) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
-func (*AccessControl) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataDeleteRange.ProtoReflect.Descriptor instead.
+func (*DataDeleteRange) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AccessControl) GetReadUsers() map[string]bool {
+func (x *DataDeleteRange) GetStartKey() string {
 	if x != nil {
-		return x.ReadUsers
+		return x.StartKey
 	}
-	return nil
+	return ""
 }
 
-func (x *AccessControl) GetReadWriteUsers() map[string]bool {
+func (x *DataDeleteRange) GetEndKey() string {
 	if x != nil {
-		return x.ReadWriteUsers
+		return x.EndKey
 	}
-	return nil
+	return ""
 }
 
-func (x *AccessControl) GetSignPolicyForWrite() AccessControlWritePolicy {
+func (x *DataDeleteRange) GetPrefix() string {
 	if x != nil {
-		return x.SignPolicyForWrite
+		return x.Prefix
 	}
-	return AccessControl_ANY
+	return ""
 }
 
-type KVWithMetadata struct {
+// DataPatch updates the JSON document stored under the key by applying either a JSON merge patch (RFC 7386) or a
+// list of JSON patch operations (RFC 6902) to it, where exactly one of the two must be set. The version is the version
+// of the document which was read by the client, and the patch is applied only if it is still the committed version.
+// The resulting document replaces the value and keeps the access control of the key.
+type DataPatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key      string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value    []byte    `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Metadata *Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Key        string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version    *Version              `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	MergePatch []byte                `protobuf:"bytes,3,opt,name=merge_patch,json=mergePatch,proto3" json:"merge_patch,omitempty"`
+	Operations []*JSONPatchOperation `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *KVWithMetadata) Reset() {
-	*x = KVWithMetadata{}
+func (x *DataPatch) Reset() {
+	*x = DataPatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1663,13 +2008,13 @@ func (x *KVWithMetadata) Reset() {
 	}
 }
 
-func (x *KVWithMetadata) String() string {
+func (x *DataPatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVWithMetadata) ProtoMessage() {}
+func (*DataPatch) ProtoMessage() {}
 
-func (x *KVWithMetadata) ProtoReflect() protoreflect.Message {
+func (x *DataPatch) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1681,43 +2026,54 @@ func (x *KVWithMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVWithMetadata.ProtoReflect.Descriptor instead.
-func (*KVWithMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataPatch.ProtoReflect.Descriptor instead.
+func (*DataPatch) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *KVWithMetadata) GetKey() string {
+func (x *DataPatch) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-func (x *KVWithMetadata) GetValue() []byte {
+func (x *DataPatch) GetVersion() *Version {
 	if x != nil {
-		return x.Value
+		return x.Version
 	}
 	return nil
 }
 
-func (x *KVWithMetadata) GetMetadata() *Metadata {
+func (x *DataPatch) GetMergePatch() []byte {
 	if x != nil {
-		return x.Metadata
+		return x.MergePatch
 	}
 	return nil
 }
 
-type ValueWithMetadata struct {
+func (x *DataPatch) GetOperations() []*JSONPatchOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// JSONPatchOperation is a single operation of a JSON patch (RFC 6902). The op is one of add, remove, replace, move,
+// copy, and test. The path and from are JSON pointers (RFC 6901), and the value is a JSON encoded document.
+type JSONPatchOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value    []byte    `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Metadata *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Op    string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Path  string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	From  string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	Value []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ValueWithMetadata) Reset() {
-	*x = ValueWithMetadata{}
+func (x *JSONPatchOperation) Reset() {
+	*x = JSONPatchOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1725,13 +2081,13 @@ func (x *ValueWithMetadata) Reset() {
 	}
 }
 
-func (x *ValueWithMetadata) String() string {
+func (x *JSONPatchOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValueWithMetadata) ProtoMessage() {}
+func (*JSONPatchOperation) ProtoMessage() {}
 
-func (x *ValueWithMetadata) ProtoReflect() protoreflect.Message {
+func (x *JSONPatchOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1743,38 +2099,52 @@ func (x *ValueWithMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValueWithMetadata.ProtoReflect.Descriptor instead.
-func (*ValueWithMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use JSONPatchOperation.ProtoReflect.Descriptor instead.
+func (*JSONPatchOperation) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ValueWithMetadata) GetValue() []byte {
+func (x *JSONPatchOperation) GetOp() string {
 	if x != nil {
-		return x.Value
+		return x.Op
 	}
-	return nil
+	return ""
 }
 
-func (x *ValueWithMetadata) GetMetadata() *Metadata {
+func (x *JSONPatchOperation) GetPath() string {
 	if x != nil {
-		return x.Metadata
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONPatchOperation) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *JSONPatchOperation) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-type Digest struct {
+type ConfigTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Ledger merkle tree root
-	RootHash []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
-	// Ledger height
-	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	UserId               string         `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TxId                 string         `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ReadOldConfigVersion *Version       `protobuf:"bytes,3,opt,name=read_old_config_version,json=readOldConfigVersion,proto3" json:"read_old_config_version,omitempty"`
+	NewConfig            *ClusterConfig `protobuf:"bytes,4,opt,name=new_config,json=newConfig,proto3" json:"new_config,omitempty"`
 }
 
-func (x *Digest) Reset() {
-	*x = Digest{}
+func (x *ConfigTx) Reset() {
+	*x = ConfigTx{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1782,13 +2152,13 @@ func (x *Digest) Reset() {
 	}
 }
 
-func (x *Digest) String() string {
+func (x *ConfigTx) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Digest) ProtoMessage() {}
+func (*ConfigTx) ProtoMessage() {}
 
-func (x *Digest) ProtoReflect() protoreflect.Message {
+func (x *ConfigTx) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1800,36 +2170,59 @@ func (x *Digest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
-func (*Digest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfigTx.ProtoReflect.Descriptor instead.
+func (*ConfigTx) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Digest) GetRootHash() []byte {
+func (x *ConfigTx) GetUserId() string {
 	if x != nil {
-		return x.RootHash
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfigTx) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ConfigTx) GetReadOldConfigVersion() *Version {
+	if x != nil {
+		return x.ReadOldConfigVersion
 	}
 	return nil
 }
 
-func (x *Digest) GetHeight() uint64 {
+func (x *ConfigTx) GetNewConfig() *ClusterConfig {
 	if x != nil {
-		return x.Height
+		return x.NewConfig
 	}
-	return 0
+	return nil
 }
 
-type ValidationInfo struct {
+type DBAdministrationTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flag            Flag   `protobuf:"varint,1,opt,name=flag,proto3,enum=types.Flag" json:"flag,omitempty"`
-	ReasonIfInvalid string `protobuf:"bytes,2,opt,name=reason_if_invalid,json=reasonIfInvalid,proto3" json:"reason_if_invalid,omitempty"`
+	UserId          string                     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TxId            string                     `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	CreateDbs       []string                   `protobuf:"bytes,3,rep,name=create_dbs,json=createDbs,proto3" json:"create_dbs,omitempty"`
+	DeleteDbs       []string                   `protobuf:"bytes,4,rep,name=delete_dbs,json=deleteDbs,proto3" json:"delete_dbs,omitempty"`
+	DbsIndex        map[string]*DBIndex        `protobuf:"bytes,5,rep,name=dbs_index,json=dbsIndex,proto3" json:"dbs_index,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DbsSchema       map[string]*DBSchema       `protobuf:"bytes,6,rep,name=dbs_schema,json=dbsSchema,proto3" json:"dbs_schema,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DbsDefaultAcl   map[string]*DBDefaultACL   `protobuf:"bytes,7,rep,name=dbs_default_acl,json=dbsDefaultAcl,proto3" json:"dbs_default_acl,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DbsMaxValueSize map[string]*DBMaxValueSize `protobuf:"bytes,8,rep,name=dbs_max_value_size,json=dbsMaxValueSize,proto3" json:"dbs_max_value_size,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DbsKeyCollation map[string]*DBKeyCollation `protobuf:"bytes,9,rep,name=dbs_key_collation,json=dbsKeyCollation,proto3" json:"dbs_key_collation,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// immutable_dbs are the databases created by the transaction as immutable, see DBDescriptor.immutable.
+	ImmutableDbs []string `protobuf:"bytes,10,rep,name=immutable_dbs,json=immutableDbs,proto3" json:"immutable_dbs,omitempty"`
 }
 
-func (x *ValidationInfo) Reset() {
-	*x = ValidationInfo{}
+func (x *DBAdministrationTx) Reset() {
+	*x = DBAdministrationTx{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,13 +2230,13 @@ func (x *ValidationInfo) Reset() {
 	}
 }
 
-func (x *ValidationInfo) String() string {
+func (x *DBAdministrationTx) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidationInfo) ProtoMessage() {}
+func (*DBAdministrationTx) ProtoMessage() {}
 
-func (x *ValidationInfo) ProtoReflect() protoreflect.Message {
+func (x *DBAdministrationTx) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1855,106 +2248,106 @@ func (x *ValidationInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidationInfo.ProtoReflect.Descriptor instead.
-func (*ValidationInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DBAdministrationTx.ProtoReflect.Descriptor instead.
+func (*DBAdministrationTx) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ValidationInfo) GetFlag() Flag {
+func (x *DBAdministrationTx) GetUserId() string {
 	if x != nil {
-		return x.Flag
+		return x.UserId
 	}
-	return Flag_VALID
+	return ""
 }
 
-func (x *ValidationInfo) GetReasonIfInvalid() string {
+func (x *DBAdministrationTx) GetTxId() string {
 	if x != nil {
-		return x.ReasonIfInvalid
+		return x.TxId
 	}
 	return ""
 }
 
-type TxProof struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Header *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Path   [][]byte     `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+func (x *DBAdministrationTx) GetCreateDbs() []string {
+	if x != nil {
+		return x.CreateDbs
+	}
+	return nil
 }
 
-func (x *TxProof) Reset() {
-	*x = TxProof{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_block_and_transaction_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DBAdministrationTx) GetDeleteDbs() []string {
+	if x != nil {
+		return x.DeleteDbs
 	}
+	return nil
 }
 
-func (x *TxProof) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DBAdministrationTx) GetDbsIndex() map[string]*DBIndex {
+	if x != nil {
+		return x.DbsIndex
+	}
+	return nil
 }
 
-func (*TxProof) ProtoMessage() {}
+func (x *DBAdministrationTx) GetDbsSchema() map[string]*DBSchema {
+	if x != nil {
+		return x.DbsSchema
+	}
+	return nil
+}
 
-func (x *TxProof) ProtoReflect() protoreflect.Message {
-	mi := &file_block_and_transaction_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DBAdministrationTx) GetDbsDefaultAcl() map[string]*DBDefaultACL {
+	if x != nil {
+		return x.DbsDefaultAcl
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TxProof.ProtoReflect.Descriptor instead.
-func (*TxProof) Descriptor() ([]byte, []int) {
-	return file_block_and_transaction_proto_rawDescGZIP(), []int{27}
+func (x *DBAdministrationTx) GetDbsMaxValueSize() map[string]*DBMaxValueSize {
+	if x != nil {
+		return x.DbsMaxValueSize
+	}
+	return nil
 }
 
-func (x *TxProof) GetHeader() *BlockHeader {
+func (x *DBAdministrationTx) GetDbsKeyCollation() map[string]*DBKeyCollation {
 	if x != nil {
-		return x.Header
+		return x.DbsKeyCollation
 	}
 	return nil
 }
 
-func (x *TxProof) GetPath() [][]byte {
+func (x *DBAdministrationTx) GetImmutableDbs() []string {
 	if x != nil {
-		return x.Path
+		return x.ImmutableDbs
 	}
 	return nil
 }
 
-type BlockProof struct {
+type DBIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockNumber uint64         `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Path        []*BlockHeader `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	AttributeAndType map[string]IndexAttributeType `protobuf:"bytes,1,rep,name=attribute_and_type,json=attributeAndType,proto3" json:"attribute_and_type,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=types.IndexAttributeType"`
 }
 
-func (x *BlockProof) Reset() {
-	*x = BlockProof{}
+func (x *DBIndex) Reset() {
+	*x = DBIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_block_and_transaction_proto_msgTypes[28]
+		mi := &file_block_and_transaction_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockProof) String() string {
+func (x *DBIndex) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockProof) ProtoMessage() {}
+func (*DBIndex) ProtoMessage() {}
 
-func (x *BlockProof) ProtoReflect() protoreflect.Message {
-	mi := &file_block_and_transaction_proto_msgTypes[28]
+func (x *DBIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,36 +2358,80 @@ func (x *BlockProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockProof.ProtoReflect.Descriptor instead.
-func (*BlockProof) Descriptor() ([]byte, []int) {
-	return file_block_and_transaction_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DBIndex.ProtoReflect.Descriptor instead.
+func (*DBIndex) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *BlockProof) GetBlockNumber() uint64 {
+func (x *DBIndex) GetAttributeAndType() map[string]IndexAttributeType {
 	if x != nil {
-		return x.BlockNumber
+		return x.AttributeAndType
 	}
-	return 0
+	return nil
 }
 
-func (x *BlockProof) GetPath() []*BlockHeader {
+// DBSchema registers a JSON schema that the values written to a database must conform to. An empty schema removes
+// the registered one.
+type DBSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonSchema string `protobuf:"bytes,1,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+}
+
+func (x *DBSchema) Reset() {
+	*x = DBSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSchema) ProtoMessage() {}
+
+func (x *DBSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSchema.ProtoReflect.Descriptor instead.
+func (*DBSchema) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DBSchema) GetJsonSchema() string {
 	if x != nil {
-		return x.Path
+		return x.JsonSchema
 	}
-	return nil
+	return ""
 }
 
-type TxReceipt struct {
+// DBDefaultACL sets the access control that applies to the writes and deletes of the keys of a database which carry
+// no access control of their own. A default ACL without an access control removes the set one, while an access control
+// without read-write users freezes the keys.
+type DBDefaultACL struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header  *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	TxIndex uint64       `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Acl *AccessControl `protobuf:"bytes,1,opt,name=acl,proto3" json:"acl,omitempty"`
 }
 
-func (x *TxReceipt) Reset() {
-	*x = TxReceipt{}
+func (x *DBDefaultACL) Reset() {
+	*x = DBDefaultACL{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,13 +2439,13 @@ func (x *TxReceipt) Reset() {
 	}
 }
 
-func (x *TxReceipt) String() string {
+func (x *DBDefaultACL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxReceipt) ProtoMessage() {}
+func (*DBDefaultACL) ProtoMessage() {}
 
-func (x *TxReceipt) ProtoReflect() protoreflect.Message {
+func (x *DBDefaultACL) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2020,40 +2457,30 @@ func (x *TxReceipt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxReceipt.ProtoReflect.Descriptor instead.
-func (*TxReceipt) Descriptor() ([]byte, []int) {
+// Deprecated: Use DBDefaultACL.ProtoReflect.Descriptor instead.
+func (*DBDefaultACL) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *TxReceipt) GetHeader() *BlockHeader {
+func (x *DBDefaultACL) GetAcl() *AccessControl {
 	if x != nil {
-		return x.Header
+		return x.Acl
 	}
 	return nil
 }
 
-func (x *TxReceipt) GetTxIndex() uint64 {
-	if x != nil {
-		return x.TxIndex
-	}
-	return 0
-}
-
-// ConsensusMetadata holds data specific to the consensus protocol ordering the block.
-// The field prefix indicated the protocil used, e.g. "raft_*".
-type ConsensusMetadata struct {
+// DBMaxValueSize caps the size, in bytes, of the values written to a database. A zero cap removes the set one. A cap
+// applies to the writes of the next blocks, and leaves the committed values as they are.
+type DBMaxValueSize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The Raft term associated with the block
-	RaftTerm uint64 `protobuf:"varint,1,opt,name=raft_term,json=raftTerm,proto3" json:"raft_term,omitempty"`
-	// The Raft index associated with the block
-	RaftIndex uint64 `protobuf:"varint,2,opt,name=raft_index,json=raftIndex,proto3" json:"raft_index,omitempty"`
+	MaxValueSizeBytes uint64 `protobuf:"varint,1,opt,name=max_value_size_bytes,json=maxValueSizeBytes,proto3" json:"max_value_size_bytes,omitempty"`
 }
 
-func (x *ConsensusMetadata) Reset() {
-	*x = ConsensusMetadata{}
+func (x *DBMaxValueSize) Reset() {
+	*x = DBMaxValueSize{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2061,13 +2488,13 @@ func (x *ConsensusMetadata) Reset() {
 	}
 }
 
-func (x *ConsensusMetadata) String() string {
+func (x *DBMaxValueSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsensusMetadata) ProtoMessage() {}
+func (*DBMaxValueSize) ProtoMessage() {}
 
-func (x *ConsensusMetadata) ProtoReflect() protoreflect.Message {
+func (x *DBMaxValueSize) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2079,36 +2506,38 @@ func (x *ConsensusMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsensusMetadata.ProtoReflect.Descriptor instead.
-func (*ConsensusMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use DBMaxValueSize.ProtoReflect.Descriptor instead.
+func (*DBMaxValueSize) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ConsensusMetadata) GetRaftTerm() uint64 {
-	if x != nil {
-		return x.RaftTerm
-	}
-	return 0
-}
-
-func (x *ConsensusMetadata) GetRaftIndex() uint64 {
+func (x *DBMaxValueSize) GetMaxValueSizeBytes() uint64 {
 	if x != nil {
-		return x.RaftIndex
+		return x.MaxValueSizeBytes
 	}
 	return 0
 }
 
-type AugmentedBlockHeader struct {
+// DBKeyCollation sets the order of the keys of a database, which the range queries and the range deletes follow. A
+// collation can only be set by the transaction that creates the database, and cannot be changed afterwards.
+//   - BINARY orders the keys lexicographically, byte by byte, which is the order of a database without a collation.
+//   - NUMERIC orders the keys, which must consist of decimal digits only, by their numeric value, e.g., "9" < "10". Of
+//     the keys with the same value, the one with fewer leading zeros comes first.
+//   - COMPOSITE splits the keys by the separator into as many segments as there are segment types, and orders them
+//     segment by segment, each by its type. A TEXT segment is ordered as a BINARY key, and a NUMBER segment as a NUMERIC
+//     one. A range bound may hold fewer segments than a key, and then bounds all the keys beginning with them.
+type DBKeyCollation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	TxIds  []string     `protobuf:"bytes,2,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	Mode      DBKeyCollation_Mode          `protobuf:"varint,1,opt,name=mode,proto3,enum=types.DBKeyCollation_Mode" json:"mode,omitempty"`
+	Segments  []DBKeyCollation_SegmentType `protobuf:"varint,2,rep,packed,name=segments,proto3,enum=types.DBKeyCollation_SegmentType" json:"segments,omitempty"`
+	Separator string                       `protobuf:"bytes,3,opt,name=separator,proto3" json:"separator,omitempty"`
 }
 
-func (x *AugmentedBlockHeader) Reset() {
-	*x = AugmentedBlockHeader{}
+func (x *DBKeyCollation) Reset() {
+	*x = DBKeyCollation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_block_and_transaction_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2116,13 +2545,13 @@ func (x *AugmentedBlockHeader) Reset() {
 	}
 }
 
-func (x *AugmentedBlockHeader) String() string {
+func (x *DBKeyCollation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AugmentedBlockHeader) ProtoMessage() {}
+func (*DBKeyCollation) ProtoMessage() {}
 
-func (x *AugmentedBlockHeader) ProtoReflect() protoreflect.Message {
+func (x *DBKeyCollation) ProtoReflect() protoreflect.Message {
 	mi := &file_block_and_transaction_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2134,463 +2563,3466 @@ func (x *AugmentedBlockHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AugmentedBlockHeader.ProtoReflect.Descriptor instead.
-func (*AugmentedBlockHeader) Descriptor() ([]byte, []int) {
+// Deprecated: Use DBKeyCollation.ProtoReflect.Descriptor instead.
+func (*DBKeyCollation) Descriptor() ([]byte, []int) {
 	return file_block_and_transaction_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AugmentedBlockHeader) GetHeader() *BlockHeader {
+func (x *DBKeyCollation) GetMode() DBKeyCollation_Mode {
 	if x != nil {
-		return x.Header
+		return x.Mode
 	}
-	return nil
+	return DBKeyCollation_BINARY
 }
 
-func (x *AugmentedBlockHeader) GetTxIds() []string {
+func (x *DBKeyCollation) GetSegments() []DBKeyCollation_SegmentType {
 	if x != nil {
-		return x.TxIds
+		return x.Segments
 	}
 	return nil
 }
 
-var File_block_and_transaction_proto protoreflect.FileDescriptor
+func (x *DBKeyCollation) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
 
-var file_block_and_transaction_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x1a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x03, 0x0a, 0x05, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x44, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
-	0x65, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
-	0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x66,
-	0x0a, 0x1d, 0x64, 0x62, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78,
-	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x64, 0x62, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e,
-	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x6c, 0x0a, 0x1f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x78,
-	0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
-	0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
-	0x75, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a,
-	0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x42, 0x61, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x39, 0x0a, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6c, 0x61,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4e, 0x75, 0x6d, 0x22, 0xa7, 0x02, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
-	0x73, 0x6b, 0x69, 0x70, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x18, 0x74, 0x78, 0x5f, 0x6d, 0x65,
-	0x72, 0x6b, 0x65, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x74, 0x78, 0x4d, 0x65, 0x72,
-	0x6b, 0x65, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x3c, 0x0a, 0x1b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x65, 0x6c, 0x5f,
-	0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x72, 0x6b, 0x65,
-	0x6c, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a,
-	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x46, 0x0a,
-	0x0f, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73,
-	0x12, 0x33, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78,
-	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x78, 0x52, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x6f, 0x0a, 0x1a, 0x44, 0x42, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
-	0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x73, 0x0a, 0x1c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x78, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x06, 0x44,
-	0x61, 0x74, 0x61, 0x54, 0x78, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x73, 0x69,
-	0x67, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0f, 0x6d, 0x75, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0d, 0x64, 0x62, 0x5f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x62, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x44, 0x42, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x64, 0x61, 0x74,
-	0x61, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x52, 0x09,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x64, 0x61, 0x74,
-	0x61, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0c,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x73, 0x22, 0x46, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x09, 0x44, 0x61,
-	0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x26, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x22, 0x1e, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xb4, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x54, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a,
-	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
-	0x49, 0x64, 0x12, 0x45, 0x0a, 0x17, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x14, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x6e, 0x65, 0x77,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x93,
-	0x02, 0x0a, 0x12, 0x44, 0x42, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13,
-	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x78, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x62,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x62, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x64, 0x62, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x62,
-	0x73, 0x12, 0x44, 0x0a, 0x09, 0x64, 0x62, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x2e,
-	0x44, 0x62, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64,
-	0x62, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x4b, 0x0a, 0x0d, 0x44, 0x62, 0x73, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xbd, 0x01, 0x0a, 0x07, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x52, 0x0a, 0x12, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x41, 0x6e, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x1a, 0x5e, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x41, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x34,
-	0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x22, 0x25, 0x0a, 0x0a, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x71, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x22, 0x3d, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x15, 0x0a, 0x06, 0x74,
-	0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x78, 0x4e,
-	0x75, 0x6d, 0x22, 0xa0, 0x03, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x72,
-	0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x64,
-	0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x65,
-	0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x15,
-	0x73, 0x69, 0x67, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x12,
-	0x73, 0x69, 0x67, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46, 0x6f, 0x72, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x20, 0x0a, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4c, 0x4c, 0x10, 0x01, 0x22, 0x65, 0x0a, 0x0e, 0x4b, 0x56, 0x57, 0x69, 0x74, 0x68, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x56, 0x0a, 0x11,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x06, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x5d, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x6c, 0x61, 0x67,
-	0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x5f, 0x69, 0x66, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x66, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x22, 0x49, 0x0a, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x0a,
-	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x57, 0x0a,
-	0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x52, 0x0a, 0x09, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4f, 0x0a, 0x11, 0x43, 0x6f,
-	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x1b, 0x0a, 0x09, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x72, 0x61, 0x66, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x59, 0x0a, 0x14, 0x41,
-	0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x2a, 0x81, 0x02, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12,
-	0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x56, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
-	0x49, 0x43, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x49, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x01, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x56,
-	0x43, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x41,
-	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
-	0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x49, 0x45, 0x53, 0x10,
-	0x05, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x41,
-	0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x53, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x49,
-	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x53,
-	0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x07, 0x2a, 0x39, 0x0a, 0x12, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c,
-	0x45, 0x41, 0x4e, 0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d,
-	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+// DBDescriptor holds the settings of a database that govern the validation of the transactions writing to it.
+type DBDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonSchema        string          `protobuf:"bytes,1,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	DefaultAcl        *AccessControl  `protobuf:"bytes,2,opt,name=default_acl,json=defaultAcl,proto3" json:"default_acl,omitempty"`
+	MaxValueSizeBytes uint64          `protobuf:"varint,3,opt,name=max_value_size_bytes,json=maxValueSizeBytes,proto3" json:"max_value_size_bytes,omitempty"`
+	KeyCollation      *DBKeyCollation `protobuf:"bytes,4,opt,name=key_collation,json=keyCollation,proto3" json:"key_collation,omitempty"`
+	// immutable is set on a database of content-addressed records, which is declared immutable at its creation. A key
+	// of an immutable database is written once: a write of a key that exists with the same value is a no-op, which
+	// leaves its version as it is, while a write with another value, and a delete, are invalid.
+	Immutable bool `protobuf:"varint,5,opt,name=immutable,proto3" json:"immutable,omitempty"`
 }
 
-var (
-	file_block_and_transaction_proto_rawDescOnce sync.Once
-	file_block_and_transaction_proto_rawDescData = file_block_and_transaction_proto_rawDesc
-)
+func (x *DBDescriptor) Reset() {
+	*x = DBDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_block_and_transaction_proto_rawDescGZIP() []byte {
-	file_block_and_transaction_proto_rawDescOnce.Do(func() {
-		file_block_and_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_block_and_transaction_proto_rawDescData)
-	})
-	return file_block_and_transaction_proto_rawDescData
+func (x *DBDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_block_and_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_block_and_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
-var file_block_and_transaction_proto_goTypes = []interface{}{
-	(Flag)(0),                            // 0: types.Flag
-	(IndexAttributeType)(0),              // 1: types.IndexAttributeType
-	(AccessControlWritePolicy)(0),        // 2: types.AccessControl.write_policy
-	(*Block)(nil),                        // 3: types.Block
-	(*BlockHeaderBase)(nil),              // 4: types.BlockHeaderBase
-	(*BlockHeader)(nil),                  // 5: types.BlockHeader
-	(*DataTxEnvelopes)(nil),              // 6: types.DataTxEnvelopes
-	(*DataTxEnvelope)(nil),               // 7: types.DataTxEnvelope
-	(*ConfigTxEnvelope)(nil),             // 8: types.ConfigTxEnvelope
-	(*DBAdministrationTxEnvelope)(nil),   // 9: types.DBAdministrationTxEnvelope
-	(*UserAdministrationTxEnvelope)(nil), // 10: types.UserAdministrationTxEnvelope
-	(*DataTx)(nil),                       // 11: types.DataTx
-	(*DBOperation)(nil),                  // 12: types.DBOperation
-	(*DataRead)(nil),                     // 13: types.DataRead
-	(*DataWrite)(nil),                    // 14: types.DataWrite
-	(*DataDelete)(nil),                   // 15: types.DataDelete
-	(*ConfigTx)(nil),                     // 16: types.ConfigTx
-	(*DBAdministrationTx)(nil),           // 17: types.DBAdministrationTx
-	(*DBIndex)(nil),                      // 18: types.DBIndex
-	(*UserAdministrationTx)(nil),         // 19: types.UserAdministrationTx
-	(*UserRead)(nil),                     // 20: types.UserRead
-	(*UserWrite)(nil),                    // 21: types.UserWrite
-	(*UserDelete)(nil),                   // 22: types.UserDelete
-	(*Metadata)(nil),                     // 23: types.Metadata
-	(*Version)(nil),                      // 24: types.Version
-	(*AccessControl)(nil),                // 25: types.AccessControl
-	(*KVWithMetadata)(nil),               // 26: types.KVWithMetadata
-	(*ValueWithMetadata)(nil),            // 27: types.ValueWithMetadata
-	(*Digest)(nil),                       // 28: types.Digest
-	(*ValidationInfo)(nil),               // 29: types.ValidationInfo
-	(*TxProof)(nil),                      // 30: types.TxProof
-	(*BlockProof)(nil),                   // 31: types.BlockProof
-	(*TxReceipt)(nil),                    // 32: types.TxReceipt
-	(*ConsensusMetadata)(nil),            // 33: types.ConsensusMetadata
-	(*AugmentedBlockHeader)(nil),         // 34: types.AugmentedBlockHeader
-	nil,                                  // 35: types.DataTxEnvelope.SignaturesEntry
-	nil,                                  // 36: types.DBAdministrationTx.DbsIndexEntry
-	nil,                                  // 37: types.DBIndex.AttributeAndTypeEntry
-	nil,                                  // 38: types.AccessControl.ReadUsersEntry
-	nil,                                  // 39: types.AccessControl.ReadWriteUsersEntry
-	(*ClusterConfig)(nil),                // 40: types.ClusterConfig
-	(*User)(nil),                         // 41: types.User
+func (*DBDescriptor) ProtoMessage() {}
+
+func (x *DBDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_block_and_transaction_proto_depIdxs = []int32{
-	5,  // 0: types.Block.header:type_name -> types.BlockHeader
-	6,  // 1: types.Block.data_tx_envelopes:type_name -> types.DataTxEnvelopes
-	8,  // 2: types.Block.config_tx_envelope:type_name -> types.ConfigTxEnvelope
-	9,  // 3: types.Block.db_administration_tx_envelope:type_name -> types.DBAdministrationTxEnvelope
-	10, // 4: types.Block.user_administration_tx_envelope:type_name -> types.UserAdministrationTxEnvelope
-	33, // 5: types.Block.consensus_metadata:type_name -> types.ConsensusMetadata
-	4,  // 6: types.BlockHeader.base_header:type_name -> types.BlockHeaderBase
-	29, // 7: types.BlockHeader.validation_info:type_name -> types.ValidationInfo
-	7,  // 8: types.DataTxEnvelopes.envelopes:type_name -> types.DataTxEnvelope
-	11, // 9: types.DataTxEnvelope.payload:type_name -> types.DataTx
-	35, // 10: types.DataTxEnvelope.signatures:type_name -> types.DataTxEnvelope.SignaturesEntry
-	16, // 11: types.ConfigTxEnvelope.payload:type_name -> types.ConfigTx
-	17, // 12: types.DBAdministrationTxEnvelope.payload:type_name -> types.DBAdministrationTx
-	19, // 13: types.UserAdministrationTxEnvelope.payload:type_name -> types.UserAdministrationTx
-	12, // 14: types.DataTx.db_operations:type_name -> types.DBOperation
-	13, // 15: types.DBOperation.data_reads:type_name -> types.DataRead
-	14, // 16: types.DBOperation.data_writes:type_name -> types.DataWrite
-	15, // 17: types.DBOperation.data_deletes:type_name -> types.DataDelete
-	24, // 18: types.DataRead.version:type_name -> types.Version
-	25, // 19: types.DataWrite.acl:type_name -> types.AccessControl
-	24, // 20: types.ConfigTx.read_old_config_version:type_name -> types.Version
-	40, // 21: types.ConfigTx.new_config:type_name -> types.ClusterConfig
-	36, // 22: types.DBAdministrationTx.dbs_index:type_name -> types.DBAdministrationTx.DbsIndexEntry
-	37, // 23: types.DBIndex.attribute_and_type:type_name -> types.DBIndex.AttributeAndTypeEntry
-	20, // 24: types.UserAdministrationTx.user_reads:type_name -> types.UserRead
-	21, // 25: types.UserAdministrationTx.user_writes:type_name -> types.UserWrite
-	22, // 26: types.UserAdministrationTx.user_deletes:type_name -> types.UserDelete
-	24, // 27: types.UserRead.version:type_name -> types.Version
-	41, // 28: types.UserWrite.user:type_name -> types.User
-	25, // 29: types.UserWrite.acl:type_name -> types.AccessControl
-	24, // 30: types.Metadata.version:type_name -> types.Version
-	25, // 31: types.Metadata.access_control:type_name -> types.AccessControl
-	38, // 32: types.AccessControl.read_users:type_name -> types.AccessControl.ReadUsersEntry
-	39, // 33: types.AccessControl.read_write_users:type_name -> types.AccessControl.ReadWriteUsersEntry
-	2,  // 34: types.AccessControl.sign_policy_for_write:type_name -> types.AccessControl.write_policy
-	23, // 35: types.KVWithMetadata.metadata:type_name -> types.Metadata
-	23, // 36: types.ValueWithMetadata.metadata:type_name -> types.Metadata
-	0,  // 37: types.ValidationInfo.flag:type_name -> types.Flag
-	5,  // 38: types.TxProof.header:type_name -> types.BlockHeader
-	5,  // 39: types.BlockProof.path:type_name -> types.BlockHeader
-	5,  // 40: types.TxReceipt.header:type_name -> types.BlockHeader
-	5,  // 41: types.AugmentedBlockHeader.header:type_name -> types.BlockHeader
-	18, // 42: types.DBAdministrationTx.DbsIndexEntry.value:type_name -> types.DBIndex
-	1,  // 43: types.DBIndex.AttributeAndTypeEntry.value:type_name -> types.IndexAttributeType
-	44, // [44:44] is the sub-list for method output_type
-	44, // [44:44] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+
+// Deprecated: Use DBDescriptor.ProtoReflect.Descriptor instead.
+func (*DBDescriptor) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{32}
 }
 
-func init() { file_block_and_transaction_proto_init() }
-func file_block_and_transaction_proto_init() {
-	if File_block_and_transaction_proto != nil {
-		return
+func (x *DBDescriptor) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
 	}
-	file_configuration_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_block_and_transaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Block); i {
+	return ""
+}
+
+func (x *DBDescriptor) GetDefaultAcl() *AccessControl {
+	if x != nil {
+		return x.DefaultAcl
+	}
+	return nil
+}
+
+func (x *DBDescriptor) GetMaxValueSizeBytes() uint64 {
+	if x != nil {
+		return x.MaxValueSizeBytes
+	}
+	return 0
+}
+
+func (x *DBDescriptor) GetKeyCollation() *DBKeyCollation {
+	if x != nil {
+		return x.KeyCollation
+	}
+	return nil
+}
+
+func (x *DBDescriptor) GetImmutable() bool {
+	if x != nil {
+		return x.Immutable
+	}
+	return false
+}
+
+type UserAdministrationTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TxId        string        `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	UserReads   []*UserRead   `protobuf:"bytes,3,rep,name=user_reads,json=userReads,proto3" json:"user_reads,omitempty"`
+	UserWrites  []*UserWrite  `protobuf:"bytes,4,rep,name=user_writes,json=userWrites,proto3" json:"user_writes,omitempty"`
+	UserDeletes []*UserDelete `protobuf:"bytes,5,rep,name=user_deletes,json=userDeletes,proto3" json:"user_deletes,omitempty"`
+}
+
+func (x *UserAdministrationTx) Reset() {
+	*x = UserAdministrationTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserAdministrationTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAdministrationTx) ProtoMessage() {}
+
+func (x *UserAdministrationTx) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAdministrationTx.ProtoReflect.Descriptor instead.
+func (*UserAdministrationTx) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UserAdministrationTx) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAdministrationTx) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *UserAdministrationTx) GetUserReads() []*UserRead {
+	if x != nil {
+		return x.UserReads
+	}
+	return nil
+}
+
+func (x *UserAdministrationTx) GetUserWrites() []*UserWrite {
+	if x != nil {
+		return x.UserWrites
+	}
+	return nil
+}
+
+func (x *UserAdministrationTx) GetUserDeletes() []*UserDelete {
+	if x != nil {
+		return x.UserDeletes
+	}
+	return nil
+}
+
+// HeartbeatTx is a system transaction which each node submits periodically to record its liveness. The last
+// heartbeat of each node is held in the heartbeats system database.
+type HeartbeatTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	TxId   string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// The software version of the node.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The last block committed by the node when it created the heartbeat.
+	LastCommittedHeight uint64 `protobuf:"varint,4,opt,name=last_committed_height,json=lastCommittedHeight,proto3" json:"last_committed_height,omitempty"`
+}
+
+func (x *HeartbeatTx) Reset() {
+	*x = HeartbeatTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatTx) ProtoMessage() {}
+
+func (x *HeartbeatTx) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatTx.ProtoReflect.Descriptor instead.
+func (*HeartbeatTx) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HeartbeatTx) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *HeartbeatTx) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *HeartbeatTx) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HeartbeatTx) GetLastCommittedHeight() uint64 {
+	if x != nil {
+		return x.LastCommittedHeight
+	}
+	return 0
+}
+
+// VoidTx voids a transaction ID that a client allocated but does not use. A valid void reserves the ID, which cannot be
+// used by any other transaction, and takes the place of the transaction in the sequence of the user, if a sequence is
+// set, but writes nothing else.
+type VoidTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TxId   string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// sequence is the sequence number of the voided transaction of the user, see DataTx.sequence. Zero denotes a
+	// transaction that is not ordered.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *VoidTx) Reset() {
+	*x = VoidTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidTx) ProtoMessage() {}
+
+func (x *VoidTx) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidTx.ProtoReflect.Descriptor instead.
+func (*VoidTx) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VoidTx) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VoidTx) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *VoidTx) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type UserRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Version *Version `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UserRead) Reset() {
+	*x = UserRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRead) ProtoMessage() {}
+
+func (x *UserRead) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRead.ProtoReflect.Descriptor instead.
+func (*UserRead) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UserRead) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserRead) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type UserWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Acl  *AccessControl `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+}
+
+func (x *UserWrite) Reset() {
+	*x = UserWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWrite) ProtoMessage() {}
+
+func (x *UserWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWrite.ProtoReflect.Descriptor instead.
+func (*UserWrite) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UserWrite) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserWrite) GetAcl() *AccessControl {
+	if x != nil {
+		return x.Acl
+	}
+	return nil
+}
+
+type UserDelete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UserDelete) Reset() {
+	*x = UserDelete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDelete) ProtoMessage() {}
+
+func (x *UserDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDelete.ProtoReflect.Descriptor instead.
+func (*UserDelete) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserDelete) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version       *Version       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	AccessControl *AccessControl `protobuf:"bytes,2,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata) ProtoMessage() {}
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Metadata) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *Metadata) GetAccessControl() *AccessControl {
+	if x != nil {
+		return x.AccessControl
+	}
+	return nil
+}
+
+type Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNum uint64 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	TxNum    uint64 `protobuf:"varint,2,opt,name=tx_num,json=txNum,proto3" json:"tx_num,omitempty"`
+}
+
+func (x *Version) Reset() {
+	*x = Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Version) ProtoMessage() {}
+
+func (x *Version) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Version.ProtoReflect.Descriptor instead.
+func (*Version) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Version) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *Version) GetTxNum() uint64 {
+	if x != nil {
+		return x.TxNum
+	}
+	return 0
+}
+
+type AccessControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadUsers          map[string]bool          `protobuf:"bytes,1,rep,name=read_users,json=readUsers,proto3" json:"read_users,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ReadWriteUsers     map[string]bool          `protobuf:"bytes,2,rep,name=read_write_users,json=readWriteUsers,proto3" json:"read_write_users,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	SignPolicyForWrite AccessControlWritePolicy `protobuf:"varint,3,opt,name=sign_policy_for_write,json=signPolicyForWrite,proto3,enum=types.AccessControlWritePolicy" json:"sign_policy_for_write,omitempty"`
+}
+
+func (x *AccessControl) Reset() {
+	*x = AccessControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessControl) ProtoMessage() {}
+
+func (x *AccessControl) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
+func (*AccessControl) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AccessControl) GetReadUsers() map[string]bool {
+	if x != nil {
+		return x.ReadUsers
+	}
+	return nil
+}
+
+func (x *AccessControl) GetReadWriteUsers() map[string]bool {
+	if x != nil {
+		return x.ReadWriteUsers
+	}
+	return nil
+}
+
+func (x *AccessControl) GetSignPolicyForWrite() AccessControlWritePolicy {
+	if x != nil {
+		return x.SignPolicyForWrite
+	}
+	return AccessControl_ANY
+}
+
+type KVWithMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value    []byte    `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata *Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *KVWithMetadata) Reset() {
+	*x = KVWithMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVWithMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWithMetadata) ProtoMessage() {}
+
+func (x *KVWithMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWithMetadata.ProtoReflect.Descriptor instead.
+func (*KVWithMetadata) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *KVWithMetadata) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KVWithMetadata) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KVWithMetadata) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ValueWithMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value    []byte    `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// value_hash is the SHA256 hash of the value, which the state database stores, and verifies on every read, in the
+	// databases which are in the integrity mode, see DatabaseConf.IntegrityCheckedDBs. It is never set in a response.
+	ValueHash []byte `protobuf:"bytes,3,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+}
+
+func (x *ValueWithMetadata) Reset() {
+	*x = ValueWithMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueWithMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueWithMetadata) ProtoMessage() {}
+
+func (x *ValueWithMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueWithMetadata.ProtoReflect.Descriptor instead.
+func (*ValueWithMetadata) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ValueWithMetadata) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ValueWithMetadata) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ValueWithMetadata) GetValueHash() []byte {
+	if x != nil {
+		return x.ValueHash
+	}
+	return nil
+}
+
+type Digest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ledger merkle tree root
+	RootHash []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	// Ledger height
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Digest) GetRootHash() []byte {
+	if x != nil {
+		return x.RootHash
+	}
+	return nil
+}
+
+func (x *Digest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ValidationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flag            Flag   `protobuf:"varint,1,opt,name=flag,proto3,enum=types.Flag" json:"flag,omitempty"`
+	ReasonIfInvalid string `protobuf:"bytes,2,opt,name=reason_if_invalid,json=reasonIfInvalid,proto3" json:"reason_if_invalid,omitempty"`
+	// write_set_digest is a deterministic hash over the writes and the deletes
+	// applied by a valid data transaction. It is empty for invalid transactions,
+	// and for the blocks of rules older than constants.WriteSetDigestRulesVersion.
+	WriteSetDigest []byte `protobuf:"bytes,3,opt,name=write_set_digest,json=writeSetDigest,proto3" json:"write_set_digest,omitempty"`
+	// conflicting_reads lists the reads of a transaction invalidated due to an
+	// mvcc conflict, along with the version each read was expected to see. It is
+	// empty for the blocks of rules older than constants.ConflictingReadsRulesVersion.
+	ConflictingReads []*ConflictingRead `protobuf:"bytes,4,rep,name=conflicting_reads,json=conflictingReads,proto3" json:"conflicting_reads,omitempty"`
+	// dependency is the outcome of the transaction on which a data transaction
+	// depends, if it declares one.
+	Dependency *TxDependency `protobuf:"bytes,5,opt,name=dependency,proto3" json:"dependency,omitempty"`
+}
+
+func (x *ValidationInfo) Reset() {
+	*x = ValidationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationInfo) ProtoMessage() {}
+
+func (x *ValidationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationInfo.ProtoReflect.Descriptor instead.
+func (*ValidationInfo) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ValidationInfo) GetFlag() Flag {
+	if x != nil {
+		return x.Flag
+	}
+	return Flag_VALID
+}
+
+func (x *ValidationInfo) GetReasonIfInvalid() string {
+	if x != nil {
+		return x.ReasonIfInvalid
+	}
+	return ""
+}
+
+func (x *ValidationInfo) GetWriteSetDigest() []byte {
+	if x != nil {
+		return x.WriteSetDigest
+	}
+	return nil
+}
+
+func (x *ValidationInfo) GetConflictingReads() []*ConflictingRead {
+	if x != nil {
+		return x.ConflictingReads
+	}
+	return nil
+}
+
+func (x *ValidationInfo) GetDependency() *TxDependency {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+// TxDependency is the outcome of the transaction on which a data transaction
+// depends. A zero block_number denotes a transaction that is not committed
+// before the dependent one, in which case the flag is meaningless.
+type TxDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId        string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TxIndex     uint64 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Flag        Flag   `protobuf:"varint,4,opt,name=flag,proto3,enum=types.Flag" json:"flag,omitempty"`
+}
+
+func (x *TxDependency) Reset() {
+	*x = TxDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxDependency) ProtoMessage() {}
+
+func (x *TxDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxDependency.ProtoReflect.Descriptor instead.
+func (*TxDependency) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TxDependency) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TxDependency) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TxDependency) GetTxIndex() uint64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *TxDependency) GetFlag() Flag {
+	if x != nil {
+		return x.Flag
+	}
+	return Flag_VALID
+}
+
+// ConflictingRead is a read whose version does not match the version of the
+// key, either as committed or as written by an earlier transaction in the same
+// block. An empty actual_version denotes a key that does not exist.
+type ConflictingRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbName          string   `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key             string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ExpectedVersion *Version `protobuf:"bytes,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	ActualVersion   *Version `protobuf:"bytes,4,opt,name=actual_version,json=actualVersion,proto3" json:"actual_version,omitempty"`
+}
+
+func (x *ConflictingRead) Reset() {
+	*x = ConflictingRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConflictingRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConflictingRead) ProtoMessage() {}
+
+func (x *ConflictingRead) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConflictingRead.ProtoReflect.Descriptor instead.
+func (*ConflictingRead) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ConflictingRead) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ConflictingRead) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConflictingRead) GetExpectedVersion() *Version {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
+func (x *ConflictingRead) GetActualVersion() *Version {
+	if x != nil {
+		return x.ActualVersion
+	}
+	return nil
+}
+
+type TxProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Path   [][]byte     `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *TxProof) Reset() {
+	*x = TxProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxProof) ProtoMessage() {}
+
+func (x *TxProof) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxProof.ProtoReflect.Descriptor instead.
+func (*TxProof) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TxProof) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TxProof) GetPath() [][]byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type BlockProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64         `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Path        []*BlockHeader `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BlockProof) Reset() {
+	*x = BlockProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProof) ProtoMessage() {}
+
+func (x *BlockProof) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProof.ProtoReflect.Descriptor instead.
+func (*BlockProof) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BlockProof) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *BlockProof) GetPath() []*BlockHeader {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type TxReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header           *BlockHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TxIndex          uint64             `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	WriteSetDigest   []byte             `protobuf:"bytes,3,opt,name=write_set_digest,json=writeSetDigest,proto3" json:"write_set_digest,omitempty"`
+	ConflictingReads []*ConflictingRead `protobuf:"bytes,4,rep,name=conflicting_reads,json=conflictingReads,proto3" json:"conflicting_reads,omitempty"`
+	// The sizes of the transaction and of its block, recorded when the block was committed. Unset for a transaction
+	// committed before the sizes were recorded.
+	Sizes *TxSizes `protobuf:"bytes,5,opt,name=sizes,proto3" json:"sizes,omitempty"`
+}
+
+func (x *TxReceipt) Reset() {
+	*x = TxReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxReceipt) ProtoMessage() {}
+
+func (x *TxReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxReceipt.ProtoReflect.Descriptor instead.
+func (*TxReceipt) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TxReceipt) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TxReceipt) GetTxIndex() uint64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *TxReceipt) GetWriteSetDigest() []byte {
+	if x != nil {
+		return x.WriteSetDigest
+	}
+	return nil
+}
+
+func (x *TxReceipt) GetConflictingReads() []*ConflictingRead {
+	if x != nil {
+		return x.ConflictingReads
+	}
+	return nil
+}
+
+func (x *TxReceipt) GetSizes() *TxSizes {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+// TxSizes holds the sizes, in bytes, of a transaction and of the block that contains it. The sizes are those of the
+// deterministic encodings, hence, they are the same on every node.
+type TxSizes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The size of the transaction envelope.
+	EnvelopeBytes uint64 `protobuf:"varint,1,opt,name=envelope_bytes,json=envelopeBytes,proto3" json:"envelope_bytes,omitempty"`
+	// The sizes of the keys and the values written by a valid data transaction, including the values resulting from its
+	// patches. Zero for an invalid transaction, an attestation, and a transaction other than a data transaction.
+	WriteBytes uint64 `protobuf:"varint,2,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	// The size of the block.
+	BlockBytes uint64 `protobuf:"varint,3,opt,name=block_bytes,json=blockBytes,proto3" json:"block_bytes,omitempty"`
+	// The number of transactions of the block, valid or not.
+	BlockTxCount uint64 `protobuf:"varint,4,opt,name=block_tx_count,json=blockTxCount,proto3" json:"block_tx_count,omitempty"`
+	// The sums of the envelope bytes and of the write bytes of the transactions of the block.
+	BlockEnvelopeBytes uint64 `protobuf:"varint,5,opt,name=block_envelope_bytes,json=blockEnvelopeBytes,proto3" json:"block_envelope_bytes,omitempty"`
+	BlockWriteBytes    uint64 `protobuf:"varint,6,opt,name=block_write_bytes,json=blockWriteBytes,proto3" json:"block_write_bytes,omitempty"`
+}
+
+func (x *TxSizes) Reset() {
+	*x = TxSizes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxSizes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxSizes) ProtoMessage() {}
+
+func (x *TxSizes) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxSizes.ProtoReflect.Descriptor instead.
+func (*TxSizes) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TxSizes) GetEnvelopeBytes() uint64 {
+	if x != nil {
+		return x.EnvelopeBytes
+	}
+	return 0
+}
+
+func (x *TxSizes) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *TxSizes) GetBlockBytes() uint64 {
+	if x != nil {
+		return x.BlockBytes
+	}
+	return 0
+}
+
+func (x *TxSizes) GetBlockTxCount() uint64 {
+	if x != nil {
+		return x.BlockTxCount
+	}
+	return 0
+}
+
+func (x *TxSizes) GetBlockEnvelopeBytes() uint64 {
+	if x != nil {
+		return x.BlockEnvelopeBytes
+	}
+	return 0
+}
+
+func (x *TxSizes) GetBlockWriteBytes() uint64 {
+	if x != nil {
+		return x.BlockWriteBytes
+	}
+	return 0
+}
+
+// ConsensusMetadata holds data specific to the consensus protocol ordering the block.
+// The field prefix indicated the protocil used, e.g. "raft_*".
+type ConsensusMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Raft term associated with the block
+	RaftTerm uint64 `protobuf:"varint,1,opt,name=raft_term,json=raftTerm,proto3" json:"raft_term,omitempty"`
+	// The Raft index associated with the block
+	RaftIndex uint64 `protobuf:"varint,2,opt,name=raft_index,json=raftIndex,proto3" json:"raft_index,omitempty"`
+}
+
+func (x *ConsensusMetadata) Reset() {
+	*x = ConsensusMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusMetadata) ProtoMessage() {}
+
+func (x *ConsensusMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusMetadata.ProtoReflect.Descriptor instead.
+func (*ConsensusMetadata) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ConsensusMetadata) GetRaftTerm() uint64 {
+	if x != nil {
+		return x.RaftTerm
+	}
+	return 0
+}
+
+func (x *ConsensusMetadata) GetRaftIndex() uint64 {
+	if x != nil {
+		return x.RaftIndex
+	}
+	return 0
+}
+
+type AugmentedBlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TxIds  []string     `protobuf:"bytes,2,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (x *AugmentedBlockHeader) Reset() {
+	*x = AugmentedBlockHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AugmentedBlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AugmentedBlockHeader) ProtoMessage() {}
+
+func (x *AugmentedBlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AugmentedBlockHeader.ProtoReflect.Descriptor instead.
+func (*AugmentedBlockHeader) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AugmentedBlockHeader) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AugmentedBlockHeader) GetTxIds() []string {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+// StateDelta is the net change of the state over a range of blocks: the final state of each key that was written or
+// deleted by the blocks in the range, ordered by database name and key.
+type StateDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartBlockNum uint64           `protobuf:"varint,1,opt,name=start_block_num,json=startBlockNum,proto3" json:"start_block_num,omitempty"`
+	EndBlockNum   uint64           `protobuf:"varint,2,opt,name=end_block_num,json=endBlockNum,proto3" json:"end_block_num,omitempty"`
+	Keys          []*KeyStateDelta `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *StateDelta) Reset() {
+	*x = StateDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDelta) ProtoMessage() {}
+
+func (x *StateDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateDelta.ProtoReflect.Descriptor instead.
+func (*StateDelta) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StateDelta) GetStartBlockNum() uint64 {
+	if x != nil {
+		return x.StartBlockNum
+	}
+	return 0
+}
+
+func (x *StateDelta) GetEndBlockNum() uint64 {
+	if x != nil {
+		return x.EndBlockNum
+	}
+	return 0
+}
+
+func (x *StateDelta) GetKeys() []*KeyStateDelta {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// KeyStateDelta is the final state of a key at the end of a range of blocks. The value and metadata of a deleted key
+// are those it held when it was deleted, if it was written in the range, and are empty otherwise.
+type KeyStateDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbName   string    `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key      string    `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value    []byte    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata *Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Deleted  bool      `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *KeyStateDelta) Reset() {
+	*x = KeyStateDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyStateDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyStateDelta) ProtoMessage() {}
+
+func (x *KeyStateDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyStateDelta.ProtoReflect.Descriptor instead.
+func (*KeyStateDelta) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *KeyStateDelta) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *KeyStateDelta) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyStateDelta) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KeyStateDelta) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *KeyStateDelta) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// BatchComposition describes how the tx reorderer of the node that cut the batch of data transactions of a block
+// composed it. It is recorded by that node only, next to the block, and is neither part of the block bytes nor of
+// the block hash.
+type BatchComposition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64                     `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	CutReason   BatchComposition_CutReason `protobuf:"varint,2,opt,name=cut_reason,json=cutReason,proto3,enum=types.BatchComposition_CutReason" json:"cut_reason,omitempty"`
+	TxCount     uint32                     `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// tx_count_per_user holds the number of transactions in the batch per submitting user, i.e., the first user in
+	// the must_sign_user_ids of the transaction
+	TxCountPerUser map[string]uint32 `protobuf:"bytes,4,rep,name=tx_count_per_user,json=txCountPerUser,proto3" json:"tx_count_per_user,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// the percentiles of the time the transactions of the batch waited from their submission until the batch was cut
+	QueueWaitP50Micros uint64 `protobuf:"varint,5,opt,name=queue_wait_p50_micros,json=queueWaitP50Micros,proto3" json:"queue_wait_p50_micros,omitempty"`
+	QueueWaitP95Micros uint64 `protobuf:"varint,6,opt,name=queue_wait_p95_micros,json=queueWaitP95Micros,proto3" json:"queue_wait_p95_micros,omitempty"`
+}
+
+func (x *BatchComposition) Reset() {
+	*x = BatchComposition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchComposition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchComposition) ProtoMessage() {}
+
+func (x *BatchComposition) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchComposition.ProtoReflect.Descriptor instead.
+func (*BatchComposition) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BatchComposition) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *BatchComposition) GetCutReason() BatchComposition_CutReason {
+	if x != nil {
+		return x.CutReason
+	}
+	return BatchComposition_UNKNOWN
+}
+
+func (x *BatchComposition) GetTxCount() uint32 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *BatchComposition) GetTxCountPerUser() map[string]uint32 {
+	if x != nil {
+		return x.TxCountPerUser
+	}
+	return nil
+}
+
+func (x *BatchComposition) GetQueueWaitP50Micros() uint64 {
+	if x != nil {
+		return x.QueueWaitP50Micros
+	}
+	return 0
+}
+
+func (x *BatchComposition) GetQueueWaitP95Micros() uint64 {
+	if x != nil {
+		return x.QueueWaitP95Micros
+	}
+	return 0
+}
+
+// CumulativeCounters are the running totals of the ledger since the genesis block, up to and including a block
+type CumulativeCounters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of transactions, valid or not.
+	TxCount uint64 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// The number of valid transactions.
+	ValidTxCount uint64 `protobuf:"varint,2,opt,name=valid_tx_count,json=validTxCount,proto3" json:"valid_tx_count,omitempty"`
+	// The sum of the sizes of the deterministic encodings of the transaction envelopes, see TxSizes.envelope_bytes.
+	EnvelopeBytes uint64 `protobuf:"varint,3,opt,name=envelope_bytes,json=envelopeBytes,proto3" json:"envelope_bytes,omitempty"`
+}
+
+func (x *CumulativeCounters) Reset() {
+	*x = CumulativeCounters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CumulativeCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CumulativeCounters) ProtoMessage() {}
+
+func (x *CumulativeCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CumulativeCounters.ProtoReflect.Descriptor instead.
+func (*CumulativeCounters) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CumulativeCounters) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *CumulativeCounters) GetValidTxCount() uint64 {
+	if x != nil {
+		return x.ValidTxCount
+	}
+	return 0
+}
+
+func (x *CumulativeCounters) GetEnvelopeBytes() uint64 {
+	if x != nil {
+		return x.EnvelopeBytes
+	}
+	return 0
+}
+
+// DBExportRecord is a record of the export of a database. An export is a stream of records, which opens with the
+// manifest, holds a record per key of the database, and closes with the trailer.
+type DBExportRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Record:
+	//
+	//	*DBExportRecord_Manifest
+	//	*DBExportRecord_Kv
+	//	*DBExportRecord_Trailer
+	Record isDBExportRecord_Record `protobuf_oneof:"record"`
+}
+
+func (x *DBExportRecord) Reset() {
+	*x = DBExportRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBExportRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBExportRecord) ProtoMessage() {}
+
+func (x *DBExportRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBExportRecord.ProtoReflect.Descriptor instead.
+func (*DBExportRecord) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{58}
+}
+
+func (m *DBExportRecord) GetRecord() isDBExportRecord_Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (x *DBExportRecord) GetManifest() *DBExportManifest {
+	if x, ok := x.GetRecord().(*DBExportRecord_Manifest); ok {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *DBExportRecord) GetKv() *DBExportKV {
+	if x, ok := x.GetRecord().(*DBExportRecord_Kv); ok {
+		return x.Kv
+	}
+	return nil
+}
+
+func (x *DBExportRecord) GetTrailer() *DBExportTrailer {
+	if x, ok := x.GetRecord().(*DBExportRecord_Trailer); ok {
+		return x.Trailer
+	}
+	return nil
+}
+
+type isDBExportRecord_Record interface {
+	isDBExportRecord_Record()
+}
+
+type DBExportRecord_Manifest struct {
+	Manifest *DBExportManifest `protobuf:"bytes,1,opt,name=manifest,proto3,oneof"`
+}
+
+type DBExportRecord_Kv struct {
+	Kv *DBExportKV `protobuf:"bytes,2,opt,name=kv,proto3,oneof"`
+}
+
+type DBExportRecord_Trailer struct {
+	Trailer *DBExportTrailer `protobuf:"bytes,3,opt,name=trailer,proto3,oneof"`
+}
+
+func (*DBExportRecord_Manifest) isDBExportRecord_Record() {}
+
+func (*DBExportRecord_Kv) isDBExportRecord_Record() {}
+
+func (*DBExportRecord_Trailer) isDBExportRecord_Record() {}
+
+// DBExportManifest describes the database of an export, and the state it was exported from. The hash of the manifest,
+// computed by DBExportManifestHash, identifies the export.
+type DBExportManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ledger_id identifies the source ledger by the hash of its genesis block header.
+	LedgerId []byte `protobuf:"bytes,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	NodeId   string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// height is the height of the ledger at the snapshot of the state the database was exported from.
+	Height       uint64        `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	DbName       string        `protobuf:"bytes,4,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Index        *DBIndex      `protobuf:"bytes,5,opt,name=index,proto3" json:"index,omitempty"`
+	DbDescriptor *DBDescriptor `protobuf:"bytes,6,opt,name=db_descriptor,json=dbDescriptor,proto3" json:"db_descriptor,omitempty"`
+	// provenance is set if the records of the keys hold their historical values.
+	Provenance bool `protobuf:"varint,7,opt,name=provenance,proto3" json:"provenance,omitempty"`
+}
+
+func (x *DBExportManifest) Reset() {
+	*x = DBExportManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBExportManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBExportManifest) ProtoMessage() {}
+
+func (x *DBExportManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBExportManifest.ProtoReflect.Descriptor instead.
+func (*DBExportManifest) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DBExportManifest) GetLedgerId() []byte {
+	if x != nil {
+		return x.LedgerId
+	}
+	return nil
+}
+
+func (x *DBExportManifest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DBExportManifest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DBExportManifest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DBExportManifest) GetIndex() *DBIndex {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *DBExportManifest) GetDbDescriptor() *DBDescriptor {
+	if x != nil {
+		return x.DbDescriptor
+	}
+	return nil
+}
+
+func (x *DBExportManifest) GetProvenance() bool {
+	if x != nil {
+		return x.Provenance
+	}
+	return false
+}
+
+// DBExportKV holds a key of an exported database, with its value and metadata as of the snapshot.
+type DBExportKV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value    []byte    `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata *Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// history holds the values the key held before its value as of the snapshot, oldest first, in an export with
+	// provenance.
+	History []*ValueWithMetadata `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *DBExportKV) Reset() {
+	*x = DBExportKV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBExportKV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBExportKV) ProtoMessage() {}
+
+func (x *DBExportKV) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBExportKV.ProtoReflect.Descriptor instead.
+func (*DBExportKV) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DBExportKV) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DBExportKV) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *DBExportKV) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DBExportKV) GetHistory() []*ValueWithMetadata {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// DBExportTrailer closes the export of a database.
+type DBExportTrailer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kv_count is the number of key records of the export.
+	KvCount uint64 `protobuf:"varint,1,opt,name=kv_count,json=kvCount,proto3" json:"kv_count,omitempty"`
+	// checksum is the SHA-256 hash of the records of the export before the trailer, see DBExportChecksum.
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (x *DBExportTrailer) Reset() {
+	*x = DBExportTrailer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBExportTrailer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBExportTrailer) ProtoMessage() {}
+
+func (x *DBExportTrailer) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBExportTrailer.ProtoReflect.Descriptor instead.
+func (*DBExportTrailer) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DBExportTrailer) GetKvCount() uint64 {
+	if x != nil {
+		return x.KvCount
+	}
+	return 0
+}
+
+func (x *DBExportTrailer) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+// DBImportRequestEnvelope is posted to a node to import a database from its export. The node creates the database
+// and writes its keys in transactions that each carry the signed request as their proof, see DBImportProof.
+type DBImportRequestEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *DBImportRequest `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// records are the records of the export, from its manifest to its trailer.
+	Records []*DBExportRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *DBImportRequestEnvelope) Reset() {
+	*x = DBImportRequestEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBImportRequestEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBImportRequestEnvelope) ProtoMessage() {}
+
+func (x *DBImportRequestEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBImportRequestEnvelope.ProtoReflect.Descriptor instead.
+func (*DBImportRequestEnvelope) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DBImportRequestEnvelope) GetPayload() *DBImportRequest {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DBImportRequestEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *DBImportRequestEnvelope) GetRecords() []*DBExportRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// DBImportRequest names the database to create from the export whose manifest hash it holds. The database may be
+// named differently from the exported one.
+type DBImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// import_id prefixes the IDs of the transactions of the import.
+	ImportId     string `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	DbName       string `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ManifestHash []byte `protobuf:"bytes,4,opt,name=manifest_hash,json=manifestHash,proto3" json:"manifest_hash,omitempty"`
+}
+
+func (x *DBImportRequest) Reset() {
+	*x = DBImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBImportRequest) ProtoMessage() {}
+
+func (x *DBImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBImportRequest.ProtoReflect.Descriptor instead.
+func (*DBImportRequest) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DBImportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DBImportRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *DBImportRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DBImportRequest) GetManifestHash() []byte {
+	if x != nil {
+		return x.ManifestHash
+	}
+	return nil
+}
+
+// DBImportProof authorizes a transaction of a database import, which may only create the database of the signed
+// request, or write to it.
+type DBImportProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request   *DBImportRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Signature []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *DBImportProof) Reset() {
+	*x = DBImportProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_and_transaction_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBImportProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBImportProof) ProtoMessage() {}
+
+func (x *DBImportProof) ProtoReflect() protoreflect.Message {
+	mi := &file_block_and_transaction_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBImportProof.ProtoReflect.Descriptor instead.
+func (*DBImportProof) Descriptor() ([]byte, []int) {
+	return file_block_and_transaction_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DBImportProof) GetRequest() *DBImportRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *DBImportProof) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_block_and_transaction_proto protoreflect.FileDescriptor
+
+var file_block_and_transaction_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x1a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x05, 0x0a, 0x05, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x44, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x66,
+	0x0a, 0x1d, 0x64, 0x62, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x64, 0x62, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x6c, 0x0a, 0x1f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x78,
+	0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x73, 0x48, 0x00, 0x52, 0x14, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x78,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x76, 0x6f, 0x69,
+	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0f,
+	0x76, 0x6f, 0x69, 0x64, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x6f, 0x0a, 0x20, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x48,
+	0x00, 0x52, 0x1d, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73,
+	0x12, 0x47, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0xed, 0x02, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x16, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x61, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x39, 0x0a, 0x19, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe2, 0x02, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a,
+	0x10, 0x73, 0x6b, 0x69, 0x70, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x18, 0x74, 0x78, 0x5f, 0x6d,
+	0x65, 0x72, 0x6b, 0x65, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x74, 0x78, 0x4d, 0x65,
+	0x72, 0x6b, 0x65, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x3c, 0x0a, 0x1b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x65, 0x6c,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x72, 0x6b,
+	0x65, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e,
+	0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x46, 0x0a, 0x0f, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x09,
+	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x73, 0x22, 0xf8, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x78, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x45, 0x0a,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x42, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x10,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x29, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x54, 0x78, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x1a, 0x44, 0x42,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x42, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x78, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x22, 0xae, 0x01, 0x0a, 0x1c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x78, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x62, 0x0a, 0x1d, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09,
+	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x6c, 0x0a, 0x11,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x75,
+	0x73, 0x65, 0x72, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x0f, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x50, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x78, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x78, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x73, 0x22, 0x61, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x78,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x78, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x46, 0x0a, 0x0f, 0x56, 0x6f, 0x69, 0x64, 0x54, 0x78, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x0e,
+	0x56, 0x6f, 0x69, 0x64, 0x54, 0x78, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x27,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x54, 0x78, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x06, 0x44, 0x61, 0x74, 0x61, 0x54, 0x78,
+	0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x75,
+	0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x12, 0x37, 0x0a, 0x0d, 0x64, 0x62, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x42, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64,
+	0x62, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x54, 0x78, 0x49, 0x64,
+	0x12, 0x41, 0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x11, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x12, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x4d, 0x0a, 0x0a, 0x54, 0x78, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0xba, 0x02, 0x0a, 0x0b, 0x44, 0x42, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x10, 0x64, 0x61, 0x74, 0x61, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x22, 0x46, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x0a,
+	0x03, 0x61, 0x63, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x03, 0x61, 0x63, 0x6c, 0x22, 0x1e, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5f, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0xa3, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x39, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a, 0x53,
+	0x4f, 0x4e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a, 0x12,
+	0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xb4, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x78, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x17, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x72, 0x65,
+	0x61, 0x64, 0x4f, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6e, 0x65,
+	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xec, 0x07, 0x0a, 0x12, 0x44, 0x42, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x62, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x64, 0x62, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x62, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x64, 0x62,
+	0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x2e, 0x44, 0x62, 0x73, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64, 0x62, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x47, 0x0a, 0x0a, 0x64, 0x62, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x2e,
+	0x44, 0x62, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x64, 0x62, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x54, 0x0a, 0x0f, 0x64, 0x62, 0x73,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x6c, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x2e, 0x44, 0x62,
+	0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0d, 0x64, 0x62, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x6c, 0x12,
+	0x5b, 0x0a, 0x12, 0x64, 0x62, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x2e, 0x44, 0x62, 0x73, 0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x64, 0x62, 0x73,
+	0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5a, 0x0a, 0x11,
+	0x64, 0x62, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x44, 0x42, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x78, 0x2e, 0x44, 0x62, 0x73, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x64, 0x62, 0x73, 0x4b, 0x65, 0x79, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x6d, 0x75,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x62, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x62, 0x73, 0x1a, 0x4b, 0x0a,
+	0x0d, 0x44, 0x62, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4d, 0x0a, 0x0e, 0x44, 0x62,
+	0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x12, 0x44, 0x62, 0x73,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x41, 0x43, 0x4c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x59, 0x0a, 0x14, 0x44, 0x62, 0x73, 0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x42, 0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x14, 0x44,
+	0x62, 0x73, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x4b,
+	0x65, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbd, 0x01, 0x0a, 0x07, 0x44, 0x42, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x52, 0x0a, 0x12, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x41,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x5e, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x08, 0x44, 0x42, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x22, 0x36, 0x0a, 0x0c, 0x44, 0x42, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x41, 0x43, 0x4c, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x22, 0x41, 0x0a, 0x0e, 0x44,
+	0x42, 0x4d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a,
+	0x14, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xf2,
+	0x01, 0x0a, 0x0e, 0x44, 0x42, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x4b, 0x65,
+	0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2e,
+	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x49, 0x43, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x23,
+	0x0a, 0x0b, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45,
+	0x52, 0x10, 0x01, 0x22, 0xf1, 0x01, 0x0a, 0x0c, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x35, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x61, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x6c, 0x12, 0x2f, 0x0a, 0x14,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x4b,
+	0x65, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6b, 0x65, 0x79,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x6d,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6d,
+	0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x12, 0x31,
+	0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x52, 0x0a, 0x06, 0x56, 0x6f, 0x69, 0x64, 0x54, 0x78, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x22, 0x25, 0x0a, 0x0a,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0e, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x22, 0x3d, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x78, 0x4e, 0x75, 0x6d, 0x22, 0xa0, 0x03, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x10, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x72, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x54, 0x0a, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x66,
+	0x6f, 0x72, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46, 0x6f, 0x72,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x20, 0x0a, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x22, 0x65, 0x0a, 0x0e, 0x4b, 0x56, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x75, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0x3d, 0x0a, 0x06, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46,
+	0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x66, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x66, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73,
+	0x65, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x43, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x61, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x54, 0x78, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x54, 0x78,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a,
+	0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0xae,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a,
+	0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x49, 0x0a, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x57, 0x0a, 0x0a, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0xe7, 0x01, 0x0a, 0x09, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x12, 0x43, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x61, 0x64, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x61, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x69, 0x7a, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x52, 0x05, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x22, 0xf6, 0x01,
+	0x0a, 0x07, 0x54, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x78, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x61, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x72, 0x61, 0x66, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x66, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x61,
+	0x66, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x59, 0x0a, 0x14, 0x41, 0x75, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x49,
+	0x64, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x28, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0xfb, 0x03, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x75, 0x74,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x75, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x09, 0x63, 0x75, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x31,
+	0x0a, 0x15, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x70, 0x35, 0x30,
+	0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x50, 0x35, 0x30, 0x4d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x12, 0x31, 0x0a, 0x15, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x70, 0x39, 0x35, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x71, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x50, 0x39, 0x35, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x09, 0x43, 0x75, 0x74, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10,
+	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x4f, 0x49, 0x44, 0x5f, 0x54, 0x58, 0x10, 0x04, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x05, 0x22,
+	0x7c, 0x0a, 0x12, 0x43, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xaa, 0x01,
+	0x0a, 0x0e, 0x44, 0x42, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x35, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x6b, 0x76, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4b, 0x56, 0x48, 0x00, 0x52, 0x02, 0x6b, 0x76, 0x12, 0x32, 0x0a, 0x07,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0xf9, 0x01, 0x0a, 0x10, 0x44,
+	0x42, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x38, 0x0a, 0x0d,
+	0x64, 0x62, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x64, 0x62, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x44, 0x42, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4b, 0x56, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x48,
+	0x0a, 0x0f, 0x44, 0x42, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x76, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6b, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x9a, 0x01, 0x0a, 0x17, 0x44, 0x42, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x44, 0x42, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x5f, 0x0a,
+	0x0d, 0x44, 0x42, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x30,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2a, 0x90,
+	0x04, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x56,
+	0x43, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x49, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x2e, 0x0a, 0x2a, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x56, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54,
+	0x45, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x44,
+	0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x03, 0x12,
+	0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x50, 0x45,
+	0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
+	0x45, 0x4e, 0x54, 0x52, 0x49, 0x45, 0x53, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x53, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45,
+	0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x49, 0x44, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x24,
+	0x0a, 0x20, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x41, 0x54, 0x49, 0x53, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x0c, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x54, 0x58, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x0d, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41,
+	0x52, 0x47, 0x45, 0x10, 0x0e, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x44, 0x42, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x0f, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x49, 0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x10,
+	0x11, 0x2a, 0x39, 0x0a, 0x12, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x02, 0x42, 0x34, 0x5a, 0x32,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f,
+	0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_block_and_transaction_proto_rawDescOnce sync.Once
+	file_block_and_transaction_proto_rawDescData = file_block_and_transaction_proto_rawDesc
+)
+
+func file_block_and_transaction_proto_rawDescGZIP() []byte {
+	file_block_and_transaction_proto_rawDescOnce.Do(func() {
+		file_block_and_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_block_and_transaction_proto_rawDescData)
+	})
+	return file_block_and_transaction_proto_rawDescData
+}
+
+var file_block_and_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_block_and_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
+var file_block_and_transaction_proto_goTypes = []interface{}{
+	(Flag)(0),                             // 0: types.Flag
+	(IndexAttributeType)(0),               // 1: types.IndexAttributeType
+	(DBKeyCollation_Mode)(0),              // 2: types.DBKeyCollation.Mode
+	(DBKeyCollation_SegmentType)(0),       // 3: types.DBKeyCollation.SegmentType
+	(AccessControlWritePolicy)(0),         // 4: types.AccessControl.write_policy
+	(BatchComposition_CutReason)(0),       // 5: types.BatchComposition.CutReason
+	(*Block)(nil),                         // 6: types.Block
+	(*BlockHeaderBase)(nil),               // 7: types.BlockHeaderBase
+	(*BlockHeader)(nil),                   // 8: types.BlockHeader
+	(*DataTxEnvelopes)(nil),               // 9: types.DataTxEnvelopes
+	(*DataTxEnvelope)(nil),                // 10: types.DataTxEnvelope
+	(*ConfigTxEnvelope)(nil),              // 11: types.ConfigTxEnvelope
+	(*DBAdministrationTxEnvelope)(nil),    // 12: types.DBAdministrationTxEnvelope
+	(*UserAdministrationTxEnvelope)(nil),  // 13: types.UserAdministrationTxEnvelope
+	(*UserAdministrationTxEnvelopes)(nil), // 14: types.UserAdministrationTxEnvelopes
+	(*UserImportRequestEnvelope)(nil),     // 15: types.UserImportRequestEnvelope
+	(*UserImportRequest)(nil),             // 16: types.UserImportRequest
+	(*UserImportProof)(nil),               // 17: types.UserImportProof
+	(*HeartbeatTxEnvelopes)(nil),          // 18: types.HeartbeatTxEnvelopes
+	(*HeartbeatTxEnvelope)(nil),           // 19: types.HeartbeatTxEnvelope
+	(*VoidTxEnvelopes)(nil),               // 20: types.VoidTxEnvelopes
+	(*VoidTxEnvelope)(nil),                // 21: types.VoidTxEnvelope
+	(*DataTx)(nil),                        // 22: types.DataTx
+	(*TxDeadline)(nil),                    // 23: types.TxDeadline
+	(*DBOperation)(nil),                   // 24: types.DBOperation
+	(*DataRead)(nil),                      // 25: types.DataRead
+	(*DataWrite)(nil),                     // 26: types.DataWrite
+	(*DataDelete)(nil),                    // 27: types.DataDelete
+	(*DataDeleteRange)(nil),               // 28: types.DataDeleteRange
+	(*DataPatch)(nil),                     // 29: types.DataPatch
+	(*JSONPatchOperation)(nil),            // 30: types.JSONPatchOperation
+	(*ConfigTx)(nil),                      // 31: types.ConfigTx
+	(*DBAdministrationTx)(nil),            // 32: types.DBAdministrationTx
+	(*DBIndex)(nil),                       // 33: types.DBIndex
+	(*DBSchema)(nil),                      // 34: types.DBSchema
+	(*DBDefaultACL)(nil),                  // 35: types.DBDefaultACL
+	(*DBMaxValueSize)(nil),                // 36: types.DBMaxValueSize
+	(*DBKeyCollation)(nil),                // 37: types.DBKeyCollation
+	(*DBDescriptor)(nil),                  // 38: types.DBDescriptor
+	(*UserAdministrationTx)(nil),          // 39: types.UserAdministrationTx
+	(*HeartbeatTx)(nil),                   // 40: types.HeartbeatTx
+	(*VoidTx)(nil),                        // 41: types.VoidTx
+	(*UserRead)(nil),                      // 42: types.UserRead
+	(*UserWrite)(nil),                     // 43: types.UserWrite
+	(*UserDelete)(nil),                    // 44: types.UserDelete
+	(*Metadata)(nil),                      // 45: types.Metadata
+	(*Version)(nil),                       // 46: types.Version
+	(*AccessControl)(nil),                 // 47: types.AccessControl
+	(*KVWithMetadata)(nil),                // 48: types.KVWithMetadata
+	(*ValueWithMetadata)(nil),             // 49: types.ValueWithMetadata
+	(*Digest)(nil),                        // 50: types.Digest
+	(*ValidationInfo)(nil),                // 51: types.ValidationInfo
+	(*TxDependency)(nil),                  // 52: types.TxDependency
+	(*ConflictingRead)(nil),               // 53: types.ConflictingRead
+	(*TxProof)(nil),                       // 54: types.TxProof
+	(*BlockProof)(nil),                    // 55: types.BlockProof
+	(*TxReceipt)(nil),                     // 56: types.TxReceipt
+	(*TxSizes)(nil),                       // 57: types.TxSizes
+	(*ConsensusMetadata)(nil),             // 58: types.ConsensusMetadata
+	(*AugmentedBlockHeader)(nil),          // 59: types.AugmentedBlockHeader
+	(*StateDelta)(nil),                    // 60: types.StateDelta
+	(*KeyStateDelta)(nil),                 // 61: types.KeyStateDelta
+	(*BatchComposition)(nil),              // 62: types.BatchComposition
+	(*CumulativeCounters)(nil),            // 63: types.CumulativeCounters
+	(*DBExportRecord)(nil),                // 64: types.DBExportRecord
+	(*DBExportManifest)(nil),              // 65: types.DBExportManifest
+	(*DBExportKV)(nil),                    // 66: types.DBExportKV
+	(*DBExportTrailer)(nil),               // 67: types.DBExportTrailer
+	(*DBImportRequestEnvelope)(nil),       // 68: types.DBImportRequestEnvelope
+	(*DBImportRequest)(nil),               // 69: types.DBImportRequest
+	(*DBImportProof)(nil),                 // 70: types.DBImportProof
+	nil,                                   // 71: types.DataTxEnvelope.SignaturesEntry
+	nil,                                   // 72: types.DBAdministrationTx.DbsIndexEntry
+	nil,                                   // 73: types.DBAdministrationTx.DbsSchemaEntry
+	nil,                                   // 74: types.DBAdministrationTx.DbsDefaultAclEntry
+	nil,                                   // 75: types.DBAdministrationTx.DbsMaxValueSizeEntry
+	nil,                                   // 76: types.DBAdministrationTx.DbsKeyCollationEntry
+	nil,                                   // 77: types.DBIndex.AttributeAndTypeEntry
+	nil,                                   // 78: types.AccessControl.ReadUsersEntry
+	nil,                                   // 79: types.AccessControl.ReadWriteUsersEntry
+	nil,                                   // 80: types.BatchComposition.TxCountPerUserEntry
+	(*User)(nil),                          // 81: types.User
+	(*ClusterConfig)(nil),                 // 82: types.ClusterConfig
+}
+var file_block_and_transaction_proto_depIdxs = []int32{
+	8,  // 0: types.Block.header:type_name -> types.BlockHeader
+	9,  // 1: types.Block.data_tx_envelopes:type_name -> types.DataTxEnvelopes
+	11, // 2: types.Block.config_tx_envelope:type_name -> types.ConfigTxEnvelope
+	12, // 3: types.Block.db_administration_tx_envelope:type_name -> types.DBAdministrationTxEnvelope
+	13, // 4: types.Block.user_administration_tx_envelope:type_name -> types.UserAdministrationTxEnvelope
+	18, // 5: types.Block.heartbeat_tx_envelopes:type_name -> types.HeartbeatTxEnvelopes
+	20, // 6: types.Block.void_tx_envelopes:type_name -> types.VoidTxEnvelopes
+	14, // 7: types.Block.user_administration_tx_envelopes:type_name -> types.UserAdministrationTxEnvelopes
+	58, // 8: types.Block.consensus_metadata:type_name -> types.ConsensusMetadata
+	7,  // 9: types.BlockHeader.base_header:type_name -> types.BlockHeaderBase
+	51, // 10: types.BlockHeader.validation_info:type_name -> types.ValidationInfo
+	63, // 11: types.BlockHeader.cumulative:type_name -> types.CumulativeCounters
+	10, // 12: types.DataTxEnvelopes.envelopes:type_name -> types.DataTxEnvelope
+	22, // 13: types.DataTxEnvelope.payload:type_name -> types.DataTx
+	71, // 14: types.DataTxEnvelope.signatures:type_name -> types.DataTxEnvelope.SignaturesEntry
+	70, // 15: types.DataTxEnvelope.import_proof:type_name -> types.DBImportProof
+	31, // 16: types.ConfigTxEnvelope.payload:type_name -> types.ConfigTx
+	32, // 17: types.DBAdministrationTxEnvelope.payload:type_name -> types.DBAdministrationTx
+	70, // 18: types.DBAdministrationTxEnvelope.import_proof:type_name -> types.DBImportProof
+	39, // 19: types.UserAdministrationTxEnvelope.payload:type_name -> types.UserAdministrationTx
+	17, // 20: types.UserAdministrationTxEnvelope.import_proof:type_name -> types.UserImportProof
+	13, // 21: types.UserAdministrationTxEnvelopes.envelopes:type_name -> types.UserAdministrationTxEnvelope
+	16, // 22: types.UserImportRequestEnvelope.payload:type_name -> types.UserImportRequest
+	81, // 23: types.UserImportRequestEnvelope.users:type_name -> types.User
+	16, // 24: types.UserImportProof.request:type_name -> types.UserImportRequest
+	19, // 25: types.HeartbeatTxEnvelopes.envelopes:type_name -> types.HeartbeatTxEnvelope
+	40, // 26: types.HeartbeatTxEnvelope.payload:type_name -> types.HeartbeatTx
+	21, // 27: types.VoidTxEnvelopes.envelopes:type_name -> types.VoidTxEnvelope
+	41, // 28: types.VoidTxEnvelope.payload:type_name -> types.VoidTx
+	24, // 29: types.DataTx.db_operations:type_name -> types.DBOperation
+	23, // 30: types.DataTx.not_committed_after:type_name -> types.TxDeadline
+	25, // 31: types.DBOperation.data_reads:type_name -> types.DataRead
+	26, // 32: types.DBOperation.data_writes:type_name -> types.DataWrite
+	27, // 33: types.DBOperation.data_deletes:type_name -> types.DataDelete
+	28, // 34: types.DBOperation.data_delete_ranges:type_name -> types.DataDeleteRange
+	29, // 35: types.DBOperation.data_patches:type_name -> types.DataPatch
+	46, // 36: types.DataRead.version:type_name -> types.Version
+	47, // 37: types.DataWrite.acl:type_name -> types.AccessControl
+	46, // 38: types.DataPatch.version:type_name -> types.Version
+	30, // 39: types.DataPatch.operations:type_name -> types.JSONPatchOperation
+	46, // 40: types.ConfigTx.read_old_config_version:type_name -> types.Version
+	82, // 41: types.ConfigTx.new_config:type_name -> types.ClusterConfig
+	72, // 42: types.DBAdministrationTx.dbs_index:type_name -> types.DBAdministrationTx.DbsIndexEntry
+	73, // 43: types.DBAdministrationTx.dbs_schema:type_name -> types.DBAdministrationTx.DbsSchemaEntry
+	74, // 44: types.DBAdministrationTx.dbs_default_acl:type_name -> types.DBAdministrationTx.DbsDefaultAclEntry
+	75, // 45: types.DBAdministrationTx.dbs_max_value_size:type_name -> types.DBAdministrationTx.DbsMaxValueSizeEntry
+	76, // 46: types.DBAdministrationTx.dbs_key_collation:type_name -> types.DBAdministrationTx.DbsKeyCollationEntry
+	77, // 47: types.DBIndex.attribute_and_type:type_name -> types.DBIndex.AttributeAndTypeEntry
+	47, // 48: types.DBDefaultACL.acl:type_name -> types.AccessControl
+	2,  // 49: types.DBKeyCollation.mode:type_name -> types.DBKeyCollation.Mode
+	3,  // 50: types.DBKeyCollation.segments:type_name -> types.DBKeyCollation.SegmentType
+	47, // 51: types.DBDescriptor.default_acl:type_name -> types.AccessControl
+	37, // 52: types.DBDescriptor.key_collation:type_name -> types.DBKeyCollation
+	42, // 53: types.UserAdministrationTx.user_reads:type_name -> types.UserRead
+	43, // 54: types.UserAdministrationTx.user_writes:type_name -> types.UserWrite
+	44, // 55: types.UserAdministrationTx.user_deletes:type_name -> types.UserDelete
+	46, // 56: types.UserRead.version:type_name -> types.Version
+	81, // 57: types.UserWrite.user:type_name -> types.User
+	47, // 58: types.UserWrite.acl:type_name -> types.AccessControl
+	46, // 59: types.Metadata.version:type_name -> types.Version
+	47, // 60: types.Metadata.access_control:type_name -> types.AccessControl
+	78, // 61: types.AccessControl.read_users:type_name -> types.AccessControl.ReadUsersEntry
+	79, // 62: types.AccessControl.read_write_users:type_name -> types.AccessControl.ReadWriteUsersEntry
+	4,  // 63: types.AccessControl.sign_policy_for_write:type_name -> types.AccessControl.write_policy
+	45, // 64: types.KVWithMetadata.metadata:type_name -> types.Metadata
+	45, // 65: types.ValueWithMetadata.metadata:type_name -> types.Metadata
+	0,  // 66: types.ValidationInfo.flag:type_name -> types.Flag
+	53, // 67: types.ValidationInfo.conflicting_reads:type_name -> types.ConflictingRead
+	52, // 68: types.ValidationInfo.dependency:type_name -> types.TxDependency
+	0,  // 69: types.TxDependency.flag:type_name -> types.Flag
+	46, // 70: types.ConflictingRead.expected_version:type_name -> types.Version
+	46, // 71: types.ConflictingRead.actual_version:type_name -> types.Version
+	8,  // 72: types.TxProof.header:type_name -> types.BlockHeader
+	8,  // 73: types.BlockProof.path:type_name -> types.BlockHeader
+	8,  // 74: types.TxReceipt.header:type_name -> types.BlockHeader
+	53, // 75: types.TxReceipt.conflicting_reads:type_name -> types.ConflictingRead
+	57, // 76: types.TxReceipt.sizes:type_name -> types.TxSizes
+	8,  // 77: types.AugmentedBlockHeader.header:type_name -> types.BlockHeader
+	61, // 78: types.StateDelta.keys:type_name -> types.KeyStateDelta
+	45, // 79: types.KeyStateDelta.metadata:type_name -> types.Metadata
+	5,  // 80: types.BatchComposition.cut_reason:type_name -> types.BatchComposition.CutReason
+	80, // 81: types.BatchComposition.tx_count_per_user:type_name -> types.BatchComposition.TxCountPerUserEntry
+	65, // 82: types.DBExportRecord.manifest:type_name -> types.DBExportManifest
+	66, // 83: types.DBExportRecord.kv:type_name -> types.DBExportKV
+	67, // 84: types.DBExportRecord.trailer:type_name -> types.DBExportTrailer
+	33, // 85: types.DBExportManifest.index:type_name -> types.DBIndex
+	38, // 86: types.DBExportManifest.db_descriptor:type_name -> types.DBDescriptor
+	45, // 87: types.DBExportKV.metadata:type_name -> types.Metadata
+	49, // 88: types.DBExportKV.history:type_name -> types.ValueWithMetadata
+	69, // 89: types.DBImportRequestEnvelope.payload:type_name -> types.DBImportRequest
+	64, // 90: types.DBImportRequestEnvelope.records:type_name -> types.DBExportRecord
+	69, // 91: types.DBImportProof.request:type_name -> types.DBImportRequest
+	33, // 92: types.DBAdministrationTx.DbsIndexEntry.value:type_name -> types.DBIndex
+	34, // 93: types.DBAdministrationTx.DbsSchemaEntry.value:type_name -> types.DBSchema
+	35, // 94: types.DBAdministrationTx.DbsDefaultAclEntry.value:type_name -> types.DBDefaultACL
+	36, // 95: types.DBAdministrationTx.DbsMaxValueSizeEntry.value:type_name -> types.DBMaxValueSize
+	37, // 96: types.DBAdministrationTx.DbsKeyCollationEntry.value:type_name -> types.DBKeyCollation
+	1,  // 97: types.DBIndex.AttributeAndTypeEntry.value:type_name -> types.IndexAttributeType
+	98, // [98:98] is the sub-list for method output_type
+	98, // [98:98] is the sub-list for method input_type
+	98, // [98:98] is the sub-list for extension type_name
+	98, // [98:98] is the sub-list for extension extendee
+	0,  // [0:98] is the sub-list for field type_name
+}
+
+func init() { file_block_and_transaction_proto_init() }
+func file_block_and_transaction_proto_init() {
+	if File_block_and_transaction_proto != nil {
+		return
+	}
+	file_configuration_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_block_and_transaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderBase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataTxEnvelopes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataTxEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigTxEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBAdministrationTxEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAdministrationTxEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAdministrationTxEnvelopes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserImportRequestEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserImportProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatTxEnvelopes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatTxEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidTxEnvelopes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidTxEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2601,8 +6033,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderBase); i {
+		file_block_and_transaction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2613,8 +6045,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeader); i {
+		file_block_and_transaction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxDeadline); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2625,8 +6057,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataTxEnvelopes); i {
+		file_block_and_transaction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBOperation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2637,8 +6069,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataTxEnvelope); i {
+		file_block_and_transaction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataRead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2649,8 +6081,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigTxEnvelope); i {
+		file_block_and_transaction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataWrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2661,8 +6093,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBAdministrationTxEnvelope); i {
+		file_block_and_transaction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataDelete); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2673,8 +6105,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAdministrationTxEnvelope); i {
+		file_block_and_transaction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataDeleteRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2685,8 +6117,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataTx); i {
+		file_block_and_transaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataPatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2697,8 +6129,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBOperation); i {
+		file_block_and_transaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONPatchOperation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2709,8 +6141,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataRead); i {
+		file_block_and_transaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2721,8 +6153,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataWrite); i {
+		file_block_and_transaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBAdministrationTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2733,8 +6165,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataDelete); i {
+		file_block_and_transaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2745,8 +6177,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigTx); i {
+		file_block_and_transaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2757,8 +6189,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBAdministrationTx); i {
+		file_block_and_transaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBDefaultACL); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2769,8 +6201,8 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DBIndex); i {
+		file_block_and_transaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBMaxValueSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2781,7 +6213,31 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBKeyCollation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserAdministrationTx); i {
 			case 0:
 				return &v.state
@@ -2793,7 +6249,31 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserRead); i {
 			case 0:
 				return &v.state
@@ -2805,7 +6285,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserWrite); i {
 			case 0:
 				return &v.state
@@ -2817,7 +6297,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserDelete); i {
 			case 0:
 				return &v.state
@@ -2829,7 +6309,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Metadata); i {
 			case 0:
 				return &v.state
@@ -2841,7 +6321,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Version); i {
 			case 0:
 				return &v.state
@@ -2853,7 +6333,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccessControl); i {
 			case 0:
 				return &v.state
@@ -2865,7 +6345,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KVWithMetadata); i {
 			case 0:
 				return &v.state
@@ -2877,7 +6357,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValueWithMetadata); i {
 			case 0:
 				return &v.state
@@ -2889,7 +6369,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Digest); i {
 			case 0:
 				return &v.state
@@ -2901,7 +6381,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidationInfo); i {
 			case 0:
 				return &v.state
@@ -2913,7 +6393,31 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConflictingRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxProof); i {
 			case 0:
 				return &v.state
@@ -2925,7 +6429,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockProof); i {
 			case 0:
 				return &v.state
@@ -2937,7 +6441,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxReceipt); i {
 			case 0:
 				return &v.state
@@ -2949,7 +6453,19 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxSizes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsensusMetadata); i {
 			case 0:
 				return &v.state
@@ -2961,7 +6477,7 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_block_and_transaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_block_and_transaction_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AugmentedBlockHeader); i {
 			case 0:
 				return &v.state
@@ -2973,20 +6489,160 @@ func file_block_and_transaction_proto_init() {
 				return nil
 			}
 		}
+		file_block_and_transaction_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyStateDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchComposition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CumulativeCounters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBExportRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBExportManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBExportKV); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBExportTrailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBImportRequestEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_block_and_transaction_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBImportProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_block_and_transaction_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Block_DataTxEnvelopes)(nil),
 		(*Block_ConfigTxEnvelope)(nil),
 		(*Block_DbAdministrationTxEnvelope)(nil),
 		(*Block_UserAdministrationTxEnvelope)(nil),
+		(*Block_HeartbeatTxEnvelopes)(nil),
+		(*Block_VoidTxEnvelopes)(nil),
+		(*Block_UserAdministrationTxEnvelopes)(nil),
+	}
+	file_block_and_transaction_proto_msgTypes[58].OneofWrappers = []interface{}{
+		(*DBExportRecord_Manifest)(nil),
+		(*DBExportRecord_Kv)(nil),
+		(*DBExportRecord_Trailer)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_block_and_transaction_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   37,
+			NumEnums:      6,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
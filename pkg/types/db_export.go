@@ -0,0 +1,123 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+
+	"github.com/pkg/errors"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// DBExportManifestHash returns the hash of the manifest of a database export, which is the SHA-256 hash of the
+// deterministic encoding of the manifest. The request to import the export holds the hash.
+func DBExportManifestHash(manifest *DBExportManifest) ([]byte, error) {
+	if manifest == nil {
+		return nil, errors.New("the manifest cannot be nil")
+	}
+
+	b, err := protov2.MarshalOptions{Deterministic: true}.Marshal(manifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while marshaling the manifest of the export of database [%s]", manifest.GetDbName())
+	}
+	digest := sha256.Sum256(b)
+	return digest[:], nil
+}
+
+// NewDBImportRequest prepares the request of the user `userID` to import the export with the given manifest into the
+// database `dbName`. The request is to be signed by `userID`.
+func NewDBImportRequest(userID, importID, dbName string, manifest *DBExportManifest) (*DBImportRequest, error) {
+	manifestHash, err := DBExportManifestHash(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DBImportRequest{
+		UserId:       userID,
+		ImportId:     importID,
+		DbName:       dbName,
+		ManifestHash: manifestHash,
+	}, nil
+}
+
+// DBExportChecksum computes the checksum of the records of a database export, which is the SHA-256 hash of the
+// deterministic encodings of the records, each prefixed with its length as a uvarint, so that the checksum changes if
+// a record is altered, dropped, or moved.
+type DBExportChecksum struct {
+	h       hash.Hash
+	kvCount uint64
+}
+
+// NewDBExportChecksum returns the checksum of an export with no record
+func NewDBExportChecksum() *DBExportChecksum {
+	return &DBExportChecksum{h: sha256.New()}
+}
+
+// Add adds the record, which follows the records added before it in the export, to the checksum
+func (c *DBExportChecksum) Add(record *DBExportRecord) error {
+	b, err := protov2.MarshalOptions{Deterministic: true}.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "error while marshaling a record of the export")
+	}
+
+	var length [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(length[:], uint64(len(b)))
+	c.h.Write(length[:n])
+	c.h.Write(b)
+	if record.GetKv() != nil {
+		c.kvCount++
+	}
+	return nil
+}
+
+// Trailer returns the trailer which closes the records added to the checksum
+func (c *DBExportChecksum) Trailer() *DBExportTrailer {
+	return &DBExportTrailer{
+		KvCount:  c.kvCount,
+		Checksum: c.h.Sum(nil),
+	}
+}
+
+// VerifyDBExport checks that the records form a whole export, which opens with the manifest and closes with a trailer
+// that matches the records before it. It returns the manifest and the records of the keys.
+func VerifyDBExport(records []*DBExportRecord) (*DBExportManifest, []*DBExportKV, error) {
+	if len(records) < 2 {
+		return nil, nil, errors.Errorf("an export holds at least a manifest and a trailer, found %d records", len(records))
+	}
+
+	manifest := records[0].GetManifest()
+	if manifest == nil {
+		return nil, nil, errors.New("the first record of the export is not its manifest")
+	}
+	trailer := records[len(records)-1].GetTrailer()
+	if trailer == nil {
+		return nil, nil, errors.New("the last record of the export is not its trailer, the export may be truncated")
+	}
+
+	checksum := NewDBExportChecksum()
+	kvs := make([]*DBExportKV, 0, len(records)-2)
+	for i, r := range records[:len(records)-1] {
+		if i > 0 {
+			if r.GetKv() == nil {
+				return nil, nil, errors.Errorf("record %d of the export is not the record of a key", i)
+			}
+			kvs = append(kvs, r.GetKv())
+		}
+		if err := checksum.Add(r); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	expected := checksum.Trailer()
+	if expected.KvCount != trailer.GetKvCount() {
+		return nil, nil, errors.Errorf("the export holds %d keys but its trailer counts %d", expected.KvCount, trailer.GetKvCount())
+	}
+	if !bytes.Equal(expected.Checksum, trailer.GetChecksum()) {
+		return nil, nil, errors.New("the checksum of the export does not match its trailer")
+	}
+
+	return manifest, kvs, nil
+}
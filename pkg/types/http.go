@@ -10,3 +10,69 @@ type HttpResponseErr struct {
 func (e *HttpResponseErr) Error() string {
 	return e.ErrMsg
 }
+
+// TraceValidationRequest is the body of a request to trace the validation of a committed block
+type TraceValidationRequest struct {
+	BlockNum uint64 `json:"blockNum"`
+}
+
+// AcceptPeerHeaderRequest is the body of a request to resolve the divergence on a block by accepting the peer's
+// version of the block
+type AcceptPeerHeaderRequest struct {
+	BlockNum uint64 `json:"blockNum"`
+}
+
+// ResyncDBRequest is the body of a request to resync a database which is unavailable, as a commit to it failed
+type ResyncDBRequest struct {
+	DBName string `json:"dbName"`
+}
+
+// ReadHint names a key which a submitted data transaction reads, so that the node prefetches it before the
+// transaction is validated
+type ReadHint struct {
+	DBName string
+	Key    string
+}
+
+// SubscribeKeysRequest is the body of a request to subscribe to the changes of keys of a database, given by their
+// names or by prefixes of their names
+type SubscribeKeysRequest struct {
+	Keys     []string `json:"keys"`
+	Prefixes []string `json:"prefixes"`
+}
+
+// GetDataMultiRequest is the body of a request to read several keys of a database from a single snapshot of the state
+type GetDataMultiRequest struct {
+	Keys []string `json:"keys"`
+}
+
+// SetLogLevelsRequest is the body of a request to set the logging levels of some modules of the server, by the name of
+// the module
+type SetLogLevelsRequest struct {
+	Levels map[string]string `json:"levels"`
+}
+
+// StateMigrationRequest is the body of a request to start, or resume, the migration of the records of the state
+// database to the current encoding, or to abort the running migration
+type StateMigrationRequest struct {
+	Abort bool `json:"abort"`
+}
+
+// StateScrubRequest is the body of a request to start the scrub of a state database which is in the integrity mode
+type StateScrubRequest struct {
+	DBName string `json:"db_name"`
+}
+
+// IssueAccessTokenRequest is the body of a request to issue an access token to the querier, limited to the given
+// databases and endpoints, given by their route templates, which expires after the given lifetime, or after the
+// maximal lifetime of the node if it is zero
+type IssueAccessTokenRequest struct {
+	DBNames         []string `json:"dbNames"`
+	Endpoints       []string `json:"endpoints"`
+	LifetimeSeconds uint64   `json:"lifetimeSeconds"`
+}
+
+// RevokeAccessTokenRequest is the body of a request to revoke an access token
+type RevokeAccessTokenRequest struct {
+	Token string `json:"token"`
+}
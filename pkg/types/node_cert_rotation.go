@@ -0,0 +1,56 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package types
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// NodeCertRotationConfig prepares the cluster config that rotates the certificate of the node `nodeID` to `newCert`,
+// starting from the current cluster config, which is not modified.
+//
+// The current certificate of the node becomes its previous certificate, which is still accepted to verify the
+// signatures of the node over the blocks numbered below `cutoverBlock`. This leaves an overlap window, from the block
+// of the config transaction up to the cutover block, in which the node switches to the key of the new certificate
+// while the blocks it signed with the old key keep verifying. From the cutover block on, only the new certificate is
+// accepted. Once the cutover block is committed, the previous certificate can be dropped by a subsequent config
+// transaction.
+func NodeCertRotationConfig(current *ClusterConfig, nodeID string, newCert []byte, cutoverBlock uint64) (*ClusterConfig, error) {
+	if len(newCert) == 0 {
+		return nil, errors.New("the new certificate of the node cannot be empty")
+	}
+	if cutoverBlock == 0 {
+		return nil, errors.New("the cutover block must be greater than zero")
+	}
+
+	rotated := proto.Clone(current).(*ClusterConfig)
+	for _, n := range rotated.GetNodes() {
+		if n.GetId() != nodeID {
+			continue
+		}
+
+		if bytes.Equal(n.Certificate, newCert) {
+			return nil, errors.Errorf("the node [%s] already uses the new certificate", nodeID)
+		}
+		n.PreviousCertificate = n.Certificate
+		n.Certificate = newCert
+		n.CertificateCutoverBlock = cutoverBlock
+		return rotated, nil
+	}
+
+	return nil, errors.Errorf("the node [%s] does not exist in the cluster config", nodeID)
+}
+
+// CertificatesAt returns the certificates which verify a signature of the node over the block `blockNum`: the current
+// certificate, and, while the certificate of the node is being rotated, the previous certificate too, for the blocks
+// numbered below the cutover block.
+func (n *NodeConfig) CertificatesAt(blockNum uint64) [][]byte {
+	certs := [][]byte{n.GetCertificate()}
+	if len(n.GetPreviousCertificate()) > 0 && blockNum < n.GetCertificateCutoverBlock() {
+		certs = append(certs, n.GetPreviousCertificate())
+	}
+	return certs
+}
@@ -66,7 +66,7 @@ func (x GetMostRecentUserOrNodeQuery_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetMostRecentUserOrNodeQuery_Type.Descriptor instead.
 func (GetMostRecentUserOrNodeQuery_Type) EnumDescriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{43, 0}
+	return file_query_proto_rawDescGZIP(), []int{66, 0}
 }
 
 type GetDBStatusQueryEnvelope struct {
@@ -289,17 +289,17 @@ func (x *GetDBIndexQuery) GetDbName() string {
 	return ""
 }
 
-type GetDataQueryEnvelope struct {
+type GetDBDescriptorQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetDataQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Payload   *GetDBDescriptorQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetDataQueryEnvelope) Reset() {
-	*x = GetDataQueryEnvelope{}
+func (x *GetDBDescriptorQueryEnvelope) Reset() {
+	*x = GetDBDescriptorQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -307,13 +307,13 @@ func (x *GetDataQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataQueryEnvelope) String() string {
+func (x *GetDBDescriptorQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataQueryEnvelope) ProtoMessage() {}
+func (*GetDBDescriptorQueryEnvelope) ProtoMessage() {}
 
-func (x *GetDataQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -325,37 +325,37 @@ func (x *GetDataQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetDataQueryEnvelope) GetPayload() *GetDataQuery {
+func (x *GetDBDescriptorQueryEnvelope) GetPayload() *GetDBDescriptorQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GetDataQueryEnvelope) GetSignature() []byte {
+func (x *GetDBDescriptorQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetDataQuery struct {
+type GetDBDescriptorQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	AsOf   uint64 `protobuf:"varint,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 }
 
-func (x *GetDataQuery) Reset() {
-	*x = GetDataQuery{}
+func (x *GetDBDescriptorQuery) Reset() {
+	*x = GetDBDescriptorQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,13 +363,13 @@ func (x *GetDataQuery) Reset() {
 	}
 }
 
-func (x *GetDataQuery) String() string {
+func (x *GetDBDescriptorQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataQuery) ProtoMessage() {}
+func (*GetDBDescriptorQuery) ProtoMessage() {}
 
-func (x *GetDataQuery) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,46 +381,43 @@ func (x *GetDataQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataQuery.ProtoReflect.Descriptor instead.
-func (*GetDataQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorQuery.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetDataQuery) GetUserId() string {
+func (x *GetDBDescriptorQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetDataQuery) GetDbName() string {
+func (x *GetDBDescriptorQuery) GetDbName() string {
 	if x != nil {
 		return x.DbName
 	}
 	return ""
 }
 
-func (x *GetDataQuery) GetKey() string {
+func (x *GetDBDescriptorQuery) GetAsOf() uint64 {
 	if x != nil {
-		return x.Key
+		return x.AsOf
 	}
-	return ""
+	return 0
 }
 
-type GetDataRangeQuery struct {
+type GetDBDigestQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DbName   string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	StartKey string `protobuf:"bytes,3,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
-	EndKey   string `protobuf:"bytes,4,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
-	Limit    uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Payload   *GetDBDigestQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte            `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetDataRangeQuery) Reset() {
-	*x = GetDataRangeQuery{}
+func (x *GetDBDigestQueryEnvelope) Reset() {
+	*x = GetDBDigestQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,13 +425,13 @@ func (x *GetDataRangeQuery) Reset() {
 	}
 }
 
-func (x *GetDataRangeQuery) String() string {
+func (x *GetDBDigestQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataRangeQuery) ProtoMessage() {}
+func (*GetDBDigestQueryEnvelope) ProtoMessage() {}
 
-func (x *GetDataRangeQuery) ProtoReflect() protoreflect.Message {
+func (x *GetDBDigestQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -446,57 +443,39 @@ func (x *GetDataRangeQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataRangeQuery.ProtoReflect.Descriptor instead.
-func (*GetDataRangeQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDigestQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDBDigestQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetDataRangeQuery) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetDataRangeQuery) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *GetDataRangeQuery) GetStartKey() string {
+func (x *GetDBDigestQueryEnvelope) GetPayload() *GetDBDigestQuery {
 	if x != nil {
-		return x.StartKey
-	}
-	return ""
-}
-
-func (x *GetDataRangeQuery) GetEndKey() string {
-	if x != nil {
-		return x.EndKey
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *GetDataRangeQuery) GetLimit() uint64 {
+func (x *GetDBDigestQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.Limit
+		return x.Signature
 	}
-	return 0
+	return nil
 }
 
-type GetUserQueryEnvelope struct {
+// GetDBDigestQuery asks for the state digest of a database as of a block, or as of the last committed block when
+// block_number is 0.
+type GetDBDigestQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetUserQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName      string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 }
 
-func (x *GetUserQueryEnvelope) Reset() {
-	*x = GetUserQueryEnvelope{}
+func (x *GetDBDigestQuery) Reset() {
+	*x = GetDBDigestQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,13 +483,13 @@ func (x *GetUserQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetUserQueryEnvelope) String() string {
+func (x *GetDBDigestQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserQueryEnvelope) ProtoMessage() {}
+func (*GetDBDigestQuery) ProtoMessage() {}
 
-func (x *GetUserQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDBDigestQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -522,36 +501,43 @@ func (x *GetUserQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetUserQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDigestQuery.ProtoReflect.Descriptor instead.
+func (*GetDBDigestQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetUserQueryEnvelope) GetPayload() *GetUserQuery {
+func (x *GetDBDigestQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserQueryEnvelope) GetSignature() []byte {
+func (x *GetDBDigestQuery) GetDbName() string {
 	if x != nil {
-		return x.Signature
+		return x.DbName
 	}
-	return nil
+	return ""
 }
 
-type GetUserQuery struct {
+func (x *GetDBDigestQuery) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type GetDBDescriptorHistoryQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Payload   *GetDBDescriptorHistoryQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetUserQuery) Reset() {
-	*x = GetUserQuery{}
+func (x *GetDBDescriptorHistoryQueryEnvelope) Reset() {
+	*x = GetDBDescriptorHistoryQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,13 +545,13 @@ func (x *GetUserQuery) Reset() {
 	}
 }
 
-func (x *GetUserQuery) String() string {
+func (x *GetDBDescriptorHistoryQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserQuery) ProtoMessage() {}
+func (*GetDBDescriptorHistoryQueryEnvelope) ProtoMessage() {}
 
-func (x *GetUserQuery) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorHistoryQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,36 +563,36 @@ func (x *GetUserQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserQuery.ProtoReflect.Descriptor instead.
-func (*GetUserQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorHistoryQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorHistoryQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetUserQuery) GetUserId() string {
+func (x *GetDBDescriptorHistoryQueryEnvelope) GetPayload() *GetDBDescriptorHistoryQuery {
 	if x != nil {
-		return x.UserId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *GetUserQuery) GetTargetUserId() string {
+func (x *GetDBDescriptorHistoryQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.TargetUserId
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
-type GetConfigQueryEnvelope struct {
+type GetDBDescriptorHistoryQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetConfigQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 }
 
-func (x *GetConfigQueryEnvelope) Reset() {
-	*x = GetConfigQueryEnvelope{}
+func (x *GetDBDescriptorHistoryQuery) Reset() {
+	*x = GetDBDescriptorHistoryQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,13 +600,13 @@ func (x *GetConfigQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetConfigQueryEnvelope) String() string {
+func (x *GetDBDescriptorHistoryQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigQueryEnvelope) ProtoMessage() {}
+func (*GetDBDescriptorHistoryQuery) ProtoMessage() {}
 
-func (x *GetConfigQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorHistoryQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,35 +618,36 @@ func (x *GetConfigQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetConfigQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorHistoryQuery.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorHistoryQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetConfigQueryEnvelope) GetPayload() *GetConfigQuery {
+func (x *GetDBDescriptorHistoryQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetConfigQueryEnvelope) GetSignature() []byte {
+func (x *GetDBDescriptorHistoryQuery) GetDbName() string {
 	if x != nil {
-		return x.Signature
+		return x.DbName
 	}
-	return nil
+	return ""
 }
 
-type GetConfigQuery struct {
+type GetDataQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Payload   *GetDataQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetConfigQuery) Reset() {
-	*x = GetConfigQuery{}
+func (x *GetDataQueryEnvelope) Reset() {
+	*x = GetDataQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -668,13 +655,13 @@ func (x *GetConfigQuery) Reset() {
 	}
 }
 
-func (x *GetConfigQuery) String() string {
+func (x *GetDataQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigQuery) ProtoMessage() {}
+func (*GetDataQueryEnvelope) ProtoMessage() {}
 
-func (x *GetConfigQuery) ProtoReflect() protoreflect.Message {
+func (x *GetDataQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,29 +673,38 @@ func (x *GetConfigQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigQuery.ProtoReflect.Descriptor instead.
-func (*GetConfigQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetConfigQuery) GetUserId() string {
+func (x *GetDataQueryEnvelope) GetPayload() *GetDataQuery {
 	if x != nil {
-		return x.UserId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-type GetNodeConfigQueryEnvelope struct {
+func (x *GetDataQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetDataQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetNodeConfigQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	AsOf   uint64 `protobuf:"varint,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 }
 
-func (x *GetNodeConfigQueryEnvelope) Reset() {
-	*x = GetNodeConfigQueryEnvelope{}
+func (x *GetDataQuery) Reset() {
+	*x = GetDataQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,13 +712,13 @@ func (x *GetNodeConfigQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetNodeConfigQueryEnvelope) String() string {
+func (x *GetDataQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeConfigQueryEnvelope) ProtoMessage() {}
+func (*GetDataQuery) ProtoMessage() {}
 
-func (x *GetNodeConfigQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDataQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,36 +730,58 @@ func (x *GetNodeConfigQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeConfigQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetNodeConfigQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataQuery.ProtoReflect.Descriptor instead.
+func (*GetDataQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetNodeConfigQueryEnvelope) GetPayload() *GetNodeConfigQuery {
+func (x *GetDataQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetNodeConfigQueryEnvelope) GetSignature() []byte {
+func (x *GetDataQuery) GetDbName() string {
 	if x != nil {
-		return x.Signature
+		return x.DbName
 	}
-	return nil
+	return ""
 }
 
-type GetNodeConfigQuery struct {
+func (x *GetDataQuery) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetDataQuery) GetAsOf() uint64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+type GetDataRangeQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName   string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	StartKey string `protobuf:"bytes,3,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   string `protobuf:"bytes,4,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	Limit    uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	AsOf     uint64 `protobuf:"varint,6,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// analytical routes the query to the read replica of the state database.
+	Analytical bool `protobuf:"varint,7,opt,name=analytical,proto3" json:"analytical,omitempty"`
+	// cursor resumes the range from the next_cursor of the previous page of the same query.
+	Cursor string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetNodeConfigQuery) Reset() {
-	*x = GetNodeConfigQuery{}
+func (x *GetDataRangeQuery) Reset() {
+	*x = GetDataRangeQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,13 +789,13 @@ func (x *GetNodeConfigQuery) Reset() {
 	}
 }
 
-func (x *GetNodeConfigQuery) String() string {
+func (x *GetDataRangeQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeConfigQuery) ProtoMessage() {}
+func (*GetDataRangeQuery) ProtoMessage() {}
 
-func (x *GetNodeConfigQuery) ProtoReflect() protoreflect.Message {
+func (x *GetDataRangeQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,36 +807,78 @@ func (x *GetNodeConfigQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeConfigQuery.ProtoReflect.Descriptor instead.
-func (*GetNodeConfigQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataRangeQuery.ProtoReflect.Descriptor instead.
+func (*GetDataRangeQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetNodeConfigQuery) GetUserId() string {
+func (x *GetDataRangeQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetNodeConfigQuery) GetNodeId() string {
+func (x *GetDataRangeQuery) GetDbName() string {
 	if x != nil {
-		return x.NodeId
+		return x.DbName
 	}
 	return ""
 }
 
-type GeConfigBlockQueryEnvelope struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Payload   *GetConfigBlockQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-}
-
-func (x *GeConfigBlockQueryEnvelope) Reset() {
-	*x = GeConfigBlockQueryEnvelope{}
+func (x *GetDataRangeQuery) GetStartKey() string {
+	if x != nil {
+		return x.StartKey
+	}
+	return ""
+}
+
+func (x *GetDataRangeQuery) GetEndKey() string {
+	if x != nil {
+		return x.EndKey
+	}
+	return ""
+}
+
+func (x *GetDataRangeQuery) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDataRangeQuery) GetAsOf() uint64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+func (x *GetDataRangeQuery) GetAnalytical() bool {
+	if x != nil {
+		return x.Analytical
+	}
+	return false
+}
+
+func (x *GetDataRangeQuery) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetUserQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetUserQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetUserQueryEnvelope) Reset() {
+	*x = GetUserQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,13 +886,13 @@ func (x *GeConfigBlockQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GeConfigBlockQueryEnvelope) String() string {
+func (x *GetUserQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeConfigBlockQueryEnvelope) ProtoMessage() {}
+func (*GetUserQueryEnvelope) ProtoMessage() {}
 
-func (x *GeConfigBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetUserQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,36 +904,36 @@ func (x *GeConfigBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeConfigBlockQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GeConfigBlockQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetUserQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GeConfigBlockQueryEnvelope) GetPayload() *GetConfigBlockQuery {
+func (x *GetUserQueryEnvelope) GetPayload() *GetUserQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GeConfigBlockQueryEnvelope) GetSignature() []byte {
+func (x *GetUserQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetConfigBlockQuery struct {
+type GetUserQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
 }
 
-func (x *GetConfigBlockQuery) Reset() {
-	*x = GetConfigBlockQuery{}
+func (x *GetUserQuery) Reset() {
+	*x = GetUserQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -881,13 +941,13 @@ func (x *GetConfigBlockQuery) Reset() {
 	}
 }
 
-func (x *GetConfigBlockQuery) String() string {
+func (x *GetUserQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigBlockQuery) ProtoMessage() {}
+func (*GetUserQuery) ProtoMessage() {}
 
-func (x *GetConfigBlockQuery) ProtoReflect() protoreflect.Message {
+func (x *GetUserQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -899,36 +959,36 @@ func (x *GetConfigBlockQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigBlockQuery.ProtoReflect.Descriptor instead.
-func (*GetConfigBlockQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserQuery.ProtoReflect.Descriptor instead.
+func (*GetUserQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetConfigBlockQuery) GetUserId() string {
+func (x *GetUserQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetConfigBlockQuery) GetBlockNumber() uint64 {
+func (x *GetUserQuery) GetTargetUserId() string {
 	if x != nil {
-		return x.BlockNumber
+		return x.TargetUserId
 	}
-	return 0
+	return ""
 }
 
-type GetClusterStatusQueryEnvelope struct {
+type GetConfigQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetClusterStatusQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Payload   *GetConfigQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetClusterStatusQueryEnvelope) Reset() {
-	*x = GetClusterStatusQueryEnvelope{}
+func (x *GetConfigQueryEnvelope) Reset() {
+	*x = GetConfigQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,13 +996,13 @@ func (x *GetClusterStatusQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetClusterStatusQueryEnvelope) String() string {
+func (x *GetConfigQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterStatusQueryEnvelope) ProtoMessage() {}
+func (*GetConfigQueryEnvelope) ProtoMessage() {}
 
-func (x *GetClusterStatusQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetConfigQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -954,36 +1014,35 @@ func (x *GetClusterStatusQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterStatusQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetClusterStatusQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetConfigQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetClusterStatusQueryEnvelope) GetPayload() *GetClusterStatusQuery {
+func (x *GetConfigQueryEnvelope) GetPayload() *GetConfigQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GetClusterStatusQueryEnvelope) GetSignature() []byte {
+func (x *GetConfigQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetClusterStatusQuery struct {
+type GetConfigQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	NoCertificates bool   `protobuf:"varint,2,opt,name=noCertificates,proto3" json:"noCertificates,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetClusterStatusQuery) Reset() {
-	*x = GetClusterStatusQuery{}
+func (x *GetConfigQuery) Reset() {
+	*x = GetConfigQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -991,13 +1050,13 @@ func (x *GetClusterStatusQuery) Reset() {
 	}
 }
 
-func (x *GetClusterStatusQuery) String() string {
+func (x *GetConfigQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterStatusQuery) ProtoMessage() {}
+func (*GetConfigQuery) ProtoMessage() {}
 
-func (x *GetClusterStatusQuery) ProtoReflect() protoreflect.Message {
+func (x *GetConfigQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1009,37 +1068,29 @@ func (x *GetClusterStatusQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterStatusQuery.ProtoReflect.Descriptor instead.
-func (*GetClusterStatusQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigQuery.ProtoReflect.Descriptor instead.
+func (*GetConfigQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetClusterStatusQuery) GetUserId() string {
+func (x *GetConfigQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetClusterStatusQuery) GetNoCertificates() bool {
-	if x != nil {
-		return x.NoCertificates
-	}
-	return false
-}
-
-type GetBlockQuery struct {
+type GetNodeConfigQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Augmented   bool   `protobuf:"varint,3,opt,name=augmented,proto3" json:"augmented,omitempty"`
+	Payload   *GetNodeConfigQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetBlockQuery) Reset() {
-	*x = GetBlockQuery{}
+func (x *GetNodeConfigQueryEnvelope) Reset() {
+	*x = GetNodeConfigQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1047,13 +1098,13 @@ func (x *GetBlockQuery) Reset() {
 	}
 }
 
-func (x *GetBlockQuery) String() string {
+func (x *GetNodeConfigQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockQuery) ProtoMessage() {}
+func (*GetNodeConfigQueryEnvelope) ProtoMessage() {}
 
-func (x *GetBlockQuery) ProtoReflect() protoreflect.Message {
+func (x *GetNodeConfigQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1065,43 +1116,36 @@ func (x *GetBlockQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockQuery.ProtoReflect.Descriptor instead.
-func (*GetBlockQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNodeConfigQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetNodeConfigQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetBlockQuery) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetBlockQuery) GetBlockNumber() uint64 {
+func (x *GetNodeConfigQueryEnvelope) GetPayload() *GetNodeConfigQuery {
 	if x != nil {
-		return x.BlockNumber
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *GetBlockQuery) GetAugmented() bool {
+func (x *GetNodeConfigQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.Augmented
+		return x.Signature
 	}
-	return false
+	return nil
 }
 
-type GetBlockQueryEnvelope struct {
+type GetNodeConfigQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetBlockQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte         `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
 
-func (x *GetBlockQueryEnvelope) Reset() {
-	*x = GetBlockQueryEnvelope{}
+func (x *GetNodeConfigQuery) Reset() {
+	*x = GetNodeConfigQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1109,13 +1153,13 @@ func (x *GetBlockQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetBlockQueryEnvelope) String() string {
+func (x *GetNodeConfigQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockQueryEnvelope) ProtoMessage() {}
+func (*GetNodeConfigQuery) ProtoMessage() {}
 
-func (x *GetBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetNodeConfigQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1127,35 +1171,36 @@ func (x *GetBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetBlockQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNodeConfigQuery.ProtoReflect.Descriptor instead.
+func (*GetNodeConfigQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetBlockQueryEnvelope) GetPayload() *GetBlockQuery {
+func (x *GetNodeConfigQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBlockQueryEnvelope) GetSignature() []byte {
+func (x *GetNodeConfigQuery) GetNodeId() string {
 	if x != nil {
-		return x.Signature
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-type GetLastBlockQuery struct {
+type GeConfigBlockQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Payload   *GetConfigBlockQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetLastBlockQuery) Reset() {
-	*x = GetLastBlockQuery{}
+func (x *GeConfigBlockQueryEnvelope) Reset() {
+	*x = GeConfigBlockQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1163,13 +1208,13 @@ func (x *GetLastBlockQuery) Reset() {
 	}
 }
 
-func (x *GetLastBlockQuery) String() string {
+func (x *GeConfigBlockQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastBlockQuery) ProtoMessage() {}
+func (*GeConfigBlockQueryEnvelope) ProtoMessage() {}
 
-func (x *GetLastBlockQuery) ProtoReflect() protoreflect.Message {
+func (x *GeConfigBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1181,43 +1226,50 @@ func (x *GetLastBlockQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastBlockQuery.ProtoReflect.Descriptor instead.
-func (*GetLastBlockQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeConfigBlockQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GeConfigBlockQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetLastBlockQuery) GetUserId() string {
+func (x *GeConfigBlockQueryEnvelope) GetPayload() *GetConfigBlockQuery {
 	if x != nil {
-		return x.UserId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-type GetLastBlockQueryEnvelope struct {
+func (x *GeConfigBlockQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetConfigBlockQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetLastBlockQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 }
 
-func (x *GetLastBlockQueryEnvelope) Reset() {
-	*x = GetLastBlockQueryEnvelope{}
-	if protoimpl.UnsafeEnabled {
+func (x *GetConfigBlockQuery) Reset() {
+	*x = GetConfigBlockQuery{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLastBlockQueryEnvelope) String() string {
+func (x *GetConfigBlockQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastBlockQueryEnvelope) ProtoMessage() {}
+func (*GetConfigBlockQuery) ProtoMessage() {}
 
-func (x *GetLastBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetConfigBlockQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1229,37 +1281,36 @@ func (x *GetLastBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastBlockQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetLastBlockQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigBlockQuery.ProtoReflect.Descriptor instead.
+func (*GetConfigBlockQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetLastBlockQueryEnvelope) GetPayload() *GetLastBlockQuery {
+func (x *GetConfigBlockQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetLastBlockQueryEnvelope) GetSignature() []byte {
+func (x *GetConfigBlockQuery) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.BlockNumber
 	}
-	return nil
+	return 0
 }
 
-type GetLedgerPathQuery struct {
+type GetConfigLimitsQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StartBlockNumber uint64 `protobuf:"varint,2,opt,name=start_block_number,json=startBlockNumber,proto3" json:"start_block_number,omitempty"`
-	EndBlockNumber   uint64 `protobuf:"varint,3,opt,name=end_block_number,json=endBlockNumber,proto3" json:"end_block_number,omitempty"`
+	Payload   *GetConfigLimitsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetLedgerPathQuery) Reset() {
-	*x = GetLedgerPathQuery{}
+func (x *GetConfigLimitsQueryEnvelope) Reset() {
+	*x = GetConfigLimitsQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1267,13 +1318,13 @@ func (x *GetLedgerPathQuery) Reset() {
 	}
 }
 
-func (x *GetLedgerPathQuery) String() string {
+func (x *GetConfigLimitsQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLedgerPathQuery) ProtoMessage() {}
+func (*GetConfigLimitsQueryEnvelope) ProtoMessage() {}
 
-func (x *GetLedgerPathQuery) ProtoReflect() protoreflect.Message {
+func (x *GetConfigLimitsQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1285,43 +1336,35 @@ func (x *GetLedgerPathQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLedgerPathQuery.ProtoReflect.Descriptor instead.
-func (*GetLedgerPathQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigLimitsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetConfigLimitsQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetLedgerPathQuery) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetLedgerPathQuery) GetStartBlockNumber() uint64 {
+func (x *GetConfigLimitsQueryEnvelope) GetPayload() *GetConfigLimitsQuery {
 	if x != nil {
-		return x.StartBlockNumber
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *GetLedgerPathQuery) GetEndBlockNumber() uint64 {
+func (x *GetConfigLimitsQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.EndBlockNumber
+		return x.Signature
 	}
-	return 0
+	return nil
 }
 
-type GetLedgerPathQueryEnvelope struct {
+type GetConfigLimitsQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetLedgerPathQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetLedgerPathQueryEnvelope) Reset() {
-	*x = GetLedgerPathQueryEnvelope{}
+func (x *GetConfigLimitsQuery) Reset() {
+	*x = GetConfigLimitsQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1329,13 +1372,13 @@ func (x *GetLedgerPathQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetLedgerPathQueryEnvelope) String() string {
+func (x *GetConfigLimitsQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLedgerPathQueryEnvelope) ProtoMessage() {}
+func (*GetConfigLimitsQuery) ProtoMessage() {}
 
-func (x *GetLedgerPathQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetConfigLimitsQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1347,37 +1390,29 @@ func (x *GetLedgerPathQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLedgerPathQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetLedgerPathQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigLimitsQuery.ProtoReflect.Descriptor instead.
+func (*GetConfigLimitsQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetLedgerPathQueryEnvelope) GetPayload() *GetLedgerPathQuery {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *GetLedgerPathQueryEnvelope) GetSignature() []byte {
+func (x *GetConfigLimitsQuery) GetUserId() string {
 	if x != nil {
-		return x.Signature
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetTxProofQuery struct {
+type GetClusterStatusQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	TxIndex     uint64 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Payload   *GetClusterStatusQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetTxProofQuery) Reset() {
-	*x = GetTxProofQuery{}
+func (x *GetClusterStatusQueryEnvelope) Reset() {
+	*x = GetClusterStatusQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1385,13 +1420,13 @@ func (x *GetTxProofQuery) Reset() {
 	}
 }
 
-func (x *GetTxProofQuery) String() string {
+func (x *GetClusterStatusQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxProofQuery) ProtoMessage() {}
+func (*GetClusterStatusQueryEnvelope) ProtoMessage() {}
 
-func (x *GetTxProofQuery) ProtoReflect() protoreflect.Message {
+func (x *GetClusterStatusQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1403,43 +1438,36 @@ func (x *GetTxProofQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxProofQuery.ProtoReflect.Descriptor instead.
-func (*GetTxProofQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterStatusQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetClusterStatusQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetTxProofQuery) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetTxProofQuery) GetBlockNumber() uint64 {
+func (x *GetClusterStatusQueryEnvelope) GetPayload() *GetClusterStatusQuery {
 	if x != nil {
-		return x.BlockNumber
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTxProofQuery) GetTxIndex() uint64 {
+func (x *GetClusterStatusQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.TxIndex
+		return x.Signature
 	}
-	return 0
+	return nil
 }
 
-type GetTxProofQueryEnvelope struct {
+type GetClusterStatusQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetTxProofQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NoCertificates bool   `protobuf:"varint,2,opt,name=noCertificates,proto3" json:"noCertificates,omitempty"`
 }
 
-func (x *GetTxProofQueryEnvelope) Reset() {
-	*x = GetTxProofQueryEnvelope{}
+func (x *GetClusterStatusQuery) Reset() {
+	*x = GetClusterStatusQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1447,13 +1475,13 @@ func (x *GetTxProofQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetTxProofQueryEnvelope) String() string {
+func (x *GetClusterStatusQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxProofQueryEnvelope) ProtoMessage() {}
+func (*GetClusterStatusQuery) ProtoMessage() {}
 
-func (x *GetTxProofQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetClusterStatusQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1465,39 +1493,35 @@ func (x *GetTxProofQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxProofQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetTxProofQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterStatusQuery.ProtoReflect.Descriptor instead.
+func (*GetClusterStatusQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetTxProofQueryEnvelope) GetPayload() *GetTxProofQuery {
+func (x *GetClusterStatusQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTxProofQueryEnvelope) GetSignature() []byte {
+func (x *GetClusterStatusQuery) GetNoCertificates() bool {
 	if x != nil {
-		return x.Signature
+		return x.NoCertificates
 	}
-	return nil
+	return false
 }
 
-type GetDataProofQuery struct {
+type GetClusterHeartbeatsQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	DbName      string `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Key         string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	IsDeleted   bool   `protobuf:"varint,5,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetDataProofQuery) Reset() {
-	*x = GetDataProofQuery{}
+func (x *GetClusterHeartbeatsQuery) Reset() {
+	*x = GetClusterHeartbeatsQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1505,13 +1529,13 @@ func (x *GetDataProofQuery) Reset() {
 	}
 }
 
-func (x *GetDataProofQuery) String() string {
+func (x *GetClusterHeartbeatsQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataProofQuery) ProtoMessage() {}
+func (*GetClusterHeartbeatsQuery) ProtoMessage() {}
 
-func (x *GetDataProofQuery) ProtoReflect() protoreflect.Message {
+func (x *GetClusterHeartbeatsQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1523,57 +1547,29 @@ func (x *GetDataProofQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataProofQuery.ProtoReflect.Descriptor instead.
-func (*GetDataProofQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterHeartbeatsQuery.ProtoReflect.Descriptor instead.
+func (*GetClusterHeartbeatsQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetDataProofQuery) GetUserId() string {
+func (x *GetClusterHeartbeatsQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetDataProofQuery) GetBlockNumber() uint64 {
-	if x != nil {
-		return x.BlockNumber
-	}
-	return 0
-}
-
-func (x *GetDataProofQuery) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *GetDataProofQuery) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *GetDataProofQuery) GetIsDeleted() bool {
-	if x != nil {
-		return x.IsDeleted
-	}
-	return false
-}
-
-type GetDataProofQueryEnvelope struct {
+type GetSessionBootstrapQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetDataProofQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Payload   *GetSessionBootstrapQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetDataProofQueryEnvelope) Reset() {
-	*x = GetDataProofQueryEnvelope{}
+func (x *GetSessionBootstrapQueryEnvelope) Reset() {
+	*x = GetSessionBootstrapQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1581,13 +1577,13 @@ func (x *GetDataProofQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataProofQueryEnvelope) String() string {
+func (x *GetSessionBootstrapQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataProofQueryEnvelope) ProtoMessage() {}
+func (*GetSessionBootstrapQueryEnvelope) ProtoMessage() {}
 
-func (x *GetDataProofQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetSessionBootstrapQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1599,41 +1595,35 @@ func (x *GetDataProofQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataProofQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataProofQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionBootstrapQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetSessionBootstrapQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetDataProofQueryEnvelope) GetPayload() *GetDataProofQuery {
+func (x *GetSessionBootstrapQueryEnvelope) GetPayload() *GetSessionBootstrapQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GetDataProofQueryEnvelope) GetSignature() []byte {
+func (x *GetSessionBootstrapQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetHistoricalDataQuery struct {
+type GetSessionBootstrapQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DbName      string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Key         string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
-	Version     *Version `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Direction   string   `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
-	OnlyDeletes bool     `protobuf:"varint,6,opt,name=only_deletes,json=onlyDeletes,proto3" json:"only_deletes,omitempty"`
-	MostRecent  bool     `protobuf:"varint,7,opt,name=most_recent,json=mostRecent,proto3" json:"most_recent,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetHistoricalDataQuery) Reset() {
-	*x = GetHistoricalDataQuery{}
+func (x *GetSessionBootstrapQuery) Reset() {
+	*x = GetSessionBootstrapQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1641,13 +1631,13 @@ func (x *GetHistoricalDataQuery) Reset() {
 	}
 }
 
-func (x *GetHistoricalDataQuery) String() string {
+func (x *GetSessionBootstrapQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricalDataQuery) ProtoMessage() {}
+func (*GetSessionBootstrapQuery) ProtoMessage() {}
 
-func (x *GetHistoricalDataQuery) ProtoReflect() protoreflect.Message {
+func (x *GetSessionBootstrapQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1659,85 +1649,43 @@ func (x *GetHistoricalDataQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricalDataQuery.ProtoReflect.Descriptor instead.
-func (*GetHistoricalDataQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionBootstrapQuery.ProtoReflect.Descriptor instead.
+func (*GetSessionBootstrapQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetHistoricalDataQuery) GetUserId() string {
+func (x *GetSessionBootstrapQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetHistoricalDataQuery) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
+type SubscribePrivilegeChangesQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetHistoricalDataQuery) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+	Payload   *SubscribePrivilegeChangesQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetHistoricalDataQuery) GetVersion() *Version {
-	if x != nil {
-		return x.Version
+func (x *SubscribePrivilegeChangesQueryEnvelope) Reset() {
+	*x = SubscribePrivilegeChangesQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GetHistoricalDataQuery) GetDirection() string {
-	if x != nil {
-		return x.Direction
-	}
-	return ""
+func (x *SubscribePrivilegeChangesQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetHistoricalDataQuery) GetOnlyDeletes() bool {
-	if x != nil {
-		return x.OnlyDeletes
-	}
-	return false
-}
+func (*SubscribePrivilegeChangesQueryEnvelope) ProtoMessage() {}
 
-func (x *GetHistoricalDataQuery) GetMostRecent() bool {
-	if x != nil {
-		return x.MostRecent
-	}
-	return false
-}
-
-type GetHistoricalDataQueryEnvelope struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Payload   *GetHistoricalDataQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-}
-
-func (x *GetHistoricalDataQueryEnvelope) Reset() {
-	*x = GetHistoricalDataQueryEnvelope{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetHistoricalDataQueryEnvelope) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetHistoricalDataQueryEnvelope) ProtoMessage() {}
-
-func (x *GetHistoricalDataQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *SubscribePrivilegeChangesQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1749,37 +1697,35 @@ func (x *GetHistoricalDataQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricalDataQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetHistoricalDataQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribePrivilegeChangesQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*SubscribePrivilegeChangesQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetHistoricalDataQueryEnvelope) GetPayload() *GetHistoricalDataQuery {
+func (x *SubscribePrivilegeChangesQueryEnvelope) GetPayload() *SubscribePrivilegeChangesQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GetHistoricalDataQueryEnvelope) GetSignature() []byte {
+func (x *SubscribePrivilegeChangesQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetDataReadersQuery struct {
+type SubscribePrivilegeChangesQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *GetDataReadersQuery) Reset() {
-	*x = GetDataReadersQuery{}
+func (x *SubscribePrivilegeChangesQuery) Reset() {
+	*x = SubscribePrivilegeChangesQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1787,13 +1733,13 @@ func (x *GetDataReadersQuery) Reset() {
 	}
 }
 
-func (x *GetDataReadersQuery) String() string {
+func (x *SubscribePrivilegeChangesQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataReadersQuery) ProtoMessage() {}
+func (*SubscribePrivilegeChangesQuery) ProtoMessage() {}
 
-func (x *GetDataReadersQuery) ProtoReflect() protoreflect.Message {
+func (x *SubscribePrivilegeChangesQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1805,43 +1751,30 @@ func (x *GetDataReadersQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataReadersQuery.ProtoReflect.Descriptor instead.
-func (*GetDataReadersQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribePrivilegeChangesQuery.ProtoReflect.Descriptor instead.
+func (*SubscribePrivilegeChangesQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetDataReadersQuery) GetUserId() string {
+func (x *SubscribePrivilegeChangesQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetDataReadersQuery) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *GetDataReadersQuery) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-type GetDataReadersQueryEnvelope struct {
+type GetBlockQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetDataReadersQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Augmented   bool   `protobuf:"varint,3,opt,name=augmented,proto3" json:"augmented,omitempty"`
 }
 
-func (x *GetDataReadersQueryEnvelope) Reset() {
-	*x = GetDataReadersQueryEnvelope{}
+func (x *GetBlockQuery) Reset() {
+	*x = GetBlockQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1849,13 +1782,13 @@ func (x *GetDataReadersQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataReadersQueryEnvelope) String() string {
+func (x *GetBlockQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataReadersQueryEnvelope) ProtoMessage() {}
+func (*GetBlockQuery) ProtoMessage() {}
 
-func (x *GetDataReadersQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetBlockQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1867,37 +1800,43 @@ func (x *GetDataReadersQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataReadersQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataReadersQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockQuery.ProtoReflect.Descriptor instead.
+func (*GetBlockQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetDataReadersQueryEnvelope) GetPayload() *GetDataReadersQuery {
+func (x *GetBlockQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDataReadersQueryEnvelope) GetSignature() []byte {
+func (x *GetBlockQuery) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.BlockNumber
 	}
-	return nil
+	return 0
 }
 
-type GetDataWritersQuery struct {
+func (x *GetBlockQuery) GetAugmented() bool {
+	if x != nil {
+		return x.Augmented
+	}
+	return false
+}
+
+type GetBlockQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Payload   *GetBlockQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte         `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetDataWritersQuery) Reset() {
-	*x = GetDataWritersQuery{}
+func (x *GetBlockQueryEnvelope) Reset() {
+	*x = GetBlockQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1905,13 +1844,13 @@ func (x *GetDataWritersQuery) Reset() {
 	}
 }
 
-func (x *GetDataWritersQuery) String() string {
+func (x *GetBlockQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataWritersQuery) ProtoMessage() {}
+func (*GetBlockQueryEnvelope) ProtoMessage() {}
 
-func (x *GetDataWritersQuery) ProtoReflect() protoreflect.Message {
+func (x *GetBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1923,43 +1862,35 @@ func (x *GetDataWritersQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataWritersQuery.ProtoReflect.Descriptor instead.
-func (*GetDataWritersQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetBlockQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetDataWritersQuery) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetDataWritersQuery) GetDbName() string {
+func (x *GetBlockQueryEnvelope) GetPayload() *GetBlockQuery {
 	if x != nil {
-		return x.DbName
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *GetDataWritersQuery) GetKey() string {
+func (x *GetBlockQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.Key
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
-type GetDataWritersQueryEnvelope struct {
+type GetLastBlockQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetDataWritersQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetDataWritersQueryEnvelope) Reset() {
-	*x = GetDataWritersQueryEnvelope{}
+func (x *GetLastBlockQuery) Reset() {
+	*x = GetLastBlockQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1967,13 +1898,13 @@ func (x *GetDataWritersQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataWritersQueryEnvelope) String() string {
+func (x *GetLastBlockQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataWritersQueryEnvelope) ProtoMessage() {}
+func (*GetLastBlockQuery) ProtoMessage() {}
 
-func (x *GetDataWritersQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetLastBlockQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1985,36 +1916,29 @@ func (x *GetDataWritersQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataWritersQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataWritersQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLastBlockQuery.ProtoReflect.Descriptor instead.
+func (*GetLastBlockQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetDataWritersQueryEnvelope) GetPayload() *GetDataWritersQuery {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *GetDataWritersQueryEnvelope) GetSignature() []byte {
+func (x *GetLastBlockQuery) GetUserId() string {
 	if x != nil {
-		return x.Signature
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetDataReadByQuery struct {
+type GetLastBlockQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Payload   *GetLastBlockQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetDataReadByQuery) Reset() {
-	*x = GetDataReadByQuery{}
+func (x *GetLastBlockQueryEnvelope) Reset() {
+	*x = GetLastBlockQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2022,13 +1946,13 @@ func (x *GetDataReadByQuery) Reset() {
 	}
 }
 
-func (x *GetDataReadByQuery) String() string {
+func (x *GetLastBlockQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataReadByQuery) ProtoMessage() {}
+func (*GetLastBlockQueryEnvelope) ProtoMessage() {}
 
-func (x *GetDataReadByQuery) ProtoReflect() protoreflect.Message {
+func (x *GetLastBlockQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2040,36 +1964,37 @@ func (x *GetDataReadByQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataReadByQuery.ProtoReflect.Descriptor instead.
-func (*GetDataReadByQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLastBlockQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLastBlockQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetDataReadByQuery) GetUserId() string {
+func (x *GetLastBlockQueryEnvelope) GetPayload() *GetLastBlockQuery {
 	if x != nil {
-		return x.UserId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *GetDataReadByQuery) GetTargetUserId() string {
+func (x *GetLastBlockQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.TargetUserId
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
-type GetDataReadByQueryEnvelope struct {
+type GetLedgerPathQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetDataReadByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartBlockNumber uint64 `protobuf:"varint,2,opt,name=start_block_number,json=startBlockNumber,proto3" json:"start_block_number,omitempty"`
+	EndBlockNumber   uint64 `protobuf:"varint,3,opt,name=end_block_number,json=endBlockNumber,proto3" json:"end_block_number,omitempty"`
 }
 
-func (x *GetDataReadByQueryEnvelope) Reset() {
-	*x = GetDataReadByQueryEnvelope{}
+func (x *GetLedgerPathQuery) Reset() {
+	*x = GetLedgerPathQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2077,13 +2002,13 @@ func (x *GetDataReadByQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataReadByQueryEnvelope) String() string {
+func (x *GetLedgerPathQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataReadByQueryEnvelope) ProtoMessage() {}
+func (*GetLedgerPathQuery) ProtoMessage() {}
 
-func (x *GetDataReadByQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetLedgerPathQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2095,36 +2020,43 @@ func (x *GetDataReadByQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataReadByQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataReadByQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLedgerPathQuery.ProtoReflect.Descriptor instead.
+func (*GetLedgerPathQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetDataReadByQueryEnvelope) GetPayload() *GetDataReadByQuery {
+func (x *GetLedgerPathQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDataReadByQueryEnvelope) GetSignature() []byte {
+func (x *GetLedgerPathQuery) GetStartBlockNumber() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.StartBlockNumber
 	}
-	return nil
+	return 0
 }
 
-type GetDataWrittenByQuery struct {
+func (x *GetLedgerPathQuery) GetEndBlockNumber() uint64 {
+	if x != nil {
+		return x.EndBlockNumber
+	}
+	return 0
+}
+
+type GetLedgerPathQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Payload   *GetLedgerPathQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetDataWrittenByQuery) Reset() {
-	*x = GetDataWrittenByQuery{}
+func (x *GetLedgerPathQueryEnvelope) Reset() {
+	*x = GetLedgerPathQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2132,13 +2064,13 @@ func (x *GetDataWrittenByQuery) Reset() {
 	}
 }
 
-func (x *GetDataWrittenByQuery) String() string {
+func (x *GetLedgerPathQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataWrittenByQuery) ProtoMessage() {}
+func (*GetLedgerPathQueryEnvelope) ProtoMessage() {}
 
-func (x *GetDataWrittenByQuery) ProtoReflect() protoreflect.Message {
+func (x *GetLedgerPathQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2150,36 +2082,37 @@ func (x *GetDataWrittenByQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataWrittenByQuery.ProtoReflect.Descriptor instead.
-func (*GetDataWrittenByQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLedgerPathQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLedgerPathQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetDataWrittenByQuery) GetUserId() string {
+func (x *GetLedgerPathQueryEnvelope) GetPayload() *GetLedgerPathQuery {
 	if x != nil {
-		return x.UserId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *GetDataWrittenByQuery) GetTargetUserId() string {
+func (x *GetLedgerPathQueryEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.TargetUserId
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
-type GetDataDeletedByQuery struct {
+type GetTxProofQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TxIndex     uint64 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 }
 
-func (x *GetDataDeletedByQuery) Reset() {
-	*x = GetDataDeletedByQuery{}
+func (x *GetTxProofQuery) Reset() {
+	*x = GetTxProofQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2187,13 +2120,13 @@ func (x *GetDataDeletedByQuery) Reset() {
 	}
 }
 
-func (x *GetDataDeletedByQuery) String() string {
+func (x *GetTxProofQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataDeletedByQuery) ProtoMessage() {}
+func (*GetTxProofQuery) ProtoMessage() {}
 
-func (x *GetDataDeletedByQuery) ProtoReflect() protoreflect.Message {
+func (x *GetTxProofQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2205,36 +2138,43 @@ func (x *GetDataDeletedByQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataDeletedByQuery.ProtoReflect.Descriptor instead.
-func (*GetDataDeletedByQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTxProofQuery.ProtoReflect.Descriptor instead.
+func (*GetTxProofQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetDataDeletedByQuery) GetUserId() string {
+func (x *GetTxProofQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetDataDeletedByQuery) GetTargetUserId() string {
+func (x *GetTxProofQuery) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.TargetUserId
+		return x.BlockNumber
 	}
-	return ""
+	return 0
 }
 
-type GetDataDeletedByQueryEnvelope struct {
+func (x *GetTxProofQuery) GetTxIndex() uint64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+type GetTxProofQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetDataDeletedByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Payload   *GetTxProofQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetDataDeletedByQueryEnvelope) Reset() {
-	*x = GetDataDeletedByQueryEnvelope{}
+func (x *GetTxProofQueryEnvelope) Reset() {
+	*x = GetTxProofQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2242,13 +2182,13 @@ func (x *GetDataDeletedByQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataDeletedByQueryEnvelope) String() string {
+func (x *GetTxProofQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataDeletedByQueryEnvelope) ProtoMessage() {}
+func (*GetTxProofQueryEnvelope) ProtoMessage() {}
 
-func (x *GetDataDeletedByQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetTxProofQueryEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2260,36 +2200,39 @@ func (x *GetDataDeletedByQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataDeletedByQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataDeletedByQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTxProofQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTxProofQueryEnvelope) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetDataDeletedByQueryEnvelope) GetPayload() *GetDataDeletedByQuery {
+func (x *GetTxProofQueryEnvelope) GetPayload() *GetTxProofQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GetDataDeletedByQueryEnvelope) GetSignature() []byte {
+func (x *GetTxProofQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetDataWrittenByQueryEnvelope struct {
+type GetDataProofQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetDataWrittenByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	DbName      string `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key         string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	IsDeleted   bool   `protobuf:"varint,5,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
 }
 
-func (x *GetDataWrittenByQueryEnvelope) Reset() {
-	*x = GetDataWrittenByQueryEnvelope{}
+func (x *GetDataProofQuery) Reset() {
+	*x = GetDataProofQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2297,13 +2240,13 @@ func (x *GetDataWrittenByQueryEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataWrittenByQueryEnvelope) String() string {
+func (x *GetDataProofQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataWrittenByQueryEnvelope) ProtoMessage() {}
+func (*GetDataProofQuery) ProtoMessage() {}
 
-func (x *GetDataWrittenByQueryEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDataProofQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2315,106 +2258,72 @@ func (x *GetDataWrittenByQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataWrittenByQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataWrittenByQueryEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataProofQuery.ProtoReflect.Descriptor instead.
+func (*GetDataProofQuery) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetDataWrittenByQueryEnvelope) GetPayload() *GetDataWrittenByQuery {
+func (x *GetDataProofQuery) GetUserId() string {
 	if x != nil {
-		return x.Payload
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDataWrittenByQueryEnvelope) GetSignature() []byte {
+func (x *GetDataProofQuery) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.Signature
-	}
-	return nil
-}
-
-type GetTxIDsSubmittedByQuery struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
-}
-
-func (x *GetTxIDsSubmittedByQuery) Reset() {
-	*x = GetTxIDsSubmittedByQuery{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.BlockNumber
 	}
+	return 0
 }
 
-func (x *GetTxIDsSubmittedByQuery) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetTxIDsSubmittedByQuery) ProtoMessage() {}
-
-func (x *GetTxIDsSubmittedByQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetDataProofQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetTxIDsSubmittedByQuery.ProtoReflect.Descriptor instead.
-func (*GetTxIDsSubmittedByQuery) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{39}
+	return ""
 }
 
-func (x *GetTxIDsSubmittedByQuery) GetUserId() string {
+func (x *GetDataProofQuery) GetKey() string {
 	if x != nil {
-		return x.UserId
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GetTxIDsSubmittedByQuery) GetTargetUserId() string {
+func (x *GetDataProofQuery) GetIsDeleted() bool {
 	if x != nil {
-		return x.TargetUserId
+		return x.IsDeleted
 	}
-	return ""
+	return false
 }
 
-type GetTxIDsSubmittedByQueryEnvelope struct {
+type GetDataProofQueryEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload   *GetTxIDsSubmittedByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Payload   *GetDataProofQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetTxIDsSubmittedByQueryEnvelope) Reset() {
-	*x = GetTxIDsSubmittedByQueryEnvelope{}
+func (x *GetDataProofQueryEnvelope) Reset() {
+	*x = GetDataProofQueryEnvelope{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[40]
+		mi := &file_query_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTxIDsSubmittedByQueryEnvelope) String() string {
+func (x *GetDataProofQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxIDsSubmittedByQueryEnvelope) ProtoMessage() {}
+func (*GetDataProofQueryEnvelope) ProtoMessage() {}
 
-func (x *GetTxIDsSubmittedByQueryEnvelope) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[40]
+func (x *GetDataProofQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,51 +2334,61 @@ func (x *GetTxIDsSubmittedByQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxIDsSubmittedByQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetTxIDsSubmittedByQueryEnvelope) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetDataProofQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataProofQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetTxIDsSubmittedByQueryEnvelope) GetPayload() *GetTxIDsSubmittedByQuery {
+func (x *GetDataProofQueryEnvelope) GetPayload() *GetDataProofQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GetTxIDsSubmittedByQueryEnvelope) GetSignature() []byte {
+func (x *GetDataProofQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetTxReceiptQuery struct {
+type GetHistoricalDataQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TxId   string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	UserId      string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName      string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key         string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Version     *Version `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Direction   string   `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	OnlyDeletes bool     `protobuf:"varint,6,opt,name=only_deletes,json=onlyDeletes,proto3" json:"only_deletes,omitempty"`
+	MostRecent  bool     `protobuf:"varint,7,opt,name=most_recent,json=mostRecent,proto3" json:"most_recent,omitempty"`
+	// limit bounds the number of values returned by a query of all the values, or all the deleted values, of the key.
+	// Zero returns all of them.
+	Limit uint64 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor resumes such a query from the next_cursor of the previous page of the same query.
+	Cursor string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetTxReceiptQuery) Reset() {
-	*x = GetTxReceiptQuery{}
+func (x *GetHistoricalDataQuery) Reset() {
+	*x = GetHistoricalDataQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[41]
+		mi := &file_query_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTxReceiptQuery) String() string {
+func (x *GetHistoricalDataQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxReceiptQuery) ProtoMessage() {}
+func (*GetHistoricalDataQuery) ProtoMessage() {}
 
-func (x *GetTxReceiptQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[41]
+func (x *GetHistoricalDataQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2480,51 +2399,100 @@ func (x *GetTxReceiptQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxReceiptQuery.ProtoReflect.Descriptor instead.
-func (*GetTxReceiptQuery) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetHistoricalDataQuery.ProtoReflect.Descriptor instead.
+func (*GetHistoricalDataQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetTxReceiptQuery) GetUserId() string {
+func (x *GetHistoricalDataQuery) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetTxReceiptQuery) GetTxId() string {
+func (x *GetHistoricalDataQuery) GetDbName() string {
 	if x != nil {
-		return x.TxId
+		return x.DbName
 	}
 	return ""
 }
 
-type GetTxReceiptQueryEnvelope struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Payload   *GetTxReceiptQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+func (x *GetHistoricalDataQuery) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
 }
 
-func (x *GetTxReceiptQueryEnvelope) Reset() {
-	*x = GetTxReceiptQueryEnvelope{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetHistoricalDataQuery) GetVersion() *Version {
+	if x != nil {
+		return x.Version
 	}
+	return nil
 }
 
-func (x *GetTxReceiptQueryEnvelope) String() string {
+func (x *GetHistoricalDataQuery) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *GetHistoricalDataQuery) GetOnlyDeletes() bool {
+	if x != nil {
+		return x.OnlyDeletes
+	}
+	return false
+}
+
+func (x *GetHistoricalDataQuery) GetMostRecent() bool {
+	if x != nil {
+		return x.MostRecent
+	}
+	return false
+}
+
+func (x *GetHistoricalDataQuery) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetHistoricalDataQuery) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetHistoricalDataQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetHistoricalDataQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetHistoricalDataQueryEnvelope) Reset() {
+	*x = GetHistoricalDataQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHistoricalDataQueryEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxReceiptQueryEnvelope) ProtoMessage() {}
+func (*GetHistoricalDataQueryEnvelope) ProtoMessage() {}
 
-func (x *GetTxReceiptQueryEnvelope) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[42]
+func (x *GetHistoricalDataQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,53 +2503,53 @@ func (x *GetTxReceiptQueryEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxReceiptQueryEnvelope.ProtoReflect.Descriptor instead.
-func (*GetTxReceiptQueryEnvelope) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetHistoricalDataQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetHistoricalDataQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetTxReceiptQueryEnvelope) GetPayload() *GetTxReceiptQuery {
+func (x *GetHistoricalDataQueryEnvelope) GetPayload() *GetHistoricalDataQuery {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *GetTxReceiptQueryEnvelope) GetSignature() []byte {
+func (x *GetHistoricalDataQueryEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetMostRecentUserOrNodeQuery struct {
+type GetDataByVersionQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    GetMostRecentUserOrNodeQuery_Type `protobuf:"varint,1,opt,name=type,proto3,enum=types.GetMostRecentUserOrNodeQuery_Type" json:"type,omitempty"`
-	UserId  string                            `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Id      string                            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	Version *Version                          `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	UserId  string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName  string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key     string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Version *Version `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *GetMostRecentUserOrNodeQuery) Reset() {
-	*x = GetMostRecentUserOrNodeQuery{}
+func (x *GetDataByVersionQuery) Reset() {
+	*x = GetDataByVersionQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[43]
+		mi := &file_query_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMostRecentUserOrNodeQuery) String() string {
+func (x *GetDataByVersionQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMostRecentUserOrNodeQuery) ProtoMessage() {}
+func (*GetDataByVersionQuery) ProtoMessage() {}
 
-func (x *GetMostRecentUserOrNodeQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[43]
+func (x *GetDataByVersionQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2592,51 +2560,106 @@ func (x *GetMostRecentUserOrNodeQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMostRecentUserOrNodeQuery.ProtoReflect.Descriptor instead.
-func (*GetMostRecentUserOrNodeQuery) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetDataByVersionQuery.ProtoReflect.Descriptor instead.
+func (*GetDataByVersionQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetMostRecentUserOrNodeQuery) GetType() GetMostRecentUserOrNodeQuery_Type {
+func (x *GetDataByVersionQuery) GetUserId() string {
 	if x != nil {
-		return x.Type
+		return x.UserId
 	}
-	return GetMostRecentUserOrNodeQuery_USER
+	return ""
 }
 
-func (x *GetMostRecentUserOrNodeQuery) GetUserId() string {
+func (x *GetDataByVersionQuery) GetDbName() string {
 	if x != nil {
-		return x.UserId
+		return x.DbName
 	}
 	return ""
 }
 
-func (x *GetMostRecentUserOrNodeQuery) GetId() string {
+func (x *GetDataByVersionQuery) GetKey() string {
 	if x != nil {
-		return x.Id
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GetMostRecentUserOrNodeQuery) GetVersion() *Version {
+func (x *GetDataByVersionQuery) GetVersion() *Version {
 	if x != nil {
 		return x.Version
 	}
 	return nil
 }
 
-type DataJSONQuery struct {
+type GetDataByVersionQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDataByVersionQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataByVersionQueryEnvelope) Reset() {
+	*x = GetDataByVersionQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataByVersionQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataByVersionQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDataByVersionQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataByVersionQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataByVersionQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDataByVersionQueryEnvelope) GetPayload() *GetDataByVersionQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDataByVersionQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetDataReadersQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	Query  string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *DataJSONQuery) Reset() {
-	*x = DataJSONQuery{}
+func (x *GetDataReadersQuery) Reset() {
+	*x = GetDataReadersQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2644,465 +2667,5514 @@ func (x *DataJSONQuery) Reset() {
 	}
 }
 
-func (x *DataJSONQuery) String() string {
+func (x *GetDataReadersQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataJSONQuery) ProtoMessage() {}
+func (*GetDataReadersQuery) ProtoMessage() {}
 
-func (x *DataJSONQuery) ProtoReflect() protoreflect.Message {
+func (x *GetDataReadersQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DataJSONQuery.ProtoReflect.Descriptor instead.
-func (*DataJSONQuery) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *DataJSONQuery) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *DataJSONQuery) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *DataJSONQuery) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-var File_query_proto protoreflect.FileDescriptor
-
-var file_query_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x1a, 0x1b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x44,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64,
-	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
-	0x30, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64,
-	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x52, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x91, 0x01,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
-	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4b,
-	0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
-	0x2f, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x29,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x22, 0x70, 0x0a, 0x1a, 0x47, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
-	0x12, 0x34, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x58,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x6f, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x65, 0x64, 0x22, 0x65, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c,
-	0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x6f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
-	0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x69, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76,
-	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x22, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0xe8, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x5f,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f,
-	0x6e, 0x6c, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f,
-	0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x1e, 0x47,
-	0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
-	0x71, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x71, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x42,
-	0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x56, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x56,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x75, 0x0a,
-	0x1d, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42,
-	0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57,
-	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x7b, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x74, 0x65, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x41, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22,
-	0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcb,
-	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x1a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x22, 0x57, 0x0a, 0x0d,
-	0x44, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d,
-	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_query_proto_rawDescOnce sync.Once
-	file_query_proto_rawDescData = file_query_proto_rawDesc
-)
-
-func file_query_proto_rawDescGZIP() []byte {
-	file_query_proto_rawDescOnce.Do(func() {
-		file_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_query_proto_rawDescData)
-	})
-	return file_query_proto_rawDescData
-}
-
-var file_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
-var file_query_proto_goTypes = []interface{}{
-	(GetMostRecentUserOrNodeQuery_Type)(0),   // 0: types.GetMostRecentUserOrNodeQuery.Type
-	(*GetDBStatusQueryEnvelope)(nil),         // 1: types.GetDBStatusQueryEnvelope
-	(*GetDBStatusQuery)(nil),                 // 2: types.GetDBStatusQuery
-	(*GetDBIndexQueryEnvelope)(nil),          // 3: types.GetDBIndexQueryEnvelope
-	(*GetDBIndexQuery)(nil),                  // 4: types.GetDBIndexQuery
-	(*GetDataQueryEnvelope)(nil),             // 5: types.GetDataQueryEnvelope
-	(*GetDataQuery)(nil),                     // 6: types.GetDataQuery
-	(*GetDataRangeQuery)(nil),                // 7: types.GetDataRangeQuery
-	(*GetUserQueryEnvelope)(nil),             // 8: types.GetUserQueryEnvelope
-	(*GetUserQuery)(nil),                     // 9: types.GetUserQuery
-	(*GetConfigQueryEnvelope)(nil),           // 10: types.GetConfigQueryEnvelope
-	(*GetConfigQuery)(nil),                   // 11: types.GetConfigQuery
-	(*GetNodeConfigQueryEnvelope)(nil),       // 12: types.GetNodeConfigQueryEnvelope
-	(*GetNodeConfigQuery)(nil),               // 13: types.GetNodeConfigQuery
-	(*GeConfigBlockQueryEnvelope)(nil),       // 14: types.GeConfigBlockQueryEnvelope
-	(*GetConfigBlockQuery)(nil),              // 15: types.GetConfigBlockQuery
-	(*GetClusterStatusQueryEnvelope)(nil),    // 16: types.GetClusterStatusQueryEnvelope
-	(*GetClusterStatusQuery)(nil),            // 17: types.GetClusterStatusQuery
-	(*GetBlockQuery)(nil),                    // 18: types.GetBlockQuery
-	(*GetBlockQueryEnvelope)(nil),            // 19: types.GetBlockQueryEnvelope
-	(*GetLastBlockQuery)(nil),                // 20: types.GetLastBlockQuery
-	(*GetLastBlockQueryEnvelope)(nil),        // 21: types.GetLastBlockQueryEnvelope
-	(*GetLedgerPathQuery)(nil),               // 22: types.GetLedgerPathQuery
-	(*GetLedgerPathQueryEnvelope)(nil),       // 23: types.GetLedgerPathQueryEnvelope
-	(*GetTxProofQuery)(nil),                  // 24: types.GetTxProofQuery
-	(*GetTxProofQueryEnvelope)(nil),          // 25: types.GetTxProofQueryEnvelope
-	(*GetDataProofQuery)(nil),                // 26: types.GetDataProofQuery
-	(*GetDataProofQueryEnvelope)(nil),        // 27: types.GetDataProofQueryEnvelope
-	(*GetHistoricalDataQuery)(nil),           // 28: types.GetHistoricalDataQuery
-	(*GetHistoricalDataQueryEnvelope)(nil),   // 29: types.GetHistoricalDataQueryEnvelope
-	(*GetDataReadersQuery)(nil),              // 30: types.GetDataReadersQuery
-	(*GetDataReadersQueryEnvelope)(nil),      // 31: types.GetDataReadersQueryEnvelope
-	(*GetDataWritersQuery)(nil),              // 32: types.GetDataWritersQuery
-	(*GetDataWritersQueryEnvelope)(nil),      // 33: types.GetDataWritersQueryEnvelope
-	(*GetDataReadByQuery)(nil),               // 34: types.GetDataReadByQuery
-	(*GetDataReadByQueryEnvelope)(nil),       // 35: types.GetDataReadByQueryEnvelope
-	(*GetDataWrittenByQuery)(nil),            // 36: types.GetDataWrittenByQuery
-	(*GetDataDeletedByQuery)(nil),            // 37: types.GetDataDeletedByQuery
-	(*GetDataDeletedByQueryEnvelope)(nil),    // 38: types.GetDataDeletedByQueryEnvelope
-	(*GetDataWrittenByQueryEnvelope)(nil),    // 39: types.GetDataWrittenByQueryEnvelope
-	(*GetTxIDsSubmittedByQuery)(nil),         // 40: types.GetTxIDsSubmittedByQuery
-	(*GetTxIDsSubmittedByQueryEnvelope)(nil), // 41: types.GetTxIDsSubmittedByQueryEnvelope
-	(*GetTxReceiptQuery)(nil),                // 42: types.GetTxReceiptQuery
-	(*GetTxReceiptQueryEnvelope)(nil),        // 43: types.GetTxReceiptQueryEnvelope
-	(*GetMostRecentUserOrNodeQuery)(nil),     // 44: types.GetMostRecentUserOrNodeQuery
-	(*DataJSONQuery)(nil),                    // 45: types.DataJSONQuery
-	(*Version)(nil),                          // 46: types.Version
-}
-var file_query_proto_depIdxs = []int32{
-	2,  // 0: types.GetDBStatusQueryEnvelope.payload:type_name -> types.GetDBStatusQuery
-	4,  // 1: types.GetDBIndexQueryEnvelope.payload:type_name -> types.GetDBIndexQuery
-	6,  // 2: types.GetDataQueryEnvelope.payload:type_name -> types.GetDataQuery
-	9,  // 3: types.GetUserQueryEnvelope.payload:type_name -> types.GetUserQuery
-	11, // 4: types.GetConfigQueryEnvelope.payload:type_name -> types.GetConfigQuery
-	13, // 5: types.GetNodeConfigQueryEnvelope.payload:type_name -> types.GetNodeConfigQuery
-	15, // 6: types.GeConfigBlockQueryEnvelope.payload:type_name -> types.GetConfigBlockQuery
-	17, // 7: types.GetClusterStatusQueryEnvelope.payload:type_name -> types.GetClusterStatusQuery
-	18, // 8: types.GetBlockQueryEnvelope.payload:type_name -> types.GetBlockQuery
-	20, // 9: types.GetLastBlockQueryEnvelope.payload:type_name -> types.GetLastBlockQuery
-	22, // 10: types.GetLedgerPathQueryEnvelope.payload:type_name -> types.GetLedgerPathQuery
-	24, // 11: types.GetTxProofQueryEnvelope.payload:type_name -> types.GetTxProofQuery
-	26, // 12: types.GetDataProofQueryEnvelope.payload:type_name -> types.GetDataProofQuery
-	46, // 13: types.GetHistoricalDataQuery.version:type_name -> types.Version
-	28, // 14: types.GetHistoricalDataQueryEnvelope.payload:type_name -> types.GetHistoricalDataQuery
-	30, // 15: types.GetDataReadersQueryEnvelope.payload:type_name -> types.GetDataReadersQuery
-	32, // 16: types.GetDataWritersQueryEnvelope.payload:type_name -> types.GetDataWritersQuery
-	34, // 17: types.GetDataReadByQueryEnvelope.payload:type_name -> types.GetDataReadByQuery
-	37, // 18: types.GetDataDeletedByQueryEnvelope.payload:type_name -> types.GetDataDeletedByQuery
-	36, // 19: types.GetDataWrittenByQueryEnvelope.payload:type_name -> types.GetDataWrittenByQuery
-	40, // 20: types.GetTxIDsSubmittedByQueryEnvelope.payload:type_name -> types.GetTxIDsSubmittedByQuery
-	42, // 21: types.GetTxReceiptQueryEnvelope.payload:type_name -> types.GetTxReceiptQuery
-	0,  // 22: types.GetMostRecentUserOrNodeQuery.type:type_name -> types.GetMostRecentUserOrNodeQuery.Type
-	46, // 23: types.GetMostRecentUserOrNodeQuery.version:type_name -> types.Version
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
-}
-
-func init() { file_query_proto_init() }
-func file_query_proto_init() {
-	if File_query_proto != nil {
-		return
-	}
-	file_block_and_transaction_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBStatusQueryEnvelope); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataReadersQuery.ProtoReflect.Descriptor instead.
+func (*GetDataReadersQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetDataReadersQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDataReadersQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetDataReadersQuery) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetDataReadersQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDataReadersQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataReadersQueryEnvelope) Reset() {
+	*x = GetDataReadersQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataReadersQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataReadersQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDataReadersQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataReadersQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataReadersQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetDataReadersQueryEnvelope) GetPayload() *GetDataReadersQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDataReadersQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetDataWritersQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetDataWritersQuery) Reset() {
+	*x = GetDataWritersQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataWritersQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataWritersQuery) ProtoMessage() {}
+
+func (x *GetDataWritersQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataWritersQuery.ProtoReflect.Descriptor instead.
+func (*GetDataWritersQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetDataWritersQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDataWritersQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetDataWritersQuery) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetDataWritersQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDataWritersQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataWritersQueryEnvelope) Reset() {
+	*x = GetDataWritersQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataWritersQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataWritersQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDataWritersQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataWritersQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataWritersQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetDataWritersQueryEnvelope) GetPayload() *GetDataWritersQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDataWritersQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetDataReadByQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *GetDataReadByQuery) Reset() {
+	*x = GetDataReadByQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataReadByQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataReadByQuery) ProtoMessage() {}
+
+func (x *GetDataReadByQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataReadByQuery.ProtoReflect.Descriptor instead.
+func (*GetDataReadByQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetDataReadByQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDataReadByQuery) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type GetDataReadByQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDataReadByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataReadByQueryEnvelope) Reset() {
+	*x = GetDataReadByQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataReadByQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataReadByQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDataReadByQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataReadByQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataReadByQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetDataReadByQueryEnvelope) GetPayload() *GetDataReadByQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDataReadByQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetDataWrittenByQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *GetDataWrittenByQuery) Reset() {
+	*x = GetDataWrittenByQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataWrittenByQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataWrittenByQuery) ProtoMessage() {}
+
+func (x *GetDataWrittenByQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataWrittenByQuery.ProtoReflect.Descriptor instead.
+func (*GetDataWrittenByQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetDataWrittenByQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDataWrittenByQuery) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type GetDataDeletedByQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *GetDataDeletedByQuery) Reset() {
+	*x = GetDataDeletedByQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataDeletedByQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataDeletedByQuery) ProtoMessage() {}
+
+func (x *GetDataDeletedByQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataDeletedByQuery.ProtoReflect.Descriptor instead.
+func (*GetDataDeletedByQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetDataDeletedByQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDataDeletedByQuery) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type GetDataDeletedByQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDataDeletedByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataDeletedByQueryEnvelope) Reset() {
+	*x = GetDataDeletedByQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataDeletedByQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataDeletedByQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDataDeletedByQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataDeletedByQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataDeletedByQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetDataDeletedByQueryEnvelope) GetPayload() *GetDataDeletedByQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDataDeletedByQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetDataWrittenByQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDataWrittenByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataWrittenByQueryEnvelope) Reset() {
+	*x = GetDataWrittenByQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataWrittenByQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataWrittenByQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDataWrittenByQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataWrittenByQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataWrittenByQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetDataWrittenByQueryEnvelope) GetPayload() *GetDataWrittenByQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDataWrittenByQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetTxIDsSubmittedByQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *GetTxIDsSubmittedByQuery) Reset() {
+	*x = GetTxIDsSubmittedByQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxIDsSubmittedByQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxIDsSubmittedByQuery) ProtoMessage() {}
+
+func (x *GetTxIDsSubmittedByQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxIDsSubmittedByQuery.ProtoReflect.Descriptor instead.
+func (*GetTxIDsSubmittedByQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTxIDsSubmittedByQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTxIDsSubmittedByQuery) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type GetTxIDsSubmittedByQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetTxIDsSubmittedByQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetTxIDsSubmittedByQueryEnvelope) Reset() {
+	*x = GetTxIDsSubmittedByQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxIDsSubmittedByQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxIDsSubmittedByQueryEnvelope) ProtoMessage() {}
+
+func (x *GetTxIDsSubmittedByQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxIDsSubmittedByQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTxIDsSubmittedByQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTxIDsSubmittedByQueryEnvelope) GetPayload() *GetTxIDsSubmittedByQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetTxIDsSubmittedByQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetTxReceiptQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TxId   string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *GetTxReceiptQuery) Reset() {
+	*x = GetTxReceiptQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxReceiptQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxReceiptQuery) ProtoMessage() {}
+
+func (x *GetTxReceiptQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxReceiptQuery.ProtoReflect.Descriptor instead.
+func (*GetTxReceiptQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetTxReceiptQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTxReceiptQuery) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type GetTxReceiptQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetTxReceiptQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetTxReceiptQueryEnvelope) Reset() {
+	*x = GetTxReceiptQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxReceiptQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxReceiptQueryEnvelope) ProtoMessage() {}
+
+func (x *GetTxReceiptQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxReceiptQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTxReceiptQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetTxReceiptQueryEnvelope) GetPayload() *GetTxReceiptQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetTxReceiptQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetTxWriteSetDigestQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TxId   string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *GetTxWriteSetDigestQuery) Reset() {
+	*x = GetTxWriteSetDigestQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxWriteSetDigestQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxWriteSetDigestQuery) ProtoMessage() {}
+
+func (x *GetTxWriteSetDigestQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxWriteSetDigestQuery.ProtoReflect.Descriptor instead.
+func (*GetTxWriteSetDigestQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetTxWriteSetDigestQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTxWriteSetDigestQuery) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type GetTxWriteSetDigestQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetTxWriteSetDigestQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetTxWriteSetDigestQueryEnvelope) Reset() {
+	*x = GetTxWriteSetDigestQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxWriteSetDigestQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxWriteSetDigestQueryEnvelope) ProtoMessage() {}
+
+func (x *GetTxWriteSetDigestQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxWriteSetDigestQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTxWriteSetDigestQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetTxWriteSetDigestQueryEnvelope) GetPayload() *GetTxWriteSetDigestQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetTxWriteSetDigestQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// GetDroppedTxQuery returns the dead-letter record of a transaction that the node accepted but dropped before it was
+// included in a block.
+type GetDroppedTxQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TxId   string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *GetDroppedTxQuery) Reset() {
+	*x = GetDroppedTxQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxQuery) ProtoMessage() {}
+
+func (x *GetDroppedTxQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxQuery.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDroppedTxQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDroppedTxQuery) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type GetDroppedTxQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDroppedTxQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDroppedTxQueryEnvelope) Reset() {
+	*x = GetDroppedTxQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDroppedTxQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetDroppedTxQueryEnvelope) GetPayload() *GetDroppedTxQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDroppedTxQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// GetLedgerRollupsQuery returns the daily rollups of the ledger from start_date to end_date, both included, each given
+// as YYYY-MM-DD, in UTC.
+type GetLedgerRollupsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+}
+
+func (x *GetLedgerRollupsQuery) Reset() {
+	*x = GetLedgerRollupsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerRollupsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerRollupsQuery) ProtoMessage() {}
+
+func (x *GetLedgerRollupsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerRollupsQuery.ProtoReflect.Descriptor instead.
+func (*GetLedgerRollupsQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetLedgerRollupsQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLedgerRollupsQuery) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetLedgerRollupsQuery) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetLedgerRollupsQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetLedgerRollupsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetLedgerRollupsQueryEnvelope) Reset() {
+	*x = GetLedgerRollupsQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerRollupsQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerRollupsQueryEnvelope) ProtoMessage() {}
+
+func (x *GetLedgerRollupsQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerRollupsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLedgerRollupsQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetLedgerRollupsQueryEnvelope) GetPayload() *GetLedgerRollupsQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetLedgerRollupsQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// GetLedgerUsageQuery returns the sizes of the transactions submitted by target_user_id in the blocks from
+// start_block_number to end_block_number, both included, summed up.
+type GetLedgerUsageQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId     string `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	StartBlockNumber uint64 `protobuf:"varint,3,opt,name=start_block_number,json=startBlockNumber,proto3" json:"start_block_number,omitempty"`
+	EndBlockNumber   uint64 `protobuf:"varint,4,opt,name=end_block_number,json=endBlockNumber,proto3" json:"end_block_number,omitempty"`
+}
+
+func (x *GetLedgerUsageQuery) Reset() {
+	*x = GetLedgerUsageQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerUsageQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerUsageQuery) ProtoMessage() {}
+
+func (x *GetLedgerUsageQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerUsageQuery.ProtoReflect.Descriptor instead.
+func (*GetLedgerUsageQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetLedgerUsageQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLedgerUsageQuery) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *GetLedgerUsageQuery) GetStartBlockNumber() uint64 {
+	if x != nil {
+		return x.StartBlockNumber
+	}
+	return 0
+}
+
+func (x *GetLedgerUsageQuery) GetEndBlockNumber() uint64 {
+	if x != nil {
+		return x.EndBlockNumber
+	}
+	return 0
+}
+
+type GetLedgerUsageQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetLedgerUsageQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetLedgerUsageQueryEnvelope) Reset() {
+	*x = GetLedgerUsageQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerUsageQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerUsageQueryEnvelope) ProtoMessage() {}
+
+func (x *GetLedgerUsageQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerUsageQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLedgerUsageQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetLedgerUsageQueryEnvelope) GetPayload() *GetLedgerUsageQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetLedgerUsageQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetMostRecentUserOrNodeQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    GetMostRecentUserOrNodeQuery_Type `protobuf:"varint,1,opt,name=type,proto3,enum=types.GetMostRecentUserOrNodeQuery_Type" json:"type,omitempty"`
+	UserId  string                            `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id      string                            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Version *Version                          `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetMostRecentUserOrNodeQuery) Reset() {
+	*x = GetMostRecentUserOrNodeQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMostRecentUserOrNodeQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMostRecentUserOrNodeQuery) ProtoMessage() {}
+
+func (x *GetMostRecentUserOrNodeQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMostRecentUserOrNodeQuery.ProtoReflect.Descriptor instead.
+func (*GetMostRecentUserOrNodeQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetMostRecentUserOrNodeQuery) GetType() GetMostRecentUserOrNodeQuery_Type {
+	if x != nil {
+		return x.Type
+	}
+	return GetMostRecentUserOrNodeQuery_USER
+}
+
+func (x *GetMostRecentUserOrNodeQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMostRecentUserOrNodeQuery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMostRecentUserOrNodeQuery) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type DataJSONQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Query  string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// limit bounds the number of key-value pairs returned. Zero returns all of them.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor resumes the query from the next_cursor of the previous page of the same query.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *DataJSONQuery) Reset() {
+	*x = DataJSONQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataJSONQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataJSONQuery) ProtoMessage() {}
+
+func (x *DataJSONQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataJSONQuery.ProtoReflect.Descriptor instead.
+func (*DataJSONQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DataJSONQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DataJSONQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DataJSONQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *DataJSONQuery) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DataJSONQuery) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// GetDataCountQuery counts the keys of a database which the querier can read, or checks whether any exists. The keys
+// are selected by the JSON query, if set, otherwise by the prefix, if set, otherwise by the range [start_key, end_key).
+type GetDataCountQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName   string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	StartKey string `protobuf:"bytes,3,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   string `protobuf:"bytes,4,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	Prefix   string `protobuf:"bytes,5,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Query    string `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	// exists_only stops at the first readable key
+	ExistsOnly bool `protobuf:"varint,7,opt,name=exists_only,json=existsOnly,proto3" json:"exists_only,omitempty"`
+}
+
+func (x *GetDataCountQuery) Reset() {
+	*x = GetDataCountQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataCountQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataCountQuery) ProtoMessage() {}
+
+func (x *GetDataCountQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataCountQuery.ProtoReflect.Descriptor instead.
+func (*GetDataCountQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetDataCountQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDataCountQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetDataCountQuery) GetStartKey() string {
+	if x != nil {
+		return x.StartKey
+	}
+	return ""
+}
+
+func (x *GetDataCountQuery) GetEndKey() string {
+	if x != nil {
+		return x.EndKey
+	}
+	return ""
+}
+
+func (x *GetDataCountQuery) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *GetDataCountQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetDataCountQuery) GetExistsOnly() bool {
+	if x != nil {
+		return x.ExistsOnly
+	}
+	return false
+}
+
+type GetStorageStatsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetStorageStatsQuery) Reset() {
+	*x = GetStorageStatsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStorageStatsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageStatsQuery) ProtoMessage() {}
+
+func (x *GetStorageStatsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageStatsQuery.ProtoReflect.Descriptor instead.
+func (*GetStorageStatsQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetStorageStatsQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetStorageStatsQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetStorageStatsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetStorageStatsQueryEnvelope) Reset() {
+	*x = GetStorageStatsQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStorageStatsQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageStatsQueryEnvelope) ProtoMessage() {}
+
+func (x *GetStorageStatsQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageStatsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetStorageStatsQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetStorageStatsQueryEnvelope) GetPayload() *GetStorageStatsQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetStorageStatsQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type TraceValidationQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *TraceValidationQuery) Reset() {
+	*x = TraceValidationQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceValidationQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceValidationQuery) ProtoMessage() {}
+
+func (x *TraceValidationQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceValidationQuery.ProtoReflect.Descriptor instead.
+func (*TraceValidationQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TraceValidationQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TraceValidationQuery) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type TraceValidationQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *TraceValidationQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *TraceValidationQueryEnvelope) Reset() {
+	*x = TraceValidationQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceValidationQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceValidationQueryEnvelope) ProtoMessage() {}
+
+func (x *TraceValidationQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceValidationQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*TraceValidationQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TraceValidationQueryEnvelope) GetPayload() *TraceValidationQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TraceValidationQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type AcceptPeerHeaderQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *AcceptPeerHeaderQuery) Reset() {
+	*x = AcceptPeerHeaderQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPeerHeaderQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPeerHeaderQuery) ProtoMessage() {}
+
+func (x *AcceptPeerHeaderQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPeerHeaderQuery.ProtoReflect.Descriptor instead.
+func (*AcceptPeerHeaderQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AcceptPeerHeaderQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptPeerHeaderQuery) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type AcceptPeerHeaderQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *AcceptPeerHeaderQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *AcceptPeerHeaderQueryEnvelope) Reset() {
+	*x = AcceptPeerHeaderQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPeerHeaderQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPeerHeaderQueryEnvelope) ProtoMessage() {}
+
+func (x *AcceptPeerHeaderQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPeerHeaderQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*AcceptPeerHeaderQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AcceptPeerHeaderQueryEnvelope) GetPayload() *AcceptPeerHeaderQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *AcceptPeerHeaderQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ResyncDBQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+}
+
+func (x *ResyncDBQuery) Reset() {
+	*x = ResyncDBQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncDBQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncDBQuery) ProtoMessage() {}
+
+func (x *ResyncDBQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncDBQuery.ProtoReflect.Descriptor instead.
+func (*ResyncDBQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ResyncDBQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResyncDBQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+type ResyncDBQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *ResyncDBQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte         `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ResyncDBQueryEnvelope) Reset() {
+	*x = ResyncDBQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncDBQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncDBQueryEnvelope) ProtoMessage() {}
+
+func (x *ResyncDBQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncDBQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*ResyncDBQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ResyncDBQueryEnvelope) GetPayload() *ResyncDBQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ResyncDBQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetTrustedCheckpointsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The number of blocks between two checkpoints. Zero means the default interval.
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *GetTrustedCheckpointsQuery) Reset() {
+	*x = GetTrustedCheckpointsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrustedCheckpointsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrustedCheckpointsQuery) ProtoMessage() {}
+
+func (x *GetTrustedCheckpointsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrustedCheckpointsQuery.ProtoReflect.Descriptor instead.
+func (*GetTrustedCheckpointsQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetTrustedCheckpointsQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTrustedCheckpointsQuery) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type GetTrustedCheckpointsQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetTrustedCheckpointsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetTrustedCheckpointsQueryEnvelope) Reset() {
+	*x = GetTrustedCheckpointsQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrustedCheckpointsQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrustedCheckpointsQueryEnvelope) ProtoMessage() {}
+
+func (x *GetTrustedCheckpointsQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrustedCheckpointsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTrustedCheckpointsQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetTrustedCheckpointsQueryEnvelope) GetPayload() *GetTrustedCheckpointsQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetTrustedCheckpointsQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetLogLevelsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetLogLevelsQuery) Reset() {
+	*x = GetLogLevelsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogLevelsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelsQuery) ProtoMessage() {}
+
+func (x *GetLogLevelsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelsQuery.ProtoReflect.Descriptor instead.
+func (*GetLogLevelsQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetLogLevelsQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetLogLevelsQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetLogLevelsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetLogLevelsQueryEnvelope) Reset() {
+	*x = GetLogLevelsQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogLevelsQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelsQueryEnvelope) ProtoMessage() {}
+
+func (x *GetLogLevelsQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLogLevelsQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetLogLevelsQueryEnvelope) GetPayload() *GetLogLevelsQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetLogLevelsQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// SetLogLevelsQuery sets the logging levels of some modules of the server, by the name of the module. The levels are
+// debug, info, warn, err, and panic.
+type SetLogLevelsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string            `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Levels map[string]string `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SetLogLevelsQuery) Reset() {
+	*x = SetLogLevelsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelsQuery) ProtoMessage() {}
+
+func (x *SetLogLevelsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelsQuery.ProtoReflect.Descriptor instead.
+func (*SetLogLevelsQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetLogLevelsQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetLogLevelsQuery) GetLevels() map[string]string {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type SetLogLevelsQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *SetLogLevelsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SetLogLevelsQueryEnvelope) Reset() {
+	*x = SetLogLevelsQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelsQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelsQueryEnvelope) ProtoMessage() {}
+
+func (x *SetLogLevelsQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*SetLogLevelsQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetLogLevelsQueryEnvelope) GetPayload() *SetLogLevelsQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SetLogLevelsQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetStateMigrationQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetStateMigrationQuery) Reset() {
+	*x = GetStateMigrationQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateMigrationQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateMigrationQuery) ProtoMessage() {}
+
+func (x *GetStateMigrationQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateMigrationQuery.ProtoReflect.Descriptor instead.
+func (*GetStateMigrationQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetStateMigrationQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetStateMigrationQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetStateMigrationQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetStateMigrationQueryEnvelope) Reset() {
+	*x = GetStateMigrationQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateMigrationQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateMigrationQueryEnvelope) ProtoMessage() {}
+
+func (x *GetStateMigrationQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateMigrationQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetStateMigrationQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetStateMigrationQueryEnvelope) GetPayload() *GetStateMigrationQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetStateMigrationQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// StateMigrationQuery starts, or resumes, the migration of the records of the state database to the current encoding,
+// or aborts the running migration.
+type StateMigrationQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Abort  bool   `protobuf:"varint,2,opt,name=abort,proto3" json:"abort,omitempty"`
+}
+
+func (x *StateMigrationQuery) Reset() {
+	*x = StateMigrationQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateMigrationQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMigrationQuery) ProtoMessage() {}
+
+func (x *StateMigrationQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateMigrationQuery.ProtoReflect.Descriptor instead.
+func (*StateMigrationQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *StateMigrationQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StateMigrationQuery) GetAbort() bool {
+	if x != nil {
+		return x.Abort
+	}
+	return false
+}
+
+type StateMigrationQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *StateMigrationQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *StateMigrationQueryEnvelope) Reset() {
+	*x = StateMigrationQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateMigrationQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMigrationQueryEnvelope) ProtoMessage() {}
+
+func (x *StateMigrationQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateMigrationQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*StateMigrationQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StateMigrationQueryEnvelope) GetPayload() *StateMigrationQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *StateMigrationQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetStateScrubQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetStateScrubQuery) Reset() {
+	*x = GetStateScrubQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateScrubQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateScrubQuery) ProtoMessage() {}
+
+func (x *GetStateScrubQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateScrubQuery.ProtoReflect.Descriptor instead.
+func (*GetStateScrubQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetStateScrubQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetStateScrubQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetStateScrubQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetStateScrubQueryEnvelope) Reset() {
+	*x = GetStateScrubQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateScrubQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateScrubQueryEnvelope) ProtoMessage() {}
+
+func (x *GetStateScrubQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateScrubQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetStateScrubQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetStateScrubQueryEnvelope) GetPayload() *GetStateScrubQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetStateScrubQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// StateScrubQuery starts the scrub of a state database which is in the integrity mode, i.e., the verification of the
+// stored hash of each of its values, in the background.
+type StateScrubQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+}
+
+func (x *StateScrubQuery) Reset() {
+	*x = StateScrubQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateScrubQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateScrubQuery) ProtoMessage() {}
+
+func (x *StateScrubQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateScrubQuery.ProtoReflect.Descriptor instead.
+func (*StateScrubQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *StateScrubQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StateScrubQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+type StateScrubQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *StateScrubQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *StateScrubQueryEnvelope) Reset() {
+	*x = StateScrubQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateScrubQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateScrubQueryEnvelope) ProtoMessage() {}
+
+func (x *StateScrubQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateScrubQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*StateScrubQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *StateScrubQueryEnvelope) GetPayload() *StateScrubQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *StateScrubQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// GetDroppedTxsQuery lists the dead-letter records of the node in the order of the drops, starting with the
+// transactions dropped at or after since, in nanoseconds since the Unix epoch, and returning at most limit records.
+type GetDroppedTxsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Since  int64  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor resumes the listing from the next_cursor of the previous page of the same query.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetDroppedTxsQuery) Reset() {
+	*x = GetDroppedTxsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxsQuery) ProtoMessage() {}
+
+func (x *GetDroppedTxsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxsQuery.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxsQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetDroppedTxsQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDroppedTxsQuery) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetDroppedTxsQuery) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDroppedTxsQuery) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetDroppedTxsQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDroppedTxsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDroppedTxsQueryEnvelope) Reset() {
+	*x = GetDroppedTxsQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxsQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxsQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDroppedTxsQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxsQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetDroppedTxsQueryEnvelope) GetPayload() *GetDroppedTxsQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDroppedTxsQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// GetAdminAuditRecordsQuery returns the admin audit record whose hash is given, if set, and otherwise lists the admin
+// audit records of the node in the order of the chain, starting with the record numbered since, and returning at most
+// limit records.
+type GetAdminAuditRecordsQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Since  uint64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Limit  uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor resumes the listing from the next_cursor of the previous page of the same query.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetAdminAuditRecordsQuery) Reset() {
+	*x = GetAdminAuditRecordsQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminAuditRecordsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminAuditRecordsQuery) ProtoMessage() {}
+
+func (x *GetAdminAuditRecordsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminAuditRecordsQuery.ProtoReflect.Descriptor instead.
+func (*GetAdminAuditRecordsQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetAdminAuditRecordsQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAdminAuditRecordsQuery) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *GetAdminAuditRecordsQuery) GetSince() uint64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetAdminAuditRecordsQuery) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAdminAuditRecordsQuery) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetAdminAuditRecordsQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetAdminAuditRecordsQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetAdminAuditRecordsQueryEnvelope) Reset() {
+	*x = GetAdminAuditRecordsQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminAuditRecordsQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminAuditRecordsQueryEnvelope) ProtoMessage() {}
+
+func (x *GetAdminAuditRecordsQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminAuditRecordsQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetAdminAuditRecordsQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetAdminAuditRecordsQueryEnvelope) GetPayload() *GetAdminAuditRecordsQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetAdminAuditRecordsQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetBlockCompositionQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *GetBlockCompositionQuery) Reset() {
+	*x = GetBlockCompositionQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockCompositionQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockCompositionQuery) ProtoMessage() {}
+
+func (x *GetBlockCompositionQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockCompositionQuery.ProtoReflect.Descriptor instead.
+func (*GetBlockCompositionQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetBlockCompositionQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBlockCompositionQuery) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type GetBlockCompositionQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetBlockCompositionQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetBlockCompositionQueryEnvelope) Reset() {
+	*x = GetBlockCompositionQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockCompositionQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockCompositionQueryEnvelope) ProtoMessage() {}
+
+func (x *GetBlockCompositionQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockCompositionQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetBlockCompositionQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetBlockCompositionQueryEnvelope) GetPayload() *GetBlockCompositionQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetBlockCompositionQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type SubscribeKeysQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName   string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Keys     []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	Prefixes []string `protobuf:"bytes,4,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *SubscribeKeysQuery) Reset() {
+	*x = SubscribeKeysQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeKeysQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeKeysQuery) ProtoMessage() {}
+
+func (x *SubscribeKeysQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeKeysQuery.ProtoReflect.Descriptor instead.
+func (*SubscribeKeysQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SubscribeKeysQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubscribeKeysQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *SubscribeKeysQuery) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *SubscribeKeysQuery) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type SubscribeKeysQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *SubscribeKeysQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SubscribeKeysQueryEnvelope) Reset() {
+	*x = SubscribeKeysQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeKeysQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeKeysQueryEnvelope) ProtoMessage() {}
+
+func (x *SubscribeKeysQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeKeysQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*SubscribeKeysQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SubscribeKeysQueryEnvelope) GetPayload() *SubscribeKeysQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SubscribeKeysQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetDataMultiQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Keys   []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *GetDataMultiQuery) Reset() {
+	*x = GetDataMultiQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataMultiQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataMultiQuery) ProtoMessage() {}
+
+func (x *GetDataMultiQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataMultiQuery.ProtoReflect.Descriptor instead.
+func (*GetDataMultiQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetDataMultiQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDataMultiQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetDataMultiQuery) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type GetDataMultiQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDataMultiQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataMultiQueryEnvelope) Reset() {
+	*x = GetDataMultiQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataMultiQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataMultiQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDataMultiQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataMultiQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataMultiQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetDataMultiQueryEnvelope) GetPayload() *GetDataMultiQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDataMultiQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// IssueAccessTokenQuery asks the node for an access token, which authenticates the queries of the user in place of a
+// signature of each query. The token is limited to the listed endpoints, given by their route templates, e.g.,
+// "/data/{dbname:[0-9a-zA-Z_\-\.]+}/{key}", and, for the endpoints that name a database, to the listed databases.
+type IssueAccessTokenQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbNames   []string `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	Endpoints []string `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// The lifetime of the token, in seconds. Zero means the maximal lifetime the node allows.
+	LifetimeSeconds uint64 `protobuf:"varint,4,opt,name=lifetime_seconds,json=lifetimeSeconds,proto3" json:"lifetime_seconds,omitempty"`
+}
+
+func (x *IssueAccessTokenQuery) Reset() {
+	*x = IssueAccessTokenQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAccessTokenQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAccessTokenQuery) ProtoMessage() {}
+
+func (x *IssueAccessTokenQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAccessTokenQuery.ProtoReflect.Descriptor instead.
+func (*IssueAccessTokenQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *IssueAccessTokenQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IssueAccessTokenQuery) GetDbNames() []string {
+	if x != nil {
+		return x.DbNames
+	}
+	return nil
+}
+
+func (x *IssueAccessTokenQuery) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *IssueAccessTokenQuery) GetLifetimeSeconds() uint64 {
+	if x != nil {
+		return x.LifetimeSeconds
+	}
+	return 0
+}
+
+type IssueAccessTokenQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *IssueAccessTokenQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *IssueAccessTokenQueryEnvelope) Reset() {
+	*x = IssueAccessTokenQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAccessTokenQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAccessTokenQueryEnvelope) ProtoMessage() {}
+
+func (x *IssueAccessTokenQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAccessTokenQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*IssueAccessTokenQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *IssueAccessTokenQueryEnvelope) GetPayload() *IssueAccessTokenQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *IssueAccessTokenQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// RevokeAccessTokenQuery revokes an access token before it expires, on behalf of the user it was issued to, or of an
+// admin.
+type RevokeAccessTokenQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token  string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *RevokeAccessTokenQuery) Reset() {
+	*x = RevokeAccessTokenQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessTokenQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenQuery) ProtoMessage() {}
+
+func (x *RevokeAccessTokenQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenQuery.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RevokeAccessTokenQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeAccessTokenQuery) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeAccessTokenQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *RevokeAccessTokenQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *RevokeAccessTokenQueryEnvelope) Reset() {
+	*x = RevokeAccessTokenQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessTokenQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenQueryEnvelope) ProtoMessage() {}
+
+func (x *RevokeAccessTokenQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RevokeAccessTokenQueryEnvelope) GetPayload() *RevokeAccessTokenQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *RevokeAccessTokenQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// GetKeyBlocksQuery returns the blocks from start_block_number to end_block_number, both included, which may have
+// modified the key of the database, as found by the key filters of the blocks alone.
+type GetKeyBlocksQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName           string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key              string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	StartBlockNumber uint64 `protobuf:"varint,4,opt,name=start_block_number,json=startBlockNumber,proto3" json:"start_block_number,omitempty"`
+	EndBlockNumber   uint64 `protobuf:"varint,5,opt,name=end_block_number,json=endBlockNumber,proto3" json:"end_block_number,omitempty"`
+}
+
+func (x *GetKeyBlocksQuery) Reset() {
+	*x = GetKeyBlocksQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKeyBlocksQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyBlocksQuery) ProtoMessage() {}
+
+func (x *GetKeyBlocksQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyBlocksQuery.ProtoReflect.Descriptor instead.
+func (*GetKeyBlocksQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetKeyBlocksQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetKeyBlocksQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetKeyBlocksQuery) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetKeyBlocksQuery) GetStartBlockNumber() uint64 {
+	if x != nil {
+		return x.StartBlockNumber
+	}
+	return 0
+}
+
+func (x *GetKeyBlocksQuery) GetEndBlockNumber() uint64 {
+	if x != nil {
+		return x.EndBlockNumber
+	}
+	return 0
+}
+
+type GetKeyBlocksQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetKeyBlocksQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetKeyBlocksQueryEnvelope) Reset() {
+	*x = GetKeyBlocksQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKeyBlocksQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyBlocksQueryEnvelope) ProtoMessage() {}
+
+func (x *GetKeyBlocksQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyBlocksQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetKeyBlocksQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetKeyBlocksQueryEnvelope) GetPayload() *GetKeyBlocksQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetKeyBlocksQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// GetDBExportQuery exports a database as a stream of DBExportRecord, from a snapshot of the state. Only an admin can
+// export a database.
+type GetDBExportQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// provenance adds the historical values of each key to the export.
+	Provenance bool `protobuf:"varint,3,opt,name=provenance,proto3" json:"provenance,omitempty"`
+}
+
+func (x *GetDBExportQuery) Reset() {
+	*x = GetDBExportQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDBExportQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDBExportQuery) ProtoMessage() {}
+
+func (x *GetDBExportQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDBExportQuery.ProtoReflect.Descriptor instead.
+func (*GetDBExportQuery) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetDBExportQuery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDBExportQuery) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetDBExportQuery) GetProvenance() bool {
+	if x != nil {
+		return x.Provenance
+	}
+	return false
+}
+
+type GetDBExportQueryEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload   *GetDBExportQuery `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte            `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDBExportQueryEnvelope) Reset() {
+	*x = GetDBExportQueryEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDBExportQueryEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDBExportQueryEnvelope) ProtoMessage() {}
+
+func (x *GetDBExportQueryEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDBExportQueryEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDBExportQueryEnvelope) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetDBExportQueryEnvelope) GetPayload() *GetDBExportQuery {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *GetDBExportQueryEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_query_proto protoreflect.FileDescriptor
+
+var file_query_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x1a, 0x1b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x44,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x30, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x73, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x42, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44,
+	0x42, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x42, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x42, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x81,
+	0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x4f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x67, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x13, 0x0a, 0x05,
+	0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x61, 0x73, 0x4f,
+	0x66, 0x22, 0xde, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x2f, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1a, 0x47, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2f, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x75,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x58, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6e, 0x6f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x34, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x26, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x39, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x65, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2c,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x69,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x6e, 0x6c, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x77, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x37, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x75,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x71, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x71,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64,
+	0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x56, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x56, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x75,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44,
+	0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x7b, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x41, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64,
+	0x22, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x48, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x78, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x54, 0x78, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x22, 0x75, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x71, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcb, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3c,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x1a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x22, 0x85, 0x01, 0x0a, 0x0d,
+	0x44, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x4b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0x2f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x73, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x52, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x1c, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x53, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x65, 0x65, 0x72, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x65, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x42, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x65,
+	0x0a, 0x15, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x42, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x42, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x51, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x7f, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3b,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6d,
+	0x0a, 0x19, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x31, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x77, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x44, 0x0a, 0x13, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x62, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x22,
+	0x71, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x72, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x6f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72,
+	0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x72, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x43, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x75, 0x62,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x72, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x72, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x54, 0x78, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x6f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x54, 0x78, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x7d, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x56, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7b, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x76, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x22, 0x6f, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4b, 0x65,
+	0x79, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x6d, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x94, 0x01, 0x0a,
+	0x15, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x75, 0x0a, 0x1d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x47, 0x0a, 0x16, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x77, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xaf, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x6d,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x64, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x44, 0x42, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x42, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x31, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x6f, 0x72, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_query_proto_rawDescOnce sync.Once
+	file_query_proto_rawDescData = file_query_proto_rawDesc
+)
+
+func file_query_proto_rawDescGZIP() []byte {
+	file_query_proto_rawDescOnce.Do(func() {
+		file_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_query_proto_rawDescData)
+	})
+	return file_query_proto_rawDescData
+}
+
+var file_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 110)
+var file_query_proto_goTypes = []interface{}{
+	(GetMostRecentUserOrNodeQuery_Type)(0),         // 0: types.GetMostRecentUserOrNodeQuery.Type
+	(*GetDBStatusQueryEnvelope)(nil),               // 1: types.GetDBStatusQueryEnvelope
+	(*GetDBStatusQuery)(nil),                       // 2: types.GetDBStatusQuery
+	(*GetDBIndexQueryEnvelope)(nil),                // 3: types.GetDBIndexQueryEnvelope
+	(*GetDBIndexQuery)(nil),                        // 4: types.GetDBIndexQuery
+	(*GetDBDescriptorQueryEnvelope)(nil),           // 5: types.GetDBDescriptorQueryEnvelope
+	(*GetDBDescriptorQuery)(nil),                   // 6: types.GetDBDescriptorQuery
+	(*GetDBDigestQueryEnvelope)(nil),               // 7: types.GetDBDigestQueryEnvelope
+	(*GetDBDigestQuery)(nil),                       // 8: types.GetDBDigestQuery
+	(*GetDBDescriptorHistoryQueryEnvelope)(nil),    // 9: types.GetDBDescriptorHistoryQueryEnvelope
+	(*GetDBDescriptorHistoryQuery)(nil),            // 10: types.GetDBDescriptorHistoryQuery
+	(*GetDataQueryEnvelope)(nil),                   // 11: types.GetDataQueryEnvelope
+	(*GetDataQuery)(nil),                           // 12: types.GetDataQuery
+	(*GetDataRangeQuery)(nil),                      // 13: types.GetDataRangeQuery
+	(*GetUserQueryEnvelope)(nil),                   // 14: types.GetUserQueryEnvelope
+	(*GetUserQuery)(nil),                           // 15: types.GetUserQuery
+	(*GetConfigQueryEnvelope)(nil),                 // 16: types.GetConfigQueryEnvelope
+	(*GetConfigQuery)(nil),                         // 17: types.GetConfigQuery
+	(*GetNodeConfigQueryEnvelope)(nil),             // 18: types.GetNodeConfigQueryEnvelope
+	(*GetNodeConfigQuery)(nil),                     // 19: types.GetNodeConfigQuery
+	(*GeConfigBlockQueryEnvelope)(nil),             // 20: types.GeConfigBlockQueryEnvelope
+	(*GetConfigBlockQuery)(nil),                    // 21: types.GetConfigBlockQuery
+	(*GetConfigLimitsQueryEnvelope)(nil),           // 22: types.GetConfigLimitsQueryEnvelope
+	(*GetConfigLimitsQuery)(nil),                   // 23: types.GetConfigLimitsQuery
+	(*GetClusterStatusQueryEnvelope)(nil),          // 24: types.GetClusterStatusQueryEnvelope
+	(*GetClusterStatusQuery)(nil),                  // 25: types.GetClusterStatusQuery
+	(*GetClusterHeartbeatsQuery)(nil),              // 26: types.GetClusterHeartbeatsQuery
+	(*GetSessionBootstrapQueryEnvelope)(nil),       // 27: types.GetSessionBootstrapQueryEnvelope
+	(*GetSessionBootstrapQuery)(nil),               // 28: types.GetSessionBootstrapQuery
+	(*SubscribePrivilegeChangesQueryEnvelope)(nil), // 29: types.SubscribePrivilegeChangesQueryEnvelope
+	(*SubscribePrivilegeChangesQuery)(nil),         // 30: types.SubscribePrivilegeChangesQuery
+	(*GetBlockQuery)(nil),                          // 31: types.GetBlockQuery
+	(*GetBlockQueryEnvelope)(nil),                  // 32: types.GetBlockQueryEnvelope
+	(*GetLastBlockQuery)(nil),                      // 33: types.GetLastBlockQuery
+	(*GetLastBlockQueryEnvelope)(nil),              // 34: types.GetLastBlockQueryEnvelope
+	(*GetLedgerPathQuery)(nil),                     // 35: types.GetLedgerPathQuery
+	(*GetLedgerPathQueryEnvelope)(nil),             // 36: types.GetLedgerPathQueryEnvelope
+	(*GetTxProofQuery)(nil),                        // 37: types.GetTxProofQuery
+	(*GetTxProofQueryEnvelope)(nil),                // 38: types.GetTxProofQueryEnvelope
+	(*GetDataProofQuery)(nil),                      // 39: types.GetDataProofQuery
+	(*GetDataProofQueryEnvelope)(nil),              // 40: types.GetDataProofQueryEnvelope
+	(*GetHistoricalDataQuery)(nil),                 // 41: types.GetHistoricalDataQuery
+	(*GetHistoricalDataQueryEnvelope)(nil),         // 42: types.GetHistoricalDataQueryEnvelope
+	(*GetDataByVersionQuery)(nil),                  // 43: types.GetDataByVersionQuery
+	(*GetDataByVersionQueryEnvelope)(nil),          // 44: types.GetDataByVersionQueryEnvelope
+	(*GetDataReadersQuery)(nil),                    // 45: types.GetDataReadersQuery
+	(*GetDataReadersQueryEnvelope)(nil),            // 46: types.GetDataReadersQueryEnvelope
+	(*GetDataWritersQuery)(nil),                    // 47: types.GetDataWritersQuery
+	(*GetDataWritersQueryEnvelope)(nil),            // 48: types.GetDataWritersQueryEnvelope
+	(*GetDataReadByQuery)(nil),                     // 49: types.GetDataReadByQuery
+	(*GetDataReadByQueryEnvelope)(nil),             // 50: types.GetDataReadByQueryEnvelope
+	(*GetDataWrittenByQuery)(nil),                  // 51: types.GetDataWrittenByQuery
+	(*GetDataDeletedByQuery)(nil),                  // 52: types.GetDataDeletedByQuery
+	(*GetDataDeletedByQueryEnvelope)(nil),          // 53: types.GetDataDeletedByQueryEnvelope
+	(*GetDataWrittenByQueryEnvelope)(nil),          // 54: types.GetDataWrittenByQueryEnvelope
+	(*GetTxIDsSubmittedByQuery)(nil),               // 55: types.GetTxIDsSubmittedByQuery
+	(*GetTxIDsSubmittedByQueryEnvelope)(nil),       // 56: types.GetTxIDsSubmittedByQueryEnvelope
+	(*GetTxReceiptQuery)(nil),                      // 57: types.GetTxReceiptQuery
+	(*GetTxReceiptQueryEnvelope)(nil),              // 58: types.GetTxReceiptQueryEnvelope
+	(*GetTxWriteSetDigestQuery)(nil),               // 59: types.GetTxWriteSetDigestQuery
+	(*GetTxWriteSetDigestQueryEnvelope)(nil),       // 60: types.GetTxWriteSetDigestQueryEnvelope
+	(*GetDroppedTxQuery)(nil),                      // 61: types.GetDroppedTxQuery
+	(*GetDroppedTxQueryEnvelope)(nil),              // 62: types.GetDroppedTxQueryEnvelope
+	(*GetLedgerRollupsQuery)(nil),                  // 63: types.GetLedgerRollupsQuery
+	(*GetLedgerRollupsQueryEnvelope)(nil),          // 64: types.GetLedgerRollupsQueryEnvelope
+	(*GetLedgerUsageQuery)(nil),                    // 65: types.GetLedgerUsageQuery
+	(*GetLedgerUsageQueryEnvelope)(nil),            // 66: types.GetLedgerUsageQueryEnvelope
+	(*GetMostRecentUserOrNodeQuery)(nil),           // 67: types.GetMostRecentUserOrNodeQuery
+	(*DataJSONQuery)(nil),                          // 68: types.DataJSONQuery
+	(*GetDataCountQuery)(nil),                      // 69: types.GetDataCountQuery
+	(*GetStorageStatsQuery)(nil),                   // 70: types.GetStorageStatsQuery
+	(*GetStorageStatsQueryEnvelope)(nil),           // 71: types.GetStorageStatsQueryEnvelope
+	(*TraceValidationQuery)(nil),                   // 72: types.TraceValidationQuery
+	(*TraceValidationQueryEnvelope)(nil),           // 73: types.TraceValidationQueryEnvelope
+	(*AcceptPeerHeaderQuery)(nil),                  // 74: types.AcceptPeerHeaderQuery
+	(*AcceptPeerHeaderQueryEnvelope)(nil),          // 75: types.AcceptPeerHeaderQueryEnvelope
+	(*ResyncDBQuery)(nil),                          // 76: types.ResyncDBQuery
+	(*ResyncDBQueryEnvelope)(nil),                  // 77: types.ResyncDBQueryEnvelope
+	(*GetTrustedCheckpointsQuery)(nil),             // 78: types.GetTrustedCheckpointsQuery
+	(*GetTrustedCheckpointsQueryEnvelope)(nil),     // 79: types.GetTrustedCheckpointsQueryEnvelope
+	(*GetLogLevelsQuery)(nil),                      // 80: types.GetLogLevelsQuery
+	(*GetLogLevelsQueryEnvelope)(nil),              // 81: types.GetLogLevelsQueryEnvelope
+	(*SetLogLevelsQuery)(nil),                      // 82: types.SetLogLevelsQuery
+	(*SetLogLevelsQueryEnvelope)(nil),              // 83: types.SetLogLevelsQueryEnvelope
+	(*GetStateMigrationQuery)(nil),                 // 84: types.GetStateMigrationQuery
+	(*GetStateMigrationQueryEnvelope)(nil),         // 85: types.GetStateMigrationQueryEnvelope
+	(*StateMigrationQuery)(nil),                    // 86: types.StateMigrationQuery
+	(*StateMigrationQueryEnvelope)(nil),            // 87: types.StateMigrationQueryEnvelope
+	(*GetStateScrubQuery)(nil),                     // 88: types.GetStateScrubQuery
+	(*GetStateScrubQueryEnvelope)(nil),             // 89: types.GetStateScrubQueryEnvelope
+	(*StateScrubQuery)(nil),                        // 90: types.StateScrubQuery
+	(*StateScrubQueryEnvelope)(nil),                // 91: types.StateScrubQueryEnvelope
+	(*GetDroppedTxsQuery)(nil),                     // 92: types.GetDroppedTxsQuery
+	(*GetDroppedTxsQueryEnvelope)(nil),             // 93: types.GetDroppedTxsQueryEnvelope
+	(*GetAdminAuditRecordsQuery)(nil),              // 94: types.GetAdminAuditRecordsQuery
+	(*GetAdminAuditRecordsQueryEnvelope)(nil),      // 95: types.GetAdminAuditRecordsQueryEnvelope
+	(*GetBlockCompositionQuery)(nil),               // 96: types.GetBlockCompositionQuery
+	(*GetBlockCompositionQueryEnvelope)(nil),       // 97: types.GetBlockCompositionQueryEnvelope
+	(*SubscribeKeysQuery)(nil),                     // 98: types.SubscribeKeysQuery
+	(*SubscribeKeysQueryEnvelope)(nil),             // 99: types.SubscribeKeysQueryEnvelope
+	(*GetDataMultiQuery)(nil),                      // 100: types.GetDataMultiQuery
+	(*GetDataMultiQueryEnvelope)(nil),              // 101: types.GetDataMultiQueryEnvelope
+	(*IssueAccessTokenQuery)(nil),                  // 102: types.IssueAccessTokenQuery
+	(*IssueAccessTokenQueryEnvelope)(nil),          // 103: types.IssueAccessTokenQueryEnvelope
+	(*RevokeAccessTokenQuery)(nil),                 // 104: types.RevokeAccessTokenQuery
+	(*RevokeAccessTokenQueryEnvelope)(nil),         // 105: types.RevokeAccessTokenQueryEnvelope
+	(*GetKeyBlocksQuery)(nil),                      // 106: types.GetKeyBlocksQuery
+	(*GetKeyBlocksQueryEnvelope)(nil),              // 107: types.GetKeyBlocksQueryEnvelope
+	(*GetDBExportQuery)(nil),                       // 108: types.GetDBExportQuery
+	(*GetDBExportQueryEnvelope)(nil),               // 109: types.GetDBExportQueryEnvelope
+	nil,                                            // 110: types.SetLogLevelsQuery.LevelsEntry
+	(*Version)(nil),                                // 111: types.Version
+}
+var file_query_proto_depIdxs = []int32{
+	2,   // 0: types.GetDBStatusQueryEnvelope.payload:type_name -> types.GetDBStatusQuery
+	4,   // 1: types.GetDBIndexQueryEnvelope.payload:type_name -> types.GetDBIndexQuery
+	6,   // 2: types.GetDBDescriptorQueryEnvelope.payload:type_name -> types.GetDBDescriptorQuery
+	8,   // 3: types.GetDBDigestQueryEnvelope.payload:type_name -> types.GetDBDigestQuery
+	10,  // 4: types.GetDBDescriptorHistoryQueryEnvelope.payload:type_name -> types.GetDBDescriptorHistoryQuery
+	12,  // 5: types.GetDataQueryEnvelope.payload:type_name -> types.GetDataQuery
+	15,  // 6: types.GetUserQueryEnvelope.payload:type_name -> types.GetUserQuery
+	17,  // 7: types.GetConfigQueryEnvelope.payload:type_name -> types.GetConfigQuery
+	19,  // 8: types.GetNodeConfigQueryEnvelope.payload:type_name -> types.GetNodeConfigQuery
+	21,  // 9: types.GeConfigBlockQueryEnvelope.payload:type_name -> types.GetConfigBlockQuery
+	23,  // 10: types.GetConfigLimitsQueryEnvelope.payload:type_name -> types.GetConfigLimitsQuery
+	25,  // 11: types.GetClusterStatusQueryEnvelope.payload:type_name -> types.GetClusterStatusQuery
+	28,  // 12: types.GetSessionBootstrapQueryEnvelope.payload:type_name -> types.GetSessionBootstrapQuery
+	30,  // 13: types.SubscribePrivilegeChangesQueryEnvelope.payload:type_name -> types.SubscribePrivilegeChangesQuery
+	31,  // 14: types.GetBlockQueryEnvelope.payload:type_name -> types.GetBlockQuery
+	33,  // 15: types.GetLastBlockQueryEnvelope.payload:type_name -> types.GetLastBlockQuery
+	35,  // 16: types.GetLedgerPathQueryEnvelope.payload:type_name -> types.GetLedgerPathQuery
+	37,  // 17: types.GetTxProofQueryEnvelope.payload:type_name -> types.GetTxProofQuery
+	39,  // 18: types.GetDataProofQueryEnvelope.payload:type_name -> types.GetDataProofQuery
+	111, // 19: types.GetHistoricalDataQuery.version:type_name -> types.Version
+	41,  // 20: types.GetHistoricalDataQueryEnvelope.payload:type_name -> types.GetHistoricalDataQuery
+	111, // 21: types.GetDataByVersionQuery.version:type_name -> types.Version
+	43,  // 22: types.GetDataByVersionQueryEnvelope.payload:type_name -> types.GetDataByVersionQuery
+	45,  // 23: types.GetDataReadersQueryEnvelope.payload:type_name -> types.GetDataReadersQuery
+	47,  // 24: types.GetDataWritersQueryEnvelope.payload:type_name -> types.GetDataWritersQuery
+	49,  // 25: types.GetDataReadByQueryEnvelope.payload:type_name -> types.GetDataReadByQuery
+	52,  // 26: types.GetDataDeletedByQueryEnvelope.payload:type_name -> types.GetDataDeletedByQuery
+	51,  // 27: types.GetDataWrittenByQueryEnvelope.payload:type_name -> types.GetDataWrittenByQuery
+	55,  // 28: types.GetTxIDsSubmittedByQueryEnvelope.payload:type_name -> types.GetTxIDsSubmittedByQuery
+	57,  // 29: types.GetTxReceiptQueryEnvelope.payload:type_name -> types.GetTxReceiptQuery
+	59,  // 30: types.GetTxWriteSetDigestQueryEnvelope.payload:type_name -> types.GetTxWriteSetDigestQuery
+	61,  // 31: types.GetDroppedTxQueryEnvelope.payload:type_name -> types.GetDroppedTxQuery
+	63,  // 32: types.GetLedgerRollupsQueryEnvelope.payload:type_name -> types.GetLedgerRollupsQuery
+	65,  // 33: types.GetLedgerUsageQueryEnvelope.payload:type_name -> types.GetLedgerUsageQuery
+	0,   // 34: types.GetMostRecentUserOrNodeQuery.type:type_name -> types.GetMostRecentUserOrNodeQuery.Type
+	111, // 35: types.GetMostRecentUserOrNodeQuery.version:type_name -> types.Version
+	70,  // 36: types.GetStorageStatsQueryEnvelope.payload:type_name -> types.GetStorageStatsQuery
+	72,  // 37: types.TraceValidationQueryEnvelope.payload:type_name -> types.TraceValidationQuery
+	74,  // 38: types.AcceptPeerHeaderQueryEnvelope.payload:type_name -> types.AcceptPeerHeaderQuery
+	76,  // 39: types.ResyncDBQueryEnvelope.payload:type_name -> types.ResyncDBQuery
+	78,  // 40: types.GetTrustedCheckpointsQueryEnvelope.payload:type_name -> types.GetTrustedCheckpointsQuery
+	80,  // 41: types.GetLogLevelsQueryEnvelope.payload:type_name -> types.GetLogLevelsQuery
+	110, // 42: types.SetLogLevelsQuery.levels:type_name -> types.SetLogLevelsQuery.LevelsEntry
+	82,  // 43: types.SetLogLevelsQueryEnvelope.payload:type_name -> types.SetLogLevelsQuery
+	84,  // 44: types.GetStateMigrationQueryEnvelope.payload:type_name -> types.GetStateMigrationQuery
+	86,  // 45: types.StateMigrationQueryEnvelope.payload:type_name -> types.StateMigrationQuery
+	88,  // 46: types.GetStateScrubQueryEnvelope.payload:type_name -> types.GetStateScrubQuery
+	90,  // 47: types.StateScrubQueryEnvelope.payload:type_name -> types.StateScrubQuery
+	92,  // 48: types.GetDroppedTxsQueryEnvelope.payload:type_name -> types.GetDroppedTxsQuery
+	94,  // 49: types.GetAdminAuditRecordsQueryEnvelope.payload:type_name -> types.GetAdminAuditRecordsQuery
+	96,  // 50: types.GetBlockCompositionQueryEnvelope.payload:type_name -> types.GetBlockCompositionQuery
+	98,  // 51: types.SubscribeKeysQueryEnvelope.payload:type_name -> types.SubscribeKeysQuery
+	100, // 52: types.GetDataMultiQueryEnvelope.payload:type_name -> types.GetDataMultiQuery
+	102, // 53: types.IssueAccessTokenQueryEnvelope.payload:type_name -> types.IssueAccessTokenQuery
+	104, // 54: types.RevokeAccessTokenQueryEnvelope.payload:type_name -> types.RevokeAccessTokenQuery
+	106, // 55: types.GetKeyBlocksQueryEnvelope.payload:type_name -> types.GetKeyBlocksQuery
+	108, // 56: types.GetDBExportQueryEnvelope.payload:type_name -> types.GetDBExportQuery
+	57,  // [57:57] is the sub-list for method output_type
+	57,  // [57:57] is the sub-list for method input_type
+	57,  // [57:57] is the sub-list for extension type_name
+	57,  // [57:57] is the sub-list for extension extendee
+	0,   // [0:57] is the sub-list for field type_name
+}
+
+func init() { file_query_proto_init() }
+func file_query_proto_init() {
+	if File_query_proto != nil {
+		return
+	}
+	file_block_and_transaction_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBStatusQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBStatusQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBIndexQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBIndexQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDigestQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDigestQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorHistoryQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorHistoryQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataRangeQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeConfigQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeConfigQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeConfigBlockQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigBlockQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigLimitsQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigLimitsQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterStatusQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterStatusQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterHeartbeatsQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionBootstrapQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionBootstrapQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePrivilegeChangesQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePrivilegeChangesQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastBlockQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastBlockQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerPathQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerPathQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxProofQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxProofQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataProofQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataProofQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoricalDataQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoricalDataQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataByVersionQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataByVersionQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataReadersQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataReadersQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataWritersQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataWritersQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataReadByQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataReadByQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataWrittenByQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataDeletedByQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataDeletedByQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataWrittenByQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxIDsSubmittedByQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxIDsSubmittedByQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxReceiptQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxReceiptQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxWriteSetDigestQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxWriteSetDigestQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerRollupsQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerRollupsQueryEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerUsageQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3113,8 +8185,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBStatusQuery); i {
+		file_query_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerUsageQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3125,8 +8197,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBIndexQueryEnvelope); i {
+		file_query_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMostRecentUserOrNodeQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3137,8 +8209,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBIndexQuery); i {
+		file_query_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataJSONQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3149,8 +8221,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataQueryEnvelope); i {
+		file_query_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataCountQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3161,8 +8233,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataQuery); i {
+		file_query_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageStatsQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3173,8 +8245,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataRangeQuery); i {
+		file_query_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageStatsQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3185,8 +8257,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserQueryEnvelope); i {
+		file_query_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceValidationQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3197,8 +8269,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserQuery); i {
+		file_query_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceValidationQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3209,8 +8281,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigQueryEnvelope); i {
+		file_query_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPeerHeaderQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3221,8 +8293,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigQuery); i {
+		file_query_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPeerHeaderQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3233,8 +8305,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeConfigQueryEnvelope); i {
+		file_query_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncDBQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3245,8 +8317,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeConfigQuery); i {
+		file_query_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncDBQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3257,8 +8329,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeConfigBlockQueryEnvelope); i {
+		file_query_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrustedCheckpointsQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3269,8 +8341,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigBlockQuery); i {
+		file_query_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrustedCheckpointsQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3281,8 +8353,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterStatusQueryEnvelope); i {
+		file_query_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogLevelsQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3293,8 +8365,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterStatusQuery); i {
+		file_query_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogLevelsQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3305,8 +8377,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockQuery); i {
+		file_query_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelsQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3317,8 +8389,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockQueryEnvelope); i {
+		file_query_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelsQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3329,8 +8401,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastBlockQuery); i {
+		file_query_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateMigrationQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3341,8 +8413,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastBlockQueryEnvelope); i {
+		file_query_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateMigrationQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3353,8 +8425,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLedgerPathQuery); i {
+		file_query_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateMigrationQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3365,8 +8437,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLedgerPathQueryEnvelope); i {
+		file_query_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateMigrationQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3377,8 +8449,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxProofQuery); i {
+		file_query_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateScrubQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3389,8 +8461,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxProofQueryEnvelope); i {
+		file_query_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateScrubQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3401,8 +8473,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataProofQuery); i {
+		file_query_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateScrubQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3413,8 +8485,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataProofQueryEnvelope); i {
+		file_query_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateScrubQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3425,8 +8497,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHistoricalDataQuery); i {
+		file_query_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxsQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3437,8 +8509,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHistoricalDataQueryEnvelope); i {
+		file_query_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxsQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3449,8 +8521,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataReadersQuery); i {
+		file_query_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminAuditRecordsQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3461,8 +8533,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataReadersQueryEnvelope); i {
+		file_query_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminAuditRecordsQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3473,8 +8545,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataWritersQuery); i {
+		file_query_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockCompositionQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3485,8 +8557,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataWritersQueryEnvelope); i {
+		file_query_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockCompositionQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3497,8 +8569,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataReadByQuery); i {
+		file_query_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeKeysQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3509,8 +8581,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataReadByQueryEnvelope); i {
+		file_query_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeKeysQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3521,8 +8593,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataWrittenByQuery); i {
+		file_query_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataMultiQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3533,8 +8605,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataDeletedByQuery); i {
+		file_query_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataMultiQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3545,8 +8617,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataDeletedByQueryEnvelope); i {
+		file_query_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueAccessTokenQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3557,8 +8629,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataWrittenByQueryEnvelope); i {
+		file_query_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueAccessTokenQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3569,8 +8641,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxIDsSubmittedByQuery); i {
+		file_query_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessTokenQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3581,8 +8653,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxIDsSubmittedByQueryEnvelope); i {
+		file_query_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessTokenQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3593,8 +8665,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxReceiptQuery); i {
+		file_query_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKeyBlocksQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3605,8 +8677,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxReceiptQueryEnvelope); i {
+		file_query_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKeyBlocksQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3617,8 +8689,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMostRecentUserOrNodeQuery); i {
+		file_query_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBExportQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3629,8 +8701,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataJSONQuery); i {
+		file_query_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBExportQueryEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3648,7 +8720,7 @@ func file_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_query_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   45,
+			NumMessages:   110,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,6 +23,275 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type WarmUpStatus_State int32
+
+const (
+	WarmUpStatus_NONE      WarmUpStatus_State = 0
+	WarmUpStatus_RUNNING   WarmUpStatus_State = 1
+	WarmUpStatus_COMPLETED WarmUpStatus_State = 2
+	WarmUpStatus_ABORTED   WarmUpStatus_State = 3
+)
+
+// Enum value maps for WarmUpStatus_State.
+var (
+	WarmUpStatus_State_name = map[int32]string{
+		0: "NONE",
+		1: "RUNNING",
+		2: "COMPLETED",
+		3: "ABORTED",
+	}
+	WarmUpStatus_State_value = map[string]int32{
+		"NONE":      0,
+		"RUNNING":   1,
+		"COMPLETED": 2,
+		"ABORTED":   3,
+	}
+)
+
+func (x WarmUpStatus_State) Enum() *WarmUpStatus_State {
+	p := new(WarmUpStatus_State)
+	*p = x
+	return p
+}
+
+func (x WarmUpStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WarmUpStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_response_proto_enumTypes[0].Descriptor()
+}
+
+func (WarmUpStatus_State) Type() protoreflect.EnumType {
+	return &file_response_proto_enumTypes[0]
+}
+
+func (x WarmUpStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WarmUpStatus_State.Descriptor instead.
+func (WarmUpStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type StateMigrationStatus_State int32
+
+const (
+	StateMigrationStatus_NONE      StateMigrationStatus_State = 0
+	StateMigrationStatus_RUNNING   StateMigrationStatus_State = 1
+	StateMigrationStatus_ABORTED   StateMigrationStatus_State = 2
+	StateMigrationStatus_COMPLETED StateMigrationStatus_State = 3
+	StateMigrationStatus_FAILED    StateMigrationStatus_State = 4
+)
+
+// Enum value maps for StateMigrationStatus_State.
+var (
+	StateMigrationStatus_State_name = map[int32]string{
+		0: "NONE",
+		1: "RUNNING",
+		2: "ABORTED",
+		3: "COMPLETED",
+		4: "FAILED",
+	}
+	StateMigrationStatus_State_value = map[string]int32{
+		"NONE":      0,
+		"RUNNING":   1,
+		"ABORTED":   2,
+		"COMPLETED": 3,
+		"FAILED":    4,
+	}
+)
+
+func (x StateMigrationStatus_State) Enum() *StateMigrationStatus_State {
+	p := new(StateMigrationStatus_State)
+	*p = x
+	return p
+}
+
+func (x StateMigrationStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateMigrationStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_response_proto_enumTypes[1].Descriptor()
+}
+
+func (StateMigrationStatus_State) Type() protoreflect.EnumType {
+	return &file_response_proto_enumTypes[1]
+}
+
+func (x StateMigrationStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StateMigrationStatus_State.Descriptor instead.
+func (StateMigrationStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{99, 0}
+}
+
+type StateScrubStatus_State int32
+
+const (
+	StateScrubStatus_NONE      StateScrubStatus_State = 0
+	StateScrubStatus_RUNNING   StateScrubStatus_State = 1
+	StateScrubStatus_COMPLETED StateScrubStatus_State = 2
+	StateScrubStatus_FAILED    StateScrubStatus_State = 3
+)
+
+// Enum value maps for StateScrubStatus_State.
+var (
+	StateScrubStatus_State_name = map[int32]string{
+		0: "NONE",
+		1: "RUNNING",
+		2: "COMPLETED",
+		3: "FAILED",
+	}
+	StateScrubStatus_State_value = map[string]int32{
+		"NONE":      0,
+		"RUNNING":   1,
+		"COMPLETED": 2,
+		"FAILED":    3,
+	}
+)
+
+func (x StateScrubStatus_State) Enum() *StateScrubStatus_State {
+	p := new(StateScrubStatus_State)
+	*p = x
+	return p
+}
+
+func (x StateScrubStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateScrubStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_response_proto_enumTypes[2].Descriptor()
+}
+
+func (StateScrubStatus_State) Type() protoreflect.EnumType {
+	return &file_response_proto_enumTypes[2]
+}
+
+func (x StateScrubStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StateScrubStatus_State.Descriptor instead.
+func (StateScrubStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{102, 0}
+}
+
+type PrivilegeChange_Kind int32
+
+const (
+	PrivilegeChange_UNKNOWN PrivilegeChange_Kind = 0
+	// The record of the user was rewritten, e.g., its database permissions or its admin privilege were changed.
+	PrivilegeChange_USER_UPDATED PrivilegeChange_Kind = 1
+	// The user was deleted; the session is closed after the notification.
+	PrivilegeChange_USER_DELETED PrivilegeChange_Kind = 2
+	// The default access control of a database the user has a privilege on was changed.
+	PrivilegeChange_DB_DEFAULT_ACL_CHANGED PrivilegeChange_Kind = 3
+	// A database the user has a privilege on was deleted.
+	PrivilegeChange_DB_DELETED PrivilegeChange_Kind = 4
+)
+
+// Enum value maps for PrivilegeChange_Kind.
+var (
+	PrivilegeChange_Kind_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "USER_UPDATED",
+		2: "USER_DELETED",
+		3: "DB_DEFAULT_ACL_CHANGED",
+		4: "DB_DELETED",
+	}
+	PrivilegeChange_Kind_value = map[string]int32{
+		"UNKNOWN":                0,
+		"USER_UPDATED":           1,
+		"USER_DELETED":           2,
+		"DB_DEFAULT_ACL_CHANGED": 3,
+		"DB_DELETED":             4,
+	}
+)
+
+func (x PrivilegeChange_Kind) Enum() *PrivilegeChange_Kind {
+	p := new(PrivilegeChange_Kind)
+	*p = x
+	return p
+}
+
+func (x PrivilegeChange_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivilegeChange_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_response_proto_enumTypes[3].Descriptor()
+}
+
+func (PrivilegeChange_Kind) Type() protoreflect.EnumType {
+	return &file_response_proto_enumTypes[3]
+}
+
+func (x PrivilegeChange_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrivilegeChange_Kind.Descriptor instead.
+func (PrivilegeChange_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{111, 0}
+}
+
+type MultiGetEntry_Status int32
+
+const (
+	MultiGetEntry_FOUND     MultiGetEntry_Status = 0
+	MultiGetEntry_NOT_FOUND MultiGetEntry_Status = 1
+	// The access control of the key does not allow the querier to read it, hence, neither its value nor its metadata
+	// are returned.
+	MultiGetEntry_FORBIDDEN MultiGetEntry_Status = 2
+)
+
+// Enum value maps for MultiGetEntry_Status.
+var (
+	MultiGetEntry_Status_name = map[int32]string{
+		0: "FOUND",
+		1: "NOT_FOUND",
+		2: "FORBIDDEN",
+	}
+	MultiGetEntry_Status_value = map[string]int32{
+		"FOUND":     0,
+		"NOT_FOUND": 1,
+		"FORBIDDEN": 2,
+	}
+)
+
+func (x MultiGetEntry_Status) Enum() *MultiGetEntry_Status {
+	p := new(MultiGetEntry_Status)
+	*p = x
+	return p
+}
+
+func (x MultiGetEntry_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MultiGetEntry_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_response_proto_enumTypes[4].Descriptor()
+}
+
+func (MultiGetEntry_Status) Type() protoreflect.EnumType {
+	return &file_response_proto_enumTypes[4]
+}
+
+func (x MultiGetEntry_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MultiGetEntry_Status.Descriptor instead.
+func (MultiGetEntry_Status) EnumDescriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{114, 0}
+}
+
 type ResponseHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -76,8 +345,9 @@ type GetDBStatusResponseEnvelope struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDBStatusResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetDBStatusResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte               `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
 func (x *GetDBStatusResponseEnvelope) Reset() {
@@ -126,6 +396,13 @@ func (x *GetDBStatusResponseEnvelope) GetSignature() []byte {
 	return nil
 }
 
+func (x *GetDBStatusResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
 type GetDBStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -133,6 +410,8 @@ type GetDBStatusResponse struct {
 
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Exist  bool            `protobuf:"varint,2,opt,name=exist,proto3" json:"exist,omitempty"`
+	// The cap on the size of the values written to the database, or zero if the database has none.
+	MaxValueSizeBytes uint64 `protobuf:"varint,3,opt,name=max_value_size_bytes,json=maxValueSizeBytes,proto3" json:"max_value_size_bytes,omitempty"`
 }
 
 func (x *GetDBStatusResponse) Reset() {
@@ -181,13 +460,21 @@ func (x *GetDBStatusResponse) GetExist() bool {
 	return false
 }
 
+func (x *GetDBStatusResponse) GetMaxValueSizeBytes() uint64 {
+	if x != nil {
+		return x.MaxValueSizeBytes
+	}
+	return 0
+}
+
 type GetDBIndexResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDBIndexResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetDBIndexResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte              `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
 func (x *GetDBIndexResponseEnvelope) Reset() {
@@ -236,6 +523,13 @@ func (x *GetDBIndexResponseEnvelope) GetSignature() []byte {
 	return nil
 }
 
+func (x *GetDBIndexResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
 type GetDBIndexResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -292,17 +586,18 @@ func (x *GetDBIndexResponse) GetIndex() string {
 }
 
 // GetData
-type GetDataResponseEnvelope struct {
+type GetDBDescriptorResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDataResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetDBDescriptorResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                   `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetDataResponseEnvelope) Reset() {
-	*x = GetDataResponseEnvelope{}
+func (x *GetDBDescriptorResponseEnvelope) Reset() {
+	*x = GetDBDescriptorResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -310,13 +605,13 @@ func (x *GetDataResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataResponseEnvelope) String() string {
+func (x *GetDBDescriptorResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataResponseEnvelope) ProtoMessage() {}
+func (*GetDBDescriptorResponseEnvelope) ProtoMessage() {}
 
-func (x *GetDataResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,37 +623,46 @@ func (x *GetDataResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetDataResponseEnvelope) GetResponse() *GetDataResponse {
+func (x *GetDBDescriptorResponseEnvelope) GetResponse() *GetDBDescriptorResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *GetDataResponseEnvelope) GetSignature() []byte {
+func (x *GetDBDescriptorResponseEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetDataResponse struct {
+func (x *GetDBDescriptorResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetDBDescriptorResponse holds the descriptor of a database as of a block, and the version at which it was set. The
+// descriptor is nil if the database had no settings at that block.
+type GetDBDescriptorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header   *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Value    []byte          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Metadata *Metadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Header       *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DbDescriptor *DBDescriptor   `protobuf:"bytes,2,opt,name=db_descriptor,json=dbDescriptor,proto3" json:"db_descriptor,omitempty"`
+	Version      *Version        `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *GetDataResponse) Reset() {
-	*x = GetDataResponse{}
+func (x *GetDBDescriptorResponse) Reset() {
+	*x = GetDBDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -366,13 +670,13 @@ func (x *GetDataResponse) Reset() {
 	}
 }
 
-func (x *GetDataResponse) String() string {
+func (x *GetDBDescriptorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataResponse) ProtoMessage() {}
+func (*GetDBDescriptorResponse) ProtoMessage() {}
 
-func (x *GetDataResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -384,43 +688,44 @@ func (x *GetDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataResponse.ProtoReflect.Descriptor instead.
-func (*GetDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorResponse.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetDataResponse) GetHeader() *ResponseHeader {
+func (x *GetDBDescriptorResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *GetDataResponse) GetValue() []byte {
+func (x *GetDBDescriptorResponse) GetDbDescriptor() *DBDescriptor {
 	if x != nil {
-		return x.Value
+		return x.DbDescriptor
 	}
 	return nil
 }
 
-func (x *GetDataResponse) GetMetadata() *Metadata {
+func (x *GetDBDescriptorResponse) GetVersion() *Version {
 	if x != nil {
-		return x.Metadata
+		return x.Version
 	}
 	return nil
 }
 
-type GetDataRangeResponseEnvelope struct {
+type GetDBDigestResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDataRangeResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetDBDigestResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte               `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte               `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetDataRangeResponseEnvelope) Reset() {
-	*x = GetDataRangeResponseEnvelope{}
+func (x *GetDBDigestResponseEnvelope) Reset() {
+	*x = GetDBDigestResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,13 +733,13 @@ func (x *GetDataRangeResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataRangeResponseEnvelope) String() string {
+func (x *GetDBDigestResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataRangeResponseEnvelope) ProtoMessage() {}
+func (*GetDBDigestResponseEnvelope) ProtoMessage() {}
 
-func (x *GetDataRangeResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDBDigestResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -446,38 +751,49 @@ func (x *GetDataRangeResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataRangeResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataRangeResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDigestResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDBDigestResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetDataRangeResponseEnvelope) GetResponse() *GetDataRangeResponse {
+func (x *GetDBDigestResponseEnvelope) GetResponse() *GetDBDigestResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *GetDataRangeResponseEnvelope) GetSignature() []byte {
+func (x *GetDBDigestResponseEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetDataRangeResponse struct {
+func (x *GetDBDigestResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetDBDigestResponse holds the state digest of a database as of a block. The digest is independent of the order in
+// which the entries of the database were written, hence, two nodes whose databases hold the same entries return the
+// same digest. updated_at is the number of the last block at or below block_number that updated the database, or 0
+// if none did, in which case the digest is the one of an empty database.
+type GetDBDigestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header        *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	KVs           []*KVWithMetadata `protobuf:"bytes,2,rep,name=KVs,proto3" json:"KVs,omitempty"`
-	PendingResult bool              `protobuf:"varint,3,opt,name=pending_result,json=pendingResult,proto3" json:"pending_result,omitempty"`
-	NextStartKey  string            `protobuf:"bytes,4,opt,name=next_start_key,json=nextStartKey,proto3" json:"next_start_key,omitempty"`
+	Header      *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BlockNumber uint64          `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Digest      []byte          `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	UpdatedAt   uint64          `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *GetDataRangeResponse) Reset() {
-	*x = GetDataRangeResponse{}
+func (x *GetDBDigestResponse) Reset() {
+	*x = GetDBDigestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -485,13 +801,13 @@ func (x *GetDataRangeResponse) Reset() {
 	}
 }
 
-func (x *GetDataRangeResponse) String() string {
+func (x *GetDBDigestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataRangeResponse) ProtoMessage() {}
+func (*GetDBDigestResponse) ProtoMessage() {}
 
-func (x *GetDataRangeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDBDigestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -503,51 +819,51 @@ func (x *GetDataRangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataRangeResponse.ProtoReflect.Descriptor instead.
-func (*GetDataRangeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDigestResponse.ProtoReflect.Descriptor instead.
+func (*GetDBDigestResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetDataRangeResponse) GetHeader() *ResponseHeader {
+func (x *GetDBDigestResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *GetDataRangeResponse) GetKVs() []*KVWithMetadata {
+func (x *GetDBDigestResponse) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.KVs
+		return x.BlockNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDataRangeResponse) GetPendingResult() bool {
+func (x *GetDBDigestResponse) GetDigest() []byte {
 	if x != nil {
-		return x.PendingResult
+		return x.Digest
 	}
-	return false
+	return nil
 }
 
-func (x *GetDataRangeResponse) GetNextStartKey() string {
+func (x *GetDBDigestResponse) GetUpdatedAt() uint64 {
 	if x != nil {
-		return x.NextStartKey
+		return x.UpdatedAt
 	}
-	return ""
+	return 0
 }
 
-// GetUser
-type GetUserResponseEnvelope struct {
+type GetDBDescriptorHistoryResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetUserResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetDBDescriptorHistoryResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                          `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetUserResponseEnvelope) Reset() {
-	*x = GetUserResponseEnvelope{}
+func (x *GetDBDescriptorHistoryResponseEnvelope) Reset() {
+	*x = GetDBDescriptorHistoryResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -555,13 +871,13 @@ func (x *GetUserResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetUserResponseEnvelope) String() string {
+func (x *GetDBDescriptorHistoryResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponseEnvelope) ProtoMessage() {}
+func (*GetDBDescriptorHistoryResponseEnvelope) ProtoMessage() {}
 
-func (x *GetUserResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorHistoryResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,37 +889,44 @@ func (x *GetUserResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetUserResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorHistoryResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorHistoryResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUserResponseEnvelope) GetResponse() *GetUserResponse {
+func (x *GetDBDescriptorHistoryResponseEnvelope) GetResponse() *GetDBDescriptorHistoryResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *GetUserResponseEnvelope) GetSignature() []byte {
+func (x *GetDBDescriptorHistoryResponseEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetUserResponse struct {
+func (x *GetDBDescriptorHistoryResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetDBDescriptorHistoryResponse lists the changes of the descriptor of a database, in the order of their versions.
+type GetDBDescriptorHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header   *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	User     *User           `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Metadata *Metadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Header  *ResponseHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Changes []*DBDescriptorChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
+func (x *GetDBDescriptorHistoryResponse) Reset() {
+	*x = GetDBDescriptorHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -611,13 +934,13 @@ func (x *GetUserResponse) Reset() {
 	}
 }
 
-func (x *GetUserResponse) String() string {
+func (x *GetDBDescriptorHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*GetDBDescriptorHistoryResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDBDescriptorHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,44 +952,40 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDBDescriptorHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDBDescriptorHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetUserResponse) GetHeader() *ResponseHeader {
+func (x *GetDBDescriptorHistoryResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *GetUserResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-func (x *GetUserResponse) GetMetadata() *Metadata {
+func (x *GetDBDescriptorHistoryResponse) GetChanges() []*DBDescriptorChange {
 	if x != nil {
-		return x.Metadata
+		return x.Changes
 	}
 	return nil
 }
 
-// GetConfig
-type GetConfigResponseEnvelope struct {
+// DBDescriptorChange is a version of the descriptor of a database along with the transaction that set it and the
+// admin who submitted the transaction.
+type DBDescriptorChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetConfigResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	DbDescriptor *DBDescriptor `protobuf:"bytes,1,opt,name=db_descriptor,json=dbDescriptor,proto3" json:"db_descriptor,omitempty"`
+	Version      *Version      `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	TxId         string        `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	UserId       string        `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetConfigResponseEnvelope) Reset() {
-	*x = GetConfigResponseEnvelope{}
+func (x *DBDescriptorChange) Reset() {
+	*x = DBDescriptorChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,13 +993,13 @@ func (x *GetConfigResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetConfigResponseEnvelope) String() string {
+func (x *DBDescriptorChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponseEnvelope) ProtoMessage() {}
+func (*DBDescriptorChange) ProtoMessage() {}
 
-func (x *GetConfigResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *DBDescriptorChange) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,51 +1011,65 @@ func (x *GetConfigResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetConfigResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use DBDescriptorChange.ProtoReflect.Descriptor instead.
+func (*DBDescriptorChange) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetConfigResponseEnvelope) GetResponse() *GetConfigResponse {
+func (x *DBDescriptorChange) GetDbDescriptor() *DBDescriptor {
 	if x != nil {
-		return x.Response
+		return x.DbDescriptor
 	}
 	return nil
 }
 
-func (x *GetConfigResponseEnvelope) GetSignature() []byte {
+func (x *DBDescriptorChange) GetVersion() *Version {
 	if x != nil {
-		return x.Signature
+		return x.Version
 	}
 	return nil
 }
 
-type GetConfigResponse struct {
+func (x *DBDescriptorChange) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *DBDescriptorChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDataResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header   *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Config   *ClusterConfig  `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Metadata *Metadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Response      *GetDataResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte           `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
-	if protoimpl.UnsafeEnabled {
+func (x *GetDataResponseEnvelope) Reset() {
+	*x = GetDataResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetConfigResponse) String() string {
+func (x *GetDataResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponse) ProtoMessage() {}
+func (*GetDataResponseEnvelope) ProtoMessage() {}
 
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDataResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,44 +1081,44 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetConfigResponse) GetHeader() *ResponseHeader {
+func (x *GetDataResponseEnvelope) GetResponse() *GetDataResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetConfigResponse) GetConfig() *ClusterConfig {
+func (x *GetDataResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.Config
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *GetConfigResponse) GetMetadata() *Metadata {
+func (x *GetDataResponseEnvelope) GetResponseBytes() []byte {
 	if x != nil {
-		return x.Metadata
+		return x.ResponseBytes
 	}
 	return nil
 }
 
-// GetNodeConfig
-type GetNodeConfigResponseEnvelope struct {
+type GetDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetNodeConfigResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header   *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Value    []byte          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata *Metadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *GetNodeConfigResponseEnvelope) Reset() {
-	*x = GetNodeConfigResponseEnvelope{}
+func (x *GetDataResponse) Reset() {
+	*x = GetDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -793,13 +1126,13 @@ func (x *GetNodeConfigResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetNodeConfigResponseEnvelope) String() string {
+func (x *GetDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeConfigResponseEnvelope) ProtoMessage() {}
+func (*GetDataResponse) ProtoMessage() {}
 
-func (x *GetNodeConfigResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -811,36 +1144,44 @@ func (x *GetNodeConfigResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeConfigResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetNodeConfigResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataResponse.ProtoReflect.Descriptor instead.
+func (*GetDataResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetNodeConfigResponseEnvelope) GetResponse() *GetNodeConfigResponse {
+func (x *GetDataResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetNodeConfigResponseEnvelope) GetSignature() []byte {
+func (x *GetDataResponse) GetValue() []byte {
 	if x != nil {
-		return x.Signature
+		return x.Value
 	}
 	return nil
 }
 
-type GetNodeConfigResponse struct {
+func (x *GetDataResponse) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetDataRangeResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header     *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	NodeConfig *NodeConfig     `protobuf:"bytes,2,opt,name=node_config,json=nodeConfig,proto3" json:"node_config,omitempty"`
+	Response      *GetDataRangeResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetNodeConfigResponse) Reset() {
-	*x = GetNodeConfigResponse{}
+func (x *GetDataRangeResponseEnvelope) Reset() {
+	*x = GetDataRangeResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,13 +1189,13 @@ func (x *GetNodeConfigResponse) Reset() {
 	}
 }
 
-func (x *GetNodeConfigResponse) String() string {
+func (x *GetDataRangeResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeConfigResponse) ProtoMessage() {}
+func (*GetDataRangeResponseEnvelope) ProtoMessage() {}
 
-func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDataRangeResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,37 +1207,50 @@ func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetNodeConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataRangeResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataRangeResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetNodeConfigResponse) GetHeader() *ResponseHeader {
+func (x *GetDataRangeResponseEnvelope) GetResponse() *GetDataRangeResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetNodeConfigResponse) GetNodeConfig() *NodeConfig {
+func (x *GetDataRangeResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.NodeConfig
+		return x.Signature
 	}
 	return nil
 }
 
-// GetConfigBlock
-type GetConfigBlockResponseEnvelope struct {
+func (x *GetDataRangeResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDataRangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetConfigBlockResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header        *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	KVs           []*KVWithMetadata `protobuf:"bytes,2,rep,name=KVs,proto3" json:"KVs,omitempty"`
+	PendingResult bool              `protobuf:"varint,3,opt,name=pending_result,json=pendingResult,proto3" json:"pending_result,omitempty"`
+	NextStartKey  string            `protobuf:"bytes,4,opt,name=next_start_key,json=nextStartKey,proto3" json:"next_start_key,omitempty"`
+	// The height of the read replica that served an analytical query; zero for a query served from the state database.
+	ReplicaHeight uint64 `protobuf:"varint,5,opt,name=replica_height,json=replicaHeight,proto3" json:"replica_height,omitempty"`
+	// The cursor of the next page, set along with pending_result. Unlike next_start_key, the cursor is expired, rather
+	// than resumed at the wrong key, once the format of the keys of the database changes.
+	NextCursor string `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *GetConfigBlockResponseEnvelope) Reset() {
-	*x = GetConfigBlockResponseEnvelope{}
+func (x *GetDataRangeResponse) Reset() {
+	*x = GetDataRangeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -904,13 +1258,13 @@ func (x *GetConfigBlockResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetConfigBlockResponseEnvelope) String() string {
+func (x *GetDataRangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigBlockResponseEnvelope) ProtoMessage() {}
+func (*GetDataRangeResponse) ProtoMessage() {}
 
-func (x *GetConfigBlockResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetDataRangeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -922,37 +1276,66 @@ func (x *GetConfigBlockResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigBlockResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetConfigBlockResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetDataRangeResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetConfigBlockResponseEnvelope) GetResponse() *GetConfigBlockResponse {
+func (x *GetDataRangeResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetConfigBlockResponseEnvelope) GetSignature() []byte {
+func (x *GetDataRangeResponse) GetKVs() []*KVWithMetadata {
 	if x != nil {
-		return x.Signature
+		return x.KVs
 	}
 	return nil
 }
 
-type GetConfigBlockResponse struct {
+func (x *GetDataRangeResponse) GetPendingResult() bool {
+	if x != nil {
+		return x.PendingResult
+	}
+	return false
+}
+
+func (x *GetDataRangeResponse) GetNextStartKey() string {
+	if x != nil {
+		return x.NextStartKey
+	}
+	return ""
+}
+
+func (x *GetDataRangeResponse) GetReplicaHeight() uint64 {
+	if x != nil {
+		return x.ReplicaHeight
+	}
+	return 0
+}
+
+func (x *GetDataRangeResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// GetUser
+type GetUserResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// block bytes, marshaled with proto.Marshal
-	Block []byte `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Response      *GetUserResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte           `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetConfigBlockResponse) Reset() {
-	*x = GetConfigBlockResponse{}
+func (x *GetUserResponseEnvelope) Reset() {
+	*x = GetUserResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -960,13 +1343,13 @@ func (x *GetConfigBlockResponse) Reset() {
 	}
 }
 
-func (x *GetConfigBlockResponse) String() string {
+func (x *GetUserResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigBlockResponse) ProtoMessage() {}
+func (*GetUserResponseEnvelope) ProtoMessage() {}
 
-func (x *GetConfigBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -978,37 +1361,44 @@ func (x *GetConfigBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigBlockResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetUserResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetConfigBlockResponse) GetHeader() *ResponseHeader {
+func (x *GetUserResponseEnvelope) GetResponse() *GetUserResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetConfigBlockResponse) GetBlock() []byte {
+func (x *GetUserResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.Block
+		return x.Signature
 	}
 	return nil
 }
 
-// GetClusterStatus
-type GetClusterStatusResponseEnvelope struct {
+func (x *GetUserResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetClusterStatusResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header   *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	User     *User           `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Metadata *Metadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *GetClusterStatusResponseEnvelope) Reset() {
-	*x = GetClusterStatusResponseEnvelope{}
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1016,13 +1406,13 @@ func (x *GetClusterStatusResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetClusterStatusResponseEnvelope) String() string {
+func (x *GetUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterStatusResponseEnvelope) ProtoMessage() {}
+func (*GetUserResponse) ProtoMessage() {}
 
-func (x *GetClusterStatusResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1034,43 +1424,45 @@ func (x *GetClusterStatusResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterStatusResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetClusterStatusResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetClusterStatusResponseEnvelope) GetResponse() *GetClusterStatusResponse {
+func (x *GetUserResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetClusterStatusResponseEnvelope) GetSignature() []byte {
+func (x *GetUserResponse) GetUser() *User {
 	if x != nil {
-		return x.Signature
+		return x.User
 	}
 	return nil
 }
 
-type GetClusterStatusResponse struct {
+func (x *GetUserResponse) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// GetConfig
+type GetConfigResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	// The configuration of the nodes, the part from ClusterConfig accessible to every client.
-	Nodes []*NodeConfig `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	// The version of the ClusterConfig from which the nodes are taken of.
-	Version *Version `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	// The leader ID, if it exists.
-	Leader string `protobuf:"bytes,4,opt,name=Leader,proto3" json:"Leader,omitempty"`
-	// The IDs of active nodes, including the leader.
-	Active []string `protobuf:"bytes,5,rep,name=Active,proto3" json:"Active,omitempty"`
+	Response      *GetConfigResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte             `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetClusterStatusResponse) Reset() {
-	*x = GetClusterStatusResponse{}
+func (x *GetConfigResponseEnvelope) Reset() {
+	*x = GetConfigResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1078,13 +1470,13 @@ func (x *GetClusterStatusResponse) Reset() {
 	}
 }
 
-func (x *GetClusterStatusResponse) String() string {
+func (x *GetConfigResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterStatusResponse) ProtoMessage() {}
+func (*GetConfigResponseEnvelope) ProtoMessage() {}
 
-func (x *GetClusterStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetConfigResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1096,58 +1488,44 @@ func (x *GetClusterStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetClusterStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetConfigResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetClusterStatusResponse) GetHeader() *ResponseHeader {
+func (x *GetConfigResponseEnvelope) GetResponse() *GetConfigResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetClusterStatusResponse) GetNodes() []*NodeConfig {
+func (x *GetConfigResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.Nodes
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *GetClusterStatusResponse) GetVersion() *Version {
+func (x *GetConfigResponseEnvelope) GetResponseBytes() []byte {
 	if x != nil {
-		return x.Version
+		return x.ResponseBytes
 	}
 	return nil
 }
 
-func (x *GetClusterStatusResponse) GetLeader() string {
-	if x != nil {
-		return x.Leader
-	}
-	return ""
-}
+type GetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetClusterStatusResponse) GetActive() []string {
-	if x != nil {
-		return x.Active
-	}
-	return nil
-}
-
-// GetBlock
-type GetBlockResponseEnvelope struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Response  *GetBlockResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte            `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header   *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Config   *ClusterConfig  `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Metadata *Metadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *GetBlockResponseEnvelope) Reset() {
-	*x = GetBlockResponseEnvelope{}
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1155,13 +1533,13 @@ func (x *GetBlockResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetBlockResponseEnvelope) String() string {
+func (x *GetConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockResponseEnvelope) ProtoMessage() {}
+func (*GetConfigResponse) ProtoMessage() {}
 
-func (x *GetBlockResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1173,36 +1551,45 @@ func (x *GetBlockResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetBlockResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetBlockResponseEnvelope) GetResponse() *GetBlockResponse {
+func (x *GetConfigResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetBlockResponseEnvelope) GetSignature() []byte {
+func (x *GetConfigResponse) GetConfig() *ClusterConfig {
 	if x != nil {
-		return x.Signature
+		return x.Config
 	}
 	return nil
 }
 
-type GetBlockResponse struct {
+func (x *GetConfigResponse) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// GetNodeConfig
+type GetNodeConfigResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header      *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	BlockHeader *BlockHeader    `protobuf:"bytes,2,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	Response      *GetNodeConfigResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                 `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetBlockResponse) Reset() {
-	*x = GetBlockResponse{}
+func (x *GetNodeConfigResponseEnvelope) Reset() {
+	*x = GetNodeConfigResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,13 +1597,13 @@ func (x *GetBlockResponse) Reset() {
 	}
 }
 
-func (x *GetBlockResponse) String() string {
+func (x *GetNodeConfigResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockResponse) ProtoMessage() {}
+func (*GetNodeConfigResponseEnvelope) ProtoMessage() {}
 
-func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *GetNodeConfigResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1228,37 +1615,43 @@ func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNodeConfigResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetNodeConfigResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetBlockResponse) GetHeader() *ResponseHeader {
+func (x *GetNodeConfigResponseEnvelope) GetResponse() *GetNodeConfigResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetBlockResponse) GetBlockHeader() *BlockHeader {
+func (x *GetNodeConfigResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.BlockHeader
+		return x.Signature
 	}
 	return nil
 }
 
-// GetAugmentedBlockHeader
-type GetAugmentedBlockHeaderResponseEnvelope struct {
+func (x *GetNodeConfigResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetNodeConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetAugmentedBlockHeaderResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header     *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	NodeConfig *NodeConfig     `protobuf:"bytes,2,opt,name=node_config,json=nodeConfig,proto3" json:"node_config,omitempty"`
 }
 
-func (x *GetAugmentedBlockHeaderResponseEnvelope) Reset() {
-	*x = GetAugmentedBlockHeaderResponseEnvelope{}
+func (x *GetNodeConfigResponse) Reset() {
+	*x = GetNodeConfigResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1266,13 +1659,13 @@ func (x *GetAugmentedBlockHeaderResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetAugmentedBlockHeaderResponseEnvelope) String() string {
+func (x *GetNodeConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAugmentedBlockHeaderResponseEnvelope) ProtoMessage() {}
+func (*GetNodeConfigResponse) ProtoMessage() {}
 
-func (x *GetAugmentedBlockHeaderResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1284,36 +1677,38 @@ func (x *GetAugmentedBlockHeaderResponseEnvelope) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAugmentedBlockHeaderResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetAugmentedBlockHeaderResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNodeConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeConfigResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetAugmentedBlockHeaderResponseEnvelope) GetResponse() *GetAugmentedBlockHeaderResponse {
+func (x *GetNodeConfigResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetAugmentedBlockHeaderResponseEnvelope) GetSignature() []byte {
+func (x *GetNodeConfigResponse) GetNodeConfig() *NodeConfig {
 	if x != nil {
-		return x.Signature
+		return x.NodeConfig
 	}
 	return nil
 }
 
-type GetAugmentedBlockHeaderResponse struct {
+// GetConfigBlock
+type GetConfigBlockResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header      *ResponseHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	BlockHeader *AugmentedBlockHeader `protobuf:"bytes,2,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	Response      *GetConfigBlockResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                  `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetAugmentedBlockHeaderResponse) Reset() {
-	*x = GetAugmentedBlockHeaderResponse{}
+func (x *GetConfigBlockResponseEnvelope) Reset() {
+	*x = GetConfigBlockResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1321,13 +1716,13 @@ func (x *GetAugmentedBlockHeaderResponse) Reset() {
 	}
 }
 
-func (x *GetAugmentedBlockHeaderResponse) String() string {
+func (x *GetConfigBlockResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAugmentedBlockHeaderResponse) ProtoMessage() {}
+func (*GetConfigBlockResponseEnvelope) ProtoMessage() {}
 
-func (x *GetAugmentedBlockHeaderResponse) ProtoReflect() protoreflect.Message {
+func (x *GetConfigBlockResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1339,37 +1734,44 @@ func (x *GetAugmentedBlockHeaderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAugmentedBlockHeaderResponse.ProtoReflect.Descriptor instead.
-func (*GetAugmentedBlockHeaderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigBlockResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetConfigBlockResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetAugmentedBlockHeaderResponse) GetHeader() *ResponseHeader {
+func (x *GetConfigBlockResponseEnvelope) GetResponse() *GetConfigBlockResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetAugmentedBlockHeaderResponse) GetBlockHeader() *AugmentedBlockHeader {
+func (x *GetConfigBlockResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.BlockHeader
+		return x.Signature
 	}
 	return nil
 }
 
-// GetLedgerPath
-type GetLedgerPathResponseEnvelope struct {
+func (x *GetConfigBlockResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetConfigBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetLedgerPathResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// block bytes, marshaled with proto.Marshal
+	Block []byte `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *GetLedgerPathResponseEnvelope) Reset() {
-	*x = GetLedgerPathResponseEnvelope{}
+func (x *GetConfigBlockResponse) Reset() {
+	*x = GetConfigBlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1377,13 +1779,13 @@ func (x *GetLedgerPathResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetLedgerPathResponseEnvelope) String() string {
+func (x *GetConfigBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLedgerPathResponseEnvelope) ProtoMessage() {}
+func (*GetConfigBlockResponse) ProtoMessage() {}
 
-func (x *GetLedgerPathResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetConfigBlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1395,36 +1797,38 @@ func (x *GetLedgerPathResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLedgerPathResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetLedgerPathResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigBlockResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetLedgerPathResponseEnvelope) GetResponse() *GetLedgerPathResponse {
+func (x *GetConfigBlockResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetLedgerPathResponseEnvelope) GetSignature() []byte {
+func (x *GetConfigBlockResponse) GetBlock() []byte {
 	if x != nil {
-		return x.Signature
+		return x.Block
 	}
 	return nil
 }
 
-type GetLedgerPathResponse struct {
+// GetConfigLimits
+type GetConfigLimitsResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header       *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	BlockHeaders []*BlockHeader  `protobuf:"bytes,2,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	Response      *GetConfigLimitsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                   `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetLedgerPathResponse) Reset() {
-	*x = GetLedgerPathResponse{}
+func (x *GetConfigLimitsResponseEnvelope) Reset() {
+	*x = GetConfigLimitsResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1432,13 +1836,13 @@ func (x *GetLedgerPathResponse) Reset() {
 	}
 }
 
-func (x *GetLedgerPathResponse) String() string {
+func (x *GetConfigLimitsResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLedgerPathResponse) ProtoMessage() {}
+func (*GetConfigLimitsResponseEnvelope) ProtoMessage() {}
 
-func (x *GetLedgerPathResponse) ProtoReflect() protoreflect.Message {
+func (x *GetConfigLimitsResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1450,37 +1854,46 @@ func (x *GetLedgerPathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLedgerPathResponse.ProtoReflect.Descriptor instead.
-func (*GetLedgerPathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigLimitsResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetConfigLimitsResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetLedgerPathResponse) GetHeader() *ResponseHeader {
+func (x *GetConfigLimitsResponseEnvelope) GetResponse() *GetConfigLimitsResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetLedgerPathResponse) GetBlockHeaders() []*BlockHeader {
+func (x *GetConfigLimitsResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.BlockHeaders
+		return x.Signature
 	}
 	return nil
 }
 
-// GetTxProof
-type GetTxProofResponseEnvelope struct {
+func (x *GetConfigLimitsResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetConfigLimitsResponse holds the limits on data transactions, as set in the committed cluster configuration.
+type GetConfigLimitsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetTxProofResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header            *ResponseHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TxOperationLimits *TxOperationLimits `protobuf:"bytes,2,opt,name=tx_operation_limits,json=txOperationLimits,proto3" json:"tx_operation_limits,omitempty"`
+	// The effective maximal number of keys per range delete, which is the default when the configuration sets none.
+	MaxKeysPerRangeDelete uint64 `protobuf:"varint,3,opt,name=max_keys_per_range_delete,json=maxKeysPerRangeDelete,proto3" json:"max_keys_per_range_delete,omitempty"`
 }
 
-func (x *GetTxProofResponseEnvelope) Reset() {
-	*x = GetTxProofResponseEnvelope{}
+func (x *GetConfigLimitsResponse) Reset() {
+	*x = GetConfigLimitsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1488,13 +1901,13 @@ func (x *GetTxProofResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetTxProofResponseEnvelope) String() string {
+func (x *GetConfigLimitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxProofResponseEnvelope) ProtoMessage() {}
+func (*GetConfigLimitsResponse) ProtoMessage() {}
 
-func (x *GetTxProofResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetConfigLimitsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1506,36 +1919,45 @@ func (x *GetTxProofResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxProofResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetTxProofResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigLimitsResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetTxProofResponseEnvelope) GetResponse() *GetTxProofResponse {
+func (x *GetConfigLimitsResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetTxProofResponseEnvelope) GetSignature() []byte {
+func (x *GetConfigLimitsResponse) GetTxOperationLimits() *TxOperationLimits {
 	if x != nil {
-		return x.Signature
+		return x.TxOperationLimits
 	}
 	return nil
 }
 
-type GetTxProofResponse struct {
+func (x *GetConfigLimitsResponse) GetMaxKeysPerRangeDelete() uint64 {
+	if x != nil {
+		return x.MaxKeysPerRangeDelete
+	}
+	return 0
+}
+
+// GetClusterStatus
+type GetClusterStatusResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Hashes [][]byte        `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Response      *GetClusterStatusResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                    `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetTxProofResponse) Reset() {
-	*x = GetTxProofResponse{}
+func (x *GetClusterStatusResponseEnvelope) Reset() {
+	*x = GetClusterStatusResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1543,13 +1965,13 @@ func (x *GetTxProofResponse) Reset() {
 	}
 }
 
-func (x *GetTxProofResponse) String() string {
+func (x *GetClusterStatusResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxProofResponse) ProtoMessage() {}
+func (*GetClusterStatusResponseEnvelope) ProtoMessage() {}
 
-func (x *GetTxProofResponse) ProtoReflect() protoreflect.Message {
+func (x *GetClusterStatusResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1561,37 +1983,60 @@ func (x *GetTxProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxProofResponse.ProtoReflect.Descriptor instead.
-func (*GetTxProofResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterStatusResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetClusterStatusResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetTxProofResponse) GetHeader() *ResponseHeader {
+func (x *GetClusterStatusResponseEnvelope) GetResponse() *GetClusterStatusResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetTxProofResponse) GetHashes() [][]byte {
+func (x *GetClusterStatusResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.Hashes
+		return x.Signature
 	}
 	return nil
 }
 
-// GetDataProof
-type GetDataProofResponseEnvelope struct {
+func (x *GetClusterStatusResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetClusterStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDataProofResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The configuration of the nodes, the part from ClusterConfig accessible to every client.
+	Nodes []*NodeConfig `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// The version of the ClusterConfig from which the nodes are taken of.
+	Version *Version `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The leader ID, if it exists.
+	Leader string `protobuf:"bytes,4,opt,name=Leader,proto3" json:"Leader,omitempty"`
+	// The IDs of active nodes, including the leader.
+	Active []string `protobuf:"bytes,5,rep,name=Active,proto3" json:"Active,omitempty"`
+	// The stage of the orderly shutdown of the node, if one is in progress; empty otherwise.
+	ShutdownStage string `protobuf:"bytes,6,opt,name=shutdown_stage,json=shutdownStage,proto3" json:"shutdown_stage,omitempty"`
+	// The divergence on which the node halted its commits, if any.
+	StateDivergence *StateDivergence `protobuf:"bytes,7,opt,name=state_divergence,json=stateDivergence,proto3" json:"state_divergence,omitempty"`
+	// The warm-up of the caches of the node after its start, if one is configured. The node is ready to serve once the
+	// warm-up is no longer running.
+	WarmUp *WarmUpStatus `protobuf:"bytes,8,opt,name=warm_up,json=warmUp,proto3" json:"warm_up,omitempty"`
+	// The replay of the committed blocks onto the stores that lagged behind the block store when the node started, one
+	// per recovered store. The node serves once the replays are done.
+	Recoveries []*RecoveryStatus `protobuf:"bytes,9,rep,name=recoveries,proto3" json:"recoveries,omitempty"`
 }
 
-func (x *GetDataProofResponseEnvelope) Reset() {
-	*x = GetDataProofResponseEnvelope{}
+func (x *GetClusterStatusResponse) Reset() {
+	*x = GetClusterStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1599,13 +2044,13 @@ func (x *GetDataProofResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataProofResponseEnvelope) String() string {
+func (x *GetClusterStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataProofResponseEnvelope) ProtoMessage() {}
+func (*GetClusterStatusResponse) ProtoMessage() {}
 
-func (x *GetDataProofResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetClusterStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1617,36 +2062,96 @@ func (x *GetDataProofResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataProofResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataProofResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterStatusResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetDataProofResponseEnvelope) GetResponse() *GetDataProofResponse {
+func (x *GetClusterStatusResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetDataProofResponseEnvelope) GetSignature() []byte {
+func (x *GetClusterStatusResponse) GetNodes() []*NodeConfig {
 	if x != nil {
-		return x.Signature
+		return x.Nodes
 	}
 	return nil
 }
 
-type GetDataProofResponse struct {
+func (x *GetClusterStatusResponse) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *GetClusterStatusResponse) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *GetClusterStatusResponse) GetActive() []string {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *GetClusterStatusResponse) GetShutdownStage() string {
+	if x != nil {
+		return x.ShutdownStage
+	}
+	return ""
+}
+
+func (x *GetClusterStatusResponse) GetStateDivergence() *StateDivergence {
+	if x != nil {
+		return x.StateDivergence
+	}
+	return nil
+}
+
+func (x *GetClusterStatusResponse) GetWarmUp() *WarmUpStatus {
+	if x != nil {
+		return x.WarmUp
+	}
+	return nil
+}
+
+func (x *GetClusterStatusResponse) GetRecoveries() []*RecoveryStatus {
+	if x != nil {
+		return x.Recoveries
+	}
+	return nil
+}
+
+// RecoveryStatus describes the replay of the committed blocks onto a store that lagged behind the block store when
+// the node started, i.e., the state database or the state trie. The provenance store and the state indexes are
+// committed along with the state database, and hence, are recovered by its replay.
+type RecoveryStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Path   []*MPTrieProofElement `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	// The name of the recovered store.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// The height of the store before the recovery, and the height of the block store.
+	StartHeight  uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	TargetHeight uint64 `protobuf:"varint,3,opt,name=target_height,json=targetHeight,proto3" json:"target_height,omitempty"`
+	// The last block replayed onto the store.
+	RecoveredHeight uint64 `protobuf:"varint,4,opt,name=recovered_height,json=recoveredHeight,proto3" json:"recovered_height,omitempty"`
+	Done            bool   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	// The number of replayed blocks that were validated anew, as their validation info was not attested.
+	RevalidatedBlocks uint64 `protobuf:"varint,6,opt,name=revalidated_blocks,json=revalidatedBlocks,proto3" json:"revalidated_blocks,omitempty"`
 }
 
-func (x *GetDataProofResponse) Reset() {
-	*x = GetDataProofResponse{}
+func (x *RecoveryStatus) Reset() {
+	*x = RecoveryStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1654,13 +2159,13 @@ func (x *GetDataProofResponse) Reset() {
 	}
 }
 
-func (x *GetDataProofResponse) String() string {
+func (x *RecoveryStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataProofResponse) ProtoMessage() {}
+func (*RecoveryStatus) ProtoMessage() {}
 
-func (x *GetDataProofResponse) ProtoReflect() protoreflect.Message {
+func (x *RecoveryStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1672,35 +2177,79 @@ func (x *GetDataProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataProofResponse.ProtoReflect.Descriptor instead.
-func (*GetDataProofResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoveryStatus.ProtoReflect.Descriptor instead.
+func (*RecoveryStatus) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetDataProofResponse) GetHeader() *ResponseHeader {
+func (x *RecoveryStatus) GetStore() string {
 	if x != nil {
-		return x.Header
+		return x.Store
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDataProofResponse) GetPath() []*MPTrieProofElement {
+func (x *RecoveryStatus) GetStartHeight() uint64 {
 	if x != nil {
-		return x.Path
+		return x.StartHeight
 	}
-	return nil
+	return 0
 }
 
-type MPTrieProofElement struct {
+func (x *RecoveryStatus) GetTargetHeight() uint64 {
+	if x != nil {
+		return x.TargetHeight
+	}
+	return 0
+}
+
+func (x *RecoveryStatus) GetRecoveredHeight() uint64 {
+	if x != nil {
+		return x.RecoveredHeight
+	}
+	return 0
+}
+
+func (x *RecoveryStatus) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *RecoveryStatus) GetRevalidatedBlocks() uint64 {
+	if x != nil {
+		return x.RevalidatedBlocks
+	}
+	return 0
+}
+
+// WarmUpStatus describes the warm-up of the caches of the state database, which runs on start, after the recovery of
+// the state database. The keys read and written by the most recent blocks, and the identities of their submitters,
+// are read back into the caches, followed by the configured hot key prefixes. A warm-up that exceeds its time or
+// bytes budget is aborted, leaving the caches partially warmed.
+type WarmUpStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
-}
-
-func (x *MPTrieProofElement) Reset() {
-	*x = MPTrieProofElement{}
+	State WarmUpStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=types.WarmUpStatus_State" json:"state,omitempty"`
+	// The number of recent blocks whose keys and submitters were read back, out of the blocks to be replayed.
+	ReplayedBlocks uint64 `protobuf:"varint,2,opt,name=replayed_blocks,json=replayedBlocks,proto3" json:"replayed_blocks,omitempty"`
+	TotalBlocks    uint64 `protobuf:"varint,3,opt,name=total_blocks,json=totalBlocks,proto3" json:"total_blocks,omitempty"`
+	// The number of keys, of identities, and of bytes of values read into the caches.
+	LoadedKeys       uint64 `protobuf:"varint,4,opt,name=loaded_keys,json=loadedKeys,proto3" json:"loaded_keys,omitempty"`
+	LoadedIdentities uint64 `protobuf:"varint,5,opt,name=loaded_identities,json=loadedIdentities,proto3" json:"loaded_identities,omitempty"`
+	LoadedBytes      uint64 `protobuf:"varint,6,opt,name=loaded_bytes,json=loadedBytes,proto3" json:"loaded_bytes,omitempty"`
+	// The number of hot key prefixes read into the caches.
+	LoadedPrefixes uint64 `protobuf:"varint,7,opt,name=loaded_prefixes,json=loadedPrefixes,proto3" json:"loaded_prefixes,omitempty"`
+	ElapsedMillis  uint64 `protobuf:"varint,8,opt,name=elapsed_millis,json=elapsedMillis,proto3" json:"elapsed_millis,omitempty"`
+	// The reason an aborted warm-up was aborted.
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *WarmUpStatus) Reset() {
+	*x = WarmUpStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1708,13 +2257,13 @@ func (x *MPTrieProofElement) Reset() {
 	}
 }
 
-func (x *MPTrieProofElement) String() string {
+func (x *WarmUpStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MPTrieProofElement) ProtoMessage() {}
+func (*WarmUpStatus) ProtoMessage() {}
 
-func (x *MPTrieProofElement) ProtoReflect() protoreflect.Message {
+func (x *WarmUpStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1726,30 +2275,90 @@ func (x *MPTrieProofElement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MPTrieProofElement.ProtoReflect.Descriptor instead.
-func (*MPTrieProofElement) Descriptor() ([]byte, []int) {
+// Deprecated: Use WarmUpStatus.ProtoReflect.Descriptor instead.
+func (*WarmUpStatus) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MPTrieProofElement) GetHashes() [][]byte {
+func (x *WarmUpStatus) GetState() WarmUpStatus_State {
 	if x != nil {
-		return x.Hashes
+		return x.State
 	}
-	return nil
+	return WarmUpStatus_NONE
 }
 
-// GetHistoricalData
-type GetHistoricalDataResponseEnvelope struct {
+func (x *WarmUpStatus) GetReplayedBlocks() uint64 {
+	if x != nil {
+		return x.ReplayedBlocks
+	}
+	return 0
+}
+
+func (x *WarmUpStatus) GetTotalBlocks() uint64 {
+	if x != nil {
+		return x.TotalBlocks
+	}
+	return 0
+}
+
+func (x *WarmUpStatus) GetLoadedKeys() uint64 {
+	if x != nil {
+		return x.LoadedKeys
+	}
+	return 0
+}
+
+func (x *WarmUpStatus) GetLoadedIdentities() uint64 {
+	if x != nil {
+		return x.LoadedIdentities
+	}
+	return 0
+}
+
+func (x *WarmUpStatus) GetLoadedBytes() uint64 {
+	if x != nil {
+		return x.LoadedBytes
+	}
+	return 0
+}
+
+func (x *WarmUpStatus) GetLoadedPrefixes() uint64 {
+	if x != nil {
+		return x.LoadedPrefixes
+	}
+	return 0
+}
+
+func (x *WarmUpStatus) GetElapsedMillis() uint64 {
+	if x != nil {
+		return x.ElapsedMillis
+	}
+	return 0
+}
+
+func (x *WarmUpStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// StateDivergence reports a block pulled from a peer whose header, as computed by this node when it re-validated the
+// block, differs from the header computed by the peer. The node halts its commits on a divergence until an admin
+// forces the acceptance of the peer's version of the block.
+type StateDivergence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetHistoricalDataResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The ID of the peer the block was pulled from, if known.
+	PeerId string                   `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Fields []*HeaderFieldDivergence `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
-func (x *GetHistoricalDataResponseEnvelope) Reset() {
-	*x = GetHistoricalDataResponseEnvelope{}
+func (x *StateDivergence) Reset() {
+	*x = StateDivergence{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1757,13 +2366,13 @@ func (x *GetHistoricalDataResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetHistoricalDataResponseEnvelope) String() string {
+func (x *StateDivergence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricalDataResponseEnvelope) ProtoMessage() {}
+func (*StateDivergence) ProtoMessage() {}
 
-func (x *GetHistoricalDataResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *StateDivergence) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1775,36 +2384,49 @@ func (x *GetHistoricalDataResponseEnvelope) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricalDataResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetHistoricalDataResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use StateDivergence.ProtoReflect.Descriptor instead.
+func (*StateDivergence) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetHistoricalDataResponseEnvelope) GetResponse() *GetHistoricalDataResponse {
+func (x *StateDivergence) GetBlockNumber() uint64 {
 	if x != nil {
-		return x.Response
+		return x.BlockNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *GetHistoricalDataResponseEnvelope) GetSignature() []byte {
+func (x *StateDivergence) GetPeerId() string {
 	if x != nil {
-		return x.Signature
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *StateDivergence) GetFields() []*HeaderFieldDivergence {
+	if x != nil {
+		return x.Fields
 	}
 	return nil
 }
 
-type GetHistoricalDataResponse struct {
+type HeaderFieldDivergence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Values []*ValueWithMetadata `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// The name of the header field, e.g., state_merkel_tree_root_hash, or validation_info[2] for the validation info of
+	// the third transaction. A divergence found by the state digests, which are compared before the header, names the
+	// database, e.g., state_digest[db1].
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The value computed by this node, and the value computed by the peer, in their JSON encoding; empty when the
+	// field is missing.
+	LocalValue string `protobuf:"bytes,2,opt,name=local_value,json=localValue,proto3" json:"local_value,omitempty"`
+	PeerValue  string `protobuf:"bytes,3,opt,name=peer_value,json=peerValue,proto3" json:"peer_value,omitempty"`
 }
 
-func (x *GetHistoricalDataResponse) Reset() {
-	*x = GetHistoricalDataResponse{}
+func (x *HeaderFieldDivergence) Reset() {
+	*x = HeaderFieldDivergence{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1812,13 +2434,13 @@ func (x *GetHistoricalDataResponse) Reset() {
 	}
 }
 
-func (x *GetHistoricalDataResponse) String() string {
+func (x *HeaderFieldDivergence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricalDataResponse) ProtoMessage() {}
+func (*HeaderFieldDivergence) ProtoMessage() {}
 
-func (x *GetHistoricalDataResponse) ProtoReflect() protoreflect.Message {
+func (x *HeaderFieldDivergence) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1830,37 +2452,45 @@ func (x *GetHistoricalDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricalDataResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoricalDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HeaderFieldDivergence.ProtoReflect.Descriptor instead.
+func (*HeaderFieldDivergence) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetHistoricalDataResponse) GetHeader() *ResponseHeader {
+func (x *HeaderFieldDivergence) GetField() string {
 	if x != nil {
-		return x.Header
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHistoricalDataResponse) GetValues() []*ValueWithMetadata {
+func (x *HeaderFieldDivergence) GetLocalValue() string {
 	if x != nil {
-		return x.Values
+		return x.LocalValue
 	}
-	return nil
+	return ""
 }
 
-// GetDataReaders
-type GetDataReadersResponseEnvelope struct {
+func (x *HeaderFieldDivergence) GetPeerValue() string {
+	if x != nil {
+		return x.PeerValue
+	}
+	return ""
+}
+
+// GetClusterHeartbeats
+type GetClusterHeartbeatsResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDataReadersResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetClusterHeartbeatsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                        `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetDataReadersResponseEnvelope) Reset() {
-	*x = GetDataReadersResponseEnvelope{}
+func (x *GetClusterHeartbeatsResponseEnvelope) Reset() {
+	*x = GetClusterHeartbeatsResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1868,13 +2498,13 @@ func (x *GetDataReadersResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataReadersResponseEnvelope) String() string {
+func (x *GetClusterHeartbeatsResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataReadersResponseEnvelope) ProtoMessage() {}
+func (*GetClusterHeartbeatsResponseEnvelope) ProtoMessage() {}
 
-func (x *GetDataReadersResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetClusterHeartbeatsResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1886,36 +2516,47 @@ func (x *GetDataReadersResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataReadersResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataReadersResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterHeartbeatsResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetClusterHeartbeatsResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetDataReadersResponseEnvelope) GetResponse() *GetDataReadersResponse {
+func (x *GetClusterHeartbeatsResponseEnvelope) GetResponse() *GetClusterHeartbeatsResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *GetDataReadersResponseEnvelope) GetSignature() []byte {
+func (x *GetClusterHeartbeatsResponseEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetDataReadersResponse struct {
+func (x *GetClusterHeartbeatsResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetClusterHeartbeatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	ReadBy map[string]uint32 `protobuf:"bytes,2,rep,name=read_by,json=readBy,proto3" json:"read_by,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The last heartbeat of each node in the ClusterConfig. A node which has not committed a heartbeat yet is listed
+	// with a zero block number.
+	Heartbeats []*NodeHeartbeat `protobuf:"bytes,2,rep,name=heartbeats,proto3" json:"heartbeats,omitempty"`
+	// The height of the ledger against which the staleness of the heartbeats is computed.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *GetDataReadersResponse) Reset() {
-	*x = GetDataReadersResponse{}
+func (x *GetClusterHeartbeatsResponse) Reset() {
+	*x = GetClusterHeartbeatsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1923,13 +2564,13 @@ func (x *GetDataReadersResponse) Reset() {
 	}
 }
 
-func (x *GetDataReadersResponse) String() string {
+func (x *GetClusterHeartbeatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataReadersResponse) ProtoMessage() {}
+func (*GetClusterHeartbeatsResponse) ProtoMessage() {}
 
-func (x *GetDataReadersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetClusterHeartbeatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1941,37 +2582,49 @@ func (x *GetDataReadersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataReadersResponse.ProtoReflect.Descriptor instead.
-func (*GetDataReadersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterHeartbeatsResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterHeartbeatsResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetDataReadersResponse) GetHeader() *ResponseHeader {
+func (x *GetClusterHeartbeatsResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *GetDataReadersResponse) GetReadBy() map[string]uint32 {
+func (x *GetClusterHeartbeatsResponse) GetHeartbeats() []*NodeHeartbeat {
 	if x != nil {
-		return x.ReadBy
+		return x.Heartbeats
 	}
 	return nil
 }
 
-// GetDataWriters
-type GetDataWritersResponseEnvelope struct {
+func (x *GetClusterHeartbeatsResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type NodeHeartbeat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDataWritersResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	NodeId  string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The last committed height reported by the node in its last heartbeat.
+	LastCommittedHeight uint64 `protobuf:"varint,3,opt,name=last_committed_height,json=lastCommittedHeight,proto3" json:"last_committed_height,omitempty"`
+	// The block in which the last heartbeat of the node was committed.
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The number of blocks committed since the last heartbeat of the node.
+	Staleness uint64 `protobuf:"varint,5,opt,name=staleness,proto3" json:"staleness,omitempty"`
 }
 
-func (x *GetDataWritersResponseEnvelope) Reset() {
-	*x = GetDataWritersResponseEnvelope{}
+func (x *NodeHeartbeat) Reset() {
+	*x = NodeHeartbeat{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1979,13 +2632,13 @@ func (x *GetDataWritersResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataWritersResponseEnvelope) String() string {
+func (x *NodeHeartbeat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataWritersResponseEnvelope) ProtoMessage() {}
+func (*NodeHeartbeat) ProtoMessage() {}
 
-func (x *GetDataWritersResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *NodeHeartbeat) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1997,36 +2650,59 @@ func (x *GetDataWritersResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataWritersResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataWritersResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeHeartbeat.ProtoReflect.Descriptor instead.
+func (*NodeHeartbeat) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetDataWritersResponseEnvelope) GetResponse() *GetDataWritersResponse {
+func (x *NodeHeartbeat) GetNodeId() string {
 	if x != nil {
-		return x.Response
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDataWritersResponseEnvelope) GetSignature() []byte {
+func (x *NodeHeartbeat) GetVersion() string {
 	if x != nil {
-		return x.Signature
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-type GetDataWritersResponse struct {
+func (x *NodeHeartbeat) GetLastCommittedHeight() uint64 {
+	if x != nil {
+		return x.LastCommittedHeight
+	}
+	return 0
+}
+
+func (x *NodeHeartbeat) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *NodeHeartbeat) GetStaleness() uint64 {
+	if x != nil {
+		return x.Staleness
+	}
+	return 0
+}
+
+// GetSessionBootstrap
+type GetSessionBootstrapResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	WrittenBy map[string]uint32 `protobuf:"bytes,2,rep,name=written_by,json=writtenBy,proto3" json:"written_by,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Response      *GetSessionBootstrapResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                       `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetDataWritersResponse) Reset() {
-	*x = GetDataWritersResponse{}
+func (x *GetSessionBootstrapResponseEnvelope) Reset() {
+	*x = GetSessionBootstrapResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2034,13 +2710,13 @@ func (x *GetDataWritersResponse) Reset() {
 	}
 }
 
-func (x *GetDataWritersResponse) String() string {
+func (x *GetSessionBootstrapResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataWritersResponse) ProtoMessage() {}
+func (*GetSessionBootstrapResponseEnvelope) ProtoMessage() {}
 
-func (x *GetDataWritersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSessionBootstrapResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2052,37 +2728,48 @@ func (x *GetDataWritersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataWritersResponse.ProtoReflect.Descriptor instead.
-func (*GetDataWritersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionBootstrapResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetSessionBootstrapResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetDataWritersResponse) GetHeader() *ResponseHeader {
+func (x *GetSessionBootstrapResponseEnvelope) GetResponse() *GetSessionBootstrapResponse {
 	if x != nil {
-		return x.Header
+		return x.Response
 	}
 	return nil
 }
 
-func (x *GetDataWritersResponse) GetWrittenBy() map[string]uint32 {
+func (x *GetSessionBootstrapResponseEnvelope) GetSignature() []byte {
 	if x != nil {
-		return x.WrittenBy
+		return x.Signature
 	}
 	return nil
 }
 
-// GetDataProvenance
-type GetDataProvenanceResponseEnvelope struct {
+func (x *GetSessionBootstrapResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetSessionBootstrapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetDataProvenanceResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The record of the querier, which holds its privileges.
+	User         *User     `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	UserMetadata *Metadata `protobuf:"bytes,3,opt,name=user_metadata,json=userMetadata,proto3" json:"user_metadata,omitempty"`
+	// The user databases the querier has at least read access to, in lexicographic order.
+	Databases []*DatabaseAccess `protobuf:"bytes,4,rep,name=databases,proto3" json:"databases,omitempty"`
+	Limits    *SessionLimits    `protobuf:"bytes,5,opt,name=limits,proto3" json:"limits,omitempty"`
 }
 
-func (x *GetDataProvenanceResponseEnvelope) Reset() {
-	*x = GetDataProvenanceResponseEnvelope{}
+func (x *GetSessionBootstrapResponse) Reset() {
+	*x = GetSessionBootstrapResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2090,13 +2777,13 @@ func (x *GetDataProvenanceResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetDataProvenanceResponseEnvelope) String() string {
+func (x *GetSessionBootstrapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataProvenanceResponseEnvelope) ProtoMessage() {}
+func (*GetSessionBootstrapResponse) ProtoMessage() {}
 
-func (x *GetDataProvenanceResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetSessionBootstrapResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2108,35 +2795,57 @@ func (x *GetDataProvenanceResponseEnvelope) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataProvenanceResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetDataProvenanceResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionBootstrapResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionBootstrapResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetDataProvenanceResponseEnvelope) GetResponse() *GetDataProvenanceResponse {
+func (x *GetSessionBootstrapResponse) GetHeader() *ResponseHeader {
 	if x != nil {
-		return x.Response
+		return x.Header
 	}
 	return nil
 }
 
-func (x *GetDataProvenanceResponseEnvelope) GetSignature() []byte {
+func (x *GetSessionBootstrapResponse) GetUser() *User {
 	if x != nil {
-		return x.Signature
+		return x.User
 	}
 	return nil
 }
 
-type KVsWithMetadata struct {
+func (x *GetSessionBootstrapResponse) GetUserMetadata() *Metadata {
+	if x != nil {
+		return x.UserMetadata
+	}
+	return nil
+}
+
+func (x *GetSessionBootstrapResponse) GetDatabases() []*DatabaseAccess {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+func (x *GetSessionBootstrapResponse) GetLimits() *SessionLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type DatabaseAccess struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KVs []*KVWithMetadata `protobuf:"bytes,1,rep,name=KVs,proto3" json:"KVs,omitempty"`
+	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Access Privilege_Access `protobuf:"varint,2,opt,name=access,proto3,enum=types.Privilege_Access" json:"access,omitempty"`
 }
 
-func (x *KVsWithMetadata) Reset() {
-	*x = KVsWithMetadata{}
+func (x *DatabaseAccess) Reset() {
+	*x = DatabaseAccess{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2144,13 +2853,13 @@ func (x *KVsWithMetadata) Reset() {
 	}
 }
 
-func (x *KVsWithMetadata) String() string {
+func (x *DatabaseAccess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KVsWithMetadata) ProtoMessage() {}
+func (*DatabaseAccess) ProtoMessage() {}
 
-func (x *KVsWithMetadata) ProtoReflect() protoreflect.Message {
+func (x *DatabaseAccess) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2162,29 +2871,43 @@ func (x *KVsWithMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KVsWithMetadata.ProtoReflect.Descriptor instead.
-func (*KVsWithMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use DatabaseAccess.ProtoReflect.Descriptor instead.
+func (*DatabaseAccess) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *KVsWithMetadata) GetKVs() []*KVWithMetadata {
+func (x *DatabaseAccess) GetName() string {
 	if x != nil {
-		return x.KVs
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetDataProvenanceResponse struct {
+func (x *DatabaseAccess) GetAccess() Privilege_Access {
+	if x != nil {
+		return x.Access
+	}
+	return Privilege_Read
+}
+
+// SessionLimits holds the limits that the node enforces on the requests of a client.
+type SessionLimits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header      *ResponseHeader             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	DBKeyValues map[string]*KVsWithMetadata `protobuf:"bytes,2,rep,name=DBKeyValues,proto3" json:"DBKeyValues,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The maximal size of a query response.
+	ResponseSizeLimitInBytes uint64 `protobuf:"varint,1,opt,name=response_size_limit_in_bytes,json=responseSizeLimitInBytes,proto3" json:"response_size_limit_in_bytes,omitempty"`
+	// The maximal number of key versions that a point-in-time query may examine.
+	HistoricalQueryCostLimit uint64 `protobuf:"varint,2,opt,name=historical_query_cost_limit,json=historicalQueryCostLimit,proto3" json:"historical_query_cost_limit,omitempty"`
+	// The maximal size of a block, in bytes, which bounds the size of a transaction.
+	MaxBlockSize uint64 `protobuf:"varint,3,opt,name=max_block_size,json=maxBlockSize,proto3" json:"max_block_size,omitempty"`
+	// The maximal number of transactions in a block.
+	MaxTransactionCountPerBlock uint32 `protobuf:"varint,4,opt,name=max_transaction_count_per_block,json=maxTransactionCountPerBlock,proto3" json:"max_transaction_count_per_block,omitempty"`
 }
 
-func (x *GetDataProvenanceResponse) Reset() {
-	*x = GetDataProvenanceResponse{}
+func (x *SessionLimits) Reset() {
+	*x = SessionLimits{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2192,13 +2915,13 @@ func (x *GetDataProvenanceResponse) Reset() {
 	}
 }
 
-func (x *GetDataProvenanceResponse) String() string {
+func (x *SessionLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataProvenanceResponse) ProtoMessage() {}
+func (*SessionLimits) ProtoMessage() {}
 
-func (x *GetDataProvenanceResponse) ProtoReflect() protoreflect.Message {
+func (x *SessionLimits) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2210,37 +2933,52 @@ func (x *GetDataProvenanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataProvenanceResponse.ProtoReflect.Descriptor instead.
-func (*GetDataProvenanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionLimits.ProtoReflect.Descriptor instead.
+func (*SessionLimits) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetDataProvenanceResponse) GetHeader() *ResponseHeader {
+func (x *SessionLimits) GetResponseSizeLimitInBytes() uint64 {
 	if x != nil {
-		return x.Header
+		return x.ResponseSizeLimitInBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDataProvenanceResponse) GetDBKeyValues() map[string]*KVsWithMetadata {
+func (x *SessionLimits) GetHistoricalQueryCostLimit() uint64 {
 	if x != nil {
-		return x.DBKeyValues
+		return x.HistoricalQueryCostLimit
 	}
-	return nil
+	return 0
 }
 
-// GetTxIDsSubmittedBy
-type GetTxIDsSubmittedByResponseEnvelope struct {
+func (x *SessionLimits) GetMaxBlockSize() uint64 {
+	if x != nil {
+		return x.MaxBlockSize
+	}
+	return 0
+}
+
+func (x *SessionLimits) GetMaxTransactionCountPerBlock() uint32 {
+	if x != nil {
+		return x.MaxTransactionCountPerBlock
+	}
+	return 0
+}
+
+// GetBlock
+type GetBlockResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *GetTxIDsSubmittedByResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetBlockResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte            `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte            `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *GetTxIDsSubmittedByResponseEnvelope) Reset() {
-	*x = GetTxIDsSubmittedByResponseEnvelope{}
+func (x *GetBlockResponseEnvelope) Reset() {
+	*x = GetBlockResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2248,13 +2986,13 @@ func (x *GetTxIDsSubmittedByResponseEnvelope) Reset() {
 	}
 }
 
-func (x *GetTxIDsSubmittedByResponseEnvelope) String() string {
+func (x *GetBlockResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxIDsSubmittedByResponseEnvelope) ProtoMessage() {}
+func (*GetBlockResponseEnvelope) ProtoMessage() {}
 
-func (x *GetTxIDsSubmittedByResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetBlockResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2266,36 +3004,43 @@ func (x *GetTxIDsSubmittedByResponseEnvelope) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxIDsSubmittedByResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*GetTxIDsSubmittedByResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetBlockResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetTxIDsSubmittedByResponseEnvelope) GetResponse() *GetTxIDsSubmittedByResponse {
+func (x *GetBlockResponseEnvelope) GetResponse() *GetBlockResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *GetTxIDsSubmittedByResponseEnvelope) GetSignature() []byte {
+func (x *GetBlockResponseEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type GetTxIDsSubmittedByResponse struct {
+func (x *GetBlockResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	TxIDs  []string        `protobuf:"bytes,2,rep,name=txIDs,proto3" json:"txIDs,omitempty"`
+	Header      *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BlockHeader *BlockHeader    `protobuf:"bytes,2,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
 }
 
-func (x *GetTxIDsSubmittedByResponse) Reset() {
-	*x = GetTxIDsSubmittedByResponse{}
+func (x *GetBlockResponse) Reset() {
+	*x = GetBlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2303,13 +3048,13 @@ func (x *GetTxIDsSubmittedByResponse) Reset() {
 	}
 }
 
-func (x *GetTxIDsSubmittedByResponse) String() string {
+func (x *GetBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTxIDsSubmittedByResponse) ProtoMessage() {}
+func (*GetBlockResponse) ProtoMessage() {}
 
-func (x *GetTxIDsSubmittedByResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2321,36 +3066,38 @@ func (x *GetTxIDsSubmittedByResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTxIDsSubmittedByResponse.ProtoReflect.Descriptor instead.
-func (*GetTxIDsSubmittedByResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetTxIDsSubmittedByResponse) GetHeader() *ResponseHeader {
+func (x *GetBlockResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *GetTxIDsSubmittedByResponse) GetTxIDs() []string {
+func (x *GetBlockResponse) GetBlockHeader() *BlockHeader {
 	if x != nil {
-		return x.TxIDs
+		return x.BlockHeader
 	}
 	return nil
 }
 
-type TxReceiptResponseEnvelope struct {
+// GetAugmentedBlockHeader
+type GetAugmentedBlockHeaderResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *TxReceiptResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetAugmentedBlockHeaderResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                           `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *TxReceiptResponseEnvelope) Reset() {
-	*x = TxReceiptResponseEnvelope{}
+func (x *GetAugmentedBlockHeaderResponseEnvelope) Reset() {
+	*x = GetAugmentedBlockHeaderResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2358,13 +3105,13 @@ func (x *TxReceiptResponseEnvelope) Reset() {
 	}
 }
 
-func (x *TxReceiptResponseEnvelope) String() string {
+func (x *GetAugmentedBlockHeaderResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxReceiptResponseEnvelope) ProtoMessage() {}
+func (*GetAugmentedBlockHeaderResponseEnvelope) ProtoMessage() {}
 
-func (x *TxReceiptResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetAugmentedBlockHeaderResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2376,36 +3123,43 @@ func (x *TxReceiptResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxReceiptResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*TxReceiptResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAugmentedBlockHeaderResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetAugmentedBlockHeaderResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *TxReceiptResponseEnvelope) GetResponse() *TxReceiptResponse {
+func (x *GetAugmentedBlockHeaderResponseEnvelope) GetResponse() *GetAugmentedBlockHeaderResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *TxReceiptResponseEnvelope) GetSignature() []byte {
+func (x *GetAugmentedBlockHeaderResponseEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type TxReceiptResponse struct {
+func (x *GetAugmentedBlockHeaderResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetAugmentedBlockHeaderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header  *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Receipt *TxReceipt      `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Header      *ResponseHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BlockHeader *AugmentedBlockHeader `protobuf:"bytes,2,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
 }
 
-func (x *TxReceiptResponse) Reset() {
-	*x = TxReceiptResponse{}
+func (x *GetAugmentedBlockHeaderResponse) Reset() {
+	*x = GetAugmentedBlockHeaderResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2413,13 +3167,13 @@ func (x *TxReceiptResponse) Reset() {
 	}
 }
 
-func (x *TxReceiptResponse) String() string {
+func (x *GetAugmentedBlockHeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxReceiptResponse) ProtoMessage() {}
+func (*GetAugmentedBlockHeaderResponse) ProtoMessage() {}
 
-func (x *TxReceiptResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAugmentedBlockHeaderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2431,36 +3185,38 @@ func (x *TxReceiptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxReceiptResponse.ProtoReflect.Descriptor instead.
-func (*TxReceiptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAugmentedBlockHeaderResponse.ProtoReflect.Descriptor instead.
+func (*GetAugmentedBlockHeaderResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *TxReceiptResponse) GetHeader() *ResponseHeader {
+func (x *GetAugmentedBlockHeaderResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *TxReceiptResponse) GetReceipt() *TxReceipt {
+func (x *GetAugmentedBlockHeaderResponse) GetBlockHeader() *AugmentedBlockHeader {
 	if x != nil {
-		return x.Receipt
+		return x.BlockHeader
 	}
 	return nil
 }
 
-type DataQueryResponseEnvelope struct {
+// GetLedgerPath
+type GetLedgerPathResponseEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response  *DataQueryResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Response      *GetLedgerPathResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                 `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-func (x *DataQueryResponseEnvelope) Reset() {
-	*x = DataQueryResponseEnvelope{}
+func (x *GetLedgerPathResponseEnvelope) Reset() {
+	*x = GetLedgerPathResponseEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2468,13 +3224,13 @@ func (x *DataQueryResponseEnvelope) Reset() {
 	}
 }
 
-func (x *DataQueryResponseEnvelope) String() string {
+func (x *GetLedgerPathResponseEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataQueryResponseEnvelope) ProtoMessage() {}
+func (*GetLedgerPathResponseEnvelope) ProtoMessage() {}
 
-func (x *DataQueryResponseEnvelope) ProtoReflect() protoreflect.Message {
+func (x *GetLedgerPathResponseEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2486,36 +3242,43 @@ func (x *DataQueryResponseEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataQueryResponseEnvelope.ProtoReflect.Descriptor instead.
-func (*DataQueryResponseEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLedgerPathResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLedgerPathResponseEnvelope) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DataQueryResponseEnvelope) GetResponse() *DataQueryResponse {
+func (x *GetLedgerPathResponseEnvelope) GetResponse() *GetLedgerPathResponse {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-func (x *DataQueryResponseEnvelope) GetSignature() []byte {
+func (x *GetLedgerPathResponseEnvelope) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-type DataQueryResponse struct {
+func (x *GetLedgerPathResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetLedgerPathResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	KVs    []*KVWithMetadata `protobuf:"bytes,2,rep,name=KVs,proto3" json:"KVs,omitempty"`
+	Header       *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BlockHeaders []*BlockHeader  `protobuf:"bytes,2,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
 }
 
-func (x *DataQueryResponse) Reset() {
-	*x = DataQueryResponse{}
+func (x *GetLedgerPathResponse) Reset() {
+	*x = GetLedgerPathResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_response_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,13 +3286,13 @@ func (x *DataQueryResponse) Reset() {
 	}
 }
 
-func (x *DataQueryResponse) String() string {
+func (x *GetLedgerPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataQueryResponse) ProtoMessage() {}
+func (*GetLedgerPathResponse) ProtoMessage() {}
 
-func (x *DataQueryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLedgerPathResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_response_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2541,545 +3304,7879 @@ func (x *DataQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataQueryResponse.ProtoReflect.Descriptor instead.
-func (*DataQueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLedgerPathResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerPathResponse) Descriptor() ([]byte, []int) {
 	return file_response_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DataQueryResponse) GetHeader() *ResponseHeader {
+func (x *GetLedgerPathResponse) GetHeader() *ResponseHeader {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *DataQueryResponse) GetKVs() []*KVWithMetadata {
+func (x *GetLedgerPathResponse) GetBlockHeaders() []*BlockHeader {
 	if x != nil {
-		return x.KVs
+		return x.BlockHeaders
 	}
 	return nil
 }
 
-var File_response_proto protoreflect.FileDescriptor
+// GetTxProof
+type GetTxProofResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_response_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x29, 0x0a, 0x0e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5a, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0x71, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44,
-	0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
-	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x6b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x83, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x75, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
-	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xbb, 0x01,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x03, 0x4b, 0x56, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x56, 0x57, 0x69, 0x74,
-	0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x03, 0x4b, 0x56, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e,
-	0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x6b, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
-	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x19, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
-	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x2c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x77, 0x0a, 0x1d, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0x7a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0b, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x79, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
-	0x65, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5d, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x7d, 0x0a, 0x20, 0x47, 0x65, 0x74,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3b, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x6d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x78, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x22, 0x8b, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x90,
-	0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x22, 0x77, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
-	0x70, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7f, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x37, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x71, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5b,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x74, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x50, 0x54, 0x72, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x45, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x12, 0x4d, 0x50, 0x54, 0x72,
-	0x69, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
-	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
-	0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
-	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0xc6, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65,
-	0x61, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42,
-	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x1a, 0x39,
-	0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x79, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x4b,
-	0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x1a, 0x3c, 0x0a, 0x0e, 0x57,
-	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x21, 0x47, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3c,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x0a, 0x0f, 0x4b, 0x56,
-	0x73, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a,
-	0x03, 0x4b, 0x56, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x4b, 0x56, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x03, 0x4b, 0x56, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x0b, 0x44, 0x42, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x42, 0x4b, 0x65, 0x79,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x44, 0x42, 0x4b,
-	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x56, 0x0a, 0x10, 0x44, 0x42, 0x4b, 0x65,
-	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x56, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x83, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x74, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x62, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49,
-	0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x49, 0x44, 0x73, 0x22, 0x6f, 0x0a, 0x19, 0x54, 0x78,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
-	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6e, 0x0a, 0x11, 0x54,
-	0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x2a, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x6f, 0x0a, 0x19, 0x44,
-	0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6b, 0x0a, 0x11,
-	0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x27, 0x0a, 0x03, 0x4b, 0x56, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x56, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x03, 0x4b, 0x56, 0x73, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f, 0x6e, 0x2d, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Response      *GetTxProofResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte              `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
 }
 
-var (
-	file_response_proto_rawDescOnce sync.Once
-	file_response_proto_rawDescData = file_response_proto_rawDesc
-)
+func (x *GetTxProofResponseEnvelope) Reset() {
+	*x = GetTxProofResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_response_proto_rawDescGZIP() []byte {
-	file_response_proto_rawDescOnce.Do(func() {
-		file_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_proto_rawDescData)
-	})
-	return file_response_proto_rawDescData
+func (x *GetTxProofResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_response_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
-var file_response_proto_goTypes = []interface{}{
-	(*ResponseHeader)(nil),                          // 0: types.ResponseHeader
-	(*GetDBStatusResponseEnvelope)(nil),             // 1: types.GetDBStatusResponseEnvelope
-	(*GetDBStatusResponse)(nil),                     // 2: types.GetDBStatusResponse
-	(*GetDBIndexResponseEnvelope)(nil),              // 3: types.GetDBIndexResponseEnvelope
-	(*GetDBIndexResponse)(nil),                      // 4: types.GetDBIndexResponse
-	(*GetDataResponseEnvelope)(nil),                 // 5: types.GetDataResponseEnvelope
-	(*GetDataResponse)(nil),                         // 6: types.GetDataResponse
-	(*GetDataRangeResponseEnvelope)(nil),            // 7: types.GetDataRangeResponseEnvelope
-	(*GetDataRangeResponse)(nil),                    // 8: types.GetDataRangeResponse
-	(*GetUserResponseEnvelope)(nil),                 // 9: types.GetUserResponseEnvelope
-	(*GetUserResponse)(nil),                         // 10: types.GetUserResponse
-	(*GetConfigResponseEnvelope)(nil),               // 11: types.GetConfigResponseEnvelope
-	(*GetConfigResponse)(nil),                       // 12: types.GetConfigResponse
-	(*GetNodeConfigResponseEnvelope)(nil),           // 13: types.GetNodeConfigResponseEnvelope
-	(*GetNodeConfigResponse)(nil),                   // 14: types.GetNodeConfigResponse
-	(*GetConfigBlockResponseEnvelope)(nil),          // 15: types.GetConfigBlockResponseEnvelope
-	(*GetConfigBlockResponse)(nil),                  // 16: types.GetConfigBlockResponse
-	(*GetClusterStatusResponseEnvelope)(nil),        // 17: types.GetClusterStatusResponseEnvelope
-	(*GetClusterStatusResponse)(nil),                // 18: types.GetClusterStatusResponse
-	(*GetBlockResponseEnvelope)(nil),                // 19: types.GetBlockResponseEnvelope
-	(*GetBlockResponse)(nil),                        // 20: types.GetBlockResponse
-	(*GetAugmentedBlockHeaderResponseEnvelope)(nil), // 21: types.GetAugmentedBlockHeaderResponseEnvelope
-	(*GetAugmentedBlockHeaderResponse)(nil),         // 22: types.GetAugmentedBlockHeaderResponse
-	(*GetLedgerPathResponseEnvelope)(nil),           // 23: types.GetLedgerPathResponseEnvelope
-	(*GetLedgerPathResponse)(nil),                   // 24: types.GetLedgerPathResponse
-	(*GetTxProofResponseEnvelope)(nil),              // 25: types.GetTxProofResponseEnvelope
-	(*GetTxProofResponse)(nil),                      // 26: types.GetTxProofResponse
-	(*GetDataProofResponseEnvelope)(nil),            // 27: types.GetDataProofResponseEnvelope
-	(*GetDataProofResponse)(nil),                    // 28: types.GetDataProofResponse
-	(*MPTrieProofElement)(nil),                      // 29: types.MPTrieProofElement
-	(*GetHistoricalDataResponseEnvelope)(nil),       // 30: types.GetHistoricalDataResponseEnvelope
-	(*GetHistoricalDataResponse)(nil),               // 31: types.GetHistoricalDataResponse
-	(*GetDataReadersResponseEnvelope)(nil),          // 32: types.GetDataReadersResponseEnvelope
-	(*GetDataReadersResponse)(nil),                  // 33: types.GetDataReadersResponse
-	(*GetDataWritersResponseEnvelope)(nil),          // 34: types.GetDataWritersResponseEnvelope
-	(*GetDataWritersResponse)(nil),                  // 35: types.GetDataWritersResponse
-	(*GetDataProvenanceResponseEnvelope)(nil),       // 36: types.GetDataProvenanceResponseEnvelope
-	(*KVsWithMetadata)(nil),                         // 37: types.KVsWithMetadata
-	(*GetDataProvenanceResponse)(nil),               // 38: types.GetDataProvenanceResponse
-	(*GetTxIDsSubmittedByResponseEnvelope)(nil),     // 39: types.GetTxIDsSubmittedByResponseEnvelope
-	(*GetTxIDsSubmittedByResponse)(nil),             // 40: types.GetTxIDsSubmittedByResponse
-	(*TxReceiptResponseEnvelope)(nil),               // 41: types.TxReceiptResponseEnvelope
-	(*TxReceiptResponse)(nil),                       // 42: types.TxReceiptResponse
-	(*DataQueryResponseEnvelope)(nil),               // 43: types.DataQueryResponseEnvelope
-	(*DataQueryResponse)(nil),                       // 44: types.DataQueryResponse
-	nil,                                             // 45: types.GetDataReadersResponse.ReadByEntry
-	nil,                                             // 46: types.GetDataWritersResponse.WrittenByEntry
-	nil,                                             // 47: types.GetDataProvenanceResponse.DBKeyValuesEntry
-	(*Metadata)(nil),                                // 48: types.Metadata
-	(*KVWithMetadata)(nil),                          // 49: types.KVWithMetadata
-	(*User)(nil),                                    // 50: types.User
-	(*ClusterConfig)(nil),                           // 51: types.ClusterConfig
-	(*NodeConfig)(nil),                              // 52: types.NodeConfig
-	(*Version)(nil),                                 // 53: types.Version
-	(*BlockHeader)(nil),                             // 54: types.BlockHeader
-	(*AugmentedBlockHeader)(nil),                    // 55: types.AugmentedBlockHeader
-	(*ValueWithMetadata)(nil),                       // 56: types.ValueWithMetadata
-	(*TxReceipt)(nil),                               // 57: types.TxReceipt
+func (*GetTxProofResponseEnvelope) ProtoMessage() {}
+
+func (x *GetTxProofResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_response_proto_depIdxs = []int32{
-	2,  // 0: types.GetDBStatusResponseEnvelope.response:type_name -> types.GetDBStatusResponse
-	0,  // 1: types.GetDBStatusResponse.header:type_name -> types.ResponseHeader
-	4,  // 2: types.GetDBIndexResponseEnvelope.response:type_name -> types.GetDBIndexResponse
-	0,  // 3: types.GetDBIndexResponse.header:type_name -> types.ResponseHeader
-	6,  // 4: types.GetDataResponseEnvelope.response:type_name -> types.GetDataResponse
-	0,  // 5: types.GetDataResponse.header:type_name -> types.ResponseHeader
-	48, // 6: types.GetDataResponse.metadata:type_name -> types.Metadata
-	8,  // 7: types.GetDataRangeResponseEnvelope.response:type_name -> types.GetDataRangeResponse
-	0,  // 8: types.GetDataRangeResponse.header:type_name -> types.ResponseHeader
-	49, // 9: types.GetDataRangeResponse.KVs:type_name -> types.KVWithMetadata
-	10, // 10: types.GetUserResponseEnvelope.response:type_name -> types.GetUserResponse
-	0,  // 11: types.GetUserResponse.header:type_name -> types.ResponseHeader
-	50, // 12: types.GetUserResponse.user:type_name -> types.User
-	48, // 13: types.GetUserResponse.metadata:type_name -> types.Metadata
-	12, // 14: types.GetConfigResponseEnvelope.response:type_name -> types.GetConfigResponse
-	0,  // 15: types.GetConfigResponse.header:type_name -> types.ResponseHeader
-	51, // 16: types.GetConfigResponse.config:type_name -> types.ClusterConfig
-	48, // 17: types.GetConfigResponse.metadata:type_name -> types.Metadata
-	14, // 18: types.GetNodeConfigResponseEnvelope.response:type_name -> types.GetNodeConfigResponse
-	0,  // 19: types.GetNodeConfigResponse.header:type_name -> types.ResponseHeader
-	52, // 20: types.GetNodeConfigResponse.node_config:type_name -> types.NodeConfig
-	16, // 21: types.GetConfigBlockResponseEnvelope.response:type_name -> types.GetConfigBlockResponse
-	0,  // 22: types.GetConfigBlockResponse.header:type_name -> types.ResponseHeader
-	18, // 23: types.GetClusterStatusResponseEnvelope.response:type_name -> types.GetClusterStatusResponse
-	0,  // 24: types.GetClusterStatusResponse.header:type_name -> types.ResponseHeader
-	52, // 25: types.GetClusterStatusResponse.nodes:type_name -> types.NodeConfig
-	53, // 26: types.GetClusterStatusResponse.version:type_name -> types.Version
-	20, // 27: types.GetBlockResponseEnvelope.response:type_name -> types.GetBlockResponse
-	0,  // 28: types.GetBlockResponse.header:type_name -> types.ResponseHeader
-	54, // 29: types.GetBlockResponse.block_header:type_name -> types.BlockHeader
-	22, // 30: types.GetAugmentedBlockHeaderResponseEnvelope.response:type_name -> types.GetAugmentedBlockHeaderResponse
-	0,  // 31: types.GetAugmentedBlockHeaderResponse.header:type_name -> types.ResponseHeader
-	55, // 32: types.GetAugmentedBlockHeaderResponse.block_header:type_name -> types.AugmentedBlockHeader
-	24, // 33: types.GetLedgerPathResponseEnvelope.response:type_name -> types.GetLedgerPathResponse
-	0,  // 34: types.GetLedgerPathResponse.header:type_name -> types.ResponseHeader
-	54, // 35: types.GetLedgerPathResponse.block_headers:type_name -> types.BlockHeader
-	26, // 36: types.GetTxProofResponseEnvelope.response:type_name -> types.GetTxProofResponse
-	0,  // 37: types.GetTxProofResponse.header:type_name -> types.ResponseHeader
-	28, // 38: types.GetDataProofResponseEnvelope.response:type_name -> types.GetDataProofResponse
-	0,  // 39: types.GetDataProofResponse.header:type_name -> types.ResponseHeader
-	29, // 40: types.GetDataProofResponse.path:type_name -> types.MPTrieProofElement
-	31, // 41: types.GetHistoricalDataResponseEnvelope.response:type_name -> types.GetHistoricalDataResponse
-	0,  // 42: types.GetHistoricalDataResponse.header:type_name -> types.ResponseHeader
-	56, // 43: types.GetHistoricalDataResponse.values:type_name -> types.ValueWithMetadata
-	33, // 44: types.GetDataReadersResponseEnvelope.response:type_name -> types.GetDataReadersResponse
-	0,  // 45: types.GetDataReadersResponse.header:type_name -> types.ResponseHeader
-	45, // 46: types.GetDataReadersResponse.read_by:type_name -> types.GetDataReadersResponse.ReadByEntry
-	35, // 47: types.GetDataWritersResponseEnvelope.response:type_name -> types.GetDataWritersResponse
-	0,  // 48: types.GetDataWritersResponse.header:type_name -> types.ResponseHeader
-	46, // 49: types.GetDataWritersResponse.written_by:type_name -> types.GetDataWritersResponse.WrittenByEntry
-	38, // 50: types.GetDataProvenanceResponseEnvelope.response:type_name -> types.GetDataProvenanceResponse
-	49, // 51: types.KVsWithMetadata.KVs:type_name -> types.KVWithMetadata
-	0,  // 52: types.GetDataProvenanceResponse.header:type_name -> types.ResponseHeader
-	47, // 53: types.GetDataProvenanceResponse.DBKeyValues:type_name -> types.GetDataProvenanceResponse.DBKeyValuesEntry
-	40, // 54: types.GetTxIDsSubmittedByResponseEnvelope.response:type_name -> types.GetTxIDsSubmittedByResponse
-	0,  // 55: types.GetTxIDsSubmittedByResponse.header:type_name -> types.ResponseHeader
-	42, // 56: types.TxReceiptResponseEnvelope.response:type_name -> types.TxReceiptResponse
-	0,  // 57: types.TxReceiptResponse.header:type_name -> types.ResponseHeader
-	57, // 58: types.TxReceiptResponse.receipt:type_name -> types.TxReceipt
-	44, // 59: types.DataQueryResponseEnvelope.response:type_name -> types.DataQueryResponse
-	0,  // 60: types.DataQueryResponse.header:type_name -> types.ResponseHeader
-	49, // 61: types.DataQueryResponse.KVs:type_name -> types.KVWithMetadata
-	37, // 62: types.GetDataProvenanceResponse.DBKeyValuesEntry.value:type_name -> types.KVsWithMetadata
-	63, // [63:63] is the sub-list for method output_type
-	63, // [63:63] is the sub-list for method input_type
-	63, // [63:63] is the sub-list for extension type_name
-	63, // [63:63] is the sub-list for extension extendee
-	0,  // [0:63] is the sub-list for field type_name
+
+// Deprecated: Use GetTxProofResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTxProofResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{45}
 }
 
-func init() { file_response_proto_init() }
-func file_response_proto_init() {
-	if File_response_proto != nil {
-		return
+func (x *GetTxProofResponseEnvelope) GetResponse() *GetTxProofResponse {
+	if x != nil {
+		return x.Response
 	}
-	file_configuration_proto_init()
-	file_block_and_transaction_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseHeader); i {
+	return nil
+}
+
+func (x *GetTxProofResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetTxProofResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetTxProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Hashes [][]byte        `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// conflicting_reads is set when the transaction was invalidated due to an
+	// mvcc conflict.
+	ConflictingReads []*ConflictingRead `protobuf:"bytes,3,rep,name=conflicting_reads,json=conflictingReads,proto3" json:"conflicting_reads,omitempty"`
+}
+
+func (x *GetTxProofResponse) Reset() {
+	*x = GetTxProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxProofResponse) ProtoMessage() {}
+
+func (x *GetTxProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxProofResponse.ProtoReflect.Descriptor instead.
+func (*GetTxProofResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetTxProofResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetTxProofResponse) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *GetTxProofResponse) GetConflictingReads() []*ConflictingRead {
+	if x != nil {
+		return x.ConflictingReads
+	}
+	return nil
+}
+
+// GetDataProof
+type GetDataProofResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDataProofResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDataProofResponseEnvelope) Reset() {
+	*x = GetDataProofResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataProofResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataProofResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDataProofResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataProofResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataProofResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetDataProofResponseEnvelope) GetResponse() *GetDataProofResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDataProofResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDataProofResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDataProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Path   []*MPTrieProofElement `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	// non_inclusion_path is set instead of path when the key is absent from the state as of the block, i.e., when it
+	// was never written or it was deleted, and the proof of a value, not of a delete, is queried. It runs from the node
+	// where the lookup of the key terminates up to the root of the state trie.
+	NonInclusionPath []*MPTrieProofElement `protobuf:"bytes,3,rep,name=non_inclusion_path,json=nonInclusionPath,proto3" json:"non_inclusion_path,omitempty"`
+}
+
+func (x *GetDataProofResponse) Reset() {
+	*x = GetDataProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataProofResponse) ProtoMessage() {}
+
+func (x *GetDataProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataProofResponse.ProtoReflect.Descriptor instead.
+func (*GetDataProofResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetDataProofResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDataProofResponse) GetPath() []*MPTrieProofElement {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *GetDataProofResponse) GetNonInclusionPath() []*MPTrieProofElement {
+	if x != nil {
+		return x.NonInclusionPath
+	}
+	return nil
+}
+
+type MPTrieProofElement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *MPTrieProofElement) Reset() {
+	*x = MPTrieProofElement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MPTrieProofElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MPTrieProofElement) ProtoMessage() {}
+
+func (x *MPTrieProofElement) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MPTrieProofElement.ProtoReflect.Descriptor instead.
+func (*MPTrieProofElement) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MPTrieProofElement) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// GetHistoricalData
+type GetHistoricalDataResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetHistoricalDataResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                     `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetHistoricalDataResponseEnvelope) Reset() {
+	*x = GetHistoricalDataResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHistoricalDataResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricalDataResponseEnvelope) ProtoMessage() {}
+
+func (x *GetHistoricalDataResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricalDataResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetHistoricalDataResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetHistoricalDataResponseEnvelope) GetResponse() *GetHistoricalDataResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetHistoricalDataResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetHistoricalDataResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetHistoricalDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Values []*ValueWithMetadata `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// The cursor of the next page, set if the limit of the query cut the values short. The values of a page are
+	// ordered by their version.
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetHistoricalDataResponse) Reset() {
+	*x = GetHistoricalDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHistoricalDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricalDataResponse) ProtoMessage() {}
+
+func (x *GetHistoricalDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricalDataResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricalDataResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetHistoricalDataResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetHistoricalDataResponse) GetValues() []*ValueWithMetadata {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *GetHistoricalDataResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// GetDataByVersion
+type GetDataByVersionResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDataByVersionResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                    `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDataByVersionResponseEnvelope) Reset() {
+	*x = GetDataByVersionResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataByVersionResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataByVersionResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDataByVersionResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataByVersionResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataByVersionResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetDataByVersionResponseEnvelope) GetResponse() *GetDataByVersionResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDataByVersionResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDataByVersionResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDataByVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Value  *ValueWithMetadata `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// tx_id is the id of the transaction which wrote the value
+	TxId string `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// is_live denotes that the value is the one currently held by the key, i.e., the key was neither
+	// updated nor deleted after the value was written
+	IsLive bool `protobuf:"varint,4,opt,name=is_live,json=isLive,proto3" json:"is_live,omitempty"`
+}
+
+func (x *GetDataByVersionResponse) Reset() {
+	*x = GetDataByVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataByVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataByVersionResponse) ProtoMessage() {}
+
+func (x *GetDataByVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataByVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetDataByVersionResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetDataByVersionResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDataByVersionResponse) GetValue() *ValueWithMetadata {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetDataByVersionResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *GetDataByVersionResponse) GetIsLive() bool {
+	if x != nil {
+		return x.IsLive
+	}
+	return false
+}
+
+// GetDataReaders
+type GetDataReadersResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDataReadersResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                  `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDataReadersResponseEnvelope) Reset() {
+	*x = GetDataReadersResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataReadersResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataReadersResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDataReadersResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataReadersResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataReadersResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetDataReadersResponseEnvelope) GetResponse() *GetDataReadersResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDataReadersResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDataReadersResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDataReadersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ReadBy map[string]uint32 `protobuf:"bytes,2,rep,name=read_by,json=readBy,proto3" json:"read_by,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetDataReadersResponse) Reset() {
+	*x = GetDataReadersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataReadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataReadersResponse) ProtoMessage() {}
+
+func (x *GetDataReadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataReadersResponse.ProtoReflect.Descriptor instead.
+func (*GetDataReadersResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetDataReadersResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDataReadersResponse) GetReadBy() map[string]uint32 {
+	if x != nil {
+		return x.ReadBy
+	}
+	return nil
+}
+
+// GetDataWriters
+type GetDataWritersResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDataWritersResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                  `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDataWritersResponseEnvelope) Reset() {
+	*x = GetDataWritersResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataWritersResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataWritersResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDataWritersResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataWritersResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataWritersResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetDataWritersResponseEnvelope) GetResponse() *GetDataWritersResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDataWritersResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDataWritersResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDataWritersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	WrittenBy map[string]uint32 `protobuf:"bytes,2,rep,name=written_by,json=writtenBy,proto3" json:"written_by,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetDataWritersResponse) Reset() {
+	*x = GetDataWritersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataWritersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataWritersResponse) ProtoMessage() {}
+
+func (x *GetDataWritersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataWritersResponse.ProtoReflect.Descriptor instead.
+func (*GetDataWritersResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDataWritersResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDataWritersResponse) GetWrittenBy() map[string]uint32 {
+	if x != nil {
+		return x.WrittenBy
+	}
+	return nil
+}
+
+// GetDataProvenance
+type GetDataProvenanceResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDataProvenanceResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                     `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDataProvenanceResponseEnvelope) Reset() {
+	*x = GetDataProvenanceResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataProvenanceResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataProvenanceResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDataProvenanceResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataProvenanceResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataProvenanceResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetDataProvenanceResponseEnvelope) GetResponse() *GetDataProvenanceResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDataProvenanceResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDataProvenanceResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type KVsWithMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KVs []*KVWithMetadata `protobuf:"bytes,1,rep,name=KVs,proto3" json:"KVs,omitempty"`
+}
+
+func (x *KVsWithMetadata) Reset() {
+	*x = KVsWithMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVsWithMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVsWithMetadata) ProtoMessage() {}
+
+func (x *KVsWithMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVsWithMetadata.ProtoReflect.Descriptor instead.
+func (*KVsWithMetadata) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *KVsWithMetadata) GetKVs() []*KVWithMetadata {
+	if x != nil {
+		return x.KVs
+	}
+	return nil
+}
+
+type GetDataProvenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *ResponseHeader             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DBKeyValues map[string]*KVsWithMetadata `protobuf:"bytes,2,rep,name=DBKeyValues,proto3" json:"DBKeyValues,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetDataProvenanceResponse) Reset() {
+	*x = GetDataProvenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataProvenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataProvenanceResponse) ProtoMessage() {}
+
+func (x *GetDataProvenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataProvenanceResponse.ProtoReflect.Descriptor instead.
+func (*GetDataProvenanceResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDataProvenanceResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDataProvenanceResponse) GetDBKeyValues() map[string]*KVsWithMetadata {
+	if x != nil {
+		return x.DBKeyValues
+	}
+	return nil
+}
+
+// GetTxIDsSubmittedBy
+type GetTxIDsSubmittedByResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetTxIDsSubmittedByResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                       `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetTxIDsSubmittedByResponseEnvelope) Reset() {
+	*x = GetTxIDsSubmittedByResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxIDsSubmittedByResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxIDsSubmittedByResponseEnvelope) ProtoMessage() {}
+
+func (x *GetTxIDsSubmittedByResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxIDsSubmittedByResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTxIDsSubmittedByResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTxIDsSubmittedByResponseEnvelope) GetResponse() *GetTxIDsSubmittedByResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetTxIDsSubmittedByResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetTxIDsSubmittedByResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetTxIDsSubmittedByResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TxIDs  []string        `protobuf:"bytes,2,rep,name=txIDs,proto3" json:"txIDs,omitempty"`
+}
+
+func (x *GetTxIDsSubmittedByResponse) Reset() {
+	*x = GetTxIDsSubmittedByResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxIDsSubmittedByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxIDsSubmittedByResponse) ProtoMessage() {}
+
+func (x *GetTxIDsSubmittedByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxIDsSubmittedByResponse.ProtoReflect.Descriptor instead.
+func (*GetTxIDsSubmittedByResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTxIDsSubmittedByResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetTxIDsSubmittedByResponse) GetTxIDs() []string {
+	if x != nil {
+		return x.TxIDs
+	}
+	return nil
+}
+
+type TxReceiptResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *TxReceiptResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte             `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *TxReceiptResponseEnvelope) Reset() {
+	*x = TxReceiptResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxReceiptResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxReceiptResponseEnvelope) ProtoMessage() {}
+
+func (x *TxReceiptResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxReceiptResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*TxReceiptResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TxReceiptResponseEnvelope) GetResponse() *TxReceiptResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TxReceiptResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *TxReceiptResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type TxReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header  *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Receipt *TxReceipt      `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// expired is set, with no receipt, for a transaction that was dropped before it was batched, as its deadline had
+	// passed. Such a transaction never commits.
+	Expired bool `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	// rejection is set, with no receipt, for a transaction that was dropped before it was included in a block, as the
+	// node drops the batches whose transactions are all invalid, and the transaction was found invalid along with the
+	// rest of its batch. It holds the flag and the reason of the rejection. Such a transaction never commits, whereas an
+	// invalid transaction of a committed block has a receipt whose block header holds the same flag and reason.
+	Rejection *ValidationInfo `protobuf:"bytes,4,opt,name=rejection,proto3" json:"rejection,omitempty"`
+}
+
+func (x *TxReceiptResponse) Reset() {
+	*x = TxReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxReceiptResponse) ProtoMessage() {}
+
+func (x *TxReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxReceiptResponse.ProtoReflect.Descriptor instead.
+func (*TxReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TxReceiptResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TxReceiptResponse) GetReceipt() *TxReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *TxReceiptResponse) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *TxReceiptResponse) GetRejection() *ValidationInfo {
+	if x != nil {
+		return x.Rejection
+	}
+	return nil
+}
+
+type GetDroppedTxResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDroppedTxResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDroppedTxResponseEnvelope) Reset() {
+	*x = GetDroppedTxResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDroppedTxResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetDroppedTxResponseEnvelope) GetResponse() *GetDroppedTxResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDroppedTxResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDroppedTxResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDroppedTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DroppedTx *DroppedTx      `protobuf:"bytes,2,opt,name=dropped_tx,json=droppedTx,proto3" json:"dropped_tx,omitempty"`
+}
+
+func (x *GetDroppedTxResponse) Reset() {
+	*x = GetDroppedTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxResponse) ProtoMessage() {}
+
+func (x *GetDroppedTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxResponse.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetDroppedTxResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDroppedTxResponse) GetDroppedTx() *DroppedTx {
+	if x != nil {
+		return x.DroppedTx
+	}
+	return nil
+}
+
+type GetDroppedTxsResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDroppedTxsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                 `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDroppedTxsResponseEnvelope) Reset() {
+	*x = GetDroppedTxsResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxsResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxsResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDroppedTxsResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxsResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxsResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetDroppedTxsResponseEnvelope) GetResponse() *GetDroppedTxsResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDroppedTxsResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDroppedTxsResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDroppedTxsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header     *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DroppedTxs []*DroppedTx    `protobuf:"bytes,2,rep,name=dropped_txs,json=droppedTxs,proto3" json:"dropped_txs,omitempty"`
+	// more is set if the limit of the query cut the listing short. The next page starts at the drop time of the last
+	// record returned.
+	More bool `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	// The cursor of the next page, set along with more. The cursor is expired once the records it was about to return
+	// are purged.
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetDroppedTxsResponse) Reset() {
+	*x = GetDroppedTxsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDroppedTxsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDroppedTxsResponse) ProtoMessage() {}
+
+func (x *GetDroppedTxsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDroppedTxsResponse.ProtoReflect.Descriptor instead.
+func (*GetDroppedTxsResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetDroppedTxsResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDroppedTxsResponse) GetDroppedTxs() []*DroppedTx {
+	if x != nil {
+		return x.DroppedTxs
+	}
+	return nil
+}
+
+func (x *GetDroppedTxsResponse) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+func (x *GetDroppedTxsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// DroppedTx is the dead-letter record of a transaction that a node accepted but dropped before it was included in a
+// block, e.g., as its deadline passed, or as the node shut down or lost the leadership. Such a transaction never
+// commits, unless it is resubmitted.
+type DroppedTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// The user who submitted the transaction, or the node for a heartbeat.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The stage of the transaction pipeline the transaction had reached when it was dropped.
+	Stage  string `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The time of the drop, in nanoseconds since the Unix epoch.
+	DroppedAt int64 `protobuf:"varint,5,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+}
+
+func (x *DroppedTx) Reset() {
+	*x = DroppedTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DroppedTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DroppedTx) ProtoMessage() {}
+
+func (x *DroppedTx) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DroppedTx.ProtoReflect.Descriptor instead.
+func (*DroppedTx) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DroppedTx) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *DroppedTx) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *DroppedTx) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *DroppedTx) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DroppedTx) GetDroppedAt() int64 {
+	if x != nil {
+		return x.DroppedAt
+	}
+	return 0
+}
+
+type GetAdminAuditRecordsResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetAdminAuditRecordsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                        `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetAdminAuditRecordsResponseEnvelope) Reset() {
+	*x = GetAdminAuditRecordsResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminAuditRecordsResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminAuditRecordsResponseEnvelope) ProtoMessage() {}
+
+func (x *GetAdminAuditRecordsResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminAuditRecordsResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetAdminAuditRecordsResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetAdminAuditRecordsResponseEnvelope) GetResponse() *GetAdminAuditRecordsResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetAdminAuditRecordsResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetAdminAuditRecordsResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetAdminAuditRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header  *ResponseHeader     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Records []*AdminAuditRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	// more is set if the limit of the query cut the listing short. The next page starts after the sequence number of
+	// the last record returned.
+	More bool `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	// The cursor of the next page, set along with more.
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetAdminAuditRecordsResponse) Reset() {
+	*x = GetAdminAuditRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminAuditRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminAuditRecordsResponse) ProtoMessage() {}
+
+func (x *GetAdminAuditRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminAuditRecordsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminAuditRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetAdminAuditRecordsResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetAdminAuditRecordsResponse) GetRecords() []*AdminAuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetAdminAuditRecordsResponse) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+func (x *GetAdminAuditRecordsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// AdminAuditRecord is the record of an administrative action performed on a node via its HTTP endpoints, e.g., a
+// resync or a change of the logging levels, which happen outside the ledger. The records of a node form a chain, as
+// each record holds the hash of the previous one, and each record is signed by the node. The hash of a record is the
+// SHA-256 hash of its deterministic encoding, signature included, and is returned to the admin as a receipt.
+type AdminAuditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The position of the record in the chain, starting from 1.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The hash of the previous record, empty for the first record.
+	PreviousHash []byte `protobuf:"bytes,2,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	// The admin who invoked the endpoint, as claimed by the request. A request whose signature did not verify is
+	// recorded with the status code of its rejection.
+	UserId   string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Method   string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Endpoint string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The query parameters and the body of the request.
+	Parameters string `protobuf:"bytes,6,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Body       []byte `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	// The result of the action: the status code of the response and the SHA-256 hash of its body.
+	StatusCode     int32  `protobuf:"varint,8,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	ResponseDigest []byte `protobuf:"bytes,9,opt,name=response_digest,json=responseDigest,proto3" json:"response_digest,omitempty"`
+	// The time of the action, in nanoseconds since the Unix epoch.
+	Timestamp int64  `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	NodeId    string `protobuf:"bytes,11,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// The signature of the node over the deterministic encoding of the record without the signature.
+	NodeSignature []byte `protobuf:"bytes,12,opt,name=node_signature,json=nodeSignature,proto3" json:"node_signature,omitempty"`
+}
+
+func (x *AdminAuditRecord) Reset() {
+	*x = AdminAuditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAuditRecord) ProtoMessage() {}
+
+func (x *AdminAuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAuditRecord.ProtoReflect.Descriptor instead.
+func (*AdminAuditRecord) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AdminAuditRecord) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AdminAuditRecord) GetPreviousHash() []byte {
+	if x != nil {
+		return x.PreviousHash
+	}
+	return nil
+}
+
+func (x *AdminAuditRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminAuditRecord) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AdminAuditRecord) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *AdminAuditRecord) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+func (x *AdminAuditRecord) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *AdminAuditRecord) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *AdminAuditRecord) GetResponseDigest() []byte {
+	if x != nil {
+		return x.ResponseDigest
+	}
+	return nil
+}
+
+func (x *AdminAuditRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AdminAuditRecord) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AdminAuditRecord) GetNodeSignature() []byte {
+	if x != nil {
+		return x.NodeSignature
+	}
+	return nil
+}
+
+type GetLedgerRollupsResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetLedgerRollupsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                    `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetLedgerRollupsResponseEnvelope) Reset() {
+	*x = GetLedgerRollupsResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerRollupsResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerRollupsResponseEnvelope) ProtoMessage() {}
+
+func (x *GetLedgerRollupsResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerRollupsResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLedgerRollupsResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetLedgerRollupsResponseEnvelope) GetResponse() *GetLedgerRollupsResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetLedgerRollupsResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetLedgerRollupsResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetLedgerRollupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The rollups of the days of the query on which a block was committed, in ascending order.
+	Rollups []*LedgerDailyRollup `protobuf:"bytes,2,rep,name=rollups,proto3" json:"rollups,omitempty"`
+	// The number of the last block aggregated into the rollups. It lags behind the height of the ledger while the
+	// rollups of the blocks already committed are being derived.
+	RollupHeight uint64 `protobuf:"varint,3,opt,name=rollup_height,json=rollupHeight,proto3" json:"rollup_height,omitempty"`
+}
+
+func (x *GetLedgerRollupsResponse) Reset() {
+	*x = GetLedgerRollupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerRollupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerRollupsResponse) ProtoMessage() {}
+
+func (x *GetLedgerRollupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerRollupsResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerRollupsResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetLedgerRollupsResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetLedgerRollupsResponse) GetRollups() []*LedgerDailyRollup {
+	if x != nil {
+		return x.Rollups
+	}
+	return nil
+}
+
+func (x *GetLedgerRollupsResponse) GetRollupHeight() uint64 {
+	if x != nil {
+		return x.RollupHeight
+	}
+	return 0
+}
+
+// LedgerDailyRollup aggregates the blocks whose timestamps, which are set by the node that produced them, fall on a
+// day, in UTC. The blocks produced before the timestamp was introduced fall on no day.
+type LedgerDailyRollup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The day, as YYYY-MM-DD.
+	Date   string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// The transactions of the blocks, valid or not, except for the heartbeats.
+	Transactions      uint64 `protobuf:"varint,3,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	ValidTransactions uint64 `protobuf:"varint,4,opt,name=valid_transactions,json=validTransactions,proto3" json:"valid_transactions,omitempty"`
+	// The distinct users who signed a transaction of the blocks.
+	ActiveUsers uint64 `protobuf:"varint,5,opt,name=active_users,json=activeUsers,proto3" json:"active_users,omitempty"`
+	// The sizes of the keys and the values written by the valid data transactions of the blocks.
+	BytesWritten uint64 `protobuf:"varint,6,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	FirstBlock   uint64 `protobuf:"varint,7,opt,name=first_block,json=firstBlock,proto3" json:"first_block,omitempty"`
+	LastBlock    uint64 `protobuf:"varint,8,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+}
+
+func (x *LedgerDailyRollup) Reset() {
+	*x = LedgerDailyRollup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerDailyRollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerDailyRollup) ProtoMessage() {}
+
+func (x *LedgerDailyRollup) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerDailyRollup.ProtoReflect.Descriptor instead.
+func (*LedgerDailyRollup) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *LedgerDailyRollup) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LedgerDailyRollup) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *LedgerDailyRollup) GetTransactions() uint64 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *LedgerDailyRollup) GetValidTransactions() uint64 {
+	if x != nil {
+		return x.ValidTransactions
+	}
+	return 0
+}
+
+func (x *LedgerDailyRollup) GetActiveUsers() uint64 {
+	if x != nil {
+		return x.ActiveUsers
+	}
+	return 0
+}
+
+func (x *LedgerDailyRollup) GetBytesWritten() uint64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *LedgerDailyRollup) GetFirstBlock() uint64 {
+	if x != nil {
+		return x.FirstBlock
+	}
+	return 0
+}
+
+func (x *LedgerDailyRollup) GetLastBlock() uint64 {
+	if x != nil {
+		return x.LastBlock
+	}
+	return 0
+}
+
+type GetLedgerUsageResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetLedgerUsageResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                  `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetLedgerUsageResponseEnvelope) Reset() {
+	*x = GetLedgerUsageResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerUsageResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerUsageResponseEnvelope) ProtoMessage() {}
+
+func (x *GetLedgerUsageResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerUsageResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLedgerUsageResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetLedgerUsageResponseEnvelope) GetResponse() *GetLedgerUsageResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetLedgerUsageResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetLedgerUsageResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetLedgerUsageResponse sums the sizes, see TxSizes, of the valid transactions submitted by a user in a range of
+// blocks, as recorded in the receipts of the transactions.
+type GetLedgerUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header           *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TargetUserId     string          `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	StartBlockNumber uint64          `protobuf:"varint,3,opt,name=start_block_number,json=startBlockNumber,proto3" json:"start_block_number,omitempty"`
+	EndBlockNumber   uint64          `protobuf:"varint,4,opt,name=end_block_number,json=endBlockNumber,proto3" json:"end_block_number,omitempty"`
+	Transactions     uint64          `protobuf:"varint,5,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	EnvelopeBytes    uint64          `protobuf:"varint,6,opt,name=envelope_bytes,json=envelopeBytes,proto3" json:"envelope_bytes,omitempty"`
+	WriteBytes       uint64          `protobuf:"varint,7,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	// The transactions whose sizes were not recorded, as they were committed before the sizes were, which add nothing to
+	// the sums.
+	UnsizedTransactions uint64 `protobuf:"varint,8,opt,name=unsized_transactions,json=unsizedTransactions,proto3" json:"unsized_transactions,omitempty"`
+}
+
+func (x *GetLedgerUsageResponse) Reset() {
+	*x = GetLedgerUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerUsageResponse) ProtoMessage() {}
+
+func (x *GetLedgerUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerUsageResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetLedgerUsageResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetLedgerUsageResponse) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *GetLedgerUsageResponse) GetStartBlockNumber() uint64 {
+	if x != nil {
+		return x.StartBlockNumber
+	}
+	return 0
+}
+
+func (x *GetLedgerUsageResponse) GetEndBlockNumber() uint64 {
+	if x != nil {
+		return x.EndBlockNumber
+	}
+	return 0
+}
+
+func (x *GetLedgerUsageResponse) GetTransactions() uint64 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *GetLedgerUsageResponse) GetEnvelopeBytes() uint64 {
+	if x != nil {
+		return x.EnvelopeBytes
+	}
+	return 0
+}
+
+func (x *GetLedgerUsageResponse) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *GetLedgerUsageResponse) GetUnsizedTransactions() uint64 {
+	if x != nil {
+		return x.UnsizedTransactions
+	}
+	return 0
+}
+
+type UserImportResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *UserImportResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte              `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *UserImportResponseEnvelope) Reset() {
+	*x = UserImportResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserImportResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportResponseEnvelope) ProtoMessage() {}
+
+func (x *UserImportResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*UserImportResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UserImportResponseEnvelope) GetResponse() *UserImportResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UserImportResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *UserImportResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type UserImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// tx_ids are the IDs of the transactions submitted for the users that passed the checks of the node.
+	TxIds []string `protobuf:"bytes,2,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	// failures report the users that were not submitted, or whose transaction was invalidated.
+	Failures []*UserImportFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *UserImportResponse) Reset() {
+	*x = UserImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportResponse) ProtoMessage() {}
+
+func (x *UserImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportResponse.ProtoReflect.Descriptor instead.
+func (*UserImportResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UserImportResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *UserImportResponse) GetTxIds() []string {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+func (x *UserImportResponse) GetFailures() []*UserImportFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type UserImportFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// index is the position of the user in the import request.
+	Index  uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UserImportFailure) Reset() {
+	*x = UserImportFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserImportFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportFailure) ProtoMessage() {}
+
+func (x *UserImportFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportFailure.ProtoReflect.Descriptor instead.
+func (*UserImportFailure) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UserImportFailure) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserImportFailure) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UserImportFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetTxWriteSetDigestResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetTxWriteSetDigestResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                       `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetTxWriteSetDigestResponseEnvelope) Reset() {
+	*x = GetTxWriteSetDigestResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxWriteSetDigestResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxWriteSetDigestResponseEnvelope) ProtoMessage() {}
+
+func (x *GetTxWriteSetDigestResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxWriteSetDigestResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTxWriteSetDigestResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetTxWriteSetDigestResponseEnvelope) GetResponse() *GetTxWriteSetDigestResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetTxWriteSetDigestResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetTxWriteSetDigestResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetTxWriteSetDigestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// committed_digest is the digest computed by the committer and stored in the block header
+	CommittedDigest []byte `protobuf:"bytes,2,opt,name=committed_digest,json=committedDigest,proto3" json:"committed_digest,omitempty"`
+	// recomputed_digest is the digest recomputed from the provenance store
+	RecomputedDigest []byte `protobuf:"bytes,3,opt,name=recomputed_digest,json=recomputedDigest,proto3" json:"recomputed_digest,omitempty"`
+	Verified         bool   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *GetTxWriteSetDigestResponse) Reset() {
+	*x = GetTxWriteSetDigestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxWriteSetDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxWriteSetDigestResponse) ProtoMessage() {}
+
+func (x *GetTxWriteSetDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxWriteSetDigestResponse.ProtoReflect.Descriptor instead.
+func (*GetTxWriteSetDigestResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetTxWriteSetDigestResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetTxWriteSetDigestResponse) GetCommittedDigest() []byte {
+	if x != nil {
+		return x.CommittedDigest
+	}
+	return nil
+}
+
+func (x *GetTxWriteSetDigestResponse) GetRecomputedDigest() []byte {
+	if x != nil {
+		return x.RecomputedDigest
+	}
+	return nil
+}
+
+func (x *GetTxWriteSetDigestResponse) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type GetBlockCompositionResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetBlockCompositionResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                       `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetBlockCompositionResponseEnvelope) Reset() {
+	*x = GetBlockCompositionResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockCompositionResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockCompositionResponseEnvelope) ProtoMessage() {}
+
+func (x *GetBlockCompositionResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockCompositionResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetBlockCompositionResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetBlockCompositionResponseEnvelope) GetResponse() *GetBlockCompositionResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetBlockCompositionResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetBlockCompositionResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetBlockCompositionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Composition *BatchComposition `protobuf:"bytes,2,opt,name=composition,proto3" json:"composition,omitempty"`
+}
+
+func (x *GetBlockCompositionResponse) Reset() {
+	*x = GetBlockCompositionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockCompositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockCompositionResponse) ProtoMessage() {}
+
+func (x *GetBlockCompositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockCompositionResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockCompositionResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetBlockCompositionResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetBlockCompositionResponse) GetComposition() *BatchComposition {
+	if x != nil {
+		return x.Composition
+	}
+	return nil
+}
+
+type DataQueryResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *DataQueryResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte             `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *DataQueryResponseEnvelope) Reset() {
+	*x = DataQueryResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataQueryResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataQueryResponseEnvelope) ProtoMessage() {}
+
+func (x *DataQueryResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataQueryResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*DataQueryResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DataQueryResponseEnvelope) GetResponse() *DataQueryResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DataQueryResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *DataQueryResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type DataQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	KVs    []*KVWithMetadata `protobuf:"bytes,2,rep,name=KVs,proto3" json:"KVs,omitempty"`
+	// the attributes of a conjunctive query whose conditions were verified against the values of the keys,
+	// instead of being evaluated on the index of the database
+	PostFilteredAttributes []string `protobuf:"bytes,3,rep,name=post_filtered_attributes,json=postFilteredAttributes,proto3" json:"post_filtered_attributes,omitempty"`
+	// the cursor of the next page, set if the limit of the query cut the key-value pairs short
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *DataQueryResponse) Reset() {
+	*x = DataQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataQueryResponse) ProtoMessage() {}
+
+func (x *DataQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataQueryResponse.ProtoReflect.Descriptor instead.
+func (*DataQueryResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DataQueryResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DataQueryResponse) GetKVs() []*KVWithMetadata {
+	if x != nil {
+		return x.KVs
+	}
+	return nil
+}
+
+func (x *DataQueryResponse) GetPostFilteredAttributes() []string {
+	if x != nil {
+		return x.PostFilteredAttributes
+	}
+	return nil
+}
+
+func (x *DataQueryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetDataCountResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDataCountResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDataCountResponseEnvelope) Reset() {
+	*x = GetDataCountResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataCountResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataCountResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDataCountResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataCountResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataCountResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetDataCountResponseEnvelope) GetResponse() *GetDataCountResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDataCountResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDataCountResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetDataCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// the number of readable keys found, which is a lower bound when the count is truncated
+	Count  uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Exists bool   `protobuf:"varint,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	// truncated is set when the query reached the cost limit before it examined all the selected keys
+	Truncated bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *GetDataCountResponse) Reset() {
+	*x = GetDataCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataCountResponse) ProtoMessage() {}
+
+func (x *GetDataCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataCountResponse.ProtoReflect.Descriptor instead.
+func (*GetDataCountResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetDataCountResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDataCountResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetDataCountResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *GetDataCountResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type AcceptPeerHeaderResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *AcceptPeerHeaderResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                    `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *AcceptPeerHeaderResponseEnvelope) Reset() {
+	*x = AcceptPeerHeaderResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPeerHeaderResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPeerHeaderResponseEnvelope) ProtoMessage() {}
+
+func (x *AcceptPeerHeaderResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPeerHeaderResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*AcceptPeerHeaderResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AcceptPeerHeaderResponseEnvelope) GetResponse() *AcceptPeerHeaderResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AcceptPeerHeaderResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AcceptPeerHeaderResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type AcceptPeerHeaderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The divergence resolved by accepting the peer's version of the block.
+	Divergence *StateDivergence `protobuf:"bytes,2,opt,name=divergence,proto3" json:"divergence,omitempty"`
+}
+
+func (x *AcceptPeerHeaderResponse) Reset() {
+	*x = AcceptPeerHeaderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPeerHeaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPeerHeaderResponse) ProtoMessage() {}
+
+func (x *AcceptPeerHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPeerHeaderResponse.ProtoReflect.Descriptor instead.
+func (*AcceptPeerHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AcceptPeerHeaderResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AcceptPeerHeaderResponse) GetDivergence() *StateDivergence {
+	if x != nil {
+		return x.Divergence
+	}
+	return nil
+}
+
+type ResyncDBResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *ResyncDBResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte            `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte            `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *ResyncDBResponseEnvelope) Reset() {
+	*x = ResyncDBResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncDBResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncDBResponseEnvelope) ProtoMessage() {}
+
+func (x *ResyncDBResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncDBResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*ResyncDBResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ResyncDBResponseEnvelope) GetResponse() *ResyncDBResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ResyncDBResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ResyncDBResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type ResyncDBResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DbName string          `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The blocks whose skipped updates to the database were committed by the resync, in ascending order.
+	ResyncedBlocks []uint64 `protobuf:"varint,3,rep,packed,name=resynced_blocks,json=resyncedBlocks,proto3" json:"resynced_blocks,omitempty"`
+}
+
+func (x *ResyncDBResponse) Reset() {
+	*x = ResyncDBResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncDBResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncDBResponse) ProtoMessage() {}
+
+func (x *ResyncDBResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncDBResponse.ProtoReflect.Descriptor instead.
+func (*ResyncDBResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ResyncDBResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ResyncDBResponse) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ResyncDBResponse) GetResyncedBlocks() []uint64 {
+	if x != nil {
+		return x.ResyncedBlocks
+	}
+	return nil
+}
+
+type GetTrustedCheckpointsResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetTrustedCheckpointsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                         `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                         `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetTrustedCheckpointsResponseEnvelope) Reset() {
+	*x = GetTrustedCheckpointsResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrustedCheckpointsResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrustedCheckpointsResponseEnvelope) ProtoMessage() {}
+
+func (x *GetTrustedCheckpointsResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrustedCheckpointsResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetTrustedCheckpointsResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetTrustedCheckpointsResponseEnvelope) GetResponse() *GetTrustedCheckpointsResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetTrustedCheckpointsResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetTrustedCheckpointsResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type GetTrustedCheckpointsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *ResponseHeader     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Checkpoints *TrustedCheckpoints `protobuf:"bytes,2,opt,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (x *GetTrustedCheckpointsResponse) Reset() {
+	*x = GetTrustedCheckpointsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrustedCheckpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrustedCheckpointsResponse) ProtoMessage() {}
+
+func (x *GetTrustedCheckpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrustedCheckpointsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrustedCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetTrustedCheckpointsResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetTrustedCheckpointsResponse) GetCheckpoints() *TrustedCheckpoints {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+type GetLogLevelsResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetLogLevelsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetLogLevelsResponseEnvelope) Reset() {
+	*x = GetLogLevelsResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogLevelsResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelsResponseEnvelope) ProtoMessage() {}
+
+func (x *GetLogLevelsResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelsResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetLogLevelsResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetLogLevelsResponseEnvelope) GetResponse() *GetLogLevelsResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetLogLevelsResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetLogLevelsResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetLogLevelsResponse holds the logging level of every module of the server, by the name of the module
+type GetLogLevelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Levels map[string]string `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetLogLevelsResponse) Reset() {
+	*x = GetLogLevelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogLevelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelsResponse) ProtoMessage() {}
+
+func (x *GetLogLevelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelsResponse.ProtoReflect.Descriptor instead.
+func (*GetLogLevelsResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetLogLevelsResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetLogLevelsResponse) GetLevels() map[string]string {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type StateMigrationResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *StateMigrationResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                  `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                  `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *StateMigrationResponseEnvelope) Reset() {
+	*x = StateMigrationResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateMigrationResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMigrationResponseEnvelope) ProtoMessage() {}
+
+func (x *StateMigrationResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateMigrationResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*StateMigrationResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *StateMigrationResponseEnvelope) GetResponse() *StateMigrationResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *StateMigrationResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *StateMigrationResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type StateMigrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Status *StateMigrationStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StateMigrationResponse) Reset() {
+	*x = StateMigrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateMigrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMigrationResponse) ProtoMessage() {}
+
+func (x *StateMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateMigrationResponse.ProtoReflect.Descriptor instead.
+func (*StateMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *StateMigrationResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StateMigrationResponse) GetStatus() *StateMigrationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// StateMigrationStatus describes the last migration of the records of the state database to the current encoding
+// started on the node.
+type StateMigrationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State StateMigrationStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=types.StateMigrationStatus_State" json:"state,omitempty"`
+	// The databases whose records are all in the current encoding, in ascending order.
+	MigratedDbs []string `protobuf:"bytes,2,rep,name=migrated_dbs,json=migratedDbs,proto3" json:"migrated_dbs,omitempty"`
+	// The database being migrated, while the migration runs.
+	CurrentDb string `protobuf:"bytes,3,opt,name=current_db,json=currentDb,proto3" json:"current_db,omitempty"`
+	// The number of records rewritten, and of sampled records verified, since the migration was last started or
+	// resumed on the node.
+	RewrittenRecords uint64 `protobuf:"varint,4,opt,name=rewritten_records,json=rewrittenRecords,proto3" json:"rewritten_records,omitempty"`
+	VerifiedRecords  uint64 `protobuf:"varint,5,opt,name=verified_records,json=verifiedRecords,proto3" json:"verified_records,omitempty"`
+	// The reason of the failure of a failed migration.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StateMigrationStatus) Reset() {
+	*x = StateMigrationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateMigrationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateMigrationStatus) ProtoMessage() {}
+
+func (x *StateMigrationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateMigrationStatus.ProtoReflect.Descriptor instead.
+func (*StateMigrationStatus) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *StateMigrationStatus) GetState() StateMigrationStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return StateMigrationStatus_NONE
+}
+
+func (x *StateMigrationStatus) GetMigratedDbs() []string {
+	if x != nil {
+		return x.MigratedDbs
+	}
+	return nil
+}
+
+func (x *StateMigrationStatus) GetCurrentDb() string {
+	if x != nil {
+		return x.CurrentDb
+	}
+	return ""
+}
+
+func (x *StateMigrationStatus) GetRewrittenRecords() uint64 {
+	if x != nil {
+		return x.RewrittenRecords
+	}
+	return 0
+}
+
+func (x *StateMigrationStatus) GetVerifiedRecords() uint64 {
+	if x != nil {
+		return x.VerifiedRecords
+	}
+	return 0
+}
+
+func (x *StateMigrationStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type StateScrubResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *StateScrubResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte              `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *StateScrubResponseEnvelope) Reset() {
+	*x = StateScrubResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateScrubResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateScrubResponseEnvelope) ProtoMessage() {}
+
+func (x *StateScrubResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateScrubResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*StateScrubResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *StateScrubResponseEnvelope) GetResponse() *StateScrubResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *StateScrubResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *StateScrubResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type StateScrubResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Status *StateScrubStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StateScrubResponse) Reset() {
+	*x = StateScrubResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateScrubResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateScrubResponse) ProtoMessage() {}
+
+func (x *StateScrubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateScrubResponse.ProtoReflect.Descriptor instead.
+func (*StateScrubResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *StateScrubResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StateScrubResponse) GetStatus() *StateScrubStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// StateScrubStatus describes the last scrub of a state database started on the node.
+type StateScrubStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State  StateScrubStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=types.StateScrubStatus_State" json:"state,omitempty"`
+	DbName string                 `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The number of records whose hash was verified, and of records stored without a hash, i.e., before the database
+	// was put in the integrity mode.
+	VerifiedRecords uint64 `protobuf:"varint,3,opt,name=verified_records,json=verifiedRecords,proto3" json:"verified_records,omitempty"`
+	UnhashedRecords uint64 `protobuf:"varint,4,opt,name=unhashed_records,json=unhashedRecords,proto3" json:"unhashed_records,omitempty"`
+	// The number of records whose value does not match its stored hash, and the first of them found.
+	CorruptedRecords uint64            `protobuf:"varint,5,opt,name=corrupted_records,json=corruptedRecords,proto3" json:"corrupted_records,omitempty"`
+	CorruptedValues  []*CorruptedValue `protobuf:"bytes,6,rep,name=corrupted_values,json=corruptedValues,proto3" json:"corrupted_values,omitempty"`
+	// The reason of the failure of a failed scrub.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StateScrubStatus) Reset() {
+	*x = StateScrubStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateScrubStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateScrubStatus) ProtoMessage() {}
+
+func (x *StateScrubStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateScrubStatus.ProtoReflect.Descriptor instead.
+func (*StateScrubStatus) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *StateScrubStatus) GetState() StateScrubStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return StateScrubStatus_NONE
+}
+
+func (x *StateScrubStatus) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *StateScrubStatus) GetVerifiedRecords() uint64 {
+	if x != nil {
+		return x.VerifiedRecords
+	}
+	return 0
+}
+
+func (x *StateScrubStatus) GetUnhashedRecords() uint64 {
+	if x != nil {
+		return x.UnhashedRecords
+	}
+	return 0
+}
+
+func (x *StateScrubStatus) GetCorruptedRecords() uint64 {
+	if x != nil {
+		return x.CorruptedRecords
+	}
+	return 0
+}
+
+func (x *StateScrubStatus) GetCorruptedValues() []*CorruptedValue {
+	if x != nil {
+		return x.CorruptedValues
+	}
+	return nil
+}
+
+func (x *StateScrubStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CorruptedValue is a record of a state database whose value does not match its stored hash.
+type CorruptedValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	StoredHash   []byte `protobuf:"bytes,2,opt,name=stored_hash,json=storedHash,proto3" json:"stored_hash,omitempty"`
+	ComputedHash []byte `protobuf:"bytes,3,opt,name=computed_hash,json=computedHash,proto3" json:"computed_hash,omitempty"`
+}
+
+func (x *CorruptedValue) Reset() {
+	*x = CorruptedValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorruptedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorruptedValue) ProtoMessage() {}
+
+func (x *CorruptedValue) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorruptedValue.ProtoReflect.Descriptor instead.
+func (*CorruptedValue) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CorruptedValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CorruptedValue) GetStoredHash() []byte {
+	if x != nil {
+		return x.StoredHash
+	}
+	return nil
+}
+
+func (x *CorruptedValue) GetComputedHash() []byte {
+	if x != nil {
+		return x.ComputedHash
+	}
+	return nil
+}
+
+// TrustedCheckpoints pins the hashes of the headers of some blocks of the ledger. A trusted checkpoints file holds
+// them in the JSON encoding of protobuf, and a node configured with the file refuses to start on a block store that
+// does not match any of them.
+type TrustedCheckpoints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checkpoints []*TrustedCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (x *TrustedCheckpoints) Reset() {
+	*x = TrustedCheckpoints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustedCheckpoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustedCheckpoints) ProtoMessage() {}
+
+func (x *TrustedCheckpoints) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustedCheckpoints.ProtoReflect.Descriptor instead.
+func (*TrustedCheckpoints) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *TrustedCheckpoints) GetCheckpoints() []*TrustedCheckpoint {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+type TrustedCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	HeaderHash  []byte `protobuf:"bytes,2,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty"`
+}
+
+func (x *TrustedCheckpoint) Reset() {
+	*x = TrustedCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustedCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustedCheckpoint) ProtoMessage() {}
+
+func (x *TrustedCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustedCheckpoint.ProtoReflect.Descriptor instead.
+func (*TrustedCheckpoint) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TrustedCheckpoint) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TrustedCheckpoint) GetHeaderHash() []byte {
+	if x != nil {
+		return x.HeaderHash
+	}
+	return nil
+}
+
+type KeyChangesResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *KeyChangesResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte              `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *KeyChangesResponseEnvelope) Reset() {
+	*x = KeyChangesResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyChangesResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyChangesResponseEnvelope) ProtoMessage() {}
+
+func (x *KeyChangesResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyChangesResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*KeyChangesResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *KeyChangesResponseEnvelope) GetResponse() *KeyChangesResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *KeyChangesResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *KeyChangesResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// KeyChangesResponse notifies a subscriber of the subscribed keys of a database that a committed block changed.
+type KeyChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DbName      string          `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	BlockNumber uint64          `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Changes     []*KeyChange    `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *KeyChangesResponse) Reset() {
+	*x = KeyChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyChangesResponse) ProtoMessage() {}
+
+func (x *KeyChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyChangesResponse.ProtoReflect.Descriptor instead.
+func (*KeyChangesResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *KeyChangesResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *KeyChangesResponse) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *KeyChangesResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *KeyChangesResponse) GetChanges() []*KeyChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type KeyChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The version of the new value; not set for a deleted key.
+	Version *Version `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The new value, withheld if the access control of the key does not allow the subscriber to read it.
+	Value         []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ValueWithheld bool   `protobuf:"varint,4,opt,name=value_withheld,json=valueWithheld,proto3" json:"value_withheld,omitempty"`
+	Deleted       bool   `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *KeyChange) Reset() {
+	*x = KeyChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyChange) ProtoMessage() {}
+
+func (x *KeyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyChange.ProtoReflect.Descriptor instead.
+func (*KeyChange) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *KeyChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyChange) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *KeyChange) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KeyChange) GetValueWithheld() bool {
+	if x != nil {
+		return x.ValueWithheld
+	}
+	return false
+}
+
+func (x *KeyChange) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type PrivilegeChangesResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *PrivilegeChangesResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                    `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *PrivilegeChangesResponseEnvelope) Reset() {
+	*x = PrivilegeChangesResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivilegeChangesResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivilegeChangesResponseEnvelope) ProtoMessage() {}
+
+func (x *PrivilegeChangesResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivilegeChangesResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*PrivilegeChangesResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PrivilegeChangesResponseEnvelope) GetResponse() *PrivilegeChangesResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *PrivilegeChangesResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *PrivilegeChangesResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// PrivilegeChangesResponse notifies a session of a user that a committed block may have changed the privileges of the
+// user, so that the client refreshes its session bootstrap.
+type PrivilegeChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *ResponseHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	UserId      string             `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockNumber uint64             `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Changes     []*PrivilegeChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *PrivilegeChangesResponse) Reset() {
+	*x = PrivilegeChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivilegeChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivilegeChangesResponse) ProtoMessage() {}
+
+func (x *PrivilegeChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivilegeChangesResponse.ProtoReflect.Descriptor instead.
+func (*PrivilegeChangesResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PrivilegeChangesResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PrivilegeChangesResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PrivilegeChangesResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *PrivilegeChangesResponse) GetChanges() []*PrivilegeChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type PrivilegeChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind PrivilegeChange_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=types.PrivilegeChange_Kind" json:"kind,omitempty"`
+	// The database of the change; not set for the changes of the record of the user.
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+}
+
+func (x *PrivilegeChange) Reset() {
+	*x = PrivilegeChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivilegeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivilegeChange) ProtoMessage() {}
+
+func (x *PrivilegeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivilegeChange.ProtoReflect.Descriptor instead.
+func (*PrivilegeChange) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PrivilegeChange) GetKind() PrivilegeChange_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return PrivilegeChange_UNKNOWN
+}
+
+func (x *PrivilegeChange) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+type GetDataMultiResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetDataMultiResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetDataMultiResponseEnvelope) Reset() {
+	*x = GetDataMultiResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataMultiResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataMultiResponseEnvelope) ProtoMessage() {}
+
+func (x *GetDataMultiResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataMultiResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetDataMultiResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetDataMultiResponseEnvelope) GetResponse() *GetDataMultiResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDataMultiResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetDataMultiResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetDataMultiResponse holds the values of several keys of a database, all read from a single snapshot of the state,
+// in the order of the requested keys.
+type GetDataMultiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The height of the state the keys were read from: the versions of the keys are not above it.
+	Height  uint64           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Entries []*MultiGetEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetDataMultiResponse) Reset() {
+	*x = GetDataMultiResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataMultiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataMultiResponse) ProtoMessage() {}
+
+func (x *GetDataMultiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataMultiResponse.ProtoReflect.Descriptor instead.
+func (*GetDataMultiResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetDataMultiResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetDataMultiResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetDataMultiResponse) GetEntries() []*MultiGetEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MultiGetEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Status   MultiGetEntry_Status `protobuf:"varint,2,opt,name=status,proto3,enum=types.MultiGetEntry_Status" json:"status,omitempty"`
+	Value    []byte               `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata *Metadata            `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *MultiGetEntry) Reset() {
+	*x = MultiGetEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiGetEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiGetEntry) ProtoMessage() {}
+
+func (x *MultiGetEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiGetEntry.ProtoReflect.Descriptor instead.
+func (*MultiGetEntry) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *MultiGetEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MultiGetEntry) GetStatus() MultiGetEntry_Status {
+	if x != nil {
+		return x.Status
+	}
+	return MultiGetEntry_FOUND
+}
+
+func (x *MultiGetEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MultiGetEntry) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// AccessToken holds the claims of an access token, which the node that issued it signs. The token handed to the
+// client is the base64url encoding of the deterministic encoding of the claims, signature included.
+type AccessToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A random identifier of the token, by which a revoked token is denied.
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// The user on whose behalf the queries authenticated by the token are served.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The node that issued the token, which is the only node that accepts it.
+	NodeId string `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// The databases the token may read, for the endpoints that name a database.
+	DbNames []string `protobuf:"bytes,4,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	// The route templates of the endpoints the token may query.
+	Endpoints []string `protobuf:"bytes,5,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// The time of the issuance and of the expiry of the token, in nanoseconds since the Unix epoch.
+	IssuedAt  int64 `protobuf:"varint,6,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// The signature of the node over the deterministic encoding of the claims without the signature.
+	NodeSignature []byte `protobuf:"bytes,8,opt,name=node_signature,json=nodeSignature,proto3" json:"node_signature,omitempty"`
+}
+
+func (x *AccessToken) Reset() {
+	*x = AccessToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessToken) ProtoMessage() {}
+
+func (x *AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AccessToken) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *AccessToken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccessToken) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AccessToken) GetDbNames() []string {
+	if x != nil {
+		return x.DbNames
+	}
+	return nil
+}
+
+func (x *AccessToken) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *AccessToken) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *AccessToken) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *AccessToken) GetNodeSignature() []byte {
+	if x != nil {
+		return x.NodeSignature
+	}
+	return nil
+}
+
+type IssueAccessTokenResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *IssueAccessTokenResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                    `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                    `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *IssueAccessTokenResponseEnvelope) Reset() {
+	*x = IssueAccessTokenResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAccessTokenResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAccessTokenResponseEnvelope) ProtoMessage() {}
+
+func (x *IssueAccessTokenResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAccessTokenResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*IssueAccessTokenResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *IssueAccessTokenResponseEnvelope) GetResponse() *IssueAccessTokenResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *IssueAccessTokenResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *IssueAccessTokenResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type IssueAccessTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The token, to be set in the X-Access-Token header of the queries.
+	Token  string       `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Claims *AccessToken `protobuf:"bytes,3,opt,name=claims,proto3" json:"claims,omitempty"`
+}
+
+func (x *IssueAccessTokenResponse) Reset() {
+	*x = IssueAccessTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueAccessTokenResponse) ProtoMessage() {}
+
+func (x *IssueAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*IssueAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *IssueAccessTokenResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *IssueAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IssueAccessTokenResponse) GetClaims() *AccessToken {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+type RevokeAccessTokenResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *RevokeAccessTokenResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                     `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *RevokeAccessTokenResponseEnvelope) Reset() {
+	*x = RevokeAccessTokenResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessTokenResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenResponseEnvelope) ProtoMessage() {}
+
+func (x *RevokeAccessTokenResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RevokeAccessTokenResponseEnvelope) GetResponse() *RevokeAccessTokenResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RevokeAccessTokenResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *RevokeAccessTokenResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+type RevokeAccessTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header  *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TokenId string          `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *RevokeAccessTokenResponse) Reset() {
+	*x = RevokeAccessTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenResponse) ProtoMessage() {}
+
+func (x *RevokeAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *RevokeAccessTokenResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *RevokeAccessTokenResponse) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type GetKeyBlocksResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *GetKeyBlocksResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte                `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *GetKeyBlocksResponseEnvelope) Reset() {
+	*x = GetKeyBlocksResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKeyBlocksResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyBlocksResponseEnvelope) ProtoMessage() {}
+
+func (x *GetKeyBlocksResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyBlocksResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*GetKeyBlocksResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetKeyBlocksResponseEnvelope) GetResponse() *GetKeyBlocksResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetKeyBlocksResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetKeyBlocksResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// GetKeyBlocksResponse lists the candidate blocks which may have modified a key, i.e., written or deleted it, in a
+// range of blocks. A block of the range which is not a candidate did not modify the key. A candidate, however, may be
+// a false positive, which did not modify the key although its key filter matches it. The data proofs of the key at the
+// candidate block and at the block before it, or the provenance of the key, confirm or refute the candidate.
+type GetKeyBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header           *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DbName           string          `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Key              string          `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	StartBlockNumber uint64          `protobuf:"varint,4,opt,name=start_block_number,json=startBlockNumber,proto3" json:"start_block_number,omitempty"`
+	EndBlockNumber   uint64          `protobuf:"varint,5,opt,name=end_block_number,json=endBlockNumber,proto3" json:"end_block_number,omitempty"`
+	// The candidates, in ascending order of block number.
+	Candidates []*KeyBlockCandidate `protobuf:"bytes,6,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *GetKeyBlocksResponse) Reset() {
+	*x = GetKeyBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKeyBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyBlocksResponse) ProtoMessage() {}
+
+func (x *GetKeyBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyBlocksResponse.ProtoReflect.Descriptor instead.
+func (*GetKeyBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetKeyBlocksResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *GetKeyBlocksResponse) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *GetKeyBlocksResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetKeyBlocksResponse) GetStartBlockNumber() uint64 {
+	if x != nil {
+		return x.StartBlockNumber
+	}
+	return 0
+}
+
+func (x *GetKeyBlocksResponse) GetEndBlockNumber() uint64 {
+	if x != nil {
+		return x.EndBlockNumber
+	}
+	return 0
+}
+
+func (x *GetKeyBlocksResponse) GetCandidates() []*KeyBlockCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type KeyBlockCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The probability that the key filter of the block matches a key the block did not modify, as estimated from the
+	// size of the filter and the number of keys it holds. It is 1 for an unfiltered block.
+	FalsePositiveProbability float64 `protobuf:"fixed64,2,opt,name=false_positive_probability,json=falsePositiveProbability,proto3" json:"false_positive_probability,omitempty"`
+	// Whether the block has no key filter, e.g., as it was committed before the key filters were recorded, in which case
+	// it is a candidate for any key.
+	Unfiltered bool `protobuf:"varint,3,opt,name=unfiltered,proto3" json:"unfiltered,omitempty"`
+}
+
+func (x *KeyBlockCandidate) Reset() {
+	*x = KeyBlockCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyBlockCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyBlockCandidate) ProtoMessage() {}
+
+func (x *KeyBlockCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyBlockCandidate.ProtoReflect.Descriptor instead.
+func (*KeyBlockCandidate) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *KeyBlockCandidate) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *KeyBlockCandidate) GetFalsePositiveProbability() float64 {
+	if x != nil {
+		return x.FalsePositiveProbability
+	}
+	return 0
+}
+
+func (x *KeyBlockCandidate) GetUnfiltered() bool {
+	if x != nil {
+		return x.Unfiltered
+	}
+	return false
+}
+
+type DBImportResponseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response      *DBImportResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Signature     []byte            `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ResponseBytes []byte            `protobuf:"bytes,3,opt,name=response_bytes,json=responseBytes,proto3" json:"response_bytes,omitempty"`
+}
+
+func (x *DBImportResponseEnvelope) Reset() {
+	*x = DBImportResponseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBImportResponseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBImportResponseEnvelope) ProtoMessage() {}
+
+func (x *DBImportResponseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBImportResponseEnvelope.ProtoReflect.Descriptor instead.
+func (*DBImportResponseEnvelope) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DBImportResponseEnvelope) GetResponse() *DBImportResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DBImportResponseEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *DBImportResponseEnvelope) GetResponseBytes() []byte {
+	if x != nil {
+		return x.ResponseBytes
+	}
+	return nil
+}
+
+// DBImportResponse lists the transactions of a database import, which are all committed, the first one creating the
+// database.
+type DBImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DbName string          `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	TxIds  []string        `protobuf:"bytes,3,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	// kv_count is the number of keys imported.
+	KvCount uint64 `protobuf:"varint,4,opt,name=kv_count,json=kvCount,proto3" json:"kv_count,omitempty"`
+}
+
+func (x *DBImportResponse) Reset() {
+	*x = DBImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_response_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBImportResponse) ProtoMessage() {}
+
+func (x *DBImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_response_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBImportResponse.ProtoReflect.Descriptor instead.
+func (*DBImportResponse) Descriptor() ([]byte, []int) {
+	return file_response_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DBImportResponse) GetHeader() *ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DBImportResponse) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DBImportResponse) GetTxIds() []string {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+func (x *DBImportResponse) GetKvCount() uint64 {
+	if x != nil {
+		return x.KvCount
+	}
+	return 0
+}
+
+var File_response_proto protoreflect.FileDescriptor
+
+var file_response_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x29, 0x0a, 0x0e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x98, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x44, 0x42, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xa2, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x42, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0d, 0x64, 0x62, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x52, 0x0c, 0x64, 0x62, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
+	0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x44, 0x42, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x42,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x44,
+	0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x64, 0x62, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x64, 0x62, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x83, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x03,
+	0x4b, 0x56, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4b, 0x56, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x03, 0x4b, 0x56, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x8e, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x96, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9e, 0x01, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x32, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa0, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xa2, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xcc, 0x01,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x13, 0x74, 0x78, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x11, 0x74, 0x78, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x38, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x65,
+	0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xa4, 0x01, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x27, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x75, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x07,
+	0x77, 0x61, 0x72, 0x6d, 0x5f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x77, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x12, 0x35, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72,
+	0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0xa0, 0x03, 0x0a, 0x0c, 0x57, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x6d, 0x0a, 0x15, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x65, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22,
+	0xaa, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x86, 0x02, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0d,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xfa, 0x01, 0x0a,
+	0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3e,
+	0x0a, 0x1c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3d,
+	0x0a, 0x1b, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x18, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x6d, 0x61,
+	0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x78, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x27, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x90, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x75, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x22, 0x9e, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0d, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0xa0, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x43, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61,
+	0x64, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0xbd, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x50, 0x54, 0x72, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x47, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x50, 0x54,
+	0x72, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x10, 0x6e, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74,
+	0x68, 0x22, 0x2c, 0x0a, 0x12, 0x4d, 0x50, 0x54, 0x72, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22,
+	0xa6, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3b, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42,
+	0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0xa7, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc6, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x52, 0x65,
+	0x61, 0x64, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa0, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x62, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01,
+	0x0a, 0x21, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x0f, 0x4b, 0x56, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x03, 0x4b, 0x56, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b,
+	0x56, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x03, 0x4b,
+	0x56, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x53, 0x0a, 0x0b, 0x44, 0x42, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x42, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x44, 0x42, 0x4b, 0x65, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x56, 0x0a, 0x10, 0x44, 0x42, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4b, 0x56, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x01, 0x0a,
+	0x23, 0x47, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x54, 0x78, 0x49, 0x44, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x49, 0x44, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x49, 0x44, 0x73, 0x22, 0x96, 0x01,
+	0x0a, 0x19, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x11, 0x54, 0x78, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x0a,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x54, 0x78, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x22, 0x9e, 0x01,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x38, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xae,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x52, 0x0a,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x8b, 0x01, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x78, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41, 0x74, 0x22, 0xac, 0x01,
+	0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d,
+	0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0xfc, 0x02, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x6f, 0x6c, 0x6c, 0x75,
+	0x70, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f,
+	0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x9a, 0x02, 0x0a, 0x11, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xa0, 0x01, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x39, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0xe4, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x6e, 0x73, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x75, 0x6e, 0x73, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x1a, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xaa, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x54, 0x78, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc0, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x78, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x22, 0xaa, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x87, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x44, 0x61, 0x74, 0x61,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xc6, 0x01, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x03, 0x4b, 0x56, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x56, 0x57, 0x69, 0x74,
+	0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x03, 0x4b, 0x56, 0x73, 0x12, 0x38,
+	0x0a, 0x18, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x16, 0x70, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xa4, 0x01, 0x0a,
+	0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x65, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x50, 0x65, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x36, 0x0a, 0x0a, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x64, 0x69, 0x76,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x79,
+	0x6e, 0x63, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x83,
+	0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x40,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x06, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa0, 0x01, 0x0a, 0x1e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x44, 0x62, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x62, 0x12, 0x2b, 0x0a, 0x11, 0x72,
+	0x65, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x46, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f,
+	0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x22, 0x98, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x75, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x72, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x12,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x75,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x6e, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x63, 0x6f, 0x72, 0x72,
+	0x75, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x75,
+	0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x75,
+	0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x39, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0x68, 0x0a, 0x0e, 0x43,
+	0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x22, 0x50, 0x0a, 0x12, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x11, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0x98, 0x01, 0x0a, 0x1a, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x12,
+	0x4b, 0x65, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x09, 0x4b, 0x65,
+	0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x20, 0x50,
+	0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x3b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x2f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x42, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x5f, 0x41, 0x43, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e,
+	0x0a, 0x0a, 0x44, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x22, 0x9c,
+	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8d, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xcc, 0x01,
+	0x0a, 0x0d, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x31, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x22, 0xf6, 0x01, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x20, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x18, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a,
+	0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x21, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x94,
+	0x01, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x1a, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x66, 0x61, 0x6c,
+	0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x44, 0x42, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x42, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a,
+	0x10, 0x44, 0x42, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6b, 0x76, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6b, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x34, 0x5a, 0x32, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6f, 0x72, 0x69, 0x6f, 0x6e,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_response_proto_rawDescOnce sync.Once
+	file_response_proto_rawDescData = file_response_proto_rawDesc
+)
+
+func file_response_proto_rawDescGZIP() []byte {
+	file_response_proto_rawDescOnce.Do(func() {
+		file_response_proto_rawDescData = protoimpl.X.CompressGZIP(file_response_proto_rawDescData)
+	})
+	return file_response_proto_rawDescData
+}
+
+var file_response_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_response_proto_msgTypes = make([]protoimpl.MessageInfo, 129)
+var file_response_proto_goTypes = []interface{}{
+	(WarmUpStatus_State)(0),                         // 0: types.WarmUpStatus.State
+	(StateMigrationStatus_State)(0),                 // 1: types.StateMigrationStatus.State
+	(StateScrubStatus_State)(0),                     // 2: types.StateScrubStatus.State
+	(PrivilegeChange_Kind)(0),                       // 3: types.PrivilegeChange.Kind
+	(MultiGetEntry_Status)(0),                       // 4: types.MultiGetEntry.Status
+	(*ResponseHeader)(nil),                          // 5: types.ResponseHeader
+	(*GetDBStatusResponseEnvelope)(nil),             // 6: types.GetDBStatusResponseEnvelope
+	(*GetDBStatusResponse)(nil),                     // 7: types.GetDBStatusResponse
+	(*GetDBIndexResponseEnvelope)(nil),              // 8: types.GetDBIndexResponseEnvelope
+	(*GetDBIndexResponse)(nil),                      // 9: types.GetDBIndexResponse
+	(*GetDBDescriptorResponseEnvelope)(nil),         // 10: types.GetDBDescriptorResponseEnvelope
+	(*GetDBDescriptorResponse)(nil),                 // 11: types.GetDBDescriptorResponse
+	(*GetDBDigestResponseEnvelope)(nil),             // 12: types.GetDBDigestResponseEnvelope
+	(*GetDBDigestResponse)(nil),                     // 13: types.GetDBDigestResponse
+	(*GetDBDescriptorHistoryResponseEnvelope)(nil),  // 14: types.GetDBDescriptorHistoryResponseEnvelope
+	(*GetDBDescriptorHistoryResponse)(nil),          // 15: types.GetDBDescriptorHistoryResponse
+	(*DBDescriptorChange)(nil),                      // 16: types.DBDescriptorChange
+	(*GetDataResponseEnvelope)(nil),                 // 17: types.GetDataResponseEnvelope
+	(*GetDataResponse)(nil),                         // 18: types.GetDataResponse
+	(*GetDataRangeResponseEnvelope)(nil),            // 19: types.GetDataRangeResponseEnvelope
+	(*GetDataRangeResponse)(nil),                    // 20: types.GetDataRangeResponse
+	(*GetUserResponseEnvelope)(nil),                 // 21: types.GetUserResponseEnvelope
+	(*GetUserResponse)(nil),                         // 22: types.GetUserResponse
+	(*GetConfigResponseEnvelope)(nil),               // 23: types.GetConfigResponseEnvelope
+	(*GetConfigResponse)(nil),                       // 24: types.GetConfigResponse
+	(*GetNodeConfigResponseEnvelope)(nil),           // 25: types.GetNodeConfigResponseEnvelope
+	(*GetNodeConfigResponse)(nil),                   // 26: types.GetNodeConfigResponse
+	(*GetConfigBlockResponseEnvelope)(nil),          // 27: types.GetConfigBlockResponseEnvelope
+	(*GetConfigBlockResponse)(nil),                  // 28: types.GetConfigBlockResponse
+	(*GetConfigLimitsResponseEnvelope)(nil),         // 29: types.GetConfigLimitsResponseEnvelope
+	(*GetConfigLimitsResponse)(nil),                 // 30: types.GetConfigLimitsResponse
+	(*GetClusterStatusResponseEnvelope)(nil),        // 31: types.GetClusterStatusResponseEnvelope
+	(*GetClusterStatusResponse)(nil),                // 32: types.GetClusterStatusResponse
+	(*RecoveryStatus)(nil),                          // 33: types.RecoveryStatus
+	(*WarmUpStatus)(nil),                            // 34: types.WarmUpStatus
+	(*StateDivergence)(nil),                         // 35: types.StateDivergence
+	(*HeaderFieldDivergence)(nil),                   // 36: types.HeaderFieldDivergence
+	(*GetClusterHeartbeatsResponseEnvelope)(nil),    // 37: types.GetClusterHeartbeatsResponseEnvelope
+	(*GetClusterHeartbeatsResponse)(nil),            // 38: types.GetClusterHeartbeatsResponse
+	(*NodeHeartbeat)(nil),                           // 39: types.NodeHeartbeat
+	(*GetSessionBootstrapResponseEnvelope)(nil),     // 40: types.GetSessionBootstrapResponseEnvelope
+	(*GetSessionBootstrapResponse)(nil),             // 41: types.GetSessionBootstrapResponse
+	(*DatabaseAccess)(nil),                          // 42: types.DatabaseAccess
+	(*SessionLimits)(nil),                           // 43: types.SessionLimits
+	(*GetBlockResponseEnvelope)(nil),                // 44: types.GetBlockResponseEnvelope
+	(*GetBlockResponse)(nil),                        // 45: types.GetBlockResponse
+	(*GetAugmentedBlockHeaderResponseEnvelope)(nil), // 46: types.GetAugmentedBlockHeaderResponseEnvelope
+	(*GetAugmentedBlockHeaderResponse)(nil),         // 47: types.GetAugmentedBlockHeaderResponse
+	(*GetLedgerPathResponseEnvelope)(nil),           // 48: types.GetLedgerPathResponseEnvelope
+	(*GetLedgerPathResponse)(nil),                   // 49: types.GetLedgerPathResponse
+	(*GetTxProofResponseEnvelope)(nil),              // 50: types.GetTxProofResponseEnvelope
+	(*GetTxProofResponse)(nil),                      // 51: types.GetTxProofResponse
+	(*GetDataProofResponseEnvelope)(nil),            // 52: types.GetDataProofResponseEnvelope
+	(*GetDataProofResponse)(nil),                    // 53: types.GetDataProofResponse
+	(*MPTrieProofElement)(nil),                      // 54: types.MPTrieProofElement
+	(*GetHistoricalDataResponseEnvelope)(nil),       // 55: types.GetHistoricalDataResponseEnvelope
+	(*GetHistoricalDataResponse)(nil),               // 56: types.GetHistoricalDataResponse
+	(*GetDataByVersionResponseEnvelope)(nil),        // 57: types.GetDataByVersionResponseEnvelope
+	(*GetDataByVersionResponse)(nil),                // 58: types.GetDataByVersionResponse
+	(*GetDataReadersResponseEnvelope)(nil),          // 59: types.GetDataReadersResponseEnvelope
+	(*GetDataReadersResponse)(nil),                  // 60: types.GetDataReadersResponse
+	(*GetDataWritersResponseEnvelope)(nil),          // 61: types.GetDataWritersResponseEnvelope
+	(*GetDataWritersResponse)(nil),                  // 62: types.GetDataWritersResponse
+	(*GetDataProvenanceResponseEnvelope)(nil),       // 63: types.GetDataProvenanceResponseEnvelope
+	(*KVsWithMetadata)(nil),                         // 64: types.KVsWithMetadata
+	(*GetDataProvenanceResponse)(nil),               // 65: types.GetDataProvenanceResponse
+	(*GetTxIDsSubmittedByResponseEnvelope)(nil),     // 66: types.GetTxIDsSubmittedByResponseEnvelope
+	(*GetTxIDsSubmittedByResponse)(nil),             // 67: types.GetTxIDsSubmittedByResponse
+	(*TxReceiptResponseEnvelope)(nil),               // 68: types.TxReceiptResponseEnvelope
+	(*TxReceiptResponse)(nil),                       // 69: types.TxReceiptResponse
+	(*GetDroppedTxResponseEnvelope)(nil),            // 70: types.GetDroppedTxResponseEnvelope
+	(*GetDroppedTxResponse)(nil),                    // 71: types.GetDroppedTxResponse
+	(*GetDroppedTxsResponseEnvelope)(nil),           // 72: types.GetDroppedTxsResponseEnvelope
+	(*GetDroppedTxsResponse)(nil),                   // 73: types.GetDroppedTxsResponse
+	(*DroppedTx)(nil),                               // 74: types.DroppedTx
+	(*GetAdminAuditRecordsResponseEnvelope)(nil),    // 75: types.GetAdminAuditRecordsResponseEnvelope
+	(*GetAdminAuditRecordsResponse)(nil),            // 76: types.GetAdminAuditRecordsResponse
+	(*AdminAuditRecord)(nil),                        // 77: types.AdminAuditRecord
+	(*GetLedgerRollupsResponseEnvelope)(nil),        // 78: types.GetLedgerRollupsResponseEnvelope
+	(*GetLedgerRollupsResponse)(nil),                // 79: types.GetLedgerRollupsResponse
+	(*LedgerDailyRollup)(nil),                       // 80: types.LedgerDailyRollup
+	(*GetLedgerUsageResponseEnvelope)(nil),          // 81: types.GetLedgerUsageResponseEnvelope
+	(*GetLedgerUsageResponse)(nil),                  // 82: types.GetLedgerUsageResponse
+	(*UserImportResponseEnvelope)(nil),              // 83: types.UserImportResponseEnvelope
+	(*UserImportResponse)(nil),                      // 84: types.UserImportResponse
+	(*UserImportFailure)(nil),                       // 85: types.UserImportFailure
+	(*GetTxWriteSetDigestResponseEnvelope)(nil),     // 86: types.GetTxWriteSetDigestResponseEnvelope
+	(*GetTxWriteSetDigestResponse)(nil),             // 87: types.GetTxWriteSetDigestResponse
+	(*GetBlockCompositionResponseEnvelope)(nil),     // 88: types.GetBlockCompositionResponseEnvelope
+	(*GetBlockCompositionResponse)(nil),             // 89: types.GetBlockCompositionResponse
+	(*DataQueryResponseEnvelope)(nil),               // 90: types.DataQueryResponseEnvelope
+	(*DataQueryResponse)(nil),                       // 91: types.DataQueryResponse
+	(*GetDataCountResponseEnvelope)(nil),            // 92: types.GetDataCountResponseEnvelope
+	(*GetDataCountResponse)(nil),                    // 93: types.GetDataCountResponse
+	(*AcceptPeerHeaderResponseEnvelope)(nil),        // 94: types.AcceptPeerHeaderResponseEnvelope
+	(*AcceptPeerHeaderResponse)(nil),                // 95: types.AcceptPeerHeaderResponse
+	(*ResyncDBResponseEnvelope)(nil),                // 96: types.ResyncDBResponseEnvelope
+	(*ResyncDBResponse)(nil),                        // 97: types.ResyncDBResponse
+	(*GetTrustedCheckpointsResponseEnvelope)(nil),   // 98: types.GetTrustedCheckpointsResponseEnvelope
+	(*GetTrustedCheckpointsResponse)(nil),           // 99: types.GetTrustedCheckpointsResponse
+	(*GetLogLevelsResponseEnvelope)(nil),            // 100: types.GetLogLevelsResponseEnvelope
+	(*GetLogLevelsResponse)(nil),                    // 101: types.GetLogLevelsResponse
+	(*StateMigrationResponseEnvelope)(nil),          // 102: types.StateMigrationResponseEnvelope
+	(*StateMigrationResponse)(nil),                  // 103: types.StateMigrationResponse
+	(*StateMigrationStatus)(nil),                    // 104: types.StateMigrationStatus
+	(*StateScrubResponseEnvelope)(nil),              // 105: types.StateScrubResponseEnvelope
+	(*StateScrubResponse)(nil),                      // 106: types.StateScrubResponse
+	(*StateScrubStatus)(nil),                        // 107: types.StateScrubStatus
+	(*CorruptedValue)(nil),                          // 108: types.CorruptedValue
+	(*TrustedCheckpoints)(nil),                      // 109: types.TrustedCheckpoints
+	(*TrustedCheckpoint)(nil),                       // 110: types.TrustedCheckpoint
+	(*KeyChangesResponseEnvelope)(nil),              // 111: types.KeyChangesResponseEnvelope
+	(*KeyChangesResponse)(nil),                      // 112: types.KeyChangesResponse
+	(*KeyChange)(nil),                               // 113: types.KeyChange
+	(*PrivilegeChangesResponseEnvelope)(nil),        // 114: types.PrivilegeChangesResponseEnvelope
+	(*PrivilegeChangesResponse)(nil),                // 115: types.PrivilegeChangesResponse
+	(*PrivilegeChange)(nil),                         // 116: types.PrivilegeChange
+	(*GetDataMultiResponseEnvelope)(nil),            // 117: types.GetDataMultiResponseEnvelope
+	(*GetDataMultiResponse)(nil),                    // 118: types.GetDataMultiResponse
+	(*MultiGetEntry)(nil),                           // 119: types.MultiGetEntry
+	(*AccessToken)(nil),                             // 120: types.AccessToken
+	(*IssueAccessTokenResponseEnvelope)(nil),        // 121: types.IssueAccessTokenResponseEnvelope
+	(*IssueAccessTokenResponse)(nil),                // 122: types.IssueAccessTokenResponse
+	(*RevokeAccessTokenResponseEnvelope)(nil),       // 123: types.RevokeAccessTokenResponseEnvelope
+	(*RevokeAccessTokenResponse)(nil),               // 124: types.RevokeAccessTokenResponse
+	(*GetKeyBlocksResponseEnvelope)(nil),            // 125: types.GetKeyBlocksResponseEnvelope
+	(*GetKeyBlocksResponse)(nil),                    // 126: types.GetKeyBlocksResponse
+	(*KeyBlockCandidate)(nil),                       // 127: types.KeyBlockCandidate
+	(*DBImportResponseEnvelope)(nil),                // 128: types.DBImportResponseEnvelope
+	(*DBImportResponse)(nil),                        // 129: types.DBImportResponse
+	nil,                                             // 130: types.GetDataReadersResponse.ReadByEntry
+	nil,                                             // 131: types.GetDataWritersResponse.WrittenByEntry
+	nil,                                             // 132: types.GetDataProvenanceResponse.DBKeyValuesEntry
+	nil,                                             // 133: types.GetLogLevelsResponse.LevelsEntry
+	(*DBDescriptor)(nil),                            // 134: types.DBDescriptor
+	(*Version)(nil),                                 // 135: types.Version
+	(*Metadata)(nil),                                // 136: types.Metadata
+	(*KVWithMetadata)(nil),                          // 137: types.KVWithMetadata
+	(*User)(nil),                                    // 138: types.User
+	(*ClusterConfig)(nil),                           // 139: types.ClusterConfig
+	(*NodeConfig)(nil),                              // 140: types.NodeConfig
+	(*TxOperationLimits)(nil),                       // 141: types.TxOperationLimits
+	(Privilege_Access)(0),                           // 142: types.Privilege.Access
+	(*BlockHeader)(nil),                             // 143: types.BlockHeader
+	(*AugmentedBlockHeader)(nil),                    // 144: types.AugmentedBlockHeader
+	(*ConflictingRead)(nil),                         // 145: types.ConflictingRead
+	(*ValueWithMetadata)(nil),                       // 146: types.ValueWithMetadata
+	(*TxReceipt)(nil),                               // 147: types.TxReceipt
+	(*ValidationInfo)(nil),                          // 148: types.ValidationInfo
+	(*BatchComposition)(nil),                        // 149: types.BatchComposition
+}
+var file_response_proto_depIdxs = []int32{
+	7,   // 0: types.GetDBStatusResponseEnvelope.response:type_name -> types.GetDBStatusResponse
+	5,   // 1: types.GetDBStatusResponse.header:type_name -> types.ResponseHeader
+	9,   // 2: types.GetDBIndexResponseEnvelope.response:type_name -> types.GetDBIndexResponse
+	5,   // 3: types.GetDBIndexResponse.header:type_name -> types.ResponseHeader
+	11,  // 4: types.GetDBDescriptorResponseEnvelope.response:type_name -> types.GetDBDescriptorResponse
+	5,   // 5: types.GetDBDescriptorResponse.header:type_name -> types.ResponseHeader
+	134, // 6: types.GetDBDescriptorResponse.db_descriptor:type_name -> types.DBDescriptor
+	135, // 7: types.GetDBDescriptorResponse.version:type_name -> types.Version
+	13,  // 8: types.GetDBDigestResponseEnvelope.response:type_name -> types.GetDBDigestResponse
+	5,   // 9: types.GetDBDigestResponse.header:type_name -> types.ResponseHeader
+	15,  // 10: types.GetDBDescriptorHistoryResponseEnvelope.response:type_name -> types.GetDBDescriptorHistoryResponse
+	5,   // 11: types.GetDBDescriptorHistoryResponse.header:type_name -> types.ResponseHeader
+	16,  // 12: types.GetDBDescriptorHistoryResponse.changes:type_name -> types.DBDescriptorChange
+	134, // 13: types.DBDescriptorChange.db_descriptor:type_name -> types.DBDescriptor
+	135, // 14: types.DBDescriptorChange.version:type_name -> types.Version
+	18,  // 15: types.GetDataResponseEnvelope.response:type_name -> types.GetDataResponse
+	5,   // 16: types.GetDataResponse.header:type_name -> types.ResponseHeader
+	136, // 17: types.GetDataResponse.metadata:type_name -> types.Metadata
+	20,  // 18: types.GetDataRangeResponseEnvelope.response:type_name -> types.GetDataRangeResponse
+	5,   // 19: types.GetDataRangeResponse.header:type_name -> types.ResponseHeader
+	137, // 20: types.GetDataRangeResponse.KVs:type_name -> types.KVWithMetadata
+	22,  // 21: types.GetUserResponseEnvelope.response:type_name -> types.GetUserResponse
+	5,   // 22: types.GetUserResponse.header:type_name -> types.ResponseHeader
+	138, // 23: types.GetUserResponse.user:type_name -> types.User
+	136, // 24: types.GetUserResponse.metadata:type_name -> types.Metadata
+	24,  // 25: types.GetConfigResponseEnvelope.response:type_name -> types.GetConfigResponse
+	5,   // 26: types.GetConfigResponse.header:type_name -> types.ResponseHeader
+	139, // 27: types.GetConfigResponse.config:type_name -> types.ClusterConfig
+	136, // 28: types.GetConfigResponse.metadata:type_name -> types.Metadata
+	26,  // 29: types.GetNodeConfigResponseEnvelope.response:type_name -> types.GetNodeConfigResponse
+	5,   // 30: types.GetNodeConfigResponse.header:type_name -> types.ResponseHeader
+	140, // 31: types.GetNodeConfigResponse.node_config:type_name -> types.NodeConfig
+	28,  // 32: types.GetConfigBlockResponseEnvelope.response:type_name -> types.GetConfigBlockResponse
+	5,   // 33: types.GetConfigBlockResponse.header:type_name -> types.ResponseHeader
+	30,  // 34: types.GetConfigLimitsResponseEnvelope.response:type_name -> types.GetConfigLimitsResponse
+	5,   // 35: types.GetConfigLimitsResponse.header:type_name -> types.ResponseHeader
+	141, // 36: types.GetConfigLimitsResponse.tx_operation_limits:type_name -> types.TxOperationLimits
+	32,  // 37: types.GetClusterStatusResponseEnvelope.response:type_name -> types.GetClusterStatusResponse
+	5,   // 38: types.GetClusterStatusResponse.header:type_name -> types.ResponseHeader
+	140, // 39: types.GetClusterStatusResponse.nodes:type_name -> types.NodeConfig
+	135, // 40: types.GetClusterStatusResponse.version:type_name -> types.Version
+	35,  // 41: types.GetClusterStatusResponse.state_divergence:type_name -> types.StateDivergence
+	34,  // 42: types.GetClusterStatusResponse.warm_up:type_name -> types.WarmUpStatus
+	33,  // 43: types.GetClusterStatusResponse.recoveries:type_name -> types.RecoveryStatus
+	0,   // 44: types.WarmUpStatus.state:type_name -> types.WarmUpStatus.State
+	36,  // 45: types.StateDivergence.fields:type_name -> types.HeaderFieldDivergence
+	38,  // 46: types.GetClusterHeartbeatsResponseEnvelope.response:type_name -> types.GetClusterHeartbeatsResponse
+	5,   // 47: types.GetClusterHeartbeatsResponse.header:type_name -> types.ResponseHeader
+	39,  // 48: types.GetClusterHeartbeatsResponse.heartbeats:type_name -> types.NodeHeartbeat
+	41,  // 49: types.GetSessionBootstrapResponseEnvelope.response:type_name -> types.GetSessionBootstrapResponse
+	5,   // 50: types.GetSessionBootstrapResponse.header:type_name -> types.ResponseHeader
+	138, // 51: types.GetSessionBootstrapResponse.user:type_name -> types.User
+	136, // 52: types.GetSessionBootstrapResponse.user_metadata:type_name -> types.Metadata
+	42,  // 53: types.GetSessionBootstrapResponse.databases:type_name -> types.DatabaseAccess
+	43,  // 54: types.GetSessionBootstrapResponse.limits:type_name -> types.SessionLimits
+	142, // 55: types.DatabaseAccess.access:type_name -> types.Privilege.Access
+	45,  // 56: types.GetBlockResponseEnvelope.response:type_name -> types.GetBlockResponse
+	5,   // 57: types.GetBlockResponse.header:type_name -> types.ResponseHeader
+	143, // 58: types.GetBlockResponse.block_header:type_name -> types.BlockHeader
+	47,  // 59: types.GetAugmentedBlockHeaderResponseEnvelope.response:type_name -> types.GetAugmentedBlockHeaderResponse
+	5,   // 60: types.GetAugmentedBlockHeaderResponse.header:type_name -> types.ResponseHeader
+	144, // 61: types.GetAugmentedBlockHeaderResponse.block_header:type_name -> types.AugmentedBlockHeader
+	49,  // 62: types.GetLedgerPathResponseEnvelope.response:type_name -> types.GetLedgerPathResponse
+	5,   // 63: types.GetLedgerPathResponse.header:type_name -> types.ResponseHeader
+	143, // 64: types.GetLedgerPathResponse.block_headers:type_name -> types.BlockHeader
+	51,  // 65: types.GetTxProofResponseEnvelope.response:type_name -> types.GetTxProofResponse
+	5,   // 66: types.GetTxProofResponse.header:type_name -> types.ResponseHeader
+	145, // 67: types.GetTxProofResponse.conflicting_reads:type_name -> types.ConflictingRead
+	53,  // 68: types.GetDataProofResponseEnvelope.response:type_name -> types.GetDataProofResponse
+	5,   // 69: types.GetDataProofResponse.header:type_name -> types.ResponseHeader
+	54,  // 70: types.GetDataProofResponse.path:type_name -> types.MPTrieProofElement
+	54,  // 71: types.GetDataProofResponse.non_inclusion_path:type_name -> types.MPTrieProofElement
+	56,  // 72: types.GetHistoricalDataResponseEnvelope.response:type_name -> types.GetHistoricalDataResponse
+	5,   // 73: types.GetHistoricalDataResponse.header:type_name -> types.ResponseHeader
+	146, // 74: types.GetHistoricalDataResponse.values:type_name -> types.ValueWithMetadata
+	58,  // 75: types.GetDataByVersionResponseEnvelope.response:type_name -> types.GetDataByVersionResponse
+	5,   // 76: types.GetDataByVersionResponse.header:type_name -> types.ResponseHeader
+	146, // 77: types.GetDataByVersionResponse.value:type_name -> types.ValueWithMetadata
+	60,  // 78: types.GetDataReadersResponseEnvelope.response:type_name -> types.GetDataReadersResponse
+	5,   // 79: types.GetDataReadersResponse.header:type_name -> types.ResponseHeader
+	130, // 80: types.GetDataReadersResponse.read_by:type_name -> types.GetDataReadersResponse.ReadByEntry
+	62,  // 81: types.GetDataWritersResponseEnvelope.response:type_name -> types.GetDataWritersResponse
+	5,   // 82: types.GetDataWritersResponse.header:type_name -> types.ResponseHeader
+	131, // 83: types.GetDataWritersResponse.written_by:type_name -> types.GetDataWritersResponse.WrittenByEntry
+	65,  // 84: types.GetDataProvenanceResponseEnvelope.response:type_name -> types.GetDataProvenanceResponse
+	137, // 85: types.KVsWithMetadata.KVs:type_name -> types.KVWithMetadata
+	5,   // 86: types.GetDataProvenanceResponse.header:type_name -> types.ResponseHeader
+	132, // 87: types.GetDataProvenanceResponse.DBKeyValues:type_name -> types.GetDataProvenanceResponse.DBKeyValuesEntry
+	67,  // 88: types.GetTxIDsSubmittedByResponseEnvelope.response:type_name -> types.GetTxIDsSubmittedByResponse
+	5,   // 89: types.GetTxIDsSubmittedByResponse.header:type_name -> types.ResponseHeader
+	69,  // 90: types.TxReceiptResponseEnvelope.response:type_name -> types.TxReceiptResponse
+	5,   // 91: types.TxReceiptResponse.header:type_name -> types.ResponseHeader
+	147, // 92: types.TxReceiptResponse.receipt:type_name -> types.TxReceipt
+	148, // 93: types.TxReceiptResponse.rejection:type_name -> types.ValidationInfo
+	71,  // 94: types.GetDroppedTxResponseEnvelope.response:type_name -> types.GetDroppedTxResponse
+	5,   // 95: types.GetDroppedTxResponse.header:type_name -> types.ResponseHeader
+	74,  // 96: types.GetDroppedTxResponse.dropped_tx:type_name -> types.DroppedTx
+	73,  // 97: types.GetDroppedTxsResponseEnvelope.response:type_name -> types.GetDroppedTxsResponse
+	5,   // 98: types.GetDroppedTxsResponse.header:type_name -> types.ResponseHeader
+	74,  // 99: types.GetDroppedTxsResponse.dropped_txs:type_name -> types.DroppedTx
+	76,  // 100: types.GetAdminAuditRecordsResponseEnvelope.response:type_name -> types.GetAdminAuditRecordsResponse
+	5,   // 101: types.GetAdminAuditRecordsResponse.header:type_name -> types.ResponseHeader
+	77,  // 102: types.GetAdminAuditRecordsResponse.records:type_name -> types.AdminAuditRecord
+	79,  // 103: types.GetLedgerRollupsResponseEnvelope.response:type_name -> types.GetLedgerRollupsResponse
+	5,   // 104: types.GetLedgerRollupsResponse.header:type_name -> types.ResponseHeader
+	80,  // 105: types.GetLedgerRollupsResponse.rollups:type_name -> types.LedgerDailyRollup
+	82,  // 106: types.GetLedgerUsageResponseEnvelope.response:type_name -> types.GetLedgerUsageResponse
+	5,   // 107: types.GetLedgerUsageResponse.header:type_name -> types.ResponseHeader
+	84,  // 108: types.UserImportResponseEnvelope.response:type_name -> types.UserImportResponse
+	5,   // 109: types.UserImportResponse.header:type_name -> types.ResponseHeader
+	85,  // 110: types.UserImportResponse.failures:type_name -> types.UserImportFailure
+	87,  // 111: types.GetTxWriteSetDigestResponseEnvelope.response:type_name -> types.GetTxWriteSetDigestResponse
+	5,   // 112: types.GetTxWriteSetDigestResponse.header:type_name -> types.ResponseHeader
+	89,  // 113: types.GetBlockCompositionResponseEnvelope.response:type_name -> types.GetBlockCompositionResponse
+	5,   // 114: types.GetBlockCompositionResponse.header:type_name -> types.ResponseHeader
+	149, // 115: types.GetBlockCompositionResponse.composition:type_name -> types.BatchComposition
+	91,  // 116: types.DataQueryResponseEnvelope.response:type_name -> types.DataQueryResponse
+	5,   // 117: types.DataQueryResponse.header:type_name -> types.ResponseHeader
+	137, // 118: types.DataQueryResponse.KVs:type_name -> types.KVWithMetadata
+	93,  // 119: types.GetDataCountResponseEnvelope.response:type_name -> types.GetDataCountResponse
+	5,   // 120: types.GetDataCountResponse.header:type_name -> types.ResponseHeader
+	95,  // 121: types.AcceptPeerHeaderResponseEnvelope.response:type_name -> types.AcceptPeerHeaderResponse
+	5,   // 122: types.AcceptPeerHeaderResponse.header:type_name -> types.ResponseHeader
+	35,  // 123: types.AcceptPeerHeaderResponse.divergence:type_name -> types.StateDivergence
+	97,  // 124: types.ResyncDBResponseEnvelope.response:type_name -> types.ResyncDBResponse
+	5,   // 125: types.ResyncDBResponse.header:type_name -> types.ResponseHeader
+	99,  // 126: types.GetTrustedCheckpointsResponseEnvelope.response:type_name -> types.GetTrustedCheckpointsResponse
+	5,   // 127: types.GetTrustedCheckpointsResponse.header:type_name -> types.ResponseHeader
+	109, // 128: types.GetTrustedCheckpointsResponse.checkpoints:type_name -> types.TrustedCheckpoints
+	101, // 129: types.GetLogLevelsResponseEnvelope.response:type_name -> types.GetLogLevelsResponse
+	5,   // 130: types.GetLogLevelsResponse.header:type_name -> types.ResponseHeader
+	133, // 131: types.GetLogLevelsResponse.levels:type_name -> types.GetLogLevelsResponse.LevelsEntry
+	103, // 132: types.StateMigrationResponseEnvelope.response:type_name -> types.StateMigrationResponse
+	5,   // 133: types.StateMigrationResponse.header:type_name -> types.ResponseHeader
+	104, // 134: types.StateMigrationResponse.status:type_name -> types.StateMigrationStatus
+	1,   // 135: types.StateMigrationStatus.state:type_name -> types.StateMigrationStatus.State
+	106, // 136: types.StateScrubResponseEnvelope.response:type_name -> types.StateScrubResponse
+	5,   // 137: types.StateScrubResponse.header:type_name -> types.ResponseHeader
+	107, // 138: types.StateScrubResponse.status:type_name -> types.StateScrubStatus
+	2,   // 139: types.StateScrubStatus.state:type_name -> types.StateScrubStatus.State
+	108, // 140: types.StateScrubStatus.corrupted_values:type_name -> types.CorruptedValue
+	110, // 141: types.TrustedCheckpoints.checkpoints:type_name -> types.TrustedCheckpoint
+	112, // 142: types.KeyChangesResponseEnvelope.response:type_name -> types.KeyChangesResponse
+	5,   // 143: types.KeyChangesResponse.header:type_name -> types.ResponseHeader
+	113, // 144: types.KeyChangesResponse.changes:type_name -> types.KeyChange
+	135, // 145: types.KeyChange.version:type_name -> types.Version
+	115, // 146: types.PrivilegeChangesResponseEnvelope.response:type_name -> types.PrivilegeChangesResponse
+	5,   // 147: types.PrivilegeChangesResponse.header:type_name -> types.ResponseHeader
+	116, // 148: types.PrivilegeChangesResponse.changes:type_name -> types.PrivilegeChange
+	3,   // 149: types.PrivilegeChange.kind:type_name -> types.PrivilegeChange.Kind
+	118, // 150: types.GetDataMultiResponseEnvelope.response:type_name -> types.GetDataMultiResponse
+	5,   // 151: types.GetDataMultiResponse.header:type_name -> types.ResponseHeader
+	119, // 152: types.GetDataMultiResponse.entries:type_name -> types.MultiGetEntry
+	4,   // 153: types.MultiGetEntry.status:type_name -> types.MultiGetEntry.Status
+	136, // 154: types.MultiGetEntry.metadata:type_name -> types.Metadata
+	122, // 155: types.IssueAccessTokenResponseEnvelope.response:type_name -> types.IssueAccessTokenResponse
+	5,   // 156: types.IssueAccessTokenResponse.header:type_name -> types.ResponseHeader
+	120, // 157: types.IssueAccessTokenResponse.claims:type_name -> types.AccessToken
+	124, // 158: types.RevokeAccessTokenResponseEnvelope.response:type_name -> types.RevokeAccessTokenResponse
+	5,   // 159: types.RevokeAccessTokenResponse.header:type_name -> types.ResponseHeader
+	126, // 160: types.GetKeyBlocksResponseEnvelope.response:type_name -> types.GetKeyBlocksResponse
+	5,   // 161: types.GetKeyBlocksResponse.header:type_name -> types.ResponseHeader
+	127, // 162: types.GetKeyBlocksResponse.candidates:type_name -> types.KeyBlockCandidate
+	129, // 163: types.DBImportResponseEnvelope.response:type_name -> types.DBImportResponse
+	5,   // 164: types.DBImportResponse.header:type_name -> types.ResponseHeader
+	64,  // 165: types.GetDataProvenanceResponse.DBKeyValuesEntry.value:type_name -> types.KVsWithMetadata
+	166, // [166:166] is the sub-list for method output_type
+	166, // [166:166] is the sub-list for method input_type
+	166, // [166:166] is the sub-list for extension type_name
+	166, // [166:166] is the sub-list for extension extendee
+	0,   // [0:166] is the sub-list for field type_name
+}
+
+func init() { file_response_proto_init() }
+func file_response_proto_init() {
+	if File_response_proto != nil {
+		return
+	}
+	file_configuration_proto_init()
+	file_block_and_transaction_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_response_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBStatusResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBIndexResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDigestResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDigestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorHistoryResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBDescriptorHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBDescriptorChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataRangeResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeConfigResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigBlockResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigLimitsResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterStatusResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarmUpStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateDivergence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderFieldDivergence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterHeartbeatsResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterHeartbeatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeHeartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionBootstrapResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionBootstrapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseAccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAugmentedBlockHeaderResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAugmentedBlockHeaderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerPathResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxProofResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataProofResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MPTrieProofElement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoricalDataResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHistoricalDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataByVersionResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataByVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataReadersResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataReadersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataWritersResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataWritersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataProvenanceResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVsWithMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataProvenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxIDsSubmittedByResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxIDsSubmittedByResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxReceiptResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxReceiptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3090,8 +11187,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBStatusResponseEnvelope); i {
+		file_response_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3102,8 +11199,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBStatusResponse); i {
+		file_response_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3114,8 +11211,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBIndexResponseEnvelope); i {
+		file_response_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxsResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3126,8 +11223,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDBIndexResponse); i {
+		file_response_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDroppedTxsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3138,8 +11235,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataResponseEnvelope); i {
+		file_response_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DroppedTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3150,8 +11247,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataResponse); i {
+		file_response_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminAuditRecordsResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3162,8 +11259,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataRangeResponseEnvelope); i {
+		file_response_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminAuditRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3174,8 +11271,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataRangeResponse); i {
+		file_response_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAuditRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3186,8 +11283,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResponseEnvelope); i {
+		file_response_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerRollupsResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3198,8 +11295,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResponse); i {
+		file_response_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerRollupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3210,8 +11307,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigResponseEnvelope); i {
+		file_response_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerDailyRollup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3222,8 +11319,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigResponse); i {
+		file_response_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerUsageResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3234,8 +11331,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeConfigResponseEnvelope); i {
+		file_response_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3246,8 +11343,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeConfigResponse); i {
+		file_response_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserImportResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3258,8 +11355,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigBlockResponseEnvelope); i {
+		file_response_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3270,8 +11367,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigBlockResponse); i {
+		file_response_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserImportFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3282,8 +11379,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterStatusResponseEnvelope); i {
+		file_response_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxWriteSetDigestResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3294,8 +11391,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterStatusResponse); i {
+		file_response_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxWriteSetDigestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3306,8 +11403,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockResponseEnvelope); i {
+		file_response_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockCompositionResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3318,8 +11415,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockResponse); i {
+		file_response_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockCompositionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3330,8 +11427,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAugmentedBlockHeaderResponseEnvelope); i {
+		file_response_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataQueryResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3342,8 +11439,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAugmentedBlockHeaderResponse); i {
+		file_response_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3354,8 +11451,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLedgerPathResponseEnvelope); i {
+		file_response_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataCountResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3366,8 +11463,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLedgerPathResponse); i {
+		file_response_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataCountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3378,8 +11475,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxProofResponseEnvelope); i {
+		file_response_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPeerHeaderResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3390,8 +11487,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxProofResponse); i {
+		file_response_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPeerHeaderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3402,8 +11499,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataProofResponseEnvelope); i {
+		file_response_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncDBResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3414,8 +11511,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataProofResponse); i {
+		file_response_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncDBResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3426,8 +11523,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MPTrieProofElement); i {
+		file_response_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrustedCheckpointsResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3438,8 +11535,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHistoricalDataResponseEnvelope); i {
+		file_response_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrustedCheckpointsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3450,8 +11547,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHistoricalDataResponse); i {
+		file_response_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogLevelsResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3462,8 +11559,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataReadersResponseEnvelope); i {
+		file_response_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogLevelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3474,8 +11571,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataReadersResponse); i {
+		file_response_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateMigrationResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3486,8 +11583,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataWritersResponseEnvelope); i {
+		file_response_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateMigrationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3498,8 +11595,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataWritersResponse); i {
+		file_response_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateMigrationStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3510,8 +11607,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataProvenanceResponseEnvelope); i {
+		file_response_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateScrubResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3522,8 +11619,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KVsWithMetadata); i {
+		file_response_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateScrubResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3534,8 +11631,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDataProvenanceResponse); i {
+		file_response_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateScrubStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3546,8 +11643,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxIDsSubmittedByResponseEnvelope); i {
+		file_response_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorruptedValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3558,8 +11655,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxIDsSubmittedByResponse); i {
+		file_response_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustedCheckpoints); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3570,8 +11667,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxReceiptResponseEnvelope); i {
+		file_response_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustedCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3582,8 +11679,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxReceiptResponse); i {
+		file_response_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyChangesResponseEnvelope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3594,8 +11691,8 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataQueryResponseEnvelope); i {
+		file_response_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyChangesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3606,8 +11703,200 @@ func file_response_proto_init() {
 				return nil
 			}
 		}
-		file_response_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataQueryResponse); i {
+		file_response_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivilegeChangesResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivilegeChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivilegeChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataMultiResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataMultiResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiGetEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueAccessTokenResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueAccessTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessTokenResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKeyBlocksResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKeyBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyBlockCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBImportResponseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_response_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3624,13 +11913,14 @@ func file_response_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_response_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   48,
+			NumEnums:      5,
+			NumMessages:   129,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_response_proto_goTypes,
 		DependencyIndexes: file_response_proto_depIdxs,
+		EnumInfos:         file_response_proto_enumTypes,
 		MessageInfos:      file_response_proto_msgTypes,
 	}.Build()
 	File_response_proto = out.File
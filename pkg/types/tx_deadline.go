@@ -0,0 +1,16 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package types
+
+// PassedAt returns true if a block numbered `blockNum` with the timestamp `timestamp` is past the deadline, and hence
+// must not hold the transaction. A nil deadline is never passed.
+func (d *TxDeadline) PassedAt(blockNum uint64, timestamp int64) bool {
+	if d == nil {
+		return false
+	}
+
+	if d.BlockNumber > 0 && blockNum > d.BlockNumber {
+		return true
+	}
+	return d.Timestamp > 0 && timestamp > d.Timestamp
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+package types
+
+import (
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+// UserImportDigest returns the digest of a user to be created by a user import, which is the SHA-256 hash of the
+// deterministic encoding of the user. The client signs the digests of the users in the UserImportRequest.
+func UserImportDigest(user *User) ([]byte, error) {
+	if user == nil {
+		return nil, errors.New("the user cannot be nil")
+	}
+
+	b, err := protov2.MarshalOptions{Deterministic: true}.Marshal(user)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while marshaling the user [%s]", user.GetId())
+	}
+	digest := sha256.Sum256(b)
+	return digest[:], nil
+}
+
+// NewUserImportRequest prepares the request of the user `userID` to import the given users, with the digest of each
+// user in the order of the users. The request is to be signed by `userID`.
+func NewUserImportRequest(userID, importID string, users []*User) (*UserImportRequest, error) {
+	request := &UserImportRequest{
+		UserId:   userID,
+		ImportId: importID,
+	}
+	for _, u := range users {
+		digest, err := UserImportDigest(u)
+		if err != nil {
+			return nil, err
+		}
+		request.UserDigests = append(request.UserDigests, digest)
+	}
+	return request, nil
+}
@@ -290,9 +290,9 @@ func TestDeleteAdmin(t *testing.T) {
 }
 
 // Scenario:
-// - admin changes his cert to the cert of alice
-// - try to get config envelope with admin old signer - tx fails
-// - update admin signer to new signer based on alice's private key
+// - admin tries to change his cert to the cert of alice in a single config tx - tx fails, as the cluster would be
+//   left without an admin that is known to hold the private key of its certificate
+// - get config envelope with admin old signer - admin is unchanged
 // - get config envelope
 func TestChangeAdminCA(t *testing.T) {
 	dir, err := ioutil.TempDir("", "int-test")
@@ -339,28 +339,17 @@ func TestChangeAdminCA(t *testing.T) {
 	require.Equal(t, "admin", newConfig.Admins[0].Id)
 	newConfig.Admins[0].Certificate = decodedCert.Bytes
 
-	txID, rcpt, err := leaderServer.SetConfigTx(t, newConfig, version, c.Servers[leaderIndex].AdminSigner(), "admin")
-	require.NoError(t, err)
-	require.NotNil(t, rcpt)
-	require.True(t, txID != "")
-	require.True(t, len(rcpt.GetHeader().GetValidationInfo()) > 0)
-	require.Equal(t, types.Flag_VALID, rcpt.Header.ValidationInfo[rcpt.TxIndex].Flag)
-	t.Logf("tx submitted: %s, %+v", txID, rcpt)
-
-	//try to get config envelope with admin old signer
-	configEnv, err = leaderServer.QueryConfig(t, "admin")
-	require.EqualError(t, err, "error while issuing /config/tx: signature verification failed")
-
-	//update admin signer
-	aliceSigner, err := c.GetSigner("alice")
-	require.NoError(t, err)
-	require.NotNil(t, aliceSigner)
-	leaderServer.SetAdminSigner(aliceSigner)
+	_, _, err = leaderServer.SetConfigTx(t, newConfig, version, c.Servers[leaderIndex].AdminSigner(), "admin")
+	require.EqualError(t, err, "failed to submit transaction, server returned: status: 400 Bad Request, message: Invalid config tx, reason: "+
+		"the updated admin config does not retain any of the current admins with the same certificate. "+
+		"At least one current admin must be retained; add the new admins first, and remove the current admins in a subsequent transaction signed by a new admin")
 
-	//get config envelope with new signer
+	//get config envelope with admin old signer
 	configEnv, err = leaderServer.QueryConfig(t, "admin")
 	require.NoError(t, err)
 	require.NotNil(t, configEnv)
+	require.Len(t, configEnv.GetResponse().GetConfig().GetAdmins(), 1)
+	require.NotEqual(t, decodedCert.Bytes, configEnv.GetResponse().GetConfig().GetAdmins()[0].Certificate)
 }
 
 // Scenario:
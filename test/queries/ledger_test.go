@@ -713,10 +713,15 @@ func TestLedgerDataProof(t *testing.T) {
 		require.True(t, ok)
 	})
 
-	t.Run("invalid: non-existing key", func(t *testing.T) {
-		respEnv, err := s.GetDataProof(t, worldstate.DefaultDBName, "key8", "admin", 3, false)
-		require.EqualError(t, err, "error while issuing /ledger/proof/data/bdb/key8?block=3: error while processing 'GET /ledger/proof/data/bdb/key8?block=3' because no proof for block 3, db bdb, key key8, isDeleted false found")
-		require.Nil(t, respEnv)
+	t.Run("valid: non-existing key", func(t *testing.T) {
+		respEnv, err := s.GetDataProof(t, worldstate.DefaultDBName, "key8", "admin", rcpt2.GetHeader().GetBaseHeader().GetNumber(), false)
+		require.NoError(t, err)
+		require.NotNil(t, respEnv)
+		require.Empty(t, respEnv.GetResponse().GetPath())
+
+		ok, err := verifyNonInclusionProof(respEnv.GetResponse().GetNonInclusionPath(), worldstate.DefaultDBName, "key8", rcpt2.GetHeader().GetStateMerkelTreeRootHash())
+		require.NoError(t, err)
+		require.True(t, ok)
 	})
 
 	t.Run("invalid: block out of range", func(t *testing.T) {
@@ -754,6 +759,21 @@ func TestLedgerDataProof(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, ok)
 	})
+
+	t.Run("valid: 'key1' absent after its delete", func(t *testing.T) {
+		respEnv, err := s.GetDataProof(t, worldstate.DefaultDBName, "key1", "admin", rcpt3.GetHeader().GetBaseHeader().GetNumber(), false)
+		require.NoError(t, err)
+		require.NotNil(t, respEnv)
+
+		ok, err := verifyNonInclusionProof(respEnv.GetResponse().GetNonInclusionPath(), worldstate.DefaultDBName, "key1", rcpt3.GetHeader().GetStateMerkelTreeRootHash())
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// 'key1' was present before its delete
+		ok, err = verifyNonInclusionProof(respEnv.GetResponse().GetNonInclusionPath(), worldstate.DefaultDBName, "key1", rcpt2.GetHeader().GetStateMerkelTreeRootHash())
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
 }
 
 func TestLedgerAsyncDataProof(t *testing.T) {
@@ -942,6 +962,14 @@ func verifyDataProof(path []*types.MPTrieProofElement, valueHash, rootHash []byt
 	return bytes.Equal(rootHash, hashToFind), nil
 }
 
+func verifyNonInclusionProof(path []*types.MPTrieProofElement, dbName, key string, rootHash []byte) (bool, error) {
+	stateTrieKey, err := state.ConstructCompositeKey(dbName, key)
+	if err != nil {
+		return false, err
+	}
+	return state.NewNonInclusionProof(path).Verify(stateTrieKey, rootHash)
+}
+
 func calculateValueHash(dbName, key string, value []byte) ([]byte, error) {
 	stateTrieKey, err := state.ConstructCompositeKey(dbName, key)
 	if err != nil {
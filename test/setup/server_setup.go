@@ -414,6 +414,30 @@ func (s *Server) QueryClusterStatus(t *testing.T) (*types.GetClusterStatusRespon
 	return response, err
 }
 
+func (s *Server) QuerySessionBootstrap(t *testing.T, userID string) (*types.GetSessionBootstrapResponseEnvelope, error) {
+	client, err := s.NewRESTClient(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := s.Signer(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &types.GetSessionBootstrapQuery{
+		UserId: userID,
+	}
+	response, err := client.GetSessionBootstrap(
+		&types.GetSessionBootstrapQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, signer, query),
+		},
+	)
+
+	return response, err
+}
+
 func (s *Server) QueryConfig(t *testing.T, user string) (*types.GetConfigResponseEnvelope, error) {
 	client, err := s.NewRESTClient(nil)
 	if err != nil {
@@ -515,6 +539,33 @@ func (s *Server) GetValueAt(t *testing.T, db, key, userID string, ver *types.Ver
 	return response, err
 }
 
+func (s *Server) GetDataByVersion(t *testing.T, db, key, userID string, ver *types.Version) (*types.GetDataByVersionResponseEnvelope, error) {
+	client, err := s.NewRESTClient(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := s.Signer(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &types.GetDataByVersionQuery{
+		UserId:  userID,
+		DbName:  db,
+		Key:     key,
+		Version: ver,
+	}
+	response, err := client.GetDataByVersion(
+		&types.GetDataByVersionQueryEnvelope{
+			Payload:   query,
+			Signature: testutils.SignatureFromQuery(t, signer, query),
+		},
+	)
+
+	return response, err
+}
+
 func (s *Server) GetPreviousValues(t *testing.T, db, key, userID string, ver *types.Version) (*types.GetHistoricalDataResponseEnvelope, error) {
 	client, err := s.NewRESTClient(nil)
 	if err != nil {
@@ -1338,6 +1389,8 @@ func (s *Server) CreateConfigFile(conf *config.LocalConfiguration) error {
 			},
 			QueryProcessing: config.QueryProcessingConf{
 				ResponseSizeLimitInBytes: s.queryLimit,
+				HistoricalQueryCostLimit: 100000,
+				CountQueryCostLimit:      100000,
 			},
 			LogLevel: "info",
 		},